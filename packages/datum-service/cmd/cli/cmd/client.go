@@ -2,6 +2,7 @@ package datum
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -272,3 +273,19 @@ func StoreSession(session string) error {
 
 	return nil
 }
+
+// RemoveTokens removes the auth tokens and session from the local keyring
+func RemoveTokens() error {
+	ring, err := GetKeyring()
+	if err != nil {
+		return fmt.Errorf("error opening keyring: %w", err)
+	}
+
+	for _, key := range []string{accessTokenKey, refreshTokenKey, sessionKey} {
+		if err := ring.Remove(key); err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
+			return fmt.Errorf("failed removing %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@ package datumlogin
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"syscall"
 
 	"github.com/spf13/cobra"
@@ -79,9 +81,41 @@ func passwordAuth(ctx context.Context, client *datumclient.DatumClient, username
 		return nil, err
 	}
 
+	if resp.TFARequired {
+		resp, err = tfaAuth(ctx, client, resp.TFAToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &oauth2.Token{
 		AccessToken:  resp.AccessToken,
 		RefreshToken: resp.RefreshToken,
 		TokenType:    resp.TokenType,
 	}, nil
 }
+
+// tfaAuth completes the login with a TOTP code or recovery code when the user has two factor authentication enabled
+func tfaAuth(ctx context.Context, client *datumclient.DatumClient, token string) (*models.LoginReply, error) {
+	fmt.Print("\nTwo factor code (or recovery code): ")
+
+	bytecode, err := term.ReadPassword(int(syscall.Stdin))
+	if err != nil {
+		return nil, err
+	}
+
+	code := strings.TrimSpace(string(bytecode))
+
+	in := models.TFALoginRequest{
+		TFAToken: token,
+	}
+
+	// totp codes are numeric, recovery codes are alphanumeric
+	if _, err := strconv.Atoi(code); err == nil {
+		in.TOTPCode = code
+	} else {
+		in.RecoveryCode = code
+	}
+
+	return client.LoginTFA(ctx, &in)
+}
@@ -0,0 +1,2 @@
+// Package datumlogout is our cobra cli for ending authenticated sessions
+package datumlogout
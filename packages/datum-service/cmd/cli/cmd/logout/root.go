@@ -0,0 +1,54 @@
+package datumlogout
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+	"github.com/datumforge/datum/pkg/models"
+)
+
+var cmd = &cobra.Command{
+	Use:   "logout",
+	Short: "logout of the datum API, revoking the session and refresh token",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := logout(cmd.Context())
+		cobra.CheckErr(err)
+	},
+}
+
+func init() {
+	datum.RootCmd.AddCommand(cmd)
+
+	cmd.Flags().Bool("everywhere", false, "revoke every session and refresh token of the user")
+}
+
+// logout revokes the session and refresh token and removes them from the keychain
+func logout(ctx context.Context) error {
+	// setup datum http client
+	client, err := datum.SetupClientWithAuth(ctx)
+	cobra.CheckErr(err)
+
+	token, _, err := datum.GetTokenFromKeyring(ctx)
+	cobra.CheckErr(err)
+
+	input := &models.LogoutRequest{
+		RefreshToken: token.RefreshToken,
+		Everywhere:   datum.Config.Bool("everywhere"),
+	}
+
+	_, err = client.Logout(ctx, input)
+	cobra.CheckErr(err)
+
+	fmt.Println("Successfully logged out!")
+
+	if err := datum.RemoveTokens(); err != nil {
+		return err
+	}
+
+	fmt.Println("auth tokens successfully removed from keychain")
+
+	return nil
+}
@@ -27,6 +27,7 @@ func init() {
 	createCmd.Flags().StringP("description", "d", "", "description of the pat")
 	createCmd.Flags().StringSliceP("organizations", "o", []string{}, "organization(s) id to associate the pat with")
 	createCmd.Flags().DurationP("expiration", "e", 0, "duration of the pat to be valid, leave empty to never expire")
+	createCmd.Flags().StringSlice("scopes", []string{"read", "write"}, "scopes to associate with the pat in the form of resource:action, e.g. organization:read")
 }
 
 // createValidation validates the required fields for the command
@@ -46,6 +47,11 @@ func createValidation() (input datumclient.CreatePersonalAccessTokenInput, err e
 		input.OrganizationIDs = organizations
 	}
 
+	scopes := datum.Config.Strings("scopes")
+	if len(scopes) > 0 {
+		input.Scopes = scopes
+	}
+
 	expiration := datum.Config.Duration("expiration")
 	if expiration != 0 {
 		input.ExpiresAt = lo.ToPtr(time.Now().Add(expiration))
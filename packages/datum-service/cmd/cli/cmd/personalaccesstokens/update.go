@@ -26,6 +26,7 @@ func init() {
 	updateCmd.Flags().StringP("description", "d", "", "description of the pat")
 	updateCmd.Flags().StringSliceP("add-organizations", "o", []string{}, "add organization(s) id to associate the pat with")
 	updateCmd.Flags().StringSliceP("remove-organizations", "r", []string{}, "remove organization(s) id to associate the pat with")
+	updateCmd.Flags().StringSlice("scopes", []string{}, "scopes of the pat in the form of resource:action, e.g. organization:read")
 }
 
 // updateValidation validates the required fields for the command
@@ -55,6 +56,11 @@ func updateValidation() (id string, input datumclient.UpdatePersonalAccessTokenI
 		input.RemoveOrganizationIDs = removeOrgs
 	}
 
+	scopes := datum.Config.Strings("scopes")
+	if len(scopes) > 0 {
+		input.Scopes = scopes
+	}
+
 	return id, input, nil
 }
 
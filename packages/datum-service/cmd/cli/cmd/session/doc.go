@@ -0,0 +1,2 @@
+// Package datumsession is our cobra cli for listing and revoking sessions
+package datumsession
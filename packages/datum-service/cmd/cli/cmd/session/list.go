@@ -0,0 +1,42 @@
+package datumsession
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list the active sessions of the user",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := list(cmd.Context())
+		cobra.CheckErr(err)
+	},
+}
+
+func init() {
+	cmd.AddCommand(listCmd)
+
+	listCmd.Flags().StringP("user-id", "u", "", "user id to list the sessions of, requires admin access to the organization")
+}
+
+// list the active sessions of the user in the datum platform
+func list(ctx context.Context) error {
+	// setup datum http client
+	client, err := datum.SetupClientWithAuth(ctx)
+	cobra.CheckErr(err)
+	defer datum.StoreSessionCookies(client)
+
+	var userID *string
+	if id := datum.Config.String("user-id"); id != "" {
+		userID = &id
+	}
+
+	o, err := client.GetUserSessions(ctx, userID)
+	cobra.CheckErr(err)
+
+	return consoleOutput(o)
+}
@@ -0,0 +1,50 @@
+package datumsession
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var revokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "revoke an active session of the user",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := revoke(cmd.Context())
+		cobra.CheckErr(err)
+	},
+}
+
+func init() {
+	cmd.AddCommand(revokeCmd)
+
+	revokeCmd.Flags().StringP("id", "i", "", "session id to revoke")
+}
+
+// revokeValidation validates the required fields for the command
+func revokeValidation() (string, error) {
+	id := datum.Config.String("id")
+	if id == "" {
+		return "", datum.NewRequiredFieldMissingError("session id")
+	}
+
+	return id, nil
+}
+
+// revoke an active session in the datum platform
+func revoke(ctx context.Context) error {
+	// setup datum http client
+	client, err := datum.SetupClientWithAuth(ctx)
+	cobra.CheckErr(err)
+	defer datum.StoreSessionCookies(client)
+
+	id, err := revokeValidation()
+	cobra.CheckErr(err)
+
+	o, err := client.RevokeSession(ctx, id)
+	cobra.CheckErr(err)
+
+	return consoleOutput(o)
+}
@@ -0,0 +1,98 @@
+package datumsession
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+	"github.com/datumforge/datum/pkg/datumclient"
+	"github.com/datumforge/datum/pkg/utils/cli/tables"
+)
+
+// cmd represents the base cmd command when called without any subcommands
+var cmd = &cobra.Command{
+	Use:   "session",
+	Short: "the subcommands for working with the sessions of a user",
+}
+
+func init() {
+	datum.RootCmd.AddCommand(cmd)
+}
+
+// consoleOutput prints the output in the console
+func consoleOutput(e any) error {
+	// check if the output format is JSON and print the output in JSON format
+	if datum.OutputFormat == datum.JSONOutput {
+		return jsonOutput(e)
+	}
+
+	// check the type of the output and print them in a table format
+	switch v := e.(type) {
+	case *datumclient.GetUserSessions:
+		e = v.UserSessions
+	case *datumclient.RevokeSession:
+		deletedTableOutput(v)
+		return nil
+	}
+
+	s, err := json.Marshal(e)
+	cobra.CheckErr(err)
+
+	var list []datumclient.UserSession
+
+	err = json.Unmarshal(s, &list)
+	cobra.CheckErr(err)
+
+	tableOutput(list)
+
+	return nil
+}
+
+// jsonOutput prints the output in a JSON format
+func jsonOutput(out any) error {
+	s, err := json.Marshal(out)
+	cobra.CheckErr(err)
+
+	return datum.JSONPrint(s)
+}
+
+// tableOutput prints the output in a table format
+func tableOutput(out []datumclient.UserSession) {
+	writer := tables.NewTableWriter(cmd.OutOrStdout(), "ID", "UserAgent", "IPAddress", "CreatedAt", "LastActivity", "Current")
+
+	for _, i := range out {
+		userAgent := "unknown"
+		if i.UserAgent != nil {
+			userAgent = *i.UserAgent
+		}
+
+		ipAddress := "unknown"
+		if i.IPAddress != nil {
+			ipAddress = *i.IPAddress
+		}
+
+		createdAt := "unknown"
+		if i.CreatedAt != nil {
+			createdAt = i.CreatedAt.String()
+		}
+
+		lastActivity := "unknown"
+		if i.LastActivity != nil {
+			lastActivity = i.LastActivity.String()
+		}
+
+		writer.AddRow(i.ID, userAgent, ipAddress, createdAt, lastActivity, i.Current)
+	}
+
+	writer.Render()
+}
+
+// deletedTableOutput prints the revoked session id in a table format
+func deletedTableOutput(e *datumclient.RevokeSession) {
+	writer := tables.NewTableWriter(cmd.OutOrStdout(), "DeletedID")
+
+	writer.AddRow(e.RevokeSession.DeletedID)
+
+	writer.Render()
+}
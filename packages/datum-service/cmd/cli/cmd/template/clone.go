@@ -0,0 +1,60 @@
+package datumtemplates
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var cloneCmd = &cobra.Command{
+	Use:   "clone",
+	Short: "clone a datum root template into an organization",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := clone(cmd.Context())
+		cobra.CheckErr(err)
+	},
+}
+
+func init() {
+	cmd.AddCommand(cloneCmd)
+
+	cloneCmd.Flags().StringP("id", "i", "", "root template id to clone")
+	cloneCmd.Flags().StringP("name", "n", "", "name of the cloned template, defaults to the name of the root template")
+	cloneCmd.Flags().StringP("owner-id", "o", "", "organization to clone the template into")
+}
+
+// cloneValidation validates the required fields for the command
+func cloneValidation() (id string, ownerID, name *string, err error) {
+	id = datum.Config.String("id")
+	if id == "" {
+		return id, nil, nil, datum.NewRequiredFieldMissingError("template id")
+	}
+
+	if o := datum.Config.String("owner-id"); o != "" {
+		ownerID = &o
+	}
+
+	if n := datum.Config.String("name"); n != "" {
+		name = &n
+	}
+
+	return id, ownerID, name, nil
+}
+
+// clone creates a document template from the root template
+func clone(ctx context.Context) error {
+	// setup datum http client
+	client, err := datum.SetupClientWithAuth(ctx)
+	cobra.CheckErr(err)
+	defer datum.StoreSessionCookies(client)
+
+	id, ownerID, name, err := cloneValidation()
+	cobra.CheckErr(err)
+
+	o, err := client.CloneTemplate(ctx, id, ownerID, name)
+	cobra.CheckErr(err)
+
+	return consoleOutput(o)
+}
@@ -0,0 +1,62 @@
+package datumtemplates
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "compare two versions of a datum template",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := diff(cmd.Context())
+		cobra.CheckErr(err)
+	},
+}
+
+func init() {
+	cmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().StringP("id", "i", "", "template id to compare the versions of")
+	diffCmd.Flags().Int64("from", 0, "version to compare from")
+	diffCmd.Flags().Int64("to", 0, "version to compare to")
+}
+
+// diffValidation validates the required fields for the command
+func diffValidation() (id string, from, to int64, err error) {
+	id = datum.Config.String("id")
+	if id == "" {
+		return id, from, to, datum.NewRequiredFieldMissingError("template id")
+	}
+
+	from = datum.Config.Int64("from")
+	if from == 0 {
+		return id, from, to, datum.NewRequiredFieldMissingError("from")
+	}
+
+	to = datum.Config.Int64("to")
+	if to == 0 {
+		return id, from, to, datum.NewRequiredFieldMissingError("to")
+	}
+
+	return id, from, to, nil
+}
+
+// diff prints the changes made to the template between the two versions
+func diff(ctx context.Context) error {
+	// setup datum http client
+	client, err := datum.SetupClientWithAuth(ctx)
+	cobra.CheckErr(err)
+	defer datum.StoreSessionCookies(client)
+
+	id, from, to, err := diffValidation()
+	cobra.CheckErr(err)
+
+	o, err := client.GetTemplateVersionDiff(ctx, id, from, to)
+	cobra.CheckErr(err)
+
+	return consoleOutput(o)
+}
@@ -0,0 +1,56 @@
+package datumtemplates
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "migrate the documents of a datum template to a version",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := migrate(cmd.Context())
+		cobra.CheckErr(err)
+	},
+}
+
+func init() {
+	cmd.AddCommand(migrateCmd)
+
+	migrateCmd.Flags().StringP("id", "i", "", "template id to migrate the documents of")
+	migrateCmd.Flags().Int64("version", 0, "version to migrate the documents to")
+}
+
+// migrateValidation validates the required fields for the command
+func migrateValidation() (id string, version int64, err error) {
+	id = datum.Config.String("id")
+	if id == "" {
+		return id, version, datum.NewRequiredFieldMissingError("template id")
+	}
+
+	version = datum.Config.Int64("version")
+	if version == 0 {
+		return id, version, datum.NewRequiredFieldMissingError("version")
+	}
+
+	return id, version, nil
+}
+
+// migrate pins the documents of the template to the version and prints the documents that failed validation
+func migrate(ctx context.Context) error {
+	// setup datum http client
+	client, err := datum.SetupClientWithAuth(ctx)
+	cobra.CheckErr(err)
+	defer datum.StoreSessionCookies(client)
+
+	id, version, err := migrateValidation()
+	cobra.CheckErr(err)
+
+	o, err := client.MigrateTemplateDocuments(ctx, id, version)
+	cobra.CheckErr(err)
+
+	return consoleOutput(o)
+}
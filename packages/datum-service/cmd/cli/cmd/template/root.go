@@ -3,6 +3,7 @@ package datumtemplates
 import (
 	"encoding/json"
 
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 
 	datum "github.com/datumforge/datum/cmd/cli/cmd"
@@ -43,6 +44,14 @@ func consoleOutput(e any) error {
 		e = v.CreateTemplate.Template
 	case *datumclient.UpdateTemplate:
 		e = v.UpdateTemplate.Template
+	case *datumclient.CloneTemplate:
+		e = v.CloneTemplate.Template
+	case *datumclient.GetTemplateVersions:
+		return versionOutput(v)
+	case *datumclient.GetTemplateVersionDiff:
+		return diffOutput(v)
+	case *datumclient.MigrateTemplateDocuments:
+		return migrationOutput(v)
 	}
 
 	s, err := json.Marshal(e)
@@ -74,11 +83,57 @@ func jsonOutput(out any) error {
 
 // tableOutput prints the output in a table format
 func tableOutput(out []datumclient.Template) {
-	writer := tables.NewTableWriter(cmd.OutOrStdout(), "ID", "Name", "Description", "JSON")
+	writer := tables.NewTableWriter(cmd.OutOrStdout(), "ID", "Name", "Description", "Version", "JSON")
 	for _, i := range out {
 		// this doesn't visually show you the json in the table but leaving it in for now
-		writer.AddRow(i.ID, i.Name, *i.Description, i.Jsonconfig)
+		writer.AddRow(i.ID, i.Name, *i.Description, i.Version, i.Jsonconfig)
 	}
 
 	writer.Render()
 }
+
+// versionOutput prints the template versions in a table format
+func versionOutput(v *datumclient.GetTemplateVersions) error {
+	writer := tables.NewTableWriter(cmd.OutOrStdout(), "ID", "Template ID", "Version", "Created By", "Created At")
+	for _, i := range v.TemplateVersions.Edges {
+		writer.AddRow(i.Node.ID, i.Node.TemplateID, i.Node.Version, lo.FromPtr(i.Node.CreatedBy), lo.FromPtr(i.Node.CreatedAt))
+	}
+
+	writer.Render()
+
+	return nil
+}
+
+// diffOutput prints the changes between the template versions in a table format
+func diffOutput(v *datumclient.GetTemplateVersionDiff) error {
+	writer := tables.NewTableWriter(cmd.OutOrStdout(), "Schema", "Path", "Operation", "From", "To")
+	for _, i := range v.TemplateVersionDiff.Jsonconfig {
+		writer.AddRow("jsonconfig", i.Path, i.Operation, lo.FromPtr(i.From), lo.FromPtr(i.To))
+	}
+
+	for _, i := range v.TemplateVersionDiff.Uischema {
+		writer.AddRow("uischema", i.Path, i.Operation, lo.FromPtr(i.From), lo.FromPtr(i.To))
+	}
+
+	writer.Render()
+
+	return nil
+}
+
+// migrationOutput prints the result of the document migration in a table format
+func migrationOutput(v *datumclient.MigrateTemplateDocuments) error {
+	writer := tables.NewTableWriter(cmd.OutOrStdout(), "Document ID", "Migrated", "Path", "Error")
+	for _, id := range v.MigrateTemplateDocuments.Migrated {
+		writer.AddRow(id, true, "", "")
+	}
+
+	for _, f := range v.MigrateTemplateDocuments.Failed {
+		for _, e := range f.Errors {
+			writer.AddRow(f.DocumentDataID, false, e.Path, e.Message)
+		}
+	}
+
+	writer.Render()
+
+	return nil
+}
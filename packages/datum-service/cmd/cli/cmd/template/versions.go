@@ -0,0 +1,63 @@
+package datumtemplates
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+	"github.com/datumforge/datum/pkg/datumclient"
+)
+
+var versionsCmd = &cobra.Command{
+	Use:   "versions",
+	Short: "get the versions of a datum template",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := versions(cmd.Context())
+		cobra.CheckErr(err)
+	},
+}
+
+func init() {
+	cmd.AddCommand(versionsCmd)
+
+	versionsCmd.Flags().StringP("id", "i", "", "template id to get the versions for")
+	versionsCmd.Flags().Int64("first", 25, "number of versions to return")
+	versionsCmd.Flags().String("after", "", "cursor to return the versions after, used for pagination")
+}
+
+// versionsValidation validates the required fields for the command
+func versionsValidation() (where datumclient.TemplateVersionWhereInput, err error) {
+	id := datum.Config.String("id")
+	if id == "" {
+		return where, datum.NewRequiredFieldMissingError("template id")
+	}
+
+	where.TemplateID = &id
+
+	return where, nil
+}
+
+// versions retrieves the versions of a template from the datum platform
+func versions(ctx context.Context) error {
+	// setup datum http client
+	client, err := datum.SetupClientWithAuth(ctx)
+	cobra.CheckErr(err)
+	defer datum.StoreSessionCookies(client)
+
+	where, err := versionsValidation()
+	cobra.CheckErr(err)
+
+	first := datum.Config.Int64("first")
+
+	var after *string
+
+	if cursor := datum.Config.String("after"); cursor != "" {
+		after = &cursor
+	}
+
+	o, err := client.GetTemplateVersions(ctx, &first, after, &where)
+	cobra.CheckErr(err)
+
+	return consoleOutput(o)
+}
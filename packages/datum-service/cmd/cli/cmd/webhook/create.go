@@ -25,6 +25,8 @@ func init() {
 	createcmd.Flags().StringP("description", "d", "", "description of the webhook")
 	createcmd.Flags().StringP("url", "u", "", "the destination url the webhook is sent to")
 	createcmd.Flags().BoolP("enabled", "e", true, "if the webhook is enabled")
+	createcmd.Flags().StringSlice("event-types", []string{}, "the event types the webhook is subscribed to, defaults to all events")
+	createcmd.Flags().StringSlice("object-types", []string{}, "the object types the webhook receives events for, defaults to all object types")
 }
 
 // createValidation validates the required fields for the command
@@ -49,6 +51,16 @@ func createValidation() (input datumclient.CreateWebhookInput, err error) {
 		input.Description = &description
 	}
 
+	eventTypes := datum.Config.Strings("event-types")
+	if len(eventTypes) > 0 {
+		input.EventTypes = eventTypes
+	}
+
+	objectTypes := datum.Config.Strings("object-types")
+	if len(objectTypes) > 0 {
+		input.ObjectTypes = objectTypes
+	}
+
 	return input, nil
 }
 
@@ -0,0 +1,69 @@
+package datumwebhooks
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+	"github.com/datumforge/datum/pkg/datumclient"
+)
+
+var deliveriesCmd = &cobra.Command{
+	Use:   "deliveries",
+	Short: "get the delivery attempts of a datum webhook",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := deliveries(cmd.Context())
+		cobra.CheckErr(err)
+	},
+}
+
+func init() {
+	cmd.AddCommand(deliveriesCmd)
+
+	deliveriesCmd.Flags().StringP("id", "i", "", "webhook id to get the deliveries for")
+	deliveriesCmd.Flags().String("event-id", "", "only get the deliveries of a specific event")
+	deliveriesCmd.Flags().Int64("first", 25, "number of deliveries to return")
+	deliveriesCmd.Flags().String("after", "", "cursor to return the deliveries after, used for pagination")
+}
+
+// deliveriesValidation validates the required fields for the command
+func deliveriesValidation() (where datumclient.WebhookDeliveryWhereInput, err error) {
+	id := datum.Config.String("id")
+	if id == "" {
+		return where, datum.NewRequiredFieldMissingError("webhook id")
+	}
+
+	where.WebhookID = &id
+
+	eventID := datum.Config.String("event-id")
+	if eventID != "" {
+		where.EventID = &eventID
+	}
+
+	return where, nil
+}
+
+// deliveries retrieves the delivery attempts of a webhook from the datum platform
+func deliveries(ctx context.Context) error {
+	// setup datum http client
+	client, err := datum.SetupClientWithAuth(ctx)
+	cobra.CheckErr(err)
+	defer datum.StoreSessionCookies(client)
+
+	where, err := deliveriesValidation()
+	cobra.CheckErr(err)
+
+	first := datum.Config.Int64("first")
+
+	var after *string
+
+	if cursor := datum.Config.String("after"); cursor != "" {
+		after = &cursor
+	}
+
+	o, err := client.GetWebhookDeliveries(ctx, &first, after, &where)
+	cobra.CheckErr(err)
+
+	return consoleOutput(o)
+}
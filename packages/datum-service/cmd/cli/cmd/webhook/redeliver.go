@@ -0,0 +1,56 @@
+package datumwebhooks
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+)
+
+var redeliverCmd = &cobra.Command{
+	Use:   "redeliver",
+	Short: "redeliver an event to a datum webhook",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := redeliver(cmd.Context())
+		cobra.CheckErr(err)
+	},
+}
+
+func init() {
+	cmd.AddCommand(redeliverCmd)
+
+	redeliverCmd.Flags().StringP("id", "i", "", "webhook id to redeliver the event to")
+	redeliverCmd.Flags().String("event-id", "", "event id to redeliver")
+}
+
+// redeliverValidation validates the required fields for the command
+func redeliverValidation() (id, eventID string, err error) {
+	id = datum.Config.String("id")
+	if id == "" {
+		return id, eventID, datum.NewRequiredFieldMissingError("webhook id")
+	}
+
+	eventID = datum.Config.String("event-id")
+	if eventID == "" {
+		return id, eventID, datum.NewRequiredFieldMissingError("event id")
+	}
+
+	return id, eventID, nil
+}
+
+// redeliver sends an event to the webhook again and prints the delivery attempts
+func redeliver(ctx context.Context) error {
+	// setup datum http client
+	client, err := datum.SetupClientWithAuth(ctx)
+	cobra.CheckErr(err)
+	defer datum.StoreSessionCookies(client)
+
+	id, eventID, err := redeliverValidation()
+	cobra.CheckErr(err)
+
+	o, err := client.RedeliverWebhookEvent(ctx, id, eventID)
+	cobra.CheckErr(err)
+
+	return consoleOutput(o)
+}
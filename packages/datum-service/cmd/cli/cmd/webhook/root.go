@@ -2,7 +2,9 @@ package datumwebhooks
 
 import (
 	"encoding/json"
+	"strings"
 
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 
 	datum "github.com/datumforge/datum/cmd/cli/cmd"
@@ -43,6 +45,16 @@ func consoleOutput(e any) error {
 		e = v.CreateWebhook.Webhook
 	case *datumclient.UpdateWebhook:
 		e = v.UpdateWebhook.Webhook
+	case *datumclient.GetWebhookDeliveries:
+		var nodes []*datumclient.GetWebhookDeliveries_WebhookDeliveries_Edges_Node
+
+		for _, i := range v.WebhookDeliveries.Edges {
+			nodes = append(nodes, i.Node)
+		}
+
+		return deliveryOutput(nodes)
+	case *datumclient.RedeliverWebhookEvent:
+		return deliveryOutput(v.RedeliverWebhookEvent.WebhookDeliveries)
 	}
 
 	s, err := json.Marshal(e)
@@ -74,11 +86,31 @@ func jsonOutput(out any) error {
 
 // tableOutput prints the output in a table format
 func tableOutput(out []datumclient.Webhook) {
-	writer := tables.NewTableWriter(cmd.OutOrStdout(), "ID", "Name", "Description", "Destination URL", "Enabled")
+	writer := tables.NewTableWriter(cmd.OutOrStdout(), "ID", "Name", "Description", "Destination URL", "Enabled", "Event Types", "Object Types")
 	for _, i := range out {
 		// this doesn't visually show you the json in the table but leaving it in for now
-		writer.AddRow(i.ID, i.Name, *i.Description, i.DestinationURL, i.Enabled)
+		writer.AddRow(i.ID, i.Name, *i.Description, i.DestinationURL, i.Enabled, strings.Join(i.EventTypes, ", "), strings.Join(i.ObjectTypes, ", "))
+	}
+
+	writer.Render()
+}
+
+// deliveryOutput prints the webhook deliveries in a table format
+func deliveryOutput(e any) error {
+	s, err := json.Marshal(e)
+	cobra.CheckErr(err)
+
+	var list []datumclient.WebhookDelivery
+
+	err = json.Unmarshal(s, &list)
+	cobra.CheckErr(err)
+
+	writer := tables.NewTableWriter(cmd.OutOrStdout(), "ID", "Event ID", "Attempt", "Status", "Latency (ms)", "Error", "Redelivery", "Created At")
+	for _, i := range list {
+		writer.AddRow(i.ID, i.EventID, i.Attempt, lo.FromPtr(i.ResponseStatus), lo.FromPtr(i.Latency), lo.FromPtr(i.Error), i.Redelivery, lo.FromPtr(i.CreatedAt))
 	}
 
 	writer.Render()
+
+	return nil
 }
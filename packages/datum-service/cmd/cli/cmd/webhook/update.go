@@ -1 +1,99 @@
 package datumwebhooks
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	datum "github.com/datumforge/datum/cmd/cli/cmd"
+	"github.com/datumforge/datum/pkg/datumclient"
+)
+
+var updateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "update an existing datum webhook",
+	Run: func(cmd *cobra.Command, args []string) {
+		err := update(cmd.Context())
+		cobra.CheckErr(err)
+	},
+}
+
+func init() {
+	cmd.AddCommand(updateCmd)
+
+	updateCmd.Flags().StringP("id", "i", "", "webhook id to update")
+	updateCmd.Flags().StringP("name", "n", "", "name of the webhook")
+	updateCmd.Flags().StringP("description", "d", "", "description of the webhook")
+	updateCmd.Flags().StringP("url", "u", "", "the destination url the webhook is sent to")
+	updateCmd.Flags().BoolP("enabled", "e", true, "enable or disable the webhook")
+	updateCmd.Flags().StringSlice("event-types", []string{}, "the event types the webhook is subscribed to, replaces the existing event types")
+	updateCmd.Flags().StringSlice("object-types", []string{}, "the object types the webhook receives events for, replaces the existing object types")
+	updateCmd.Flags().Bool("clear-event-types", false, "clear the event types to subscribe the webhook to all events")
+	updateCmd.Flags().Bool("clear-object-types", false, "clear the object types to send events for all object types")
+}
+
+// updateValidation validates the required fields for the command
+func updateValidation() (id string, input datumclient.UpdateWebhookInput, err error) {
+	id = datum.Config.String("id")
+	if id == "" {
+		return id, input, datum.NewRequiredFieldMissingError("webhook id")
+	}
+
+	name := datum.Config.String("name")
+	if name != "" {
+		input.Name = &name
+	}
+
+	description := datum.Config.String("description")
+	if description != "" {
+		input.Description = &description
+	}
+
+	url := datum.Config.String("url")
+	if url != "" {
+		input.DestinationURL = &url
+	}
+
+	enabled := datum.Config.Bool("enabled")
+	if !enabled {
+		input.Enabled = &enabled
+	}
+
+	eventTypes := datum.Config.Strings("event-types")
+	if len(eventTypes) > 0 {
+		input.EventTypes = eventTypes
+	}
+
+	objectTypes := datum.Config.Strings("object-types")
+	if len(objectTypes) > 0 {
+		input.ObjectTypes = objectTypes
+	}
+
+	if datum.Config.Bool("clear-event-types") {
+		clearEventTypes := true
+		input.ClearEventTypes = &clearEventTypes
+	}
+
+	if datum.Config.Bool("clear-object-types") {
+		clearObjectTypes := true
+		input.ClearObjectTypes = &clearObjectTypes
+	}
+
+	return id, input, nil
+}
+
+// update an existing webhook in the datum platform
+func update(ctx context.Context) error {
+	// setup datum http client
+	client, err := datum.SetupClientWithAuth(ctx)
+	cobra.CheckErr(err)
+	defer datum.StoreSessionCookies(client)
+
+	id, input, err := updateValidation()
+	cobra.CheckErr(err)
+
+	o, err := client.UpdateWebhook(ctx, id, input)
+	cobra.CheckErr(err)
+
+	return consoleOutput(o)
+}
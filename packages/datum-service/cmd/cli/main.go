@@ -20,6 +20,7 @@ import (
 	_ "github.com/datumforge/datum/cmd/cli/cmd/integration"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/invite"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/login"
+	_ "github.com/datumforge/datum/cmd/cli/cmd/logout"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/organization"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/organizationsetting"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/orgmembers"
@@ -27,6 +28,7 @@ import (
 	_ "github.com/datumforge/datum/cmd/cli/cmd/register"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/reset"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/search"
+	_ "github.com/datumforge/datum/cmd/cli/cmd/session"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/subscriber"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/switch"
 	_ "github.com/datumforge/datum/cmd/cli/cmd/template"
@@ -11,12 +11,18 @@ import (
 
 	ent "github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/entdb"
+	"github.com/datumforge/datum/internal/graphapi"
 	"github.com/datumforge/datum/internal/httpserve/authmanager"
 	"github.com/datumforge/datum/internal/httpserve/config"
 	"github.com/datumforge/datum/internal/httpserve/server"
 	"github.com/datumforge/datum/internal/httpserve/serveropts"
+	"github.com/datumforge/datum/internal/outbox"
 	"github.com/datumforge/datum/pkg/cache"
+	"github.com/datumforge/datum/pkg/events"
+	"github.com/datumforge/datum/pkg/events/kafka/publisher"
+	"github.com/datumforge/datum/pkg/events/pubsub"
 	"github.com/datumforge/datum/pkg/otelx"
+	"github.com/datumforge/datum/pkg/webhooks"
 )
 
 var serveCmd = &cobra.Command{
@@ -58,6 +64,8 @@ func serve(ctx context.Context) error {
 		serveropts.WithCORS(),
 		serveropts.WithAnalytics(),
 		serveropts.WithEventPublisher(),
+		serveropts.WithObjectStorage(),
+		serveropts.WithDomainVerifier(),
 	)
 
 	so := serveropts.NewServerOptions(serverOpts, k.String("config"))
@@ -104,6 +112,11 @@ func serve(ctx context.Context) error {
 		entOpts = append(entOpts, ent.Geodetic(gc.(*geodetic.Client)))
 	}
 
+	// Setup webhook delivery
+	if so.Config.Settings.Webhooks.Enabled {
+		entOpts = append(entOpts, ent.WebhookDeliverer(webhooks.New(so.Config.Settings.Webhooks)))
+	}
+
 	// add otp manager, after redis is setup
 	so.AddServerOptions(
 		serveropts.WithOTP(),
@@ -119,6 +132,7 @@ func serve(ctx context.Context) error {
 		ent.TOTP(so.Config.Handler.OTPManager),
 		ent.TokenManager(so.Config.Handler.TokenManager),
 		ent.SessionConfig(so.Config.Handler.SessionConfig),
+		ent.ObjectStorage(so.Config.Handler.ObjectStorage),
 		ent.EntConfig(&so.Config.Settings.EntConfig),
 	)
 
@@ -133,6 +147,23 @@ func serve(ctx context.Context) error {
 	// Add Driver to the Handlers Config
 	so.Config.Handler.DBClient = entdbClient
 
+	// Setup the outbox relay to publish the events written by mutations once they are committed
+	if so.Config.Settings.EntConfig.Outbox.Enabled {
+		// the publishers are named so a failed event is only retried on the publishers that did not accept it
+		publishers := events.MultiPublisher{{Name: "graphapi", EventPublisher: graphapi.NewEventPublisher(entdbClient)}}
+
+		if so.Config.Settings.Events.Enabled {
+			publishers = append(publishers, events.NamedPublisher{Name: "kafka", EventPublisher: publisher.NewKafkaEventPublisher(so.Config.Settings.Events)})
+		}
+
+		relay := outbox.NewRelay(entdbClient, publishers, so.Config.Settings.EntConfig.Outbox, logger.Named("outbox"))
+		if err := relay.Start(ctx); err != nil {
+			return err
+		}
+
+		defer relay.Close(context.WithoutCancel(ctx))
+	}
+
 	// Add redis client to Handlers Config
 	so.Config.Handler.RedisClient = redisClient
 
@@ -151,10 +182,32 @@ func serve(ctx context.Context) error {
 		serveropts.WithSessionMiddleware(),
 	)
 
+	// Setup the broker streaming the committed changes to the graph subscriptions, fanned out to all replicas
+	// over redis when enabled
+	var broker *pubsub.Broker
+
+	if so.Config.Settings.Subscriptions.Enabled {
+		brokerOpts := []pubsub.Option{
+			pubsub.WithConfig(so.Config.Settings.Subscriptions),
+			pubsub.WithLogger(logger.Named("pubsub")),
+		}
+
+		if so.Config.Settings.Redis.Enabled {
+			brokerOpts = append(brokerOpts, pubsub.WithRedis(redisClient))
+		}
+
+		broker = pubsub.NewBroker(brokerOpts...)
+		if err := broker.Start(ctx); err != nil {
+			return err
+		}
+
+		defer broker.Close()
+	}
+
 	srv := server.NewServer(so.Config, so.Config.Logger)
 
 	// Setup Graph API Handlers
-	so.AddServerOptions(serveropts.WithGraphRoute(srv, entdbClient))
+	so.AddServerOptions(serveropts.WithGraphRoute(srv, entdbClient, broker))
 
 	if err := srv.StartEchoServer(ctx); err != nil {
 		logger.Error("failed to run server", zap.Error(err))
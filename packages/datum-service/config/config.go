@@ -15,21 +15,25 @@ import (
 	"github.com/mcuadros/go-defaults"
 
 	"github.com/datumforge/datum/internal/ent/entconfig"
+	"github.com/datumforge/datum/internal/graphapi"
 	"github.com/datumforge/datum/internal/httpserve/handlers"
 	"github.com/datumforge/datum/pkg/analytics/posthog"
 	"github.com/datumforge/datum/pkg/cache"
 	"github.com/datumforge/datum/pkg/events/kafka/kafkaconfig"
+	"github.com/datumforge/datum/pkg/events/pubsub"
 	"github.com/datumforge/datum/pkg/middleware/cachecontrol"
 	"github.com/datumforge/datum/pkg/middleware/cors"
 	"github.com/datumforge/datum/pkg/middleware/mime"
 	"github.com/datumforge/datum/pkg/middleware/ratelimit"
 	"github.com/datumforge/datum/pkg/middleware/redirect"
 	"github.com/datumforge/datum/pkg/middleware/secure"
+	"github.com/datumforge/datum/pkg/objects"
 	"github.com/datumforge/datum/pkg/otelx"
 	"github.com/datumforge/datum/pkg/sessions"
 	"github.com/datumforge/datum/pkg/tokens"
 	"github.com/datumforge/datum/pkg/utils/emails"
 	"github.com/datumforge/datum/pkg/utils/totp"
+	"github.com/datumforge/datum/pkg/webhooks"
 )
 
 var (
@@ -68,6 +72,12 @@ type Config struct {
 	Ratelimit ratelimit.Config `json:"ratelimit" koanf:"ratelimit"`
 	// EventPublisher contains the configuration for the event publisher
 	Events kafkaconfig.Config `json:"publisherConfig" koanf:"publisherConfig"`
+	// Webhooks contains the configuration for outbound webhook delivery
+	Webhooks webhooks.Config `json:"webhooks" koanf:"webhooks"`
+	// ObjectStorage contains the configuration for the file storage backend
+	ObjectStorage objects.Config `json:"objectStorage" koanf:"objectStorage"`
+	// Subscriptions contains the configuration for streaming changes to the graph subscriptions
+	Subscriptions pubsub.Config `json:"subscriptions" koanf:"subscriptions"`
 }
 
 // Server settings for the echo server
@@ -104,6 +114,8 @@ type Server struct {
 	GraphPool PondPool `json:"graphPool" koanf:"graphPool"`
 	// EnableGraphExtensions enables the graph extensions for the graph resolvers
 	EnableGraphExtensions bool `json:"enableGraphExtensions" koanf:"enableGraphExtensions" default:"true"`
+	// GraphQueryLimits contains the complexity and depth limits of graph operations
+	GraphQueryLimits graphapi.QueryLimits `json:"graphQueryLimits" koanf:"graphQueryLimits"`
 }
 
 // Auth settings including oauth2 providers and datum token configuration
@@ -116,6 +128,8 @@ type Auth struct {
 	SupportedProviders []string `json:"supportedProviders" koanf:"supportedProviders"`
 	// Providers contains supported oauth2 providers configuration
 	Providers handlers.OauthProviderConfig `json:"providers" koanf:"providers"`
+	// AccountLockout contains the settings to lock user accounts after too many failed login attempts
+	AccountLockout handlers.AccountLockoutConfig `json:"accountLockout" koanf:"accountLockout"`
 }
 
 // TLS settings for the server for secure connections
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "webhook_history" table
+ALTER TABLE "webhook_history" ADD COLUMN "event_types" jsonb NULL, ADD COLUMN "object_types" jsonb NULL;
+-- modify "webhooks" table
+ALTER TABLE "webhooks" ADD COLUMN "event_types" jsonb NULL, ADD COLUMN "object_types" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "webhooks" table
+ALTER TABLE "webhooks" DROP COLUMN "object_types", DROP COLUMN "event_types";
+-- reverse: modify "webhook_history" table
+ALTER TABLE "webhook_history" DROP COLUMN "object_types", DROP COLUMN "event_types";
@@ -0,0 +1,15 @@
+-- +goose Up
+-- create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" ("id" character varying NOT NULL, "created_at" timestamptz NULL, "updated_at" timestamptz NULL, "created_by" character varying NULL, "updated_by" character varying NULL, "mapping_id" character varying NOT NULL, "attempt" bigint NOT NULL, "redelivery" boolean NOT NULL DEFAULT false, "request_body" text NULL, "response_status" bigint NULL, "response_body" text NULL, "latency" bigint NULL, "error" character varying NULL, "event_id" character varying NOT NULL, "owner_id" character varying NULL, "webhook_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "webhook_deliveries_events_webhook_deliveries" FOREIGN KEY ("event_id") REFERENCES "events" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "webhook_deliveries_organizations_webhook_deliveries" FOREIGN KEY ("owner_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "webhook_deliveries_webhooks_deliveries" FOREIGN KEY ("webhook_id") REFERENCES "webhooks" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "webhook_deliveries_mapping_id_key" to table: "webhook_deliveries"
+CREATE UNIQUE INDEX "webhook_deliveries_mapping_id_key" ON "webhook_deliveries" ("mapping_id");
+-- create index "webhookdelivery_webhook_id_event_id" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_webhook_id_event_id" ON "webhook_deliveries" ("webhook_id", "event_id");
+
+-- +goose Down
+-- reverse: create index "webhookdelivery_webhook_id_event_id" to table: "webhook_deliveries"
+DROP INDEX "webhookdelivery_webhook_id_event_id";
+-- reverse: create index "webhook_deliveries_mapping_id_key" to table: "webhook_deliveries"
+DROP INDEX "webhook_deliveries_mapping_id_key";
+-- reverse: create "webhook_deliveries" table
+DROP TABLE "webhook_deliveries";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "user_setting_history" table
+ALTER TABLE "user_setting_history" ADD COLUMN "locked_until" timestamptz NULL, ADD COLUMN "failed_login_attempts" bigint NOT NULL DEFAULT 0;
+-- modify "user_settings" table
+ALTER TABLE "user_settings" ADD COLUMN "locked_until" timestamptz NULL, ADD COLUMN "failed_login_attempts" bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+-- reverse: modify "user_settings" table
+ALTER TABLE "user_settings" DROP COLUMN "failed_login_attempts", DROP COLUMN "locked_until";
+-- reverse: modify "user_setting_history" table
+ALTER TABLE "user_setting_history" DROP COLUMN "failed_login_attempts", DROP COLUMN "locked_until";
@@ -0,0 +1,19 @@
+-- +goose Up
+-- modify "oauth_provider_history" table
+ALTER TABLE "oauth_provider_history" ADD COLUMN "issuer" character varying NULL;
+-- modify "oauth_providers" table
+ALTER TABLE "oauth_providers" ADD COLUMN "issuer" character varying NULL;
+-- modify "organization_setting_history" table
+ALTER TABLE "organization_setting_history" ADD COLUMN "sso_enforced" boolean NOT NULL DEFAULT false;
+-- modify "organization_settings" table
+ALTER TABLE "organization_settings" ADD COLUMN "sso_enforced" boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+-- reverse: modify "organization_settings" table
+ALTER TABLE "organization_settings" DROP COLUMN "sso_enforced";
+-- reverse: modify "organization_setting_history" table
+ALTER TABLE "organization_setting_history" DROP COLUMN "sso_enforced";
+-- reverse: modify "oauth_providers" table
+ALTER TABLE "oauth_providers" DROP COLUMN "issuer";
+-- reverse: modify "oauth_provider_history" table
+ALTER TABLE "oauth_provider_history" DROP COLUMN "issuer";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "organization_setting_history" table
+ALTER TABLE "organization_setting_history" ADD COLUMN "saml_metadata_url" character varying NULL, ADD COLUMN "saml_metadata" text NULL, ADD COLUMN "saml_attribute_mapping" jsonb NULL;
+-- modify "organization_settings" table
+ALTER TABLE "organization_settings" ADD COLUMN "saml_metadata_url" character varying NULL, ADD COLUMN "saml_metadata" text NULL, ADD COLUMN "saml_attribute_mapping" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "organization_settings" table
+ALTER TABLE "organization_settings" DROP COLUMN "saml_attribute_mapping", DROP COLUMN "saml_metadata", DROP COLUMN "saml_metadata_url";
+-- reverse: modify "organization_setting_history" table
+ALTER TABLE "organization_setting_history" DROP COLUMN "saml_attribute_mapping", DROP COLUMN "saml_metadata", DROP COLUMN "saml_metadata_url";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "organization_setting_history" table
+ALTER TABLE "organization_setting_history" ADD COLUMN "domain_verification_token" character varying NULL, ADD COLUMN "verified_domains" jsonb NULL, ADD COLUMN "join_policy" character varying NOT NULL DEFAULT 'INVITE_ONLY';
+-- modify "organization_settings" table
+ALTER TABLE "organization_settings" ADD COLUMN "domain_verification_token" character varying NULL, ADD COLUMN "verified_domains" jsonb NULL, ADD COLUMN "join_policy" character varying NOT NULL DEFAULT 'INVITE_ONLY';
+
+-- +goose Down
+-- reverse: modify "organization_settings" table
+ALTER TABLE "organization_settings" DROP COLUMN "join_policy", DROP COLUMN "verified_domains", DROP COLUMN "domain_verification_token";
+-- reverse: modify "organization_setting_history" table
+ALTER TABLE "organization_setting_history" DROP COLUMN "join_policy", DROP COLUMN "verified_domains", DROP COLUMN "domain_verification_token";
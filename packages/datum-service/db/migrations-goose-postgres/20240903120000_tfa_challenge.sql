@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "tfa_settings" table
+ALTER TABLE "tfa_settings" ADD COLUMN "challenge_id" character varying NULL, ADD COLUMN "challenge_attempts" bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+-- reverse: modify "tfa_settings" table
+ALTER TABLE "tfa_settings" DROP COLUMN "challenge_attempts", DROP COLUMN "challenge_id";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "outbox_events" table
+ALTER TABLE "outbox_events" ADD COLUMN "claim_id" character varying NULL, ADD COLUMN "claimed_until" timestamptz NULL;
+-- create index "outboxevent_claim_id" to table: "outbox_events"
+CREATE INDEX "outboxevent_claim_id" ON "outbox_events" ("claim_id");
+
+-- +goose Down
+-- reverse: create index "outboxevent_claim_id" to table: "outbox_events"
+DROP INDEX "outboxevent_claim_id";
+-- reverse: modify "outbox_events" table
+ALTER TABLE "outbox_events" DROP COLUMN "claimed_until", DROP COLUMN "claim_id";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "org_membership_history" table
+ALTER TABLE "org_membership_history" ADD COLUMN "user_provisioned" boolean NOT NULL DEFAULT false;
+-- modify "org_memberships" table
+ALTER TABLE "org_memberships" ADD COLUMN "user_provisioned" boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+-- reverse: modify "org_memberships" table
+ALTER TABLE "org_memberships" DROP COLUMN "user_provisioned";
+-- reverse: modify "org_membership_history" table
+ALTER TABLE "org_membership_history" DROP COLUMN "user_provisioned";
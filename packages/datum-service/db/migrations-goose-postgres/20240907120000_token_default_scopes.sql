@@ -0,0 +1,8 @@
+-- +goose Up
+-- backfill "scopes" of "api_tokens" created before scopes were enforced
+UPDATE "api_tokens" SET "scopes" = '["*:read", "*:write", "*:delete"]' WHERE "scopes" IS NULL OR "scopes" IN ('null'::jsonb, '[]'::jsonb);
+-- backfill "scopes" of "personal_access_tokens" created before scopes were enforced
+UPDATE "personal_access_tokens" SET "scopes" = '["*:read", "*:write", "*:delete"]' WHERE "scopes" IS NULL OR "scopes" IN ('null'::jsonb, '[]'::jsonb);
+
+-- +goose Down
+-- the backfilled scopes can not be told apart from scopes that were granted, so they are kept
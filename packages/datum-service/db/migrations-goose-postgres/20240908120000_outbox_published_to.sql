@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "outbox_events" table
+ALTER TABLE "outbox_events" ADD COLUMN "published_to" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "outbox_events" table
+ALTER TABLE "outbox_events" DROP COLUMN "published_to";
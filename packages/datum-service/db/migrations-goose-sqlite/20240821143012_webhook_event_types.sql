@@ -0,0 +1,19 @@
+-- +goose Up
+-- add column "event_types" to table: "webhook_history"
+ALTER TABLE `webhook_history` ADD COLUMN `event_types` json NULL;
+-- add column "object_types" to table: "webhook_history"
+ALTER TABLE `webhook_history` ADD COLUMN `object_types` json NULL;
+-- add column "event_types" to table: "webhooks"
+ALTER TABLE `webhooks` ADD COLUMN `event_types` json NULL;
+-- add column "object_types" to table: "webhooks"
+ALTER TABLE `webhooks` ADD COLUMN `object_types` json NULL;
+
+-- +goose Down
+-- reverse: add column "object_types" to table: "webhooks"
+ALTER TABLE `webhooks` DROP COLUMN `object_types`;
+-- reverse: add column "event_types" to table: "webhooks"
+ALTER TABLE `webhooks` DROP COLUMN `event_types`;
+-- reverse: add column "object_types" to table: "webhook_history"
+ALTER TABLE `webhook_history` DROP COLUMN `object_types`;
+-- reverse: add column "event_types" to table: "webhook_history"
+ALTER TABLE `webhook_history` DROP COLUMN `event_types`;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- create "outbox_events" table
+CREATE TABLE `outbox_events` (`id` text NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `created_by` text NULL, `updated_by` text NULL, `mapping_id` text NOT NULL, `topic` text NOT NULL, `aggregate_type` text NOT NULL, `aggregate_id` text NOT NULL, `organization_id` text NULL, `payload` json NULL, `attempts` integer NOT NULL DEFAULT (0), `available_at` datetime NOT NULL, `published_at` datetime NULL, `last_error` text NULL, PRIMARY KEY (`id`));
+-- create index "outbox_events_mapping_id_key" to table: "outbox_events"
+CREATE UNIQUE INDEX `outbox_events_mapping_id_key` ON `outbox_events` (`mapping_id`);
+-- create index "outboxevent_aggregate_id" to table: "outbox_events"
+CREATE INDEX `outboxevent_aggregate_id` ON `outbox_events` (`aggregate_id`);
+-- create index "outboxevent_published_at_available_at" to table: "outbox_events"
+CREATE INDEX `outboxevent_published_at_available_at` ON `outbox_events` (`published_at`, `available_at`);
+
+-- +goose Down
+-- reverse: create index "outboxevent_published_at_available_at" to table: "outbox_events"
+DROP INDEX `outboxevent_published_at_available_at`;
+-- reverse: create index "outboxevent_aggregate_id" to table: "outbox_events"
+DROP INDEX `outboxevent_aggregate_id`;
+-- reverse: create index "outbox_events_mapping_id_key" to table: "outbox_events"
+DROP INDEX `outbox_events_mapping_id_key`;
+-- reverse: create "outbox_events" table
+DROP TABLE `outbox_events`;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- add column "locked_until" to table: "user_setting_history"
+ALTER TABLE `user_setting_history` ADD COLUMN `locked_until` datetime NULL;
+-- add column "failed_login_attempts" to table: "user_setting_history"
+ALTER TABLE `user_setting_history` ADD COLUMN `failed_login_attempts` integer NOT NULL DEFAULT (0);
+-- add column "locked_until" to table: "user_settings"
+ALTER TABLE `user_settings` ADD COLUMN `locked_until` datetime NULL;
+-- add column "failed_login_attempts" to table: "user_settings"
+ALTER TABLE `user_settings` ADD COLUMN `failed_login_attempts` integer NOT NULL DEFAULT (0);
+
+-- +goose Down
+-- reverse: add column "failed_login_attempts" to table: "user_settings"
+ALTER TABLE `user_settings` DROP COLUMN `failed_login_attempts`;
+-- reverse: add column "locked_until" to table: "user_settings"
+ALTER TABLE `user_settings` DROP COLUMN `locked_until`;
+-- reverse: add column "failed_login_attempts" to table: "user_setting_history"
+ALTER TABLE `user_setting_history` DROP COLUMN `failed_login_attempts`;
+-- reverse: add column "locked_until" to table: "user_setting_history"
+ALTER TABLE `user_setting_history` DROP COLUMN `locked_until`;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- add column "issuer" to table: "oauth_provider_history"
+ALTER TABLE `oauth_provider_history` ADD COLUMN `issuer` text NULL;
+-- add column "issuer" to table: "oauth_providers"
+ALTER TABLE `oauth_providers` ADD COLUMN `issuer` text NULL;
+-- add column "sso_enforced" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` ADD COLUMN `sso_enforced` bool NOT NULL DEFAULT (false);
+-- add column "sso_enforced" to table: "organization_settings"
+ALTER TABLE `organization_settings` ADD COLUMN `sso_enforced` bool NOT NULL DEFAULT (false);
+
+-- +goose Down
+-- reverse: add column "sso_enforced" to table: "organization_settings"
+ALTER TABLE `organization_settings` DROP COLUMN `sso_enforced`;
+-- reverse: add column "sso_enforced" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` DROP COLUMN `sso_enforced`;
+-- reverse: add column "issuer" to table: "oauth_providers"
+ALTER TABLE `oauth_providers` DROP COLUMN `issuer`;
+-- reverse: add column "issuer" to table: "oauth_provider_history"
+ALTER TABLE `oauth_provider_history` DROP COLUMN `issuer`;
@@ -0,0 +1,27 @@
+-- +goose Up
+-- add column "saml_metadata_url" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` ADD COLUMN `saml_metadata_url` text NULL;
+-- add column "saml_metadata" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` ADD COLUMN `saml_metadata` text NULL;
+-- add column "saml_attribute_mapping" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` ADD COLUMN `saml_attribute_mapping` json NULL;
+-- add column "saml_metadata_url" to table: "organization_settings"
+ALTER TABLE `organization_settings` ADD COLUMN `saml_metadata_url` text NULL;
+-- add column "saml_metadata" to table: "organization_settings"
+ALTER TABLE `organization_settings` ADD COLUMN `saml_metadata` text NULL;
+-- add column "saml_attribute_mapping" to table: "organization_settings"
+ALTER TABLE `organization_settings` ADD COLUMN `saml_attribute_mapping` json NULL;
+
+-- +goose Down
+-- reverse: add column "saml_attribute_mapping" to table: "organization_settings"
+ALTER TABLE `organization_settings` DROP COLUMN `saml_attribute_mapping`;
+-- reverse: add column "saml_metadata" to table: "organization_settings"
+ALTER TABLE `organization_settings` DROP COLUMN `saml_metadata`;
+-- reverse: add column "saml_metadata_url" to table: "organization_settings"
+ALTER TABLE `organization_settings` DROP COLUMN `saml_metadata_url`;
+-- reverse: add column "saml_attribute_mapping" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` DROP COLUMN `saml_attribute_mapping`;
+-- reverse: add column "saml_metadata" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` DROP COLUMN `saml_metadata`;
+-- reverse: add column "saml_metadata_url" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` DROP COLUMN `saml_metadata_url`;
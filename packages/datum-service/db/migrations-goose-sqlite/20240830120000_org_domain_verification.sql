@@ -0,0 +1,27 @@
+-- +goose Up
+-- add column "domain_verification_token" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` ADD COLUMN `domain_verification_token` text NULL;
+-- add column "verified_domains" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` ADD COLUMN `verified_domains` json NULL;
+-- add column "join_policy" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` ADD COLUMN `join_policy` text NOT NULL DEFAULT ('INVITE_ONLY');
+-- add column "domain_verification_token" to table: "organization_settings"
+ALTER TABLE `organization_settings` ADD COLUMN `domain_verification_token` text NULL;
+-- add column "verified_domains" to table: "organization_settings"
+ALTER TABLE `organization_settings` ADD COLUMN `verified_domains` json NULL;
+-- add column "join_policy" to table: "organization_settings"
+ALTER TABLE `organization_settings` ADD COLUMN `join_policy` text NOT NULL DEFAULT ('INVITE_ONLY');
+
+-- +goose Down
+-- reverse: add column "join_policy" to table: "organization_settings"
+ALTER TABLE `organization_settings` DROP COLUMN `join_policy`;
+-- reverse: add column "verified_domains" to table: "organization_settings"
+ALTER TABLE `organization_settings` DROP COLUMN `verified_domains`;
+-- reverse: add column "domain_verification_token" to table: "organization_settings"
+ALTER TABLE `organization_settings` DROP COLUMN `domain_verification_token`;
+-- reverse: add column "join_policy" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` DROP COLUMN `join_policy`;
+-- reverse: add column "verified_domains" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` DROP COLUMN `verified_domains`;
+-- reverse: add column "domain_verification_token" to table: "organization_setting_history"
+ALTER TABLE `organization_setting_history` DROP COLUMN `domain_verification_token`;
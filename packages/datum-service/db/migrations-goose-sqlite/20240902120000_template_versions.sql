@@ -0,0 +1,60 @@
+-- +goose Up
+-- add column "template_version" to table: "document_data"
+ALTER TABLE `document_data` ADD COLUMN `template_version` integer NULL;
+-- add column "template_version" to table: "document_data_history"
+ALTER TABLE `document_data_history` ADD COLUMN `template_version` integer NULL;
+-- add column "version" to table: "template_history"
+ALTER TABLE `template_history` ADD COLUMN `version` integer NOT NULL DEFAULT (1);
+-- add column "source_template_id" to table: "template_history"
+ALTER TABLE `template_history` ADD COLUMN `source_template_id` text NULL;
+-- add column "source_template_version" to table: "template_history"
+ALTER TABLE `template_history` ADD COLUMN `source_template_version` integer NULL;
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_templates" table
+CREATE TABLE `new_templates` (`id` text NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `created_by` text NULL, `updated_by` text NULL, `deleted_at` datetime NULL, `deleted_by` text NULL, `mapping_id` text NOT NULL, `tags` json NULL, `name` text NOT NULL, `template_type` text NOT NULL DEFAULT ('DOCUMENT'), `description` text NULL, `jsonconfig` json NOT NULL, `uischema` json NULL, `version` integer NOT NULL DEFAULT (1), `source_template_version` integer NULL, `owner_id` text NULL, `source_template_id` text NULL, PRIMARY KEY (`id`), CONSTRAINT `templates_organizations_templates` FOREIGN KEY (`owner_id`) REFERENCES `organizations` (`id`) ON DELETE SET NULL, CONSTRAINT `templates_templates_clones` FOREIGN KEY (`source_template_id`) REFERENCES `templates` (`id`) ON DELETE SET NULL);
+-- copy rows from old table "templates" to new temporary table "new_templates"
+INSERT INTO `new_templates` (`id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `deleted_at`, `deleted_by`, `mapping_id`, `tags`, `name`, `template_type`, `description`, `jsonconfig`, `uischema`, `owner_id`) SELECT `id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `deleted_at`, `deleted_by`, `mapping_id`, `tags`, `name`, `template_type`, `description`, `jsonconfig`, `uischema`, `owner_id` FROM `templates`;
+-- drop "templates" table after copying rows
+DROP TABLE `templates`;
+-- rename temporary table "new_templates" to "templates"
+ALTER TABLE `new_templates` RENAME TO `templates`;
+-- create index "templates_mapping_id_key" to table: "templates"
+CREATE UNIQUE INDEX `templates_mapping_id_key` ON `templates` (`mapping_id`);
+-- create index "template_name_owner_id_template_type" to table: "templates"
+CREATE UNIQUE INDEX `template_name_owner_id_template_type` ON `templates` (`name`, `owner_id`, `template_type`) WHERE deleted_at is NULL;
+-- create "template_versions" table
+CREATE TABLE `template_versions` (`id` text NOT NULL, `created_at` datetime NULL, `updated_at` datetime NULL, `created_by` text NULL, `updated_by` text NULL, `deleted_at` datetime NULL, `deleted_by` text NULL, `mapping_id` text NOT NULL, `version` integer NOT NULL, `jsonconfig` json NOT NULL, `uischema` json NULL, `owner_id` text NULL, `template_id` text NOT NULL, PRIMARY KEY (`id`), CONSTRAINT `template_versions_organizations_template_versions` FOREIGN KEY (`owner_id`) REFERENCES `organizations` (`id`) ON DELETE SET NULL, CONSTRAINT `template_versions_templates_versions` FOREIGN KEY (`template_id`) REFERENCES `templates` (`id`) ON DELETE NO ACTION);
+-- create index "template_versions_mapping_id_key" to table: "template_versions"
+CREATE UNIQUE INDEX `template_versions_mapping_id_key` ON `template_versions` (`mapping_id`);
+-- create index "templateversion_template_id_version" to table: "template_versions"
+CREATE UNIQUE INDEX `templateversion_template_id_version` ON `template_versions` (`template_id`, `version`) WHERE deleted_at is NULL;
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
+-- record the existing templates as their first version, the documents are pinned to it
+INSERT INTO `template_versions` (`id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `deleted_at`, `deleted_by`, `mapping_id`, `version`, `jsonconfig`, `uischema`, `owner_id`, `template_id`) SELECT `id`, `created_at`, `updated_at`, `created_by`, `updated_by`, `deleted_at`, `deleted_by`, `mapping_id`, 1, `jsonconfig`, `uischema`, `owner_id`, `id` FROM `templates`;
+UPDATE `document_data` SET `template_version` = 1;
+
+-- +goose Down
+-- reverse: create index "templateversion_template_id_version" to table: "template_versions"
+DROP INDEX `templateversion_template_id_version`;
+-- reverse: create index "template_versions_mapping_id_key" to table: "template_versions"
+DROP INDEX `template_versions_mapping_id_key`;
+-- reverse: create "template_versions" table
+DROP TABLE `template_versions`;
+-- reverse: create index "template_name_owner_id_template_type" to table: "templates"
+DROP INDEX `template_name_owner_id_template_type`;
+-- reverse: create index "templates_mapping_id_key" to table: "templates"
+DROP INDEX `templates_mapping_id_key`;
+-- reverse: create "new_templates" table
+DROP TABLE `new_templates`;
+-- reverse: add column "source_template_version" to table: "template_history"
+ALTER TABLE `template_history` DROP COLUMN `source_template_version`;
+-- reverse: add column "source_template_id" to table: "template_history"
+ALTER TABLE `template_history` DROP COLUMN `source_template_id`;
+-- reverse: add column "version" to table: "template_history"
+ALTER TABLE `template_history` DROP COLUMN `version`;
+-- reverse: add column "template_version" to table: "document_data_history"
+ALTER TABLE `document_data_history` DROP COLUMN `template_version`;
+-- reverse: add column "template_version" to table: "document_data"
+ALTER TABLE `document_data` DROP COLUMN `template_version`;
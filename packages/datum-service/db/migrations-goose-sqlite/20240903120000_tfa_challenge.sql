@@ -0,0 +1,11 @@
+-- +goose Up
+-- add column "challenge_id" to table: "tfa_settings"
+ALTER TABLE `tfa_settings` ADD COLUMN `challenge_id` text NULL;
+-- add column "challenge_attempts" to table: "tfa_settings"
+ALTER TABLE `tfa_settings` ADD COLUMN `challenge_attempts` integer NOT NULL DEFAULT (0);
+
+-- +goose Down
+-- reverse: add column "challenge_attempts" to table: "tfa_settings"
+ALTER TABLE `tfa_settings` DROP COLUMN `challenge_attempts`;
+-- reverse: add column "challenge_id" to table: "tfa_settings"
+ALTER TABLE `tfa_settings` DROP COLUMN `challenge_id`;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- add column "claim_id" to table: "outbox_events"
+ALTER TABLE `outbox_events` ADD COLUMN `claim_id` text NULL;
+-- add column "claimed_until" to table: "outbox_events"
+ALTER TABLE `outbox_events` ADD COLUMN `claimed_until` datetime NULL;
+-- create index "outboxevent_claim_id" to table: "outbox_events"
+CREATE INDEX `outboxevent_claim_id` ON `outbox_events` (`claim_id`);
+
+-- +goose Down
+-- reverse: create index "outboxevent_claim_id" to table: "outbox_events"
+DROP INDEX `outboxevent_claim_id`;
+-- reverse: add column "claimed_until" to table: "outbox_events"
+ALTER TABLE `outbox_events` DROP COLUMN `claimed_until`;
+-- reverse: add column "claim_id" to table: "outbox_events"
+ALTER TABLE `outbox_events` DROP COLUMN `claim_id`;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- add column "sso_issuer" to table: "org_membership_history"
+ALTER TABLE `org_membership_history` ADD COLUMN `sso_issuer` text NULL;
+-- add column "sso_subject" to table: "org_membership_history"
+ALTER TABLE `org_membership_history` ADD COLUMN `sso_subject` text NULL;
+-- add column "sso_issuer" to table: "org_memberships"
+ALTER TABLE `org_memberships` ADD COLUMN `sso_issuer` text NULL;
+-- add column "sso_subject" to table: "org_memberships"
+ALTER TABLE `org_memberships` ADD COLUMN `sso_subject` text NULL;
+-- create index "orgmembership_organization_id_sso_issuer_sso_subject" to table: "org_memberships"
+CREATE UNIQUE INDEX `orgmembership_organization_id_sso_issuer_sso_subject` ON `org_memberships` (`organization_id`, `sso_issuer`, `sso_subject`) WHERE deleted_at is NULL;
+
+-- +goose Down
+-- reverse: create index "orgmembership_organization_id_sso_issuer_sso_subject" to table: "org_memberships"
+DROP INDEX `orgmembership_organization_id_sso_issuer_sso_subject`;
+-- reverse: add column "sso_subject" to table: "org_memberships"
+ALTER TABLE `org_memberships` DROP COLUMN `sso_subject`;
+-- reverse: add column "sso_issuer" to table: "org_memberships"
+ALTER TABLE `org_memberships` DROP COLUMN `sso_issuer`;
+-- reverse: add column "sso_subject" to table: "org_membership_history"
+ALTER TABLE `org_membership_history` DROP COLUMN `sso_subject`;
+-- reverse: add column "sso_issuer" to table: "org_membership_history"
+ALTER TABLE `org_membership_history` DROP COLUMN `sso_issuer`;
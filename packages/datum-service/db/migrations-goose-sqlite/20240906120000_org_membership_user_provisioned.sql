@@ -0,0 +1,11 @@
+-- +goose Up
+-- add column "user_provisioned" to table: "org_membership_history"
+ALTER TABLE `org_membership_history` ADD COLUMN `user_provisioned` bool NOT NULL DEFAULT (false);
+-- add column "user_provisioned" to table: "org_memberships"
+ALTER TABLE `org_memberships` ADD COLUMN `user_provisioned` bool NOT NULL DEFAULT (false);
+
+-- +goose Down
+-- reverse: add column "user_provisioned" to table: "org_memberships"
+ALTER TABLE `org_memberships` DROP COLUMN `user_provisioned`;
+-- reverse: add column "user_provisioned" to table: "org_membership_history"
+ALTER TABLE `org_membership_history` DROP COLUMN `user_provisioned`;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- add column "published_to" to table: "outbox_events"
+ALTER TABLE `outbox_events` ADD COLUMN `published_to` json NULL;
+
+-- +goose Down
+-- reverse: add column "published_to" to table: "outbox_events"
+ALTER TABLE `outbox_events` DROP COLUMN `published_to`;
@@ -0,0 +1,4 @@
+-- Modify "webhook_history" table
+ALTER TABLE "webhook_history" ADD COLUMN "event_types" jsonb NULL, ADD COLUMN "object_types" jsonb NULL;
+-- Modify "webhooks" table
+ALTER TABLE "webhooks" ADD COLUMN "event_types" jsonb NULL, ADD COLUMN "object_types" jsonb NULL;
@@ -0,0 +1,8 @@
+-- Create "outbox_events" table
+CREATE TABLE "outbox_events" ("id" character varying NOT NULL, "created_at" timestamptz NULL, "updated_at" timestamptz NULL, "created_by" character varying NULL, "updated_by" character varying NULL, "mapping_id" character varying NOT NULL, "topic" character varying NOT NULL, "aggregate_type" character varying NOT NULL, "aggregate_id" character varying NOT NULL, "organization_id" character varying NULL, "payload" jsonb NULL, "attempts" bigint NOT NULL DEFAULT 0, "available_at" timestamptz NOT NULL, "published_at" timestamptz NULL, "last_error" character varying NULL, PRIMARY KEY ("id"));
+-- Create index "outbox_events_mapping_id_key" to table: "outbox_events"
+CREATE UNIQUE INDEX "outbox_events_mapping_id_key" ON "outbox_events" ("mapping_id");
+-- Create index "outboxevent_aggregate_id" to table: "outbox_events"
+CREATE INDEX "outboxevent_aggregate_id" ON "outbox_events" ("aggregate_id");
+-- Create index "outboxevent_published_at_available_at" to table: "outbox_events"
+CREATE INDEX "outboxevent_published_at_available_at" ON "outbox_events" ("published_at", "available_at");
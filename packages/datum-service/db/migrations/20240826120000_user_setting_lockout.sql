@@ -0,0 +1,4 @@
+-- Modify "user_setting_history" table
+ALTER TABLE "user_setting_history" ADD COLUMN "locked_until" timestamptz NULL, ADD COLUMN "failed_login_attempts" bigint NOT NULL DEFAULT 0;
+-- Modify "user_settings" table
+ALTER TABLE "user_settings" ADD COLUMN "locked_until" timestamptz NULL, ADD COLUMN "failed_login_attempts" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,8 @@
+-- Modify "oauth_provider_history" table
+ALTER TABLE "oauth_provider_history" ADD COLUMN "issuer" character varying NULL;
+-- Modify "oauth_providers" table
+ALTER TABLE "oauth_providers" ADD COLUMN "issuer" character varying NULL;
+-- Modify "organization_setting_history" table
+ALTER TABLE "organization_setting_history" ADD COLUMN "sso_enforced" boolean NOT NULL DEFAULT false;
+-- Modify "organization_settings" table
+ALTER TABLE "organization_settings" ADD COLUMN "sso_enforced" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,4 @@
+-- Modify "organization_setting_history" table
+ALTER TABLE "organization_setting_history" ADD COLUMN "saml_metadata_url" character varying NULL, ADD COLUMN "saml_metadata" text NULL, ADD COLUMN "saml_attribute_mapping" jsonb NULL;
+-- Modify "organization_settings" table
+ALTER TABLE "organization_settings" ADD COLUMN "saml_metadata_url" character varying NULL, ADD COLUMN "saml_metadata" text NULL, ADD COLUMN "saml_attribute_mapping" jsonb NULL;
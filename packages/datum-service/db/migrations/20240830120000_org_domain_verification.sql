@@ -0,0 +1,4 @@
+-- Modify "organization_setting_history" table
+ALTER TABLE "organization_setting_history" ADD COLUMN "domain_verification_token" character varying NULL, ADD COLUMN "verified_domains" jsonb NULL, ADD COLUMN "join_policy" character varying NOT NULL DEFAULT 'INVITE_ONLY';
+-- Modify "organization_settings" table
+ALTER TABLE "organization_settings" ADD COLUMN "domain_verification_token" character varying NULL, ADD COLUMN "verified_domains" jsonb NULL, ADD COLUMN "join_policy" character varying NOT NULL DEFAULT 'INVITE_ONLY';
@@ -0,0 +1,17 @@
+-- Modify "document_data" table
+ALTER TABLE "document_data" ADD COLUMN "template_version" bigint NULL;
+-- Modify "document_data_history" table
+ALTER TABLE "document_data_history" ADD COLUMN "template_version" bigint NULL;
+-- Modify "template_history" table
+ALTER TABLE "template_history" ADD COLUMN "version" bigint NOT NULL DEFAULT 1, ADD COLUMN "source_template_id" character varying NULL, ADD COLUMN "source_template_version" bigint NULL;
+-- Modify "templates" table
+ALTER TABLE "templates" ADD COLUMN "version" bigint NOT NULL DEFAULT 1, ADD COLUMN "source_template_version" bigint NULL, ADD COLUMN "source_template_id" character varying NULL, ADD CONSTRAINT "templates_templates_clones" FOREIGN KEY ("source_template_id") REFERENCES "templates" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create "template_versions" table
+CREATE TABLE "template_versions" ("id" character varying NOT NULL, "created_at" timestamptz NULL, "updated_at" timestamptz NULL, "created_by" character varying NULL, "updated_by" character varying NULL, "deleted_at" timestamptz NULL, "deleted_by" character varying NULL, "mapping_id" character varying NOT NULL, "version" bigint NOT NULL, "jsonconfig" jsonb NOT NULL, "uischema" jsonb NULL, "owner_id" character varying NULL, "template_id" character varying NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "template_versions_organizations_template_versions" FOREIGN KEY ("owner_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE SET NULL, CONSTRAINT "template_versions_templates_versions" FOREIGN KEY ("template_id") REFERENCES "templates" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "template_versions_mapping_id_key" to table: "template_versions"
+CREATE UNIQUE INDEX "template_versions_mapping_id_key" ON "template_versions" ("mapping_id");
+-- Create index "templateversion_template_id_version" to table: "template_versions"
+CREATE UNIQUE INDEX "templateversion_template_id_version" ON "template_versions" ("template_id", "version") WHERE (deleted_at IS NULL);
+-- Record the existing templates as their first version, the documents are pinned to it
+INSERT INTO "template_versions" ("id", "created_at", "updated_at", "created_by", "updated_by", "deleted_at", "deleted_by", "mapping_id", "version", "jsonconfig", "uischema", "owner_id", "template_id") SELECT "id", "created_at", "updated_at", "created_by", "updated_by", "deleted_at", "deleted_by", "mapping_id", 1, "jsonconfig", "uischema", "owner_id", "id" FROM "templates";
+UPDATE "document_data" SET "template_version" = 1;
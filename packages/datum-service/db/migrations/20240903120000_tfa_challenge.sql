@@ -0,0 +1,2 @@
+-- Modify "tfa_settings" table
+ALTER TABLE "tfa_settings" ADD COLUMN "challenge_id" character varying NULL, ADD COLUMN "challenge_attempts" bigint NOT NULL DEFAULT 0;
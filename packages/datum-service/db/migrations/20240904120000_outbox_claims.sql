@@ -0,0 +1,4 @@
+-- Modify "outbox_events" table
+ALTER TABLE "outbox_events" ADD COLUMN "claim_id" character varying NULL, ADD COLUMN "claimed_until" timestamptz NULL;
+-- Create index "outboxevent_claim_id" to table: "outbox_events"
+CREATE INDEX "outboxevent_claim_id" ON "outbox_events" ("claim_id");
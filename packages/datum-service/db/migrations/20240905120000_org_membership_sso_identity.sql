@@ -0,0 +1,6 @@
+-- Modify "org_membership_history" table
+ALTER TABLE "org_membership_history" ADD COLUMN "sso_issuer" character varying NULL, ADD COLUMN "sso_subject" character varying NULL;
+-- Modify "org_memberships" table
+ALTER TABLE "org_memberships" ADD COLUMN "sso_issuer" character varying NULL, ADD COLUMN "sso_subject" character varying NULL;
+-- Create index "orgmembership_organization_id_sso_issuer_sso_subject" to table: "org_memberships"
+CREATE UNIQUE INDEX "orgmembership_organization_id_sso_issuer_sso_subject" ON "org_memberships" ("organization_id", "sso_issuer", "sso_subject") WHERE (deleted_at IS NULL);
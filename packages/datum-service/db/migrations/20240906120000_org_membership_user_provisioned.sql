@@ -0,0 +1,4 @@
+-- Modify "org_membership_history" table
+ALTER TABLE "org_membership_history" ADD COLUMN "user_provisioned" boolean NOT NULL DEFAULT false;
+-- Modify "org_memberships" table
+ALTER TABLE "org_memberships" ADD COLUMN "user_provisioned" boolean NOT NULL DEFAULT false;
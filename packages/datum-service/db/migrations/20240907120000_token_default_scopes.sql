@@ -0,0 +1,4 @@
+-- Backfill "scopes" of "api_tokens" created before scopes were enforced
+UPDATE "api_tokens" SET "scopes" = '["*:read", "*:write", "*:delete"]' WHERE "scopes" IS NULL OR "scopes" IN ('null'::jsonb, '[]'::jsonb);
+-- Backfill "scopes" of "personal_access_tokens" created before scopes were enforced
+UPDATE "personal_access_tokens" SET "scopes" = '["*:read", "*:write", "*:delete"]' WHERE "scopes" IS NULL OR "scopes" IN ('null'::jsonb, '[]'::jsonb);
@@ -0,0 +1,2 @@
+-- Modify "outbox_events" table
+ALTER TABLE "outbox_events" ADD COLUMN "published_to" jsonb NULL;
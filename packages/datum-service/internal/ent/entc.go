@@ -23,11 +23,13 @@ import (
 
 	"github.com/datumforge/datum/internal/ent/entconfig"
 	"github.com/datumforge/datum/pkg/analytics"
+	"github.com/datumforge/datum/pkg/objects"
 	"github.com/datumforge/datum/pkg/sessions"
 	"github.com/datumforge/datum/pkg/tokens"
 	"github.com/datumforge/datum/pkg/utils/emails"
 	"github.com/datumforge/datum/pkg/utils/marionette"
 	"github.com/datumforge/datum/pkg/utils/totp"
+	"github.com/datumforge/datum/pkg/webhooks"
 )
 
 const (
@@ -121,6 +123,14 @@ func main() {
 			entc.DependencyName("Geodetic"),
 			entc.DependencyType(&geodetic.Client{}),
 		),
+		entc.Dependency(
+			entc.DependencyName("WebhookDeliverer"),
+			entc.DependencyType(&webhooks.Deliverer{}),
+		),
+		entc.Dependency(
+			entc.DependencyName("ObjectStorage"),
+			entc.DependencyType(&objects.Objects{}),
+		),
 		entc.TemplateDir("./internal/ent/templates"),
 		entc.Extensions(
 			gqlExt,
@@ -1,11 +1,32 @@
 package entconfig
 
+import "time"
+
 // Config holds the configuration for the ent server
 type Config struct {
 	// Flags contains the flags for the server to allow use to test different code paths
 	Flags Flags `json:"flags" koanf:"flags" jsonschema:"description=flags for the server"`
 	// EntityTypes is the list of entity types to create by default for the organization
 	EntityTypes []string `json:"entityTypes" koanf:"entityTypes" default:"" description:"entity types to create for the organization"`
+	// Outbox contains the configuration for the transactional event outbox
+	Outbox Outbox `json:"outbox" koanf:"outbox" jsonschema:"description=transactional event outbox settings"`
+}
+
+// Outbox contains the configuration for the transactional event outbox, when enabled events produced by mutations are
+// written in the same transaction as the mutation and published by the relay once committed
+type Outbox struct {
+	// Enabled writes events to the outbox instead of publishing them directly from the mutation
+	Enabled bool `json:"enabled" koanf:"enabled" jsonschema:"description=write events to the outbox instead of publishing them directly" default:"true"`
+	// Interval is how often the relay polls the outbox for pending events
+	Interval time.Duration `json:"interval" koanf:"interval" jsonschema:"description=how often the relay polls the outbox for pending events" default:"1s"`
+	// BatchSize is the maximum number of events the relay publishes per poll
+	BatchSize int `json:"batchSize" koanf:"batchSize" jsonschema:"description=maximum number of events published per poll" default:"100"`
+	// MaxBackoff is the maximum time the relay waits before retrying an event that failed to publish
+	MaxBackoff time.Duration `json:"maxBackoff" koanf:"maxBackoff" jsonschema:"description=maximum time to wait before retrying a failed event" default:"5m"`
+	// ClaimTimeout is how long the events claimed by a relay are held before another relay can claim them again
+	ClaimTimeout time.Duration `json:"claimTimeout" koanf:"claimTimeout" jsonschema:"description=how long claimed events are held before another relay can claim them" default:"1m"`
+	// Retention is how long published events are kept in the outbox before they are deleted
+	Retention time.Duration `json:"retention" koanf:"retention" jsonschema:"description=how long published events are kept before they are deleted" default:"168h"`
 }
 
 // Flags contains the flags for the server to allow use to test different code paths
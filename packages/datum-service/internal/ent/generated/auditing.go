@@ -168,6 +168,9 @@ func (ddh *DocumentDataHistory) changes(new *DocumentDataHistory) []Change {
 	if !reflect.DeepEqual(ddh.TemplateID, new.TemplateID) {
 		changes = append(changes, NewChange(documentdatahistory.FieldTemplateID, ddh.TemplateID, new.TemplateID))
 	}
+	if !reflect.DeepEqual(ddh.TemplateVersion, new.TemplateVersion) {
+		changes = append(changes, NewChange(documentdatahistory.FieldTemplateVersion, ddh.TemplateVersion, new.TemplateVersion))
+	}
 	if !reflect.DeepEqual(ddh.Data, new.Data) {
 		changes = append(changes, NewChange(documentdatahistory.FieldData, ddh.Data, new.Data))
 	}
@@ -1161,6 +1164,9 @@ func (oph *OauthProviderHistory) changes(new *OauthProviderHistory) []Change {
 	if !reflect.DeepEqual(oph.InfoURL, new.InfoURL) {
 		changes = append(changes, NewChange(oauthproviderhistory.FieldInfoURL, oph.InfoURL, new.InfoURL))
 	}
+	if !reflect.DeepEqual(oph.Issuer, new.Issuer) {
+		changes = append(changes, NewChange(oauthproviderhistory.FieldIssuer, oph.Issuer, new.Issuer))
+	}
 	return changes
 }
 
@@ -1218,6 +1224,15 @@ func (omh *OrgMembershipHistory) changes(new *OrgMembershipHistory) []Change {
 	if !reflect.DeepEqual(omh.UserID, new.UserID) {
 		changes = append(changes, NewChange(orgmembershiphistory.FieldUserID, omh.UserID, new.UserID))
 	}
+	if !reflect.DeepEqual(omh.SSOIssuer, new.SSOIssuer) {
+		changes = append(changes, NewChange(orgmembershiphistory.FieldSSOIssuer, omh.SSOIssuer, new.SSOIssuer))
+	}
+	if !reflect.DeepEqual(omh.SSOSubject, new.SSOSubject) {
+		changes = append(changes, NewChange(orgmembershiphistory.FieldSSOSubject, omh.SSOSubject, new.SSOSubject))
+	}
+	if !reflect.DeepEqual(omh.UserProvisioned, new.UserProvisioned) {
+		changes = append(changes, NewChange(orgmembershiphistory.FieldUserProvisioned, omh.UserProvisioned, new.UserProvisioned))
+	}
 	return changes
 }
 
@@ -1365,6 +1380,27 @@ func (osh *OrganizationSettingHistory) changes(new *OrganizationSettingHistory)
 	if !reflect.DeepEqual(osh.OrganizationID, new.OrganizationID) {
 		changes = append(changes, NewChange(organizationsettinghistory.FieldOrganizationID, osh.OrganizationID, new.OrganizationID))
 	}
+	if !reflect.DeepEqual(osh.SSOEnforced, new.SSOEnforced) {
+		changes = append(changes, NewChange(organizationsettinghistory.FieldSSOEnforced, osh.SSOEnforced, new.SSOEnforced))
+	}
+	if !reflect.DeepEqual(osh.SamlMetadataURL, new.SamlMetadataURL) {
+		changes = append(changes, NewChange(organizationsettinghistory.FieldSamlMetadataURL, osh.SamlMetadataURL, new.SamlMetadataURL))
+	}
+	if !reflect.DeepEqual(osh.SamlMetadata, new.SamlMetadata) {
+		changes = append(changes, NewChange(organizationsettinghistory.FieldSamlMetadata, osh.SamlMetadata, new.SamlMetadata))
+	}
+	if !reflect.DeepEqual(osh.SamlAttributeMapping, new.SamlAttributeMapping) {
+		changes = append(changes, NewChange(organizationsettinghistory.FieldSamlAttributeMapping, osh.SamlAttributeMapping, new.SamlAttributeMapping))
+	}
+	if !reflect.DeepEqual(osh.DomainVerificationToken, new.DomainVerificationToken) {
+		changes = append(changes, NewChange(organizationsettinghistory.FieldDomainVerificationToken, osh.DomainVerificationToken, new.DomainVerificationToken))
+	}
+	if !reflect.DeepEqual(osh.VerifiedDomains, new.VerifiedDomains) {
+		changes = append(changes, NewChange(organizationsettinghistory.FieldVerifiedDomains, osh.VerifiedDomains, new.VerifiedDomains))
+	}
+	if !reflect.DeepEqual(osh.JoinPolicy, new.JoinPolicy) {
+		changes = append(changes, NewChange(organizationsettinghistory.FieldJoinPolicy, osh.JoinPolicy, new.JoinPolicy))
+	}
 	return changes
 }
 
@@ -1434,6 +1470,15 @@ func (th *TemplateHistory) changes(new *TemplateHistory) []Change {
 	if !reflect.DeepEqual(th.Uischema, new.Uischema) {
 		changes = append(changes, NewChange(templatehistory.FieldUischema, th.Uischema, new.Uischema))
 	}
+	if !reflect.DeepEqual(th.Version, new.Version) {
+		changes = append(changes, NewChange(templatehistory.FieldVersion, th.Version, new.Version))
+	}
+	if !reflect.DeepEqual(th.SourceTemplateID, new.SourceTemplateID) {
+		changes = append(changes, NewChange(templatehistory.FieldSourceTemplateID, th.SourceTemplateID, new.SourceTemplateID))
+	}
+	if !reflect.DeepEqual(th.SourceTemplateVersion, new.SourceTemplateVersion) {
+		changes = append(changes, NewChange(templatehistory.FieldSourceTemplateVersion, th.SourceTemplateVersion, new.SourceTemplateVersion))
+	}
 	return changes
 }
 
@@ -1578,6 +1623,12 @@ func (ush *UserSettingHistory) changes(new *UserSettingHistory) []Change {
 	if !reflect.DeepEqual(ush.Locked, new.Locked) {
 		changes = append(changes, NewChange(usersettinghistory.FieldLocked, ush.Locked, new.Locked))
 	}
+	if !reflect.DeepEqual(ush.LockedUntil, new.LockedUntil) {
+		changes = append(changes, NewChange(usersettinghistory.FieldLockedUntil, ush.LockedUntil, new.LockedUntil))
+	}
+	if !reflect.DeepEqual(ush.FailedLoginAttempts, new.FailedLoginAttempts) {
+		changes = append(changes, NewChange(usersettinghistory.FieldFailedLoginAttempts, ush.FailedLoginAttempts, new.FailedLoginAttempts))
+	}
 	if !reflect.DeepEqual(ush.SilencedAt, new.SilencedAt) {
 		changes = append(changes, NewChange(usersettinghistory.FieldSilencedAt, ush.SilencedAt, new.SilencedAt))
 	}
@@ -1665,6 +1716,12 @@ func (wh *WebhookHistory) changes(new *WebhookHistory) []Change {
 	if !reflect.DeepEqual(wh.Enabled, new.Enabled) {
 		changes = append(changes, NewChange(webhookhistory.FieldEnabled, wh.Enabled, new.Enabled))
 	}
+	if !reflect.DeepEqual(wh.EventTypes, new.EventTypes) {
+		changes = append(changes, NewChange(webhookhistory.FieldEventTypes, wh.EventTypes, new.EventTypes))
+	}
+	if !reflect.DeepEqual(wh.ObjectTypes, new.ObjectTypes) {
+		changes = append(changes, NewChange(webhookhistory.FieldObjectTypes, wh.ObjectTypes, new.ObjectTypes))
+	}
 	if !reflect.DeepEqual(wh.Callback, new.Callback) {
 		changes = append(changes, NewChange(webhookhistory.FieldCallback, wh.Callback, new.Callback))
 	}
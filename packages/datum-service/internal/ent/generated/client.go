@@ -61,11 +61,13 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/organizationsettinghistory"
 	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
 	"github.com/datumforge/datum/internal/ent/generated/orgmembershiphistory"
+	"github.com/datumforge/datum/internal/ent/generated/outboxevent"
 	"github.com/datumforge/datum/internal/ent/generated/passwordresettoken"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/subscriber"
 	"github.com/datumforge/datum/internal/ent/generated/template"
 	"github.com/datumforge/datum/internal/ent/generated/templatehistory"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
 	"github.com/datumforge/datum/internal/ent/generated/tfasetting"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/userhistory"
@@ -73,13 +75,16 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/usersettinghistory"
 	"github.com/datumforge/datum/internal/ent/generated/webauthn"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 	"github.com/datumforge/datum/internal/ent/generated/webhookhistory"
 	"github.com/datumforge/datum/pkg/analytics"
+	"github.com/datumforge/datum/pkg/objects"
 	"github.com/datumforge/datum/pkg/sessions"
 	"github.com/datumforge/datum/pkg/tokens"
 	"github.com/datumforge/datum/pkg/utils/emails"
 	"github.com/datumforge/datum/pkg/utils/marionette"
 	"github.com/datumforge/datum/pkg/utils/totp"
+	"github.com/datumforge/datum/pkg/webhooks"
 	"github.com/datumforge/fgax"
 	"github.com/datumforge/geodetic/pkg/geodeticclient"
 	"go.uber.org/zap"
@@ -181,6 +186,8 @@ type Client struct {
 	OrganizationSetting *OrganizationSettingClient
 	// OrganizationSettingHistory is the client for interacting with the OrganizationSettingHistory builders.
 	OrganizationSettingHistory *OrganizationSettingHistoryClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
 	PasswordResetToken *PasswordResetTokenClient
 	// PersonalAccessToken is the client for interacting with the PersonalAccessToken builders.
@@ -193,6 +200,8 @@ type Client struct {
 	Template *TemplateClient
 	// TemplateHistory is the client for interacting with the TemplateHistory builders.
 	TemplateHistory *TemplateHistoryClient
+	// TemplateVersion is the client for interacting with the TemplateVersion builders.
+	TemplateVersion *TemplateVersionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserHistory is the client for interacting with the UserHistory builders.
@@ -205,6 +214,8 @@ type Client struct {
 	Webauthn *WebauthnClient
 	// Webhook is the client for interacting with the Webhook builders.
 	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 	// WebhookHistory is the client for interacting with the WebhookHistory builders.
 	WebhookHistory *WebhookHistoryClient
 
@@ -267,18 +278,21 @@ func (c *Client) init() {
 	c.OrganizationHistory = NewOrganizationHistoryClient(c.config)
 	c.OrganizationSetting = NewOrganizationSettingClient(c.config)
 	c.OrganizationSettingHistory = NewOrganizationSettingHistoryClient(c.config)
+	c.OutboxEvent = NewOutboxEventClient(c.config)
 	c.PasswordResetToken = NewPasswordResetTokenClient(c.config)
 	c.PersonalAccessToken = NewPersonalAccessTokenClient(c.config)
 	c.Subscriber = NewSubscriberClient(c.config)
 	c.TFASetting = NewTFASettingClient(c.config)
 	c.Template = NewTemplateClient(c.config)
 	c.TemplateHistory = NewTemplateHistoryClient(c.config)
+	c.TemplateVersion = NewTemplateVersionClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserHistory = NewUserHistoryClient(c.config)
 	c.UserSetting = NewUserSettingClient(c.config)
 	c.UserSettingHistory = NewUserSettingHistoryClient(c.config)
 	c.Webauthn = NewWebauthnClient(c.config)
 	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 	c.WebhookHistory = NewWebhookHistoryClient(c.config)
 }
 
@@ -310,18 +324,20 @@ type (
 		// hooks to execute on mutations.
 		hooks *hooks
 		// interceptors to execute on queries.
-		inters        *inters
-		EntConfig     *entconfig.Config
-		Secrets       *secrets.Keeper
-		Authz         fgax.Client
-		TokenManager  *tokens.TokenManager
-		SessionConfig *sessions.SessionConfig
-		Logger        zap.SugaredLogger
-		Emails        *emails.EmailManager
-		Marionette    *marionette.TaskManager
-		Analytics     *analytics.EventManager
-		TOTP          *totp.Manager
-		Geodetic      *geodeticclient.Client
+		inters           *inters
+		EntConfig        *entconfig.Config
+		Secrets          *secrets.Keeper
+		Authz            fgax.Client
+		TokenManager     *tokens.TokenManager
+		SessionConfig    *sessions.SessionConfig
+		Logger           zap.SugaredLogger
+		Emails           *emails.EmailManager
+		Marionette       *marionette.TaskManager
+		Analytics        *analytics.EventManager
+		TOTP             *totp.Manager
+		Geodetic         *geodeticclient.Client
+		WebhookDeliverer *webhooks.Deliverer
+		ObjectStorage    *objects.Objects
 		// schemaConfig contains alternative names for all tables.
 		schemaConfig SchemaConfig
 	}
@@ -444,6 +460,20 @@ func Geodetic(v *geodeticclient.Client) Option {
 	}
 }
 
+// WebhookDeliverer configures the WebhookDeliverer.
+func WebhookDeliverer(v *webhooks.Deliverer) Option {
+	return func(c *config) {
+		c.WebhookDeliverer = v
+	}
+}
+
+// ObjectStorage configures the ObjectStorage.
+func ObjectStorage(v *objects.Objects) Option {
+	return func(c *config) {
+		c.ObjectStorage = v
+	}
+}
+
 // Open opens a database/sql.DB specified by the driver name and
 // the data source name, and returns a new client attached to it.
 // Optional parameters can be added for configuring the client.
@@ -522,18 +552,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		OrganizationHistory:           NewOrganizationHistoryClient(cfg),
 		OrganizationSetting:           NewOrganizationSettingClient(cfg),
 		OrganizationSettingHistory:    NewOrganizationSettingHistoryClient(cfg),
+		OutboxEvent:                   NewOutboxEventClient(cfg),
 		PasswordResetToken:            NewPasswordResetTokenClient(cfg),
 		PersonalAccessToken:           NewPersonalAccessTokenClient(cfg),
 		Subscriber:                    NewSubscriberClient(cfg),
 		TFASetting:                    NewTFASettingClient(cfg),
 		Template:                      NewTemplateClient(cfg),
 		TemplateHistory:               NewTemplateHistoryClient(cfg),
+		TemplateVersion:               NewTemplateVersionClient(cfg),
 		User:                          NewUserClient(cfg),
 		UserHistory:                   NewUserHistoryClient(cfg),
 		UserSetting:                   NewUserSettingClient(cfg),
 		UserSettingHistory:            NewUserSettingHistoryClient(cfg),
 		Webauthn:                      NewWebauthnClient(cfg),
 		Webhook:                       NewWebhookClient(cfg),
+		WebhookDelivery:               NewWebhookDeliveryClient(cfg),
 		WebhookHistory:                NewWebhookHistoryClient(cfg),
 	}, nil
 }
@@ -598,18 +631,21 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		OrganizationHistory:           NewOrganizationHistoryClient(cfg),
 		OrganizationSetting:           NewOrganizationSettingClient(cfg),
 		OrganizationSettingHistory:    NewOrganizationSettingHistoryClient(cfg),
+		OutboxEvent:                   NewOutboxEventClient(cfg),
 		PasswordResetToken:            NewPasswordResetTokenClient(cfg),
 		PersonalAccessToken:           NewPersonalAccessTokenClient(cfg),
 		Subscriber:                    NewSubscriberClient(cfg),
 		TFASetting:                    NewTFASettingClient(cfg),
 		Template:                      NewTemplateClient(cfg),
 		TemplateHistory:               NewTemplateHistoryClient(cfg),
+		TemplateVersion:               NewTemplateVersionClient(cfg),
 		User:                          NewUserClient(cfg),
 		UserHistory:                   NewUserHistoryClient(cfg),
 		UserSetting:                   NewUserSettingClient(cfg),
 		UserSettingHistory:            NewUserSettingHistoryClient(cfg),
 		Webauthn:                      NewWebauthnClient(cfg),
 		Webhook:                       NewWebhookClient(cfg),
+		WebhookDelivery:               NewWebhookDeliveryClient(cfg),
 		WebhookHistory:                NewWebhookHistoryClient(cfg),
 	}, nil
 }
@@ -650,10 +686,11 @@ func (c *Client) Use(hooks ...Hook) {
 		c.HushHistory, c.Integration, c.IntegrationHistory, c.Invite, c.Note,
 		c.NoteHistory, c.OauthProvider, c.OauthProviderHistory, c.OhAuthTooToken,
 		c.OrgMembership, c.OrgMembershipHistory, c.Organization, c.OrganizationHistory,
-		c.OrganizationSetting, c.OrganizationSettingHistory, c.PasswordResetToken,
-		c.PersonalAccessToken, c.Subscriber, c.TFASetting, c.Template,
-		c.TemplateHistory, c.User, c.UserHistory, c.UserSetting, c.UserSettingHistory,
-		c.Webauthn, c.Webhook, c.WebhookHistory,
+		c.OrganizationSetting, c.OrganizationSettingHistory, c.OutboxEvent,
+		c.PasswordResetToken, c.PersonalAccessToken, c.Subscriber, c.TFASetting,
+		c.Template, c.TemplateHistory, c.TemplateVersion, c.User, c.UserHistory,
+		c.UserSetting, c.UserSettingHistory, c.Webauthn, c.Webhook, c.WebhookDelivery,
+		c.WebhookHistory,
 	} {
 		n.Use(hooks...)
 	}
@@ -673,10 +710,11 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 		c.HushHistory, c.Integration, c.IntegrationHistory, c.Invite, c.Note,
 		c.NoteHistory, c.OauthProvider, c.OauthProviderHistory, c.OhAuthTooToken,
 		c.OrgMembership, c.OrgMembershipHistory, c.Organization, c.OrganizationHistory,
-		c.OrganizationSetting, c.OrganizationSettingHistory, c.PasswordResetToken,
-		c.PersonalAccessToken, c.Subscriber, c.TFASetting, c.Template,
-		c.TemplateHistory, c.User, c.UserHistory, c.UserSetting, c.UserSettingHistory,
-		c.Webauthn, c.Webhook, c.WebhookHistory,
+		c.OrganizationSetting, c.OrganizationSettingHistory, c.OutboxEvent,
+		c.PasswordResetToken, c.PersonalAccessToken, c.Subscriber, c.TFASetting,
+		c.Template, c.TemplateHistory, c.TemplateVersion, c.User, c.UserHistory,
+		c.UserSetting, c.UserSettingHistory, c.Webauthn, c.Webhook, c.WebhookDelivery,
+		c.WebhookHistory,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -773,6 +811,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.OrganizationSetting.mutate(ctx, m)
 	case *OrganizationSettingHistoryMutation:
 		return c.OrganizationSettingHistory.mutate(ctx, m)
+	case *OutboxEventMutation:
+		return c.OutboxEvent.mutate(ctx, m)
 	case *PasswordResetTokenMutation:
 		return c.PasswordResetToken.mutate(ctx, m)
 	case *PersonalAccessTokenMutation:
@@ -785,6 +825,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Template.mutate(ctx, m)
 	case *TemplateHistoryMutation:
 		return c.TemplateHistory.mutate(ctx, m)
+	case *TemplateVersionMutation:
+		return c.TemplateVersion.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserHistoryMutation:
@@ -797,6 +839,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Webauthn.mutate(ctx, m)
 	case *WebhookMutation:
 		return c.Webhook.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
 	case *WebhookHistoryMutation:
 		return c.WebhookHistory.mutate(ctx, m)
 	default:
@@ -3908,6 +3952,25 @@ func (c *EventClient) QuerySubscriber(e *Event) *SubscriberQuery {
 	return query
 }
 
+// QueryWebhookDeliveries queries the webhook_deliveries edge of a Event.
+func (c *EventClient) QueryWebhookDeliveries(e *Event) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, event.WebhookDeliveriesTable, event.WebhookDeliveriesColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.WebhookDelivery
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *EventClient) Hooks() []Hook {
 	hooks := c.hooks.Event
@@ -7918,6 +7981,25 @@ func (c *OrganizationClient) QueryTemplates(o *Organization) *TemplateQuery {
 	return query
 }
 
+// QueryTemplateVersions queries the template_versions edge of a Organization.
+func (c *OrganizationClient) QueryTemplateVersions(o *Organization) *TemplateVersionQuery {
+	query := (&TemplateVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(templateversion.Table, templateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.TemplateVersionsTable, organization.TemplateVersionsColumn),
+		)
+		schemaConfig := o.schemaConfig
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.TemplateVersion
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryIntegrations queries the integrations edge of a Organization.
 func (c *OrganizationClient) QueryIntegrations(o *Organization) *IntegrationQuery {
 	query := (&IntegrationClient{config: c.config}).Query()
@@ -8146,6 +8228,25 @@ func (c *OrganizationClient) QueryWebhooks(o *Organization) *WebhookQuery {
 	return query
 }
 
+// QueryWebhookDeliveries queries the webhook_deliveries edge of a Organization.
+func (c *OrganizationClient) QueryWebhookDeliveries(o *Organization) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.WebhookDeliveriesTable, organization.WebhookDeliveriesColumn),
+		)
+		schemaConfig := o.schemaConfig
+		step.To.Schema = schemaConfig.WebhookDelivery
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryEvents queries the events edge of a Organization.
 func (c *OrganizationClient) QueryEvents(o *Organization) *EventQuery {
 	query := (&EventClient{config: c.config}).Query()
@@ -8806,6 +8907,140 @@ func (c *OrganizationSettingHistoryClient) mutate(ctx context.Context, m *Organi
 	}
 }
 
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
+	config
+}
+
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEventClient) UpdateOne(oe *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(oe))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEventClient) UpdateOneID(id string) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEventClient) DeleteOne(oe *OutboxEvent) *OutboxEventDeleteOne {
+	return c.DeleteOneID(oe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEventClient) DeleteOneID(id string) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id string) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEventClient) GetX(ctx context.Context, id string) *OutboxEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	hooks := c.hooks.OutboxEvent
+	return append(hooks[:len(hooks):len(hooks)], outboxevent.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown OutboxEvent mutation op: %q", m.Op())
+	}
+}
+
 // PasswordResetTokenClient is a client for the PasswordResetToken schema.
 type PasswordResetTokenClient struct {
 	config
@@ -9625,6 +9860,63 @@ func (c *TemplateClient) QueryDocuments(t *Template) *DocumentDataQuery {
 	return query
 }
 
+// QueryVersions queries the versions edge of a Template.
+func (c *TemplateClient) QueryVersions(t *Template) *TemplateVersionQuery {
+	query := (&TemplateVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(template.Table, template.FieldID, id),
+			sqlgraph.To(templateversion.Table, templateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, template.VersionsTable, template.VersionsColumn),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.TemplateVersion
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySourceTemplate queries the source_template edge of a Template.
+func (c *TemplateClient) QuerySourceTemplate(t *Template) *TemplateQuery {
+	query := (&TemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(template.Table, template.FieldID, id),
+			sqlgraph.To(template.Table, template.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, template.SourceTemplateTable, template.SourceTemplateColumn),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.Template
+		step.Edge.Schema = schemaConfig.Template
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryClones queries the clones edge of a Template.
+func (c *TemplateClient) QueryClones(t *Template) *TemplateQuery {
+	query := (&TemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(template.Table, template.FieldID, id),
+			sqlgraph.To(template.Table, template.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, template.ClonesTable, template.ClonesColumn),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.Template
+		step.Edge.Schema = schemaConfig.Template
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TemplateClient) Hooks() []Hook {
 	hooks := c.hooks.Template
@@ -9787,6 +10079,179 @@ func (c *TemplateHistoryClient) mutate(ctx context.Context, m *TemplateHistoryMu
 	}
 }
 
+// TemplateVersionClient is a client for the TemplateVersion schema.
+type TemplateVersionClient struct {
+	config
+}
+
+// NewTemplateVersionClient returns a client for the TemplateVersion from the given config.
+func NewTemplateVersionClient(c config) *TemplateVersionClient {
+	return &TemplateVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `templateversion.Hooks(f(g(h())))`.
+func (c *TemplateVersionClient) Use(hooks ...Hook) {
+	c.hooks.TemplateVersion = append(c.hooks.TemplateVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `templateversion.Intercept(f(g(h())))`.
+func (c *TemplateVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TemplateVersion = append(c.inters.TemplateVersion, interceptors...)
+}
+
+// Create returns a builder for creating a TemplateVersion entity.
+func (c *TemplateVersionClient) Create() *TemplateVersionCreate {
+	mutation := newTemplateVersionMutation(c.config, OpCreate)
+	return &TemplateVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TemplateVersion entities.
+func (c *TemplateVersionClient) CreateBulk(builders ...*TemplateVersionCreate) *TemplateVersionCreateBulk {
+	return &TemplateVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TemplateVersionClient) MapCreateBulk(slice any, setFunc func(*TemplateVersionCreate, int)) *TemplateVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TemplateVersionCreateBulk{err: fmt.Errorf("calling to TemplateVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TemplateVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TemplateVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TemplateVersion.
+func (c *TemplateVersionClient) Update() *TemplateVersionUpdate {
+	mutation := newTemplateVersionMutation(c.config, OpUpdate)
+	return &TemplateVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TemplateVersionClient) UpdateOne(tv *TemplateVersion) *TemplateVersionUpdateOne {
+	mutation := newTemplateVersionMutation(c.config, OpUpdateOne, withTemplateVersion(tv))
+	return &TemplateVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TemplateVersionClient) UpdateOneID(id string) *TemplateVersionUpdateOne {
+	mutation := newTemplateVersionMutation(c.config, OpUpdateOne, withTemplateVersionID(id))
+	return &TemplateVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TemplateVersion.
+func (c *TemplateVersionClient) Delete() *TemplateVersionDelete {
+	mutation := newTemplateVersionMutation(c.config, OpDelete)
+	return &TemplateVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TemplateVersionClient) DeleteOne(tv *TemplateVersion) *TemplateVersionDeleteOne {
+	return c.DeleteOneID(tv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TemplateVersionClient) DeleteOneID(id string) *TemplateVersionDeleteOne {
+	builder := c.Delete().Where(templateversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TemplateVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for TemplateVersion.
+func (c *TemplateVersionClient) Query() *TemplateVersionQuery {
+	return &TemplateVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTemplateVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TemplateVersion entity by its id.
+func (c *TemplateVersionClient) Get(ctx context.Context, id string) (*TemplateVersion, error) {
+	return c.Query().Where(templateversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TemplateVersionClient) GetX(ctx context.Context, id string) *TemplateVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a TemplateVersion.
+func (c *TemplateVersionClient) QueryOwner(tv *TemplateVersion) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(templateversion.Table, templateversion.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, templateversion.OwnerTable, templateversion.OwnerColumn),
+		)
+		schemaConfig := tv.schemaConfig
+		step.To.Schema = schemaConfig.Organization
+		step.Edge.Schema = schemaConfig.TemplateVersion
+		fromV = sqlgraph.Neighbors(tv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTemplate queries the template edge of a TemplateVersion.
+func (c *TemplateVersionClient) QueryTemplate(tv *TemplateVersion) *TemplateQuery {
+	query := (&TemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(templateversion.Table, templateversion.FieldID, id),
+			sqlgraph.To(template.Table, template.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, templateversion.TemplateTable, templateversion.TemplateColumn),
+		)
+		schemaConfig := tv.schemaConfig
+		step.To.Schema = schemaConfig.Template
+		step.Edge.Schema = schemaConfig.TemplateVersion
+		fromV = sqlgraph.Neighbors(tv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TemplateVersionClient) Hooks() []Hook {
+	hooks := c.hooks.TemplateVersion
+	return append(hooks[:len(hooks):len(hooks)], templateversion.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TemplateVersionClient) Interceptors() []Interceptor {
+	inters := c.inters.TemplateVersion
+	return append(inters[:len(inters):len(inters)], templateversion.Interceptors[:]...)
+}
+
+func (c *TemplateVersionClient) mutate(ctx context.Context, m *TemplateVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TemplateVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TemplateVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TemplateVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TemplateVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown TemplateVersion mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -10891,6 +11356,25 @@ func (c *WebhookClient) QueryEvents(w *Webhook) *EventQuery {
 	return query
 }
 
+// QueryDeliveries queries the deliveries edge of a Webhook.
+func (c *WebhookClient) QueryDeliveries(w *Webhook) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		schemaConfig := w.schemaConfig
+		step.To.Schema = schemaConfig.WebhookDelivery
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryIntegrations queries the integrations edge of a Webhook.
 func (c *WebhookClient) QueryIntegrations(w *Webhook) *IntegrationQuery {
 	query := (&IntegrationClient{config: c.config}).Query()
@@ -10937,6 +11421,198 @@ func (c *WebhookClient) mutate(ctx context.Context, m *WebhookMutation) (Value,
 	}
 }
 
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id string) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id string) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id string) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id string) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryOwner(wd *WebhookDelivery) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.OwnerTable, webhookdelivery.OwnerColumn),
+		)
+		schemaConfig := wd.schemaConfig
+		step.To.Schema = schemaConfig.Organization
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryWebhook(wd *WebhookDelivery) *WebhookQuery {
+	query := (&WebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		schemaConfig := wd.schemaConfig
+		step.To.Schema = schemaConfig.Webhook
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEvent queries the event edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryEvent(wd *WebhookDelivery) *EventQuery {
+	query := (&EventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(event.Table, event.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.EventTable, webhookdelivery.EventColumn),
+		)
+		schemaConfig := wd.schemaConfig
+		step.To.Schema = schemaConfig.Event
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookDelivery
+	return append(hooks[:len(hooks):len(hooks)], webhookdelivery.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	inters := c.inters.WebhookDelivery
+	return append(inters[:len(inters):len(inters)], webhookdelivery.Interceptors[:]...)
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
 // WebhookHistoryClient is a client for the WebhookHistory schema.
 type WebhookHistoryClient struct {
 	config
@@ -11084,9 +11760,10 @@ type (
 		Hush, HushHistory, Integration, IntegrationHistory, Invite, Note, NoteHistory,
 		OauthProvider, OauthProviderHistory, OhAuthTooToken, OrgMembership,
 		OrgMembershipHistory, Organization, OrganizationHistory, OrganizationSetting,
-		OrganizationSettingHistory, PasswordResetToken, PersonalAccessToken,
-		Subscriber, TFASetting, Template, TemplateHistory, User, UserHistory,
-		UserSetting, UserSettingHistory, Webauthn, Webhook, WebhookHistory []ent.Hook
+		OrganizationSettingHistory, OutboxEvent, PasswordResetToken,
+		PersonalAccessToken, Subscriber, TFASetting, Template, TemplateHistory,
+		TemplateVersion, User, UserHistory, UserSetting, UserSettingHistory, Webauthn,
+		Webhook, WebhookDelivery, WebhookHistory []ent.Hook
 	}
 	inters struct {
 		APIToken, Contact, ContactHistory, DocumentData, DocumentDataHistory,
@@ -11098,10 +11775,10 @@ type (
 		Hush, HushHistory, Integration, IntegrationHistory, Invite, Note, NoteHistory,
 		OauthProvider, OauthProviderHistory, OhAuthTooToken, OrgMembership,
 		OrgMembershipHistory, Organization, OrganizationHistory, OrganizationSetting,
-		OrganizationSettingHistory, PasswordResetToken, PersonalAccessToken,
-		Subscriber, TFASetting, Template, TemplateHistory, User, UserHistory,
-		UserSetting, UserSettingHistory, Webauthn, Webhook,
-		WebhookHistory []ent.Interceptor
+		OrganizationSettingHistory, OutboxEvent, PasswordResetToken,
+		PersonalAccessToken, Subscriber, TFASetting, Template, TemplateHistory,
+		TemplateVersion, User, UserHistory, UserSetting, UserSettingHistory, Webauthn,
+		Webhook, WebhookDelivery, WebhookHistory []ent.Interceptor
 	}
 )
 
@@ -41,6 +41,8 @@ type DocumentData struct {
 	OwnerID string `json:"owner_id,omitempty"`
 	// the template id of the document
 	TemplateID string `json:"template_id,omitempty"`
+	// the version of the template the document is validated against
+	TemplateVersion int `json:"template_version,omitempty"`
 	// the json data of the document
 	Data customtypes.JSONObject `json:"data,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -104,6 +106,8 @@ func (*DocumentData) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case documentdata.FieldTags, documentdata.FieldData:
 			values[i] = new([]byte)
+		case documentdata.FieldTemplateVersion:
+			values[i] = new(sql.NullInt64)
 		case documentdata.FieldID, documentdata.FieldCreatedBy, documentdata.FieldUpdatedBy, documentdata.FieldMappingID, documentdata.FieldDeletedBy, documentdata.FieldOwnerID, documentdata.FieldTemplateID:
 			values[i] = new(sql.NullString)
 		case documentdata.FieldCreatedAt, documentdata.FieldUpdatedAt, documentdata.FieldDeletedAt:
@@ -191,6 +195,12 @@ func (dd *DocumentData) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				dd.TemplateID = value.String
 			}
+		case documentdata.FieldTemplateVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field template_version", values[i])
+			} else if value.Valid {
+				dd.TemplateVersion = int(value.Int64)
+			}
 		case documentdata.FieldData:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field data", values[i])
@@ -280,6 +290,9 @@ func (dd *DocumentData) String() string {
 	builder.WriteString("template_id=")
 	builder.WriteString(dd.TemplateID)
 	builder.WriteString(", ")
+	builder.WriteString("template_version=")
+	builder.WriteString(fmt.Sprintf("%v", dd.TemplateVersion))
+	builder.WriteString(", ")
 	builder.WriteString("data=")
 	builder.WriteString(fmt.Sprintf("%v", dd.Data))
 	builder.WriteByte(')')
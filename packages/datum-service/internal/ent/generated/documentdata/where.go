@@ -112,6 +112,11 @@ func TemplateID(v string) predicate.DocumentData {
 	return predicate.DocumentData(sql.FieldEQ(FieldTemplateID, v))
 }
 
+// TemplateVersion applies equality check predicate on the "template_version" field. It's identical to TemplateVersionEQ.
+func TemplateVersion(v int) predicate.DocumentData {
+	return predicate.DocumentData(sql.FieldEQ(FieldTemplateVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.DocumentData {
 	return predicate.DocumentData(sql.FieldEQ(FieldCreatedAt, v))
@@ -702,6 +707,56 @@ func TemplateIDContainsFold(v string) predicate.DocumentData {
 	return predicate.DocumentData(sql.FieldContainsFold(FieldTemplateID, v))
 }
 
+// TemplateVersionEQ applies the EQ predicate on the "template_version" field.
+func TemplateVersionEQ(v int) predicate.DocumentData {
+	return predicate.DocumentData(sql.FieldEQ(FieldTemplateVersion, v))
+}
+
+// TemplateVersionNEQ applies the NEQ predicate on the "template_version" field.
+func TemplateVersionNEQ(v int) predicate.DocumentData {
+	return predicate.DocumentData(sql.FieldNEQ(FieldTemplateVersion, v))
+}
+
+// TemplateVersionIn applies the In predicate on the "template_version" field.
+func TemplateVersionIn(vs ...int) predicate.DocumentData {
+	return predicate.DocumentData(sql.FieldIn(FieldTemplateVersion, vs...))
+}
+
+// TemplateVersionNotIn applies the NotIn predicate on the "template_version" field.
+func TemplateVersionNotIn(vs ...int) predicate.DocumentData {
+	return predicate.DocumentData(sql.FieldNotIn(FieldTemplateVersion, vs...))
+}
+
+// TemplateVersionGT applies the GT predicate on the "template_version" field.
+func TemplateVersionGT(v int) predicate.DocumentData {
+	return predicate.DocumentData(sql.FieldGT(FieldTemplateVersion, v))
+}
+
+// TemplateVersionGTE applies the GTE predicate on the "template_version" field.
+func TemplateVersionGTE(v int) predicate.DocumentData {
+	return predicate.DocumentData(sql.FieldGTE(FieldTemplateVersion, v))
+}
+
+// TemplateVersionLT applies the LT predicate on the "template_version" field.
+func TemplateVersionLT(v int) predicate.DocumentData {
+	return predicate.DocumentData(sql.FieldLT(FieldTemplateVersion, v))
+}
+
+// TemplateVersionLTE applies the LTE predicate on the "template_version" field.
+func TemplateVersionLTE(v int) predicate.DocumentData {
+	return predicate.DocumentData(sql.FieldLTE(FieldTemplateVersion, v))
+}
+
+// TemplateVersionIsNil applies the IsNil predicate on the "template_version" field.
+func TemplateVersionIsNil() predicate.DocumentData {
+	return predicate.DocumentData(sql.FieldIsNull(FieldTemplateVersion))
+}
+
+// TemplateVersionNotNil applies the NotNil predicate on the "template_version" field.
+func TemplateVersionNotNil() predicate.DocumentData {
+	return predicate.DocumentData(sql.FieldNotNull(FieldTemplateVersion))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.DocumentData {
 	return predicate.DocumentData(func(s *sql.Selector) {
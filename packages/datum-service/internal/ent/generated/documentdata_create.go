@@ -148,6 +148,20 @@ func (ddc *DocumentDataCreate) SetTemplateID(s string) *DocumentDataCreate {
 	return ddc
 }
 
+// SetTemplateVersion sets the "template_version" field.
+func (ddc *DocumentDataCreate) SetTemplateVersion(i int) *DocumentDataCreate {
+	ddc.mutation.SetTemplateVersion(i)
+	return ddc
+}
+
+// SetNillableTemplateVersion sets the "template_version" field if the given value is not nil.
+func (ddc *DocumentDataCreate) SetNillableTemplateVersion(i *int) *DocumentDataCreate {
+	if i != nil {
+		ddc.SetTemplateVersion(*i)
+	}
+	return ddc
+}
+
 // SetData sets the "data" field.
 func (ddc *DocumentDataCreate) SetData(co customtypes.JSONObject) *DocumentDataCreate {
 	ddc.mutation.SetData(co)
@@ -352,6 +366,10 @@ func (ddc *DocumentDataCreate) createSpec() (*DocumentData, *sqlgraph.CreateSpec
 		_spec.SetField(documentdata.FieldDeletedBy, field.TypeString, value)
 		_node.DeletedBy = value
 	}
+	if value, ok := ddc.mutation.TemplateVersion(); ok {
+		_spec.SetField(documentdata.FieldTemplateVersion, field.TypeInt, value)
+		_node.TemplateVersion = value
+	}
 	if value, ok := ddc.mutation.Data(); ok {
 		_spec.SetField(documentdata.FieldData, field.TypeJSON, value)
 		_node.Data = value
@@ -159,6 +159,33 @@ func (ddu *DocumentDataUpdate) SetNillableTemplateID(s *string) *DocumentDataUpd
 	return ddu
 }
 
+// SetTemplateVersion sets the "template_version" field.
+func (ddu *DocumentDataUpdate) SetTemplateVersion(i int) *DocumentDataUpdate {
+	ddu.mutation.ResetTemplateVersion()
+	ddu.mutation.SetTemplateVersion(i)
+	return ddu
+}
+
+// SetNillableTemplateVersion sets the "template_version" field if the given value is not nil.
+func (ddu *DocumentDataUpdate) SetNillableTemplateVersion(i *int) *DocumentDataUpdate {
+	if i != nil {
+		ddu.SetTemplateVersion(*i)
+	}
+	return ddu
+}
+
+// AddTemplateVersion adds i to the "template_version" field.
+func (ddu *DocumentDataUpdate) AddTemplateVersion(i int) *DocumentDataUpdate {
+	ddu.mutation.AddTemplateVersion(i)
+	return ddu
+}
+
+// ClearTemplateVersion clears the value of the "template_version" field.
+func (ddu *DocumentDataUpdate) ClearTemplateVersion() *DocumentDataUpdate {
+	ddu.mutation.ClearTemplateVersion()
+	return ddu
+}
+
 // SetData sets the "data" field.
 func (ddu *DocumentDataUpdate) SetData(co customtypes.JSONObject) *DocumentDataUpdate {
 	ddu.mutation.SetData(co)
@@ -336,6 +363,15 @@ func (ddu *DocumentDataUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ddu.mutation.DeletedByCleared() {
 		_spec.ClearField(documentdata.FieldDeletedBy, field.TypeString)
 	}
+	if value, ok := ddu.mutation.TemplateVersion(); ok {
+		_spec.SetField(documentdata.FieldTemplateVersion, field.TypeInt, value)
+	}
+	if value, ok := ddu.mutation.AddedTemplateVersion(); ok {
+		_spec.AddField(documentdata.FieldTemplateVersion, field.TypeInt, value)
+	}
+	if ddu.mutation.TemplateVersionCleared() {
+		_spec.ClearField(documentdata.FieldTemplateVersion, field.TypeInt)
+	}
 	if value, ok := ddu.mutation.Data(); ok {
 		_spec.SetField(documentdata.FieldData, field.TypeJSON, value)
 	}
@@ -595,6 +631,33 @@ func (dduo *DocumentDataUpdateOne) SetNillableTemplateID(s *string) *DocumentDat
 	return dduo
 }
 
+// SetTemplateVersion sets the "template_version" field.
+func (dduo *DocumentDataUpdateOne) SetTemplateVersion(i int) *DocumentDataUpdateOne {
+	dduo.mutation.ResetTemplateVersion()
+	dduo.mutation.SetTemplateVersion(i)
+	return dduo
+}
+
+// SetNillableTemplateVersion sets the "template_version" field if the given value is not nil.
+func (dduo *DocumentDataUpdateOne) SetNillableTemplateVersion(i *int) *DocumentDataUpdateOne {
+	if i != nil {
+		dduo.SetTemplateVersion(*i)
+	}
+	return dduo
+}
+
+// AddTemplateVersion adds i to the "template_version" field.
+func (dduo *DocumentDataUpdateOne) AddTemplateVersion(i int) *DocumentDataUpdateOne {
+	dduo.mutation.AddTemplateVersion(i)
+	return dduo
+}
+
+// ClearTemplateVersion clears the value of the "template_version" field.
+func (dduo *DocumentDataUpdateOne) ClearTemplateVersion() *DocumentDataUpdateOne {
+	dduo.mutation.ClearTemplateVersion()
+	return dduo
+}
+
 // SetData sets the "data" field.
 func (dduo *DocumentDataUpdateOne) SetData(co customtypes.JSONObject) *DocumentDataUpdateOne {
 	dduo.mutation.SetData(co)
@@ -802,6 +865,15 @@ func (dduo *DocumentDataUpdateOne) sqlSave(ctx context.Context) (_node *Document
 	if dduo.mutation.DeletedByCleared() {
 		_spec.ClearField(documentdata.FieldDeletedBy, field.TypeString)
 	}
+	if value, ok := dduo.mutation.TemplateVersion(); ok {
+		_spec.SetField(documentdata.FieldTemplateVersion, field.TypeInt, value)
+	}
+	if value, ok := dduo.mutation.AddedTemplateVersion(); ok {
+		_spec.AddField(documentdata.FieldTemplateVersion, field.TypeInt, value)
+	}
+	if dduo.mutation.TemplateVersionCleared() {
+		_spec.ClearField(documentdata.FieldTemplateVersion, field.TypeInt)
+	}
 	if value, ok := dduo.mutation.Data(); ok {
 		_spec.SetField(documentdata.FieldData, field.TypeJSON, value)
 	}
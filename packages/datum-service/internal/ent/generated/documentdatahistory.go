@@ -46,6 +46,8 @@ type DocumentDataHistory struct {
 	OwnerID string `json:"owner_id,omitempty"`
 	// the template id of the document
 	TemplateID string `json:"template_id,omitempty"`
+	// the version of the template the document is validated against
+	TemplateVersion int `json:"template_version,omitempty"`
 	// the json data of the document
 	Data         customtypes.JSONObject `json:"data,omitempty"`
 	selectValues sql.SelectValues
@@ -60,6 +62,8 @@ func (*DocumentDataHistory) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case documentdatahistory.FieldOperation:
 			values[i] = new(enthistory.OpType)
+		case documentdatahistory.FieldTemplateVersion:
+			values[i] = new(sql.NullInt64)
 		case documentdatahistory.FieldID, documentdatahistory.FieldRef, documentdatahistory.FieldCreatedBy, documentdatahistory.FieldUpdatedBy, documentdatahistory.FieldMappingID, documentdatahistory.FieldDeletedBy, documentdatahistory.FieldOwnerID, documentdatahistory.FieldTemplateID:
 			values[i] = new(sql.NullString)
 		case documentdatahistory.FieldHistoryTime, documentdatahistory.FieldCreatedAt, documentdatahistory.FieldUpdatedAt, documentdatahistory.FieldDeletedAt:
@@ -165,6 +169,12 @@ func (ddh *DocumentDataHistory) assignValues(columns []string, values []any) err
 			} else if value.Valid {
 				ddh.TemplateID = value.String
 			}
+		case documentdatahistory.FieldTemplateVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field template_version", values[i])
+			} else if value.Valid {
+				ddh.TemplateVersion = int(value.Int64)
+			}
 		case documentdatahistory.FieldData:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field data", values[i])
@@ -248,6 +258,9 @@ func (ddh *DocumentDataHistory) String() string {
 	builder.WriteString("template_id=")
 	builder.WriteString(ddh.TemplateID)
 	builder.WriteString(", ")
+	builder.WriteString("template_version=")
+	builder.WriteString(fmt.Sprintf("%v", ddh.TemplateVersion))
+	builder.WriteString(", ")
 	builder.WriteString("data=")
 	builder.WriteString(fmt.Sprintf("%v", ddh.Data))
 	builder.WriteByte(')')
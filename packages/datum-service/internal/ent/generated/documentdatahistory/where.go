@@ -120,6 +120,11 @@ func TemplateID(v string) predicate.DocumentDataHistory {
 	return predicate.DocumentDataHistory(sql.FieldEQ(FieldTemplateID, v))
 }
 
+// TemplateVersion applies equality check predicate on the "template_version" field. It's identical to TemplateVersionEQ.
+func TemplateVersion(v int) predicate.DocumentDataHistory {
+	return predicate.DocumentDataHistory(sql.FieldEQ(FieldTemplateVersion, v))
+}
+
 // HistoryTimeEQ applies the EQ predicate on the "history_time" field.
 func HistoryTimeEQ(v time.Time) predicate.DocumentDataHistory {
 	return predicate.DocumentDataHistory(sql.FieldEQ(FieldHistoryTime, v))
@@ -845,6 +850,56 @@ func TemplateIDContainsFold(v string) predicate.DocumentDataHistory {
 	return predicate.DocumentDataHistory(sql.FieldContainsFold(FieldTemplateID, v))
 }
 
+// TemplateVersionEQ applies the EQ predicate on the "template_version" field.
+func TemplateVersionEQ(v int) predicate.DocumentDataHistory {
+	return predicate.DocumentDataHistory(sql.FieldEQ(FieldTemplateVersion, v))
+}
+
+// TemplateVersionNEQ applies the NEQ predicate on the "template_version" field.
+func TemplateVersionNEQ(v int) predicate.DocumentDataHistory {
+	return predicate.DocumentDataHistory(sql.FieldNEQ(FieldTemplateVersion, v))
+}
+
+// TemplateVersionIn applies the In predicate on the "template_version" field.
+func TemplateVersionIn(vs ...int) predicate.DocumentDataHistory {
+	return predicate.DocumentDataHistory(sql.FieldIn(FieldTemplateVersion, vs...))
+}
+
+// TemplateVersionNotIn applies the NotIn predicate on the "template_version" field.
+func TemplateVersionNotIn(vs ...int) predicate.DocumentDataHistory {
+	return predicate.DocumentDataHistory(sql.FieldNotIn(FieldTemplateVersion, vs...))
+}
+
+// TemplateVersionGT applies the GT predicate on the "template_version" field.
+func TemplateVersionGT(v int) predicate.DocumentDataHistory {
+	return predicate.DocumentDataHistory(sql.FieldGT(FieldTemplateVersion, v))
+}
+
+// TemplateVersionGTE applies the GTE predicate on the "template_version" field.
+func TemplateVersionGTE(v int) predicate.DocumentDataHistory {
+	return predicate.DocumentDataHistory(sql.FieldGTE(FieldTemplateVersion, v))
+}
+
+// TemplateVersionLT applies the LT predicate on the "template_version" field.
+func TemplateVersionLT(v int) predicate.DocumentDataHistory {
+	return predicate.DocumentDataHistory(sql.FieldLT(FieldTemplateVersion, v))
+}
+
+// TemplateVersionLTE applies the LTE predicate on the "template_version" field.
+func TemplateVersionLTE(v int) predicate.DocumentDataHistory {
+	return predicate.DocumentDataHistory(sql.FieldLTE(FieldTemplateVersion, v))
+}
+
+// TemplateVersionIsNil applies the IsNil predicate on the "template_version" field.
+func TemplateVersionIsNil() predicate.DocumentDataHistory {
+	return predicate.DocumentDataHistory(sql.FieldIsNull(FieldTemplateVersion))
+}
+
+// TemplateVersionNotNil applies the NotNil predicate on the "template_version" field.
+func TemplateVersionNotNil() predicate.DocumentDataHistory {
+	return predicate.DocumentDataHistory(sql.FieldNotNull(FieldTemplateVersion))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.DocumentDataHistory) predicate.DocumentDataHistory {
 	return predicate.DocumentDataHistory(sql.AndPredicates(predicates...))
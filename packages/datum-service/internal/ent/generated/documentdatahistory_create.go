@@ -180,6 +180,20 @@ func (ddhc *DocumentDataHistoryCreate) SetTemplateID(s string) *DocumentDataHist
 	return ddhc
 }
 
+// SetTemplateVersion sets the "template_version" field.
+func (ddhc *DocumentDataHistoryCreate) SetTemplateVersion(i int) *DocumentDataHistoryCreate {
+	ddhc.mutation.SetTemplateVersion(i)
+	return ddhc
+}
+
+// SetNillableTemplateVersion sets the "template_version" field if the given value is not nil.
+func (ddhc *DocumentDataHistoryCreate) SetNillableTemplateVersion(i *int) *DocumentDataHistoryCreate {
+	if i != nil {
+		ddhc.SetTemplateVersion(*i)
+	}
+	return ddhc
+}
+
 // SetData sets the "data" field.
 func (ddhc *DocumentDataHistoryCreate) SetData(co customtypes.JSONObject) *DocumentDataHistoryCreate {
 	ddhc.mutation.SetData(co)
@@ -389,6 +403,10 @@ func (ddhc *DocumentDataHistoryCreate) createSpec() (*DocumentDataHistory, *sqlg
 		_spec.SetField(documentdatahistory.FieldTemplateID, field.TypeString, value)
 		_node.TemplateID = value
 	}
+	if value, ok := ddhc.mutation.TemplateVersion(); ok {
+		_spec.SetField(documentdatahistory.FieldTemplateVersion, field.TypeInt, value)
+		_node.TemplateVersion = value
+	}
 	if value, ok := ddhc.mutation.Data(); ok {
 		_spec.SetField(documentdatahistory.FieldData, field.TypeJSON, value)
 		_node.Data = value
@@ -156,6 +156,33 @@ func (ddhu *DocumentDataHistoryUpdate) SetNillableTemplateID(s *string) *Documen
 	return ddhu
 }
 
+// SetTemplateVersion sets the "template_version" field.
+func (ddhu *DocumentDataHistoryUpdate) SetTemplateVersion(i int) *DocumentDataHistoryUpdate {
+	ddhu.mutation.ResetTemplateVersion()
+	ddhu.mutation.SetTemplateVersion(i)
+	return ddhu
+}
+
+// SetNillableTemplateVersion sets the "template_version" field if the given value is not nil.
+func (ddhu *DocumentDataHistoryUpdate) SetNillableTemplateVersion(i *int) *DocumentDataHistoryUpdate {
+	if i != nil {
+		ddhu.SetTemplateVersion(*i)
+	}
+	return ddhu
+}
+
+// AddTemplateVersion adds i to the "template_version" field.
+func (ddhu *DocumentDataHistoryUpdate) AddTemplateVersion(i int) *DocumentDataHistoryUpdate {
+	ddhu.mutation.AddTemplateVersion(i)
+	return ddhu
+}
+
+// ClearTemplateVersion clears the value of the "template_version" field.
+func (ddhu *DocumentDataHistoryUpdate) ClearTemplateVersion() *DocumentDataHistoryUpdate {
+	ddhu.mutation.ClearTemplateVersion()
+	return ddhu
+}
+
 // SetData sets the "data" field.
 func (ddhu *DocumentDataHistoryUpdate) SetData(co customtypes.JSONObject) *DocumentDataHistoryUpdate {
 	ddhu.mutation.SetData(co)
@@ -271,6 +298,15 @@ func (ddhu *DocumentDataHistoryUpdate) sqlSave(ctx context.Context) (n int, err
 	if value, ok := ddhu.mutation.TemplateID(); ok {
 		_spec.SetField(documentdatahistory.FieldTemplateID, field.TypeString, value)
 	}
+	if value, ok := ddhu.mutation.TemplateVersion(); ok {
+		_spec.SetField(documentdatahistory.FieldTemplateVersion, field.TypeInt, value)
+	}
+	if value, ok := ddhu.mutation.AddedTemplateVersion(); ok {
+		_spec.AddField(documentdatahistory.FieldTemplateVersion, field.TypeInt, value)
+	}
+	if ddhu.mutation.TemplateVersionCleared() {
+		_spec.ClearField(documentdatahistory.FieldTemplateVersion, field.TypeInt)
+	}
 	if value, ok := ddhu.mutation.Data(); ok {
 		_spec.SetField(documentdatahistory.FieldData, field.TypeJSON, value)
 	}
@@ -420,6 +456,33 @@ func (ddhuo *DocumentDataHistoryUpdateOne) SetNillableTemplateID(s *string) *Doc
 	return ddhuo
 }
 
+// SetTemplateVersion sets the "template_version" field.
+func (ddhuo *DocumentDataHistoryUpdateOne) SetTemplateVersion(i int) *DocumentDataHistoryUpdateOne {
+	ddhuo.mutation.ResetTemplateVersion()
+	ddhuo.mutation.SetTemplateVersion(i)
+	return ddhuo
+}
+
+// SetNillableTemplateVersion sets the "template_version" field if the given value is not nil.
+func (ddhuo *DocumentDataHistoryUpdateOne) SetNillableTemplateVersion(i *int) *DocumentDataHistoryUpdateOne {
+	if i != nil {
+		ddhuo.SetTemplateVersion(*i)
+	}
+	return ddhuo
+}
+
+// AddTemplateVersion adds i to the "template_version" field.
+func (ddhuo *DocumentDataHistoryUpdateOne) AddTemplateVersion(i int) *DocumentDataHistoryUpdateOne {
+	ddhuo.mutation.AddTemplateVersion(i)
+	return ddhuo
+}
+
+// ClearTemplateVersion clears the value of the "template_version" field.
+func (ddhuo *DocumentDataHistoryUpdateOne) ClearTemplateVersion() *DocumentDataHistoryUpdateOne {
+	ddhuo.mutation.ClearTemplateVersion()
+	return ddhuo
+}
+
 // SetData sets the "data" field.
 func (ddhuo *DocumentDataHistoryUpdateOne) SetData(co customtypes.JSONObject) *DocumentDataHistoryUpdateOne {
 	ddhuo.mutation.SetData(co)
@@ -565,6 +628,15 @@ func (ddhuo *DocumentDataHistoryUpdateOne) sqlSave(ctx context.Context) (_node *
 	if value, ok := ddhuo.mutation.TemplateID(); ok {
 		_spec.SetField(documentdatahistory.FieldTemplateID, field.TypeString, value)
 	}
+	if value, ok := ddhuo.mutation.TemplateVersion(); ok {
+		_spec.SetField(documentdatahistory.FieldTemplateVersion, field.TypeInt, value)
+	}
+	if value, ok := ddhuo.mutation.AddedTemplateVersion(); ok {
+		_spec.AddField(documentdatahistory.FieldTemplateVersion, field.TypeInt, value)
+	}
+	if ddhuo.mutation.TemplateVersionCleared() {
+		_spec.ClearField(documentdatahistory.FieldTemplateVersion, field.TypeInt)
+	}
 	if value, ok := ddhuo.mutation.Data(); ok {
 		_spec.SetField(documentdatahistory.FieldData, field.TypeJSON, value)
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/entitlementplanfeature"
 	"github.com/datumforge/datum/internal/ent/generated/entity"
 	"github.com/datumforge/datum/internal/ent/generated/entitytype"
+	"github.com/datumforge/datum/internal/ent/generated/event"
 	"github.com/datumforge/datum/internal/ent/generated/group"
 	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
 	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
@@ -27,11 +28,13 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/subscriber"
 	"github.com/datumforge/datum/internal/ent/generated/template"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
 	"github.com/datumforge/datum/internal/ent/generated/tfasetting"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
 	"github.com/datumforge/datum/internal/ent/generated/webauthn"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 )
 
 func APITokenEdgeCleanup(ctx context.Context, id string) error {
@@ -150,6 +153,13 @@ func EventEdgeCleanup(ctx context.Context, id string) error {
 	// If a user has access to delete the object, they have access to delete all edges
 	ctx = privacy.DecisionContext(ctx, privacy.Allowf("cleanup event edge"))
 
+	if exists, err := FromContext(ctx).WebhookDelivery.Query().Where((webhookdelivery.HasEventWith(event.ID(id)))).Exist(ctx); err == nil && exists {
+		if webhookdeliveryCount, err := FromContext(ctx).WebhookDelivery.Delete().Where(webhookdelivery.HasEventWith(event.ID(id))).Exec(ctx); err != nil {
+			FromContext(ctx).Logger.Debugw("deleting webhookdelivery", "count", webhookdeliveryCount, "err", err)
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -353,6 +363,13 @@ func OrganizationEdgeCleanup(ctx context.Context, id string) error {
 		}
 	}
 
+	if exists, err := FromContext(ctx).TemplateVersion.Query().Where((templateversion.HasOwnerWith(organization.ID(id)))).Exist(ctx); err == nil && exists {
+		if templateversionCount, err := FromContext(ctx).TemplateVersion.Delete().Where(templateversion.HasOwnerWith(organization.ID(id))).Exec(ctx); err != nil {
+			FromContext(ctx).Logger.Debugw("deleting templateversion", "count", templateversionCount, "err", err)
+			return err
+		}
+	}
+
 	if exists, err := FromContext(ctx).Integration.Query().Where((integration.HasOwnerWith(organization.ID(id)))).Exist(ctx); err == nil && exists {
 		if integrationCount, err := FromContext(ctx).Integration.Delete().Where(integration.HasOwnerWith(organization.ID(id))).Exec(ctx); err != nil {
 			FromContext(ctx).Logger.Debugw("deleting integration", "count", integrationCount, "err", err)
@@ -402,6 +419,13 @@ func OrganizationEdgeCleanup(ctx context.Context, id string) error {
 		}
 	}
 
+	if exists, err := FromContext(ctx).WebhookDelivery.Query().Where((webhookdelivery.HasOwnerWith(organization.ID(id)))).Exist(ctx); err == nil && exists {
+		if webhookdeliveryCount, err := FromContext(ctx).WebhookDelivery.Delete().Where(webhookdelivery.HasOwnerWith(organization.ID(id))).Exec(ctx); err != nil {
+			FromContext(ctx).Logger.Debugw("deleting webhookdelivery", "count", webhookdeliveryCount, "err", err)
+			return err
+		}
+	}
+
 	if exists, err := FromContext(ctx).EntitlementPlan.Query().Where((entitlementplan.HasOwnerWith(organization.ID(id)))).Exist(ctx); err == nil && exists {
 		if entitlementplanCount, err := FromContext(ctx).EntitlementPlan.Delete().Where(entitlementplan.HasOwnerWith(organization.ID(id))).Exec(ctx); err != nil {
 			FromContext(ctx).Logger.Debugw("deleting entitlementplan", "count", entitlementplanCount, "err", err)
@@ -475,6 +499,13 @@ func OrganizationSettingHistoryEdgeCleanup(ctx context.Context, id string) error
 	return nil
 }
 
+func OutboxEventEdgeCleanup(ctx context.Context, id string) error {
+	// If a user has access to delete the object, they have access to delete all edges
+	ctx = privacy.DecisionContext(ctx, privacy.Allowf("cleanup outboxevent edge"))
+
+	return nil
+}
+
 func PasswordResetTokenEdgeCleanup(ctx context.Context, id string) error {
 	// If a user has access to delete the object, they have access to delete all edges
 	ctx = privacy.DecisionContext(ctx, privacy.Allowf("cleanup passwordresettoken edge"))
@@ -514,6 +545,13 @@ func TemplateEdgeCleanup(ctx context.Context, id string) error {
 		}
 	}
 
+	if exists, err := FromContext(ctx).TemplateVersion.Query().Where((templateversion.HasTemplateWith(template.ID(id)))).Exist(ctx); err == nil && exists {
+		if templateversionCount, err := FromContext(ctx).TemplateVersion.Delete().Where(templateversion.HasTemplateWith(template.ID(id))).Exec(ctx); err != nil {
+			FromContext(ctx).Logger.Debugw("deleting templateversion", "count", templateversionCount, "err", err)
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -524,6 +562,13 @@ func TemplateHistoryEdgeCleanup(ctx context.Context, id string) error {
 	return nil
 }
 
+func TemplateVersionEdgeCleanup(ctx context.Context, id string) error {
+	// If a user has access to delete the object, they have access to delete all edges
+	ctx = privacy.DecisionContext(ctx, privacy.Allowf("cleanup templateversion edge"))
+
+	return nil
+}
+
 func UserEdgeCleanup(ctx context.Context, id string) error {
 	// If a user has access to delete the object, they have access to delete all edges
 	ctx = privacy.DecisionContext(ctx, privacy.Allowf("cleanup user edge"))
@@ -619,6 +664,20 @@ func WebhookEdgeCleanup(ctx context.Context, id string) error {
 	// If a user has access to delete the object, they have access to delete all edges
 	ctx = privacy.DecisionContext(ctx, privacy.Allowf("cleanup webhook edge"))
 
+	if exists, err := FromContext(ctx).WebhookDelivery.Query().Where((webhookdelivery.HasWebhookWith(webhook.ID(id)))).Exist(ctx); err == nil && exists {
+		if webhookdeliveryCount, err := FromContext(ctx).WebhookDelivery.Delete().Where(webhookdelivery.HasWebhookWith(webhook.ID(id))).Exec(ctx); err != nil {
+			FromContext(ctx).Logger.Debugw("deleting webhookdelivery", "count", webhookdeliveryCount, "err", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func WebhookDeliveryEdgeCleanup(ctx context.Context, id string) error {
+	// If a user has access to delete the object, they have access to delete all edges
+	ctx = privacy.DecisionContext(ctx, privacy.Allowf("cleanup webhookdelivery edge"))
+
 	return nil
 }
 
@@ -56,11 +56,13 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/organizationsettinghistory"
 	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
 	"github.com/datumforge/datum/internal/ent/generated/orgmembershiphistory"
+	"github.com/datumforge/datum/internal/ent/generated/outboxevent"
 	"github.com/datumforge/datum/internal/ent/generated/passwordresettoken"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/subscriber"
 	"github.com/datumforge/datum/internal/ent/generated/template"
 	"github.com/datumforge/datum/internal/ent/generated/templatehistory"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
 	"github.com/datumforge/datum/internal/ent/generated/tfasetting"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/userhistory"
@@ -68,6 +70,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/usersettinghistory"
 	"github.com/datumforge/datum/internal/ent/generated/webauthn"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 	"github.com/datumforge/datum/internal/ent/generated/webhookhistory"
 )
 
@@ -173,18 +176,21 @@ func checkColumn(table, column string) error {
 			organizationhistory.Table:           organizationhistory.ValidColumn,
 			organizationsetting.Table:           organizationsetting.ValidColumn,
 			organizationsettinghistory.Table:    organizationsettinghistory.ValidColumn,
+			outboxevent.Table:                   outboxevent.ValidColumn,
 			passwordresettoken.Table:            passwordresettoken.ValidColumn,
 			personalaccesstoken.Table:           personalaccesstoken.ValidColumn,
 			subscriber.Table:                    subscriber.ValidColumn,
 			tfasetting.Table:                    tfasetting.ValidColumn,
 			template.Table:                      template.ValidColumn,
 			templatehistory.Table:               templatehistory.ValidColumn,
+			templateversion.Table:               templateversion.ValidColumn,
 			user.Table:                          user.ValidColumn,
 			userhistory.Table:                   userhistory.ValidColumn,
 			usersetting.Table:                   usersetting.ValidColumn,
 			usersettinghistory.Table:            usersettinghistory.ValidColumn,
 			webauthn.Table:                      webauthn.ValidColumn,
 			webhook.Table:                       webhook.ValidColumn,
+			webhookdelivery.Table:               webhookdelivery.ValidColumn,
 			webhookhistory.Table:                webhookhistory.ValidColumn,
 		})
 	})
@@ -47,12 +47,14 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/organizationsettinghistory"
 	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
 	"github.com/datumforge/datum/internal/ent/generated/orgmembershiphistory"
+	"github.com/datumforge/datum/internal/ent/generated/outboxevent"
 	"github.com/datumforge/datum/internal/ent/generated/passwordresettoken"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/predicate"
 	"github.com/datumforge/datum/internal/ent/generated/subscriber"
 	"github.com/datumforge/datum/internal/ent/generated/template"
 	"github.com/datumforge/datum/internal/ent/generated/templatehistory"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
 	"github.com/datumforge/datum/internal/ent/generated/tfasetting"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/userhistory"
@@ -60,6 +62,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/usersettinghistory"
 	"github.com/datumforge/datum/internal/ent/generated/webauthn"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 	"github.com/datumforge/datum/internal/ent/generated/webhookhistory"
 
 	"entgo.io/ent/dialect/sql"
@@ -70,7 +73,7 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 57)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 60)}
 	graph.Nodes[0] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   apitoken.Table,
@@ -171,17 +174,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "DocumentData",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			documentdata.FieldCreatedAt:  {Type: field.TypeTime, Column: documentdata.FieldCreatedAt},
-			documentdata.FieldUpdatedAt:  {Type: field.TypeTime, Column: documentdata.FieldUpdatedAt},
-			documentdata.FieldCreatedBy:  {Type: field.TypeString, Column: documentdata.FieldCreatedBy},
-			documentdata.FieldUpdatedBy:  {Type: field.TypeString, Column: documentdata.FieldUpdatedBy},
-			documentdata.FieldMappingID:  {Type: field.TypeString, Column: documentdata.FieldMappingID},
-			documentdata.FieldTags:       {Type: field.TypeJSON, Column: documentdata.FieldTags},
-			documentdata.FieldDeletedAt:  {Type: field.TypeTime, Column: documentdata.FieldDeletedAt},
-			documentdata.FieldDeletedBy:  {Type: field.TypeString, Column: documentdata.FieldDeletedBy},
-			documentdata.FieldOwnerID:    {Type: field.TypeString, Column: documentdata.FieldOwnerID},
-			documentdata.FieldTemplateID: {Type: field.TypeString, Column: documentdata.FieldTemplateID},
-			documentdata.FieldData:       {Type: field.TypeJSON, Column: documentdata.FieldData},
+			documentdata.FieldCreatedAt:       {Type: field.TypeTime, Column: documentdata.FieldCreatedAt},
+			documentdata.FieldUpdatedAt:       {Type: field.TypeTime, Column: documentdata.FieldUpdatedAt},
+			documentdata.FieldCreatedBy:       {Type: field.TypeString, Column: documentdata.FieldCreatedBy},
+			documentdata.FieldUpdatedBy:       {Type: field.TypeString, Column: documentdata.FieldUpdatedBy},
+			documentdata.FieldMappingID:       {Type: field.TypeString, Column: documentdata.FieldMappingID},
+			documentdata.FieldTags:            {Type: field.TypeJSON, Column: documentdata.FieldTags},
+			documentdata.FieldDeletedAt:       {Type: field.TypeTime, Column: documentdata.FieldDeletedAt},
+			documentdata.FieldDeletedBy:       {Type: field.TypeString, Column: documentdata.FieldDeletedBy},
+			documentdata.FieldOwnerID:         {Type: field.TypeString, Column: documentdata.FieldOwnerID},
+			documentdata.FieldTemplateID:      {Type: field.TypeString, Column: documentdata.FieldTemplateID},
+			documentdata.FieldTemplateVersion: {Type: field.TypeInt, Column: documentdata.FieldTemplateVersion},
+			documentdata.FieldData:            {Type: field.TypeJSON, Column: documentdata.FieldData},
 		},
 	}
 	graph.Nodes[4] = &sqlgraph.Node{
@@ -195,20 +199,21 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "DocumentDataHistory",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			documentdatahistory.FieldHistoryTime: {Type: field.TypeTime, Column: documentdatahistory.FieldHistoryTime},
-			documentdatahistory.FieldRef:         {Type: field.TypeString, Column: documentdatahistory.FieldRef},
-			documentdatahistory.FieldOperation:   {Type: field.TypeEnum, Column: documentdatahistory.FieldOperation},
-			documentdatahistory.FieldCreatedAt:   {Type: field.TypeTime, Column: documentdatahistory.FieldCreatedAt},
-			documentdatahistory.FieldUpdatedAt:   {Type: field.TypeTime, Column: documentdatahistory.FieldUpdatedAt},
-			documentdatahistory.FieldCreatedBy:   {Type: field.TypeString, Column: documentdatahistory.FieldCreatedBy},
-			documentdatahistory.FieldUpdatedBy:   {Type: field.TypeString, Column: documentdatahistory.FieldUpdatedBy},
-			documentdatahistory.FieldMappingID:   {Type: field.TypeString, Column: documentdatahistory.FieldMappingID},
-			documentdatahistory.FieldTags:        {Type: field.TypeJSON, Column: documentdatahistory.FieldTags},
-			documentdatahistory.FieldDeletedAt:   {Type: field.TypeTime, Column: documentdatahistory.FieldDeletedAt},
-			documentdatahistory.FieldDeletedBy:   {Type: field.TypeString, Column: documentdatahistory.FieldDeletedBy},
-			documentdatahistory.FieldOwnerID:     {Type: field.TypeString, Column: documentdatahistory.FieldOwnerID},
-			documentdatahistory.FieldTemplateID:  {Type: field.TypeString, Column: documentdatahistory.FieldTemplateID},
-			documentdatahistory.FieldData:        {Type: field.TypeJSON, Column: documentdatahistory.FieldData},
+			documentdatahistory.FieldHistoryTime:     {Type: field.TypeTime, Column: documentdatahistory.FieldHistoryTime},
+			documentdatahistory.FieldRef:             {Type: field.TypeString, Column: documentdatahistory.FieldRef},
+			documentdatahistory.FieldOperation:       {Type: field.TypeEnum, Column: documentdatahistory.FieldOperation},
+			documentdatahistory.FieldCreatedAt:       {Type: field.TypeTime, Column: documentdatahistory.FieldCreatedAt},
+			documentdatahistory.FieldUpdatedAt:       {Type: field.TypeTime, Column: documentdatahistory.FieldUpdatedAt},
+			documentdatahistory.FieldCreatedBy:       {Type: field.TypeString, Column: documentdatahistory.FieldCreatedBy},
+			documentdatahistory.FieldUpdatedBy:       {Type: field.TypeString, Column: documentdatahistory.FieldUpdatedBy},
+			documentdatahistory.FieldMappingID:       {Type: field.TypeString, Column: documentdatahistory.FieldMappingID},
+			documentdatahistory.FieldTags:            {Type: field.TypeJSON, Column: documentdatahistory.FieldTags},
+			documentdatahistory.FieldDeletedAt:       {Type: field.TypeTime, Column: documentdatahistory.FieldDeletedAt},
+			documentdatahistory.FieldDeletedBy:       {Type: field.TypeString, Column: documentdatahistory.FieldDeletedBy},
+			documentdatahistory.FieldOwnerID:         {Type: field.TypeString, Column: documentdatahistory.FieldOwnerID},
+			documentdatahistory.FieldTemplateID:      {Type: field.TypeString, Column: documentdatahistory.FieldTemplateID},
+			documentdatahistory.FieldTemplateVersion: {Type: field.TypeInt, Column: documentdatahistory.FieldTemplateVersion},
+			documentdatahistory.FieldData:            {Type: field.TypeJSON, Column: documentdatahistory.FieldData},
 		},
 	}
 	graph.Nodes[5] = &sqlgraph.Node{
@@ -1054,6 +1059,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			oauthprovider.FieldTokenURL:     {Type: field.TypeString, Column: oauthprovider.FieldTokenURL},
 			oauthprovider.FieldAuthStyle:    {Type: field.TypeUint8, Column: oauthprovider.FieldAuthStyle},
 			oauthprovider.FieldInfoURL:      {Type: field.TypeString, Column: oauthprovider.FieldInfoURL},
+			oauthprovider.FieldIssuer:       {Type: field.TypeString, Column: oauthprovider.FieldIssuer},
 		},
 	}
 	graph.Nodes[36] = &sqlgraph.Node{
@@ -1088,6 +1094,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			oauthproviderhistory.FieldTokenURL:     {Type: field.TypeString, Column: oauthproviderhistory.FieldTokenURL},
 			oauthproviderhistory.FieldAuthStyle:    {Type: field.TypeUint8, Column: oauthproviderhistory.FieldAuthStyle},
 			oauthproviderhistory.FieldInfoURL:      {Type: field.TypeString, Column: oauthproviderhistory.FieldInfoURL},
+			oauthproviderhistory.FieldIssuer:       {Type: field.TypeString, Column: oauthproviderhistory.FieldIssuer},
 		},
 	}
 	graph.Nodes[37] = &sqlgraph.Node{
@@ -1128,16 +1135,19 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "OrgMembership",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			orgmembership.FieldCreatedAt:      {Type: field.TypeTime, Column: orgmembership.FieldCreatedAt},
-			orgmembership.FieldUpdatedAt:      {Type: field.TypeTime, Column: orgmembership.FieldUpdatedAt},
-			orgmembership.FieldCreatedBy:      {Type: field.TypeString, Column: orgmembership.FieldCreatedBy},
-			orgmembership.FieldUpdatedBy:      {Type: field.TypeString, Column: orgmembership.FieldUpdatedBy},
-			orgmembership.FieldMappingID:      {Type: field.TypeString, Column: orgmembership.FieldMappingID},
-			orgmembership.FieldDeletedAt:      {Type: field.TypeTime, Column: orgmembership.FieldDeletedAt},
-			orgmembership.FieldDeletedBy:      {Type: field.TypeString, Column: orgmembership.FieldDeletedBy},
-			orgmembership.FieldRole:           {Type: field.TypeEnum, Column: orgmembership.FieldRole},
-			orgmembership.FieldOrganizationID: {Type: field.TypeString, Column: orgmembership.FieldOrganizationID},
-			orgmembership.FieldUserID:         {Type: field.TypeString, Column: orgmembership.FieldUserID},
+			orgmembership.FieldCreatedAt:       {Type: field.TypeTime, Column: orgmembership.FieldCreatedAt},
+			orgmembership.FieldUpdatedAt:       {Type: field.TypeTime, Column: orgmembership.FieldUpdatedAt},
+			orgmembership.FieldCreatedBy:       {Type: field.TypeString, Column: orgmembership.FieldCreatedBy},
+			orgmembership.FieldUpdatedBy:       {Type: field.TypeString, Column: orgmembership.FieldUpdatedBy},
+			orgmembership.FieldMappingID:       {Type: field.TypeString, Column: orgmembership.FieldMappingID},
+			orgmembership.FieldDeletedAt:       {Type: field.TypeTime, Column: orgmembership.FieldDeletedAt},
+			orgmembership.FieldDeletedBy:       {Type: field.TypeString, Column: orgmembership.FieldDeletedBy},
+			orgmembership.FieldRole:            {Type: field.TypeEnum, Column: orgmembership.FieldRole},
+			orgmembership.FieldOrganizationID:  {Type: field.TypeString, Column: orgmembership.FieldOrganizationID},
+			orgmembership.FieldUserID:          {Type: field.TypeString, Column: orgmembership.FieldUserID},
+			orgmembership.FieldSSOIssuer:       {Type: field.TypeString, Column: orgmembership.FieldSSOIssuer},
+			orgmembership.FieldSSOSubject:      {Type: field.TypeString, Column: orgmembership.FieldSSOSubject},
+			orgmembership.FieldUserProvisioned: {Type: field.TypeBool, Column: orgmembership.FieldUserProvisioned},
 		},
 	}
 	graph.Nodes[39] = &sqlgraph.Node{
@@ -1151,19 +1161,22 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "OrgMembershipHistory",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			orgmembershiphistory.FieldHistoryTime:    {Type: field.TypeTime, Column: orgmembershiphistory.FieldHistoryTime},
-			orgmembershiphistory.FieldRef:            {Type: field.TypeString, Column: orgmembershiphistory.FieldRef},
-			orgmembershiphistory.FieldOperation:      {Type: field.TypeEnum, Column: orgmembershiphistory.FieldOperation},
-			orgmembershiphistory.FieldCreatedAt:      {Type: field.TypeTime, Column: orgmembershiphistory.FieldCreatedAt},
-			orgmembershiphistory.FieldUpdatedAt:      {Type: field.TypeTime, Column: orgmembershiphistory.FieldUpdatedAt},
-			orgmembershiphistory.FieldCreatedBy:      {Type: field.TypeString, Column: orgmembershiphistory.FieldCreatedBy},
-			orgmembershiphistory.FieldUpdatedBy:      {Type: field.TypeString, Column: orgmembershiphistory.FieldUpdatedBy},
-			orgmembershiphistory.FieldMappingID:      {Type: field.TypeString, Column: orgmembershiphistory.FieldMappingID},
-			orgmembershiphistory.FieldDeletedAt:      {Type: field.TypeTime, Column: orgmembershiphistory.FieldDeletedAt},
-			orgmembershiphistory.FieldDeletedBy:      {Type: field.TypeString, Column: orgmembershiphistory.FieldDeletedBy},
-			orgmembershiphistory.FieldRole:           {Type: field.TypeEnum, Column: orgmembershiphistory.FieldRole},
-			orgmembershiphistory.FieldOrganizationID: {Type: field.TypeString, Column: orgmembershiphistory.FieldOrganizationID},
-			orgmembershiphistory.FieldUserID:         {Type: field.TypeString, Column: orgmembershiphistory.FieldUserID},
+			orgmembershiphistory.FieldHistoryTime:     {Type: field.TypeTime, Column: orgmembershiphistory.FieldHistoryTime},
+			orgmembershiphistory.FieldRef:             {Type: field.TypeString, Column: orgmembershiphistory.FieldRef},
+			orgmembershiphistory.FieldOperation:       {Type: field.TypeEnum, Column: orgmembershiphistory.FieldOperation},
+			orgmembershiphistory.FieldCreatedAt:       {Type: field.TypeTime, Column: orgmembershiphistory.FieldCreatedAt},
+			orgmembershiphistory.FieldUpdatedAt:       {Type: field.TypeTime, Column: orgmembershiphistory.FieldUpdatedAt},
+			orgmembershiphistory.FieldCreatedBy:       {Type: field.TypeString, Column: orgmembershiphistory.FieldCreatedBy},
+			orgmembershiphistory.FieldUpdatedBy:       {Type: field.TypeString, Column: orgmembershiphistory.FieldUpdatedBy},
+			orgmembershiphistory.FieldMappingID:       {Type: field.TypeString, Column: orgmembershiphistory.FieldMappingID},
+			orgmembershiphistory.FieldDeletedAt:       {Type: field.TypeTime, Column: orgmembershiphistory.FieldDeletedAt},
+			orgmembershiphistory.FieldDeletedBy:       {Type: field.TypeString, Column: orgmembershiphistory.FieldDeletedBy},
+			orgmembershiphistory.FieldRole:            {Type: field.TypeEnum, Column: orgmembershiphistory.FieldRole},
+			orgmembershiphistory.FieldOrganizationID:  {Type: field.TypeString, Column: orgmembershiphistory.FieldOrganizationID},
+			orgmembershiphistory.FieldUserID:          {Type: field.TypeString, Column: orgmembershiphistory.FieldUserID},
+			orgmembershiphistory.FieldSSOIssuer:       {Type: field.TypeString, Column: orgmembershiphistory.FieldSSOIssuer},
+			orgmembershiphistory.FieldSSOSubject:      {Type: field.TypeString, Column: orgmembershiphistory.FieldSSOSubject},
+			orgmembershiphistory.FieldUserProvisioned: {Type: field.TypeBool, Column: orgmembershiphistory.FieldUserProvisioned},
 		},
 	}
 	graph.Nodes[40] = &sqlgraph.Node{
@@ -1236,22 +1249,29 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "OrganizationSetting",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			organizationsetting.FieldCreatedAt:      {Type: field.TypeTime, Column: organizationsetting.FieldCreatedAt},
-			organizationsetting.FieldUpdatedAt:      {Type: field.TypeTime, Column: organizationsetting.FieldUpdatedAt},
-			organizationsetting.FieldCreatedBy:      {Type: field.TypeString, Column: organizationsetting.FieldCreatedBy},
-			organizationsetting.FieldUpdatedBy:      {Type: field.TypeString, Column: organizationsetting.FieldUpdatedBy},
-			organizationsetting.FieldMappingID:      {Type: field.TypeString, Column: organizationsetting.FieldMappingID},
-			organizationsetting.FieldTags:           {Type: field.TypeJSON, Column: organizationsetting.FieldTags},
-			organizationsetting.FieldDeletedAt:      {Type: field.TypeTime, Column: organizationsetting.FieldDeletedAt},
-			organizationsetting.FieldDeletedBy:      {Type: field.TypeString, Column: organizationsetting.FieldDeletedBy},
-			organizationsetting.FieldDomains:        {Type: field.TypeJSON, Column: organizationsetting.FieldDomains},
-			organizationsetting.FieldBillingContact: {Type: field.TypeString, Column: organizationsetting.FieldBillingContact},
-			organizationsetting.FieldBillingEmail:   {Type: field.TypeString, Column: organizationsetting.FieldBillingEmail},
-			organizationsetting.FieldBillingPhone:   {Type: field.TypeString, Column: organizationsetting.FieldBillingPhone},
-			organizationsetting.FieldBillingAddress: {Type: field.TypeString, Column: organizationsetting.FieldBillingAddress},
-			organizationsetting.FieldTaxIdentifier:  {Type: field.TypeString, Column: organizationsetting.FieldTaxIdentifier},
-			organizationsetting.FieldGeoLocation:    {Type: field.TypeEnum, Column: organizationsetting.FieldGeoLocation},
-			organizationsetting.FieldOrganizationID: {Type: field.TypeString, Column: organizationsetting.FieldOrganizationID},
+			organizationsetting.FieldCreatedAt:               {Type: field.TypeTime, Column: organizationsetting.FieldCreatedAt},
+			organizationsetting.FieldUpdatedAt:               {Type: field.TypeTime, Column: organizationsetting.FieldUpdatedAt},
+			organizationsetting.FieldCreatedBy:               {Type: field.TypeString, Column: organizationsetting.FieldCreatedBy},
+			organizationsetting.FieldUpdatedBy:               {Type: field.TypeString, Column: organizationsetting.FieldUpdatedBy},
+			organizationsetting.FieldMappingID:               {Type: field.TypeString, Column: organizationsetting.FieldMappingID},
+			organizationsetting.FieldTags:                    {Type: field.TypeJSON, Column: organizationsetting.FieldTags},
+			organizationsetting.FieldDeletedAt:               {Type: field.TypeTime, Column: organizationsetting.FieldDeletedAt},
+			organizationsetting.FieldDeletedBy:               {Type: field.TypeString, Column: organizationsetting.FieldDeletedBy},
+			organizationsetting.FieldDomains:                 {Type: field.TypeJSON, Column: organizationsetting.FieldDomains},
+			organizationsetting.FieldBillingContact:          {Type: field.TypeString, Column: organizationsetting.FieldBillingContact},
+			organizationsetting.FieldBillingEmail:            {Type: field.TypeString, Column: organizationsetting.FieldBillingEmail},
+			organizationsetting.FieldBillingPhone:            {Type: field.TypeString, Column: organizationsetting.FieldBillingPhone},
+			organizationsetting.FieldBillingAddress:          {Type: field.TypeString, Column: organizationsetting.FieldBillingAddress},
+			organizationsetting.FieldTaxIdentifier:           {Type: field.TypeString, Column: organizationsetting.FieldTaxIdentifier},
+			organizationsetting.FieldGeoLocation:             {Type: field.TypeEnum, Column: organizationsetting.FieldGeoLocation},
+			organizationsetting.FieldOrganizationID:          {Type: field.TypeString, Column: organizationsetting.FieldOrganizationID},
+			organizationsetting.FieldSSOEnforced:             {Type: field.TypeBool, Column: organizationsetting.FieldSSOEnforced},
+			organizationsetting.FieldSamlMetadataURL:         {Type: field.TypeString, Column: organizationsetting.FieldSamlMetadataURL},
+			organizationsetting.FieldSamlMetadata:            {Type: field.TypeString, Column: organizationsetting.FieldSamlMetadata},
+			organizationsetting.FieldSamlAttributeMapping:    {Type: field.TypeJSON, Column: organizationsetting.FieldSamlAttributeMapping},
+			organizationsetting.FieldDomainVerificationToken: {Type: field.TypeString, Column: organizationsetting.FieldDomainVerificationToken},
+			organizationsetting.FieldVerifiedDomains:         {Type: field.TypeJSON, Column: organizationsetting.FieldVerifiedDomains},
+			organizationsetting.FieldJoinPolicy:              {Type: field.TypeEnum, Column: organizationsetting.FieldJoinPolicy},
 		},
 	}
 	graph.Nodes[43] = &sqlgraph.Node{
@@ -1265,28 +1285,65 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "OrganizationSettingHistory",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			organizationsettinghistory.FieldHistoryTime:    {Type: field.TypeTime, Column: organizationsettinghistory.FieldHistoryTime},
-			organizationsettinghistory.FieldRef:            {Type: field.TypeString, Column: organizationsettinghistory.FieldRef},
-			organizationsettinghistory.FieldOperation:      {Type: field.TypeEnum, Column: organizationsettinghistory.FieldOperation},
-			organizationsettinghistory.FieldCreatedAt:      {Type: field.TypeTime, Column: organizationsettinghistory.FieldCreatedAt},
-			organizationsettinghistory.FieldUpdatedAt:      {Type: field.TypeTime, Column: organizationsettinghistory.FieldUpdatedAt},
-			organizationsettinghistory.FieldCreatedBy:      {Type: field.TypeString, Column: organizationsettinghistory.FieldCreatedBy},
-			organizationsettinghistory.FieldUpdatedBy:      {Type: field.TypeString, Column: organizationsettinghistory.FieldUpdatedBy},
-			organizationsettinghistory.FieldMappingID:      {Type: field.TypeString, Column: organizationsettinghistory.FieldMappingID},
-			organizationsettinghistory.FieldTags:           {Type: field.TypeJSON, Column: organizationsettinghistory.FieldTags},
-			organizationsettinghistory.FieldDeletedAt:      {Type: field.TypeTime, Column: organizationsettinghistory.FieldDeletedAt},
-			organizationsettinghistory.FieldDeletedBy:      {Type: field.TypeString, Column: organizationsettinghistory.FieldDeletedBy},
-			organizationsettinghistory.FieldDomains:        {Type: field.TypeJSON, Column: organizationsettinghistory.FieldDomains},
-			organizationsettinghistory.FieldBillingContact: {Type: field.TypeString, Column: organizationsettinghistory.FieldBillingContact},
-			organizationsettinghistory.FieldBillingEmail:   {Type: field.TypeString, Column: organizationsettinghistory.FieldBillingEmail},
-			organizationsettinghistory.FieldBillingPhone:   {Type: field.TypeString, Column: organizationsettinghistory.FieldBillingPhone},
-			organizationsettinghistory.FieldBillingAddress: {Type: field.TypeString, Column: organizationsettinghistory.FieldBillingAddress},
-			organizationsettinghistory.FieldTaxIdentifier:  {Type: field.TypeString, Column: organizationsettinghistory.FieldTaxIdentifier},
-			organizationsettinghistory.FieldGeoLocation:    {Type: field.TypeEnum, Column: organizationsettinghistory.FieldGeoLocation},
-			organizationsettinghistory.FieldOrganizationID: {Type: field.TypeString, Column: organizationsettinghistory.FieldOrganizationID},
+			organizationsettinghistory.FieldHistoryTime:             {Type: field.TypeTime, Column: organizationsettinghistory.FieldHistoryTime},
+			organizationsettinghistory.FieldRef:                     {Type: field.TypeString, Column: organizationsettinghistory.FieldRef},
+			organizationsettinghistory.FieldOperation:               {Type: field.TypeEnum, Column: organizationsettinghistory.FieldOperation},
+			organizationsettinghistory.FieldCreatedAt:               {Type: field.TypeTime, Column: organizationsettinghistory.FieldCreatedAt},
+			organizationsettinghistory.FieldUpdatedAt:               {Type: field.TypeTime, Column: organizationsettinghistory.FieldUpdatedAt},
+			organizationsettinghistory.FieldCreatedBy:               {Type: field.TypeString, Column: organizationsettinghistory.FieldCreatedBy},
+			organizationsettinghistory.FieldUpdatedBy:               {Type: field.TypeString, Column: organizationsettinghistory.FieldUpdatedBy},
+			organizationsettinghistory.FieldMappingID:               {Type: field.TypeString, Column: organizationsettinghistory.FieldMappingID},
+			organizationsettinghistory.FieldTags:                    {Type: field.TypeJSON, Column: organizationsettinghistory.FieldTags},
+			organizationsettinghistory.FieldDeletedAt:               {Type: field.TypeTime, Column: organizationsettinghistory.FieldDeletedAt},
+			organizationsettinghistory.FieldDeletedBy:               {Type: field.TypeString, Column: organizationsettinghistory.FieldDeletedBy},
+			organizationsettinghistory.FieldDomains:                 {Type: field.TypeJSON, Column: organizationsettinghistory.FieldDomains},
+			organizationsettinghistory.FieldBillingContact:          {Type: field.TypeString, Column: organizationsettinghistory.FieldBillingContact},
+			organizationsettinghistory.FieldBillingEmail:            {Type: field.TypeString, Column: organizationsettinghistory.FieldBillingEmail},
+			organizationsettinghistory.FieldBillingPhone:            {Type: field.TypeString, Column: organizationsettinghistory.FieldBillingPhone},
+			organizationsettinghistory.FieldBillingAddress:          {Type: field.TypeString, Column: organizationsettinghistory.FieldBillingAddress},
+			organizationsettinghistory.FieldTaxIdentifier:           {Type: field.TypeString, Column: organizationsettinghistory.FieldTaxIdentifier},
+			organizationsettinghistory.FieldGeoLocation:             {Type: field.TypeEnum, Column: organizationsettinghistory.FieldGeoLocation},
+			organizationsettinghistory.FieldOrganizationID:          {Type: field.TypeString, Column: organizationsettinghistory.FieldOrganizationID},
+			organizationsettinghistory.FieldSSOEnforced:             {Type: field.TypeBool, Column: organizationsettinghistory.FieldSSOEnforced},
+			organizationsettinghistory.FieldSamlMetadataURL:         {Type: field.TypeString, Column: organizationsettinghistory.FieldSamlMetadataURL},
+			organizationsettinghistory.FieldSamlMetadata:            {Type: field.TypeString, Column: organizationsettinghistory.FieldSamlMetadata},
+			organizationsettinghistory.FieldSamlAttributeMapping:    {Type: field.TypeJSON, Column: organizationsettinghistory.FieldSamlAttributeMapping},
+			organizationsettinghistory.FieldDomainVerificationToken: {Type: field.TypeString, Column: organizationsettinghistory.FieldDomainVerificationToken},
+			organizationsettinghistory.FieldVerifiedDomains:         {Type: field.TypeJSON, Column: organizationsettinghistory.FieldVerifiedDomains},
+			organizationsettinghistory.FieldJoinPolicy:              {Type: field.TypeEnum, Column: organizationsettinghistory.FieldJoinPolicy},
 		},
 	}
 	graph.Nodes[44] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   outboxevent.Table,
+			Columns: outboxevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: outboxevent.FieldID,
+			},
+		},
+		Type: "OutboxEvent",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			outboxevent.FieldCreatedAt:      {Type: field.TypeTime, Column: outboxevent.FieldCreatedAt},
+			outboxevent.FieldUpdatedAt:      {Type: field.TypeTime, Column: outboxevent.FieldUpdatedAt},
+			outboxevent.FieldCreatedBy:      {Type: field.TypeString, Column: outboxevent.FieldCreatedBy},
+			outboxevent.FieldUpdatedBy:      {Type: field.TypeString, Column: outboxevent.FieldUpdatedBy},
+			outboxevent.FieldMappingID:      {Type: field.TypeString, Column: outboxevent.FieldMappingID},
+			outboxevent.FieldTopic:          {Type: field.TypeString, Column: outboxevent.FieldTopic},
+			outboxevent.FieldAggregateType:  {Type: field.TypeString, Column: outboxevent.FieldAggregateType},
+			outboxevent.FieldAggregateID:    {Type: field.TypeString, Column: outboxevent.FieldAggregateID},
+			outboxevent.FieldOrganizationID: {Type: field.TypeString, Column: outboxevent.FieldOrganizationID},
+			outboxevent.FieldPayload:        {Type: field.TypeJSON, Column: outboxevent.FieldPayload},
+			outboxevent.FieldAttempts:       {Type: field.TypeInt, Column: outboxevent.FieldAttempts},
+			outboxevent.FieldAvailableAt:    {Type: field.TypeTime, Column: outboxevent.FieldAvailableAt},
+			outboxevent.FieldPublishedAt:    {Type: field.TypeTime, Column: outboxevent.FieldPublishedAt},
+			outboxevent.FieldPublishedTo:    {Type: field.TypeJSON, Column: outboxevent.FieldPublishedTo},
+			outboxevent.FieldLastError:      {Type: field.TypeString, Column: outboxevent.FieldLastError},
+			outboxevent.FieldClaimID:        {Type: field.TypeString, Column: outboxevent.FieldClaimID},
+			outboxevent.FieldClaimedUntil:   {Type: field.TypeTime, Column: outboxevent.FieldClaimedUntil},
+		},
+	}
+	graph.Nodes[45] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   passwordresettoken.Table,
 			Columns: passwordresettoken.Columns,
@@ -1311,7 +1368,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			passwordresettoken.FieldSecret:    {Type: field.TypeBytes, Column: passwordresettoken.FieldSecret},
 		},
 	}
-	graph.Nodes[45] = &sqlgraph.Node{
+	graph.Nodes[46] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   personalaccesstoken.Table,
 			Columns: personalaccesstoken.Columns,
@@ -1339,7 +1396,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			personalaccesstoken.FieldLastUsedAt:  {Type: field.TypeTime, Column: personalaccesstoken.FieldLastUsedAt},
 		},
 	}
-	graph.Nodes[46] = &sqlgraph.Node{
+	graph.Nodes[47] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   subscriber.Table,
 			Columns: subscriber.Columns,
@@ -1369,7 +1426,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			subscriber.FieldSecret:        {Type: field.TypeBytes, Column: subscriber.FieldSecret},
 		},
 	}
-	graph.Nodes[47] = &sqlgraph.Node{
+	graph.Nodes[48] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   tfasetting.Table,
 			Columns: tfasetting.Columns,
@@ -1380,24 +1437,26 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "TFASetting",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			tfasetting.FieldCreatedAt:       {Type: field.TypeTime, Column: tfasetting.FieldCreatedAt},
-			tfasetting.FieldUpdatedAt:       {Type: field.TypeTime, Column: tfasetting.FieldUpdatedAt},
-			tfasetting.FieldCreatedBy:       {Type: field.TypeString, Column: tfasetting.FieldCreatedBy},
-			tfasetting.FieldUpdatedBy:       {Type: field.TypeString, Column: tfasetting.FieldUpdatedBy},
-			tfasetting.FieldMappingID:       {Type: field.TypeString, Column: tfasetting.FieldMappingID},
-			tfasetting.FieldDeletedAt:       {Type: field.TypeTime, Column: tfasetting.FieldDeletedAt},
-			tfasetting.FieldDeletedBy:       {Type: field.TypeString, Column: tfasetting.FieldDeletedBy},
-			tfasetting.FieldTags:            {Type: field.TypeJSON, Column: tfasetting.FieldTags},
-			tfasetting.FieldOwnerID:         {Type: field.TypeString, Column: tfasetting.FieldOwnerID},
-			tfasetting.FieldTfaSecret:       {Type: field.TypeString, Column: tfasetting.FieldTfaSecret},
-			tfasetting.FieldVerified:        {Type: field.TypeBool, Column: tfasetting.FieldVerified},
-			tfasetting.FieldRecoveryCodes:   {Type: field.TypeJSON, Column: tfasetting.FieldRecoveryCodes},
-			tfasetting.FieldPhoneOtpAllowed: {Type: field.TypeBool, Column: tfasetting.FieldPhoneOtpAllowed},
-			tfasetting.FieldEmailOtpAllowed: {Type: field.TypeBool, Column: tfasetting.FieldEmailOtpAllowed},
-			tfasetting.FieldTotpAllowed:     {Type: field.TypeBool, Column: tfasetting.FieldTotpAllowed},
+			tfasetting.FieldCreatedAt:         {Type: field.TypeTime, Column: tfasetting.FieldCreatedAt},
+			tfasetting.FieldUpdatedAt:         {Type: field.TypeTime, Column: tfasetting.FieldUpdatedAt},
+			tfasetting.FieldCreatedBy:         {Type: field.TypeString, Column: tfasetting.FieldCreatedBy},
+			tfasetting.FieldUpdatedBy:         {Type: field.TypeString, Column: tfasetting.FieldUpdatedBy},
+			tfasetting.FieldMappingID:         {Type: field.TypeString, Column: tfasetting.FieldMappingID},
+			tfasetting.FieldDeletedAt:         {Type: field.TypeTime, Column: tfasetting.FieldDeletedAt},
+			tfasetting.FieldDeletedBy:         {Type: field.TypeString, Column: tfasetting.FieldDeletedBy},
+			tfasetting.FieldTags:              {Type: field.TypeJSON, Column: tfasetting.FieldTags},
+			tfasetting.FieldOwnerID:           {Type: field.TypeString, Column: tfasetting.FieldOwnerID},
+			tfasetting.FieldTfaSecret:         {Type: field.TypeString, Column: tfasetting.FieldTfaSecret},
+			tfasetting.FieldVerified:          {Type: field.TypeBool, Column: tfasetting.FieldVerified},
+			tfasetting.FieldRecoveryCodes:     {Type: field.TypeJSON, Column: tfasetting.FieldRecoveryCodes},
+			tfasetting.FieldPhoneOtpAllowed:   {Type: field.TypeBool, Column: tfasetting.FieldPhoneOtpAllowed},
+			tfasetting.FieldEmailOtpAllowed:   {Type: field.TypeBool, Column: tfasetting.FieldEmailOtpAllowed},
+			tfasetting.FieldTotpAllowed:       {Type: field.TypeBool, Column: tfasetting.FieldTotpAllowed},
+			tfasetting.FieldChallengeID:       {Type: field.TypeString, Column: tfasetting.FieldChallengeID},
+			tfasetting.FieldChallengeAttempts: {Type: field.TypeInt, Column: tfasetting.FieldChallengeAttempts},
 		},
 	}
-	graph.Nodes[48] = &sqlgraph.Node{
+	graph.Nodes[49] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   template.Table,
 			Columns: template.Columns,
@@ -1408,23 +1467,26 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Template",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			template.FieldCreatedAt:    {Type: field.TypeTime, Column: template.FieldCreatedAt},
-			template.FieldUpdatedAt:    {Type: field.TypeTime, Column: template.FieldUpdatedAt},
-			template.FieldCreatedBy:    {Type: field.TypeString, Column: template.FieldCreatedBy},
-			template.FieldUpdatedBy:    {Type: field.TypeString, Column: template.FieldUpdatedBy},
-			template.FieldDeletedAt:    {Type: field.TypeTime, Column: template.FieldDeletedAt},
-			template.FieldDeletedBy:    {Type: field.TypeString, Column: template.FieldDeletedBy},
-			template.FieldMappingID:    {Type: field.TypeString, Column: template.FieldMappingID},
-			template.FieldTags:         {Type: field.TypeJSON, Column: template.FieldTags},
-			template.FieldOwnerID:      {Type: field.TypeString, Column: template.FieldOwnerID},
-			template.FieldName:         {Type: field.TypeString, Column: template.FieldName},
-			template.FieldTemplateType: {Type: field.TypeEnum, Column: template.FieldTemplateType},
-			template.FieldDescription:  {Type: field.TypeString, Column: template.FieldDescription},
-			template.FieldJsonconfig:   {Type: field.TypeJSON, Column: template.FieldJsonconfig},
-			template.FieldUischema:     {Type: field.TypeJSON, Column: template.FieldUischema},
+			template.FieldCreatedAt:             {Type: field.TypeTime, Column: template.FieldCreatedAt},
+			template.FieldUpdatedAt:             {Type: field.TypeTime, Column: template.FieldUpdatedAt},
+			template.FieldCreatedBy:             {Type: field.TypeString, Column: template.FieldCreatedBy},
+			template.FieldUpdatedBy:             {Type: field.TypeString, Column: template.FieldUpdatedBy},
+			template.FieldDeletedAt:             {Type: field.TypeTime, Column: template.FieldDeletedAt},
+			template.FieldDeletedBy:             {Type: field.TypeString, Column: template.FieldDeletedBy},
+			template.FieldMappingID:             {Type: field.TypeString, Column: template.FieldMappingID},
+			template.FieldTags:                  {Type: field.TypeJSON, Column: template.FieldTags},
+			template.FieldOwnerID:               {Type: field.TypeString, Column: template.FieldOwnerID},
+			template.FieldName:                  {Type: field.TypeString, Column: template.FieldName},
+			template.FieldTemplateType:          {Type: field.TypeEnum, Column: template.FieldTemplateType},
+			template.FieldDescription:           {Type: field.TypeString, Column: template.FieldDescription},
+			template.FieldJsonconfig:            {Type: field.TypeJSON, Column: template.FieldJsonconfig},
+			template.FieldUischema:              {Type: field.TypeJSON, Column: template.FieldUischema},
+			template.FieldVersion:               {Type: field.TypeInt, Column: template.FieldVersion},
+			template.FieldSourceTemplateID:      {Type: field.TypeString, Column: template.FieldSourceTemplateID},
+			template.FieldSourceTemplateVersion: {Type: field.TypeInt, Column: template.FieldSourceTemplateVersion},
 		},
 	}
-	graph.Nodes[49] = &sqlgraph.Node{
+	graph.Nodes[50] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   templatehistory.Table,
 			Columns: templatehistory.Columns,
@@ -1435,26 +1497,54 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "TemplateHistory",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			templatehistory.FieldHistoryTime:  {Type: field.TypeTime, Column: templatehistory.FieldHistoryTime},
-			templatehistory.FieldRef:          {Type: field.TypeString, Column: templatehistory.FieldRef},
-			templatehistory.FieldOperation:    {Type: field.TypeEnum, Column: templatehistory.FieldOperation},
-			templatehistory.FieldCreatedAt:    {Type: field.TypeTime, Column: templatehistory.FieldCreatedAt},
-			templatehistory.FieldUpdatedAt:    {Type: field.TypeTime, Column: templatehistory.FieldUpdatedAt},
-			templatehistory.FieldCreatedBy:    {Type: field.TypeString, Column: templatehistory.FieldCreatedBy},
-			templatehistory.FieldUpdatedBy:    {Type: field.TypeString, Column: templatehistory.FieldUpdatedBy},
-			templatehistory.FieldDeletedAt:    {Type: field.TypeTime, Column: templatehistory.FieldDeletedAt},
-			templatehistory.FieldDeletedBy:    {Type: field.TypeString, Column: templatehistory.FieldDeletedBy},
-			templatehistory.FieldMappingID:    {Type: field.TypeString, Column: templatehistory.FieldMappingID},
-			templatehistory.FieldTags:         {Type: field.TypeJSON, Column: templatehistory.FieldTags},
-			templatehistory.FieldOwnerID:      {Type: field.TypeString, Column: templatehistory.FieldOwnerID},
-			templatehistory.FieldName:         {Type: field.TypeString, Column: templatehistory.FieldName},
-			templatehistory.FieldTemplateType: {Type: field.TypeEnum, Column: templatehistory.FieldTemplateType},
-			templatehistory.FieldDescription:  {Type: field.TypeString, Column: templatehistory.FieldDescription},
-			templatehistory.FieldJsonconfig:   {Type: field.TypeJSON, Column: templatehistory.FieldJsonconfig},
-			templatehistory.FieldUischema:     {Type: field.TypeJSON, Column: templatehistory.FieldUischema},
+			templatehistory.FieldHistoryTime:           {Type: field.TypeTime, Column: templatehistory.FieldHistoryTime},
+			templatehistory.FieldRef:                   {Type: field.TypeString, Column: templatehistory.FieldRef},
+			templatehistory.FieldOperation:             {Type: field.TypeEnum, Column: templatehistory.FieldOperation},
+			templatehistory.FieldCreatedAt:             {Type: field.TypeTime, Column: templatehistory.FieldCreatedAt},
+			templatehistory.FieldUpdatedAt:             {Type: field.TypeTime, Column: templatehistory.FieldUpdatedAt},
+			templatehistory.FieldCreatedBy:             {Type: field.TypeString, Column: templatehistory.FieldCreatedBy},
+			templatehistory.FieldUpdatedBy:             {Type: field.TypeString, Column: templatehistory.FieldUpdatedBy},
+			templatehistory.FieldDeletedAt:             {Type: field.TypeTime, Column: templatehistory.FieldDeletedAt},
+			templatehistory.FieldDeletedBy:             {Type: field.TypeString, Column: templatehistory.FieldDeletedBy},
+			templatehistory.FieldMappingID:             {Type: field.TypeString, Column: templatehistory.FieldMappingID},
+			templatehistory.FieldTags:                  {Type: field.TypeJSON, Column: templatehistory.FieldTags},
+			templatehistory.FieldOwnerID:               {Type: field.TypeString, Column: templatehistory.FieldOwnerID},
+			templatehistory.FieldName:                  {Type: field.TypeString, Column: templatehistory.FieldName},
+			templatehistory.FieldTemplateType:          {Type: field.TypeEnum, Column: templatehistory.FieldTemplateType},
+			templatehistory.FieldDescription:           {Type: field.TypeString, Column: templatehistory.FieldDescription},
+			templatehistory.FieldJsonconfig:            {Type: field.TypeJSON, Column: templatehistory.FieldJsonconfig},
+			templatehistory.FieldUischema:              {Type: field.TypeJSON, Column: templatehistory.FieldUischema},
+			templatehistory.FieldVersion:               {Type: field.TypeInt, Column: templatehistory.FieldVersion},
+			templatehistory.FieldSourceTemplateID:      {Type: field.TypeString, Column: templatehistory.FieldSourceTemplateID},
+			templatehistory.FieldSourceTemplateVersion: {Type: field.TypeInt, Column: templatehistory.FieldSourceTemplateVersion},
 		},
 	}
-	graph.Nodes[50] = &sqlgraph.Node{
+	graph.Nodes[51] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   templateversion.Table,
+			Columns: templateversion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: templateversion.FieldID,
+			},
+		},
+		Type: "TemplateVersion",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			templateversion.FieldCreatedAt:  {Type: field.TypeTime, Column: templateversion.FieldCreatedAt},
+			templateversion.FieldUpdatedAt:  {Type: field.TypeTime, Column: templateversion.FieldUpdatedAt},
+			templateversion.FieldCreatedBy:  {Type: field.TypeString, Column: templateversion.FieldCreatedBy},
+			templateversion.FieldUpdatedBy:  {Type: field.TypeString, Column: templateversion.FieldUpdatedBy},
+			templateversion.FieldDeletedAt:  {Type: field.TypeTime, Column: templateversion.FieldDeletedAt},
+			templateversion.FieldDeletedBy:  {Type: field.TypeString, Column: templateversion.FieldDeletedBy},
+			templateversion.FieldMappingID:  {Type: field.TypeString, Column: templateversion.FieldMappingID},
+			templateversion.FieldOwnerID:    {Type: field.TypeString, Column: templateversion.FieldOwnerID},
+			templateversion.FieldTemplateID: {Type: field.TypeString, Column: templateversion.FieldTemplateID},
+			templateversion.FieldVersion:    {Type: field.TypeInt, Column: templateversion.FieldVersion},
+			templateversion.FieldJsonconfig: {Type: field.TypeJSON, Column: templateversion.FieldJsonconfig},
+			templateversion.FieldUischema:   {Type: field.TypeJSON, Column: templateversion.FieldUischema},
+		},
+	}
+	graph.Nodes[52] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -1487,7 +1577,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldRole:            {Type: field.TypeEnum, Column: user.FieldRole},
 		},
 	}
-	graph.Nodes[51] = &sqlgraph.Node{
+	graph.Nodes[53] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userhistory.Table,
 			Columns: userhistory.Columns,
@@ -1523,7 +1613,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			userhistory.FieldRole:            {Type: field.TypeEnum, Column: userhistory.FieldRole},
 		},
 	}
-	graph.Nodes[52] = &sqlgraph.Node{
+	graph.Nodes[54] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   usersetting.Table,
 			Columns: usersetting.Columns,
@@ -1534,26 +1624,28 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "UserSetting",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			usersetting.FieldCreatedAt:         {Type: field.TypeTime, Column: usersetting.FieldCreatedAt},
-			usersetting.FieldUpdatedAt:         {Type: field.TypeTime, Column: usersetting.FieldUpdatedAt},
-			usersetting.FieldCreatedBy:         {Type: field.TypeString, Column: usersetting.FieldCreatedBy},
-			usersetting.FieldUpdatedBy:         {Type: field.TypeString, Column: usersetting.FieldUpdatedBy},
-			usersetting.FieldMappingID:         {Type: field.TypeString, Column: usersetting.FieldMappingID},
-			usersetting.FieldTags:              {Type: field.TypeJSON, Column: usersetting.FieldTags},
-			usersetting.FieldDeletedAt:         {Type: field.TypeTime, Column: usersetting.FieldDeletedAt},
-			usersetting.FieldDeletedBy:         {Type: field.TypeString, Column: usersetting.FieldDeletedBy},
-			usersetting.FieldUserID:            {Type: field.TypeString, Column: usersetting.FieldUserID},
-			usersetting.FieldLocked:            {Type: field.TypeBool, Column: usersetting.FieldLocked},
-			usersetting.FieldSilencedAt:        {Type: field.TypeTime, Column: usersetting.FieldSilencedAt},
-			usersetting.FieldSuspendedAt:       {Type: field.TypeTime, Column: usersetting.FieldSuspendedAt},
-			usersetting.FieldStatus:            {Type: field.TypeEnum, Column: usersetting.FieldStatus},
-			usersetting.FieldEmailConfirmed:    {Type: field.TypeBool, Column: usersetting.FieldEmailConfirmed},
-			usersetting.FieldIsWebauthnAllowed: {Type: field.TypeBool, Column: usersetting.FieldIsWebauthnAllowed},
-			usersetting.FieldIsTfaEnabled:      {Type: field.TypeBool, Column: usersetting.FieldIsTfaEnabled},
-			usersetting.FieldPhoneNumber:       {Type: field.TypeString, Column: usersetting.FieldPhoneNumber},
+			usersetting.FieldCreatedAt:           {Type: field.TypeTime, Column: usersetting.FieldCreatedAt},
+			usersetting.FieldUpdatedAt:           {Type: field.TypeTime, Column: usersetting.FieldUpdatedAt},
+			usersetting.FieldCreatedBy:           {Type: field.TypeString, Column: usersetting.FieldCreatedBy},
+			usersetting.FieldUpdatedBy:           {Type: field.TypeString, Column: usersetting.FieldUpdatedBy},
+			usersetting.FieldMappingID:           {Type: field.TypeString, Column: usersetting.FieldMappingID},
+			usersetting.FieldTags:                {Type: field.TypeJSON, Column: usersetting.FieldTags},
+			usersetting.FieldDeletedAt:           {Type: field.TypeTime, Column: usersetting.FieldDeletedAt},
+			usersetting.FieldDeletedBy:           {Type: field.TypeString, Column: usersetting.FieldDeletedBy},
+			usersetting.FieldUserID:              {Type: field.TypeString, Column: usersetting.FieldUserID},
+			usersetting.FieldLocked:              {Type: field.TypeBool, Column: usersetting.FieldLocked},
+			usersetting.FieldLockedUntil:         {Type: field.TypeTime, Column: usersetting.FieldLockedUntil},
+			usersetting.FieldFailedLoginAttempts: {Type: field.TypeInt, Column: usersetting.FieldFailedLoginAttempts},
+			usersetting.FieldSilencedAt:          {Type: field.TypeTime, Column: usersetting.FieldSilencedAt},
+			usersetting.FieldSuspendedAt:         {Type: field.TypeTime, Column: usersetting.FieldSuspendedAt},
+			usersetting.FieldStatus:              {Type: field.TypeEnum, Column: usersetting.FieldStatus},
+			usersetting.FieldEmailConfirmed:      {Type: field.TypeBool, Column: usersetting.FieldEmailConfirmed},
+			usersetting.FieldIsWebauthnAllowed:   {Type: field.TypeBool, Column: usersetting.FieldIsWebauthnAllowed},
+			usersetting.FieldIsTfaEnabled:        {Type: field.TypeBool, Column: usersetting.FieldIsTfaEnabled},
+			usersetting.FieldPhoneNumber:         {Type: field.TypeString, Column: usersetting.FieldPhoneNumber},
 		},
 	}
-	graph.Nodes[53] = &sqlgraph.Node{
+	graph.Nodes[55] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   usersettinghistory.Table,
 			Columns: usersettinghistory.Columns,
@@ -1564,29 +1656,31 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "UserSettingHistory",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			usersettinghistory.FieldHistoryTime:       {Type: field.TypeTime, Column: usersettinghistory.FieldHistoryTime},
-			usersettinghistory.FieldRef:               {Type: field.TypeString, Column: usersettinghistory.FieldRef},
-			usersettinghistory.FieldOperation:         {Type: field.TypeEnum, Column: usersettinghistory.FieldOperation},
-			usersettinghistory.FieldCreatedAt:         {Type: field.TypeTime, Column: usersettinghistory.FieldCreatedAt},
-			usersettinghistory.FieldUpdatedAt:         {Type: field.TypeTime, Column: usersettinghistory.FieldUpdatedAt},
-			usersettinghistory.FieldCreatedBy:         {Type: field.TypeString, Column: usersettinghistory.FieldCreatedBy},
-			usersettinghistory.FieldUpdatedBy:         {Type: field.TypeString, Column: usersettinghistory.FieldUpdatedBy},
-			usersettinghistory.FieldMappingID:         {Type: field.TypeString, Column: usersettinghistory.FieldMappingID},
-			usersettinghistory.FieldTags:              {Type: field.TypeJSON, Column: usersettinghistory.FieldTags},
-			usersettinghistory.FieldDeletedAt:         {Type: field.TypeTime, Column: usersettinghistory.FieldDeletedAt},
-			usersettinghistory.FieldDeletedBy:         {Type: field.TypeString, Column: usersettinghistory.FieldDeletedBy},
-			usersettinghistory.FieldUserID:            {Type: field.TypeString, Column: usersettinghistory.FieldUserID},
-			usersettinghistory.FieldLocked:            {Type: field.TypeBool, Column: usersettinghistory.FieldLocked},
-			usersettinghistory.FieldSilencedAt:        {Type: field.TypeTime, Column: usersettinghistory.FieldSilencedAt},
-			usersettinghistory.FieldSuspendedAt:       {Type: field.TypeTime, Column: usersettinghistory.FieldSuspendedAt},
-			usersettinghistory.FieldStatus:            {Type: field.TypeEnum, Column: usersettinghistory.FieldStatus},
-			usersettinghistory.FieldEmailConfirmed:    {Type: field.TypeBool, Column: usersettinghistory.FieldEmailConfirmed},
-			usersettinghistory.FieldIsWebauthnAllowed: {Type: field.TypeBool, Column: usersettinghistory.FieldIsWebauthnAllowed},
-			usersettinghistory.FieldIsTfaEnabled:      {Type: field.TypeBool, Column: usersettinghistory.FieldIsTfaEnabled},
-			usersettinghistory.FieldPhoneNumber:       {Type: field.TypeString, Column: usersettinghistory.FieldPhoneNumber},
+			usersettinghistory.FieldHistoryTime:         {Type: field.TypeTime, Column: usersettinghistory.FieldHistoryTime},
+			usersettinghistory.FieldRef:                 {Type: field.TypeString, Column: usersettinghistory.FieldRef},
+			usersettinghistory.FieldOperation:           {Type: field.TypeEnum, Column: usersettinghistory.FieldOperation},
+			usersettinghistory.FieldCreatedAt:           {Type: field.TypeTime, Column: usersettinghistory.FieldCreatedAt},
+			usersettinghistory.FieldUpdatedAt:           {Type: field.TypeTime, Column: usersettinghistory.FieldUpdatedAt},
+			usersettinghistory.FieldCreatedBy:           {Type: field.TypeString, Column: usersettinghistory.FieldCreatedBy},
+			usersettinghistory.FieldUpdatedBy:           {Type: field.TypeString, Column: usersettinghistory.FieldUpdatedBy},
+			usersettinghistory.FieldMappingID:           {Type: field.TypeString, Column: usersettinghistory.FieldMappingID},
+			usersettinghistory.FieldTags:                {Type: field.TypeJSON, Column: usersettinghistory.FieldTags},
+			usersettinghistory.FieldDeletedAt:           {Type: field.TypeTime, Column: usersettinghistory.FieldDeletedAt},
+			usersettinghistory.FieldDeletedBy:           {Type: field.TypeString, Column: usersettinghistory.FieldDeletedBy},
+			usersettinghistory.FieldUserID:              {Type: field.TypeString, Column: usersettinghistory.FieldUserID},
+			usersettinghistory.FieldLocked:              {Type: field.TypeBool, Column: usersettinghistory.FieldLocked},
+			usersettinghistory.FieldLockedUntil:         {Type: field.TypeTime, Column: usersettinghistory.FieldLockedUntil},
+			usersettinghistory.FieldFailedLoginAttempts: {Type: field.TypeInt, Column: usersettinghistory.FieldFailedLoginAttempts},
+			usersettinghistory.FieldSilencedAt:          {Type: field.TypeTime, Column: usersettinghistory.FieldSilencedAt},
+			usersettinghistory.FieldSuspendedAt:         {Type: field.TypeTime, Column: usersettinghistory.FieldSuspendedAt},
+			usersettinghistory.FieldStatus:              {Type: field.TypeEnum, Column: usersettinghistory.FieldStatus},
+			usersettinghistory.FieldEmailConfirmed:      {Type: field.TypeBool, Column: usersettinghistory.FieldEmailConfirmed},
+			usersettinghistory.FieldIsWebauthnAllowed:   {Type: field.TypeBool, Column: usersettinghistory.FieldIsWebauthnAllowed},
+			usersettinghistory.FieldIsTfaEnabled:        {Type: field.TypeBool, Column: usersettinghistory.FieldIsTfaEnabled},
+			usersettinghistory.FieldPhoneNumber:         {Type: field.TypeString, Column: usersettinghistory.FieldPhoneNumber},
 		},
 	}
-	graph.Nodes[54] = &sqlgraph.Node{
+	graph.Nodes[56] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   webauthn.Table,
 			Columns: webauthn.Columns,
@@ -1616,7 +1710,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			webauthn.FieldUserVerified:    {Type: field.TypeBool, Column: webauthn.FieldUserVerified},
 		},
 	}
-	graph.Nodes[55] = &sqlgraph.Node{
+	graph.Nodes[57] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   webhook.Table,
 			Columns: webhook.Columns,
@@ -1640,6 +1734,8 @@ var schemaGraph = func() *sqlgraph.Schema {
 			webhook.FieldDescription:    {Type: field.TypeString, Column: webhook.FieldDescription},
 			webhook.FieldDestinationURL: {Type: field.TypeString, Column: webhook.FieldDestinationURL},
 			webhook.FieldEnabled:        {Type: field.TypeBool, Column: webhook.FieldEnabled},
+			webhook.FieldEventTypes:     {Type: field.TypeJSON, Column: webhook.FieldEventTypes},
+			webhook.FieldObjectTypes:    {Type: field.TypeJSON, Column: webhook.FieldObjectTypes},
 			webhook.FieldCallback:       {Type: field.TypeString, Column: webhook.FieldCallback},
 			webhook.FieldExpiresAt:      {Type: field.TypeTime, Column: webhook.FieldExpiresAt},
 			webhook.FieldSecret:         {Type: field.TypeBytes, Column: webhook.FieldSecret},
@@ -1648,7 +1744,35 @@ var schemaGraph = func() *sqlgraph.Schema {
 			webhook.FieldLastResponse:   {Type: field.TypeString, Column: webhook.FieldLastResponse},
 		},
 	}
-	graph.Nodes[56] = &sqlgraph.Node{
+	graph.Nodes[58] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+		Type: "WebhookDelivery",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			webhookdelivery.FieldCreatedAt:      {Type: field.TypeTime, Column: webhookdelivery.FieldCreatedAt},
+			webhookdelivery.FieldUpdatedAt:      {Type: field.TypeTime, Column: webhookdelivery.FieldUpdatedAt},
+			webhookdelivery.FieldCreatedBy:      {Type: field.TypeString, Column: webhookdelivery.FieldCreatedBy},
+			webhookdelivery.FieldUpdatedBy:      {Type: field.TypeString, Column: webhookdelivery.FieldUpdatedBy},
+			webhookdelivery.FieldMappingID:      {Type: field.TypeString, Column: webhookdelivery.FieldMappingID},
+			webhookdelivery.FieldOwnerID:        {Type: field.TypeString, Column: webhookdelivery.FieldOwnerID},
+			webhookdelivery.FieldWebhookID:      {Type: field.TypeString, Column: webhookdelivery.FieldWebhookID},
+			webhookdelivery.FieldEventID:        {Type: field.TypeString, Column: webhookdelivery.FieldEventID},
+			webhookdelivery.FieldAttempt:        {Type: field.TypeInt, Column: webhookdelivery.FieldAttempt},
+			webhookdelivery.FieldRedelivery:     {Type: field.TypeBool, Column: webhookdelivery.FieldRedelivery},
+			webhookdelivery.FieldRequestBody:    {Type: field.TypeString, Column: webhookdelivery.FieldRequestBody},
+			webhookdelivery.FieldResponseStatus: {Type: field.TypeInt, Column: webhookdelivery.FieldResponseStatus},
+			webhookdelivery.FieldResponseBody:   {Type: field.TypeString, Column: webhookdelivery.FieldResponseBody},
+			webhookdelivery.FieldLatency:        {Type: field.TypeInt64, Column: webhookdelivery.FieldLatency},
+			webhookdelivery.FieldError:          {Type: field.TypeString, Column: webhookdelivery.FieldError},
+		},
+	}
+	graph.Nodes[59] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   webhookhistory.Table,
 			Columns: webhookhistory.Columns,
@@ -1675,6 +1799,8 @@ var schemaGraph = func() *sqlgraph.Schema {
 			webhookhistory.FieldDescription:    {Type: field.TypeString, Column: webhookhistory.FieldDescription},
 			webhookhistory.FieldDestinationURL: {Type: field.TypeString, Column: webhookhistory.FieldDestinationURL},
 			webhookhistory.FieldEnabled:        {Type: field.TypeBool, Column: webhookhistory.FieldEnabled},
+			webhookhistory.FieldEventTypes:     {Type: field.TypeJSON, Column: webhookhistory.FieldEventTypes},
+			webhookhistory.FieldObjectTypes:    {Type: field.TypeJSON, Column: webhookhistory.FieldObjectTypes},
 			webhookhistory.FieldCallback:       {Type: field.TypeString, Column: webhookhistory.FieldCallback},
 			webhookhistory.FieldExpiresAt:      {Type: field.TypeTime, Column: webhookhistory.FieldExpiresAt},
 			webhookhistory.FieldSecret:         {Type: field.TypeBytes, Column: webhookhistory.FieldSecret},
@@ -2211,6 +2337,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Event",
 		"Subscriber",
 	)
+	graph.MustAddE(
+		"webhook_deliveries",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.WebhookDeliveriesTable,
+			Columns: []string{event.WebhookDeliveriesColumn},
+			Bidi:    false,
+		},
+		"Event",
+		"WebhookDelivery",
+	)
 	graph.MustAddE(
 		"owner",
 		&sqlgraph.EdgeSpec{
@@ -2703,6 +2841,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Organization",
 		"Template",
 	)
+	graph.MustAddE(
+		"template_versions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TemplateVersionsTable,
+			Columns: []string{organization.TemplateVersionsColumn},
+			Bidi:    false,
+		},
+		"Organization",
+		"TemplateVersion",
+	)
 	graph.MustAddE(
 		"integrations",
 		&sqlgraph.EdgeSpec{
@@ -2847,6 +2997,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Organization",
 		"Webhook",
 	)
+	graph.MustAddE(
+		"webhook_deliveries",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhookDeliveriesTable,
+			Columns: []string{organization.WebhookDeliveriesColumn},
+			Bidi:    false,
+		},
+		"Organization",
+		"WebhookDelivery",
+	)
 	graph.MustAddE(
 		"events",
 		&sqlgraph.EdgeSpec{
@@ -3099,6 +3261,66 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Template",
 		"DocumentData",
 	)
+	graph.MustAddE(
+		"versions",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.VersionsTable,
+			Columns: []string{template.VersionsColumn},
+			Bidi:    false,
+		},
+		"Template",
+		"TemplateVersion",
+	)
+	graph.MustAddE(
+		"source_template",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   template.SourceTemplateTable,
+			Columns: []string{template.SourceTemplateColumn},
+			Bidi:    false,
+		},
+		"Template",
+		"Template",
+	)
+	graph.MustAddE(
+		"clones",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ClonesTable,
+			Columns: []string{template.ClonesColumn},
+			Bidi:    false,
+		},
+		"Template",
+		"Template",
+	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templateversion.OwnerTable,
+			Columns: []string{templateversion.OwnerColumn},
+			Bidi:    false,
+		},
+		"TemplateVersion",
+		"Organization",
+	)
+	graph.MustAddE(
+		"template",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templateversion.TemplateTable,
+			Columns: []string{templateversion.TemplateColumn},
+			Bidi:    false,
+		},
+		"TemplateVersion",
+		"Template",
+	)
 	graph.MustAddE(
 		"personal_access_tokens",
 		&sqlgraph.EdgeSpec{
@@ -3303,6 +3525,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Webhook",
 		"Event",
 	)
+	graph.MustAddE(
+		"deliveries",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+		},
+		"Webhook",
+		"WebhookDelivery",
+	)
 	graph.MustAddE(
 		"integrations",
 		&sqlgraph.EdgeSpec{
@@ -3315,6 +3549,42 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Webhook",
 		"Integration",
 	)
+	graph.MustAddE(
+		"owner",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.OwnerTable,
+			Columns: []string{webhookdelivery.OwnerColumn},
+			Bidi:    false,
+		},
+		"WebhookDelivery",
+		"Organization",
+	)
+	graph.MustAddE(
+		"webhook",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+		},
+		"WebhookDelivery",
+		"Webhook",
+	)
+	graph.MustAddE(
+		"event",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.EventTable,
+			Columns: []string{webhookdelivery.EventColumn},
+			Bidi:    false,
+		},
+		"WebhookDelivery",
+		"Event",
+	)
 	return graph
 }()
 
@@ -3826,6 +4096,11 @@ func (f *DocumentDataFilter) WhereTemplateID(p entql.StringP) {
 	f.Where(p.Field(documentdata.FieldTemplateID))
 }
 
+// WhereTemplateVersion applies the entql int predicate on the template_version field.
+func (f *DocumentDataFilter) WhereTemplateVersion(p entql.IntP) {
+	f.Where(p.Field(documentdata.FieldTemplateVersion))
+}
+
 // WhereData applies the entql json.RawMessage predicate on the data field.
 func (f *DocumentDataFilter) WhereData(p entql.BytesP) {
 	f.Where(p.Field(documentdata.FieldData))
@@ -3978,6 +4253,11 @@ func (f *DocumentDataHistoryFilter) WhereTemplateID(p entql.StringP) {
 	f.Where(p.Field(documentdatahistory.FieldTemplateID))
 }
 
+// WhereTemplateVersion applies the entql int predicate on the template_version field.
+func (f *DocumentDataHistoryFilter) WhereTemplateVersion(p entql.IntP) {
+	f.Where(p.Field(documentdatahistory.FieldTemplateVersion))
+}
+
 // WhereData applies the entql json.RawMessage predicate on the data field.
 func (f *DocumentDataHistoryFilter) WhereData(p entql.BytesP) {
 	f.Where(p.Field(documentdatahistory.FieldData))
@@ -5850,6 +6130,20 @@ func (f *EventFilter) WhereHasSubscriberWith(preds ...predicate.Subscriber) {
 	})))
 }
 
+// WhereHasWebhookDeliveries applies a predicate to check if query has an edge webhook_deliveries.
+func (f *EventFilter) WhereHasWebhookDeliveries() {
+	f.Where(entql.HasEdge("webhook_deliveries"))
+}
+
+// WhereHasWebhookDeliveriesWith applies a predicate to check if query has an edge webhook_deliveries with a given conditions (other predicates).
+func (f *EventFilter) WhereHasWebhookDeliveriesWith(preds ...predicate.WebhookDelivery) {
+	f.Where(entql.HasEdgeWith("webhook_deliveries", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (ehq *EventHistoryQuery) addPredicate(pred func(s *sql.Selector)) {
 	ehq.predicates = append(ehq.predicates, pred)
@@ -8399,6 +8693,11 @@ func (f *OauthProviderFilter) WhereInfoURL(p entql.StringP) {
 	f.Where(p.Field(oauthprovider.FieldInfoURL))
 }
 
+// WhereIssuer applies the entql string predicate on the issuer field.
+func (f *OauthProviderFilter) WhereIssuer(p entql.StringP) {
+	f.Where(p.Field(oauthprovider.FieldIssuer))
+}
+
 // WhereHasOwner applies a predicate to check if query has an edge owner.
 func (f *OauthProviderFilter) WhereHasOwner() {
 	f.Where(entql.HasEdge("owner"))
@@ -8558,6 +8857,11 @@ func (f *OauthProviderHistoryFilter) WhereInfoURL(p entql.StringP) {
 	f.Where(p.Field(oauthproviderhistory.FieldInfoURL))
 }
 
+// WhereIssuer applies the entql string predicate on the issuer field.
+func (f *OauthProviderHistoryFilter) WhereIssuer(p entql.StringP) {
+	f.Where(p.Field(oauthproviderhistory.FieldIssuer))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (oattq *OhAuthTooTokenQuery) addPredicate(pred func(s *sql.Selector)) {
 	oattq.predicates = append(oattq.predicates, pred)
@@ -8786,6 +9090,21 @@ func (f *OrgMembershipFilter) WhereUserID(p entql.StringP) {
 	f.Where(p.Field(orgmembership.FieldUserID))
 }
 
+// WhereSSOIssuer applies the entql string predicate on the sso_issuer field.
+func (f *OrgMembershipFilter) WhereSSOIssuer(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldSSOIssuer))
+}
+
+// WhereSSOSubject applies the entql string predicate on the sso_subject field.
+func (f *OrgMembershipFilter) WhereSSOSubject(p entql.StringP) {
+	f.Where(p.Field(orgmembership.FieldSSOSubject))
+}
+
+// WhereUserProvisioned applies the entql bool predicate on the user_provisioned field.
+func (f *OrgMembershipFilter) WhereUserProvisioned(p entql.BoolP) {
+	f.Where(p.Field(orgmembership.FieldUserProvisioned))
+}
+
 // WhereHasOrganization applies a predicate to check if query has an edge organization.
 func (f *OrgMembershipFilter) WhereHasOrganization() {
 	f.Where(entql.HasEdge("organization"))
@@ -8933,6 +9252,21 @@ func (f *OrgMembershipHistoryFilter) WhereUserID(p entql.StringP) {
 	f.Where(p.Field(orgmembershiphistory.FieldUserID))
 }
 
+// WhereSSOIssuer applies the entql string predicate on the sso_issuer field.
+func (f *OrgMembershipHistoryFilter) WhereSSOIssuer(p entql.StringP) {
+	f.Where(p.Field(orgmembershiphistory.FieldSSOIssuer))
+}
+
+// WhereSSOSubject applies the entql string predicate on the sso_subject field.
+func (f *OrgMembershipHistoryFilter) WhereSSOSubject(p entql.StringP) {
+	f.Where(p.Field(orgmembershiphistory.FieldSSOSubject))
+}
+
+// WhereUserProvisioned applies the entql bool predicate on the user_provisioned field.
+func (f *OrgMembershipHistoryFilter) WhereUserProvisioned(p entql.BoolP) {
+	f.Where(p.Field(orgmembershiphistory.FieldUserProvisioned))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (oq *OrganizationQuery) addPredicate(pred func(s *sql.Selector)) {
 	oq.predicates = append(oq.predicates, pred)
@@ -9104,6 +9438,20 @@ func (f *OrganizationFilter) WhereHasTemplatesWith(preds ...predicate.Template)
 	})))
 }
 
+// WhereHasTemplateVersions applies a predicate to check if query has an edge template_versions.
+func (f *OrganizationFilter) WhereHasTemplateVersions() {
+	f.Where(entql.HasEdge("template_versions"))
+}
+
+// WhereHasTemplateVersionsWith applies a predicate to check if query has an edge template_versions with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasTemplateVersionsWith(preds ...predicate.TemplateVersion) {
+	f.Where(entql.HasEdgeWith("template_versions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // WhereHasIntegrations applies a predicate to check if query has an edge integrations.
 func (f *OrganizationFilter) WhereHasIntegrations() {
 	f.Where(entql.HasEdge("integrations"))
@@ -9272,6 +9620,20 @@ func (f *OrganizationFilter) WhereHasWebhooksWith(preds ...predicate.Webhook) {
 	})))
 }
 
+// WhereHasWebhookDeliveries applies a predicate to check if query has an edge webhook_deliveries.
+func (f *OrganizationFilter) WhereHasWebhookDeliveries() {
+	f.Where(entql.HasEdge("webhook_deliveries"))
+}
+
+// WhereHasWebhookDeliveriesWith applies a predicate to check if query has an edge webhook_deliveries with a given conditions (other predicates).
+func (f *OrganizationFilter) WhereHasWebhookDeliveriesWith(preds ...predicate.WebhookDelivery) {
+	f.Where(entql.HasEdgeWith("webhook_deliveries", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // WhereHasEvents applies a predicate to check if query has an edge events.
 func (f *OrganizationFilter) WhereHasEvents() {
 	f.Where(entql.HasEdge("events"))
@@ -9676,6 +10038,41 @@ func (f *OrganizationSettingFilter) WhereOrganizationID(p entql.StringP) {
 	f.Where(p.Field(organizationsetting.FieldOrganizationID))
 }
 
+// WhereSSOEnforced applies the entql bool predicate on the sso_enforced field.
+func (f *OrganizationSettingFilter) WhereSSOEnforced(p entql.BoolP) {
+	f.Where(p.Field(organizationsetting.FieldSSOEnforced))
+}
+
+// WhereSamlMetadataURL applies the entql string predicate on the saml_metadata_url field.
+func (f *OrganizationSettingFilter) WhereSamlMetadataURL(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldSamlMetadataURL))
+}
+
+// WhereSamlMetadata applies the entql string predicate on the saml_metadata field.
+func (f *OrganizationSettingFilter) WhereSamlMetadata(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldSamlMetadata))
+}
+
+// WhereSamlAttributeMapping applies the entql json.RawMessage predicate on the saml_attribute_mapping field.
+func (f *OrganizationSettingFilter) WhereSamlAttributeMapping(p entql.BytesP) {
+	f.Where(p.Field(organizationsetting.FieldSamlAttributeMapping))
+}
+
+// WhereDomainVerificationToken applies the entql string predicate on the domain_verification_token field.
+func (f *OrganizationSettingFilter) WhereDomainVerificationToken(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldDomainVerificationToken))
+}
+
+// WhereVerifiedDomains applies the entql json.RawMessage predicate on the verified_domains field.
+func (f *OrganizationSettingFilter) WhereVerifiedDomains(p entql.BytesP) {
+	f.Where(p.Field(organizationsetting.FieldVerifiedDomains))
+}
+
+// WhereJoinPolicy applies the entql string predicate on the join_policy field.
+func (f *OrganizationSettingFilter) WhereJoinPolicy(p entql.StringP) {
+	f.Where(p.Field(organizationsetting.FieldJoinPolicy))
+}
+
 // WhereHasOrganization applies a predicate to check if query has an edge organization.
 func (f *OrganizationSettingFilter) WhereHasOrganization() {
 	f.Where(entql.HasEdge("organization"))
@@ -9825,34 +10222,69 @@ func (f *OrganizationSettingHistoryFilter) WhereOrganizationID(p entql.StringP)
 	f.Where(p.Field(organizationsettinghistory.FieldOrganizationID))
 }
 
+// WhereSSOEnforced applies the entql bool predicate on the sso_enforced field.
+func (f *OrganizationSettingHistoryFilter) WhereSSOEnforced(p entql.BoolP) {
+	f.Where(p.Field(organizationsettinghistory.FieldSSOEnforced))
+}
+
+// WhereSamlMetadataURL applies the entql string predicate on the saml_metadata_url field.
+func (f *OrganizationSettingHistoryFilter) WhereSamlMetadataURL(p entql.StringP) {
+	f.Where(p.Field(organizationsettinghistory.FieldSamlMetadataURL))
+}
+
+// WhereSamlMetadata applies the entql string predicate on the saml_metadata field.
+func (f *OrganizationSettingHistoryFilter) WhereSamlMetadata(p entql.StringP) {
+	f.Where(p.Field(organizationsettinghistory.FieldSamlMetadata))
+}
+
+// WhereSamlAttributeMapping applies the entql json.RawMessage predicate on the saml_attribute_mapping field.
+func (f *OrganizationSettingHistoryFilter) WhereSamlAttributeMapping(p entql.BytesP) {
+	f.Where(p.Field(organizationsettinghistory.FieldSamlAttributeMapping))
+}
+
+// WhereDomainVerificationToken applies the entql string predicate on the domain_verification_token field.
+func (f *OrganizationSettingHistoryFilter) WhereDomainVerificationToken(p entql.StringP) {
+	f.Where(p.Field(organizationsettinghistory.FieldDomainVerificationToken))
+}
+
+// WhereVerifiedDomains applies the entql json.RawMessage predicate on the verified_domains field.
+func (f *OrganizationSettingHistoryFilter) WhereVerifiedDomains(p entql.BytesP) {
+	f.Where(p.Field(organizationsettinghistory.FieldVerifiedDomains))
+}
+
+// WhereJoinPolicy applies the entql string predicate on the join_policy field.
+func (f *OrganizationSettingHistoryFilter) WhereJoinPolicy(p entql.StringP) {
+	f.Where(p.Field(organizationsettinghistory.FieldJoinPolicy))
+}
+
 // addPredicate implements the predicateAdder interface.
-func (prtq *PasswordResetTokenQuery) addPredicate(pred func(s *sql.Selector)) {
-	prtq.predicates = append(prtq.predicates, pred)
+func (oeq *OutboxEventQuery) addPredicate(pred func(s *sql.Selector)) {
+	oeq.predicates = append(oeq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the PasswordResetTokenQuery builder.
-func (prtq *PasswordResetTokenQuery) Filter() *PasswordResetTokenFilter {
-	return &PasswordResetTokenFilter{config: prtq.config, predicateAdder: prtq}
+// Filter returns a Filter implementation to apply filters on the OutboxEventQuery builder.
+func (oeq *OutboxEventQuery) Filter() *OutboxEventFilter {
+	return &OutboxEventFilter{config: oeq.config, predicateAdder: oeq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *PasswordResetTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *OutboxEventMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the PasswordResetTokenMutation builder.
-func (m *PasswordResetTokenMutation) Filter() *PasswordResetTokenFilter {
-	return &PasswordResetTokenFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the OutboxEventMutation builder.
+func (m *OutboxEventMutation) Filter() *OutboxEventFilter {
+	return &OutboxEventFilter{config: m.config, predicateAdder: m}
 }
 
-// PasswordResetTokenFilter provides a generic filtering capability at runtime for PasswordResetTokenQuery.
-type PasswordResetTokenFilter struct {
+// OutboxEventFilter provides a generic filtering capability at runtime for OutboxEventQuery.
+type OutboxEventFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *PasswordResetTokenFilter) Where(p entql.P) {
+func (f *OutboxEventFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[44].Type, p, s); err != nil {
 			s.AddError(err)
@@ -9861,47 +10293,172 @@ func (f *PasswordResetTokenFilter) Where(p entql.P) {
 }
 
 // WhereID applies the entql string predicate on the id field.
-func (f *PasswordResetTokenFilter) WhereID(p entql.StringP) {
-	f.Where(p.Field(passwordresettoken.FieldID))
+func (f *OutboxEventFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(outboxevent.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *PasswordResetTokenFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(passwordresettoken.FieldCreatedAt))
+func (f *OutboxEventFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(outboxevent.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *PasswordResetTokenFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(passwordresettoken.FieldUpdatedAt))
+func (f *OutboxEventFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(outboxevent.FieldUpdatedAt))
 }
 
 // WhereCreatedBy applies the entql string predicate on the created_by field.
-func (f *PasswordResetTokenFilter) WhereCreatedBy(p entql.StringP) {
-	f.Where(p.Field(passwordresettoken.FieldCreatedBy))
+func (f *OutboxEventFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(outboxevent.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql string predicate on the updated_by field.
-func (f *PasswordResetTokenFilter) WhereUpdatedBy(p entql.StringP) {
-	f.Where(p.Field(passwordresettoken.FieldUpdatedBy))
+func (f *OutboxEventFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(outboxevent.FieldUpdatedBy))
 }
 
 // WhereMappingID applies the entql string predicate on the mapping_id field.
-func (f *PasswordResetTokenFilter) WhereMappingID(p entql.StringP) {
-	f.Where(p.Field(passwordresettoken.FieldMappingID))
+func (f *OutboxEventFilter) WhereMappingID(p entql.StringP) {
+	f.Where(p.Field(outboxevent.FieldMappingID))
 }
 
-// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *PasswordResetTokenFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(passwordresettoken.FieldDeletedAt))
+// WhereTopic applies the entql string predicate on the topic field.
+func (f *OutboxEventFilter) WhereTopic(p entql.StringP) {
+	f.Where(p.Field(outboxevent.FieldTopic))
 }
 
-// WhereDeletedBy applies the entql string predicate on the deleted_by field.
-func (f *PasswordResetTokenFilter) WhereDeletedBy(p entql.StringP) {
-	f.Where(p.Field(passwordresettoken.FieldDeletedBy))
+// WhereAggregateType applies the entql string predicate on the aggregate_type field.
+func (f *OutboxEventFilter) WhereAggregateType(p entql.StringP) {
+	f.Where(p.Field(outboxevent.FieldAggregateType))
 }
 
-// WhereOwnerID applies the entql string predicate on the owner_id field.
-func (f *PasswordResetTokenFilter) WhereOwnerID(p entql.StringP) {
+// WhereAggregateID applies the entql string predicate on the aggregate_id field.
+func (f *OutboxEventFilter) WhereAggregateID(p entql.StringP) {
+	f.Where(p.Field(outboxevent.FieldAggregateID))
+}
+
+// WhereOrganizationID applies the entql string predicate on the organization_id field.
+func (f *OutboxEventFilter) WhereOrganizationID(p entql.StringP) {
+	f.Where(p.Field(outboxevent.FieldOrganizationID))
+}
+
+// WherePayload applies the entql json.RawMessage predicate on the payload field.
+func (f *OutboxEventFilter) WherePayload(p entql.BytesP) {
+	f.Where(p.Field(outboxevent.FieldPayload))
+}
+
+// WhereAttempts applies the entql int predicate on the attempts field.
+func (f *OutboxEventFilter) WhereAttempts(p entql.IntP) {
+	f.Where(p.Field(outboxevent.FieldAttempts))
+}
+
+// WhereAvailableAt applies the entql time.Time predicate on the available_at field.
+func (f *OutboxEventFilter) WhereAvailableAt(p entql.TimeP) {
+	f.Where(p.Field(outboxevent.FieldAvailableAt))
+}
+
+// WherePublishedAt applies the entql time.Time predicate on the published_at field.
+func (f *OutboxEventFilter) WherePublishedAt(p entql.TimeP) {
+	f.Where(p.Field(outboxevent.FieldPublishedAt))
+}
+
+// WherePublishedTo applies the entql json.RawMessage predicate on the published_to field.
+func (f *OutboxEventFilter) WherePublishedTo(p entql.BytesP) {
+	f.Where(p.Field(outboxevent.FieldPublishedTo))
+}
+
+// WhereLastError applies the entql string predicate on the last_error field.
+func (f *OutboxEventFilter) WhereLastError(p entql.StringP) {
+	f.Where(p.Field(outboxevent.FieldLastError))
+}
+
+// WhereClaimID applies the entql string predicate on the claim_id field.
+func (f *OutboxEventFilter) WhereClaimID(p entql.StringP) {
+	f.Where(p.Field(outboxevent.FieldClaimID))
+}
+
+// WhereClaimedUntil applies the entql time.Time predicate on the claimed_until field.
+func (f *OutboxEventFilter) WhereClaimedUntil(p entql.TimeP) {
+	f.Where(p.Field(outboxevent.FieldClaimedUntil))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (prtq *PasswordResetTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	prtq.predicates = append(prtq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PasswordResetTokenQuery builder.
+func (prtq *PasswordResetTokenQuery) Filter() *PasswordResetTokenFilter {
+	return &PasswordResetTokenFilter{config: prtq.config, predicateAdder: prtq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PasswordResetTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PasswordResetTokenMutation builder.
+func (m *PasswordResetTokenMutation) Filter() *PasswordResetTokenFilter {
+	return &PasswordResetTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// PasswordResetTokenFilter provides a generic filtering capability at runtime for PasswordResetTokenQuery.
+type PasswordResetTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PasswordResetTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[45].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *PasswordResetTokenFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(passwordresettoken.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PasswordResetTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordresettoken.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *PasswordResetTokenFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordresettoken.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *PasswordResetTokenFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(passwordresettoken.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *PasswordResetTokenFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(passwordresettoken.FieldUpdatedBy))
+}
+
+// WhereMappingID applies the entql string predicate on the mapping_id field.
+func (f *PasswordResetTokenFilter) WhereMappingID(p entql.StringP) {
+	f.Where(p.Field(passwordresettoken.FieldMappingID))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *PasswordResetTokenFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(passwordresettoken.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *PasswordResetTokenFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(passwordresettoken.FieldDeletedBy))
+}
+
+// WhereOwnerID applies the entql string predicate on the owner_id field.
+func (f *PasswordResetTokenFilter) WhereOwnerID(p entql.StringP) {
 	f.Where(p.Field(passwordresettoken.FieldOwnerID))
 }
 
@@ -9968,7 +10525,7 @@ type PersonalAccessTokenFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PersonalAccessTokenFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[45].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[46].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -10125,7 +10682,7 @@ type SubscriberFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *SubscriberFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[46].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[47].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -10278,7 +10835,7 @@ type TFASettingFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TFASettingFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[47].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[48].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -10364,6 +10921,16 @@ func (f *TFASettingFilter) WhereTotpAllowed(p entql.BoolP) {
 	f.Where(p.Field(tfasetting.FieldTotpAllowed))
 }
 
+// WhereChallengeID applies the entql string predicate on the challenge_id field.
+func (f *TFASettingFilter) WhereChallengeID(p entql.StringP) {
+	f.Where(p.Field(tfasetting.FieldChallengeID))
+}
+
+// WhereChallengeAttempts applies the entql int predicate on the challenge_attempts field.
+func (f *TFASettingFilter) WhereChallengeAttempts(p entql.IntP) {
+	f.Where(p.Field(tfasetting.FieldChallengeAttempts))
+}
+
 // WhereHasOwner applies a predicate to check if query has an edge owner.
 func (f *TFASettingFilter) WhereHasOwner() {
 	f.Where(entql.HasEdge("owner"))
@@ -10407,7 +10974,7 @@ type TemplateFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TemplateFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[48].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[49].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -10488,6 +11055,21 @@ func (f *TemplateFilter) WhereUischema(p entql.BytesP) {
 	f.Where(p.Field(template.FieldUischema))
 }
 
+// WhereVersion applies the entql int predicate on the version field.
+func (f *TemplateFilter) WhereVersion(p entql.IntP) {
+	f.Where(p.Field(template.FieldVersion))
+}
+
+// WhereSourceTemplateID applies the entql string predicate on the source_template_id field.
+func (f *TemplateFilter) WhereSourceTemplateID(p entql.StringP) {
+	f.Where(p.Field(template.FieldSourceTemplateID))
+}
+
+// WhereSourceTemplateVersion applies the entql int predicate on the source_template_version field.
+func (f *TemplateFilter) WhereSourceTemplateVersion(p entql.IntP) {
+	f.Where(p.Field(template.FieldSourceTemplateVersion))
+}
+
 // WhereHasOwner applies a predicate to check if query has an edge owner.
 func (f *TemplateFilter) WhereHasOwner() {
 	f.Where(entql.HasEdge("owner"))
@@ -10516,6 +11098,48 @@ func (f *TemplateFilter) WhereHasDocumentsWith(preds ...predicate.DocumentData)
 	})))
 }
 
+// WhereHasVersions applies a predicate to check if query has an edge versions.
+func (f *TemplateFilter) WhereHasVersions() {
+	f.Where(entql.HasEdge("versions"))
+}
+
+// WhereHasVersionsWith applies a predicate to check if query has an edge versions with a given conditions (other predicates).
+func (f *TemplateFilter) WhereHasVersionsWith(preds ...predicate.TemplateVersion) {
+	f.Where(entql.HasEdgeWith("versions", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasSourceTemplate applies a predicate to check if query has an edge source_template.
+func (f *TemplateFilter) WhereHasSourceTemplate() {
+	f.Where(entql.HasEdge("source_template"))
+}
+
+// WhereHasSourceTemplateWith applies a predicate to check if query has an edge source_template with a given conditions (other predicates).
+func (f *TemplateFilter) WhereHasSourceTemplateWith(preds ...predicate.Template) {
+	f.Where(entql.HasEdgeWith("source_template", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasClones applies a predicate to check if query has an edge clones.
+func (f *TemplateFilter) WhereHasClones() {
+	f.Where(entql.HasEdge("clones"))
+}
+
+// WhereHasClonesWith applies a predicate to check if query has an edge clones with a given conditions (other predicates).
+func (f *TemplateFilter) WhereHasClonesWith(preds ...predicate.Template) {
+	f.Where(entql.HasEdgeWith("clones", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (thq *TemplateHistoryQuery) addPredicate(pred func(s *sql.Selector)) {
 	thq.predicates = append(thq.predicates, pred)
@@ -10545,7 +11169,7 @@ type TemplateHistoryFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TemplateHistoryFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[49].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[50].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -10641,6 +11265,149 @@ func (f *TemplateHistoryFilter) WhereUischema(p entql.BytesP) {
 	f.Where(p.Field(templatehistory.FieldUischema))
 }
 
+// WhereVersion applies the entql int predicate on the version field.
+func (f *TemplateHistoryFilter) WhereVersion(p entql.IntP) {
+	f.Where(p.Field(templatehistory.FieldVersion))
+}
+
+// WhereSourceTemplateID applies the entql string predicate on the source_template_id field.
+func (f *TemplateHistoryFilter) WhereSourceTemplateID(p entql.StringP) {
+	f.Where(p.Field(templatehistory.FieldSourceTemplateID))
+}
+
+// WhereSourceTemplateVersion applies the entql int predicate on the source_template_version field.
+func (f *TemplateHistoryFilter) WhereSourceTemplateVersion(p entql.IntP) {
+	f.Where(p.Field(templatehistory.FieldSourceTemplateVersion))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (tvq *TemplateVersionQuery) addPredicate(pred func(s *sql.Selector)) {
+	tvq.predicates = append(tvq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TemplateVersionQuery builder.
+func (tvq *TemplateVersionQuery) Filter() *TemplateVersionFilter {
+	return &TemplateVersionFilter{config: tvq.config, predicateAdder: tvq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TemplateVersionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TemplateVersionMutation builder.
+func (m *TemplateVersionMutation) Filter() *TemplateVersionFilter {
+	return &TemplateVersionFilter{config: m.config, predicateAdder: m}
+}
+
+// TemplateVersionFilter provides a generic filtering capability at runtime for TemplateVersionQuery.
+type TemplateVersionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TemplateVersionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[51].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *TemplateVersionFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(templateversion.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TemplateVersionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(templateversion.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TemplateVersionFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(templateversion.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *TemplateVersionFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(templateversion.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *TemplateVersionFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(templateversion.FieldUpdatedBy))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *TemplateVersionFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(templateversion.FieldDeletedAt))
+}
+
+// WhereDeletedBy applies the entql string predicate on the deleted_by field.
+func (f *TemplateVersionFilter) WhereDeletedBy(p entql.StringP) {
+	f.Where(p.Field(templateversion.FieldDeletedBy))
+}
+
+// WhereMappingID applies the entql string predicate on the mapping_id field.
+func (f *TemplateVersionFilter) WhereMappingID(p entql.StringP) {
+	f.Where(p.Field(templateversion.FieldMappingID))
+}
+
+// WhereOwnerID applies the entql string predicate on the owner_id field.
+func (f *TemplateVersionFilter) WhereOwnerID(p entql.StringP) {
+	f.Where(p.Field(templateversion.FieldOwnerID))
+}
+
+// WhereTemplateID applies the entql string predicate on the template_id field.
+func (f *TemplateVersionFilter) WhereTemplateID(p entql.StringP) {
+	f.Where(p.Field(templateversion.FieldTemplateID))
+}
+
+// WhereVersion applies the entql int predicate on the version field.
+func (f *TemplateVersionFilter) WhereVersion(p entql.IntP) {
+	f.Where(p.Field(templateversion.FieldVersion))
+}
+
+// WhereJsonconfig applies the entql json.RawMessage predicate on the jsonconfig field.
+func (f *TemplateVersionFilter) WhereJsonconfig(p entql.BytesP) {
+	f.Where(p.Field(templateversion.FieldJsonconfig))
+}
+
+// WhereUischema applies the entql json.RawMessage predicate on the uischema field.
+func (f *TemplateVersionFilter) WhereUischema(p entql.BytesP) {
+	f.Where(p.Field(templateversion.FieldUischema))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *TemplateVersionFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *TemplateVersionFilter) WhereHasOwnerWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasTemplate applies a predicate to check if query has an edge template.
+func (f *TemplateVersionFilter) WhereHasTemplate() {
+	f.Where(entql.HasEdge("template"))
+}
+
+// WhereHasTemplateWith applies a predicate to check if query has an edge template with a given conditions (other predicates).
+func (f *TemplateVersionFilter) WhereHasTemplateWith(preds ...predicate.Template) {
+	f.Where(entql.HasEdgeWith("template", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (uq *UserQuery) addPredicate(pred func(s *sql.Selector)) {
 	uq.predicates = append(uq.predicates, pred)
@@ -10670,7 +11437,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[50].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[52].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -10978,7 +11745,7 @@ type UserHistoryFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserHistoryFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[51].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[53].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -11133,7 +11900,7 @@ type UserSettingFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserSettingFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[52].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[54].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -11194,6 +11961,16 @@ func (f *UserSettingFilter) WhereLocked(p entql.BoolP) {
 	f.Where(p.Field(usersetting.FieldLocked))
 }
 
+// WhereLockedUntil applies the entql time.Time predicate on the locked_until field.
+func (f *UserSettingFilter) WhereLockedUntil(p entql.TimeP) {
+	f.Where(p.Field(usersetting.FieldLockedUntil))
+}
+
+// WhereFailedLoginAttempts applies the entql int predicate on the failed_login_attempts field.
+func (f *UserSettingFilter) WhereFailedLoginAttempts(p entql.IntP) {
+	f.Where(p.Field(usersetting.FieldFailedLoginAttempts))
+}
+
 // WhereSilencedAt applies the entql time.Time predicate on the silenced_at field.
 func (f *UserSettingFilter) WhereSilencedAt(p entql.TimeP) {
 	f.Where(p.Field(usersetting.FieldSilencedAt))
@@ -11286,7 +12063,7 @@ type UserSettingHistoryFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserSettingHistoryFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[53].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[55].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -11362,6 +12139,16 @@ func (f *UserSettingHistoryFilter) WhereLocked(p entql.BoolP) {
 	f.Where(p.Field(usersettinghistory.FieldLocked))
 }
 
+// WhereLockedUntil applies the entql time.Time predicate on the locked_until field.
+func (f *UserSettingHistoryFilter) WhereLockedUntil(p entql.TimeP) {
+	f.Where(p.Field(usersettinghistory.FieldLockedUntil))
+}
+
+// WhereFailedLoginAttempts applies the entql int predicate on the failed_login_attempts field.
+func (f *UserSettingHistoryFilter) WhereFailedLoginAttempts(p entql.IntP) {
+	f.Where(p.Field(usersettinghistory.FieldFailedLoginAttempts))
+}
+
 // WhereSilencedAt applies the entql time.Time predicate on the silenced_at field.
 func (f *UserSettingHistoryFilter) WhereSilencedAt(p entql.TimeP) {
 	f.Where(p.Field(usersettinghistory.FieldSilencedAt))
@@ -11426,7 +12213,7 @@ type WebauthnFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *WebauthnFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[54].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[56].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -11565,7 +12352,7 @@ type WebhookFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *WebhookFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[55].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[57].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -11641,6 +12428,16 @@ func (f *WebhookFilter) WhereEnabled(p entql.BoolP) {
 	f.Where(p.Field(webhook.FieldEnabled))
 }
 
+// WhereEventTypes applies the entql json.RawMessage predicate on the event_types field.
+func (f *WebhookFilter) WhereEventTypes(p entql.BytesP) {
+	f.Where(p.Field(webhook.FieldEventTypes))
+}
+
+// WhereObjectTypes applies the entql json.RawMessage predicate on the object_types field.
+func (f *WebhookFilter) WhereObjectTypes(p entql.BytesP) {
+	f.Where(p.Field(webhook.FieldObjectTypes))
+}
+
 // WhereCallback applies the entql string predicate on the callback field.
 func (f *WebhookFilter) WhereCallback(p entql.StringP) {
 	f.Where(p.Field(webhook.FieldCallback))
@@ -11699,6 +12496,20 @@ func (f *WebhookFilter) WhereHasEventsWith(preds ...predicate.Event) {
 	})))
 }
 
+// WhereHasDeliveries applies a predicate to check if query has an edge deliveries.
+func (f *WebhookFilter) WhereHasDeliveries() {
+	f.Where(entql.HasEdge("deliveries"))
+}
+
+// WhereHasDeliveriesWith applies a predicate to check if query has an edge deliveries with a given conditions (other predicates).
+func (f *WebhookFilter) WhereHasDeliveriesWith(preds ...predicate.WebhookDelivery) {
+	f.Where(entql.HasEdgeWith("deliveries", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // WhereHasIntegrations applies a predicate to check if query has an edge integrations.
 func (f *WebhookFilter) WhereHasIntegrations() {
 	f.Where(entql.HasEdge("integrations"))
@@ -11713,6 +12524,163 @@ func (f *WebhookFilter) WhereHasIntegrationsWith(preds ...predicate.Integration)
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (wdq *WebhookDeliveryQuery) addPredicate(pred func(s *sql.Selector)) {
+	wdq.predicates = append(wdq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WebhookDeliveryQuery builder.
+func (wdq *WebhookDeliveryQuery) Filter() *WebhookDeliveryFilter {
+	return &WebhookDeliveryFilter{config: wdq.config, predicateAdder: wdq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WebhookDeliveryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Filter() *WebhookDeliveryFilter {
+	return &WebhookDeliveryFilter{config: m.config, predicateAdder: m}
+}
+
+// WebhookDeliveryFilter provides a generic filtering capability at runtime for WebhookDeliveryQuery.
+type WebhookDeliveryFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WebhookDeliveryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[58].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql string predicate on the id field.
+func (f *WebhookDeliveryFilter) WhereID(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WebhookDeliveryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *WebhookDeliveryFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(webhookdelivery.FieldUpdatedAt))
+}
+
+// WhereCreatedBy applies the entql string predicate on the created_by field.
+func (f *WebhookDeliveryFilter) WhereCreatedBy(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql string predicate on the updated_by field.
+func (f *WebhookDeliveryFilter) WhereUpdatedBy(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldUpdatedBy))
+}
+
+// WhereMappingID applies the entql string predicate on the mapping_id field.
+func (f *WebhookDeliveryFilter) WhereMappingID(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldMappingID))
+}
+
+// WhereOwnerID applies the entql string predicate on the owner_id field.
+func (f *WebhookDeliveryFilter) WhereOwnerID(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldOwnerID))
+}
+
+// WhereWebhookID applies the entql string predicate on the webhook_id field.
+func (f *WebhookDeliveryFilter) WhereWebhookID(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldWebhookID))
+}
+
+// WhereEventID applies the entql string predicate on the event_id field.
+func (f *WebhookDeliveryFilter) WhereEventID(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldEventID))
+}
+
+// WhereAttempt applies the entql int predicate on the attempt field.
+func (f *WebhookDeliveryFilter) WhereAttempt(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldAttempt))
+}
+
+// WhereRedelivery applies the entql bool predicate on the redelivery field.
+func (f *WebhookDeliveryFilter) WhereRedelivery(p entql.BoolP) {
+	f.Where(p.Field(webhookdelivery.FieldRedelivery))
+}
+
+// WhereRequestBody applies the entql string predicate on the request_body field.
+func (f *WebhookDeliveryFilter) WhereRequestBody(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldRequestBody))
+}
+
+// WhereResponseStatus applies the entql int predicate on the response_status field.
+func (f *WebhookDeliveryFilter) WhereResponseStatus(p entql.IntP) {
+	f.Where(p.Field(webhookdelivery.FieldResponseStatus))
+}
+
+// WhereResponseBody applies the entql string predicate on the response_body field.
+func (f *WebhookDeliveryFilter) WhereResponseBody(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldResponseBody))
+}
+
+// WhereLatency applies the entql int64 predicate on the latency field.
+func (f *WebhookDeliveryFilter) WhereLatency(p entql.Int64P) {
+	f.Where(p.Field(webhookdelivery.FieldLatency))
+}
+
+// WhereError applies the entql string predicate on the error field.
+func (f *WebhookDeliveryFilter) WhereError(p entql.StringP) {
+	f.Where(p.Field(webhookdelivery.FieldError))
+}
+
+// WhereHasOwner applies a predicate to check if query has an edge owner.
+func (f *WebhookDeliveryFilter) WhereHasOwner() {
+	f.Where(entql.HasEdge("owner"))
+}
+
+// WhereHasOwnerWith applies a predicate to check if query has an edge owner with a given conditions (other predicates).
+func (f *WebhookDeliveryFilter) WhereHasOwnerWith(preds ...predicate.Organization) {
+	f.Where(entql.HasEdgeWith("owner", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasWebhook applies a predicate to check if query has an edge webhook.
+func (f *WebhookDeliveryFilter) WhereHasWebhook() {
+	f.Where(entql.HasEdge("webhook"))
+}
+
+// WhereHasWebhookWith applies a predicate to check if query has an edge webhook with a given conditions (other predicates).
+func (f *WebhookDeliveryFilter) WhereHasWebhookWith(preds ...predicate.Webhook) {
+	f.Where(entql.HasEdgeWith("webhook", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasEvent applies a predicate to check if query has an edge event.
+func (f *WebhookDeliveryFilter) WhereHasEvent() {
+	f.Where(entql.HasEdge("event"))
+}
+
+// WhereHasEventWith applies a predicate to check if query has an edge event with a given conditions (other predicates).
+func (f *WebhookDeliveryFilter) WhereHasEventWith(preds ...predicate.Event) {
+	f.Where(entql.HasEdgeWith("event", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (whq *WebhookHistoryQuery) addPredicate(pred func(s *sql.Selector)) {
 	whq.predicates = append(whq.predicates, pred)
@@ -11742,7 +12710,7 @@ type WebhookHistoryFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *WebhookHistoryFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[56].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[59].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -11833,6 +12801,16 @@ func (f *WebhookHistoryFilter) WhereEnabled(p entql.BoolP) {
 	f.Where(p.Field(webhookhistory.FieldEnabled))
 }
 
+// WhereEventTypes applies the entql json.RawMessage predicate on the event_types field.
+func (f *WebhookHistoryFilter) WhereEventTypes(p entql.BytesP) {
+	f.Where(p.Field(webhookhistory.FieldEventTypes))
+}
+
+// WhereObjectTypes applies the entql json.RawMessage predicate on the object_types field.
+func (f *WebhookHistoryFilter) WhereObjectTypes(p entql.BytesP) {
+	f.Where(p.Field(webhookhistory.FieldObjectTypes))
+}
+
 // WhereCallback applies the entql string predicate on the callback field.
 func (f *WebhookHistoryFilter) WhereCallback(p entql.StringP) {
 	f.Where(p.Field(webhookhistory.FieldCallback))
@@ -78,11 +78,13 @@ type EventEdges struct {
 	Webhook []*Webhook `json:"webhook,omitempty"`
 	// Subscriber holds the value of the subscriber edge.
 	Subscriber []*Subscriber `json:"subscriber,omitempty"`
+	// WebhookDeliveries holds the value of the webhook_deliveries edge.
+	WebhookDeliveries []*WebhookDelivery `json:"webhook_deliveries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [16]bool
+	loadedTypes [17]bool
 	// totalCount holds the count of the edges above.
-	totalCount [16]map[string]int
+	totalCount [17]map[string]int
 
 	namedUser                   map[string][]*User
 	namedGroup                  map[string][]*Group
@@ -100,6 +102,7 @@ type EventEdges struct {
 	namedEntitlement            map[string][]*Entitlement
 	namedWebhook                map[string][]*Webhook
 	namedSubscriber             map[string][]*Subscriber
+	namedWebhookDeliveries      map[string][]*WebhookDelivery
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -246,6 +249,15 @@ func (e EventEdges) SubscriberOrErr() ([]*Subscriber, error) {
 	return nil, &NotLoadedError{edge: "subscriber"}
 }
 
+// WebhookDeliveriesOrErr returns the WebhookDeliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e EventEdges) WebhookDeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[16] {
+		return e.WebhookDeliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook_deliveries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Event) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -435,6 +447,11 @@ func (e *Event) QuerySubscriber() *SubscriberQuery {
 	return NewEventClient(e.config).QuerySubscriber(e)
 }
 
+// QueryWebhookDeliveries queries the "webhook_deliveries" edge of the Event entity.
+func (e *Event) QueryWebhookDeliveries() *WebhookDeliveryQuery {
+	return NewEventClient(e.config).QueryWebhookDeliveries(e)
+}
+
 // Update returns a builder for updating this Event.
 // Note that you need to call Event.Unwrap() before calling this method if this Event
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -875,5 +892,29 @@ func (e *Event) appendNamedSubscriber(name string, edges ...*Subscriber) {
 	}
 }
 
+// NamedWebhookDeliveries returns the WebhookDeliveries named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (e *Event) NamedWebhookDeliveries(name string) ([]*WebhookDelivery, error) {
+	if e.Edges.namedWebhookDeliveries == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := e.Edges.namedWebhookDeliveries[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (e *Event) appendNamedWebhookDeliveries(name string, edges ...*WebhookDelivery) {
+	if e.Edges.namedWebhookDeliveries == nil {
+		e.Edges.namedWebhookDeliveries = make(map[string][]*WebhookDelivery)
+	}
+	if len(edges) == 0 {
+		e.Edges.namedWebhookDeliveries[name] = []*WebhookDelivery{}
+	} else {
+		e.Edges.namedWebhookDeliveries[name] = append(e.Edges.namedWebhookDeliveries[name], edges...)
+	}
+}
+
 // Events is a parsable slice of Event.
 type Events []*Event
@@ -30,6 +30,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/subscriber"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 
 	"github.com/datumforge/datum/internal/ent/generated/internal"
 )
@@ -57,6 +58,7 @@ type EventQuery struct {
 	withEntitlement                 *EntitlementQuery
 	withWebhook                     *WebhookQuery
 	withSubscriber                  *SubscriberQuery
+	withWebhookDeliveries           *WebhookDeliveryQuery
 	modifiers                       []func(*sql.Selector)
 	loadTotal                       []func(context.Context, []*Event) error
 	withNamedUser                   map[string]*UserQuery
@@ -75,6 +77,7 @@ type EventQuery struct {
 	withNamedEntitlement            map[string]*EntitlementQuery
 	withNamedWebhook                map[string]*WebhookQuery
 	withNamedSubscriber             map[string]*SubscriberQuery
+	withNamedWebhookDeliveries      map[string]*WebhookDeliveryQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -511,6 +514,31 @@ func (eq *EventQuery) QuerySubscriber() *SubscriberQuery {
 	return query
 }
 
+// QueryWebhookDeliveries chains the current query on the "webhook_deliveries" edge.
+func (eq *EventQuery) QueryWebhookDeliveries() *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, selector),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, event.WebhookDeliveriesTable, event.WebhookDeliveriesColumn),
+		)
+		schemaConfig := eq.schemaConfig
+		step.To.Schema = schemaConfig.WebhookDelivery
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Event entity from the query.
 // Returns a *NotFoundError when no Event was found.
 func (eq *EventQuery) First(ctx context.Context) (*Event, error) {
@@ -719,6 +747,7 @@ func (eq *EventQuery) Clone() *EventQuery {
 		withEntitlement:            eq.withEntitlement.Clone(),
 		withWebhook:                eq.withWebhook.Clone(),
 		withSubscriber:             eq.withSubscriber.Clone(),
+		withWebhookDeliveries:      eq.withWebhookDeliveries.Clone(),
 		// clone intermediate query.
 		sql:  eq.sql.Clone(),
 		path: eq.path,
@@ -901,6 +930,17 @@ func (eq *EventQuery) WithSubscriber(opts ...func(*SubscriberQuery)) *EventQuery
 	return eq
 }
 
+// WithWebhookDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "webhook_deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EventQuery) WithWebhookDeliveries(opts ...func(*WebhookDeliveryQuery)) *EventQuery {
+	query := (&WebhookDeliveryClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withWebhookDeliveries = query
+	return eq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -979,7 +1019,7 @@ func (eq *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 	var (
 		nodes       = []*Event{}
 		_spec       = eq.querySpec()
-		loadedTypes = [16]bool{
+		loadedTypes = [17]bool{
 			eq.withUser != nil,
 			eq.withGroup != nil,
 			eq.withIntegration != nil,
@@ -996,6 +1036,7 @@ func (eq *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 			eq.withEntitlement != nil,
 			eq.withWebhook != nil,
 			eq.withSubscriber != nil,
+			eq.withWebhookDeliveries != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -1137,6 +1178,13 @@ func (eq *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 			return nil, err
 		}
 	}
+	if query := eq.withWebhookDeliveries; query != nil {
+		if err := eq.loadWebhookDeliveries(ctx, query, nodes,
+			func(n *Event) { n.Edges.WebhookDeliveries = []*WebhookDelivery{} },
+			func(n *Event, e *WebhookDelivery) { n.Edges.WebhookDeliveries = append(n.Edges.WebhookDeliveries, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range eq.withNamedUser {
 		if err := eq.loadUser(ctx, query, nodes,
 			func(n *Event) { n.appendNamedUser(name) },
@@ -1249,6 +1297,13 @@ func (eq *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 			return nil, err
 		}
 	}
+	for name, query := range eq.withNamedWebhookDeliveries {
+		if err := eq.loadWebhookDeliveries(ctx, query, nodes,
+			func(n *Event) { n.appendNamedWebhookDeliveries(name) },
+			func(n *Event, e *WebhookDelivery) { n.appendNamedWebhookDeliveries(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range eq.loadTotal {
 		if err := eq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -2249,6 +2304,36 @@ func (eq *EventQuery) loadSubscriber(ctx context.Context, query *SubscriberQuery
 	}
 	return nil
 }
+func (eq *EventQuery) loadWebhookDeliveries(ctx context.Context, query *WebhookDeliveryQuery, nodes []*Event, init func(*Event), assign func(*Event, *WebhookDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Event)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhookdelivery.FieldEventID)
+	}
+	query.Where(predicate.WebhookDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(event.WebhookDeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EventID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "event_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (eq *EventQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := eq.querySpec()
@@ -2563,6 +2648,20 @@ func (eq *EventQuery) WithNamedSubscriber(name string, opts ...func(*SubscriberQ
 	return eq
 }
 
+// WithNamedWebhookDeliveries tells the query-builder to eager-load the nodes that are connected to the "webhook_deliveries"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (eq *EventQuery) WithNamedWebhookDeliveries(name string, opts ...func(*WebhookDeliveryQuery)) *EventQuery {
+	query := (&WebhookDeliveryClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if eq.withNamedWebhookDeliveries == nil {
+		eq.withNamedWebhookDeliveries = make(map[string]*WebhookDeliveryQuery)
+	}
+	eq.withNamedWebhookDeliveries[name] = query
+	return eq
+}
+
 // EventGroupBy is the group-by builder for Event entities.
 type EventGroupBy struct {
 	selector
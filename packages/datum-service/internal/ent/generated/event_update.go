@@ -30,6 +30,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/subscriber"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 
 	"github.com/datumforge/datum/internal/ent/generated/internal"
 )
@@ -403,6 +404,21 @@ func (eu *EventUpdate) AddSubscriber(s ...*Subscriber) *EventUpdate {
 	return eu.AddSubscriberIDs(ids...)
 }
 
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (eu *EventUpdate) AddWebhookDeliveryIDs(ids ...string) *EventUpdate {
+	eu.mutation.AddWebhookDeliveryIDs(ids...)
+	return eu
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (eu *EventUpdate) AddWebhookDeliveries(w ...*WebhookDelivery) *EventUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eu.AddWebhookDeliveryIDs(ids...)
+}
+
 // Mutation returns the EventMutation object of the builder.
 func (eu *EventUpdate) Mutation() *EventMutation {
 	return eu.mutation
@@ -744,6 +760,27 @@ func (eu *EventUpdate) RemoveSubscriber(s ...*Subscriber) *EventUpdate {
 	return eu.RemoveSubscriberIDs(ids...)
 }
 
+// ClearWebhookDeliveries clears all "webhook_deliveries" edges to the WebhookDelivery entity.
+func (eu *EventUpdate) ClearWebhookDeliveries() *EventUpdate {
+	eu.mutation.ClearWebhookDeliveries()
+	return eu
+}
+
+// RemoveWebhookDeliveryIDs removes the "webhook_deliveries" edge to WebhookDelivery entities by IDs.
+func (eu *EventUpdate) RemoveWebhookDeliveryIDs(ids ...string) *EventUpdate {
+	eu.mutation.RemoveWebhookDeliveryIDs(ids...)
+	return eu
+}
+
+// RemoveWebhookDeliveries removes "webhook_deliveries" edges to WebhookDelivery entities.
+func (eu *EventUpdate) RemoveWebhookDeliveries(w ...*WebhookDelivery) *EventUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eu.RemoveWebhookDeliveryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (eu *EventUpdate) Save(ctx context.Context) (int, error) {
 	if err := eu.defaults(); err != nil {
@@ -1613,6 +1650,54 @@ func (eu *EventUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if eu.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.WebhookDeliveriesTable,
+			Columns: []string{event.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedWebhookDeliveriesIDs(); len(nodes) > 0 && !eu.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.WebhookDeliveriesTable,
+			Columns: []string{event.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.WebhookDeliveriesTable,
+			Columns: []string{event.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = eu.schemaConfig.Event
 	ctx = internal.NewSchemaConfigContext(ctx, eu.schemaConfig)
 	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
@@ -1991,6 +2076,21 @@ func (euo *EventUpdateOne) AddSubscriber(s ...*Subscriber) *EventUpdateOne {
 	return euo.AddSubscriberIDs(ids...)
 }
 
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (euo *EventUpdateOne) AddWebhookDeliveryIDs(ids ...string) *EventUpdateOne {
+	euo.mutation.AddWebhookDeliveryIDs(ids...)
+	return euo
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (euo *EventUpdateOne) AddWebhookDeliveries(w ...*WebhookDelivery) *EventUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return euo.AddWebhookDeliveryIDs(ids...)
+}
+
 // Mutation returns the EventMutation object of the builder.
 func (euo *EventUpdateOne) Mutation() *EventMutation {
 	return euo.mutation
@@ -2332,6 +2432,27 @@ func (euo *EventUpdateOne) RemoveSubscriber(s ...*Subscriber) *EventUpdateOne {
 	return euo.RemoveSubscriberIDs(ids...)
 }
 
+// ClearWebhookDeliveries clears all "webhook_deliveries" edges to the WebhookDelivery entity.
+func (euo *EventUpdateOne) ClearWebhookDeliveries() *EventUpdateOne {
+	euo.mutation.ClearWebhookDeliveries()
+	return euo
+}
+
+// RemoveWebhookDeliveryIDs removes the "webhook_deliveries" edge to WebhookDelivery entities by IDs.
+func (euo *EventUpdateOne) RemoveWebhookDeliveryIDs(ids ...string) *EventUpdateOne {
+	euo.mutation.RemoveWebhookDeliveryIDs(ids...)
+	return euo
+}
+
+// RemoveWebhookDeliveries removes "webhook_deliveries" edges to WebhookDelivery entities.
+func (euo *EventUpdateOne) RemoveWebhookDeliveries(w ...*WebhookDelivery) *EventUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return euo.RemoveWebhookDeliveryIDs(ids...)
+}
+
 // Where appends a list predicates to the EventUpdate builder.
 func (euo *EventUpdateOne) Where(ps ...predicate.Event) *EventUpdateOne {
 	euo.mutation.Where(ps...)
@@ -3231,6 +3352,54 @@ func (euo *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if euo.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.WebhookDeliveriesTable,
+			Columns: []string{event.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedWebhookDeliveriesIDs(); len(nodes) > 0 && !euo.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.WebhookDeliveriesTable,
+			Columns: []string{event.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.WebhookDeliveriesTable,
+			Columns: []string{event.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = euo.schemaConfig.Event
 	ctx = internal.NewSchemaConfigContext(ctx, euo.schemaConfig)
 	_node = &Event{config: euo.config}
@@ -57,12 +57,14 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/subscriber"
 	"github.com/datumforge/datum/internal/ent/generated/template"
 	"github.com/datumforge/datum/internal/ent/generated/templatehistory"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
 	"github.com/datumforge/datum/internal/ent/generated/tfasetting"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/userhistory"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
 	"github.com/datumforge/datum/internal/ent/generated/usersettinghistory"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 	"github.com/datumforge/datum/internal/ent/generated/webhookhistory"
 )
 
@@ -639,6 +641,11 @@ func (dd *DocumentDataQuery) collectField(ctx context.Context, oneNode bool, opC
 				selectedFields = append(selectedFields, documentdata.FieldTemplateID)
 				fieldSeen[documentdata.FieldTemplateID] = struct{}{}
 			}
+		case "templateVersion":
+			if _, ok := fieldSeen[documentdata.FieldTemplateVersion]; !ok {
+				selectedFields = append(selectedFields, documentdata.FieldTemplateVersion)
+				fieldSeen[documentdata.FieldTemplateVersion] = struct{}{}
+			}
 		case "data":
 			if _, ok := fieldSeen[documentdata.FieldData]; !ok {
 				selectedFields = append(selectedFields, documentdata.FieldData)
@@ -766,6 +773,11 @@ func (ddh *DocumentDataHistoryQuery) collectField(ctx context.Context, oneNode b
 				selectedFields = append(selectedFields, documentdatahistory.FieldTemplateID)
 				fieldSeen[documentdatahistory.FieldTemplateID] = struct{}{}
 			}
+		case "templateVersion":
+			if _, ok := fieldSeen[documentdatahistory.FieldTemplateVersion]; !ok {
+				selectedFields = append(selectedFields, documentdatahistory.FieldTemplateVersion)
+				fieldSeen[documentdatahistory.FieldTemplateVersion] = struct{}{}
+			}
 		case "data":
 			if _, ok := fieldSeen[documentdatahistory.FieldData]; !ok {
 				selectedFields = append(selectedFields, documentdatahistory.FieldData)
@@ -2737,6 +2749,19 @@ func (e *EventQuery) collectField(ctx context.Context, oneNode bool, opCtx *grap
 			e.WithNamedSubscriber(alias, func(wq *SubscriberQuery) {
 				*wq = *query
 			})
+
+		case "webhookDeliveries":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&WebhookDeliveryClient{config: e.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, webhookdeliveryImplementors)...); err != nil {
+				return err
+			}
+			e.WithNamedWebhookDeliveries(alias, func(wq *WebhookDeliveryQuery) {
+				*wq = *query
+			})
 		case "createdAt":
 			if _, ok := fieldSeen[event.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, event.FieldCreatedAt)
@@ -5760,6 +5785,11 @@ func (op *OauthProviderQuery) collectField(ctx context.Context, oneNode bool, op
 				selectedFields = append(selectedFields, oauthprovider.FieldInfoURL)
 				fieldSeen[oauthprovider.FieldInfoURL] = struct{}{}
 			}
+		case "issuer":
+			if _, ok := fieldSeen[oauthprovider.FieldIssuer]; !ok {
+				selectedFields = append(selectedFields, oauthprovider.FieldIssuer)
+				fieldSeen[oauthprovider.FieldIssuer] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -5922,6 +5952,11 @@ func (oph *OauthProviderHistoryQuery) collectField(ctx context.Context, oneNode
 				selectedFields = append(selectedFields, oauthproviderhistory.FieldInfoURL)
 				fieldSeen[oauthproviderhistory.FieldInfoURL] = struct{}{}
 			}
+		case "issuer":
+			if _, ok := fieldSeen[oauthproviderhistory.FieldIssuer]; !ok {
+				selectedFields = append(selectedFields, oauthproviderhistory.FieldIssuer)
+				fieldSeen[oauthproviderhistory.FieldIssuer] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -7239,6 +7274,41 @@ func (os *OrganizationSettingQuery) collectField(ctx context.Context, oneNode bo
 				selectedFields = append(selectedFields, organizationsetting.FieldOrganizationID)
 				fieldSeen[organizationsetting.FieldOrganizationID] = struct{}{}
 			}
+		case "ssoEnforced":
+			if _, ok := fieldSeen[organizationsetting.FieldSSOEnforced]; !ok {
+				selectedFields = append(selectedFields, organizationsetting.FieldSSOEnforced)
+				fieldSeen[organizationsetting.FieldSSOEnforced] = struct{}{}
+			}
+		case "samlMetadataURL":
+			if _, ok := fieldSeen[organizationsetting.FieldSamlMetadataURL]; !ok {
+				selectedFields = append(selectedFields, organizationsetting.FieldSamlMetadataURL)
+				fieldSeen[organizationsetting.FieldSamlMetadataURL] = struct{}{}
+			}
+		case "samlMetadata":
+			if _, ok := fieldSeen[organizationsetting.FieldSamlMetadata]; !ok {
+				selectedFields = append(selectedFields, organizationsetting.FieldSamlMetadata)
+				fieldSeen[organizationsetting.FieldSamlMetadata] = struct{}{}
+			}
+		case "samlAttributeMapping":
+			if _, ok := fieldSeen[organizationsetting.FieldSamlAttributeMapping]; !ok {
+				selectedFields = append(selectedFields, organizationsetting.FieldSamlAttributeMapping)
+				fieldSeen[organizationsetting.FieldSamlAttributeMapping] = struct{}{}
+			}
+		case "domainVerificationToken":
+			if _, ok := fieldSeen[organizationsetting.FieldDomainVerificationToken]; !ok {
+				selectedFields = append(selectedFields, organizationsetting.FieldDomainVerificationToken)
+				fieldSeen[organizationsetting.FieldDomainVerificationToken] = struct{}{}
+			}
+		case "verifiedDomains":
+			if _, ok := fieldSeen[organizationsetting.FieldVerifiedDomains]; !ok {
+				selectedFields = append(selectedFields, organizationsetting.FieldVerifiedDomains)
+				fieldSeen[organizationsetting.FieldVerifiedDomains] = struct{}{}
+			}
+		case "joinPolicy":
+			if _, ok := fieldSeen[organizationsetting.FieldJoinPolicy]; !ok {
+				selectedFields = append(selectedFields, organizationsetting.FieldJoinPolicy)
+				fieldSeen[organizationsetting.FieldJoinPolicy] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -7391,6 +7461,41 @@ func (osh *OrganizationSettingHistoryQuery) collectField(ctx context.Context, on
 				selectedFields = append(selectedFields, organizationsettinghistory.FieldOrganizationID)
 				fieldSeen[organizationsettinghistory.FieldOrganizationID] = struct{}{}
 			}
+		case "ssoEnforced":
+			if _, ok := fieldSeen[organizationsettinghistory.FieldSSOEnforced]; !ok {
+				selectedFields = append(selectedFields, organizationsettinghistory.FieldSSOEnforced)
+				fieldSeen[organizationsettinghistory.FieldSSOEnforced] = struct{}{}
+			}
+		case "samlMetadataURL":
+			if _, ok := fieldSeen[organizationsettinghistory.FieldSamlMetadataURL]; !ok {
+				selectedFields = append(selectedFields, organizationsettinghistory.FieldSamlMetadataURL)
+				fieldSeen[organizationsettinghistory.FieldSamlMetadataURL] = struct{}{}
+			}
+		case "samlMetadata":
+			if _, ok := fieldSeen[organizationsettinghistory.FieldSamlMetadata]; !ok {
+				selectedFields = append(selectedFields, organizationsettinghistory.FieldSamlMetadata)
+				fieldSeen[organizationsettinghistory.FieldSamlMetadata] = struct{}{}
+			}
+		case "samlAttributeMapping":
+			if _, ok := fieldSeen[organizationsettinghistory.FieldSamlAttributeMapping]; !ok {
+				selectedFields = append(selectedFields, organizationsettinghistory.FieldSamlAttributeMapping)
+				fieldSeen[organizationsettinghistory.FieldSamlAttributeMapping] = struct{}{}
+			}
+		case "domainVerificationToken":
+			if _, ok := fieldSeen[organizationsettinghistory.FieldDomainVerificationToken]; !ok {
+				selectedFields = append(selectedFields, organizationsettinghistory.FieldDomainVerificationToken)
+				fieldSeen[organizationsettinghistory.FieldDomainVerificationToken] = struct{}{}
+			}
+		case "verifiedDomains":
+			if _, ok := fieldSeen[organizationsettinghistory.FieldVerifiedDomains]; !ok {
+				selectedFields = append(selectedFields, organizationsettinghistory.FieldVerifiedDomains)
+				fieldSeen[organizationsettinghistory.FieldVerifiedDomains] = struct{}{}
+			}
+		case "joinPolicy":
+			if _, ok := fieldSeen[organizationsettinghistory.FieldJoinPolicy]; !ok {
+				selectedFields = append(selectedFields, organizationsettinghistory.FieldJoinPolicy)
+				fieldSeen[organizationsettinghistory.FieldJoinPolicy] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -7936,6 +8041,47 @@ func (t *TemplateQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 			t.WithNamedDocuments(alias, func(wq *DocumentDataQuery) {
 				*wq = *query
 			})
+
+		case "versions":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&TemplateVersionClient{config: t.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, templateversionImplementors)...); err != nil {
+				return err
+			}
+			t.WithNamedVersions(alias, func(wq *TemplateVersionQuery) {
+				*wq = *query
+			})
+
+		case "sourceTemplate":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&TemplateClient{config: t.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, templateImplementors)...); err != nil {
+				return err
+			}
+			t.withSourceTemplate = query
+			if _, ok := fieldSeen[template.FieldSourceTemplateID]; !ok {
+				selectedFields = append(selectedFields, template.FieldSourceTemplateID)
+				fieldSeen[template.FieldSourceTemplateID] = struct{}{}
+			}
+
+		case "clones":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&TemplateClient{config: t.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, templateImplementors)...); err != nil {
+				return err
+			}
+			t.WithNamedClones(alias, func(wq *TemplateQuery) {
+				*wq = *query
+			})
 		case "createdAt":
 			if _, ok := fieldSeen[template.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, template.FieldCreatedAt)
@@ -8001,6 +8147,21 @@ func (t *TemplateQuery) collectField(ctx context.Context, oneNode bool, opCtx *g
 				selectedFields = append(selectedFields, template.FieldUischema)
 				fieldSeen[template.FieldUischema] = struct{}{}
 			}
+		case "version":
+			if _, ok := fieldSeen[template.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, template.FieldVersion)
+				fieldSeen[template.FieldVersion] = struct{}{}
+			}
+		case "sourceTemplateID":
+			if _, ok := fieldSeen[template.FieldSourceTemplateID]; !ok {
+				selectedFields = append(selectedFields, template.FieldSourceTemplateID)
+				fieldSeen[template.FieldSourceTemplateID] = struct{}{}
+			}
+		case "sourceTemplateVersion":
+			if _, ok := fieldSeen[template.FieldSourceTemplateVersion]; !ok {
+				selectedFields = append(selectedFields, template.FieldSourceTemplateVersion)
+				fieldSeen[template.FieldSourceTemplateVersion] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -8165,6 +8326,21 @@ func (th *TemplateHistoryQuery) collectField(ctx context.Context, oneNode bool,
 				selectedFields = append(selectedFields, templatehistory.FieldUischema)
 				fieldSeen[templatehistory.FieldUischema] = struct{}{}
 			}
+		case "version":
+			if _, ok := fieldSeen[templatehistory.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, templatehistory.FieldVersion)
+				fieldSeen[templatehistory.FieldVersion] = struct{}{}
+			}
+		case "sourceTemplateID":
+			if _, ok := fieldSeen[templatehistory.FieldSourceTemplateID]; !ok {
+				selectedFields = append(selectedFields, templatehistory.FieldSourceTemplateID)
+				fieldSeen[templatehistory.FieldSourceTemplateID] = struct{}{}
+			}
+		case "sourceTemplateVersion":
+			if _, ok := fieldSeen[templatehistory.FieldSourceTemplateVersion]; !ok {
+				selectedFields = append(selectedFields, templatehistory.FieldSourceTemplateVersion)
+				fieldSeen[templatehistory.FieldSourceTemplateVersion] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -8228,6 +8404,175 @@ func newTemplateHistoryPaginateArgs(rv map[string]any) *templatehistoryPaginateA
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (tv *TemplateVersionQuery) CollectFields(ctx context.Context, satisfies ...string) (*TemplateVersionQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return tv, nil
+	}
+	if err := tv.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return tv, nil
+}
+
+func (tv *TemplateVersionQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(templateversion.Columns))
+		selectedFields = []string{templateversion.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OrganizationClient{config: tv.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, organizationImplementors)...); err != nil {
+				return err
+			}
+			tv.withOwner = query
+			if _, ok := fieldSeen[templateversion.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldOwnerID)
+				fieldSeen[templateversion.FieldOwnerID] = struct{}{}
+			}
+
+		case "template":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&TemplateClient{config: tv.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, templateImplementors)...); err != nil {
+				return err
+			}
+			tv.withTemplate = query
+			if _, ok := fieldSeen[templateversion.FieldTemplateID]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldTemplateID)
+				fieldSeen[templateversion.FieldTemplateID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[templateversion.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldCreatedAt)
+				fieldSeen[templateversion.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[templateversion.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldUpdatedAt)
+				fieldSeen[templateversion.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[templateversion.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldCreatedBy)
+				fieldSeen[templateversion.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[templateversion.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldUpdatedBy)
+				fieldSeen[templateversion.FieldUpdatedBy] = struct{}{}
+			}
+		case "deletedAt":
+			if _, ok := fieldSeen[templateversion.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldDeletedAt)
+				fieldSeen[templateversion.FieldDeletedAt] = struct{}{}
+			}
+		case "deletedBy":
+			if _, ok := fieldSeen[templateversion.FieldDeletedBy]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldDeletedBy)
+				fieldSeen[templateversion.FieldDeletedBy] = struct{}{}
+			}
+		case "ownerID":
+			if _, ok := fieldSeen[templateversion.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldOwnerID)
+				fieldSeen[templateversion.FieldOwnerID] = struct{}{}
+			}
+		case "templateID":
+			if _, ok := fieldSeen[templateversion.FieldTemplateID]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldTemplateID)
+				fieldSeen[templateversion.FieldTemplateID] = struct{}{}
+			}
+		case "version":
+			if _, ok := fieldSeen[templateversion.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldVersion)
+				fieldSeen[templateversion.FieldVersion] = struct{}{}
+			}
+		case "jsonconfig":
+			if _, ok := fieldSeen[templateversion.FieldJsonconfig]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldJsonconfig)
+				fieldSeen[templateversion.FieldJsonconfig] = struct{}{}
+			}
+		case "uischema":
+			if _, ok := fieldSeen[templateversion.FieldUischema]; !ok {
+				selectedFields = append(selectedFields, templateversion.FieldUischema)
+				fieldSeen[templateversion.FieldUischema] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		tv.Select(selectedFields...)
+	}
+	return nil
+}
+
+type templateversionPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []TemplateVersionPaginateOption
+}
+
+func newTemplateVersionPaginateArgs(rv map[string]any) *templateversionPaginateArgs {
+	args := &templateversionPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &TemplateVersionOrder{Field: &TemplateVersionOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithTemplateVersionOrder(order))
+			}
+		case *TemplateVersionOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithTemplateVersionOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*TemplateVersionWhereInput); ok {
+		args.opts = append(args.opts, WithTemplateVersionFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (u *UserQuery) CollectFields(ctx context.Context, satisfies ...string) (*UserQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -8798,6 +9143,16 @@ func (us *UserSettingQuery) collectField(ctx context.Context, oneNode bool, opCt
 				selectedFields = append(selectedFields, usersetting.FieldLocked)
 				fieldSeen[usersetting.FieldLocked] = struct{}{}
 			}
+		case "lockedUntil":
+			if _, ok := fieldSeen[usersetting.FieldLockedUntil]; !ok {
+				selectedFields = append(selectedFields, usersetting.FieldLockedUntil)
+				fieldSeen[usersetting.FieldLockedUntil] = struct{}{}
+			}
+		case "failedLoginAttempts":
+			if _, ok := fieldSeen[usersetting.FieldFailedLoginAttempts]; !ok {
+				selectedFields = append(selectedFields, usersetting.FieldFailedLoginAttempts)
+				fieldSeen[usersetting.FieldFailedLoginAttempts] = struct{}{}
+			}
 		case "silencedAt":
 			if _, ok := fieldSeen[usersetting.FieldSilencedAt]; !ok {
 				selectedFields = append(selectedFields, usersetting.FieldSilencedAt)
@@ -8950,6 +9305,16 @@ func (ush *UserSettingHistoryQuery) collectField(ctx context.Context, oneNode bo
 				selectedFields = append(selectedFields, usersettinghistory.FieldLocked)
 				fieldSeen[usersettinghistory.FieldLocked] = struct{}{}
 			}
+		case "lockedUntil":
+			if _, ok := fieldSeen[usersettinghistory.FieldLockedUntil]; !ok {
+				selectedFields = append(selectedFields, usersettinghistory.FieldLockedUntil)
+				fieldSeen[usersettinghistory.FieldLockedUntil] = struct{}{}
+			}
+		case "failedLoginAttempts":
+			if _, ok := fieldSeen[usersettinghistory.FieldFailedLoginAttempts]; !ok {
+				selectedFields = append(selectedFields, usersettinghistory.FieldFailedLoginAttempts)
+				fieldSeen[usersettinghistory.FieldFailedLoginAttempts] = struct{}{}
+			}
 		case "silencedAt":
 			if _, ok := fieldSeen[usersettinghistory.FieldSilencedAt]; !ok {
 				selectedFields = append(selectedFields, usersettinghistory.FieldSilencedAt)
@@ -9071,6 +9436,95 @@ func (w *WebhookQuery) collectField(ctx context.Context, oneNode bool, opCtx *gr
 				*wq = *query
 			})
 
+		case "deliveries":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&WebhookDeliveryClient{config: w.config}).Query()
+			)
+			args := newWebhookDeliveryPaginateArgs(fieldArgs(ctx, new(WebhookDeliveryWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newWebhookDeliveryPager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					w.loadTotal = append(w.loadTotal, func(ctx context.Context, nodes []*Webhook) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID string `sql:"webhook_id"`
+							Count  int    `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(s.C(webhook.DeliveriesColumn), ids...))
+						})
+						if err := query.GroupBy(webhook.DeliveriesColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[string]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[2] == nil {
+								nodes[i].Edges.totalCount[2] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[2][alias] = n
+						}
+						return nil
+					})
+				} else {
+					w.loadTotal = append(w.loadTotal, func(_ context.Context, nodes []*Webhook) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.Deliveries)
+							if nodes[i].Edges.totalCount[2] == nil {
+								nodes[i].Edges.totalCount[2] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[2][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
+				return err
+			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, webhookdeliveryImplementors)...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				if oneNode {
+					pager.applyOrder(query.Limit(limit))
+				} else {
+					modify := entgql.LimitPerRow(webhook.DeliveriesColumn, limit, pager.orderExpr(query))
+					query.modifiers = append(query.modifiers, modify)
+				}
+			} else {
+				query = pager.applyOrder(query)
+			}
+			w.WithNamedDeliveries(alias, func(wq *WebhookDeliveryQuery) {
+				*wq = *query
+			})
+
 		case "integrations":
 			var (
 				alias = field.Alias
@@ -9143,6 +9597,16 @@ func (w *WebhookQuery) collectField(ctx context.Context, oneNode bool, opCtx *gr
 				selectedFields = append(selectedFields, webhook.FieldEnabled)
 				fieldSeen[webhook.FieldEnabled] = struct{}{}
 			}
+		case "eventTypes":
+			if _, ok := fieldSeen[webhook.FieldEventTypes]; !ok {
+				selectedFields = append(selectedFields, webhook.FieldEventTypes)
+				fieldSeen[webhook.FieldEventTypes] = struct{}{}
+			}
+		case "objectTypes":
+			if _, ok := fieldSeen[webhook.FieldObjectTypes]; !ok {
+				selectedFields = append(selectedFields, webhook.FieldObjectTypes)
+				fieldSeen[webhook.FieldObjectTypes] = struct{}{}
+			}
 		case "failures":
 			if _, ok := fieldSeen[webhook.FieldFailures]; !ok {
 				selectedFields = append(selectedFields, webhook.FieldFailures)
@@ -9221,6 +9685,205 @@ func newWebhookPaginateArgs(rv map[string]any) *webhookPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (wd *WebhookDeliveryQuery) CollectFields(ctx context.Context, satisfies ...string) (*WebhookDeliveryQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return wd, nil
+	}
+	if err := wd.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return wd, nil
+}
+
+func (wd *WebhookDeliveryQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(webhookdelivery.Columns))
+		selectedFields = []string{webhookdelivery.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OrganizationClient{config: wd.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, organizationImplementors)...); err != nil {
+				return err
+			}
+			wd.withOwner = query
+			if _, ok := fieldSeen[webhookdelivery.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldOwnerID)
+				fieldSeen[webhookdelivery.FieldOwnerID] = struct{}{}
+			}
+
+		case "webhook":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&WebhookClient{config: wd.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, webhookImplementors)...); err != nil {
+				return err
+			}
+			wd.withWebhook = query
+			if _, ok := fieldSeen[webhookdelivery.FieldWebhookID]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldWebhookID)
+				fieldSeen[webhookdelivery.FieldWebhookID] = struct{}{}
+			}
+
+		case "event":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&EventClient{config: wd.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, eventImplementors)...); err != nil {
+				return err
+			}
+			wd.withEvent = query
+			if _, ok := fieldSeen[webhookdelivery.FieldEventID]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldEventID)
+				fieldSeen[webhookdelivery.FieldEventID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[webhookdelivery.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldCreatedAt)
+				fieldSeen[webhookdelivery.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[webhookdelivery.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldUpdatedAt)
+				fieldSeen[webhookdelivery.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[webhookdelivery.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldCreatedBy)
+				fieldSeen[webhookdelivery.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[webhookdelivery.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldUpdatedBy)
+				fieldSeen[webhookdelivery.FieldUpdatedBy] = struct{}{}
+			}
+		case "ownerID":
+			if _, ok := fieldSeen[webhookdelivery.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldOwnerID)
+				fieldSeen[webhookdelivery.FieldOwnerID] = struct{}{}
+			}
+		case "webhookID":
+			if _, ok := fieldSeen[webhookdelivery.FieldWebhookID]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldWebhookID)
+				fieldSeen[webhookdelivery.FieldWebhookID] = struct{}{}
+			}
+		case "eventID":
+			if _, ok := fieldSeen[webhookdelivery.FieldEventID]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldEventID)
+				fieldSeen[webhookdelivery.FieldEventID] = struct{}{}
+			}
+		case "attempt":
+			if _, ok := fieldSeen[webhookdelivery.FieldAttempt]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldAttempt)
+				fieldSeen[webhookdelivery.FieldAttempt] = struct{}{}
+			}
+		case "redelivery":
+			if _, ok := fieldSeen[webhookdelivery.FieldRedelivery]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldRedelivery)
+				fieldSeen[webhookdelivery.FieldRedelivery] = struct{}{}
+			}
+		case "requestBody":
+			if _, ok := fieldSeen[webhookdelivery.FieldRequestBody]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldRequestBody)
+				fieldSeen[webhookdelivery.FieldRequestBody] = struct{}{}
+			}
+		case "responseStatus":
+			if _, ok := fieldSeen[webhookdelivery.FieldResponseStatus]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldResponseStatus)
+				fieldSeen[webhookdelivery.FieldResponseStatus] = struct{}{}
+			}
+		case "responseBody":
+			if _, ok := fieldSeen[webhookdelivery.FieldResponseBody]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldResponseBody)
+				fieldSeen[webhookdelivery.FieldResponseBody] = struct{}{}
+			}
+		case "latency":
+			if _, ok := fieldSeen[webhookdelivery.FieldLatency]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldLatency)
+				fieldSeen[webhookdelivery.FieldLatency] = struct{}{}
+			}
+		case "error":
+			if _, ok := fieldSeen[webhookdelivery.FieldError]; !ok {
+				selectedFields = append(selectedFields, webhookdelivery.FieldError)
+				fieldSeen[webhookdelivery.FieldError] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		wd.Select(selectedFields...)
+	}
+	return nil
+}
+
+type webhookdeliveryPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []WebhookDeliveryPaginateOption
+}
+
+func newWebhookDeliveryPaginateArgs(rv map[string]any) *webhookdeliveryPaginateArgs {
+	args := &webhookdeliveryPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &WebhookDeliveryOrder{Field: &WebhookDeliveryOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithWebhookDeliveryOrder(order))
+			}
+		case *WebhookDeliveryOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithWebhookDeliveryOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*WebhookDeliveryWhereInput); ok {
+		args.opts = append(args.opts, WithWebhookDeliveryFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (wh *WebhookHistoryQuery) CollectFields(ctx context.Context, satisfies ...string) (*WebhookHistoryQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -9317,6 +9980,16 @@ func (wh *WebhookHistoryQuery) collectField(ctx context.Context, oneNode bool, o
 				selectedFields = append(selectedFields, webhookhistory.FieldEnabled)
 				fieldSeen[webhookhistory.FieldEnabled] = struct{}{}
 			}
+		case "eventTypes":
+			if _, ok := fieldSeen[webhookhistory.FieldEventTypes]; !ok {
+				selectedFields = append(selectedFields, webhookhistory.FieldEventTypes)
+				fieldSeen[webhookhistory.FieldEventTypes] = struct{}{}
+			}
+		case "objectTypes":
+			if _, ok := fieldSeen[webhookhistory.FieldObjectTypes]; !ok {
+				selectedFields = append(selectedFields, webhookhistory.FieldObjectTypes)
+				fieldSeen[webhookhistory.FieldObjectTypes] = struct{}{}
+			}
 		case "failures":
 			if _, ok := fieldSeen[webhookhistory.FieldFailures]; !ok {
 				selectedFields = append(selectedFields, webhookhistory.FieldFailures)
@@ -468,6 +468,18 @@ func (e *Event) Subscriber(ctx context.Context) (result []*Subscriber, err error
 	return result, err
 }
 
+func (e *Event) WebhookDeliveries(ctx context.Context) (result []*WebhookDelivery, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = e.NamedWebhookDeliveries(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = e.Edges.WebhookDeliveriesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = e.QueryWebhookDeliveries().All(ctx)
+	}
+	return result, err
+}
+
 func (f *Feature) Owner(ctx context.Context) (*Organization, error) {
 	result, err := f.Edges.OwnerOrErr()
 	if IsNotLoaded(err) {
@@ -1269,6 +1281,54 @@ func (t *Template) Documents(ctx context.Context) (result []*DocumentData, err e
 	return result, err
 }
 
+func (t *Template) Versions(ctx context.Context) (result []*TemplateVersion, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = t.NamedVersions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = t.Edges.VersionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = t.QueryVersions().All(ctx)
+	}
+	return result, err
+}
+
+func (t *Template) SourceTemplate(ctx context.Context) (*Template, error) {
+	result, err := t.Edges.SourceTemplateOrErr()
+	if IsNotLoaded(err) {
+		result, err = t.QuerySourceTemplate().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (t *Template) Clones(ctx context.Context) (result []*Template, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = t.NamedClones(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = t.Edges.ClonesOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = t.QueryClones().All(ctx)
+	}
+	return result, err
+}
+
+func (tv *TemplateVersion) Owner(ctx context.Context) (*Organization, error) {
+	result, err := tv.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = tv.QueryOwner().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (tv *TemplateVersion) Template(ctx context.Context) (*Template, error) {
+	result, err := tv.Edges.TemplateOrErr()
+	if IsNotLoaded(err) {
+		result, err = tv.QueryTemplate().Only(ctx)
+	}
+	return result, err
+}
+
 func (u *User) PersonalAccessTokens(ctx context.Context) (result []*PersonalAccessToken, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = u.NamedPersonalAccessTokens(graphql.GetFieldContext(ctx).Field.Alias)
@@ -1409,6 +1469,27 @@ func (w *Webhook) Events(ctx context.Context) (result []*Event, err error) {
 	return result, err
 }
 
+func (w *Webhook) Deliveries(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *WebhookDeliveryOrder, where *WebhookDeliveryWhereInput,
+) (*WebhookDeliveryConnection, error) {
+	opts := []WebhookDeliveryPaginateOption{
+		WithWebhookDeliveryOrder(orderBy),
+		WithWebhookDeliveryFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := w.Edges.totalCount[2][alias]
+	if nodes, err := w.NamedDeliveries(alias); err == nil || hasTotalCount {
+		pager, err := newWebhookDeliveryPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &WebhookDeliveryConnection{Edges: []*WebhookDeliveryEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return w.QueryDeliveries().Paginate(ctx, after, first, before, last, opts...)
+}
+
 func (w *Webhook) Integrations(ctx context.Context) (result []*Integration, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = w.NamedIntegrations(graphql.GetFieldContext(ctx).Field.Alias)
@@ -1420,3 +1501,27 @@ func (w *Webhook) Integrations(ctx context.Context) (result []*Integration, err
 	}
 	return result, err
 }
+
+func (wd *WebhookDelivery) Owner(ctx context.Context) (*Organization, error) {
+	result, err := wd.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = wd.QueryOwner().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (wd *WebhookDelivery) Webhook(ctx context.Context) (*Webhook, error) {
+	result, err := wd.Edges.WebhookOrErr()
+	if IsNotLoaded(err) {
+		result, err = wd.QueryWebhook().Only(ctx)
+	}
+	return result, err
+}
+
+func (wd *WebhookDelivery) Event(ctx context.Context) (*Event, error) {
+	result, err := wd.Edges.EventOrErr()
+	if IsNotLoaded(err) {
+		result, err = wd.QueryEvent().Only(ctx)
+	}
+	return result, err
+}
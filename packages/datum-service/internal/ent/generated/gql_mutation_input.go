@@ -2449,6 +2449,7 @@ type CreateOauthProviderInput struct {
 	TokenURL     string
 	AuthStyle    customtypes.Uint8
 	InfoURL      string
+	Issuer       *string
 	OwnerID      *string
 }
 
@@ -2466,6 +2467,9 @@ func (i *CreateOauthProviderInput) Mutate(m *OauthProviderMutation) {
 	m.SetTokenURL(i.TokenURL)
 	m.SetAuthStyle(i.AuthStyle)
 	m.SetInfoURL(i.InfoURL)
+	if v := i.Issuer; v != nil {
+		m.SetIssuer(*v)
+	}
 	if v := i.OwnerID; v != nil {
 		m.SetOwnerID(*v)
 	}
@@ -2491,6 +2495,8 @@ type UpdateOauthProviderInput struct {
 	TokenURL     *string
 	AuthStyle    *customtypes.Uint8
 	InfoURL      *string
+	ClearIssuer  bool
+	Issuer       *string
 	ClearOwner   bool
 	OwnerID      *string
 }
@@ -2533,6 +2539,12 @@ func (i *UpdateOauthProviderInput) Mutate(m *OauthProviderMutation) {
 	if v := i.InfoURL; v != nil {
 		m.SetInfoURL(*v)
 	}
+	if i.ClearIssuer {
+		m.ClearIssuer()
+	}
+	if v := i.Issuer; v != nil {
+		m.SetIssuer(*v)
+	}
 	if i.ClearOwner {
 		m.ClearOwner()
 	}
@@ -3267,15 +3279,20 @@ func (c *OrganizationUpdateOne) SetInput(i UpdateOrganizationInput) *Organizatio
 
 // CreateOrganizationSettingInput represents a mutation input for creating organizationsettings.
 type CreateOrganizationSettingInput struct {
-	Tags           []string
-	Domains        []string
-	BillingContact *string
-	BillingEmail   *string
-	BillingPhone   *string
-	BillingAddress *string
-	TaxIdentifier  *string
-	GeoLocation    *enums.Region
-	OrganizationID *string
+	Tags                 []string
+	Domains              []string
+	BillingContact       *string
+	BillingEmail         *string
+	BillingPhone         *string
+	BillingAddress       *string
+	TaxIdentifier        *string
+	GeoLocation          *enums.Region
+	SSOEnforced          *bool
+	SamlMetadataURL      *string
+	SamlMetadata         *string
+	SamlAttributeMapping map[string]interface{}
+	JoinPolicy           *enums.OrgJoinPolicy
+	OrganizationID       *string
 }
 
 // Mutate applies the CreateOrganizationSettingInput on the OrganizationSettingMutation builder.
@@ -3304,6 +3321,21 @@ func (i *CreateOrganizationSettingInput) Mutate(m *OrganizationSettingMutation)
 	if v := i.GeoLocation; v != nil {
 		m.SetGeoLocation(*v)
 	}
+	if v := i.SSOEnforced; v != nil {
+		m.SetSSOEnforced(*v)
+	}
+	if v := i.SamlMetadataURL; v != nil {
+		m.SetSamlMetadataURL(*v)
+	}
+	if v := i.SamlMetadata; v != nil {
+		m.SetSamlMetadata(*v)
+	}
+	if v := i.SamlAttributeMapping; v != nil {
+		m.SetSamlAttributeMapping(v)
+	}
+	if v := i.JoinPolicy; v != nil {
+		m.SetJoinPolicy(*v)
+	}
 	if v := i.OrganizationID; v != nil {
 		m.SetOrganizationID(*v)
 	}
@@ -3317,26 +3349,34 @@ func (c *OrganizationSettingCreate) SetInput(i CreateOrganizationSettingInput) *
 
 // UpdateOrganizationSettingInput represents a mutation input for updating organizationsettings.
 type UpdateOrganizationSettingInput struct {
-	ClearTags           bool
-	Tags                []string
-	AppendTags          []string
-	ClearDomains        bool
-	Domains             []string
-	AppendDomains       []string
-	ClearBillingContact bool
-	BillingContact      *string
-	ClearBillingEmail   bool
-	BillingEmail        *string
-	ClearBillingPhone   bool
-	BillingPhone        *string
-	ClearBillingAddress bool
-	BillingAddress      *string
-	ClearTaxIdentifier  bool
-	TaxIdentifier       *string
-	ClearGeoLocation    bool
-	GeoLocation         *enums.Region
-	ClearOrganization   bool
-	OrganizationID      *string
+	ClearTags                 bool
+	Tags                      []string
+	AppendTags                []string
+	ClearDomains              bool
+	Domains                   []string
+	AppendDomains             []string
+	ClearBillingContact       bool
+	BillingContact            *string
+	ClearBillingEmail         bool
+	BillingEmail              *string
+	ClearBillingPhone         bool
+	BillingPhone              *string
+	ClearBillingAddress       bool
+	BillingAddress            *string
+	ClearTaxIdentifier        bool
+	TaxIdentifier             *string
+	ClearGeoLocation          bool
+	GeoLocation               *enums.Region
+	SSOEnforced               *bool
+	ClearSamlMetadataURL      bool
+	SamlMetadataURL           *string
+	ClearSamlMetadata         bool
+	SamlMetadata              *string
+	ClearSamlAttributeMapping bool
+	SamlAttributeMapping      map[string]interface{}
+	JoinPolicy                *enums.OrgJoinPolicy
+	ClearOrganization         bool
+	OrganizationID            *string
 }
 
 // Mutate applies the UpdateOrganizationSettingInput on the OrganizationSettingMutation builder.
@@ -3395,6 +3435,30 @@ func (i *UpdateOrganizationSettingInput) Mutate(m *OrganizationSettingMutation)
 	if v := i.GeoLocation; v != nil {
 		m.SetGeoLocation(*v)
 	}
+	if v := i.SSOEnforced; v != nil {
+		m.SetSSOEnforced(*v)
+	}
+	if i.ClearSamlMetadataURL {
+		m.ClearSamlMetadataURL()
+	}
+	if v := i.SamlMetadataURL; v != nil {
+		m.SetSamlMetadataURL(*v)
+	}
+	if i.ClearSamlMetadata {
+		m.ClearSamlMetadata()
+	}
+	if v := i.SamlMetadata; v != nil {
+		m.SetSamlMetadata(*v)
+	}
+	if i.ClearSamlAttributeMapping {
+		m.ClearSamlAttributeMapping()
+	}
+	if v := i.SamlAttributeMapping; v != nil {
+		m.SetSamlAttributeMapping(v)
+	}
+	if v := i.JoinPolicy; v != nil {
+		m.SetJoinPolicy(*v)
+	}
 	if i.ClearOrganization {
 		m.ClearOrganization()
 	}
@@ -3676,7 +3740,6 @@ type UpdateTFASettingInput struct {
 	ClearTags        bool
 	Tags             []string
 	AppendTags       []string
-	Verified         *bool
 	ClearTotpAllowed bool
 	TotpAllowed      *bool
 }
@@ -3692,9 +3755,6 @@ func (i *UpdateTFASettingInput) Mutate(m *TFASettingMutation) {
 	if i.AppendTags != nil {
 		m.AppendTags(i.Tags)
 	}
-	if v := i.Verified; v != nil {
-		m.SetVerified(*v)
-	}
 	if i.ClearTotpAllowed {
 		m.ClearTotpAllowed()
 	}
@@ -3717,14 +3777,17 @@ func (c *TFASettingUpdateOne) SetInput(i UpdateTFASettingInput) *TFASettingUpdat
 
 // CreateTemplateInput represents a mutation input for creating templates.
 type CreateTemplateInput struct {
-	Tags         []string
-	Name         string
-	TemplateType *enums.DocumentType
-	Description  *string
-	Jsonconfig   customtypes.JSONObject
-	Uischema     customtypes.JSONObject
-	OwnerID      *string
-	DocumentIDs  []string
+	Tags             []string
+	Name             string
+	TemplateType     *enums.DocumentType
+	Description      *string
+	Jsonconfig       customtypes.JSONObject
+	Uischema         customtypes.JSONObject
+	OwnerID          *string
+	DocumentIDs      []string
+	VersionIDs       []string
+	SourceTemplateID *string
+	CloneIDs         []string
 }
 
 // Mutate applies the CreateTemplateInput on the TemplateMutation builder.
@@ -3751,6 +3814,15 @@ func (i *CreateTemplateInput) Mutate(m *TemplateMutation) {
 	if v := i.DocumentIDs; len(v) > 0 {
 		m.AddDocumentIDs(v...)
 	}
+	if v := i.VersionIDs; len(v) > 0 {
+		m.AddVersionIDs(v...)
+	}
+	if v := i.SourceTemplateID; v != nil {
+		m.SetSourceTemplateID(*v)
+	}
+	if v := i.CloneIDs; len(v) > 0 {
+		m.AddCloneIDs(v...)
+	}
 }
 
 // SetInput applies the change-set in the CreateTemplateInput on the TemplateCreate builder.
@@ -3776,6 +3848,12 @@ type UpdateTemplateInput struct {
 	ClearDocuments    bool
 	AddDocumentIDs    []string
 	RemoveDocumentIDs []string
+	ClearVersions     bool
+	AddVersionIDs     []string
+	RemoveVersionIDs  []string
+	ClearClones       bool
+	AddCloneIDs       []string
+	RemoveCloneIDs    []string
 }
 
 // Mutate applies the UpdateTemplateInput on the TemplateMutation builder.
@@ -3825,6 +3903,24 @@ func (i *UpdateTemplateInput) Mutate(m *TemplateMutation) {
 	if v := i.RemoveDocumentIDs; len(v) > 0 {
 		m.RemoveDocumentIDs(v...)
 	}
+	if i.ClearVersions {
+		m.ClearVersions()
+	}
+	if v := i.AddVersionIDs; len(v) > 0 {
+		m.AddVersionIDs(v...)
+	}
+	if v := i.RemoveVersionIDs; len(v) > 0 {
+		m.RemoveVersionIDs(v...)
+	}
+	if i.ClearClones {
+		m.ClearClones()
+	}
+	if v := i.AddCloneIDs; len(v) > 0 {
+		m.AddCloneIDs(v...)
+	}
+	if v := i.RemoveCloneIDs; len(v) > 0 {
+		m.RemoveCloneIDs(v...)
+	}
 }
 
 // SetInput applies the change-set in the UpdateTemplateInput on the TemplateUpdate builder.
@@ -4320,6 +4416,8 @@ type CreateWebhookInput struct {
 	Description    *string
 	DestinationURL string
 	Enabled        *bool
+	EventTypes     []string
+	ObjectTypes    []string
 	Failures       *int
 	LastError      *string
 	LastResponse   *string
@@ -4341,6 +4439,12 @@ func (i *CreateWebhookInput) Mutate(m *WebhookMutation) {
 	if v := i.Enabled; v != nil {
 		m.SetEnabled(*v)
 	}
+	if v := i.EventTypes; v != nil {
+		m.SetEventTypes(v)
+	}
+	if v := i.ObjectTypes; v != nil {
+		m.SetObjectTypes(v)
+	}
 	if v := i.Failures; v != nil {
 		m.SetFailures(*v)
 	}
@@ -4377,6 +4481,12 @@ type UpdateWebhookInput struct {
 	Description          *string
 	DestinationURL       *string
 	Enabled              *bool
+	ClearEventTypes      bool
+	EventTypes           []string
+	AppendEventTypes     []string
+	ClearObjectTypes     bool
+	ObjectTypes          []string
+	AppendObjectTypes    []string
 	ClearFailures        bool
 	Failures             *int
 	ClearLastError       bool
@@ -4419,6 +4529,24 @@ func (i *UpdateWebhookInput) Mutate(m *WebhookMutation) {
 	if v := i.Enabled; v != nil {
 		m.SetEnabled(*v)
 	}
+	if i.ClearEventTypes {
+		m.ClearEventTypes()
+	}
+	if v := i.EventTypes; v != nil {
+		m.SetEventTypes(v)
+	}
+	if i.AppendEventTypes != nil {
+		m.AppendEventTypes(i.EventTypes)
+	}
+	if i.ClearObjectTypes {
+		m.ClearObjectTypes()
+	}
+	if v := i.ObjectTypes; v != nil {
+		m.SetObjectTypes(v)
+	}
+	if i.AppendObjectTypes != nil {
+		m.AppendObjectTypes(i.ObjectTypes)
+	}
 	if i.ClearFailures {
 		m.ClearFailures()
 	}
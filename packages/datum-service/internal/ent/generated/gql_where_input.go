@@ -56,12 +56,14 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/subscriber"
 	"github.com/datumforge/datum/internal/ent/generated/template"
 	"github.com/datumforge/datum/internal/ent/generated/templatehistory"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
 	"github.com/datumforge/datum/internal/ent/generated/tfasetting"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/userhistory"
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
 	"github.com/datumforge/datum/internal/ent/generated/usersettinghistory"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 	"github.com/datumforge/datum/internal/ent/generated/webhookhistory"
 	"github.com/datumforge/datum/pkg/enums"
 	"github.com/datumforge/enthistory"
@@ -2805,6 +2807,18 @@ type DocumentDataWhereInput struct {
 	TemplateIDEqualFold    *string  `json:"templateIDEqualFold,omitempty"`
 	TemplateIDContainsFold *string  `json:"templateIDContainsFold,omitempty"`
 
+	// "template_version" field predicates.
+	TemplateVersion       *int  `json:"templateVersion,omitempty"`
+	TemplateVersionNEQ    *int  `json:"templateVersionNEQ,omitempty"`
+	TemplateVersionIn     []int `json:"templateVersionIn,omitempty"`
+	TemplateVersionNotIn  []int `json:"templateVersionNotIn,omitempty"`
+	TemplateVersionGT     *int  `json:"templateVersionGT,omitempty"`
+	TemplateVersionGTE    *int  `json:"templateVersionGTE,omitempty"`
+	TemplateVersionLT     *int  `json:"templateVersionLT,omitempty"`
+	TemplateVersionLTE    *int  `json:"templateVersionLTE,omitempty"`
+	TemplateVersionIsNil  bool  `json:"templateVersionIsNil,omitempty"`
+	TemplateVersionNotNil bool  `json:"templateVersionNotNil,omitempty"`
+
 	// "owner" edge predicates.
 	HasOwner     *bool                     `json:"hasOwner,omitempty"`
 	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
@@ -3228,6 +3242,36 @@ func (i *DocumentDataWhereInput) P() (predicate.DocumentData, error) {
 	if i.TemplateIDContainsFold != nil {
 		predicates = append(predicates, documentdata.TemplateIDContainsFold(*i.TemplateIDContainsFold))
 	}
+	if i.TemplateVersion != nil {
+		predicates = append(predicates, documentdata.TemplateVersionEQ(*i.TemplateVersion))
+	}
+	if i.TemplateVersionNEQ != nil {
+		predicates = append(predicates, documentdata.TemplateVersionNEQ(*i.TemplateVersionNEQ))
+	}
+	if len(i.TemplateVersionIn) > 0 {
+		predicates = append(predicates, documentdata.TemplateVersionIn(i.TemplateVersionIn...))
+	}
+	if len(i.TemplateVersionNotIn) > 0 {
+		predicates = append(predicates, documentdata.TemplateVersionNotIn(i.TemplateVersionNotIn...))
+	}
+	if i.TemplateVersionGT != nil {
+		predicates = append(predicates, documentdata.TemplateVersionGT(*i.TemplateVersionGT))
+	}
+	if i.TemplateVersionGTE != nil {
+		predicates = append(predicates, documentdata.TemplateVersionGTE(*i.TemplateVersionGTE))
+	}
+	if i.TemplateVersionLT != nil {
+		predicates = append(predicates, documentdata.TemplateVersionLT(*i.TemplateVersionLT))
+	}
+	if i.TemplateVersionLTE != nil {
+		predicates = append(predicates, documentdata.TemplateVersionLTE(*i.TemplateVersionLTE))
+	}
+	if i.TemplateVersionIsNil {
+		predicates = append(predicates, documentdata.TemplateVersionIsNil())
+	}
+	if i.TemplateVersionNotNil {
+		predicates = append(predicates, documentdata.TemplateVersionNotNil())
+	}
 
 	if i.HasOwner != nil {
 		p := documentdata.HasOwner()
@@ -3463,6 +3507,18 @@ type DocumentDataHistoryWhereInput struct {
 	TemplateIDHasSuffix    *string  `json:"templateIDHasSuffix,omitempty"`
 	TemplateIDEqualFold    *string  `json:"templateIDEqualFold,omitempty"`
 	TemplateIDContainsFold *string  `json:"templateIDContainsFold,omitempty"`
+
+	// "template_version" field predicates.
+	TemplateVersion       *int  `json:"templateVersion,omitempty"`
+	TemplateVersionNEQ    *int  `json:"templateVersionNEQ,omitempty"`
+	TemplateVersionIn     []int `json:"templateVersionIn,omitempty"`
+	TemplateVersionNotIn  []int `json:"templateVersionNotIn,omitempty"`
+	TemplateVersionGT     *int  `json:"templateVersionGT,omitempty"`
+	TemplateVersionGTE    *int  `json:"templateVersionGTE,omitempty"`
+	TemplateVersionLT     *int  `json:"templateVersionLT,omitempty"`
+	TemplateVersionLTE    *int  `json:"templateVersionLTE,omitempty"`
+	TemplateVersionIsNil  bool  `json:"templateVersionIsNil,omitempty"`
+	TemplateVersionNotNil bool  `json:"templateVersionNotNil,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -3956,6 +4012,36 @@ func (i *DocumentDataHistoryWhereInput) P() (predicate.DocumentDataHistory, erro
 	if i.TemplateIDContainsFold != nil {
 		predicates = append(predicates, documentdatahistory.TemplateIDContainsFold(*i.TemplateIDContainsFold))
 	}
+	if i.TemplateVersion != nil {
+		predicates = append(predicates, documentdatahistory.TemplateVersionEQ(*i.TemplateVersion))
+	}
+	if i.TemplateVersionNEQ != nil {
+		predicates = append(predicates, documentdatahistory.TemplateVersionNEQ(*i.TemplateVersionNEQ))
+	}
+	if len(i.TemplateVersionIn) > 0 {
+		predicates = append(predicates, documentdatahistory.TemplateVersionIn(i.TemplateVersionIn...))
+	}
+	if len(i.TemplateVersionNotIn) > 0 {
+		predicates = append(predicates, documentdatahistory.TemplateVersionNotIn(i.TemplateVersionNotIn...))
+	}
+	if i.TemplateVersionGT != nil {
+		predicates = append(predicates, documentdatahistory.TemplateVersionGT(*i.TemplateVersionGT))
+	}
+	if i.TemplateVersionGTE != nil {
+		predicates = append(predicates, documentdatahistory.TemplateVersionGTE(*i.TemplateVersionGTE))
+	}
+	if i.TemplateVersionLT != nil {
+		predicates = append(predicates, documentdatahistory.TemplateVersionLT(*i.TemplateVersionLT))
+	}
+	if i.TemplateVersionLTE != nil {
+		predicates = append(predicates, documentdatahistory.TemplateVersionLTE(*i.TemplateVersionLTE))
+	}
+	if i.TemplateVersionIsNil {
+		predicates = append(predicates, documentdatahistory.TemplateVersionIsNil())
+	}
+	if i.TemplateVersionNotNil {
+		predicates = append(predicates, documentdatahistory.TemplateVersionNotNil())
+	}
 
 	switch len(predicates) {
 	case 0:
@@ -12120,6 +12206,10 @@ type EventWhereInput struct {
 	// "subscriber" edge predicates.
 	HasSubscriber     *bool                   `json:"hasSubscriber,omitempty"`
 	HasSubscriberWith []*SubscriberWhereInput `json:"hasSubscriberWith,omitempty"`
+
+	// "webhook_deliveries" edge predicates.
+	HasWebhookDeliveries     *bool                        `json:"hasWebhookDeliveries,omitempty"`
+	HasWebhookDeliveriesWith []*WebhookDeliveryWhereInput `json:"hasWebhookDeliveriesWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -12791,6 +12881,24 @@ func (i *EventWhereInput) P() (predicate.Event, error) {
 		}
 		predicates = append(predicates, event.HasSubscriberWith(with...))
 	}
+	if i.HasWebhookDeliveries != nil {
+		p := event.HasWebhookDeliveries()
+		if !*i.HasWebhookDeliveries {
+			p = event.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasWebhookDeliveriesWith) > 0 {
+		with := make([]predicate.WebhookDelivery, 0, len(i.HasWebhookDeliveriesWith))
+		for _, w := range i.HasWebhookDeliveriesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasWebhookDeliveriesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, event.HasWebhookDeliveriesWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
 		return nil, ErrEmptyEventWhereInput
@@ -26186,6 +26294,23 @@ type OauthProviderWhereInput struct {
 	InfoURLEqualFold    *string  `json:"infoURLEqualFold,omitempty"`
 	InfoURLContainsFold *string  `json:"infoURLContainsFold,omitempty"`
 
+	// "issuer" field predicates.
+	Issuer             *string  `json:"issuer,omitempty"`
+	IssuerNEQ          *string  `json:"issuerNEQ,omitempty"`
+	IssuerIn           []string `json:"issuerIn,omitempty"`
+	IssuerNotIn        []string `json:"issuerNotIn,omitempty"`
+	IssuerGT           *string  `json:"issuerGT,omitempty"`
+	IssuerGTE          *string  `json:"issuerGTE,omitempty"`
+	IssuerLT           *string  `json:"issuerLT,omitempty"`
+	IssuerLTE          *string  `json:"issuerLTE,omitempty"`
+	IssuerContains     *string  `json:"issuerContains,omitempty"`
+	IssuerHasPrefix    *string  `json:"issuerHasPrefix,omitempty"`
+	IssuerHasSuffix    *string  `json:"issuerHasSuffix,omitempty"`
+	IssuerIsNil        bool     `json:"issuerIsNil,omitempty"`
+	IssuerNotNil       bool     `json:"issuerNotNil,omitempty"`
+	IssuerEqualFold    *string  `json:"issuerEqualFold,omitempty"`
+	IssuerContainsFold *string  `json:"issuerContainsFold,omitempty"`
+
 	// "owner" edge predicates.
 	HasOwner     *bool                     `json:"hasOwner,omitempty"`
 	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
@@ -26898,6 +27023,51 @@ func (i *OauthProviderWhereInput) P() (predicate.OauthProvider, error) {
 	if i.InfoURLContainsFold != nil {
 		predicates = append(predicates, oauthprovider.InfoURLContainsFold(*i.InfoURLContainsFold))
 	}
+	if i.Issuer != nil {
+		predicates = append(predicates, oauthprovider.IssuerEQ(*i.Issuer))
+	}
+	if i.IssuerNEQ != nil {
+		predicates = append(predicates, oauthprovider.IssuerNEQ(*i.IssuerNEQ))
+	}
+	if len(i.IssuerIn) > 0 {
+		predicates = append(predicates, oauthprovider.IssuerIn(i.IssuerIn...))
+	}
+	if len(i.IssuerNotIn) > 0 {
+		predicates = append(predicates, oauthprovider.IssuerNotIn(i.IssuerNotIn...))
+	}
+	if i.IssuerGT != nil {
+		predicates = append(predicates, oauthprovider.IssuerGT(*i.IssuerGT))
+	}
+	if i.IssuerGTE != nil {
+		predicates = append(predicates, oauthprovider.IssuerGTE(*i.IssuerGTE))
+	}
+	if i.IssuerLT != nil {
+		predicates = append(predicates, oauthprovider.IssuerLT(*i.IssuerLT))
+	}
+	if i.IssuerLTE != nil {
+		predicates = append(predicates, oauthprovider.IssuerLTE(*i.IssuerLTE))
+	}
+	if i.IssuerContains != nil {
+		predicates = append(predicates, oauthprovider.IssuerContains(*i.IssuerContains))
+	}
+	if i.IssuerHasPrefix != nil {
+		predicates = append(predicates, oauthprovider.IssuerHasPrefix(*i.IssuerHasPrefix))
+	}
+	if i.IssuerHasSuffix != nil {
+		predicates = append(predicates, oauthprovider.IssuerHasSuffix(*i.IssuerHasSuffix))
+	}
+	if i.IssuerIsNil {
+		predicates = append(predicates, oauthprovider.IssuerIsNil())
+	}
+	if i.IssuerNotNil {
+		predicates = append(predicates, oauthprovider.IssuerNotNil())
+	}
+	if i.IssuerEqualFold != nil {
+		predicates = append(predicates, oauthprovider.IssuerEqualFold(*i.IssuerEqualFold))
+	}
+	if i.IssuerContainsFold != nil {
+		predicates = append(predicates, oauthprovider.IssuerContainsFold(*i.IssuerContainsFold))
+	}
 
 	if i.HasOwner != nil {
 		p := oauthprovider.HasOwner()
@@ -27212,6 +27382,23 @@ type OauthProviderHistoryWhereInput struct {
 	InfoURLHasSuffix    *string  `json:"infoURLHasSuffix,omitempty"`
 	InfoURLEqualFold    *string  `json:"infoURLEqualFold,omitempty"`
 	InfoURLContainsFold *string  `json:"infoURLContainsFold,omitempty"`
+
+	// "issuer" field predicates.
+	Issuer             *string  `json:"issuer,omitempty"`
+	IssuerNEQ          *string  `json:"issuerNEQ,omitempty"`
+	IssuerIn           []string `json:"issuerIn,omitempty"`
+	IssuerNotIn        []string `json:"issuerNotIn,omitempty"`
+	IssuerGT           *string  `json:"issuerGT,omitempty"`
+	IssuerGTE          *string  `json:"issuerGTE,omitempty"`
+	IssuerLT           *string  `json:"issuerLT,omitempty"`
+	IssuerLTE          *string  `json:"issuerLTE,omitempty"`
+	IssuerContains     *string  `json:"issuerContains,omitempty"`
+	IssuerHasPrefix    *string  `json:"issuerHasPrefix,omitempty"`
+	IssuerHasSuffix    *string  `json:"issuerHasSuffix,omitempty"`
+	IssuerIsNil        bool     `json:"issuerIsNil,omitempty"`
+	IssuerNotNil       bool     `json:"issuerNotNil,omitempty"`
+	IssuerEqualFold    *string  `json:"issuerEqualFold,omitempty"`
+	IssuerContainsFold *string  `json:"issuerContainsFold,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -28002,6 +28189,51 @@ func (i *OauthProviderHistoryWhereInput) P() (predicate.OauthProviderHistory, er
 	if i.InfoURLContainsFold != nil {
 		predicates = append(predicates, oauthproviderhistory.InfoURLContainsFold(*i.InfoURLContainsFold))
 	}
+	if i.Issuer != nil {
+		predicates = append(predicates, oauthproviderhistory.IssuerEQ(*i.Issuer))
+	}
+	if i.IssuerNEQ != nil {
+		predicates = append(predicates, oauthproviderhistory.IssuerNEQ(*i.IssuerNEQ))
+	}
+	if len(i.IssuerIn) > 0 {
+		predicates = append(predicates, oauthproviderhistory.IssuerIn(i.IssuerIn...))
+	}
+	if len(i.IssuerNotIn) > 0 {
+		predicates = append(predicates, oauthproviderhistory.IssuerNotIn(i.IssuerNotIn...))
+	}
+	if i.IssuerGT != nil {
+		predicates = append(predicates, oauthproviderhistory.IssuerGT(*i.IssuerGT))
+	}
+	if i.IssuerGTE != nil {
+		predicates = append(predicates, oauthproviderhistory.IssuerGTE(*i.IssuerGTE))
+	}
+	if i.IssuerLT != nil {
+		predicates = append(predicates, oauthproviderhistory.IssuerLT(*i.IssuerLT))
+	}
+	if i.IssuerLTE != nil {
+		predicates = append(predicates, oauthproviderhistory.IssuerLTE(*i.IssuerLTE))
+	}
+	if i.IssuerContains != nil {
+		predicates = append(predicates, oauthproviderhistory.IssuerContains(*i.IssuerContains))
+	}
+	if i.IssuerHasPrefix != nil {
+		predicates = append(predicates, oauthproviderhistory.IssuerHasPrefix(*i.IssuerHasPrefix))
+	}
+	if i.IssuerHasSuffix != nil {
+		predicates = append(predicates, oauthproviderhistory.IssuerHasSuffix(*i.IssuerHasSuffix))
+	}
+	if i.IssuerIsNil {
+		predicates = append(predicates, oauthproviderhistory.IssuerIsNil())
+	}
+	if i.IssuerNotNil {
+		predicates = append(predicates, oauthproviderhistory.IssuerNotNil())
+	}
+	if i.IssuerEqualFold != nil {
+		predicates = append(predicates, oauthproviderhistory.IssuerEqualFold(*i.IssuerEqualFold))
+	}
+	if i.IssuerContainsFold != nil {
+		predicates = append(predicates, oauthproviderhistory.IssuerContainsFold(*i.IssuerContainsFold))
+	}
 
 	switch len(predicates) {
 	case 0:
@@ -32135,6 +32367,67 @@ type OrganizationSettingWhereInput struct {
 	OrganizationIDEqualFold    *string  `json:"organizationIDEqualFold,omitempty"`
 	OrganizationIDContainsFold *string  `json:"organizationIDContainsFold,omitempty"`
 
+	// "sso_enforced" field predicates.
+	SSOEnforced    *bool `json:"ssoEnforced,omitempty"`
+	SSOEnforcedNEQ *bool `json:"ssoEnforcedNEQ,omitempty"`
+
+	// "saml_metadata_url" field predicates.
+	SamlMetadataURL             *string  `json:"samlMetadataURL,omitempty"`
+	SamlMetadataURLNEQ          *string  `json:"samlMetadataURLNEQ,omitempty"`
+	SamlMetadataURLIn           []string `json:"samlMetadataURLIn,omitempty"`
+	SamlMetadataURLNotIn        []string `json:"samlMetadataURLNotIn,omitempty"`
+	SamlMetadataURLGT           *string  `json:"samlMetadataURLGT,omitempty"`
+	SamlMetadataURLGTE          *string  `json:"samlMetadataURLGTE,omitempty"`
+	SamlMetadataURLLT           *string  `json:"samlMetadataURLLT,omitempty"`
+	SamlMetadataURLLTE          *string  `json:"samlMetadataURLLTE,omitempty"`
+	SamlMetadataURLContains     *string  `json:"samlMetadataURLContains,omitempty"`
+	SamlMetadataURLHasPrefix    *string  `json:"samlMetadataURLHasPrefix,omitempty"`
+	SamlMetadataURLHasSuffix    *string  `json:"samlMetadataURLHasSuffix,omitempty"`
+	SamlMetadataURLIsNil        bool     `json:"samlMetadataURLIsNil,omitempty"`
+	SamlMetadataURLNotNil       bool     `json:"samlMetadataURLNotNil,omitempty"`
+	SamlMetadataURLEqualFold    *string  `json:"samlMetadataURLEqualFold,omitempty"`
+	SamlMetadataURLContainsFold *string  `json:"samlMetadataURLContainsFold,omitempty"`
+
+	// "saml_metadata" field predicates.
+	SamlMetadata             *string  `json:"samlMetadata,omitempty"`
+	SamlMetadataNEQ          *string  `json:"samlMetadataNEQ,omitempty"`
+	SamlMetadataIn           []string `json:"samlMetadataIn,omitempty"`
+	SamlMetadataNotIn        []string `json:"samlMetadataNotIn,omitempty"`
+	SamlMetadataGT           *string  `json:"samlMetadataGT,omitempty"`
+	SamlMetadataGTE          *string  `json:"samlMetadataGTE,omitempty"`
+	SamlMetadataLT           *string  `json:"samlMetadataLT,omitempty"`
+	SamlMetadataLTE          *string  `json:"samlMetadataLTE,omitempty"`
+	SamlMetadataContains     *string  `json:"samlMetadataContains,omitempty"`
+	SamlMetadataHasPrefix    *string  `json:"samlMetadataHasPrefix,omitempty"`
+	SamlMetadataHasSuffix    *string  `json:"samlMetadataHasSuffix,omitempty"`
+	SamlMetadataIsNil        bool     `json:"samlMetadataIsNil,omitempty"`
+	SamlMetadataNotNil       bool     `json:"samlMetadataNotNil,omitempty"`
+	SamlMetadataEqualFold    *string  `json:"samlMetadataEqualFold,omitempty"`
+	SamlMetadataContainsFold *string  `json:"samlMetadataContainsFold,omitempty"`
+
+	// "domain_verification_token" field predicates.
+	DomainVerificationToken             *string  `json:"domainVerificationToken,omitempty"`
+	DomainVerificationTokenNEQ          *string  `json:"domainVerificationTokenNEQ,omitempty"`
+	DomainVerificationTokenIn           []string `json:"domainVerificationTokenIn,omitempty"`
+	DomainVerificationTokenNotIn        []string `json:"domainVerificationTokenNotIn,omitempty"`
+	DomainVerificationTokenGT           *string  `json:"domainVerificationTokenGT,omitempty"`
+	DomainVerificationTokenGTE          *string  `json:"domainVerificationTokenGTE,omitempty"`
+	DomainVerificationTokenLT           *string  `json:"domainVerificationTokenLT,omitempty"`
+	DomainVerificationTokenLTE          *string  `json:"domainVerificationTokenLTE,omitempty"`
+	DomainVerificationTokenContains     *string  `json:"domainVerificationTokenContains,omitempty"`
+	DomainVerificationTokenHasPrefix    *string  `json:"domainVerificationTokenHasPrefix,omitempty"`
+	DomainVerificationTokenHasSuffix    *string  `json:"domainVerificationTokenHasSuffix,omitempty"`
+	DomainVerificationTokenIsNil        bool     `json:"domainVerificationTokenIsNil,omitempty"`
+	DomainVerificationTokenNotNil       bool     `json:"domainVerificationTokenNotNil,omitempty"`
+	DomainVerificationTokenEqualFold    *string  `json:"domainVerificationTokenEqualFold,omitempty"`
+	DomainVerificationTokenContainsFold *string  `json:"domainVerificationTokenContainsFold,omitempty"`
+
+	// "join_policy" field predicates.
+	JoinPolicy      *enums.OrgJoinPolicy  `json:"joinPolicy,omitempty"`
+	JoinPolicyNEQ   *enums.OrgJoinPolicy  `json:"joinPolicyNEQ,omitempty"`
+	JoinPolicyIn    []enums.OrgJoinPolicy `json:"joinPolicyIn,omitempty"`
+	JoinPolicyNotIn []enums.OrgJoinPolicy `json:"joinPolicyNotIn,omitempty"`
+
 	// "organization" edge predicates.
 	HasOrganization     *bool                     `json:"hasOrganization,omitempty"`
 	HasOrganizationWith []*OrganizationWhereInput `json:"hasOrganizationWith,omitempty"`
@@ -32754,6 +33047,159 @@ func (i *OrganizationSettingWhereInput) P() (predicate.OrganizationSetting, erro
 	if i.OrganizationIDContainsFold != nil {
 		predicates = append(predicates, organizationsetting.OrganizationIDContainsFold(*i.OrganizationIDContainsFold))
 	}
+	if i.SSOEnforced != nil {
+		predicates = append(predicates, organizationsetting.SSOEnforcedEQ(*i.SSOEnforced))
+	}
+	if i.SSOEnforcedNEQ != nil {
+		predicates = append(predicates, organizationsetting.SSOEnforcedNEQ(*i.SSOEnforcedNEQ))
+	}
+	if i.SamlMetadataURL != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLEQ(*i.SamlMetadataURL))
+	}
+	if i.SamlMetadataURLNEQ != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLNEQ(*i.SamlMetadataURLNEQ))
+	}
+	if len(i.SamlMetadataURLIn) > 0 {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLIn(i.SamlMetadataURLIn...))
+	}
+	if len(i.SamlMetadataURLNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLNotIn(i.SamlMetadataURLNotIn...))
+	}
+	if i.SamlMetadataURLGT != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLGT(*i.SamlMetadataURLGT))
+	}
+	if i.SamlMetadataURLGTE != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLGTE(*i.SamlMetadataURLGTE))
+	}
+	if i.SamlMetadataURLLT != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLLT(*i.SamlMetadataURLLT))
+	}
+	if i.SamlMetadataURLLTE != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLLTE(*i.SamlMetadataURLLTE))
+	}
+	if i.SamlMetadataURLContains != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLContains(*i.SamlMetadataURLContains))
+	}
+	if i.SamlMetadataURLHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLHasPrefix(*i.SamlMetadataURLHasPrefix))
+	}
+	if i.SamlMetadataURLHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLHasSuffix(*i.SamlMetadataURLHasSuffix))
+	}
+	if i.SamlMetadataURLIsNil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLIsNil())
+	}
+	if i.SamlMetadataURLNotNil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLNotNil())
+	}
+	if i.SamlMetadataURLEqualFold != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLEqualFold(*i.SamlMetadataURLEqualFold))
+	}
+	if i.SamlMetadataURLContainsFold != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataURLContainsFold(*i.SamlMetadataURLContainsFold))
+	}
+	if i.SamlMetadata != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataEQ(*i.SamlMetadata))
+	}
+	if i.SamlMetadataNEQ != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataNEQ(*i.SamlMetadataNEQ))
+	}
+	if len(i.SamlMetadataIn) > 0 {
+		predicates = append(predicates, organizationsetting.SamlMetadataIn(i.SamlMetadataIn...))
+	}
+	if len(i.SamlMetadataNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.SamlMetadataNotIn(i.SamlMetadataNotIn...))
+	}
+	if i.SamlMetadataGT != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataGT(*i.SamlMetadataGT))
+	}
+	if i.SamlMetadataGTE != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataGTE(*i.SamlMetadataGTE))
+	}
+	if i.SamlMetadataLT != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataLT(*i.SamlMetadataLT))
+	}
+	if i.SamlMetadataLTE != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataLTE(*i.SamlMetadataLTE))
+	}
+	if i.SamlMetadataContains != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataContains(*i.SamlMetadataContains))
+	}
+	if i.SamlMetadataHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataHasPrefix(*i.SamlMetadataHasPrefix))
+	}
+	if i.SamlMetadataHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataHasSuffix(*i.SamlMetadataHasSuffix))
+	}
+	if i.SamlMetadataIsNil {
+		predicates = append(predicates, organizationsetting.SamlMetadataIsNil())
+	}
+	if i.SamlMetadataNotNil {
+		predicates = append(predicates, organizationsetting.SamlMetadataNotNil())
+	}
+	if i.SamlMetadataEqualFold != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataEqualFold(*i.SamlMetadataEqualFold))
+	}
+	if i.SamlMetadataContainsFold != nil {
+		predicates = append(predicates, organizationsetting.SamlMetadataContainsFold(*i.SamlMetadataContainsFold))
+	}
+	if i.DomainVerificationToken != nil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenEQ(*i.DomainVerificationToken))
+	}
+	if i.DomainVerificationTokenNEQ != nil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenNEQ(*i.DomainVerificationTokenNEQ))
+	}
+	if len(i.DomainVerificationTokenIn) > 0 {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenIn(i.DomainVerificationTokenIn...))
+	}
+	if len(i.DomainVerificationTokenNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenNotIn(i.DomainVerificationTokenNotIn...))
+	}
+	if i.DomainVerificationTokenGT != nil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenGT(*i.DomainVerificationTokenGT))
+	}
+	if i.DomainVerificationTokenGTE != nil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenGTE(*i.DomainVerificationTokenGTE))
+	}
+	if i.DomainVerificationTokenLT != nil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenLT(*i.DomainVerificationTokenLT))
+	}
+	if i.DomainVerificationTokenLTE != nil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenLTE(*i.DomainVerificationTokenLTE))
+	}
+	if i.DomainVerificationTokenContains != nil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenContains(*i.DomainVerificationTokenContains))
+	}
+	if i.DomainVerificationTokenHasPrefix != nil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenHasPrefix(*i.DomainVerificationTokenHasPrefix))
+	}
+	if i.DomainVerificationTokenHasSuffix != nil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenHasSuffix(*i.DomainVerificationTokenHasSuffix))
+	}
+	if i.DomainVerificationTokenIsNil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenIsNil())
+	}
+	if i.DomainVerificationTokenNotNil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenNotNil())
+	}
+	if i.DomainVerificationTokenEqualFold != nil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenEqualFold(*i.DomainVerificationTokenEqualFold))
+	}
+	if i.DomainVerificationTokenContainsFold != nil {
+		predicates = append(predicates, organizationsetting.DomainVerificationTokenContainsFold(*i.DomainVerificationTokenContainsFold))
+	}
+	if i.JoinPolicy != nil {
+		predicates = append(predicates, organizationsetting.JoinPolicyEQ(*i.JoinPolicy))
+	}
+	if i.JoinPolicyNEQ != nil {
+		predicates = append(predicates, organizationsetting.JoinPolicyNEQ(*i.JoinPolicyNEQ))
+	}
+	if len(i.JoinPolicyIn) > 0 {
+		predicates = append(predicates, organizationsetting.JoinPolicyIn(i.JoinPolicyIn...))
+	}
+	if len(i.JoinPolicyNotIn) > 0 {
+		predicates = append(predicates, organizationsetting.JoinPolicyNotIn(i.JoinPolicyNotIn...))
+	}
 
 	if i.HasOrganization != nil {
 		p := organizationsetting.HasOrganization()
@@ -33031,6 +33477,67 @@ type OrganizationSettingHistoryWhereInput struct {
 	OrganizationIDNotNil       bool     `json:"organizationIDNotNil,omitempty"`
 	OrganizationIDEqualFold    *string  `json:"organizationIDEqualFold,omitempty"`
 	OrganizationIDContainsFold *string  `json:"organizationIDContainsFold,omitempty"`
+
+	// "sso_enforced" field predicates.
+	SSOEnforced    *bool `json:"ssoEnforced,omitempty"`
+	SSOEnforcedNEQ *bool `json:"ssoEnforcedNEQ,omitempty"`
+
+	// "saml_metadata_url" field predicates.
+	SamlMetadataURL             *string  `json:"samlMetadataURL,omitempty"`
+	SamlMetadataURLNEQ          *string  `json:"samlMetadataURLNEQ,omitempty"`
+	SamlMetadataURLIn           []string `json:"samlMetadataURLIn,omitempty"`
+	SamlMetadataURLNotIn        []string `json:"samlMetadataURLNotIn,omitempty"`
+	SamlMetadataURLGT           *string  `json:"samlMetadataURLGT,omitempty"`
+	SamlMetadataURLGTE          *string  `json:"samlMetadataURLGTE,omitempty"`
+	SamlMetadataURLLT           *string  `json:"samlMetadataURLLT,omitempty"`
+	SamlMetadataURLLTE          *string  `json:"samlMetadataURLLTE,omitempty"`
+	SamlMetadataURLContains     *string  `json:"samlMetadataURLContains,omitempty"`
+	SamlMetadataURLHasPrefix    *string  `json:"samlMetadataURLHasPrefix,omitempty"`
+	SamlMetadataURLHasSuffix    *string  `json:"samlMetadataURLHasSuffix,omitempty"`
+	SamlMetadataURLIsNil        bool     `json:"samlMetadataURLIsNil,omitempty"`
+	SamlMetadataURLNotNil       bool     `json:"samlMetadataURLNotNil,omitempty"`
+	SamlMetadataURLEqualFold    *string  `json:"samlMetadataURLEqualFold,omitempty"`
+	SamlMetadataURLContainsFold *string  `json:"samlMetadataURLContainsFold,omitempty"`
+
+	// "saml_metadata" field predicates.
+	SamlMetadata             *string  `json:"samlMetadata,omitempty"`
+	SamlMetadataNEQ          *string  `json:"samlMetadataNEQ,omitempty"`
+	SamlMetadataIn           []string `json:"samlMetadataIn,omitempty"`
+	SamlMetadataNotIn        []string `json:"samlMetadataNotIn,omitempty"`
+	SamlMetadataGT           *string  `json:"samlMetadataGT,omitempty"`
+	SamlMetadataGTE          *string  `json:"samlMetadataGTE,omitempty"`
+	SamlMetadataLT           *string  `json:"samlMetadataLT,omitempty"`
+	SamlMetadataLTE          *string  `json:"samlMetadataLTE,omitempty"`
+	SamlMetadataContains     *string  `json:"samlMetadataContains,omitempty"`
+	SamlMetadataHasPrefix    *string  `json:"samlMetadataHasPrefix,omitempty"`
+	SamlMetadataHasSuffix    *string  `json:"samlMetadataHasSuffix,omitempty"`
+	SamlMetadataIsNil        bool     `json:"samlMetadataIsNil,omitempty"`
+	SamlMetadataNotNil       bool     `json:"samlMetadataNotNil,omitempty"`
+	SamlMetadataEqualFold    *string  `json:"samlMetadataEqualFold,omitempty"`
+	SamlMetadataContainsFold *string  `json:"samlMetadataContainsFold,omitempty"`
+
+	// "domain_verification_token" field predicates.
+	DomainVerificationToken             *string  `json:"domainVerificationToken,omitempty"`
+	DomainVerificationTokenNEQ          *string  `json:"domainVerificationTokenNEQ,omitempty"`
+	DomainVerificationTokenIn           []string `json:"domainVerificationTokenIn,omitempty"`
+	DomainVerificationTokenNotIn        []string `json:"domainVerificationTokenNotIn,omitempty"`
+	DomainVerificationTokenGT           *string  `json:"domainVerificationTokenGT,omitempty"`
+	DomainVerificationTokenGTE          *string  `json:"domainVerificationTokenGTE,omitempty"`
+	DomainVerificationTokenLT           *string  `json:"domainVerificationTokenLT,omitempty"`
+	DomainVerificationTokenLTE          *string  `json:"domainVerificationTokenLTE,omitempty"`
+	DomainVerificationTokenContains     *string  `json:"domainVerificationTokenContains,omitempty"`
+	DomainVerificationTokenHasPrefix    *string  `json:"domainVerificationTokenHasPrefix,omitempty"`
+	DomainVerificationTokenHasSuffix    *string  `json:"domainVerificationTokenHasSuffix,omitempty"`
+	DomainVerificationTokenIsNil        bool     `json:"domainVerificationTokenIsNil,omitempty"`
+	DomainVerificationTokenNotNil       bool     `json:"domainVerificationTokenNotNil,omitempty"`
+	DomainVerificationTokenEqualFold    *string  `json:"domainVerificationTokenEqualFold,omitempty"`
+	DomainVerificationTokenContainsFold *string  `json:"domainVerificationTokenContainsFold,omitempty"`
+
+	// "join_policy" field predicates.
+	JoinPolicy      *enums.OrgJoinPolicy  `json:"joinPolicy,omitempty"`
+	JoinPolicyNEQ   *enums.OrgJoinPolicy  `json:"joinPolicyNEQ,omitempty"`
+	JoinPolicyIn    []enums.OrgJoinPolicy `json:"joinPolicyIn,omitempty"`
+	JoinPolicyNotIn []enums.OrgJoinPolicy `json:"joinPolicyNotIn,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -33728,6 +34235,159 @@ func (i *OrganizationSettingHistoryWhereInput) P() (predicate.OrganizationSettin
 	if i.OrganizationIDContainsFold != nil {
 		predicates = append(predicates, organizationsettinghistory.OrganizationIDContainsFold(*i.OrganizationIDContainsFold))
 	}
+	if i.SSOEnforced != nil {
+		predicates = append(predicates, organizationsettinghistory.SSOEnforcedEQ(*i.SSOEnforced))
+	}
+	if i.SSOEnforcedNEQ != nil {
+		predicates = append(predicates, organizationsettinghistory.SSOEnforcedNEQ(*i.SSOEnforcedNEQ))
+	}
+	if i.SamlMetadataURL != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLEQ(*i.SamlMetadataURL))
+	}
+	if i.SamlMetadataURLNEQ != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLNEQ(*i.SamlMetadataURLNEQ))
+	}
+	if len(i.SamlMetadataURLIn) > 0 {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLIn(i.SamlMetadataURLIn...))
+	}
+	if len(i.SamlMetadataURLNotIn) > 0 {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLNotIn(i.SamlMetadataURLNotIn...))
+	}
+	if i.SamlMetadataURLGT != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLGT(*i.SamlMetadataURLGT))
+	}
+	if i.SamlMetadataURLGTE != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLGTE(*i.SamlMetadataURLGTE))
+	}
+	if i.SamlMetadataURLLT != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLLT(*i.SamlMetadataURLLT))
+	}
+	if i.SamlMetadataURLLTE != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLLTE(*i.SamlMetadataURLLTE))
+	}
+	if i.SamlMetadataURLContains != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLContains(*i.SamlMetadataURLContains))
+	}
+	if i.SamlMetadataURLHasPrefix != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLHasPrefix(*i.SamlMetadataURLHasPrefix))
+	}
+	if i.SamlMetadataURLHasSuffix != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLHasSuffix(*i.SamlMetadataURLHasSuffix))
+	}
+	if i.SamlMetadataURLIsNil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLIsNil())
+	}
+	if i.SamlMetadataURLNotNil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLNotNil())
+	}
+	if i.SamlMetadataURLEqualFold != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLEqualFold(*i.SamlMetadataURLEqualFold))
+	}
+	if i.SamlMetadataURLContainsFold != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataURLContainsFold(*i.SamlMetadataURLContainsFold))
+	}
+	if i.SamlMetadata != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataEQ(*i.SamlMetadata))
+	}
+	if i.SamlMetadataNEQ != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataNEQ(*i.SamlMetadataNEQ))
+	}
+	if len(i.SamlMetadataIn) > 0 {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataIn(i.SamlMetadataIn...))
+	}
+	if len(i.SamlMetadataNotIn) > 0 {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataNotIn(i.SamlMetadataNotIn...))
+	}
+	if i.SamlMetadataGT != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataGT(*i.SamlMetadataGT))
+	}
+	if i.SamlMetadataGTE != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataGTE(*i.SamlMetadataGTE))
+	}
+	if i.SamlMetadataLT != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataLT(*i.SamlMetadataLT))
+	}
+	if i.SamlMetadataLTE != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataLTE(*i.SamlMetadataLTE))
+	}
+	if i.SamlMetadataContains != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataContains(*i.SamlMetadataContains))
+	}
+	if i.SamlMetadataHasPrefix != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataHasPrefix(*i.SamlMetadataHasPrefix))
+	}
+	if i.SamlMetadataHasSuffix != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataHasSuffix(*i.SamlMetadataHasSuffix))
+	}
+	if i.SamlMetadataIsNil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataIsNil())
+	}
+	if i.SamlMetadataNotNil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataNotNil())
+	}
+	if i.SamlMetadataEqualFold != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataEqualFold(*i.SamlMetadataEqualFold))
+	}
+	if i.SamlMetadataContainsFold != nil {
+		predicates = append(predicates, organizationsettinghistory.SamlMetadataContainsFold(*i.SamlMetadataContainsFold))
+	}
+	if i.DomainVerificationToken != nil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenEQ(*i.DomainVerificationToken))
+	}
+	if i.DomainVerificationTokenNEQ != nil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenNEQ(*i.DomainVerificationTokenNEQ))
+	}
+	if len(i.DomainVerificationTokenIn) > 0 {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenIn(i.DomainVerificationTokenIn...))
+	}
+	if len(i.DomainVerificationTokenNotIn) > 0 {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenNotIn(i.DomainVerificationTokenNotIn...))
+	}
+	if i.DomainVerificationTokenGT != nil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenGT(*i.DomainVerificationTokenGT))
+	}
+	if i.DomainVerificationTokenGTE != nil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenGTE(*i.DomainVerificationTokenGTE))
+	}
+	if i.DomainVerificationTokenLT != nil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenLT(*i.DomainVerificationTokenLT))
+	}
+	if i.DomainVerificationTokenLTE != nil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenLTE(*i.DomainVerificationTokenLTE))
+	}
+	if i.DomainVerificationTokenContains != nil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenContains(*i.DomainVerificationTokenContains))
+	}
+	if i.DomainVerificationTokenHasPrefix != nil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenHasPrefix(*i.DomainVerificationTokenHasPrefix))
+	}
+	if i.DomainVerificationTokenHasSuffix != nil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenHasSuffix(*i.DomainVerificationTokenHasSuffix))
+	}
+	if i.DomainVerificationTokenIsNil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenIsNil())
+	}
+	if i.DomainVerificationTokenNotNil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenNotNil())
+	}
+	if i.DomainVerificationTokenEqualFold != nil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenEqualFold(*i.DomainVerificationTokenEqualFold))
+	}
+	if i.DomainVerificationTokenContainsFold != nil {
+		predicates = append(predicates, organizationsettinghistory.DomainVerificationTokenContainsFold(*i.DomainVerificationTokenContainsFold))
+	}
+	if i.JoinPolicy != nil {
+		predicates = append(predicates, organizationsettinghistory.JoinPolicyEQ(*i.JoinPolicy))
+	}
+	if i.JoinPolicyNEQ != nil {
+		predicates = append(predicates, organizationsettinghistory.JoinPolicyNEQ(*i.JoinPolicyNEQ))
+	}
+	if len(i.JoinPolicyIn) > 0 {
+		predicates = append(predicates, organizationsettinghistory.JoinPolicyIn(i.JoinPolicyIn...))
+	}
+	if len(i.JoinPolicyNotIn) > 0 {
+		predicates = append(predicates, organizationsettinghistory.JoinPolicyNotIn(i.JoinPolicyNotIn...))
+	}
 
 	switch len(predicates) {
 	case 0:
@@ -35800,6 +36460,45 @@ type TemplateWhereInput struct {
 	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
 	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
 
+	// "version" field predicates.
+	Version      *int  `json:"version,omitempty"`
+	VersionNEQ   *int  `json:"versionNEQ,omitempty"`
+	VersionIn    []int `json:"versionIn,omitempty"`
+	VersionNotIn []int `json:"versionNotIn,omitempty"`
+	VersionGT    *int  `json:"versionGT,omitempty"`
+	VersionGTE   *int  `json:"versionGTE,omitempty"`
+	VersionLT    *int  `json:"versionLT,omitempty"`
+	VersionLTE   *int  `json:"versionLTE,omitempty"`
+
+	// "source_template_id" field predicates.
+	SourceTemplateID             *string  `json:"sourceTemplateID,omitempty"`
+	SourceTemplateIDNEQ          *string  `json:"sourceTemplateIDNEQ,omitempty"`
+	SourceTemplateIDIn           []string `json:"sourceTemplateIDIn,omitempty"`
+	SourceTemplateIDNotIn        []string `json:"sourceTemplateIDNotIn,omitempty"`
+	SourceTemplateIDGT           *string  `json:"sourceTemplateIDGT,omitempty"`
+	SourceTemplateIDGTE          *string  `json:"sourceTemplateIDGTE,omitempty"`
+	SourceTemplateIDLT           *string  `json:"sourceTemplateIDLT,omitempty"`
+	SourceTemplateIDLTE          *string  `json:"sourceTemplateIDLTE,omitempty"`
+	SourceTemplateIDContains     *string  `json:"sourceTemplateIDContains,omitempty"`
+	SourceTemplateIDHasPrefix    *string  `json:"sourceTemplateIDHasPrefix,omitempty"`
+	SourceTemplateIDHasSuffix    *string  `json:"sourceTemplateIDHasSuffix,omitempty"`
+	SourceTemplateIDIsNil        bool     `json:"sourceTemplateIDIsNil,omitempty"`
+	SourceTemplateIDNotNil       bool     `json:"sourceTemplateIDNotNil,omitempty"`
+	SourceTemplateIDEqualFold    *string  `json:"sourceTemplateIDEqualFold,omitempty"`
+	SourceTemplateIDContainsFold *string  `json:"sourceTemplateIDContainsFold,omitempty"`
+
+	// "source_template_version" field predicates.
+	SourceTemplateVersion       *int  `json:"sourceTemplateVersion,omitempty"`
+	SourceTemplateVersionNEQ    *int  `json:"sourceTemplateVersionNEQ,omitempty"`
+	SourceTemplateVersionIn     []int `json:"sourceTemplateVersionIn,omitempty"`
+	SourceTemplateVersionNotIn  []int `json:"sourceTemplateVersionNotIn,omitempty"`
+	SourceTemplateVersionGT     *int  `json:"sourceTemplateVersionGT,omitempty"`
+	SourceTemplateVersionGTE    *int  `json:"sourceTemplateVersionGTE,omitempty"`
+	SourceTemplateVersionLT     *int  `json:"sourceTemplateVersionLT,omitempty"`
+	SourceTemplateVersionLTE    *int  `json:"sourceTemplateVersionLTE,omitempty"`
+	SourceTemplateVersionIsNil  bool  `json:"sourceTemplateVersionIsNil,omitempty"`
+	SourceTemplateVersionNotNil bool  `json:"sourceTemplateVersionNotNil,omitempty"`
+
 	// "owner" edge predicates.
 	HasOwner     *bool                     `json:"hasOwner,omitempty"`
 	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
@@ -35807,6 +36506,18 @@ type TemplateWhereInput struct {
 	// "documents" edge predicates.
 	HasDocuments     *bool                     `json:"hasDocuments,omitempty"`
 	HasDocumentsWith []*DocumentDataWhereInput `json:"hasDocumentsWith,omitempty"`
+
+	// "versions" edge predicates.
+	HasVersions     *bool                        `json:"hasVersions,omitempty"`
+	HasVersionsWith []*TemplateVersionWhereInput `json:"hasVersionsWith,omitempty"`
+
+	// "source_template" edge predicates.
+	HasSourceTemplate     *bool                 `json:"hasSourceTemplate,omitempty"`
+	HasSourceTemplateWith []*TemplateWhereInput `json:"hasSourceTemplateWith,omitempty"`
+
+	// "clones" edge predicates.
+	HasClones     *bool                 `json:"hasClones,omitempty"`
+	HasClonesWith []*TemplateWhereInput `json:"hasClonesWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -36276,6 +36987,105 @@ func (i *TemplateWhereInput) P() (predicate.Template, error) {
 	if i.DescriptionContainsFold != nil {
 		predicates = append(predicates, template.DescriptionContainsFold(*i.DescriptionContainsFold))
 	}
+	if i.Version != nil {
+		predicates = append(predicates, template.VersionEQ(*i.Version))
+	}
+	if i.VersionNEQ != nil {
+		predicates = append(predicates, template.VersionNEQ(*i.VersionNEQ))
+	}
+	if len(i.VersionIn) > 0 {
+		predicates = append(predicates, template.VersionIn(i.VersionIn...))
+	}
+	if len(i.VersionNotIn) > 0 {
+		predicates = append(predicates, template.VersionNotIn(i.VersionNotIn...))
+	}
+	if i.VersionGT != nil {
+		predicates = append(predicates, template.VersionGT(*i.VersionGT))
+	}
+	if i.VersionGTE != nil {
+		predicates = append(predicates, template.VersionGTE(*i.VersionGTE))
+	}
+	if i.VersionLT != nil {
+		predicates = append(predicates, template.VersionLT(*i.VersionLT))
+	}
+	if i.VersionLTE != nil {
+		predicates = append(predicates, template.VersionLTE(*i.VersionLTE))
+	}
+	if i.SourceTemplateID != nil {
+		predicates = append(predicates, template.SourceTemplateIDEQ(*i.SourceTemplateID))
+	}
+	if i.SourceTemplateIDNEQ != nil {
+		predicates = append(predicates, template.SourceTemplateIDNEQ(*i.SourceTemplateIDNEQ))
+	}
+	if len(i.SourceTemplateIDIn) > 0 {
+		predicates = append(predicates, template.SourceTemplateIDIn(i.SourceTemplateIDIn...))
+	}
+	if len(i.SourceTemplateIDNotIn) > 0 {
+		predicates = append(predicates, template.SourceTemplateIDNotIn(i.SourceTemplateIDNotIn...))
+	}
+	if i.SourceTemplateIDGT != nil {
+		predicates = append(predicates, template.SourceTemplateIDGT(*i.SourceTemplateIDGT))
+	}
+	if i.SourceTemplateIDGTE != nil {
+		predicates = append(predicates, template.SourceTemplateIDGTE(*i.SourceTemplateIDGTE))
+	}
+	if i.SourceTemplateIDLT != nil {
+		predicates = append(predicates, template.SourceTemplateIDLT(*i.SourceTemplateIDLT))
+	}
+	if i.SourceTemplateIDLTE != nil {
+		predicates = append(predicates, template.SourceTemplateIDLTE(*i.SourceTemplateIDLTE))
+	}
+	if i.SourceTemplateIDContains != nil {
+		predicates = append(predicates, template.SourceTemplateIDContains(*i.SourceTemplateIDContains))
+	}
+	if i.SourceTemplateIDHasPrefix != nil {
+		predicates = append(predicates, template.SourceTemplateIDHasPrefix(*i.SourceTemplateIDHasPrefix))
+	}
+	if i.SourceTemplateIDHasSuffix != nil {
+		predicates = append(predicates, template.SourceTemplateIDHasSuffix(*i.SourceTemplateIDHasSuffix))
+	}
+	if i.SourceTemplateIDIsNil {
+		predicates = append(predicates, template.SourceTemplateIDIsNil())
+	}
+	if i.SourceTemplateIDNotNil {
+		predicates = append(predicates, template.SourceTemplateIDNotNil())
+	}
+	if i.SourceTemplateIDEqualFold != nil {
+		predicates = append(predicates, template.SourceTemplateIDEqualFold(*i.SourceTemplateIDEqualFold))
+	}
+	if i.SourceTemplateIDContainsFold != nil {
+		predicates = append(predicates, template.SourceTemplateIDContainsFold(*i.SourceTemplateIDContainsFold))
+	}
+	if i.SourceTemplateVersion != nil {
+		predicates = append(predicates, template.SourceTemplateVersionEQ(*i.SourceTemplateVersion))
+	}
+	if i.SourceTemplateVersionNEQ != nil {
+		predicates = append(predicates, template.SourceTemplateVersionNEQ(*i.SourceTemplateVersionNEQ))
+	}
+	if len(i.SourceTemplateVersionIn) > 0 {
+		predicates = append(predicates, template.SourceTemplateVersionIn(i.SourceTemplateVersionIn...))
+	}
+	if len(i.SourceTemplateVersionNotIn) > 0 {
+		predicates = append(predicates, template.SourceTemplateVersionNotIn(i.SourceTemplateVersionNotIn...))
+	}
+	if i.SourceTemplateVersionGT != nil {
+		predicates = append(predicates, template.SourceTemplateVersionGT(*i.SourceTemplateVersionGT))
+	}
+	if i.SourceTemplateVersionGTE != nil {
+		predicates = append(predicates, template.SourceTemplateVersionGTE(*i.SourceTemplateVersionGTE))
+	}
+	if i.SourceTemplateVersionLT != nil {
+		predicates = append(predicates, template.SourceTemplateVersionLT(*i.SourceTemplateVersionLT))
+	}
+	if i.SourceTemplateVersionLTE != nil {
+		predicates = append(predicates, template.SourceTemplateVersionLTE(*i.SourceTemplateVersionLTE))
+	}
+	if i.SourceTemplateVersionIsNil {
+		predicates = append(predicates, template.SourceTemplateVersionIsNil())
+	}
+	if i.SourceTemplateVersionNotNil {
+		predicates = append(predicates, template.SourceTemplateVersionNotNil())
+	}
 
 	if i.HasOwner != nil {
 		p := template.HasOwner()
@@ -36313,6 +37123,60 @@ func (i *TemplateWhereInput) P() (predicate.Template, error) {
 		}
 		predicates = append(predicates, template.HasDocumentsWith(with...))
 	}
+	if i.HasVersions != nil {
+		p := template.HasVersions()
+		if !*i.HasVersions {
+			p = template.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasVersionsWith) > 0 {
+		with := make([]predicate.TemplateVersion, 0, len(i.HasVersionsWith))
+		for _, w := range i.HasVersionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasVersionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, template.HasVersionsWith(with...))
+	}
+	if i.HasSourceTemplate != nil {
+		p := template.HasSourceTemplate()
+		if !*i.HasSourceTemplate {
+			p = template.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSourceTemplateWith) > 0 {
+		with := make([]predicate.Template, 0, len(i.HasSourceTemplateWith))
+		for _, w := range i.HasSourceTemplateWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSourceTemplateWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, template.HasSourceTemplateWith(with...))
+	}
+	if i.HasClones != nil {
+		p := template.HasClones()
+		if !*i.HasClones {
+			p = template.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasClonesWith) > 0 {
+		with := make([]predicate.Template, 0, len(i.HasClonesWith))
+		for _, w := range i.HasClonesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasClonesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, template.HasClonesWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
 		return nil, ErrEmptyTemplateWhereInput
@@ -36516,6 +37380,45 @@ type TemplateHistoryWhereInput struct {
 	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
 	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
 	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+
+	// "version" field predicates.
+	Version      *int  `json:"version,omitempty"`
+	VersionNEQ   *int  `json:"versionNEQ,omitempty"`
+	VersionIn    []int `json:"versionIn,omitempty"`
+	VersionNotIn []int `json:"versionNotIn,omitempty"`
+	VersionGT    *int  `json:"versionGT,omitempty"`
+	VersionGTE   *int  `json:"versionGTE,omitempty"`
+	VersionLT    *int  `json:"versionLT,omitempty"`
+	VersionLTE   *int  `json:"versionLTE,omitempty"`
+
+	// "source_template_id" field predicates.
+	SourceTemplateID             *string  `json:"sourceTemplateID,omitempty"`
+	SourceTemplateIDNEQ          *string  `json:"sourceTemplateIDNEQ,omitempty"`
+	SourceTemplateIDIn           []string `json:"sourceTemplateIDIn,omitempty"`
+	SourceTemplateIDNotIn        []string `json:"sourceTemplateIDNotIn,omitempty"`
+	SourceTemplateIDGT           *string  `json:"sourceTemplateIDGT,omitempty"`
+	SourceTemplateIDGTE          *string  `json:"sourceTemplateIDGTE,omitempty"`
+	SourceTemplateIDLT           *string  `json:"sourceTemplateIDLT,omitempty"`
+	SourceTemplateIDLTE          *string  `json:"sourceTemplateIDLTE,omitempty"`
+	SourceTemplateIDContains     *string  `json:"sourceTemplateIDContains,omitempty"`
+	SourceTemplateIDHasPrefix    *string  `json:"sourceTemplateIDHasPrefix,omitempty"`
+	SourceTemplateIDHasSuffix    *string  `json:"sourceTemplateIDHasSuffix,omitempty"`
+	SourceTemplateIDIsNil        bool     `json:"sourceTemplateIDIsNil,omitempty"`
+	SourceTemplateIDNotNil       bool     `json:"sourceTemplateIDNotNil,omitempty"`
+	SourceTemplateIDEqualFold    *string  `json:"sourceTemplateIDEqualFold,omitempty"`
+	SourceTemplateIDContainsFold *string  `json:"sourceTemplateIDContainsFold,omitempty"`
+
+	// "source_template_version" field predicates.
+	SourceTemplateVersion       *int  `json:"sourceTemplateVersion,omitempty"`
+	SourceTemplateVersionNEQ    *int  `json:"sourceTemplateVersionNEQ,omitempty"`
+	SourceTemplateVersionIn     []int `json:"sourceTemplateVersionIn,omitempty"`
+	SourceTemplateVersionNotIn  []int `json:"sourceTemplateVersionNotIn,omitempty"`
+	SourceTemplateVersionGT     *int  `json:"sourceTemplateVersionGT,omitempty"`
+	SourceTemplateVersionGTE    *int  `json:"sourceTemplateVersionGTE,omitempty"`
+	SourceTemplateVersionLT     *int  `json:"sourceTemplateVersionLT,omitempty"`
+	SourceTemplateVersionLTE    *int  `json:"sourceTemplateVersionLTE,omitempty"`
+	SourceTemplateVersionIsNil  bool  `json:"sourceTemplateVersionIsNil,omitempty"`
+	SourceTemplateVersionNotNil bool  `json:"sourceTemplateVersionNotNil,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -37066,6 +37969,105 @@ func (i *TemplateHistoryWhereInput) P() (predicate.TemplateHistory, error) {
 	if i.DescriptionContainsFold != nil {
 		predicates = append(predicates, templatehistory.DescriptionContainsFold(*i.DescriptionContainsFold))
 	}
+	if i.Version != nil {
+		predicates = append(predicates, templatehistory.VersionEQ(*i.Version))
+	}
+	if i.VersionNEQ != nil {
+		predicates = append(predicates, templatehistory.VersionNEQ(*i.VersionNEQ))
+	}
+	if len(i.VersionIn) > 0 {
+		predicates = append(predicates, templatehistory.VersionIn(i.VersionIn...))
+	}
+	if len(i.VersionNotIn) > 0 {
+		predicates = append(predicates, templatehistory.VersionNotIn(i.VersionNotIn...))
+	}
+	if i.VersionGT != nil {
+		predicates = append(predicates, templatehistory.VersionGT(*i.VersionGT))
+	}
+	if i.VersionGTE != nil {
+		predicates = append(predicates, templatehistory.VersionGTE(*i.VersionGTE))
+	}
+	if i.VersionLT != nil {
+		predicates = append(predicates, templatehistory.VersionLT(*i.VersionLT))
+	}
+	if i.VersionLTE != nil {
+		predicates = append(predicates, templatehistory.VersionLTE(*i.VersionLTE))
+	}
+	if i.SourceTemplateID != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDEQ(*i.SourceTemplateID))
+	}
+	if i.SourceTemplateIDNEQ != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDNEQ(*i.SourceTemplateIDNEQ))
+	}
+	if len(i.SourceTemplateIDIn) > 0 {
+		predicates = append(predicates, templatehistory.SourceTemplateIDIn(i.SourceTemplateIDIn...))
+	}
+	if len(i.SourceTemplateIDNotIn) > 0 {
+		predicates = append(predicates, templatehistory.SourceTemplateIDNotIn(i.SourceTemplateIDNotIn...))
+	}
+	if i.SourceTemplateIDGT != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDGT(*i.SourceTemplateIDGT))
+	}
+	if i.SourceTemplateIDGTE != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDGTE(*i.SourceTemplateIDGTE))
+	}
+	if i.SourceTemplateIDLT != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDLT(*i.SourceTemplateIDLT))
+	}
+	if i.SourceTemplateIDLTE != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDLTE(*i.SourceTemplateIDLTE))
+	}
+	if i.SourceTemplateIDContains != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDContains(*i.SourceTemplateIDContains))
+	}
+	if i.SourceTemplateIDHasPrefix != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDHasPrefix(*i.SourceTemplateIDHasPrefix))
+	}
+	if i.SourceTemplateIDHasSuffix != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDHasSuffix(*i.SourceTemplateIDHasSuffix))
+	}
+	if i.SourceTemplateIDIsNil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDIsNil())
+	}
+	if i.SourceTemplateIDNotNil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDNotNil())
+	}
+	if i.SourceTemplateIDEqualFold != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDEqualFold(*i.SourceTemplateIDEqualFold))
+	}
+	if i.SourceTemplateIDContainsFold != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateIDContainsFold(*i.SourceTemplateIDContainsFold))
+	}
+	if i.SourceTemplateVersion != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateVersionEQ(*i.SourceTemplateVersion))
+	}
+	if i.SourceTemplateVersionNEQ != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateVersionNEQ(*i.SourceTemplateVersionNEQ))
+	}
+	if len(i.SourceTemplateVersionIn) > 0 {
+		predicates = append(predicates, templatehistory.SourceTemplateVersionIn(i.SourceTemplateVersionIn...))
+	}
+	if len(i.SourceTemplateVersionNotIn) > 0 {
+		predicates = append(predicates, templatehistory.SourceTemplateVersionNotIn(i.SourceTemplateVersionNotIn...))
+	}
+	if i.SourceTemplateVersionGT != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateVersionGT(*i.SourceTemplateVersionGT))
+	}
+	if i.SourceTemplateVersionGTE != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateVersionGTE(*i.SourceTemplateVersionGTE))
+	}
+	if i.SourceTemplateVersionLT != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateVersionLT(*i.SourceTemplateVersionLT))
+	}
+	if i.SourceTemplateVersionLTE != nil {
+		predicates = append(predicates, templatehistory.SourceTemplateVersionLTE(*i.SourceTemplateVersionLTE))
+	}
+	if i.SourceTemplateVersionIsNil {
+		predicates = append(predicates, templatehistory.SourceTemplateVersionIsNil())
+	}
+	if i.SourceTemplateVersionNotNil {
+		predicates = append(predicates, templatehistory.SourceTemplateVersionNotNil())
+	}
 
 	switch len(predicates) {
 	case 0:
@@ -37077,6 +38079,644 @@ func (i *TemplateHistoryWhereInput) P() (predicate.TemplateHistory, error) {
 	}
 }
 
+// TemplateVersionWhereInput represents a where input for filtering TemplateVersion queries.
+type TemplateVersionWhereInput struct {
+	Predicates []predicate.TemplateVersion  `json:"-"`
+	Not        *TemplateVersionWhereInput   `json:"not,omitempty"`
+	Or         []*TemplateVersionWhereInput `json:"or,omitempty"`
+	And        []*TemplateVersionWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt       *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ    *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT     *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE    *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT     *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE    *time.Time  `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil bool        `json:"createdAtNotNil,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt       *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ    *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT     *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE    *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT     *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE    *time.Time  `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil bool        `json:"updatedAtNotNil,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+
+	// "updated_by" field predicates.
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNEQ          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGT           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGTE          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLT           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLTE          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        bool     `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       bool     `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+
+	// "deleted_at" field predicates.
+	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+
+	// "deleted_by" field predicates.
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNEQ          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGT           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGTE          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLT           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLTE          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        bool     `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       bool     `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+
+	// "owner_id" field predicates.
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNEQ          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGT           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGTE          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLT           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLTE          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDIsNil        bool     `json:"ownerIDIsNil,omitempty"`
+	OwnerIDNotNil       bool     `json:"ownerIDNotNil,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
+
+	// "template_id" field predicates.
+	TemplateID             *string  `json:"templateID,omitempty"`
+	TemplateIDNEQ          *string  `json:"templateIDNEQ,omitempty"`
+	TemplateIDIn           []string `json:"templateIDIn,omitempty"`
+	TemplateIDNotIn        []string `json:"templateIDNotIn,omitempty"`
+	TemplateIDGT           *string  `json:"templateIDGT,omitempty"`
+	TemplateIDGTE          *string  `json:"templateIDGTE,omitempty"`
+	TemplateIDLT           *string  `json:"templateIDLT,omitempty"`
+	TemplateIDLTE          *string  `json:"templateIDLTE,omitempty"`
+	TemplateIDContains     *string  `json:"templateIDContains,omitempty"`
+	TemplateIDHasPrefix    *string  `json:"templateIDHasPrefix,omitempty"`
+	TemplateIDHasSuffix    *string  `json:"templateIDHasSuffix,omitempty"`
+	TemplateIDEqualFold    *string  `json:"templateIDEqualFold,omitempty"`
+	TemplateIDContainsFold *string  `json:"templateIDContainsFold,omitempty"`
+
+	// "version" field predicates.
+	Version      *int  `json:"version,omitempty"`
+	VersionNEQ   *int  `json:"versionNEQ,omitempty"`
+	VersionIn    []int `json:"versionIn,omitempty"`
+	VersionNotIn []int `json:"versionNotIn,omitempty"`
+	VersionGT    *int  `json:"versionGT,omitempty"`
+	VersionGTE   *int  `json:"versionGTE,omitempty"`
+	VersionLT    *int  `json:"versionLT,omitempty"`
+	VersionLTE   *int  `json:"versionLTE,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+
+	// "template" edge predicates.
+	HasTemplate     *bool                 `json:"hasTemplate,omitempty"`
+	HasTemplateWith []*TemplateWhereInput `json:"hasTemplateWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *TemplateVersionWhereInput) AddPredicates(predicates ...predicate.TemplateVersion) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the TemplateVersionWhereInput filter on the TemplateVersionQuery builder.
+func (i *TemplateVersionWhereInput) Filter(q *TemplateVersionQuery) (*TemplateVersionQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyTemplateVersionWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyTemplateVersionWhereInput is returned in case the TemplateVersionWhereInput is empty.
+var ErrEmptyTemplateVersionWhereInput = errors.New("generated: empty predicate TemplateVersionWhereInput")
+
+// P returns a predicate for filtering templateversions.
+// An error is returned if the input is empty or invalid.
+func (i *TemplateVersionWhereInput) P() (predicate.TemplateVersion, error) {
+	var predicates []predicate.TemplateVersion
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, templateversion.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.TemplateVersion, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, templateversion.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.TemplateVersion, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, templateversion.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, templateversion.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, templateversion.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, templateversion.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, templateversion.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, templateversion.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, templateversion.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, templateversion.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, templateversion.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, templateversion.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, templateversion.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, templateversion.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, templateversion.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, templateversion.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, templateversion.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, templateversion.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, templateversion.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, templateversion.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, templateversion.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.CreatedAtIsNil {
+		predicates = append(predicates, templateversion.CreatedAtIsNil())
+	}
+	if i.CreatedAtNotNil {
+		predicates = append(predicates, templateversion.CreatedAtNotNil())
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, templateversion.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, templateversion.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, templateversion.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, templateversion.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, templateversion.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, templateversion.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, templateversion.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, templateversion.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.UpdatedAtIsNil {
+		predicates = append(predicates, templateversion.UpdatedAtIsNil())
+	}
+	if i.UpdatedAtNotNil {
+		predicates = append(predicates, templateversion.UpdatedAtNotNil())
+	}
+	if i.CreatedBy != nil {
+		predicates = append(predicates, templateversion.CreatedByEQ(*i.CreatedBy))
+	}
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, templateversion.CreatedByNEQ(*i.CreatedByNEQ))
+	}
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, templateversion.CreatedByIn(i.CreatedByIn...))
+	}
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, templateversion.CreatedByNotIn(i.CreatedByNotIn...))
+	}
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, templateversion.CreatedByGT(*i.CreatedByGT))
+	}
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, templateversion.CreatedByGTE(*i.CreatedByGTE))
+	}
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, templateversion.CreatedByLT(*i.CreatedByLT))
+	}
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, templateversion.CreatedByLTE(*i.CreatedByLTE))
+	}
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, templateversion.CreatedByContains(*i.CreatedByContains))
+	}
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, templateversion.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+	}
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, templateversion.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+	}
+	if i.CreatedByIsNil {
+		predicates = append(predicates, templateversion.CreatedByIsNil())
+	}
+	if i.CreatedByNotNil {
+		predicates = append(predicates, templateversion.CreatedByNotNil())
+	}
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, templateversion.CreatedByEqualFold(*i.CreatedByEqualFold))
+	}
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, templateversion.CreatedByContainsFold(*i.CreatedByContainsFold))
+	}
+	if i.UpdatedBy != nil {
+		predicates = append(predicates, templateversion.UpdatedByEQ(*i.UpdatedBy))
+	}
+	if i.UpdatedByNEQ != nil {
+		predicates = append(predicates, templateversion.UpdatedByNEQ(*i.UpdatedByNEQ))
+	}
+	if len(i.UpdatedByIn) > 0 {
+		predicates = append(predicates, templateversion.UpdatedByIn(i.UpdatedByIn...))
+	}
+	if len(i.UpdatedByNotIn) > 0 {
+		predicates = append(predicates, templateversion.UpdatedByNotIn(i.UpdatedByNotIn...))
+	}
+	if i.UpdatedByGT != nil {
+		predicates = append(predicates, templateversion.UpdatedByGT(*i.UpdatedByGT))
+	}
+	if i.UpdatedByGTE != nil {
+		predicates = append(predicates, templateversion.UpdatedByGTE(*i.UpdatedByGTE))
+	}
+	if i.UpdatedByLT != nil {
+		predicates = append(predicates, templateversion.UpdatedByLT(*i.UpdatedByLT))
+	}
+	if i.UpdatedByLTE != nil {
+		predicates = append(predicates, templateversion.UpdatedByLTE(*i.UpdatedByLTE))
+	}
+	if i.UpdatedByContains != nil {
+		predicates = append(predicates, templateversion.UpdatedByContains(*i.UpdatedByContains))
+	}
+	if i.UpdatedByHasPrefix != nil {
+		predicates = append(predicates, templateversion.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+	}
+	if i.UpdatedByHasSuffix != nil {
+		predicates = append(predicates, templateversion.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+	}
+	if i.UpdatedByIsNil {
+		predicates = append(predicates, templateversion.UpdatedByIsNil())
+	}
+	if i.UpdatedByNotNil {
+		predicates = append(predicates, templateversion.UpdatedByNotNil())
+	}
+	if i.UpdatedByEqualFold != nil {
+		predicates = append(predicates, templateversion.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+	}
+	if i.UpdatedByContainsFold != nil {
+		predicates = append(predicates, templateversion.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+	}
+	if i.DeletedAt != nil {
+		predicates = append(predicates, templateversion.DeletedAtEQ(*i.DeletedAt))
+	}
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, templateversion.DeletedAtNEQ(*i.DeletedAtNEQ))
+	}
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, templateversion.DeletedAtIn(i.DeletedAtIn...))
+	}
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, templateversion.DeletedAtNotIn(i.DeletedAtNotIn...))
+	}
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, templateversion.DeletedAtGT(*i.DeletedAtGT))
+	}
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, templateversion.DeletedAtGTE(*i.DeletedAtGTE))
+	}
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, templateversion.DeletedAtLT(*i.DeletedAtLT))
+	}
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, templateversion.DeletedAtLTE(*i.DeletedAtLTE))
+	}
+	if i.DeletedAtIsNil {
+		predicates = append(predicates, templateversion.DeletedAtIsNil())
+	}
+	if i.DeletedAtNotNil {
+		predicates = append(predicates, templateversion.DeletedAtNotNil())
+	}
+	if i.DeletedBy != nil {
+		predicates = append(predicates, templateversion.DeletedByEQ(*i.DeletedBy))
+	}
+	if i.DeletedByNEQ != nil {
+		predicates = append(predicates, templateversion.DeletedByNEQ(*i.DeletedByNEQ))
+	}
+	if len(i.DeletedByIn) > 0 {
+		predicates = append(predicates, templateversion.DeletedByIn(i.DeletedByIn...))
+	}
+	if len(i.DeletedByNotIn) > 0 {
+		predicates = append(predicates, templateversion.DeletedByNotIn(i.DeletedByNotIn...))
+	}
+	if i.DeletedByGT != nil {
+		predicates = append(predicates, templateversion.DeletedByGT(*i.DeletedByGT))
+	}
+	if i.DeletedByGTE != nil {
+		predicates = append(predicates, templateversion.DeletedByGTE(*i.DeletedByGTE))
+	}
+	if i.DeletedByLT != nil {
+		predicates = append(predicates, templateversion.DeletedByLT(*i.DeletedByLT))
+	}
+	if i.DeletedByLTE != nil {
+		predicates = append(predicates, templateversion.DeletedByLTE(*i.DeletedByLTE))
+	}
+	if i.DeletedByContains != nil {
+		predicates = append(predicates, templateversion.DeletedByContains(*i.DeletedByContains))
+	}
+	if i.DeletedByHasPrefix != nil {
+		predicates = append(predicates, templateversion.DeletedByHasPrefix(*i.DeletedByHasPrefix))
+	}
+	if i.DeletedByHasSuffix != nil {
+		predicates = append(predicates, templateversion.DeletedByHasSuffix(*i.DeletedByHasSuffix))
+	}
+	if i.DeletedByIsNil {
+		predicates = append(predicates, templateversion.DeletedByIsNil())
+	}
+	if i.DeletedByNotNil {
+		predicates = append(predicates, templateversion.DeletedByNotNil())
+	}
+	if i.DeletedByEqualFold != nil {
+		predicates = append(predicates, templateversion.DeletedByEqualFold(*i.DeletedByEqualFold))
+	}
+	if i.DeletedByContainsFold != nil {
+		predicates = append(predicates, templateversion.DeletedByContainsFold(*i.DeletedByContainsFold))
+	}
+	if i.OwnerID != nil {
+		predicates = append(predicates, templateversion.OwnerIDEQ(*i.OwnerID))
+	}
+	if i.OwnerIDNEQ != nil {
+		predicates = append(predicates, templateversion.OwnerIDNEQ(*i.OwnerIDNEQ))
+	}
+	if len(i.OwnerIDIn) > 0 {
+		predicates = append(predicates, templateversion.OwnerIDIn(i.OwnerIDIn...))
+	}
+	if len(i.OwnerIDNotIn) > 0 {
+		predicates = append(predicates, templateversion.OwnerIDNotIn(i.OwnerIDNotIn...))
+	}
+	if i.OwnerIDGT != nil {
+		predicates = append(predicates, templateversion.OwnerIDGT(*i.OwnerIDGT))
+	}
+	if i.OwnerIDGTE != nil {
+		predicates = append(predicates, templateversion.OwnerIDGTE(*i.OwnerIDGTE))
+	}
+	if i.OwnerIDLT != nil {
+		predicates = append(predicates, templateversion.OwnerIDLT(*i.OwnerIDLT))
+	}
+	if i.OwnerIDLTE != nil {
+		predicates = append(predicates, templateversion.OwnerIDLTE(*i.OwnerIDLTE))
+	}
+	if i.OwnerIDContains != nil {
+		predicates = append(predicates, templateversion.OwnerIDContains(*i.OwnerIDContains))
+	}
+	if i.OwnerIDHasPrefix != nil {
+		predicates = append(predicates, templateversion.OwnerIDHasPrefix(*i.OwnerIDHasPrefix))
+	}
+	if i.OwnerIDHasSuffix != nil {
+		predicates = append(predicates, templateversion.OwnerIDHasSuffix(*i.OwnerIDHasSuffix))
+	}
+	if i.OwnerIDIsNil {
+		predicates = append(predicates, templateversion.OwnerIDIsNil())
+	}
+	if i.OwnerIDNotNil {
+		predicates = append(predicates, templateversion.OwnerIDNotNil())
+	}
+	if i.OwnerIDEqualFold != nil {
+		predicates = append(predicates, templateversion.OwnerIDEqualFold(*i.OwnerIDEqualFold))
+	}
+	if i.OwnerIDContainsFold != nil {
+		predicates = append(predicates, templateversion.OwnerIDContainsFold(*i.OwnerIDContainsFold))
+	}
+	if i.TemplateID != nil {
+		predicates = append(predicates, templateversion.TemplateIDEQ(*i.TemplateID))
+	}
+	if i.TemplateIDNEQ != nil {
+		predicates = append(predicates, templateversion.TemplateIDNEQ(*i.TemplateIDNEQ))
+	}
+	if len(i.TemplateIDIn) > 0 {
+		predicates = append(predicates, templateversion.TemplateIDIn(i.TemplateIDIn...))
+	}
+	if len(i.TemplateIDNotIn) > 0 {
+		predicates = append(predicates, templateversion.TemplateIDNotIn(i.TemplateIDNotIn...))
+	}
+	if i.TemplateIDGT != nil {
+		predicates = append(predicates, templateversion.TemplateIDGT(*i.TemplateIDGT))
+	}
+	if i.TemplateIDGTE != nil {
+		predicates = append(predicates, templateversion.TemplateIDGTE(*i.TemplateIDGTE))
+	}
+	if i.TemplateIDLT != nil {
+		predicates = append(predicates, templateversion.TemplateIDLT(*i.TemplateIDLT))
+	}
+	if i.TemplateIDLTE != nil {
+		predicates = append(predicates, templateversion.TemplateIDLTE(*i.TemplateIDLTE))
+	}
+	if i.TemplateIDContains != nil {
+		predicates = append(predicates, templateversion.TemplateIDContains(*i.TemplateIDContains))
+	}
+	if i.TemplateIDHasPrefix != nil {
+		predicates = append(predicates, templateversion.TemplateIDHasPrefix(*i.TemplateIDHasPrefix))
+	}
+	if i.TemplateIDHasSuffix != nil {
+		predicates = append(predicates, templateversion.TemplateIDHasSuffix(*i.TemplateIDHasSuffix))
+	}
+	if i.TemplateIDEqualFold != nil {
+		predicates = append(predicates, templateversion.TemplateIDEqualFold(*i.TemplateIDEqualFold))
+	}
+	if i.TemplateIDContainsFold != nil {
+		predicates = append(predicates, templateversion.TemplateIDContainsFold(*i.TemplateIDContainsFold))
+	}
+	if i.Version != nil {
+		predicates = append(predicates, templateversion.VersionEQ(*i.Version))
+	}
+	if i.VersionNEQ != nil {
+		predicates = append(predicates, templateversion.VersionNEQ(*i.VersionNEQ))
+	}
+	if len(i.VersionIn) > 0 {
+		predicates = append(predicates, templateversion.VersionIn(i.VersionIn...))
+	}
+	if len(i.VersionNotIn) > 0 {
+		predicates = append(predicates, templateversion.VersionNotIn(i.VersionNotIn...))
+	}
+	if i.VersionGT != nil {
+		predicates = append(predicates, templateversion.VersionGT(*i.VersionGT))
+	}
+	if i.VersionGTE != nil {
+		predicates = append(predicates, templateversion.VersionGTE(*i.VersionGTE))
+	}
+	if i.VersionLT != nil {
+		predicates = append(predicates, templateversion.VersionLT(*i.VersionLT))
+	}
+	if i.VersionLTE != nil {
+		predicates = append(predicates, templateversion.VersionLTE(*i.VersionLTE))
+	}
+
+	if i.HasOwner != nil {
+		p := templateversion.HasOwner()
+		if !*i.HasOwner {
+			p = templateversion.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, templateversion.HasOwnerWith(with...))
+	}
+	if i.HasTemplate != nil {
+		p := templateversion.HasTemplate()
+		if !*i.HasTemplate {
+			p = templateversion.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasTemplateWith) > 0 {
+		with := make([]predicate.Template, 0, len(i.HasTemplateWith))
+		for _, w := range i.HasTemplateWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasTemplateWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, templateversion.HasTemplateWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyTemplateVersionWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return templateversion.And(predicates...), nil
+	}
+}
+
 // UserWhereInput represents a where input for filtering User queries.
 type UserWhereInput struct {
 	Predicates []predicate.User  `json:"-"`
@@ -39620,6 +41260,28 @@ type UserSettingWhereInput struct {
 	Locked    *bool `json:"locked,omitempty"`
 	LockedNEQ *bool `json:"lockedNEQ,omitempty"`
 
+	// "locked_until" field predicates.
+	LockedUntil       *time.Time  `json:"lockedUntil,omitempty"`
+	LockedUntilNEQ    *time.Time  `json:"lockedUntilNEQ,omitempty"`
+	LockedUntilIn     []time.Time `json:"lockedUntilIn,omitempty"`
+	LockedUntilNotIn  []time.Time `json:"lockedUntilNotIn,omitempty"`
+	LockedUntilGT     *time.Time  `json:"lockedUntilGT,omitempty"`
+	LockedUntilGTE    *time.Time  `json:"lockedUntilGTE,omitempty"`
+	LockedUntilLT     *time.Time  `json:"lockedUntilLT,omitempty"`
+	LockedUntilLTE    *time.Time  `json:"lockedUntilLTE,omitempty"`
+	LockedUntilIsNil  bool        `json:"lockedUntilIsNil,omitempty"`
+	LockedUntilNotNil bool        `json:"lockedUntilNotNil,omitempty"`
+
+	// "failed_login_attempts" field predicates.
+	FailedLoginAttempts      *int  `json:"failedLoginAttempts,omitempty"`
+	FailedLoginAttemptsNEQ   *int  `json:"failedLoginAttemptsNEQ,omitempty"`
+	FailedLoginAttemptsIn    []int `json:"failedLoginAttemptsIn,omitempty"`
+	FailedLoginAttemptsNotIn []int `json:"failedLoginAttemptsNotIn,omitempty"`
+	FailedLoginAttemptsGT    *int  `json:"failedLoginAttemptsGT,omitempty"`
+	FailedLoginAttemptsGTE   *int  `json:"failedLoginAttemptsGTE,omitempty"`
+	FailedLoginAttemptsLT    *int  `json:"failedLoginAttemptsLT,omitempty"`
+	FailedLoginAttemptsLTE   *int  `json:"failedLoginAttemptsLTE,omitempty"`
+
 	// "silenced_at" field predicates.
 	SilencedAt       *time.Time  `json:"silencedAt,omitempty"`
 	SilencedAtNEQ    *time.Time  `json:"silencedAtNEQ,omitempty"`
@@ -40052,6 +41714,60 @@ func (i *UserSettingWhereInput) P() (predicate.UserSetting, error) {
 	if i.LockedNEQ != nil {
 		predicates = append(predicates, usersetting.LockedNEQ(*i.LockedNEQ))
 	}
+	if i.LockedUntil != nil {
+		predicates = append(predicates, usersetting.LockedUntilEQ(*i.LockedUntil))
+	}
+	if i.LockedUntilNEQ != nil {
+		predicates = append(predicates, usersetting.LockedUntilNEQ(*i.LockedUntilNEQ))
+	}
+	if len(i.LockedUntilIn) > 0 {
+		predicates = append(predicates, usersetting.LockedUntilIn(i.LockedUntilIn...))
+	}
+	if len(i.LockedUntilNotIn) > 0 {
+		predicates = append(predicates, usersetting.LockedUntilNotIn(i.LockedUntilNotIn...))
+	}
+	if i.LockedUntilGT != nil {
+		predicates = append(predicates, usersetting.LockedUntilGT(*i.LockedUntilGT))
+	}
+	if i.LockedUntilGTE != nil {
+		predicates = append(predicates, usersetting.LockedUntilGTE(*i.LockedUntilGTE))
+	}
+	if i.LockedUntilLT != nil {
+		predicates = append(predicates, usersetting.LockedUntilLT(*i.LockedUntilLT))
+	}
+	if i.LockedUntilLTE != nil {
+		predicates = append(predicates, usersetting.LockedUntilLTE(*i.LockedUntilLTE))
+	}
+	if i.LockedUntilIsNil {
+		predicates = append(predicates, usersetting.LockedUntilIsNil())
+	}
+	if i.LockedUntilNotNil {
+		predicates = append(predicates, usersetting.LockedUntilNotNil())
+	}
+	if i.FailedLoginAttempts != nil {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsEQ(*i.FailedLoginAttempts))
+	}
+	if i.FailedLoginAttemptsNEQ != nil {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsNEQ(*i.FailedLoginAttemptsNEQ))
+	}
+	if len(i.FailedLoginAttemptsIn) > 0 {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsIn(i.FailedLoginAttemptsIn...))
+	}
+	if len(i.FailedLoginAttemptsNotIn) > 0 {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsNotIn(i.FailedLoginAttemptsNotIn...))
+	}
+	if i.FailedLoginAttemptsGT != nil {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsGT(*i.FailedLoginAttemptsGT))
+	}
+	if i.FailedLoginAttemptsGTE != nil {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsGTE(*i.FailedLoginAttemptsGTE))
+	}
+	if i.FailedLoginAttemptsLT != nil {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsLT(*i.FailedLoginAttemptsLT))
+	}
+	if i.FailedLoginAttemptsLTE != nil {
+		predicates = append(predicates, usersetting.FailedLoginAttemptsLTE(*i.FailedLoginAttemptsLTE))
+	}
 	if i.SilencedAt != nil {
 		predicates = append(predicates, usersetting.SilencedAtEQ(*i.SilencedAt))
 	}
@@ -40361,6 +42077,28 @@ type UserSettingHistoryWhereInput struct {
 	Locked    *bool `json:"locked,omitempty"`
 	LockedNEQ *bool `json:"lockedNEQ,omitempty"`
 
+	// "locked_until" field predicates.
+	LockedUntil       *time.Time  `json:"lockedUntil,omitempty"`
+	LockedUntilNEQ    *time.Time  `json:"lockedUntilNEQ,omitempty"`
+	LockedUntilIn     []time.Time `json:"lockedUntilIn,omitempty"`
+	LockedUntilNotIn  []time.Time `json:"lockedUntilNotIn,omitempty"`
+	LockedUntilGT     *time.Time  `json:"lockedUntilGT,omitempty"`
+	LockedUntilGTE    *time.Time  `json:"lockedUntilGTE,omitempty"`
+	LockedUntilLT     *time.Time  `json:"lockedUntilLT,omitempty"`
+	LockedUntilLTE    *time.Time  `json:"lockedUntilLTE,omitempty"`
+	LockedUntilIsNil  bool        `json:"lockedUntilIsNil,omitempty"`
+	LockedUntilNotNil bool        `json:"lockedUntilNotNil,omitempty"`
+
+	// "failed_login_attempts" field predicates.
+	FailedLoginAttempts      *int  `json:"failedLoginAttempts,omitempty"`
+	FailedLoginAttemptsNEQ   *int  `json:"failedLoginAttemptsNEQ,omitempty"`
+	FailedLoginAttemptsIn    []int `json:"failedLoginAttemptsIn,omitempty"`
+	FailedLoginAttemptsNotIn []int `json:"failedLoginAttemptsNotIn,omitempty"`
+	FailedLoginAttemptsGT    *int  `json:"failedLoginAttemptsGT,omitempty"`
+	FailedLoginAttemptsGTE   *int  `json:"failedLoginAttemptsGTE,omitempty"`
+	FailedLoginAttemptsLT    *int  `json:"failedLoginAttemptsLT,omitempty"`
+	FailedLoginAttemptsLTE   *int  `json:"failedLoginAttemptsLTE,omitempty"`
+
 	// "silenced_at" field predicates.
 	SilencedAt       *time.Time  `json:"silencedAt,omitempty"`
 	SilencedAtNEQ    *time.Time  `json:"silencedAtNEQ,omitempty"`
@@ -40866,6 +42604,60 @@ func (i *UserSettingHistoryWhereInput) P() (predicate.UserSettingHistory, error)
 	if i.LockedNEQ != nil {
 		predicates = append(predicates, usersettinghistory.LockedNEQ(*i.LockedNEQ))
 	}
+	if i.LockedUntil != nil {
+		predicates = append(predicates, usersettinghistory.LockedUntilEQ(*i.LockedUntil))
+	}
+	if i.LockedUntilNEQ != nil {
+		predicates = append(predicates, usersettinghistory.LockedUntilNEQ(*i.LockedUntilNEQ))
+	}
+	if len(i.LockedUntilIn) > 0 {
+		predicates = append(predicates, usersettinghistory.LockedUntilIn(i.LockedUntilIn...))
+	}
+	if len(i.LockedUntilNotIn) > 0 {
+		predicates = append(predicates, usersettinghistory.LockedUntilNotIn(i.LockedUntilNotIn...))
+	}
+	if i.LockedUntilGT != nil {
+		predicates = append(predicates, usersettinghistory.LockedUntilGT(*i.LockedUntilGT))
+	}
+	if i.LockedUntilGTE != nil {
+		predicates = append(predicates, usersettinghistory.LockedUntilGTE(*i.LockedUntilGTE))
+	}
+	if i.LockedUntilLT != nil {
+		predicates = append(predicates, usersettinghistory.LockedUntilLT(*i.LockedUntilLT))
+	}
+	if i.LockedUntilLTE != nil {
+		predicates = append(predicates, usersettinghistory.LockedUntilLTE(*i.LockedUntilLTE))
+	}
+	if i.LockedUntilIsNil {
+		predicates = append(predicates, usersettinghistory.LockedUntilIsNil())
+	}
+	if i.LockedUntilNotNil {
+		predicates = append(predicates, usersettinghistory.LockedUntilNotNil())
+	}
+	if i.FailedLoginAttempts != nil {
+		predicates = append(predicates, usersettinghistory.FailedLoginAttemptsEQ(*i.FailedLoginAttempts))
+	}
+	if i.FailedLoginAttemptsNEQ != nil {
+		predicates = append(predicates, usersettinghistory.FailedLoginAttemptsNEQ(*i.FailedLoginAttemptsNEQ))
+	}
+	if len(i.FailedLoginAttemptsIn) > 0 {
+		predicates = append(predicates, usersettinghistory.FailedLoginAttemptsIn(i.FailedLoginAttemptsIn...))
+	}
+	if len(i.FailedLoginAttemptsNotIn) > 0 {
+		predicates = append(predicates, usersettinghistory.FailedLoginAttemptsNotIn(i.FailedLoginAttemptsNotIn...))
+	}
+	if i.FailedLoginAttemptsGT != nil {
+		predicates = append(predicates, usersettinghistory.FailedLoginAttemptsGT(*i.FailedLoginAttemptsGT))
+	}
+	if i.FailedLoginAttemptsGTE != nil {
+		predicates = append(predicates, usersettinghistory.FailedLoginAttemptsGTE(*i.FailedLoginAttemptsGTE))
+	}
+	if i.FailedLoginAttemptsLT != nil {
+		predicates = append(predicates, usersettinghistory.FailedLoginAttemptsLT(*i.FailedLoginAttemptsLT))
+	}
+	if i.FailedLoginAttemptsLTE != nil {
+		predicates = append(predicates, usersettinghistory.FailedLoginAttemptsLTE(*i.FailedLoginAttemptsLTE))
+	}
 	if i.SilencedAt != nil {
 		predicates = append(predicates, usersettinghistory.SilencedAtEQ(*i.SilencedAt))
 	}
@@ -41190,6 +42982,10 @@ type WebhookWhereInput struct {
 	HasEvents     *bool              `json:"hasEvents,omitempty"`
 	HasEventsWith []*EventWhereInput `json:"hasEventsWith,omitempty"`
 
+	// "deliveries" edge predicates.
+	HasDeliveries     *bool                        `json:"hasDeliveries,omitempty"`
+	HasDeliveriesWith []*WebhookDeliveryWhereInput `json:"hasDeliveriesWith,omitempty"`
+
 	// "integrations" edge predicates.
 	HasIntegrations     *bool                    `json:"hasIntegrations,omitempty"`
 	HasIntegrationsWith []*IntegrationWhereInput `json:"hasIntegrationsWith,omitempty"`
@@ -41807,6 +43603,24 @@ func (i *WebhookWhereInput) P() (predicate.Webhook, error) {
 		}
 		predicates = append(predicates, webhook.HasEventsWith(with...))
 	}
+	if i.HasDeliveries != nil {
+		p := webhook.HasDeliveries()
+		if !*i.HasDeliveries {
+			p = webhook.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDeliveriesWith) > 0 {
+		with := make([]predicate.WebhookDelivery, 0, len(i.HasDeliveriesWith))
+		for _, w := range i.HasDeliveriesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDeliveriesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, webhook.HasDeliveriesWith(with...))
+	}
 	if i.HasIntegrations != nil {
 		p := webhook.HasIntegrations()
 		if !*i.HasIntegrations {
@@ -41835,6 +43649,710 @@ func (i *WebhookWhereInput) P() (predicate.Webhook, error) {
 	}
 }
 
+// WebhookDeliveryWhereInput represents a where input for filtering WebhookDelivery queries.
+type WebhookDeliveryWhereInput struct {
+	Predicates []predicate.WebhookDelivery  `json:"-"`
+	Not        *WebhookDeliveryWhereInput   `json:"not,omitempty"`
+	Or         []*WebhookDeliveryWhereInput `json:"or,omitempty"`
+	And        []*WebhookDeliveryWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt       *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ    *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT     *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE    *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT     *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE    *time.Time  `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil bool        `json:"createdAtNotNil,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt       *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ    *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT     *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE    *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT     *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE    *time.Time  `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil bool        `json:"updatedAtNotNil,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNEQ          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGT           *string  `json:"createdByGT,omitempty"`
+	CreatedByGTE          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLT           *string  `json:"createdByLT,omitempty"`
+	CreatedByLTE          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        bool     `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       bool     `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+
+	// "updated_by" field predicates.
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNEQ          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGT           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGTE          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLT           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLTE          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        bool     `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       bool     `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+
+	// "owner_id" field predicates.
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNEQ          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGT           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGTE          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLT           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLTE          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDIsNil        bool     `json:"ownerIDIsNil,omitempty"`
+	OwnerIDNotNil       bool     `json:"ownerIDNotNil,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
+
+	// "webhook_id" field predicates.
+	WebhookID             *string  `json:"webhookID,omitempty"`
+	WebhookIDNEQ          *string  `json:"webhookIDNEQ,omitempty"`
+	WebhookIDIn           []string `json:"webhookIDIn,omitempty"`
+	WebhookIDNotIn        []string `json:"webhookIDNotIn,omitempty"`
+	WebhookIDGT           *string  `json:"webhookIDGT,omitempty"`
+	WebhookIDGTE          *string  `json:"webhookIDGTE,omitempty"`
+	WebhookIDLT           *string  `json:"webhookIDLT,omitempty"`
+	WebhookIDLTE          *string  `json:"webhookIDLTE,omitempty"`
+	WebhookIDContains     *string  `json:"webhookIDContains,omitempty"`
+	WebhookIDHasPrefix    *string  `json:"webhookIDHasPrefix,omitempty"`
+	WebhookIDHasSuffix    *string  `json:"webhookIDHasSuffix,omitempty"`
+	WebhookIDEqualFold    *string  `json:"webhookIDEqualFold,omitempty"`
+	WebhookIDContainsFold *string  `json:"webhookIDContainsFold,omitempty"`
+
+	// "event_id" field predicates.
+	EventID             *string  `json:"eventID,omitempty"`
+	EventIDNEQ          *string  `json:"eventIDNEQ,omitempty"`
+	EventIDIn           []string `json:"eventIDIn,omitempty"`
+	EventIDNotIn        []string `json:"eventIDNotIn,omitempty"`
+	EventIDGT           *string  `json:"eventIDGT,omitempty"`
+	EventIDGTE          *string  `json:"eventIDGTE,omitempty"`
+	EventIDLT           *string  `json:"eventIDLT,omitempty"`
+	EventIDLTE          *string  `json:"eventIDLTE,omitempty"`
+	EventIDContains     *string  `json:"eventIDContains,omitempty"`
+	EventIDHasPrefix    *string  `json:"eventIDHasPrefix,omitempty"`
+	EventIDHasSuffix    *string  `json:"eventIDHasSuffix,omitempty"`
+	EventIDEqualFold    *string  `json:"eventIDEqualFold,omitempty"`
+	EventIDContainsFold *string  `json:"eventIDContainsFold,omitempty"`
+
+	// "attempt" field predicates.
+	Attempt      *int  `json:"attempt,omitempty"`
+	AttemptNEQ   *int  `json:"attemptNEQ,omitempty"`
+	AttemptIn    []int `json:"attemptIn,omitempty"`
+	AttemptNotIn []int `json:"attemptNotIn,omitempty"`
+	AttemptGT    *int  `json:"attemptGT,omitempty"`
+	AttemptGTE   *int  `json:"attemptGTE,omitempty"`
+	AttemptLT    *int  `json:"attemptLT,omitempty"`
+	AttemptLTE   *int  `json:"attemptLTE,omitempty"`
+
+	// "redelivery" field predicates.
+	Redelivery    *bool `json:"redelivery,omitempty"`
+	RedeliveryNEQ *bool `json:"redeliveryNEQ,omitempty"`
+
+	// "response_status" field predicates.
+	ResponseStatus       *int  `json:"responseStatus,omitempty"`
+	ResponseStatusNEQ    *int  `json:"responseStatusNEQ,omitempty"`
+	ResponseStatusIn     []int `json:"responseStatusIn,omitempty"`
+	ResponseStatusNotIn  []int `json:"responseStatusNotIn,omitempty"`
+	ResponseStatusGT     *int  `json:"responseStatusGT,omitempty"`
+	ResponseStatusGTE    *int  `json:"responseStatusGTE,omitempty"`
+	ResponseStatusLT     *int  `json:"responseStatusLT,omitempty"`
+	ResponseStatusLTE    *int  `json:"responseStatusLTE,omitempty"`
+	ResponseStatusIsNil  bool  `json:"responseStatusIsNil,omitempty"`
+	ResponseStatusNotNil bool  `json:"responseStatusNotNil,omitempty"`
+
+	// "latency" field predicates.
+	Latency       *int64  `json:"latency,omitempty"`
+	LatencyNEQ    *int64  `json:"latencyNEQ,omitempty"`
+	LatencyIn     []int64 `json:"latencyIn,omitempty"`
+	LatencyNotIn  []int64 `json:"latencyNotIn,omitempty"`
+	LatencyGT     *int64  `json:"latencyGT,omitempty"`
+	LatencyGTE    *int64  `json:"latencyGTE,omitempty"`
+	LatencyLT     *int64  `json:"latencyLT,omitempty"`
+	LatencyLTE    *int64  `json:"latencyLTE,omitempty"`
+	LatencyIsNil  bool    `json:"latencyIsNil,omitempty"`
+	LatencyNotNil bool    `json:"latencyNotNil,omitempty"`
+
+	// "owner" edge predicates.
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+
+	// "webhook" edge predicates.
+	HasWebhook     *bool                `json:"hasWebhook,omitempty"`
+	HasWebhookWith []*WebhookWhereInput `json:"hasWebhookWith,omitempty"`
+
+	// "event" edge predicates.
+	HasEvent     *bool              `json:"hasEvent,omitempty"`
+	HasEventWith []*EventWhereInput `json:"hasEventWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *WebhookDeliveryWhereInput) AddPredicates(predicates ...predicate.WebhookDelivery) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the WebhookDeliveryWhereInput filter on the WebhookDeliveryQuery builder.
+func (i *WebhookDeliveryWhereInput) Filter(q *WebhookDeliveryQuery) (*WebhookDeliveryQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyWebhookDeliveryWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyWebhookDeliveryWhereInput is returned in case the WebhookDeliveryWhereInput is empty.
+var ErrEmptyWebhookDeliveryWhereInput = errors.New("generated: empty predicate WebhookDeliveryWhereInput")
+
+// P returns a predicate for filtering webhookdeliveries.
+// An error is returned if the input is empty or invalid.
+func (i *WebhookDeliveryWhereInput) P() (predicate.WebhookDelivery, error) {
+	var predicates []predicate.WebhookDelivery
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, webhookdelivery.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.WebhookDelivery, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, webhookdelivery.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.WebhookDelivery, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, webhookdelivery.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, webhookdelivery.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, webhookdelivery.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, webhookdelivery.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, webhookdelivery.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, webhookdelivery.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, webhookdelivery.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, webhookdelivery.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, webhookdelivery.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, webhookdelivery.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, webhookdelivery.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, webhookdelivery.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, webhookdelivery.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, webhookdelivery.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, webhookdelivery.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, webhookdelivery.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, webhookdelivery.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.CreatedAtIsNil {
+		predicates = append(predicates, webhookdelivery.CreatedAtIsNil())
+	}
+	if i.CreatedAtNotNil {
+		predicates = append(predicates, webhookdelivery.CreatedAtNotNil())
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, webhookdelivery.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.UpdatedAtIsNil {
+		predicates = append(predicates, webhookdelivery.UpdatedAtIsNil())
+	}
+	if i.UpdatedAtNotNil {
+		predicates = append(predicates, webhookdelivery.UpdatedAtNotNil())
+	}
+	if i.CreatedBy != nil {
+		predicates = append(predicates, webhookdelivery.CreatedByEQ(*i.CreatedBy))
+	}
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, webhookdelivery.CreatedByNEQ(*i.CreatedByNEQ))
+	}
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, webhookdelivery.CreatedByIn(i.CreatedByIn...))
+	}
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.CreatedByNotIn(i.CreatedByNotIn...))
+	}
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, webhookdelivery.CreatedByGT(*i.CreatedByGT))
+	}
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, webhookdelivery.CreatedByGTE(*i.CreatedByGTE))
+	}
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, webhookdelivery.CreatedByLT(*i.CreatedByLT))
+	}
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, webhookdelivery.CreatedByLTE(*i.CreatedByLTE))
+	}
+	if i.CreatedByContains != nil {
+		predicates = append(predicates, webhookdelivery.CreatedByContains(*i.CreatedByContains))
+	}
+	if i.CreatedByHasPrefix != nil {
+		predicates = append(predicates, webhookdelivery.CreatedByHasPrefix(*i.CreatedByHasPrefix))
+	}
+	if i.CreatedByHasSuffix != nil {
+		predicates = append(predicates, webhookdelivery.CreatedByHasSuffix(*i.CreatedByHasSuffix))
+	}
+	if i.CreatedByIsNil {
+		predicates = append(predicates, webhookdelivery.CreatedByIsNil())
+	}
+	if i.CreatedByNotNil {
+		predicates = append(predicates, webhookdelivery.CreatedByNotNil())
+	}
+	if i.CreatedByEqualFold != nil {
+		predicates = append(predicates, webhookdelivery.CreatedByEqualFold(*i.CreatedByEqualFold))
+	}
+	if i.CreatedByContainsFold != nil {
+		predicates = append(predicates, webhookdelivery.CreatedByContainsFold(*i.CreatedByContainsFold))
+	}
+	if i.UpdatedBy != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedByEQ(*i.UpdatedBy))
+	}
+	if i.UpdatedByNEQ != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedByNEQ(*i.UpdatedByNEQ))
+	}
+	if len(i.UpdatedByIn) > 0 {
+		predicates = append(predicates, webhookdelivery.UpdatedByIn(i.UpdatedByIn...))
+	}
+	if len(i.UpdatedByNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.UpdatedByNotIn(i.UpdatedByNotIn...))
+	}
+	if i.UpdatedByGT != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedByGT(*i.UpdatedByGT))
+	}
+	if i.UpdatedByGTE != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedByGTE(*i.UpdatedByGTE))
+	}
+	if i.UpdatedByLT != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedByLT(*i.UpdatedByLT))
+	}
+	if i.UpdatedByLTE != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedByLTE(*i.UpdatedByLTE))
+	}
+	if i.UpdatedByContains != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedByContains(*i.UpdatedByContains))
+	}
+	if i.UpdatedByHasPrefix != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedByHasPrefix(*i.UpdatedByHasPrefix))
+	}
+	if i.UpdatedByHasSuffix != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedByHasSuffix(*i.UpdatedByHasSuffix))
+	}
+	if i.UpdatedByIsNil {
+		predicates = append(predicates, webhookdelivery.UpdatedByIsNil())
+	}
+	if i.UpdatedByNotNil {
+		predicates = append(predicates, webhookdelivery.UpdatedByNotNil())
+	}
+	if i.UpdatedByEqualFold != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedByEqualFold(*i.UpdatedByEqualFold))
+	}
+	if i.UpdatedByContainsFold != nil {
+		predicates = append(predicates, webhookdelivery.UpdatedByContainsFold(*i.UpdatedByContainsFold))
+	}
+	if i.OwnerID != nil {
+		predicates = append(predicates, webhookdelivery.OwnerIDEQ(*i.OwnerID))
+	}
+	if i.OwnerIDNEQ != nil {
+		predicates = append(predicates, webhookdelivery.OwnerIDNEQ(*i.OwnerIDNEQ))
+	}
+	if len(i.OwnerIDIn) > 0 {
+		predicates = append(predicates, webhookdelivery.OwnerIDIn(i.OwnerIDIn...))
+	}
+	if len(i.OwnerIDNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.OwnerIDNotIn(i.OwnerIDNotIn...))
+	}
+	if i.OwnerIDGT != nil {
+		predicates = append(predicates, webhookdelivery.OwnerIDGT(*i.OwnerIDGT))
+	}
+	if i.OwnerIDGTE != nil {
+		predicates = append(predicates, webhookdelivery.OwnerIDGTE(*i.OwnerIDGTE))
+	}
+	if i.OwnerIDLT != nil {
+		predicates = append(predicates, webhookdelivery.OwnerIDLT(*i.OwnerIDLT))
+	}
+	if i.OwnerIDLTE != nil {
+		predicates = append(predicates, webhookdelivery.OwnerIDLTE(*i.OwnerIDLTE))
+	}
+	if i.OwnerIDContains != nil {
+		predicates = append(predicates, webhookdelivery.OwnerIDContains(*i.OwnerIDContains))
+	}
+	if i.OwnerIDHasPrefix != nil {
+		predicates = append(predicates, webhookdelivery.OwnerIDHasPrefix(*i.OwnerIDHasPrefix))
+	}
+	if i.OwnerIDHasSuffix != nil {
+		predicates = append(predicates, webhookdelivery.OwnerIDHasSuffix(*i.OwnerIDHasSuffix))
+	}
+	if i.OwnerIDIsNil {
+		predicates = append(predicates, webhookdelivery.OwnerIDIsNil())
+	}
+	if i.OwnerIDNotNil {
+		predicates = append(predicates, webhookdelivery.OwnerIDNotNil())
+	}
+	if i.OwnerIDEqualFold != nil {
+		predicates = append(predicates, webhookdelivery.OwnerIDEqualFold(*i.OwnerIDEqualFold))
+	}
+	if i.OwnerIDContainsFold != nil {
+		predicates = append(predicates, webhookdelivery.OwnerIDContainsFold(*i.OwnerIDContainsFold))
+	}
+	if i.WebhookID != nil {
+		predicates = append(predicates, webhookdelivery.WebhookIDEQ(*i.WebhookID))
+	}
+	if i.WebhookIDNEQ != nil {
+		predicates = append(predicates, webhookdelivery.WebhookIDNEQ(*i.WebhookIDNEQ))
+	}
+	if len(i.WebhookIDIn) > 0 {
+		predicates = append(predicates, webhookdelivery.WebhookIDIn(i.WebhookIDIn...))
+	}
+	if len(i.WebhookIDNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.WebhookIDNotIn(i.WebhookIDNotIn...))
+	}
+	if i.WebhookIDGT != nil {
+		predicates = append(predicates, webhookdelivery.WebhookIDGT(*i.WebhookIDGT))
+	}
+	if i.WebhookIDGTE != nil {
+		predicates = append(predicates, webhookdelivery.WebhookIDGTE(*i.WebhookIDGTE))
+	}
+	if i.WebhookIDLT != nil {
+		predicates = append(predicates, webhookdelivery.WebhookIDLT(*i.WebhookIDLT))
+	}
+	if i.WebhookIDLTE != nil {
+		predicates = append(predicates, webhookdelivery.WebhookIDLTE(*i.WebhookIDLTE))
+	}
+	if i.WebhookIDContains != nil {
+		predicates = append(predicates, webhookdelivery.WebhookIDContains(*i.WebhookIDContains))
+	}
+	if i.WebhookIDHasPrefix != nil {
+		predicates = append(predicates, webhookdelivery.WebhookIDHasPrefix(*i.WebhookIDHasPrefix))
+	}
+	if i.WebhookIDHasSuffix != nil {
+		predicates = append(predicates, webhookdelivery.WebhookIDHasSuffix(*i.WebhookIDHasSuffix))
+	}
+	if i.WebhookIDEqualFold != nil {
+		predicates = append(predicates, webhookdelivery.WebhookIDEqualFold(*i.WebhookIDEqualFold))
+	}
+	if i.WebhookIDContainsFold != nil {
+		predicates = append(predicates, webhookdelivery.WebhookIDContainsFold(*i.WebhookIDContainsFold))
+	}
+	if i.EventID != nil {
+		predicates = append(predicates, webhookdelivery.EventIDEQ(*i.EventID))
+	}
+	if i.EventIDNEQ != nil {
+		predicates = append(predicates, webhookdelivery.EventIDNEQ(*i.EventIDNEQ))
+	}
+	if len(i.EventIDIn) > 0 {
+		predicates = append(predicates, webhookdelivery.EventIDIn(i.EventIDIn...))
+	}
+	if len(i.EventIDNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.EventIDNotIn(i.EventIDNotIn...))
+	}
+	if i.EventIDGT != nil {
+		predicates = append(predicates, webhookdelivery.EventIDGT(*i.EventIDGT))
+	}
+	if i.EventIDGTE != nil {
+		predicates = append(predicates, webhookdelivery.EventIDGTE(*i.EventIDGTE))
+	}
+	if i.EventIDLT != nil {
+		predicates = append(predicates, webhookdelivery.EventIDLT(*i.EventIDLT))
+	}
+	if i.EventIDLTE != nil {
+		predicates = append(predicates, webhookdelivery.EventIDLTE(*i.EventIDLTE))
+	}
+	if i.EventIDContains != nil {
+		predicates = append(predicates, webhookdelivery.EventIDContains(*i.EventIDContains))
+	}
+	if i.EventIDHasPrefix != nil {
+		predicates = append(predicates, webhookdelivery.EventIDHasPrefix(*i.EventIDHasPrefix))
+	}
+	if i.EventIDHasSuffix != nil {
+		predicates = append(predicates, webhookdelivery.EventIDHasSuffix(*i.EventIDHasSuffix))
+	}
+	if i.EventIDEqualFold != nil {
+		predicates = append(predicates, webhookdelivery.EventIDEqualFold(*i.EventIDEqualFold))
+	}
+	if i.EventIDContainsFold != nil {
+		predicates = append(predicates, webhookdelivery.EventIDContainsFold(*i.EventIDContainsFold))
+	}
+	if i.Attempt != nil {
+		predicates = append(predicates, webhookdelivery.AttemptEQ(*i.Attempt))
+	}
+	if i.AttemptNEQ != nil {
+		predicates = append(predicates, webhookdelivery.AttemptNEQ(*i.AttemptNEQ))
+	}
+	if len(i.AttemptIn) > 0 {
+		predicates = append(predicates, webhookdelivery.AttemptIn(i.AttemptIn...))
+	}
+	if len(i.AttemptNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.AttemptNotIn(i.AttemptNotIn...))
+	}
+	if i.AttemptGT != nil {
+		predicates = append(predicates, webhookdelivery.AttemptGT(*i.AttemptGT))
+	}
+	if i.AttemptGTE != nil {
+		predicates = append(predicates, webhookdelivery.AttemptGTE(*i.AttemptGTE))
+	}
+	if i.AttemptLT != nil {
+		predicates = append(predicates, webhookdelivery.AttemptLT(*i.AttemptLT))
+	}
+	if i.AttemptLTE != nil {
+		predicates = append(predicates, webhookdelivery.AttemptLTE(*i.AttemptLTE))
+	}
+	if i.Redelivery != nil {
+		predicates = append(predicates, webhookdelivery.RedeliveryEQ(*i.Redelivery))
+	}
+	if i.RedeliveryNEQ != nil {
+		predicates = append(predicates, webhookdelivery.RedeliveryNEQ(*i.RedeliveryNEQ))
+	}
+	if i.ResponseStatus != nil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusEQ(*i.ResponseStatus))
+	}
+	if i.ResponseStatusNEQ != nil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusNEQ(*i.ResponseStatusNEQ))
+	}
+	if len(i.ResponseStatusIn) > 0 {
+		predicates = append(predicates, webhookdelivery.ResponseStatusIn(i.ResponseStatusIn...))
+	}
+	if len(i.ResponseStatusNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.ResponseStatusNotIn(i.ResponseStatusNotIn...))
+	}
+	if i.ResponseStatusGT != nil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusGT(*i.ResponseStatusGT))
+	}
+	if i.ResponseStatusGTE != nil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusGTE(*i.ResponseStatusGTE))
+	}
+	if i.ResponseStatusLT != nil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusLT(*i.ResponseStatusLT))
+	}
+	if i.ResponseStatusLTE != nil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusLTE(*i.ResponseStatusLTE))
+	}
+	if i.ResponseStatusIsNil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusIsNil())
+	}
+	if i.ResponseStatusNotNil {
+		predicates = append(predicates, webhookdelivery.ResponseStatusNotNil())
+	}
+	if i.Latency != nil {
+		predicates = append(predicates, webhookdelivery.LatencyEQ(*i.Latency))
+	}
+	if i.LatencyNEQ != nil {
+		predicates = append(predicates, webhookdelivery.LatencyNEQ(*i.LatencyNEQ))
+	}
+	if len(i.LatencyIn) > 0 {
+		predicates = append(predicates, webhookdelivery.LatencyIn(i.LatencyIn...))
+	}
+	if len(i.LatencyNotIn) > 0 {
+		predicates = append(predicates, webhookdelivery.LatencyNotIn(i.LatencyNotIn...))
+	}
+	if i.LatencyGT != nil {
+		predicates = append(predicates, webhookdelivery.LatencyGT(*i.LatencyGT))
+	}
+	if i.LatencyGTE != nil {
+		predicates = append(predicates, webhookdelivery.LatencyGTE(*i.LatencyGTE))
+	}
+	if i.LatencyLT != nil {
+		predicates = append(predicates, webhookdelivery.LatencyLT(*i.LatencyLT))
+	}
+	if i.LatencyLTE != nil {
+		predicates = append(predicates, webhookdelivery.LatencyLTE(*i.LatencyLTE))
+	}
+	if i.LatencyIsNil {
+		predicates = append(predicates, webhookdelivery.LatencyIsNil())
+	}
+	if i.LatencyNotNil {
+		predicates = append(predicates, webhookdelivery.LatencyNotNil())
+	}
+
+	if i.HasOwner != nil {
+		p := webhookdelivery.HasOwner()
+		if !*i.HasOwner {
+			p = webhookdelivery.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOwnerWith) > 0 {
+		with := make([]predicate.Organization, 0, len(i.HasOwnerWith))
+		for _, w := range i.HasOwnerWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOwnerWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, webhookdelivery.HasOwnerWith(with...))
+	}
+	if i.HasWebhook != nil {
+		p := webhookdelivery.HasWebhook()
+		if !*i.HasWebhook {
+			p = webhookdelivery.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasWebhookWith) > 0 {
+		with := make([]predicate.Webhook, 0, len(i.HasWebhookWith))
+		for _, w := range i.HasWebhookWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasWebhookWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, webhookdelivery.HasWebhookWith(with...))
+	}
+	if i.HasEvent != nil {
+		p := webhookdelivery.HasEvent()
+		if !*i.HasEvent {
+			p = webhookdelivery.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasEventWith) > 0 {
+		with := make([]predicate.Event, 0, len(i.HasEventWith))
+		for _, w := range i.HasEventWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasEventWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, webhookdelivery.HasEventWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyWebhookDeliveryWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return webhookdelivery.And(predicates...), nil
+	}
+}
+
 // WebhookHistoryWhereInput represents a where input for filtering WebhookHistory queries.
 type WebhookHistoryWhereInput struct {
 	Predicates []predicate.WebhookHistory  `json:"-"`
@@ -347,6 +347,10 @@ func (m *DocumentDataMutation) CreateHistoryFromCreate(ctx context.Context) erro
 		create = create.SetTemplateID(templateID)
 	}
 
+	if templateVersion, exists := m.TemplateVersion(); exists {
+		create = create.SetTemplateVersion(templateVersion)
+	}
+
 	if data, exists := m.Data(); exists {
 		create = create.SetData(data)
 	}
@@ -441,6 +445,12 @@ func (m *DocumentDataMutation) CreateHistoryFromUpdate(ctx context.Context) erro
 			create = create.SetTemplateID(documentdata.TemplateID)
 		}
 
+		if templateVersion, exists := m.TemplateVersion(); exists {
+			create = create.SetTemplateVersion(templateVersion)
+		} else {
+			create = create.SetTemplateVersion(documentdata.TemplateVersion)
+		}
+
 		if data, exists := m.Data(); exists {
 			create = create.SetData(data)
 		} else {
@@ -489,6 +499,7 @@ func (m *DocumentDataMutation) CreateHistoryFromDelete(ctx context.Context) erro
 			SetDeletedBy(documentdata.DeletedBy).
 			SetOwnerID(documentdata.OwnerID).
 			SetTemplateID(documentdata.TemplateID).
+			SetTemplateVersion(documentdata.TemplateVersion).
 			SetData(documentdata.Data).
 			Save(ctx)
 		if err != nil {
@@ -3737,6 +3748,10 @@ func (m *OauthProviderMutation) CreateHistoryFromCreate(ctx context.Context) err
 		create = create.SetInfoURL(infoURL)
 	}
 
+	if issuer, exists := m.Issuer(); exists {
+		create = create.SetIssuer(issuer)
+	}
+
 	_, err := create.Save(ctx)
 
 	return err
@@ -3875,6 +3890,12 @@ func (m *OauthProviderMutation) CreateHistoryFromUpdate(ctx context.Context) err
 			create = create.SetInfoURL(oauthprovider.InfoURL)
 		}
 
+		if issuer, exists := m.Issuer(); exists {
+			create = create.SetIssuer(issuer)
+		} else {
+			create = create.SetIssuer(oauthprovider.Issuer)
+		}
+
 		if _, err := create.Save(ctx); err != nil {
 			return err
 		}
@@ -3925,6 +3946,7 @@ func (m *OauthProviderMutation) CreateHistoryFromDelete(ctx context.Context) err
 			SetTokenURL(oauthprovider.TokenURL).
 			SetAuthStyle(oauthprovider.AuthStyle).
 			SetInfoURL(oauthprovider.InfoURL).
+			SetIssuer(oauthprovider.Issuer).
 			Save(ctx)
 		if err != nil {
 			return err
@@ -3989,6 +4011,18 @@ func (m *OrgMembershipMutation) CreateHistoryFromCreate(ctx context.Context) err
 		create = create.SetUserID(userID)
 	}
 
+	if ssoIssuer, exists := m.SSOIssuer(); exists {
+		create = create.SetNillableSSOIssuer(&ssoIssuer)
+	}
+
+	if ssoSubject, exists := m.SSOSubject(); exists {
+		create = create.SetNillableSSOSubject(&ssoSubject)
+	}
+
+	if userProvisioned, exists := m.UserProvisioned(); exists {
+		create = create.SetUserProvisioned(userProvisioned)
+	}
+
 	_, err := create.Save(ctx)
 
 	return err
@@ -4079,6 +4113,24 @@ func (m *OrgMembershipMutation) CreateHistoryFromUpdate(ctx context.Context) err
 			create = create.SetUserID(orgmembership.UserID)
 		}
 
+		if ssoIssuer, exists := m.SSOIssuer(); exists {
+			create = create.SetNillableSSOIssuer(&ssoIssuer)
+		} else {
+			create = create.SetNillableSSOIssuer(orgmembership.SSOIssuer)
+		}
+
+		if ssoSubject, exists := m.SSOSubject(); exists {
+			create = create.SetNillableSSOSubject(&ssoSubject)
+		} else {
+			create = create.SetNillableSSOSubject(orgmembership.SSOSubject)
+		}
+
+		if userProvisioned, exists := m.UserProvisioned(); exists {
+			create = create.SetUserProvisioned(userProvisioned)
+		} else {
+			create = create.SetUserProvisioned(orgmembership.UserProvisioned)
+		}
+
 		if _, err := create.Save(ctx); err != nil {
 			return err
 		}
@@ -4121,6 +4173,9 @@ func (m *OrgMembershipMutation) CreateHistoryFromDelete(ctx context.Context) err
 			SetRole(orgmembership.Role).
 			SetOrganizationID(orgmembership.OrganizationID).
 			SetUserID(orgmembership.UserID).
+			SetNillableSSOIssuer(orgmembership.SSOIssuer).
+			SetNillableSSOSubject(orgmembership.SSOSubject).
+			SetUserProvisioned(orgmembership.UserProvisioned).
 			Save(ctx)
 		if err != nil {
 			return err
@@ -4460,6 +4515,34 @@ func (m *OrganizationSettingMutation) CreateHistoryFromCreate(ctx context.Contex
 		create = create.SetOrganizationID(organizationID)
 	}
 
+	if ssoEnforced, exists := m.SSOEnforced(); exists {
+		create = create.SetSSOEnforced(ssoEnforced)
+	}
+
+	if samlMetadataURL, exists := m.SamlMetadataURL(); exists {
+		create = create.SetSamlMetadataURL(samlMetadataURL)
+	}
+
+	if samlMetadata, exists := m.SamlMetadata(); exists {
+		create = create.SetSamlMetadata(samlMetadata)
+	}
+
+	if samlAttributeMapping, exists := m.SamlAttributeMapping(); exists {
+		create = create.SetSamlAttributeMapping(samlAttributeMapping)
+	}
+
+	if domainVerificationToken, exists := m.DomainVerificationToken(); exists {
+		create = create.SetDomainVerificationToken(domainVerificationToken)
+	}
+
+	if verifiedDomains, exists := m.VerifiedDomains(); exists {
+		create = create.SetVerifiedDomains(verifiedDomains)
+	}
+
+	if joinPolicy, exists := m.JoinPolicy(); exists {
+		create = create.SetJoinPolicy(joinPolicy)
+	}
+
 	_, err := create.Save(ctx)
 
 	return err
@@ -4586,6 +4669,48 @@ func (m *OrganizationSettingMutation) CreateHistoryFromUpdate(ctx context.Contex
 			create = create.SetOrganizationID(organizationsetting.OrganizationID)
 		}
 
+		if ssoEnforced, exists := m.SSOEnforced(); exists {
+			create = create.SetSSOEnforced(ssoEnforced)
+		} else {
+			create = create.SetSSOEnforced(organizationsetting.SSOEnforced)
+		}
+
+		if samlMetadataURL, exists := m.SamlMetadataURL(); exists {
+			create = create.SetSamlMetadataURL(samlMetadataURL)
+		} else {
+			create = create.SetSamlMetadataURL(organizationsetting.SamlMetadataURL)
+		}
+
+		if samlMetadata, exists := m.SamlMetadata(); exists {
+			create = create.SetSamlMetadata(samlMetadata)
+		} else {
+			create = create.SetSamlMetadata(organizationsetting.SamlMetadata)
+		}
+
+		if samlAttributeMapping, exists := m.SamlAttributeMapping(); exists {
+			create = create.SetSamlAttributeMapping(samlAttributeMapping)
+		} else {
+			create = create.SetSamlAttributeMapping(organizationsetting.SamlAttributeMapping)
+		}
+
+		if domainVerificationToken, exists := m.DomainVerificationToken(); exists {
+			create = create.SetDomainVerificationToken(domainVerificationToken)
+		} else {
+			create = create.SetDomainVerificationToken(organizationsetting.DomainVerificationToken)
+		}
+
+		if verifiedDomains, exists := m.VerifiedDomains(); exists {
+			create = create.SetVerifiedDomains(verifiedDomains)
+		} else {
+			create = create.SetVerifiedDomains(organizationsetting.VerifiedDomains)
+		}
+
+		if joinPolicy, exists := m.JoinPolicy(); exists {
+			create = create.SetJoinPolicy(joinPolicy)
+		} else {
+			create = create.SetJoinPolicy(organizationsetting.JoinPolicy)
+		}
+
 		if _, err := create.Save(ctx); err != nil {
 			return err
 		}
@@ -4634,6 +4759,13 @@ func (m *OrganizationSettingMutation) CreateHistoryFromDelete(ctx context.Contex
 			SetTaxIdentifier(organizationsetting.TaxIdentifier).
 			SetGeoLocation(organizationsetting.GeoLocation).
 			SetOrganizationID(organizationsetting.OrganizationID).
+			SetSSOEnforced(organizationsetting.SSOEnforced).
+			SetSamlMetadataURL(organizationsetting.SamlMetadataURL).
+			SetSamlMetadata(organizationsetting.SamlMetadata).
+			SetSamlAttributeMapping(organizationsetting.SamlAttributeMapping).
+			SetDomainVerificationToken(organizationsetting.DomainVerificationToken).
+			SetVerifiedDomains(organizationsetting.VerifiedDomains).
+			SetJoinPolicy(organizationsetting.JoinPolicy).
 			Save(ctx)
 		if err != nil {
 			return err
@@ -4714,6 +4846,18 @@ func (m *TemplateMutation) CreateHistoryFromCreate(ctx context.Context) error {
 		create = create.SetUischema(uischema)
 	}
 
+	if version, exists := m.Version(); exists {
+		create = create.SetVersion(version)
+	}
+
+	if sourceTemplateID, exists := m.SourceTemplateID(); exists {
+		create = create.SetSourceTemplateID(sourceTemplateID)
+	}
+
+	if sourceTemplateVersion, exists := m.SourceTemplateVersion(); exists {
+		create = create.SetSourceTemplateVersion(sourceTemplateVersion)
+	}
+
 	_, err := create.Save(ctx)
 
 	return err
@@ -4828,6 +4972,24 @@ func (m *TemplateMutation) CreateHistoryFromUpdate(ctx context.Context) error {
 			create = create.SetUischema(template.Uischema)
 		}
 
+		if version, exists := m.Version(); exists {
+			create = create.SetVersion(version)
+		} else {
+			create = create.SetVersion(template.Version)
+		}
+
+		if sourceTemplateID, exists := m.SourceTemplateID(); exists {
+			create = create.SetSourceTemplateID(sourceTemplateID)
+		} else {
+			create = create.SetSourceTemplateID(template.SourceTemplateID)
+		}
+
+		if sourceTemplateVersion, exists := m.SourceTemplateVersion(); exists {
+			create = create.SetSourceTemplateVersion(sourceTemplateVersion)
+		} else {
+			create = create.SetSourceTemplateVersion(template.SourceTemplateVersion)
+		}
+
 		if _, err := create.Save(ctx); err != nil {
 			return err
 		}
@@ -4874,6 +5036,9 @@ func (m *TemplateMutation) CreateHistoryFromDelete(ctx context.Context) error {
 			SetDescription(template.Description).
 			SetJsonconfig(template.Jsonconfig).
 			SetUischema(template.Uischema).
+			SetVersion(template.Version).
+			SetSourceTemplateID(template.SourceTemplateID).
+			SetSourceTemplateVersion(template.SourceTemplateVersion).
 			Save(ctx)
 		if err != nil {
 			return err
@@ -5244,6 +5409,14 @@ func (m *UserSettingMutation) CreateHistoryFromCreate(ctx context.Context) error
 		create = create.SetLocked(locked)
 	}
 
+	if lockedUntil, exists := m.LockedUntil(); exists {
+		create = create.SetNillableLockedUntil(&lockedUntil)
+	}
+
+	if failedLoginAttempts, exists := m.FailedLoginAttempts(); exists {
+		create = create.SetFailedLoginAttempts(failedLoginAttempts)
+	}
+
 	if silencedAt, exists := m.SilencedAt(); exists {
 		create = create.SetNillableSilencedAt(&silencedAt)
 	}
@@ -5362,6 +5535,18 @@ func (m *UserSettingMutation) CreateHistoryFromUpdate(ctx context.Context) error
 			create = create.SetLocked(usersetting.Locked)
 		}
 
+		if lockedUntil, exists := m.LockedUntil(); exists {
+			create = create.SetNillableLockedUntil(&lockedUntil)
+		} else {
+			create = create.SetNillableLockedUntil(usersetting.LockedUntil)
+		}
+
+		if failedLoginAttempts, exists := m.FailedLoginAttempts(); exists {
+			create = create.SetFailedLoginAttempts(failedLoginAttempts)
+		} else {
+			create = create.SetFailedLoginAttempts(usersetting.FailedLoginAttempts)
+		}
+
 		if silencedAt, exists := m.SilencedAt(); exists {
 			create = create.SetNillableSilencedAt(&silencedAt)
 		} else {
@@ -5446,6 +5631,8 @@ func (m *UserSettingMutation) CreateHistoryFromDelete(ctx context.Context) error
 			SetDeletedBy(usersetting.DeletedBy).
 			SetUserID(usersetting.UserID).
 			SetLocked(usersetting.Locked).
+			SetNillableLockedUntil(usersetting.LockedUntil).
+			SetFailedLoginAttempts(usersetting.FailedLoginAttempts).
 			SetNillableSilencedAt(usersetting.SilencedAt).
 			SetNillableSuspendedAt(usersetting.SuspendedAt).
 			SetStatus(usersetting.Status).
@@ -5529,6 +5716,14 @@ func (m *WebhookMutation) CreateHistoryFromCreate(ctx context.Context) error {
 		create = create.SetEnabled(enabled)
 	}
 
+	if eventTypes, exists := m.EventTypes(); exists {
+		create = create.SetEventTypes(eventTypes)
+	}
+
+	if objectTypes, exists := m.ObjectTypes(); exists {
+		create = create.SetObjectTypes(objectTypes)
+	}
+
 	if callback, exists := m.Callback(); exists {
 		create = create.SetCallback(callback)
 	}
@@ -5661,6 +5856,18 @@ func (m *WebhookMutation) CreateHistoryFromUpdate(ctx context.Context) error {
 			create = create.SetEnabled(webhook.Enabled)
 		}
 
+		if eventTypes, exists := m.EventTypes(); exists {
+			create = create.SetEventTypes(eventTypes)
+		} else {
+			create = create.SetEventTypes(webhook.EventTypes)
+		}
+
+		if objectTypes, exists := m.ObjectTypes(); exists {
+			create = create.SetObjectTypes(objectTypes)
+		} else {
+			create = create.SetObjectTypes(webhook.ObjectTypes)
+		}
+
 		if callback, exists := m.Callback(); exists {
 			create = create.SetCallback(callback)
 		} else {
@@ -5742,6 +5949,8 @@ func (m *WebhookMutation) CreateHistoryFromDelete(ctx context.Context) error {
 			SetDescription(webhook.Description).
 			SetDestinationURL(webhook.DestinationURL).
 			SetEnabled(webhook.Enabled).
+			SetEventTypes(webhook.EventTypes).
+			SetObjectTypes(webhook.ObjectTypes).
 			SetCallback(webhook.Callback).
 			SetExpiresAt(webhook.ExpiresAt).
 			SetSecret(webhook.Secret).
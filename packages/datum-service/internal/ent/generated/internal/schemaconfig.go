@@ -73,6 +73,7 @@ type SchemaConfig struct {
 	OrganizationHistory              string // OrganizationHistory table.
 	OrganizationSetting              string // OrganizationSetting table.
 	OrganizationSettingHistory       string // OrganizationSettingHistory table.
+	OutboxEvent                      string // OutboxEvent table.
 	PasswordResetToken               string // PasswordResetToken table.
 	PersonalAccessToken              string // PersonalAccessToken table.
 	PersonalAccessTokenEvents        string // PersonalAccessToken-events->Event table.
@@ -81,6 +82,7 @@ type SchemaConfig struct {
 	TFASetting                       string // TFASetting table.
 	Template                         string // Template table.
 	TemplateHistory                  string // TemplateHistory table.
+	TemplateVersion                  string // TemplateVersion table.
 	User                             string // User table.
 	UserEvents                       string // User-events->Event table.
 	UserHistory                      string // UserHistory table.
@@ -89,6 +91,7 @@ type SchemaConfig struct {
 	Webauthn                         string // Webauthn table.
 	Webhook                          string // Webhook table.
 	WebhookEvents                    string // Webhook-events->Event table.
+	WebhookDelivery                  string // WebhookDelivery table.
 	WebhookHistory                   string // WebhookHistory table.
 }
 
@@ -130,6 +130,7 @@ var (
 		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "template_version", Type: field.TypeInt, Nullable: true},
 		{Name: "data", Type: field.TypeJSON},
 		{Name: "owner_id", Type: field.TypeString, Nullable: true},
 		{Name: "template_id", Type: field.TypeString},
@@ -142,13 +143,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "document_data_organizations_documentdata",
-				Columns:    []*schema.Column{DocumentDataColumns[10]},
+				Columns:    []*schema.Column{DocumentDataColumns[11]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "document_data_templates_documents",
-				Columns:    []*schema.Column{DocumentDataColumns[11]},
+				Columns:    []*schema.Column{DocumentDataColumns[12]},
 				RefColumns: []*schema.Column{TemplatesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -170,6 +171,7 @@ var (
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "owner_id", Type: field.TypeString, Nullable: true},
 		{Name: "template_id", Type: field.TypeString},
+		{Name: "template_version", Type: field.TypeInt, Nullable: true},
 		{Name: "data", Type: field.TypeJSON},
 	}
 	// DocumentDataHistoryTable holds the schema information for the "document_data_history" table.
@@ -1297,6 +1299,7 @@ var (
 		{Name: "token_url", Type: field.TypeString},
 		{Name: "auth_style", Type: field.TypeUint8},
 		{Name: "info_url", Type: field.TypeString},
+		{Name: "issuer", Type: field.TypeString, Nullable: true},
 		{Name: "owner_id", Type: field.TypeString, Nullable: true},
 	}
 	// OauthProvidersTable holds the schema information for the "oauth_providers" table.
@@ -1307,7 +1310,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "oauth_providers_organizations_oauthprovider",
-				Columns:    []*schema.Column{OauthProvidersColumns[18]},
+				Columns:    []*schema.Column{OauthProvidersColumns[19]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1337,6 +1340,7 @@ var (
 		{Name: "token_url", Type: field.TypeString},
 		{Name: "auth_style", Type: field.TypeUint8},
 		{Name: "info_url", Type: field.TypeString},
+		{Name: "issuer", Type: field.TypeString, Nullable: true},
 	}
 	// OauthProviderHistoryTable holds the schema information for the "oauth_provider_history" table.
 	OauthProviderHistoryTable = &schema.Table{
@@ -1386,6 +1390,9 @@ var (
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "role", Type: field.TypeEnum, Enums: []string{"ADMIN", "MEMBER", "USER", "OWNER"}, Default: "MEMBER"},
+		{Name: "sso_issuer", Type: field.TypeString, Nullable: true},
+		{Name: "sso_subject", Type: field.TypeString, Nullable: true},
+		{Name: "user_provisioned", Type: field.TypeBool, Default: false},
 		{Name: "organization_id", Type: field.TypeString},
 		{Name: "user_id", Type: field.TypeString},
 	}
@@ -1397,13 +1404,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "org_memberships_organizations_organization",
-				Columns:    []*schema.Column{OrgMembershipsColumns[9]},
+				Columns:    []*schema.Column{OrgMembershipsColumns[12]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "org_memberships_users_user",
-				Columns:    []*schema.Column{OrgMembershipsColumns[10]},
+				Columns:    []*schema.Column{OrgMembershipsColumns[13]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -1412,7 +1419,15 @@ var (
 			{
 				Name:    "orgmembership_user_id_organization_id",
 				Unique:  true,
-				Columns: []*schema.Column{OrgMembershipsColumns[10], OrgMembershipsColumns[9]},
+				Columns: []*schema.Column{OrgMembershipsColumns[13], OrgMembershipsColumns[12]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at is NULL",
+				},
+			},
+			{
+				Name:    "orgmembership_organization_id_sso_issuer_sso_subject",
+				Unique:  true,
+				Columns: []*schema.Column{OrgMembershipsColumns[12], OrgMembershipsColumns[9], OrgMembershipsColumns[10]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "deleted_at is NULL",
 				},
@@ -1435,6 +1450,9 @@ var (
 		{Name: "role", Type: field.TypeEnum, Enums: []string{"ADMIN", "MEMBER", "USER", "OWNER"}, Default: "MEMBER"},
 		{Name: "organization_id", Type: field.TypeString},
 		{Name: "user_id", Type: field.TypeString},
+		{Name: "sso_issuer", Type: field.TypeString, Nullable: true},
+		{Name: "sso_subject", Type: field.TypeString, Nullable: true},
+		{Name: "user_provisioned", Type: field.TypeBool, Default: false},
 	}
 	// OrgMembershipHistoryTable holds the schema information for the "org_membership_history" table.
 	OrgMembershipHistoryTable = &schema.Table{
@@ -1545,6 +1563,13 @@ var (
 		{Name: "billing_address", Type: field.TypeString, Nullable: true},
 		{Name: "tax_identifier", Type: field.TypeString, Nullable: true},
 		{Name: "geo_location", Type: field.TypeEnum, Nullable: true, Enums: []string{"AMER", "EMEA", "APAC"}, Default: "AMER"},
+		{Name: "sso_enforced", Type: field.TypeBool, Default: false},
+		{Name: "saml_metadata_url", Type: field.TypeString, Nullable: true},
+		{Name: "saml_metadata", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "saml_attribute_mapping", Type: field.TypeJSON, Nullable: true},
+		{Name: "domain_verification_token", Type: field.TypeString, Nullable: true},
+		{Name: "verified_domains", Type: field.TypeJSON, Nullable: true},
+		{Name: "join_policy", Type: field.TypeEnum, Enums: []string{"INVITE_ONLY", "OFFER", "AUTO_JOIN"}, Default: "INVITE_ONLY"},
 		{Name: "organization_id", Type: field.TypeString, Unique: true, Nullable: true},
 	}
 	// OrganizationSettingsTable holds the schema information for the "organization_settings" table.
@@ -1555,7 +1580,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "organization_settings_organizations_setting",
-				Columns:    []*schema.Column{OrganizationSettingsColumns[16]},
+				Columns:    []*schema.Column{OrganizationSettingsColumns[23]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1583,6 +1608,13 @@ var (
 		{Name: "tax_identifier", Type: field.TypeString, Nullable: true},
 		{Name: "geo_location", Type: field.TypeEnum, Nullable: true, Enums: []string{"AMER", "EMEA", "APAC"}, Default: "AMER"},
 		{Name: "organization_id", Type: field.TypeString, Nullable: true},
+		{Name: "sso_enforced", Type: field.TypeBool, Default: false},
+		{Name: "saml_metadata_url", Type: field.TypeString, Nullable: true},
+		{Name: "saml_metadata", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "saml_attribute_mapping", Type: field.TypeJSON, Nullable: true},
+		{Name: "domain_verification_token", Type: field.TypeString, Nullable: true},
+		{Name: "verified_domains", Type: field.TypeJSON, Nullable: true},
+		{Name: "join_policy", Type: field.TypeEnum, Enums: []string{"INVITE_ONLY", "OFFER", "AUTO_JOIN"}, Default: "INVITE_ONLY"},
 	}
 	// OrganizationSettingHistoryTable holds the schema information for the "organization_setting_history" table.
 	OrganizationSettingHistoryTable = &schema.Table{
@@ -1597,6 +1629,50 @@ var (
 			},
 		},
 	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "mapping_id", Type: field.TypeString, Unique: true},
+		{Name: "topic", Type: field.TypeString},
+		{Name: "aggregate_type", Type: field.TypeString},
+		{Name: "aggregate_id", Type: field.TypeString},
+		{Name: "organization_id", Type: field.TypeString, Nullable: true},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "available_at", Type: field.TypeTime},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "published_to", Type: field.TypeJSON, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "claim_id", Type: field.TypeString, Nullable: true},
+		{Name: "claimed_until", Type: field.TypeTime, Nullable: true},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_published_at_available_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[13], OutboxEventsColumns[12]},
+			},
+			{
+				Name:    "outboxevent_aggregate_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[8]},
+			},
+			{
+				Name:    "outboxevent_claim_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[16]},
+			},
+		},
+	}
 	// PasswordResetTokensColumns holds the columns for the "password_reset_tokens" table.
 	PasswordResetTokensColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -1739,6 +1815,8 @@ var (
 		{Name: "phone_otp_allowed", Type: field.TypeBool, Nullable: true, Default: false},
 		{Name: "email_otp_allowed", Type: field.TypeBool, Nullable: true, Default: false},
 		{Name: "totp_allowed", Type: field.TypeBool, Nullable: true, Default: false},
+		{Name: "challenge_id", Type: field.TypeString, Nullable: true},
+		{Name: "challenge_attempts", Type: field.TypeInt, Default: 0},
 		{Name: "owner_id", Type: field.TypeString, Nullable: true},
 	}
 	// TfaSettingsTable holds the schema information for the "tfa_settings" table.
@@ -1749,7 +1827,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tfa_settings_users_tfa_settings",
-				Columns:    []*schema.Column{TfaSettingsColumns[15]},
+				Columns:    []*schema.Column{TfaSettingsColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1758,7 +1836,7 @@ var (
 			{
 				Name:    "tfasetting_owner_id",
 				Unique:  true,
-				Columns: []*schema.Column{TfaSettingsColumns[15]},
+				Columns: []*schema.Column{TfaSettingsColumns[17]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "deleted_at is NULL",
 				},
@@ -1781,7 +1859,10 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "jsonconfig", Type: field.TypeJSON},
 		{Name: "uischema", Type: field.TypeJSON, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "source_template_version", Type: field.TypeInt, Nullable: true},
 		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "source_template_id", Type: field.TypeString, Nullable: true},
 	}
 	// TemplatesTable holds the schema information for the "templates" table.
 	TemplatesTable = &schema.Table{
@@ -1791,16 +1872,22 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "templates_organizations_templates",
-				Columns:    []*schema.Column{TemplatesColumns[14]},
+				Columns:    []*schema.Column{TemplatesColumns[16]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "templates_templates_clones",
+				Columns:    []*schema.Column{TemplatesColumns[17]},
+				RefColumns: []*schema.Column{TemplatesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "template_name_owner_id_template_type",
 				Unique:  true,
-				Columns: []*schema.Column{TemplatesColumns[9], TemplatesColumns[14], TemplatesColumns[10]},
+				Columns: []*schema.Column{TemplatesColumns[9], TemplatesColumns[16], TemplatesColumns[10]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "deleted_at is NULL",
 				},
@@ -1827,6 +1914,9 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "jsonconfig", Type: field.TypeJSON},
 		{Name: "uischema", Type: field.TypeJSON, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "source_template_id", Type: field.TypeString, Nullable: true},
+		{Name: "source_template_version", Type: field.TypeInt, Nullable: true},
 	}
 	// TemplateHistoryTable holds the schema information for the "template_history" table.
 	TemplateHistoryTable = &schema.Table{
@@ -1841,6 +1931,52 @@ var (
 			},
 		},
 	}
+	// TemplateVersionsColumns holds the columns for the "template_versions" table.
+	TemplateVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "mapping_id", Type: field.TypeString, Unique: true},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "jsonconfig", Type: field.TypeJSON},
+		{Name: "uischema", Type: field.TypeJSON, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "template_id", Type: field.TypeString},
+	}
+	// TemplateVersionsTable holds the schema information for the "template_versions" table.
+	TemplateVersionsTable = &schema.Table{
+		Name:       "template_versions",
+		Columns:    TemplateVersionsColumns,
+		PrimaryKey: []*schema.Column{TemplateVersionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "template_versions_organizations_template_versions",
+				Columns:    []*schema.Column{TemplateVersionsColumns[11]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "template_versions_templates_versions",
+				Columns:    []*schema.Column{TemplateVersionsColumns[12]},
+				RefColumns: []*schema.Column{TemplatesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "templateversion_template_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{TemplateVersionsColumns[12], TemplateVersionsColumns[8]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at is NULL",
+				},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -1862,7 +1998,7 @@ var (
 		{Name: "last_seen", Type: field.TypeTime, Nullable: true},
 		{Name: "password", Type: field.TypeString, Nullable: true},
 		{Name: "sub", Type: field.TypeString, Unique: true, Nullable: true},
-		{Name: "auth_provider", Type: field.TypeEnum, Enums: []string{"CREDENTIALS", "GOOGLE", "GITHUB", "WEBAUTHN"}, Default: "CREDENTIALS"},
+		{Name: "auth_provider", Type: field.TypeEnum, Enums: []string{"CREDENTIALS", "GOOGLE", "GITHUB", "WEBAUTHN", "OIDC", "SAML"}, Default: "CREDENTIALS"},
 		{Name: "role", Type: field.TypeEnum, Nullable: true, Enums: []string{"ADMIN", "MEMBER", "USER"}, Default: "USER"},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -1910,7 +2046,7 @@ var (
 		{Name: "last_seen", Type: field.TypeTime, Nullable: true},
 		{Name: "password", Type: field.TypeString, Nullable: true},
 		{Name: "sub", Type: field.TypeString, Nullable: true},
-		{Name: "auth_provider", Type: field.TypeEnum, Enums: []string{"CREDENTIALS", "GOOGLE", "GITHUB", "WEBAUTHN"}, Default: "CREDENTIALS"},
+		{Name: "auth_provider", Type: field.TypeEnum, Enums: []string{"CREDENTIALS", "GOOGLE", "GITHUB", "WEBAUTHN", "OIDC", "SAML"}, Default: "CREDENTIALS"},
 		{Name: "role", Type: field.TypeEnum, Nullable: true, Enums: []string{"ADMIN", "MEMBER", "USER"}, Default: "USER"},
 	}
 	// UserHistoryTable holds the schema information for the "user_history" table.
@@ -1938,6 +2074,8 @@ var (
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "locked", Type: field.TypeBool, Default: false},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "failed_login_attempts", Type: field.TypeInt, Default: 0},
 		{Name: "silenced_at", Type: field.TypeTime, Nullable: true},
 		{Name: "suspended_at", Type: field.TypeTime, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"ACTIVE", "INACTIVE", "DEACTIVATED", "SUSPENDED", "ONBOARDING"}, Default: "ACTIVE"},
@@ -1956,13 +2094,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_settings_users_setting",
-				Columns:    []*schema.Column{UserSettingsColumns[17]},
+				Columns:    []*schema.Column{UserSettingsColumns[19]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "user_settings_organizations_default_org",
-				Columns:    []*schema.Column{UserSettingsColumns[18]},
+				Columns:    []*schema.Column{UserSettingsColumns[20]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -1984,6 +2122,8 @@ var (
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "user_id", Type: field.TypeString, Nullable: true},
 		{Name: "locked", Type: field.TypeBool, Default: false},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "failed_login_attempts", Type: field.TypeInt, Default: 0},
 		{Name: "silenced_at", Type: field.TypeTime, Nullable: true},
 		{Name: "suspended_at", Type: field.TypeTime, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"ACTIVE", "INACTIVE", "DEACTIVATED", "SUSPENDED", "ONBOARDING"}, Default: "ACTIVE"},
@@ -2055,6 +2195,8 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "destination_url", Type: field.TypeString},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "object_types", Type: field.TypeJSON, Nullable: true},
 		{Name: "callback", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "secret", Type: field.TypeBytes, Nullable: true},
@@ -2071,7 +2213,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "webhooks_organizations_webhooks",
-				Columns:    []*schema.Column{WebhooksColumns[19]},
+				Columns:    []*schema.Column{WebhooksColumns[21]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -2080,13 +2222,65 @@ var (
 			{
 				Name:    "webhook_name_owner_id",
 				Unique:  true,
-				Columns: []*schema.Column{WebhooksColumns[9], WebhooksColumns[19]},
+				Columns: []*schema.Column{WebhooksColumns[9], WebhooksColumns[21]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "deleted_at is NULL",
 				},
 			},
 		},
 	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "mapping_id", Type: field.TypeString, Unique: true},
+		{Name: "attempt", Type: field.TypeInt},
+		{Name: "redelivery", Type: field.TypeBool, Default: false},
+		{Name: "request_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "response_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "latency", Type: field.TypeInt64, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "webhook_id", Type: field.TypeString},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_events_webhook_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[13]},
+				RefColumns: []*schema.Column{EventsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "webhook_deliveries_organizations_webhook_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[14]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[15]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id_event_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[15], WebhookDeliveriesColumns[13]},
+			},
+		},
+	}
 	// WebhookHistoryColumns holds the columns for the "webhook_history" table.
 	WebhookHistoryColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -2106,6 +2300,8 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "destination_url", Type: field.TypeString},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "object_types", Type: field.TypeJSON, Nullable: true},
 		{Name: "callback", Type: field.TypeString, Nullable: true},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "secret", Type: field.TypeBytes, Nullable: true},
@@ -2822,18 +3018,21 @@ var (
 		OrganizationHistoryTable,
 		OrganizationSettingsTable,
 		OrganizationSettingHistoryTable,
+		OutboxEventsTable,
 		PasswordResetTokensTable,
 		PersonalAccessTokensTable,
 		SubscribersTable,
 		TfaSettingsTable,
 		TemplatesTable,
 		TemplateHistoryTable,
+		TemplateVersionsTable,
 		UsersTable,
 		UserHistoryTable,
 		UserSettingsTable,
 		UserSettingHistoryTable,
 		WebauthnsTable,
 		WebhooksTable,
+		WebhookDeliveriesTable,
 		WebhookHistoryTable,
 		EntitlementEventsTable,
 		EntitlementPlanEventsTable,
@@ -2962,9 +3161,12 @@ func init() {
 	SubscribersTable.ForeignKeys[0].RefTable = OrganizationsTable
 	TfaSettingsTable.ForeignKeys[0].RefTable = UsersTable
 	TemplatesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	TemplatesTable.ForeignKeys[1].RefTable = TemplatesTable
 	TemplateHistoryTable.Annotation = &entsql.Annotation{
 		Table: "template_history",
 	}
+	TemplateVersionsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	TemplateVersionsTable.ForeignKeys[1].RefTable = TemplatesTable
 	UserHistoryTable.Annotation = &entsql.Annotation{
 		Table: "user_history",
 	}
@@ -2975,6 +3177,9 @@ func init() {
 	}
 	WebauthnsTable.ForeignKeys[0].RefTable = UsersTable
 	WebhooksTable.ForeignKeys[0].RefTable = OrganizationsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = EventsTable
+	WebhookDeliveriesTable.ForeignKeys[1].RefTable = OrganizationsTable
+	WebhookDeliveriesTable.ForeignKeys[2].RefTable = WebhooksTable
 	WebhookHistoryTable.Annotation = &entsql.Annotation{
 		Table: "webhook_history",
 	}
@@ -56,6 +56,8 @@ type OauthProvider struct {
 	AuthStyle customtypes.Uint8 `json:"auth_style,omitempty"`
 	// the URL to request user information by token
 	InfoURL string `json:"info_url,omitempty"`
+	// the OIDC issuer URL, when set the provider endpoints are discovered and ID tokens are verified
+	Issuer string `json:"issuer,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OauthProviderQuery when eager-loading is set.
 	Edges        OauthProviderEdges `json:"edges"`
@@ -93,7 +95,7 @@ func (*OauthProvider) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case oauthprovider.FieldAuthStyle:
 			values[i] = new(sql.NullInt64)
-		case oauthprovider.FieldID, oauthprovider.FieldCreatedBy, oauthprovider.FieldUpdatedBy, oauthprovider.FieldMappingID, oauthprovider.FieldDeletedBy, oauthprovider.FieldOwnerID, oauthprovider.FieldName, oauthprovider.FieldClientID, oauthprovider.FieldClientSecret, oauthprovider.FieldRedirectURL, oauthprovider.FieldScopes, oauthprovider.FieldAuthURL, oauthprovider.FieldTokenURL, oauthprovider.FieldInfoURL:
+		case oauthprovider.FieldID, oauthprovider.FieldCreatedBy, oauthprovider.FieldUpdatedBy, oauthprovider.FieldMappingID, oauthprovider.FieldDeletedBy, oauthprovider.FieldOwnerID, oauthprovider.FieldName, oauthprovider.FieldClientID, oauthprovider.FieldClientSecret, oauthprovider.FieldRedirectURL, oauthprovider.FieldScopes, oauthprovider.FieldAuthURL, oauthprovider.FieldTokenURL, oauthprovider.FieldInfoURL, oauthprovider.FieldIssuer:
 			values[i] = new(sql.NullString)
 		case oauthprovider.FieldCreatedAt, oauthprovider.FieldUpdatedAt, oauthprovider.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -228,6 +230,12 @@ func (op *OauthProvider) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				op.InfoURL = value.String
 			}
+		case oauthprovider.FieldIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer", values[i])
+			} else if value.Valid {
+				op.Issuer = value.String
+			}
 		default:
 			op.selectValues.Set(columns[i], values[i])
 		}
@@ -322,6 +330,9 @@ func (op *OauthProvider) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("info_url=")
 	builder.WriteString(op.InfoURL)
+	builder.WriteString(", ")
+	builder.WriteString("issuer=")
+	builder.WriteString(op.Issuer)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -51,6 +51,8 @@ const (
 	FieldAuthStyle = "auth_style"
 	// FieldInfoURL holds the string denoting the info_url field in the database.
 	FieldInfoURL = "info_url"
+	// FieldIssuer holds the string denoting the issuer field in the database.
+	FieldIssuer = "issuer"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// Table holds the table name of the oauthprovider in the database.
@@ -85,6 +87,7 @@ var Columns = []string{
 	FieldTokenURL,
 	FieldAuthStyle,
 	FieldInfoURL,
+	FieldIssuer,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -215,6 +218,11 @@ func ByInfoURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInfoURL, opts...).ToFunc()
 }
 
+// ByIssuer orders the results by the issuer field.
+func ByIssuer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuer, opts...).ToFunc()
+}
+
 // ByOwnerField orders the results by owner field.
 func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
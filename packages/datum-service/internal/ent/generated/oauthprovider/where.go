@@ -154,6 +154,11 @@ func InfoURL(v string) predicate.OauthProvider {
 	return predicate.OauthProvider(sql.FieldEQ(FieldInfoURL, v))
 }
 
+// Issuer applies equality check predicate on the "issuer" field. It's identical to IssuerEQ.
+func Issuer(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEQ(FieldIssuer, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.OauthProvider {
 	return predicate.OauthProvider(sql.FieldEQ(FieldCreatedAt, v))
@@ -1253,6 +1258,81 @@ func InfoURLContainsFold(v string) predicate.OauthProvider {
 	return predicate.OauthProvider(sql.FieldContainsFold(FieldInfoURL, v))
 }
 
+// IssuerEQ applies the EQ predicate on the "issuer" field.
+func IssuerEQ(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEQ(FieldIssuer, v))
+}
+
+// IssuerNEQ applies the NEQ predicate on the "issuer" field.
+func IssuerNEQ(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNEQ(FieldIssuer, v))
+}
+
+// IssuerIn applies the In predicate on the "issuer" field.
+func IssuerIn(vs ...string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldIn(FieldIssuer, vs...))
+}
+
+// IssuerNotIn applies the NotIn predicate on the "issuer" field.
+func IssuerNotIn(vs ...string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNotIn(FieldIssuer, vs...))
+}
+
+// IssuerGT applies the GT predicate on the "issuer" field.
+func IssuerGT(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldGT(FieldIssuer, v))
+}
+
+// IssuerGTE applies the GTE predicate on the "issuer" field.
+func IssuerGTE(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldGTE(FieldIssuer, v))
+}
+
+// IssuerLT applies the LT predicate on the "issuer" field.
+func IssuerLT(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldLT(FieldIssuer, v))
+}
+
+// IssuerLTE applies the LTE predicate on the "issuer" field.
+func IssuerLTE(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldLTE(FieldIssuer, v))
+}
+
+// IssuerContains applies the Contains predicate on the "issuer" field.
+func IssuerContains(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldContains(FieldIssuer, v))
+}
+
+// IssuerHasPrefix applies the HasPrefix predicate on the "issuer" field.
+func IssuerHasPrefix(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldHasPrefix(FieldIssuer, v))
+}
+
+// IssuerHasSuffix applies the HasSuffix predicate on the "issuer" field.
+func IssuerHasSuffix(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldHasSuffix(FieldIssuer, v))
+}
+
+// IssuerIsNil applies the IsNil predicate on the "issuer" field.
+func IssuerIsNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldIsNull(FieldIssuer))
+}
+
+// IssuerNotNil applies the NotNil predicate on the "issuer" field.
+func IssuerNotNil() predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldNotNull(FieldIssuer))
+}
+
+// IssuerEqualFold applies the EqualFold predicate on the "issuer" field.
+func IssuerEqualFold(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldEqualFold(FieldIssuer, v))
+}
+
+// IssuerContainsFold applies the ContainsFold predicate on the "issuer" field.
+func IssuerContainsFold(v string) predicate.OauthProvider {
+	return predicate.OauthProvider(sql.FieldContainsFold(FieldIssuer, v))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.OauthProvider {
 	return predicate.OauthProvider(func(s *sql.Selector) {
@@ -194,6 +194,20 @@ func (opc *OauthProviderCreate) SetInfoURL(s string) *OauthProviderCreate {
 	return opc
 }
 
+// SetIssuer sets the "issuer" field.
+func (opc *OauthProviderCreate) SetIssuer(s string) *OauthProviderCreate {
+	opc.mutation.SetIssuer(s)
+	return opc
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (opc *OauthProviderCreate) SetNillableIssuer(s *string) *OauthProviderCreate {
+	if s != nil {
+		opc.SetIssuer(*s)
+	}
+	return opc
+}
+
 // SetID sets the "id" field.
 func (opc *OauthProviderCreate) SetID(s string) *OauthProviderCreate {
 	opc.mutation.SetID(s)
@@ -426,6 +440,10 @@ func (opc *OauthProviderCreate) createSpec() (*OauthProvider, *sqlgraph.CreateSp
 		_spec.SetField(oauthprovider.FieldInfoURL, field.TypeString, value)
 		_node.InfoURL = value
 	}
+	if value, ok := opc.mutation.Issuer(); ok {
+		_spec.SetField(oauthprovider.FieldIssuer, field.TypeString, value)
+		_node.Issuer = value
+	}
 	if nodes := opc.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -276,6 +276,26 @@ func (opu *OauthProviderUpdate) SetNillableInfoURL(s *string) *OauthProviderUpda
 	return opu
 }
 
+// SetIssuer sets the "issuer" field.
+func (opu *OauthProviderUpdate) SetIssuer(s string) *OauthProviderUpdate {
+	opu.mutation.SetIssuer(s)
+	return opu
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (opu *OauthProviderUpdate) SetNillableIssuer(s *string) *OauthProviderUpdate {
+	if s != nil {
+		opu.SetIssuer(*s)
+	}
+	return opu
+}
+
+// ClearIssuer clears the value of the "issuer" field.
+func (opu *OauthProviderUpdate) ClearIssuer() *OauthProviderUpdate {
+	opu.mutation.ClearIssuer()
+	return opu
+}
+
 // SetOwner sets the "owner" edge to the Organization entity.
 func (opu *OauthProviderUpdate) SetOwner(o *Organization) *OauthProviderUpdate {
 	return opu.SetOwnerID(o.ID)
@@ -427,6 +447,12 @@ func (opu *OauthProviderUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := opu.mutation.InfoURL(); ok {
 		_spec.SetField(oauthprovider.FieldInfoURL, field.TypeString, value)
 	}
+	if value, ok := opu.mutation.Issuer(); ok {
+		_spec.SetField(oauthprovider.FieldIssuer, field.TypeString, value)
+	}
+	if opu.mutation.IssuerCleared() {
+		_spec.ClearField(oauthprovider.FieldIssuer, field.TypeString)
+	}
 	if opu.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -723,6 +749,26 @@ func (opuo *OauthProviderUpdateOne) SetNillableInfoURL(s *string) *OauthProvider
 	return opuo
 }
 
+// SetIssuer sets the "issuer" field.
+func (opuo *OauthProviderUpdateOne) SetIssuer(s string) *OauthProviderUpdateOne {
+	opuo.mutation.SetIssuer(s)
+	return opuo
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (opuo *OauthProviderUpdateOne) SetNillableIssuer(s *string) *OauthProviderUpdateOne {
+	if s != nil {
+		opuo.SetIssuer(*s)
+	}
+	return opuo
+}
+
+// ClearIssuer clears the value of the "issuer" field.
+func (opuo *OauthProviderUpdateOne) ClearIssuer() *OauthProviderUpdateOne {
+	opuo.mutation.ClearIssuer()
+	return opuo
+}
+
 // SetOwner sets the "owner" edge to the Organization entity.
 func (opuo *OauthProviderUpdateOne) SetOwner(o *Organization) *OauthProviderUpdateOne {
 	return opuo.SetOwnerID(o.ID)
@@ -904,6 +950,12 @@ func (opuo *OauthProviderUpdateOne) sqlSave(ctx context.Context) (_node *OauthPr
 	if value, ok := opuo.mutation.InfoURL(); ok {
 		_spec.SetField(oauthprovider.FieldInfoURL, field.TypeString, value)
 	}
+	if value, ok := opuo.mutation.Issuer(); ok {
+		_spec.SetField(oauthprovider.FieldIssuer, field.TypeString, value)
+	}
+	if opuo.mutation.IssuerCleared() {
+		_spec.ClearField(oauthprovider.FieldIssuer, field.TypeString)
+	}
 	if opuo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -61,7 +61,9 @@ type OauthProviderHistory struct {
 	// the auth style, 0: auto detect 1: third party log in 2: log in with username and password
 	AuthStyle customtypes.Uint8 `json:"auth_style,omitempty"`
 	// the URL to request user information by token
-	InfoURL      string `json:"info_url,omitempty"`
+	InfoURL string `json:"info_url,omitempty"`
+	// the OIDC issuer URL, when set the provider endpoints are discovered and ID tokens are verified
+	Issuer       string `json:"issuer,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -76,7 +78,7 @@ func (*OauthProviderHistory) scanValues(columns []string) ([]any, error) {
 			values[i] = new(enthistory.OpType)
 		case oauthproviderhistory.FieldAuthStyle:
 			values[i] = new(sql.NullInt64)
-		case oauthproviderhistory.FieldID, oauthproviderhistory.FieldRef, oauthproviderhistory.FieldCreatedBy, oauthproviderhistory.FieldUpdatedBy, oauthproviderhistory.FieldMappingID, oauthproviderhistory.FieldDeletedBy, oauthproviderhistory.FieldOwnerID, oauthproviderhistory.FieldName, oauthproviderhistory.FieldClientID, oauthproviderhistory.FieldClientSecret, oauthproviderhistory.FieldRedirectURL, oauthproviderhistory.FieldScopes, oauthproviderhistory.FieldAuthURL, oauthproviderhistory.FieldTokenURL, oauthproviderhistory.FieldInfoURL:
+		case oauthproviderhistory.FieldID, oauthproviderhistory.FieldRef, oauthproviderhistory.FieldCreatedBy, oauthproviderhistory.FieldUpdatedBy, oauthproviderhistory.FieldMappingID, oauthproviderhistory.FieldDeletedBy, oauthproviderhistory.FieldOwnerID, oauthproviderhistory.FieldName, oauthproviderhistory.FieldClientID, oauthproviderhistory.FieldClientSecret, oauthproviderhistory.FieldRedirectURL, oauthproviderhistory.FieldScopes, oauthproviderhistory.FieldAuthURL, oauthproviderhistory.FieldTokenURL, oauthproviderhistory.FieldInfoURL, oauthproviderhistory.FieldIssuer:
 			values[i] = new(sql.NullString)
 		case oauthproviderhistory.FieldHistoryTime, oauthproviderhistory.FieldCreatedAt, oauthproviderhistory.FieldUpdatedAt, oauthproviderhistory.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -229,6 +231,12 @@ func (oph *OauthProviderHistory) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				oph.InfoURL = value.String
 			}
+		case oauthproviderhistory.FieldIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer", values[i])
+			} else if value.Valid {
+				oph.Issuer = value.String
+			}
 		default:
 			oph.selectValues.Set(columns[i], values[i])
 		}
@@ -327,6 +335,9 @@ func (oph *OauthProviderHistory) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("info_url=")
 	builder.WriteString(oph.InfoURL)
+	builder.WriteString(", ")
+	builder.WriteString("issuer=")
+	builder.WriteString(oph.Issuer)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -59,6 +59,8 @@ const (
 	FieldAuthStyle = "auth_style"
 	// FieldInfoURL holds the string denoting the info_url field in the database.
 	FieldInfoURL = "info_url"
+	// FieldIssuer holds the string denoting the issuer field in the database.
+	FieldIssuer = "issuer"
 	// Table holds the table name of the oauthproviderhistory in the database.
 	Table = "oauth_provider_history"
 )
@@ -87,6 +89,7 @@ var Columns = []string{
 	FieldTokenURL,
 	FieldAuthStyle,
 	FieldInfoURL,
+	FieldIssuer,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -242,6 +245,11 @@ func ByInfoURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInfoURL, opts...).ToFunc()
 }
 
+// ByIssuer orders the results by the issuer field.
+func ByIssuer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuer, opts...).ToFunc()
+}
+
 var (
 	// enthistory.OpType must implement graphql.Marshaler.
 	_ graphql.Marshaler = (*enthistory.OpType)(nil)
@@ -162,6 +162,11 @@ func InfoURL(v string) predicate.OauthProviderHistory {
 	return predicate.OauthProviderHistory(sql.FieldEQ(FieldInfoURL, v))
 }
 
+// Issuer applies equality check predicate on the "issuer" field. It's identical to IssuerEQ.
+func Issuer(v string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldEQ(FieldIssuer, v))
+}
+
 // HistoryTimeEQ applies the EQ predicate on the "history_time" field.
 func HistoryTimeEQ(v time.Time) predicate.OauthProviderHistory {
 	return predicate.OauthProviderHistory(sql.FieldEQ(FieldHistoryTime, v))
@@ -1396,6 +1401,81 @@ func InfoURLContainsFold(v string) predicate.OauthProviderHistory {
 	return predicate.OauthProviderHistory(sql.FieldContainsFold(FieldInfoURL, v))
 }
 
+// IssuerEQ applies the EQ predicate on the "issuer" field.
+func IssuerEQ(v string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldEQ(FieldIssuer, v))
+}
+
+// IssuerNEQ applies the NEQ predicate on the "issuer" field.
+func IssuerNEQ(v string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldNEQ(FieldIssuer, v))
+}
+
+// IssuerIn applies the In predicate on the "issuer" field.
+func IssuerIn(vs ...string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldIn(FieldIssuer, vs...))
+}
+
+// IssuerNotIn applies the NotIn predicate on the "issuer" field.
+func IssuerNotIn(vs ...string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldNotIn(FieldIssuer, vs...))
+}
+
+// IssuerGT applies the GT predicate on the "issuer" field.
+func IssuerGT(v string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldGT(FieldIssuer, v))
+}
+
+// IssuerGTE applies the GTE predicate on the "issuer" field.
+func IssuerGTE(v string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldGTE(FieldIssuer, v))
+}
+
+// IssuerLT applies the LT predicate on the "issuer" field.
+func IssuerLT(v string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldLT(FieldIssuer, v))
+}
+
+// IssuerLTE applies the LTE predicate on the "issuer" field.
+func IssuerLTE(v string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldLTE(FieldIssuer, v))
+}
+
+// IssuerContains applies the Contains predicate on the "issuer" field.
+func IssuerContains(v string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldContains(FieldIssuer, v))
+}
+
+// IssuerHasPrefix applies the HasPrefix predicate on the "issuer" field.
+func IssuerHasPrefix(v string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldHasPrefix(FieldIssuer, v))
+}
+
+// IssuerHasSuffix applies the HasSuffix predicate on the "issuer" field.
+func IssuerHasSuffix(v string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldHasSuffix(FieldIssuer, v))
+}
+
+// IssuerIsNil applies the IsNil predicate on the "issuer" field.
+func IssuerIsNil() predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldIsNull(FieldIssuer))
+}
+
+// IssuerNotNil applies the NotNil predicate on the "issuer" field.
+func IssuerNotNil() predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldNotNull(FieldIssuer))
+}
+
+// IssuerEqualFold applies the EqualFold predicate on the "issuer" field.
+func IssuerEqualFold(v string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldEqualFold(FieldIssuer, v))
+}
+
+// IssuerContainsFold applies the ContainsFold predicate on the "issuer" field.
+func IssuerContainsFold(v string) predicate.OauthProviderHistory {
+	return predicate.OauthProviderHistory(sql.FieldContainsFold(FieldIssuer, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.OauthProviderHistory) predicate.OauthProviderHistory {
 	return predicate.OauthProviderHistory(sql.AndPredicates(predicates...))
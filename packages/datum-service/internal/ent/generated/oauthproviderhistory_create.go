@@ -228,6 +228,20 @@ func (ophc *OauthProviderHistoryCreate) SetInfoURL(s string) *OauthProviderHisto
 	return ophc
 }
 
+// SetIssuer sets the "issuer" field.
+func (ophc *OauthProviderHistoryCreate) SetIssuer(s string) *OauthProviderHistoryCreate {
+	ophc.mutation.SetIssuer(s)
+	return ophc
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (ophc *OauthProviderHistoryCreate) SetNillableIssuer(s *string) *OauthProviderHistoryCreate {
+	if s != nil {
+		ophc.SetIssuer(*s)
+	}
+	return ophc
+}
+
 // SetID sets the "id" field.
 func (ophc *OauthProviderHistoryCreate) SetID(s string) *OauthProviderHistoryCreate {
 	ophc.mutation.SetID(s)
@@ -484,6 +498,10 @@ func (ophc *OauthProviderHistoryCreate) createSpec() (*OauthProviderHistory, *sq
 		_spec.SetField(oauthproviderhistory.FieldInfoURL, field.TypeString, value)
 		_node.InfoURL = value
 	}
+	if value, ok := ophc.mutation.Issuer(); ok {
+		_spec.SetField(oauthproviderhistory.FieldIssuer, field.TypeString, value)
+		_node.Issuer = value
+	}
 	return _node, _spec
 }
 
@@ -275,6 +275,26 @@ func (ophu *OauthProviderHistoryUpdate) SetNillableInfoURL(s *string) *OauthProv
 	return ophu
 }
 
+// SetIssuer sets the "issuer" field.
+func (ophu *OauthProviderHistoryUpdate) SetIssuer(s string) *OauthProviderHistoryUpdate {
+	ophu.mutation.SetIssuer(s)
+	return ophu
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (ophu *OauthProviderHistoryUpdate) SetNillableIssuer(s *string) *OauthProviderHistoryUpdate {
+	if s != nil {
+		ophu.SetIssuer(*s)
+	}
+	return ophu
+}
+
+// ClearIssuer clears the value of the "issuer" field.
+func (ophu *OauthProviderHistoryUpdate) ClearIssuer() *OauthProviderHistoryUpdate {
+	ophu.mutation.ClearIssuer()
+	return ophu
+}
+
 // Mutation returns the OauthProviderHistoryMutation object of the builder.
 func (ophu *OauthProviderHistoryUpdate) Mutation() *OauthProviderHistoryMutation {
 	return ophu.mutation
@@ -411,6 +431,12 @@ func (ophu *OauthProviderHistoryUpdate) sqlSave(ctx context.Context) (n int, err
 	if value, ok := ophu.mutation.InfoURL(); ok {
 		_spec.SetField(oauthproviderhistory.FieldInfoURL, field.TypeString, value)
 	}
+	if value, ok := ophu.mutation.Issuer(); ok {
+		_spec.SetField(oauthproviderhistory.FieldIssuer, field.TypeString, value)
+	}
+	if ophu.mutation.IssuerCleared() {
+		_spec.ClearField(oauthproviderhistory.FieldIssuer, field.TypeString)
+	}
 	_spec.Node.Schema = ophu.schemaConfig.OauthProviderHistory
 	ctx = internal.NewSchemaConfigContext(ctx, ophu.schemaConfig)
 	if n, err = sqlgraph.UpdateNodes(ctx, ophu.driver, _spec); err != nil {
@@ -676,6 +702,26 @@ func (ophuo *OauthProviderHistoryUpdateOne) SetNillableInfoURL(s *string) *Oauth
 	return ophuo
 }
 
+// SetIssuer sets the "issuer" field.
+func (ophuo *OauthProviderHistoryUpdateOne) SetIssuer(s string) *OauthProviderHistoryUpdateOne {
+	ophuo.mutation.SetIssuer(s)
+	return ophuo
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (ophuo *OauthProviderHistoryUpdateOne) SetNillableIssuer(s *string) *OauthProviderHistoryUpdateOne {
+	if s != nil {
+		ophuo.SetIssuer(*s)
+	}
+	return ophuo
+}
+
+// ClearIssuer clears the value of the "issuer" field.
+func (ophuo *OauthProviderHistoryUpdateOne) ClearIssuer() *OauthProviderHistoryUpdateOne {
+	ophuo.mutation.ClearIssuer()
+	return ophuo
+}
+
 // Mutation returns the OauthProviderHistoryMutation object of the builder.
 func (ophuo *OauthProviderHistoryUpdateOne) Mutation() *OauthProviderHistoryMutation {
 	return ophuo.mutation
@@ -842,6 +888,12 @@ func (ophuo *OauthProviderHistoryUpdateOne) sqlSave(ctx context.Context) (_node
 	if value, ok := ophuo.mutation.InfoURL(); ok {
 		_spec.SetField(oauthproviderhistory.FieldInfoURL, field.TypeString, value)
 	}
+	if value, ok := ophuo.mutation.Issuer(); ok {
+		_spec.SetField(oauthproviderhistory.FieldIssuer, field.TypeString, value)
+	}
+	if ophuo.mutation.IssuerCleared() {
+		_spec.ClearField(oauthproviderhistory.FieldIssuer, field.TypeString)
+	}
 	_spec.Node.Schema = ophuo.schemaConfig.OauthProviderHistory
 	ctx = internal.NewSchemaConfigContext(ctx, ophuo.schemaConfig)
 	_node = &OauthProviderHistory{config: ophuo.config}
@@ -65,6 +65,8 @@ type OrganizationEdges struct {
 	Groups []*Group `json:"groups,omitempty"`
 	// Templates holds the value of the templates edge.
 	Templates []*Template `json:"templates,omitempty"`
+	// TemplateVersions holds the value of the template_versions edge.
+	TemplateVersions []*TemplateVersion `json:"template_versions,omitempty"`
 	// Integrations holds the value of the integrations edge.
 	Integrations []*Integration `json:"integrations,omitempty"`
 	// Setting holds the value of the setting edge.
@@ -89,6 +91,8 @@ type OrganizationEdges struct {
 	Subscribers []*Subscriber `json:"subscribers,omitempty"`
 	// Webhooks holds the value of the webhooks edge.
 	Webhooks []*Webhook `json:"webhooks,omitempty"`
+	// WebhookDeliveries holds the value of the webhook_deliveries edge.
+	WebhookDeliveries []*WebhookDelivery `json:"webhook_deliveries,omitempty"`
 	// Events holds the value of the events edge.
 	Events []*Event `json:"events,omitempty"`
 	// Secrets holds the value of the secrets edge.
@@ -113,13 +117,14 @@ type OrganizationEdges struct {
 	Members []*OrgMembership `json:"members,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [27]bool
+	loadedTypes [29]bool
 	// totalCount holds the count of the edges above.
 	totalCount [27]map[string]int
 
 	namedChildren                map[string][]*Organization
 	namedGroups                  map[string][]*Group
 	namedTemplates               map[string][]*Template
+	namedTemplateVersions        map[string][]*TemplateVersion
 	namedIntegrations            map[string][]*Integration
 	namedDocumentdata            map[string][]*DocumentData
 	namedEntitlements            map[string][]*Entitlement
@@ -131,6 +136,7 @@ type OrganizationEdges struct {
 	namedInvites                 map[string][]*Invite
 	namedSubscribers             map[string][]*Subscriber
 	namedWebhooks                map[string][]*Webhook
+	namedWebhookDeliveries       map[string][]*WebhookDelivery
 	namedEvents                  map[string][]*Event
 	namedSecrets                 map[string][]*Hush
 	namedFeatures                map[string][]*Feature
@@ -182,10 +188,19 @@ func (e OrganizationEdges) TemplatesOrErr() ([]*Template, error) {
 	return nil, &NotLoadedError{edge: "templates"}
 }
 
+// TemplateVersionsOrErr returns the TemplateVersions value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) TemplateVersionsOrErr() ([]*TemplateVersion, error) {
+	if e.loadedTypes[4] {
+		return e.TemplateVersions, nil
+	}
+	return nil, &NotLoadedError{edge: "template_versions"}
+}
+
 // IntegrationsOrErr returns the Integrations value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) IntegrationsOrErr() ([]*Integration, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.Integrations, nil
 	}
 	return nil, &NotLoadedError{edge: "integrations"}
@@ -196,7 +211,7 @@ func (e OrganizationEdges) IntegrationsOrErr() ([]*Integration, error) {
 func (e OrganizationEdges) SettingOrErr() (*OrganizationSetting, error) {
 	if e.Setting != nil {
 		return e.Setting, nil
-	} else if e.loadedTypes[5] {
+	} else if e.loadedTypes[6] {
 		return nil, &NotFoundError{label: organizationsetting.Label}
 	}
 	return nil, &NotLoadedError{edge: "setting"}
@@ -205,7 +220,7 @@ func (e OrganizationEdges) SettingOrErr() (*OrganizationSetting, error) {
 // DocumentdataOrErr returns the Documentdata value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) DocumentdataOrErr() ([]*DocumentData, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[7] {
 		return e.Documentdata, nil
 	}
 	return nil, &NotLoadedError{edge: "documentdata"}
@@ -214,7 +229,7 @@ func (e OrganizationEdges) DocumentdataOrErr() ([]*DocumentData, error) {
 // EntitlementsOrErr returns the Entitlements value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) EntitlementsOrErr() ([]*Entitlement, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[8] {
 		return e.Entitlements, nil
 	}
 	return nil, &NotLoadedError{edge: "entitlements"}
@@ -223,7 +238,7 @@ func (e OrganizationEdges) EntitlementsOrErr() ([]*Entitlement, error) {
 // OrganizationEntitlementOrErr returns the OrganizationEntitlement value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) OrganizationEntitlementOrErr() ([]*Entitlement, error) {
-	if e.loadedTypes[8] {
+	if e.loadedTypes[9] {
 		return e.OrganizationEntitlement, nil
 	}
 	return nil, &NotLoadedError{edge: "organization_entitlement"}
@@ -232,7 +247,7 @@ func (e OrganizationEdges) OrganizationEntitlementOrErr() ([]*Entitlement, error
 // PersonalAccessTokensOrErr returns the PersonalAccessTokens value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) PersonalAccessTokensOrErr() ([]*PersonalAccessToken, error) {
-	if e.loadedTypes[9] {
+	if e.loadedTypes[10] {
 		return e.PersonalAccessTokens, nil
 	}
 	return nil, &NotLoadedError{edge: "personal_access_tokens"}
@@ -241,7 +256,7 @@ func (e OrganizationEdges) PersonalAccessTokensOrErr() ([]*PersonalAccessToken,
 // APITokensOrErr returns the APITokens value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) APITokensOrErr() ([]*APIToken, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[11] {
 		return e.APITokens, nil
 	}
 	return nil, &NotLoadedError{edge: "api_tokens"}
@@ -250,7 +265,7 @@ func (e OrganizationEdges) APITokensOrErr() ([]*APIToken, error) {
 // OauthproviderOrErr returns the Oauthprovider value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) OauthproviderOrErr() ([]*OauthProvider, error) {
-	if e.loadedTypes[11] {
+	if e.loadedTypes[12] {
 		return e.Oauthprovider, nil
 	}
 	return nil, &NotLoadedError{edge: "oauthprovider"}
@@ -259,7 +274,7 @@ func (e OrganizationEdges) OauthproviderOrErr() ([]*OauthProvider, error) {
 // UsersOrErr returns the Users value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) UsersOrErr() ([]*User, error) {
-	if e.loadedTypes[12] {
+	if e.loadedTypes[13] {
 		return e.Users, nil
 	}
 	return nil, &NotLoadedError{edge: "users"}
@@ -268,7 +283,7 @@ func (e OrganizationEdges) UsersOrErr() ([]*User, error) {
 // InvitesOrErr returns the Invites value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) InvitesOrErr() ([]*Invite, error) {
-	if e.loadedTypes[13] {
+	if e.loadedTypes[14] {
 		return e.Invites, nil
 	}
 	return nil, &NotLoadedError{edge: "invites"}
@@ -277,7 +292,7 @@ func (e OrganizationEdges) InvitesOrErr() ([]*Invite, error) {
 // SubscribersOrErr returns the Subscribers value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) SubscribersOrErr() ([]*Subscriber, error) {
-	if e.loadedTypes[14] {
+	if e.loadedTypes[15] {
 		return e.Subscribers, nil
 	}
 	return nil, &NotLoadedError{edge: "subscribers"}
@@ -286,16 +301,25 @@ func (e OrganizationEdges) SubscribersOrErr() ([]*Subscriber, error) {
 // WebhooksOrErr returns the Webhooks value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) WebhooksOrErr() ([]*Webhook, error) {
-	if e.loadedTypes[15] {
+	if e.loadedTypes[16] {
 		return e.Webhooks, nil
 	}
 	return nil, &NotLoadedError{edge: "webhooks"}
 }
 
+// WebhookDeliveriesOrErr returns the WebhookDeliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) WebhookDeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[17] {
+		return e.WebhookDeliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook_deliveries"}
+}
+
 // EventsOrErr returns the Events value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) EventsOrErr() ([]*Event, error) {
-	if e.loadedTypes[16] {
+	if e.loadedTypes[18] {
 		return e.Events, nil
 	}
 	return nil, &NotLoadedError{edge: "events"}
@@ -304,7 +328,7 @@ func (e OrganizationEdges) EventsOrErr() ([]*Event, error) {
 // SecretsOrErr returns the Secrets value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) SecretsOrErr() ([]*Hush, error) {
-	if e.loadedTypes[17] {
+	if e.loadedTypes[19] {
 		return e.Secrets, nil
 	}
 	return nil, &NotLoadedError{edge: "secrets"}
@@ -313,7 +337,7 @@ func (e OrganizationEdges) SecretsOrErr() ([]*Hush, error) {
 // FeaturesOrErr returns the Features value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) FeaturesOrErr() ([]*Feature, error) {
-	if e.loadedTypes[18] {
+	if e.loadedTypes[20] {
 		return e.Features, nil
 	}
 	return nil, &NotLoadedError{edge: "features"}
@@ -322,7 +346,7 @@ func (e OrganizationEdges) FeaturesOrErr() ([]*Feature, error) {
 // FilesOrErr returns the Files value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) FilesOrErr() ([]*File, error) {
-	if e.loadedTypes[19] {
+	if e.loadedTypes[21] {
 		return e.Files, nil
 	}
 	return nil, &NotLoadedError{edge: "files"}
@@ -331,7 +355,7 @@ func (e OrganizationEdges) FilesOrErr() ([]*File, error) {
 // EntitlementplansOrErr returns the Entitlementplans value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) EntitlementplansOrErr() ([]*EntitlementPlan, error) {
-	if e.loadedTypes[20] {
+	if e.loadedTypes[22] {
 		return e.Entitlementplans, nil
 	}
 	return nil, &NotLoadedError{edge: "entitlementplans"}
@@ -340,7 +364,7 @@ func (e OrganizationEdges) EntitlementplansOrErr() ([]*EntitlementPlan, error) {
 // EntitlementplanfeaturesOrErr returns the Entitlementplanfeatures value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) EntitlementplanfeaturesOrErr() ([]*EntitlementPlanFeature, error) {
-	if e.loadedTypes[21] {
+	if e.loadedTypes[23] {
 		return e.Entitlementplanfeatures, nil
 	}
 	return nil, &NotLoadedError{edge: "entitlementplanfeatures"}
@@ -349,7 +373,7 @@ func (e OrganizationEdges) EntitlementplanfeaturesOrErr() ([]*EntitlementPlanFea
 // EntitiesOrErr returns the Entities value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) EntitiesOrErr() ([]*Entity, error) {
-	if e.loadedTypes[22] {
+	if e.loadedTypes[24] {
 		return e.Entities, nil
 	}
 	return nil, &NotLoadedError{edge: "entities"}
@@ -358,7 +382,7 @@ func (e OrganizationEdges) EntitiesOrErr() ([]*Entity, error) {
 // EntitytypesOrErr returns the Entitytypes value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) EntitytypesOrErr() ([]*EntityType, error) {
-	if e.loadedTypes[23] {
+	if e.loadedTypes[25] {
 		return e.Entitytypes, nil
 	}
 	return nil, &NotLoadedError{edge: "entitytypes"}
@@ -367,7 +391,7 @@ func (e OrganizationEdges) EntitytypesOrErr() ([]*EntityType, error) {
 // ContactsOrErr returns the Contacts value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) ContactsOrErr() ([]*Contact, error) {
-	if e.loadedTypes[24] {
+	if e.loadedTypes[26] {
 		return e.Contacts, nil
 	}
 	return nil, &NotLoadedError{edge: "contacts"}
@@ -376,7 +400,7 @@ func (e OrganizationEdges) ContactsOrErr() ([]*Contact, error) {
 // NotesOrErr returns the Notes value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) NotesOrErr() ([]*Note, error) {
-	if e.loadedTypes[25] {
+	if e.loadedTypes[27] {
 		return e.Notes, nil
 	}
 	return nil, &NotLoadedError{edge: "notes"}
@@ -385,7 +409,7 @@ func (e OrganizationEdges) NotesOrErr() ([]*Note, error) {
 // MembersOrErr returns the Members value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) MembersOrErr() ([]*OrgMembership, error) {
-	if e.loadedTypes[26] {
+	if e.loadedTypes[28] {
 		return e.Members, nil
 	}
 	return nil, &NotLoadedError{edge: "members"}
@@ -551,6 +575,11 @@ func (o *Organization) QueryTemplates() *TemplateQuery {
 	return NewOrganizationClient(o.config).QueryTemplates(o)
 }
 
+// QueryTemplateVersions queries the "template_versions" edge of the Organization entity.
+func (o *Organization) QueryTemplateVersions() *TemplateVersionQuery {
+	return NewOrganizationClient(o.config).QueryTemplateVersions(o)
+}
+
 // QueryIntegrations queries the "integrations" edge of the Organization entity.
 func (o *Organization) QueryIntegrations() *IntegrationQuery {
 	return NewOrganizationClient(o.config).QueryIntegrations(o)
@@ -611,6 +640,11 @@ func (o *Organization) QueryWebhooks() *WebhookQuery {
 	return NewOrganizationClient(o.config).QueryWebhooks(o)
 }
 
+// QueryWebhookDeliveries queries the "webhook_deliveries" edge of the Organization entity.
+func (o *Organization) QueryWebhookDeliveries() *WebhookDeliveryQuery {
+	return NewOrganizationClient(o.config).QueryWebhookDeliveries(o)
+}
+
 // QueryEvents queries the "events" edge of the Organization entity.
 func (o *Organization) QueryEvents() *EventQuery {
 	return NewOrganizationClient(o.config).QueryEvents(o)
@@ -811,6 +845,30 @@ func (o *Organization) appendNamedTemplates(name string, edges ...*Template) {
 	}
 }
 
+// NamedTemplateVersions returns the TemplateVersions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedTemplateVersions(name string) ([]*TemplateVersion, error) {
+	if o.Edges.namedTemplateVersions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedTemplateVersions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedTemplateVersions(name string, edges ...*TemplateVersion) {
+	if o.Edges.namedTemplateVersions == nil {
+		o.Edges.namedTemplateVersions = make(map[string][]*TemplateVersion)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedTemplateVersions[name] = []*TemplateVersion{}
+	} else {
+		o.Edges.namedTemplateVersions[name] = append(o.Edges.namedTemplateVersions[name], edges...)
+	}
+}
+
 // NamedIntegrations returns the Integrations named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (o *Organization) NamedIntegrations(name string) ([]*Integration, error) {
@@ -1075,6 +1133,30 @@ func (o *Organization) appendNamedWebhooks(name string, edges ...*Webhook) {
 	}
 }
 
+// NamedWebhookDeliveries returns the WebhookDeliveries named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (o *Organization) NamedWebhookDeliveries(name string) ([]*WebhookDelivery, error) {
+	if o.Edges.namedWebhookDeliveries == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := o.Edges.namedWebhookDeliveries[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (o *Organization) appendNamedWebhookDeliveries(name string, edges ...*WebhookDelivery) {
+	if o.Edges.namedWebhookDeliveries == nil {
+		o.Edges.namedWebhookDeliveries = make(map[string][]*WebhookDelivery)
+	}
+	if len(edges) == 0 {
+		o.Edges.namedWebhookDeliveries[name] = []*WebhookDelivery{}
+	} else {
+		o.Edges.namedWebhookDeliveries[name] = append(o.Edges.namedWebhookDeliveries[name], edges...)
+	}
+}
+
 // NamedEvents returns the Events named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (o *Organization) NamedEvents(name string) ([]*Event, error) {
@@ -37,8 +37,10 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/predicate"
 	"github.com/datumforge/datum/internal/ent/generated/subscriber"
 	"github.com/datumforge/datum/internal/ent/generated/template"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 
 	"github.com/datumforge/datum/internal/ent/generated/internal"
 )
@@ -54,6 +56,7 @@ type OrganizationQuery struct {
 	withChildren                     *OrganizationQuery
 	withGroups                       *GroupQuery
 	withTemplates                    *TemplateQuery
+	withTemplateVersions             *TemplateVersionQuery
 	withIntegrations                 *IntegrationQuery
 	withSetting                      *OrganizationSettingQuery
 	withDocumentdata                 *DocumentDataQuery
@@ -66,6 +69,7 @@ type OrganizationQuery struct {
 	withInvites                      *InviteQuery
 	withSubscribers                  *SubscriberQuery
 	withWebhooks                     *WebhookQuery
+	withWebhookDeliveries            *WebhookDeliveryQuery
 	withEvents                       *EventQuery
 	withSecrets                      *HushQuery
 	withFeatures                     *FeatureQuery
@@ -82,6 +86,7 @@ type OrganizationQuery struct {
 	withNamedChildren                map[string]*OrganizationQuery
 	withNamedGroups                  map[string]*GroupQuery
 	withNamedTemplates               map[string]*TemplateQuery
+	withNamedTemplateVersions        map[string]*TemplateVersionQuery
 	withNamedIntegrations            map[string]*IntegrationQuery
 	withNamedDocumentdata            map[string]*DocumentDataQuery
 	withNamedEntitlements            map[string]*EntitlementQuery
@@ -93,6 +98,7 @@ type OrganizationQuery struct {
 	withNamedInvites                 map[string]*InviteQuery
 	withNamedSubscribers             map[string]*SubscriberQuery
 	withNamedWebhooks                map[string]*WebhookQuery
+	withNamedWebhookDeliveries       map[string]*WebhookDeliveryQuery
 	withNamedEvents                  map[string]*EventQuery
 	withNamedSecrets                 map[string]*HushQuery
 	withNamedFeatures                map[string]*FeatureQuery
@@ -240,6 +246,31 @@ func (oq *OrganizationQuery) QueryTemplates() *TemplateQuery {
 	return query
 }
 
+// QueryTemplateVersions chains the current query on the "template_versions" edge.
+func (oq *OrganizationQuery) QueryTemplateVersions() *TemplateVersionQuery {
+	query := (&TemplateVersionClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(templateversion.Table, templateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.TemplateVersionsTable, organization.TemplateVersionsColumn),
+		)
+		schemaConfig := oq.schemaConfig
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.TemplateVersion
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryIntegrations chains the current query on the "integrations" edge.
 func (oq *OrganizationQuery) QueryIntegrations() *IntegrationQuery {
 	query := (&IntegrationClient{config: oq.config}).Query()
@@ -540,6 +571,31 @@ func (oq *OrganizationQuery) QueryWebhooks() *WebhookQuery {
 	return query
 }
 
+// QueryWebhookDeliveries chains the current query on the "webhook_deliveries" edge.
+func (oq *OrganizationQuery) QueryWebhookDeliveries() *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.WebhookDeliveriesTable, organization.WebhookDeliveriesColumn),
+		)
+		schemaConfig := oq.schemaConfig
+		step.To.Schema = schemaConfig.WebhookDelivery
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryEvents chains the current query on the "events" edge.
 func (oq *OrganizationQuery) QueryEvents() *EventQuery {
 	query := (&EventClient{config: oq.config}).Query()
@@ -1011,6 +1067,7 @@ func (oq *OrganizationQuery) Clone() *OrganizationQuery {
 		withChildren:                oq.withChildren.Clone(),
 		withGroups:                  oq.withGroups.Clone(),
 		withTemplates:               oq.withTemplates.Clone(),
+		withTemplateVersions:        oq.withTemplateVersions.Clone(),
 		withIntegrations:            oq.withIntegrations.Clone(),
 		withSetting:                 oq.withSetting.Clone(),
 		withDocumentdata:            oq.withDocumentdata.Clone(),
@@ -1023,6 +1080,7 @@ func (oq *OrganizationQuery) Clone() *OrganizationQuery {
 		withInvites:                 oq.withInvites.Clone(),
 		withSubscribers:             oq.withSubscribers.Clone(),
 		withWebhooks:                oq.withWebhooks.Clone(),
+		withWebhookDeliveries:       oq.withWebhookDeliveries.Clone(),
 		withEvents:                  oq.withEvents.Clone(),
 		withSecrets:                 oq.withSecrets.Clone(),
 		withFeatures:                oq.withFeatures.Clone(),
@@ -1084,6 +1142,17 @@ func (oq *OrganizationQuery) WithTemplates(opts ...func(*TemplateQuery)) *Organi
 	return oq
 }
 
+// WithTemplateVersions tells the query-builder to eager-load the nodes that are connected to
+// the "template_versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithTemplateVersions(opts ...func(*TemplateVersionQuery)) *OrganizationQuery {
+	query := (&TemplateVersionClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withTemplateVersions = query
+	return oq
+}
+
 // WithIntegrations tells the query-builder to eager-load the nodes that are connected to
 // the "integrations" edge. The optional arguments are used to configure the query builder of the edge.
 func (oq *OrganizationQuery) WithIntegrations(opts ...func(*IntegrationQuery)) *OrganizationQuery {
@@ -1216,6 +1285,17 @@ func (oq *OrganizationQuery) WithWebhooks(opts ...func(*WebhookQuery)) *Organiza
 	return oq
 }
 
+// WithWebhookDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "webhook_deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithWebhookDeliveries(opts ...func(*WebhookDeliveryQuery)) *OrganizationQuery {
+	query := (&WebhookDeliveryClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withWebhookDeliveries = query
+	return oq
+}
+
 // WithEvents tells the query-builder to eager-load the nodes that are connected to
 // the "events" edge. The optional arguments are used to configure the query builder of the edge.
 func (oq *OrganizationQuery) WithEvents(opts ...func(*EventQuery)) *OrganizationQuery {
@@ -1421,11 +1501,12 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*Organization{}
 		_spec       = oq.querySpec()
-		loadedTypes = [27]bool{
+		loadedTypes = [29]bool{
 			oq.withParent != nil,
 			oq.withChildren != nil,
 			oq.withGroups != nil,
 			oq.withTemplates != nil,
+			oq.withTemplateVersions != nil,
 			oq.withIntegrations != nil,
 			oq.withSetting != nil,
 			oq.withDocumentdata != nil,
@@ -1438,6 +1519,7 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			oq.withInvites != nil,
 			oq.withSubscribers != nil,
 			oq.withWebhooks != nil,
+			oq.withWebhookDeliveries != nil,
 			oq.withEvents != nil,
 			oq.withSecrets != nil,
 			oq.withFeatures != nil,
@@ -1501,6 +1583,15 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := oq.withTemplateVersions; query != nil {
+		if err := oq.loadTemplateVersions(ctx, query, nodes,
+			func(n *Organization) { n.Edges.TemplateVersions = []*TemplateVersion{} },
+			func(n *Organization, e *TemplateVersion) {
+				n.Edges.TemplateVersions = append(n.Edges.TemplateVersions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := oq.withIntegrations; query != nil {
 		if err := oq.loadIntegrations(ctx, query, nodes,
 			func(n *Organization) { n.Edges.Integrations = []*Integration{} },
@@ -1588,6 +1679,15 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := oq.withWebhookDeliveries; query != nil {
+		if err := oq.loadWebhookDeliveries(ctx, query, nodes,
+			func(n *Organization) { n.Edges.WebhookDeliveries = []*WebhookDelivery{} },
+			func(n *Organization, e *WebhookDelivery) {
+				n.Edges.WebhookDeliveries = append(n.Edges.WebhookDeliveries, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := oq.withEvents; query != nil {
 		if err := oq.loadEvents(ctx, query, nodes,
 			func(n *Organization) { n.Edges.Events = []*Event{} },
@@ -1690,6 +1790,13 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	for name, query := range oq.withNamedTemplateVersions {
+		if err := oq.loadTemplateVersions(ctx, query, nodes,
+			func(n *Organization) { n.appendNamedTemplateVersions(name) },
+			func(n *Organization, e *TemplateVersion) { n.appendNamedTemplateVersions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range oq.withNamedIntegrations {
 		if err := oq.loadIntegrations(ctx, query, nodes,
 			func(n *Organization) { n.appendNamedIntegrations(name) },
@@ -1767,6 +1874,13 @@ func (oq *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	for name, query := range oq.withNamedWebhookDeliveries {
+		if err := oq.loadWebhookDeliveries(ctx, query, nodes,
+			func(n *Organization) { n.appendNamedWebhookDeliveries(name) },
+			func(n *Organization, e *WebhookDelivery) { n.appendNamedWebhookDeliveries(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range oq.withNamedEvents {
 		if err := oq.loadEvents(ctx, query, nodes,
 			func(n *Organization) { n.appendNamedEvents(name) },
@@ -1971,6 +2085,36 @@ func (oq *OrganizationQuery) loadTemplates(ctx context.Context, query *TemplateQ
 	}
 	return nil
 }
+func (oq *OrganizationQuery) loadTemplateVersions(ctx context.Context, query *TemplateVersionQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *TemplateVersion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(templateversion.FieldOwnerID)
+	}
+	query.Where(predicate.TemplateVersion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.TemplateVersionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (oq *OrganizationQuery) loadIntegrations(ctx context.Context, query *IntegrationQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *Integration)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*Organization)
@@ -2393,6 +2537,36 @@ func (oq *OrganizationQuery) loadWebhooks(ctx context.Context, query *WebhookQue
 	}
 	return nil
 }
+func (oq *OrganizationQuery) loadWebhookDeliveries(ctx context.Context, query *WebhookDeliveryQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *WebhookDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhookdelivery.FieldOwnerID)
+	}
+	query.Where(predicate.WebhookDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.WebhookDeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (oq *OrganizationQuery) loadEvents(ctx context.Context, query *EventQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *Event)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[string]*Organization)
@@ -2956,6 +3130,20 @@ func (oq *OrganizationQuery) WithNamedTemplates(name string, opts ...func(*Templ
 	return oq
 }
 
+// WithNamedTemplateVersions tells the query-builder to eager-load the nodes that are connected to the "template_versions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithNamedTemplateVersions(name string, opts ...func(*TemplateVersionQuery)) *OrganizationQuery {
+	query := (&TemplateVersionClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if oq.withNamedTemplateVersions == nil {
+		oq.withNamedTemplateVersions = make(map[string]*TemplateVersionQuery)
+	}
+	oq.withNamedTemplateVersions[name] = query
+	return oq
+}
+
 // WithNamedIntegrations tells the query-builder to eager-load the nodes that are connected to the "integrations"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (oq *OrganizationQuery) WithNamedIntegrations(name string, opts ...func(*IntegrationQuery)) *OrganizationQuery {
@@ -3110,6 +3298,20 @@ func (oq *OrganizationQuery) WithNamedWebhooks(name string, opts ...func(*Webhoo
 	return oq
 }
 
+// WithNamedWebhookDeliveries tells the query-builder to eager-load the nodes that are connected to the "webhook_deliveries"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrganizationQuery) WithNamedWebhookDeliveries(name string, opts ...func(*WebhookDeliveryQuery)) *OrganizationQuery {
+	query := (&WebhookDeliveryClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if oq.withNamedWebhookDeliveries == nil {
+		oq.withNamedWebhookDeliveries = make(map[string]*WebhookDeliveryQuery)
+	}
+	oq.withNamedWebhookDeliveries[name] = query
+	return oq
+}
+
 // WithNamedEvents tells the query-builder to eager-load the nodes that are connected to the "events"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (oq *OrganizationQuery) WithNamedEvents(name string, opts ...func(*EventQuery)) *OrganizationQuery {
@@ -36,8 +36,10 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/predicate"
 	"github.com/datumforge/datum/internal/ent/generated/subscriber"
 	"github.com/datumforge/datum/internal/ent/generated/template"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 
 	"github.com/datumforge/datum/internal/ent/generated/internal"
 )
@@ -272,6 +274,21 @@ func (ou *OrganizationUpdate) AddTemplates(t ...*Template) *OrganizationUpdate {
 	return ou.AddTemplateIDs(ids...)
 }
 
+// AddTemplateVersionIDs adds the "template_versions" edge to the TemplateVersion entity by IDs.
+func (ou *OrganizationUpdate) AddTemplateVersionIDs(ids ...string) *OrganizationUpdate {
+	ou.mutation.AddTemplateVersionIDs(ids...)
+	return ou
+}
+
+// AddTemplateVersions adds the "template_versions" edges to the TemplateVersion entity.
+func (ou *OrganizationUpdate) AddTemplateVersions(t ...*TemplateVersion) *OrganizationUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ou.AddTemplateVersionIDs(ids...)
+}
+
 // AddIntegrationIDs adds the "integrations" edge to the Integration entity by IDs.
 func (ou *OrganizationUpdate) AddIntegrationIDs(ids ...string) *OrganizationUpdate {
 	ou.mutation.AddIntegrationIDs(ids...)
@@ -456,6 +473,21 @@ func (ou *OrganizationUpdate) AddWebhooks(w ...*Webhook) *OrganizationUpdate {
 	return ou.AddWebhookIDs(ids...)
 }
 
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (ou *OrganizationUpdate) AddWebhookDeliveryIDs(ids ...string) *OrganizationUpdate {
+	ou.mutation.AddWebhookDeliveryIDs(ids...)
+	return ou
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (ou *OrganizationUpdate) AddWebhookDeliveries(w ...*WebhookDelivery) *OrganizationUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ou.AddWebhookDeliveryIDs(ids...)
+}
+
 // AddEventIDs adds the "events" edge to the Event entity by IDs.
 func (ou *OrganizationUpdate) AddEventIDs(ids ...string) *OrganizationUpdate {
 	ou.mutation.AddEventIDs(ids...)
@@ -689,6 +721,27 @@ func (ou *OrganizationUpdate) RemoveTemplates(t ...*Template) *OrganizationUpdat
 	return ou.RemoveTemplateIDs(ids...)
 }
 
+// ClearTemplateVersions clears all "template_versions" edges to the TemplateVersion entity.
+func (ou *OrganizationUpdate) ClearTemplateVersions() *OrganizationUpdate {
+	ou.mutation.ClearTemplateVersions()
+	return ou
+}
+
+// RemoveTemplateVersionIDs removes the "template_versions" edge to TemplateVersion entities by IDs.
+func (ou *OrganizationUpdate) RemoveTemplateVersionIDs(ids ...string) *OrganizationUpdate {
+	ou.mutation.RemoveTemplateVersionIDs(ids...)
+	return ou
+}
+
+// RemoveTemplateVersions removes "template_versions" edges to TemplateVersion entities.
+func (ou *OrganizationUpdate) RemoveTemplateVersions(t ...*TemplateVersion) *OrganizationUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ou.RemoveTemplateVersionIDs(ids...)
+}
+
 // ClearIntegrations clears all "integrations" edges to the Integration entity.
 func (ou *OrganizationUpdate) ClearIntegrations() *OrganizationUpdate {
 	ou.mutation.ClearIntegrations()
@@ -926,6 +979,27 @@ func (ou *OrganizationUpdate) RemoveWebhooks(w ...*Webhook) *OrganizationUpdate
 	return ou.RemoveWebhookIDs(ids...)
 }
 
+// ClearWebhookDeliveries clears all "webhook_deliveries" edges to the WebhookDelivery entity.
+func (ou *OrganizationUpdate) ClearWebhookDeliveries() *OrganizationUpdate {
+	ou.mutation.ClearWebhookDeliveries()
+	return ou
+}
+
+// RemoveWebhookDeliveryIDs removes the "webhook_deliveries" edge to WebhookDelivery entities by IDs.
+func (ou *OrganizationUpdate) RemoveWebhookDeliveryIDs(ids ...string) *OrganizationUpdate {
+	ou.mutation.RemoveWebhookDeliveryIDs(ids...)
+	return ou
+}
+
+// RemoveWebhookDeliveries removes "webhook_deliveries" edges to WebhookDelivery entities.
+func (ou *OrganizationUpdate) RemoveWebhookDeliveries(w ...*WebhookDelivery) *OrganizationUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ou.RemoveWebhookDeliveryIDs(ids...)
+}
+
 // ClearEvents clears all "events" edges to the Event entity.
 func (ou *OrganizationUpdate) ClearEvents() *OrganizationUpdate {
 	ou.mutation.ClearEvents()
@@ -1440,6 +1514,54 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ou.mutation.TemplateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TemplateVersionsTable,
+			Columns: []string{organization.TemplateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.TemplateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedTemplateVersionsIDs(); len(nodes) > 0 && !ou.mutation.TemplateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TemplateVersionsTable,
+			Columns: []string{organization.TemplateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.TemplateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TemplateVersionsTable,
+			Columns: []string{organization.TemplateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ou.mutation.IntegrationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -2020,6 +2142,54 @@ func (ou *OrganizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ou.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhookDeliveriesTable,
+			Columns: []string{organization.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedWebhookDeliveriesIDs(); len(nodes) > 0 && !ou.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhookDeliveriesTable,
+			Columns: []string{organization.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhookDeliveriesTable,
+			Columns: []string{organization.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ou.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ou.mutation.EventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -2787,6 +2957,21 @@ func (ouo *OrganizationUpdateOne) AddTemplates(t ...*Template) *OrganizationUpda
 	return ouo.AddTemplateIDs(ids...)
 }
 
+// AddTemplateVersionIDs adds the "template_versions" edge to the TemplateVersion entity by IDs.
+func (ouo *OrganizationUpdateOne) AddTemplateVersionIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.AddTemplateVersionIDs(ids...)
+	return ouo
+}
+
+// AddTemplateVersions adds the "template_versions" edges to the TemplateVersion entity.
+func (ouo *OrganizationUpdateOne) AddTemplateVersions(t ...*TemplateVersion) *OrganizationUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ouo.AddTemplateVersionIDs(ids...)
+}
+
 // AddIntegrationIDs adds the "integrations" edge to the Integration entity by IDs.
 func (ouo *OrganizationUpdateOne) AddIntegrationIDs(ids ...string) *OrganizationUpdateOne {
 	ouo.mutation.AddIntegrationIDs(ids...)
@@ -2971,6 +3156,21 @@ func (ouo *OrganizationUpdateOne) AddWebhooks(w ...*Webhook) *OrganizationUpdate
 	return ouo.AddWebhookIDs(ids...)
 }
 
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (ouo *OrganizationUpdateOne) AddWebhookDeliveryIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.AddWebhookDeliveryIDs(ids...)
+	return ouo
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (ouo *OrganizationUpdateOne) AddWebhookDeliveries(w ...*WebhookDelivery) *OrganizationUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ouo.AddWebhookDeliveryIDs(ids...)
+}
+
 // AddEventIDs adds the "events" edge to the Event entity by IDs.
 func (ouo *OrganizationUpdateOne) AddEventIDs(ids ...string) *OrganizationUpdateOne {
 	ouo.mutation.AddEventIDs(ids...)
@@ -3204,6 +3404,27 @@ func (ouo *OrganizationUpdateOne) RemoveTemplates(t ...*Template) *OrganizationU
 	return ouo.RemoveTemplateIDs(ids...)
 }
 
+// ClearTemplateVersions clears all "template_versions" edges to the TemplateVersion entity.
+func (ouo *OrganizationUpdateOne) ClearTemplateVersions() *OrganizationUpdateOne {
+	ouo.mutation.ClearTemplateVersions()
+	return ouo
+}
+
+// RemoveTemplateVersionIDs removes the "template_versions" edge to TemplateVersion entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveTemplateVersionIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.RemoveTemplateVersionIDs(ids...)
+	return ouo
+}
+
+// RemoveTemplateVersions removes "template_versions" edges to TemplateVersion entities.
+func (ouo *OrganizationUpdateOne) RemoveTemplateVersions(t ...*TemplateVersion) *OrganizationUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return ouo.RemoveTemplateVersionIDs(ids...)
+}
+
 // ClearIntegrations clears all "integrations" edges to the Integration entity.
 func (ouo *OrganizationUpdateOne) ClearIntegrations() *OrganizationUpdateOne {
 	ouo.mutation.ClearIntegrations()
@@ -3441,6 +3662,27 @@ func (ouo *OrganizationUpdateOne) RemoveWebhooks(w ...*Webhook) *OrganizationUpd
 	return ouo.RemoveWebhookIDs(ids...)
 }
 
+// ClearWebhookDeliveries clears all "webhook_deliveries" edges to the WebhookDelivery entity.
+func (ouo *OrganizationUpdateOne) ClearWebhookDeliveries() *OrganizationUpdateOne {
+	ouo.mutation.ClearWebhookDeliveries()
+	return ouo
+}
+
+// RemoveWebhookDeliveryIDs removes the "webhook_deliveries" edge to WebhookDelivery entities by IDs.
+func (ouo *OrganizationUpdateOne) RemoveWebhookDeliveryIDs(ids ...string) *OrganizationUpdateOne {
+	ouo.mutation.RemoveWebhookDeliveryIDs(ids...)
+	return ouo
+}
+
+// RemoveWebhookDeliveries removes "webhook_deliveries" edges to WebhookDelivery entities.
+func (ouo *OrganizationUpdateOne) RemoveWebhookDeliveries(w ...*WebhookDelivery) *OrganizationUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return ouo.RemoveWebhookDeliveryIDs(ids...)
+}
+
 // ClearEvents clears all "events" edges to the Event entity.
 func (ouo *OrganizationUpdateOne) ClearEvents() *OrganizationUpdateOne {
 	ouo.mutation.ClearEvents()
@@ -3985,6 +4227,54 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ouo.mutation.TemplateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TemplateVersionsTable,
+			Columns: []string{organization.TemplateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.TemplateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedTemplateVersionsIDs(); len(nodes) > 0 && !ouo.mutation.TemplateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TemplateVersionsTable,
+			Columns: []string{organization.TemplateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.TemplateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.TemplateVersionsTable,
+			Columns: []string{organization.TemplateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ouo.mutation.IntegrationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -4565,6 +4855,54 @@ func (ouo *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizat
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ouo.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhookDeliveriesTable,
+			Columns: []string{organization.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedWebhookDeliveriesIDs(); len(nodes) > 0 && !ouo.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhookDeliveriesTable,
+			Columns: []string{organization.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhookDeliveriesTable,
+			Columns: []string{organization.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ouo.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if ouo.mutation.EventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
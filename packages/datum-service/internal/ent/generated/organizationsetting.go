@@ -52,6 +52,20 @@ type OrganizationSetting struct {
 	GeoLocation enums.Region `json:"geo_location,omitempty"`
 	// the ID of the organization the settings belong to
 	OrganizationID string `json:"organization_id,omitempty"`
+	// members with an email address in one of the organization domains must login with the organization SSO provider
+	SSOEnforced bool `json:"sso_enforced,omitempty"`
+	// URL of the SAML metadata of the organization identity provider
+	SamlMetadataURL string `json:"saml_metadata_url,omitempty"`
+	// SAML metadata XML of the organization identity provider, used instead of the metadata URL when set
+	SamlMetadata string `json:"saml_metadata,omitempty"`
+	// mapping of the user fields (email, first_name, last_name, display_name) to the SAML assertion attribute names
+	SamlAttributeMapping map[string]interface{} `json:"saml_attribute_mapping,omitempty"`
+	// token published in a DNS TXT record or the well-known file of a domain to verify the organization owns the domain
+	DomainVerificationToken string `json:"domain_verification_token,omitempty"`
+	// domains of the organization which have been verified to be owned by the organization
+	VerifiedDomains []string `json:"verified_domains,omitempty"`
+	// the policy for users with an email address in a verified domain, whether they are added as members, offered to join, or must be invited
+	JoinPolicy enums.OrgJoinPolicy `json:"join_policy,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrganizationSettingQuery when eager-loading is set.
 	Edges        OrganizationSettingEdges `json:"edges"`
@@ -85,9 +99,11 @@ func (*OrganizationSetting) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case organizationsetting.FieldTags, organizationsetting.FieldDomains:
+		case organizationsetting.FieldTags, organizationsetting.FieldDomains, organizationsetting.FieldSamlAttributeMapping, organizationsetting.FieldVerifiedDomains:
 			values[i] = new([]byte)
-		case organizationsetting.FieldID, organizationsetting.FieldCreatedBy, organizationsetting.FieldUpdatedBy, organizationsetting.FieldMappingID, organizationsetting.FieldDeletedBy, organizationsetting.FieldBillingContact, organizationsetting.FieldBillingEmail, organizationsetting.FieldBillingPhone, organizationsetting.FieldBillingAddress, organizationsetting.FieldTaxIdentifier, organizationsetting.FieldGeoLocation, organizationsetting.FieldOrganizationID:
+		case organizationsetting.FieldSSOEnforced:
+			values[i] = new(sql.NullBool)
+		case organizationsetting.FieldID, organizationsetting.FieldCreatedBy, organizationsetting.FieldUpdatedBy, organizationsetting.FieldMappingID, organizationsetting.FieldDeletedBy, organizationsetting.FieldBillingContact, organizationsetting.FieldBillingEmail, organizationsetting.FieldBillingPhone, organizationsetting.FieldBillingAddress, organizationsetting.FieldTaxIdentifier, organizationsetting.FieldGeoLocation, organizationsetting.FieldOrganizationID, organizationsetting.FieldSamlMetadataURL, organizationsetting.FieldSamlMetadata, organizationsetting.FieldDomainVerificationToken, organizationsetting.FieldJoinPolicy:
 			values[i] = new(sql.NullString)
 		case organizationsetting.FieldCreatedAt, organizationsetting.FieldUpdatedAt, organizationsetting.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -212,6 +228,52 @@ func (os *OrganizationSetting) assignValues(columns []string, values []any) erro
 			} else if value.Valid {
 				os.OrganizationID = value.String
 			}
+		case organizationsetting.FieldSSOEnforced:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sso_enforced", values[i])
+			} else if value.Valid {
+				os.SSOEnforced = value.Bool
+			}
+		case organizationsetting.FieldSamlMetadataURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field saml_metadata_url", values[i])
+			} else if value.Valid {
+				os.SamlMetadataURL = value.String
+			}
+		case organizationsetting.FieldSamlMetadata:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field saml_metadata", values[i])
+			} else if value.Valid {
+				os.SamlMetadata = value.String
+			}
+		case organizationsetting.FieldSamlAttributeMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field saml_attribute_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &os.SamlAttributeMapping); err != nil {
+					return fmt.Errorf("unmarshal field saml_attribute_mapping: %w", err)
+				}
+			}
+		case organizationsetting.FieldDomainVerificationToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain_verification_token", values[i])
+			} else if value.Valid {
+				os.DomainVerificationToken = value.String
+			}
+		case organizationsetting.FieldVerifiedDomains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_domains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &os.VerifiedDomains); err != nil {
+					return fmt.Errorf("unmarshal field verified_domains: %w", err)
+				}
+			}
+		case organizationsetting.FieldJoinPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field join_policy", values[i])
+			} else if value.Valid {
+				os.JoinPolicy = enums.OrgJoinPolicy(value.String)
+			}
 		default:
 			os.selectValues.Set(columns[i], values[i])
 		}
@@ -300,6 +362,27 @@ func (os *OrganizationSetting) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("organization_id=")
 	builder.WriteString(os.OrganizationID)
+	builder.WriteString(", ")
+	builder.WriteString("sso_enforced=")
+	builder.WriteString(fmt.Sprintf("%v", os.SSOEnforced))
+	builder.WriteString(", ")
+	builder.WriteString("saml_metadata_url=")
+	builder.WriteString(os.SamlMetadataURL)
+	builder.WriteString(", ")
+	builder.WriteString("saml_metadata=")
+	builder.WriteString(os.SamlMetadata)
+	builder.WriteString(", ")
+	builder.WriteString("saml_attribute_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", os.SamlAttributeMapping))
+	builder.WriteString(", ")
+	builder.WriteString("domain_verification_token=")
+	builder.WriteString(os.DomainVerificationToken)
+	builder.WriteString(", ")
+	builder.WriteString("verified_domains=")
+	builder.WriteString(fmt.Sprintf("%v", os.VerifiedDomains))
+	builder.WriteString(", ")
+	builder.WriteString("join_policy=")
+	builder.WriteString(fmt.Sprintf("%v", os.JoinPolicy))
 	builder.WriteByte(')')
 	return builder.String()
 }
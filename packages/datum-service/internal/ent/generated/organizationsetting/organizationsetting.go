@@ -50,6 +50,20 @@ const (
 	FieldGeoLocation = "geo_location"
 	// FieldOrganizationID holds the string denoting the organization_id field in the database.
 	FieldOrganizationID = "organization_id"
+	// FieldSSOEnforced holds the string denoting the sso_enforced field in the database.
+	FieldSSOEnforced = "sso_enforced"
+	// FieldSamlMetadataURL holds the string denoting the saml_metadata_url field in the database.
+	FieldSamlMetadataURL = "saml_metadata_url"
+	// FieldSamlMetadata holds the string denoting the saml_metadata field in the database.
+	FieldSamlMetadata = "saml_metadata"
+	// FieldSamlAttributeMapping holds the string denoting the saml_attribute_mapping field in the database.
+	FieldSamlAttributeMapping = "saml_attribute_mapping"
+	// FieldDomainVerificationToken holds the string denoting the domain_verification_token field in the database.
+	FieldDomainVerificationToken = "domain_verification_token"
+	// FieldVerifiedDomains holds the string denoting the verified_domains field in the database.
+	FieldVerifiedDomains = "verified_domains"
+	// FieldJoinPolicy holds the string denoting the join_policy field in the database.
+	FieldJoinPolicy = "join_policy"
 	// EdgeOrganization holds the string denoting the organization edge name in mutations.
 	EdgeOrganization = "organization"
 	// Table holds the table name of the organizationsetting in the database.
@@ -82,6 +96,13 @@ var Columns = []string{
 	FieldTaxIdentifier,
 	FieldGeoLocation,
 	FieldOrganizationID,
+	FieldSSOEnforced,
+	FieldSamlMetadataURL,
+	FieldSamlMetadata,
+	FieldSamlAttributeMapping,
+	FieldDomainVerificationToken,
+	FieldVerifiedDomains,
+	FieldJoinPolicy,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -100,7 +121,7 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/datumforge/datum/internal/ent/generated/runtime"
 var (
-	Hooks        [3]ent.Hook
+	Hooks        [4]ent.Hook
 	Interceptors [2]ent.Interceptor
 	Policy       ent.Policy
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -119,6 +140,14 @@ var (
 	BillingEmailValidator func(string) error
 	// BillingPhoneValidator is a validator for the "billing_phone" field. It is called by the builders before save.
 	BillingPhoneValidator func(string) error
+	// DefaultSSOEnforced holds the default value on creation for the "sso_enforced" field.
+	DefaultSSOEnforced bool
+	// SamlMetadataURLValidator is a validator for the "saml_metadata_url" field. It is called by the builders before save.
+	SamlMetadataURLValidator func(string) error
+	// SamlMetadataValidator is a validator for the "saml_metadata" field. It is called by the builders before save.
+	SamlMetadataValidator func(string) error
+	// DefaultDomainVerificationToken holds the default value on creation for the "domain_verification_token" field.
+	DefaultDomainVerificationToken func() string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -135,6 +164,18 @@ func GeoLocationValidator(gl enums.Region) error {
 	}
 }
 
+const DefaultJoinPolicy enums.OrgJoinPolicy = "INVITE_ONLY"
+
+// JoinPolicyValidator is a validator for the "join_policy" field enum values. It is called by the builders before save.
+func JoinPolicyValidator(jp enums.OrgJoinPolicy) error {
+	switch jp.String() {
+	case "INVITE_ONLY", "OFFER", "AUTO_JOIN":
+		return nil
+	default:
+		return fmt.Errorf("organizationsetting: invalid enum value for join_policy field: %q", jp)
+	}
+}
+
 // OrderOption defines the ordering options for the OrganizationSetting queries.
 type OrderOption func(*sql.Selector)
 
@@ -213,6 +254,31 @@ func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
 }
 
+// BySSOEnforced orders the results by the sso_enforced field.
+func BySSOEnforced(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSSOEnforced, opts...).ToFunc()
+}
+
+// BySamlMetadataURL orders the results by the saml_metadata_url field.
+func BySamlMetadataURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSamlMetadataURL, opts...).ToFunc()
+}
+
+// BySamlMetadata orders the results by the saml_metadata field.
+func BySamlMetadata(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSamlMetadata, opts...).ToFunc()
+}
+
+// ByDomainVerificationToken orders the results by the domain_verification_token field.
+func ByDomainVerificationToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDomainVerificationToken, opts...).ToFunc()
+}
+
+// ByJoinPolicy orders the results by the join_policy field.
+func ByJoinPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJoinPolicy, opts...).ToFunc()
+}
+
 // ByOrganizationField orders the results by organization field.
 func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -233,3 +299,10 @@ var (
 	// enums.Region must implement graphql.Unmarshaler.
 	_ graphql.Unmarshaler = (*enums.Region)(nil)
 )
+
+var (
+	// enums.OrgJoinPolicy must implement graphql.Marshaler.
+	_ graphql.Marshaler = (*enums.OrgJoinPolicy)(nil)
+	// enums.OrgJoinPolicy must implement graphql.Unmarshaler.
+	_ graphql.Unmarshaler = (*enums.OrgJoinPolicy)(nil)
+)
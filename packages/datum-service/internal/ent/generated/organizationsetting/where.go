@@ -133,6 +133,26 @@ func OrganizationID(v string) predicate.OrganizationSetting {
 	return predicate.OrganizationSetting(sql.FieldEQ(FieldOrganizationID, v))
 }
 
+// SSOEnforced applies equality check predicate on the "sso_enforced" field. It's identical to SSOEnforcedEQ.
+func SSOEnforced(v bool) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldEQ(FieldSSOEnforced, v))
+}
+
+// SamlMetadataURL applies equality check predicate on the "saml_metadata_url" field. It's identical to SamlMetadataURLEQ.
+func SamlMetadataURL(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldEQ(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadata applies equality check predicate on the "saml_metadata" field. It's identical to SamlMetadataEQ.
+func SamlMetadata(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldEQ(FieldSamlMetadata, v))
+}
+
+// DomainVerificationToken applies equality check predicate on the "domain_verification_token" field. It's identical to DomainVerificationTokenEQ.
+func DomainVerificationToken(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldEQ(FieldDomainVerificationToken, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.OrganizationSetting {
 	return predicate.OrganizationSetting(sql.FieldEQ(FieldCreatedAt, v))
@@ -1083,6 +1103,291 @@ func OrganizationIDContainsFold(v string) predicate.OrganizationSetting {
 	return predicate.OrganizationSetting(sql.FieldContainsFold(FieldOrganizationID, v))
 }
 
+// SSOEnforcedEQ applies the EQ predicate on the "sso_enforced" field.
+func SSOEnforcedEQ(v bool) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldEQ(FieldSSOEnforced, v))
+}
+
+// SSOEnforcedNEQ applies the NEQ predicate on the "sso_enforced" field.
+func SSOEnforcedNEQ(v bool) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldNEQ(FieldSSOEnforced, v))
+}
+
+// SamlMetadataURLEQ applies the EQ predicate on the "saml_metadata_url" field.
+func SamlMetadataURLEQ(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldEQ(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLNEQ applies the NEQ predicate on the "saml_metadata_url" field.
+func SamlMetadataURLNEQ(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldNEQ(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLIn applies the In predicate on the "saml_metadata_url" field.
+func SamlMetadataURLIn(vs ...string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldIn(FieldSamlMetadataURL, vs...))
+}
+
+// SamlMetadataURLNotIn applies the NotIn predicate on the "saml_metadata_url" field.
+func SamlMetadataURLNotIn(vs ...string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldNotIn(FieldSamlMetadataURL, vs...))
+}
+
+// SamlMetadataURLGT applies the GT predicate on the "saml_metadata_url" field.
+func SamlMetadataURLGT(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldGT(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLGTE applies the GTE predicate on the "saml_metadata_url" field.
+func SamlMetadataURLGTE(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldGTE(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLLT applies the LT predicate on the "saml_metadata_url" field.
+func SamlMetadataURLLT(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldLT(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLLTE applies the LTE predicate on the "saml_metadata_url" field.
+func SamlMetadataURLLTE(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldLTE(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLContains applies the Contains predicate on the "saml_metadata_url" field.
+func SamlMetadataURLContains(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldContains(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLHasPrefix applies the HasPrefix predicate on the "saml_metadata_url" field.
+func SamlMetadataURLHasPrefix(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldHasPrefix(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLHasSuffix applies the HasSuffix predicate on the "saml_metadata_url" field.
+func SamlMetadataURLHasSuffix(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldHasSuffix(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLIsNil applies the IsNil predicate on the "saml_metadata_url" field.
+func SamlMetadataURLIsNil() predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldIsNull(FieldSamlMetadataURL))
+}
+
+// SamlMetadataURLNotNil applies the NotNil predicate on the "saml_metadata_url" field.
+func SamlMetadataURLNotNil() predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldNotNull(FieldSamlMetadataURL))
+}
+
+// SamlMetadataURLEqualFold applies the EqualFold predicate on the "saml_metadata_url" field.
+func SamlMetadataURLEqualFold(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldEqualFold(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLContainsFold applies the ContainsFold predicate on the "saml_metadata_url" field.
+func SamlMetadataURLContainsFold(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldContainsFold(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataEQ applies the EQ predicate on the "saml_metadata" field.
+func SamlMetadataEQ(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldEQ(FieldSamlMetadata, v))
+}
+
+// SamlMetadataNEQ applies the NEQ predicate on the "saml_metadata" field.
+func SamlMetadataNEQ(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldNEQ(FieldSamlMetadata, v))
+}
+
+// SamlMetadataIn applies the In predicate on the "saml_metadata" field.
+func SamlMetadataIn(vs ...string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldIn(FieldSamlMetadata, vs...))
+}
+
+// SamlMetadataNotIn applies the NotIn predicate on the "saml_metadata" field.
+func SamlMetadataNotIn(vs ...string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldNotIn(FieldSamlMetadata, vs...))
+}
+
+// SamlMetadataGT applies the GT predicate on the "saml_metadata" field.
+func SamlMetadataGT(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldGT(FieldSamlMetadata, v))
+}
+
+// SamlMetadataGTE applies the GTE predicate on the "saml_metadata" field.
+func SamlMetadataGTE(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldGTE(FieldSamlMetadata, v))
+}
+
+// SamlMetadataLT applies the LT predicate on the "saml_metadata" field.
+func SamlMetadataLT(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldLT(FieldSamlMetadata, v))
+}
+
+// SamlMetadataLTE applies the LTE predicate on the "saml_metadata" field.
+func SamlMetadataLTE(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldLTE(FieldSamlMetadata, v))
+}
+
+// SamlMetadataContains applies the Contains predicate on the "saml_metadata" field.
+func SamlMetadataContains(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldContains(FieldSamlMetadata, v))
+}
+
+// SamlMetadataHasPrefix applies the HasPrefix predicate on the "saml_metadata" field.
+func SamlMetadataHasPrefix(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldHasPrefix(FieldSamlMetadata, v))
+}
+
+// SamlMetadataHasSuffix applies the HasSuffix predicate on the "saml_metadata" field.
+func SamlMetadataHasSuffix(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldHasSuffix(FieldSamlMetadata, v))
+}
+
+// SamlMetadataIsNil applies the IsNil predicate on the "saml_metadata" field.
+func SamlMetadataIsNil() predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldIsNull(FieldSamlMetadata))
+}
+
+// SamlMetadataNotNil applies the NotNil predicate on the "saml_metadata" field.
+func SamlMetadataNotNil() predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldNotNull(FieldSamlMetadata))
+}
+
+// SamlMetadataEqualFold applies the EqualFold predicate on the "saml_metadata" field.
+func SamlMetadataEqualFold(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldEqualFold(FieldSamlMetadata, v))
+}
+
+// SamlMetadataContainsFold applies the ContainsFold predicate on the "saml_metadata" field.
+func SamlMetadataContainsFold(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldContainsFold(FieldSamlMetadata, v))
+}
+
+// SamlAttributeMappingIsNil applies the IsNil predicate on the "saml_attribute_mapping" field.
+func SamlAttributeMappingIsNil() predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldIsNull(FieldSamlAttributeMapping))
+}
+
+// SamlAttributeMappingNotNil applies the NotNil predicate on the "saml_attribute_mapping" field.
+func SamlAttributeMappingNotNil() predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldNotNull(FieldSamlAttributeMapping))
+}
+
+// DomainVerificationTokenEQ applies the EQ predicate on the "domain_verification_token" field.
+func DomainVerificationTokenEQ(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldEQ(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenNEQ applies the NEQ predicate on the "domain_verification_token" field.
+func DomainVerificationTokenNEQ(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldNEQ(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenIn applies the In predicate on the "domain_verification_token" field.
+func DomainVerificationTokenIn(vs ...string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldIn(FieldDomainVerificationToken, vs...))
+}
+
+// DomainVerificationTokenNotIn applies the NotIn predicate on the "domain_verification_token" field.
+func DomainVerificationTokenNotIn(vs ...string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldNotIn(FieldDomainVerificationToken, vs...))
+}
+
+// DomainVerificationTokenGT applies the GT predicate on the "domain_verification_token" field.
+func DomainVerificationTokenGT(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldGT(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenGTE applies the GTE predicate on the "domain_verification_token" field.
+func DomainVerificationTokenGTE(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldGTE(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenLT applies the LT predicate on the "domain_verification_token" field.
+func DomainVerificationTokenLT(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldLT(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenLTE applies the LTE predicate on the "domain_verification_token" field.
+func DomainVerificationTokenLTE(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldLTE(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenContains applies the Contains predicate on the "domain_verification_token" field.
+func DomainVerificationTokenContains(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldContains(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenHasPrefix applies the HasPrefix predicate on the "domain_verification_token" field.
+func DomainVerificationTokenHasPrefix(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldHasPrefix(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenHasSuffix applies the HasSuffix predicate on the "domain_verification_token" field.
+func DomainVerificationTokenHasSuffix(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldHasSuffix(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenIsNil applies the IsNil predicate on the "domain_verification_token" field.
+func DomainVerificationTokenIsNil() predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldIsNull(FieldDomainVerificationToken))
+}
+
+// DomainVerificationTokenNotNil applies the NotNil predicate on the "domain_verification_token" field.
+func DomainVerificationTokenNotNil() predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldNotNull(FieldDomainVerificationToken))
+}
+
+// DomainVerificationTokenEqualFold applies the EqualFold predicate on the "domain_verification_token" field.
+func DomainVerificationTokenEqualFold(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldEqualFold(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenContainsFold applies the ContainsFold predicate on the "domain_verification_token" field.
+func DomainVerificationTokenContainsFold(v string) predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldContainsFold(FieldDomainVerificationToken, v))
+}
+
+// VerifiedDomainsIsNil applies the IsNil predicate on the "verified_domains" field.
+func VerifiedDomainsIsNil() predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldIsNull(FieldVerifiedDomains))
+}
+
+// VerifiedDomainsNotNil applies the NotNil predicate on the "verified_domains" field.
+func VerifiedDomainsNotNil() predicate.OrganizationSetting {
+	return predicate.OrganizationSetting(sql.FieldNotNull(FieldVerifiedDomains))
+}
+
+// JoinPolicyEQ applies the EQ predicate on the "join_policy" field.
+func JoinPolicyEQ(v enums.OrgJoinPolicy) predicate.OrganizationSetting {
+	vc := v
+	return predicate.OrganizationSetting(sql.FieldEQ(FieldJoinPolicy, vc))
+}
+
+// JoinPolicyNEQ applies the NEQ predicate on the "join_policy" field.
+func JoinPolicyNEQ(v enums.OrgJoinPolicy) predicate.OrganizationSetting {
+	vc := v
+	return predicate.OrganizationSetting(sql.FieldNEQ(FieldJoinPolicy, vc))
+}
+
+// JoinPolicyIn applies the In predicate on the "join_policy" field.
+func JoinPolicyIn(vs ...enums.OrgJoinPolicy) predicate.OrganizationSetting {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OrganizationSetting(sql.FieldIn(FieldJoinPolicy, v...))
+}
+
+// JoinPolicyNotIn applies the NotIn predicate on the "join_policy" field.
+func JoinPolicyNotIn(vs ...enums.OrgJoinPolicy) predicate.OrganizationSetting {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OrganizationSetting(sql.FieldNotIn(FieldJoinPolicy, v...))
+}
+
 // HasOrganization applies the HasEdge predicate on the "organization" edge.
 func HasOrganization() predicate.OrganizationSetting {
 	return predicate.OrganizationSetting(func(s *sql.Selector) {
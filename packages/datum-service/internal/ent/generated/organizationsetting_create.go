@@ -230,6 +230,88 @@ func (osc *OrganizationSettingCreate) SetNillableOrganizationID(s *string) *Orga
 	return osc
 }
 
+// SetSSOEnforced sets the "sso_enforced" field.
+func (osc *OrganizationSettingCreate) SetSSOEnforced(b bool) *OrganizationSettingCreate {
+	osc.mutation.SetSSOEnforced(b)
+	return osc
+}
+
+// SetNillableSSOEnforced sets the "sso_enforced" field if the given value is not nil.
+func (osc *OrganizationSettingCreate) SetNillableSSOEnforced(b *bool) *OrganizationSettingCreate {
+	if b != nil {
+		osc.SetSSOEnforced(*b)
+	}
+	return osc
+}
+
+// SetSamlMetadataURL sets the "saml_metadata_url" field.
+func (osc *OrganizationSettingCreate) SetSamlMetadataURL(s string) *OrganizationSettingCreate {
+	osc.mutation.SetSamlMetadataURL(s)
+	return osc
+}
+
+// SetNillableSamlMetadataURL sets the "saml_metadata_url" field if the given value is not nil.
+func (osc *OrganizationSettingCreate) SetNillableSamlMetadataURL(s *string) *OrganizationSettingCreate {
+	if s != nil {
+		osc.SetSamlMetadataURL(*s)
+	}
+	return osc
+}
+
+// SetSamlMetadata sets the "saml_metadata" field.
+func (osc *OrganizationSettingCreate) SetSamlMetadata(s string) *OrganizationSettingCreate {
+	osc.mutation.SetSamlMetadata(s)
+	return osc
+}
+
+// SetNillableSamlMetadata sets the "saml_metadata" field if the given value is not nil.
+func (osc *OrganizationSettingCreate) SetNillableSamlMetadata(s *string) *OrganizationSettingCreate {
+	if s != nil {
+		osc.SetSamlMetadata(*s)
+	}
+	return osc
+}
+
+// SetSamlAttributeMapping sets the "saml_attribute_mapping" field.
+func (osc *OrganizationSettingCreate) SetSamlAttributeMapping(m map[string]interface{}) *OrganizationSettingCreate {
+	osc.mutation.SetSamlAttributeMapping(m)
+	return osc
+}
+
+// SetDomainVerificationToken sets the "domain_verification_token" field.
+func (osc *OrganizationSettingCreate) SetDomainVerificationToken(s string) *OrganizationSettingCreate {
+	osc.mutation.SetDomainVerificationToken(s)
+	return osc
+}
+
+// SetNillableDomainVerificationToken sets the "domain_verification_token" field if the given value is not nil.
+func (osc *OrganizationSettingCreate) SetNillableDomainVerificationToken(s *string) *OrganizationSettingCreate {
+	if s != nil {
+		osc.SetDomainVerificationToken(*s)
+	}
+	return osc
+}
+
+// SetVerifiedDomains sets the "verified_domains" field.
+func (osc *OrganizationSettingCreate) SetVerifiedDomains(s []string) *OrganizationSettingCreate {
+	osc.mutation.SetVerifiedDomains(s)
+	return osc
+}
+
+// SetJoinPolicy sets the "join_policy" field.
+func (osc *OrganizationSettingCreate) SetJoinPolicy(ejp enums.OrgJoinPolicy) *OrganizationSettingCreate {
+	osc.mutation.SetJoinPolicy(ejp)
+	return osc
+}
+
+// SetNillableJoinPolicy sets the "join_policy" field if the given value is not nil.
+func (osc *OrganizationSettingCreate) SetNillableJoinPolicy(ejp *enums.OrgJoinPolicy) *OrganizationSettingCreate {
+	if ejp != nil {
+		osc.SetJoinPolicy(*ejp)
+	}
+	return osc
+}
+
 // SetID sets the "id" field.
 func (osc *OrganizationSettingCreate) SetID(s string) *OrganizationSettingCreate {
 	osc.mutation.SetID(s)
@@ -315,6 +397,21 @@ func (osc *OrganizationSettingCreate) defaults() error {
 		v := organizationsetting.DefaultGeoLocation
 		osc.mutation.SetGeoLocation(v)
 	}
+	if _, ok := osc.mutation.SSOEnforced(); !ok {
+		v := organizationsetting.DefaultSSOEnforced
+		osc.mutation.SetSSOEnforced(v)
+	}
+	if _, ok := osc.mutation.DomainVerificationToken(); !ok {
+		if organizationsetting.DefaultDomainVerificationToken == nil {
+			return fmt.Errorf("generated: uninitialized organizationsetting.DefaultDomainVerificationToken (forgotten import generated/runtime?)")
+		}
+		v := organizationsetting.DefaultDomainVerificationToken()
+		osc.mutation.SetDomainVerificationToken(v)
+	}
+	if _, ok := osc.mutation.JoinPolicy(); !ok {
+		v := organizationsetting.DefaultJoinPolicy
+		osc.mutation.SetJoinPolicy(v)
+	}
 	if _, ok := osc.mutation.ID(); !ok {
 		if organizationsetting.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized organizationsetting.DefaultID (forgotten import generated/runtime?)")
@@ -350,6 +447,27 @@ func (osc *OrganizationSettingCreate) check() error {
 			return &ValidationError{Name: "geo_location", err: fmt.Errorf(`generated: validator failed for field "OrganizationSetting.geo_location": %w`, err)}
 		}
 	}
+	if _, ok := osc.mutation.SSOEnforced(); !ok {
+		return &ValidationError{Name: "sso_enforced", err: errors.New(`generated: missing required field "OrganizationSetting.sso_enforced"`)}
+	}
+	if v, ok := osc.mutation.SamlMetadataURL(); ok {
+		if err := organizationsetting.SamlMetadataURLValidator(v); err != nil {
+			return &ValidationError{Name: "saml_metadata_url", err: fmt.Errorf(`generated: validator failed for field "OrganizationSetting.saml_metadata_url": %w`, err)}
+		}
+	}
+	if v, ok := osc.mutation.SamlMetadata(); ok {
+		if err := organizationsetting.SamlMetadataValidator(v); err != nil {
+			return &ValidationError{Name: "saml_metadata", err: fmt.Errorf(`generated: validator failed for field "OrganizationSetting.saml_metadata": %w`, err)}
+		}
+	}
+	if _, ok := osc.mutation.JoinPolicy(); !ok {
+		return &ValidationError{Name: "join_policy", err: errors.New(`generated: missing required field "OrganizationSetting.join_policy"`)}
+	}
+	if v, ok := osc.mutation.JoinPolicy(); ok {
+		if err := organizationsetting.JoinPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "join_policy", err: fmt.Errorf(`generated: validator failed for field "OrganizationSetting.join_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -446,6 +564,34 @@ func (osc *OrganizationSettingCreate) createSpec() (*OrganizationSetting, *sqlgr
 		_spec.SetField(organizationsetting.FieldGeoLocation, field.TypeEnum, value)
 		_node.GeoLocation = value
 	}
+	if value, ok := osc.mutation.SSOEnforced(); ok {
+		_spec.SetField(organizationsetting.FieldSSOEnforced, field.TypeBool, value)
+		_node.SSOEnforced = value
+	}
+	if value, ok := osc.mutation.SamlMetadataURL(); ok {
+		_spec.SetField(organizationsetting.FieldSamlMetadataURL, field.TypeString, value)
+		_node.SamlMetadataURL = value
+	}
+	if value, ok := osc.mutation.SamlMetadata(); ok {
+		_spec.SetField(organizationsetting.FieldSamlMetadata, field.TypeString, value)
+		_node.SamlMetadata = value
+	}
+	if value, ok := osc.mutation.SamlAttributeMapping(); ok {
+		_spec.SetField(organizationsetting.FieldSamlAttributeMapping, field.TypeJSON, value)
+		_node.SamlAttributeMapping = value
+	}
+	if value, ok := osc.mutation.DomainVerificationToken(); ok {
+		_spec.SetField(organizationsetting.FieldDomainVerificationToken, field.TypeString, value)
+		_node.DomainVerificationToken = value
+	}
+	if value, ok := osc.mutation.VerifiedDomains(); ok {
+		_spec.SetField(organizationsetting.FieldVerifiedDomains, field.TypeJSON, value)
+		_node.VerifiedDomains = value
+	}
+	if value, ok := osc.mutation.JoinPolicy(); ok {
+		_spec.SetField(organizationsetting.FieldJoinPolicy, field.TypeEnum, value)
+		_node.JoinPolicy = value
+	}
 	if nodes := osc.mutation.OrganizationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -281,6 +281,124 @@ func (osu *OrganizationSettingUpdate) ClearOrganizationID() *OrganizationSetting
 	return osu
 }
 
+// SetSSOEnforced sets the "sso_enforced" field.
+func (osu *OrganizationSettingUpdate) SetSSOEnforced(b bool) *OrganizationSettingUpdate {
+	osu.mutation.SetSSOEnforced(b)
+	return osu
+}
+
+// SetNillableSSOEnforced sets the "sso_enforced" field if the given value is not nil.
+func (osu *OrganizationSettingUpdate) SetNillableSSOEnforced(b *bool) *OrganizationSettingUpdate {
+	if b != nil {
+		osu.SetSSOEnforced(*b)
+	}
+	return osu
+}
+
+// SetSamlMetadataURL sets the "saml_metadata_url" field.
+func (osu *OrganizationSettingUpdate) SetSamlMetadataURL(s string) *OrganizationSettingUpdate {
+	osu.mutation.SetSamlMetadataURL(s)
+	return osu
+}
+
+// SetNillableSamlMetadataURL sets the "saml_metadata_url" field if the given value is not nil.
+func (osu *OrganizationSettingUpdate) SetNillableSamlMetadataURL(s *string) *OrganizationSettingUpdate {
+	if s != nil {
+		osu.SetSamlMetadataURL(*s)
+	}
+	return osu
+}
+
+// ClearSamlMetadataURL clears the value of the "saml_metadata_url" field.
+func (osu *OrganizationSettingUpdate) ClearSamlMetadataURL() *OrganizationSettingUpdate {
+	osu.mutation.ClearSamlMetadataURL()
+	return osu
+}
+
+// SetSamlMetadata sets the "saml_metadata" field.
+func (osu *OrganizationSettingUpdate) SetSamlMetadata(s string) *OrganizationSettingUpdate {
+	osu.mutation.SetSamlMetadata(s)
+	return osu
+}
+
+// SetNillableSamlMetadata sets the "saml_metadata" field if the given value is not nil.
+func (osu *OrganizationSettingUpdate) SetNillableSamlMetadata(s *string) *OrganizationSettingUpdate {
+	if s != nil {
+		osu.SetSamlMetadata(*s)
+	}
+	return osu
+}
+
+// ClearSamlMetadata clears the value of the "saml_metadata" field.
+func (osu *OrganizationSettingUpdate) ClearSamlMetadata() *OrganizationSettingUpdate {
+	osu.mutation.ClearSamlMetadata()
+	return osu
+}
+
+// SetSamlAttributeMapping sets the "saml_attribute_mapping" field.
+func (osu *OrganizationSettingUpdate) SetSamlAttributeMapping(m map[string]interface{}) *OrganizationSettingUpdate {
+	osu.mutation.SetSamlAttributeMapping(m)
+	return osu
+}
+
+// ClearSamlAttributeMapping clears the value of the "saml_attribute_mapping" field.
+func (osu *OrganizationSettingUpdate) ClearSamlAttributeMapping() *OrganizationSettingUpdate {
+	osu.mutation.ClearSamlAttributeMapping()
+	return osu
+}
+
+// SetDomainVerificationToken sets the "domain_verification_token" field.
+func (osu *OrganizationSettingUpdate) SetDomainVerificationToken(s string) *OrganizationSettingUpdate {
+	osu.mutation.SetDomainVerificationToken(s)
+	return osu
+}
+
+// SetNillableDomainVerificationToken sets the "domain_verification_token" field if the given value is not nil.
+func (osu *OrganizationSettingUpdate) SetNillableDomainVerificationToken(s *string) *OrganizationSettingUpdate {
+	if s != nil {
+		osu.SetDomainVerificationToken(*s)
+	}
+	return osu
+}
+
+// ClearDomainVerificationToken clears the value of the "domain_verification_token" field.
+func (osu *OrganizationSettingUpdate) ClearDomainVerificationToken() *OrganizationSettingUpdate {
+	osu.mutation.ClearDomainVerificationToken()
+	return osu
+}
+
+// SetVerifiedDomains sets the "verified_domains" field.
+func (osu *OrganizationSettingUpdate) SetVerifiedDomains(s []string) *OrganizationSettingUpdate {
+	osu.mutation.SetVerifiedDomains(s)
+	return osu
+}
+
+// AppendVerifiedDomains appends s to the "verified_domains" field.
+func (osu *OrganizationSettingUpdate) AppendVerifiedDomains(s []string) *OrganizationSettingUpdate {
+	osu.mutation.AppendVerifiedDomains(s)
+	return osu
+}
+
+// ClearVerifiedDomains clears the value of the "verified_domains" field.
+func (osu *OrganizationSettingUpdate) ClearVerifiedDomains() *OrganizationSettingUpdate {
+	osu.mutation.ClearVerifiedDomains()
+	return osu
+}
+
+// SetJoinPolicy sets the "join_policy" field.
+func (osu *OrganizationSettingUpdate) SetJoinPolicy(ejp enums.OrgJoinPolicy) *OrganizationSettingUpdate {
+	osu.mutation.SetJoinPolicy(ejp)
+	return osu
+}
+
+// SetNillableJoinPolicy sets the "join_policy" field if the given value is not nil.
+func (osu *OrganizationSettingUpdate) SetNillableJoinPolicy(ejp *enums.OrgJoinPolicy) *OrganizationSettingUpdate {
+	if ejp != nil {
+		osu.SetJoinPolicy(*ejp)
+	}
+	return osu
+}
+
 // SetOrganization sets the "organization" edge to the Organization entity.
 func (osu *OrganizationSettingUpdate) SetOrganization(o *Organization) *OrganizationSettingUpdate {
 	return osu.SetOrganizationID(o.ID)
@@ -361,6 +479,21 @@ func (osu *OrganizationSettingUpdate) check() error {
 			return &ValidationError{Name: "geo_location", err: fmt.Errorf(`generated: validator failed for field "OrganizationSetting.geo_location": %w`, err)}
 		}
 	}
+	if v, ok := osu.mutation.SamlMetadataURL(); ok {
+		if err := organizationsetting.SamlMetadataURLValidator(v); err != nil {
+			return &ValidationError{Name: "saml_metadata_url", err: fmt.Errorf(`generated: validator failed for field "OrganizationSetting.saml_metadata_url": %w`, err)}
+		}
+	}
+	if v, ok := osu.mutation.SamlMetadata(); ok {
+		if err := organizationsetting.SamlMetadataValidator(v); err != nil {
+			return &ValidationError{Name: "saml_metadata", err: fmt.Errorf(`generated: validator failed for field "OrganizationSetting.saml_metadata": %w`, err)}
+		}
+	}
+	if v, ok := osu.mutation.JoinPolicy(); ok {
+		if err := organizationsetting.JoinPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "join_policy", err: fmt.Errorf(`generated: validator failed for field "OrganizationSetting.join_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -464,6 +597,47 @@ func (osu *OrganizationSettingUpdate) sqlSave(ctx context.Context) (n int, err e
 	if osu.mutation.GeoLocationCleared() {
 		_spec.ClearField(organizationsetting.FieldGeoLocation, field.TypeEnum)
 	}
+	if value, ok := osu.mutation.SSOEnforced(); ok {
+		_spec.SetField(organizationsetting.FieldSSOEnforced, field.TypeBool, value)
+	}
+	if value, ok := osu.mutation.SamlMetadataURL(); ok {
+		_spec.SetField(organizationsetting.FieldSamlMetadataURL, field.TypeString, value)
+	}
+	if osu.mutation.SamlMetadataURLCleared() {
+		_spec.ClearField(organizationsetting.FieldSamlMetadataURL, field.TypeString)
+	}
+	if value, ok := osu.mutation.SamlMetadata(); ok {
+		_spec.SetField(organizationsetting.FieldSamlMetadata, field.TypeString, value)
+	}
+	if osu.mutation.SamlMetadataCleared() {
+		_spec.ClearField(organizationsetting.FieldSamlMetadata, field.TypeString)
+	}
+	if value, ok := osu.mutation.SamlAttributeMapping(); ok {
+		_spec.SetField(organizationsetting.FieldSamlAttributeMapping, field.TypeJSON, value)
+	}
+	if osu.mutation.SamlAttributeMappingCleared() {
+		_spec.ClearField(organizationsetting.FieldSamlAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := osu.mutation.DomainVerificationToken(); ok {
+		_spec.SetField(organizationsetting.FieldDomainVerificationToken, field.TypeString, value)
+	}
+	if osu.mutation.DomainVerificationTokenCleared() {
+		_spec.ClearField(organizationsetting.FieldDomainVerificationToken, field.TypeString)
+	}
+	if value, ok := osu.mutation.VerifiedDomains(); ok {
+		_spec.SetField(organizationsetting.FieldVerifiedDomains, field.TypeJSON, value)
+	}
+	if value, ok := osu.mutation.AppendedVerifiedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, organizationsetting.FieldVerifiedDomains, value)
+		})
+	}
+	if osu.mutation.VerifiedDomainsCleared() {
+		_spec.ClearField(organizationsetting.FieldVerifiedDomains, field.TypeJSON)
+	}
+	if value, ok := osu.mutation.JoinPolicy(); ok {
+		_spec.SetField(organizationsetting.FieldJoinPolicy, field.TypeEnum, value)
+	}
 	if osu.mutation.OrganizationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -765,6 +939,124 @@ func (osuo *OrganizationSettingUpdateOne) ClearOrganizationID() *OrganizationSet
 	return osuo
 }
 
+// SetSSOEnforced sets the "sso_enforced" field.
+func (osuo *OrganizationSettingUpdateOne) SetSSOEnforced(b bool) *OrganizationSettingUpdateOne {
+	osuo.mutation.SetSSOEnforced(b)
+	return osuo
+}
+
+// SetNillableSSOEnforced sets the "sso_enforced" field if the given value is not nil.
+func (osuo *OrganizationSettingUpdateOne) SetNillableSSOEnforced(b *bool) *OrganizationSettingUpdateOne {
+	if b != nil {
+		osuo.SetSSOEnforced(*b)
+	}
+	return osuo
+}
+
+// SetSamlMetadataURL sets the "saml_metadata_url" field.
+func (osuo *OrganizationSettingUpdateOne) SetSamlMetadataURL(s string) *OrganizationSettingUpdateOne {
+	osuo.mutation.SetSamlMetadataURL(s)
+	return osuo
+}
+
+// SetNillableSamlMetadataURL sets the "saml_metadata_url" field if the given value is not nil.
+func (osuo *OrganizationSettingUpdateOne) SetNillableSamlMetadataURL(s *string) *OrganizationSettingUpdateOne {
+	if s != nil {
+		osuo.SetSamlMetadataURL(*s)
+	}
+	return osuo
+}
+
+// ClearSamlMetadataURL clears the value of the "saml_metadata_url" field.
+func (osuo *OrganizationSettingUpdateOne) ClearSamlMetadataURL() *OrganizationSettingUpdateOne {
+	osuo.mutation.ClearSamlMetadataURL()
+	return osuo
+}
+
+// SetSamlMetadata sets the "saml_metadata" field.
+func (osuo *OrganizationSettingUpdateOne) SetSamlMetadata(s string) *OrganizationSettingUpdateOne {
+	osuo.mutation.SetSamlMetadata(s)
+	return osuo
+}
+
+// SetNillableSamlMetadata sets the "saml_metadata" field if the given value is not nil.
+func (osuo *OrganizationSettingUpdateOne) SetNillableSamlMetadata(s *string) *OrganizationSettingUpdateOne {
+	if s != nil {
+		osuo.SetSamlMetadata(*s)
+	}
+	return osuo
+}
+
+// ClearSamlMetadata clears the value of the "saml_metadata" field.
+func (osuo *OrganizationSettingUpdateOne) ClearSamlMetadata() *OrganizationSettingUpdateOne {
+	osuo.mutation.ClearSamlMetadata()
+	return osuo
+}
+
+// SetSamlAttributeMapping sets the "saml_attribute_mapping" field.
+func (osuo *OrganizationSettingUpdateOne) SetSamlAttributeMapping(m map[string]interface{}) *OrganizationSettingUpdateOne {
+	osuo.mutation.SetSamlAttributeMapping(m)
+	return osuo
+}
+
+// ClearSamlAttributeMapping clears the value of the "saml_attribute_mapping" field.
+func (osuo *OrganizationSettingUpdateOne) ClearSamlAttributeMapping() *OrganizationSettingUpdateOne {
+	osuo.mutation.ClearSamlAttributeMapping()
+	return osuo
+}
+
+// SetDomainVerificationToken sets the "domain_verification_token" field.
+func (osuo *OrganizationSettingUpdateOne) SetDomainVerificationToken(s string) *OrganizationSettingUpdateOne {
+	osuo.mutation.SetDomainVerificationToken(s)
+	return osuo
+}
+
+// SetNillableDomainVerificationToken sets the "domain_verification_token" field if the given value is not nil.
+func (osuo *OrganizationSettingUpdateOne) SetNillableDomainVerificationToken(s *string) *OrganizationSettingUpdateOne {
+	if s != nil {
+		osuo.SetDomainVerificationToken(*s)
+	}
+	return osuo
+}
+
+// ClearDomainVerificationToken clears the value of the "domain_verification_token" field.
+func (osuo *OrganizationSettingUpdateOne) ClearDomainVerificationToken() *OrganizationSettingUpdateOne {
+	osuo.mutation.ClearDomainVerificationToken()
+	return osuo
+}
+
+// SetVerifiedDomains sets the "verified_domains" field.
+func (osuo *OrganizationSettingUpdateOne) SetVerifiedDomains(s []string) *OrganizationSettingUpdateOne {
+	osuo.mutation.SetVerifiedDomains(s)
+	return osuo
+}
+
+// AppendVerifiedDomains appends s to the "verified_domains" field.
+func (osuo *OrganizationSettingUpdateOne) AppendVerifiedDomains(s []string) *OrganizationSettingUpdateOne {
+	osuo.mutation.AppendVerifiedDomains(s)
+	return osuo
+}
+
+// ClearVerifiedDomains clears the value of the "verified_domains" field.
+func (osuo *OrganizationSettingUpdateOne) ClearVerifiedDomains() *OrganizationSettingUpdateOne {
+	osuo.mutation.ClearVerifiedDomains()
+	return osuo
+}
+
+// SetJoinPolicy sets the "join_policy" field.
+func (osuo *OrganizationSettingUpdateOne) SetJoinPolicy(ejp enums.OrgJoinPolicy) *OrganizationSettingUpdateOne {
+	osuo.mutation.SetJoinPolicy(ejp)
+	return osuo
+}
+
+// SetNillableJoinPolicy sets the "join_policy" field if the given value is not nil.
+func (osuo *OrganizationSettingUpdateOne) SetNillableJoinPolicy(ejp *enums.OrgJoinPolicy) *OrganizationSettingUpdateOne {
+	if ejp != nil {
+		osuo.SetJoinPolicy(*ejp)
+	}
+	return osuo
+}
+
 // SetOrganization sets the "organization" edge to the Organization entity.
 func (osuo *OrganizationSettingUpdateOne) SetOrganization(o *Organization) *OrganizationSettingUpdateOne {
 	return osuo.SetOrganizationID(o.ID)
@@ -858,6 +1150,21 @@ func (osuo *OrganizationSettingUpdateOne) check() error {
 			return &ValidationError{Name: "geo_location", err: fmt.Errorf(`generated: validator failed for field "OrganizationSetting.geo_location": %w`, err)}
 		}
 	}
+	if v, ok := osuo.mutation.SamlMetadataURL(); ok {
+		if err := organizationsetting.SamlMetadataURLValidator(v); err != nil {
+			return &ValidationError{Name: "saml_metadata_url", err: fmt.Errorf(`generated: validator failed for field "OrganizationSetting.saml_metadata_url": %w`, err)}
+		}
+	}
+	if v, ok := osuo.mutation.SamlMetadata(); ok {
+		if err := organizationsetting.SamlMetadataValidator(v); err != nil {
+			return &ValidationError{Name: "saml_metadata", err: fmt.Errorf(`generated: validator failed for field "OrganizationSetting.saml_metadata": %w`, err)}
+		}
+	}
+	if v, ok := osuo.mutation.JoinPolicy(); ok {
+		if err := organizationsetting.JoinPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "join_policy", err: fmt.Errorf(`generated: validator failed for field "OrganizationSetting.join_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -978,6 +1285,47 @@ func (osuo *OrganizationSettingUpdateOne) sqlSave(ctx context.Context) (_node *O
 	if osuo.mutation.GeoLocationCleared() {
 		_spec.ClearField(organizationsetting.FieldGeoLocation, field.TypeEnum)
 	}
+	if value, ok := osuo.mutation.SSOEnforced(); ok {
+		_spec.SetField(organizationsetting.FieldSSOEnforced, field.TypeBool, value)
+	}
+	if value, ok := osuo.mutation.SamlMetadataURL(); ok {
+		_spec.SetField(organizationsetting.FieldSamlMetadataURL, field.TypeString, value)
+	}
+	if osuo.mutation.SamlMetadataURLCleared() {
+		_spec.ClearField(organizationsetting.FieldSamlMetadataURL, field.TypeString)
+	}
+	if value, ok := osuo.mutation.SamlMetadata(); ok {
+		_spec.SetField(organizationsetting.FieldSamlMetadata, field.TypeString, value)
+	}
+	if osuo.mutation.SamlMetadataCleared() {
+		_spec.ClearField(organizationsetting.FieldSamlMetadata, field.TypeString)
+	}
+	if value, ok := osuo.mutation.SamlAttributeMapping(); ok {
+		_spec.SetField(organizationsetting.FieldSamlAttributeMapping, field.TypeJSON, value)
+	}
+	if osuo.mutation.SamlAttributeMappingCleared() {
+		_spec.ClearField(organizationsetting.FieldSamlAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := osuo.mutation.DomainVerificationToken(); ok {
+		_spec.SetField(organizationsetting.FieldDomainVerificationToken, field.TypeString, value)
+	}
+	if osuo.mutation.DomainVerificationTokenCleared() {
+		_spec.ClearField(organizationsetting.FieldDomainVerificationToken, field.TypeString)
+	}
+	if value, ok := osuo.mutation.VerifiedDomains(); ok {
+		_spec.SetField(organizationsetting.FieldVerifiedDomains, field.TypeJSON, value)
+	}
+	if value, ok := osuo.mutation.AppendedVerifiedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, organizationsetting.FieldVerifiedDomains, value)
+		})
+	}
+	if osuo.mutation.VerifiedDomainsCleared() {
+		_spec.ClearField(organizationsetting.FieldVerifiedDomains, field.TypeJSON)
+	}
+	if value, ok := osuo.mutation.JoinPolicy(); ok {
+		_spec.SetField(organizationsetting.FieldJoinPolicy, field.TypeEnum, value)
+	}
 	if osuo.mutation.OrganizationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
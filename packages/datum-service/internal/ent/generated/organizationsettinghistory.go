@@ -58,7 +58,21 @@ type OrganizationSettingHistory struct {
 	GeoLocation enums.Region `json:"geo_location,omitempty"`
 	// the ID of the organization the settings belong to
 	OrganizationID string `json:"organization_id,omitempty"`
-	selectValues   sql.SelectValues
+	// members with an email address in one of the organization domains must login with the organization SSO provider
+	SSOEnforced bool `json:"sso_enforced,omitempty"`
+	// URL of the SAML metadata of the organization identity provider
+	SamlMetadataURL string `json:"saml_metadata_url,omitempty"`
+	// SAML metadata XML of the organization identity provider, used instead of the metadata URL when set
+	SamlMetadata string `json:"saml_metadata,omitempty"`
+	// mapping of the user fields (email, first_name, last_name, display_name) to the SAML assertion attribute names
+	SamlAttributeMapping map[string]interface{} `json:"saml_attribute_mapping,omitempty"`
+	// token published in a DNS TXT record or the well-known file of a domain to verify the organization owns the domain
+	DomainVerificationToken string `json:"domain_verification_token,omitempty"`
+	// domains of the organization which have been verified to be owned by the organization
+	VerifiedDomains []string `json:"verified_domains,omitempty"`
+	// the policy for users with an email address in a verified domain, whether they are added as members, offered to join, or must be invited
+	JoinPolicy   enums.OrgJoinPolicy `json:"join_policy,omitempty"`
+	selectValues sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -66,11 +80,13 @@ func (*OrganizationSettingHistory) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case organizationsettinghistory.FieldTags, organizationsettinghistory.FieldDomains:
+		case organizationsettinghistory.FieldTags, organizationsettinghistory.FieldDomains, organizationsettinghistory.FieldSamlAttributeMapping, organizationsettinghistory.FieldVerifiedDomains:
 			values[i] = new([]byte)
 		case organizationsettinghistory.FieldOperation:
 			values[i] = new(enthistory.OpType)
-		case organizationsettinghistory.FieldID, organizationsettinghistory.FieldRef, organizationsettinghistory.FieldCreatedBy, organizationsettinghistory.FieldUpdatedBy, organizationsettinghistory.FieldMappingID, organizationsettinghistory.FieldDeletedBy, organizationsettinghistory.FieldBillingContact, organizationsettinghistory.FieldBillingEmail, organizationsettinghistory.FieldBillingPhone, organizationsettinghistory.FieldBillingAddress, organizationsettinghistory.FieldTaxIdentifier, organizationsettinghistory.FieldGeoLocation, organizationsettinghistory.FieldOrganizationID:
+		case organizationsettinghistory.FieldSSOEnforced:
+			values[i] = new(sql.NullBool)
+		case organizationsettinghistory.FieldID, organizationsettinghistory.FieldRef, organizationsettinghistory.FieldCreatedBy, organizationsettinghistory.FieldUpdatedBy, organizationsettinghistory.FieldMappingID, organizationsettinghistory.FieldDeletedBy, organizationsettinghistory.FieldBillingContact, organizationsettinghistory.FieldBillingEmail, organizationsettinghistory.FieldBillingPhone, organizationsettinghistory.FieldBillingAddress, organizationsettinghistory.FieldTaxIdentifier, organizationsettinghistory.FieldGeoLocation, organizationsettinghistory.FieldOrganizationID, organizationsettinghistory.FieldSamlMetadataURL, organizationsettinghistory.FieldSamlMetadata, organizationsettinghistory.FieldDomainVerificationToken, organizationsettinghistory.FieldJoinPolicy:
 			values[i] = new(sql.NullString)
 		case organizationsettinghistory.FieldHistoryTime, organizationsettinghistory.FieldCreatedAt, organizationsettinghistory.FieldUpdatedAt, organizationsettinghistory.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -213,6 +229,52 @@ func (osh *OrganizationSettingHistory) assignValues(columns []string, values []a
 			} else if value.Valid {
 				osh.OrganizationID = value.String
 			}
+		case organizationsettinghistory.FieldSSOEnforced:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sso_enforced", values[i])
+			} else if value.Valid {
+				osh.SSOEnforced = value.Bool
+			}
+		case organizationsettinghistory.FieldSamlMetadataURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field saml_metadata_url", values[i])
+			} else if value.Valid {
+				osh.SamlMetadataURL = value.String
+			}
+		case organizationsettinghistory.FieldSamlMetadata:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field saml_metadata", values[i])
+			} else if value.Valid {
+				osh.SamlMetadata = value.String
+			}
+		case organizationsettinghistory.FieldSamlAttributeMapping:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field saml_attribute_mapping", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &osh.SamlAttributeMapping); err != nil {
+					return fmt.Errorf("unmarshal field saml_attribute_mapping: %w", err)
+				}
+			}
+		case organizationsettinghistory.FieldDomainVerificationToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain_verification_token", values[i])
+			} else if value.Valid {
+				osh.DomainVerificationToken = value.String
+			}
+		case organizationsettinghistory.FieldVerifiedDomains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_domains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &osh.VerifiedDomains); err != nil {
+					return fmt.Errorf("unmarshal field verified_domains: %w", err)
+				}
+			}
+		case organizationsettinghistory.FieldJoinPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field join_policy", values[i])
+			} else if value.Valid {
+				osh.JoinPolicy = enums.OrgJoinPolicy(value.String)
+			}
 		default:
 			osh.selectValues.Set(columns[i], values[i])
 		}
@@ -305,6 +367,27 @@ func (osh *OrganizationSettingHistory) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("organization_id=")
 	builder.WriteString(osh.OrganizationID)
+	builder.WriteString(", ")
+	builder.WriteString("sso_enforced=")
+	builder.WriteString(fmt.Sprintf("%v", osh.SSOEnforced))
+	builder.WriteString(", ")
+	builder.WriteString("saml_metadata_url=")
+	builder.WriteString(osh.SamlMetadataURL)
+	builder.WriteString(", ")
+	builder.WriteString("saml_metadata=")
+	builder.WriteString(osh.SamlMetadata)
+	builder.WriteString(", ")
+	builder.WriteString("saml_attribute_mapping=")
+	builder.WriteString(fmt.Sprintf("%v", osh.SamlAttributeMapping))
+	builder.WriteString(", ")
+	builder.WriteString("domain_verification_token=")
+	builder.WriteString(osh.DomainVerificationToken)
+	builder.WriteString(", ")
+	builder.WriteString("verified_domains=")
+	builder.WriteString(fmt.Sprintf("%v", osh.VerifiedDomains))
+	builder.WriteString(", ")
+	builder.WriteString("join_policy=")
+	builder.WriteString(fmt.Sprintf("%v", osh.JoinPolicy))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -56,6 +56,20 @@ const (
 	FieldGeoLocation = "geo_location"
 	// FieldOrganizationID holds the string denoting the organization_id field in the database.
 	FieldOrganizationID = "organization_id"
+	// FieldSSOEnforced holds the string denoting the sso_enforced field in the database.
+	FieldSSOEnforced = "sso_enforced"
+	// FieldSamlMetadataURL holds the string denoting the saml_metadata_url field in the database.
+	FieldSamlMetadataURL = "saml_metadata_url"
+	// FieldSamlMetadata holds the string denoting the saml_metadata field in the database.
+	FieldSamlMetadata = "saml_metadata"
+	// FieldSamlAttributeMapping holds the string denoting the saml_attribute_mapping field in the database.
+	FieldSamlAttributeMapping = "saml_attribute_mapping"
+	// FieldDomainVerificationToken holds the string denoting the domain_verification_token field in the database.
+	FieldDomainVerificationToken = "domain_verification_token"
+	// FieldVerifiedDomains holds the string denoting the verified_domains field in the database.
+	FieldVerifiedDomains = "verified_domains"
+	// FieldJoinPolicy holds the string denoting the join_policy field in the database.
+	FieldJoinPolicy = "join_policy"
 	// Table holds the table name of the organizationsettinghistory in the database.
 	Table = "organization_setting_history"
 )
@@ -82,6 +96,13 @@ var Columns = []string{
 	FieldTaxIdentifier,
 	FieldGeoLocation,
 	FieldOrganizationID,
+	FieldSSOEnforced,
+	FieldSamlMetadataURL,
+	FieldSamlMetadata,
+	FieldSamlAttributeMapping,
+	FieldDomainVerificationToken,
+	FieldVerifiedDomains,
+	FieldJoinPolicy,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -115,6 +136,10 @@ var (
 	DefaultMappingID func() string
 	// DefaultTags holds the default value on creation for the "tags" field.
 	DefaultTags []string
+	// DefaultSSOEnforced holds the default value on creation for the "sso_enforced" field.
+	DefaultSSOEnforced bool
+	// DefaultDomainVerificationToken holds the default value on creation for the "domain_verification_token" field.
+	DefaultDomainVerificationToken func() string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -141,6 +166,18 @@ func GeoLocationValidator(gl enums.Region) error {
 	}
 }
 
+const DefaultJoinPolicy enums.OrgJoinPolicy = "INVITE_ONLY"
+
+// JoinPolicyValidator is a validator for the "join_policy" field enum values. It is called by the builders before save.
+func JoinPolicyValidator(jp enums.OrgJoinPolicy) error {
+	switch jp.String() {
+	case "INVITE_ONLY", "OFFER", "AUTO_JOIN":
+		return nil
+	default:
+		return fmt.Errorf("organizationsettinghistory: invalid enum value for join_policy field: %q", jp)
+	}
+}
+
 // OrderOption defines the ordering options for the OrganizationSettingHistory queries.
 type OrderOption func(*sql.Selector)
 
@@ -234,6 +271,31 @@ func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
 }
 
+// BySSOEnforced orders the results by the sso_enforced field.
+func BySSOEnforced(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSSOEnforced, opts...).ToFunc()
+}
+
+// BySamlMetadataURL orders the results by the saml_metadata_url field.
+func BySamlMetadataURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSamlMetadataURL, opts...).ToFunc()
+}
+
+// BySamlMetadata orders the results by the saml_metadata field.
+func BySamlMetadata(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSamlMetadata, opts...).ToFunc()
+}
+
+// ByDomainVerificationToken orders the results by the domain_verification_token field.
+func ByDomainVerificationToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDomainVerificationToken, opts...).ToFunc()
+}
+
+// ByJoinPolicy orders the results by the join_policy field.
+func ByJoinPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJoinPolicy, opts...).ToFunc()
+}
+
 var (
 	// enthistory.OpType must implement graphql.Marshaler.
 	_ graphql.Marshaler = (*enthistory.OpType)(nil)
@@ -247,3 +309,10 @@ var (
 	// enums.Region must implement graphql.Unmarshaler.
 	_ graphql.Unmarshaler = (*enums.Region)(nil)
 )
+
+var (
+	// enums.OrgJoinPolicy must implement graphql.Marshaler.
+	_ graphql.Marshaler = (*enums.OrgJoinPolicy)(nil)
+	// enums.OrgJoinPolicy must implement graphql.Unmarshaler.
+	_ graphql.Unmarshaler = (*enums.OrgJoinPolicy)(nil)
+)
@@ -141,6 +141,26 @@ func OrganizationID(v string) predicate.OrganizationSettingHistory {
 	return predicate.OrganizationSettingHistory(sql.FieldEQ(FieldOrganizationID, v))
 }
 
+// SSOEnforced applies equality check predicate on the "sso_enforced" field. It's identical to SSOEnforcedEQ.
+func SSOEnforced(v bool) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldEQ(FieldSSOEnforced, v))
+}
+
+// SamlMetadataURL applies equality check predicate on the "saml_metadata_url" field. It's identical to SamlMetadataURLEQ.
+func SamlMetadataURL(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldEQ(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadata applies equality check predicate on the "saml_metadata" field. It's identical to SamlMetadataEQ.
+func SamlMetadata(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldEQ(FieldSamlMetadata, v))
+}
+
+// DomainVerificationToken applies equality check predicate on the "domain_verification_token" field. It's identical to DomainVerificationTokenEQ.
+func DomainVerificationToken(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldEQ(FieldDomainVerificationToken, v))
+}
+
 // HistoryTimeEQ applies the EQ predicate on the "history_time" field.
 func HistoryTimeEQ(v time.Time) predicate.OrganizationSettingHistory {
 	return predicate.OrganizationSettingHistory(sql.FieldEQ(FieldHistoryTime, v))
@@ -1226,6 +1246,291 @@ func OrganizationIDContainsFold(v string) predicate.OrganizationSettingHistory {
 	return predicate.OrganizationSettingHistory(sql.FieldContainsFold(FieldOrganizationID, v))
 }
 
+// SSOEnforcedEQ applies the EQ predicate on the "sso_enforced" field.
+func SSOEnforcedEQ(v bool) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldEQ(FieldSSOEnforced, v))
+}
+
+// SSOEnforcedNEQ applies the NEQ predicate on the "sso_enforced" field.
+func SSOEnforcedNEQ(v bool) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldNEQ(FieldSSOEnforced, v))
+}
+
+// SamlMetadataURLEQ applies the EQ predicate on the "saml_metadata_url" field.
+func SamlMetadataURLEQ(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldEQ(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLNEQ applies the NEQ predicate on the "saml_metadata_url" field.
+func SamlMetadataURLNEQ(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldNEQ(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLIn applies the In predicate on the "saml_metadata_url" field.
+func SamlMetadataURLIn(vs ...string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldIn(FieldSamlMetadataURL, vs...))
+}
+
+// SamlMetadataURLNotIn applies the NotIn predicate on the "saml_metadata_url" field.
+func SamlMetadataURLNotIn(vs ...string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldNotIn(FieldSamlMetadataURL, vs...))
+}
+
+// SamlMetadataURLGT applies the GT predicate on the "saml_metadata_url" field.
+func SamlMetadataURLGT(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldGT(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLGTE applies the GTE predicate on the "saml_metadata_url" field.
+func SamlMetadataURLGTE(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldGTE(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLLT applies the LT predicate on the "saml_metadata_url" field.
+func SamlMetadataURLLT(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldLT(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLLTE applies the LTE predicate on the "saml_metadata_url" field.
+func SamlMetadataURLLTE(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldLTE(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLContains applies the Contains predicate on the "saml_metadata_url" field.
+func SamlMetadataURLContains(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldContains(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLHasPrefix applies the HasPrefix predicate on the "saml_metadata_url" field.
+func SamlMetadataURLHasPrefix(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldHasPrefix(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLHasSuffix applies the HasSuffix predicate on the "saml_metadata_url" field.
+func SamlMetadataURLHasSuffix(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldHasSuffix(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLIsNil applies the IsNil predicate on the "saml_metadata_url" field.
+func SamlMetadataURLIsNil() predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldIsNull(FieldSamlMetadataURL))
+}
+
+// SamlMetadataURLNotNil applies the NotNil predicate on the "saml_metadata_url" field.
+func SamlMetadataURLNotNil() predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldNotNull(FieldSamlMetadataURL))
+}
+
+// SamlMetadataURLEqualFold applies the EqualFold predicate on the "saml_metadata_url" field.
+func SamlMetadataURLEqualFold(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldEqualFold(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataURLContainsFold applies the ContainsFold predicate on the "saml_metadata_url" field.
+func SamlMetadataURLContainsFold(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldContainsFold(FieldSamlMetadataURL, v))
+}
+
+// SamlMetadataEQ applies the EQ predicate on the "saml_metadata" field.
+func SamlMetadataEQ(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldEQ(FieldSamlMetadata, v))
+}
+
+// SamlMetadataNEQ applies the NEQ predicate on the "saml_metadata" field.
+func SamlMetadataNEQ(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldNEQ(FieldSamlMetadata, v))
+}
+
+// SamlMetadataIn applies the In predicate on the "saml_metadata" field.
+func SamlMetadataIn(vs ...string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldIn(FieldSamlMetadata, vs...))
+}
+
+// SamlMetadataNotIn applies the NotIn predicate on the "saml_metadata" field.
+func SamlMetadataNotIn(vs ...string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldNotIn(FieldSamlMetadata, vs...))
+}
+
+// SamlMetadataGT applies the GT predicate on the "saml_metadata" field.
+func SamlMetadataGT(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldGT(FieldSamlMetadata, v))
+}
+
+// SamlMetadataGTE applies the GTE predicate on the "saml_metadata" field.
+func SamlMetadataGTE(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldGTE(FieldSamlMetadata, v))
+}
+
+// SamlMetadataLT applies the LT predicate on the "saml_metadata" field.
+func SamlMetadataLT(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldLT(FieldSamlMetadata, v))
+}
+
+// SamlMetadataLTE applies the LTE predicate on the "saml_metadata" field.
+func SamlMetadataLTE(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldLTE(FieldSamlMetadata, v))
+}
+
+// SamlMetadataContains applies the Contains predicate on the "saml_metadata" field.
+func SamlMetadataContains(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldContains(FieldSamlMetadata, v))
+}
+
+// SamlMetadataHasPrefix applies the HasPrefix predicate on the "saml_metadata" field.
+func SamlMetadataHasPrefix(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldHasPrefix(FieldSamlMetadata, v))
+}
+
+// SamlMetadataHasSuffix applies the HasSuffix predicate on the "saml_metadata" field.
+func SamlMetadataHasSuffix(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldHasSuffix(FieldSamlMetadata, v))
+}
+
+// SamlMetadataIsNil applies the IsNil predicate on the "saml_metadata" field.
+func SamlMetadataIsNil() predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldIsNull(FieldSamlMetadata))
+}
+
+// SamlMetadataNotNil applies the NotNil predicate on the "saml_metadata" field.
+func SamlMetadataNotNil() predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldNotNull(FieldSamlMetadata))
+}
+
+// SamlMetadataEqualFold applies the EqualFold predicate on the "saml_metadata" field.
+func SamlMetadataEqualFold(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldEqualFold(FieldSamlMetadata, v))
+}
+
+// SamlMetadataContainsFold applies the ContainsFold predicate on the "saml_metadata" field.
+func SamlMetadataContainsFold(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldContainsFold(FieldSamlMetadata, v))
+}
+
+// SamlAttributeMappingIsNil applies the IsNil predicate on the "saml_attribute_mapping" field.
+func SamlAttributeMappingIsNil() predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldIsNull(FieldSamlAttributeMapping))
+}
+
+// SamlAttributeMappingNotNil applies the NotNil predicate on the "saml_attribute_mapping" field.
+func SamlAttributeMappingNotNil() predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldNotNull(FieldSamlAttributeMapping))
+}
+
+// DomainVerificationTokenEQ applies the EQ predicate on the "domain_verification_token" field.
+func DomainVerificationTokenEQ(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldEQ(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenNEQ applies the NEQ predicate on the "domain_verification_token" field.
+func DomainVerificationTokenNEQ(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldNEQ(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenIn applies the In predicate on the "domain_verification_token" field.
+func DomainVerificationTokenIn(vs ...string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldIn(FieldDomainVerificationToken, vs...))
+}
+
+// DomainVerificationTokenNotIn applies the NotIn predicate on the "domain_verification_token" field.
+func DomainVerificationTokenNotIn(vs ...string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldNotIn(FieldDomainVerificationToken, vs...))
+}
+
+// DomainVerificationTokenGT applies the GT predicate on the "domain_verification_token" field.
+func DomainVerificationTokenGT(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldGT(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenGTE applies the GTE predicate on the "domain_verification_token" field.
+func DomainVerificationTokenGTE(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldGTE(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenLT applies the LT predicate on the "domain_verification_token" field.
+func DomainVerificationTokenLT(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldLT(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenLTE applies the LTE predicate on the "domain_verification_token" field.
+func DomainVerificationTokenLTE(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldLTE(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenContains applies the Contains predicate on the "domain_verification_token" field.
+func DomainVerificationTokenContains(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldContains(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenHasPrefix applies the HasPrefix predicate on the "domain_verification_token" field.
+func DomainVerificationTokenHasPrefix(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldHasPrefix(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenHasSuffix applies the HasSuffix predicate on the "domain_verification_token" field.
+func DomainVerificationTokenHasSuffix(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldHasSuffix(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenIsNil applies the IsNil predicate on the "domain_verification_token" field.
+func DomainVerificationTokenIsNil() predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldIsNull(FieldDomainVerificationToken))
+}
+
+// DomainVerificationTokenNotNil applies the NotNil predicate on the "domain_verification_token" field.
+func DomainVerificationTokenNotNil() predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldNotNull(FieldDomainVerificationToken))
+}
+
+// DomainVerificationTokenEqualFold applies the EqualFold predicate on the "domain_verification_token" field.
+func DomainVerificationTokenEqualFold(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldEqualFold(FieldDomainVerificationToken, v))
+}
+
+// DomainVerificationTokenContainsFold applies the ContainsFold predicate on the "domain_verification_token" field.
+func DomainVerificationTokenContainsFold(v string) predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldContainsFold(FieldDomainVerificationToken, v))
+}
+
+// VerifiedDomainsIsNil applies the IsNil predicate on the "verified_domains" field.
+func VerifiedDomainsIsNil() predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldIsNull(FieldVerifiedDomains))
+}
+
+// VerifiedDomainsNotNil applies the NotNil predicate on the "verified_domains" field.
+func VerifiedDomainsNotNil() predicate.OrganizationSettingHistory {
+	return predicate.OrganizationSettingHistory(sql.FieldNotNull(FieldVerifiedDomains))
+}
+
+// JoinPolicyEQ applies the EQ predicate on the "join_policy" field.
+func JoinPolicyEQ(v enums.OrgJoinPolicy) predicate.OrganizationSettingHistory {
+	vc := v
+	return predicate.OrganizationSettingHistory(sql.FieldEQ(FieldJoinPolicy, vc))
+}
+
+// JoinPolicyNEQ applies the NEQ predicate on the "join_policy" field.
+func JoinPolicyNEQ(v enums.OrgJoinPolicy) predicate.OrganizationSettingHistory {
+	vc := v
+	return predicate.OrganizationSettingHistory(sql.FieldNEQ(FieldJoinPolicy, vc))
+}
+
+// JoinPolicyIn applies the In predicate on the "join_policy" field.
+func JoinPolicyIn(vs ...enums.OrgJoinPolicy) predicate.OrganizationSettingHistory {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OrganizationSettingHistory(sql.FieldIn(FieldJoinPolicy, v...))
+}
+
+// JoinPolicyNotIn applies the NotIn predicate on the "join_policy" field.
+func JoinPolicyNotIn(vs ...enums.OrgJoinPolicy) predicate.OrganizationSettingHistory {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.OrganizationSettingHistory(sql.FieldNotIn(FieldJoinPolicy, v...))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.OrganizationSettingHistory) predicate.OrganizationSettingHistory {
 	return predicate.OrganizationSettingHistory(sql.AndPredicates(predicates...))
@@ -264,6 +264,88 @@ func (oshc *OrganizationSettingHistoryCreate) SetNillableOrganizationID(s *strin
 	return oshc
 }
 
+// SetSSOEnforced sets the "sso_enforced" field.
+func (oshc *OrganizationSettingHistoryCreate) SetSSOEnforced(b bool) *OrganizationSettingHistoryCreate {
+	oshc.mutation.SetSSOEnforced(b)
+	return oshc
+}
+
+// SetNillableSSOEnforced sets the "sso_enforced" field if the given value is not nil.
+func (oshc *OrganizationSettingHistoryCreate) SetNillableSSOEnforced(b *bool) *OrganizationSettingHistoryCreate {
+	if b != nil {
+		oshc.SetSSOEnforced(*b)
+	}
+	return oshc
+}
+
+// SetSamlMetadataURL sets the "saml_metadata_url" field.
+func (oshc *OrganizationSettingHistoryCreate) SetSamlMetadataURL(s string) *OrganizationSettingHistoryCreate {
+	oshc.mutation.SetSamlMetadataURL(s)
+	return oshc
+}
+
+// SetNillableSamlMetadataURL sets the "saml_metadata_url" field if the given value is not nil.
+func (oshc *OrganizationSettingHistoryCreate) SetNillableSamlMetadataURL(s *string) *OrganizationSettingHistoryCreate {
+	if s != nil {
+		oshc.SetSamlMetadataURL(*s)
+	}
+	return oshc
+}
+
+// SetSamlMetadata sets the "saml_metadata" field.
+func (oshc *OrganizationSettingHistoryCreate) SetSamlMetadata(s string) *OrganizationSettingHistoryCreate {
+	oshc.mutation.SetSamlMetadata(s)
+	return oshc
+}
+
+// SetNillableSamlMetadata sets the "saml_metadata" field if the given value is not nil.
+func (oshc *OrganizationSettingHistoryCreate) SetNillableSamlMetadata(s *string) *OrganizationSettingHistoryCreate {
+	if s != nil {
+		oshc.SetSamlMetadata(*s)
+	}
+	return oshc
+}
+
+// SetSamlAttributeMapping sets the "saml_attribute_mapping" field.
+func (oshc *OrganizationSettingHistoryCreate) SetSamlAttributeMapping(m map[string]interface{}) *OrganizationSettingHistoryCreate {
+	oshc.mutation.SetSamlAttributeMapping(m)
+	return oshc
+}
+
+// SetDomainVerificationToken sets the "domain_verification_token" field.
+func (oshc *OrganizationSettingHistoryCreate) SetDomainVerificationToken(s string) *OrganizationSettingHistoryCreate {
+	oshc.mutation.SetDomainVerificationToken(s)
+	return oshc
+}
+
+// SetNillableDomainVerificationToken sets the "domain_verification_token" field if the given value is not nil.
+func (oshc *OrganizationSettingHistoryCreate) SetNillableDomainVerificationToken(s *string) *OrganizationSettingHistoryCreate {
+	if s != nil {
+		oshc.SetDomainVerificationToken(*s)
+	}
+	return oshc
+}
+
+// SetVerifiedDomains sets the "verified_domains" field.
+func (oshc *OrganizationSettingHistoryCreate) SetVerifiedDomains(s []string) *OrganizationSettingHistoryCreate {
+	oshc.mutation.SetVerifiedDomains(s)
+	return oshc
+}
+
+// SetJoinPolicy sets the "join_policy" field.
+func (oshc *OrganizationSettingHistoryCreate) SetJoinPolicy(ejp enums.OrgJoinPolicy) *OrganizationSettingHistoryCreate {
+	oshc.mutation.SetJoinPolicy(ejp)
+	return oshc
+}
+
+// SetNillableJoinPolicy sets the "join_policy" field if the given value is not nil.
+func (oshc *OrganizationSettingHistoryCreate) SetNillableJoinPolicy(ejp *enums.OrgJoinPolicy) *OrganizationSettingHistoryCreate {
+	if ejp != nil {
+		oshc.SetJoinPolicy(*ejp)
+	}
+	return oshc
+}
+
 // SetID sets the "id" field.
 func (oshc *OrganizationSettingHistoryCreate) SetID(s string) *OrganizationSettingHistoryCreate {
 	oshc.mutation.SetID(s)
@@ -351,6 +433,21 @@ func (oshc *OrganizationSettingHistoryCreate) defaults() error {
 		v := organizationsettinghistory.DefaultGeoLocation
 		oshc.mutation.SetGeoLocation(v)
 	}
+	if _, ok := oshc.mutation.SSOEnforced(); !ok {
+		v := organizationsettinghistory.DefaultSSOEnforced
+		oshc.mutation.SetSSOEnforced(v)
+	}
+	if _, ok := oshc.mutation.DomainVerificationToken(); !ok {
+		if organizationsettinghistory.DefaultDomainVerificationToken == nil {
+			return fmt.Errorf("generated: uninitialized organizationsettinghistory.DefaultDomainVerificationToken (forgotten import generated/runtime?)")
+		}
+		v := organizationsettinghistory.DefaultDomainVerificationToken()
+		oshc.mutation.SetDomainVerificationToken(v)
+	}
+	if _, ok := oshc.mutation.JoinPolicy(); !ok {
+		v := organizationsettinghistory.DefaultJoinPolicy
+		oshc.mutation.SetJoinPolicy(v)
+	}
 	if _, ok := oshc.mutation.ID(); !ok {
 		if organizationsettinghistory.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized organizationsettinghistory.DefaultID (forgotten import generated/runtime?)")
@@ -382,6 +479,17 @@ func (oshc *OrganizationSettingHistoryCreate) check() error {
 			return &ValidationError{Name: "geo_location", err: fmt.Errorf(`generated: validator failed for field "OrganizationSettingHistory.geo_location": %w`, err)}
 		}
 	}
+	if _, ok := oshc.mutation.SSOEnforced(); !ok {
+		return &ValidationError{Name: "sso_enforced", err: errors.New(`generated: missing required field "OrganizationSettingHistory.sso_enforced"`)}
+	}
+	if _, ok := oshc.mutation.JoinPolicy(); !ok {
+		return &ValidationError{Name: "join_policy", err: errors.New(`generated: missing required field "OrganizationSettingHistory.join_policy"`)}
+	}
+	if v, ok := oshc.mutation.JoinPolicy(); ok {
+		if err := organizationsettinghistory.JoinPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "join_policy", err: fmt.Errorf(`generated: validator failed for field "OrganizationSettingHistory.join_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -494,6 +602,34 @@ func (oshc *OrganizationSettingHistoryCreate) createSpec() (*OrganizationSetting
 		_spec.SetField(organizationsettinghistory.FieldOrganizationID, field.TypeString, value)
 		_node.OrganizationID = value
 	}
+	if value, ok := oshc.mutation.SSOEnforced(); ok {
+		_spec.SetField(organizationsettinghistory.FieldSSOEnforced, field.TypeBool, value)
+		_node.SSOEnforced = value
+	}
+	if value, ok := oshc.mutation.SamlMetadataURL(); ok {
+		_spec.SetField(organizationsettinghistory.FieldSamlMetadataURL, field.TypeString, value)
+		_node.SamlMetadataURL = value
+	}
+	if value, ok := oshc.mutation.SamlMetadata(); ok {
+		_spec.SetField(organizationsettinghistory.FieldSamlMetadata, field.TypeString, value)
+		_node.SamlMetadata = value
+	}
+	if value, ok := oshc.mutation.SamlAttributeMapping(); ok {
+		_spec.SetField(organizationsettinghistory.FieldSamlAttributeMapping, field.TypeJSON, value)
+		_node.SamlAttributeMapping = value
+	}
+	if value, ok := oshc.mutation.DomainVerificationToken(); ok {
+		_spec.SetField(organizationsettinghistory.FieldDomainVerificationToken, field.TypeString, value)
+		_node.DomainVerificationToken = value
+	}
+	if value, ok := oshc.mutation.VerifiedDomains(); ok {
+		_spec.SetField(organizationsettinghistory.FieldVerifiedDomains, field.TypeJSON, value)
+		_node.VerifiedDomains = value
+	}
+	if value, ok := oshc.mutation.JoinPolicy(); ok {
+		_spec.SetField(organizationsettinghistory.FieldJoinPolicy, field.TypeEnum, value)
+		_node.JoinPolicy = value
+	}
 	return _node, _spec
 }
 
@@ -280,6 +280,124 @@ func (oshu *OrganizationSettingHistoryUpdate) ClearOrganizationID() *Organizatio
 	return oshu
 }
 
+// SetSSOEnforced sets the "sso_enforced" field.
+func (oshu *OrganizationSettingHistoryUpdate) SetSSOEnforced(b bool) *OrganizationSettingHistoryUpdate {
+	oshu.mutation.SetSSOEnforced(b)
+	return oshu
+}
+
+// SetNillableSSOEnforced sets the "sso_enforced" field if the given value is not nil.
+func (oshu *OrganizationSettingHistoryUpdate) SetNillableSSOEnforced(b *bool) *OrganizationSettingHistoryUpdate {
+	if b != nil {
+		oshu.SetSSOEnforced(*b)
+	}
+	return oshu
+}
+
+// SetSamlMetadataURL sets the "saml_metadata_url" field.
+func (oshu *OrganizationSettingHistoryUpdate) SetSamlMetadataURL(s string) *OrganizationSettingHistoryUpdate {
+	oshu.mutation.SetSamlMetadataURL(s)
+	return oshu
+}
+
+// SetNillableSamlMetadataURL sets the "saml_metadata_url" field if the given value is not nil.
+func (oshu *OrganizationSettingHistoryUpdate) SetNillableSamlMetadataURL(s *string) *OrganizationSettingHistoryUpdate {
+	if s != nil {
+		oshu.SetSamlMetadataURL(*s)
+	}
+	return oshu
+}
+
+// ClearSamlMetadataURL clears the value of the "saml_metadata_url" field.
+func (oshu *OrganizationSettingHistoryUpdate) ClearSamlMetadataURL() *OrganizationSettingHistoryUpdate {
+	oshu.mutation.ClearSamlMetadataURL()
+	return oshu
+}
+
+// SetSamlMetadata sets the "saml_metadata" field.
+func (oshu *OrganizationSettingHistoryUpdate) SetSamlMetadata(s string) *OrganizationSettingHistoryUpdate {
+	oshu.mutation.SetSamlMetadata(s)
+	return oshu
+}
+
+// SetNillableSamlMetadata sets the "saml_metadata" field if the given value is not nil.
+func (oshu *OrganizationSettingHistoryUpdate) SetNillableSamlMetadata(s *string) *OrganizationSettingHistoryUpdate {
+	if s != nil {
+		oshu.SetSamlMetadata(*s)
+	}
+	return oshu
+}
+
+// ClearSamlMetadata clears the value of the "saml_metadata" field.
+func (oshu *OrganizationSettingHistoryUpdate) ClearSamlMetadata() *OrganizationSettingHistoryUpdate {
+	oshu.mutation.ClearSamlMetadata()
+	return oshu
+}
+
+// SetSamlAttributeMapping sets the "saml_attribute_mapping" field.
+func (oshu *OrganizationSettingHistoryUpdate) SetSamlAttributeMapping(m map[string]interface{}) *OrganizationSettingHistoryUpdate {
+	oshu.mutation.SetSamlAttributeMapping(m)
+	return oshu
+}
+
+// ClearSamlAttributeMapping clears the value of the "saml_attribute_mapping" field.
+func (oshu *OrganizationSettingHistoryUpdate) ClearSamlAttributeMapping() *OrganizationSettingHistoryUpdate {
+	oshu.mutation.ClearSamlAttributeMapping()
+	return oshu
+}
+
+// SetDomainVerificationToken sets the "domain_verification_token" field.
+func (oshu *OrganizationSettingHistoryUpdate) SetDomainVerificationToken(s string) *OrganizationSettingHistoryUpdate {
+	oshu.mutation.SetDomainVerificationToken(s)
+	return oshu
+}
+
+// SetNillableDomainVerificationToken sets the "domain_verification_token" field if the given value is not nil.
+func (oshu *OrganizationSettingHistoryUpdate) SetNillableDomainVerificationToken(s *string) *OrganizationSettingHistoryUpdate {
+	if s != nil {
+		oshu.SetDomainVerificationToken(*s)
+	}
+	return oshu
+}
+
+// ClearDomainVerificationToken clears the value of the "domain_verification_token" field.
+func (oshu *OrganizationSettingHistoryUpdate) ClearDomainVerificationToken() *OrganizationSettingHistoryUpdate {
+	oshu.mutation.ClearDomainVerificationToken()
+	return oshu
+}
+
+// SetVerifiedDomains sets the "verified_domains" field.
+func (oshu *OrganizationSettingHistoryUpdate) SetVerifiedDomains(s []string) *OrganizationSettingHistoryUpdate {
+	oshu.mutation.SetVerifiedDomains(s)
+	return oshu
+}
+
+// AppendVerifiedDomains appends s to the "verified_domains" field.
+func (oshu *OrganizationSettingHistoryUpdate) AppendVerifiedDomains(s []string) *OrganizationSettingHistoryUpdate {
+	oshu.mutation.AppendVerifiedDomains(s)
+	return oshu
+}
+
+// ClearVerifiedDomains clears the value of the "verified_domains" field.
+func (oshu *OrganizationSettingHistoryUpdate) ClearVerifiedDomains() *OrganizationSettingHistoryUpdate {
+	oshu.mutation.ClearVerifiedDomains()
+	return oshu
+}
+
+// SetJoinPolicy sets the "join_policy" field.
+func (oshu *OrganizationSettingHistoryUpdate) SetJoinPolicy(ejp enums.OrgJoinPolicy) *OrganizationSettingHistoryUpdate {
+	oshu.mutation.SetJoinPolicy(ejp)
+	return oshu
+}
+
+// SetNillableJoinPolicy sets the "join_policy" field if the given value is not nil.
+func (oshu *OrganizationSettingHistoryUpdate) SetNillableJoinPolicy(ejp *enums.OrgJoinPolicy) *OrganizationSettingHistoryUpdate {
+	if ejp != nil {
+		oshu.SetJoinPolicy(*ejp)
+	}
+	return oshu
+}
+
 // Mutation returns the OrganizationSettingHistoryMutation object of the builder.
 func (oshu *OrganizationSettingHistoryUpdate) Mutation() *OrganizationSettingHistoryMutation {
 	return oshu.mutation
@@ -334,6 +452,11 @@ func (oshu *OrganizationSettingHistoryUpdate) check() error {
 			return &ValidationError{Name: "geo_location", err: fmt.Errorf(`generated: validator failed for field "OrganizationSettingHistory.geo_location": %w`, err)}
 		}
 	}
+	if v, ok := oshu.mutation.JoinPolicy(); ok {
+		if err := organizationsettinghistory.JoinPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "join_policy", err: fmt.Errorf(`generated: validator failed for field "OrganizationSettingHistory.join_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -446,6 +569,47 @@ func (oshu *OrganizationSettingHistoryUpdate) sqlSave(ctx context.Context) (n in
 	if oshu.mutation.OrganizationIDCleared() {
 		_spec.ClearField(organizationsettinghistory.FieldOrganizationID, field.TypeString)
 	}
+	if value, ok := oshu.mutation.SSOEnforced(); ok {
+		_spec.SetField(organizationsettinghistory.FieldSSOEnforced, field.TypeBool, value)
+	}
+	if value, ok := oshu.mutation.SamlMetadataURL(); ok {
+		_spec.SetField(organizationsettinghistory.FieldSamlMetadataURL, field.TypeString, value)
+	}
+	if oshu.mutation.SamlMetadataURLCleared() {
+		_spec.ClearField(organizationsettinghistory.FieldSamlMetadataURL, field.TypeString)
+	}
+	if value, ok := oshu.mutation.SamlMetadata(); ok {
+		_spec.SetField(organizationsettinghistory.FieldSamlMetadata, field.TypeString, value)
+	}
+	if oshu.mutation.SamlMetadataCleared() {
+		_spec.ClearField(organizationsettinghistory.FieldSamlMetadata, field.TypeString)
+	}
+	if value, ok := oshu.mutation.SamlAttributeMapping(); ok {
+		_spec.SetField(organizationsettinghistory.FieldSamlAttributeMapping, field.TypeJSON, value)
+	}
+	if oshu.mutation.SamlAttributeMappingCleared() {
+		_spec.ClearField(organizationsettinghistory.FieldSamlAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := oshu.mutation.DomainVerificationToken(); ok {
+		_spec.SetField(organizationsettinghistory.FieldDomainVerificationToken, field.TypeString, value)
+	}
+	if oshu.mutation.DomainVerificationTokenCleared() {
+		_spec.ClearField(organizationsettinghistory.FieldDomainVerificationToken, field.TypeString)
+	}
+	if value, ok := oshu.mutation.VerifiedDomains(); ok {
+		_spec.SetField(organizationsettinghistory.FieldVerifiedDomains, field.TypeJSON, value)
+	}
+	if value, ok := oshu.mutation.AppendedVerifiedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, organizationsettinghistory.FieldVerifiedDomains, value)
+		})
+	}
+	if oshu.mutation.VerifiedDomainsCleared() {
+		_spec.ClearField(organizationsettinghistory.FieldVerifiedDomains, field.TypeJSON)
+	}
+	if value, ok := oshu.mutation.JoinPolicy(); ok {
+		_spec.SetField(organizationsettinghistory.FieldJoinPolicy, field.TypeEnum, value)
+	}
 	_spec.Node.Schema = oshu.schemaConfig.OrganizationSettingHistory
 	ctx = internal.NewSchemaConfigContext(ctx, oshu.schemaConfig)
 	if n, err = sqlgraph.UpdateNodes(ctx, oshu.driver, _spec); err != nil {
@@ -716,6 +880,124 @@ func (oshuo *OrganizationSettingHistoryUpdateOne) ClearOrganizationID() *Organiz
 	return oshuo
 }
 
+// SetSSOEnforced sets the "sso_enforced" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) SetSSOEnforced(b bool) *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.SetSSOEnforced(b)
+	return oshuo
+}
+
+// SetNillableSSOEnforced sets the "sso_enforced" field if the given value is not nil.
+func (oshuo *OrganizationSettingHistoryUpdateOne) SetNillableSSOEnforced(b *bool) *OrganizationSettingHistoryUpdateOne {
+	if b != nil {
+		oshuo.SetSSOEnforced(*b)
+	}
+	return oshuo
+}
+
+// SetSamlMetadataURL sets the "saml_metadata_url" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) SetSamlMetadataURL(s string) *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.SetSamlMetadataURL(s)
+	return oshuo
+}
+
+// SetNillableSamlMetadataURL sets the "saml_metadata_url" field if the given value is not nil.
+func (oshuo *OrganizationSettingHistoryUpdateOne) SetNillableSamlMetadataURL(s *string) *OrganizationSettingHistoryUpdateOne {
+	if s != nil {
+		oshuo.SetSamlMetadataURL(*s)
+	}
+	return oshuo
+}
+
+// ClearSamlMetadataURL clears the value of the "saml_metadata_url" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) ClearSamlMetadataURL() *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.ClearSamlMetadataURL()
+	return oshuo
+}
+
+// SetSamlMetadata sets the "saml_metadata" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) SetSamlMetadata(s string) *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.SetSamlMetadata(s)
+	return oshuo
+}
+
+// SetNillableSamlMetadata sets the "saml_metadata" field if the given value is not nil.
+func (oshuo *OrganizationSettingHistoryUpdateOne) SetNillableSamlMetadata(s *string) *OrganizationSettingHistoryUpdateOne {
+	if s != nil {
+		oshuo.SetSamlMetadata(*s)
+	}
+	return oshuo
+}
+
+// ClearSamlMetadata clears the value of the "saml_metadata" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) ClearSamlMetadata() *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.ClearSamlMetadata()
+	return oshuo
+}
+
+// SetSamlAttributeMapping sets the "saml_attribute_mapping" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) SetSamlAttributeMapping(m map[string]interface{}) *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.SetSamlAttributeMapping(m)
+	return oshuo
+}
+
+// ClearSamlAttributeMapping clears the value of the "saml_attribute_mapping" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) ClearSamlAttributeMapping() *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.ClearSamlAttributeMapping()
+	return oshuo
+}
+
+// SetDomainVerificationToken sets the "domain_verification_token" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) SetDomainVerificationToken(s string) *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.SetDomainVerificationToken(s)
+	return oshuo
+}
+
+// SetNillableDomainVerificationToken sets the "domain_verification_token" field if the given value is not nil.
+func (oshuo *OrganizationSettingHistoryUpdateOne) SetNillableDomainVerificationToken(s *string) *OrganizationSettingHistoryUpdateOne {
+	if s != nil {
+		oshuo.SetDomainVerificationToken(*s)
+	}
+	return oshuo
+}
+
+// ClearDomainVerificationToken clears the value of the "domain_verification_token" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) ClearDomainVerificationToken() *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.ClearDomainVerificationToken()
+	return oshuo
+}
+
+// SetVerifiedDomains sets the "verified_domains" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) SetVerifiedDomains(s []string) *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.SetVerifiedDomains(s)
+	return oshuo
+}
+
+// AppendVerifiedDomains appends s to the "verified_domains" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) AppendVerifiedDomains(s []string) *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.AppendVerifiedDomains(s)
+	return oshuo
+}
+
+// ClearVerifiedDomains clears the value of the "verified_domains" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) ClearVerifiedDomains() *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.ClearVerifiedDomains()
+	return oshuo
+}
+
+// SetJoinPolicy sets the "join_policy" field.
+func (oshuo *OrganizationSettingHistoryUpdateOne) SetJoinPolicy(ejp enums.OrgJoinPolicy) *OrganizationSettingHistoryUpdateOne {
+	oshuo.mutation.SetJoinPolicy(ejp)
+	return oshuo
+}
+
+// SetNillableJoinPolicy sets the "join_policy" field if the given value is not nil.
+func (oshuo *OrganizationSettingHistoryUpdateOne) SetNillableJoinPolicy(ejp *enums.OrgJoinPolicy) *OrganizationSettingHistoryUpdateOne {
+	if ejp != nil {
+		oshuo.SetJoinPolicy(*ejp)
+	}
+	return oshuo
+}
+
 // Mutation returns the OrganizationSettingHistoryMutation object of the builder.
 func (oshuo *OrganizationSettingHistoryUpdateOne) Mutation() *OrganizationSettingHistoryMutation {
 	return oshuo.mutation
@@ -783,6 +1065,11 @@ func (oshuo *OrganizationSettingHistoryUpdateOne) check() error {
 			return &ValidationError{Name: "geo_location", err: fmt.Errorf(`generated: validator failed for field "OrganizationSettingHistory.geo_location": %w`, err)}
 		}
 	}
+	if v, ok := oshuo.mutation.JoinPolicy(); ok {
+		if err := organizationsettinghistory.JoinPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "join_policy", err: fmt.Errorf(`generated: validator failed for field "OrganizationSettingHistory.join_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -912,6 +1199,47 @@ func (oshuo *OrganizationSettingHistoryUpdateOne) sqlSave(ctx context.Context) (
 	if oshuo.mutation.OrganizationIDCleared() {
 		_spec.ClearField(organizationsettinghistory.FieldOrganizationID, field.TypeString)
 	}
+	if value, ok := oshuo.mutation.SSOEnforced(); ok {
+		_spec.SetField(organizationsettinghistory.FieldSSOEnforced, field.TypeBool, value)
+	}
+	if value, ok := oshuo.mutation.SamlMetadataURL(); ok {
+		_spec.SetField(organizationsettinghistory.FieldSamlMetadataURL, field.TypeString, value)
+	}
+	if oshuo.mutation.SamlMetadataURLCleared() {
+		_spec.ClearField(organizationsettinghistory.FieldSamlMetadataURL, field.TypeString)
+	}
+	if value, ok := oshuo.mutation.SamlMetadata(); ok {
+		_spec.SetField(organizationsettinghistory.FieldSamlMetadata, field.TypeString, value)
+	}
+	if oshuo.mutation.SamlMetadataCleared() {
+		_spec.ClearField(organizationsettinghistory.FieldSamlMetadata, field.TypeString)
+	}
+	if value, ok := oshuo.mutation.SamlAttributeMapping(); ok {
+		_spec.SetField(organizationsettinghistory.FieldSamlAttributeMapping, field.TypeJSON, value)
+	}
+	if oshuo.mutation.SamlAttributeMappingCleared() {
+		_spec.ClearField(organizationsettinghistory.FieldSamlAttributeMapping, field.TypeJSON)
+	}
+	if value, ok := oshuo.mutation.DomainVerificationToken(); ok {
+		_spec.SetField(organizationsettinghistory.FieldDomainVerificationToken, field.TypeString, value)
+	}
+	if oshuo.mutation.DomainVerificationTokenCleared() {
+		_spec.ClearField(organizationsettinghistory.FieldDomainVerificationToken, field.TypeString)
+	}
+	if value, ok := oshuo.mutation.VerifiedDomains(); ok {
+		_spec.SetField(organizationsettinghistory.FieldVerifiedDomains, field.TypeJSON, value)
+	}
+	if value, ok := oshuo.mutation.AppendedVerifiedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, organizationsettinghistory.FieldVerifiedDomains, value)
+		})
+	}
+	if oshuo.mutation.VerifiedDomainsCleared() {
+		_spec.ClearField(organizationsettinghistory.FieldVerifiedDomains, field.TypeJSON)
+	}
+	if value, ok := oshuo.mutation.JoinPolicy(); ok {
+		_spec.SetField(organizationsettinghistory.FieldJoinPolicy, field.TypeEnum, value)
+	}
 	_spec.Node.Schema = oshuo.schemaConfig.OrganizationSettingHistory
 	ctx = internal.NewSchemaConfigContext(ctx, oshuo.schemaConfig)
 	_node = &OrganizationSettingHistory{config: oshuo.config}
@@ -40,6 +40,12 @@ type OrgMembership struct {
 	OrganizationID string `json:"organization_id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID string `json:"user_id,omitempty"`
+	// the issuer of the single sign-on identity of the member, e.g. the OIDC issuer or the SAML identity provider entity id
+	SSOIssuer *string `json:"sso_issuer,omitempty"`
+	// the subject of the single sign-on identity of the member at the issuer
+	SSOSubject *string `json:"sso_subject,omitempty"`
+	// whether the user account was created by the organization when the member was provisioned, only then the organization manages the profile of the user
+	UserProvisioned bool `json:"user_provisioned,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrgMembershipQuery when eager-loading is set.
 	Edges        OrgMembershipEdges `json:"edges"`
@@ -99,7 +105,9 @@ func (*OrgMembership) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case orgmembership.FieldID, orgmembership.FieldCreatedBy, orgmembership.FieldUpdatedBy, orgmembership.FieldMappingID, orgmembership.FieldDeletedBy, orgmembership.FieldRole, orgmembership.FieldOrganizationID, orgmembership.FieldUserID:
+		case orgmembership.FieldUserProvisioned:
+			values[i] = new(sql.NullBool)
+		case orgmembership.FieldID, orgmembership.FieldCreatedBy, orgmembership.FieldUpdatedBy, orgmembership.FieldMappingID, orgmembership.FieldDeletedBy, orgmembership.FieldRole, orgmembership.FieldOrganizationID, orgmembership.FieldUserID, orgmembership.FieldSSOIssuer, orgmembership.FieldSSOSubject:
 			values[i] = new(sql.NullString)
 		case orgmembership.FieldCreatedAt, orgmembership.FieldUpdatedAt, orgmembership.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -184,6 +192,26 @@ func (om *OrgMembership) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				om.UserID = value.String
 			}
+		case orgmembership.FieldSSOIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sso_issuer", values[i])
+			} else if value.Valid {
+				om.SSOIssuer = new(string)
+				*om.SSOIssuer = value.String
+			}
+		case orgmembership.FieldSSOSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sso_subject", values[i])
+			} else if value.Valid {
+				om.SSOSubject = new(string)
+				*om.SSOSubject = value.String
+			}
+		case orgmembership.FieldUserProvisioned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field user_provisioned", values[i])
+			} else if value.Valid {
+				om.UserProvisioned = value.Bool
+			}
 		default:
 			om.selectValues.Set(columns[i], values[i])
 		}
@@ -264,6 +292,19 @@ func (om *OrgMembership) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(om.UserID)
+	builder.WriteString(", ")
+	if v := om.SSOIssuer; v != nil {
+		builder.WriteString("sso_issuer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := om.SSOSubject; v != nil {
+		builder.WriteString("sso_subject=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_provisioned=")
+	builder.WriteString(fmt.Sprintf("%v", om.UserProvisioned))
 	builder.WriteByte(')')
 	return builder.String()
 }
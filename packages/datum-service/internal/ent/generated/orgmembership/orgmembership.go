@@ -38,6 +38,12 @@ const (
 	FieldOrganizationID = "organization_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldSSOIssuer holds the string denoting the sso_issuer field in the database.
+	FieldSSOIssuer = "sso_issuer"
+	// FieldSSOSubject holds the string denoting the sso_subject field in the database.
+	FieldSSOSubject = "sso_subject"
+	// FieldUserProvisioned holds the string denoting the user_provisioned field in the database.
+	FieldUserProvisioned = "user_provisioned"
 	// EdgeOrganization holds the string denoting the organization edge name in mutations.
 	EdgeOrganization = "organization"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -80,6 +86,9 @@ var Columns = []string{
 	FieldRole,
 	FieldOrganizationID,
 	FieldUserID,
+	FieldSSOIssuer,
+	FieldSSOSubject,
+	FieldUserProvisioned,
 }
 
 var (
@@ -115,6 +124,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultMappingID holds the default value on creation for the "mapping_id" field.
 	DefaultMappingID func() string
+	// DefaultUserProvisioned holds the default value on creation for the "user_provisioned" field.
+	DefaultUserProvisioned bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -189,6 +200,21 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// BySSOIssuer orders the results by the sso_issuer field.
+func BySSOIssuer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSSOIssuer, opts...).ToFunc()
+}
+
+// BySSOSubject orders the results by the sso_subject field.
+func BySSOSubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSSOSubject, opts...).ToFunc()
+}
+
+// ByUserProvisioned orders the results by the user_provisioned field.
+func ByUserProvisioned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserProvisioned, opts...).ToFunc()
+}
+
 // ByOrganizationField orders the results by organization field.
 func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -113,6 +113,21 @@ func UserID(v string) predicate.OrgMembership {
 	return predicate.OrgMembership(sql.FieldEQ(FieldUserID, v))
 }
 
+// SSOIssuer applies equality check predicate on the "sso_issuer" field. It's identical to SSOIssuerEQ.
+func SSOIssuer(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldEQ(FieldSSOIssuer, v))
+}
+
+// SSOSubject applies equality check predicate on the "sso_subject" field. It's identical to SSOSubjectEQ.
+func SSOSubject(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldEQ(FieldSSOSubject, v))
+}
+
+// UserProvisioned applies equality check predicate on the "user_provisioned" field. It's identical to UserProvisionedEQ.
+func UserProvisioned(v bool) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldEQ(FieldUserProvisioned, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.OrgMembership {
 	return predicate.OrgMembership(sql.FieldEQ(FieldCreatedAt, v))
@@ -713,6 +728,166 @@ func UserIDContainsFold(v string) predicate.OrgMembership {
 	return predicate.OrgMembership(sql.FieldContainsFold(FieldUserID, v))
 }
 
+// SSOIssuerEQ applies the EQ predicate on the "sso_issuer" field.
+func SSOIssuerEQ(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldEQ(FieldSSOIssuer, v))
+}
+
+// SSOIssuerNEQ applies the NEQ predicate on the "sso_issuer" field.
+func SSOIssuerNEQ(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldNEQ(FieldSSOIssuer, v))
+}
+
+// SSOIssuerIn applies the In predicate on the "sso_issuer" field.
+func SSOIssuerIn(vs ...string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldIn(FieldSSOIssuer, vs...))
+}
+
+// SSOIssuerNotIn applies the NotIn predicate on the "sso_issuer" field.
+func SSOIssuerNotIn(vs ...string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldNotIn(FieldSSOIssuer, vs...))
+}
+
+// SSOIssuerGT applies the GT predicate on the "sso_issuer" field.
+func SSOIssuerGT(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldGT(FieldSSOIssuer, v))
+}
+
+// SSOIssuerGTE applies the GTE predicate on the "sso_issuer" field.
+func SSOIssuerGTE(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldGTE(FieldSSOIssuer, v))
+}
+
+// SSOIssuerLT applies the LT predicate on the "sso_issuer" field.
+func SSOIssuerLT(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldLT(FieldSSOIssuer, v))
+}
+
+// SSOIssuerLTE applies the LTE predicate on the "sso_issuer" field.
+func SSOIssuerLTE(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldLTE(FieldSSOIssuer, v))
+}
+
+// SSOIssuerContains applies the Contains predicate on the "sso_issuer" field.
+func SSOIssuerContains(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldContains(FieldSSOIssuer, v))
+}
+
+// SSOIssuerHasPrefix applies the HasPrefix predicate on the "sso_issuer" field.
+func SSOIssuerHasPrefix(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldHasPrefix(FieldSSOIssuer, v))
+}
+
+// SSOIssuerHasSuffix applies the HasSuffix predicate on the "sso_issuer" field.
+func SSOIssuerHasSuffix(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldHasSuffix(FieldSSOIssuer, v))
+}
+
+// SSOIssuerIsNil applies the IsNil predicate on the "sso_issuer" field.
+func SSOIssuerIsNil() predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldIsNull(FieldSSOIssuer))
+}
+
+// SSOIssuerNotNil applies the NotNil predicate on the "sso_issuer" field.
+func SSOIssuerNotNil() predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldNotNull(FieldSSOIssuer))
+}
+
+// SSOIssuerEqualFold applies the EqualFold predicate on the "sso_issuer" field.
+func SSOIssuerEqualFold(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldEqualFold(FieldSSOIssuer, v))
+}
+
+// SSOIssuerContainsFold applies the ContainsFold predicate on the "sso_issuer" field.
+func SSOIssuerContainsFold(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldContainsFold(FieldSSOIssuer, v))
+}
+
+// SSOSubjectEQ applies the EQ predicate on the "sso_subject" field.
+func SSOSubjectEQ(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldEQ(FieldSSOSubject, v))
+}
+
+// SSOSubjectNEQ applies the NEQ predicate on the "sso_subject" field.
+func SSOSubjectNEQ(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldNEQ(FieldSSOSubject, v))
+}
+
+// SSOSubjectIn applies the In predicate on the "sso_subject" field.
+func SSOSubjectIn(vs ...string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldIn(FieldSSOSubject, vs...))
+}
+
+// SSOSubjectNotIn applies the NotIn predicate on the "sso_subject" field.
+func SSOSubjectNotIn(vs ...string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldNotIn(FieldSSOSubject, vs...))
+}
+
+// SSOSubjectGT applies the GT predicate on the "sso_subject" field.
+func SSOSubjectGT(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldGT(FieldSSOSubject, v))
+}
+
+// SSOSubjectGTE applies the GTE predicate on the "sso_subject" field.
+func SSOSubjectGTE(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldGTE(FieldSSOSubject, v))
+}
+
+// SSOSubjectLT applies the LT predicate on the "sso_subject" field.
+func SSOSubjectLT(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldLT(FieldSSOSubject, v))
+}
+
+// SSOSubjectLTE applies the LTE predicate on the "sso_subject" field.
+func SSOSubjectLTE(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldLTE(FieldSSOSubject, v))
+}
+
+// SSOSubjectContains applies the Contains predicate on the "sso_subject" field.
+func SSOSubjectContains(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldContains(FieldSSOSubject, v))
+}
+
+// SSOSubjectHasPrefix applies the HasPrefix predicate on the "sso_subject" field.
+func SSOSubjectHasPrefix(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldHasPrefix(FieldSSOSubject, v))
+}
+
+// SSOSubjectHasSuffix applies the HasSuffix predicate on the "sso_subject" field.
+func SSOSubjectHasSuffix(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldHasSuffix(FieldSSOSubject, v))
+}
+
+// SSOSubjectIsNil applies the IsNil predicate on the "sso_subject" field.
+func SSOSubjectIsNil() predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldIsNull(FieldSSOSubject))
+}
+
+// SSOSubjectNotNil applies the NotNil predicate on the "sso_subject" field.
+func SSOSubjectNotNil() predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldNotNull(FieldSSOSubject))
+}
+
+// SSOSubjectEqualFold applies the EqualFold predicate on the "sso_subject" field.
+func SSOSubjectEqualFold(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldEqualFold(FieldSSOSubject, v))
+}
+
+// SSOSubjectContainsFold applies the ContainsFold predicate on the "sso_subject" field.
+func SSOSubjectContainsFold(v string) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldContainsFold(FieldSSOSubject, v))
+}
+
+// UserProvisionedEQ applies the EQ predicate on the "user_provisioned" field.
+func UserProvisionedEQ(v bool) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldEQ(FieldUserProvisioned, v))
+}
+
+// UserProvisionedNEQ applies the NEQ predicate on the "user_provisioned" field.
+func UserProvisionedNEQ(v bool) predicate.OrgMembership {
+	return predicate.OrgMembership(sql.FieldNEQ(FieldUserProvisioned, v))
+}
+
 // HasOrganization applies the HasEdge predicate on the "organization" edge.
 func HasOrganization() predicate.OrgMembership {
 	return predicate.OrgMembership(func(s *sql.Selector) {
@@ -148,6 +148,48 @@ func (omc *OrgMembershipCreate) SetUserID(s string) *OrgMembershipCreate {
 	return omc
 }
 
+// SetSSOIssuer sets the "sso_issuer" field.
+func (omc *OrgMembershipCreate) SetSSOIssuer(s string) *OrgMembershipCreate {
+	omc.mutation.SetSSOIssuer(s)
+	return omc
+}
+
+// SetNillableSSOIssuer sets the "sso_issuer" field if the given value is not nil.
+func (omc *OrgMembershipCreate) SetNillableSSOIssuer(s *string) *OrgMembershipCreate {
+	if s != nil {
+		omc.SetSSOIssuer(*s)
+	}
+	return omc
+}
+
+// SetSSOSubject sets the "sso_subject" field.
+func (omc *OrgMembershipCreate) SetSSOSubject(s string) *OrgMembershipCreate {
+	omc.mutation.SetSSOSubject(s)
+	return omc
+}
+
+// SetNillableSSOSubject sets the "sso_subject" field if the given value is not nil.
+func (omc *OrgMembershipCreate) SetNillableSSOSubject(s *string) *OrgMembershipCreate {
+	if s != nil {
+		omc.SetSSOSubject(*s)
+	}
+	return omc
+}
+
+// SetUserProvisioned sets the "user_provisioned" field.
+func (omc *OrgMembershipCreate) SetUserProvisioned(b bool) *OrgMembershipCreate {
+	omc.mutation.SetUserProvisioned(b)
+	return omc
+}
+
+// SetNillableUserProvisioned sets the "user_provisioned" field if the given value is not nil.
+func (omc *OrgMembershipCreate) SetNillableUserProvisioned(b *bool) *OrgMembershipCreate {
+	if b != nil {
+		omc.SetUserProvisioned(*b)
+	}
+	return omc
+}
+
 // SetID sets the "id" field.
 func (omc *OrgMembershipCreate) SetID(s string) *OrgMembershipCreate {
 	omc.mutation.SetID(s)
@@ -249,6 +291,10 @@ func (omc *OrgMembershipCreate) defaults() error {
 		v := orgmembership.DefaultRole
 		omc.mutation.SetRole(v)
 	}
+	if _, ok := omc.mutation.UserProvisioned(); !ok {
+		v := orgmembership.DefaultUserProvisioned
+		omc.mutation.SetUserProvisioned(v)
+	}
 	if _, ok := omc.mutation.ID(); !ok {
 		if orgmembership.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized orgmembership.DefaultID (forgotten import generated/runtime?)")
@@ -278,6 +324,9 @@ func (omc *OrgMembershipCreate) check() error {
 	if _, ok := omc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "OrgMembership.user_id"`)}
 	}
+	if _, ok := omc.mutation.UserProvisioned(); !ok {
+		return &ValidationError{Name: "user_provisioned", err: errors.New(`generated: missing required field "OrgMembership.user_provisioned"`)}
+	}
 	if len(omc.mutation.OrganizationIDs()) == 0 {
 		return &ValidationError{Name: "organization", err: errors.New(`generated: missing required edge "OrgMembership.organization"`)}
 	}
@@ -352,6 +401,18 @@ func (omc *OrgMembershipCreate) createSpec() (*OrgMembership, *sqlgraph.CreateSp
 		_spec.SetField(orgmembership.FieldRole, field.TypeEnum, value)
 		_node.Role = value
 	}
+	if value, ok := omc.mutation.SSOIssuer(); ok {
+		_spec.SetField(orgmembership.FieldSSOIssuer, field.TypeString, value)
+		_node.SSOIssuer = &value
+	}
+	if value, ok := omc.mutation.SSOSubject(); ok {
+		_spec.SetField(orgmembership.FieldSSOSubject, field.TypeString, value)
+		_node.SSOSubject = &value
+	}
+	if value, ok := omc.mutation.UserProvisioned(); ok {
+		_spec.SetField(orgmembership.FieldUserProvisioned, field.TypeBool, value)
+		_node.UserProvisioned = value
+	}
 	if nodes := omc.mutation.OrganizationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -118,6 +118,46 @@ func (omu *OrgMembershipUpdate) SetNillableRole(e *enums.Role) *OrgMembershipUpd
 	return omu
 }
 
+// SetSSOIssuer sets the "sso_issuer" field.
+func (omu *OrgMembershipUpdate) SetSSOIssuer(s string) *OrgMembershipUpdate {
+	omu.mutation.SetSSOIssuer(s)
+	return omu
+}
+
+// SetNillableSSOIssuer sets the "sso_issuer" field if the given value is not nil.
+func (omu *OrgMembershipUpdate) SetNillableSSOIssuer(s *string) *OrgMembershipUpdate {
+	if s != nil {
+		omu.SetSSOIssuer(*s)
+	}
+	return omu
+}
+
+// ClearSSOIssuer clears the value of the "sso_issuer" field.
+func (omu *OrgMembershipUpdate) ClearSSOIssuer() *OrgMembershipUpdate {
+	omu.mutation.ClearSSOIssuer()
+	return omu
+}
+
+// SetSSOSubject sets the "sso_subject" field.
+func (omu *OrgMembershipUpdate) SetSSOSubject(s string) *OrgMembershipUpdate {
+	omu.mutation.SetSSOSubject(s)
+	return omu
+}
+
+// SetNillableSSOSubject sets the "sso_subject" field if the given value is not nil.
+func (omu *OrgMembershipUpdate) SetNillableSSOSubject(s *string) *OrgMembershipUpdate {
+	if s != nil {
+		omu.SetSSOSubject(*s)
+	}
+	return omu
+}
+
+// ClearSSOSubject clears the value of the "sso_subject" field.
+func (omu *OrgMembershipUpdate) ClearSSOSubject() *OrgMembershipUpdate {
+	omu.mutation.ClearSSOSubject()
+	return omu
+}
+
 // AddEventIDs adds the "events" edge to the Event entity by IDs.
 func (omu *OrgMembershipUpdate) AddEventIDs(ids ...string) *OrgMembershipUpdate {
 	omu.mutation.AddEventIDs(ids...)
@@ -262,6 +302,18 @@ func (omu *OrgMembershipUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := omu.mutation.Role(); ok {
 		_spec.SetField(orgmembership.FieldRole, field.TypeEnum, value)
 	}
+	if value, ok := omu.mutation.SSOIssuer(); ok {
+		_spec.SetField(orgmembership.FieldSSOIssuer, field.TypeString, value)
+	}
+	if omu.mutation.SSOIssuerCleared() {
+		_spec.ClearField(orgmembership.FieldSSOIssuer, field.TypeString)
+	}
+	if value, ok := omu.mutation.SSOSubject(); ok {
+		_spec.SetField(orgmembership.FieldSSOSubject, field.TypeString, value)
+	}
+	if omu.mutation.SSOSubjectCleared() {
+		_spec.ClearField(orgmembership.FieldSSOSubject, field.TypeString)
+	}
 	if omu.mutation.EventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -418,6 +470,46 @@ func (omuo *OrgMembershipUpdateOne) SetNillableRole(e *enums.Role) *OrgMembershi
 	return omuo
 }
 
+// SetSSOIssuer sets the "sso_issuer" field.
+func (omuo *OrgMembershipUpdateOne) SetSSOIssuer(s string) *OrgMembershipUpdateOne {
+	omuo.mutation.SetSSOIssuer(s)
+	return omuo
+}
+
+// SetNillableSSOIssuer sets the "sso_issuer" field if the given value is not nil.
+func (omuo *OrgMembershipUpdateOne) SetNillableSSOIssuer(s *string) *OrgMembershipUpdateOne {
+	if s != nil {
+		omuo.SetSSOIssuer(*s)
+	}
+	return omuo
+}
+
+// ClearSSOIssuer clears the value of the "sso_issuer" field.
+func (omuo *OrgMembershipUpdateOne) ClearSSOIssuer() *OrgMembershipUpdateOne {
+	omuo.mutation.ClearSSOIssuer()
+	return omuo
+}
+
+// SetSSOSubject sets the "sso_subject" field.
+func (omuo *OrgMembershipUpdateOne) SetSSOSubject(s string) *OrgMembershipUpdateOne {
+	omuo.mutation.SetSSOSubject(s)
+	return omuo
+}
+
+// SetNillableSSOSubject sets the "sso_subject" field if the given value is not nil.
+func (omuo *OrgMembershipUpdateOne) SetNillableSSOSubject(s *string) *OrgMembershipUpdateOne {
+	if s != nil {
+		omuo.SetSSOSubject(*s)
+	}
+	return omuo
+}
+
+// ClearSSOSubject clears the value of the "sso_subject" field.
+func (omuo *OrgMembershipUpdateOne) ClearSSOSubject() *OrgMembershipUpdateOne {
+	omuo.mutation.ClearSSOSubject()
+	return omuo
+}
+
 // AddEventIDs adds the "events" edge to the Event entity by IDs.
 func (omuo *OrgMembershipUpdateOne) AddEventIDs(ids ...string) *OrgMembershipUpdateOne {
 	omuo.mutation.AddEventIDs(ids...)
@@ -592,6 +684,18 @@ func (omuo *OrgMembershipUpdateOne) sqlSave(ctx context.Context) (_node *OrgMemb
 	if value, ok := omuo.mutation.Role(); ok {
 		_spec.SetField(orgmembership.FieldRole, field.TypeEnum, value)
 	}
+	if value, ok := omuo.mutation.SSOIssuer(); ok {
+		_spec.SetField(orgmembership.FieldSSOIssuer, field.TypeString, value)
+	}
+	if omuo.mutation.SSOIssuerCleared() {
+		_spec.ClearField(orgmembership.FieldSSOIssuer, field.TypeString)
+	}
+	if value, ok := omuo.mutation.SSOSubject(); ok {
+		_spec.SetField(orgmembership.FieldSSOSubject, field.TypeString, value)
+	}
+	if omuo.mutation.SSOSubjectCleared() {
+		_spec.ClearField(orgmembership.FieldSSOSubject, field.TypeString)
+	}
 	if omuo.mutation.EventsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
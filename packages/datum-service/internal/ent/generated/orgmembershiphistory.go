@@ -44,8 +44,14 @@ type OrgMembershipHistory struct {
 	// OrganizationID holds the value of the "organization_id" field.
 	OrganizationID string `json:"organization_id,omitempty"`
 	// UserID holds the value of the "user_id" field.
-	UserID       string `json:"user_id,omitempty"`
-	selectValues sql.SelectValues
+	UserID string `json:"user_id,omitempty"`
+	// the issuer of the single sign-on identity of the member, e.g. the OIDC issuer or the SAML identity provider entity id
+	SSOIssuer *string `json:"sso_issuer,omitempty"`
+	// the subject of the single sign-on identity of the member at the issuer
+	SSOSubject *string `json:"sso_subject,omitempty"`
+	// whether the user account was created by the organization when the member was provisioned, only then the organization manages the profile of the user
+	UserProvisioned bool `json:"user_provisioned,omitempty"`
+	selectValues    sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -55,7 +61,9 @@ func (*OrgMembershipHistory) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case orgmembershiphistory.FieldOperation:
 			values[i] = new(enthistory.OpType)
-		case orgmembershiphistory.FieldID, orgmembershiphistory.FieldRef, orgmembershiphistory.FieldCreatedBy, orgmembershiphistory.FieldUpdatedBy, orgmembershiphistory.FieldMappingID, orgmembershiphistory.FieldDeletedBy, orgmembershiphistory.FieldRole, orgmembershiphistory.FieldOrganizationID, orgmembershiphistory.FieldUserID:
+		case orgmembershiphistory.FieldUserProvisioned:
+			values[i] = new(sql.NullBool)
+		case orgmembershiphistory.FieldID, orgmembershiphistory.FieldRef, orgmembershiphistory.FieldCreatedBy, orgmembershiphistory.FieldUpdatedBy, orgmembershiphistory.FieldMappingID, orgmembershiphistory.FieldDeletedBy, orgmembershiphistory.FieldRole, orgmembershiphistory.FieldOrganizationID, orgmembershiphistory.FieldUserID, orgmembershiphistory.FieldSSOIssuer, orgmembershiphistory.FieldSSOSubject:
 			values[i] = new(sql.NullString)
 		case orgmembershiphistory.FieldHistoryTime, orgmembershiphistory.FieldCreatedAt, orgmembershiphistory.FieldUpdatedAt, orgmembershiphistory.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -158,6 +166,26 @@ func (omh *OrgMembershipHistory) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				omh.UserID = value.String
 			}
+		case orgmembershiphistory.FieldSSOIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sso_issuer", values[i])
+			} else if value.Valid {
+				omh.SSOIssuer = new(string)
+				*omh.SSOIssuer = value.String
+			}
+		case orgmembershiphistory.FieldSSOSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sso_subject", values[i])
+			} else if value.Valid {
+				omh.SSOSubject = new(string)
+				*omh.SSOSubject = value.String
+			}
+		case orgmembershiphistory.FieldUserProvisioned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field user_provisioned", values[i])
+			} else if value.Valid {
+				omh.UserProvisioned = value.Bool
+			}
 		default:
 			omh.selectValues.Set(columns[i], values[i])
 		}
@@ -232,6 +260,19 @@ func (omh *OrgMembershipHistory) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(omh.UserID)
+	builder.WriteString(", ")
+	if v := omh.SSOIssuer; v != nil {
+		builder.WriteString("sso_issuer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := omh.SSOSubject; v != nil {
+		builder.WriteString("sso_subject=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("user_provisioned=")
+	builder.WriteString(fmt.Sprintf("%v", omh.UserProvisioned))
 	builder.WriteByte(')')
 	return builder.String()
 }
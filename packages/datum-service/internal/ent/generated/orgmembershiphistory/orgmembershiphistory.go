@@ -44,6 +44,12 @@ const (
 	FieldOrganizationID = "organization_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldSSOIssuer holds the string denoting the sso_issuer field in the database.
+	FieldSSOIssuer = "sso_issuer"
+	// FieldSSOSubject holds the string denoting the sso_subject field in the database.
+	FieldSSOSubject = "sso_subject"
+	// FieldUserProvisioned holds the string denoting the user_provisioned field in the database.
+	FieldUserProvisioned = "user_provisioned"
 	// Table holds the table name of the orgmembershiphistory in the database.
 	Table = "org_membership_history"
 )
@@ -64,6 +70,9 @@ var Columns = []string{
 	FieldRole,
 	FieldOrganizationID,
 	FieldUserID,
+	FieldSSOIssuer,
+	FieldSSOSubject,
+	FieldUserProvisioned,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -95,6 +104,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultMappingID holds the default value on creation for the "mapping_id" field.
 	DefaultMappingID func() string
+	// DefaultUserProvisioned holds the default value on creation for the "user_provisioned" field.
+	DefaultUserProvisioned bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -194,6 +205,21 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// BySSOIssuer orders the results by the sso_issuer field.
+func BySSOIssuer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSSOIssuer, opts...).ToFunc()
+}
+
+// BySSOSubject orders the results by the sso_subject field.
+func BySSOSubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSSOSubject, opts...).ToFunc()
+}
+
+// ByUserProvisioned orders the results by the user_provisioned field.
+func ByUserProvisioned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserProvisioned, opts...).ToFunc()
+}
+
 var (
 	// enthistory.OpType must implement graphql.Marshaler.
 	_ graphql.Marshaler = (*enthistory.OpType)(nil)
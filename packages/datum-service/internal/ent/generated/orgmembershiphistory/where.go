@@ -121,6 +121,21 @@ func UserID(v string) predicate.OrgMembershipHistory {
 	return predicate.OrgMembershipHistory(sql.FieldEQ(FieldUserID, v))
 }
 
+// SSOIssuer applies equality check predicate on the "sso_issuer" field. It's identical to SSOIssuerEQ.
+func SSOIssuer(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldEQ(FieldSSOIssuer, v))
+}
+
+// SSOSubject applies equality check predicate on the "sso_subject" field. It's identical to SSOSubjectEQ.
+func SSOSubject(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldEQ(FieldSSOSubject, v))
+}
+
+// UserProvisioned applies equality check predicate on the "user_provisioned" field. It's identical to UserProvisionedEQ.
+func UserProvisioned(v bool) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldEQ(FieldUserProvisioned, v))
+}
+
 // HistoryTimeEQ applies the EQ predicate on the "history_time" field.
 func HistoryTimeEQ(v time.Time) predicate.OrgMembershipHistory {
 	return predicate.OrgMembershipHistory(sql.FieldEQ(FieldHistoryTime, v))
@@ -856,6 +871,166 @@ func UserIDContainsFold(v string) predicate.OrgMembershipHistory {
 	return predicate.OrgMembershipHistory(sql.FieldContainsFold(FieldUserID, v))
 }
 
+// SSOIssuerEQ applies the EQ predicate on the "sso_issuer" field.
+func SSOIssuerEQ(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldEQ(FieldSSOIssuer, v))
+}
+
+// SSOIssuerNEQ applies the NEQ predicate on the "sso_issuer" field.
+func SSOIssuerNEQ(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldNEQ(FieldSSOIssuer, v))
+}
+
+// SSOIssuerIn applies the In predicate on the "sso_issuer" field.
+func SSOIssuerIn(vs ...string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldIn(FieldSSOIssuer, vs...))
+}
+
+// SSOIssuerNotIn applies the NotIn predicate on the "sso_issuer" field.
+func SSOIssuerNotIn(vs ...string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldNotIn(FieldSSOIssuer, vs...))
+}
+
+// SSOIssuerGT applies the GT predicate on the "sso_issuer" field.
+func SSOIssuerGT(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldGT(FieldSSOIssuer, v))
+}
+
+// SSOIssuerGTE applies the GTE predicate on the "sso_issuer" field.
+func SSOIssuerGTE(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldGTE(FieldSSOIssuer, v))
+}
+
+// SSOIssuerLT applies the LT predicate on the "sso_issuer" field.
+func SSOIssuerLT(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldLT(FieldSSOIssuer, v))
+}
+
+// SSOIssuerLTE applies the LTE predicate on the "sso_issuer" field.
+func SSOIssuerLTE(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldLTE(FieldSSOIssuer, v))
+}
+
+// SSOIssuerContains applies the Contains predicate on the "sso_issuer" field.
+func SSOIssuerContains(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldContains(FieldSSOIssuer, v))
+}
+
+// SSOIssuerHasPrefix applies the HasPrefix predicate on the "sso_issuer" field.
+func SSOIssuerHasPrefix(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldHasPrefix(FieldSSOIssuer, v))
+}
+
+// SSOIssuerHasSuffix applies the HasSuffix predicate on the "sso_issuer" field.
+func SSOIssuerHasSuffix(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldHasSuffix(FieldSSOIssuer, v))
+}
+
+// SSOIssuerIsNil applies the IsNil predicate on the "sso_issuer" field.
+func SSOIssuerIsNil() predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldIsNull(FieldSSOIssuer))
+}
+
+// SSOIssuerNotNil applies the NotNil predicate on the "sso_issuer" field.
+func SSOIssuerNotNil() predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldNotNull(FieldSSOIssuer))
+}
+
+// SSOIssuerEqualFold applies the EqualFold predicate on the "sso_issuer" field.
+func SSOIssuerEqualFold(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldEqualFold(FieldSSOIssuer, v))
+}
+
+// SSOIssuerContainsFold applies the ContainsFold predicate on the "sso_issuer" field.
+func SSOIssuerContainsFold(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldContainsFold(FieldSSOIssuer, v))
+}
+
+// SSOSubjectEQ applies the EQ predicate on the "sso_subject" field.
+func SSOSubjectEQ(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldEQ(FieldSSOSubject, v))
+}
+
+// SSOSubjectNEQ applies the NEQ predicate on the "sso_subject" field.
+func SSOSubjectNEQ(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldNEQ(FieldSSOSubject, v))
+}
+
+// SSOSubjectIn applies the In predicate on the "sso_subject" field.
+func SSOSubjectIn(vs ...string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldIn(FieldSSOSubject, vs...))
+}
+
+// SSOSubjectNotIn applies the NotIn predicate on the "sso_subject" field.
+func SSOSubjectNotIn(vs ...string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldNotIn(FieldSSOSubject, vs...))
+}
+
+// SSOSubjectGT applies the GT predicate on the "sso_subject" field.
+func SSOSubjectGT(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldGT(FieldSSOSubject, v))
+}
+
+// SSOSubjectGTE applies the GTE predicate on the "sso_subject" field.
+func SSOSubjectGTE(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldGTE(FieldSSOSubject, v))
+}
+
+// SSOSubjectLT applies the LT predicate on the "sso_subject" field.
+func SSOSubjectLT(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldLT(FieldSSOSubject, v))
+}
+
+// SSOSubjectLTE applies the LTE predicate on the "sso_subject" field.
+func SSOSubjectLTE(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldLTE(FieldSSOSubject, v))
+}
+
+// SSOSubjectContains applies the Contains predicate on the "sso_subject" field.
+func SSOSubjectContains(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldContains(FieldSSOSubject, v))
+}
+
+// SSOSubjectHasPrefix applies the HasPrefix predicate on the "sso_subject" field.
+func SSOSubjectHasPrefix(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldHasPrefix(FieldSSOSubject, v))
+}
+
+// SSOSubjectHasSuffix applies the HasSuffix predicate on the "sso_subject" field.
+func SSOSubjectHasSuffix(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldHasSuffix(FieldSSOSubject, v))
+}
+
+// SSOSubjectIsNil applies the IsNil predicate on the "sso_subject" field.
+func SSOSubjectIsNil() predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldIsNull(FieldSSOSubject))
+}
+
+// SSOSubjectNotNil applies the NotNil predicate on the "sso_subject" field.
+func SSOSubjectNotNil() predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldNotNull(FieldSSOSubject))
+}
+
+// SSOSubjectEqualFold applies the EqualFold predicate on the "sso_subject" field.
+func SSOSubjectEqualFold(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldEqualFold(FieldSSOSubject, v))
+}
+
+// SSOSubjectContainsFold applies the ContainsFold predicate on the "sso_subject" field.
+func SSOSubjectContainsFold(v string) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldContainsFold(FieldSSOSubject, v))
+}
+
+// UserProvisionedEQ applies the EQ predicate on the "user_provisioned" field.
+func UserProvisionedEQ(v bool) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldEQ(FieldUserProvisioned, v))
+}
+
+// UserProvisionedNEQ applies the NEQ predicate on the "user_provisioned" field.
+func UserProvisionedNEQ(v bool) predicate.OrgMembershipHistory {
+	return predicate.OrgMembershipHistory(sql.FieldNEQ(FieldUserProvisioned, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.OrgMembershipHistory) predicate.OrgMembershipHistory {
 	return predicate.OrgMembershipHistory(sql.AndPredicates(predicates...))
@@ -180,6 +180,48 @@ func (omhc *OrgMembershipHistoryCreate) SetUserID(s string) *OrgMembershipHistor
 	return omhc
 }
 
+// SetSSOIssuer sets the "sso_issuer" field.
+func (omhc *OrgMembershipHistoryCreate) SetSSOIssuer(s string) *OrgMembershipHistoryCreate {
+	omhc.mutation.SetSSOIssuer(s)
+	return omhc
+}
+
+// SetNillableSSOIssuer sets the "sso_issuer" field if the given value is not nil.
+func (omhc *OrgMembershipHistoryCreate) SetNillableSSOIssuer(s *string) *OrgMembershipHistoryCreate {
+	if s != nil {
+		omhc.SetSSOIssuer(*s)
+	}
+	return omhc
+}
+
+// SetSSOSubject sets the "sso_subject" field.
+func (omhc *OrgMembershipHistoryCreate) SetSSOSubject(s string) *OrgMembershipHistoryCreate {
+	omhc.mutation.SetSSOSubject(s)
+	return omhc
+}
+
+// SetNillableSSOSubject sets the "sso_subject" field if the given value is not nil.
+func (omhc *OrgMembershipHistoryCreate) SetNillableSSOSubject(s *string) *OrgMembershipHistoryCreate {
+	if s != nil {
+		omhc.SetSSOSubject(*s)
+	}
+	return omhc
+}
+
+// SetUserProvisioned sets the "user_provisioned" field.
+func (omhc *OrgMembershipHistoryCreate) SetUserProvisioned(b bool) *OrgMembershipHistoryCreate {
+	omhc.mutation.SetUserProvisioned(b)
+	return omhc
+}
+
+// SetNillableUserProvisioned sets the "user_provisioned" field if the given value is not nil.
+func (omhc *OrgMembershipHistoryCreate) SetNillableUserProvisioned(b *bool) *OrgMembershipHistoryCreate {
+	if b != nil {
+		omhc.SetUserProvisioned(*b)
+	}
+	return omhc
+}
+
 // SetID sets the "id" field.
 func (omhc *OrgMembershipHistoryCreate) SetID(s string) *OrgMembershipHistoryCreate {
 	omhc.mutation.SetID(s)
@@ -263,6 +305,10 @@ func (omhc *OrgMembershipHistoryCreate) defaults() error {
 		v := orgmembershiphistory.DefaultRole
 		omhc.mutation.SetRole(v)
 	}
+	if _, ok := omhc.mutation.UserProvisioned(); !ok {
+		v := orgmembershiphistory.DefaultUserProvisioned
+		omhc.mutation.SetUserProvisioned(v)
+	}
 	if _, ok := omhc.mutation.ID(); !ok {
 		if orgmembershiphistory.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized orgmembershiphistory.DefaultID (forgotten import generated/runtime?)")
@@ -303,6 +349,9 @@ func (omhc *OrgMembershipHistoryCreate) check() error {
 	if _, ok := omhc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`generated: missing required field "OrgMembershipHistory.user_id"`)}
 	}
+	if _, ok := omhc.mutation.UserProvisioned(); !ok {
+		return &ValidationError{Name: "user_provisioned", err: errors.New(`generated: missing required field "OrgMembershipHistory.user_provisioned"`)}
+	}
 	return nil
 }
 
@@ -391,6 +440,18 @@ func (omhc *OrgMembershipHistoryCreate) createSpec() (*OrgMembershipHistory, *sq
 		_spec.SetField(orgmembershiphistory.FieldUserID, field.TypeString, value)
 		_node.UserID = value
 	}
+	if value, ok := omhc.mutation.SSOIssuer(); ok {
+		_spec.SetField(orgmembershiphistory.FieldSSOIssuer, field.TypeString, value)
+		_node.SSOIssuer = &value
+	}
+	if value, ok := omhc.mutation.SSOSubject(); ok {
+		_spec.SetField(orgmembershiphistory.FieldSSOSubject, field.TypeString, value)
+		_node.SSOSubject = &value
+	}
+	if value, ok := omhc.mutation.UserProvisioned(); ok {
+		_spec.SetField(orgmembershiphistory.FieldUserProvisioned, field.TypeBool, value)
+		_node.UserProvisioned = value
+	}
 	return _node, _spec
 }
 
@@ -117,6 +117,46 @@ func (omhu *OrgMembershipHistoryUpdate) SetNillableRole(e *enums.Role) *OrgMembe
 	return omhu
 }
 
+// SetSSOIssuer sets the "sso_issuer" field.
+func (omhu *OrgMembershipHistoryUpdate) SetSSOIssuer(s string) *OrgMembershipHistoryUpdate {
+	omhu.mutation.SetSSOIssuer(s)
+	return omhu
+}
+
+// SetNillableSSOIssuer sets the "sso_issuer" field if the given value is not nil.
+func (omhu *OrgMembershipHistoryUpdate) SetNillableSSOIssuer(s *string) *OrgMembershipHistoryUpdate {
+	if s != nil {
+		omhu.SetSSOIssuer(*s)
+	}
+	return omhu
+}
+
+// ClearSSOIssuer clears the value of the "sso_issuer" field.
+func (omhu *OrgMembershipHistoryUpdate) ClearSSOIssuer() *OrgMembershipHistoryUpdate {
+	omhu.mutation.ClearSSOIssuer()
+	return omhu
+}
+
+// SetSSOSubject sets the "sso_subject" field.
+func (omhu *OrgMembershipHistoryUpdate) SetSSOSubject(s string) *OrgMembershipHistoryUpdate {
+	omhu.mutation.SetSSOSubject(s)
+	return omhu
+}
+
+// SetNillableSSOSubject sets the "sso_subject" field if the given value is not nil.
+func (omhu *OrgMembershipHistoryUpdate) SetNillableSSOSubject(s *string) *OrgMembershipHistoryUpdate {
+	if s != nil {
+		omhu.SetSSOSubject(*s)
+	}
+	return omhu
+}
+
+// ClearSSOSubject clears the value of the "sso_subject" field.
+func (omhu *OrgMembershipHistoryUpdate) ClearSSOSubject() *OrgMembershipHistoryUpdate {
+	omhu.mutation.ClearSSOSubject()
+	return omhu
+}
+
 // Mutation returns the OrgMembershipHistoryMutation object of the builder.
 func (omhu *OrgMembershipHistoryUpdate) Mutation() *OrgMembershipHistoryMutation {
 	return omhu.mutation
@@ -222,6 +262,18 @@ func (omhu *OrgMembershipHistoryUpdate) sqlSave(ctx context.Context) (n int, err
 	if value, ok := omhu.mutation.Role(); ok {
 		_spec.SetField(orgmembershiphistory.FieldRole, field.TypeEnum, value)
 	}
+	if value, ok := omhu.mutation.SSOIssuer(); ok {
+		_spec.SetField(orgmembershiphistory.FieldSSOIssuer, field.TypeString, value)
+	}
+	if omhu.mutation.SSOIssuerCleared() {
+		_spec.ClearField(orgmembershiphistory.FieldSSOIssuer, field.TypeString)
+	}
+	if value, ok := omhu.mutation.SSOSubject(); ok {
+		_spec.SetField(orgmembershiphistory.FieldSSOSubject, field.TypeString, value)
+	}
+	if omhu.mutation.SSOSubjectCleared() {
+		_spec.ClearField(orgmembershiphistory.FieldSSOSubject, field.TypeString)
+	}
 	_spec.Node.Schema = omhu.schemaConfig.OrgMembershipHistory
 	ctx = internal.NewSchemaConfigContext(ctx, omhu.schemaConfig)
 	if n, err = sqlgraph.UpdateNodes(ctx, omhu.driver, _spec); err != nil {
@@ -330,6 +382,46 @@ func (omhuo *OrgMembershipHistoryUpdateOne) SetNillableRole(e *enums.Role) *OrgM
 	return omhuo
 }
 
+// SetSSOIssuer sets the "sso_issuer" field.
+func (omhuo *OrgMembershipHistoryUpdateOne) SetSSOIssuer(s string) *OrgMembershipHistoryUpdateOne {
+	omhuo.mutation.SetSSOIssuer(s)
+	return omhuo
+}
+
+// SetNillableSSOIssuer sets the "sso_issuer" field if the given value is not nil.
+func (omhuo *OrgMembershipHistoryUpdateOne) SetNillableSSOIssuer(s *string) *OrgMembershipHistoryUpdateOne {
+	if s != nil {
+		omhuo.SetSSOIssuer(*s)
+	}
+	return omhuo
+}
+
+// ClearSSOIssuer clears the value of the "sso_issuer" field.
+func (omhuo *OrgMembershipHistoryUpdateOne) ClearSSOIssuer() *OrgMembershipHistoryUpdateOne {
+	omhuo.mutation.ClearSSOIssuer()
+	return omhuo
+}
+
+// SetSSOSubject sets the "sso_subject" field.
+func (omhuo *OrgMembershipHistoryUpdateOne) SetSSOSubject(s string) *OrgMembershipHistoryUpdateOne {
+	omhuo.mutation.SetSSOSubject(s)
+	return omhuo
+}
+
+// SetNillableSSOSubject sets the "sso_subject" field if the given value is not nil.
+func (omhuo *OrgMembershipHistoryUpdateOne) SetNillableSSOSubject(s *string) *OrgMembershipHistoryUpdateOne {
+	if s != nil {
+		omhuo.SetSSOSubject(*s)
+	}
+	return omhuo
+}
+
+// ClearSSOSubject clears the value of the "sso_subject" field.
+func (omhuo *OrgMembershipHistoryUpdateOne) ClearSSOSubject() *OrgMembershipHistoryUpdateOne {
+	omhuo.mutation.ClearSSOSubject()
+	return omhuo
+}
+
 // Mutation returns the OrgMembershipHistoryMutation object of the builder.
 func (omhuo *OrgMembershipHistoryUpdateOne) Mutation() *OrgMembershipHistoryMutation {
 	return omhuo.mutation
@@ -465,6 +557,18 @@ func (omhuo *OrgMembershipHistoryUpdateOne) sqlSave(ctx context.Context) (_node
 	if value, ok := omhuo.mutation.Role(); ok {
 		_spec.SetField(orgmembershiphistory.FieldRole, field.TypeEnum, value)
 	}
+	if value, ok := omhuo.mutation.SSOIssuer(); ok {
+		_spec.SetField(orgmembershiphistory.FieldSSOIssuer, field.TypeString, value)
+	}
+	if omhuo.mutation.SSOIssuerCleared() {
+		_spec.ClearField(orgmembershiphistory.FieldSSOIssuer, field.TypeString)
+	}
+	if value, ok := omhuo.mutation.SSOSubject(); ok {
+		_spec.SetField(orgmembershiphistory.FieldSSOSubject, field.TypeString, value)
+	}
+	if omhuo.mutation.SSOSubjectCleared() {
+		_spec.ClearField(orgmembershiphistory.FieldSSOSubject, field.TypeString)
+	}
 	_spec.Node.Schema = omhuo.schemaConfig.OrgMembershipHistory
 	ctx = internal.NewSchemaConfigContext(ctx, omhuo.schemaConfig)
 	_node = &OrgMembershipHistory{config: omhuo.config}
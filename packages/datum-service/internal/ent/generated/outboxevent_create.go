@@ -0,0 +1,538 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/outboxevent"
+)
+
+// OutboxEventCreate is the builder for creating a OutboxEvent entity.
+type OutboxEventCreate struct {
+	config
+	mutation *OutboxEventMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oec *OutboxEventCreate) SetCreatedAt(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetCreatedAt(t)
+	return oec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableCreatedAt(t *time.Time) *OutboxEventCreate {
+	if t != nil {
+		oec.SetCreatedAt(*t)
+	}
+	return oec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oec *OutboxEventCreate) SetUpdatedAt(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetUpdatedAt(t)
+	return oec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableUpdatedAt(t *time.Time) *OutboxEventCreate {
+	if t != nil {
+		oec.SetUpdatedAt(*t)
+	}
+	return oec
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (oec *OutboxEventCreate) SetCreatedBy(s string) *OutboxEventCreate {
+	oec.mutation.SetCreatedBy(s)
+	return oec
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableCreatedBy(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetCreatedBy(*s)
+	}
+	return oec
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (oec *OutboxEventCreate) SetUpdatedBy(s string) *OutboxEventCreate {
+	oec.mutation.SetUpdatedBy(s)
+	return oec
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableUpdatedBy(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetUpdatedBy(*s)
+	}
+	return oec
+}
+
+// SetMappingID sets the "mapping_id" field.
+func (oec *OutboxEventCreate) SetMappingID(s string) *OutboxEventCreate {
+	oec.mutation.SetMappingID(s)
+	return oec
+}
+
+// SetNillableMappingID sets the "mapping_id" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableMappingID(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetMappingID(*s)
+	}
+	return oec
+}
+
+// SetTopic sets the "topic" field.
+func (oec *OutboxEventCreate) SetTopic(s string) *OutboxEventCreate {
+	oec.mutation.SetTopic(s)
+	return oec
+}
+
+// SetAggregateType sets the "aggregate_type" field.
+func (oec *OutboxEventCreate) SetAggregateType(s string) *OutboxEventCreate {
+	oec.mutation.SetAggregateType(s)
+	return oec
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (oec *OutboxEventCreate) SetAggregateID(s string) *OutboxEventCreate {
+	oec.mutation.SetAggregateID(s)
+	return oec
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (oec *OutboxEventCreate) SetOrganizationID(s string) *OutboxEventCreate {
+	oec.mutation.SetOrganizationID(s)
+	return oec
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableOrganizationID(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetOrganizationID(*s)
+	}
+	return oec
+}
+
+// SetPayload sets the "payload" field.
+func (oec *OutboxEventCreate) SetPayload(m map[string]interface{}) *OutboxEventCreate {
+	oec.mutation.SetPayload(m)
+	return oec
+}
+
+// SetAttempts sets the "attempts" field.
+func (oec *OutboxEventCreate) SetAttempts(i int) *OutboxEventCreate {
+	oec.mutation.SetAttempts(i)
+	return oec
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableAttempts(i *int) *OutboxEventCreate {
+	if i != nil {
+		oec.SetAttempts(*i)
+	}
+	return oec
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (oec *OutboxEventCreate) SetAvailableAt(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetAvailableAt(t)
+	return oec
+}
+
+// SetNillableAvailableAt sets the "available_at" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableAvailableAt(t *time.Time) *OutboxEventCreate {
+	if t != nil {
+		oec.SetAvailableAt(*t)
+	}
+	return oec
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (oec *OutboxEventCreate) SetPublishedAt(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetPublishedAt(t)
+	return oec
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillablePublishedAt(t *time.Time) *OutboxEventCreate {
+	if t != nil {
+		oec.SetPublishedAt(*t)
+	}
+	return oec
+}
+
+// SetPublishedTo sets the "published_to" field.
+func (oec *OutboxEventCreate) SetPublishedTo(s []string) *OutboxEventCreate {
+	oec.mutation.SetPublishedTo(s)
+	return oec
+}
+
+// SetLastError sets the "last_error" field.
+func (oec *OutboxEventCreate) SetLastError(s string) *OutboxEventCreate {
+	oec.mutation.SetLastError(s)
+	return oec
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableLastError(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetLastError(*s)
+	}
+	return oec
+}
+
+// SetClaimID sets the "claim_id" field.
+func (oec *OutboxEventCreate) SetClaimID(s string) *OutboxEventCreate {
+	oec.mutation.SetClaimID(s)
+	return oec
+}
+
+// SetNillableClaimID sets the "claim_id" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableClaimID(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetClaimID(*s)
+	}
+	return oec
+}
+
+// SetClaimedUntil sets the "claimed_until" field.
+func (oec *OutboxEventCreate) SetClaimedUntil(t time.Time) *OutboxEventCreate {
+	oec.mutation.SetClaimedUntil(t)
+	return oec
+}
+
+// SetNillableClaimedUntil sets the "claimed_until" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableClaimedUntil(t *time.Time) *OutboxEventCreate {
+	if t != nil {
+		oec.SetClaimedUntil(*t)
+	}
+	return oec
+}
+
+// SetID sets the "id" field.
+func (oec *OutboxEventCreate) SetID(s string) *OutboxEventCreate {
+	oec.mutation.SetID(s)
+	return oec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (oec *OutboxEventCreate) SetNillableID(s *string) *OutboxEventCreate {
+	if s != nil {
+		oec.SetID(*s)
+	}
+	return oec
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oec *OutboxEventCreate) Mutation() *OutboxEventMutation {
+	return oec.mutation
+}
+
+// Save creates the OutboxEvent in the database.
+func (oec *OutboxEventCreate) Save(ctx context.Context) (*OutboxEvent, error) {
+	if err := oec.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, oec.sqlSave, oec.mutation, oec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oec *OutboxEventCreate) SaveX(ctx context.Context) *OutboxEvent {
+	v, err := oec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oec *OutboxEventCreate) Exec(ctx context.Context) error {
+	_, err := oec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oec *OutboxEventCreate) ExecX(ctx context.Context) {
+	if err := oec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oec *OutboxEventCreate) defaults() error {
+	if _, ok := oec.mutation.CreatedAt(); !ok {
+		if outboxevent.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized outboxevent.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := outboxevent.DefaultCreatedAt()
+		oec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oec.mutation.UpdatedAt(); !ok {
+		if outboxevent.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized outboxevent.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := outboxevent.DefaultUpdatedAt()
+		oec.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := oec.mutation.MappingID(); !ok {
+		if outboxevent.DefaultMappingID == nil {
+			return fmt.Errorf("generated: uninitialized outboxevent.DefaultMappingID (forgotten import generated/runtime?)")
+		}
+		v := outboxevent.DefaultMappingID()
+		oec.mutation.SetMappingID(v)
+	}
+	if _, ok := oec.mutation.Attempts(); !ok {
+		v := outboxevent.DefaultAttempts
+		oec.mutation.SetAttempts(v)
+	}
+	if _, ok := oec.mutation.AvailableAt(); !ok {
+		if outboxevent.DefaultAvailableAt == nil {
+			return fmt.Errorf("generated: uninitialized outboxevent.DefaultAvailableAt (forgotten import generated/runtime?)")
+		}
+		v := outboxevent.DefaultAvailableAt()
+		oec.mutation.SetAvailableAt(v)
+	}
+	if _, ok := oec.mutation.ID(); !ok {
+		if outboxevent.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized outboxevent.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := outboxevent.DefaultID()
+		oec.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oec *OutboxEventCreate) check() error {
+	if _, ok := oec.mutation.MappingID(); !ok {
+		return &ValidationError{Name: "mapping_id", err: errors.New(`generated: missing required field "OutboxEvent.mapping_id"`)}
+	}
+	if _, ok := oec.mutation.Topic(); !ok {
+		return &ValidationError{Name: "topic", err: errors.New(`generated: missing required field "OutboxEvent.topic"`)}
+	}
+	if v, ok := oec.mutation.Topic(); ok {
+		if err := outboxevent.TopicValidator(v); err != nil {
+			return &ValidationError{Name: "topic", err: fmt.Errorf(`generated: validator failed for field "OutboxEvent.topic": %w`, err)}
+		}
+	}
+	if _, ok := oec.mutation.AggregateType(); !ok {
+		return &ValidationError{Name: "aggregate_type", err: errors.New(`generated: missing required field "OutboxEvent.aggregate_type"`)}
+	}
+	if v, ok := oec.mutation.AggregateType(); ok {
+		if err := outboxevent.AggregateTypeValidator(v); err != nil {
+			return &ValidationError{Name: "aggregate_type", err: fmt.Errorf(`generated: validator failed for field "OutboxEvent.aggregate_type": %w`, err)}
+		}
+	}
+	if _, ok := oec.mutation.AggregateID(); !ok {
+		return &ValidationError{Name: "aggregate_id", err: errors.New(`generated: missing required field "OutboxEvent.aggregate_id"`)}
+	}
+	if v, ok := oec.mutation.AggregateID(); ok {
+		if err := outboxevent.AggregateIDValidator(v); err != nil {
+			return &ValidationError{Name: "aggregate_id", err: fmt.Errorf(`generated: validator failed for field "OutboxEvent.aggregate_id": %w`, err)}
+		}
+	}
+	if _, ok := oec.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`generated: missing required field "OutboxEvent.attempts"`)}
+	}
+	if _, ok := oec.mutation.AvailableAt(); !ok {
+		return &ValidationError{Name: "available_at", err: errors.New(`generated: missing required field "OutboxEvent.available_at"`)}
+	}
+	return nil
+}
+
+func (oec *OutboxEventCreate) sqlSave(ctx context.Context) (*OutboxEvent, error) {
+	if err := oec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected OutboxEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	oec.mutation.id = &_node.ID
+	oec.mutation.done = true
+	return _node, nil
+}
+
+func (oec *OutboxEventCreate) createSpec() (*OutboxEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OutboxEvent{config: oec.config}
+		_spec = sqlgraph.NewCreateSpec(outboxevent.Table, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeString))
+	)
+	_spec.Schema = oec.schemaConfig.OutboxEvent
+	if id, ok := oec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := oec.mutation.CreatedAt(); ok {
+		_spec.SetField(outboxevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := oec.mutation.UpdatedAt(); ok {
+		_spec.SetField(outboxevent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := oec.mutation.CreatedBy(); ok {
+		_spec.SetField(outboxevent.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := oec.mutation.UpdatedBy(); ok {
+		_spec.SetField(outboxevent.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := oec.mutation.MappingID(); ok {
+		_spec.SetField(outboxevent.FieldMappingID, field.TypeString, value)
+		_node.MappingID = value
+	}
+	if value, ok := oec.mutation.Topic(); ok {
+		_spec.SetField(outboxevent.FieldTopic, field.TypeString, value)
+		_node.Topic = value
+	}
+	if value, ok := oec.mutation.AggregateType(); ok {
+		_spec.SetField(outboxevent.FieldAggregateType, field.TypeString, value)
+		_node.AggregateType = value
+	}
+	if value, ok := oec.mutation.AggregateID(); ok {
+		_spec.SetField(outboxevent.FieldAggregateID, field.TypeString, value)
+		_node.AggregateID = value
+	}
+	if value, ok := oec.mutation.OrganizationID(); ok {
+		_spec.SetField(outboxevent.FieldOrganizationID, field.TypeString, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := oec.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := oec.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := oec.mutation.AvailableAt(); ok {
+		_spec.SetField(outboxevent.FieldAvailableAt, field.TypeTime, value)
+		_node.AvailableAt = value
+	}
+	if value, ok := oec.mutation.PublishedAt(); ok {
+		_spec.SetField(outboxevent.FieldPublishedAt, field.TypeTime, value)
+		_node.PublishedAt = &value
+	}
+	if value, ok := oec.mutation.PublishedTo(); ok {
+		_spec.SetField(outboxevent.FieldPublishedTo, field.TypeJSON, value)
+		_node.PublishedTo = value
+	}
+	if value, ok := oec.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := oec.mutation.ClaimID(); ok {
+		_spec.SetField(outboxevent.FieldClaimID, field.TypeString, value)
+		_node.ClaimID = &value
+	}
+	if value, ok := oec.mutation.ClaimedUntil(); ok {
+		_spec.SetField(outboxevent.FieldClaimedUntil, field.TypeTime, value)
+		_node.ClaimedUntil = &value
+	}
+	return _node, _spec
+}
+
+// OutboxEventCreateBulk is the builder for creating many OutboxEvent entities in bulk.
+type OutboxEventCreateBulk struct {
+	config
+	err      error
+	builders []*OutboxEventCreate
+}
+
+// Save creates the OutboxEvent entities in the database.
+func (oecb *OutboxEventCreateBulk) Save(ctx context.Context) ([]*OutboxEvent, error) {
+	if oecb.err != nil {
+		return nil, oecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(oecb.builders))
+	nodes := make([]*OutboxEvent, len(oecb.builders))
+	mutators := make([]Mutator, len(oecb.builders))
+	for i := range oecb.builders {
+		func(i int, root context.Context) {
+			builder := oecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboxEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, oecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, oecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, oecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oecb *OutboxEventCreateBulk) SaveX(ctx context.Context) []*OutboxEvent {
+	v, err := oecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oecb *OutboxEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := oecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oecb *OutboxEventCreateBulk) ExecX(ctx context.Context) {
+	if err := oecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
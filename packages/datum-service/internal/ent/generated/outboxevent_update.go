@@ -0,0 +1,679 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/outboxevent"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// OutboxEventUpdate is the builder for updating OutboxEvent entities.
+type OutboxEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxEventMutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeu *OutboxEventUpdate) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdate {
+	oeu.mutation.Where(ps...)
+	return oeu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oeu *OutboxEventUpdate) SetUpdatedAt(t time.Time) *OutboxEventUpdate {
+	oeu.mutation.SetUpdatedAt(t)
+	return oeu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (oeu *OutboxEventUpdate) ClearUpdatedAt() *OutboxEventUpdate {
+	oeu.mutation.ClearUpdatedAt()
+	return oeu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (oeu *OutboxEventUpdate) SetUpdatedBy(s string) *OutboxEventUpdate {
+	oeu.mutation.SetUpdatedBy(s)
+	return oeu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableUpdatedBy(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetUpdatedBy(*s)
+	}
+	return oeu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (oeu *OutboxEventUpdate) ClearUpdatedBy() *OutboxEventUpdate {
+	oeu.mutation.ClearUpdatedBy()
+	return oeu
+}
+
+// SetAttempts sets the "attempts" field.
+func (oeu *OutboxEventUpdate) SetAttempts(i int) *OutboxEventUpdate {
+	oeu.mutation.ResetAttempts()
+	oeu.mutation.SetAttempts(i)
+	return oeu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableAttempts(i *int) *OutboxEventUpdate {
+	if i != nil {
+		oeu.SetAttempts(*i)
+	}
+	return oeu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (oeu *OutboxEventUpdate) AddAttempts(i int) *OutboxEventUpdate {
+	oeu.mutation.AddAttempts(i)
+	return oeu
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (oeu *OutboxEventUpdate) SetAvailableAt(t time.Time) *OutboxEventUpdate {
+	oeu.mutation.SetAvailableAt(t)
+	return oeu
+}
+
+// SetNillableAvailableAt sets the "available_at" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableAvailableAt(t *time.Time) *OutboxEventUpdate {
+	if t != nil {
+		oeu.SetAvailableAt(*t)
+	}
+	return oeu
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (oeu *OutboxEventUpdate) SetPublishedAt(t time.Time) *OutboxEventUpdate {
+	oeu.mutation.SetPublishedAt(t)
+	return oeu
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillablePublishedAt(t *time.Time) *OutboxEventUpdate {
+	if t != nil {
+		oeu.SetPublishedAt(*t)
+	}
+	return oeu
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (oeu *OutboxEventUpdate) ClearPublishedAt() *OutboxEventUpdate {
+	oeu.mutation.ClearPublishedAt()
+	return oeu
+}
+
+// SetPublishedTo sets the "published_to" field.
+func (oeu *OutboxEventUpdate) SetPublishedTo(s []string) *OutboxEventUpdate {
+	oeu.mutation.SetPublishedTo(s)
+	return oeu
+}
+
+// AppendPublishedTo appends s to the "published_to" field.
+func (oeu *OutboxEventUpdate) AppendPublishedTo(s []string) *OutboxEventUpdate {
+	oeu.mutation.AppendPublishedTo(s)
+	return oeu
+}
+
+// ClearPublishedTo clears the value of the "published_to" field.
+func (oeu *OutboxEventUpdate) ClearPublishedTo() *OutboxEventUpdate {
+	oeu.mutation.ClearPublishedTo()
+	return oeu
+}
+
+// SetLastError sets the "last_error" field.
+func (oeu *OutboxEventUpdate) SetLastError(s string) *OutboxEventUpdate {
+	oeu.mutation.SetLastError(s)
+	return oeu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableLastError(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetLastError(*s)
+	}
+	return oeu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (oeu *OutboxEventUpdate) ClearLastError() *OutboxEventUpdate {
+	oeu.mutation.ClearLastError()
+	return oeu
+}
+
+// SetClaimID sets the "claim_id" field.
+func (oeu *OutboxEventUpdate) SetClaimID(s string) *OutboxEventUpdate {
+	oeu.mutation.SetClaimID(s)
+	return oeu
+}
+
+// SetNillableClaimID sets the "claim_id" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableClaimID(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetClaimID(*s)
+	}
+	return oeu
+}
+
+// ClearClaimID clears the value of the "claim_id" field.
+func (oeu *OutboxEventUpdate) ClearClaimID() *OutboxEventUpdate {
+	oeu.mutation.ClearClaimID()
+	return oeu
+}
+
+// SetClaimedUntil sets the "claimed_until" field.
+func (oeu *OutboxEventUpdate) SetClaimedUntil(t time.Time) *OutboxEventUpdate {
+	oeu.mutation.SetClaimedUntil(t)
+	return oeu
+}
+
+// SetNillableClaimedUntil sets the "claimed_until" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableClaimedUntil(t *time.Time) *OutboxEventUpdate {
+	if t != nil {
+		oeu.SetClaimedUntil(*t)
+	}
+	return oeu
+}
+
+// ClearClaimedUntil clears the value of the "claimed_until" field.
+func (oeu *OutboxEventUpdate) ClearClaimedUntil() *OutboxEventUpdate {
+	oeu.mutation.ClearClaimedUntil()
+	return oeu
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeu *OutboxEventUpdate) Mutation() *OutboxEventMutation {
+	return oeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oeu *OutboxEventUpdate) Save(ctx context.Context) (int, error) {
+	if err := oeu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, oeu.sqlSave, oeu.mutation, oeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := oeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oeu *OutboxEventUpdate) Exec(ctx context.Context) error {
+	_, err := oeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) ExecX(ctx context.Context) {
+	if err := oeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oeu *OutboxEventUpdate) defaults() error {
+	if _, ok := oeu.mutation.UpdatedAt(); !ok && !oeu.mutation.UpdatedAtCleared() {
+		if outboxevent.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized outboxevent.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := outboxevent.UpdateDefaultUpdatedAt()
+		oeu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (oeu *OutboxEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeString))
+	if ps := oeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if oeu.mutation.CreatedAtCleared() {
+		_spec.ClearField(outboxevent.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := oeu.mutation.UpdatedAt(); ok {
+		_spec.SetField(outboxevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if oeu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(outboxevent.FieldUpdatedAt, field.TypeTime)
+	}
+	if oeu.mutation.CreatedByCleared() {
+		_spec.ClearField(outboxevent.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := oeu.mutation.UpdatedBy(); ok {
+		_spec.SetField(outboxevent.FieldUpdatedBy, field.TypeString, value)
+	}
+	if oeu.mutation.UpdatedByCleared() {
+		_spec.ClearField(outboxevent.FieldUpdatedBy, field.TypeString)
+	}
+	if oeu.mutation.OrganizationIDCleared() {
+		_spec.ClearField(outboxevent.FieldOrganizationID, field.TypeString)
+	}
+	if oeu.mutation.PayloadCleared() {
+		_spec.ClearField(outboxevent.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := oeu.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.AvailableAt(); ok {
+		_spec.SetField(outboxevent.FieldAvailableAt, field.TypeTime, value)
+	}
+	if value, ok := oeu.mutation.PublishedAt(); ok {
+		_spec.SetField(outboxevent.FieldPublishedAt, field.TypeTime, value)
+	}
+	if oeu.mutation.PublishedAtCleared() {
+		_spec.ClearField(outboxevent.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := oeu.mutation.PublishedTo(); ok {
+		_spec.SetField(outboxevent.FieldPublishedTo, field.TypeJSON, value)
+	}
+	if value, ok := oeu.mutation.AppendedPublishedTo(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, outboxevent.FieldPublishedTo, value)
+		})
+	}
+	if oeu.mutation.PublishedToCleared() {
+		_spec.ClearField(outboxevent.FieldPublishedTo, field.TypeJSON)
+	}
+	if value, ok := oeu.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+	}
+	if oeu.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxevent.FieldLastError, field.TypeString)
+	}
+	if value, ok := oeu.mutation.ClaimID(); ok {
+		_spec.SetField(outboxevent.FieldClaimID, field.TypeString, value)
+	}
+	if oeu.mutation.ClaimIDCleared() {
+		_spec.ClearField(outboxevent.FieldClaimID, field.TypeString)
+	}
+	if value, ok := oeu.mutation.ClaimedUntil(); ok {
+		_spec.SetField(outboxevent.FieldClaimedUntil, field.TypeTime, value)
+	}
+	if oeu.mutation.ClaimedUntilCleared() {
+		_spec.ClearField(outboxevent.FieldClaimedUntil, field.TypeTime)
+	}
+	_spec.Node.Schema = oeu.schemaConfig.OutboxEvent
+	ctx = internal.NewSchemaConfigContext(ctx, oeu.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, oeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oeu.mutation.done = true
+	return n, nil
+}
+
+// OutboxEventUpdateOne is the builder for updating a single OutboxEvent entity.
+type OutboxEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxEventMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (oeuo *OutboxEventUpdateOne) SetUpdatedAt(t time.Time) *OutboxEventUpdateOne {
+	oeuo.mutation.SetUpdatedAt(t)
+	return oeuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (oeuo *OutboxEventUpdateOne) ClearUpdatedAt() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearUpdatedAt()
+	return oeuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (oeuo *OutboxEventUpdateOne) SetUpdatedBy(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetUpdatedBy(s)
+	return oeuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableUpdatedBy(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetUpdatedBy(*s)
+	}
+	return oeuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (oeuo *OutboxEventUpdateOne) ClearUpdatedBy() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearUpdatedBy()
+	return oeuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (oeuo *OutboxEventUpdateOne) SetAttempts(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.ResetAttempts()
+	oeuo.mutation.SetAttempts(i)
+	return oeuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableAttempts(i *int) *OutboxEventUpdateOne {
+	if i != nil {
+		oeuo.SetAttempts(*i)
+	}
+	return oeuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (oeuo *OutboxEventUpdateOne) AddAttempts(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.AddAttempts(i)
+	return oeuo
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (oeuo *OutboxEventUpdateOne) SetAvailableAt(t time.Time) *OutboxEventUpdateOne {
+	oeuo.mutation.SetAvailableAt(t)
+	return oeuo
+}
+
+// SetNillableAvailableAt sets the "available_at" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableAvailableAt(t *time.Time) *OutboxEventUpdateOne {
+	if t != nil {
+		oeuo.SetAvailableAt(*t)
+	}
+	return oeuo
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (oeuo *OutboxEventUpdateOne) SetPublishedAt(t time.Time) *OutboxEventUpdateOne {
+	oeuo.mutation.SetPublishedAt(t)
+	return oeuo
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillablePublishedAt(t *time.Time) *OutboxEventUpdateOne {
+	if t != nil {
+		oeuo.SetPublishedAt(*t)
+	}
+	return oeuo
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (oeuo *OutboxEventUpdateOne) ClearPublishedAt() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearPublishedAt()
+	return oeuo
+}
+
+// SetPublishedTo sets the "published_to" field.
+func (oeuo *OutboxEventUpdateOne) SetPublishedTo(s []string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetPublishedTo(s)
+	return oeuo
+}
+
+// AppendPublishedTo appends s to the "published_to" field.
+func (oeuo *OutboxEventUpdateOne) AppendPublishedTo(s []string) *OutboxEventUpdateOne {
+	oeuo.mutation.AppendPublishedTo(s)
+	return oeuo
+}
+
+// ClearPublishedTo clears the value of the "published_to" field.
+func (oeuo *OutboxEventUpdateOne) ClearPublishedTo() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearPublishedTo()
+	return oeuo
+}
+
+// SetLastError sets the "last_error" field.
+func (oeuo *OutboxEventUpdateOne) SetLastError(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetLastError(s)
+	return oeuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableLastError(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetLastError(*s)
+	}
+	return oeuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (oeuo *OutboxEventUpdateOne) ClearLastError() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearLastError()
+	return oeuo
+}
+
+// SetClaimID sets the "claim_id" field.
+func (oeuo *OutboxEventUpdateOne) SetClaimID(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetClaimID(s)
+	return oeuo
+}
+
+// SetNillableClaimID sets the "claim_id" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableClaimID(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetClaimID(*s)
+	}
+	return oeuo
+}
+
+// ClearClaimID clears the value of the "claim_id" field.
+func (oeuo *OutboxEventUpdateOne) ClearClaimID() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearClaimID()
+	return oeuo
+}
+
+// SetClaimedUntil sets the "claimed_until" field.
+func (oeuo *OutboxEventUpdateOne) SetClaimedUntil(t time.Time) *OutboxEventUpdateOne {
+	oeuo.mutation.SetClaimedUntil(t)
+	return oeuo
+}
+
+// SetNillableClaimedUntil sets the "claimed_until" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableClaimedUntil(t *time.Time) *OutboxEventUpdateOne {
+	if t != nil {
+		oeuo.SetClaimedUntil(*t)
+	}
+	return oeuo
+}
+
+// ClearClaimedUntil clears the value of the "claimed_until" field.
+func (oeuo *OutboxEventUpdateOne) ClearClaimedUntil() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearClaimedUntil()
+	return oeuo
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeuo *OutboxEventUpdateOne) Mutation() *OutboxEventMutation {
+	return oeuo.mutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeuo *OutboxEventUpdateOne) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdateOne {
+	oeuo.mutation.Where(ps...)
+	return oeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oeuo *OutboxEventUpdateOne) Select(field string, fields ...string) *OutboxEventUpdateOne {
+	oeuo.fields = append([]string{field}, fields...)
+	return oeuo
+}
+
+// Save executes the query and returns the updated OutboxEvent entity.
+func (oeuo *OutboxEventUpdateOne) Save(ctx context.Context) (*OutboxEvent, error) {
+	if err := oeuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, oeuo.sqlSave, oeuo.mutation, oeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) SaveX(ctx context.Context) *OutboxEvent {
+	node, err := oeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oeuo *OutboxEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := oeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) ExecX(ctx context.Context) {
+	if err := oeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oeuo *OutboxEventUpdateOne) defaults() error {
+	if _, ok := oeuo.mutation.UpdatedAt(); !ok && !oeuo.mutation.UpdatedAtCleared() {
+		if outboxevent.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized outboxevent.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := outboxevent.UpdateDefaultUpdatedAt()
+		oeuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+func (oeuo *OutboxEventUpdateOne) sqlSave(ctx context.Context) (_node *OutboxEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeString))
+	id, ok := oeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "OutboxEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxevent.FieldID)
+		for _, f := range fields {
+			if !outboxevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != outboxevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if oeuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(outboxevent.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := oeuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(outboxevent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if oeuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(outboxevent.FieldUpdatedAt, field.TypeTime)
+	}
+	if oeuo.mutation.CreatedByCleared() {
+		_spec.ClearField(outboxevent.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := oeuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(outboxevent.FieldUpdatedBy, field.TypeString, value)
+	}
+	if oeuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(outboxevent.FieldUpdatedBy, field.TypeString)
+	}
+	if oeuo.mutation.OrganizationIDCleared() {
+		_spec.ClearField(outboxevent.FieldOrganizationID, field.TypeString)
+	}
+	if oeuo.mutation.PayloadCleared() {
+		_spec.ClearField(outboxevent.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := oeuo.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.AvailableAt(); ok {
+		_spec.SetField(outboxevent.FieldAvailableAt, field.TypeTime, value)
+	}
+	if value, ok := oeuo.mutation.PublishedAt(); ok {
+		_spec.SetField(outboxevent.FieldPublishedAt, field.TypeTime, value)
+	}
+	if oeuo.mutation.PublishedAtCleared() {
+		_spec.ClearField(outboxevent.FieldPublishedAt, field.TypeTime)
+	}
+	if value, ok := oeuo.mutation.PublishedTo(); ok {
+		_spec.SetField(outboxevent.FieldPublishedTo, field.TypeJSON, value)
+	}
+	if value, ok := oeuo.mutation.AppendedPublishedTo(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, outboxevent.FieldPublishedTo, value)
+		})
+	}
+	if oeuo.mutation.PublishedToCleared() {
+		_spec.ClearField(outboxevent.FieldPublishedTo, field.TypeJSON)
+	}
+	if value, ok := oeuo.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+	}
+	if oeuo.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxevent.FieldLastError, field.TypeString)
+	}
+	if value, ok := oeuo.mutation.ClaimID(); ok {
+		_spec.SetField(outboxevent.FieldClaimID, field.TypeString, value)
+	}
+	if oeuo.mutation.ClaimIDCleared() {
+		_spec.ClearField(outboxevent.FieldClaimID, field.TypeString)
+	}
+	if value, ok := oeuo.mutation.ClaimedUntil(); ok {
+		_spec.SetField(outboxevent.FieldClaimedUntil, field.TypeTime, value)
+	}
+	if oeuo.mutation.ClaimedUntilCleared() {
+		_spec.ClearField(outboxevent.FieldClaimedUntil, field.TypeTime)
+	}
+	_spec.Node.Schema = oeuo.schemaConfig.OutboxEvent
+	ctx = internal.NewSchemaConfigContext(ctx, oeuo.schemaConfig)
+	_node = &OutboxEvent{config: oeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oeuo.mutation.done = true
+	return _node, nil
+}
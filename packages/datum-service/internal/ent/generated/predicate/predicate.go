@@ -138,6 +138,9 @@ type OrganizationSetting func(*sql.Selector)
 // OrganizationSettingHistory is the predicate function for organizationsettinghistory builders.
 type OrganizationSettingHistory func(*sql.Selector)
 
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
 // PasswordResetToken is the predicate function for passwordresettoken builders.
 type PasswordResetToken func(*sql.Selector)
 
@@ -156,6 +159,9 @@ type Template func(*sql.Selector)
 // TemplateHistory is the predicate function for templatehistory builders.
 type TemplateHistory func(*sql.Selector)
 
+// TemplateVersion is the predicate function for templateversion builders.
+type TemplateVersion func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
@@ -174,5 +180,8 @@ type Webauthn func(*sql.Selector)
 // Webhook is the predicate function for webhook builders.
 type Webhook func(*sql.Selector)
 
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
 // WebhookHistory is the predicate function for webhookhistory builders.
 type WebhookHistory func(*sql.Selector)
@@ -1167,6 +1167,30 @@ func (f OrganizationSettingHistoryMutationRuleFunc) EvalMutation(ctx context.Con
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.OrganizationSettingHistoryMutation", m)
 }
 
+// The OutboxEventQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OutboxEventQueryRuleFunc func(context.Context, *generated.OutboxEventQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OutboxEventQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.OutboxEventQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.OutboxEventQuery", q)
+}
+
+// The OutboxEventMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OutboxEventMutationRuleFunc func(context.Context, *generated.OutboxEventMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OutboxEventMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.OutboxEventMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.OutboxEventMutation", m)
+}
+
 // The PasswordResetTokenQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type PasswordResetTokenQueryRuleFunc func(context.Context, *generated.PasswordResetTokenQuery) error
@@ -1311,6 +1335,30 @@ func (f TemplateHistoryMutationRuleFunc) EvalMutation(ctx context.Context, m gen
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.TemplateHistoryMutation", m)
 }
 
+// The TemplateVersionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TemplateVersionQueryRuleFunc func(context.Context, *generated.TemplateVersionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TemplateVersionQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.TemplateVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.TemplateVersionQuery", q)
+}
+
+// The TemplateVersionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TemplateVersionMutationRuleFunc func(context.Context, *generated.TemplateVersionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TemplateVersionMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.TemplateVersionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.TemplateVersionMutation", m)
+}
+
 // The UserQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserQueryRuleFunc func(context.Context, *generated.UserQuery) error
@@ -1455,6 +1503,30 @@ func (f WebhookMutationRuleFunc) EvalMutation(ctx context.Context, m generated.M
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.WebhookMutation", m)
 }
 
+// The WebhookDeliveryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type WebhookDeliveryQueryRuleFunc func(context.Context, *generated.WebhookDeliveryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f WebhookDeliveryQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.WebhookDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.WebhookDeliveryQuery", q)
+}
+
+// The WebhookDeliveryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type WebhookDeliveryMutationRuleFunc func(context.Context, *generated.WebhookDeliveryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f WebhookDeliveryMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.WebhookDeliveryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.WebhookDeliveryMutation", m)
+}
+
 // The WebhookHistoryQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type WebhookHistoryQueryRuleFunc func(context.Context, *generated.WebhookHistoryQuery) error
@@ -1602,6 +1674,8 @@ func queryFilter(q generated.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *generated.OrganizationSettingHistoryQuery:
 		return q.Filter(), nil
+	case *generated.OutboxEventQuery:
+		return q.Filter(), nil
 	case *generated.PasswordResetTokenQuery:
 		return q.Filter(), nil
 	case *generated.PersonalAccessTokenQuery:
@@ -1614,6 +1688,8 @@ func queryFilter(q generated.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *generated.TemplateHistoryQuery:
 		return q.Filter(), nil
+	case *generated.TemplateVersionQuery:
+		return q.Filter(), nil
 	case *generated.UserQuery:
 		return q.Filter(), nil
 	case *generated.UserHistoryQuery:
@@ -1626,6 +1702,8 @@ func queryFilter(q generated.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *generated.WebhookQuery:
 		return q.Filter(), nil
+	case *generated.WebhookDeliveryQuery:
+		return q.Filter(), nil
 	case *generated.WebhookHistoryQuery:
 		return q.Filter(), nil
 	default:
@@ -1723,6 +1801,8 @@ func mutationFilter(m generated.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *generated.OrganizationSettingHistoryMutation:
 		return m.Filter(), nil
+	case *generated.OutboxEventMutation:
+		return m.Filter(), nil
 	case *generated.PasswordResetTokenMutation:
 		return m.Filter(), nil
 	case *generated.PersonalAccessTokenMutation:
@@ -1735,6 +1815,8 @@ func mutationFilter(m generated.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *generated.TemplateHistoryMutation:
 		return m.Filter(), nil
+	case *generated.TemplateVersionMutation:
+		return m.Filter(), nil
 	case *generated.UserMutation:
 		return m.Filter(), nil
 	case *generated.UserHistoryMutation:
@@ -1747,6 +1829,8 @@ func mutationFilter(m generated.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *generated.WebhookMutation:
 		return m.Filter(), nil
+	case *generated.WebhookDeliveryMutation:
+		return m.Filter(), nil
 	case *generated.WebhookHistoryMutation:
 		return m.Filter(), nil
 	default:
@@ -50,11 +50,13 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/organizationsettinghistory"
 	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
 	"github.com/datumforge/datum/internal/ent/generated/orgmembershiphistory"
+	"github.com/datumforge/datum/internal/ent/generated/outboxevent"
 	"github.com/datumforge/datum/internal/ent/generated/passwordresettoken"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/subscriber"
 	"github.com/datumforge/datum/internal/ent/generated/template"
 	"github.com/datumforge/datum/internal/ent/generated/templatehistory"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
 	"github.com/datumforge/datum/internal/ent/generated/tfasetting"
 	"github.com/datumforge/datum/internal/ent/generated/user"
 	"github.com/datumforge/datum/internal/ent/generated/userhistory"
@@ -62,6 +64,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/usersettinghistory"
 	"github.com/datumforge/datum/internal/ent/generated/webauthn"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 	"github.com/datumforge/datum/internal/ent/generated/webhookhistory"
 	"github.com/datumforge/datum/internal/ent/schema"
 
@@ -284,12 +287,15 @@ func init() {
 	documentdataMixinHooks0 := documentdataMixin[0].Hooks()
 	documentdataMixinHooks3 := documentdataMixin[3].Hooks()
 	documentdataMixinHooks4 := documentdataMixin[4].Hooks()
+	documentdataHooks := schema.DocumentData{}.Hooks()
 
 	documentdata.Hooks[1] = documentdataMixinHooks0[0]
 
 	documentdata.Hooks[2] = documentdataMixinHooks3[0]
 
 	documentdata.Hooks[3] = documentdataMixinHooks4[0]
+
+	documentdata.Hooks[4] = documentdataHooks[0]
 	documentdataMixinInters3 := documentdataMixin[3].Interceptors()
 	documentdataMixinInters4 := documentdataMixin[4].Interceptors()
 	documentdata.Interceptors[0] = documentdataMixinInters3[0]
@@ -2149,6 +2155,10 @@ func init() {
 	orgmembershipDescMappingID := orgmembershipMixinFields1[1].Descriptor()
 	// orgmembership.DefaultMappingID holds the default value on creation for the mapping_id field.
 	orgmembership.DefaultMappingID = orgmembershipDescMappingID.Default.(func() string)
+	// orgmembershipDescUserProvisioned is the schema descriptor for user_provisioned field.
+	orgmembershipDescUserProvisioned := orgmembershipFields[5].Descriptor()
+	// orgmembership.DefaultUserProvisioned holds the default value on creation for the user_provisioned field.
+	orgmembership.DefaultUserProvisioned = orgmembershipDescUserProvisioned.Default.(bool)
 	// orgmembershipDescID is the schema descriptor for id field.
 	orgmembershipDescID := orgmembershipMixinFields1[0].Descriptor()
 	// orgmembership.DefaultID holds the default value on creation for the id field.
@@ -2184,6 +2194,10 @@ func init() {
 	orgmembershiphistoryDescMappingID := orgmembershiphistoryFields[8].Descriptor()
 	// orgmembershiphistory.DefaultMappingID holds the default value on creation for the mapping_id field.
 	orgmembershiphistory.DefaultMappingID = orgmembershiphistoryDescMappingID.Default.(func() string)
+	// orgmembershiphistoryDescUserProvisioned is the schema descriptor for user_provisioned field.
+	orgmembershiphistoryDescUserProvisioned := orgmembershiphistoryFields[16].Descriptor()
+	// orgmembershiphistory.DefaultUserProvisioned holds the default value on creation for the user_provisioned field.
+	orgmembershiphistory.DefaultUserProvisioned = orgmembershiphistoryDescUserProvisioned.Default.(bool)
 	// orgmembershiphistoryDescID is the schema descriptor for id field.
 	orgmembershiphistoryDescID := orgmembershiphistoryFields[7].Descriptor()
 	// orgmembershiphistory.DefaultID holds the default value on creation for the id field.
@@ -2356,10 +2370,13 @@ func init() {
 	}
 	organizationsettingMixinHooks0 := organizationsettingMixin[0].Hooks()
 	organizationsettingMixinHooks3 := organizationsettingMixin[3].Hooks()
+	organizationsettingHooks := schema.OrganizationSetting{}.Hooks()
 
 	organizationsetting.Hooks[1] = organizationsettingMixinHooks0[0]
 
 	organizationsetting.Hooks[2] = organizationsettingMixinHooks3[0]
+
+	organizationsetting.Hooks[3] = organizationsettingHooks[0]
 	organizationsettingMixinInters3 := organizationsettingMixin[3].Interceptors()
 	organizationsettingInters := schema.OrganizationSetting{}.Interceptors()
 	organizationsetting.Interceptors[0] = organizationsettingMixinInters3[0]
@@ -2402,6 +2419,22 @@ func init() {
 	organizationsettingDescBillingPhone := organizationsettingFields[3].Descriptor()
 	// organizationsetting.BillingPhoneValidator is a validator for the "billing_phone" field. It is called by the builders before save.
 	organizationsetting.BillingPhoneValidator = organizationsettingDescBillingPhone.Validators[0].(func(string) error)
+	// organizationsettingDescSSOEnforced is the schema descriptor for sso_enforced field.
+	organizationsettingDescSSOEnforced := organizationsettingFields[8].Descriptor()
+	// organizationsetting.DefaultSSOEnforced holds the default value on creation for the sso_enforced field.
+	organizationsetting.DefaultSSOEnforced = organizationsettingDescSSOEnforced.Default.(bool)
+	// organizationsettingDescSamlMetadataURL is the schema descriptor for saml_metadata_url field.
+	organizationsettingDescSamlMetadataURL := organizationsettingFields[9].Descriptor()
+	// organizationsetting.SamlMetadataURLValidator is a validator for the "saml_metadata_url" field. It is called by the builders before save.
+	organizationsetting.SamlMetadataURLValidator = organizationsettingDescSamlMetadataURL.Validators[0].(func(string) error)
+	// organizationsettingDescSamlMetadata is the schema descriptor for saml_metadata field.
+	organizationsettingDescSamlMetadata := organizationsettingFields[10].Descriptor()
+	// organizationsetting.SamlMetadataValidator is a validator for the "saml_metadata" field. It is called by the builders before save.
+	organizationsetting.SamlMetadataValidator = organizationsettingDescSamlMetadata.Validators[0].(func(string) error)
+	// organizationsettingDescDomainVerificationToken is the schema descriptor for domain_verification_token field.
+	organizationsettingDescDomainVerificationToken := organizationsettingFields[12].Descriptor()
+	// organizationsetting.DefaultDomainVerificationToken holds the default value on creation for the domain_verification_token field.
+	organizationsetting.DefaultDomainVerificationToken = organizationsettingDescDomainVerificationToken.Default.(func() string)
 	// organizationsettingDescID is the schema descriptor for id field.
 	organizationsettingDescID := organizationsettingMixinFields1[0].Descriptor()
 	// organizationsetting.DefaultID holds the default value on creation for the id field.
@@ -2441,10 +2474,75 @@ func init() {
 	organizationsettinghistoryDescTags := organizationsettinghistoryFields[9].Descriptor()
 	// organizationsettinghistory.DefaultTags holds the default value on creation for the tags field.
 	organizationsettinghistory.DefaultTags = organizationsettinghistoryDescTags.Default.([]string)
+	// organizationsettinghistoryDescSSOEnforced is the schema descriptor for sso_enforced field.
+	organizationsettinghistoryDescSSOEnforced := organizationsettinghistoryFields[20].Descriptor()
+	// organizationsettinghistory.DefaultSSOEnforced holds the default value on creation for the sso_enforced field.
+	organizationsettinghistory.DefaultSSOEnforced = organizationsettinghistoryDescSSOEnforced.Default.(bool)
+	// organizationsettinghistoryDescDomainVerificationToken is the schema descriptor for domain_verification_token field.
+	organizationsettinghistoryDescDomainVerificationToken := organizationsettinghistoryFields[24].Descriptor()
+	// organizationsettinghistory.DefaultDomainVerificationToken holds the default value on creation for the domain_verification_token field.
+	organizationsettinghistory.DefaultDomainVerificationToken = organizationsettinghistoryDescDomainVerificationToken.Default.(func() string)
 	// organizationsettinghistoryDescID is the schema descriptor for id field.
 	organizationsettinghistoryDescID := organizationsettinghistoryFields[7].Descriptor()
 	// organizationsettinghistory.DefaultID holds the default value on creation for the id field.
 	organizationsettinghistory.DefaultID = organizationsettinghistoryDescID.Default.(func() string)
+	outboxeventMixin := schema.OutboxEvent{}.Mixin()
+	outboxevent.Policy = privacy.NewPolicies(schema.OutboxEvent{})
+	outboxevent.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := outboxevent.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	outboxeventMixinHooks0 := outboxeventMixin[0].Hooks()
+
+	outboxevent.Hooks[1] = outboxeventMixinHooks0[0]
+	outboxeventMixinFields0 := outboxeventMixin[0].Fields()
+	_ = outboxeventMixinFields0
+	outboxeventMixinFields1 := outboxeventMixin[1].Fields()
+	_ = outboxeventMixinFields1
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventMixinFields0[0].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	// outboxeventDescUpdatedAt is the schema descriptor for updated_at field.
+	outboxeventDescUpdatedAt := outboxeventMixinFields0[1].Descriptor()
+	// outboxevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	outboxevent.DefaultUpdatedAt = outboxeventDescUpdatedAt.Default.(func() time.Time)
+	// outboxevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	outboxevent.UpdateDefaultUpdatedAt = outboxeventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// outboxeventDescMappingID is the schema descriptor for mapping_id field.
+	outboxeventDescMappingID := outboxeventMixinFields1[1].Descriptor()
+	// outboxevent.DefaultMappingID holds the default value on creation for the mapping_id field.
+	outboxevent.DefaultMappingID = outboxeventDescMappingID.Default.(func() string)
+	// outboxeventDescTopic is the schema descriptor for topic field.
+	outboxeventDescTopic := outboxeventFields[0].Descriptor()
+	// outboxevent.TopicValidator is a validator for the "topic" field. It is called by the builders before save.
+	outboxevent.TopicValidator = outboxeventDescTopic.Validators[0].(func(string) error)
+	// outboxeventDescAggregateType is the schema descriptor for aggregate_type field.
+	outboxeventDescAggregateType := outboxeventFields[1].Descriptor()
+	// outboxevent.AggregateTypeValidator is a validator for the "aggregate_type" field. It is called by the builders before save.
+	outboxevent.AggregateTypeValidator = outboxeventDescAggregateType.Validators[0].(func(string) error)
+	// outboxeventDescAggregateID is the schema descriptor for aggregate_id field.
+	outboxeventDescAggregateID := outboxeventFields[2].Descriptor()
+	// outboxevent.AggregateIDValidator is a validator for the "aggregate_id" field. It is called by the builders before save.
+	outboxevent.AggregateIDValidator = outboxeventDescAggregateID.Validators[0].(func(string) error)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[5].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescAvailableAt is the schema descriptor for available_at field.
+	outboxeventDescAvailableAt := outboxeventFields[6].Descriptor()
+	// outboxevent.DefaultAvailableAt holds the default value on creation for the available_at field.
+	outboxevent.DefaultAvailableAt = outboxeventDescAvailableAt.Default.(func() time.Time)
+	// outboxeventDescID is the schema descriptor for id field.
+	outboxeventDescID := outboxeventMixinFields1[0].Descriptor()
+	// outboxevent.DefaultID holds the default value on creation for the id field.
+	outboxevent.DefaultID = outboxeventDescID.Default.(func() string)
 	passwordresettokenMixin := schema.PasswordResetToken{}.Mixin()
 	passwordresettoken.Policy = privacy.NewPolicies(schema.PasswordResetToken{})
 	passwordresettoken.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -2732,6 +2830,10 @@ func init() {
 	tfasettingDescTotpAllowed := tfasettingFields[5].Descriptor()
 	// tfasetting.DefaultTotpAllowed holds the default value on creation for the totp_allowed field.
 	tfasetting.DefaultTotpAllowed = tfasettingDescTotpAllowed.Default.(bool)
+	// tfasettingDescChallengeAttempts is the schema descriptor for challenge_attempts field.
+	tfasettingDescChallengeAttempts := tfasettingFields[7].Descriptor()
+	// tfasetting.DefaultChallengeAttempts holds the default value on creation for the challenge_attempts field.
+	tfasetting.DefaultChallengeAttempts = tfasettingDescChallengeAttempts.Default.(int)
 	// tfasettingDescID is the schema descriptor for id field.
 	tfasettingDescID := tfasettingMixinFields1[0].Descriptor()
 	// tfasetting.DefaultID holds the default value on creation for the id field.
@@ -2749,12 +2851,15 @@ func init() {
 	templateMixinHooks0 := templateMixin[0].Hooks()
 	templateMixinHooks1 := templateMixin[1].Hooks()
 	templateMixinHooks4 := templateMixin[4].Hooks()
+	templateHooks := schema.Template{}.Hooks()
 
 	template.Hooks[1] = templateMixinHooks0[0]
 
 	template.Hooks[2] = templateMixinHooks1[0]
 
 	template.Hooks[3] = templateMixinHooks4[0]
+
+	template.Hooks[4] = templateHooks[0]
 	templateMixinInters1 := templateMixin[1].Interceptors()
 	templateMixinInters4 := templateMixin[4].Interceptors()
 	template.Interceptors[0] = templateMixinInters1[0]
@@ -2795,6 +2900,10 @@ func init() {
 	templateDescName := templateFields[0].Descriptor()
 	// template.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	template.NameValidator = templateDescName.Validators[0].(func(string) error)
+	// templateDescVersion is the schema descriptor for version field.
+	templateDescVersion := templateFields[5].Descriptor()
+	// template.DefaultVersion holds the default value on creation for the version field.
+	template.DefaultVersion = templateDescVersion.Default.(int)
 	// templateDescID is the schema descriptor for id field.
 	templateDescID := templateMixinFields2[0].Descriptor()
 	// template.DefaultID holds the default value on creation for the id field.
@@ -2834,10 +2943,67 @@ func init() {
 	templatehistoryDescTags := templatehistoryFields[11].Descriptor()
 	// templatehistory.DefaultTags holds the default value on creation for the tags field.
 	templatehistory.DefaultTags = templatehistoryDescTags.Default.([]string)
+	// templatehistoryDescVersion is the schema descriptor for version field.
+	templatehistoryDescVersion := templatehistoryFields[18].Descriptor()
+	// templatehistory.DefaultVersion holds the default value on creation for the version field.
+	templatehistory.DefaultVersion = templatehistoryDescVersion.Default.(int)
 	// templatehistoryDescID is the schema descriptor for id field.
 	templatehistoryDescID := templatehistoryFields[9].Descriptor()
 	// templatehistory.DefaultID holds the default value on creation for the id field.
 	templatehistory.DefaultID = templatehistoryDescID.Default.(func() string)
+	templateversionMixin := schema.TemplateVersion{}.Mixin()
+	templateversion.Policy = privacy.NewPolicies(schema.TemplateVersion{})
+	templateversion.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := templateversion.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	templateversionMixinHooks0 := templateversionMixin[0].Hooks()
+	templateversionMixinHooks1 := templateversionMixin[1].Hooks()
+	templateversionMixinHooks3 := templateversionMixin[3].Hooks()
+
+	templateversion.Hooks[1] = templateversionMixinHooks0[0]
+
+	templateversion.Hooks[2] = templateversionMixinHooks1[0]
+
+	templateversion.Hooks[3] = templateversionMixinHooks3[0]
+	templateversionMixinInters1 := templateversionMixin[1].Interceptors()
+	templateversionMixinInters3 := templateversionMixin[3].Interceptors()
+	templateversion.Interceptors[0] = templateversionMixinInters1[0]
+	templateversion.Interceptors[1] = templateversionMixinInters3[0]
+	templateversionMixinFields0 := templateversionMixin[0].Fields()
+	_ = templateversionMixinFields0
+	templateversionMixinFields2 := templateversionMixin[2].Fields()
+	_ = templateversionMixinFields2
+	templateversionMixinFields3 := templateversionMixin[3].Fields()
+	_ = templateversionMixinFields3
+	templateversionFields := schema.TemplateVersion{}.Fields()
+	_ = templateversionFields
+	// templateversionDescCreatedAt is the schema descriptor for created_at field.
+	templateversionDescCreatedAt := templateversionMixinFields0[0].Descriptor()
+	// templateversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	templateversion.DefaultCreatedAt = templateversionDescCreatedAt.Default.(func() time.Time)
+	// templateversionDescUpdatedAt is the schema descriptor for updated_at field.
+	templateversionDescUpdatedAt := templateversionMixinFields0[1].Descriptor()
+	// templateversion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	templateversion.DefaultUpdatedAt = templateversionDescUpdatedAt.Default.(func() time.Time)
+	// templateversion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	templateversion.UpdateDefaultUpdatedAt = templateversionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// templateversionDescMappingID is the schema descriptor for mapping_id field.
+	templateversionDescMappingID := templateversionMixinFields2[1].Descriptor()
+	// templateversion.DefaultMappingID holds the default value on creation for the mapping_id field.
+	templateversion.DefaultMappingID = templateversionDescMappingID.Default.(func() string)
+	// templateversionDescOwnerID is the schema descriptor for owner_id field.
+	templateversionDescOwnerID := templateversionMixinFields3[0].Descriptor()
+	// templateversion.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	templateversion.OwnerIDValidator = templateversionDescOwnerID.Validators[0].(func(string) error)
+	// templateversionDescID is the schema descriptor for id field.
+	templateversionDescID := templateversionMixinFields2[0].Descriptor()
+	// templateversion.DefaultID holds the default value on creation for the id field.
+	templateversion.DefaultID = templateversionDescID.Default.(func() string)
 	userMixin := schema.User{}.Mixin()
 	user.Policy = privacy.NewPolicies(schema.User{})
 	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -3045,16 +3211,20 @@ func init() {
 	usersettingDescLocked := usersettingFields[1].Descriptor()
 	// usersetting.DefaultLocked holds the default value on creation for the locked field.
 	usersetting.DefaultLocked = usersettingDescLocked.Default.(bool)
+	// usersettingDescFailedLoginAttempts is the schema descriptor for failed_login_attempts field.
+	usersettingDescFailedLoginAttempts := usersettingFields[3].Descriptor()
+	// usersetting.DefaultFailedLoginAttempts holds the default value on creation for the failed_login_attempts field.
+	usersetting.DefaultFailedLoginAttempts = usersettingDescFailedLoginAttempts.Default.(int)
 	// usersettingDescEmailConfirmed is the schema descriptor for email_confirmed field.
-	usersettingDescEmailConfirmed := usersettingFields[5].Descriptor()
+	usersettingDescEmailConfirmed := usersettingFields[7].Descriptor()
 	// usersetting.DefaultEmailConfirmed holds the default value on creation for the email_confirmed field.
 	usersetting.DefaultEmailConfirmed = usersettingDescEmailConfirmed.Default.(bool)
 	// usersettingDescIsWebauthnAllowed is the schema descriptor for is_webauthn_allowed field.
-	usersettingDescIsWebauthnAllowed := usersettingFields[6].Descriptor()
+	usersettingDescIsWebauthnAllowed := usersettingFields[8].Descriptor()
 	// usersetting.DefaultIsWebauthnAllowed holds the default value on creation for the is_webauthn_allowed field.
 	usersetting.DefaultIsWebauthnAllowed = usersettingDescIsWebauthnAllowed.Default.(bool)
 	// usersettingDescIsTfaEnabled is the schema descriptor for is_tfa_enabled field.
-	usersettingDescIsTfaEnabled := usersettingFields[7].Descriptor()
+	usersettingDescIsTfaEnabled := usersettingFields[9].Descriptor()
 	// usersetting.DefaultIsTfaEnabled holds the default value on creation for the is_tfa_enabled field.
 	usersetting.DefaultIsTfaEnabled = usersettingDescIsTfaEnabled.Default.(bool)
 	// usersettingDescID is the schema descriptor for id field.
@@ -3091,16 +3261,20 @@ func init() {
 	usersettinghistoryDescLocked := usersettinghistoryFields[13].Descriptor()
 	// usersettinghistory.DefaultLocked holds the default value on creation for the locked field.
 	usersettinghistory.DefaultLocked = usersettinghistoryDescLocked.Default.(bool)
+	// usersettinghistoryDescFailedLoginAttempts is the schema descriptor for failed_login_attempts field.
+	usersettinghistoryDescFailedLoginAttempts := usersettinghistoryFields[15].Descriptor()
+	// usersettinghistory.DefaultFailedLoginAttempts holds the default value on creation for the failed_login_attempts field.
+	usersettinghistory.DefaultFailedLoginAttempts = usersettinghistoryDescFailedLoginAttempts.Default.(int)
 	// usersettinghistoryDescEmailConfirmed is the schema descriptor for email_confirmed field.
-	usersettinghistoryDescEmailConfirmed := usersettinghistoryFields[17].Descriptor()
+	usersettinghistoryDescEmailConfirmed := usersettinghistoryFields[19].Descriptor()
 	// usersettinghistory.DefaultEmailConfirmed holds the default value on creation for the email_confirmed field.
 	usersettinghistory.DefaultEmailConfirmed = usersettinghistoryDescEmailConfirmed.Default.(bool)
 	// usersettinghistoryDescIsWebauthnAllowed is the schema descriptor for is_webauthn_allowed field.
-	usersettinghistoryDescIsWebauthnAllowed := usersettinghistoryFields[18].Descriptor()
+	usersettinghistoryDescIsWebauthnAllowed := usersettinghistoryFields[20].Descriptor()
 	// usersettinghistory.DefaultIsWebauthnAllowed holds the default value on creation for the is_webauthn_allowed field.
 	usersettinghistory.DefaultIsWebauthnAllowed = usersettinghistoryDescIsWebauthnAllowed.Default.(bool)
 	// usersettinghistoryDescIsTfaEnabled is the schema descriptor for is_tfa_enabled field.
-	usersettinghistoryDescIsTfaEnabled := usersettinghistoryFields[19].Descriptor()
+	usersettinghistoryDescIsTfaEnabled := usersettinghistoryFields[21].Descriptor()
 	// usersettinghistory.DefaultIsTfaEnabled holds the default value on creation for the is_tfa_enabled field.
 	usersettinghistory.DefaultIsTfaEnabled = usersettinghistoryDescIsTfaEnabled.Default.(bool)
 	// usersettinghistoryDescID is the schema descriptor for id field.
@@ -3163,8 +3337,10 @@ func init() {
 	webhookMixin := schema.Webhook{}.Mixin()
 	webhookMixinHooks0 := webhookMixin[0].Hooks()
 	webhookMixinHooks3 := webhookMixin[3].Hooks()
+	webhookHooks := schema.Webhook{}.Hooks()
 	webhook.Hooks[0] = webhookMixinHooks0[0]
 	webhook.Hooks[1] = webhookMixinHooks3[0]
+	webhook.Hooks[2] = webhookHooks[0]
 	webhookMixinInters3 := webhookMixin[3].Interceptors()
 	webhook.Interceptors[0] = webhookMixinInters3[0]
 	webhookMixinFields0 := webhookMixin[0].Fields()
@@ -3205,14 +3381,74 @@ func init() {
 	webhookDescEnabled := webhookFields[3].Descriptor()
 	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
 	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	// webhookDescEventTypes is the schema descriptor for event_types field.
+	webhookDescEventTypes := webhookFields[4].Descriptor()
+	// webhook.EventTypesValidator is a validator for the "event_types" field. It is called by the builders before save.
+	webhook.EventTypesValidator = webhookDescEventTypes.Validators[0].(func([]string) error)
+	// webhookDescObjectTypes is the schema descriptor for object_types field.
+	webhookDescObjectTypes := webhookFields[5].Descriptor()
+	// webhook.ObjectTypesValidator is a validator for the "object_types" field. It is called by the builders before save.
+	webhook.ObjectTypesValidator = webhookDescObjectTypes.Validators[0].(func([]string) error)
 	// webhookDescFailures is the schema descriptor for failures field.
-	webhookDescFailures := webhookFields[7].Descriptor()
+	webhookDescFailures := webhookFields[9].Descriptor()
 	// webhook.DefaultFailures holds the default value on creation for the failures field.
 	webhook.DefaultFailures = webhookDescFailures.Default.(int)
 	// webhookDescID is the schema descriptor for id field.
 	webhookDescID := webhookMixinFields1[0].Descriptor()
 	// webhook.DefaultID holds the default value on creation for the id field.
 	webhook.DefaultID = webhookDescID.Default.(func() string)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdelivery.Policy = privacy.NewPolicies(schema.WebhookDelivery{})
+	webhookdelivery.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := webhookdelivery.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	webhookdeliveryMixinHooks0 := webhookdeliveryMixin[0].Hooks()
+	webhookdeliveryMixinHooks2 := webhookdeliveryMixin[2].Hooks()
+
+	webhookdelivery.Hooks[1] = webhookdeliveryMixinHooks0[0]
+
+	webhookdelivery.Hooks[2] = webhookdeliveryMixinHooks2[0]
+	webhookdeliveryMixinInters2 := webhookdeliveryMixin[2].Interceptors()
+	webhookdelivery.Interceptors[0] = webhookdeliveryMixinInters2[0]
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryMixinFields1 := webhookdeliveryMixin[1].Fields()
+	_ = webhookdeliveryMixinFields1
+	webhookdeliveryMixinFields2 := webhookdeliveryMixin[2].Fields()
+	_ = webhookdeliveryMixinFields2
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescMappingID is the schema descriptor for mapping_id field.
+	webhookdeliveryDescMappingID := webhookdeliveryMixinFields1[1].Descriptor()
+	// webhookdelivery.DefaultMappingID holds the default value on creation for the mapping_id field.
+	webhookdelivery.DefaultMappingID = webhookdeliveryDescMappingID.Default.(func() string)
+	// webhookdeliveryDescOwnerID is the schema descriptor for owner_id field.
+	webhookdeliveryDescOwnerID := webhookdeliveryMixinFields2[0].Descriptor()
+	// webhookdelivery.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	webhookdelivery.OwnerIDValidator = webhookdeliveryDescOwnerID.Validators[0].(func(string) error)
+	// webhookdeliveryDescRedelivery is the schema descriptor for redelivery field.
+	webhookdeliveryDescRedelivery := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.DefaultRedelivery holds the default value on creation for the redelivery field.
+	webhookdelivery.DefaultRedelivery = webhookdeliveryDescRedelivery.Default.(bool)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryMixinFields1[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() string)
 	webhookhistory.Policy = privacy.NewPolicies(schema.WebhookHistory{})
 	webhookhistory.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
@@ -3253,7 +3489,7 @@ func init() {
 	// webhookhistory.DefaultEnabled holds the default value on creation for the enabled field.
 	webhookhistory.DefaultEnabled = webhookhistoryDescEnabled.Default.(bool)
 	// webhookhistoryDescFailures is the schema descriptor for failures field.
-	webhookhistoryDescFailures := webhookhistoryFields[20].Descriptor()
+	webhookhistoryDescFailures := webhookhistoryFields[22].Descriptor()
 	// webhookhistory.DefaultFailures holds the default value on creation for the failures field.
 	webhookhistory.DefaultFailures = webhookhistoryDescFailures.Default.(int)
 	// webhookhistoryDescID is the schema descriptor for id field.
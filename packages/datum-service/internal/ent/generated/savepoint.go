@@ -0,0 +1,23 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+)
+
+// Savepoint creates a savepoint with the name in the transaction of the client, the changes made after the
+// savepoint can be undone with RollbackToSavepoint without rolling back the transaction
+func (c *Client) Savepoint(ctx context.Context, name string) error {
+	return c.driver.Exec(ctx, "SAVEPOINT "+name, []any{}, nil)
+}
+
+// RollbackToSavepoint undoes the changes made in the transaction of the client after the savepoint was created
+func (c *Client) RollbackToSavepoint(ctx context.Context, name string) error {
+	return c.driver.Exec(ctx, "ROLLBACK TO SAVEPOINT "+name, []any{}, nil)
+}
+
+// ReleaseSavepoint removes the savepoint, keeping the changes made after it was created in the transaction
+func (c *Client) ReleaseSavepoint(ctx context.Context, name string) error {
+	return c.driver.Exec(ctx, "RELEASE SAVEPOINT "+name, []any{}, nil)
+}
@@ -49,6 +49,12 @@ type Template struct {
 	Jsonconfig customtypes.JSONObject `json:"jsonconfig,omitempty"`
 	// the uischema for the template to render in the UI
 	Uischema customtypes.JSONObject `json:"uischema,omitempty"`
+	// the current version of the template, incremented each time the jsonconfig or uischema is changed
+	Version int `json:"version,omitempty"`
+	// the root template the template was cloned from
+	SourceTemplateID string `json:"source_template_id,omitempty"`
+	// the version of the root template the template was cloned from
+	SourceTemplateVersion int `json:"source_template_version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TemplateQuery when eager-loading is set.
 	Edges        TemplateEdges `json:"edges"`
@@ -61,13 +67,21 @@ type TemplateEdges struct {
 	Owner *Organization `json:"owner,omitempty"`
 	// Documents holds the value of the documents edge.
 	Documents []*DocumentData `json:"documents,omitempty"`
+	// Versions holds the value of the versions edge.
+	Versions []*TemplateVersion `json:"versions,omitempty"`
+	// SourceTemplate holds the value of the source_template edge.
+	SourceTemplate *Template `json:"source_template,omitempty"`
+	// Clones holds the value of the clones edge.
+	Clones []*Template `json:"clones,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
 	// totalCount holds the count of the edges above.
-	totalCount [2]map[string]int
+	totalCount [5]map[string]int
 
 	namedDocuments map[string][]*DocumentData
+	namedVersions  map[string][]*TemplateVersion
+	namedClones    map[string][]*Template
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -90,6 +104,35 @@ func (e TemplateEdges) DocumentsOrErr() ([]*DocumentData, error) {
 	return nil, &NotLoadedError{edge: "documents"}
 }
 
+// VersionsOrErr returns the Versions value or an error if the edge
+// was not loaded in eager-loading.
+func (e TemplateEdges) VersionsOrErr() ([]*TemplateVersion, error) {
+	if e.loadedTypes[2] {
+		return e.Versions, nil
+	}
+	return nil, &NotLoadedError{edge: "versions"}
+}
+
+// SourceTemplateOrErr returns the SourceTemplate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TemplateEdges) SourceTemplateOrErr() (*Template, error) {
+	if e.SourceTemplate != nil {
+		return e.SourceTemplate, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: template.Label}
+	}
+	return nil, &NotLoadedError{edge: "source_template"}
+}
+
+// ClonesOrErr returns the Clones value or an error if the edge
+// was not loaded in eager-loading.
+func (e TemplateEdges) ClonesOrErr() ([]*Template, error) {
+	if e.loadedTypes[4] {
+		return e.Clones, nil
+	}
+	return nil, &NotLoadedError{edge: "clones"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Template) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -97,7 +140,9 @@ func (*Template) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case template.FieldTags, template.FieldJsonconfig, template.FieldUischema:
 			values[i] = new([]byte)
-		case template.FieldID, template.FieldCreatedBy, template.FieldUpdatedBy, template.FieldDeletedBy, template.FieldMappingID, template.FieldOwnerID, template.FieldName, template.FieldTemplateType, template.FieldDescription:
+		case template.FieldVersion, template.FieldSourceTemplateVersion:
+			values[i] = new(sql.NullInt64)
+		case template.FieldID, template.FieldCreatedBy, template.FieldUpdatedBy, template.FieldDeletedBy, template.FieldMappingID, template.FieldOwnerID, template.FieldName, template.FieldTemplateType, template.FieldDescription, template.FieldSourceTemplateID:
 			values[i] = new(sql.NullString)
 		case template.FieldCreatedAt, template.FieldUpdatedAt, template.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -212,6 +257,24 @@ func (t *Template) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field uischema: %w", err)
 				}
 			}
+		case template.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				t.Version = int(value.Int64)
+			}
+		case template.FieldSourceTemplateID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_template_id", values[i])
+			} else if value.Valid {
+				t.SourceTemplateID = value.String
+			}
+		case template.FieldSourceTemplateVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_template_version", values[i])
+			} else if value.Valid {
+				t.SourceTemplateVersion = int(value.Int64)
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -235,6 +298,21 @@ func (t *Template) QueryDocuments() *DocumentDataQuery {
 	return NewTemplateClient(t.config).QueryDocuments(t)
 }
 
+// QueryVersions queries the "versions" edge of the Template entity.
+func (t *Template) QueryVersions() *TemplateVersionQuery {
+	return NewTemplateClient(t.config).QueryVersions(t)
+}
+
+// QuerySourceTemplate queries the "source_template" edge of the Template entity.
+func (t *Template) QuerySourceTemplate() *TemplateQuery {
+	return NewTemplateClient(t.config).QuerySourceTemplate(t)
+}
+
+// QueryClones queries the "clones" edge of the Template entity.
+func (t *Template) QueryClones() *TemplateQuery {
+	return NewTemplateClient(t.config).QueryClones(t)
+}
+
 // Update returns a builder for updating this Template.
 // Note that you need to call Template.Unwrap() before calling this method if this Template
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -299,6 +377,15 @@ func (t *Template) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("uischema=")
 	builder.WriteString(fmt.Sprintf("%v", t.Uischema))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", t.Version))
+	builder.WriteString(", ")
+	builder.WriteString("source_template_id=")
+	builder.WriteString(t.SourceTemplateID)
+	builder.WriteString(", ")
+	builder.WriteString("source_template_version=")
+	builder.WriteString(fmt.Sprintf("%v", t.SourceTemplateVersion))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -327,5 +414,53 @@ func (t *Template) appendNamedDocuments(name string, edges ...*DocumentData) {
 	}
 }
 
+// NamedVersions returns the Versions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (t *Template) NamedVersions(name string) ([]*TemplateVersion, error) {
+	if t.Edges.namedVersions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := t.Edges.namedVersions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (t *Template) appendNamedVersions(name string, edges ...*TemplateVersion) {
+	if t.Edges.namedVersions == nil {
+		t.Edges.namedVersions = make(map[string][]*TemplateVersion)
+	}
+	if len(edges) == 0 {
+		t.Edges.namedVersions[name] = []*TemplateVersion{}
+	} else {
+		t.Edges.namedVersions[name] = append(t.Edges.namedVersions[name], edges...)
+	}
+}
+
+// NamedClones returns the Clones named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (t *Template) NamedClones(name string) ([]*Template, error) {
+	if t.Edges.namedClones == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := t.Edges.namedClones[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (t *Template) appendNamedClones(name string, edges ...*Template) {
+	if t.Edges.namedClones == nil {
+		t.Edges.namedClones = make(map[string][]*Template)
+	}
+	if len(edges) == 0 {
+		t.Edges.namedClones[name] = []*Template{}
+	} else {
+		t.Edges.namedClones[name] = append(t.Edges.namedClones[name], edges...)
+	}
+}
+
 // Templates is a parsable slice of Template.
 type Templates []*Template
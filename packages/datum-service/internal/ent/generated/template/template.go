@@ -46,10 +46,22 @@ const (
 	FieldJsonconfig = "jsonconfig"
 	// FieldUischema holds the string denoting the uischema field in the database.
 	FieldUischema = "uischema"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldSourceTemplateID holds the string denoting the source_template_id field in the database.
+	FieldSourceTemplateID = "source_template_id"
+	// FieldSourceTemplateVersion holds the string denoting the source_template_version field in the database.
+	FieldSourceTemplateVersion = "source_template_version"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// EdgeDocuments holds the string denoting the documents edge name in mutations.
 	EdgeDocuments = "documents"
+	// EdgeVersions holds the string denoting the versions edge name in mutations.
+	EdgeVersions = "versions"
+	// EdgeSourceTemplate holds the string denoting the source_template edge name in mutations.
+	EdgeSourceTemplate = "source_template"
+	// EdgeClones holds the string denoting the clones edge name in mutations.
+	EdgeClones = "clones"
 	// Table holds the table name of the template in the database.
 	Table = "templates"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -66,6 +78,21 @@ const (
 	DocumentsInverseTable = "document_data"
 	// DocumentsColumn is the table column denoting the documents relation/edge.
 	DocumentsColumn = "template_id"
+	// VersionsTable is the table that holds the versions relation/edge.
+	VersionsTable = "template_versions"
+	// VersionsInverseTable is the table name for the TemplateVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "templateversion" package.
+	VersionsInverseTable = "template_versions"
+	// VersionsColumn is the table column denoting the versions relation/edge.
+	VersionsColumn = "template_id"
+	// SourceTemplateTable is the table that holds the source_template relation/edge.
+	SourceTemplateTable = "templates"
+	// SourceTemplateColumn is the table column denoting the source_template relation/edge.
+	SourceTemplateColumn = "source_template_id"
+	// ClonesTable is the table that holds the clones relation/edge.
+	ClonesTable = "templates"
+	// ClonesColumn is the table column denoting the clones relation/edge.
+	ClonesColumn = "source_template_id"
 )
 
 // Columns holds all SQL columns for template fields.
@@ -85,6 +112,9 @@ var Columns = []string{
 	FieldDescription,
 	FieldJsonconfig,
 	FieldUischema,
+	FieldVersion,
+	FieldSourceTemplateID,
+	FieldSourceTemplateVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -103,7 +133,7 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/datumforge/datum/internal/ent/generated/runtime"
 var (
-	Hooks        [4]ent.Hook
+	Hooks        [5]ent.Hook
 	Interceptors [2]ent.Interceptor
 	Policy       ent.Policy
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -120,6 +150,8 @@ var (
 	OwnerIDValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -199,6 +231,21 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// BySourceTemplateID orders the results by the source_template_id field.
+func BySourceTemplateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceTemplateID, opts...).ToFunc()
+}
+
+// BySourceTemplateVersion orders the results by the source_template_version field.
+func BySourceTemplateVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceTemplateVersion, opts...).ToFunc()
+}
+
 // ByOwnerField orders the results by owner field.
 func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -219,6 +266,41 @@ func ByDocuments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDocumentsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByVersionsCount orders the results by versions count.
+func ByVersionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVersionsStep(), opts...)
+	}
+}
+
+// ByVersions orders the results by versions terms.
+func ByVersions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVersionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySourceTemplateField orders the results by source_template field.
+func BySourceTemplateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSourceTemplateStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByClonesCount orders the results by clones count.
+func ByClonesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newClonesStep(), opts...)
+	}
+}
+
+// ByClones orders the results by clones terms.
+func ByClones(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClonesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -233,6 +315,27 @@ func newDocumentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DocumentsTable, DocumentsColumn),
 	)
 }
+func newVersionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VersionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VersionsTable, VersionsColumn),
+	)
+}
+func newSourceTemplateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SourceTemplateTable, SourceTemplateColumn),
+	)
+}
+func newClonesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ClonesTable, ClonesColumn),
+	)
+}
 
 var (
 	// enums.DocumentType must implement graphql.Marshaler.
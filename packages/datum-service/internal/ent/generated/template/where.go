@@ -118,6 +118,21 @@ func Description(v string) predicate.Template {
 	return predicate.Template(sql.FieldEQ(FieldDescription, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Template {
+	return predicate.Template(sql.FieldEQ(FieldVersion, v))
+}
+
+// SourceTemplateID applies equality check predicate on the "source_template_id" field. It's identical to SourceTemplateIDEQ.
+func SourceTemplateID(v string) predicate.Template {
+	return predicate.Template(sql.FieldEQ(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateVersion applies equality check predicate on the "source_template_version" field. It's identical to SourceTemplateVersionEQ.
+func SourceTemplateVersion(v int) predicate.Template {
+	return predicate.Template(sql.FieldEQ(FieldSourceTemplateVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Template {
 	return predicate.Template(sql.FieldEQ(FieldCreatedAt, v))
@@ -823,6 +838,171 @@ func UischemaNotNil() predicate.Template {
 	return predicate.Template(sql.FieldNotNull(FieldUischema))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Template {
+	return predicate.Template(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Template {
+	return predicate.Template(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Template {
+	return predicate.Template(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Template {
+	return predicate.Template(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Template {
+	return predicate.Template(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Template {
+	return predicate.Template(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Template {
+	return predicate.Template(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Template {
+	return predicate.Template(sql.FieldLTE(FieldVersion, v))
+}
+
+// SourceTemplateIDEQ applies the EQ predicate on the "source_template_id" field.
+func SourceTemplateIDEQ(v string) predicate.Template {
+	return predicate.Template(sql.FieldEQ(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDNEQ applies the NEQ predicate on the "source_template_id" field.
+func SourceTemplateIDNEQ(v string) predicate.Template {
+	return predicate.Template(sql.FieldNEQ(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDIn applies the In predicate on the "source_template_id" field.
+func SourceTemplateIDIn(vs ...string) predicate.Template {
+	return predicate.Template(sql.FieldIn(FieldSourceTemplateID, vs...))
+}
+
+// SourceTemplateIDNotIn applies the NotIn predicate on the "source_template_id" field.
+func SourceTemplateIDNotIn(vs ...string) predicate.Template {
+	return predicate.Template(sql.FieldNotIn(FieldSourceTemplateID, vs...))
+}
+
+// SourceTemplateIDGT applies the GT predicate on the "source_template_id" field.
+func SourceTemplateIDGT(v string) predicate.Template {
+	return predicate.Template(sql.FieldGT(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDGTE applies the GTE predicate on the "source_template_id" field.
+func SourceTemplateIDGTE(v string) predicate.Template {
+	return predicate.Template(sql.FieldGTE(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDLT applies the LT predicate on the "source_template_id" field.
+func SourceTemplateIDLT(v string) predicate.Template {
+	return predicate.Template(sql.FieldLT(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDLTE applies the LTE predicate on the "source_template_id" field.
+func SourceTemplateIDLTE(v string) predicate.Template {
+	return predicate.Template(sql.FieldLTE(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDContains applies the Contains predicate on the "source_template_id" field.
+func SourceTemplateIDContains(v string) predicate.Template {
+	return predicate.Template(sql.FieldContains(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDHasPrefix applies the HasPrefix predicate on the "source_template_id" field.
+func SourceTemplateIDHasPrefix(v string) predicate.Template {
+	return predicate.Template(sql.FieldHasPrefix(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDHasSuffix applies the HasSuffix predicate on the "source_template_id" field.
+func SourceTemplateIDHasSuffix(v string) predicate.Template {
+	return predicate.Template(sql.FieldHasSuffix(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDIsNil applies the IsNil predicate on the "source_template_id" field.
+func SourceTemplateIDIsNil() predicate.Template {
+	return predicate.Template(sql.FieldIsNull(FieldSourceTemplateID))
+}
+
+// SourceTemplateIDNotNil applies the NotNil predicate on the "source_template_id" field.
+func SourceTemplateIDNotNil() predicate.Template {
+	return predicate.Template(sql.FieldNotNull(FieldSourceTemplateID))
+}
+
+// SourceTemplateIDEqualFold applies the EqualFold predicate on the "source_template_id" field.
+func SourceTemplateIDEqualFold(v string) predicate.Template {
+	return predicate.Template(sql.FieldEqualFold(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDContainsFold applies the ContainsFold predicate on the "source_template_id" field.
+func SourceTemplateIDContainsFold(v string) predicate.Template {
+	return predicate.Template(sql.FieldContainsFold(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateVersionEQ applies the EQ predicate on the "source_template_version" field.
+func SourceTemplateVersionEQ(v int) predicate.Template {
+	return predicate.Template(sql.FieldEQ(FieldSourceTemplateVersion, v))
+}
+
+// SourceTemplateVersionNEQ applies the NEQ predicate on the "source_template_version" field.
+func SourceTemplateVersionNEQ(v int) predicate.Template {
+	return predicate.Template(sql.FieldNEQ(FieldSourceTemplateVersion, v))
+}
+
+// SourceTemplateVersionIn applies the In predicate on the "source_template_version" field.
+func SourceTemplateVersionIn(vs ...int) predicate.Template {
+	return predicate.Template(sql.FieldIn(FieldSourceTemplateVersion, vs...))
+}
+
+// SourceTemplateVersionNotIn applies the NotIn predicate on the "source_template_version" field.
+func SourceTemplateVersionNotIn(vs ...int) predicate.Template {
+	return predicate.Template(sql.FieldNotIn(FieldSourceTemplateVersion, vs...))
+}
+
+// SourceTemplateVersionGT applies the GT predicate on the "source_template_version" field.
+func SourceTemplateVersionGT(v int) predicate.Template {
+	return predicate.Template(sql.FieldGT(FieldSourceTemplateVersion, v))
+}
+
+// SourceTemplateVersionGTE applies the GTE predicate on the "source_template_version" field.
+func SourceTemplateVersionGTE(v int) predicate.Template {
+	return predicate.Template(sql.FieldGTE(FieldSourceTemplateVersion, v))
+}
+
+// SourceTemplateVersionLT applies the LT predicate on the "source_template_version" field.
+func SourceTemplateVersionLT(v int) predicate.Template {
+	return predicate.Template(sql.FieldLT(FieldSourceTemplateVersion, v))
+}
+
+// SourceTemplateVersionLTE applies the LTE predicate on the "source_template_version" field.
+func SourceTemplateVersionLTE(v int) predicate.Template {
+	return predicate.Template(sql.FieldLTE(FieldSourceTemplateVersion, v))
+}
+
+// SourceTemplateVersionIsNil applies the IsNil predicate on the "source_template_version" field.
+func SourceTemplateVersionIsNil() predicate.Template {
+	return predicate.Template(sql.FieldIsNull(FieldSourceTemplateVersion))
+}
+
+// SourceTemplateVersionNotNil applies the NotNil predicate on the "source_template_version" field.
+func SourceTemplateVersionNotNil() predicate.Template {
+	return predicate.Template(sql.FieldNotNull(FieldSourceTemplateVersion))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Template {
 	return predicate.Template(func(s *sql.Selector) {
@@ -881,6 +1061,93 @@ func HasDocumentsWith(preds ...predicate.DocumentData) predicate.Template {
 	})
 }
 
+// HasVersions applies the HasEdge predicate on the "versions" edge.
+func HasVersions() predicate.Template {
+	return predicate.Template(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VersionsTable, VersionsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.TemplateVersion
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVersionsWith applies the HasEdge predicate on the "versions" edge with a given conditions (other predicates).
+func HasVersionsWith(preds ...predicate.TemplateVersion) predicate.Template {
+	return predicate.Template(func(s *sql.Selector) {
+		step := newVersionsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.TemplateVersion
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSourceTemplate applies the HasEdge predicate on the "source_template" edge.
+func HasSourceTemplate() predicate.Template {
+	return predicate.Template(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SourceTemplateTable, SourceTemplateColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Template
+		step.Edge.Schema = schemaConfig.Template
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSourceTemplateWith applies the HasEdge predicate on the "source_template" edge with a given conditions (other predicates).
+func HasSourceTemplateWith(preds ...predicate.Template) predicate.Template {
+	return predicate.Template(func(s *sql.Selector) {
+		step := newSourceTemplateStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Template
+		step.Edge.Schema = schemaConfig.Template
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasClones applies the HasEdge predicate on the "clones" edge.
+func HasClones() predicate.Template {
+	return predicate.Template(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ClonesTable, ClonesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Template
+		step.Edge.Schema = schemaConfig.Template
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClonesWith applies the HasEdge predicate on the "clones" edge with a given conditions (other predicates).
+func HasClonesWith(preds ...predicate.Template) predicate.Template {
+	return predicate.Template(func(s *sql.Selector) {
+		step := newClonesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Template
+		step.Edge.Schema = schemaConfig.Template
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Template) predicate.Template {
 	return predicate.Template(sql.AndPredicates(predicates...))
@@ -14,6 +14,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/documentdata"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/template"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
 	"github.com/datumforge/datum/pkg/enums"
 )
 
@@ -188,6 +189,48 @@ func (tc *TemplateCreate) SetUischema(co customtypes.JSONObject) *TemplateCreate
 	return tc
 }
 
+// SetVersion sets the "version" field.
+func (tc *TemplateCreate) SetVersion(i int) *TemplateCreate {
+	tc.mutation.SetVersion(i)
+	return tc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tc *TemplateCreate) SetNillableVersion(i *int) *TemplateCreate {
+	if i != nil {
+		tc.SetVersion(*i)
+	}
+	return tc
+}
+
+// SetSourceTemplateID sets the "source_template_id" field.
+func (tc *TemplateCreate) SetSourceTemplateID(s string) *TemplateCreate {
+	tc.mutation.SetSourceTemplateID(s)
+	return tc
+}
+
+// SetNillableSourceTemplateID sets the "source_template_id" field if the given value is not nil.
+func (tc *TemplateCreate) SetNillableSourceTemplateID(s *string) *TemplateCreate {
+	if s != nil {
+		tc.SetSourceTemplateID(*s)
+	}
+	return tc
+}
+
+// SetSourceTemplateVersion sets the "source_template_version" field.
+func (tc *TemplateCreate) SetSourceTemplateVersion(i int) *TemplateCreate {
+	tc.mutation.SetSourceTemplateVersion(i)
+	return tc
+}
+
+// SetNillableSourceTemplateVersion sets the "source_template_version" field if the given value is not nil.
+func (tc *TemplateCreate) SetNillableSourceTemplateVersion(i *int) *TemplateCreate {
+	if i != nil {
+		tc.SetSourceTemplateVersion(*i)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TemplateCreate) SetID(s string) *TemplateCreate {
 	tc.mutation.SetID(s)
@@ -222,6 +265,41 @@ func (tc *TemplateCreate) AddDocuments(d ...*DocumentData) *TemplateCreate {
 	return tc.AddDocumentIDs(ids...)
 }
 
+// AddVersionIDs adds the "versions" edge to the TemplateVersion entity by IDs.
+func (tc *TemplateCreate) AddVersionIDs(ids ...string) *TemplateCreate {
+	tc.mutation.AddVersionIDs(ids...)
+	return tc
+}
+
+// AddVersions adds the "versions" edges to the TemplateVersion entity.
+func (tc *TemplateCreate) AddVersions(t ...*TemplateVersion) *TemplateCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddVersionIDs(ids...)
+}
+
+// SetSourceTemplate sets the "source_template" edge to the Template entity.
+func (tc *TemplateCreate) SetSourceTemplate(t *Template) *TemplateCreate {
+	return tc.SetSourceTemplateID(t.ID)
+}
+
+// AddCloneIDs adds the "clones" edge to the Template entity by IDs.
+func (tc *TemplateCreate) AddCloneIDs(ids ...string) *TemplateCreate {
+	tc.mutation.AddCloneIDs(ids...)
+	return tc
+}
+
+// AddClones adds the "clones" edges to the Template entity.
+func (tc *TemplateCreate) AddClones(t ...*Template) *TemplateCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddCloneIDs(ids...)
+}
+
 // Mutation returns the TemplateMutation object of the builder.
 func (tc *TemplateCreate) Mutation() *TemplateMutation {
 	return tc.mutation
@@ -288,6 +366,10 @@ func (tc *TemplateCreate) defaults() error {
 		v := template.DefaultTemplateType
 		tc.mutation.SetTemplateType(v)
 	}
+	if _, ok := tc.mutation.Version(); !ok {
+		v := template.DefaultVersion
+		tc.mutation.SetVersion(v)
+	}
 	if _, ok := tc.mutation.ID(); !ok {
 		if template.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized template.DefaultID (forgotten import generated/runtime?)")
@@ -327,6 +409,9 @@ func (tc *TemplateCreate) check() error {
 	if _, ok := tc.mutation.Jsonconfig(); !ok {
 		return &ValidationError{Name: "jsonconfig", err: errors.New(`generated: missing required field "Template.jsonconfig"`)}
 	}
+	if _, ok := tc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "Template.version"`)}
+	}
 	return nil
 }
 
@@ -415,6 +500,14 @@ func (tc *TemplateCreate) createSpec() (*Template, *sqlgraph.CreateSpec) {
 		_spec.SetField(template.FieldUischema, field.TypeJSON, value)
 		_node.Uischema = value
 	}
+	if value, ok := tc.mutation.Version(); ok {
+		_spec.SetField(template.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := tc.mutation.SourceTemplateVersion(); ok {
+		_spec.SetField(template.FieldSourceTemplateVersion, field.TypeInt, value)
+		_node.SourceTemplateVersion = value
+	}
 	if nodes := tc.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -450,6 +543,58 @@ func (tc *TemplateCreate) createSpec() (*Template, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.VersionsTable,
+			Columns: []string{template.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tc.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.SourceTemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   template.SourceTemplateTable,
+			Columns: []string{template.SourceTemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tc.schemaConfig.Template
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SourceTemplateID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.ClonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ClonesTable,
+			Columns: []string{template.ClonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tc.schemaConfig.Template
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
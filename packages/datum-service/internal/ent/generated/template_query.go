@@ -17,6 +17,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/predicate"
 	"github.com/datumforge/datum/internal/ent/generated/template"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
 
 	"github.com/datumforge/datum/internal/ent/generated/internal"
 )
@@ -30,9 +31,14 @@ type TemplateQuery struct {
 	predicates         []predicate.Template
 	withOwner          *OrganizationQuery
 	withDocuments      *DocumentDataQuery
+	withVersions       *TemplateVersionQuery
+	withSourceTemplate *TemplateQuery
+	withClones         *TemplateQuery
 	modifiers          []func(*sql.Selector)
 	loadTotal          []func(context.Context, []*Template) error
 	withNamedDocuments map[string]*DocumentDataQuery
+	withNamedVersions  map[string]*TemplateVersionQuery
+	withNamedClones    map[string]*TemplateQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -119,6 +125,81 @@ func (tq *TemplateQuery) QueryDocuments() *DocumentDataQuery {
 	return query
 }
 
+// QueryVersions chains the current query on the "versions" edge.
+func (tq *TemplateQuery) QueryVersions() *TemplateVersionQuery {
+	query := (&TemplateVersionClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(template.Table, template.FieldID, selector),
+			sqlgraph.To(templateversion.Table, templateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, template.VersionsTable, template.VersionsColumn),
+		)
+		schemaConfig := tq.schemaConfig
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.TemplateVersion
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySourceTemplate chains the current query on the "source_template" edge.
+func (tq *TemplateQuery) QuerySourceTemplate() *TemplateQuery {
+	query := (&TemplateClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(template.Table, template.FieldID, selector),
+			sqlgraph.To(template.Table, template.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, template.SourceTemplateTable, template.SourceTemplateColumn),
+		)
+		schemaConfig := tq.schemaConfig
+		step.To.Schema = schemaConfig.Template
+		step.Edge.Schema = schemaConfig.Template
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryClones chains the current query on the "clones" edge.
+func (tq *TemplateQuery) QueryClones() *TemplateQuery {
+	query := (&TemplateClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(template.Table, template.FieldID, selector),
+			sqlgraph.To(template.Table, template.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, template.ClonesTable, template.ClonesColumn),
+		)
+		schemaConfig := tq.schemaConfig
+		step.To.Schema = schemaConfig.Template
+		step.Edge.Schema = schemaConfig.Template
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Template entity from the query.
 // Returns a *NotFoundError when no Template was found.
 func (tq *TemplateQuery) First(ctx context.Context) (*Template, error) {
@@ -306,13 +387,16 @@ func (tq *TemplateQuery) Clone() *TemplateQuery {
 		return nil
 	}
 	return &TemplateQuery{
-		config:        tq.config,
-		ctx:           tq.ctx.Clone(),
-		order:         append([]template.OrderOption{}, tq.order...),
-		inters:        append([]Interceptor{}, tq.inters...),
-		predicates:    append([]predicate.Template{}, tq.predicates...),
-		withOwner:     tq.withOwner.Clone(),
-		withDocuments: tq.withDocuments.Clone(),
+		config:             tq.config,
+		ctx:                tq.ctx.Clone(),
+		order:              append([]template.OrderOption{}, tq.order...),
+		inters:             append([]Interceptor{}, tq.inters...),
+		predicates:         append([]predicate.Template{}, tq.predicates...),
+		withOwner:          tq.withOwner.Clone(),
+		withDocuments:      tq.withDocuments.Clone(),
+		withVersions:       tq.withVersions.Clone(),
+		withSourceTemplate: tq.withSourceTemplate.Clone(),
+		withClones:         tq.withClones.Clone(),
 		// clone intermediate query.
 		sql:  tq.sql.Clone(),
 		path: tq.path,
@@ -341,6 +425,39 @@ func (tq *TemplateQuery) WithDocuments(opts ...func(*DocumentDataQuery)) *Templa
 	return tq
 }
 
+// WithVersions tells the query-builder to eager-load the nodes that are connected to
+// the "versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TemplateQuery) WithVersions(opts ...func(*TemplateVersionQuery)) *TemplateQuery {
+	query := (&TemplateVersionClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withVersions = query
+	return tq
+}
+
+// WithSourceTemplate tells the query-builder to eager-load the nodes that are connected to
+// the "source_template" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TemplateQuery) WithSourceTemplate(opts ...func(*TemplateQuery)) *TemplateQuery {
+	query := (&TemplateClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withSourceTemplate = query
+	return tq
+}
+
+// WithClones tells the query-builder to eager-load the nodes that are connected to
+// the "clones" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TemplateQuery) WithClones(opts ...func(*TemplateQuery)) *TemplateQuery {
+	query := (&TemplateClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withClones = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -425,9 +542,12 @@ func (tq *TemplateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tem
 	var (
 		nodes       = []*Template{}
 		_spec       = tq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [5]bool{
 			tq.withOwner != nil,
 			tq.withDocuments != nil,
+			tq.withVersions != nil,
+			tq.withSourceTemplate != nil,
+			tq.withClones != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -466,6 +586,26 @@ func (tq *TemplateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tem
 			return nil, err
 		}
 	}
+	if query := tq.withVersions; query != nil {
+		if err := tq.loadVersions(ctx, query, nodes,
+			func(n *Template) { n.Edges.Versions = []*TemplateVersion{} },
+			func(n *Template, e *TemplateVersion) { n.Edges.Versions = append(n.Edges.Versions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withSourceTemplate; query != nil {
+		if err := tq.loadSourceTemplate(ctx, query, nodes, nil,
+			func(n *Template, e *Template) { n.Edges.SourceTemplate = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tq.withClones; query != nil {
+		if err := tq.loadClones(ctx, query, nodes,
+			func(n *Template) { n.Edges.Clones = []*Template{} },
+			func(n *Template, e *Template) { n.Edges.Clones = append(n.Edges.Clones, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range tq.withNamedDocuments {
 		if err := tq.loadDocuments(ctx, query, nodes,
 			func(n *Template) { n.appendNamedDocuments(name) },
@@ -473,6 +613,20 @@ func (tq *TemplateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tem
 			return nil, err
 		}
 	}
+	for name, query := range tq.withNamedVersions {
+		if err := tq.loadVersions(ctx, query, nodes,
+			func(n *Template) { n.appendNamedVersions(name) },
+			func(n *Template, e *TemplateVersion) { n.appendNamedVersions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range tq.withNamedClones {
+		if err := tq.loadClones(ctx, query, nodes,
+			func(n *Template) { n.appendNamedClones(name) },
+			func(n *Template, e *Template) { n.appendNamedClones(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for i := range tq.loadTotal {
 		if err := tq.loadTotal[i](ctx, nodes); err != nil {
 			return nil, err
@@ -540,6 +694,95 @@ func (tq *TemplateQuery) loadDocuments(ctx context.Context, query *DocumentDataQ
 	}
 	return nil
 }
+func (tq *TemplateQuery) loadVersions(ctx context.Context, query *TemplateVersionQuery, nodes []*Template, init func(*Template), assign func(*Template, *TemplateVersion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Template)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(templateversion.FieldTemplateID)
+	}
+	query.Where(predicate.TemplateVersion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(template.VersionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TemplateID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "template_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tq *TemplateQuery) loadSourceTemplate(ctx context.Context, query *TemplateQuery, nodes []*Template, init func(*Template), assign func(*Template, *Template)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Template)
+	for i := range nodes {
+		fk := nodes[i].SourceTemplateID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(template.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "source_template_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tq *TemplateQuery) loadClones(ctx context.Context, query *TemplateQuery, nodes []*Template, init func(*Template), assign func(*Template, *Template)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Template)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(template.FieldSourceTemplateID)
+	}
+	query.Where(predicate.Template(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(template.ClonesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SourceTemplateID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "source_template_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (tq *TemplateQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
@@ -574,6 +817,9 @@ func (tq *TemplateQuery) querySpec() *sqlgraph.QuerySpec {
 		if tq.withOwner != nil {
 			_spec.Node.AddColumnOnce(template.FieldOwnerID)
 		}
+		if tq.withSourceTemplate != nil {
+			_spec.Node.AddColumnOnce(template.FieldSourceTemplateID)
+		}
 	}
 	if ps := tq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -647,6 +893,34 @@ func (tq *TemplateQuery) WithNamedDocuments(name string, opts ...func(*DocumentD
 	return tq
 }
 
+// WithNamedVersions tells the query-builder to eager-load the nodes that are connected to the "versions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (tq *TemplateQuery) WithNamedVersions(name string, opts ...func(*TemplateVersionQuery)) *TemplateQuery {
+	query := (&TemplateVersionClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if tq.withNamedVersions == nil {
+		tq.withNamedVersions = make(map[string]*TemplateVersionQuery)
+	}
+	tq.withNamedVersions[name] = query
+	return tq
+}
+
+// WithNamedClones tells the query-builder to eager-load the nodes that are connected to the "clones"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (tq *TemplateQuery) WithNamedClones(name string, opts ...func(*TemplateQuery)) *TemplateQuery {
+	query := (&TemplateClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if tq.withNamedClones == nil {
+		tq.withNamedClones = make(map[string]*TemplateQuery)
+	}
+	tq.withNamedClones[name] = query
+	return tq
+}
+
 // TemplateGroupBy is the group-by builder for Template entities.
 type TemplateGroupBy struct {
 	selector
@@ -17,6 +17,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/predicate"
 	"github.com/datumforge/datum/internal/ent/generated/template"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
 	"github.com/datumforge/datum/pkg/enums"
 
 	"github.com/datumforge/datum/internal/ent/generated/internal"
@@ -211,6 +212,27 @@ func (tu *TemplateUpdate) ClearUischema() *TemplateUpdate {
 	return tu
 }
 
+// SetVersion sets the "version" field.
+func (tu *TemplateUpdate) SetVersion(i int) *TemplateUpdate {
+	tu.mutation.ResetVersion()
+	tu.mutation.SetVersion(i)
+	return tu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tu *TemplateUpdate) SetNillableVersion(i *int) *TemplateUpdate {
+	if i != nil {
+		tu.SetVersion(*i)
+	}
+	return tu
+}
+
+// AddVersion adds i to the "version" field.
+func (tu *TemplateUpdate) AddVersion(i int) *TemplateUpdate {
+	tu.mutation.AddVersion(i)
+	return tu
+}
+
 // SetOwner sets the "owner" edge to the Organization entity.
 func (tu *TemplateUpdate) SetOwner(o *Organization) *TemplateUpdate {
 	return tu.SetOwnerID(o.ID)
@@ -231,6 +253,36 @@ func (tu *TemplateUpdate) AddDocuments(d ...*DocumentData) *TemplateUpdate {
 	return tu.AddDocumentIDs(ids...)
 }
 
+// AddVersionIDs adds the "versions" edge to the TemplateVersion entity by IDs.
+func (tu *TemplateUpdate) AddVersionIDs(ids ...string) *TemplateUpdate {
+	tu.mutation.AddVersionIDs(ids...)
+	return tu
+}
+
+// AddVersions adds the "versions" edges to the TemplateVersion entity.
+func (tu *TemplateUpdate) AddVersions(t ...*TemplateVersion) *TemplateUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddVersionIDs(ids...)
+}
+
+// AddCloneIDs adds the "clones" edge to the Template entity by IDs.
+func (tu *TemplateUpdate) AddCloneIDs(ids ...string) *TemplateUpdate {
+	tu.mutation.AddCloneIDs(ids...)
+	return tu
+}
+
+// AddClones adds the "clones" edges to the Template entity.
+func (tu *TemplateUpdate) AddClones(t ...*Template) *TemplateUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddCloneIDs(ids...)
+}
+
 // Mutation returns the TemplateMutation object of the builder.
 func (tu *TemplateUpdate) Mutation() *TemplateMutation {
 	return tu.mutation
@@ -263,6 +315,48 @@ func (tu *TemplateUpdate) RemoveDocuments(d ...*DocumentData) *TemplateUpdate {
 	return tu.RemoveDocumentIDs(ids...)
 }
 
+// ClearVersions clears all "versions" edges to the TemplateVersion entity.
+func (tu *TemplateUpdate) ClearVersions() *TemplateUpdate {
+	tu.mutation.ClearVersions()
+	return tu
+}
+
+// RemoveVersionIDs removes the "versions" edge to TemplateVersion entities by IDs.
+func (tu *TemplateUpdate) RemoveVersionIDs(ids ...string) *TemplateUpdate {
+	tu.mutation.RemoveVersionIDs(ids...)
+	return tu
+}
+
+// RemoveVersions removes "versions" edges to TemplateVersion entities.
+func (tu *TemplateUpdate) RemoveVersions(t ...*TemplateVersion) *TemplateUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveVersionIDs(ids...)
+}
+
+// ClearClones clears all "clones" edges to the Template entity.
+func (tu *TemplateUpdate) ClearClones() *TemplateUpdate {
+	tu.mutation.ClearClones()
+	return tu
+}
+
+// RemoveCloneIDs removes the "clones" edge to Template entities by IDs.
+func (tu *TemplateUpdate) RemoveCloneIDs(ids ...string) *TemplateUpdate {
+	tu.mutation.RemoveCloneIDs(ids...)
+	return tu
+}
+
+// RemoveClones removes "clones" edges to Template entities.
+func (tu *TemplateUpdate) RemoveClones(t ...*Template) *TemplateUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveCloneIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TemplateUpdate) Save(ctx context.Context) (int, error) {
 	if err := tu.defaults(); err != nil {
@@ -399,6 +493,15 @@ func (tu *TemplateUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.UischemaCleared() {
 		_spec.ClearField(template.FieldUischema, field.TypeJSON)
 	}
+	if value, ok := tu.mutation.Version(); ok {
+		_spec.SetField(template.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedVersion(); ok {
+		_spec.AddField(template.FieldVersion, field.TypeInt, value)
+	}
+	if tu.mutation.SourceTemplateVersionCleared() {
+		_spec.ClearField(template.FieldSourceTemplateVersion, field.TypeInt)
+	}
 	if tu.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -478,6 +581,102 @@ func (tu *TemplateUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.VersionsTable,
+			Columns: []string{template.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tu.schemaConfig.TemplateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !tu.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.VersionsTable,
+			Columns: []string{template.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tu.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.VersionsTable,
+			Columns: []string{template.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tu.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.ClonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ClonesTable,
+			Columns: []string{template.ClonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tu.schemaConfig.Template
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedClonesIDs(); len(nodes) > 0 && !tu.mutation.ClonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ClonesTable,
+			Columns: []string{template.ClonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tu.schemaConfig.Template
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ClonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ClonesTable,
+			Columns: []string{template.ClonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tu.schemaConfig.Template
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = tu.schemaConfig.Template
 	ctx = internal.NewSchemaConfigContext(ctx, tu.schemaConfig)
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
@@ -676,6 +875,27 @@ func (tuo *TemplateUpdateOne) ClearUischema() *TemplateUpdateOne {
 	return tuo
 }
 
+// SetVersion sets the "version" field.
+func (tuo *TemplateUpdateOne) SetVersion(i int) *TemplateUpdateOne {
+	tuo.mutation.ResetVersion()
+	tuo.mutation.SetVersion(i)
+	return tuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tuo *TemplateUpdateOne) SetNillableVersion(i *int) *TemplateUpdateOne {
+	if i != nil {
+		tuo.SetVersion(*i)
+	}
+	return tuo
+}
+
+// AddVersion adds i to the "version" field.
+func (tuo *TemplateUpdateOne) AddVersion(i int) *TemplateUpdateOne {
+	tuo.mutation.AddVersion(i)
+	return tuo
+}
+
 // SetOwner sets the "owner" edge to the Organization entity.
 func (tuo *TemplateUpdateOne) SetOwner(o *Organization) *TemplateUpdateOne {
 	return tuo.SetOwnerID(o.ID)
@@ -696,6 +916,36 @@ func (tuo *TemplateUpdateOne) AddDocuments(d ...*DocumentData) *TemplateUpdateOn
 	return tuo.AddDocumentIDs(ids...)
 }
 
+// AddVersionIDs adds the "versions" edge to the TemplateVersion entity by IDs.
+func (tuo *TemplateUpdateOne) AddVersionIDs(ids ...string) *TemplateUpdateOne {
+	tuo.mutation.AddVersionIDs(ids...)
+	return tuo
+}
+
+// AddVersions adds the "versions" edges to the TemplateVersion entity.
+func (tuo *TemplateUpdateOne) AddVersions(t ...*TemplateVersion) *TemplateUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddVersionIDs(ids...)
+}
+
+// AddCloneIDs adds the "clones" edge to the Template entity by IDs.
+func (tuo *TemplateUpdateOne) AddCloneIDs(ids ...string) *TemplateUpdateOne {
+	tuo.mutation.AddCloneIDs(ids...)
+	return tuo
+}
+
+// AddClones adds the "clones" edges to the Template entity.
+func (tuo *TemplateUpdateOne) AddClones(t ...*Template) *TemplateUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddCloneIDs(ids...)
+}
+
 // Mutation returns the TemplateMutation object of the builder.
 func (tuo *TemplateUpdateOne) Mutation() *TemplateMutation {
 	return tuo.mutation
@@ -728,6 +978,48 @@ func (tuo *TemplateUpdateOne) RemoveDocuments(d ...*DocumentData) *TemplateUpdat
 	return tuo.RemoveDocumentIDs(ids...)
 }
 
+// ClearVersions clears all "versions" edges to the TemplateVersion entity.
+func (tuo *TemplateUpdateOne) ClearVersions() *TemplateUpdateOne {
+	tuo.mutation.ClearVersions()
+	return tuo
+}
+
+// RemoveVersionIDs removes the "versions" edge to TemplateVersion entities by IDs.
+func (tuo *TemplateUpdateOne) RemoveVersionIDs(ids ...string) *TemplateUpdateOne {
+	tuo.mutation.RemoveVersionIDs(ids...)
+	return tuo
+}
+
+// RemoveVersions removes "versions" edges to TemplateVersion entities.
+func (tuo *TemplateUpdateOne) RemoveVersions(t ...*TemplateVersion) *TemplateUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveVersionIDs(ids...)
+}
+
+// ClearClones clears all "clones" edges to the Template entity.
+func (tuo *TemplateUpdateOne) ClearClones() *TemplateUpdateOne {
+	tuo.mutation.ClearClones()
+	return tuo
+}
+
+// RemoveCloneIDs removes the "clones" edge to Template entities by IDs.
+func (tuo *TemplateUpdateOne) RemoveCloneIDs(ids ...string) *TemplateUpdateOne {
+	tuo.mutation.RemoveCloneIDs(ids...)
+	return tuo
+}
+
+// RemoveClones removes "clones" edges to Template entities.
+func (tuo *TemplateUpdateOne) RemoveClones(t ...*Template) *TemplateUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveCloneIDs(ids...)
+}
+
 // Where appends a list predicates to the TemplateUpdate builder.
 func (tuo *TemplateUpdateOne) Where(ps ...predicate.Template) *TemplateUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -894,6 +1186,15 @@ func (tuo *TemplateUpdateOne) sqlSave(ctx context.Context) (_node *Template, err
 	if tuo.mutation.UischemaCleared() {
 		_spec.ClearField(template.FieldUischema, field.TypeJSON)
 	}
+	if value, ok := tuo.mutation.Version(); ok {
+		_spec.SetField(template.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedVersion(); ok {
+		_spec.AddField(template.FieldVersion, field.TypeInt, value)
+	}
+	if tuo.mutation.SourceTemplateVersionCleared() {
+		_spec.ClearField(template.FieldSourceTemplateVersion, field.TypeInt)
+	}
 	if tuo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -973,6 +1274,102 @@ func (tuo *TemplateUpdateOne) sqlSave(ctx context.Context) (_node *Template, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.VersionsTable,
+			Columns: []string{template.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.TemplateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !tuo.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.VersionsTable,
+			Columns: []string{template.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.VersionsTable,
+			Columns: []string{template.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.ClonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ClonesTable,
+			Columns: []string{template.ClonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.Template
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedClonesIDs(); len(nodes) > 0 && !tuo.mutation.ClonesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ClonesTable,
+			Columns: []string{template.ClonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.Template
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ClonesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   template.ClonesTable,
+			Columns: []string{template.ClonesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tuo.schemaConfig.Template
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = tuo.schemaConfig.Template
 	ctx = internal.NewSchemaConfigContext(ctx, tuo.schemaConfig)
 	_node = &Template{config: tuo.config}
@@ -54,8 +54,14 @@ type TemplateHistory struct {
 	// the jsonschema object of the template
 	Jsonconfig customtypes.JSONObject `json:"jsonconfig,omitempty"`
 	// the uischema for the template to render in the UI
-	Uischema     customtypes.JSONObject `json:"uischema,omitempty"`
-	selectValues sql.SelectValues
+	Uischema customtypes.JSONObject `json:"uischema,omitempty"`
+	// the current version of the template, incremented each time the jsonconfig or uischema is changed
+	Version int `json:"version,omitempty"`
+	// the root template the template was cloned from
+	SourceTemplateID string `json:"source_template_id,omitempty"`
+	// the version of the root template the template was cloned from
+	SourceTemplateVersion int `json:"source_template_version,omitempty"`
+	selectValues          sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -67,7 +73,9 @@ func (*TemplateHistory) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case templatehistory.FieldOperation:
 			values[i] = new(enthistory.OpType)
-		case templatehistory.FieldID, templatehistory.FieldRef, templatehistory.FieldCreatedBy, templatehistory.FieldUpdatedBy, templatehistory.FieldDeletedBy, templatehistory.FieldMappingID, templatehistory.FieldOwnerID, templatehistory.FieldName, templatehistory.FieldTemplateType, templatehistory.FieldDescription:
+		case templatehistory.FieldVersion, templatehistory.FieldSourceTemplateVersion:
+			values[i] = new(sql.NullInt64)
+		case templatehistory.FieldID, templatehistory.FieldRef, templatehistory.FieldCreatedBy, templatehistory.FieldUpdatedBy, templatehistory.FieldDeletedBy, templatehistory.FieldMappingID, templatehistory.FieldOwnerID, templatehistory.FieldName, templatehistory.FieldTemplateType, templatehistory.FieldDescription, templatehistory.FieldSourceTemplateID:
 			values[i] = new(sql.NullString)
 		case templatehistory.FieldHistoryTime, templatehistory.FieldCreatedAt, templatehistory.FieldUpdatedAt, templatehistory.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -200,6 +208,24 @@ func (th *TemplateHistory) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field uischema: %w", err)
 				}
 			}
+		case templatehistory.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				th.Version = int(value.Int64)
+			}
+		case templatehistory.FieldSourceTemplateID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_template_id", values[i])
+			} else if value.Valid {
+				th.SourceTemplateID = value.String
+			}
+		case templatehistory.FieldSourceTemplateVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_template_version", values[i])
+			} else if value.Valid {
+				th.SourceTemplateVersion = int(value.Int64)
+			}
 		default:
 			th.selectValues.Set(columns[i], values[i])
 		}
@@ -286,6 +312,15 @@ func (th *TemplateHistory) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("uischema=")
 	builder.WriteString(fmt.Sprintf("%v", th.Uischema))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", th.Version))
+	builder.WriteString(", ")
+	builder.WriteString("source_template_id=")
+	builder.WriteString(th.SourceTemplateID)
+	builder.WriteString(", ")
+	builder.WriteString("source_template_version=")
+	builder.WriteString(fmt.Sprintf("%v", th.SourceTemplateVersion))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -52,6 +52,12 @@ const (
 	FieldJsonconfig = "jsonconfig"
 	// FieldUischema holds the string denoting the uischema field in the database.
 	FieldUischema = "uischema"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldSourceTemplateID holds the string denoting the source_template_id field in the database.
+	FieldSourceTemplateID = "source_template_id"
+	// FieldSourceTemplateVersion holds the string denoting the source_template_version field in the database.
+	FieldSourceTemplateVersion = "source_template_version"
 	// Table holds the table name of the templatehistory in the database.
 	Table = "template_history"
 )
@@ -76,6 +82,9 @@ var Columns = []string{
 	FieldDescription,
 	FieldJsonconfig,
 	FieldUischema,
+	FieldVersion,
+	FieldSourceTemplateID,
+	FieldSourceTemplateVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -109,6 +118,8 @@ var (
 	DefaultMappingID func() string
 	// DefaultTags holds the default value on creation for the "tags" field.
 	DefaultTags []string
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -213,6 +224,21 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// BySourceTemplateID orders the results by the source_template_id field.
+func BySourceTemplateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceTemplateID, opts...).ToFunc()
+}
+
+// BySourceTemplateVersion orders the results by the source_template_version field.
+func BySourceTemplateVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceTemplateVersion, opts...).ToFunc()
+}
+
 var (
 	// enthistory.OpType must implement graphql.Marshaler.
 	_ graphql.Marshaler = (*enthistory.OpType)(nil)
@@ -126,6 +126,21 @@ func Description(v string) predicate.TemplateHistory {
 	return predicate.TemplateHistory(sql.FieldEQ(FieldDescription, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldEQ(FieldVersion, v))
+}
+
+// SourceTemplateID applies equality check predicate on the "source_template_id" field. It's identical to SourceTemplateIDEQ.
+func SourceTemplateID(v string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldEQ(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateVersion applies equality check predicate on the "source_template_version" field. It's identical to SourceTemplateVersionEQ.
+func SourceTemplateVersion(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldEQ(FieldSourceTemplateVersion, v))
+}
+
 // HistoryTimeEQ applies the EQ predicate on the "history_time" field.
 func HistoryTimeEQ(v time.Time) predicate.TemplateHistory {
 	return predicate.TemplateHistory(sql.FieldEQ(FieldHistoryTime, v))
@@ -966,6 +981,171 @@ func UischemaNotNil() predicate.TemplateHistory {
 	return predicate.TemplateHistory(sql.FieldNotNull(FieldUischema))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldLTE(FieldVersion, v))
+}
+
+// SourceTemplateIDEQ applies the EQ predicate on the "source_template_id" field.
+func SourceTemplateIDEQ(v string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldEQ(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDNEQ applies the NEQ predicate on the "source_template_id" field.
+func SourceTemplateIDNEQ(v string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldNEQ(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDIn applies the In predicate on the "source_template_id" field.
+func SourceTemplateIDIn(vs ...string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldIn(FieldSourceTemplateID, vs...))
+}
+
+// SourceTemplateIDNotIn applies the NotIn predicate on the "source_template_id" field.
+func SourceTemplateIDNotIn(vs ...string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldNotIn(FieldSourceTemplateID, vs...))
+}
+
+// SourceTemplateIDGT applies the GT predicate on the "source_template_id" field.
+func SourceTemplateIDGT(v string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldGT(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDGTE applies the GTE predicate on the "source_template_id" field.
+func SourceTemplateIDGTE(v string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldGTE(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDLT applies the LT predicate on the "source_template_id" field.
+func SourceTemplateIDLT(v string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldLT(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDLTE applies the LTE predicate on the "source_template_id" field.
+func SourceTemplateIDLTE(v string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldLTE(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDContains applies the Contains predicate on the "source_template_id" field.
+func SourceTemplateIDContains(v string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldContains(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDHasPrefix applies the HasPrefix predicate on the "source_template_id" field.
+func SourceTemplateIDHasPrefix(v string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldHasPrefix(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDHasSuffix applies the HasSuffix predicate on the "source_template_id" field.
+func SourceTemplateIDHasSuffix(v string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldHasSuffix(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDIsNil applies the IsNil predicate on the "source_template_id" field.
+func SourceTemplateIDIsNil() predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldIsNull(FieldSourceTemplateID))
+}
+
+// SourceTemplateIDNotNil applies the NotNil predicate on the "source_template_id" field.
+func SourceTemplateIDNotNil() predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldNotNull(FieldSourceTemplateID))
+}
+
+// SourceTemplateIDEqualFold applies the EqualFold predicate on the "source_template_id" field.
+func SourceTemplateIDEqualFold(v string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldEqualFold(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateIDContainsFold applies the ContainsFold predicate on the "source_template_id" field.
+func SourceTemplateIDContainsFold(v string) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldContainsFold(FieldSourceTemplateID, v))
+}
+
+// SourceTemplateVersionEQ applies the EQ predicate on the "source_template_version" field.
+func SourceTemplateVersionEQ(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldEQ(FieldSourceTemplateVersion, v))
+}
+
+// SourceTemplateVersionNEQ applies the NEQ predicate on the "source_template_version" field.
+func SourceTemplateVersionNEQ(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldNEQ(FieldSourceTemplateVersion, v))
+}
+
+// SourceTemplateVersionIn applies the In predicate on the "source_template_version" field.
+func SourceTemplateVersionIn(vs ...int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldIn(FieldSourceTemplateVersion, vs...))
+}
+
+// SourceTemplateVersionNotIn applies the NotIn predicate on the "source_template_version" field.
+func SourceTemplateVersionNotIn(vs ...int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldNotIn(FieldSourceTemplateVersion, vs...))
+}
+
+// SourceTemplateVersionGT applies the GT predicate on the "source_template_version" field.
+func SourceTemplateVersionGT(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldGT(FieldSourceTemplateVersion, v))
+}
+
+// SourceTemplateVersionGTE applies the GTE predicate on the "source_template_version" field.
+func SourceTemplateVersionGTE(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldGTE(FieldSourceTemplateVersion, v))
+}
+
+// SourceTemplateVersionLT applies the LT predicate on the "source_template_version" field.
+func SourceTemplateVersionLT(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldLT(FieldSourceTemplateVersion, v))
+}
+
+// SourceTemplateVersionLTE applies the LTE predicate on the "source_template_version" field.
+func SourceTemplateVersionLTE(v int) predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldLTE(FieldSourceTemplateVersion, v))
+}
+
+// SourceTemplateVersionIsNil applies the IsNil predicate on the "source_template_version" field.
+func SourceTemplateVersionIsNil() predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldIsNull(FieldSourceTemplateVersion))
+}
+
+// SourceTemplateVersionNotNil applies the NotNil predicate on the "source_template_version" field.
+func SourceTemplateVersionNotNil() predicate.TemplateHistory {
+	return predicate.TemplateHistory(sql.FieldNotNull(FieldSourceTemplateVersion))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.TemplateHistory) predicate.TemplateHistory {
 	return predicate.TemplateHistory(sql.AndPredicates(predicates...))
@@ -221,6 +221,48 @@ func (thc *TemplateHistoryCreate) SetUischema(co customtypes.JSONObject) *Templa
 	return thc
 }
 
+// SetVersion sets the "version" field.
+func (thc *TemplateHistoryCreate) SetVersion(i int) *TemplateHistoryCreate {
+	thc.mutation.SetVersion(i)
+	return thc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (thc *TemplateHistoryCreate) SetNillableVersion(i *int) *TemplateHistoryCreate {
+	if i != nil {
+		thc.SetVersion(*i)
+	}
+	return thc
+}
+
+// SetSourceTemplateID sets the "source_template_id" field.
+func (thc *TemplateHistoryCreate) SetSourceTemplateID(s string) *TemplateHistoryCreate {
+	thc.mutation.SetSourceTemplateID(s)
+	return thc
+}
+
+// SetNillableSourceTemplateID sets the "source_template_id" field if the given value is not nil.
+func (thc *TemplateHistoryCreate) SetNillableSourceTemplateID(s *string) *TemplateHistoryCreate {
+	if s != nil {
+		thc.SetSourceTemplateID(*s)
+	}
+	return thc
+}
+
+// SetSourceTemplateVersion sets the "source_template_version" field.
+func (thc *TemplateHistoryCreate) SetSourceTemplateVersion(i int) *TemplateHistoryCreate {
+	thc.mutation.SetSourceTemplateVersion(i)
+	return thc
+}
+
+// SetNillableSourceTemplateVersion sets the "source_template_version" field if the given value is not nil.
+func (thc *TemplateHistoryCreate) SetNillableSourceTemplateVersion(i *int) *TemplateHistoryCreate {
+	if i != nil {
+		thc.SetSourceTemplateVersion(*i)
+	}
+	return thc
+}
+
 // SetID sets the "id" field.
 func (thc *TemplateHistoryCreate) SetID(s string) *TemplateHistoryCreate {
 	thc.mutation.SetID(s)
@@ -308,6 +350,10 @@ func (thc *TemplateHistoryCreate) defaults() error {
 		v := templatehistory.DefaultTemplateType
 		thc.mutation.SetTemplateType(v)
 	}
+	if _, ok := thc.mutation.Version(); !ok {
+		v := templatehistory.DefaultVersion
+		thc.mutation.SetVersion(v)
+	}
 	if _, ok := thc.mutation.ID(); !ok {
 		if templatehistory.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized templatehistory.DefaultID (forgotten import generated/runtime?)")
@@ -348,6 +394,9 @@ func (thc *TemplateHistoryCreate) check() error {
 	if _, ok := thc.mutation.Jsonconfig(); !ok {
 		return &ValidationError{Name: "jsonconfig", err: errors.New(`generated: missing required field "TemplateHistory.jsonconfig"`)}
 	}
+	if _, ok := thc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "TemplateHistory.version"`)}
+	}
 	return nil
 }
 
@@ -452,6 +501,18 @@ func (thc *TemplateHistoryCreate) createSpec() (*TemplateHistory, *sqlgraph.Crea
 		_spec.SetField(templatehistory.FieldUischema, field.TypeJSON, value)
 		_node.Uischema = value
 	}
+	if value, ok := thc.mutation.Version(); ok {
+		_spec.SetField(templatehistory.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := thc.mutation.SourceTemplateID(); ok {
+		_spec.SetField(templatehistory.FieldSourceTemplateID, field.TypeString, value)
+		_node.SourceTemplateID = value
+	}
+	if value, ok := thc.mutation.SourceTemplateVersion(); ok {
+		_spec.SetField(templatehistory.FieldSourceTemplateVersion, field.TypeInt, value)
+		_node.SourceTemplateVersion = value
+	}
 	return _node, _spec
 }
 
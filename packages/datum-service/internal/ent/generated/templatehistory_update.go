@@ -209,6 +209,27 @@ func (thu *TemplateHistoryUpdate) ClearUischema() *TemplateHistoryUpdate {
 	return thu
 }
 
+// SetVersion sets the "version" field.
+func (thu *TemplateHistoryUpdate) SetVersion(i int) *TemplateHistoryUpdate {
+	thu.mutation.ResetVersion()
+	thu.mutation.SetVersion(i)
+	return thu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (thu *TemplateHistoryUpdate) SetNillableVersion(i *int) *TemplateHistoryUpdate {
+	if i != nil {
+		thu.SetVersion(*i)
+	}
+	return thu
+}
+
+// AddVersion adds i to the "version" field.
+func (thu *TemplateHistoryUpdate) AddVersion(i int) *TemplateHistoryUpdate {
+	thu.mutation.AddVersion(i)
+	return thu
+}
+
 // Mutation returns the TemplateHistoryMutation object of the builder.
 func (thu *TemplateHistoryUpdate) Mutation() *TemplateHistoryMutation {
 	return thu.mutation
@@ -349,6 +370,18 @@ func (thu *TemplateHistoryUpdate) sqlSave(ctx context.Context) (n int, err error
 	if thu.mutation.UischemaCleared() {
 		_spec.ClearField(templatehistory.FieldUischema, field.TypeJSON)
 	}
+	if value, ok := thu.mutation.Version(); ok {
+		_spec.SetField(templatehistory.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := thu.mutation.AddedVersion(); ok {
+		_spec.AddField(templatehistory.FieldVersion, field.TypeInt, value)
+	}
+	if thu.mutation.SourceTemplateIDCleared() {
+		_spec.ClearField(templatehistory.FieldSourceTemplateID, field.TypeString)
+	}
+	if thu.mutation.SourceTemplateVersionCleared() {
+		_spec.ClearField(templatehistory.FieldSourceTemplateVersion, field.TypeInt)
+	}
 	_spec.Node.Schema = thu.schemaConfig.TemplateHistory
 	ctx = internal.NewSchemaConfigContext(ctx, thu.schemaConfig)
 	if n, err = sqlgraph.UpdateNodes(ctx, thu.driver, _spec); err != nil {
@@ -547,6 +580,27 @@ func (thuo *TemplateHistoryUpdateOne) ClearUischema() *TemplateHistoryUpdateOne
 	return thuo
 }
 
+// SetVersion sets the "version" field.
+func (thuo *TemplateHistoryUpdateOne) SetVersion(i int) *TemplateHistoryUpdateOne {
+	thuo.mutation.ResetVersion()
+	thuo.mutation.SetVersion(i)
+	return thuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (thuo *TemplateHistoryUpdateOne) SetNillableVersion(i *int) *TemplateHistoryUpdateOne {
+	if i != nil {
+		thuo.SetVersion(*i)
+	}
+	return thuo
+}
+
+// AddVersion adds i to the "version" field.
+func (thuo *TemplateHistoryUpdateOne) AddVersion(i int) *TemplateHistoryUpdateOne {
+	thuo.mutation.AddVersion(i)
+	return thuo
+}
+
 // Mutation returns the TemplateHistoryMutation object of the builder.
 func (thuo *TemplateHistoryUpdateOne) Mutation() *TemplateHistoryMutation {
 	return thuo.mutation
@@ -717,6 +771,18 @@ func (thuo *TemplateHistoryUpdateOne) sqlSave(ctx context.Context) (_node *Templ
 	if thuo.mutation.UischemaCleared() {
 		_spec.ClearField(templatehistory.FieldUischema, field.TypeJSON)
 	}
+	if value, ok := thuo.mutation.Version(); ok {
+		_spec.SetField(templatehistory.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := thuo.mutation.AddedVersion(); ok {
+		_spec.AddField(templatehistory.FieldVersion, field.TypeInt, value)
+	}
+	if thuo.mutation.SourceTemplateIDCleared() {
+		_spec.ClearField(templatehistory.FieldSourceTemplateID, field.TypeString)
+	}
+	if thuo.mutation.SourceTemplateVersionCleared() {
+		_spec.ClearField(templatehistory.FieldSourceTemplateVersion, field.TypeInt)
+	}
 	_spec.Node.Schema = thuo.schemaConfig.TemplateHistory
 	ctx = internal.NewSchemaConfigContext(ctx, thuo.schemaConfig)
 	_node = &TemplateHistory{config: thuo.config}
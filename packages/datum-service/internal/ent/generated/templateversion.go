@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/datumforge/datum/internal/ent/customtypes"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/template"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
+)
+
+// TemplateVersion is the model entity for the TemplateVersion schema.
+type TemplateVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// MappingID holds the value of the "mapping_id" field.
+	MappingID string `json:"mapping_id,omitempty"`
+	// The organization id that owns the object
+	OwnerID string `json:"owner_id,omitempty"`
+	// the template the version belongs to
+	TemplateID string `json:"template_id,omitempty"`
+	// the version number of the template, starting at 1
+	Version int `json:"version,omitempty"`
+	// the jsonschema object of the template at the version
+	Jsonconfig customtypes.JSONObject `json:"jsonconfig,omitempty"`
+	// the uischema of the template at the version
+	Uischema customtypes.JSONObject `json:"uischema,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TemplateVersionQuery when eager-loading is set.
+	Edges        TemplateVersionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TemplateVersionEdges holds the relations/edges for other nodes in the graph.
+type TemplateVersionEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *Organization `json:"owner,omitempty"`
+	// Template holds the value of the template edge.
+	Template *Template `json:"template,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TemplateVersionEdges) OwnerOrErr() (*Organization, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// TemplateOrErr returns the Template value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TemplateVersionEdges) TemplateOrErr() (*Template, error) {
+	if e.Template != nil {
+		return e.Template, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: template.Label}
+	}
+	return nil, &NotLoadedError{edge: "template"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TemplateVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case templateversion.FieldJsonconfig, templateversion.FieldUischema:
+			values[i] = new([]byte)
+		case templateversion.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case templateversion.FieldID, templateversion.FieldCreatedBy, templateversion.FieldUpdatedBy, templateversion.FieldDeletedBy, templateversion.FieldMappingID, templateversion.FieldOwnerID, templateversion.FieldTemplateID:
+			values[i] = new(sql.NullString)
+		case templateversion.FieldCreatedAt, templateversion.FieldUpdatedAt, templateversion.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TemplateVersion fields.
+func (tv *TemplateVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case templateversion.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				tv.ID = value.String
+			}
+		case templateversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tv.CreatedAt = value.Time
+			}
+		case templateversion.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tv.UpdatedAt = value.Time
+			}
+		case templateversion.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				tv.CreatedBy = value.String
+			}
+		case templateversion.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				tv.UpdatedBy = value.String
+			}
+		case templateversion.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				tv.DeletedAt = value.Time
+			}
+		case templateversion.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				tv.DeletedBy = value.String
+			}
+		case templateversion.FieldMappingID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mapping_id", values[i])
+			} else if value.Valid {
+				tv.MappingID = value.String
+			}
+		case templateversion.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				tv.OwnerID = value.String
+			}
+		case templateversion.FieldTemplateID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template_id", values[i])
+			} else if value.Valid {
+				tv.TemplateID = value.String
+			}
+		case templateversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				tv.Version = int(value.Int64)
+			}
+		case templateversion.FieldJsonconfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field jsonconfig", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tv.Jsonconfig); err != nil {
+					return fmt.Errorf("unmarshal field jsonconfig: %w", err)
+				}
+			}
+		case templateversion.FieldUischema:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field uischema", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tv.Uischema); err != nil {
+					return fmt.Errorf("unmarshal field uischema: %w", err)
+				}
+			}
+		default:
+			tv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TemplateVersion.
+// This includes values selected through modifiers, order, etc.
+func (tv *TemplateVersion) Value(name string) (ent.Value, error) {
+	return tv.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the TemplateVersion entity.
+func (tv *TemplateVersion) QueryOwner() *OrganizationQuery {
+	return NewTemplateVersionClient(tv.config).QueryOwner(tv)
+}
+
+// QueryTemplate queries the "template" edge of the TemplateVersion entity.
+func (tv *TemplateVersion) QueryTemplate() *TemplateQuery {
+	return NewTemplateVersionClient(tv.config).QueryTemplate(tv)
+}
+
+// Update returns a builder for updating this TemplateVersion.
+// Note that you need to call TemplateVersion.Unwrap() before calling this method if this TemplateVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tv *TemplateVersion) Update() *TemplateVersionUpdateOne {
+	return NewTemplateVersionClient(tv.config).UpdateOne(tv)
+}
+
+// Unwrap unwraps the TemplateVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tv *TemplateVersion) Unwrap() *TemplateVersion {
+	_tx, ok := tv.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: TemplateVersion is not a transactional entity")
+	}
+	tv.config.driver = _tx.drv
+	return tv
+}
+
+// String implements the fmt.Stringer.
+func (tv *TemplateVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("TemplateVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tv.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tv.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(tv.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(tv.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(tv.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(tv.DeletedBy)
+	builder.WriteString(", ")
+	builder.WriteString("mapping_id=")
+	builder.WriteString(tv.MappingID)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(tv.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("template_id=")
+	builder.WriteString(tv.TemplateID)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", tv.Version))
+	builder.WriteString(", ")
+	builder.WriteString("jsonconfig=")
+	builder.WriteString(fmt.Sprintf("%v", tv.Jsonconfig))
+	builder.WriteString(", ")
+	builder.WriteString("uischema=")
+	builder.WriteString(fmt.Sprintf("%v", tv.Uischema))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TemplateVersions is a parsable slice of TemplateVersion.
+type TemplateVersions []*TemplateVersion
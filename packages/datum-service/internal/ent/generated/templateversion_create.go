@@ -0,0 +1,473 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/customtypes"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/template"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
+)
+
+// TemplateVersionCreate is the builder for creating a TemplateVersion entity.
+type TemplateVersionCreate struct {
+	config
+	mutation *TemplateVersionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tvc *TemplateVersionCreate) SetCreatedAt(t time.Time) *TemplateVersionCreate {
+	tvc.mutation.SetCreatedAt(t)
+	return tvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tvc *TemplateVersionCreate) SetNillableCreatedAt(t *time.Time) *TemplateVersionCreate {
+	if t != nil {
+		tvc.SetCreatedAt(*t)
+	}
+	return tvc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tvc *TemplateVersionCreate) SetUpdatedAt(t time.Time) *TemplateVersionCreate {
+	tvc.mutation.SetUpdatedAt(t)
+	return tvc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tvc *TemplateVersionCreate) SetNillableUpdatedAt(t *time.Time) *TemplateVersionCreate {
+	if t != nil {
+		tvc.SetUpdatedAt(*t)
+	}
+	return tvc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (tvc *TemplateVersionCreate) SetCreatedBy(s string) *TemplateVersionCreate {
+	tvc.mutation.SetCreatedBy(s)
+	return tvc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (tvc *TemplateVersionCreate) SetNillableCreatedBy(s *string) *TemplateVersionCreate {
+	if s != nil {
+		tvc.SetCreatedBy(*s)
+	}
+	return tvc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (tvc *TemplateVersionCreate) SetUpdatedBy(s string) *TemplateVersionCreate {
+	tvc.mutation.SetUpdatedBy(s)
+	return tvc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (tvc *TemplateVersionCreate) SetNillableUpdatedBy(s *string) *TemplateVersionCreate {
+	if s != nil {
+		tvc.SetUpdatedBy(*s)
+	}
+	return tvc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tvc *TemplateVersionCreate) SetDeletedAt(t time.Time) *TemplateVersionCreate {
+	tvc.mutation.SetDeletedAt(t)
+	return tvc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tvc *TemplateVersionCreate) SetNillableDeletedAt(t *time.Time) *TemplateVersionCreate {
+	if t != nil {
+		tvc.SetDeletedAt(*t)
+	}
+	return tvc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (tvc *TemplateVersionCreate) SetDeletedBy(s string) *TemplateVersionCreate {
+	tvc.mutation.SetDeletedBy(s)
+	return tvc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (tvc *TemplateVersionCreate) SetNillableDeletedBy(s *string) *TemplateVersionCreate {
+	if s != nil {
+		tvc.SetDeletedBy(*s)
+	}
+	return tvc
+}
+
+// SetMappingID sets the "mapping_id" field.
+func (tvc *TemplateVersionCreate) SetMappingID(s string) *TemplateVersionCreate {
+	tvc.mutation.SetMappingID(s)
+	return tvc
+}
+
+// SetNillableMappingID sets the "mapping_id" field if the given value is not nil.
+func (tvc *TemplateVersionCreate) SetNillableMappingID(s *string) *TemplateVersionCreate {
+	if s != nil {
+		tvc.SetMappingID(*s)
+	}
+	return tvc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (tvc *TemplateVersionCreate) SetOwnerID(s string) *TemplateVersionCreate {
+	tvc.mutation.SetOwnerID(s)
+	return tvc
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (tvc *TemplateVersionCreate) SetNillableOwnerID(s *string) *TemplateVersionCreate {
+	if s != nil {
+		tvc.SetOwnerID(*s)
+	}
+	return tvc
+}
+
+// SetTemplateID sets the "template_id" field.
+func (tvc *TemplateVersionCreate) SetTemplateID(s string) *TemplateVersionCreate {
+	tvc.mutation.SetTemplateID(s)
+	return tvc
+}
+
+// SetVersion sets the "version" field.
+func (tvc *TemplateVersionCreate) SetVersion(i int) *TemplateVersionCreate {
+	tvc.mutation.SetVersion(i)
+	return tvc
+}
+
+// SetJsonconfig sets the "jsonconfig" field.
+func (tvc *TemplateVersionCreate) SetJsonconfig(co customtypes.JSONObject) *TemplateVersionCreate {
+	tvc.mutation.SetJsonconfig(co)
+	return tvc
+}
+
+// SetUischema sets the "uischema" field.
+func (tvc *TemplateVersionCreate) SetUischema(co customtypes.JSONObject) *TemplateVersionCreate {
+	tvc.mutation.SetUischema(co)
+	return tvc
+}
+
+// SetID sets the "id" field.
+func (tvc *TemplateVersionCreate) SetID(s string) *TemplateVersionCreate {
+	tvc.mutation.SetID(s)
+	return tvc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tvc *TemplateVersionCreate) SetNillableID(s *string) *TemplateVersionCreate {
+	if s != nil {
+		tvc.SetID(*s)
+	}
+	return tvc
+}
+
+// SetOwner sets the "owner" edge to the Organization entity.
+func (tvc *TemplateVersionCreate) SetOwner(o *Organization) *TemplateVersionCreate {
+	return tvc.SetOwnerID(o.ID)
+}
+
+// SetTemplate sets the "template" edge to the Template entity.
+func (tvc *TemplateVersionCreate) SetTemplate(t *Template) *TemplateVersionCreate {
+	return tvc.SetTemplateID(t.ID)
+}
+
+// Mutation returns the TemplateVersionMutation object of the builder.
+func (tvc *TemplateVersionCreate) Mutation() *TemplateVersionMutation {
+	return tvc.mutation
+}
+
+// Save creates the TemplateVersion in the database.
+func (tvc *TemplateVersionCreate) Save(ctx context.Context) (*TemplateVersion, error) {
+	if err := tvc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tvc.sqlSave, tvc.mutation, tvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tvc *TemplateVersionCreate) SaveX(ctx context.Context) *TemplateVersion {
+	v, err := tvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tvc *TemplateVersionCreate) Exec(ctx context.Context) error {
+	_, err := tvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvc *TemplateVersionCreate) ExecX(ctx context.Context) {
+	if err := tvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tvc *TemplateVersionCreate) defaults() error {
+	if _, ok := tvc.mutation.CreatedAt(); !ok {
+		if templateversion.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized templateversion.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := templateversion.DefaultCreatedAt()
+		tvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tvc.mutation.UpdatedAt(); !ok {
+		if templateversion.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized templateversion.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := templateversion.DefaultUpdatedAt()
+		tvc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := tvc.mutation.MappingID(); !ok {
+		if templateversion.DefaultMappingID == nil {
+			return fmt.Errorf("generated: uninitialized templateversion.DefaultMappingID (forgotten import generated/runtime?)")
+		}
+		v := templateversion.DefaultMappingID()
+		tvc.mutation.SetMappingID(v)
+	}
+	if _, ok := tvc.mutation.ID(); !ok {
+		if templateversion.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized templateversion.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := templateversion.DefaultID()
+		tvc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tvc *TemplateVersionCreate) check() error {
+	if _, ok := tvc.mutation.MappingID(); !ok {
+		return &ValidationError{Name: "mapping_id", err: errors.New(`generated: missing required field "TemplateVersion.mapping_id"`)}
+	}
+	if v, ok := tvc.mutation.OwnerID(); ok {
+		if err := templateversion.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`generated: validator failed for field "TemplateVersion.owner_id": %w`, err)}
+		}
+	}
+	if _, ok := tvc.mutation.TemplateID(); !ok {
+		return &ValidationError{Name: "template_id", err: errors.New(`generated: missing required field "TemplateVersion.template_id"`)}
+	}
+	if _, ok := tvc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "TemplateVersion.version"`)}
+	}
+	if _, ok := tvc.mutation.Jsonconfig(); !ok {
+		return &ValidationError{Name: "jsonconfig", err: errors.New(`generated: missing required field "TemplateVersion.jsonconfig"`)}
+	}
+	if len(tvc.mutation.TemplateIDs()) == 0 {
+		return &ValidationError{Name: "template", err: errors.New(`generated: missing required edge "TemplateVersion.template"`)}
+	}
+	return nil
+}
+
+func (tvc *TemplateVersionCreate) sqlSave(ctx context.Context) (*TemplateVersion, error) {
+	if err := tvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TemplateVersion.ID type: %T", _spec.ID.Value)
+		}
+	}
+	tvc.mutation.id = &_node.ID
+	tvc.mutation.done = true
+	return _node, nil
+}
+
+func (tvc *TemplateVersionCreate) createSpec() (*TemplateVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TemplateVersion{config: tvc.config}
+		_spec = sqlgraph.NewCreateSpec(templateversion.Table, sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString))
+	)
+	_spec.Schema = tvc.schemaConfig.TemplateVersion
+	if id, ok := tvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tvc.mutation.CreatedAt(); ok {
+		_spec.SetField(templateversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tvc.mutation.UpdatedAt(); ok {
+		_spec.SetField(templateversion.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tvc.mutation.CreatedBy(); ok {
+		_spec.SetField(templateversion.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := tvc.mutation.UpdatedBy(); ok {
+		_spec.SetField(templateversion.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := tvc.mutation.DeletedAt(); ok {
+		_spec.SetField(templateversion.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := tvc.mutation.DeletedBy(); ok {
+		_spec.SetField(templateversion.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := tvc.mutation.MappingID(); ok {
+		_spec.SetField(templateversion.FieldMappingID, field.TypeString, value)
+		_node.MappingID = value
+	}
+	if value, ok := tvc.mutation.Version(); ok {
+		_spec.SetField(templateversion.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := tvc.mutation.Jsonconfig(); ok {
+		_spec.SetField(templateversion.FieldJsonconfig, field.TypeJSON, value)
+		_node.Jsonconfig = value
+	}
+	if value, ok := tvc.mutation.Uischema(); ok {
+		_spec.SetField(templateversion.FieldUischema, field.TypeJSON, value)
+		_node.Uischema = value
+	}
+	if nodes := tvc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templateversion.OwnerTable,
+			Columns: []string{templateversion.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvc.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tvc.mutation.TemplateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templateversion.TemplateTable,
+			Columns: []string{templateversion.TemplateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvc.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TemplateID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TemplateVersionCreateBulk is the builder for creating many TemplateVersion entities in bulk.
+type TemplateVersionCreateBulk struct {
+	config
+	err      error
+	builders []*TemplateVersionCreate
+}
+
+// Save creates the TemplateVersion entities in the database.
+func (tvcb *TemplateVersionCreateBulk) Save(ctx context.Context) ([]*TemplateVersion, error) {
+	if tvcb.err != nil {
+		return nil, tvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tvcb.builders))
+	nodes := make([]*TemplateVersion, len(tvcb.builders))
+	mutators := make([]Mutator, len(tvcb.builders))
+	for i := range tvcb.builders {
+		func(i int, root context.Context) {
+			builder := tvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TemplateVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tvcb *TemplateVersionCreateBulk) SaveX(ctx context.Context) []*TemplateVersion {
+	v, err := tvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tvcb *TemplateVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := tvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvcb *TemplateVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := tvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
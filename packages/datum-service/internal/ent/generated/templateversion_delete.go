@@ -0,0 +1,92 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
+)
+
+// TemplateVersionDelete is the builder for deleting a TemplateVersion entity.
+type TemplateVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *TemplateVersionMutation
+}
+
+// Where appends a list predicates to the TemplateVersionDelete builder.
+func (tvd *TemplateVersionDelete) Where(ps ...predicate.TemplateVersion) *TemplateVersionDelete {
+	tvd.mutation.Where(ps...)
+	return tvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tvd *TemplateVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tvd.sqlExec, tvd.mutation, tvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvd *TemplateVersionDelete) ExecX(ctx context.Context) int {
+	n, err := tvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tvd *TemplateVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(templateversion.Table, sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString))
+	_spec.Node.Schema = tvd.schemaConfig.TemplateVersion
+	ctx = internal.NewSchemaConfigContext(ctx, tvd.schemaConfig)
+	if ps := tvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tvd.mutation.done = true
+	return affected, err
+}
+
+// TemplateVersionDeleteOne is the builder for deleting a single TemplateVersion entity.
+type TemplateVersionDeleteOne struct {
+	tvd *TemplateVersionDelete
+}
+
+// Where appends a list predicates to the TemplateVersionDelete builder.
+func (tvdo *TemplateVersionDeleteOne) Where(ps ...predicate.TemplateVersion) *TemplateVersionDeleteOne {
+	tvdo.tvd.mutation.Where(ps...)
+	return tvdo
+}
+
+// Exec executes the deletion query.
+func (tvdo *TemplateVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := tvdo.tvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{templateversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvdo *TemplateVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := tvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// TemplateVersionUpdate is the builder for updating TemplateVersion entities.
+type TemplateVersionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TemplateVersionMutation
+}
+
+// Where appends a list predicates to the TemplateVersionUpdate builder.
+func (tvu *TemplateVersionUpdate) Where(ps ...predicate.TemplateVersion) *TemplateVersionUpdate {
+	tvu.mutation.Where(ps...)
+	return tvu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tvu *TemplateVersionUpdate) SetUpdatedAt(t time.Time) *TemplateVersionUpdate {
+	tvu.mutation.SetUpdatedAt(t)
+	return tvu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (tvu *TemplateVersionUpdate) ClearUpdatedAt() *TemplateVersionUpdate {
+	tvu.mutation.ClearUpdatedAt()
+	return tvu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (tvu *TemplateVersionUpdate) SetUpdatedBy(s string) *TemplateVersionUpdate {
+	tvu.mutation.SetUpdatedBy(s)
+	return tvu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (tvu *TemplateVersionUpdate) SetNillableUpdatedBy(s *string) *TemplateVersionUpdate {
+	if s != nil {
+		tvu.SetUpdatedBy(*s)
+	}
+	return tvu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (tvu *TemplateVersionUpdate) ClearUpdatedBy() *TemplateVersionUpdate {
+	tvu.mutation.ClearUpdatedBy()
+	return tvu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tvu *TemplateVersionUpdate) SetDeletedAt(t time.Time) *TemplateVersionUpdate {
+	tvu.mutation.SetDeletedAt(t)
+	return tvu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tvu *TemplateVersionUpdate) SetNillableDeletedAt(t *time.Time) *TemplateVersionUpdate {
+	if t != nil {
+		tvu.SetDeletedAt(*t)
+	}
+	return tvu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tvu *TemplateVersionUpdate) ClearDeletedAt() *TemplateVersionUpdate {
+	tvu.mutation.ClearDeletedAt()
+	return tvu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (tvu *TemplateVersionUpdate) SetDeletedBy(s string) *TemplateVersionUpdate {
+	tvu.mutation.SetDeletedBy(s)
+	return tvu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (tvu *TemplateVersionUpdate) SetNillableDeletedBy(s *string) *TemplateVersionUpdate {
+	if s != nil {
+		tvu.SetDeletedBy(*s)
+	}
+	return tvu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (tvu *TemplateVersionUpdate) ClearDeletedBy() *TemplateVersionUpdate {
+	tvu.mutation.ClearDeletedBy()
+	return tvu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (tvu *TemplateVersionUpdate) SetOwnerID(s string) *TemplateVersionUpdate {
+	tvu.mutation.SetOwnerID(s)
+	return tvu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (tvu *TemplateVersionUpdate) SetNillableOwnerID(s *string) *TemplateVersionUpdate {
+	if s != nil {
+		tvu.SetOwnerID(*s)
+	}
+	return tvu
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (tvu *TemplateVersionUpdate) ClearOwnerID() *TemplateVersionUpdate {
+	tvu.mutation.ClearOwnerID()
+	return tvu
+}
+
+// SetOwner sets the "owner" edge to the Organization entity.
+func (tvu *TemplateVersionUpdate) SetOwner(o *Organization) *TemplateVersionUpdate {
+	return tvu.SetOwnerID(o.ID)
+}
+
+// Mutation returns the TemplateVersionMutation object of the builder.
+func (tvu *TemplateVersionUpdate) Mutation() *TemplateVersionMutation {
+	return tvu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the Organization entity.
+func (tvu *TemplateVersionUpdate) ClearOwner() *TemplateVersionUpdate {
+	tvu.mutation.ClearOwner()
+	return tvu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tvu *TemplateVersionUpdate) Save(ctx context.Context) (int, error) {
+	if err := tvu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, tvu.sqlSave, tvu.mutation, tvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tvu *TemplateVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := tvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tvu *TemplateVersionUpdate) Exec(ctx context.Context) error {
+	_, err := tvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvu *TemplateVersionUpdate) ExecX(ctx context.Context) {
+	if err := tvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tvu *TemplateVersionUpdate) defaults() error {
+	if _, ok := tvu.mutation.UpdatedAt(); !ok && !tvu.mutation.UpdatedAtCleared() {
+		if templateversion.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized templateversion.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := templateversion.UpdateDefaultUpdatedAt()
+		tvu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tvu *TemplateVersionUpdate) check() error {
+	if v, ok := tvu.mutation.OwnerID(); ok {
+		if err := templateversion.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`generated: validator failed for field "TemplateVersion.owner_id": %w`, err)}
+		}
+	}
+	if tvu.mutation.TemplateCleared() && len(tvu.mutation.TemplateIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "TemplateVersion.template"`)
+	}
+	return nil
+}
+
+func (tvu *TemplateVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(templateversion.Table, templateversion.Columns, sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString))
+	if ps := tvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if tvu.mutation.CreatedAtCleared() {
+		_spec.ClearField(templateversion.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := tvu.mutation.UpdatedAt(); ok {
+		_spec.SetField(templateversion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tvu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(templateversion.FieldUpdatedAt, field.TypeTime)
+	}
+	if tvu.mutation.CreatedByCleared() {
+		_spec.ClearField(templateversion.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := tvu.mutation.UpdatedBy(); ok {
+		_spec.SetField(templateversion.FieldUpdatedBy, field.TypeString, value)
+	}
+	if tvu.mutation.UpdatedByCleared() {
+		_spec.ClearField(templateversion.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := tvu.mutation.DeletedAt(); ok {
+		_spec.SetField(templateversion.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tvu.mutation.DeletedAtCleared() {
+		_spec.ClearField(templateversion.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tvu.mutation.DeletedBy(); ok {
+		_spec.SetField(templateversion.FieldDeletedBy, field.TypeString, value)
+	}
+	if tvu.mutation.DeletedByCleared() {
+		_spec.ClearField(templateversion.FieldDeletedBy, field.TypeString)
+	}
+	if tvu.mutation.UischemaCleared() {
+		_spec.ClearField(templateversion.FieldUischema, field.TypeJSON)
+	}
+	if tvu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templateversion.OwnerTable,
+			Columns: []string{templateversion.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvu.schemaConfig.TemplateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tvu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templateversion.OwnerTable,
+			Columns: []string{templateversion.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvu.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = tvu.schemaConfig.TemplateVersion
+	ctx = internal.NewSchemaConfigContext(ctx, tvu.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, tvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{templateversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tvu.mutation.done = true
+	return n, nil
+}
+
+// TemplateVersionUpdateOne is the builder for updating a single TemplateVersion entity.
+type TemplateVersionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TemplateVersionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tvuo *TemplateVersionUpdateOne) SetUpdatedAt(t time.Time) *TemplateVersionUpdateOne {
+	tvuo.mutation.SetUpdatedAt(t)
+	return tvuo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (tvuo *TemplateVersionUpdateOne) ClearUpdatedAt() *TemplateVersionUpdateOne {
+	tvuo.mutation.ClearUpdatedAt()
+	return tvuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (tvuo *TemplateVersionUpdateOne) SetUpdatedBy(s string) *TemplateVersionUpdateOne {
+	tvuo.mutation.SetUpdatedBy(s)
+	return tvuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (tvuo *TemplateVersionUpdateOne) SetNillableUpdatedBy(s *string) *TemplateVersionUpdateOne {
+	if s != nil {
+		tvuo.SetUpdatedBy(*s)
+	}
+	return tvuo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (tvuo *TemplateVersionUpdateOne) ClearUpdatedBy() *TemplateVersionUpdateOne {
+	tvuo.mutation.ClearUpdatedBy()
+	return tvuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tvuo *TemplateVersionUpdateOne) SetDeletedAt(t time.Time) *TemplateVersionUpdateOne {
+	tvuo.mutation.SetDeletedAt(t)
+	return tvuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tvuo *TemplateVersionUpdateOne) SetNillableDeletedAt(t *time.Time) *TemplateVersionUpdateOne {
+	if t != nil {
+		tvuo.SetDeletedAt(*t)
+	}
+	return tvuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tvuo *TemplateVersionUpdateOne) ClearDeletedAt() *TemplateVersionUpdateOne {
+	tvuo.mutation.ClearDeletedAt()
+	return tvuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (tvuo *TemplateVersionUpdateOne) SetDeletedBy(s string) *TemplateVersionUpdateOne {
+	tvuo.mutation.SetDeletedBy(s)
+	return tvuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (tvuo *TemplateVersionUpdateOne) SetNillableDeletedBy(s *string) *TemplateVersionUpdateOne {
+	if s != nil {
+		tvuo.SetDeletedBy(*s)
+	}
+	return tvuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (tvuo *TemplateVersionUpdateOne) ClearDeletedBy() *TemplateVersionUpdateOne {
+	tvuo.mutation.ClearDeletedBy()
+	return tvuo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (tvuo *TemplateVersionUpdateOne) SetOwnerID(s string) *TemplateVersionUpdateOne {
+	tvuo.mutation.SetOwnerID(s)
+	return tvuo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (tvuo *TemplateVersionUpdateOne) SetNillableOwnerID(s *string) *TemplateVersionUpdateOne {
+	if s != nil {
+		tvuo.SetOwnerID(*s)
+	}
+	return tvuo
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (tvuo *TemplateVersionUpdateOne) ClearOwnerID() *TemplateVersionUpdateOne {
+	tvuo.mutation.ClearOwnerID()
+	return tvuo
+}
+
+// SetOwner sets the "owner" edge to the Organization entity.
+func (tvuo *TemplateVersionUpdateOne) SetOwner(o *Organization) *TemplateVersionUpdateOne {
+	return tvuo.SetOwnerID(o.ID)
+}
+
+// Mutation returns the TemplateVersionMutation object of the builder.
+func (tvuo *TemplateVersionUpdateOne) Mutation() *TemplateVersionMutation {
+	return tvuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the Organization entity.
+func (tvuo *TemplateVersionUpdateOne) ClearOwner() *TemplateVersionUpdateOne {
+	tvuo.mutation.ClearOwner()
+	return tvuo
+}
+
+// Where appends a list predicates to the TemplateVersionUpdate builder.
+func (tvuo *TemplateVersionUpdateOne) Where(ps ...predicate.TemplateVersion) *TemplateVersionUpdateOne {
+	tvuo.mutation.Where(ps...)
+	return tvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tvuo *TemplateVersionUpdateOne) Select(field string, fields ...string) *TemplateVersionUpdateOne {
+	tvuo.fields = append([]string{field}, fields...)
+	return tvuo
+}
+
+// Save executes the query and returns the updated TemplateVersion entity.
+func (tvuo *TemplateVersionUpdateOne) Save(ctx context.Context) (*TemplateVersion, error) {
+	if err := tvuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tvuo.sqlSave, tvuo.mutation, tvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tvuo *TemplateVersionUpdateOne) SaveX(ctx context.Context) *TemplateVersion {
+	node, err := tvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tvuo *TemplateVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := tvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tvuo *TemplateVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := tvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tvuo *TemplateVersionUpdateOne) defaults() error {
+	if _, ok := tvuo.mutation.UpdatedAt(); !ok && !tvuo.mutation.UpdatedAtCleared() {
+		if templateversion.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized templateversion.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := templateversion.UpdateDefaultUpdatedAt()
+		tvuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tvuo *TemplateVersionUpdateOne) check() error {
+	if v, ok := tvuo.mutation.OwnerID(); ok {
+		if err := templateversion.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`generated: validator failed for field "TemplateVersion.owner_id": %w`, err)}
+		}
+	}
+	if tvuo.mutation.TemplateCleared() && len(tvuo.mutation.TemplateIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "TemplateVersion.template"`)
+	}
+	return nil
+}
+
+func (tvuo *TemplateVersionUpdateOne) sqlSave(ctx context.Context) (_node *TemplateVersion, err error) {
+	if err := tvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(templateversion.Table, templateversion.Columns, sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString))
+	id, ok := tvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "TemplateVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, templateversion.FieldID)
+		for _, f := range fields {
+			if !templateversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != templateversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if tvuo.mutation.CreatedAtCleared() {
+		_spec.ClearField(templateversion.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := tvuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(templateversion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tvuo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(templateversion.FieldUpdatedAt, field.TypeTime)
+	}
+	if tvuo.mutation.CreatedByCleared() {
+		_spec.ClearField(templateversion.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := tvuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(templateversion.FieldUpdatedBy, field.TypeString, value)
+	}
+	if tvuo.mutation.UpdatedByCleared() {
+		_spec.ClearField(templateversion.FieldUpdatedBy, field.TypeString)
+	}
+	if value, ok := tvuo.mutation.DeletedAt(); ok {
+		_spec.SetField(templateversion.FieldDeletedAt, field.TypeTime, value)
+	}
+	if tvuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(templateversion.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := tvuo.mutation.DeletedBy(); ok {
+		_spec.SetField(templateversion.FieldDeletedBy, field.TypeString, value)
+	}
+	if tvuo.mutation.DeletedByCleared() {
+		_spec.ClearField(templateversion.FieldDeletedBy, field.TypeString)
+	}
+	if tvuo.mutation.UischemaCleared() {
+		_spec.ClearField(templateversion.FieldUischema, field.TypeJSON)
+	}
+	if tvuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templateversion.OwnerTable,
+			Columns: []string{templateversion.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvuo.schemaConfig.TemplateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tvuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templateversion.OwnerTable,
+			Columns: []string{templateversion.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvuo.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = tvuo.schemaConfig.TemplateVersion
+	ctx = internal.NewSchemaConfigContext(ctx, tvuo.schemaConfig)
+	_node = &TemplateVersion{config: tvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{templateversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tvuo.mutation.done = true
+	return _node, nil
+}
@@ -49,6 +49,10 @@ type TFASetting struct {
 	EmailOtpAllowed bool `json:"email_otp_allowed,omitempty"`
 	// specifies a user may complete authentication by verifying a TOTP code delivered through an authenticator app
 	TotpAllowed bool `json:"totp_allowed,omitempty"`
+	// the ID of the login challenge the user can complete with a second factor, cleared once it is completed or invalidated
+	ChallengeID *string `json:"-"`
+	// the number of invalid codes provided for the login challenge
+	ChallengeAttempts int `json:"challenge_attempts,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TFASettingQuery when eager-loading is set.
 	Edges        TFASettingEdges `json:"edges"`
@@ -86,7 +90,9 @@ func (*TFASetting) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case tfasetting.FieldVerified, tfasetting.FieldPhoneOtpAllowed, tfasetting.FieldEmailOtpAllowed, tfasetting.FieldTotpAllowed:
 			values[i] = new(sql.NullBool)
-		case tfasetting.FieldID, tfasetting.FieldCreatedBy, tfasetting.FieldUpdatedBy, tfasetting.FieldMappingID, tfasetting.FieldDeletedBy, tfasetting.FieldOwnerID, tfasetting.FieldTfaSecret:
+		case tfasetting.FieldChallengeAttempts:
+			values[i] = new(sql.NullInt64)
+		case tfasetting.FieldID, tfasetting.FieldCreatedBy, tfasetting.FieldUpdatedBy, tfasetting.FieldMappingID, tfasetting.FieldDeletedBy, tfasetting.FieldOwnerID, tfasetting.FieldTfaSecret, tfasetting.FieldChallengeID:
 			values[i] = new(sql.NullString)
 		case tfasetting.FieldCreatedAt, tfasetting.FieldUpdatedAt, tfasetting.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -206,6 +212,19 @@ func (ts *TFASetting) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ts.TotpAllowed = value.Bool
 			}
+		case tfasetting.FieldChallengeID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field challenge_id", values[i])
+			} else if value.Valid {
+				ts.ChallengeID = new(string)
+				*ts.ChallengeID = value.String
+			}
+		case tfasetting.FieldChallengeAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field challenge_attempts", values[i])
+			} else if value.Valid {
+				ts.ChallengeAttempts = int(value.Int64)
+			}
 		default:
 			ts.selectValues.Set(columns[i], values[i])
 		}
@@ -293,6 +312,11 @@ func (ts *TFASetting) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("totp_allowed=")
 	builder.WriteString(fmt.Sprintf("%v", ts.TotpAllowed))
+	builder.WriteString(", ")
+	builder.WriteString("challenge_id=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("challenge_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", ts.ChallengeAttempts))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -45,6 +45,10 @@ const (
 	FieldEmailOtpAllowed = "email_otp_allowed"
 	// FieldTotpAllowed holds the string denoting the totp_allowed field in the database.
 	FieldTotpAllowed = "totp_allowed"
+	// FieldChallengeID holds the string denoting the challenge_id field in the database.
+	FieldChallengeID = "challenge_id"
+	// FieldChallengeAttempts holds the string denoting the challenge_attempts field in the database.
+	FieldChallengeAttempts = "challenge_attempts"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// Table holds the table name of the tfasetting in the database.
@@ -76,6 +80,8 @@ var Columns = []string{
 	FieldPhoneOtpAllowed,
 	FieldEmailOtpAllowed,
 	FieldTotpAllowed,
+	FieldChallengeID,
+	FieldChallengeAttempts,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -114,6 +120,8 @@ var (
 	DefaultEmailOtpAllowed bool
 	// DefaultTotpAllowed holds the default value on creation for the "totp_allowed" field.
 	DefaultTotpAllowed bool
+	// DefaultChallengeAttempts holds the default value on creation for the "challenge_attempts" field.
+	DefaultChallengeAttempts int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -191,6 +199,16 @@ func ByTotpAllowed(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotpAllowed, opts...).ToFunc()
 }
 
+// ByChallengeID orders the results by the challenge_id field.
+func ByChallengeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChallengeID, opts...).ToFunc()
+}
+
+// ByChallengeAttempts orders the results by the challenge_attempts field.
+func ByChallengeAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChallengeAttempts, opts...).ToFunc()
+}
+
 // ByOwnerField orders the results by owner field.
 func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -132,6 +132,16 @@ func TotpAllowed(v bool) predicate.TFASetting {
 	return predicate.TFASetting(sql.FieldEQ(FieldTotpAllowed, v))
 }
 
+// ChallengeID applies equality check predicate on the "challenge_id" field. It's identical to ChallengeIDEQ.
+func ChallengeID(v string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldEQ(FieldChallengeID, v))
+}
+
+// ChallengeAttempts applies equality check predicate on the "challenge_attempts" field. It's identical to ChallengeAttemptsEQ.
+func ChallengeAttempts(v int) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldEQ(FieldChallengeAttempts, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.TFASetting {
 	return predicate.TFASetting(sql.FieldEQ(FieldCreatedAt, v))
@@ -812,6 +822,121 @@ func TotpAllowedNotNil() predicate.TFASetting {
 	return predicate.TFASetting(sql.FieldNotNull(FieldTotpAllowed))
 }
 
+// ChallengeIDEQ applies the EQ predicate on the "challenge_id" field.
+func ChallengeIDEQ(v string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldEQ(FieldChallengeID, v))
+}
+
+// ChallengeIDNEQ applies the NEQ predicate on the "challenge_id" field.
+func ChallengeIDNEQ(v string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldNEQ(FieldChallengeID, v))
+}
+
+// ChallengeIDIn applies the In predicate on the "challenge_id" field.
+func ChallengeIDIn(vs ...string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldIn(FieldChallengeID, vs...))
+}
+
+// ChallengeIDNotIn applies the NotIn predicate on the "challenge_id" field.
+func ChallengeIDNotIn(vs ...string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldNotIn(FieldChallengeID, vs...))
+}
+
+// ChallengeIDGT applies the GT predicate on the "challenge_id" field.
+func ChallengeIDGT(v string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldGT(FieldChallengeID, v))
+}
+
+// ChallengeIDGTE applies the GTE predicate on the "challenge_id" field.
+func ChallengeIDGTE(v string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldGTE(FieldChallengeID, v))
+}
+
+// ChallengeIDLT applies the LT predicate on the "challenge_id" field.
+func ChallengeIDLT(v string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldLT(FieldChallengeID, v))
+}
+
+// ChallengeIDLTE applies the LTE predicate on the "challenge_id" field.
+func ChallengeIDLTE(v string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldLTE(FieldChallengeID, v))
+}
+
+// ChallengeIDContains applies the Contains predicate on the "challenge_id" field.
+func ChallengeIDContains(v string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldContains(FieldChallengeID, v))
+}
+
+// ChallengeIDHasPrefix applies the HasPrefix predicate on the "challenge_id" field.
+func ChallengeIDHasPrefix(v string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldHasPrefix(FieldChallengeID, v))
+}
+
+// ChallengeIDHasSuffix applies the HasSuffix predicate on the "challenge_id" field.
+func ChallengeIDHasSuffix(v string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldHasSuffix(FieldChallengeID, v))
+}
+
+// ChallengeIDIsNil applies the IsNil predicate on the "challenge_id" field.
+func ChallengeIDIsNil() predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldIsNull(FieldChallengeID))
+}
+
+// ChallengeIDNotNil applies the NotNil predicate on the "challenge_id" field.
+func ChallengeIDNotNil() predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldNotNull(FieldChallengeID))
+}
+
+// ChallengeIDEqualFold applies the EqualFold predicate on the "challenge_id" field.
+func ChallengeIDEqualFold(v string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldEqualFold(FieldChallengeID, v))
+}
+
+// ChallengeIDContainsFold applies the ContainsFold predicate on the "challenge_id" field.
+func ChallengeIDContainsFold(v string) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldContainsFold(FieldChallengeID, v))
+}
+
+// ChallengeAttemptsEQ applies the EQ predicate on the "challenge_attempts" field.
+func ChallengeAttemptsEQ(v int) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldEQ(FieldChallengeAttempts, v))
+}
+
+// ChallengeAttemptsNEQ applies the NEQ predicate on the "challenge_attempts" field.
+func ChallengeAttemptsNEQ(v int) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldNEQ(FieldChallengeAttempts, v))
+}
+
+// ChallengeAttemptsIn applies the In predicate on the "challenge_attempts" field.
+func ChallengeAttemptsIn(vs ...int) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldIn(FieldChallengeAttempts, vs...))
+}
+
+// ChallengeAttemptsNotIn applies the NotIn predicate on the "challenge_attempts" field.
+func ChallengeAttemptsNotIn(vs ...int) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldNotIn(FieldChallengeAttempts, vs...))
+}
+
+// ChallengeAttemptsGT applies the GT predicate on the "challenge_attempts" field.
+func ChallengeAttemptsGT(v int) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldGT(FieldChallengeAttempts, v))
+}
+
+// ChallengeAttemptsGTE applies the GTE predicate on the "challenge_attempts" field.
+func ChallengeAttemptsGTE(v int) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldGTE(FieldChallengeAttempts, v))
+}
+
+// ChallengeAttemptsLT applies the LT predicate on the "challenge_attempts" field.
+func ChallengeAttemptsLT(v int) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldLT(FieldChallengeAttempts, v))
+}
+
+// ChallengeAttemptsLTE applies the LTE predicate on the "challenge_attempts" field.
+func ChallengeAttemptsLTE(v int) predicate.TFASetting {
+	return predicate.TFASetting(sql.FieldLTE(FieldChallengeAttempts, v))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.TFASetting {
 	return predicate.TFASetting(func(s *sql.Selector) {
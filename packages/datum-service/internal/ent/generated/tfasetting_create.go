@@ -215,6 +215,34 @@ func (tsc *TFASettingCreate) SetNillableTotpAllowed(b *bool) *TFASettingCreate {
 	return tsc
 }
 
+// SetChallengeID sets the "challenge_id" field.
+func (tsc *TFASettingCreate) SetChallengeID(s string) *TFASettingCreate {
+	tsc.mutation.SetChallengeID(s)
+	return tsc
+}
+
+// SetNillableChallengeID sets the "challenge_id" field if the given value is not nil.
+func (tsc *TFASettingCreate) SetNillableChallengeID(s *string) *TFASettingCreate {
+	if s != nil {
+		tsc.SetChallengeID(*s)
+	}
+	return tsc
+}
+
+// SetChallengeAttempts sets the "challenge_attempts" field.
+func (tsc *TFASettingCreate) SetChallengeAttempts(i int) *TFASettingCreate {
+	tsc.mutation.SetChallengeAttempts(i)
+	return tsc
+}
+
+// SetNillableChallengeAttempts sets the "challenge_attempts" field if the given value is not nil.
+func (tsc *TFASettingCreate) SetNillableChallengeAttempts(i *int) *TFASettingCreate {
+	if i != nil {
+		tsc.SetChallengeAttempts(*i)
+	}
+	return tsc
+}
+
 // SetID sets the "id" field.
 func (tsc *TFASettingCreate) SetID(s string) *TFASettingCreate {
 	tsc.mutation.SetID(s)
@@ -312,6 +340,10 @@ func (tsc *TFASettingCreate) defaults() error {
 		v := tfasetting.DefaultTotpAllowed
 		tsc.mutation.SetTotpAllowed(v)
 	}
+	if _, ok := tsc.mutation.ChallengeAttempts(); !ok {
+		v := tfasetting.DefaultChallengeAttempts
+		tsc.mutation.SetChallengeAttempts(v)
+	}
 	if _, ok := tsc.mutation.ID(); !ok {
 		if tfasetting.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized tfasetting.DefaultID (forgotten import generated/runtime?)")
@@ -330,6 +362,9 @@ func (tsc *TFASettingCreate) check() error {
 	if _, ok := tsc.mutation.Verified(); !ok {
 		return &ValidationError{Name: "verified", err: errors.New(`generated: missing required field "TFASetting.verified"`)}
 	}
+	if _, ok := tsc.mutation.ChallengeAttempts(); !ok {
+		return &ValidationError{Name: "challenge_attempts", err: errors.New(`generated: missing required field "TFASetting.challenge_attempts"`)}
+	}
 	return nil
 }
 
@@ -422,6 +457,14 @@ func (tsc *TFASettingCreate) createSpec() (*TFASetting, *sqlgraph.CreateSpec) {
 		_spec.SetField(tfasetting.FieldTotpAllowed, field.TypeBool, value)
 		_node.TotpAllowed = value
 	}
+	if value, ok := tsc.mutation.ChallengeID(); ok {
+		_spec.SetField(tfasetting.FieldChallengeID, field.TypeString, value)
+		_node.ChallengeID = &value
+	}
+	if value, ok := tsc.mutation.ChallengeAttempts(); ok {
+		_spec.SetField(tfasetting.FieldChallengeAttempts, field.TypeInt, value)
+		_node.ChallengeAttempts = value
+	}
 	if nodes := tsc.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -254,6 +254,47 @@ func (tsu *TFASettingUpdate) ClearTotpAllowed() *TFASettingUpdate {
 	return tsu
 }
 
+// SetChallengeID sets the "challenge_id" field.
+func (tsu *TFASettingUpdate) SetChallengeID(s string) *TFASettingUpdate {
+	tsu.mutation.SetChallengeID(s)
+	return tsu
+}
+
+// SetNillableChallengeID sets the "challenge_id" field if the given value is not nil.
+func (tsu *TFASettingUpdate) SetNillableChallengeID(s *string) *TFASettingUpdate {
+	if s != nil {
+		tsu.SetChallengeID(*s)
+	}
+	return tsu
+}
+
+// ClearChallengeID clears the value of the "challenge_id" field.
+func (tsu *TFASettingUpdate) ClearChallengeID() *TFASettingUpdate {
+	tsu.mutation.ClearChallengeID()
+	return tsu
+}
+
+// SetChallengeAttempts sets the "challenge_attempts" field.
+func (tsu *TFASettingUpdate) SetChallengeAttempts(i int) *TFASettingUpdate {
+	tsu.mutation.ResetChallengeAttempts()
+	tsu.mutation.SetChallengeAttempts(i)
+	return tsu
+}
+
+// SetNillableChallengeAttempts sets the "challenge_attempts" field if the given value is not nil.
+func (tsu *TFASettingUpdate) SetNillableChallengeAttempts(i *int) *TFASettingUpdate {
+	if i != nil {
+		tsu.SetChallengeAttempts(*i)
+	}
+	return tsu
+}
+
+// AddChallengeAttempts adds i to the "challenge_attempts" field.
+func (tsu *TFASettingUpdate) AddChallengeAttempts(i int) *TFASettingUpdate {
+	tsu.mutation.AddChallengeAttempts(i)
+	return tsu
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (tsu *TFASettingUpdate) SetOwner(u *User) *TFASettingUpdate {
 	return tsu.SetOwnerID(u.ID)
@@ -400,6 +441,18 @@ func (tsu *TFASettingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tsu.mutation.TotpAllowedCleared() {
 		_spec.ClearField(tfasetting.FieldTotpAllowed, field.TypeBool)
 	}
+	if value, ok := tsu.mutation.ChallengeID(); ok {
+		_spec.SetField(tfasetting.FieldChallengeID, field.TypeString, value)
+	}
+	if tsu.mutation.ChallengeIDCleared() {
+		_spec.ClearField(tfasetting.FieldChallengeID, field.TypeString)
+	}
+	if value, ok := tsu.mutation.ChallengeAttempts(); ok {
+		_spec.SetField(tfasetting.FieldChallengeAttempts, field.TypeInt, value)
+	}
+	if value, ok := tsu.mutation.AddedChallengeAttempts(); ok {
+		_spec.AddField(tfasetting.FieldChallengeAttempts, field.TypeInt, value)
+	}
 	if tsu.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -675,6 +728,47 @@ func (tsuo *TFASettingUpdateOne) ClearTotpAllowed() *TFASettingUpdateOne {
 	return tsuo
 }
 
+// SetChallengeID sets the "challenge_id" field.
+func (tsuo *TFASettingUpdateOne) SetChallengeID(s string) *TFASettingUpdateOne {
+	tsuo.mutation.SetChallengeID(s)
+	return tsuo
+}
+
+// SetNillableChallengeID sets the "challenge_id" field if the given value is not nil.
+func (tsuo *TFASettingUpdateOne) SetNillableChallengeID(s *string) *TFASettingUpdateOne {
+	if s != nil {
+		tsuo.SetChallengeID(*s)
+	}
+	return tsuo
+}
+
+// ClearChallengeID clears the value of the "challenge_id" field.
+func (tsuo *TFASettingUpdateOne) ClearChallengeID() *TFASettingUpdateOne {
+	tsuo.mutation.ClearChallengeID()
+	return tsuo
+}
+
+// SetChallengeAttempts sets the "challenge_attempts" field.
+func (tsuo *TFASettingUpdateOne) SetChallengeAttempts(i int) *TFASettingUpdateOne {
+	tsuo.mutation.ResetChallengeAttempts()
+	tsuo.mutation.SetChallengeAttempts(i)
+	return tsuo
+}
+
+// SetNillableChallengeAttempts sets the "challenge_attempts" field if the given value is not nil.
+func (tsuo *TFASettingUpdateOne) SetNillableChallengeAttempts(i *int) *TFASettingUpdateOne {
+	if i != nil {
+		tsuo.SetChallengeAttempts(*i)
+	}
+	return tsuo
+}
+
+// AddChallengeAttempts adds i to the "challenge_attempts" field.
+func (tsuo *TFASettingUpdateOne) AddChallengeAttempts(i int) *TFASettingUpdateOne {
+	tsuo.mutation.AddChallengeAttempts(i)
+	return tsuo
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (tsuo *TFASettingUpdateOne) SetOwner(u *User) *TFASettingUpdateOne {
 	return tsuo.SetOwnerID(u.ID)
@@ -851,6 +945,18 @@ func (tsuo *TFASettingUpdateOne) sqlSave(ctx context.Context) (_node *TFASetting
 	if tsuo.mutation.TotpAllowedCleared() {
 		_spec.ClearField(tfasetting.FieldTotpAllowed, field.TypeBool)
 	}
+	if value, ok := tsuo.mutation.ChallengeID(); ok {
+		_spec.SetField(tfasetting.FieldChallengeID, field.TypeString, value)
+	}
+	if tsuo.mutation.ChallengeIDCleared() {
+		_spec.ClearField(tfasetting.FieldChallengeID, field.TypeString)
+	}
+	if value, ok := tsuo.mutation.ChallengeAttempts(); ok {
+		_spec.SetField(tfasetting.FieldChallengeAttempts, field.TypeInt, value)
+	}
+	if value, ok := tsuo.mutation.AddedChallengeAttempts(); ok {
+		_spec.AddField(tfasetting.FieldChallengeAttempts, field.TypeInt, value)
+	}
 	if tsuo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -41,6 +41,10 @@ type UserSetting struct {
 	UserID string `json:"user_id,omitempty"`
 	// user account is locked if unconfirmed or explicitly locked
 	Locked bool `json:"locked,omitempty"`
+	// the time the lock of the user account expires, a locked account without an expiration must be unlocked by an admin
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// the number of consecutive failed login attempts, the account is locked when the limit is reached
+	FailedLoginAttempts int `json:"failed_login_attempts,omitempty"`
 	// The time notifications regarding the user were silenced
 	SilencedAt *time.Time `json:"silenced_at,omitempty"`
 	// The time the user was suspended
@@ -106,9 +110,11 @@ func (*UserSetting) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case usersetting.FieldLocked, usersetting.FieldEmailConfirmed, usersetting.FieldIsWebauthnAllowed, usersetting.FieldIsTfaEnabled:
 			values[i] = new(sql.NullBool)
+		case usersetting.FieldFailedLoginAttempts:
+			values[i] = new(sql.NullInt64)
 		case usersetting.FieldID, usersetting.FieldCreatedBy, usersetting.FieldUpdatedBy, usersetting.FieldMappingID, usersetting.FieldDeletedBy, usersetting.FieldUserID, usersetting.FieldStatus, usersetting.FieldPhoneNumber:
 			values[i] = new(sql.NullString)
-		case usersetting.FieldCreatedAt, usersetting.FieldUpdatedAt, usersetting.FieldDeletedAt, usersetting.FieldSilencedAt, usersetting.FieldSuspendedAt:
+		case usersetting.FieldCreatedAt, usersetting.FieldUpdatedAt, usersetting.FieldDeletedAt, usersetting.FieldLockedUntil, usersetting.FieldSilencedAt, usersetting.FieldSuspendedAt:
 			values[i] = new(sql.NullTime)
 		case usersetting.ForeignKeys[0]: // user_setting_default_org
 			values[i] = new(sql.NullString)
@@ -195,6 +201,19 @@ func (us *UserSetting) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				us.Locked = value.Bool
 			}
+		case usersetting.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				us.LockedUntil = new(time.Time)
+				*us.LockedUntil = value.Time
+			}
+		case usersetting.FieldFailedLoginAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_login_attempts", values[i])
+			} else if value.Valid {
+				us.FailedLoginAttempts = int(value.Int64)
+			}
 		case usersetting.FieldSilencedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field silenced_at", values[i])
@@ -323,6 +342,14 @@ func (us *UserSetting) String() string {
 	builder.WriteString("locked=")
 	builder.WriteString(fmt.Sprintf("%v", us.Locked))
 	builder.WriteString(", ")
+	if v := us.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("failed_login_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", us.FailedLoginAttempts))
+	builder.WriteString(", ")
 	if v := us.SilencedAt; v != nil {
 		builder.WriteString("silenced_at=")
 		builder.WriteString(v.Format(time.ANSIC))
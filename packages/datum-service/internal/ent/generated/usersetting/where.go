@@ -113,6 +113,16 @@ func Locked(v bool) predicate.UserSetting {
 	return predicate.UserSetting(sql.FieldEQ(FieldLocked, v))
 }
 
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// FailedLoginAttempts applies equality check predicate on the "failed_login_attempts" field. It's identical to FailedLoginAttemptsEQ.
+func FailedLoginAttempts(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldFailedLoginAttempts, v))
+}
+
 // SilencedAt applies equality check predicate on the "silenced_at" field. It's identical to SilencedAtEQ.
 func SilencedAt(v time.Time) predicate.UserSetting {
 	return predicate.UserSetting(sql.FieldEQ(FieldSilencedAt, v))
@@ -678,6 +688,96 @@ func LockedNEQ(v bool) predicate.UserSetting {
 	return predicate.UserSetting(sql.FieldNEQ(FieldLocked, v))
 }
 
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// FailedLoginAttemptsEQ applies the EQ predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsEQ(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldEQ(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsNEQ applies the NEQ predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsNEQ(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNEQ(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsIn applies the In predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsIn(vs ...int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldIn(FieldFailedLoginAttempts, vs...))
+}
+
+// FailedLoginAttemptsNotIn applies the NotIn predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsNotIn(vs ...int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldNotIn(FieldFailedLoginAttempts, vs...))
+}
+
+// FailedLoginAttemptsGT applies the GT predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsGT(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGT(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsGTE applies the GTE predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsGTE(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldGTE(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsLT applies the LT predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsLT(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLT(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsLTE applies the LTE predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsLTE(v int) predicate.UserSetting {
+	return predicate.UserSetting(sql.FieldLTE(FieldFailedLoginAttempts, v))
+}
+
 // SilencedAtEQ applies the EQ predicate on the "silenced_at" field.
 func SilencedAtEQ(v time.Time) predicate.UserSetting {
 	return predicate.UserSetting(sql.FieldEQ(FieldSilencedAt, v))
@@ -155,6 +155,34 @@ func (usc *UserSettingCreate) SetNillableLocked(b *bool) *UserSettingCreate {
 	return usc
 }
 
+// SetLockedUntil sets the "locked_until" field.
+func (usc *UserSettingCreate) SetLockedUntil(t time.Time) *UserSettingCreate {
+	usc.mutation.SetLockedUntil(t)
+	return usc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (usc *UserSettingCreate) SetNillableLockedUntil(t *time.Time) *UserSettingCreate {
+	if t != nil {
+		usc.SetLockedUntil(*t)
+	}
+	return usc
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (usc *UserSettingCreate) SetFailedLoginAttempts(i int) *UserSettingCreate {
+	usc.mutation.SetFailedLoginAttempts(i)
+	return usc
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (usc *UserSettingCreate) SetNillableFailedLoginAttempts(i *int) *UserSettingCreate {
+	if i != nil {
+		usc.SetFailedLoginAttempts(*i)
+	}
+	return usc
+}
+
 // SetSilencedAt sets the "silenced_at" field.
 func (usc *UserSettingCreate) SetSilencedAt(t time.Time) *UserSettingCreate {
 	usc.mutation.SetSilencedAt(t)
@@ -357,6 +385,10 @@ func (usc *UserSettingCreate) defaults() error {
 		v := usersetting.DefaultLocked
 		usc.mutation.SetLocked(v)
 	}
+	if _, ok := usc.mutation.FailedLoginAttempts(); !ok {
+		v := usersetting.DefaultFailedLoginAttempts
+		usc.mutation.SetFailedLoginAttempts(v)
+	}
 	if _, ok := usc.mutation.Status(); !ok {
 		v := usersetting.DefaultStatus
 		usc.mutation.SetStatus(v)
@@ -391,6 +423,9 @@ func (usc *UserSettingCreate) check() error {
 	if _, ok := usc.mutation.Locked(); !ok {
 		return &ValidationError{Name: "locked", err: errors.New(`generated: missing required field "UserSetting.locked"`)}
 	}
+	if _, ok := usc.mutation.FailedLoginAttempts(); !ok {
+		return &ValidationError{Name: "failed_login_attempts", err: errors.New(`generated: missing required field "UserSetting.failed_login_attempts"`)}
+	}
 	if _, ok := usc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "UserSetting.status"`)}
 	}
@@ -474,6 +509,14 @@ func (usc *UserSettingCreate) createSpec() (*UserSetting, *sqlgraph.CreateSpec)
 		_spec.SetField(usersetting.FieldLocked, field.TypeBool, value)
 		_node.Locked = value
 	}
+	if value, ok := usc.mutation.LockedUntil(); ok {
+		_spec.SetField(usersetting.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := usc.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(usersetting.FieldFailedLoginAttempts, field.TypeInt, value)
+		_node.FailedLoginAttempts = value
+	}
 	if value, ok := usc.mutation.SilencedAt(); ok {
 		_spec.SetField(usersetting.FieldSilencedAt, field.TypeTime, value)
 		_node.SilencedAt = &value
@@ -158,6 +158,47 @@ func (usu *UserSettingUpdate) SetNillableLocked(b *bool) *UserSettingUpdate {
 	return usu
 }
 
+// SetLockedUntil sets the "locked_until" field.
+func (usu *UserSettingUpdate) SetLockedUntil(t time.Time) *UserSettingUpdate {
+	usu.mutation.SetLockedUntil(t)
+	return usu
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (usu *UserSettingUpdate) SetNillableLockedUntil(t *time.Time) *UserSettingUpdate {
+	if t != nil {
+		usu.SetLockedUntil(*t)
+	}
+	return usu
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (usu *UserSettingUpdate) ClearLockedUntil() *UserSettingUpdate {
+	usu.mutation.ClearLockedUntil()
+	return usu
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (usu *UserSettingUpdate) SetFailedLoginAttempts(i int) *UserSettingUpdate {
+	usu.mutation.ResetFailedLoginAttempts()
+	usu.mutation.SetFailedLoginAttempts(i)
+	return usu
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (usu *UserSettingUpdate) SetNillableFailedLoginAttempts(i *int) *UserSettingUpdate {
+	if i != nil {
+		usu.SetFailedLoginAttempts(*i)
+	}
+	return usu
+}
+
+// AddFailedLoginAttempts adds i to the "failed_login_attempts" field.
+func (usu *UserSettingUpdate) AddFailedLoginAttempts(i int) *UserSettingUpdate {
+	usu.mutation.AddFailedLoginAttempts(i)
+	return usu
+}
+
 // SetSilencedAt sets the "silenced_at" field.
 func (usu *UserSettingUpdate) SetSilencedAt(t time.Time) *UserSettingUpdate {
 	usu.mutation.SetSilencedAt(t)
@@ -435,6 +476,18 @@ func (usu *UserSettingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := usu.mutation.Locked(); ok {
 		_spec.SetField(usersetting.FieldLocked, field.TypeBool, value)
 	}
+	if value, ok := usu.mutation.LockedUntil(); ok {
+		_spec.SetField(usersetting.FieldLockedUntil, field.TypeTime, value)
+	}
+	if usu.mutation.LockedUntilCleared() {
+		_spec.ClearField(usersetting.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := usu.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(usersetting.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := usu.mutation.AddedFailedLoginAttempts(); ok {
+		_spec.AddField(usersetting.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
 	if value, ok := usu.mutation.SilencedAt(); ok {
 		_spec.SetField(usersetting.FieldSilencedAt, field.TypeTime, value)
 	}
@@ -679,6 +732,47 @@ func (usuo *UserSettingUpdateOne) SetNillableLocked(b *bool) *UserSettingUpdateO
 	return usuo
 }
 
+// SetLockedUntil sets the "locked_until" field.
+func (usuo *UserSettingUpdateOne) SetLockedUntil(t time.Time) *UserSettingUpdateOne {
+	usuo.mutation.SetLockedUntil(t)
+	return usuo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (usuo *UserSettingUpdateOne) SetNillableLockedUntil(t *time.Time) *UserSettingUpdateOne {
+	if t != nil {
+		usuo.SetLockedUntil(*t)
+	}
+	return usuo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (usuo *UserSettingUpdateOne) ClearLockedUntil() *UserSettingUpdateOne {
+	usuo.mutation.ClearLockedUntil()
+	return usuo
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (usuo *UserSettingUpdateOne) SetFailedLoginAttempts(i int) *UserSettingUpdateOne {
+	usuo.mutation.ResetFailedLoginAttempts()
+	usuo.mutation.SetFailedLoginAttempts(i)
+	return usuo
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (usuo *UserSettingUpdateOne) SetNillableFailedLoginAttempts(i *int) *UserSettingUpdateOne {
+	if i != nil {
+		usuo.SetFailedLoginAttempts(*i)
+	}
+	return usuo
+}
+
+// AddFailedLoginAttempts adds i to the "failed_login_attempts" field.
+func (usuo *UserSettingUpdateOne) AddFailedLoginAttempts(i int) *UserSettingUpdateOne {
+	usuo.mutation.AddFailedLoginAttempts(i)
+	return usuo
+}
+
 // SetSilencedAt sets the "silenced_at" field.
 func (usuo *UserSettingUpdateOne) SetSilencedAt(t time.Time) *UserSettingUpdateOne {
 	usuo.mutation.SetSilencedAt(t)
@@ -986,6 +1080,18 @@ func (usuo *UserSettingUpdateOne) sqlSave(ctx context.Context) (_node *UserSetti
 	if value, ok := usuo.mutation.Locked(); ok {
 		_spec.SetField(usersetting.FieldLocked, field.TypeBool, value)
 	}
+	if value, ok := usuo.mutation.LockedUntil(); ok {
+		_spec.SetField(usersetting.FieldLockedUntil, field.TypeTime, value)
+	}
+	if usuo.mutation.LockedUntilCleared() {
+		_spec.ClearField(usersetting.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := usuo.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(usersetting.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := usuo.mutation.AddedFailedLoginAttempts(); ok {
+		_spec.AddField(usersetting.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
 	if value, ok := usuo.mutation.SilencedAt(); ok {
 		_spec.SetField(usersetting.FieldSilencedAt, field.TypeTime, value)
 	}
@@ -46,6 +46,10 @@ type UserSettingHistory struct {
 	UserID string `json:"user_id,omitempty"`
 	// user account is locked if unconfirmed or explicitly locked
 	Locked bool `json:"locked,omitempty"`
+	// the time the lock of the user account expires, a locked account without an expiration must be unlocked by an admin
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// the number of consecutive failed login attempts, the account is locked when the limit is reached
+	FailedLoginAttempts int `json:"failed_login_attempts,omitempty"`
 	// The time notifications regarding the user were silenced
 	SilencedAt *time.Time `json:"silenced_at,omitempty"`
 	// The time the user was suspended
@@ -74,9 +78,11 @@ func (*UserSettingHistory) scanValues(columns []string) ([]any, error) {
 			values[i] = new(enthistory.OpType)
 		case usersettinghistory.FieldLocked, usersettinghistory.FieldEmailConfirmed, usersettinghistory.FieldIsWebauthnAllowed, usersettinghistory.FieldIsTfaEnabled:
 			values[i] = new(sql.NullBool)
+		case usersettinghistory.FieldFailedLoginAttempts:
+			values[i] = new(sql.NullInt64)
 		case usersettinghistory.FieldID, usersettinghistory.FieldRef, usersettinghistory.FieldCreatedBy, usersettinghistory.FieldUpdatedBy, usersettinghistory.FieldMappingID, usersettinghistory.FieldDeletedBy, usersettinghistory.FieldUserID, usersettinghistory.FieldStatus, usersettinghistory.FieldPhoneNumber:
 			values[i] = new(sql.NullString)
-		case usersettinghistory.FieldHistoryTime, usersettinghistory.FieldCreatedAt, usersettinghistory.FieldUpdatedAt, usersettinghistory.FieldDeletedAt, usersettinghistory.FieldSilencedAt, usersettinghistory.FieldSuspendedAt:
+		case usersettinghistory.FieldHistoryTime, usersettinghistory.FieldCreatedAt, usersettinghistory.FieldUpdatedAt, usersettinghistory.FieldDeletedAt, usersettinghistory.FieldLockedUntil, usersettinghistory.FieldSilencedAt, usersettinghistory.FieldSuspendedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -179,6 +185,19 @@ func (ush *UserSettingHistory) assignValues(columns []string, values []any) erro
 			} else if value.Valid {
 				ush.Locked = value.Bool
 			}
+		case usersettinghistory.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				ush.LockedUntil = new(time.Time)
+				*ush.LockedUntil = value.Time
+			}
+		case usersettinghistory.FieldFailedLoginAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_login_attempts", values[i])
+			} else if value.Valid {
+				ush.FailedLoginAttempts = int(value.Int64)
+			}
 		case usersettinghistory.FieldSilencedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field silenced_at", values[i])
@@ -299,6 +318,14 @@ func (ush *UserSettingHistory) String() string {
 	builder.WriteString("locked=")
 	builder.WriteString(fmt.Sprintf("%v", ush.Locked))
 	builder.WriteString(", ")
+	if v := ush.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("failed_login_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", ush.FailedLoginAttempts))
+	builder.WriteString(", ")
 	if v := ush.SilencedAt; v != nil {
 		builder.WriteString("silenced_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -44,6 +44,10 @@ const (
 	FieldUserID = "user_id"
 	// FieldLocked holds the string denoting the locked field in the database.
 	FieldLocked = "locked"
+	// FieldLockedUntil holds the string denoting the locked_until field in the database.
+	FieldLockedUntil = "locked_until"
+	// FieldFailedLoginAttempts holds the string denoting the failed_login_attempts field in the database.
+	FieldFailedLoginAttempts = "failed_login_attempts"
 	// FieldSilencedAt holds the string denoting the silenced_at field in the database.
 	FieldSilencedAt = "silenced_at"
 	// FieldSuspendedAt holds the string denoting the suspended_at field in the database.
@@ -78,6 +82,8 @@ var Columns = []string{
 	FieldDeletedBy,
 	FieldUserID,
 	FieldLocked,
+	FieldLockedUntil,
+	FieldFailedLoginAttempts,
 	FieldSilencedAt,
 	FieldSuspendedAt,
 	FieldStatus,
@@ -118,6 +124,8 @@ var (
 	DefaultTags []string
 	// DefaultLocked holds the default value on creation for the "locked" field.
 	DefaultLocked bool
+	// DefaultFailedLoginAttempts holds the default value on creation for the "failed_login_attempts" field.
+	DefaultFailedLoginAttempts int
 	// DefaultEmailConfirmed holds the default value on creation for the "email_confirmed" field.
 	DefaultEmailConfirmed bool
 	// DefaultIsWebauthnAllowed holds the default value on creation for the "is_webauthn_allowed" field.
@@ -218,6 +226,16 @@ func ByLocked(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLocked, opts...).ToFunc()
 }
 
+// ByLockedUntil orders the results by the locked_until field.
+func ByLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedUntil, opts...).ToFunc()
+}
+
+// ByFailedLoginAttempts orders the results by the failed_login_attempts field.
+func ByFailedLoginAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedLoginAttempts, opts...).ToFunc()
+}
+
 // BySilencedAt orders the results by the silenced_at field.
 func BySilencedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSilencedAt, opts...).ToFunc()
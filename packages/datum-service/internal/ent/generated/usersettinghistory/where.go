@@ -121,6 +121,16 @@ func Locked(v bool) predicate.UserSettingHistory {
 	return predicate.UserSettingHistory(sql.FieldEQ(FieldLocked, v))
 }
 
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// FailedLoginAttempts applies equality check predicate on the "failed_login_attempts" field. It's identical to FailedLoginAttemptsEQ.
+func FailedLoginAttempts(v int) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldEQ(FieldFailedLoginAttempts, v))
+}
+
 // SilencedAt applies equality check predicate on the "silenced_at" field. It's identical to SilencedAtEQ.
 func SilencedAt(v time.Time) predicate.UserSettingHistory {
 	return predicate.UserSettingHistory(sql.FieldEQ(FieldSilencedAt, v))
@@ -821,6 +831,96 @@ func LockedNEQ(v bool) predicate.UserSettingHistory {
 	return predicate.UserSettingHistory(sql.FieldNEQ(FieldLocked, v))
 }
 
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// FailedLoginAttemptsEQ applies the EQ predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsEQ(v int) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldEQ(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsNEQ applies the NEQ predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsNEQ(v int) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldNEQ(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsIn applies the In predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsIn(vs ...int) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldIn(FieldFailedLoginAttempts, vs...))
+}
+
+// FailedLoginAttemptsNotIn applies the NotIn predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsNotIn(vs ...int) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldNotIn(FieldFailedLoginAttempts, vs...))
+}
+
+// FailedLoginAttemptsGT applies the GT predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsGT(v int) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldGT(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsGTE applies the GTE predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsGTE(v int) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldGTE(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsLT applies the LT predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsLT(v int) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldLT(FieldFailedLoginAttempts, v))
+}
+
+// FailedLoginAttemptsLTE applies the LTE predicate on the "failed_login_attempts" field.
+func FailedLoginAttemptsLTE(v int) predicate.UserSettingHistory {
+	return predicate.UserSettingHistory(sql.FieldLTE(FieldFailedLoginAttempts, v))
+}
+
 // SilencedAtEQ applies the EQ predicate on the "silenced_at" field.
 func SilencedAtEQ(v time.Time) predicate.UserSettingHistory {
 	return predicate.UserSettingHistory(sql.FieldEQ(FieldSilencedAt, v))
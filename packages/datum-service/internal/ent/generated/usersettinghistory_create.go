@@ -188,6 +188,34 @@ func (ushc *UserSettingHistoryCreate) SetNillableLocked(b *bool) *UserSettingHis
 	return ushc
 }
 
+// SetLockedUntil sets the "locked_until" field.
+func (ushc *UserSettingHistoryCreate) SetLockedUntil(t time.Time) *UserSettingHistoryCreate {
+	ushc.mutation.SetLockedUntil(t)
+	return ushc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (ushc *UserSettingHistoryCreate) SetNillableLockedUntil(t *time.Time) *UserSettingHistoryCreate {
+	if t != nil {
+		ushc.SetLockedUntil(*t)
+	}
+	return ushc
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (ushc *UserSettingHistoryCreate) SetFailedLoginAttempts(i int) *UserSettingHistoryCreate {
+	ushc.mutation.SetFailedLoginAttempts(i)
+	return ushc
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (ushc *UserSettingHistoryCreate) SetNillableFailedLoginAttempts(i *int) *UserSettingHistoryCreate {
+	if i != nil {
+		ushc.SetFailedLoginAttempts(*i)
+	}
+	return ushc
+}
+
 // SetSilencedAt sets the "silenced_at" field.
 func (ushc *UserSettingHistoryCreate) SetSilencedAt(t time.Time) *UserSettingHistoryCreate {
 	ushc.mutation.SetSilencedAt(t)
@@ -359,6 +387,10 @@ func (ushc *UserSettingHistoryCreate) defaults() {
 		v := usersettinghistory.DefaultLocked
 		ushc.mutation.SetLocked(v)
 	}
+	if _, ok := ushc.mutation.FailedLoginAttempts(); !ok {
+		v := usersettinghistory.DefaultFailedLoginAttempts
+		ushc.mutation.SetFailedLoginAttempts(v)
+	}
 	if _, ok := ushc.mutation.Status(); !ok {
 		v := usersettinghistory.DefaultStatus
 		ushc.mutation.SetStatus(v)
@@ -400,6 +432,9 @@ func (ushc *UserSettingHistoryCreate) check() error {
 	if _, ok := ushc.mutation.Locked(); !ok {
 		return &ValidationError{Name: "locked", err: errors.New(`generated: missing required field "UserSettingHistory.locked"`)}
 	}
+	if _, ok := ushc.mutation.FailedLoginAttempts(); !ok {
+		return &ValidationError{Name: "failed_login_attempts", err: errors.New(`generated: missing required field "UserSettingHistory.failed_login_attempts"`)}
+	}
 	if _, ok := ushc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`generated: missing required field "UserSettingHistory.status"`)}
 	}
@@ -499,6 +534,14 @@ func (ushc *UserSettingHistoryCreate) createSpec() (*UserSettingHistory, *sqlgra
 		_spec.SetField(usersettinghistory.FieldLocked, field.TypeBool, value)
 		_node.Locked = value
 	}
+	if value, ok := ushc.mutation.LockedUntil(); ok {
+		_spec.SetField(usersettinghistory.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := ushc.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(usersettinghistory.FieldFailedLoginAttempts, field.TypeInt, value)
+		_node.FailedLoginAttempts = value
+	}
 	if value, ok := ushc.mutation.SilencedAt(); ok {
 		_spec.SetField(usersettinghistory.FieldSilencedAt, field.TypeTime, value)
 		_node.SilencedAt = &value
@@ -156,6 +156,47 @@ func (ushu *UserSettingHistoryUpdate) SetNillableLocked(b *bool) *UserSettingHis
 	return ushu
 }
 
+// SetLockedUntil sets the "locked_until" field.
+func (ushu *UserSettingHistoryUpdate) SetLockedUntil(t time.Time) *UserSettingHistoryUpdate {
+	ushu.mutation.SetLockedUntil(t)
+	return ushu
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (ushu *UserSettingHistoryUpdate) SetNillableLockedUntil(t *time.Time) *UserSettingHistoryUpdate {
+	if t != nil {
+		ushu.SetLockedUntil(*t)
+	}
+	return ushu
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (ushu *UserSettingHistoryUpdate) ClearLockedUntil() *UserSettingHistoryUpdate {
+	ushu.mutation.ClearLockedUntil()
+	return ushu
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (ushu *UserSettingHistoryUpdate) SetFailedLoginAttempts(i int) *UserSettingHistoryUpdate {
+	ushu.mutation.ResetFailedLoginAttempts()
+	ushu.mutation.SetFailedLoginAttempts(i)
+	return ushu
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (ushu *UserSettingHistoryUpdate) SetNillableFailedLoginAttempts(i *int) *UserSettingHistoryUpdate {
+	if i != nil {
+		ushu.SetFailedLoginAttempts(*i)
+	}
+	return ushu
+}
+
+// AddFailedLoginAttempts adds i to the "failed_login_attempts" field.
+func (ushu *UserSettingHistoryUpdate) AddFailedLoginAttempts(i int) *UserSettingHistoryUpdate {
+	ushu.mutation.AddFailedLoginAttempts(i)
+	return ushu
+}
+
 // SetSilencedAt sets the "silenced_at" field.
 func (ushu *UserSettingHistoryUpdate) SetSilencedAt(t time.Time) *UserSettingHistoryUpdate {
 	ushu.mutation.SetSilencedAt(t)
@@ -400,6 +441,18 @@ func (ushu *UserSettingHistoryUpdate) sqlSave(ctx context.Context) (n int, err e
 	if value, ok := ushu.mutation.Locked(); ok {
 		_spec.SetField(usersettinghistory.FieldLocked, field.TypeBool, value)
 	}
+	if value, ok := ushu.mutation.LockedUntil(); ok {
+		_spec.SetField(usersettinghistory.FieldLockedUntil, field.TypeTime, value)
+	}
+	if ushu.mutation.LockedUntilCleared() {
+		_spec.ClearField(usersettinghistory.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := ushu.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(usersettinghistory.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := ushu.mutation.AddedFailedLoginAttempts(); ok {
+		_spec.AddField(usersettinghistory.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
 	if value, ok := ushu.mutation.SilencedAt(); ok {
 		_spec.SetField(usersettinghistory.FieldSilencedAt, field.TypeTime, value)
 	}
@@ -582,6 +635,47 @@ func (ushuo *UserSettingHistoryUpdateOne) SetNillableLocked(b *bool) *UserSettin
 	return ushuo
 }
 
+// SetLockedUntil sets the "locked_until" field.
+func (ushuo *UserSettingHistoryUpdateOne) SetLockedUntil(t time.Time) *UserSettingHistoryUpdateOne {
+	ushuo.mutation.SetLockedUntil(t)
+	return ushuo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (ushuo *UserSettingHistoryUpdateOne) SetNillableLockedUntil(t *time.Time) *UserSettingHistoryUpdateOne {
+	if t != nil {
+		ushuo.SetLockedUntil(*t)
+	}
+	return ushuo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (ushuo *UserSettingHistoryUpdateOne) ClearLockedUntil() *UserSettingHistoryUpdateOne {
+	ushuo.mutation.ClearLockedUntil()
+	return ushuo
+}
+
+// SetFailedLoginAttempts sets the "failed_login_attempts" field.
+func (ushuo *UserSettingHistoryUpdateOne) SetFailedLoginAttempts(i int) *UserSettingHistoryUpdateOne {
+	ushuo.mutation.ResetFailedLoginAttempts()
+	ushuo.mutation.SetFailedLoginAttempts(i)
+	return ushuo
+}
+
+// SetNillableFailedLoginAttempts sets the "failed_login_attempts" field if the given value is not nil.
+func (ushuo *UserSettingHistoryUpdateOne) SetNillableFailedLoginAttempts(i *int) *UserSettingHistoryUpdateOne {
+	if i != nil {
+		ushuo.SetFailedLoginAttempts(*i)
+	}
+	return ushuo
+}
+
+// AddFailedLoginAttempts adds i to the "failed_login_attempts" field.
+func (ushuo *UserSettingHistoryUpdateOne) AddFailedLoginAttempts(i int) *UserSettingHistoryUpdateOne {
+	ushuo.mutation.AddFailedLoginAttempts(i)
+	return ushuo
+}
+
 // SetSilencedAt sets the "silenced_at" field.
 func (ushuo *UserSettingHistoryUpdateOne) SetSilencedAt(t time.Time) *UserSettingHistoryUpdateOne {
 	ushuo.mutation.SetSilencedAt(t)
@@ -856,6 +950,18 @@ func (ushuo *UserSettingHistoryUpdateOne) sqlSave(ctx context.Context) (_node *U
 	if value, ok := ushuo.mutation.Locked(); ok {
 		_spec.SetField(usersettinghistory.FieldLocked, field.TypeBool, value)
 	}
+	if value, ok := ushuo.mutation.LockedUntil(); ok {
+		_spec.SetField(usersettinghistory.FieldLockedUntil, field.TypeTime, value)
+	}
+	if ushuo.mutation.LockedUntilCleared() {
+		_spec.ClearField(usersettinghistory.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := ushuo.mutation.FailedLoginAttempts(); ok {
+		_spec.SetField(usersettinghistory.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
+	if value, ok := ushuo.mutation.AddedFailedLoginAttempts(); ok {
+		_spec.AddField(usersettinghistory.FieldFailedLoginAttempts, field.TypeInt, value)
+	}
 	if value, ok := ushuo.mutation.SilencedAt(); ok {
 		_spec.SetField(usersettinghistory.FieldSilencedAt, field.TypeTime, value)
 	}
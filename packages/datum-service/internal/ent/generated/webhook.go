@@ -45,6 +45,10 @@ type Webhook struct {
 	DestinationURL string `json:"destination_url,omitempty"`
 	// indicates if the webhook is active and enabled
 	Enabled bool `json:"enabled,omitempty"`
+	// the event types the webhook is subscribed to, all events are sent when empty
+	EventTypes []string `json:"event_types,omitempty"`
+	// the object types the webhook receives events for, all object types are sent when empty
+	ObjectTypes []string `json:"object_types,omitempty"`
 	// the call back string
 	Callback string `json:"callback,omitempty"`
 	// the ttl of the webhook delivery
@@ -69,15 +73,18 @@ type WebhookEdges struct {
 	Owner *Organization `json:"owner,omitempty"`
 	// Events holds the value of the events edge.
 	Events []*Event `json:"events,omitempty"`
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*WebhookDelivery `json:"deliveries,omitempty"`
 	// Integrations holds the value of the integrations edge.
 	Integrations []*Integration `json:"integrations,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 	// totalCount holds the count of the edges above.
-	totalCount [3]map[string]int
+	totalCount [4]map[string]int
 
 	namedEvents       map[string][]*Event
+	namedDeliveries   map[string][]*WebhookDelivery
 	namedIntegrations map[string][]*Integration
 }
 
@@ -101,10 +108,19 @@ func (e WebhookEdges) EventsOrErr() ([]*Event, error) {
 	return nil, &NotLoadedError{edge: "events"}
 }
 
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WebhookEdges) DeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[2] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
 // IntegrationsOrErr returns the Integrations value or an error if the edge
 // was not loaded in eager-loading.
 func (e WebhookEdges) IntegrationsOrErr() ([]*Integration, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.Integrations, nil
 	}
 	return nil, &NotLoadedError{edge: "integrations"}
@@ -115,7 +131,7 @@ func (*Webhook) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case webhook.FieldTags, webhook.FieldSecret:
+		case webhook.FieldTags, webhook.FieldEventTypes, webhook.FieldObjectTypes, webhook.FieldSecret:
 			values[i] = new([]byte)
 		case webhook.FieldEnabled:
 			values[i] = new(sql.NullBool)
@@ -226,6 +242,22 @@ func (w *Webhook) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				w.Enabled = value.Bool
 			}
+		case webhook.FieldEventTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field event_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &w.EventTypes); err != nil {
+					return fmt.Errorf("unmarshal field event_types: %w", err)
+				}
+			}
+		case webhook.FieldObjectTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field object_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &w.ObjectTypes); err != nil {
+					return fmt.Errorf("unmarshal field object_types: %w", err)
+				}
+			}
 		case webhook.FieldCallback:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field callback", values[i])
@@ -285,6 +317,11 @@ func (w *Webhook) QueryEvents() *EventQuery {
 	return NewWebhookClient(w.config).QueryEvents(w)
 }
 
+// QueryDeliveries queries the "deliveries" edge of the Webhook entity.
+func (w *Webhook) QueryDeliveries() *WebhookDeliveryQuery {
+	return NewWebhookClient(w.config).QueryDeliveries(w)
+}
+
 // QueryIntegrations queries the "integrations" edge of the Webhook entity.
 func (w *Webhook) QueryIntegrations() *IntegrationQuery {
 	return NewWebhookClient(w.config).QueryIntegrations(w)
@@ -352,6 +389,12 @@ func (w *Webhook) String() string {
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", w.Enabled))
 	builder.WriteString(", ")
+	builder.WriteString("event_types=")
+	builder.WriteString(fmt.Sprintf("%v", w.EventTypes))
+	builder.WriteString(", ")
+	builder.WriteString("object_types=")
+	builder.WriteString(fmt.Sprintf("%v", w.ObjectTypes))
+	builder.WriteString(", ")
 	builder.WriteString("callback=")
 	builder.WriteString(w.Callback)
 	builder.WriteString(", ")
@@ -397,6 +440,30 @@ func (w *Webhook) appendNamedEvents(name string, edges ...*Event) {
 	}
 }
 
+// NamedDeliveries returns the Deliveries named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (w *Webhook) NamedDeliveries(name string) ([]*WebhookDelivery, error) {
+	if w.Edges.namedDeliveries == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := w.Edges.namedDeliveries[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (w *Webhook) appendNamedDeliveries(name string, edges ...*WebhookDelivery) {
+	if w.Edges.namedDeliveries == nil {
+		w.Edges.namedDeliveries = make(map[string][]*WebhookDelivery)
+	}
+	if len(edges) == 0 {
+		w.Edges.namedDeliveries[name] = []*WebhookDelivery{}
+	} else {
+		w.Edges.namedDeliveries[name] = append(w.Edges.namedDeliveries[name], edges...)
+	}
+}
+
 // NamedIntegrations returns the Integrations named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (w *Webhook) NamedIntegrations(name string) ([]*Integration, error) {
@@ -897,6 +897,26 @@ func EnabledNEQ(v bool) predicate.Webhook {
 	return predicate.Webhook(sql.FieldNEQ(FieldEnabled, v))
 }
 
+// EventTypesIsNil applies the IsNil predicate on the "event_types" field.
+func EventTypesIsNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldIsNull(FieldEventTypes))
+}
+
+// EventTypesNotNil applies the NotNil predicate on the "event_types" field.
+func EventTypesNotNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotNull(FieldEventTypes))
+}
+
+// ObjectTypesIsNil applies the IsNil predicate on the "object_types" field.
+func ObjectTypesIsNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldIsNull(FieldObjectTypes))
+}
+
+// ObjectTypesNotNil applies the NotNil predicate on the "object_types" field.
+func ObjectTypesNotNil() predicate.Webhook {
+	return predicate.Webhook(sql.FieldNotNull(FieldObjectTypes))
+}
+
 // CallbackEQ applies the EQ predicate on the "callback" field.
 func CallbackEQ(v string) predicate.Webhook {
 	return predicate.Webhook(sql.FieldEQ(FieldCallback, v))
@@ -1330,6 +1350,35 @@ func HasEventsWith(preds ...predicate.Event) predicate.Webhook {
 	})
 }
 
+// HasDeliveries applies the HasEdge predicate on the "deliveries" edge.
+func HasDeliveries() predicate.Webhook {
+	return predicate.Webhook(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeliveriesTable, DeliveriesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.WebhookDelivery
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeliveriesWith applies the HasEdge predicate on the "deliveries" edge with a given conditions (other predicates).
+func HasDeliveriesWith(preds ...predicate.WebhookDelivery) predicate.Webhook {
+	return predicate.Webhook(func(s *sql.Selector) {
+		step := newDeliveriesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.WebhookDelivery
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasIntegrations applies the HasEdge predicate on the "integrations" edge.
 func HasIntegrations() predicate.Webhook {
 	return predicate.Webhook(func(s *sql.Selector) {
@@ -14,6 +14,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/integration"
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 )
 
 // WebhookCreate is the builder for creating a Webhook entity.
@@ -181,6 +182,18 @@ func (wc *WebhookCreate) SetNillableEnabled(b *bool) *WebhookCreate {
 	return wc
 }
 
+// SetEventTypes sets the "event_types" field.
+func (wc *WebhookCreate) SetEventTypes(s []string) *WebhookCreate {
+	wc.mutation.SetEventTypes(s)
+	return wc
+}
+
+// SetObjectTypes sets the "object_types" field.
+func (wc *WebhookCreate) SetObjectTypes(s []string) *WebhookCreate {
+	wc.mutation.SetObjectTypes(s)
+	return wc
+}
+
 // SetCallback sets the "callback" field.
 func (wc *WebhookCreate) SetCallback(s string) *WebhookCreate {
 	wc.mutation.SetCallback(s)
@@ -291,6 +304,21 @@ func (wc *WebhookCreate) AddEvents(e ...*Event) *WebhookCreate {
 	return wc.AddEventIDs(ids...)
 }
 
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wc *WebhookCreate) AddDeliveryIDs(ids ...string) *WebhookCreate {
+	wc.mutation.AddDeliveryIDs(ids...)
+	return wc
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wc *WebhookCreate) AddDeliveries(w ...*WebhookDelivery) *WebhookCreate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddDeliveryIDs(ids...)
+}
+
 // AddIntegrationIDs adds the "integrations" edge to the Integration entity by IDs.
 func (wc *WebhookCreate) AddIntegrationIDs(ids ...string) *WebhookCreate {
 	wc.mutation.AddIntegrationIDs(ids...)
@@ -410,6 +438,16 @@ func (wc *WebhookCreate) check() error {
 	if _, ok := wc.mutation.Enabled(); !ok {
 		return &ValidationError{Name: "enabled", err: errors.New(`generated: missing required field "Webhook.enabled"`)}
 	}
+	if v, ok := wc.mutation.EventTypes(); ok {
+		if err := webhook.EventTypesValidator(v); err != nil {
+			return &ValidationError{Name: "event_types", err: fmt.Errorf(`generated: validator failed for field "Webhook.event_types": %w`, err)}
+		}
+	}
+	if v, ok := wc.mutation.ObjectTypes(); ok {
+		if err := webhook.ObjectTypesValidator(v); err != nil {
+			return &ValidationError{Name: "object_types", err: fmt.Errorf(`generated: validator failed for field "Webhook.object_types": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -494,6 +532,14 @@ func (wc *WebhookCreate) createSpec() (*Webhook, *sqlgraph.CreateSpec) {
 		_spec.SetField(webhook.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
 	}
+	if value, ok := wc.mutation.EventTypes(); ok {
+		_spec.SetField(webhook.FieldEventTypes, field.TypeJSON, value)
+		_node.EventTypes = value
+	}
+	if value, ok := wc.mutation.ObjectTypes(); ok {
+		_spec.SetField(webhook.FieldObjectTypes, field.TypeJSON, value)
+		_node.ObjectTypes = value
+	}
 	if value, ok := wc.mutation.Callback(); ok {
 		_spec.SetField(webhook.FieldCallback, field.TypeString, value)
 		_node.Callback = value
@@ -553,6 +599,23 @@ func (wc *WebhookCreate) createSpec() (*Webhook, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := wc.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wc.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := wc.mutation.IntegrationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -17,6 +17,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/predicate"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 
 	"github.com/datumforge/datum/internal/ent/generated/internal"
 )
@@ -30,10 +31,12 @@ type WebhookQuery struct {
 	predicates            []predicate.Webhook
 	withOwner             *OrganizationQuery
 	withEvents            *EventQuery
+	withDeliveries        *WebhookDeliveryQuery
 	withIntegrations      *IntegrationQuery
 	modifiers             []func(*sql.Selector)
 	loadTotal             []func(context.Context, []*Webhook) error
 	withNamedEvents       map[string]*EventQuery
+	withNamedDeliveries   map[string]*WebhookDeliveryQuery
 	withNamedIntegrations map[string]*IntegrationQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -121,6 +124,31 @@ func (wq *WebhookQuery) QueryEvents() *EventQuery {
 	return query
 }
 
+// QueryDeliveries chains the current query on the "deliveries" edge.
+func (wq *WebhookQuery) QueryDeliveries() *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: wq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, selector),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		schemaConfig := wq.schemaConfig
+		step.To.Schema = schemaConfig.WebhookDelivery
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryIntegrations chains the current query on the "integrations" edge.
 func (wq *WebhookQuery) QueryIntegrations() *IntegrationQuery {
 	query := (&IntegrationClient{config: wq.config}).Query()
@@ -340,6 +368,7 @@ func (wq *WebhookQuery) Clone() *WebhookQuery {
 		predicates:       append([]predicate.Webhook{}, wq.predicates...),
 		withOwner:        wq.withOwner.Clone(),
 		withEvents:       wq.withEvents.Clone(),
+		withDeliveries:   wq.withDeliveries.Clone(),
 		withIntegrations: wq.withIntegrations.Clone(),
 		// clone intermediate query.
 		sql:  wq.sql.Clone(),
@@ -369,6 +398,17 @@ func (wq *WebhookQuery) WithEvents(opts ...func(*EventQuery)) *WebhookQuery {
 	return wq
 }
 
+// WithDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WebhookQuery) WithDeliveries(opts ...func(*WebhookDeliveryQuery)) *WebhookQuery {
+	query := (&WebhookDeliveryClient{config: wq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withDeliveries = query
+	return wq
+}
+
 // WithIntegrations tells the query-builder to eager-load the nodes that are connected to
 // the "integrations" edge. The optional arguments are used to configure the query builder of the edge.
 func (wq *WebhookQuery) WithIntegrations(opts ...func(*IntegrationQuery)) *WebhookQuery {
@@ -458,9 +498,10 @@ func (wq *WebhookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Webh
 	var (
 		nodes       = []*Webhook{}
 		_spec       = wq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			wq.withOwner != nil,
 			wq.withEvents != nil,
+			wq.withDeliveries != nil,
 			wq.withIntegrations != nil,
 		}
 	)
@@ -500,6 +541,13 @@ func (wq *WebhookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Webh
 			return nil, err
 		}
 	}
+	if query := wq.withDeliveries; query != nil {
+		if err := wq.loadDeliveries(ctx, query, nodes,
+			func(n *Webhook) { n.Edges.Deliveries = []*WebhookDelivery{} },
+			func(n *Webhook, e *WebhookDelivery) { n.Edges.Deliveries = append(n.Edges.Deliveries, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := wq.withIntegrations; query != nil {
 		if err := wq.loadIntegrations(ctx, query, nodes,
 			func(n *Webhook) { n.Edges.Integrations = []*Integration{} },
@@ -514,6 +562,13 @@ func (wq *WebhookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Webh
 			return nil, err
 		}
 	}
+	for name, query := range wq.withNamedDeliveries {
+		if err := wq.loadDeliveries(ctx, query, nodes,
+			func(n *Webhook) { n.appendNamedDeliveries(name) },
+			func(n *Webhook, e *WebhookDelivery) { n.appendNamedDeliveries(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range wq.withNamedIntegrations {
 		if err := wq.loadIntegrations(ctx, query, nodes,
 			func(n *Webhook) { n.appendNamedIntegrations(name) },
@@ -620,6 +675,36 @@ func (wq *WebhookQuery) loadEvents(ctx context.Context, query *EventQuery, nodes
 	}
 	return nil
 }
+func (wq *WebhookQuery) loadDeliveries(ctx context.Context, query *WebhookDeliveryQuery, nodes []*Webhook, init func(*Webhook), assign func(*Webhook, *WebhookDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Webhook)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhookdelivery.FieldWebhookID)
+	}
+	query.Where(predicate.WebhookDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(webhook.DeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WebhookID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "webhook_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (wq *WebhookQuery) loadIntegrations(ctx context.Context, query *IntegrationQuery, nodes []*Webhook, init func(*Webhook), assign func(*Webhook, *Integration)) error {
 	edgeIDs := make([]driver.Value, len(nodes))
 	byID := make(map[string]*Webhook)
@@ -789,6 +874,20 @@ func (wq *WebhookQuery) WithNamedEvents(name string, opts ...func(*EventQuery))
 	return wq
 }
 
+// WithNamedDeliveries tells the query-builder to eager-load the nodes that are connected to the "deliveries"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (wq *WebhookQuery) WithNamedDeliveries(name string, opts ...func(*WebhookDeliveryQuery)) *WebhookQuery {
+	query := (&WebhookDeliveryClient{config: wq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if wq.withNamedDeliveries == nil {
+		wq.withNamedDeliveries = make(map[string]*WebhookDeliveryQuery)
+	}
+	wq.withNamedDeliveries[name] = query
+	return wq
+}
+
 // WithNamedIntegrations tells the query-builder to eager-load the nodes that are connected to the "integrations"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (wq *WebhookQuery) WithNamedIntegrations(name string, opts ...func(*IntegrationQuery)) *WebhookQuery {
@@ -17,6 +17,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/organization"
 	"github.com/datumforge/datum/internal/ent/generated/predicate"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
 
 	"github.com/datumforge/datum/internal/ent/generated/internal"
 )
@@ -206,6 +207,42 @@ func (wu *WebhookUpdate) SetNillableEnabled(b *bool) *WebhookUpdate {
 	return wu
 }
 
+// SetEventTypes sets the "event_types" field.
+func (wu *WebhookUpdate) SetEventTypes(s []string) *WebhookUpdate {
+	wu.mutation.SetEventTypes(s)
+	return wu
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (wu *WebhookUpdate) AppendEventTypes(s []string) *WebhookUpdate {
+	wu.mutation.AppendEventTypes(s)
+	return wu
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (wu *WebhookUpdate) ClearEventTypes() *WebhookUpdate {
+	wu.mutation.ClearEventTypes()
+	return wu
+}
+
+// SetObjectTypes sets the "object_types" field.
+func (wu *WebhookUpdate) SetObjectTypes(s []string) *WebhookUpdate {
+	wu.mutation.SetObjectTypes(s)
+	return wu
+}
+
+// AppendObjectTypes appends s to the "object_types" field.
+func (wu *WebhookUpdate) AppendObjectTypes(s []string) *WebhookUpdate {
+	wu.mutation.AppendObjectTypes(s)
+	return wu
+}
+
+// ClearObjectTypes clears the value of the "object_types" field.
+func (wu *WebhookUpdate) ClearObjectTypes() *WebhookUpdate {
+	wu.mutation.ClearObjectTypes()
+	return wu
+}
+
 // SetCallback sets the "callback" field.
 func (wu *WebhookUpdate) SetCallback(s string) *WebhookUpdate {
 	wu.mutation.SetCallback(s)
@@ -345,6 +382,21 @@ func (wu *WebhookUpdate) AddEvents(e ...*Event) *WebhookUpdate {
 	return wu.AddEventIDs(ids...)
 }
 
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wu *WebhookUpdate) AddDeliveryIDs(ids ...string) *WebhookUpdate {
+	wu.mutation.AddDeliveryIDs(ids...)
+	return wu
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wu *WebhookUpdate) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddDeliveryIDs(ids...)
+}
+
 // AddIntegrationIDs adds the "integrations" edge to the Integration entity by IDs.
 func (wu *WebhookUpdate) AddIntegrationIDs(ids ...string) *WebhookUpdate {
 	wu.mutation.AddIntegrationIDs(ids...)
@@ -392,6 +444,27 @@ func (wu *WebhookUpdate) RemoveEvents(e ...*Event) *WebhookUpdate {
 	return wu.RemoveEventIDs(ids...)
 }
 
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wu *WebhookUpdate) ClearDeliveries() *WebhookUpdate {
+	wu.mutation.ClearDeliveries()
+	return wu
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wu *WebhookUpdate) RemoveDeliveryIDs(ids ...string) *WebhookUpdate {
+	wu.mutation.RemoveDeliveryIDs(ids...)
+	return wu
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wu *WebhookUpdate) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveDeliveryIDs(ids...)
+}
+
 // ClearIntegrations clears all "integrations" edges to the Integration entity.
 func (wu *WebhookUpdate) ClearIntegrations() *WebhookUpdate {
 	wu.mutation.ClearIntegrations()
@@ -467,6 +540,16 @@ func (wu *WebhookUpdate) check() error {
 			return &ValidationError{Name: "destination_url", err: fmt.Errorf(`generated: validator failed for field "Webhook.destination_url": %w`, err)}
 		}
 	}
+	if v, ok := wu.mutation.EventTypes(); ok {
+		if err := webhook.EventTypesValidator(v); err != nil {
+			return &ValidationError{Name: "event_types", err: fmt.Errorf(`generated: validator failed for field "Webhook.event_types": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.ObjectTypes(); ok {
+		if err := webhook.ObjectTypesValidator(v); err != nil {
+			return &ValidationError{Name: "object_types", err: fmt.Errorf(`generated: validator failed for field "Webhook.object_types": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -538,6 +621,28 @@ func (wu *WebhookUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := wu.mutation.Enabled(); ok {
 		_spec.SetField(webhook.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := wu.mutation.EventTypes(); ok {
+		_spec.SetField(webhook.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := wu.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEventTypes, value)
+		})
+	}
+	if wu.mutation.EventTypesCleared() {
+		_spec.ClearField(webhook.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := wu.mutation.ObjectTypes(); ok {
+		_spec.SetField(webhook.FieldObjectTypes, field.TypeJSON, value)
+	}
+	if value, ok := wu.mutation.AppendedObjectTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldObjectTypes, value)
+		})
+	}
+	if wu.mutation.ObjectTypesCleared() {
+		_spec.ClearField(webhook.FieldObjectTypes, field.TypeJSON)
+	}
 	if value, ok := wu.mutation.Callback(); ok {
 		_spec.SetField(webhook.FieldCallback, field.TypeString, value)
 	}
@@ -656,6 +761,54 @@ func (wu *WebhookUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wu.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wu.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wu.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if wu.mutation.IntegrationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -898,6 +1051,42 @@ func (wuo *WebhookUpdateOne) SetNillableEnabled(b *bool) *WebhookUpdateOne {
 	return wuo
 }
 
+// SetEventTypes sets the "event_types" field.
+func (wuo *WebhookUpdateOne) SetEventTypes(s []string) *WebhookUpdateOne {
+	wuo.mutation.SetEventTypes(s)
+	return wuo
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (wuo *WebhookUpdateOne) AppendEventTypes(s []string) *WebhookUpdateOne {
+	wuo.mutation.AppendEventTypes(s)
+	return wuo
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (wuo *WebhookUpdateOne) ClearEventTypes() *WebhookUpdateOne {
+	wuo.mutation.ClearEventTypes()
+	return wuo
+}
+
+// SetObjectTypes sets the "object_types" field.
+func (wuo *WebhookUpdateOne) SetObjectTypes(s []string) *WebhookUpdateOne {
+	wuo.mutation.SetObjectTypes(s)
+	return wuo
+}
+
+// AppendObjectTypes appends s to the "object_types" field.
+func (wuo *WebhookUpdateOne) AppendObjectTypes(s []string) *WebhookUpdateOne {
+	wuo.mutation.AppendObjectTypes(s)
+	return wuo
+}
+
+// ClearObjectTypes clears the value of the "object_types" field.
+func (wuo *WebhookUpdateOne) ClearObjectTypes() *WebhookUpdateOne {
+	wuo.mutation.ClearObjectTypes()
+	return wuo
+}
+
 // SetCallback sets the "callback" field.
 func (wuo *WebhookUpdateOne) SetCallback(s string) *WebhookUpdateOne {
 	wuo.mutation.SetCallback(s)
@@ -1037,6 +1226,21 @@ func (wuo *WebhookUpdateOne) AddEvents(e ...*Event) *WebhookUpdateOne {
 	return wuo.AddEventIDs(ids...)
 }
 
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wuo *WebhookUpdateOne) AddDeliveryIDs(ids ...string) *WebhookUpdateOne {
+	wuo.mutation.AddDeliveryIDs(ids...)
+	return wuo
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wuo *WebhookUpdateOne) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddDeliveryIDs(ids...)
+}
+
 // AddIntegrationIDs adds the "integrations" edge to the Integration entity by IDs.
 func (wuo *WebhookUpdateOne) AddIntegrationIDs(ids ...string) *WebhookUpdateOne {
 	wuo.mutation.AddIntegrationIDs(ids...)
@@ -1084,6 +1288,27 @@ func (wuo *WebhookUpdateOne) RemoveEvents(e ...*Event) *WebhookUpdateOne {
 	return wuo.RemoveEventIDs(ids...)
 }
 
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wuo *WebhookUpdateOne) ClearDeliveries() *WebhookUpdateOne {
+	wuo.mutation.ClearDeliveries()
+	return wuo
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wuo *WebhookUpdateOne) RemoveDeliveryIDs(ids ...string) *WebhookUpdateOne {
+	wuo.mutation.RemoveDeliveryIDs(ids...)
+	return wuo
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wuo *WebhookUpdateOne) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveDeliveryIDs(ids...)
+}
+
 // ClearIntegrations clears all "integrations" edges to the Integration entity.
 func (wuo *WebhookUpdateOne) ClearIntegrations() *WebhookUpdateOne {
 	wuo.mutation.ClearIntegrations()
@@ -1172,6 +1397,16 @@ func (wuo *WebhookUpdateOne) check() error {
 			return &ValidationError{Name: "destination_url", err: fmt.Errorf(`generated: validator failed for field "Webhook.destination_url": %w`, err)}
 		}
 	}
+	if v, ok := wuo.mutation.EventTypes(); ok {
+		if err := webhook.EventTypesValidator(v); err != nil {
+			return &ValidationError{Name: "event_types", err: fmt.Errorf(`generated: validator failed for field "Webhook.event_types": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.ObjectTypes(); ok {
+		if err := webhook.ObjectTypesValidator(v); err != nil {
+			return &ValidationError{Name: "object_types", err: fmt.Errorf(`generated: validator failed for field "Webhook.object_types": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1260,6 +1495,28 @@ func (wuo *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err e
 	if value, ok := wuo.mutation.Enabled(); ok {
 		_spec.SetField(webhook.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := wuo.mutation.EventTypes(); ok {
+		_spec.SetField(webhook.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := wuo.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEventTypes, value)
+		})
+	}
+	if wuo.mutation.EventTypesCleared() {
+		_spec.ClearField(webhook.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := wuo.mutation.ObjectTypes(); ok {
+		_spec.SetField(webhook.FieldObjectTypes, field.TypeJSON, value)
+	}
+	if value, ok := wuo.mutation.AppendedObjectTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldObjectTypes, value)
+		})
+	}
+	if wuo.mutation.ObjectTypesCleared() {
+		_spec.ClearField(webhook.FieldObjectTypes, field.TypeJSON)
+	}
 	if value, ok := wuo.mutation.Callback(); ok {
 		_spec.SetField(webhook.FieldCallback, field.TypeString, value)
 	}
@@ -1378,6 +1635,54 @@ func (wuo *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wuo.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wuo.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wuo.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if wuo.mutation.IntegrationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -0,0 +1,331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/datumforge/datum/internal/ent/generated/event"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
+)
+
+// WebhookDelivery is the model entity for the WebhookDelivery schema.
+type WebhookDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// MappingID holds the value of the "mapping_id" field.
+	MappingID string `json:"mapping_id,omitempty"`
+	// The organization id that owns the object
+	OwnerID string `json:"owner_id,omitempty"`
+	// the webhook the event was delivered to
+	WebhookID string `json:"webhook_id,omitempty"`
+	// the event that was delivered
+	EventID string `json:"event_id,omitempty"`
+	// the attempt number of the delivery, starting at 1
+	Attempt int `json:"attempt,omitempty"`
+	// indicates if the delivery was a manual redelivery of the event
+	Redelivery bool `json:"redelivery,omitempty"`
+	// the signed request body sent to the webhook destination
+	RequestBody string `json:"request_body,omitempty"`
+	// the http status code returned by the destination, 0 if no response was received
+	ResponseStatus int `json:"response_status,omitempty"`
+	// the response body returned by the destination
+	ResponseBody string `json:"response_body,omitempty"`
+	// the time in milliseconds the delivery attempt took
+	Latency int64 `json:"latency,omitempty"`
+	// the error of the delivery attempt, empty if the delivery succeeded
+	Error string `json:"error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookDeliveryQuery when eager-loading is set.
+	Edges        WebhookDeliveryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebhookDeliveryEdges holds the relations/edges for other nodes in the graph.
+type WebhookDeliveryEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *Organization `json:"owner,omitempty"`
+	// Webhook holds the value of the webhook edge.
+	Webhook *Webhook `json:"webhook,omitempty"`
+	// Event holds the value of the event edge.
+	Event *Event `json:"event,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+	// totalCount holds the count of the edges above.
+	totalCount [3]map[string]int
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookDeliveryEdges) OwnerOrErr() (*Organization, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// WebhookOrErr returns the Webhook value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookDeliveryEdges) WebhookOrErr() (*Webhook, error) {
+	if e.Webhook != nil {
+		return e.Webhook, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: webhook.Label}
+	}
+	return nil, &NotLoadedError{edge: "webhook"}
+}
+
+// EventOrErr returns the Event value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookDeliveryEdges) EventOrErr() (*Event, error) {
+	if e.Event != nil {
+		return e.Event, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: event.Label}
+	}
+	return nil, &NotLoadedError{edge: "event"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldRedelivery:
+			values[i] = new(sql.NullBool)
+		case webhookdelivery.FieldAttempt, webhookdelivery.FieldResponseStatus, webhookdelivery.FieldLatency:
+			values[i] = new(sql.NullInt64)
+		case webhookdelivery.FieldID, webhookdelivery.FieldCreatedBy, webhookdelivery.FieldUpdatedBy, webhookdelivery.FieldMappingID, webhookdelivery.FieldOwnerID, webhookdelivery.FieldWebhookID, webhookdelivery.FieldEventID, webhookdelivery.FieldRequestBody, webhookdelivery.FieldResponseBody, webhookdelivery.FieldError:
+			values[i] = new(sql.NullString)
+		case webhookdelivery.FieldCreatedAt, webhookdelivery.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookDelivery fields.
+func (wd *WebhookDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookdelivery.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				wd.ID = value.String
+			}
+		case webhookdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wd.CreatedAt = value.Time
+			}
+		case webhookdelivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				wd.UpdatedAt = value.Time
+			}
+		case webhookdelivery.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				wd.CreatedBy = value.String
+			}
+		case webhookdelivery.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				wd.UpdatedBy = value.String
+			}
+		case webhookdelivery.FieldMappingID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mapping_id", values[i])
+			} else if value.Valid {
+				wd.MappingID = value.String
+			}
+		case webhookdelivery.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				wd.OwnerID = value.String
+			}
+		case webhookdelivery.FieldWebhookID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field webhook_id", values[i])
+			} else if value.Valid {
+				wd.WebhookID = value.String
+			}
+		case webhookdelivery.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				wd.EventID = value.String
+			}
+		case webhookdelivery.FieldAttempt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt", values[i])
+			} else if value.Valid {
+				wd.Attempt = int(value.Int64)
+			}
+		case webhookdelivery.FieldRedelivery:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field redelivery", values[i])
+			} else if value.Valid {
+				wd.Redelivery = value.Bool
+			}
+		case webhookdelivery.FieldRequestBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_body", values[i])
+			} else if value.Valid {
+				wd.RequestBody = value.String
+			}
+		case webhookdelivery.FieldResponseStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field response_status", values[i])
+			} else if value.Valid {
+				wd.ResponseStatus = int(value.Int64)
+			}
+		case webhookdelivery.FieldResponseBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field response_body", values[i])
+			} else if value.Valid {
+				wd.ResponseBody = value.String
+			}
+		case webhookdelivery.FieldLatency:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency", values[i])
+			} else if value.Valid {
+				wd.Latency = value.Int64
+			}
+		case webhookdelivery.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				wd.Error = value.String
+			}
+		default:
+			wd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookDelivery.
+// This includes values selected through modifiers, order, etc.
+func (wd *WebhookDelivery) Value(name string) (ent.Value, error) {
+	return wd.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the WebhookDelivery entity.
+func (wd *WebhookDelivery) QueryOwner() *OrganizationQuery {
+	return NewWebhookDeliveryClient(wd.config).QueryOwner(wd)
+}
+
+// QueryWebhook queries the "webhook" edge of the WebhookDelivery entity.
+func (wd *WebhookDelivery) QueryWebhook() *WebhookQuery {
+	return NewWebhookDeliveryClient(wd.config).QueryWebhook(wd)
+}
+
+// QueryEvent queries the "event" edge of the WebhookDelivery entity.
+func (wd *WebhookDelivery) QueryEvent() *EventQuery {
+	return NewWebhookDeliveryClient(wd.config).QueryEvent(wd)
+}
+
+// Update returns a builder for updating this WebhookDelivery.
+// Note that you need to call WebhookDelivery.Unwrap() before calling this method if this WebhookDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wd *WebhookDelivery) Update() *WebhookDeliveryUpdateOne {
+	return NewWebhookDeliveryClient(wd.config).UpdateOne(wd)
+}
+
+// Unwrap unwraps the WebhookDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wd *WebhookDelivery) Unwrap() *WebhookDelivery {
+	_tx, ok := wd.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: WebhookDelivery is not a transactional entity")
+	}
+	wd.config.driver = _tx.drv
+	return wd
+}
+
+// String implements the fmt.Stringer.
+func (wd *WebhookDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wd.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(wd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(wd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(wd.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(wd.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("mapping_id=")
+	builder.WriteString(wd.MappingID)
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(wd.OwnerID)
+	builder.WriteString(", ")
+	builder.WriteString("webhook_id=")
+	builder.WriteString(wd.WebhookID)
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(wd.EventID)
+	builder.WriteString(", ")
+	builder.WriteString("attempt=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Attempt))
+	builder.WriteString(", ")
+	builder.WriteString("redelivery=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Redelivery))
+	builder.WriteString(", ")
+	builder.WriteString("request_body=")
+	builder.WriteString(wd.RequestBody)
+	builder.WriteString(", ")
+	builder.WriteString("response_status=")
+	builder.WriteString(fmt.Sprintf("%v", wd.ResponseStatus))
+	builder.WriteString(", ")
+	builder.WriteString("response_body=")
+	builder.WriteString(wd.ResponseBody)
+	builder.WriteString(", ")
+	builder.WriteString("latency=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Latency))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(wd.Error)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookDeliveries is a parsable slice of WebhookDelivery.
+type WebhookDeliveries []*WebhookDelivery
@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/event"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
+)
+
+// WebhookDeliveryCreate is the builder for creating a WebhookDelivery entity.
+type WebhookDeliveryCreate struct {
+	config
+	mutation *WebhookDeliveryMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wdc *WebhookDeliveryCreate) SetCreatedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetCreatedAt(t)
+	return wdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetCreatedAt(*t)
+	}
+	return wdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdc *WebhookDeliveryCreate) SetUpdatedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetUpdatedAt(t)
+	return wdc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableUpdatedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetUpdatedAt(*t)
+	}
+	return wdc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (wdc *WebhookDeliveryCreate) SetCreatedBy(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetCreatedBy(s)
+	return wdc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableCreatedBy(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetCreatedBy(*s)
+	}
+	return wdc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wdc *WebhookDeliveryCreate) SetUpdatedBy(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetUpdatedBy(s)
+	return wdc
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableUpdatedBy(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetUpdatedBy(*s)
+	}
+	return wdc
+}
+
+// SetMappingID sets the "mapping_id" field.
+func (wdc *WebhookDeliveryCreate) SetMappingID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetMappingID(s)
+	return wdc
+}
+
+// SetNillableMappingID sets the "mapping_id" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableMappingID(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetMappingID(*s)
+	}
+	return wdc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (wdc *WebhookDeliveryCreate) SetOwnerID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetOwnerID(s)
+	return wdc
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableOwnerID(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetOwnerID(*s)
+	}
+	return wdc
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdc *WebhookDeliveryCreate) SetWebhookID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetWebhookID(s)
+	return wdc
+}
+
+// SetEventID sets the "event_id" field.
+func (wdc *WebhookDeliveryCreate) SetEventID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEventID(s)
+	return wdc
+}
+
+// SetAttempt sets the "attempt" field.
+func (wdc *WebhookDeliveryCreate) SetAttempt(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetAttempt(i)
+	return wdc
+}
+
+// SetRedelivery sets the "redelivery" field.
+func (wdc *WebhookDeliveryCreate) SetRedelivery(b bool) *WebhookDeliveryCreate {
+	wdc.mutation.SetRedelivery(b)
+	return wdc
+}
+
+// SetNillableRedelivery sets the "redelivery" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableRedelivery(b *bool) *WebhookDeliveryCreate {
+	if b != nil {
+		wdc.SetRedelivery(*b)
+	}
+	return wdc
+}
+
+// SetRequestBody sets the "request_body" field.
+func (wdc *WebhookDeliveryCreate) SetRequestBody(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetRequestBody(s)
+	return wdc
+}
+
+// SetNillableRequestBody sets the "request_body" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableRequestBody(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetRequestBody(*s)
+	}
+	return wdc
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wdc *WebhookDeliveryCreate) SetResponseStatus(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetResponseStatus(i)
+	return wdc
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableResponseStatus(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetResponseStatus(*i)
+	}
+	return wdc
+}
+
+// SetResponseBody sets the "response_body" field.
+func (wdc *WebhookDeliveryCreate) SetResponseBody(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetResponseBody(s)
+	return wdc
+}
+
+// SetNillableResponseBody sets the "response_body" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableResponseBody(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetResponseBody(*s)
+	}
+	return wdc
+}
+
+// SetLatency sets the "latency" field.
+func (wdc *WebhookDeliveryCreate) SetLatency(i int64) *WebhookDeliveryCreate {
+	wdc.mutation.SetLatency(i)
+	return wdc
+}
+
+// SetNillableLatency sets the "latency" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableLatency(i *int64) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetLatency(*i)
+	}
+	return wdc
+}
+
+// SetError sets the "error" field.
+func (wdc *WebhookDeliveryCreate) SetError(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetError(s)
+	return wdc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableError(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetError(*s)
+	}
+	return wdc
+}
+
+// SetID sets the "id" field.
+func (wdc *WebhookDeliveryCreate) SetID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetID(s)
+	return wdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableID(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetID(*s)
+	}
+	return wdc
+}
+
+// SetOwner sets the "owner" edge to the Organization entity.
+func (wdc *WebhookDeliveryCreate) SetOwner(o *Organization) *WebhookDeliveryCreate {
+	return wdc.SetOwnerID(o.ID)
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdc *WebhookDeliveryCreate) SetWebhook(w *Webhook) *WebhookDeliveryCreate {
+	return wdc.SetWebhookID(w.ID)
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (wdc *WebhookDeliveryCreate) SetEvent(e *Event) *WebhookDeliveryCreate {
+	return wdc.SetEventID(e.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdc *WebhookDeliveryCreate) Mutation() *WebhookDeliveryMutation {
+	return wdc.mutation
+}
+
+// Save creates the WebhookDelivery in the database.
+func (wdc *WebhookDeliveryCreate) Save(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wdc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wdc.sqlSave, wdc.mutation, wdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdc *WebhookDeliveryCreate) SaveX(ctx context.Context) *WebhookDelivery {
+	v, err := wdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdc *WebhookDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := wdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdc *WebhookDeliveryCreate) ExecX(ctx context.Context) {
+	if err := wdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdc *WebhookDeliveryCreate) defaults() error {
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		if webhookdelivery.DefaultCreatedAt == nil {
+			return fmt.Errorf("generated: uninitialized webhookdelivery.DefaultCreatedAt (forgotten import generated/runtime?)")
+		}
+		v := webhookdelivery.DefaultCreatedAt()
+		wdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wdc.mutation.UpdatedAt(); !ok {
+		if webhookdelivery.DefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized webhookdelivery.DefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := webhookdelivery.DefaultUpdatedAt()
+		wdc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := wdc.mutation.MappingID(); !ok {
+		if webhookdelivery.DefaultMappingID == nil {
+			return fmt.Errorf("generated: uninitialized webhookdelivery.DefaultMappingID (forgotten import generated/runtime?)")
+		}
+		v := webhookdelivery.DefaultMappingID()
+		wdc.mutation.SetMappingID(v)
+	}
+	if _, ok := wdc.mutation.Redelivery(); !ok {
+		v := webhookdelivery.DefaultRedelivery
+		wdc.mutation.SetRedelivery(v)
+	}
+	if _, ok := wdc.mutation.ID(); !ok {
+		if webhookdelivery.DefaultID == nil {
+			return fmt.Errorf("generated: uninitialized webhookdelivery.DefaultID (forgotten import generated/runtime?)")
+		}
+		v := webhookdelivery.DefaultID()
+		wdc.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdc *WebhookDeliveryCreate) check() error {
+	if _, ok := wdc.mutation.MappingID(); !ok {
+		return &ValidationError{Name: "mapping_id", err: errors.New(`generated: missing required field "WebhookDelivery.mapping_id"`)}
+	}
+	if v, ok := wdc.mutation.OwnerID(); ok {
+		if err := webhookdelivery.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`generated: validator failed for field "WebhookDelivery.owner_id": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.WebhookID(); !ok {
+		return &ValidationError{Name: "webhook_id", err: errors.New(`generated: missing required field "WebhookDelivery.webhook_id"`)}
+	}
+	if _, ok := wdc.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`generated: missing required field "WebhookDelivery.event_id"`)}
+	}
+	if _, ok := wdc.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`generated: missing required field "WebhookDelivery.attempt"`)}
+	}
+	if _, ok := wdc.mutation.Redelivery(); !ok {
+		return &ValidationError{Name: "redelivery", err: errors.New(`generated: missing required field "WebhookDelivery.redelivery"`)}
+	}
+	if len(wdc.mutation.WebhookIDs()) == 0 {
+		return &ValidationError{Name: "webhook", err: errors.New(`generated: missing required edge "WebhookDelivery.webhook"`)}
+	}
+	if len(wdc.mutation.EventIDs()) == 0 {
+		return &ValidationError{Name: "event", err: errors.New(`generated: missing required edge "WebhookDelivery.event"`)}
+	}
+	return nil
+}
+
+func (wdc *WebhookDeliveryCreate) sqlSave(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WebhookDelivery.ID type: %T", _spec.ID.Value)
+		}
+	}
+	wdc.mutation.id = &_node.ID
+	wdc.mutation.done = true
+	return _node, nil
+}
+
+func (wdc *WebhookDeliveryCreate) createSpec() (*WebhookDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDelivery{config: wdc.config}
+		_spec = sqlgraph.NewCreateSpec(webhookdelivery.Table, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString))
+	)
+	_spec.Schema = wdc.schemaConfig.WebhookDelivery
+	if id, ok := wdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wdc.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wdc.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := wdc.mutation.CreatedBy(); ok {
+		_spec.SetField(webhookdelivery.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := wdc.mutation.UpdatedBy(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := wdc.mutation.MappingID(); ok {
+		_spec.SetField(webhookdelivery.FieldMappingID, field.TypeString, value)
+		_node.MappingID = value
+	}
+	if value, ok := wdc.mutation.Attempt(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempt, field.TypeInt, value)
+		_node.Attempt = value
+	}
+	if value, ok := wdc.mutation.Redelivery(); ok {
+		_spec.SetField(webhookdelivery.FieldRedelivery, field.TypeBool, value)
+		_node.Redelivery = value
+	}
+	if value, ok := wdc.mutation.RequestBody(); ok {
+		_spec.SetField(webhookdelivery.FieldRequestBody, field.TypeString, value)
+		_node.RequestBody = value
+	}
+	if value, ok := wdc.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+		_node.ResponseStatus = value
+	}
+	if value, ok := wdc.mutation.ResponseBody(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseBody, field.TypeString, value)
+		_node.ResponseBody = value
+	}
+	if value, ok := wdc.mutation.Latency(); ok {
+		_spec.SetField(webhookdelivery.FieldLatency, field.TypeInt64, value)
+		_node.Latency = value
+	}
+	if value, ok := wdc.mutation.Error(); ok {
+		_spec.SetField(webhookdelivery.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if nodes := wdc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.OwnerTable,
+			Columns: []string{webhookdelivery.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wdc.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OwnerID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wdc.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wdc.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WebhookID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wdc.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.EventTable,
+			Columns: []string{webhookdelivery.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wdc.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EventID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebhookDeliveryCreateBulk is the builder for creating many WebhookDelivery entities in bulk.
+type WebhookDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookDeliveryCreate
+}
+
+// Save creates the WebhookDelivery entities in the database.
+func (wdcb *WebhookDeliveryCreateBulk) Save(ctx context.Context) ([]*WebhookDelivery, error) {
+	if wdcb.err != nil {
+		return nil, wdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wdcb.builders))
+	nodes := make([]*WebhookDelivery, len(wdcb.builders))
+	mutators := make([]Mutator, len(wdcb.builders))
+	for i := range wdcb.builders {
+		func(i int, root context.Context) {
+			builder := wdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) SaveX(ctx context.Context) []*WebhookDelivery {
+	v, err := wdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdcb *WebhookDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := wdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
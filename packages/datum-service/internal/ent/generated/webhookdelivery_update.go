@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
+
+	"github.com/datumforge/datum/internal/ent/generated/internal"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdatedAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetUpdatedAt(t)
+	return wdu
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (wdu *WebhookDeliveryUpdate) ClearUpdatedAt() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearUpdatedAt()
+	return wdu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdatedBy(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetUpdatedBy(s)
+	return wdu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableUpdatedBy(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetUpdatedBy(*s)
+	}
+	return wdu
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (wdu *WebhookDeliveryUpdate) ClearUpdatedBy() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearUpdatedBy()
+	return wdu
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (wdu *WebhookDeliveryUpdate) SetOwnerID(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetOwnerID(s)
+	return wdu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableOwnerID(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetOwnerID(*s)
+	}
+	return wdu
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (wdu *WebhookDeliveryUpdate) ClearOwnerID() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearOwnerID()
+	return wdu
+}
+
+// SetOwner sets the "owner" edge to the Organization entity.
+func (wdu *WebhookDeliveryUpdate) SetOwner(o *Organization) *WebhookDeliveryUpdate {
+	return wdu.SetOwnerID(o.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the Organization entity.
+func (wdu *WebhookDeliveryUpdate) ClearOwner() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearOwner()
+	return wdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	if err := wdu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdu *WebhookDeliveryUpdate) defaults() error {
+	if _, ok := wdu.mutation.UpdatedAt(); !ok && !wdu.mutation.UpdatedAtCleared() {
+		if webhookdelivery.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized webhookdelivery.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wdu.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdu *WebhookDeliveryUpdate) check() error {
+	if v, ok := wdu.mutation.OwnerID(); ok {
+		if err := webhookdelivery.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`generated: validator failed for field "WebhookDelivery.owner_id": %w`, err)}
+		}
+	}
+	if wdu.mutation.WebhookCleared() && len(wdu.mutation.WebhookIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	if wdu.mutation.EventCleared() && len(wdu.mutation.EventIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "WebhookDelivery.event"`)
+	}
+	return nil
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if wdu.mutation.CreatedAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := wdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wdu.mutation.UpdatedAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldUpdatedAt, field.TypeTime)
+	}
+	if wdu.mutation.CreatedByCleared() {
+		_spec.ClearField(webhookdelivery.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := wdu.mutation.UpdatedBy(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedBy, field.TypeString, value)
+	}
+	if wdu.mutation.UpdatedByCleared() {
+		_spec.ClearField(webhookdelivery.FieldUpdatedBy, field.TypeString)
+	}
+	if wdu.mutation.RequestBodyCleared() {
+		_spec.ClearField(webhookdelivery.FieldRequestBody, field.TypeString)
+	}
+	if wdu.mutation.ResponseStatusCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseStatus, field.TypeInt)
+	}
+	if wdu.mutation.ResponseBodyCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseBody, field.TypeString)
+	}
+	if wdu.mutation.LatencyCleared() {
+		_spec.ClearField(webhookdelivery.FieldLatency, field.TypeInt64)
+	}
+	if wdu.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if wdu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.OwnerTable,
+			Columns: []string{webhookdelivery.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wdu.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.OwnerTable,
+			Columns: []string{webhookdelivery.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wdu.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = wdu.schemaConfig.WebhookDelivery
+	ctx = internal.NewSchemaConfigContext(ctx, wdu.schemaConfig)
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdatedAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetUpdatedAt(t)
+	return wduo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearUpdatedAt() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearUpdatedAt()
+	return wduo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdatedBy(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetUpdatedBy(s)
+	return wduo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableUpdatedBy(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetUpdatedBy(*s)
+	}
+	return wduo
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearUpdatedBy() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearUpdatedBy()
+	return wduo
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetOwnerID(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetOwnerID(s)
+	return wduo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableOwnerID(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetOwnerID(*s)
+	}
+	return wduo
+}
+
+// ClearOwnerID clears the value of the "owner_id" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearOwnerID() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearOwnerID()
+	return wduo
+}
+
+// SetOwner sets the "owner" edge to the Organization entity.
+func (wduo *WebhookDeliveryUpdateOne) SetOwner(o *Organization) *WebhookDeliveryUpdateOne {
+	return wduo.SetOwnerID(o.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the Organization entity.
+func (wduo *WebhookDeliveryUpdateOne) ClearOwner() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearOwner()
+	return wduo
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wduo *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wduo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wduo *WebhookDeliveryUpdateOne) defaults() error {
+	if _, ok := wduo.mutation.UpdatedAt(); !ok && !wduo.mutation.UpdatedAtCleared() {
+		if webhookdelivery.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("generated: uninitialized webhookdelivery.UpdateDefaultUpdatedAt (forgotten import generated/runtime?)")
+		}
+		v := webhookdelivery.UpdateDefaultUpdatedAt()
+		wduo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wduo *WebhookDeliveryUpdateOne) check() error {
+	if v, ok := wduo.mutation.OwnerID(); ok {
+		if err := webhookdelivery.OwnerIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`generated: validator failed for field "WebhookDelivery.owner_id": %w`, err)}
+		}
+	}
+	if wduo.mutation.WebhookCleared() && len(wduo.mutation.WebhookIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	if wduo.mutation.EventCleared() && len(wduo.mutation.EventIDs()) > 0 {
+		return errors.New(`generated: clearing a required unique edge "WebhookDelivery.event"`)
+	}
+	return nil
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := wduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if wduo.mutation.CreatedAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := wduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if wduo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(webhookdelivery.FieldUpdatedAt, field.TypeTime)
+	}
+	if wduo.mutation.CreatedByCleared() {
+		_spec.ClearField(webhookdelivery.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := wduo.mutation.UpdatedBy(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdatedBy, field.TypeString, value)
+	}
+	if wduo.mutation.UpdatedByCleared() {
+		_spec.ClearField(webhookdelivery.FieldUpdatedBy, field.TypeString)
+	}
+	if wduo.mutation.RequestBodyCleared() {
+		_spec.ClearField(webhookdelivery.FieldRequestBody, field.TypeString)
+	}
+	if wduo.mutation.ResponseStatusCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseStatus, field.TypeInt)
+	}
+	if wduo.mutation.ResponseBodyCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseBody, field.TypeString)
+	}
+	if wduo.mutation.LatencyCleared() {
+		_spec.ClearField(webhookdelivery.FieldLatency, field.TypeInt64)
+	}
+	if wduo.mutation.ErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldError, field.TypeString)
+	}
+	if wduo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.OwnerTable,
+			Columns: []string{webhookdelivery.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wduo.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wduo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.OwnerTable,
+			Columns: []string{webhookdelivery.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wduo.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = wduo.schemaConfig.WebhookDelivery
+	ctx = internal.NewSchemaConfigContext(ctx, wduo.schemaConfig)
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
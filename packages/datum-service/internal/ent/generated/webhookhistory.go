@@ -51,6 +51,10 @@ type WebhookHistory struct {
 	DestinationURL string `json:"destination_url,omitempty"`
 	// indicates if the webhook is active and enabled
 	Enabled bool `json:"enabled,omitempty"`
+	// the event types the webhook is subscribed to, all events are sent when empty
+	EventTypes []string `json:"event_types,omitempty"`
+	// the object types the webhook receives events for, all object types are sent when empty
+	ObjectTypes []string `json:"object_types,omitempty"`
 	// the call back string
 	Callback string `json:"callback,omitempty"`
 	// the ttl of the webhook delivery
@@ -71,7 +75,7 @@ func (*WebhookHistory) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case webhookhistory.FieldTags, webhookhistory.FieldSecret:
+		case webhookhistory.FieldTags, webhookhistory.FieldEventTypes, webhookhistory.FieldObjectTypes, webhookhistory.FieldSecret:
 			values[i] = new([]byte)
 		case webhookhistory.FieldOperation:
 			values[i] = new(enthistory.OpType)
@@ -202,6 +206,22 @@ func (wh *WebhookHistory) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				wh.Enabled = value.Bool
 			}
+		case webhookhistory.FieldEventTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field event_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wh.EventTypes); err != nil {
+					return fmt.Errorf("unmarshal field event_types: %w", err)
+				}
+			}
+		case webhookhistory.FieldObjectTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field object_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wh.ObjectTypes); err != nil {
+					return fmt.Errorf("unmarshal field object_types: %w", err)
+				}
+			}
 		case webhookhistory.FieldCallback:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field callback", values[i])
@@ -322,6 +342,12 @@ func (wh *WebhookHistory) String() string {
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", wh.Enabled))
 	builder.WriteString(", ")
+	builder.WriteString("event_types=")
+	builder.WriteString(fmt.Sprintf("%v", wh.EventTypes))
+	builder.WriteString(", ")
+	builder.WriteString("object_types=")
+	builder.WriteString(fmt.Sprintf("%v", wh.ObjectTypes))
+	builder.WriteString(", ")
 	builder.WriteString("callback=")
 	builder.WriteString(wh.Callback)
 	builder.WriteString(", ")
@@ -49,6 +49,10 @@ const (
 	FieldDestinationURL = "destination_url"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
+	// FieldEventTypes holds the string denoting the event_types field in the database.
+	FieldEventTypes = "event_types"
+	// FieldObjectTypes holds the string denoting the object_types field in the database.
+	FieldObjectTypes = "object_types"
 	// FieldCallback holds the string denoting the callback field in the database.
 	FieldCallback = "callback"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
@@ -84,6 +88,8 @@ var Columns = []string{
 	FieldDescription,
 	FieldDestinationURL,
 	FieldEnabled,
+	FieldEventTypes,
+	FieldObjectTypes,
 	FieldCallback,
 	FieldExpiresAt,
 	FieldSecret,
@@ -1040,6 +1040,26 @@ func EnabledNEQ(v bool) predicate.WebhookHistory {
 	return predicate.WebhookHistory(sql.FieldNEQ(FieldEnabled, v))
 }
 
+// EventTypesIsNil applies the IsNil predicate on the "event_types" field.
+func EventTypesIsNil() predicate.WebhookHistory {
+	return predicate.WebhookHistory(sql.FieldIsNull(FieldEventTypes))
+}
+
+// EventTypesNotNil applies the NotNil predicate on the "event_types" field.
+func EventTypesNotNil() predicate.WebhookHistory {
+	return predicate.WebhookHistory(sql.FieldNotNull(FieldEventTypes))
+}
+
+// ObjectTypesIsNil applies the IsNil predicate on the "object_types" field.
+func ObjectTypesIsNil() predicate.WebhookHistory {
+	return predicate.WebhookHistory(sql.FieldIsNull(FieldObjectTypes))
+}
+
+// ObjectTypesNotNil applies the NotNil predicate on the "object_types" field.
+func ObjectTypesNotNil() predicate.WebhookHistory {
+	return predicate.WebhookHistory(sql.FieldNotNull(FieldObjectTypes))
+}
+
 // CallbackEQ applies the EQ predicate on the "callback" field.
 func CallbackEQ(v string) predicate.WebhookHistory {
 	return predicate.WebhookHistory(sql.FieldEQ(FieldCallback, v))
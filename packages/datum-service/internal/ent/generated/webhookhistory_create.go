@@ -213,6 +213,18 @@ func (whc *WebhookHistoryCreate) SetNillableEnabled(b *bool) *WebhookHistoryCrea
 	return whc
 }
 
+// SetEventTypes sets the "event_types" field.
+func (whc *WebhookHistoryCreate) SetEventTypes(s []string) *WebhookHistoryCreate {
+	whc.mutation.SetEventTypes(s)
+	return whc
+}
+
+// SetObjectTypes sets the "object_types" field.
+func (whc *WebhookHistoryCreate) SetObjectTypes(s []string) *WebhookHistoryCreate {
+	whc.mutation.SetObjectTypes(s)
+	return whc
+}
+
 // SetCallback sets the "callback" field.
 func (whc *WebhookHistoryCreate) SetCallback(s string) *WebhookHistoryCreate {
 	whc.mutation.SetCallback(s)
@@ -515,6 +527,14 @@ func (whc *WebhookHistoryCreate) createSpec() (*WebhookHistory, *sqlgraph.Create
 		_spec.SetField(webhookhistory.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
 	}
+	if value, ok := whc.mutation.EventTypes(); ok {
+		_spec.SetField(webhookhistory.FieldEventTypes, field.TypeJSON, value)
+		_node.EventTypes = value
+	}
+	if value, ok := whc.mutation.ObjectTypes(); ok {
+		_spec.SetField(webhookhistory.FieldObjectTypes, field.TypeJSON, value)
+		_node.ObjectTypes = value
+	}
 	if value, ok := whc.mutation.Callback(); ok {
 		_spec.SetField(webhookhistory.FieldCallback, field.TypeString, value)
 		_node.Callback = value
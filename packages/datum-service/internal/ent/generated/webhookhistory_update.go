@@ -203,6 +203,42 @@ func (whu *WebhookHistoryUpdate) SetNillableEnabled(b *bool) *WebhookHistoryUpda
 	return whu
 }
 
+// SetEventTypes sets the "event_types" field.
+func (whu *WebhookHistoryUpdate) SetEventTypes(s []string) *WebhookHistoryUpdate {
+	whu.mutation.SetEventTypes(s)
+	return whu
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (whu *WebhookHistoryUpdate) AppendEventTypes(s []string) *WebhookHistoryUpdate {
+	whu.mutation.AppendEventTypes(s)
+	return whu
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (whu *WebhookHistoryUpdate) ClearEventTypes() *WebhookHistoryUpdate {
+	whu.mutation.ClearEventTypes()
+	return whu
+}
+
+// SetObjectTypes sets the "object_types" field.
+func (whu *WebhookHistoryUpdate) SetObjectTypes(s []string) *WebhookHistoryUpdate {
+	whu.mutation.SetObjectTypes(s)
+	return whu
+}
+
+// AppendObjectTypes appends s to the "object_types" field.
+func (whu *WebhookHistoryUpdate) AppendObjectTypes(s []string) *WebhookHistoryUpdate {
+	whu.mutation.AppendObjectTypes(s)
+	return whu
+}
+
+// ClearObjectTypes clears the value of the "object_types" field.
+func (whu *WebhookHistoryUpdate) ClearObjectTypes() *WebhookHistoryUpdate {
+	whu.mutation.ClearObjectTypes()
+	return whu
+}
+
 // SetCallback sets the "callback" field.
 func (whu *WebhookHistoryUpdate) SetCallback(s string) *WebhookHistoryUpdate {
 	whu.mutation.SetCallback(s)
@@ -443,6 +479,28 @@ func (whu *WebhookHistoryUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := whu.mutation.Enabled(); ok {
 		_spec.SetField(webhookhistory.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := whu.mutation.EventTypes(); ok {
+		_spec.SetField(webhookhistory.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := whu.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookhistory.FieldEventTypes, value)
+		})
+	}
+	if whu.mutation.EventTypesCleared() {
+		_spec.ClearField(webhookhistory.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := whu.mutation.ObjectTypes(); ok {
+		_spec.SetField(webhookhistory.FieldObjectTypes, field.TypeJSON, value)
+	}
+	if value, ok := whu.mutation.AppendedObjectTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookhistory.FieldObjectTypes, value)
+		})
+	}
+	if whu.mutation.ObjectTypesCleared() {
+		_spec.ClearField(webhookhistory.FieldObjectTypes, field.TypeJSON)
+	}
 	if value, ok := whu.mutation.Callback(); ok {
 		_spec.SetField(webhookhistory.FieldCallback, field.TypeString, value)
 	}
@@ -676,6 +734,42 @@ func (whuo *WebhookHistoryUpdateOne) SetNillableEnabled(b *bool) *WebhookHistory
 	return whuo
 }
 
+// SetEventTypes sets the "event_types" field.
+func (whuo *WebhookHistoryUpdateOne) SetEventTypes(s []string) *WebhookHistoryUpdateOne {
+	whuo.mutation.SetEventTypes(s)
+	return whuo
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (whuo *WebhookHistoryUpdateOne) AppendEventTypes(s []string) *WebhookHistoryUpdateOne {
+	whuo.mutation.AppendEventTypes(s)
+	return whuo
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (whuo *WebhookHistoryUpdateOne) ClearEventTypes() *WebhookHistoryUpdateOne {
+	whuo.mutation.ClearEventTypes()
+	return whuo
+}
+
+// SetObjectTypes sets the "object_types" field.
+func (whuo *WebhookHistoryUpdateOne) SetObjectTypes(s []string) *WebhookHistoryUpdateOne {
+	whuo.mutation.SetObjectTypes(s)
+	return whuo
+}
+
+// AppendObjectTypes appends s to the "object_types" field.
+func (whuo *WebhookHistoryUpdateOne) AppendObjectTypes(s []string) *WebhookHistoryUpdateOne {
+	whuo.mutation.AppendObjectTypes(s)
+	return whuo
+}
+
+// ClearObjectTypes clears the value of the "object_types" field.
+func (whuo *WebhookHistoryUpdateOne) ClearObjectTypes() *WebhookHistoryUpdateOne {
+	whuo.mutation.ClearObjectTypes()
+	return whuo
+}
+
 // SetCallback sets the "callback" field.
 func (whuo *WebhookHistoryUpdateOne) SetCallback(s string) *WebhookHistoryUpdateOne {
 	whuo.mutation.SetCallback(s)
@@ -946,6 +1040,28 @@ func (whuo *WebhookHistoryUpdateOne) sqlSave(ctx context.Context) (_node *Webhoo
 	if value, ok := whuo.mutation.Enabled(); ok {
 		_spec.SetField(webhookhistory.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := whuo.mutation.EventTypes(); ok {
+		_spec.SetField(webhookhistory.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := whuo.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookhistory.FieldEventTypes, value)
+		})
+	}
+	if whuo.mutation.EventTypesCleared() {
+		_spec.ClearField(webhookhistory.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := whuo.mutation.ObjectTypes(); ok {
+		_spec.SetField(webhookhistory.FieldObjectTypes, field.TypeJSON, value)
+	}
+	if value, ok := whuo.mutation.AppendedObjectTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhookhistory.FieldObjectTypes, value)
+		})
+	}
+	if whuo.mutation.ObjectTypesCleared() {
+		_spec.ClearField(webhookhistory.FieldObjectTypes, field.TypeJSON)
+	}
 	if value, ok := whuo.mutation.Callback(); ok {
 		_spec.SetField(webhookhistory.FieldCallback, field.TypeString, value)
 	}
@@ -25,6 +25,10 @@ func HookCreateAPIToken() ent.Hook {
 			// set organization on the token
 			mutation.SetOwnerID(orgID)
 
+			if err := validateScopes(ctx, mutation); err != nil {
+				return nil, err
+			}
+
 			retVal, err := next.Mutate(ctx, mutation)
 			if err != nil {
 				return nil, err
@@ -59,6 +63,10 @@ func HookCreateAPIToken() ent.Hook {
 func HookUpdateAPIToken() ent.Hook {
 	return hook.On(func(next ent.Mutator) ent.Mutator {
 		return hook.APITokenFunc(func(ctx context.Context, mutation *generated.APITokenMutation) (generated.Value, error) {
+			if err := validateScopes(ctx, mutation); err != nil {
+				return nil, err
+			}
+
 			retVal, err := next.Mutate(ctx, mutation)
 			if err != nil {
 				return nil, err
@@ -97,22 +105,34 @@ func HookUpdateAPIToken() ent.Hook {
 	}, ent.OpUpdate|ent.OpUpdateOne)
 }
 
-// createScopeTuples creates the relationship tuples for the token
+// createScopeTuples creates the relationship tuples for the token, the scopes are granted on the organization based
+// on their action; access to individual resources is restricted by the scopes when the token is used
 func createScopeTuples(scopes []string, orgID, tokenID string) (tuples []fgax.TupleKey, err error) {
-	// create the relationship tuples in fga for the token
-	// TODO (sfunk): this shouldn't be a static list
-	for _, scope := range scopes {
+	relations := []string{}
+
+	for _, s := range scopes {
+		scope, err := auth.ParseScope(s)
+		if err != nil {
+			return nil, err
+		}
+
 		var relation string
 
-		switch scope {
-		case "read":
+		switch scope.Action {
+		case auth.ScopeActionRead:
 			relation = "can_view"
-		case "write":
+		case auth.ScopeActionWrite:
 			relation = "can_edit"
-		case "delete":
+		case auth.ScopeActionDelete:
 			relation = "can_delete"
 		}
 
+		if sliceutil.Contains(relations, relation) {
+			continue
+		}
+
+		relations = append(relations, relation)
+
 		req := fgax.TupleRequest{
 			SubjectID:   tokenID,
 			SubjectType: "service",
@@ -127,6 +147,30 @@ func createScopeTuples(scopes []string, orgID, tokenID string) (tuples []fgax.Tu
 	return
 }
 
+// scopesMutation is a mutation of a token with scopes
+type scopesMutation interface {
+	Scopes() ([]string, bool)
+	AppendedScopes() ([]string, bool)
+}
+
+// validateScopes returns an error if the mutation sets or appends scopes that are unknown, or scopes that are not
+// held by the token making the request
+func validateScopes(ctx context.Context, mutation scopesMutation) error {
+	if scopes, ok := mutation.Scopes(); ok {
+		if err := auth.CheckGrantScopes(ctx, scopes); err != nil {
+			return err
+		}
+	}
+
+	if scopes, ok := mutation.AppendedScopes(); ok {
+		if err := auth.CheckGrantScopes(ctx, scopes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // getNewScopes returns the new scopes that were added to the token during an update
 // NOTE: there is an AppendedScopes on the mutation, but this is not populated
 // so calculating the new scopes for now
@@ -0,0 +1,176 @@
+package hooks
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/customtypes"
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/documentdata"
+	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
+	"github.com/datumforge/datum/pkg/templates"
+)
+
+// HookDocumentData runs on document data create and update mutations to pin the document to the current version
+// of its template and validate the data against the JSON Schema of the pinned version
+func HookDocumentData() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.DocumentDataFunc(func(ctx context.Context, m *generated.DocumentDataMutation) (generated.Value, error) {
+			data, dataSet := m.Data()
+			templateID, templateSet := m.TemplateID()
+
+			if !dataSet && !templateSet {
+				return next.Mutate(ctx, m)
+			}
+
+			// the mutation policy has authorized the document, the template is read to validate it
+			allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+			// documents created or moved to a template are pinned to its current version
+			var t *generated.Template
+
+			if templateSet {
+				var err error
+
+				t, err = m.Client().Template.Get(allowCtx, templateID)
+				if err != nil && !generated.IsNotFound(err) {
+					return nil, err
+				}
+
+				// a missing template is left to fail on the foreign key constraint of the mutation
+				if t == nil {
+					return next.Mutate(ctx, m)
+				}
+
+				m.SetTemplateVersion(t.Version)
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				// a document created without a template is left to fail on the required template of the mutation
+				if t == nil {
+					return next.Mutate(ctx, m)
+				}
+
+				if err := templates.Validate(t.Jsonconfig, data); err != nil {
+					return nil, err
+				}
+
+				return next.Mutate(ctx, m)
+			}
+
+			// updates may only change the data or the template, so the documents are validated with their
+			// current values for the fields that are not changed
+			ids, err := m.IDs(allowCtx)
+			if err != nil {
+				return nil, err
+			}
+
+			docs, err := m.Client().DocumentData.Query().Where(documentdata.IDIn(ids...)).All(allowCtx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, doc := range docs {
+				d := doc.Data
+				if dataSet {
+					d = data
+				}
+
+				var schema customtypes.JSONObject
+
+				if t != nil {
+					schema = t.Jsonconfig
+				} else if schema, err = pinnedSchema(allowCtx, m.Client(), doc); err != nil {
+					return nil, err
+				}
+
+				if err := templates.Validate(schema, d); err != nil {
+					return nil, err
+				}
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// pinnedSchema returns the schema of the template version the document is pinned to, documents created before
+// templates were versioned are validated against the current schema of the template
+func pinnedSchema(ctx context.Context, c *generated.Client, doc *generated.DocumentData) (customtypes.JSONObject, error) {
+	v, err := pinnedVersion(ctx, c, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if v != nil {
+		return v.Jsonconfig, nil
+	}
+
+	t, err := c.Template.Get(ctx, doc.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Jsonconfig, nil
+}
+
+// RenderableDocument returns the document with the title and description of its template and the schema and
+// layout of the template version the document is pinned to, documents created before templates were versioned
+// are rendered with the current schema and layout of the template; the template edge is used when loaded
+func RenderableDocument(ctx context.Context, c *generated.Client, doc *generated.DocumentData) (templates.Document, error) {
+	t := doc.Edges.Template
+	if t == nil {
+		var err error
+
+		if t, err = c.Template.Get(ctx, doc.TemplateID); err != nil {
+			return templates.Document{}, err
+		}
+	}
+
+	rd := templates.Document{
+		Title:       t.Name,
+		Description: t.Description,
+		Schema:      t.Jsonconfig,
+		UISchema:    t.Uischema,
+		Data:        doc.Data,
+	}
+
+	v, err := pinnedVersion(ctx, c, doc)
+	if err != nil {
+		return templates.Document{}, err
+	}
+
+	if v != nil {
+		rd.Schema = v.Jsonconfig
+		rd.UISchema = v.Uischema
+	}
+
+	return rd, nil
+}
+
+// pinnedVersion returns the template version the document is pinned to, or nil when the document is not pinned
+// to a version
+func pinnedVersion(ctx context.Context, c *generated.Client, doc *generated.DocumentData) (*generated.TemplateVersion, error) {
+	if doc.TemplateVersion == 0 {
+		return nil, nil
+	}
+
+	v, err := c.TemplateVersion.Query().
+		Where(
+			templateversion.TemplateID(doc.TemplateID),
+			templateversion.Version(doc.TemplateVersion),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return v, nil
+}
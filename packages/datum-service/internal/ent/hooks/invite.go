@@ -282,15 +282,20 @@ func createInviteToSend(ctx context.Context, m *generated.InviteMutation) error
 		return err
 	}
 
+	// invites sent with an api token, e.g. by SCIM provisioning, are not requested by a user
+	inviter := org.DisplayName
+
 	requestor, err := m.Client().User.Get(ctx, reqID)
-	if err != nil {
+	if err == nil {
+		inviter = requestor.FirstName
+	} else if !generated.IsNotFound(err) {
 		return err
 	}
 
 	invite := &emails.Invite{
 		OrgName:   org.Name,
 		Token:     token,
-		Requestor: requestor.FirstName,
+		Requestor: inviter,
 		Recipient: email,
 		Role:      string(role),
 	}
@@ -0,0 +1,51 @@
+package hooks
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/pkg/domains"
+)
+
+// HookOrganizationSetting runs on organization setting update mutations and removes the verified domains
+// that are no longer domains of the organization, so a domain must be verified again if it is added back
+func HookOrganizationSetting() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.OrganizationSettingFunc(func(ctx context.Context, m *generated.OrganizationSettingMutation) (generated.Value, error) {
+			newDomains, ok := m.Domains()
+			if !ok && !m.DomainsCleared() {
+				return next.Mutate(ctx, m)
+			}
+
+			appended, _ := m.AppendedDomains()
+			newDomains = append(newDomains, appended...)
+
+			verified, ok := m.VerifiedDomains()
+			if !ok {
+				var err error
+
+				verified, err = m.OldVerifiedDomains(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			keep := []string{}
+
+			for _, d := range verified {
+				if domains.Contains(newDomains, d) {
+					keep = append(keep, d)
+				}
+			}
+
+			if len(keep) != len(verified) {
+				m.SetVerifiedDomains(keep)
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpUpdateOne)
+}
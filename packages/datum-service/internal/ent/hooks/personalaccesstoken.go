@@ -26,6 +26,10 @@ func HookCreatePersonalAccessToken() ent.Hook {
 			// set user on the token
 			mutation.SetOwnerID(userID)
 
+			if err := validateScopes(ctx, mutation); err != nil {
+				return nil, err
+			}
+
 			return next.Mutate(ctx, mutation)
 		})
 	}, ent.OpCreate)
@@ -41,6 +45,10 @@ func HookUpdatePersonalAccessToken() ent.Hook {
 				mutation.ClearOwner()
 			}
 
+			if err := validateScopes(ctx, mutation); err != nil {
+				return nil, err
+			}
+
 			retVal, err := next.Mutate(ctx, mutation)
 			if err != nil {
 				return nil, err
@@ -0,0 +1,89 @@
+package hooks
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/template"
+	"github.com/datumforge/datum/pkg/templates"
+)
+
+// HookTemplate runs on template create and update mutations to validate the jsonconfig is a JSON Schema document
+// and to record an immutable version of the template each time the jsonconfig or uischema is changed
+func HookTemplate() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.TemplateFunc(func(ctx context.Context, m *generated.TemplateMutation) (generated.Value, error) {
+			schema, schemaSet := m.Jsonconfig()
+			if schemaSet {
+				if err := templates.ValidateSchema(schema); err != nil {
+					return nil, err
+				}
+			}
+
+			// the mutation policy has authorized the template, the versions are recorded on its behalf
+			allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+			if m.Op().Is(ent.OpCreate) {
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+
+				t, ok := v.(*generated.Template)
+				if !ok {
+					return v, nil
+				}
+
+				return v, createTemplateVersions(allowCtx, m.Client(), t)
+			}
+
+			_, uischemaSet := m.Uischema()
+			if !schemaSet && !uischemaSet && !m.UischemaCleared() {
+				return next.Mutate(ctx, m)
+			}
+
+			ids, err := m.IDs(allowCtx)
+			if err != nil {
+				return nil, err
+			}
+
+			m.AddVersion(1)
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+
+			ts, err := m.Client().Template.Query().Where(template.IDIn(ids...)).All(allowCtx)
+			if err != nil {
+				return nil, err
+			}
+
+			return v, createTemplateVersions(allowCtx, m.Client(), ts...)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// createTemplateVersions records the current version of the templates
+func createTemplateVersions(ctx context.Context, c *generated.Client, ts ...*generated.Template) error {
+	if len(ts) == 0 {
+		return nil
+	}
+
+	builders := make([]*generated.TemplateVersionCreate, len(ts))
+
+	for i, t := range ts {
+		builders[i] = c.TemplateVersion.Create().
+			SetTemplateID(t.ID).
+			SetVersion(t.Version).
+			SetJsonconfig(t.Jsonconfig).
+			SetUischema(t.Uischema).
+			SetOwnerID(t.OwnerID)
+	}
+
+	return c.TemplateVersion.CreateBulk(builders...).Exec(ctx)
+}
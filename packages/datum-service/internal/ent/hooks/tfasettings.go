@@ -14,45 +14,86 @@ import (
 	"github.com/datumforge/datum/pkg/utils/totp"
 )
 
+// HookEnableTFA generates and stores the TOTP secret when TOTP is allowed, once the setting is verified
+// recovery codes are created and TFA is enabled on the user settings
 func HookEnableTFA() ent.Hook {
 	return hook.On(func(next ent.Mutator) ent.Mutator {
 		return hook.TFASettingFunc(func(ctx context.Context, mutation *generated.TFASettingMutation) (generated.Value, error) {
-			// once verified, create recovery codes
-			verified, ok := mutation.Verified()
-
-			// if recovery codes are cleared, generate new ones
-			gtx := graphql.GetOperationContext(ctx)
-			regenBackupCodes, _ := gtx.Variables["input"].(map[string]interface{})["regenBackupCodes"].(bool)
+			if mutation.TOTP == nil || mutation.TOTP.TOTPManager == nil {
+				return next.Mutate(ctx, mutation)
+			}
 
-			if (ok && verified) || regenBackupCodes {
+			// create the secret used for code generation, this is only done once per setting
+			totpAllowed, ok := mutation.TotpAllowed()
+			if ok && totpAllowed && !hasTFASecret(ctx, mutation) {
 				u, err := constructTOTPUser(ctx, mutation)
 				if err != nil {
 					return nil, err
 				}
 
-				u.TFASecret, err = mutation.TOTP.TOTPManager.TOTPSecret(u)
+				secret, err := mutation.TOTP.TOTPManager.TOTPSecret(u)
 				if err != nil {
 					return nil, err
 				}
 
+				mutation.SetTfaSecret(secret)
+			}
+
+			// once verified, create recovery codes
+			verified, ok := mutation.Verified()
+
+			// if recovery codes are cleared, generate new ones
+			// the settings are also updated outside of graphql requests, e.g. when a recovery code is used during login
+			regenBackupCodes := false
+
+			if graphql.HasOperationContext(ctx) {
+				input, _ := graphql.GetOperationContext(ctx).Variables["input"].(map[string]interface{})
+				regenBackupCodes, _ = input["regenBackupCodes"].(bool)
+			}
+
+			if (ok && verified) || regenBackupCodes {
 				codes := mutation.TOTP.TOTPManager.GenerateRecoveryCodes()
 				mutation.SetRecoveryCodes(codes)
+			}
+
+			if ok && verified {
+				u, err := constructTOTPUser(ctx, mutation)
+				if err != nil {
+					return nil, err
+				}
 
-				if verified {
-					// update user settings
-					_, err := mutation.Client().UserSetting.Update().
-						Where(usersetting.UserID(u.ID)).
-						SetIsTfaEnabled(true). // set tfa enabled to true
-						Save(ctx)
-					if err != nil {
-						return nil, err
-					}
+				// update user settings
+				if _, err := mutation.Client().UserSetting.Update().
+					Where(usersetting.UserID(u.ID)).
+					SetIsTfaEnabled(true). // set tfa enabled to true
+					Save(ctx); err != nil {
+					return nil, err
 				}
 			}
 
 			return next.Mutate(ctx, mutation)
 		})
-	}, ent.OpUpdate|ent.OpUpdateOne)
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// hasTFASecret returns true when the setting being updated already has a secret, a new secret should not be
+// generated because the user has already added the existing one to their authenticator app
+func hasTFASecret(ctx context.Context, mutation *generated.TFASettingMutation) bool {
+	if _, ok := mutation.TfaSecret(); ok {
+		return true
+	}
+
+	switch mutation.Op() {
+	case ent.OpCreate:
+		return false
+	case ent.OpUpdateOne:
+		secret, err := mutation.OldTfaSecret(ctx)
+
+		return err == nil && secret != nil
+	default:
+		// bulk updates do not generate secrets
+		return true
+	}
 }
 
 // constructTOTPUser constructs a TOTP user object from the mutation
@@ -0,0 +1,47 @@
+package hooks
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/hook"
+	"github.com/datumforge/datum/pkg/webhooks"
+)
+
+// HookWebhook runs on webhook create mutations to generate the secret used to sign deliveries
+// and on update mutations to validate appended event and object types
+func HookWebhook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.WebhookFunc(func(ctx context.Context, m *generated.WebhookMutation) (generated.Value, error) {
+			// appended values are not checked by the field validators
+			if eventTypes, ok := m.AppendedEventTypes(); ok {
+				if err := webhooks.ValidateEventTypes(eventTypes); err != nil {
+					return nil, err
+				}
+			}
+
+			if objectTypes, ok := m.AppendedObjectTypes(); ok {
+				if err := webhooks.ValidateObjectTypes(objectTypes); err != nil {
+					return nil, err
+				}
+			}
+
+			if !m.Op().Is(ent.OpCreate) {
+				return next.Mutate(ctx, m)
+			}
+
+			if secret, ok := m.Secret(); !ok || len(secret) == 0 {
+				secret, err := webhooks.NewSecret()
+				if err != nil {
+					return nil, err
+				}
+
+				m.SetSecret(secret)
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
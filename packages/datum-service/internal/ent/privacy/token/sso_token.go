@@ -0,0 +1,47 @@
+package token
+
+import "context"
+
+// SSOToken that implements the PrivacyToken interface
+type SSOToken struct {
+	PrivacyToken
+	organizationID string
+}
+
+type ssoTokenKey struct{}
+
+// GetContextKey from SSOToken
+func (SSOToken) GetContextKey() interface{} {
+	return ssoTokenKey{}
+}
+
+// NewSSOTokenWithOrganizationID creates a new PrivacyToken of type SSOToken with
+// organization id set
+func NewSSOTokenWithOrganizationID(organizationID string) SSOToken {
+	return SSOToken{
+		organizationID: organizationID,
+	}
+}
+
+// GetOrganizationID from sso token
+func (token *SSOToken) GetOrganizationID() string {
+	return token.organizationID
+}
+
+// SetOrganizationID on the sso token
+func (token *SSOToken) SetOrganizationID(id string) {
+	token.organizationID = id
+}
+
+// NewContextWithSSOToken returns a new context with the sso token inside
+func NewContextWithSSOToken(parent context.Context, organizationID string) context.Context {
+	return context.WithValue(parent, ssoTokenKey{}, &SSOToken{
+		organizationID: organizationID,
+	})
+}
+
+// SSOTokenFromContext parses a context for a sso token and returns the token
+func SSOTokenFromContext(ctx context.Context) *SSOToken {
+	token, _ := ctx.Value(ssoTokenKey{}).(*SSOToken)
+	return token
+}
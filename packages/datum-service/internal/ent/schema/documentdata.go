@@ -15,6 +15,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/customtypes"
 	"github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
 	"github.com/datumforge/datum/internal/ent/mixin"
 )
 
@@ -28,6 +29,12 @@ func (DocumentData) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("template_id").
 			Comment("the template id of the document"),
+		field.Int("template_version").
+			Comment("the version of the template the document is validated against").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 		field.JSON("data", customtypes.JSONObject{}).
 			Comment("the json data of the document").
 			Annotations(
@@ -62,6 +69,13 @@ func (DocumentData) Edges() []ent.Edge {
 	}
 }
 
+// Hooks of the DocumentData
+func (DocumentData) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookDocumentData(),
+	}
+}
+
 // Annotations of the DocumentData
 func (DocumentData) Annotations() []schema.Annotation {
 	return []schema.Annotation{
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/datumforge/entx"
 	emixin "github.com/datumforge/entx/mixin"
 )
 
@@ -44,6 +45,11 @@ func (Event) Edges() []ent.Edge {
 		edge.From("entitlement", Entitlement.Type).Ref("events"),
 		edge.From("webhook", Webhook.Type).Ref("events"),
 		edge.From("subscriber", Subscriber.Type).Ref("events"),
+		edge.To("webhook_deliveries", WebhookDelivery.Type).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+				entx.CascadeAnnotationField("Event"),
+			),
 	}
 }
 
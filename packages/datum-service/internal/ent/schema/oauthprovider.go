@@ -16,6 +16,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/internal/ent/privacy/token"
 )
 
 // OauthProvider holds the schema definition for the OauthProvider entity
@@ -48,6 +49,9 @@ func (OauthProvider) Fields() []ent.Field {
 			Comment("the auth style, 0: auto detect 1: third party log in 2: log in with username and password"),
 		field.String("info_url").
 			Comment("the URL to request user information by token"),
+		field.String("issuer").
+			Comment("the OIDC issuer URL, when set the provider endpoints are discovered and ID tokens are verified").
+			Optional(),
 	}
 }
 
@@ -82,6 +86,9 @@ func (OauthProvider) Mixin() []ent.Mixin {
 		mixin.SoftDeleteMixin{},
 		OrgOwnerMixin{
 			Ref: "oauthprovider",
+			SkipTokenType: []token.PrivacyToken{
+				&token.SSOToken{},
+			},
 		},
 	}
 }
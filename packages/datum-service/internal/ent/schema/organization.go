@@ -115,6 +115,11 @@ func (Organization) Edges() []ent.Edge {
 			Annotations(
 				entx.CascadeAnnotationField("Owner"),
 			),
+		edge.To("template_versions", TemplateVersion.Type).
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+				entx.CascadeAnnotationField("Owner"),
+			),
 		edge.To("integrations", Integration.Type).
 			Annotations(
 				entx.CascadeAnnotationField("Owner"),
@@ -147,6 +152,11 @@ func (Organization) Edges() []ent.Edge {
 			Annotations(entx.CascadeAnnotationField("Owner")),
 		edge.To("webhooks", Webhook.Type).
 			Annotations(entx.CascadeAnnotationField("Owner")),
+		edge.To("webhook_deliveries", WebhookDelivery.Type).
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+				entx.CascadeAnnotationField("Owner"),
+			),
 		edge.To("events", Event.Type),
 		edge.To("secrets", Hush.Type),
 		edge.To("features", Feature.Type),
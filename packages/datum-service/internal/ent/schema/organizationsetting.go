@@ -3,6 +3,7 @@ package schema
 import (
 	"context"
 	"net/mail"
+	"net/url"
 	"regexp"
 
 	"entgo.io/contrib/entgql"
@@ -16,10 +17,13 @@ import (
 
 	"github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
 	"github.com/datumforge/datum/internal/ent/interceptors"
 	"github.com/datumforge/datum/internal/ent/mixin"
 	"github.com/datumforge/datum/internal/ent/validator"
+	"github.com/datumforge/datum/pkg/domains"
 	"github.com/datumforge/datum/pkg/enums"
+	"github.com/datumforge/datum/pkg/providers/saml"
 )
 
 // OrganizationSetting holds the schema definition for the OrganizationSetting entity
@@ -66,6 +70,39 @@ func (OrganizationSetting) Fields() []ent.Field {
 		field.String("organization_id").
 			Comment("the ID of the organization the settings belong to").
 			Optional(),
+		field.Bool("sso_enforced").
+			Comment("members with an email address in one of the organization domains must login with the organization SSO provider").
+			Default(false),
+		field.String("saml_metadata_url").
+			Comment("URL of the SAML metadata of the organization identity provider").
+			Validate(func(s string) error {
+				_, err := url.ParseRequestURI(s)
+				return err
+			}).
+			Optional(),
+		field.Text("saml_metadata").
+			Comment("SAML metadata XML of the organization identity provider, used instead of the metadata URL when set").
+			Validate(func(s string) error {
+				_, err := saml.ParseMetadata([]byte(s))
+				return err
+			}).
+			Optional(),
+		field.JSON("saml_attribute_mapping", map[string]interface{}{}).
+			Comment("mapping of the user fields (email, first_name, last_name, display_name) to the SAML assertion attribute names").
+			Optional(),
+		field.String("domain_verification_token").
+			Comment("token published in a DNS TXT record or the well-known file of a domain to verify the organization owns the domain").
+			DefaultFunc(domains.NewToken).
+			Optional().
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		field.Strings("verified_domains").
+			Comment("domains of the organization which have been verified to be owned by the organization").
+			Optional().
+			Annotations(entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput)),
+		field.Enum("join_policy").
+			GoType(enums.OrgJoinPolicy("")).
+			Comment("the policy for users with an email address in a verified domain, whether they are added as members, offered to join, or must be invited").
+			Default(string(enums.OrgJoinPolicyInviteOnly)),
 	}
 }
 
@@ -91,6 +128,13 @@ func (OrganizationSetting) Annotations() []schema.Annotation {
 	}
 }
 
+// Hooks of the OrganizationSetting
+func (OrganizationSetting) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookOrganizationSetting(),
+	}
+}
+
 // Interceptors of the OrganizationSetting
 func (OrganizationSetting) Interceptors() []ent.Interceptor {
 	return []ent.Interceptor{
@@ -38,6 +38,21 @@ func (OrgMembership) Fields() []ent.Field {
 			Default(string(enums.RoleMember)),
 		field.String("organization_id").Immutable(),
 		field.String("user_id").Immutable(),
+		field.String("sso_issuer").
+			Comment("the issuer of the single sign-on identity of the member, e.g. the OIDC issuer or the SAML identity provider entity id").
+			Annotations(entgql.Skip(entgql.SkipAll)).
+			Optional().
+			Nillable(),
+		field.String("sso_subject").
+			Comment("the subject of the single sign-on identity of the member at the issuer").
+			Annotations(entgql.Skip(entgql.SkipAll)).
+			Optional().
+			Nillable(),
+		field.Bool("user_provisioned").
+			Comment("whether the user account was created by the organization when the member was provisioned, only then the organization manages the profile of the user").
+			Annotations(entgql.Skip(entgql.SkipAll)).
+			Immutable().
+			Default(false),
 	}
 }
 
@@ -76,6 +91,9 @@ func (OrgMembership) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id", "organization_id").
 			Unique().Annotations(entsql.IndexWhere("deleted_at is NULL")),
+		// a single sign-on identity is linked to one member of the organization
+		index.Fields("organization_id", "sso_issuer", "sso_subject").
+			Unique().Annotations(entsql.IndexWhere("deleted_at is NULL")),
 	}
 }
 
@@ -0,0 +1,120 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/datumforge/enthistory"
+	"github.com/datumforge/entx"
+	emixin "github.com/datumforge/entx/mixin"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity; events are written to the outbox in the same
+// transaction as the mutation that produced them and are relayed to the event publisher once the transaction commits
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Fields of the OutboxEvent
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("topic").
+			Comment("the topic the event is published to, e.g. user.created").
+			Immutable().
+			NotEmpty(),
+		field.String("aggregate_type").
+			Comment("the type of the object the event is about").
+			Immutable().
+			NotEmpty(),
+		field.String("aggregate_id").
+			Comment("the id of the object the event is about, events with the same aggregate id are published in order").
+			Immutable().
+			NotEmpty(),
+		field.String("organization_id").
+			Comment("the organization the event was produced in, if any").
+			Immutable().
+			Optional(),
+		field.JSON("payload", map[string]interface{}{}).
+			Comment("the payload of the event").
+			Immutable().
+			Optional(),
+		field.Int("attempts").
+			Comment("the number of times publishing the event has been attempted").
+			Default(0),
+		field.Time("available_at").
+			Comment("the time the event can next be published, used to back off failed attempts").
+			Default(time.Now),
+		field.Time("published_at").
+			Comment("the time the event was published, empty while the event is pending").
+			Optional().
+			Nillable(),
+		field.JSON("published_to", []string{}).
+			Comment("the names of the publishers that accepted the event, they are skipped when the event is retried").
+			Optional(),
+		field.String("last_error").
+			Comment("the error of the last failed publishing attempt").
+			Optional(),
+		field.String("claim_id").
+			Comment("the id of the relay drain that claimed the event for publishing, empty while the event is unclaimed").
+			Optional().
+			Nillable(),
+		field.Time("claimed_until").
+			Comment("the time the claim expires, after which the event can be claimed by another relay").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the OutboxEvent
+func (OutboxEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		emixin.AuditMixin{},
+		emixin.IDMixin{},
+	}
+}
+
+// Edges of the OutboxEvent
+func (OutboxEvent) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the OutboxEvent
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// the relay looks up pending events that are available to publish
+		index.Fields("published_at", "available_at"),
+		index.Fields("aggregate_id"),
+		index.Fields("claim_id"),
+	}
+}
+
+// Annotations of the OutboxEvent
+func (OutboxEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(entgql.SkipAll),
+		entx.SchemaGenSkip(true),
+		entx.QueryGenSkip(true),
+		enthistory.Annotations{
+			Exclude: true,
+		},
+	}
+}
+
+// Policy of the OutboxEvent
+func (OutboxEvent) Policy() ent.Policy {
+	return privacy.Policy{
+		// the outbox is only written and read by the service
+		Mutation: privacy.MutationPolicy{
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
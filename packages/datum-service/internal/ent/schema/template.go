@@ -17,6 +17,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/customtypes"
 	"github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
 	"github.com/datumforge/datum/internal/ent/mixin"
 	"github.com/datumforge/datum/pkg/enums"
 )
@@ -66,6 +67,26 @@ func (Template) Fields() []ent.Field {
 				entgql.Type("JSON"),
 			).
 			Optional(),
+		field.Int("version").
+			Comment("the current version of the template, incremented each time the jsonconfig or uischema is changed").
+			Default(1).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.String("source_template_id").
+			Comment("the root template the template was cloned from").
+			Optional().
+			Immutable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Int("source_template_version").
+			Comment("the version of the root template the template was cloned from").
+			Optional().
+			Immutable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 	}
 }
 
@@ -76,6 +97,15 @@ func (Template) Edges() []ent.Edge {
 			Annotations(
 				entx.CascadeAnnotationField("Template"),
 			),
+		edge.To("versions", TemplateVersion.Type).
+			Annotations(
+				entx.CascadeAnnotationField("Template"),
+			),
+		edge.To("clones", Template.Type).
+			From("source_template").
+			Field("source_template_id").
+			Immutable().
+			Unique(),
 	}
 }
 
@@ -88,6 +118,13 @@ func (Template) Indexes() []ent.Index {
 	}
 }
 
+// Hooks of the Template
+func (Template) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookTemplate(),
+	}
+}
+
 // Annotations of the Template
 func (Template) Annotations() []schema.Annotation {
 	return []schema.Annotation{
@@ -0,0 +1,129 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/enthistory"
+	"github.com/datumforge/entx"
+	emixin "github.com/datumforge/entx/mixin"
+	"github.com/datumforge/fgax/entfga"
+
+	"github.com/datumforge/datum/internal/ent/customtypes"
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/mixin"
+)
+
+// TemplateVersion holds the schema definition for the TemplateVersion entity
+type TemplateVersion struct {
+	ent.Schema
+}
+
+// Fields of the TemplateVersion
+func (TemplateVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("template_id").
+			Comment("the template the version belongs to").
+			Immutable(),
+		field.Int("version").
+			Comment("the version number of the template, starting at 1").
+			Immutable().
+			Annotations(
+				entgql.OrderField("version"),
+			),
+		field.JSON("jsonconfig", customtypes.JSONObject{}).
+			Comment("the jsonschema object of the template at the version").
+			Immutable().
+			Annotations(
+				entgql.Type("JSON"),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+		field.JSON("uischema", customtypes.JSONObject{}).
+			Comment("the uischema of the template at the version").
+			Immutable().
+			Optional().
+			Annotations(
+				entgql.Type("JSON"),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+	}
+}
+
+// Mixin of the TemplateVersion
+func (TemplateVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		emixin.AuditMixin{},
+		mixin.SoftDeleteMixin{},
+		emixin.IDMixin{},
+		OrgOwnerMixin{
+			Ref: "template_versions",
+		},
+	}
+}
+
+// Edges of the TemplateVersion
+func (TemplateVersion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("template", Template.Type).
+			Ref("versions").
+			Field("template_id").
+			Immutable().
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the TemplateVersion
+func (TemplateVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		// a template has a single version for each number
+		index.Fields("template_id", "version").
+			Unique().Annotations(entsql.IndexWhere("deleted_at is NULL")),
+	}
+}
+
+// Annotations of the TemplateVersion
+func (TemplateVersion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entfga.Annotations{
+			ObjectType:      "organization",
+			IncludeHooks:    false,
+			NillableIDField: true,
+			OrgOwnedField:   true,
+			IDField:         "OwnerID",
+		},
+		// versions are immutable, the history of the template is tracked by the versions themselves
+		enthistory.Annotations{
+			Exclude: true,
+		},
+		// versions are recorded by the template hooks, only the query schema is generated through ent
+		entx.SchemaGenSkip(true),
+		entx.QueryGenSkip(true),
+	}
+}
+
+// Policy of the TemplateVersion
+func (TemplateVersion) Policy() ent.Policy {
+	return privacy.Policy{
+		// versions are only recorded by the template hooks and removed with their template
+		Mutation: privacy.MutationPolicy{
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			privacy.TemplateVersionQueryRuleFunc(func(ctx context.Context, q *generated.TemplateVersionQuery) error {
+				return q.CheckAccess(ctx)
+			}),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -31,7 +31,8 @@ func (TFASetting) Fields() []ent.Field {
 		field.Bool("verified").
 			Comment("specifies if the TFA device has been verified").
 			Annotations(
-				entgql.Skip(entgql.SkipMutationCreateInput),
+				// only set by the verifyTFASetting mutation once a valid code is provided
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
 			).
 			Default(false),
 		field.Strings("recovery_codes").
@@ -60,6 +61,20 @@ func (TFASetting) Fields() []ent.Field {
 			Comment("specifies a user may complete authentication by verifying a TOTP code delivered through an authenticator app").
 			Optional().
 			Default(false),
+		field.String("challenge_id").
+			Comment("the ID of the login challenge the user can complete with a second factor, cleared once it is completed or invalidated").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			).
+			Sensitive().
+			Optional().
+			Nillable(),
+		field.Int("challenge_attempts").
+			Comment("the number of invalid codes provided for the login challenge").
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			).
+			Default(0),
 	}
 }
 
@@ -82,7 +97,7 @@ func (TFASetting) Mixin() []ent.Mixin {
 // Hooks of the TFASetting
 func (TFASetting) Hooks() []ent.Hook {
 	return []ent.Hook{
-		hooks.HookEnableTFA(), // sets 2fa on user settings and stores recovery codes
+		hooks.HookEnableTFA(), // stores the totp secret, sets 2fa on user settings and stores recovery codes
 	}
 }
 
@@ -40,6 +40,19 @@ func (UserSetting) Fields() []ent.Field {
 		field.Bool("locked").
 			Comment("user account is locked if unconfirmed or explicitly locked").
 			Default(false),
+		field.Time("locked_until").
+			Comment("the time the lock of the user account expires, a locked account without an expiration must be unlocked by an admin").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Int("failed_login_attempts").
+			Comment("the number of consecutive failed login attempts, the account is locked when the limit is reached").
+			Default(0).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 		field.Time("silenced_at").
 			Comment("The time notifications regarding the user were silenced").
 			Optional().
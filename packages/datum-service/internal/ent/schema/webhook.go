@@ -10,10 +10,13 @@ import (
 	"entgo.io/ent/schema/index"
 
 	"github.com/datumforge/enthistory"
+	"github.com/datumforge/entx"
 	emixin "github.com/datumforge/entx/mixin"
 	"github.com/datumforge/fgax/entfga"
 
+	"github.com/datumforge/datum/internal/ent/hooks"
 	"github.com/datumforge/datum/internal/ent/mixin"
+	"github.com/datumforge/datum/pkg/webhooks"
 )
 
 // Webhook holds the schema definition for the Webhook entity
@@ -38,13 +41,21 @@ func (Webhook) Fields() []ent.Field {
 			),
 		field.String("destination_url").
 			Comment("the url to send the webhook to").
-			NotEmpty().
+			Validate(webhooks.ValidateDestinationURL).
 			Annotations(
 				entgql.OrderField("url"),
 			),
 		field.Bool("enabled").
 			Comment("indicates if the webhook is active and enabled").
 			Default(true),
+		field.Strings("event_types").
+			Comment("the event types the webhook is subscribed to, all events are sent when empty").
+			Validate(webhooks.ValidateEventTypes).
+			Optional(),
+		field.Strings("object_types").
+			Comment("the object types the webhook receives events for, all object types are sent when empty").
+			Validate(webhooks.ValidateObjectTypes).
+			Optional(),
 		field.String("callback").
 			Comment("the call back string").
 			Unique().
@@ -89,10 +100,23 @@ func (Webhook) Mixin() []ent.Mixin {
 func (Webhook) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("events", Event.Type),
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(
+				entgql.RelayConnection(),
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+				entx.CascadeAnnotationField("Webhook"),
+			),
 		edge.From("integrations", Integration.Type).Ref("webhooks"),
 	}
 }
 
+// Hooks of the Webhook
+func (Webhook) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookWebhook(),
+	}
+}
+
 // Indexes of the Webhook
 func (Webhook) Indexes() []ent.Index {
 	return []ent.Index{
@@ -0,0 +1,159 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/datumforge/enthistory"
+	"github.com/datumforge/entx"
+	emixin "github.com/datumforge/entx/mixin"
+	"github.com/datumforge/fgax/entfga"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("webhook_id").
+			Comment("the webhook the event was delivered to").
+			Immutable(),
+		field.String("event_id").
+			Comment("the event that was delivered").
+			Immutable(),
+		field.Int("attempt").
+			Comment("the attempt number of the delivery, starting at 1").
+			Immutable().
+			Annotations(
+				entgql.OrderField("attempt"),
+			),
+		field.Bool("redelivery").
+			Comment("indicates if the delivery was a manual redelivery of the event").
+			Immutable().
+			Default(false),
+		field.Text("request_body").
+			Comment("the signed request body sent to the webhook destination").
+			Immutable().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+		field.Int("response_status").
+			Comment("the http status code returned by the destination, 0 if no response was received").
+			Immutable().
+			Optional(),
+		field.Text("response_body").
+			Comment("the response body returned by the destination").
+			Immutable().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+		field.Int64("latency").
+			Comment("the time in milliseconds the delivery attempt took").
+			Immutable().
+			Optional(),
+		field.String("error").
+			Comment("the error of the delivery attempt, empty if the delivery succeeded").
+			Immutable().
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+	}
+}
+
+// Mixin of the WebhookDelivery
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		emixin.AuditMixin{},
+		emixin.IDMixin{},
+		OrgOwnerMixin{
+			Ref: "webhook_deliveries",
+		},
+	}
+}
+
+// Edges of the WebhookDelivery
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Immutable().
+			Unique().
+			Required(),
+		edge.From("event", Event.Type).
+			Ref("webhook_deliveries").
+			Field("event_id").
+			Immutable().
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the WebhookDelivery
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id", "event_id"),
+	}
+}
+
+// Annotations of the WebhookDelivery
+func (WebhookDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+		entfga.Annotations{
+			ObjectType:      "organization",
+			IncludeHooks:    false,
+			NillableIDField: true,
+			OrgOwnedField:   true,
+			IDField:         "OwnerID",
+		},
+		enthistory.Annotations{
+			Exclude: true,
+		},
+		// deliveries are recorded by the service, only the query schema is generated through ent
+		entx.SchemaGenSkip(true),
+		entx.QueryGenSkip(true),
+	}
+}
+
+// Hooks of the WebhookDelivery
+func (WebhookDelivery) Hooks() []ent.Hook {
+	return []ent.Hook{}
+}
+
+// Interceptors of the WebhookDelivery
+func (WebhookDelivery) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{}
+}
+
+// Policy of the WebhookDelivery
+func (WebhookDelivery) Policy() ent.Policy {
+	return privacy.Policy{
+		// deliveries are only recorded by the service
+		Mutation: privacy.MutationPolicy{
+			privacy.AlwaysDenyRule(),
+		},
+		Query: privacy.QueryPolicy{
+			privacy.WebhookDeliveryQueryRuleFunc(func(ctx context.Context, q *generated.WebhookDeliveryQuery) error {
+				return q.CheckAccess(ctx)
+			}),
+			privacy.AlwaysDenyRule(),
+		},
+	}
+}
@@ -11,39 +11,56 @@ import (
 	ent "github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/integration"
 	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/pkg/auth"
 	"github.com/datumforge/datum/pkg/events/soiree"
 	"github.com/datumforge/datum/pkg/utils/slack"
+	"github.com/datumforge/datum/pkg/webhooks"
 )
 
-// CreateEvent creates an event for the mutation with the properties
+// CreateEvent creates an event for the mutation with the properties and publishes it in-process
 func CreateEvent(ctx context.Context, c *ent.Client, m ent.Mutation, v ent.Value) {
-	pool := soiree.NewPondPool(100, 1000)
-	e := soiree.NewEventPool(soiree.WithPool(pool))
+	evt, ok := newMutationEvent(m, v)
+	if !ok {
+		return
+	}
 
+	// debug log the event
+	c.Logger.Debugw("tracking event", "object", evt.objectType, "action", getOp(m))
+
+	// the organization is optional, events outside of an organization are not sent to webhooks
+	orgID, _ := auth.GetOrganizationIDFromContext(ctx)
+
+	PublishEvent(ctx, c, "", orgID, evt.eventType, evt.objectType, evt.props)
+}
+
+// mutationEvent is the event produced by a tracked mutation
+type mutationEvent struct {
+	eventType  string
+	objectType string
+	objectID   string
+	props      ph.Properties
+}
+
+// newMutationEvent returns the event for the mutation and its returned value, false is returned if the value
+// does not have an id
+func newMutationEvent(m ent.Mutation, v ent.Value) (*mutationEvent, bool) {
 	out, err := parseValue(v)
 	if err != nil {
-		return
+		return nil, false
 	}
 
 	obj := strings.ToLower(m.Type())
-	action := getOp(m)
-
-	// debug log the event
-	c.Logger.Debugw("tracking event", "object", obj, "action", action)
-
-	event := fmt.Sprintf("%s.%sd", obj, action)
-	e.EnsureTopic(event)
 
 	id, ok := out["id"]
 	if !ok {
 		// keep going
-		return
+		return nil, false
 	}
 
 	i, ok := id.(string)
 	if !ok {
 		// keep going
-		return
+		return nil, false
 	}
 
 	// Set properties for the event
@@ -51,53 +68,57 @@ func CreateEvent(ctx context.Context, c *ent.Client, m ent.Mutation, v ent.Value
 	props := ph.NewProperties().
 		Set(fmt.Sprintf("%s_id", obj), i)
 
-	payload := map[string]string{"key": "value"}
-	sEvent := soiree.NewBaseEvent(event, payload)
-
 	// set the name if it exists
-	name, ok := out["name"]
-	if ok {
+	if name, ok := out["name"]; ok {
 		props.Set(fmt.Sprintf("%s_name", obj), name)
-		payload["name"] = name.(string)
 	}
 
-	// set the first name if it exists
-	fName, ok := out["first_name"]
-	if ok {
-		props.Set("first_name", fName)
-		payload["first_name"] = fName.(string)
+	// set the user details if they exist
+	for _, key := range []string{"first_name", "last_name", "email", "auth_provider"} {
+		if val, ok := out[key]; ok {
+			props.Set(key, val)
+		}
 	}
 
-	// set the last name if it exists
-	lName, ok := out["last_name"]
-	if ok {
-		props.Set("last_name", lName)
-		payload["last_name"] = lName.(string)
-	}
+	return &mutationEvent{
+		eventType:  webhooks.EventType(obj, getOp(m)),
+		objectType: obj,
+		objectID:   i,
+		props:      props,
+	}, true
+}
+
+// PublishEvent dispatches the event to the in-process listeners, analytics and the organization's webhooks; the id
+// of the event is sent to the webhooks so redelivered events can be deduplicated, a new id is used when it is empty
+func PublishEvent(ctx context.Context, c *ent.Client, id, orgID, event, obj string, props map[string]interface{}) {
+	pool := soiree.NewPondPool(100, 1000)
+	e := soiree.NewEventPool(soiree.WithPool(pool))
+
+	e.EnsureTopic(event)
+
+	payload := map[string]string{"key": "value"}
 
-	// set the email if it exists
-	email, ok := out["email"]
-	if ok {
-		props.Set("email", email)
-		payload["email"] = email.(string)
+	// set the details used by the slack listeners if they exist
+	if name, ok := props[fmt.Sprintf("%s_name", obj)].(string); ok {
+		payload["name"] = name
 	}
 
-	authprovider, ok := out["auth_provider"]
-	if ok {
-		props.Set("auth_provider", authprovider)
-		payload["auth_provider"] = authprovider.(string)
+	for _, key := range []string{"first_name", "last_name", "email", "auth_provider"} {
+		if val, ok := props[key].(string); ok {
+			payload[key] = val
+		}
 	}
 
+	sEvent := soiree.NewBaseEvent(event, payload)
+
 	userCreatedListener := userCreatedListener(ctx, c, sEvent)
 	orgCreatedListener := orgCreatedListener(ctx, c, sEvent)
 
-	_, err = e.On("user.created", userCreatedListener)
-	if err != nil {
+	if _, err := e.On("user.created", userCreatedListener); err != nil {
 		return
 	}
 
-	_, err = e.On("organization.created", orgCreatedListener)
-	if err != nil {
+	if _, err := e.On("organization.created", orgCreatedListener); err != nil {
 		return
 	}
 
@@ -105,6 +126,9 @@ func CreateEvent(ctx context.Context, c *ent.Client, m ent.Mutation, v ent.Value
 
 	c.Analytics.Event(event, props)
 
+	// send the event to the organization's webhooks
+	queueWebhookDeliveries(ctx, c, id, orgID, event, obj, props)
+
 	// debug log the event
 	c.Logger.Debugw("event tracked", "event", event, "props", props)
 }
@@ -113,16 +137,7 @@ func CreateEvent(ctx context.Context, c *ent.Client, m ent.Mutation, v ent.Value
 // for now, lets just track high level create and delete events
 // TODO: make these configurable by integration
 func TrackedEvent(m ent.Mutation) bool {
-	switch m.Type() {
-	case "User", "Organization", "Group", "Subscriber":
-		switch getOp(m) {
-		case ActionCreate, ActionDelete:
-			return true
-		}
-		return false
-	}
-
-	return false
+	return webhooks.IsTracked(m.Type(), getOp(m))
 }
 
 // getOp returns the string action for the mutation
@@ -384,7 +384,7 @@ func (suite *GraphTestSuite) TestLastUsedAPIToken() {
 	require.NoError(t, err)
 
 	// create new API token
-	token := (&APITokenBuilder{client: suite.client}).MustNew(reqCtx, t)
+	token := (&APITokenBuilder{client: suite.client, Scopes: []string{"apitoken:read", "organization:read"}}).MustNew(reqCtx, t)
 
 	mock_fga.CheckAny(t, suite.client.fga, true)
 
@@ -0,0 +1,518 @@
+package graphapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/samber/lo"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/intercept"
+	"github.com/datumforge/datum/pkg/utils/ulids"
+	"github.com/datumforge/enthistory"
+)
+
+const (
+	// defaultAuditLogPageSize is the number of audit logs returned when first or last is not provided
+	defaultAuditLogPageSize = 100
+	// maxAuditLogPageSize is the maximum number of audit logs returned in a page
+	maxAuditLogPageSize = 1000
+)
+
+var (
+	// ErrInvalidAuditLogCursor is returned when the cursor provided to the audit logs was not returned by the audit logs
+	ErrInvalidAuditLogCursor = errors.New("invalid audit log cursor")
+
+	// ErrInvalidAuditLogPagination is returned when both first and last are provided to the audit logs
+	ErrInvalidAuditLogPagination = errors.New("first and last cannot be used together")
+
+	// ErrAuditLogPageSizeTooLarge is returned when first or last exceed the maximum page size of the audit logs
+	ErrAuditLogPageSizeTooLarge = fmt.Errorf("first and last cannot be greater than %d", maxAuditLogPageSize)
+)
+
+// auditLogMetadataFields are the fields of the history tables describing the history row, they are not
+// included in the changes
+var auditLogMetadataFields = []string{"id", "history_time", "ref", "operation", "updated_by", "edges"}
+
+// auditLogRecord is a row of a history table
+type auditLogRecord struct {
+	table       string
+	id          string
+	ref         string
+	historyTime time.Time
+	operation   string
+	updatedBy   string
+	fields      map[string]json.RawMessage
+}
+
+// newAuditLogRecord returns the record of a history row
+func newAuditLogRecord(table string, row any) (*auditLogRecord, error) {
+	out, err := json.Marshal(row)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(out, &fields); err != nil {
+		return nil, err
+	}
+
+	var meta struct {
+		ID          string    `json:"id"`
+		Ref         string    `json:"ref"`
+		HistoryTime time.Time `json:"history_time"`
+		Operation   string    `json:"operation"`
+		UpdatedBy   string    `json:"updated_by"`
+	}
+
+	if err := json.Unmarshal(out, &meta); err != nil {
+		return nil, err
+	}
+
+	for _, f := range auditLogMetadataFields {
+		delete(fields, f)
+	}
+
+	return &auditLogRecord{
+		table:       table,
+		id:          meta.ID,
+		ref:         meta.Ref,
+		historyTime: meta.HistoryTime,
+		operation:   meta.Operation,
+		updatedBy:   meta.UpdatedBy,
+		fields:      fields,
+	}, nil
+}
+
+// cursor returns the pagination cursor of the record
+func (r *auditLogRecord) cursor() entgql.Cursor[string] {
+	return entgql.Cursor[string]{ID: r.id}
+}
+
+// changes returns the fields changed from the previous record of the object, prev is nil when there is none
+func (r *auditLogRecord) changes(prev *auditLogRecord) []*AuditLogChange {
+	var oldFields, newFields map[string]json.RawMessage
+
+	switch enthistory.OpType(r.operation) {
+	case enthistory.OpTypeInsert:
+		newFields = r.fields
+	case enthistory.OpTypeDelete:
+		oldFields = r.fields
+	default:
+		newFields = r.fields
+
+		if prev != nil {
+			oldFields = prev.fields
+		}
+	}
+
+	fields := []string{}
+
+	for f := range oldFields {
+		fields = append(fields, f)
+	}
+
+	for f := range newFields {
+		if _, ok := oldFields[f]; !ok {
+			fields = append(fields, f)
+		}
+	}
+
+	slices.Sort(fields)
+
+	changes := []*AuditLogChange{}
+
+	for _, f := range fields {
+		if reflect.DeepEqual(oldFields[f], newFields[f]) {
+			continue
+		}
+
+		changes = append(changes, &AuditLogChange{
+			Field: f,
+			Old:   oldFields[f],
+			New:   newFields[f],
+		})
+	}
+
+	return changes
+}
+
+// auditLogTable is a history table included in the audit logs
+type auditLogTable struct {
+	// name is the type of the audited object, e.g. Organization
+	name string
+	// count returns the number of rows matching the predicates
+	count func(ctx context.Context, c *ent.Client, ps []func(*sql.Selector)) (int, error)
+	// list returns up to limit rows matching the predicates, or all of them when limit is 0, newest first unless
+	// ascending is set
+	list func(ctx context.Context, c *ent.Client, ps []func(*sql.Selector), limit int, ascending bool) ([]*auditLogRecord, error)
+}
+
+// historyQuery is the query of a history table
+type historyQuery[T any] interface {
+	ent.Query
+	Count(context.Context) (int, error)
+	All(context.Context) ([]T, error)
+}
+
+// newAuditLogTable returns the audit log table for the history type; the query runs through the interceptors and
+// privacy rules of the history type, so the audit logs only include the history the user has access to
+func newAuditLogTable[T any, Q historyQuery[T]](historyType string, query func(*ent.Client) Q) auditLogTable {
+	where := func(c *ent.Client, ps []func(*sql.Selector)) (Q, intercept.Query, error) {
+		q := query(c)
+
+		iq, err := intercept.NewQuery(q)
+		if err != nil {
+			return q, nil, err
+		}
+
+		iq.WhereP(ps...)
+
+		return q, iq, nil
+	}
+
+	name := strings.TrimSuffix(historyType, "History")
+
+	return auditLogTable{
+		name: name,
+		count: func(ctx context.Context, c *ent.Client, ps []func(*sql.Selector)) (int, error) {
+			q, _, err := where(c, ps)
+			if err != nil {
+				return 0, err
+			}
+
+			return q.Count(ctx)
+		},
+		list: func(ctx context.Context, c *ent.Client, ps []func(*sql.Selector), limit int, ascending bool) ([]*auditLogRecord, error) {
+			q, iq, err := where(c, ps)
+			if err != nil {
+				return nil, err
+			}
+
+			opt := sql.OrderDesc()
+			if ascending {
+				opt = sql.OrderAsc()
+			}
+
+			iq.Order(sql.OrderByField("id", opt).ToFunc())
+
+			if limit > 0 {
+				iq.Limit(limit)
+			}
+
+			rows, err := q.All(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			records := make([]*auditLogRecord, 0, len(rows))
+
+			for _, row := range rows {
+				rec, err := newAuditLogRecord(name, row)
+				if err != nil {
+					return nil, err
+				}
+
+				records = append(records, rec)
+			}
+
+			return records, nil
+		},
+	}
+}
+
+// auditLogTables are the history tables included in the audit logs
+var auditLogTables = []auditLogTable{
+	newAuditLogTable(ent.TypeContactHistory, func(c *ent.Client) *ent.ContactHistoryQuery { return c.ContactHistory.Query() }),
+	newAuditLogTable(ent.TypeDocumentDataHistory, func(c *ent.Client) *ent.DocumentDataHistoryQuery { return c.DocumentDataHistory.Query() }),
+	newAuditLogTable(ent.TypeEntitlementHistory, func(c *ent.Client) *ent.EntitlementHistoryQuery { return c.EntitlementHistory.Query() }),
+	newAuditLogTable(ent.TypeEntitlementPlanFeatureHistory, func(c *ent.Client) *ent.EntitlementPlanFeatureHistoryQuery {
+		return c.EntitlementPlanFeatureHistory.Query()
+	}),
+	newAuditLogTable(ent.TypeEntitlementPlanHistory, func(c *ent.Client) *ent.EntitlementPlanHistoryQuery { return c.EntitlementPlanHistory.Query() }),
+	newAuditLogTable(ent.TypeEntityHistory, func(c *ent.Client) *ent.EntityHistoryQuery { return c.EntityHistory.Query() }),
+	newAuditLogTable(ent.TypeEntityTypeHistory, func(c *ent.Client) *ent.EntityTypeHistoryQuery { return c.EntityTypeHistory.Query() }),
+	newAuditLogTable(ent.TypeEventHistory, func(c *ent.Client) *ent.EventHistoryQuery { return c.EventHistory.Query() }),
+	newAuditLogTable(ent.TypeFeatureHistory, func(c *ent.Client) *ent.FeatureHistoryQuery { return c.FeatureHistory.Query() }),
+	newAuditLogTable(ent.TypeFileHistory, func(c *ent.Client) *ent.FileHistoryQuery { return c.FileHistory.Query() }),
+	newAuditLogTable(ent.TypeGroupHistory, func(c *ent.Client) *ent.GroupHistoryQuery { return c.GroupHistory.Query() }),
+	newAuditLogTable(ent.TypeGroupMembershipHistory, func(c *ent.Client) *ent.GroupMembershipHistoryQuery { return c.GroupMembershipHistory.Query() }),
+	newAuditLogTable(ent.TypeGroupSettingHistory, func(c *ent.Client) *ent.GroupSettingHistoryQuery { return c.GroupSettingHistory.Query() }),
+	newAuditLogTable(ent.TypeHushHistory, func(c *ent.Client) *ent.HushHistoryQuery { return c.HushHistory.Query() }),
+	newAuditLogTable(ent.TypeIntegrationHistory, func(c *ent.Client) *ent.IntegrationHistoryQuery { return c.IntegrationHistory.Query() }),
+	newAuditLogTable(ent.TypeNoteHistory, func(c *ent.Client) *ent.NoteHistoryQuery { return c.NoteHistory.Query() }),
+	newAuditLogTable(ent.TypeOauthProviderHistory, func(c *ent.Client) *ent.OauthProviderHistoryQuery { return c.OauthProviderHistory.Query() }),
+	newAuditLogTable(ent.TypeOrgMembershipHistory, func(c *ent.Client) *ent.OrgMembershipHistoryQuery { return c.OrgMembershipHistory.Query() }),
+	newAuditLogTable(ent.TypeOrganizationHistory, func(c *ent.Client) *ent.OrganizationHistoryQuery { return c.OrganizationHistory.Query() }),
+	newAuditLogTable(ent.TypeOrganizationSettingHistory, func(c *ent.Client) *ent.OrganizationSettingHistoryQuery {
+		return c.OrganizationSettingHistory.Query()
+	}),
+	newAuditLogTable(ent.TypeTemplateHistory, func(c *ent.Client) *ent.TemplateHistoryQuery { return c.TemplateHistory.Query() }),
+	newAuditLogTable(ent.TypeUserHistory, func(c *ent.Client) *ent.UserHistoryQuery { return c.UserHistory.Query() }),
+	newAuditLogTable(ent.TypeUserSettingHistory, func(c *ent.Client) *ent.UserSettingHistoryQuery { return c.UserSettingHistory.Query() }),
+	newAuditLogTable(ent.TypeWebhookHistory, func(c *ent.Client) *ent.WebhookHistoryQuery { return c.WebhookHistory.Query() }),
+}
+
+// auditLogCursorPredicate returns the predicate selecting the rows after the cursor, or before the cursor when
+// before is set, in the audit log order; history ids are ULIDs so ordering by id orders the rows by the time they
+// were written, and unlike the history time the ids compare the same way on every dialect
+func auditLogCursorPredicate(cursor *entgql.Cursor[string], before bool) (func(*sql.Selector), error) {
+	if _, err := ulids.Parse(cursor.ID); err != nil || cursor.ID == "" {
+		return nil, ErrInvalidAuditLogCursor
+	}
+
+	// audit logs are ordered newest first, so the rows after the cursor are older
+	if before {
+		return sql.FieldGT("id", cursor.ID), nil
+	}
+
+	return sql.FieldLT("id", cursor.ID), nil
+}
+
+// auditLogFilter returns the tables and predicates matching the where input
+func auditLogFilter(where *AuditLogWhereInput) ([]auditLogTable, []func(*sql.Selector)) {
+	if where == nil {
+		return auditLogTables, nil
+	}
+
+	tables := auditLogTables
+
+	if where.Table != nil {
+		tables = slices.DeleteFunc(slices.Clone(auditLogTables), func(t auditLogTable) bool {
+			return !strings.EqualFold(t.name, *where.Table)
+		})
+	}
+
+	ps := []func(*sql.Selector){}
+
+	if where.RefID != nil {
+		ps = append(ps, sql.FieldEQ("ref", *where.RefID))
+	}
+
+	if where.UpdatedBy != nil {
+		ps = append(ps, sql.FieldEQ("updated_by", *where.UpdatedBy))
+	}
+
+	if where.Operation != nil {
+		ps = append(ps, sql.FieldEQ("operation", strings.ToUpper(*where.Operation)))
+	}
+
+	if where.After != nil {
+		ps = append(ps, sql.FieldGT("history_time", *where.After))
+	}
+
+	if where.Before != nil {
+		ps = append(ps, sql.FieldLT("history_time", *where.Before))
+	}
+
+	return tables, ps
+}
+
+// auditLogs returns a page of the history of the objects the user has access to, newest first; the filters and
+// pagination are applied by the database on each history table and the pages of the tables are merged
+func auditLogs(ctx context.Context, c *ent.Client, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *AuditLogWhereInput) (*AuditLogConnection, error) {
+	if first != nil && last != nil {
+		return nil, ErrInvalidAuditLogPagination
+	}
+
+	// pages are read from the end when paginating backwards
+	backward := last != nil
+
+	limit := defaultAuditLogPageSize
+
+	switch {
+	case first != nil:
+		limit = *first
+	case last != nil:
+		limit = *last
+	}
+
+	if limit > maxAuditLogPageSize {
+		return nil, ErrAuditLogPageSizeTooLarge
+	}
+
+	tables, filters := auditLogFilter(where)
+
+	conn := &AuditLogConnection{
+		Edges:    []*AuditLogEdge{},
+		PageInfo: &entgql.PageInfo[string]{},
+	}
+
+	if auditLogTotalCountRequested(ctx) {
+		for _, t := range tables {
+			n, err := t.count(ctx, c, filters)
+			if err != nil {
+				return nil, err
+			}
+
+			conn.TotalCount += n
+		}
+	}
+
+	if limit <= 0 {
+		return conn, nil
+	}
+
+	ps := slices.Clone(filters)
+
+	if after != nil {
+		p, err := auditLogCursorPredicate(after, false)
+		if err != nil {
+			return nil, err
+		}
+
+		ps = append(ps, p)
+	}
+
+	if before != nil {
+		p, err := auditLogCursorPredicate(before, true)
+		if err != nil {
+			return nil, err
+		}
+
+		ps = append(ps, p)
+	}
+
+	// fetch one more than the page from every table to know if there is another page
+	records := []*auditLogRecord{}
+
+	for _, t := range tables {
+		recs, err := t.list(ctx, c, ps, limit+1, backward)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, recs...)
+	}
+
+	slices.SortFunc(records, func(a, b *auditLogRecord) int {
+		if backward {
+			return strings.Compare(a.id, b.id)
+		}
+
+		return strings.Compare(b.id, a.id)
+	})
+
+	hasMore := len(records) > limit
+	if hasMore {
+		records = records[:limit]
+	}
+
+	if backward {
+		slices.Reverse(records)
+
+		conn.PageInfo.HasPreviousPage = hasMore
+		conn.PageInfo.HasNextPage = before != nil
+	} else {
+		conn.PageInfo.HasNextPage = hasMore
+		conn.PageInfo.HasPreviousPage = after != nil
+	}
+
+	prev, err := previousAuditLogRecords(ctx, c, records)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rec := range records {
+		table := rec.table
+		op := rec.operation
+		ts := rec.historyTime
+		updatedBy := rec.updatedBy
+
+		conn.Edges = append(conn.Edges, &AuditLogEdge{
+			Node: &AuditLog{
+				Table:     &table,
+				ID:        rec.ref,
+				Time:      &ts,
+				Operation: &op,
+				Changes:   rec.changes(prev[rec.id]),
+				UpdatedBy: &updatedBy,
+			},
+			Cursor: rec.cursor(),
+		})
+	}
+
+	if len(records) > 0 {
+		start, end := records[0].cursor(), records[len(records)-1].cursor()
+
+		conn.PageInfo.StartCursor = &start
+		conn.PageInfo.EndCursor = &end
+	}
+
+	return conn, nil
+}
+
+// previousAuditLogRecords returns the history rows of the objects preceding the update records, keyed by the id of
+// the record, used to find the fields changed by the updates; the rows are looked up with one query per table
+func previousAuditLogRecords(ctx context.Context, c *ent.Client, records []*auditLogRecord) (map[string]*auditLogRecord, error) {
+	updates := map[string][]*auditLogRecord{}
+
+	for _, rec := range records {
+		if enthistory.OpType(rec.operation) == enthistory.OpTypeUpdate {
+			updates[rec.table] = append(updates[rec.table], rec)
+		}
+	}
+
+	prev := map[string]*auditLogRecord{}
+
+	for _, t := range auditLogTables {
+		recs := updates[t.name]
+		if len(recs) == 0 {
+			continue
+		}
+
+		refs := []string{}
+		maxID := ""
+
+		for _, rec := range recs {
+			refs = append(refs, rec.ref)
+			maxID = max(maxID, rec.id)
+		}
+
+		// the rows of the objects older than the newest record, newest first
+		rows, err := t.list(ctx, c, []func(*sql.Selector){
+			sql.FieldIn("ref", lo.Uniq(refs)...),
+			sql.FieldLT("id", maxID),
+		}, 0, false)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rec := range recs {
+			idx := slices.IndexFunc(rows, func(row *auditLogRecord) bool {
+				return row.ref == rec.ref && row.id < rec.id
+			})
+
+			if idx >= 0 {
+				prev[rec.id] = rows[idx]
+			}
+		}
+	}
+
+	return prev, nil
+}
+
+// auditLogTotalCountRequested reports whether the total count was requested, counting every history table is
+// skipped when it is not
+func auditLogTotalCountRequested(ctx context.Context) bool {
+	if !graphql.HasOperationContext(ctx) || graphql.GetFieldContext(ctx) == nil {
+		return true
+	}
+
+	for _, f := range graphql.CollectFieldsCtx(ctx, nil) {
+		if f.Name == "totalCount" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -6,105 +6,11 @@ package graphapi
 
 import (
 	"context"
-	"strings"
-	"time"
 
 	"entgo.io/contrib/entgql"
 )
 
 // AuditLogs is the resolver for the auditLogs field.
 func (r *queryResolver) AuditLogs(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, where *AuditLogWhereInput) (*AuditLogConnection, error) {
-	var (
-		auditLogs [][]string
-		err       error
-	)
-
-	if where.Table != nil {
-		auditLogs, err = withTransactionalMutation(ctx).AuditWithFilter(ctx, *where.Table)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		auditLogs, err = withTransactionalMutation(ctx).Audit(ctx)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	count := len(auditLogs) - 1
-
-	logs := &AuditLogConnection{
-		Edges:      []*AuditLogEdge{},
-		TotalCount: count,
-	}
-
-	for i, auditLog := range auditLogs {
-		// skip the header
-		if i == 0 {
-			continue
-		}
-
-		// Response format:
-		// Table RefId HistoryTime Operation Changes UpdatedBy
-		// [0]   [1]   [2]         [3]       [4]      [5]
-		tableName := strings.TrimSuffix(auditLog[0], "History")
-		objectID := auditLog[1]
-
-		// Thu Jul 18 17:30:19 2024
-		ts, err := time.Parse("Mon Jan 02 15:04:05 2006", auditLog[2])
-		if err != nil {
-			return nil, err
-		}
-
-		op := auditLog[3]
-		changes := strings.Split(auditLog[4], "\n")
-		updatedBy := auditLog[5]
-
-		// filter the response back
-		if where != nil {
-			// filter by operation
-			if where.Operation != nil && !strings.EqualFold(*where.Operation, op) {
-				continue
-			}
-
-			// filter by updated by
-			if where.UpdatedBy != nil && *where.UpdatedBy != updatedBy {
-				continue
-			}
-
-			// filter by object ID
-			if where.RefID != nil && *where.RefID != objectID {
-				continue
-			}
-
-			// filter by time
-			if where.After != nil {
-				after := *where.After
-				if !after.Before(ts) {
-					continue
-				}
-			}
-
-			if where.Before != nil {
-				if !where.Before.After(ts) {
-					continue
-				}
-			}
-		}
-
-		edge := AuditLogEdge{
-			Node: &AuditLog{
-				Table:     &tableName,
-				ID:        objectID,
-				Time:      &ts,
-				Operation: &op,
-				Changes:   changes,
-				UpdatedBy: &updatedBy,
-			},
-		}
-
-		logs.Edges = append(logs.Edges, &edge)
-	}
-
-	return logs, nil
+	return auditLogs(ctx, withTransactionalMutation(ctx), after, first, before, last, where)
 }
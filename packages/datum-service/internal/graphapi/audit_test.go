@@ -0,0 +1,164 @@
+package graphapi_test
+
+import (
+	"encoding/json"
+
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/pkg/datumclient"
+)
+
+func (suite *GraphTestSuite) TestQueryAuditLogs() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	entityType := (&EntityTypeBuilder{client: suite.client, Name: "meow"}).MustNew(reqCtx, t)
+
+	suite.client.db.EntityType.UpdateOne(entityType).SetName("purr").ExecX(allowCtx)
+	suite.client.db.EntityType.UpdateOne(entityType).SetName("hiss").ExecX(allowCtx)
+
+	where := &datumclient.AuditLogWhereInput{
+		RefID: &entityType.ID,
+	}
+
+	name := func(v json.RawMessage) string {
+		var s string
+
+		require.NoError(t, json.Unmarshal(v, &s))
+
+		return s
+	}
+
+	nameChange := func(node *datumclient.AuditLogs_AuditLogs_Edges_Node) *datumclient.AuditLogs_AuditLogs_Edges_Node_Changes {
+		for _, c := range node.Changes {
+			if c.Field == "name" {
+				return c
+			}
+		}
+
+		return nil
+	}
+
+	mock_fga.CheckAny(t, suite.client.fga, true)
+	defer mock_fga.ClearMocks(suite.client.fga)
+
+	// the first page has the updates, newest first
+	resp, err := suite.client.datum.AuditLogs(reqCtx, lo.ToPtr(int64(2)), nil, nil, nil, where)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), resp.AuditLogs.TotalCount)
+	assert.True(t, resp.AuditLogs.PageInfo.HasNextPage)
+	assert.False(t, resp.AuditLogs.PageInfo.HasPreviousPage)
+	require.Len(t, resp.AuditLogs.Edges, 2)
+
+	latest := resp.AuditLogs.Edges[0].Node
+	assert.Equal(t, entityType.ID, latest.ID)
+	assert.Equal(t, "EntityType", *latest.Table)
+	assert.Equal(t, "UPDATE", *latest.Operation)
+
+	change := nameChange(latest)
+	require.NotNil(t, change)
+	assert.Equal(t, "purr", name(change.Old))
+	assert.Equal(t, "hiss", name(change.New))
+
+	change = nameChange(resp.AuditLogs.Edges[1].Node)
+	require.NotNil(t, change)
+	assert.Equal(t, "meow", name(change.Old))
+	assert.Equal(t, "purr", name(change.New))
+
+	// the next page has the insert
+	resp, err = suite.client.datum.AuditLogs(reqCtx, lo.ToPtr(int64(2)), resp.AuditLogs.PageInfo.EndCursor, nil, nil, where)
+	require.NoError(t, err)
+
+	assert.False(t, resp.AuditLogs.PageInfo.HasNextPage)
+	assert.True(t, resp.AuditLogs.PageInfo.HasPreviousPage)
+	require.Len(t, resp.AuditLogs.Edges, 1)
+
+	inserted := resp.AuditLogs.Edges[0].Node
+	assert.Equal(t, "INSERT", *inserted.Operation)
+
+	change = nameChange(inserted)
+	require.NotNil(t, change)
+	assert.Empty(t, name(change.Old))
+	assert.Equal(t, "meow", name(change.New))
+
+	// paginating backwards from the insert returns the update before it
+	resp, err = suite.client.datum.AuditLogs(reqCtx, nil, nil, lo.ToPtr(int64(1)), resp.AuditLogs.PageInfo.StartCursor, where)
+	require.NoError(t, err)
+
+	assert.True(t, resp.AuditLogs.PageInfo.HasPreviousPage)
+	assert.True(t, resp.AuditLogs.PageInfo.HasNextPage)
+	require.Len(t, resp.AuditLogs.Edges, 1)
+
+	change = nameChange(resp.AuditLogs.Edges[0].Node)
+	require.NotNil(t, change)
+	assert.Equal(t, "meow", name(change.Old))
+	assert.Equal(t, "purr", name(change.New))
+
+	// filters are applied by the database
+	resp, err = suite.client.datum.AuditLogs(reqCtx, nil, nil, nil, nil, &datumclient.AuditLogWhereInput{
+		RefID:     &entityType.ID,
+		Operation: lo.ToPtr("insert"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.AuditLogs.TotalCount)
+	require.Len(t, resp.AuditLogs.Edges, 1)
+	assert.Equal(t, "INSERT", *resp.AuditLogs.Edges[0].Node.Operation)
+
+	resp, err = suite.client.datum.AuditLogs(reqCtx, nil, nil, nil, nil, &datumclient.AuditLogWhereInput{
+		RefID: &entityType.ID,
+		Table: lo.ToPtr("Contact"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), resp.AuditLogs.TotalCount)
+	assert.Empty(t, resp.AuditLogs.Edges)
+
+	// the cursor must be one returned by the audit logs
+	_, err = suite.client.datum.AuditLogs(reqCtx, nil, lo.ToPtr(entityType.ID), nil, nil, where)
+	require.Error(t, err)
+
+	// first and last cannot be combined
+	_, err = suite.client.datum.AuditLogs(reqCtx, lo.ToPtr(int64(1)), nil, lo.ToPtr(int64(1)), nil, where)
+	require.ErrorContains(t, err, "first and last cannot be used together")
+
+	// the page size is limited
+	_, err = suite.client.datum.AuditLogs(reqCtx, lo.ToPtr(int64(1001)), nil, nil, nil, where)
+	require.ErrorContains(t, err, "first and last cannot be greater than 1000")
+
+	// the changes of updates to different objects in the same page are found from their own history
+	other := (&EntityTypeBuilder{client: suite.client, Name: "woof"}).MustNew(reqCtx, t)
+
+	suite.client.db.EntityType.UpdateOne(other).SetName("bark").ExecX(allowCtx)
+	suite.client.db.EntityType.UpdateOne(entityType).SetName("growl").ExecX(allowCtx)
+
+	mock_fga.CheckAny(t, suite.client.fga, true)
+
+	resp, err = suite.client.datum.AuditLogs(reqCtx, lo.ToPtr(int64(2)), nil, nil, nil, &datumclient.AuditLogWhereInput{
+		Table:     lo.ToPtr("EntityType"),
+		Operation: lo.ToPtr("update"),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.AuditLogs.Edges, 2)
+
+	assert.Equal(t, entityType.ID, resp.AuditLogs.Edges[0].Node.ID)
+
+	change = nameChange(resp.AuditLogs.Edges[0].Node)
+	require.NotNil(t, change)
+	assert.Equal(t, "hiss", name(change.Old))
+	assert.Equal(t, "growl", name(change.New))
+
+	assert.Equal(t, other.ID, resp.AuditLogs.Edges[1].Node.ID)
+
+	change = nameChange(resp.AuditLogs.Edges[1].Node)
+	require.NotNil(t, change)
+	assert.Equal(t, "woof", name(change.Old))
+	assert.Equal(t, "bark", name(change.New))
+}
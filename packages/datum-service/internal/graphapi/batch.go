@@ -0,0 +1,477 @@
+package graphapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/gocarina/gocsv"
+	"github.com/samber/lo"
+	"github.com/stoewer/go-strcase"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+)
+
+const (
+	// bulkBatchSavepoint wraps all rows of a bulk mutation so they can be undone together
+	bulkBatchSavepoint = "bulk_batch"
+	// bulkRowSavepoint wraps a single row of a bulk mutation so a failed row does not abort the transaction
+	bulkRowSavepoint = "bulk_row"
+	// bulkIDColumn is the csv column with the ID of the object to update
+	bulkIDColumn = "id"
+)
+
+// bulkOptions are the options of a bulk mutation
+type bulkOptions struct {
+	// mode determines how rows that fail are handled, defaults to ALL_OR_NOTHING
+	mode *BulkMode
+	// dryRun undoes all rows once they are applied, so the rows are validated without being saved
+	dryRun bool
+}
+
+// bulkRow is a single row of a bulk mutation
+type bulkRow[T any] struct {
+	// id of the object of the row, if known before the row is applied
+	id *string
+	// line of the row in the csv file, zero when the row is not from a file
+	line int
+	// run applies the row in the transaction of the request
+	run func(ctx context.Context) (T, error)
+}
+
+// runBulk applies the rows of a bulk mutation in the transaction of the request, each row runs in its own savepoint
+// so a failed row is undone without aborting the transaction. When a row fails in ALL_OR_NOTHING mode (the default)
+// the remaining rows are still run to report their errors but all changes are undone; in BEST_EFFORT mode the rows
+// that succeeded are kept. A dry run undoes all changes after reporting the errors.
+// Rolling back to a savepoint does not undo relationship tuples written by hooks, so objects that write tuples
+// (e.g. groups, memberships and invites) must not have bulk mutations that run their rows with runBulk
+func runBulk[T any](ctx context.Context, c *generated.Client, opts bulkOptions, rows []bulkRow[T], a action, logger *zap.SugaredLogger) ([]T, []*BulkError, error) {
+	// savepoints only exist inside of a transaction
+	if generated.TxFromContext(ctx) == nil {
+		return nil, nil, ErrBulkTransactionRequired
+	}
+
+	if err := c.Savepoint(ctx, bulkBatchSavepoint); err != nil {
+		return nil, nil, err
+	}
+
+	batchCtx, batch := withUndoScope(ctx)
+
+	res := make([]T, 0, len(rows))
+
+	var bulkErrs []*BulkError
+
+	for i, row := range rows {
+		if err := c.Savepoint(ctx, bulkRowSavepoint); err != nil {
+			return nil, nil, err
+		}
+
+		rowCtx, rowScope := withUndoScope(batchCtx)
+
+		v, err := row.run(rowCtx)
+		if err != nil {
+			if err := c.RollbackToSavepoint(ctx, bulkRowSavepoint); err != nil {
+				return nil, nil, err
+			}
+
+			rowScope.undo()
+
+			bulkErrs = append(bulkErrs, newBulkError(i, row, parseRequestError(err, a, logger)))
+		} else {
+			res = append(res, v)
+		}
+
+		if err := c.ReleaseSavepoint(ctx, bulkRowSavepoint); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	allOrNothing := lo.FromPtrOr(opts.mode, BulkModeAllOrNothing) == BulkModeAllOrNothing
+
+	if opts.dryRun || (len(bulkErrs) > 0 && allOrNothing) {
+		if err := c.RollbackToSavepoint(ctx, bulkBatchSavepoint); err != nil {
+			return nil, nil, err
+		}
+
+		batch.undo()
+
+		// a dry run returns the rows that are valid
+		if !opts.dryRun {
+			res = res[:0]
+		}
+	}
+
+	if err := c.ReleaseSavepoint(ctx, bulkBatchSavepoint); err != nil {
+		return nil, nil, err
+	}
+
+	return res, bulkErrs, nil
+}
+
+// newBulkError returns the error of the row at index i of a bulk mutation
+func newBulkError[T any](i int, row bulkRow[T], err error) *BulkError {
+	bulkErr := &BulkError{
+		Row:     i + 1,
+		ID:      row.id,
+		Message: err.Error(),
+	}
+
+	if row.line > 0 {
+		bulkErr.Line = &row.line
+	}
+
+	return bulkErr
+}
+
+// undoScopeContextKey is the context key of the undo scope of a savepoint
+type undoScopeContextKey struct{}
+
+// undoScope tracks whether the changes made in a savepoint, or the savepoint it is nested in, were undone;
+// the changes that are published once the transaction is committed are dropped when their scope was undone
+type undoScope struct {
+	parent *undoScope
+	undone bool
+}
+
+// withUndoScope returns a context with a new undo scope nested in the scope of the parent context
+func withUndoScope(ctx context.Context) (context.Context, *undoScope) {
+	parent, _ := ctx.Value(undoScopeContextKey{}).(*undoScope)
+
+	scope := &undoScope{parent: parent}
+
+	return context.WithValue(ctx, undoScopeContextKey{}, scope), scope
+}
+
+// undo marks the changes made in the scope as undone
+func (s *undoScope) undo() {
+	s.undone = true
+}
+
+// isUndone returns true when the changes made with the context were undone by rolling back to a savepoint
+func isUndone(ctx context.Context) bool {
+	for s, _ := ctx.Value(undoScopeContextKey{}).(*undoScope); s != nil; s = s.parent {
+		if s.undone {
+			return true
+		}
+	}
+
+	return false
+}
+
+// csvRecord is a row of a csv file
+type csvRecord struct {
+	// line of the start of the row in the file, the header is line 1
+	line int
+	// fields of the row, in the order of the header
+	fields []string
+	// err is set when the row could not be read
+	err error
+}
+
+// readCSV reads the header and the rows of a csv file, rows that cannot be read are returned with their error so
+// they can be reported without failing the other rows
+func readCSV(r io.Reader) ([]string, []csvRecord, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, ErrBulkEmptyFile
+		}
+
+		return nil, nil, err
+	}
+
+	// the number of fields of the rows is checked against the header
+	reader.FieldsPerRecord = -1
+
+	var records []csvRecord
+
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			record := csvRecord{err: fmt.Errorf("%w: %w", ErrBulkInvalidRow, err)}
+
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				record.line = parseErr.StartLine
+			}
+
+			records = append(records, record)
+
+			continue
+		}
+
+		record := csvRecord{fields: fields}
+		record.line, _ = reader.FieldPos(0)
+
+		if len(fields) != len(header) {
+			record.err = fmt.Errorf("%w: expected %d fields, got %d", ErrBulkInvalidRow, len(header), len(fields))
+		}
+
+		records = append(records, record)
+	}
+
+	return header, records, nil
+}
+
+// unmarshalCSVFields unmarshals the non-empty values into a new T, the columns are the names of the fields of T;
+// empty values are left unset
+func unmarshalCSVFields[T any](columns, values []string) (*T, error) {
+	var cols, vals []string
+
+	for i, v := range values {
+		if columns[i] == "" || v == "" {
+			continue
+		}
+
+		cols = append(cols, columns[i])
+		vals = append(vals, v)
+	}
+
+	// a row with only empty cells has nothing to unmarshal
+	if len(cols) == 0 {
+		return new(T), nil
+	}
+
+	var buf bytes.Buffer
+
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll([][]string{cols, vals}); err != nil {
+		return nil, err
+	}
+
+	var out []*T
+
+	if err := gocsv.UnmarshalBytes(buf.Bytes(), &out); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBulkInvalidRow, err)
+	}
+
+	return out[0], nil
+}
+
+// upsertRow is a single row of a csv upsert, the row either updates an existing object or creates a new one
+type upsertRow[C, U any] struct {
+	// id of the object to update, from the ID column
+	id *string
+	// line of the row in the csv file
+	line int
+	// match selects the existing object by the values of the key columns
+	match func(*sql.Selector)
+	// create is the input used when no existing object is found
+	create *C
+	// update is the input used when an existing object is found
+	update *U
+	// err is set when the row could not be parsed
+	err error
+}
+
+// target returns the id of the object the row updates, or an empty string when the row creates a new object;
+// ids is used to look up the objects matching the key columns of the row
+func (u upsertRow[C, U]) target(ctx context.Context, ids func(context.Context, func(*sql.Selector)) ([]string, error)) (string, error) {
+	switch {
+	case u.err != nil:
+		return "", u.err
+	case u.id != nil:
+		return *u.id, nil
+	case u.match == nil:
+		return "", nil
+	}
+
+	matched, err := ids(ctx, u.match)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(matched) {
+	case 0:
+		return "", nil
+	case 1:
+		return matched[0], nil
+	default:
+		return "", ErrBulkKeyNotUnique
+	}
+}
+
+// unmarshalUpsertData parses the rows of the csv upload into create and update inputs; empty cells are left unset
+// so an update only changes the columns with a value. Rows without an ID are matched on the key columns, the keys
+// are the csv column names and are validated with validColumn
+func unmarshalUpsertData[C, U any](input graphql.Upload, keys []string, validColumn func(string) bool) ([]upsertRow[C, U], error) {
+	header, records, err := readCSV(input.File)
+	if err != nil {
+		return nil, err
+	}
+
+	idIndex := lo.IndexOf(lo.Map(header, func(h string, _ int) string { return strings.ToLower(h) }), bulkIDColumn)
+
+	keyIndexes := make(map[string]int, len(keys))
+
+	for _, k := range keys {
+		column := strcase.SnakeCase(k)
+		if !validColumn(column) {
+			return nil, fmt.Errorf("%w: %s", ErrBulkInvalidKey, k)
+		}
+
+		i := lo.IndexOf(header, k)
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %s is not a column of the file", ErrBulkInvalidKey, k)
+		}
+
+		keyIndexes[column] = i
+	}
+
+	// the ID is not a field of the inputs
+	columns := append([]string{}, header...)
+	if idIndex >= 0 {
+		columns[idIndex] = ""
+	}
+
+	rows := make([]upsertRow[C, U], 0, len(records))
+
+	for _, record := range records {
+		row := upsertRow[C, U]{line: record.line, err: record.err}
+
+		switch {
+		case row.err != nil:
+		case idIndex >= 0 && record.fields[idIndex] != "":
+			row.id = &record.fields[idIndex]
+		case len(keyIndexes) > 0:
+			row.match, row.err = keyPredicate(header, record.fields, keyIndexes)
+		}
+
+		if row.err == nil {
+			row.create, row.err = unmarshalCSVFields[C](columns, record.fields)
+		}
+
+		if row.err == nil {
+			row.update, row.err = unmarshalCSVFields[U](columns, record.fields)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// keyPredicate returns the predicate matching the key columns of the record, all key columns must have a value
+func keyPredicate(header, record []string, keyIndexes map[string]int) (func(*sql.Selector), error) {
+	preds := make([]func(*sql.Selector), 0, len(keyIndexes))
+
+	for column, i := range keyIndexes {
+		if record[i] == "" {
+			return nil, fmt.Errorf("%w: %s", ErrBulkMissingKey, header[i])
+		}
+
+		preds = append(preds, sql.FieldEQ(column, record[i]))
+	}
+
+	return sql.AndPredicates(preds...), nil
+}
+
+// importRow is a single row of a csv import
+type importRow[C any] struct {
+	// line of the row in the csv file
+	line int
+	// input used to create the object
+	input *C
+	// err is set when the row could not be parsed
+	err error
+}
+
+// unmarshalImportData parses the rows of the csv upload into create inputs. The columns are imported into the
+// fields of the input given by the mapping, columns that are not mapped are imported into the field with the same
+// name ignoring case, spaces and punctuation (e.g. "Full Name" into fullName); other columns are ignored
+func unmarshalImportData[C any](input graphql.Upload, mapping []*CSVColumnMapping) ([]importRow[C], error) {
+	header, records, err := readCSV(input.File)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := importColumns[C](header, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]importRow[C], 0, len(records))
+
+	for _, record := range records {
+		row := importRow[C]{line: record.line, err: record.err}
+
+		if row.err == nil {
+			row.input, row.err = unmarshalCSVFields[C](columns, record.fields)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// importColumns returns the name of the field of C each column of the header is imported into, columns that are
+// not imported have an empty name
+func importColumns[C any](header []string, mapping []*CSVColumnMapping) ([]string, error) {
+	// fields of the input by their normalized name
+	fields := map[string]string{}
+
+	t := reflect.TypeOf(new(C)).Elem()
+	for i := range t.NumField() {
+		if f := t.Field(i); f.IsExported() {
+			fields[normalizeColumn(f.Name)] = f.Name
+		}
+	}
+
+	columns := make([]string, len(header))
+	mapped := map[string]bool{}
+
+	for _, m := range mapping {
+		i := lo.IndexOf(header, m.Column)
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %s is not a column of the file", ErrBulkInvalidMapping, m.Column)
+		}
+
+		field, ok := fields[normalizeColumn(m.Field)]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s is not a field of the input", ErrBulkInvalidMapping, m.Field)
+		}
+
+		columns[i] = field
+		mapped[field] = true
+	}
+
+	for i, h := range header {
+		if columns[i] != "" {
+			continue
+		}
+
+		// fields that are mapped explicitly are not also imported from a column with the same name
+		if field, ok := fields[normalizeColumn(h)]; ok && !mapped[field] {
+			columns[i] = field
+		}
+	}
+
+	return columns, nil
+}
+
+// normalizeColumn returns the lower case letters and digits of the name of a column or field
+func normalizeColumn(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+
+		return -1
+	}, name)
+}
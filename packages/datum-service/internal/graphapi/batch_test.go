@@ -0,0 +1,29 @@
+package graphapi_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/datumforge/datum/internal/graphapi"
+)
+
+// TestBulkMutationsWithoutTuples checks the objects that write relationship tuples have no bulk update, delete or
+// upsert mutations; rows of these mutations are undone by rolling back to a savepoint, which does not undo tuples
+func TestBulkMutationsWithoutTuples(t *testing.T) {
+	schema := graphapi.NewExecutableSchema(graphapi.Config{}).Schema()
+
+	objects := []string{"APIToken", "Group", "GroupMembership", "Invite", "Organization", "OrgMembership"}
+
+	for _, f := range schema.Mutation.Fields {
+		for _, prefix := range []string{"updateBulk", "deleteBulk", "upsertBulkCSV"} {
+			name, ok := strings.CutPrefix(f.Name, prefix)
+			if !ok {
+				continue
+			}
+
+			assert.NotContains(t, objects, name, "%s writes relationship tuples that are not undone", f.Name)
+		}
+	}
+}
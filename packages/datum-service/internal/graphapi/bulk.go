@@ -5,7 +5,29 @@ package graphapi
 import (
 	"context"
 
+	"entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/contact"
+	"github.com/datumforge/datum/internal/ent/generated/documentdata"
+	"github.com/datumforge/datum/internal/ent/generated/entitlement"
+	"github.com/datumforge/datum/internal/ent/generated/entitlementplan"
+	"github.com/datumforge/datum/internal/ent/generated/entitlementplanfeature"
+	"github.com/datumforge/datum/internal/ent/generated/entity"
+	"github.com/datumforge/datum/internal/ent/generated/entitytype"
+	"github.com/datumforge/datum/internal/ent/generated/feature"
+	"github.com/datumforge/datum/internal/ent/generated/file"
+	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
+	"github.com/datumforge/datum/internal/ent/generated/hush"
+	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
+	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
+	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+	"github.com/datumforge/datum/internal/ent/generated/template"
+	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/samber/lo"
 )
 
 // bulkCreateAPIToken uses the CreateBulk function to create multiple APIToken entities
@@ -46,6 +68,164 @@ func (r *mutationResolver) bulkCreateContact(ctx context.Context, input []*gener
 	}, nil
 }
 
+// bulkUpdateContact updates multiple Contact entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateContact(ctx context.Context, ids []string, input generated.UpdateContactInput, mode *BulkMode) (*ContactBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.Contact], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.Contact]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.Contact, error) {
+				return bulkUpdateContactRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "contact"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "contact"}, r.logger)
+	}
+
+	// return response
+	return &ContactBulkUpdatePayload{
+		Contacts: res,
+		Errors:   bulkErrs,
+	}, nil
+}
+
+// bulkUpdateContactRow updates a single Contact entity of a bulk mutation
+func bulkUpdateContactRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateContactInput) (*generated.Contact, error) {
+	res, err := c.Contact.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, &res.OwnerID); err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).Save(ctx)
+}
+
+// bulkDeleteContact deletes multiple Contact entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteContact(ctx context.Context, ids []string, mode *BulkMode) (*ContactBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.Contact.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.ContactEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "contact"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "contact"}, r.logger)
+	}
+
+	// return response
+	return &ContactBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertContact creates or updates Contact entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertContact(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*ContactBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateContactInput, generated.UpdateContactInput](input, keys, contact.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.Contact], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.Contact]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.Contact, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.Contact.Query().Where(predicate.Contact(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.Contact.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateContactRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "contact"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "contact"}, r.logger)
+	}
+
+	// return response
+	return &ContactBulkUpsertPayload{
+		Contacts: res,
+		Errors:   bulkErrs,
+	}, nil
+}
+
+// bulkImportContact creates Contact entities from the rows of a csv file, the columns are mapped to the fields
+// of the input and each row is created in its own savepoint; a dry run validates the rows without saving them
+func (r *mutationResolver) bulkImportContact(ctx context.Context, input graphql.Upload, mapping []*CSVColumnMapping, dryRun *bool, mode *BulkMode) (*ContactBulkImportPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalImportData[generated.CreateContactInput](input, mapping)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.Contact], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.Contact]{
+			line: d.line,
+			run: func(ctx context.Context) (*generated.Contact, error) {
+				if d.err != nil {
+					return nil, d.err
+				}
+
+				return c.Contact.Create().SetInput(*d.input).Save(ctx)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode, dryRun: lo.FromPtr(dryRun)}, rows, action{action: ActionCreate, object: "contact"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "contact"}, r.logger)
+	}
+
+	// return response
+	return &ContactBulkImportPayload{
+		Contacts: res,
+		Errors:   bulkErrs,
+		DryRun:   lo.FromPtr(dryRun),
+	}, nil
+}
+
 // bulkCreateDocumentData uses the CreateBulk function to create multiple DocumentData entities
 func (r *mutationResolver) bulkCreateDocumentData(ctx context.Context, input []*generated.CreateDocumentDataInput) (*DocumentDataBulkCreatePayload, error) {
 	c := withTransactionalMutation(ctx)
@@ -65,6 +245,125 @@ func (r *mutationResolver) bulkCreateDocumentData(ctx context.Context, input []*
 	}, nil
 }
 
+// bulkUpdateDocumentData updates multiple DocumentData entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateDocumentData(ctx context.Context, ids []string, input generated.UpdateDocumentDataInput, mode *BulkMode) (*DocumentDataBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.DocumentData], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.DocumentData]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.DocumentData, error) {
+				return bulkUpdateDocumentDataRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "documentdata"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "documentdata"}, r.logger)
+	}
+
+	// return response
+	return &DocumentDataBulkUpdatePayload{
+		DocumentData: res,
+		Errors:       bulkErrs,
+	}, nil
+}
+
+// bulkUpdateDocumentDataRow updates a single DocumentData entity of a bulk mutation
+func bulkUpdateDocumentDataRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateDocumentDataInput) (*generated.DocumentData, error) {
+	res, err := c.DocumentData.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, &res.OwnerID); err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).Save(ctx)
+}
+
+// bulkDeleteDocumentData deletes multiple DocumentData entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteDocumentData(ctx context.Context, ids []string, mode *BulkMode) (*DocumentDataBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.DocumentData.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.DocumentDataEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "documentdata"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "documentdata"}, r.logger)
+	}
+
+	// return response
+	return &DocumentDataBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertDocumentData creates or updates DocumentData entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertDocumentData(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*DocumentDataBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateDocumentDataInput, generated.UpdateDocumentDataInput](input, keys, documentdata.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.DocumentData], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.DocumentData]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.DocumentData, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.DocumentData.Query().Where(predicate.DocumentData(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.DocumentData.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateDocumentDataRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "documentdata"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "documentdata"}, r.logger)
+	}
+
+	// return response
+	return &DocumentDataBulkUpsertPayload{
+		DocumentData: res,
+		Errors:       bulkErrs,
+	}, nil
+}
+
 // bulkCreateEntitlement uses the CreateBulk function to create multiple Entitlement entities
 func (r *mutationResolver) bulkCreateEntitlement(ctx context.Context, input []*generated.CreateEntitlementInput) (*EntitlementBulkCreatePayload, error) {
 	c := withTransactionalMutation(ctx)
@@ -84,6 +383,125 @@ func (r *mutationResolver) bulkCreateEntitlement(ctx context.Context, input []*g
 	}, nil
 }
 
+// bulkUpdateEntitlement updates multiple Entitlement entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateEntitlement(ctx context.Context, ids []string, input generated.UpdateEntitlementInput, mode *BulkMode) (*EntitlementBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.Entitlement], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.Entitlement]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.Entitlement, error) {
+				return bulkUpdateEntitlementRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "entitlement"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "entitlement"}, r.logger)
+	}
+
+	// return response
+	return &EntitlementBulkUpdatePayload{
+		Entitlements: res,
+		Errors:       bulkErrs,
+	}, nil
+}
+
+// bulkUpdateEntitlementRow updates a single Entitlement entity of a bulk mutation
+func bulkUpdateEntitlementRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateEntitlementInput) (*generated.Entitlement, error) {
+	res, err := c.Entitlement.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, &res.OwnerID); err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).Save(ctx)
+}
+
+// bulkDeleteEntitlement deletes multiple Entitlement entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteEntitlement(ctx context.Context, ids []string, mode *BulkMode) (*EntitlementBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.Entitlement.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.EntitlementEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "entitlement"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "entitlement"}, r.logger)
+	}
+
+	// return response
+	return &EntitlementBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertEntitlement creates or updates Entitlement entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertEntitlement(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*EntitlementBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateEntitlementInput, generated.UpdateEntitlementInput](input, keys, entitlement.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.Entitlement], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.Entitlement]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.Entitlement, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.Entitlement.Query().Where(predicate.Entitlement(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.Entitlement.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateEntitlementRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "entitlement"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "entitlement"}, r.logger)
+	}
+
+	// return response
+	return &EntitlementBulkUpsertPayload{
+		Entitlements: res,
+		Errors:       bulkErrs,
+	}, nil
+}
+
 // bulkCreateEntitlementPlan uses the CreateBulk function to create multiple EntitlementPlan entities
 func (r *mutationResolver) bulkCreateEntitlementPlan(ctx context.Context, input []*generated.CreateEntitlementPlanInput) (*EntitlementPlanBulkCreatePayload, error) {
 	c := withTransactionalMutation(ctx)
@@ -103,269 +521,1597 @@ func (r *mutationResolver) bulkCreateEntitlementPlan(ctx context.Context, input
 	}, nil
 }
 
-// bulkCreateEntitlementPlanFeature uses the CreateBulk function to create multiple EntitlementPlanFeature entities
-func (r *mutationResolver) bulkCreateEntitlementPlanFeature(ctx context.Context, input []*generated.CreateEntitlementPlanFeatureInput) (*EntitlementPlanFeatureBulkCreatePayload, error) {
+// bulkUpdateEntitlementPlan updates multiple EntitlementPlan entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateEntitlementPlan(ctx context.Context, ids []string, input generated.UpdateEntitlementPlanInput, mode *BulkMode) (*EntitlementPlanBulkUpdatePayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.EntitlementPlanFeatureCreate, len(input))
-	for i, data := range input {
-		builders[i] = c.EntitlementPlanFeature.Create().SetInput(*data)
+	rows := make([]bulkRow[*generated.EntitlementPlan], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.EntitlementPlan]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.EntitlementPlan, error) {
+				return bulkUpdateEntitlementPlanRow(ctx, c, id, input)
+			},
+		}
 	}
 
-	res, err := c.EntitlementPlanFeature.CreateBulk(builders...).Save(ctx)
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "entitlementplan"}, r.logger)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "entitlementplanfeature"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "entitlementplan"}, r.logger)
 	}
 
 	// return response
-	return &EntitlementPlanFeatureBulkCreatePayload{
-		EntitlementPlanFeatures: res,
+	return &EntitlementPlanBulkUpdatePayload{
+		EntitlementPlans: res,
+		Errors:           bulkErrs,
 	}, nil
 }
 
-// bulkCreateEntity uses the CreateBulk function to create multiple Entity entities
-func (r *mutationResolver) bulkCreateEntity(ctx context.Context, input []*generated.CreateEntityInput) (*EntityBulkCreatePayload, error) {
+// bulkUpdateEntitlementPlanRow updates a single EntitlementPlan entity of a bulk mutation
+func bulkUpdateEntitlementPlanRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateEntitlementPlanInput) (*generated.EntitlementPlan, error) {
+	res, err := c.EntitlementPlan.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, &res.OwnerID); err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).Save(ctx)
+}
+
+// bulkDeleteEntitlementPlan deletes multiple EntitlementPlan entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteEntitlementPlan(ctx context.Context, ids []string, mode *BulkMode) (*EntitlementPlanBulkDeletePayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.EntityCreate, len(input))
-	for i, data := range input {
-		builders[i] = c.Entity.Create().SetInput(*data)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.EntitlementPlan.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.EntitlementPlanEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
 	}
 
-	res, err := c.Entity.CreateBulk(builders...).Save(ctx)
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "entitlementplan"}, r.logger)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "entity"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "entitlementplan"}, r.logger)
 	}
 
 	// return response
-	return &EntityBulkCreatePayload{
-		Entities: res,
+	return &EntitlementPlanBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
 	}, nil
 }
 
-// bulkCreateEntityType uses the CreateBulk function to create multiple EntityType entities
-func (r *mutationResolver) bulkCreateEntityType(ctx context.Context, input []*generated.CreateEntityTypeInput) (*EntityTypeBulkCreatePayload, error) {
+// bulkUpsertEntitlementPlan creates or updates EntitlementPlan entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertEntitlementPlan(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*EntitlementPlanBulkUpsertPayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.EntityTypeCreate, len(input))
-	for i, data := range input {
-		builders[i] = c.EntityType.Create().SetInput(*data)
+
+	data, err := unmarshalUpsertData[generated.CreateEntitlementPlanInput, generated.UpdateEntitlementPlanInput](input, keys, entitlementplan.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
 	}
 
-	res, err := c.EntityType.CreateBulk(builders...).Save(ctx)
+	rows := make([]bulkRow[*generated.EntitlementPlan], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.EntitlementPlan]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.EntitlementPlan, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.EntitlementPlan.Query().Where(predicate.EntitlementPlan(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.EntitlementPlan.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateEntitlementPlanRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "entitlementplan"}, r.logger)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "entitytype"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "entitlementplan"}, r.logger)
 	}
 
 	// return response
-	return &EntityTypeBulkCreatePayload{
-		EntityTypes: res,
+	return &EntitlementPlanBulkUpsertPayload{
+		EntitlementPlans: res,
+		Errors:           bulkErrs,
 	}, nil
 }
 
-// bulkCreateEvent uses the CreateBulk function to create multiple Event entities
-func (r *mutationResolver) bulkCreateEvent(ctx context.Context, input []*generated.CreateEventInput) (*EventBulkCreatePayload, error) {
+// bulkCreateEntitlementPlanFeature uses the CreateBulk function to create multiple EntitlementPlanFeature entities
+func (r *mutationResolver) bulkCreateEntitlementPlanFeature(ctx context.Context, input []*generated.CreateEntitlementPlanFeatureInput) (*EntitlementPlanFeatureBulkCreatePayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.EventCreate, len(input))
+	builders := make([]*generated.EntitlementPlanFeatureCreate, len(input))
 	for i, data := range input {
-		builders[i] = c.Event.Create().SetInput(*data)
+		builders[i] = c.EntitlementPlanFeature.Create().SetInput(*data)
 	}
 
-	res, err := c.Event.CreateBulk(builders...).Save(ctx)
+	res, err := c.EntitlementPlanFeature.CreateBulk(builders...).Save(ctx)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "event"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "entitlementplanfeature"}, r.logger)
 	}
 
 	// return response
-	return &EventBulkCreatePayload{
-		Events: res,
+	return &EntitlementPlanFeatureBulkCreatePayload{
+		EntitlementPlanFeatures: res,
 	}, nil
 }
 
-// bulkCreateFeature uses the CreateBulk function to create multiple Feature entities
-func (r *mutationResolver) bulkCreateFeature(ctx context.Context, input []*generated.CreateFeatureInput) (*FeatureBulkCreatePayload, error) {
+// bulkUpdateEntitlementPlanFeature updates multiple EntitlementPlanFeature entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateEntitlementPlanFeature(ctx context.Context, ids []string, input generated.UpdateEntitlementPlanFeatureInput, mode *BulkMode) (*EntitlementPlanFeatureBulkUpdatePayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.FeatureCreate, len(input))
-	for i, data := range input {
-		builders[i] = c.Feature.Create().SetInput(*data)
+	rows := make([]bulkRow[*generated.EntitlementPlanFeature], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.EntitlementPlanFeature]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.EntitlementPlanFeature, error) {
+				return bulkUpdateEntitlementPlanFeatureRow(ctx, c, id, input)
+			},
+		}
 	}
 
-	res, err := c.Feature.CreateBulk(builders...).Save(ctx)
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "entitlementplanfeature"}, r.logger)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "feature"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "entitlementplanfeature"}, r.logger)
 	}
 
 	// return response
-	return &FeatureBulkCreatePayload{
-		Features: res,
+	return &EntitlementPlanFeatureBulkUpdatePayload{
+		EntitlementPlanFeatures: res,
+		Errors:                  bulkErrs,
 	}, nil
 }
 
-// bulkCreateFile uses the CreateBulk function to create multiple File entities
-func (r *mutationResolver) bulkCreateFile(ctx context.Context, input []*generated.CreateFileInput) (*FileBulkCreatePayload, error) {
+// bulkUpdateEntitlementPlanFeatureRow updates a single EntitlementPlanFeature entity of a bulk mutation
+func bulkUpdateEntitlementPlanFeatureRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateEntitlementPlanFeatureInput) (*generated.EntitlementPlanFeature, error) {
+	res, err := c.EntitlementPlanFeature.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, &res.OwnerID); err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).Save(ctx)
+}
+
+// bulkDeleteEntitlementPlanFeature deletes multiple EntitlementPlanFeature entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteEntitlementPlanFeature(ctx context.Context, ids []string, mode *BulkMode) (*EntitlementPlanFeatureBulkDeletePayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.FileCreate, len(input))
-	for i, data := range input {
-		builders[i] = c.File.Create().SetInput(*data)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.EntitlementPlanFeature.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.EntitlementPlanFeatureEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
 	}
 
-	res, err := c.File.CreateBulk(builders...).Save(ctx)
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "entitlementplanfeature"}, r.logger)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "file"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "entitlementplanfeature"}, r.logger)
 	}
 
 	// return response
-	return &FileBulkCreatePayload{
-		Files: res,
+	return &EntitlementPlanFeatureBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
 	}, nil
 }
 
-// bulkCreateGroup uses the CreateBulk function to create multiple Group entities
-func (r *mutationResolver) bulkCreateGroup(ctx context.Context, input []*generated.CreateGroupInput) (*GroupBulkCreatePayload, error) {
+// bulkUpsertEntitlementPlanFeature creates or updates EntitlementPlanFeature entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertEntitlementPlanFeature(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*EntitlementPlanFeatureBulkUpsertPayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.GroupCreate, len(input))
-	for i, data := range input {
-		builders[i] = c.Group.Create().SetInput(*data)
+
+	data, err := unmarshalUpsertData[generated.CreateEntitlementPlanFeatureInput, generated.UpdateEntitlementPlanFeatureInput](input, keys, entitlementplanfeature.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
 	}
 
-	res, err := c.Group.CreateBulk(builders...).Save(ctx)
+	rows := make([]bulkRow[*generated.EntitlementPlanFeature], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.EntitlementPlanFeature]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.EntitlementPlanFeature, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.EntitlementPlanFeature.Query().Where(predicate.EntitlementPlanFeature(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.EntitlementPlanFeature.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateEntitlementPlanFeatureRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "entitlementplanfeature"}, r.logger)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "group"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "entitlementplanfeature"}, r.logger)
 	}
 
 	// return response
-	return &GroupBulkCreatePayload{
-		Groups: res,
+	return &EntitlementPlanFeatureBulkUpsertPayload{
+		EntitlementPlanFeatures: res,
+		Errors:                  bulkErrs,
 	}, nil
 }
 
-// bulkCreateGroupMembership uses the CreateBulk function to create multiple GroupMembership entities
-func (r *mutationResolver) bulkCreateGroupMembership(ctx context.Context, input []*generated.CreateGroupMembershipInput) (*GroupMembershipBulkCreatePayload, error) {
+// bulkCreateEntity uses the CreateBulk function to create multiple Entity entities
+func (r *mutationResolver) bulkCreateEntity(ctx context.Context, input []*generated.CreateEntityInput) (*EntityBulkCreatePayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.GroupMembershipCreate, len(input))
+	builders := make([]*generated.EntityCreate, len(input))
 	for i, data := range input {
-		builders[i] = c.GroupMembership.Create().SetInput(*data)
+		builders[i] = c.Entity.Create().SetInput(*data)
+	}
+
+	res, err := c.Entity.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "entity"}, r.logger)
+	}
+
+	// return response
+	return &EntityBulkCreatePayload{
+		Entities: res,
+	}, nil
+}
+
+// bulkUpdateEntity updates multiple Entity entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateEntity(ctx context.Context, ids []string, input generated.UpdateEntityInput, mode *BulkMode) (*EntityBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.Entity], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.Entity]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.Entity, error) {
+				return bulkUpdateEntityRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "entity"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "entity"}, r.logger)
+	}
+
+	// return response
+	return &EntityBulkUpdatePayload{
+		Entities: res,
+		Errors:   bulkErrs,
+	}, nil
+}
+
+// bulkUpdateEntityRow updates a single Entity entity of a bulk mutation
+func bulkUpdateEntityRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateEntityInput) (*generated.Entity, error) {
+	res, err := c.Entity.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, &res.OwnerID); err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).AppendDomains(input.AppendDomains).Save(ctx)
+}
+
+// bulkDeleteEntity deletes multiple Entity entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteEntity(ctx context.Context, ids []string, mode *BulkMode) (*EntityBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.Entity.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.EntityEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "entity"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "entity"}, r.logger)
+	}
+
+	// return response
+	return &EntityBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertEntity creates or updates Entity entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertEntity(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*EntityBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateEntityInput, generated.UpdateEntityInput](input, keys, entity.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.Entity], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.Entity]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.Entity, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.Entity.Query().Where(predicate.Entity(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.Entity.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateEntityRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "entity"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "entity"}, r.logger)
+	}
+
+	// return response
+	return &EntityBulkUpsertPayload{
+		Entities: res,
+		Errors:   bulkErrs,
+	}, nil
+}
+
+// bulkImportEntity creates Entity entities from the rows of a csv file, the columns are mapped to the fields
+// of the input and each row is created in its own savepoint; a dry run validates the rows without saving them
+func (r *mutationResolver) bulkImportEntity(ctx context.Context, input graphql.Upload, mapping []*CSVColumnMapping, dryRun *bool, mode *BulkMode) (*EntityBulkImportPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalImportData[generated.CreateEntityInput](input, mapping)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.Entity], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.Entity]{
+			line: d.line,
+			run: func(ctx context.Context) (*generated.Entity, error) {
+				if d.err != nil {
+					return nil, d.err
+				}
+
+				return c.Entity.Create().SetInput(*d.input).Save(ctx)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode, dryRun: lo.FromPtr(dryRun)}, rows, action{action: ActionCreate, object: "entity"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "entity"}, r.logger)
+	}
+
+	// return response
+	return &EntityBulkImportPayload{
+		Entities: res,
+		Errors:   bulkErrs,
+		DryRun:   lo.FromPtr(dryRun),
+	}, nil
+}
+
+// bulkCreateEntityType uses the CreateBulk function to create multiple EntityType entities
+func (r *mutationResolver) bulkCreateEntityType(ctx context.Context, input []*generated.CreateEntityTypeInput) (*EntityTypeBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.EntityTypeCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.EntityType.Create().SetInput(*data)
+	}
+
+	res, err := c.EntityType.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "entitytype"}, r.logger)
+	}
+
+	// return response
+	return &EntityTypeBulkCreatePayload{
+		EntityTypes: res,
+	}, nil
+}
+
+// bulkUpdateEntityType updates multiple EntityType entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateEntityType(ctx context.Context, ids []string, input generated.UpdateEntityTypeInput, mode *BulkMode) (*EntityTypeBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.EntityType], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.EntityType]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.EntityType, error) {
+				return bulkUpdateEntityTypeRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "entitytype"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "entitytype"}, r.logger)
+	}
+
+	// return response
+	return &EntityTypeBulkUpdatePayload{
+		EntityTypes: res,
+		Errors:      bulkErrs,
+	}, nil
+}
+
+// bulkUpdateEntityTypeRow updates a single EntityType entity of a bulk mutation
+func bulkUpdateEntityTypeRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateEntityTypeInput) (*generated.EntityType, error) {
+	res, err := c.EntityType.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, &res.OwnerID); err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).Save(ctx)
+}
+
+// bulkDeleteEntityType deletes multiple EntityType entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteEntityType(ctx context.Context, ids []string, mode *BulkMode) (*EntityTypeBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.EntityType.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.EntityTypeEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "entitytype"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "entitytype"}, r.logger)
+	}
+
+	// return response
+	return &EntityTypeBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertEntityType creates or updates EntityType entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertEntityType(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*EntityTypeBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateEntityTypeInput, generated.UpdateEntityTypeInput](input, keys, entitytype.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.EntityType], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.EntityType]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.EntityType, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.EntityType.Query().Where(predicate.EntityType(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.EntityType.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateEntityTypeRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "entitytype"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "entitytype"}, r.logger)
+	}
+
+	// return response
+	return &EntityTypeBulkUpsertPayload{
+		EntityTypes: res,
+		Errors:      bulkErrs,
+	}, nil
+}
+
+// bulkCreateEvent uses the CreateBulk function to create multiple Event entities
+func (r *mutationResolver) bulkCreateEvent(ctx context.Context, input []*generated.CreateEventInput) (*EventBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.EventCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.Event.Create().SetInput(*data)
+	}
+
+	res, err := c.Event.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "event"}, r.logger)
+	}
+
+	// return response
+	return &EventBulkCreatePayload{
+		Events: res,
+	}, nil
+}
+
+// bulkCreateFeature uses the CreateBulk function to create multiple Feature entities
+func (r *mutationResolver) bulkCreateFeature(ctx context.Context, input []*generated.CreateFeatureInput) (*FeatureBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.FeatureCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.Feature.Create().SetInput(*data)
+	}
+
+	res, err := c.Feature.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "feature"}, r.logger)
+	}
+
+	// return response
+	return &FeatureBulkCreatePayload{
+		Features: res,
+	}, nil
+}
+
+// bulkUpdateFeature updates multiple Feature entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateFeature(ctx context.Context, ids []string, input generated.UpdateFeatureInput, mode *BulkMode) (*FeatureBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.Feature], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.Feature]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.Feature, error) {
+				return bulkUpdateFeatureRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "feature"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "feature"}, r.logger)
+	}
+
+	// return response
+	return &FeatureBulkUpdatePayload{
+		Features: res,
+		Errors:   bulkErrs,
+	}, nil
+}
+
+// bulkUpdateFeatureRow updates a single Feature entity of a bulk mutation
+func bulkUpdateFeatureRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateFeatureInput) (*generated.Feature, error) {
+	res, err := c.Feature.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, &res.OwnerID); err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).Save(ctx)
+}
+
+// bulkDeleteFeature deletes multiple Feature entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteFeature(ctx context.Context, ids []string, mode *BulkMode) (*FeatureBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.Feature.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.FeatureEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "feature"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "feature"}, r.logger)
+	}
+
+	// return response
+	return &FeatureBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertFeature creates or updates Feature entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertFeature(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*FeatureBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateFeatureInput, generated.UpdateFeatureInput](input, keys, feature.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.Feature], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.Feature]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.Feature, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.Feature.Query().Where(predicate.Feature(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.Feature.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateFeatureRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "feature"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "feature"}, r.logger)
+	}
+
+	// return response
+	return &FeatureBulkUpsertPayload{
+		Features: res,
+		Errors:   bulkErrs,
+	}, nil
+}
+
+// bulkCreateFile uses the CreateBulk function to create multiple File entities
+func (r *mutationResolver) bulkCreateFile(ctx context.Context, input []*generated.CreateFileInput) (*FileBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.FileCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.File.Create().SetInput(*data)
+	}
+
+	res, err := c.File.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "file"}, r.logger)
+	}
+
+	// return response
+	return &FileBulkCreatePayload{
+		Files: res,
+	}, nil
+}
+
+// bulkUpdateFile updates multiple File entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateFile(ctx context.Context, ids []string, input generated.UpdateFileInput, mode *BulkMode) (*FileBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.File], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.File]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.File, error) {
+				return bulkUpdateFileRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "file"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "file"}, r.logger)
+	}
+
+	// return response
+	return &FileBulkUpdatePayload{
+		Files:  res,
+		Errors: bulkErrs,
+	}, nil
+}
+
+// bulkUpdateFileRow updates a single File entity of a bulk mutation
+func bulkUpdateFileRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateFileInput) (*generated.File, error) {
+	res, err := c.File.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).Save(ctx)
+}
+
+// bulkDeleteFile deletes multiple File entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteFile(ctx context.Context, ids []string, mode *BulkMode) (*FileBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.File.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.FileEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "file"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "file"}, r.logger)
+	}
+
+	// return response
+	return &FileBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertFile creates or updates File entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertFile(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*FileBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateFileInput, generated.UpdateFileInput](input, keys, file.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.File], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.File]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.File, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.File.Query().Where(predicate.File(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.File.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateFileRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "file"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "file"}, r.logger)
+	}
+
+	// return response
+	return &FileBulkUpsertPayload{
+		Files:  res,
+		Errors: bulkErrs,
+	}, nil
+}
+
+// bulkCreateGroup uses the CreateBulk function to create multiple Group entities
+func (r *mutationResolver) bulkCreateGroup(ctx context.Context, input []*generated.CreateGroupInput) (*GroupBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.GroupCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.Group.Create().SetInput(*data)
+	}
+
+	res, err := c.Group.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "group"}, r.logger)
+	}
+
+	// return response
+	return &GroupBulkCreatePayload{
+		Groups: res,
+	}, nil
+}
+
+// bulkCreateGroupMembership uses the CreateBulk function to create multiple GroupMembership entities
+func (r *mutationResolver) bulkCreateGroupMembership(ctx context.Context, input []*generated.CreateGroupMembershipInput) (*GroupMembershipBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.GroupMembershipCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.GroupMembership.Create().SetInput(*data)
+	}
+
+	res, err := c.GroupMembership.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "groupmembership"}, r.logger)
+	}
+
+	// return response
+	return &GroupMembershipBulkCreatePayload{
+		GroupMemberships: res,
+	}, nil
+}
+
+// bulkCreateGroupSetting uses the CreateBulk function to create multiple GroupSetting entities
+func (r *mutationResolver) bulkCreateGroupSetting(ctx context.Context, input []*generated.CreateGroupSettingInput) (*GroupSettingBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.GroupSettingCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.GroupSetting.Create().SetInput(*data)
+	}
+
+	res, err := c.GroupSetting.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "groupsetting"}, r.logger)
+	}
+
+	// return response
+	return &GroupSettingBulkCreatePayload{
+		GroupSettings: res,
+	}, nil
+}
+
+// bulkUpdateGroupSetting updates multiple GroupSetting entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateGroupSetting(ctx context.Context, ids []string, input generated.UpdateGroupSettingInput, mode *BulkMode) (*GroupSettingBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.GroupSetting], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.GroupSetting]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.GroupSetting, error) {
+				return bulkUpdateGroupSettingRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "groupsetting"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "groupsetting"}, r.logger)
+	}
+
+	// return response
+	return &GroupSettingBulkUpdatePayload{
+		GroupSettings: res,
+		Errors:        bulkErrs,
+	}, nil
+}
+
+// bulkUpdateGroupSettingRow updates a single GroupSetting entity of a bulk mutation
+func bulkUpdateGroupSettingRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateGroupSettingInput) (*generated.GroupSetting, error) {
+	res, err := c.GroupSetting.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).Save(ctx)
+}
+
+// bulkDeleteGroupSetting deletes multiple GroupSetting entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteGroupSetting(ctx context.Context, ids []string, mode *BulkMode) (*GroupSettingBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.GroupSetting.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.GroupSettingEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "groupsetting"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "groupsetting"}, r.logger)
+	}
+
+	// return response
+	return &GroupSettingBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertGroupSetting creates or updates GroupSetting entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertGroupSetting(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*GroupSettingBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateGroupSettingInput, generated.UpdateGroupSettingInput](input, keys, groupsetting.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.GroupSetting], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.GroupSetting]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.GroupSetting, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.GroupSetting.Query().Where(predicate.GroupSetting(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.GroupSetting.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateGroupSettingRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "groupsetting"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "groupsetting"}, r.logger)
+	}
+
+	// return response
+	return &GroupSettingBulkUpsertPayload{
+		GroupSettings: res,
+		Errors:        bulkErrs,
+	}, nil
+}
+
+// bulkCreateHush uses the CreateBulk function to create multiple Hush entities
+func (r *mutationResolver) bulkCreateHush(ctx context.Context, input []*generated.CreateHushInput) (*HushBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.HushCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.Hush.Create().SetInput(*data)
+	}
+
+	res, err := c.Hush.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "hush"}, r.logger)
+	}
+
+	// return response
+	return &HushBulkCreatePayload{
+		Hushes: res,
+	}, nil
+}
+
+// bulkUpdateHush updates multiple Hush entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateHush(ctx context.Context, ids []string, input generated.UpdateHushInput, mode *BulkMode) (*HushBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.Hush], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.Hush]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.Hush, error) {
+				return bulkUpdateHushRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "hush"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "hush"}, r.logger)
+	}
+
+	// return response
+	return &HushBulkUpdatePayload{
+		Hushes: res,
+		Errors: bulkErrs,
+	}, nil
+}
+
+// bulkUpdateHushRow updates a single Hush entity of a bulk mutation
+func bulkUpdateHushRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateHushInput) (*generated.Hush, error) {
+	res, err := c.Hush.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Update().SetInput(input).Save(ctx)
+}
+
+// bulkDeleteHush deletes multiple Hush entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteHush(ctx context.Context, ids []string, mode *BulkMode) (*HushBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.Hush.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.HushEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "hush"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "hush"}, r.logger)
+	}
+
+	// return response
+	return &HushBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertHush creates or updates Hush entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertHush(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*HushBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateHushInput, generated.UpdateHushInput](input, keys, hush.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.Hush], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.Hush]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.Hush, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.Hush.Query().Where(predicate.Hush(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.Hush.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateHushRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "hush"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "hush"}, r.logger)
+	}
+
+	// return response
+	return &HushBulkUpsertPayload{
+		Hushes: res,
+		Errors: bulkErrs,
+	}, nil
+}
+
+// bulkCreateIntegration uses the CreateBulk function to create multiple Integration entities
+func (r *mutationResolver) bulkCreateIntegration(ctx context.Context, input []*generated.CreateIntegrationInput) (*IntegrationBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.IntegrationCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.Integration.Create().SetInput(*data)
+	}
+
+	res, err := c.Integration.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "integration"}, r.logger)
+	}
+
+	// return response
+	return &IntegrationBulkCreatePayload{
+		Integrations: res,
+	}, nil
+}
+
+// bulkUpdateIntegration updates multiple Integration entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateIntegration(ctx context.Context, ids []string, input generated.UpdateIntegrationInput, mode *BulkMode) (*IntegrationBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.Integration], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.Integration]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.Integration, error) {
+				return bulkUpdateIntegrationRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "integration"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "integration"}, r.logger)
+	}
+
+	// return response
+	return &IntegrationBulkUpdatePayload{
+		Integrations: res,
+		Errors:       bulkErrs,
+	}, nil
+}
+
+// bulkUpdateIntegrationRow updates a single Integration entity of a bulk mutation
+func bulkUpdateIntegrationRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateIntegrationInput) (*generated.Integration, error) {
+	res, err := c.Integration.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, &res.OwnerID); err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).Save(ctx)
+}
+
+// bulkDeleteIntegration deletes multiple Integration entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteIntegration(ctx context.Context, ids []string, mode *BulkMode) (*IntegrationBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.Integration.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.IntegrationEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "integration"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "integration"}, r.logger)
+	}
+
+	// return response
+	return &IntegrationBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertIntegration creates or updates Integration entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertIntegration(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*IntegrationBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateIntegrationInput, generated.UpdateIntegrationInput](input, keys, integration.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.Integration], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.Integration]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.Integration, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.Integration.Query().Where(predicate.Integration(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.Integration.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateIntegrationRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "integration"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "integration"}, r.logger)
+	}
+
+	// return response
+	return &IntegrationBulkUpsertPayload{
+		Integrations: res,
+		Errors:       bulkErrs,
+	}, nil
+}
+
+// bulkCreateInvite uses the CreateBulk function to create multiple Invite entities
+func (r *mutationResolver) bulkCreateInvite(ctx context.Context, input []*generated.CreateInviteInput) (*InviteBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.InviteCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.Invite.Create().SetInput(*data)
+	}
+
+	res, err := c.Invite.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "invite"}, r.logger)
+	}
+
+	// return response
+	return &InviteBulkCreatePayload{
+		Invites: res,
+	}, nil
+}
+
+// bulkCreateOauthProvider uses the CreateBulk function to create multiple OauthProvider entities
+func (r *mutationResolver) bulkCreateOauthProvider(ctx context.Context, input []*generated.CreateOauthProviderInput) (*OauthProviderBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.OauthProviderCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.OauthProvider.Create().SetInput(*data)
+	}
+
+	res, err := c.OauthProvider.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "oauthprovider"}, r.logger)
+	}
+
+	// return response
+	return &OauthProviderBulkCreatePayload{
+		OauthProviders: res,
+	}, nil
+}
+
+// bulkUpdateOauthProvider updates multiple OauthProvider entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateOauthProvider(ctx context.Context, ids []string, input generated.UpdateOauthProviderInput, mode *BulkMode) (*OauthProviderBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.OauthProvider], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.OauthProvider]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.OauthProvider, error) {
+				return bulkUpdateOauthProviderRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "oauthprovider"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "oauthprovider"}, r.logger)
+	}
+
+	// return response
+	return &OauthProviderBulkUpdatePayload{
+		OauthProviders: res,
+		Errors:         bulkErrs,
+	}, nil
+}
+
+// bulkUpdateOauthProviderRow updates a single OauthProvider entity of a bulk mutation
+func bulkUpdateOauthProviderRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateOauthProviderInput) (*generated.OauthProvider, error) {
+	res, err := c.OauthProvider.Get(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := c.GroupMembership.CreateBulk(builders...).Save(ctx)
-	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "groupmembership"}, r.logger)
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, &res.OwnerID); err != nil {
+		return nil, ErrPermissionDenied
 	}
 
-	// return response
-	return &GroupMembershipBulkCreatePayload{
-		GroupMemberships: res,
-	}, nil
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).Save(ctx)
 }
 
-// bulkCreateGroupSetting uses the CreateBulk function to create multiple GroupSetting entities
-func (r *mutationResolver) bulkCreateGroupSetting(ctx context.Context, input []*generated.CreateGroupSettingInput) (*GroupSettingBulkCreatePayload, error) {
+// bulkDeleteOauthProvider deletes multiple OauthProvider entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteOauthProvider(ctx context.Context, ids []string, mode *BulkMode) (*OauthProviderBulkDeletePayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.GroupSettingCreate, len(input))
-	for i, data := range input {
-		builders[i] = c.GroupSetting.Create().SetInput(*data)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.OauthProvider.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.OauthProviderEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
 	}
 
-	res, err := c.GroupSetting.CreateBulk(builders...).Save(ctx)
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "oauthprovider"}, r.logger)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "groupsetting"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "oauthprovider"}, r.logger)
 	}
 
 	// return response
-	return &GroupSettingBulkCreatePayload{
-		GroupSettings: res,
+	return &OauthProviderBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
 	}, nil
 }
 
-// bulkCreateHush uses the CreateBulk function to create multiple Hush entities
-func (r *mutationResolver) bulkCreateHush(ctx context.Context, input []*generated.CreateHushInput) (*HushBulkCreatePayload, error) {
+// bulkUpsertOauthProvider creates or updates OauthProvider entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertOauthProvider(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*OauthProviderBulkUpsertPayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.HushCreate, len(input))
-	for i, data := range input {
-		builders[i] = c.Hush.Create().SetInput(*data)
+
+	data, err := unmarshalUpsertData[generated.CreateOauthProviderInput, generated.UpdateOauthProviderInput](input, keys, oauthprovider.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
 	}
 
-	res, err := c.Hush.CreateBulk(builders...).Save(ctx)
+	rows := make([]bulkRow[*generated.OauthProvider], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.OauthProvider]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.OauthProvider, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.OauthProvider.Query().Where(predicate.OauthProvider(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.OauthProvider.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateOauthProviderRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "oauthprovider"}, r.logger)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "hush"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "oauthprovider"}, r.logger)
 	}
 
 	// return response
-	return &HushBulkCreatePayload{
-		Hushes: res,
+	return &OauthProviderBulkUpsertPayload{
+		OauthProviders: res,
+		Errors:         bulkErrs,
 	}, nil
 }
 
-// bulkCreateIntegration uses the CreateBulk function to create multiple Integration entities
-func (r *mutationResolver) bulkCreateIntegration(ctx context.Context, input []*generated.CreateIntegrationInput) (*IntegrationBulkCreatePayload, error) {
+// bulkCreateOhAuthTooToken uses the CreateBulk function to create multiple OhAuthTooToken entities
+func (r *mutationResolver) bulkCreateOhAuthTooToken(ctx context.Context, input []*generated.CreateOhAuthTooTokenInput) (*OhAuthTooTokenBulkCreatePayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.IntegrationCreate, len(input))
+	builders := make([]*generated.OhAuthTooTokenCreate, len(input))
 	for i, data := range input {
-		builders[i] = c.Integration.Create().SetInput(*data)
+		builders[i] = c.OhAuthTooToken.Create().SetInput(*data)
 	}
 
-	res, err := c.Integration.CreateBulk(builders...).Save(ctx)
+	res, err := c.OhAuthTooToken.CreateBulk(builders...).Save(ctx)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "integration"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "ohauthtootoken"}, r.logger)
 	}
 
 	// return response
-	return &IntegrationBulkCreatePayload{
-		Integrations: res,
+	return &OhAuthTooTokenBulkCreatePayload{
+		OhAuthTooTokens: res,
 	}, nil
 }
 
-// bulkCreateInvite uses the CreateBulk function to create multiple Invite entities
-func (r *mutationResolver) bulkCreateInvite(ctx context.Context, input []*generated.CreateInviteInput) (*InviteBulkCreatePayload, error) {
+// bulkUpdateOhAuthTooToken updates multiple OhAuthTooToken entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateOhAuthTooToken(ctx context.Context, ids []string, input generated.UpdateOhAuthTooTokenInput, mode *BulkMode) (*OhAuthTooTokenBulkUpdatePayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.InviteCreate, len(input))
-	for i, data := range input {
-		builders[i] = c.Invite.Create().SetInput(*data)
+	rows := make([]bulkRow[*generated.OhAuthTooToken], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.OhAuthTooToken]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.OhAuthTooToken, error) {
+				return bulkUpdateOhAuthTooTokenRow(ctx, c, id, input)
+			},
+		}
 	}
 
-	res, err := c.Invite.CreateBulk(builders...).Save(ctx)
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "ohauthtootoken"}, r.logger)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "invite"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "ohauthtootoken"}, r.logger)
 	}
 
 	// return response
-	return &InviteBulkCreatePayload{
-		Invites: res,
+	return &OhAuthTooTokenBulkUpdatePayload{
+		OhAuthTooTokens: res,
+		Errors:          bulkErrs,
 	}, nil
 }
 
-// bulkCreateOauthProvider uses the CreateBulk function to create multiple OauthProvider entities
-func (r *mutationResolver) bulkCreateOauthProvider(ctx context.Context, input []*generated.CreateOauthProviderInput) (*OauthProviderBulkCreatePayload, error) {
+// bulkUpdateOhAuthTooTokenRow updates a single OhAuthTooToken entity of a bulk mutation
+func bulkUpdateOhAuthTooTokenRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateOhAuthTooTokenInput) (*generated.OhAuthTooToken, error) {
+	res, err := c.OhAuthTooToken.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).AppendScopes(input.AppendScopes).AppendClaimsGroups(input.AppendClaimsGroups).AppendConnectorData(input.AppendConnectorData).Save(ctx)
+}
+
+// bulkDeleteOhAuthTooToken deletes multiple OhAuthTooToken entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteOhAuthTooToken(ctx context.Context, ids []string, mode *BulkMode) (*OhAuthTooTokenBulkDeletePayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.OauthProviderCreate, len(input))
-	for i, data := range input {
-		builders[i] = c.OauthProvider.Create().SetInput(*data)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.OhAuthTooToken.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.OhAuthTooTokenEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
 	}
 
-	res, err := c.OauthProvider.CreateBulk(builders...).Save(ctx)
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "ohauthtootoken"}, r.logger)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "oauthprovider"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "ohauthtootoken"}, r.logger)
 	}
 
 	// return response
-	return &OauthProviderBulkCreatePayload{
-		OauthProviders: res,
+	return &OhAuthTooTokenBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
 	}, nil
 }
 
-// bulkCreateOhAuthTooToken uses the CreateBulk function to create multiple OhAuthTooToken entities
-func (r *mutationResolver) bulkCreateOhAuthTooToken(ctx context.Context, input []*generated.CreateOhAuthTooTokenInput) (*OhAuthTooTokenBulkCreatePayload, error) {
+// bulkUpsertOhAuthTooToken creates or updates OhAuthTooToken entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertOhAuthTooToken(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*OhAuthTooTokenBulkUpsertPayload, error) {
 	c := withTransactionalMutation(ctx)
-	builders := make([]*generated.OhAuthTooTokenCreate, len(input))
-	for i, data := range input {
-		builders[i] = c.OhAuthTooToken.Create().SetInput(*data)
+
+	data, err := unmarshalUpsertData[generated.CreateOhAuthTooTokenInput, generated.UpdateOhAuthTooTokenInput](input, keys, ohauthtootoken.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
 	}
 
-	res, err := c.OhAuthTooToken.CreateBulk(builders...).Save(ctx)
+	rows := make([]bulkRow[*generated.OhAuthTooToken], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.OhAuthTooToken]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.OhAuthTooToken, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.OhAuthTooToken.Query().Where(predicate.OhAuthTooToken(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.OhAuthTooToken.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateOhAuthTooTokenRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "ohauthtootoken"}, r.logger)
 	if err != nil {
-		return nil, parseRequestError(err, action{action: ActionCreate, object: "ohauthtootoken"}, r.logger)
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "ohauthtootoken"}, r.logger)
 	}
 
 	// return response
-	return &OhAuthTooTokenBulkCreatePayload{
+	return &OhAuthTooTokenBulkUpsertPayload{
 		OhAuthTooTokens: res,
+		Errors:          bulkErrs,
 	}, nil
 }
 
@@ -407,6 +2153,120 @@ func (r *mutationResolver) bulkCreateOrganizationSetting(ctx context.Context, in
 	}, nil
 }
 
+// bulkUpdateOrganizationSetting updates multiple OrganizationSetting entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateOrganizationSetting(ctx context.Context, ids []string, input generated.UpdateOrganizationSettingInput, mode *BulkMode) (*OrganizationSettingBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.OrganizationSetting], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.OrganizationSetting]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.OrganizationSetting, error) {
+				return bulkUpdateOrganizationSettingRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "organizationsetting"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "organizationsetting"}, r.logger)
+	}
+
+	// return response
+	return &OrganizationSettingBulkUpdatePayload{
+		OrganizationSettings: res,
+		Errors:               bulkErrs,
+	}, nil
+}
+
+// bulkUpdateOrganizationSettingRow updates a single OrganizationSetting entity of a bulk mutation
+func bulkUpdateOrganizationSettingRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateOrganizationSettingInput) (*generated.OrganizationSetting, error) {
+	res, err := c.OrganizationSetting.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).AppendDomains(input.AppendDomains).Save(ctx)
+}
+
+// bulkDeleteOrganizationSetting deletes multiple OrganizationSetting entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteOrganizationSetting(ctx context.Context, ids []string, mode *BulkMode) (*OrganizationSettingBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.OrganizationSetting.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.OrganizationSettingEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "organizationsetting"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "organizationsetting"}, r.logger)
+	}
+
+	// return response
+	return &OrganizationSettingBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertOrganizationSetting creates or updates OrganizationSetting entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertOrganizationSetting(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*OrganizationSettingBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateOrganizationSettingInput, generated.UpdateOrganizationSettingInput](input, keys, organizationsetting.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.OrganizationSetting], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.OrganizationSetting]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.OrganizationSetting, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.OrganizationSetting.Query().Where(predicate.OrganizationSetting(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.OrganizationSetting.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateOrganizationSettingRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "organizationsetting"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "organizationsetting"}, r.logger)
+	}
+
+	// return response
+	return &OrganizationSettingBulkUpsertPayload{
+		OrganizationSettings: res,
+		Errors:               bulkErrs,
+	}, nil
+}
+
 // bulkCreateOrgMembership uses the CreateBulk function to create multiple OrgMembership entities
 func (r *mutationResolver) bulkCreateOrgMembership(ctx context.Context, input []*generated.CreateOrgMembershipInput) (*OrgMembershipBulkCreatePayload, error) {
 	c := withTransactionalMutation(ctx)
@@ -445,6 +2305,120 @@ func (r *mutationResolver) bulkCreatePersonalAccessToken(ctx context.Context, in
 	}, nil
 }
 
+// bulkUpdatePersonalAccessToken updates multiple PersonalAccessToken entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdatePersonalAccessToken(ctx context.Context, ids []string, input generated.UpdatePersonalAccessTokenInput, mode *BulkMode) (*PersonalAccessTokenBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.PersonalAccessToken], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.PersonalAccessToken]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.PersonalAccessToken, error) {
+				return bulkUpdatePersonalAccessTokenRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "personalaccesstoken"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "personalaccesstoken"}, r.logger)
+	}
+
+	// return response
+	return &PersonalAccessTokenBulkUpdatePayload{
+		PersonalAccessTokens: res,
+		Errors:               bulkErrs,
+	}, nil
+}
+
+// bulkUpdatePersonalAccessTokenRow updates a single PersonalAccessToken entity of a bulk mutation
+func bulkUpdatePersonalAccessTokenRow(ctx context.Context, c *generated.Client, id string, input generated.UpdatePersonalAccessTokenInput) (*generated.PersonalAccessToken, error) {
+	res, err := c.PersonalAccessToken.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).AppendScopes(input.AppendScopes).Save(ctx)
+}
+
+// bulkDeletePersonalAccessToken deletes multiple PersonalAccessToken entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeletePersonalAccessToken(ctx context.Context, ids []string, mode *BulkMode) (*PersonalAccessTokenBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.PersonalAccessToken.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.PersonalAccessTokenEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "personalaccesstoken"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "personalaccesstoken"}, r.logger)
+	}
+
+	// return response
+	return &PersonalAccessTokenBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertPersonalAccessToken creates or updates PersonalAccessToken entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertPersonalAccessToken(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*PersonalAccessTokenBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreatePersonalAccessTokenInput, generated.UpdatePersonalAccessTokenInput](input, keys, personalaccesstoken.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.PersonalAccessToken], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.PersonalAccessToken]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.PersonalAccessToken, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.PersonalAccessToken.Query().Where(predicate.PersonalAccessToken(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.PersonalAccessToken.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdatePersonalAccessTokenRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "personalaccesstoken"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "personalaccesstoken"}, r.logger)
+	}
+
+	// return response
+	return &PersonalAccessTokenBulkUpsertPayload{
+		PersonalAccessTokens: res,
+		Errors:               bulkErrs,
+	}, nil
+}
+
 // bulkCreateSubscriber uses the CreateBulk function to create multiple Subscriber entities
 func (r *mutationResolver) bulkCreateSubscriber(ctx context.Context, input []*generated.CreateSubscriberInput) (*SubscriberBulkCreatePayload, error) {
 	c := withTransactionalMutation(ctx)
@@ -483,6 +2457,125 @@ func (r *mutationResolver) bulkCreateTemplate(ctx context.Context, input []*gene
 	}, nil
 }
 
+// bulkUpdateTemplate updates multiple Template entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateTemplate(ctx context.Context, ids []string, input generated.UpdateTemplateInput, mode *BulkMode) (*TemplateBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.Template], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.Template]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.Template, error) {
+				return bulkUpdateTemplateRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "template"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "template"}, r.logger)
+	}
+
+	// return response
+	return &TemplateBulkUpdatePayload{
+		Templates: res,
+		Errors:    bulkErrs,
+	}, nil
+}
+
+// bulkUpdateTemplateRow updates a single Template entity of a bulk mutation
+func bulkUpdateTemplateRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateTemplateInput) (*generated.Template, error) {
+	res, err := c.Template.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, &res.OwnerID); err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).Save(ctx)
+}
+
+// bulkDeleteTemplate deletes multiple Template entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteTemplate(ctx context.Context, ids []string, mode *BulkMode) (*TemplateBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.Template.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.TemplateEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "template"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "template"}, r.logger)
+	}
+
+	// return response
+	return &TemplateBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertTemplate creates or updates Template entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertTemplate(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*TemplateBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateTemplateInput, generated.UpdateTemplateInput](input, keys, template.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.Template], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.Template]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.Template, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.Template.Query().Where(predicate.Template(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.Template.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateTemplateRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "template"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "template"}, r.logger)
+	}
+
+	// return response
+	return &TemplateBulkUpsertPayload{
+		Templates: res,
+		Errors:    bulkErrs,
+	}, nil
+}
+
 // bulkCreateUserSetting uses the CreateBulk function to create multiple UserSetting entities
 func (r *mutationResolver) bulkCreateUserSetting(ctx context.Context, input []*generated.CreateUserSettingInput) (*UserSettingBulkCreatePayload, error) {
 	c := withTransactionalMutation(ctx)
@@ -520,3 +2613,122 @@ func (r *mutationResolver) bulkCreateWebhook(ctx context.Context, input []*gener
 		Webhooks: res,
 	}, nil
 }
+
+// bulkUpdateWebhook updates multiple Webhook entities with the same input, each entity is updated in its own savepoint
+func (r *mutationResolver) bulkUpdateWebhook(ctx context.Context, ids []string, input generated.UpdateWebhookInput, mode *BulkMode) (*WebhookBulkUpdatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[*generated.Webhook], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[*generated.Webhook]{
+			id: &id,
+			run: func(ctx context.Context) (*generated.Webhook, error) {
+				return bulkUpdateWebhookRow(ctx, c, id, input)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "webhook"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "webhook"}, r.logger)
+	}
+
+	// return response
+	return &WebhookBulkUpdatePayload{
+		Webhooks: res,
+		Errors:   bulkErrs,
+	}, nil
+}
+
+// bulkUpdateWebhookRow updates a single Webhook entity of a bulk mutation
+func bulkUpdateWebhookRow(ctx context.Context, c *generated.Client, id string, input generated.UpdateWebhookInput) (*generated.Webhook, error) {
+	res, err := c.Webhook.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, &res.OwnerID); err != nil {
+		return nil, ErrPermissionDenied
+	}
+
+	return res.Update().SetInput(input).AppendTags(input.AppendTags).AppendEventTypes(input.AppendEventTypes).AppendObjectTypes(input.AppendObjectTypes).Save(ctx)
+}
+
+// bulkDeleteWebhook deletes multiple Webhook entities, each entity is deleted in its own savepoint
+func (r *mutationResolver) bulkDeleteWebhook(ctx context.Context, ids []string, mode *BulkMode) (*WebhookBulkDeletePayload, error) {
+	c := withTransactionalMutation(ctx)
+	rows := make([]bulkRow[string], len(ids))
+	for i, id := range ids {
+		rows[i] = bulkRow[string]{
+			id: &id,
+			run: func(ctx context.Context) (string, error) {
+				if err := c.Webhook.DeleteOneID(id).Exec(ctx); err != nil {
+					return "", err
+				}
+
+				if err := generated.WebhookEdgeCleanup(ctx, id); err != nil {
+					return "", newCascadeDeleteError(err)
+				}
+
+				return id, nil
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionDelete, object: "webhook"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "webhook"}, r.logger)
+	}
+
+	// return response
+	return &WebhookBulkDeletePayload{
+		DeletedIDs: res,
+		Errors:     bulkErrs,
+	}, nil
+}
+
+// bulkUpsertWebhook creates or updates Webhook entities from the rows of a csv file, rows are matched
+// on the ID column or the key columns and each row is applied in its own savepoint
+func (r *mutationResolver) bulkUpsertWebhook(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*WebhookBulkUpsertPayload, error) {
+	c := withTransactionalMutation(ctx)
+
+	data, err := unmarshalUpsertData[generated.CreateWebhookInput, generated.UpdateWebhookInput](input, keys, webhook.ValidColumn)
+	if err != nil {
+		r.logger.Errorw("failed to unmarshal bulk data", "error", err)
+
+		return nil, err
+	}
+
+	rows := make([]bulkRow[*generated.Webhook], len(data))
+	for i, d := range data {
+		rows[i] = bulkRow[*generated.Webhook]{
+			id:   d.id,
+			line: d.line,
+			run: func(ctx context.Context) (*generated.Webhook, error) {
+				id, err := d.target(ctx, func(ctx context.Context, match func(*sql.Selector)) ([]string, error) {
+					return c.Webhook.Query().Where(predicate.Webhook(match)).Limit(2).IDs(ctx) //nolint:mnd
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				if id == "" {
+					return c.Webhook.Create().SetInput(*d.create).Save(ctx)
+				}
+
+				return bulkUpdateWebhookRow(ctx, c, id, *d.update)
+			},
+		}
+	}
+
+	res, bulkErrs, err := runBulk(ctx, c, bulkOptions{mode: mode}, rows, action{action: ActionUpdate, object: "webhook"}, r.logger)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "webhook"}, r.logger)
+	}
+
+	// return response
+	return &WebhookBulkUpsertPayload{
+		Webhooks: res,
+		Errors:   bulkErrs,
+	}, nil
+}
@@ -89,6 +89,26 @@ func (r *mutationResolver) DeleteContact(ctx context.Context, id string) (*Conta
 	}, nil
 }
 
+// UpdateBulkContact is the resolver for the updateBulkContact field.
+func (r *mutationResolver) UpdateBulkContact(ctx context.Context, ids []string, input generated.UpdateContactInput, mode *BulkMode) (*ContactBulkUpdatePayload, error) {
+	return r.bulkUpdateContact(ctx, ids, input, mode)
+}
+
+// DeleteBulkContact is the resolver for the deleteBulkContact field.
+func (r *mutationResolver) DeleteBulkContact(ctx context.Context, ids []string, mode *BulkMode) (*ContactBulkDeletePayload, error) {
+	return r.bulkDeleteContact(ctx, ids, mode)
+}
+
+// UpsertBulkCSVContact is the resolver for the upsertBulkCSVContact field.
+func (r *mutationResolver) UpsertBulkCSVContact(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*ContactBulkUpsertPayload, error) {
+	return r.bulkUpsertContact(ctx, input, keys, mode)
+}
+
+// ImportBulkCSVContact is the resolver for the importBulkCSVContact field.
+func (r *mutationResolver) ImportBulkCSVContact(ctx context.Context, input graphql.Upload, mapping []*CSVColumnMapping, dryRun *bool, mode *BulkMode) (*ContactBulkImportPayload, error) {
+	return r.bulkImportContact(ctx, input, mapping, dryRun, mode)
+}
+
 // Contact is the resolver for the contact field.
 func (r *queryResolver) Contact(ctx context.Context, id string) (*generated.Contact, error) {
 	res, err := withTransactionalMutation(ctx).Contact.Get(ctx, id)
@@ -2,8 +2,10 @@ package graphapi_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/brianvoe/gofakeit/v7"
 	mock_fga "github.com/datumforge/fgax/mockery"
 	"github.com/samber/lo"
@@ -11,6 +13,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/contact"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	"github.com/datumforge/datum/pkg/datumclient"
 	"github.com/datumforge/datum/pkg/enums"
 	"github.com/datumforge/datum/pkg/rout"
@@ -557,3 +561,325 @@ func (suite *GraphTestSuite) TestMutationDeleteContact() {
 		})
 	}
 }
+
+func (suite *GraphTestSuite) TestMutationUpdateBulkContact() {
+	t := suite.T()
+
+	// setup user context
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	contact1 := (&ContactBuilder{client: suite.client}).MustNew(reqCtx, t)
+	contact2 := (&ContactBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	unknownID := ulids.New().String()
+
+	testCases := []struct {
+		name             string
+		ids              []string
+		mode             *datumclient.BulkMode
+		status           enums.UserStatus
+		expectedUpdated  []string
+		expectedErrorRow int64
+	}{
+		{
+			name:            "happy path, update status",
+			ids:             []string{contact1.ID, contact2.ID},
+			status:          enums.UserStatusInactive,
+			expectedUpdated: []string{contact1.ID, contact2.ID},
+		},
+		{
+			name:             "unknown contact, all or nothing",
+			ids:              []string{contact1.ID, unknownID},
+			status:           enums.UserStatusSuspended,
+			expectedErrorRow: 2,
+		},
+		{
+			name:             "unknown contact, best effort",
+			ids:              []string{unknownID, contact2.ID},
+			mode:             lo.ToPtr(datumclient.BulkModeBestEffort),
+			status:           enums.UserStatusDeactivated,
+			expectedUpdated:  []string{contact2.ID},
+			expectedErrorRow: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Update "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			// check for edit permissions on the organization
+			mock_fga.CheckAny(t, suite.client.fga, true)
+
+			resp, err := suite.client.datum.UpdateBulkContact(reqCtx, tc.ids, datumclient.UpdateContactInput{
+				Status: &tc.status,
+			}, tc.mode)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			updated := []string{}
+
+			for _, c := range resp.UpdateBulkContact.Contacts {
+				assert.Equal(t, tc.status, c.Status)
+
+				updated = append(updated, c.ID)
+			}
+
+			assert.ElementsMatch(t, tc.expectedUpdated, updated)
+
+			if tc.expectedErrorRow == 0 {
+				assert.Empty(t, resp.UpdateBulkContact.Errors)
+
+				return
+			}
+
+			require.Len(t, resp.UpdateBulkContact.Errors, 1)
+			assert.Equal(t, tc.expectedErrorRow, resp.UpdateBulkContact.Errors[0].Row)
+			assert.Equal(t, unknownID, *resp.UpdateBulkContact.Errors[0].ID)
+			assert.Contains(t, resp.UpdateBulkContact.Errors[0].Message, "contact not found")
+
+			// the contacts that were not updated keep their status
+			for _, id := range tc.ids {
+				if id == unknownID || lo.Contains(tc.expectedUpdated, id) {
+					continue
+				}
+
+				c, err := suite.client.db.Contact.Get(privacy.DecisionContext(reqCtx, privacy.Allow), id)
+				require.NoError(t, err)
+				assert.NotEqual(t, tc.status, c.Status)
+			}
+		})
+	}
+}
+
+func (suite *GraphTestSuite) TestMutationUpsertBulkCSVContact() {
+	t := suite.T()
+
+	// setup user context
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	byKey := (&ContactBuilder{client: suite.client, Email: "rhaenyra@targaryen.net"}).MustNew(reqCtx, t)
+	byID := (&ContactBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	testCases := []struct {
+		name        string
+		csv         string
+		keys        []string
+		expected    map[string]string
+		errorRow    int64
+		expectedErr string
+	}{
+		{
+			name: "happy path, update by id and key, create new",
+			csv: "ID,FullName,Email,Status\n" +
+				byID.ID + ",Daemon Targaryen,,\n" +
+				",Rhaenyra Targaryen,rhaenyra@targaryen.net,INACTIVE\n" +
+				",Corlys Velaryon,corlys@velaryon.net,\n",
+			keys: []string{"Email"},
+			expected: map[string]string{
+				"Daemon Targaryen":   byID.ID,
+				"Rhaenyra Targaryen": byKey.ID,
+				"Corlys Velaryon":    "",
+			},
+		},
+		{
+			name: "missing key value, nothing is changed",
+			csv: "FullName,Email\n" +
+				"Laena Velaryon,laena@velaryon.net\n" +
+				"Laenor Velaryon,\n",
+			keys:     []string{"Email"},
+			errorRow: 2,
+		},
+		{
+			name:        "invalid key",
+			csv:         "FullName,Email\nLaena Velaryon,laena@velaryon.net\n",
+			keys:        []string{"Dragon"},
+			expectedErr: "invalid key column",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Upsert "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			if tc.expectedErr == "" {
+				mock_fga.CheckAny(t, suite.client.fga, true)
+			}
+
+			upload := graphql.Upload{
+				File:        strings.NewReader(tc.csv),
+				Filename:    "contacts.csv",
+				Size:        int64(len(tc.csv)),
+				ContentType: "text/csv",
+			}
+
+			resp, err := suite.client.datum.UpsertBulkCSVContact(reqCtx, upload, tc.keys, nil)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.expectedErr)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			if tc.errorRow != 0 {
+				require.Len(t, resp.UpsertBulkCSVContact.Errors, 1)
+				assert.Equal(t, tc.errorRow, resp.UpsertBulkCSVContact.Errors[0].Row)
+				assert.Contains(t, resp.UpsertBulkCSVContact.Errors[0].Message, "missing value for key column")
+				assert.Empty(t, resp.UpsertBulkCSVContact.Contacts)
+
+				return
+			}
+
+			assert.Empty(t, resp.UpsertBulkCSVContact.Errors)
+			require.Len(t, resp.UpsertBulkCSVContact.Contacts, len(tc.expected))
+
+			for _, c := range resp.UpsertBulkCSVContact.Contacts {
+				id, ok := tc.expected[c.FullName]
+				require.True(t, ok)
+
+				if id != "" {
+					assert.Equal(t, id, c.ID)
+				}
+			}
+
+			// empty cells do not change the existing values
+			contact, err := suite.client.db.Contact.Get(privacy.DecisionContext(reqCtx, privacy.Allow), byID.ID)
+			require.NoError(t, err)
+			assert.Equal(t, byID.Email, contact.Email)
+			assert.Equal(t, byID.Status, contact.Status)
+
+			contact, err = suite.client.db.Contact.Get(privacy.DecisionContext(reqCtx, privacy.Allow), byKey.ID)
+			require.NoError(t, err)
+			assert.Equal(t, enums.UserStatusInactive, contact.Status)
+		})
+	}
+}
+
+func (suite *GraphTestSuite) TestMutationImportBulkCSVContact() {
+	t := suite.T()
+
+	// setup user context
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	// an export of another crm, the address of the first row spans two lines
+	export := "Full Name,E-mail,Lead Status,Company,Mailing Address,Owner Name\n" +
+		"Jaehaerys Targaryen,jaehaerys@targaryen.net,ACTIVE,House Targaryen,\"Red Keep\nKing's Landing\",Alysanne\n" +
+		"Aegon Targaryen,not-an-email,,,,Alysanne\n" +
+		"Baelon Targaryen,baelon@targaryen.net,INACTIVE,,,\n" +
+		"Viserys Targaryen,viserys@targaryen.net\n"
+
+	mapping := []*datumclient.CSVColumnMapping{
+		{Column: "Lead Status", Field: "status"},
+		{Column: "Mailing Address", Field: "address"},
+	}
+
+	testCases := []struct {
+		name          string
+		mapping       []*datumclient.CSVColumnMapping
+		dryRun        bool
+		mode          *datumclient.BulkMode
+		expectedSaved bool
+		expectedNames []string
+		expectedErr   string
+	}{
+		{
+			name:          "dry run, nothing is saved",
+			mapping:       mapping,
+			dryRun:        true,
+			mode:          lo.ToPtr(datumclient.BulkModeBestEffort),
+			expectedNames: []string{"Jaehaerys Targaryen", "Baelon Targaryen"},
+		},
+		{
+			name:    "all or nothing, nothing is saved",
+			mapping: mapping,
+		},
+		{
+			name:          "happy path, best effort",
+			mapping:       mapping,
+			mode:          lo.ToPtr(datumclient.BulkModeBestEffort),
+			expectedSaved: true,
+			expectedNames: []string{"Jaehaerys Targaryen", "Baelon Targaryen"},
+		},
+		{
+			name:        "mapping column not in file",
+			mapping:     []*datumclient.CSVColumnMapping{{Column: "Sigil", Field: "title"}},
+			expectedErr: "invalid column mapping: Sigil is not a column of the file",
+		},
+		{
+			name:        "mapping field not in input",
+			mapping:     []*datumclient.CSVColumnMapping{{Column: "Owner Name", Field: "sigil"}},
+			expectedErr: "invalid column mapping: sigil is not a field of the input",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Import "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			if tc.expectedErr == "" {
+				mock_fga.CheckAny(t, suite.client.fga, true)
+			}
+
+			upload := graphql.Upload{
+				File:        strings.NewReader(export),
+				Filename:    "export.csv",
+				Size:        int64(len(export)),
+				ContentType: "text/csv",
+			}
+
+			resp, err := suite.client.datum.ImportBulkCSVContact(reqCtx, upload, tc.mapping, &tc.dryRun, tc.mode)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.expectedErr)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, tc.dryRun, resp.ImportBulkCSVContact.DryRun)
+
+			names := []string{}
+			for _, c := range resp.ImportBulkCSVContact.Contacts {
+				names = append(names, c.FullName)
+			}
+
+			assert.ElementsMatch(t, tc.expectedNames, names)
+
+			// the invalid rows are reported with their line in the file
+			require.Len(t, resp.ImportBulkCSVContact.Errors, 2)
+
+			assert.Equal(t, int64(2), resp.ImportBulkCSVContact.Errors[0].Row)
+			assert.Equal(t, int64(4), *resp.ImportBulkCSVContact.Errors[0].Line)
+			assert.Contains(t, resp.ImportBulkCSVContact.Errors[0].Message, "email")
+
+			assert.Equal(t, int64(4), resp.ImportBulkCSVContact.Errors[1].Row)
+			assert.Equal(t, int64(6), *resp.ImportBulkCSVContact.Errors[1].Line)
+			assert.Contains(t, resp.ImportBulkCSVContact.Errors[1].Message, "expected 6 fields, got 2")
+
+			saved, err := suite.client.db.Contact.Query().Where(contact.FullName("Jaehaerys Targaryen")).All(allowCtx)
+			require.NoError(t, err)
+
+			if !tc.expectedSaved {
+				assert.Empty(t, saved)
+
+				return
+			}
+
+			require.Len(t, saved, 1)
+			assert.Equal(t, "jaehaerys@targaryen.net", saved[0].Email)
+			assert.Equal(t, "Red Keep\nKing's Landing", saved[0].Address)
+			assert.Equal(t, "House Targaryen", saved[0].Company)
+			assert.Equal(t, enums.UserStatusActive, saved[0].Status)
+		})
+	}
+}
@@ -89,6 +89,21 @@ func (r *mutationResolver) DeleteDocumentData(ctx context.Context, id string) (*
 	}, nil
 }
 
+// UpdateBulkDocumentData is the resolver for the updateBulkDocumentData field.
+func (r *mutationResolver) UpdateBulkDocumentData(ctx context.Context, ids []string, input generated.UpdateDocumentDataInput, mode *BulkMode) (*DocumentDataBulkUpdatePayload, error) {
+	return r.bulkUpdateDocumentData(ctx, ids, input, mode)
+}
+
+// DeleteBulkDocumentData is the resolver for the deleteBulkDocumentData field.
+func (r *mutationResolver) DeleteBulkDocumentData(ctx context.Context, ids []string, mode *BulkMode) (*DocumentDataBulkDeletePayload, error) {
+	return r.bulkDeleteDocumentData(ctx, ids, mode)
+}
+
+// UpsertBulkCSVDocumentData is the resolver for the upsertBulkCSVDocumentData field.
+func (r *mutationResolver) UpsertBulkCSVDocumentData(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*DocumentDataBulkUpsertPayload, error) {
+	return r.bulkUpsertDocumentData(ctx, input, keys, mode)
+}
+
 // DocumentData is the resolver for the documentData field.
 func (r *queryResolver) DocumentData(ctx context.Context, id string) (*generated.DocumentData, error) {
 	res, err := withTransactionalMutation(ctx).DocumentData.Get(ctx, id)
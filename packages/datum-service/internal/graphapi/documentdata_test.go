@@ -0,0 +1,358 @@
+package graphapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/Yamashou/gqlgenc/clientv2"
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/graphapi"
+	"github.com/datumforge/datum/pkg/datumclient"
+	"github.com/datumforge/datum/pkg/objects"
+)
+
+// contractSchema is the schema of the templates used to test the validation of documents
+var contractSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"name": map[string]any{"type": "string", "minLength": 1},
+		"term": map[string]any{"type": "integer", "minimum": 1},
+		"party": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"email": map[string]any{"type": "string", "format": "email"},
+			},
+		},
+	},
+	"required": []any{"name"},
+}
+
+// requireSchemaViolations asserts the error of the request contains the code and the paths of the violations
+func requireSchemaViolations(t *testing.T, err error, code string, paths ...string) {
+	t.Helper()
+
+	var errResp *clientv2.ErrorResponse
+
+	require.ErrorAs(t, err, &errResp)
+	require.NotNil(t, errResp.GqlErrors)
+	require.Len(t, *errResp.GqlErrors, 1)
+
+	ext := (*errResp.GqlErrors)[0].Extensions
+	assert.Equal(t, code, ext["code"])
+
+	fields, ok := ext["fields"].([]interface{})
+	require.True(t, ok)
+
+	actual := []string{}
+
+	for _, f := range fields {
+		actual = append(actual, f.(map[string]interface{})["path"].(string))
+	}
+
+	assert.Equal(t, paths, actual)
+}
+
+func (suite *GraphTestSuite) TestMutationCreateDocumentDataValidation() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	template := (&TemplateBuilder{client: suite.client, Jsonconfig: contractSchema}).MustNew(reqCtx, t)
+
+	testCases := []struct {
+		name          string
+		data          map[string]any
+		expectedPaths []string
+	}{
+		{
+			name: "happy path",
+			data: map[string]any{"name": "MITB", "term": 12, "party": map[string]any{"email": "mitb@datum.net"}},
+		},
+		{
+			name:          "missing required field",
+			data:          map[string]any{"term": 12},
+			expectedPaths: []string{""},
+		},
+		{
+			name:          "invalid fields",
+			data:          map[string]any{"name": "MITB", "term": 0, "party": map[string]any{"email": "meow"}},
+			expectedPaths: []string{"/party/email", "/term"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Create "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			mock_fga.CheckAny(t, suite.client.fga, true)
+
+			data, err := json.Marshal(tc.data)
+			require.NoError(t, err)
+
+			resp, err := suite.client.datum.CreateDocumentData(reqCtx, datumclient.CreateDocumentDataInput{
+				TemplateID: template.ID,
+				Data:       data,
+			})
+
+			if tc.expectedPaths != nil {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				assert.ErrorContains(t, err, "document data does not conform to the template schema")
+
+				requireSchemaViolations(t, err, graphapi.ErrCodeInvalidDocumentData, tc.expectedPaths...)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, template.ID, resp.CreateDocumentData.DocumentData.TemplateID)
+		})
+	}
+}
+
+func (suite *GraphTestSuite) TestMutationUpdateDocumentDataValidation() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	template := (&TemplateBuilder{client: suite.client}).MustNew(reqCtx, t)
+	strict := (&TemplateBuilder{client: suite.client, Jsonconfig: contractSchema}).MustNew(reqCtx, t)
+
+	doc := (&DocumentDataBuilder{client: suite.client, TemplateID: template.ID, Data: map[string]any{"title": "nda"}}).MustNew(reqCtx, t)
+
+	testCases := []struct {
+		name          string
+		request       datumclient.UpdateDocumentDataInput
+		expectedPaths []string
+	}{
+		{
+			name: "invalid data for the current template",
+			request: datumclient.UpdateDocumentDataInput{
+				Data: json.RawMessage(`{"name": 1}`),
+			},
+			expectedPaths: []string{"/name"},
+		},
+		{
+			name: "current data is checked against the new template",
+			request: datumclient.UpdateDocumentDataInput{
+				TemplateID: &strict.ID,
+			},
+			expectedPaths: []string{""},
+		},
+		{
+			name: "happy path, new template and data",
+			request: datumclient.UpdateDocumentDataInput{
+				TemplateID: &strict.ID,
+				Data:       json.RawMessage(`{"name": "MITB", "term": 24}`),
+			},
+		},
+		{
+			name: "happy path, changes not validated by the schema",
+			request: datumclient.UpdateDocumentDataInput{
+				AppendTags: []string{"signed"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Update "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			mock_fga.CheckAny(t, suite.client.fga, true)
+
+			resp, err := suite.client.datum.UpdateDocumentData(reqCtx, doc.ID, tc.request)
+
+			if tc.expectedPaths != nil {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+
+				requireSchemaViolations(t, err, graphapi.ErrCodeInvalidDocumentData, tc.expectedPaths...)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+		})
+	}
+}
+
+func (suite *GraphTestSuite) TestMutationTemplateSchemaValidation() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	template := (&TemplateBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	t.Run("Create invalid json schema", func(t *testing.T) {
+		defer mock_fga.ClearMocks(suite.client.fga)
+
+		mock_fga.CheckAny(t, suite.client.fga, true)
+
+		resp, err := suite.client.datum.CreateTemplate(reqCtx, datumclient.CreateTemplateInput{
+			Name:       "invalid",
+			Jsonconfig: json.RawMessage(`{"minProperties": "two"}`),
+		})
+
+		require.Error(t, err)
+		assert.Nil(t, resp)
+		assert.ErrorContains(t, err, "template jsonconfig is not a valid json schema")
+
+		requireSchemaViolations(t, err, graphapi.ErrCodeInvalidTemplateSchema, "/minProperties")
+	})
+
+	t.Run("Update invalid json schema", func(t *testing.T) {
+		defer mock_fga.ClearMocks(suite.client.fga)
+
+		mock_fga.CheckAny(t, suite.client.fga, true)
+
+		resp, err := suite.client.datum.UpdateTemplate(reqCtx, template.ID, datumclient.UpdateTemplateInput{
+			Jsonconfig: json.RawMessage(`{"properties": {"name": {"minLength": -1}}}`),
+		})
+
+		require.Error(t, err)
+		assert.Nil(t, resp)
+
+		requireSchemaViolations(t, err, graphapi.ErrCodeInvalidTemplateSchema, "/properties/name/minLength")
+	})
+
+	t.Run("Update happy path", func(t *testing.T) {
+		defer mock_fga.ClearMocks(suite.client.fga)
+
+		mock_fga.CheckAny(t, suite.client.fga, true)
+
+		resp, err := suite.client.datum.UpdateTemplate(reqCtx, template.ID, datumclient.UpdateTemplateInput{
+			Description: lo.ToPtr("contract template"),
+			Jsonconfig:  json.RawMessage(`{"type": "object", "required": ["name"]}`),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+	})
+}
+
+func (suite *GraphTestSuite) TestMutationRenderDocumentData() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	template := (&TemplateBuilder{client: suite.client, Jsonconfig: contractSchema}).MustNew(reqCtx, t)
+	doc := (&DocumentDataBuilder{client: suite.client, TemplateID: template.ID, Data: map[string]any{"name": "MITB", "term": 12}}).MustNew(reqCtx, t)
+
+	// the document is rendered with the template version it was created with, not the current template
+	err = suite.client.db.Template.UpdateOne(template).
+		SetJsonconfig(map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"name": map[string]any{"type": "string", "title": "Legal name"},
+			},
+		}).
+		Exec(privacy.DecisionContext(reqCtx, privacy.Allow))
+	require.NoError(t, err)
+
+	store, err := objects.New(context.Background(), objects.Config{
+		Provider: objects.ProviderDisk,
+		Disk: objects.DiskConfig{
+			Path: t.TempDir(),
+		},
+	})
+	require.NoError(t, err)
+
+	defer store.Close()
+
+	testCases := []struct {
+		name              string
+		id                string
+		format            *datumclient.DocumentRenderFormat
+		storageDisabled   bool
+		checked           bool
+		expectedExtension string
+		expectedBody      string
+		expectedErr       string
+	}{
+		{
+			name:              "happy path, pdf by default",
+			id:                doc.ID,
+			checked:           true,
+			expectedExtension: "pdf",
+			expectedBody:      "%PDF-",
+		},
+		{
+			name:              "happy path, html",
+			id:                doc.ID,
+			format:            lo.ToPtr(datumclient.DocumentRenderFormatHTML),
+			checked:           true,
+			expectedExtension: "html",
+			expectedBody:      `<span class="label">Name</span><span class="value">MITB</span>`,
+		},
+		{
+			name:        "document not found",
+			id:          "notfound",
+			expectedErr: "not found",
+		},
+		{
+			name:            "storage not enabled",
+			id:              doc.ID,
+			storageDisabled: true,
+			expectedErr:     graphapi.ErrObjectStorageNotEnabled.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Render "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			// documents that do not exist are not checked
+			if tc.checked {
+				mock_fga.CheckAny(t, suite.client.fga, true)
+			}
+
+			if !tc.storageDisabled {
+				suite.client.db.ObjectStorage = store
+
+				defer func() { suite.client.db.ObjectStorage = nil }()
+			}
+
+			resp, err := suite.client.datum.RenderDocumentData(reqCtx, tc.id, tc.format)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				assert.ErrorContains(t, err, tc.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			f := resp.RenderDocumentData.File
+			assert.Equal(t, doc.ID+"."+tc.expectedExtension, f.FileName)
+			assert.Equal(t, tc.expectedExtension, f.FileExtension)
+			assert.Equal(t, lo.ToPtr("rendered_document"), f.Category)
+			require.Len(t, f.Organization, 1)
+			assert.Equal(t, testOrgID, f.Organization[0].ID)
+
+			obj, err := store.Download(context.Background(), f.StoreKey)
+			require.NoError(t, err)
+
+			defer obj.Close()
+
+			body, err := io.ReadAll(obj)
+			require.NoError(t, err)
+
+			assert.Equal(t, int64(len(body)), *f.FileSize)
+			assert.Contains(t, string(body), tc.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/datumforge/datum/pkg/templates"
+	"github.com/samber/lo"
+)
+
+// RenderDocumentData is the resolver for the renderDocumentData field.
+func (r *mutationResolver) RenderDocumentData(ctx context.Context, id string, format *DocumentRenderFormat) (*FileCreatePayload, error) {
+	f := templates.Format(lo.FromPtrOr(format, DocumentRenderFormatPDF))
+
+	res, err := renderDocumentData(ctx, withTransactionalMutation(ctx), id, f)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "file"}, r.logger)
+	}
+
+	return &FileCreatePayload{
+		File: res,
+	}, nil
+}
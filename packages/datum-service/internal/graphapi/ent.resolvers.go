@@ -349,6 +349,18 @@ func (r *queryResolver) TemplateHistories(ctx context.Context, after *entgql.Cur
 	return withTransactionalMutation(ctx).TemplateHistory.Query().Paginate(ctx, after, first, before, last, generated.WithTemplateHistoryOrder(orderBy), generated.WithTemplateHistoryFilter(where.Filter))
 }
 
+// TemplateVersions is the resolver for the templateVersions field.
+func (r *queryResolver) TemplateVersions(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *generated.TemplateVersionOrder, where *generated.TemplateVersionWhereInput) (*generated.TemplateVersionConnection, error) {
+	return withTransactionalMutation(ctx).TemplateVersion.Query().Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		generated.WithTemplateVersionOrder(orderBy),
+		generated.WithTemplateVersionFilter(where.Filter))
+}
+
 // Users is the resolver for the users field.
 func (r *queryResolver) Users(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *generated.UserOrder, where *generated.UserWhereInput) (*generated.UserConnection, error) {
 	return withTransactionalMutation(ctx).User.Query().Paginate(ctx, after, first, before, last, generated.WithUserOrder(orderBy), generated.WithUserFilter(where.Filter))
@@ -374,6 +386,18 @@ func (r *queryResolver) Webhooks(ctx context.Context, after *entgql.Cursor[strin
 	return withTransactionalMutation(ctx).Webhook.Query().Paginate(ctx, after, first, before, last, generated.WithWebhookOrder(orderBy), generated.WithWebhookFilter(where.Filter))
 }
 
+// WebhookDeliveries is the resolver for the webhookDeliveries field.
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *generated.WebhookDeliveryOrder, where *generated.WebhookDeliveryWhereInput) (*generated.WebhookDeliveryConnection, error) {
+	return withTransactionalMutation(ctx).WebhookDelivery.Query().Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		generated.WithWebhookDeliveryOrder(orderBy),
+		generated.WithWebhookDeliveryFilter(where.Filter))
+}
+
 // WebhookHistories is the resolver for the webhookHistories field.
 func (r *queryResolver) WebhookHistories(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *generated.WebhookHistoryOrder, where *generated.WebhookHistoryWhereInput) (*generated.WebhookHistoryConnection, error) {
 	return withTransactionalMutation(ctx).WebhookHistory.Query().Paginate(ctx, after, first, before, last, generated.WithWebhookHistoryOrder(orderBy), generated.WithWebhookHistoryFilter(where.Filter))
@@ -382,6 +406,9 @@ func (r *queryResolver) WebhookHistories(ctx context.Context, after *entgql.Curs
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// TFASetting returns TFASettingResolver implementation.
+func (r *Resolver) TFASetting() TFASettingResolver { return &tFASettingResolver{r} }
+
 // CreateEntityInput returns CreateEntityInputResolver implementation.
 func (r *Resolver) CreateEntityInput() CreateEntityInputResolver {
 	return &createEntityInputResolver{r}
@@ -414,6 +441,7 @@ func (r *Resolver) UpdateTFASettingInput() UpdateTFASettingInputResolver {
 }
 
 type queryResolver struct{ *Resolver }
+type tFASettingResolver struct{ *Resolver }
 type createEntityInputResolver struct{ *Resolver }
 type createGroupInputResolver struct{ *Resolver }
 type createOrganizationInputResolver struct{ *Resolver }
@@ -89,6 +89,21 @@ func (r *mutationResolver) DeleteEntitlement(ctx context.Context, id string) (*E
 	}, nil
 }
 
+// UpdateBulkEntitlement is the resolver for the updateBulkEntitlement field.
+func (r *mutationResolver) UpdateBulkEntitlement(ctx context.Context, ids []string, input generated.UpdateEntitlementInput, mode *BulkMode) (*EntitlementBulkUpdatePayload, error) {
+	return r.bulkUpdateEntitlement(ctx, ids, input, mode)
+}
+
+// DeleteBulkEntitlement is the resolver for the deleteBulkEntitlement field.
+func (r *mutationResolver) DeleteBulkEntitlement(ctx context.Context, ids []string, mode *BulkMode) (*EntitlementBulkDeletePayload, error) {
+	return r.bulkDeleteEntitlement(ctx, ids, mode)
+}
+
+// UpsertBulkCSVEntitlement is the resolver for the upsertBulkCSVEntitlement field.
+func (r *mutationResolver) UpsertBulkCSVEntitlement(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*EntitlementBulkUpsertPayload, error) {
+	return r.bulkUpsertEntitlement(ctx, input, keys, mode)
+}
+
 // Entitlement is the resolver for the entitlement field.
 func (r *queryResolver) Entitlement(ctx context.Context, id string) (*generated.Entitlement, error) {
 	res, err := withTransactionalMutation(ctx).Entitlement.Get(ctx, id)
@@ -89,6 +89,21 @@ func (r *mutationResolver) DeleteEntitlementPlan(ctx context.Context, id string)
 	}, nil
 }
 
+// UpdateBulkEntitlementPlan is the resolver for the updateBulkEntitlementPlan field.
+func (r *mutationResolver) UpdateBulkEntitlementPlan(ctx context.Context, ids []string, input generated.UpdateEntitlementPlanInput, mode *BulkMode) (*EntitlementPlanBulkUpdatePayload, error) {
+	return r.bulkUpdateEntitlementPlan(ctx, ids, input, mode)
+}
+
+// DeleteBulkEntitlementPlan is the resolver for the deleteBulkEntitlementPlan field.
+func (r *mutationResolver) DeleteBulkEntitlementPlan(ctx context.Context, ids []string, mode *BulkMode) (*EntitlementPlanBulkDeletePayload, error) {
+	return r.bulkDeleteEntitlementPlan(ctx, ids, mode)
+}
+
+// UpsertBulkCSVEntitlementPlan is the resolver for the upsertBulkCSVEntitlementPlan field.
+func (r *mutationResolver) UpsertBulkCSVEntitlementPlan(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*EntitlementPlanBulkUpsertPayload, error) {
+	return r.bulkUpsertEntitlementPlan(ctx, input, keys, mode)
+}
+
 // EntitlementPlan is the resolver for the entitlementPlan field.
 func (r *queryResolver) EntitlementPlan(ctx context.Context, id string) (*generated.EntitlementPlan, error) {
 	res, err := withTransactionalMutation(ctx).EntitlementPlan.Get(ctx, id)
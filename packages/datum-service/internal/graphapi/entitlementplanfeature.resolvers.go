@@ -89,6 +89,21 @@ func (r *mutationResolver) DeleteEntitlementPlanFeature(ctx context.Context, id
 	}, nil
 }
 
+// UpdateBulkEntitlementPlanFeature is the resolver for the updateBulkEntitlementPlanFeature field.
+func (r *mutationResolver) UpdateBulkEntitlementPlanFeature(ctx context.Context, ids []string, input generated.UpdateEntitlementPlanFeatureInput, mode *BulkMode) (*EntitlementPlanFeatureBulkUpdatePayload, error) {
+	return r.bulkUpdateEntitlementPlanFeature(ctx, ids, input, mode)
+}
+
+// DeleteBulkEntitlementPlanFeature is the resolver for the deleteBulkEntitlementPlanFeature field.
+func (r *mutationResolver) DeleteBulkEntitlementPlanFeature(ctx context.Context, ids []string, mode *BulkMode) (*EntitlementPlanFeatureBulkDeletePayload, error) {
+	return r.bulkDeleteEntitlementPlanFeature(ctx, ids, mode)
+}
+
+// UpsertBulkCSVEntitlementPlanFeature is the resolver for the upsertBulkCSVEntitlementPlanFeature field.
+func (r *mutationResolver) UpsertBulkCSVEntitlementPlanFeature(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*EntitlementPlanFeatureBulkUpsertPayload, error) {
+	return r.bulkUpsertEntitlementPlanFeature(ctx, input, keys, mode)
+}
+
 // EntitlementPlanFeature is the resolver for the entitlementPlanFeature field.
 func (r *queryResolver) EntitlementPlanFeature(ctx context.Context, id string) (*generated.EntitlementPlanFeature, error) {
 	res, err := withTransactionalMutation(ctx).EntitlementPlanFeature.Get(ctx, id)
@@ -89,6 +89,26 @@ func (r *mutationResolver) DeleteEntity(ctx context.Context, id string) (*Entity
 	}, nil
 }
 
+// UpdateBulkEntity is the resolver for the updateBulkEntity field.
+func (r *mutationResolver) UpdateBulkEntity(ctx context.Context, ids []string, input generated.UpdateEntityInput, mode *BulkMode) (*EntityBulkUpdatePayload, error) {
+	return r.bulkUpdateEntity(ctx, ids, input, mode)
+}
+
+// DeleteBulkEntity is the resolver for the deleteBulkEntity field.
+func (r *mutationResolver) DeleteBulkEntity(ctx context.Context, ids []string, mode *BulkMode) (*EntityBulkDeletePayload, error) {
+	return r.bulkDeleteEntity(ctx, ids, mode)
+}
+
+// UpsertBulkCSVEntity is the resolver for the upsertBulkCSVEntity field.
+func (r *mutationResolver) UpsertBulkCSVEntity(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*EntityBulkUpsertPayload, error) {
+	return r.bulkUpsertEntity(ctx, input, keys, mode)
+}
+
+// ImportBulkCSVEntity is the resolver for the importBulkCSVEntity field.
+func (r *mutationResolver) ImportBulkCSVEntity(ctx context.Context, input graphql.Upload, mapping []*CSVColumnMapping, dryRun *bool, mode *BulkMode) (*EntityBulkImportPayload, error) {
+	return r.bulkImportEntity(ctx, input, mapping, dryRun, mode)
+}
+
 // Entity is the resolver for the entity field.
 func (r *queryResolver) Entity(ctx context.Context, id string) (*generated.Entity, error) {
 	res, err := withTransactionalMutation(ctx).Entity.Get(ctx, id)
@@ -2,14 +2,18 @@ package graphapi_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/99designs/gqlgen/graphql"
 	mock_fga "github.com/datumforge/fgax/mockery"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/entity"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	"github.com/datumforge/datum/pkg/datumclient"
 	"github.com/datumforge/datum/pkg/utils/ulids"
 )
@@ -534,3 +538,177 @@ func (suite *GraphTestSuite) TestMutationDeleteEntity() {
 		})
 	}
 }
+
+func (suite *GraphTestSuite) TestMutationDeleteBulkEntity() {
+	t := suite.T()
+
+	// setup user context
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	entity1 := (&EntityBuilder{client: suite.client}).MustNew(reqCtx, t)
+	entity2 := (&EntityBuilder{client: suite.client}).MustNew(reqCtx, t)
+	entity3 := (&EntityBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	unknownID := ulids.New().String()
+
+	testCases := []struct {
+		name            string
+		ids             []string
+		mode            *datumclient.BulkMode
+		allowed         bool
+		expectedDeleted []string
+		expectedErrRows []int64
+		expectedErr     string
+	}{
+		{
+			name:            "not allowed to delete",
+			ids:             []string{entity1.ID, entity2.ID},
+			allowed:         false,
+			expectedErrRows: []int64{1, 2},
+			expectedErr:     "you are not authorized to perform this action: delete on entity",
+		},
+		{
+			name:            "unknown entity, all or nothing",
+			ids:             []string{entity1.ID, unknownID},
+			allowed:         true,
+			expectedErrRows: []int64{2},
+			expectedErr:     "entity not found",
+		},
+		{
+			name:            "unknown entity, best effort",
+			ids:             []string{entity1.ID, unknownID},
+			mode:            lo.ToPtr(datumclient.BulkModeBestEffort),
+			allowed:         true,
+			expectedDeleted: []string{entity1.ID},
+			expectedErrRows: []int64{2},
+			expectedErr:     "entity not found",
+		},
+		{
+			name:            "happy path, delete entities",
+			ids:             []string{entity2.ID, entity3.ID},
+			allowed:         true,
+			expectedDeleted: []string{entity2.ID, entity3.ID},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Delete "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			// check for delete permissions on the organization
+			mock_fga.CheckAny(t, suite.client.fga, tc.allowed)
+
+			resp, err := suite.client.datum.DeleteBulkEntity(reqCtx, tc.ids, tc.mode)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			assert.ElementsMatch(t, tc.expectedDeleted, resp.DeleteBulkEntity.DeletedIDs)
+
+			var errRows []int64
+
+			for _, e := range resp.DeleteBulkEntity.Errors {
+				assert.Contains(t, e.Message, tc.expectedErr)
+
+				errRows = append(errRows, e.Row)
+			}
+
+			assert.Equal(t, tc.expectedErrRows, errRows)
+
+			// rows that were undone or failed are not deleted
+			allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+			for _, id := range tc.ids {
+				_, err := suite.client.db.Entity.Get(allowCtx, id)
+
+				if id == unknownID || lo.Contains(tc.expectedDeleted, id) {
+					assert.True(t, ent.IsNotFound(err))
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+		})
+	}
+}
+
+func (suite *GraphTestSuite) TestMutationImportBulkCSVEntity() {
+	t := suite.T()
+
+	// setup user context
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	export := "Company Name,Display Name,About\n" +
+		"blackwater-holdings,Blackwater Holdings,river trade\n" +
+		",,no name at all\n" +
+		"kingswood-supply,,\n"
+
+	testCases := []struct {
+		name          string
+		dryRun        bool
+		expectedNames []string
+	}{
+		{
+			name:          "dry run",
+			dryRun:        true,
+			expectedNames: []string{"blackwater-holdings", "kingswood-supply"},
+		},
+		{
+			name:          "happy path",
+			expectedNames: []string{"blackwater-holdings", "kingswood-supply"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Import "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			mock_fga.CheckAny(t, suite.client.fga, true)
+
+			upload := graphql.Upload{
+				File:        strings.NewReader(export),
+				Filename:    "companies.csv",
+				Size:        int64(len(export)),
+				ContentType: "text/csv",
+			}
+
+			// display name is matched to the field without a mapping
+			mapping := []*datumclient.CSVColumnMapping{
+				{Column: "Company Name", Field: "name"},
+				{Column: "About", Field: "description"},
+			}
+
+			resp, err := suite.client.datum.ImportBulkCSVEntity(reqCtx, upload, mapping, &tc.dryRun, lo.ToPtr(datumclient.BulkModeBestEffort))
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			assert.Equal(t, tc.dryRun, resp.ImportBulkCSVEntity.DryRun)
+
+			names := []string{}
+			for _, e := range resp.ImportBulkCSVEntity.Entities {
+				names = append(names, *e.Name)
+			}
+
+			assert.ElementsMatch(t, tc.expectedNames, names)
+
+			require.Len(t, resp.ImportBulkCSVEntity.Errors, 1)
+			assert.Equal(t, int64(2), resp.ImportBulkCSVEntity.Errors[0].Row)
+			assert.Equal(t, int64(3), *resp.ImportBulkCSVEntity.Errors[0].Line)
+
+			allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+			saved, err := suite.client.db.Entity.Query().Where(entity.Name("blackwater-holdings")).All(allowCtx)
+			require.NoError(t, err)
+
+			if tc.dryRun {
+				assert.Empty(t, saved)
+
+				return
+			}
+
+			require.Len(t, saved, 1)
+			assert.Equal(t, "Blackwater Holdings", saved[0].DisplayName)
+			assert.Equal(t, "river trade", saved[0].Description)
+		})
+	}
+}
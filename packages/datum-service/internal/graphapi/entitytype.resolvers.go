@@ -89,6 +89,21 @@ func (r *mutationResolver) DeleteEntityType(ctx context.Context, id string) (*En
 	}, nil
 }
 
+// UpdateBulkEntityType is the resolver for the updateBulkEntityType field.
+func (r *mutationResolver) UpdateBulkEntityType(ctx context.Context, ids []string, input generated.UpdateEntityTypeInput, mode *BulkMode) (*EntityTypeBulkUpdatePayload, error) {
+	return r.bulkUpdateEntityType(ctx, ids, input, mode)
+}
+
+// DeleteBulkEntityType is the resolver for the deleteBulkEntityType field.
+func (r *mutationResolver) DeleteBulkEntityType(ctx context.Context, ids []string, mode *BulkMode) (*EntityTypeBulkDeletePayload, error) {
+	return r.bulkDeleteEntityType(ctx, ids, mode)
+}
+
+// UpsertBulkCSVEntityType is the resolver for the upsertBulkCSVEntityType field.
+func (r *mutationResolver) UpsertBulkCSVEntityType(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*EntityTypeBulkUpsertPayload, error) {
+	return r.bulkUpsertEntityType(ctx, input, keys, mode)
+}
+
 // EntityType is the resolver for the entityType field.
 func (r *queryResolver) EntityType(ctx context.Context, id string) (*generated.EntityType, error) {
 	res, err := withTransactionalMutation(ctx).EntityType.Get(ctx, id)
@@ -5,10 +5,20 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+
 	"github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	"github.com/datumforge/datum/pkg/rout"
-	"go.uber.org/zap"
+	"github.com/datumforge/datum/pkg/templates"
+)
+
+const (
+	// ErrCodeInvalidDocumentData is the error code of documents that do not conform to their template schema
+	ErrCodeInvalidDocumentData = "INVALID_DOCUMENT_DATA"
+	// ErrCodeInvalidTemplateSchema is the error code of templates that are not a valid JSON Schema document
+	ErrCodeInvalidTemplateSchema = "INVALID_TEMPLATE_SCHEMA"
 )
 
 var (
@@ -26,6 +36,57 @@ var (
 
 	// ErrSearchFailed is returned when the search operation fails
 	ErrSearchFailed = errors.New("search failed, please try again")
+
+	// ErrWebhooksNotEnabled is returned when a webhook delivery is requested but webhook delivery is not configured
+	ErrWebhooksNotEnabled = errors.New("webhook delivery is not enabled")
+
+	// ErrTOTPNotEnabled is returned when a tfa setting is verified but totp is not configured on the server
+	ErrTOTPNotEnabled = errors.New("totp is not enabled")
+
+	// ErrTFAAlreadyVerified is returned when verifying a tfa setting that has already been verified
+	ErrTFAAlreadyVerified = errors.New("tfa setting has already been verified")
+
+	// ErrTFANotVerified is returned when recovery codes are requested for a tfa setting that has not been verified
+	ErrTFANotVerified = errors.New("tfa setting has not been verified")
+
+	// ErrInvalidTOTPCode is returned when the totp code provided to verify the tfa setting is not valid
+	ErrInvalidTOTPCode = errors.New("totp code is invalid")
+
+	// ErrSessionsNotEnabled is returned when sessions are requested but the session store is not configured
+	ErrSessionsNotEnabled = errors.New("sessions are not enabled")
+
+	// ErrSessionNotFound is returned when the session to revoke does not exist or has expired
+	ErrSessionNotFound = errors.New("session not found")
+
+	// ErrSubscriptionsNotEnabled is returned when a subscription is requested but the broker is not configured
+	ErrSubscriptionsNotEnabled = errors.New("subscriptions are not enabled")
+
+	// ErrTemplateNotRoot is returned when cloning a template that is not a root template
+	ErrTemplateNotRoot = errors.New("only root templates can be cloned")
+
+	// ErrObjectStorageNotEnabled is returned when a document is rendered but object storage is not configured
+	ErrObjectStorageNotEnabled = errors.New("file storage is not enabled")
+
+	// ErrBulkTransactionRequired is returned when a bulk mutation is run outside of a transaction
+	ErrBulkTransactionRequired = errors.New("bulk mutations must be run in a transaction")
+
+	// ErrBulkInvalidKey is returned when a key of a csv upsert is not a column of the object or the file
+	ErrBulkInvalidKey = errors.New("invalid key column")
+
+	// ErrBulkMissingKey is returned when a row of a csv upsert has no ID and no value for a key column
+	ErrBulkMissingKey = errors.New("missing value for key column")
+
+	// ErrBulkKeyNotUnique is returned when the key columns of a row of a csv upsert match multiple objects
+	ErrBulkKeyNotUnique = errors.New("key columns match more than one object")
+
+	// ErrBulkEmptyFile is returned when the csv file of a bulk mutation has no header
+	ErrBulkEmptyFile = errors.New("csv file is empty")
+
+	// ErrBulkInvalidRow is returned when a row of a csv file cannot be read or parsed into the input
+	ErrBulkInvalidRow = errors.New("invalid row")
+
+	// ErrBulkInvalidMapping is returned when a column mapping of a csv import does not match the file or the input
+	ErrBulkInvalidMapping = errors.New("invalid column mapping")
 )
 
 // PermissionDeniedError is returned when user is not authorized to perform the requested query or mutation
@@ -92,13 +153,39 @@ func newForeignKeyError(action, objecttype string) *ForeignKeyError {
 	}
 }
 
+// newSchemaViolationError returns the error with the code and the field level violations of the schema in
+// the extensions of the graphql error
+func newSchemaViolationError(err error, code string, fields []templates.FieldError) *gqlerror.Error {
+	return &gqlerror.Error{
+		Err:     err,
+		Message: err.Error(),
+		Extensions: map[string]interface{}{
+			"code":   code,
+			"fields": fields,
+		},
+	}
+}
+
 // parseRequestError logs and parses the error and returns the appropriate error type for the client
 // TODO: cleanup return error messages
 func parseRequestError(err error, a action, logger *zap.SugaredLogger) error {
 	// log the error for debugging
 	logger.Errorw("error processing request", "action", a.action, "object", a.object, "error", err)
 
+	var (
+		dataErr   *templates.ValidationError
+		schemaErr *templates.SchemaError
+	)
+
 	switch {
+	case errors.As(err, &dataErr):
+		logger.Debugw("document data validation error", "error", dataErr.Error())
+
+		return newSchemaViolationError(dataErr, ErrCodeInvalidDocumentData, dataErr.Errors)
+	case errors.As(err, &schemaErr):
+		logger.Debugw("template schema validation error", "error", schemaErr.Error())
+
+		return newSchemaViolationError(schemaErr, ErrCodeInvalidTemplateSchema, schemaErr.Errors)
 	case generated.IsValidationError(err):
 		validationError := err.(*generated.ValidationError)
 
@@ -124,6 +211,10 @@ func parseRequestError(err error, a action, logger *zap.SugaredLogger) error {
 	case generated.IsNotFound(err):
 		logger.Debugw("not found", "error", err.Error())
 
+		return err
+	case errors.Is(err, ErrBulkInvalidRow), errors.Is(err, ErrBulkMissingKey), errors.Is(err, ErrBulkKeyNotUnique):
+		logger.Debugw("invalid bulk row", "error", err.Error())
+
 		return err
 	case errors.Is(err, privacy.Deny):
 		logger.Debugw("permission denied", "error", err.Error())
@@ -89,6 +89,21 @@ func (r *mutationResolver) DeleteFeature(ctx context.Context, id string) (*Featu
 	}, nil
 }
 
+// UpdateBulkFeature is the resolver for the updateBulkFeature field.
+func (r *mutationResolver) UpdateBulkFeature(ctx context.Context, ids []string, input generated.UpdateFeatureInput, mode *BulkMode) (*FeatureBulkUpdatePayload, error) {
+	return r.bulkUpdateFeature(ctx, ids, input, mode)
+}
+
+// DeleteBulkFeature is the resolver for the deleteBulkFeature field.
+func (r *mutationResolver) DeleteBulkFeature(ctx context.Context, ids []string, mode *BulkMode) (*FeatureBulkDeletePayload, error) {
+	return r.bulkDeleteFeature(ctx, ids, mode)
+}
+
+// UpsertBulkCSVFeature is the resolver for the upsertBulkCSVFeature field.
+func (r *mutationResolver) UpsertBulkCSVFeature(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*FeatureBulkUpsertPayload, error) {
+	return r.bulkUpsertFeature(ctx, input, keys, mode)
+}
+
 // Feature is the resolver for the feature field.
 func (r *queryResolver) Feature(ctx context.Context, id string) (*generated.Feature, error) {
 	res, err := withTransactionalMutation(ctx).Feature.Get(ctx, id)
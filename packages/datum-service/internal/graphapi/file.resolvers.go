@@ -75,6 +75,21 @@ func (r *mutationResolver) DeleteFile(ctx context.Context, id string) (*FileDele
 	}, nil
 }
 
+// UpdateBulkFile is the resolver for the updateBulkFile field.
+func (r *mutationResolver) UpdateBulkFile(ctx context.Context, ids []string, input generated.UpdateFileInput, mode *BulkMode) (*FileBulkUpdatePayload, error) {
+	return r.bulkUpdateFile(ctx, ids, input, mode)
+}
+
+// DeleteBulkFile is the resolver for the deleteBulkFile field.
+func (r *mutationResolver) DeleteBulkFile(ctx context.Context, ids []string, mode *BulkMode) (*FileBulkDeletePayload, error) {
+	return r.bulkDeleteFile(ctx, ids, mode)
+}
+
+// UpsertBulkCSVFile is the resolver for the upsertBulkCSVFile field.
+func (r *mutationResolver) UpsertBulkCSVFile(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*FileBulkUpsertPayload, error) {
+	return r.bulkUpsertFile(ctx, input, keys, mode)
+}
+
 // File is the resolver for the file field.
 func (r *queryResolver) File(ctx context.Context, id string) (*generated.File, error) {
 	res, err := withTransactionalMutation(ctx).File.Get(ctx, id)
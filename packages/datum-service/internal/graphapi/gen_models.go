@@ -3,6 +3,10 @@
 package graphapi
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"entgo.io/contrib/entgql"
@@ -38,16 +42,26 @@ type APITokenUpdatePayload struct {
 }
 
 type AuditLog struct {
-	Table     *string    `json:"table,omitempty"`
-	Time      *time.Time `json:"time,omitempty"`
-	ID        string     `json:"id"`
-	Operation *string    `json:"operation,omitempty"`
-	Changes   []string   `json:"changes,omitempty"`
-	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	Table     *string           `json:"table,omitempty"`
+	Time      *time.Time        `json:"time,omitempty"`
+	ID        string            `json:"id"`
+	Operation *string           `json:"operation,omitempty"`
+	Changes   []*AuditLogChange `json:"changes,omitempty"`
+	UpdatedBy *string           `json:"updatedBy,omitempty"`
 }
 
 func (AuditLog) IsNode() {}
 
+// A field of the object changed by the operation recorded in the audit log
+type AuditLogChange struct {
+	// The name of the changed field
+	Field string `json:"field"`
+	// The value of the field before the operation, null when the field was not set
+	Old json.RawMessage `json:"old,omitempty"`
+	// The value of the field after the operation, null when the field is no longer set
+	New json.RawMessage `json:"new,omitempty"`
+}
+
 // A connection to a list of items.
 type AuditLogConnection struct {
 	// A list of edges.
@@ -75,12 +89,84 @@ type AuditLogWhereInput struct {
 	After     *time.Time `json:"after,omitempty"`
 }
 
+// BulkError is the error of a single row of a bulk mutation
+type BulkError struct {
+	// Position of the row in the input, starting at 1
+	Row int `json:"row"`
+	// Line of the row in the csv file, the header is line 1
+	Line *int `json:"line,omitempty"`
+	// ID of the object of the row, if known
+	ID *string `json:"id,omitempty"`
+	// Error message
+	Message string `json:"message"`
+}
+
+// CSVColumnMapping maps a column of a csv file to a field of the input it is imported into
+type CSVColumnMapping struct {
+	// Name of the column in the header of the csv file
+	Column string `json:"column"`
+	// Name of the input field, e.g. fullName
+	Field string `json:"field"`
+}
+
+// A committed change of an object, the changed object can be queried by its ID
+type ChangeEvent struct {
+	// ID of the change
+	ID string `json:"id"`
+	// Type of the event of the change, e.g. organization.created
+	EventType string `json:"eventType"`
+	// Operation of the change, create, update or delete
+	Operation string `json:"operation"`
+	// Type of the changed object
+	ObjectType string `json:"objectType"`
+	// ID of the changed object
+	ObjectID string `json:"objectID"`
+	// ID of the organization the change was made in
+	OrganizationID *string `json:"organizationID,omitempty"`
+	// Time the change was committed
+	CreatedAt time.Time `json:"createdAt"`
+}
+
 // Return response for createBulkContact mutation
 type ContactBulkCreatePayload struct {
 	// Created contacts
 	Contacts []*generated.Contact `json:"contacts,omitempty"`
 }
 
+// Return response for deleteBulkContact mutation
+type ContactBulkDeletePayload struct {
+	// Deleted contact IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for importBulkCSVContact mutation
+type ContactBulkImportPayload struct {
+	// Imported contacts, in a dry run the contacts that are valid but were not saved
+	Contacts []*generated.Contact `json:"contacts,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+	// Whether the import was a dry run
+	DryRun bool `json:"dryRun"`
+}
+
+// Return response for updateBulkContact mutation
+type ContactBulkUpdatePayload struct {
+	// Updated contacts
+	Contacts []*generated.Contact `json:"contacts,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVContact mutation
+type ContactBulkUpsertPayload struct {
+	// Created and updated contacts
+	Contacts []*generated.Contact `json:"contacts,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createContact mutation
 type ContactCreatePayload struct {
 	// Created contact
@@ -105,6 +191,30 @@ type DocumentDataBulkCreatePayload struct {
 	DocumentData []*generated.DocumentData `json:"documentData,omitempty"`
 }
 
+// Return response for deleteBulkDocumentData mutation
+type DocumentDataBulkDeletePayload struct {
+	// Deleted documentdata IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkDocumentData mutation
+type DocumentDataBulkUpdatePayload struct {
+	// Updated documentData
+	DocumentData []*generated.DocumentData `json:"documentData,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVDocumentData mutation
+type DocumentDataBulkUpsertPayload struct {
+	// Created and updated documentData
+	DocumentData []*generated.DocumentData `json:"documentData,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createDocumentData mutation
 type DocumentDataCreatePayload struct {
 	// Created documentData
@@ -129,6 +239,30 @@ type EntitlementBulkCreatePayload struct {
 	Entitlements []*generated.Entitlement `json:"entitlements,omitempty"`
 }
 
+// Return response for deleteBulkEntitlement mutation
+type EntitlementBulkDeletePayload struct {
+	// Deleted entitlement IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkEntitlement mutation
+type EntitlementBulkUpdatePayload struct {
+	// Updated entitlements
+	Entitlements []*generated.Entitlement `json:"entitlements,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVEntitlement mutation
+type EntitlementBulkUpsertPayload struct {
+	// Created and updated entitlements
+	Entitlements []*generated.Entitlement `json:"entitlements,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createEntitlement mutation
 type EntitlementCreatePayload struct {
 	// Created entitlement
@@ -147,6 +281,30 @@ type EntitlementPlanBulkCreatePayload struct {
 	EntitlementPlans []*generated.EntitlementPlan `json:"entitlementPlans,omitempty"`
 }
 
+// Return response for deleteBulkEntitlementPlan mutation
+type EntitlementPlanBulkDeletePayload struct {
+	// Deleted entitlementplan IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkEntitlementPlan mutation
+type EntitlementPlanBulkUpdatePayload struct {
+	// Updated entitlementPlans
+	EntitlementPlans []*generated.EntitlementPlan `json:"entitlementPlans,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVEntitlementPlan mutation
+type EntitlementPlanBulkUpsertPayload struct {
+	// Created and updated entitlementPlans
+	EntitlementPlans []*generated.EntitlementPlan `json:"entitlementPlans,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createEntitlementPlan mutation
 type EntitlementPlanCreatePayload struct {
 	// Created entitlementPlan
@@ -165,6 +323,30 @@ type EntitlementPlanFeatureBulkCreatePayload struct {
 	EntitlementPlanFeatures []*generated.EntitlementPlanFeature `json:"entitlementPlanFeatures,omitempty"`
 }
 
+// Return response for deleteBulkEntitlementPlanFeature mutation
+type EntitlementPlanFeatureBulkDeletePayload struct {
+	// Deleted entitlementplanfeature IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkEntitlementPlanFeature mutation
+type EntitlementPlanFeatureBulkUpdatePayload struct {
+	// Updated entitlementPlanFeatures
+	EntitlementPlanFeatures []*generated.EntitlementPlanFeature `json:"entitlementPlanFeatures,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVEntitlementPlanFeature mutation
+type EntitlementPlanFeatureBulkUpsertPayload struct {
+	// Created and updated entitlementPlanFeatures
+	EntitlementPlanFeatures []*generated.EntitlementPlanFeature `json:"entitlementPlanFeatures,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createEntitlementPlanFeature mutation
 type EntitlementPlanFeatureCreatePayload struct {
 	// Created entitlementPlanFeature
@@ -201,6 +383,40 @@ type EntityBulkCreatePayload struct {
 	Entities []*generated.Entity `json:"entities,omitempty"`
 }
 
+// Return response for deleteBulkEntity mutation
+type EntityBulkDeletePayload struct {
+	// Deleted entity IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for importBulkCSVEntity mutation
+type EntityBulkImportPayload struct {
+	// Imported entities, in a dry run the entities that are valid but were not saved
+	Entities []*generated.Entity `json:"entities,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+	// Whether the import was a dry run
+	DryRun bool `json:"dryRun"`
+}
+
+// Return response for updateBulkEntity mutation
+type EntityBulkUpdatePayload struct {
+	// Updated entities
+	Entities []*generated.Entity `json:"entities,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVEntity mutation
+type EntityBulkUpsertPayload struct {
+	// Created and updated entities
+	Entities []*generated.Entity `json:"entities,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createEntity mutation
 type EntityCreatePayload struct {
 	// Created entity
@@ -219,6 +435,30 @@ type EntityTypeBulkCreatePayload struct {
 	EntityTypes []*generated.EntityType `json:"entityTypes,omitempty"`
 }
 
+// Return response for deleteBulkEntityType mutation
+type EntityTypeBulkDeletePayload struct {
+	// Deleted entitytype IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkEntityType mutation
+type EntityTypeBulkUpdatePayload struct {
+	// Updated entityTypes
+	EntityTypes []*generated.EntityType `json:"entityTypes,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVEntityType mutation
+type EntityTypeBulkUpsertPayload struct {
+	// Created and updated entityTypes
+	EntityTypes []*generated.EntityType `json:"entityTypes,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createEntityType mutation
 type EntityTypeCreatePayload struct {
 	// Created entityType
@@ -273,6 +513,30 @@ type FeatureBulkCreatePayload struct {
 	Features []*generated.Feature `json:"features,omitempty"`
 }
 
+// Return response for deleteBulkFeature mutation
+type FeatureBulkDeletePayload struct {
+	// Deleted feature IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkFeature mutation
+type FeatureBulkUpdatePayload struct {
+	// Updated features
+	Features []*generated.Feature `json:"features,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVFeature mutation
+type FeatureBulkUpsertPayload struct {
+	// Created and updated features
+	Features []*generated.Feature `json:"features,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createFeature mutation
 type FeatureCreatePayload struct {
 	// Created feature
@@ -297,6 +561,30 @@ type FileBulkCreatePayload struct {
 	Files []*generated.File `json:"files,omitempty"`
 }
 
+// Return response for deleteBulkFile mutation
+type FileBulkDeletePayload struct {
+	// Deleted file IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkFile mutation
+type FileBulkUpdatePayload struct {
+	// Updated files
+	Files []*generated.File `json:"files,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVFile mutation
+type FileBulkUpsertPayload struct {
+	// Created and updated files
+	Files []*generated.File `json:"files,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createFile mutation
 type FileCreatePayload struct {
 	// Created file
@@ -374,6 +662,30 @@ type GroupSettingBulkCreatePayload struct {
 	GroupSettings []*generated.GroupSetting `json:"groupSettings,omitempty"`
 }
 
+// Return response for deleteBulkGroupSetting mutation
+type GroupSettingBulkDeletePayload struct {
+	// Deleted groupsetting IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkGroupSetting mutation
+type GroupSettingBulkUpdatePayload struct {
+	// Updated groupSettings
+	GroupSettings []*generated.GroupSetting `json:"groupSettings,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVGroupSetting mutation
+type GroupSettingBulkUpsertPayload struct {
+	// Created and updated groupSettings
+	GroupSettings []*generated.GroupSetting `json:"groupSettings,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createGroupSetting mutation
 type GroupSettingCreatePayload struct {
 	// Created groupSetting
@@ -404,6 +716,30 @@ type HushBulkCreatePayload struct {
 	Hushes []*generated.Hush `json:"hushes,omitempty"`
 }
 
+// Return response for deleteBulkHush mutation
+type HushBulkDeletePayload struct {
+	// Deleted hush IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkHush mutation
+type HushBulkUpdatePayload struct {
+	// Updated hushs
+	Hushes []*generated.Hush `json:"hushes,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVHush mutation
+type HushBulkUpsertPayload struct {
+	// Created and updated hushs
+	Hushes []*generated.Hush `json:"hushes,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createHush mutation
 type HushCreatePayload struct {
 	// Created hush
@@ -428,6 +764,30 @@ type IntegrationBulkCreatePayload struct {
 	Integrations []*generated.Integration `json:"integrations,omitempty"`
 }
 
+// Return response for deleteBulkIntegration mutation
+type IntegrationBulkDeletePayload struct {
+	// Deleted integration IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkIntegration mutation
+type IntegrationBulkUpdatePayload struct {
+	// Updated integrations
+	Integrations []*generated.Integration `json:"integrations,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVIntegration mutation
+type IntegrationBulkUpsertPayload struct {
+	// Created and updated integrations
+	Integrations []*generated.Integration `json:"integrations,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createIntegration mutation
 type IntegrationCreatePayload struct {
 	// Created integration
@@ -476,6 +836,30 @@ type OauthProviderBulkCreatePayload struct {
 	OauthProviders []*generated.OauthProvider `json:"oauthProviders,omitempty"`
 }
 
+// Return response for deleteBulkOauthProvider mutation
+type OauthProviderBulkDeletePayload struct {
+	// Deleted oauthprovider IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkOauthProvider mutation
+type OauthProviderBulkUpdatePayload struct {
+	// Updated oauthProviders
+	OauthProviders []*generated.OauthProvider `json:"oauthProviders,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVOauthProvider mutation
+type OauthProviderBulkUpsertPayload struct {
+	// Created and updated oauthProviders
+	OauthProviders []*generated.OauthProvider `json:"oauthProviders,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createOauthProvider mutation
 type OauthProviderCreatePayload struct {
 	// Created oauthProvider
@@ -500,6 +884,30 @@ type OhAuthTooTokenBulkCreatePayload struct {
 	OhAuthTooTokens []*generated.OhAuthTooToken `json:"ohAuthTooTokens,omitempty"`
 }
 
+// Return response for deleteBulkOhAuthTooToken mutation
+type OhAuthTooTokenBulkDeletePayload struct {
+	// Deleted ohauthtootoken IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkOhAuthTooToken mutation
+type OhAuthTooTokenBulkUpdatePayload struct {
+	// Updated ohAuthTooTokens
+	OhAuthTooTokens []*generated.OhAuthTooToken `json:"ohAuthTooTokens,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVOhAuthTooToken mutation
+type OhAuthTooTokenBulkUpsertPayload struct {
+	// Created and updated ohAuthTooTokens
+	OhAuthTooTokens []*generated.OhAuthTooToken `json:"ohAuthTooTokens,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createOhAuthTooToken mutation
 type OhAuthTooTokenCreatePayload struct {
 	// Created ohAuthTooToken
@@ -572,6 +980,30 @@ type OrganizationSettingBulkCreatePayload struct {
 	OrganizationSettings []*generated.OrganizationSetting `json:"organizationSettings,omitempty"`
 }
 
+// Return response for deleteBulkOrganizationSetting mutation
+type OrganizationSettingBulkDeletePayload struct {
+	// Deleted organizationsetting IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkOrganizationSetting mutation
+type OrganizationSettingBulkUpdatePayload struct {
+	// Updated organizationSettings
+	OrganizationSettings []*generated.OrganizationSetting `json:"organizationSettings,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVOrganizationSetting mutation
+type OrganizationSettingBulkUpsertPayload struct {
+	// Created and updated organizationSettings
+	OrganizationSettings []*generated.OrganizationSetting `json:"organizationSettings,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createOrganizationSetting mutation
 type OrganizationSettingCreatePayload struct {
 	// Created organizationSetting
@@ -602,6 +1034,30 @@ type PersonalAccessTokenBulkCreatePayload struct {
 	PersonalAccessTokens []*generated.PersonalAccessToken `json:"personalAccessTokens,omitempty"`
 }
 
+// Return response for deleteBulkPersonalAccessToken mutation
+type PersonalAccessTokenBulkDeletePayload struct {
+	// Deleted personalaccesstoken IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkPersonalAccessToken mutation
+type PersonalAccessTokenBulkUpdatePayload struct {
+	// Updated personalAccessTokens
+	PersonalAccessTokens []*generated.PersonalAccessToken `json:"personalAccessTokens,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVPersonalAccessToken mutation
+type PersonalAccessTokenBulkUpsertPayload struct {
+	// Created and updated personalAccessTokens
+	PersonalAccessTokens []*generated.PersonalAccessToken `json:"personalAccessTokens,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createPersonalAccessToken mutation
 type PersonalAccessTokenCreatePayload struct {
 	// Created personalAccessToken
@@ -620,6 +1076,12 @@ type PersonalAccessTokenUpdatePayload struct {
 	PersonalAccessToken *generated.PersonalAccessToken `json:"personalAccessToken"`
 }
 
+// Return response for revokeSession mutation
+type SessionRevokePayload struct {
+	// Revoked session ID
+	DeletedID string `json:"deletedID"`
+}
+
 // Return response for createBulkSubscriber mutation
 type SubscriberBulkCreatePayload struct {
 	// Created subscribers
@@ -650,6 +1112,9 @@ type SubscriberUpdatePayload struct {
 	Subscriber *generated.Subscriber `json:"subscriber"`
 }
 
+type Subscription struct {
+}
+
 // Return response for createTFASetting mutation
 type TFASettingCreatePayload struct {
 	// Created tfaSetting
@@ -668,6 +1133,30 @@ type TemplateBulkCreatePayload struct {
 	Templates []*generated.Template `json:"templates,omitempty"`
 }
 
+// Return response for deleteBulkTemplate mutation
+type TemplateBulkDeletePayload struct {
+	// Deleted template IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkTemplate mutation
+type TemplateBulkUpdatePayload struct {
+	// Updated templates
+	Templates []*generated.Template `json:"templates,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVTemplate mutation
+type TemplateBulkUpsertPayload struct {
+	// Created and updated templates
+	Templates []*generated.Template `json:"templates,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createTemplate mutation
 type TemplateCreatePayload struct {
 	// Created template
@@ -680,12 +1169,66 @@ type TemplateDeletePayload struct {
 	DeletedID string `json:"deletedID"`
 }
 
+// A field that does not conform to the schema of a template
+type TemplateFieldError struct {
+	// JSON pointer to the field
+	Path string `json:"path"`
+	// reason the field is not valid
+	Message string `json:"message"`
+}
+
+// A document that could not be migrated to the template version
+type TemplateMigrationFailure struct {
+	// ID of the document
+	DocumentDataID string `json:"documentDataID"`
+	// fields of the document that do not conform to the schema of the version
+	Errors []*TemplateFieldError `json:"errors"`
+}
+
+// Return response for migrateTemplateDocuments mutation
+type TemplateMigrationPayload struct {
+	// ID of the template
+	TemplateID string `json:"templateID"`
+	// version the documents were migrated to
+	Version int `json:"version"`
+	// IDs of the migrated documents
+	Migrated []string `json:"migrated"`
+	// documents that do not conform to the version and were not migrated
+	Failed []*TemplateMigrationFailure `json:"failed"`
+}
+
 // Return response for updateTemplate mutation
 type TemplateUpdatePayload struct {
 	// Updated template
 	Template *generated.Template `json:"template"`
 }
 
+// A change to a value of the template between two versions
+type TemplateVersionChange struct {
+	// JSON pointer to the value that changed
+	Path string `json:"path"`
+	// the kind of change
+	Operation TemplateVersionChangeOperation `json:"operation"`
+	// the JSON encoded value in the version compared from, empty when the value was added
+	From *string `json:"from,omitempty"`
+	// the JSON encoded value in the version compared to, empty when the value was removed
+	To *string `json:"to,omitempty"`
+}
+
+// Return response for templateVersionDiff query
+type TemplateVersionDiff struct {
+	// ID of the template
+	TemplateID string `json:"templateID"`
+	// version compared from
+	From int `json:"from"`
+	// version compared to
+	To int `json:"to"`
+	// changes to the jsonconfig of the template
+	Jsonconfig []*TemplateVersionChange `json:"jsonconfig"`
+	// changes to the uischema of the template
+	Uischema []*TemplateVersionChange `json:"uischema"`
+}
+
 // Return response for createBulkUser mutation
 type UserBulkCreatePayload struct {
 	// Created users
@@ -710,6 +1253,24 @@ type UserSearchResult struct {
 
 func (UserSearchResult) IsGlobalSearchResult() {}
 
+// A session of a user, describing the device the user logged in from
+type UserSession struct {
+	// ID of the session
+	ID string `json:"id"`
+	// ID of the user the session belongs to
+	UserID string `json:"userID"`
+	// User agent of the device that created the session
+	UserAgent *string `json:"userAgent,omitempty"`
+	// IP address of the device that created the session
+	IPAddress *string `json:"ipAddress,omitempty"`
+	// Time the session was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// Last time the session was used
+	LastActivity *time.Time `json:"lastActivity,omitempty"`
+	// Whether the session is the one used for the request
+	Current bool `json:"current"`
+}
+
 // Return response for createBulkUserSetting mutation
 type UserSettingBulkCreatePayload struct {
 	// Created userSettings
@@ -740,6 +1301,30 @@ type WebhookBulkCreatePayload struct {
 	Webhooks []*generated.Webhook `json:"webhooks,omitempty"`
 }
 
+// Return response for deleteBulkWebhook mutation
+type WebhookBulkDeletePayload struct {
+	// Deleted webhook IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkWebhook mutation
+type WebhookBulkUpdatePayload struct {
+	// Updated webhooks
+	Webhooks []*generated.Webhook `json:"webhooks,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVWebhook mutation
+type WebhookBulkUpsertPayload struct {
+	// Created and updated webhooks
+	Webhooks []*generated.Webhook `json:"webhooks,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // Return response for createWebhook mutation
 type WebhookCreatePayload struct {
 	// Created webhook
@@ -752,8 +1337,144 @@ type WebhookDeletePayload struct {
 	DeletedID string `json:"deletedID"`
 }
 
+// Return response for redeliverWebhookEvent mutation
+type WebhookRedeliveryPayload struct {
+	// Delivery attempts made for the event
+	WebhookDeliveries []*generated.WebhookDelivery `json:"webhookDeliveries"`
+}
+
 // Return response for updateWebhook mutation
 type WebhookUpdatePayload struct {
 	// Updated webhook
 	Webhook *generated.Webhook `json:"webhook"`
 }
+
+// BulkMode determines how a bulk mutation handles rows that fail
+type BulkMode string
+
+const (
+	// Undo all rows when any row fails, this is the default
+	BulkModeAllOrNothing BulkMode = "ALL_OR_NOTHING"
+	// Keep the rows that succeeded and report the rows that failed
+	BulkModeBestEffort BulkMode = "BEST_EFFORT"
+)
+
+var AllBulkMode = []BulkMode{
+	BulkModeAllOrNothing,
+	BulkModeBestEffort,
+}
+
+func (e BulkMode) IsValid() bool {
+	switch e {
+	case BulkModeAllOrNothing, BulkModeBestEffort:
+		return true
+	}
+	return false
+}
+
+func (e BulkMode) String() string {
+	return string(e)
+}
+
+func (e *BulkMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BulkMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BulkMode", str)
+	}
+	return nil
+}
+
+func (e BulkMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// The output format of a rendered document
+type DocumentRenderFormat string
+
+const (
+	DocumentRenderFormatPDF  DocumentRenderFormat = "PDF"
+	DocumentRenderFormatHTML DocumentRenderFormat = "HTML"
+)
+
+var AllDocumentRenderFormat = []DocumentRenderFormat{
+	DocumentRenderFormatPDF,
+	DocumentRenderFormatHTML,
+}
+
+func (e DocumentRenderFormat) IsValid() bool {
+	switch e {
+	case DocumentRenderFormatPDF, DocumentRenderFormatHTML:
+		return true
+	}
+	return false
+}
+
+func (e DocumentRenderFormat) String() string {
+	return string(e)
+}
+
+func (e *DocumentRenderFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DocumentRenderFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DocumentRenderFormat", str)
+	}
+	return nil
+}
+
+func (e DocumentRenderFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// The operation of a change between two template versions
+type TemplateVersionChangeOperation string
+
+const (
+	TemplateVersionChangeOperationAdded   TemplateVersionChangeOperation = "ADDED"
+	TemplateVersionChangeOperationRemoved TemplateVersionChangeOperation = "REMOVED"
+	TemplateVersionChangeOperationChanged TemplateVersionChangeOperation = "CHANGED"
+)
+
+var AllTemplateVersionChangeOperation = []TemplateVersionChangeOperation{
+	TemplateVersionChangeOperationAdded,
+	TemplateVersionChangeOperationRemoved,
+	TemplateVersionChangeOperationChanged,
+}
+
+func (e TemplateVersionChangeOperation) IsValid() bool {
+	switch e {
+	case TemplateVersionChangeOperationAdded, TemplateVersionChangeOperationRemoved, TemplateVersionChangeOperationChanged:
+		return true
+	}
+	return false
+}
+
+func (e TemplateVersionChangeOperation) String() string {
+	return string(e)
+}
+
+func (e *TemplateVersionChangeOperation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TemplateVersionChangeOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TemplateVersionChangeOperation", str)
+	}
+	return nil
+}
+
+func (e TemplateVersionChangeOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -75,6 +75,21 @@ func (r *mutationResolver) DeleteGroupSetting(ctx context.Context, id string) (*
 	}, nil
 }
 
+// UpdateBulkGroupSetting is the resolver for the updateBulkGroupSetting field.
+func (r *mutationResolver) UpdateBulkGroupSetting(ctx context.Context, ids []string, input generated.UpdateGroupSettingInput, mode *BulkMode) (*GroupSettingBulkUpdatePayload, error) {
+	return r.bulkUpdateGroupSetting(ctx, ids, input, mode)
+}
+
+// DeleteBulkGroupSetting is the resolver for the deleteBulkGroupSetting field.
+func (r *mutationResolver) DeleteBulkGroupSetting(ctx context.Context, ids []string, mode *BulkMode) (*GroupSettingBulkDeletePayload, error) {
+	return r.bulkDeleteGroupSetting(ctx, ids, mode)
+}
+
+// UpsertBulkCSVGroupSetting is the resolver for the upsertBulkCSVGroupSetting field.
+func (r *mutationResolver) UpsertBulkCSVGroupSetting(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*GroupSettingBulkUpsertPayload, error) {
+	return r.bulkUpsertGroupSetting(ctx, input, keys, mode)
+}
+
 // GroupSetting is the resolver for the groupSetting field.
 func (r *queryResolver) GroupSetting(ctx context.Context, id string) (*generated.GroupSetting, error) {
 	res, err := withTransactionalMutation(ctx).GroupSetting.Get(ctx, id)
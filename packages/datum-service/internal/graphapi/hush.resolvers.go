@@ -75,6 +75,21 @@ func (r *mutationResolver) DeleteHush(ctx context.Context, id string) (*HushDele
 	}, nil
 }
 
+// UpdateBulkHush is the resolver for the updateBulkHush field.
+func (r *mutationResolver) UpdateBulkHush(ctx context.Context, ids []string, input generated.UpdateHushInput, mode *BulkMode) (*HushBulkUpdatePayload, error) {
+	return r.bulkUpdateHush(ctx, ids, input, mode)
+}
+
+// DeleteBulkHush is the resolver for the deleteBulkHush field.
+func (r *mutationResolver) DeleteBulkHush(ctx context.Context, ids []string, mode *BulkMode) (*HushBulkDeletePayload, error) {
+	return r.bulkDeleteHush(ctx, ids, mode)
+}
+
+// UpsertBulkCSVHush is the resolver for the upsertBulkCSVHush field.
+func (r *mutationResolver) UpsertBulkCSVHush(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*HushBulkUpsertPayload, error) {
+	return r.bulkUpsertHush(ctx, input, keys, mode)
+}
+
 // Hush is the resolver for the hush field.
 func (r *queryResolver) Hush(ctx context.Context, id string) (*generated.Hush, error) {
 	res, err := withTransactionalMutation(ctx).Hush.Get(ctx, id)
@@ -89,6 +89,21 @@ func (r *mutationResolver) DeleteIntegration(ctx context.Context, id string) (*I
 	}, nil
 }
 
+// UpdateBulkIntegration is the resolver for the updateBulkIntegration field.
+func (r *mutationResolver) UpdateBulkIntegration(ctx context.Context, ids []string, input generated.UpdateIntegrationInput, mode *BulkMode) (*IntegrationBulkUpdatePayload, error) {
+	return r.bulkUpdateIntegration(ctx, ids, input, mode)
+}
+
+// DeleteBulkIntegration is the resolver for the deleteBulkIntegration field.
+func (r *mutationResolver) DeleteBulkIntegration(ctx context.Context, ids []string, mode *BulkMode) (*IntegrationBulkDeletePayload, error) {
+	return r.bulkDeleteIntegration(ctx, ids, mode)
+}
+
+// UpsertBulkCSVIntegration is the resolver for the upsertBulkCSVIntegration field.
+func (r *mutationResolver) UpsertBulkCSVIntegration(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*IntegrationBulkUpsertPayload, error) {
+	return r.bulkUpsertIntegration(ctx, input, keys, mode)
+}
+
 // Integration is the resolver for the integration field.
 func (r *queryResolver) Integration(ctx context.Context, id string) (*generated.Integration, error) {
 	res, err := withTransactionalMutation(ctx).Integration.Get(ctx, id)
@@ -0,0 +1,242 @@
+package graphapi
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/datumforge/datum/pkg/auth"
+)
+
+const (
+	// ErrCodeComplexityLimit is the error code of operations exceeding the complexity limit
+	ErrCodeComplexityLimit = "COMPLEXITY_LIMIT_EXCEEDED"
+	// ErrCodeDepthLimit is the error code of operations exceeding the depth limit
+	ErrCodeDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+
+	queryLimitsExtension = "QueryLimits"
+)
+
+// QueryLimits contains the complexity and depth limits of graph operations by the authentication type of the request
+type QueryLimits struct {
+	// Enabled turns on the complexity and depth limits of graph operations
+	Enabled bool `json:"enabled" koanf:"enabled" default:"true"`
+	// JWT contains the limits of requests authenticated with a session or JWT
+	JWT QueryLimit `json:"jwt" koanf:"jwt"`
+	// APIToken contains the limits of requests authenticated with an API token or personal access token
+	APIToken QueryLimit `json:"apiToken" koanf:"apiToken"`
+	// DefaultListSize is the number of results assumed for connections without a first or last argument and for list fields
+	DefaultListSize int `json:"defaultListSize" koanf:"defaultListSize" default:"10"`
+}
+
+// QueryLimit contains the limits of a single graph operation, a limit of 0 disables the limit
+type QueryLimit struct {
+	// MaxComplexity is the maximum complexity of an operation
+	MaxComplexity int `json:"maxComplexity" koanf:"maxComplexity" default:"5000"`
+	// MaxDepth is the maximum depth of the fields of an operation, introspection fields are not counted
+	MaxDepth int `json:"maxDepth" koanf:"maxDepth" default:"10"`
+}
+
+// forAuthType returns the limits of requests with the authentication type
+func (l QueryLimits) forAuthType(t auth.AuthenticationType) QueryLimit {
+	if t == auth.APITokenAuthentication || t == auth.PATAuthentication {
+		return l.APIToken
+	}
+
+	return l.JWT
+}
+
+// WithQueryLimits adds the complexity and depth limits to the handler, operations exceeding the limits
+// of the authentication type of the request are rejected before they are executed
+func WithQueryLimits(h *handler.Server, limits QueryLimits) {
+	if !limits.Enabled {
+		return
+	}
+
+	h.Use(&queryLimiter{limits: limits})
+}
+
+// queryLimiter is a handler extension enforcing the query limits
+type queryLimiter struct {
+	limits QueryLimits
+	es     graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &queryLimiter{}
+
+// ExtensionName returns the name of the extension
+func (q *queryLimiter) ExtensionName() string {
+	return queryLimitsExtension
+}
+
+// Validate stores the schema used to calculate the complexity of operations
+func (q *queryLimiter) Validate(es graphql.ExecutableSchema) error {
+	q.es = costModel{
+		ExecutableSchema: es,
+		listSize:         q.limits.DefaultListSize,
+	}
+
+	return nil
+}
+
+// MutateOperationContext rejects operations that exceed the depth or complexity limit
+func (q *queryLimiter) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	limit := q.limits.forAuthType(auth.GetAuthTypeFromContext(ctx))
+
+	if limit.MaxDepth > 0 {
+		if depth := queryDepth(op.SelectionSet); depth > limit.MaxDepth {
+			err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, limit.MaxDepth)
+			errcode.Set(err, ErrCodeDepthLimit)
+
+			return err
+		}
+	}
+
+	if limit.MaxComplexity > 0 {
+		if cost := complexity.Calculate(q.es, op, rc.Variables); cost > limit.MaxComplexity {
+			err := gqlerror.Errorf("operation has complexity %d, which exceeds the limit of %d", cost, limit.MaxComplexity)
+			errcode.Set(err, ErrCodeComplexityLimit)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// queryDepth returns the depth of the deepest field of the selection set, introspection fields are not counted
+func queryDepth(set ast.SelectionSet) int {
+	depth := 0
+
+	for _, sel := range set {
+		var d int
+
+		switch s := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+
+			d = 1 + queryDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			d = queryDepth(s.Definition.SelectionSet)
+		case *ast.InlineFragment:
+			d = queryDepth(s.SelectionSet)
+		}
+
+		depth = max(depth, d)
+	}
+
+	return depth
+}
+
+// costModel is the executable schema with the complexity of the fields returning many objects multiplied by the
+// number of objects they return; connections return the first or last number of objects and list fields
+// are assumed to return the default list size
+type costModel struct {
+	graphql.ExecutableSchema
+
+	listSize int
+}
+
+// Complexity returns the complexity of the field of the type
+func (c costModel) Complexity(typeName, field string, childComplexity int, args map[string]any) (int, bool) {
+	if cost, ok := c.ExecutableSchema.Complexity(typeName, field, childComplexity, args); ok {
+		return cost, ok
+	}
+
+	parent := c.Schema().Types[typeName]
+
+	// the edges of a connection are already multiplied by the connection field
+	if parent == nil || isConnection(parent) {
+		return 0, false
+	}
+
+	fd := parent.Fields.ForName(field)
+	if fd == nil {
+		return 0, false
+	}
+
+	size := c.listSize
+
+	switch {
+	case isConnection(c.Schema().Types[fd.Type.Name()]):
+		if n, ok := intArg(args, "first"); ok {
+			size = n
+		} else if n, ok := intArg(args, "last"); ok {
+			size = n
+		}
+	case fd.Type.Elem == nil:
+		return 0, false
+	}
+
+	return safeAdd(1, safeMultiply(childComplexity, size)), true
+}
+
+// isConnection returns true if the type is a relay connection
+func isConnection(def *ast.Definition) bool {
+	return def != nil && def.Kind == ast.Object && def.Fields.ForName("pageInfo") != nil && def.Fields.ForName("edges") != nil
+}
+
+// intArg returns the value of the int argument, negative values are ignored
+func intArg(args map[string]any, name string) (int, bool) {
+	var n int64
+
+	switch v := args[name].(type) {
+	case int:
+		n = int64(v)
+	case int64:
+		n = v
+	case float64:
+		n = int64(v)
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			return 0, false
+		}
+
+		n = i
+	default:
+		return 0, false
+	}
+
+	if n < 0 {
+		return 0, false
+	}
+
+	return int(min(n, math.MaxInt32)), true
+}
+
+// safeAdd adds the complexities without overflowing
+func safeAdd(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+
+	return a + b
+}
+
+// safeMultiply multiplies the complexities without overflowing
+func safeMultiply(a, b int) int {
+	if a != 0 && b > math.MaxInt/a {
+		return math.MaxInt
+	}
+
+	return a * b
+}
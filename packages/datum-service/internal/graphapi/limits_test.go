@@ -0,0 +1,141 @@
+package graphapi_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/datum/internal/graphapi"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/middleware/echocontext"
+)
+
+func (suite *GraphTestSuite) TestQueryLimits() {
+	t := suite.T()
+
+	srv := handler.NewDefaultServer(graphapi.NewExecutableSchema(graphapi.Config{
+		Resolvers: graphapi.NewResolver(suite.client.db).WithLogger(zap.NewNop().Sugar()),
+	}))
+
+	graphapi.WithTransactions(srv, suite.client.db)
+	graphapi.WithSkipCache(srv)
+	graphapi.WithQueryLimits(srv, graphapi.QueryLimits{
+		Enabled:         true,
+		JWT:             graphapi.QueryLimit{MaxComplexity: 100, MaxDepth: 5},
+		APIToken:        graphapi.QueryLimit{MaxComplexity: 50, MaxDepth: 3},
+		DefaultListSize: 10,
+	})
+
+	userCtx, err := userContext()
+	require.NoError(t, err)
+
+	ec, err := auth.NewTestEchoContextWithOrgID(testUser.ID, testOrgID)
+	require.NoError(t, err)
+
+	auth.SetAuthenticatedUserContext(ec, &auth.AuthenticatedUser{
+		SubjectID:          testUser.ID,
+		OrganizationID:     testOrgID,
+		OrganizationIDs:    []string{testOrgID},
+		AuthenticationType: auth.APITokenAuthentication,
+	})
+
+	apiTokenCtx := context.WithValue(ec.Request().Context(), echocontext.EchoContextKey, ec)
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		query        string
+		variables    map[string]any
+		expectedCode string
+		expectedErr  string
+	}{
+		{
+			name:  "happy path, within limits",
+			ctx:   userCtx,
+			query: `query { organizations(first: 2) { edges { node { id name } } } }`,
+		},
+		{
+			name:  "introspection fields are not counted",
+			ctx:   apiTokenCtx,
+			query: `query { __schema { types { fields { type { ofType { name } } } } } }`,
+		},
+		{
+			name:         "depth exceeded",
+			ctx:          userCtx,
+			query:        `query { organizations { edges { node { users { organizations { id } } } } } }`,
+			expectedCode: graphapi.ErrCodeDepthLimit,
+			expectedErr:  "operation has depth 6, which exceeds the limit of 5",
+		},
+		{
+			name:         "depth exceeded, lower limit for api tokens",
+			ctx:          apiTokenCtx,
+			query:        `query { organizations(first: 2) { edges { node { id name } } } }`,
+			expectedCode: graphapi.ErrCodeDepthLimit,
+			expectedErr:  "operation has depth 4, which exceeds the limit of 3",
+		},
+		{
+			name:         "complexity exceeded, connection multiplied by first",
+			ctx:          userCtx,
+			query:        `query ($first: Int) { organizations(first: $first) { edges { node { id name } } } }`,
+			variables:    map[string]any{"first": 100},
+			expectedCode: graphapi.ErrCodeComplexityLimit,
+			expectedErr:  "operation has complexity 401, which exceeds the limit of 100",
+		},
+		{
+			name:         "complexity exceeded, connection multiplied by last",
+			ctx:          userCtx,
+			query:        `query { organizations(last: 50) { edges { node { id } } } }`,
+			expectedCode: graphapi.ErrCodeComplexityLimit,
+			expectedErr:  "operation has complexity 151, which exceeds the limit of 100",
+		},
+		{
+			name:         "complexity exceeded, list fields multiplied by the default list size",
+			ctx:          userCtx,
+			query:        `query { organizations(first: 2) { edges { node { users { id displayName email } groups { id name } } } } }`,
+			expectedCode: graphapi.ErrCodeComplexityLimit,
+			expectedErr:  "operation has complexity 109, which exceeds the limit of 100",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(map[string]any{
+				"query":     tc.query,
+				"variables": tc.variables,
+			})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			recorder := httptest.NewRecorder()
+
+			srv.ServeHTTP(recorder, req.WithContext(tc.ctx))
+
+			var out struct {
+				Errors gqlerror.List `json:"errors"`
+			}
+
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+			if tc.expectedErr == "" {
+				assert.Empty(t, out.Errors)
+
+				return
+			}
+
+			require.Len(t, out.Errors, 1)
+			assert.Equal(t, tc.expectedErr, out.Errors[0].Message)
+			assert.Equal(t, tc.expectedCode, out.Errors[0].Extensions["code"])
+		})
+	}
+}
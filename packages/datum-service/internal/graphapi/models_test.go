@@ -127,6 +127,7 @@ type PersonalAccessTokenBuilder struct {
 	ExpiresAt       *time.Time
 	OwnerID         string
 	OrganizationIDs []string
+	Scopes          []string
 }
 
 type APITokenBuilder struct {
@@ -272,6 +273,23 @@ type ContactCleanup struct {
 	ID string
 }
 
+type TemplateBuilder struct {
+	client *client
+
+	// Fields
+	Name         string
+	TemplateType enums.DocumentType
+	Jsonconfig   map[string]any
+}
+
+type DocumentDataBuilder struct {
+	client *client
+
+	// Fields
+	TemplateID string
+	Data       map[string]any
+}
+
 // MustNew organization builder is used to create, without authz checks, orgs in the database
 func (o *OrganizationBuilder) MustNew(ctx context.Context, t *testing.T) *ent.Organization {
 	// no auth, so allow policy
@@ -560,6 +578,10 @@ func (pat *PersonalAccessTokenBuilder) MustNew(ctx context.Context, t *testing.T
 		SetDescription(pat.Description).
 		AddOrganizationIDs(pat.OrganizationIDs...)
 
+	if len(pat.Scopes) > 0 {
+		request.SetScopes(pat.Scopes)
+	}
+
 	if pat.ExpiresAt != nil {
 		request.SetExpiresAt(*pat.ExpiresAt)
 	}
@@ -893,3 +915,60 @@ func (e *ContactCleanup) MustDelete(ctx context.Context, t *testing.T) {
 	// clear mocks before going to tests
 	mock_fga.ClearMocks(e.client.fga)
 }
+
+// MustNew template builder is used to create, without authz checks, templates in the database
+func (tb *TemplateBuilder) MustNew(ctx context.Context, t *testing.T) *ent.Template {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	if tb.Name == "" {
+		tb.Name = gofakeit.AppName()
+	}
+
+	if tb.Jsonconfig == nil {
+		tb.Jsonconfig = map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"name": map[string]any{"type": "string"},
+			},
+		}
+	}
+
+	if tb.TemplateType == "" {
+		tb.TemplateType = enums.Document
+	}
+
+	template := tb.client.db.Template.Create().
+		SetName(tb.Name).
+		SetTemplateType(tb.TemplateType).
+		SetJsonconfig(tb.Jsonconfig).
+		SaveX(ctx)
+
+	// clear mocks before going to tests
+	mock_fga.ClearMocks(tb.client.fga)
+
+	return template
+}
+
+// MustNew document data builder is used to create, without authz checks, documents in the database
+func (d *DocumentDataBuilder) MustNew(ctx context.Context, t *testing.T) *ent.DocumentData {
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	if d.TemplateID == "" {
+		template := (&TemplateBuilder{client: d.client}).MustNew(ctx, t)
+		d.TemplateID = template.ID
+	}
+
+	if d.Data == nil {
+		d.Data = map[string]any{"name": gofakeit.Name()}
+	}
+
+	document := d.client.db.DocumentData.Create().
+		SetTemplateID(d.TemplateID).
+		SetData(d.Data).
+		SaveX(ctx)
+
+	// clear mocks before going to tests
+	mock_fga.ClearMocks(d.client.fga)
+
+	return document
+}
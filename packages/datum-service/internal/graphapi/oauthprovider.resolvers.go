@@ -89,6 +89,21 @@ func (r *mutationResolver) DeleteOauthProvider(ctx context.Context, id string) (
 	}, nil
 }
 
+// UpdateBulkOauthProvider is the resolver for the updateBulkOauthProvider field.
+func (r *mutationResolver) UpdateBulkOauthProvider(ctx context.Context, ids []string, input generated.UpdateOauthProviderInput, mode *BulkMode) (*OauthProviderBulkUpdatePayload, error) {
+	return r.bulkUpdateOauthProvider(ctx, ids, input, mode)
+}
+
+// DeleteBulkOauthProvider is the resolver for the deleteBulkOauthProvider field.
+func (r *mutationResolver) DeleteBulkOauthProvider(ctx context.Context, ids []string, mode *BulkMode) (*OauthProviderBulkDeletePayload, error) {
+	return r.bulkDeleteOauthProvider(ctx, ids, mode)
+}
+
+// UpsertBulkCSVOauthProvider is the resolver for the upsertBulkCSVOauthProvider field.
+func (r *mutationResolver) UpsertBulkCSVOauthProvider(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*OauthProviderBulkUpsertPayload, error) {
+	return r.bulkUpsertOauthProvider(ctx, input, keys, mode)
+}
+
 // OauthProvider is the resolver for the oauthProvider field.
 func (r *queryResolver) OauthProvider(ctx context.Context, id string) (*generated.OauthProvider, error) {
 	res, err := withTransactionalMutation(ctx).OauthProvider.Get(ctx, id)
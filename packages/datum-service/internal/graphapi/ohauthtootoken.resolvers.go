@@ -75,6 +75,21 @@ func (r *mutationResolver) DeleteOhAuthTooToken(ctx context.Context, id string)
 	}, nil
 }
 
+// UpdateBulkOhAuthTooToken is the resolver for the updateBulkOhAuthTooToken field.
+func (r *mutationResolver) UpdateBulkOhAuthTooToken(ctx context.Context, ids []string, input generated.UpdateOhAuthTooTokenInput, mode *BulkMode) (*OhAuthTooTokenBulkUpdatePayload, error) {
+	return r.bulkUpdateOhAuthTooToken(ctx, ids, input, mode)
+}
+
+// DeleteBulkOhAuthTooToken is the resolver for the deleteBulkOhAuthTooToken field.
+func (r *mutationResolver) DeleteBulkOhAuthTooToken(ctx context.Context, ids []string, mode *BulkMode) (*OhAuthTooTokenBulkDeletePayload, error) {
+	return r.bulkDeleteOhAuthTooToken(ctx, ids, mode)
+}
+
+// UpsertBulkCSVOhAuthTooToken is the resolver for the upsertBulkCSVOhAuthTooToken field.
+func (r *mutationResolver) UpsertBulkCSVOhAuthTooToken(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*OhAuthTooTokenBulkUpsertPayload, error) {
+	return r.bulkUpsertOhAuthTooToken(ctx, input, keys, mode)
+}
+
 // OhAuthTooToken is the resolver for the ohAuthTooToken field.
 func (r *queryResolver) OhAuthTooToken(ctx context.Context, id string) (*generated.OhAuthTooToken, error) {
 	res, err := withTransactionalMutation(ctx).OhAuthTooToken.Get(ctx, id)
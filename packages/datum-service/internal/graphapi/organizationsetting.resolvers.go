@@ -75,6 +75,21 @@ func (r *mutationResolver) DeleteOrganizationSetting(ctx context.Context, id str
 	}, nil
 }
 
+// UpdateBulkOrganizationSetting is the resolver for the updateBulkOrganizationSetting field.
+func (r *mutationResolver) UpdateBulkOrganizationSetting(ctx context.Context, ids []string, input generated.UpdateOrganizationSettingInput, mode *BulkMode) (*OrganizationSettingBulkUpdatePayload, error) {
+	return r.bulkUpdateOrganizationSetting(ctx, ids, input, mode)
+}
+
+// DeleteBulkOrganizationSetting is the resolver for the deleteBulkOrganizationSetting field.
+func (r *mutationResolver) DeleteBulkOrganizationSetting(ctx context.Context, ids []string, mode *BulkMode) (*OrganizationSettingBulkDeletePayload, error) {
+	return r.bulkDeleteOrganizationSetting(ctx, ids, mode)
+}
+
+// UpsertBulkCSVOrganizationSetting is the resolver for the upsertBulkCSVOrganizationSetting field.
+func (r *mutationResolver) UpsertBulkCSVOrganizationSetting(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*OrganizationSettingBulkUpsertPayload, error) {
+	return r.bulkUpsertOrganizationSetting(ctx, input, keys, mode)
+}
+
 // OrganizationSetting is the resolver for the organizationSetting field.
 func (r *queryResolver) OrganizationSetting(ctx context.Context, id string) (*generated.OrganizationSetting, error) {
 	res, err := withTransactionalMutation(ctx).OrganizationSetting.Get(ctx, id)
@@ -0,0 +1,83 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/events"
+)
+
+// ErrUnexpectedEventPayload is returned when the in-process publisher receives a payload that is not an outbox message
+var ErrUnexpectedEventPayload = errors.New("unexpected event payload, expected an outbox message")
+
+// outboxEnabled returns true when events should be written to the outbox instead of being published directly
+func outboxEnabled(c *ent.Client) bool {
+	return c.EntConfig != nil && c.EntConfig.Outbox.Enabled
+}
+
+// writeOutboxEvent writes the event produced by the mutation to the outbox using the mutation's client, so the event
+// is committed or rolled back together with the mutation when it runs in a transaction
+func writeOutboxEvent(ctx context.Context, m ent.Mutation, evt *mutationEvent) error {
+	mc, ok := m.(interface{ Client() *ent.Client })
+	if !ok {
+		return nil
+	}
+
+	create := mc.Client().OutboxEvent.Create().
+		SetTopic(evt.eventType).
+		SetAggregateType(evt.objectType).
+		SetAggregateID(evt.objectID).
+		SetPayload(evt.props)
+
+	if orgID, err := auth.GetOrganizationIDFromContext(ctx); err == nil {
+		create.SetOrganizationID(orgID)
+	}
+
+	// the outbox is internal to the service and is not accessible by the user making the request
+	return create.Exec(privacy.DecisionContext(ctx, privacy.Allow))
+}
+
+// EventPublisher publishes the events relayed from the outbox in-process to the event listeners, analytics and
+// the organization's webhooks
+type EventPublisher struct {
+	client *ent.Client
+}
+
+// ensure the in-process publisher satisfies the event publisher interface
+var _ events.EventPublisher = (*EventPublisher)(nil)
+
+// NewEventPublisher returns an in-process event publisher using the ent client
+func NewEventPublisher(c *ent.Client) *EventPublisher {
+	return &EventPublisher{
+		client: c,
+	}
+}
+
+// StartPublisher is a no-op, the in-process publisher does not hold any connections
+func (p *EventPublisher) StartPublisher(_ context.Context) error {
+	return nil
+}
+
+// Publish dispatches the outbox message; listeners and webhook deliveries run in the background, allowed to
+// read the data they need as no user is attached to the relay's context
+func (p *EventPublisher) Publish(ctx context.Context, _ string, payload interface{}) error {
+	msg, ok := payload.(*events.Message)
+	if !ok {
+		return ErrUnexpectedEventPayload
+	}
+
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	// the id of the outbox event is stable across retries, so it is used as the id of the webhook event
+	PublishEvent(allowCtx, p.client, msg.ID, msg.OrganizationID, msg.Topic, msg.AggregateType, msg.Payload)
+
+	return nil
+}
+
+// Close is a no-op, the in-process publisher does not hold any connections
+func (p *EventPublisher) Close(_ context.Context) error {
+	return nil
+}
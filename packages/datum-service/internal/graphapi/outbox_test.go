@@ -0,0 +1,81 @@
+package graphapi_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brianvoe/gofakeit/v7"
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/outboxevent"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/graphapi"
+)
+
+func (suite *GraphTestSuite) TestOutboxEvents() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	suite.client.db.EntConfig.Outbox.Enabled = true
+	defer func() { suite.client.db.EntConfig.Outbox.Enabled = false }()
+
+	graphapi.WithEvents(suite.client.db)
+
+	mock_fga.WriteAny(t, suite.client.fga)
+	mock_fga.ListAny(t, suite.client.fga, []string{fmt.Sprintf("group:%s", testOrgID)})
+
+	defer mock_fga.ClearMocks(suite.client.fga)
+
+	// a rolled back mutation does not leave an event behind
+	tx, err := suite.client.db.Tx(reqCtx)
+	require.NoError(t, err)
+
+	rolledBack := tx.Group.Create().
+		SetName(gofakeit.AppName()).
+		SetOwnerID(testOrgID).
+		SaveX(allowCtx)
+
+	require.NoError(t, tx.Rollback())
+
+	exists, err := suite.client.db.OutboxEvent.Query().
+		Where(outboxevent.AggregateID(rolledBack.ID)).
+		Exist(allowCtx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// a committed mutation writes the event in the same transaction
+	tx, err = suite.client.db.Tx(reqCtx)
+	require.NoError(t, err)
+
+	committed := tx.Group.Create().
+		SetName("meow").
+		SetOwnerID(testOrgID).
+		SaveX(allowCtx)
+
+	require.NoError(t, tx.Commit())
+
+	evt, err := suite.client.db.OutboxEvent.Query().
+		Where(outboxevent.AggregateID(committed.ID)).
+		Only(allowCtx)
+	require.NoError(t, err)
+
+	assert.Equal(t, "group.created", evt.Topic)
+	assert.Equal(t, "group", evt.AggregateType)
+	assert.Equal(t, testOrgID, evt.OrganizationID)
+	assert.Equal(t, "meow", evt.Payload["group_name"])
+	assert.Nil(t, evt.PublishedAt)
+
+	// the outbox is not accessible to users
+	_, err = suite.client.db.OutboxEvent.Query().All(reqCtx)
+	assert.Error(t, err)
+
+	// the in-process publisher only accepts outbox messages
+	err = graphapi.NewEventPublisher(suite.client.db).Publish(context.Background(), "group.created", "meow")
+	assert.ErrorIs(t, err, graphapi.ErrUnexpectedEventPayload)
+}
@@ -75,6 +75,21 @@ func (r *mutationResolver) DeletePersonalAccessToken(ctx context.Context, id str
 	}, nil
 }
 
+// UpdateBulkPersonalAccessToken is the resolver for the updateBulkPersonalAccessToken field.
+func (r *mutationResolver) UpdateBulkPersonalAccessToken(ctx context.Context, ids []string, input generated.UpdatePersonalAccessTokenInput, mode *BulkMode) (*PersonalAccessTokenBulkUpdatePayload, error) {
+	return r.bulkUpdatePersonalAccessToken(ctx, ids, input, mode)
+}
+
+// DeleteBulkPersonalAccessToken is the resolver for the deleteBulkPersonalAccessToken field.
+func (r *mutationResolver) DeleteBulkPersonalAccessToken(ctx context.Context, ids []string, mode *BulkMode) (*PersonalAccessTokenBulkDeletePayload, error) {
+	return r.bulkDeletePersonalAccessToken(ctx, ids, mode)
+}
+
+// UpsertBulkCSVPersonalAccessToken is the resolver for the upsertBulkCSVPersonalAccessToken field.
+func (r *mutationResolver) UpsertBulkCSVPersonalAccessToken(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*PersonalAccessTokenBulkUpsertPayload, error) {
+	return r.bulkUpsertPersonalAccessToken(ctx, input, keys, mode)
+}
+
 // PersonalAccessToken is the resolver for the personalAccessToken field.
 func (r *queryResolver) PersonalAccessToken(ctx context.Context, id string) (*generated.PersonalAccessToken, error) {
 	res, err := withTransactionalMutation(ctx).PersonalAccessToken.Get(ctx, id)
@@ -429,7 +429,7 @@ func (suite *GraphTestSuite) TestLastUsedPersonalAccessToken() {
 	require.NoError(t, err)
 
 	// create new personal access token
-	token := (&PersonalAccessTokenBuilder{client: suite.client}).MustNew(reqCtx, t)
+	token := (&PersonalAccessTokenBuilder{client: suite.client, Scopes: []string{"personalaccesstoken:read", "organization:read"}}).MustNew(reqCtx, t)
 
 	// check that the last used is empty
 	res, err := suite.client.datum.GetPersonalAccessTokenByID(reqCtx, token.ID)
@@ -22,6 +22,7 @@ import (
 	"go.uber.org/zap"
 
 	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/pkg/events/pubsub"
 	"github.com/datumforge/datum/pkg/events/soiree"
 )
 
@@ -49,6 +50,8 @@ type Resolver struct {
 	pool              *soiree.PondPool
 	logger            *zap.SugaredLogger
 	extensionsEnabled bool
+	queryLimits       QueryLimits
+	pubsub            *pubsub.Broker
 }
 
 // NewResolver returns a resolver configured with the given ent client
@@ -70,6 +73,20 @@ func (r Resolver) WithExtensions(enabled bool) *Resolver {
 	return &r
 }
 
+// WithQueryLimits sets the complexity and depth limits of the operations handled by the resolver
+func (r Resolver) WithQueryLimits(limits QueryLimits) *Resolver {
+	r.queryLimits = limits
+
+	return &r
+}
+
+// WithPubSub sets the broker the changes streamed to the graph subscriptions are received from
+func (r Resolver) WithPubSub(b *pubsub.Broker) *Resolver {
+	r.pubsub = b
+
+	return &r
+}
+
 // Handler is an http handler wrapping a Resolver
 type Handler struct {
 	r              *Resolver
@@ -114,9 +131,20 @@ func (r *Resolver) Handler(withPlayground bool) *Handler {
 	// add context level caching
 	WithContextLevelCache(srv)
 
+	// restrict api tokens and personal access tokens to their scopes
+	WithScopes(srv)
+
+	// reject operations exceeding the complexity and depth limits
+	WithQueryLimits(srv, r.queryLimits)
+
 	// add analytics
 	WithEvents(r.client)
 
+	// publish committed changes to the subscriptions if enabled
+	if r.pubsub != nil {
+		WithChanges(r.client, r.pubsub)
+	}
+
 	// add extensions if enabled
 	if r.extensionsEnabled {
 		AddAllExtensions(srv)
@@ -140,8 +168,9 @@ func (r *Resolver) Handler(withPlayground bool) *Handler {
 	return h
 }
 
+// WithEvents adds a global hook that tracks events for mutations on all types and all operations; when the outbox
+// is enabled the event is written to the outbox with the mutation and published by the relay after the commit
 func WithEvents(c *ent.Client) {
-	// Add a global hook that runs on all types and all operations.
 	c.Use(func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 			retVal, err := next.Mutate(ctx, m)
@@ -149,8 +178,26 @@ func WithEvents(c *ent.Client) {
 				return retVal, err
 			}
 
-			if TrackedEvent(m) {
+			if !TrackedEvent(m) {
+				return retVal, nil
+			}
+
+			if !outboxEnabled(c) {
 				CreateEvent(ctx, c, m, retVal)
+
+				return retVal, nil
+			}
+
+			evt, ok := newMutationEvent(m, retVal)
+			if !ok {
+				return retVal, nil
+			}
+
+			// fail the mutation if the event cannot be recorded, so the event is never lost
+			if err := writeOutboxEvent(ctx, m, evt); err != nil {
+				c.Logger.Errorw("unable to write event to outbox", "event", evt.eventType, "error", err)
+
+				return nil, err
 			}
 
 			return retVal, nil
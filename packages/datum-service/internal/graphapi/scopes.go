@@ -0,0 +1,157 @@
+package graphapi
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/datumforge/datum/pkg/auth"
+)
+
+// scopeResourceSuffixes are trimmed from the name of the type returned by a root field to get the scope resource
+var scopeResourceSuffixes = []string{
+	"BulkCreatePayload",
+	"BulkUpdatePayload",
+	"BulkDeletePayload",
+	"BulkUpsertPayload",
+	"BulkImportPayload",
+	"CreatePayload",
+	"UpdatePayload",
+	"DeletePayload",
+	"HistoryConnection",
+	"Connection",
+}
+
+// scopeResourceOverrides maps the types returned by root fields that do not follow the naming of the generated
+// types to their scope resource
+var scopeResourceOverrides = map[string]string{
+	"GlobalSearchResultConnection": "search",
+	"SessionRevokePayload":         "session",
+	"UserSession":                  "session",
+	"WebhookRedeliveryPayload":     "webhookdelivery",
+}
+
+// WithScopes restricts requests authenticated with api tokens and personal access tokens to the operations allowed
+// by their scopes; the root fields require the scope of the operation on the resource they return, and every object
+// resolved in the response requires the read scope on its resource, so a token cannot read other resources through
+// the edges of a resource it has access to
+func WithScopes(h *handler.Server) {
+	h.AroundRootFields(func(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+		fc := graphql.GetRootFieldContext(ctx)
+		if fc == nil || fc.Field.Definition == nil {
+			return next(ctx)
+		}
+
+		// the introspection fields are not restricted
+		if strings.HasPrefix(fc.Field.Name, "__") {
+			return next(ctx)
+		}
+
+		au, err := auth.GetAuthenticatedUserContext(ctx)
+		if err != nil {
+			// unauthenticated requests are rejected by the privacy rules
+			return next(ctx)
+		}
+
+		resource := scopeResource(fc.Field.Definition.Type)
+		action := scopeAction(graphql.GetOperationContext(ctx).Operation.Operation, fc.Field.Name)
+
+		if err := au.CheckScope(resource, action); err != nil {
+			graphql.AddError(ctx, err)
+
+			return graphql.Null
+		}
+
+		return next(ctx)
+	})
+
+	h.AroundFields(func(ctx context.Context, next graphql.Resolver) (any, error) {
+		fc := graphql.GetFieldContext(ctx)
+		if fc == nil || fc.Field.Field == nil {
+			return next(ctx)
+		}
+
+		resource, ok := objectScopeResource(fc.Object)
+		if !ok {
+			return next(ctx)
+		}
+
+		au, err := auth.GetAuthenticatedUserContext(ctx)
+		if err != nil {
+			return next(ctx)
+		}
+
+		// the objects of the resource changed by a mutation are returned with the scope of the mutation
+		if graphql.GetOperationContext(ctx).Operation.Operation == ast.Mutation && resource == rootScopeResource(fc) {
+			return next(ctx)
+		}
+
+		if err := au.CheckScope(resource, auth.ScopeActionRead); err != nil {
+			return nil, err
+		}
+
+		return next(ctx)
+	})
+}
+
+// objectScopeResource returns the scope resource of a resolved object type, false is returned for the types that
+// are not a scope resource such as connections, edges and the root types
+func objectScopeResource(name string) (string, bool) {
+	if r, ok := scopeResourceOverrides[name]; ok {
+		return r, true
+	}
+
+	r := strings.ToLower(strings.TrimSuffix(name, "History"))
+
+	return r, slices.Contains(auth.ScopeResources, r)
+}
+
+// rootScopeResource returns the scope resource of the root field the field is resolved under
+func rootScopeResource(fc *graphql.FieldContext) string {
+	for fc.Parent != nil && fc.Parent.Field.Field != nil {
+		fc = fc.Parent
+	}
+
+	if fc.Field.Field == nil || fc.Field.Definition == nil {
+		return ""
+	}
+
+	return scopeResource(fc.Field.Definition.Type)
+}
+
+// scopeResource returns the scope resource of the type returned by a root field
+func scopeResource(t *ast.Type) string {
+	name := t.Name()
+
+	if r, ok := scopeResourceOverrides[name]; ok {
+		return r
+	}
+
+	for _, suffix := range scopeResourceSuffixes {
+		if trimmed, ok := strings.CutSuffix(name, suffix); ok {
+			name = trimmed
+
+			break
+		}
+	}
+
+	return strings.ToLower(name)
+}
+
+// scopeAction returns the scope action required by a root field; queries read resources, mutations that delete or
+// revoke resources require the delete action and all other mutations require the write action
+func scopeAction(op ast.Operation, field string) string {
+	if op != ast.Mutation {
+		return auth.ScopeActionRead
+	}
+
+	if strings.HasPrefix(field, "delete") || strings.HasPrefix(field, "revoke") {
+		return auth.ScopeActionDelete
+	}
+
+	return auth.ScopeActionWrite
+}
@@ -0,0 +1,215 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/datumclient"
+	"github.com/datumforge/datum/pkg/testutils"
+)
+
+func (suite *GraphTestSuite) TestTokenScopes() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	// least privilege token that can only read organizations, along with their members and settings
+	pat := (&PersonalAccessTokenBuilder{
+		client:          suite.client,
+		OwnerID:         testUser.ID,
+		OrganizationIDs: []string{testOrgID},
+		Scopes:          []string{"organization:read", "orgmembership:read", "user:read", "organizationsetting:read"},
+	}).MustNew(reqCtx, t)
+
+	// the edges of the organization read with the token
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	hook := suite.client.db.Webhook.Create().
+		SetName(gofakeit.AppName()).
+		SetDestinationURL("https://example.com/hook").
+		SetOwnerID(testOrgID).
+		SaveX(allowCtx)
+
+	defer suite.client.db.Webhook.DeleteOneID(hook.ID).ExecX(allowCtx)
+
+	integration := suite.client.db.Integration.Create().
+		SetName(gofakeit.AppName()).
+		SetKind("slack").
+		SetOwnerID(testOrgID).
+		SaveX(allowCtx)
+
+	defer suite.client.db.Integration.DeleteOneID(integration.ID).ExecX(allowCtx)
+
+	(&APITokenBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	client, err := testutils.DatumTestClientWithAuth(t, suite.client.db, datumclient.WithCredentials(datumclient.Authorization{
+		BearerToken: pat.Token,
+	}))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		request  func() error
+		checkFGA bool
+		errorMsg string
+	}{
+		{
+			name: "happy path, read organization",
+			request: func() error {
+				_, err := client.GetOrganizationByID(context.Background(), testOrgID)
+				return err
+			},
+			checkFGA: true,
+		},
+		{
+			name: "read api tokens through the organization not in scopes",
+			request: func() error {
+				return postQuery(client, `query Organization($id: ID!) { organization(id: $id) { id apiTokens { id token } } }`, testOrgID)
+			},
+			checkFGA: true,
+			errorMsg: auth.ErrInsufficientScope.Error(),
+		},
+		{
+			name: "read webhooks through the organization not in scopes",
+			request: func() error {
+				return postQuery(client, `query Organization($id: ID!) { organization(id: $id) { id webhooks { id destinationURL } } }`, testOrgID)
+			},
+			checkFGA: true,
+			errorMsg: auth.ErrInsufficientScope.Error(),
+		},
+		{
+			name: "read integrations through the organization not in scopes",
+			request: func() error {
+				return postQuery(client, `query Organization($id: ID!) { organization(id: $id) { id integrations { id name } } }`, testOrgID)
+			},
+			checkFGA: true,
+			errorMsg: auth.ErrInsufficientScope.Error(),
+		},
+		{
+			name: "create organization not in scopes",
+			request: func() error {
+				_, err := client.CreateOrganization(context.Background(), datumclient.CreateOrganizationInput{
+					Name: gofakeit.Name(),
+				})
+				return err
+			},
+			errorMsg: auth.ErrInsufficientScope.Error(),
+		},
+		{
+			name: "read groups not in scopes",
+			request: func() error {
+				_, err := client.GetAllGroups(context.Background())
+				return err
+			},
+			errorMsg: auth.ErrInsufficientScope.Error(),
+		},
+		{
+			name: "delete group not in scopes",
+			request: func() error {
+				_, err := client.DeleteGroup(context.Background(), "notarealid")
+				return err
+			},
+			errorMsg: auth.ErrInsufficientScope.Error(),
+		},
+		{
+			name: "bulk delete entities not in scopes",
+			request: func() error {
+				_, err := client.DeleteBulkEntity(context.Background(), []string{"notarealid"}, nil)
+				return err
+			},
+			errorMsg: auth.ErrInsufficientScope.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			if tc.checkFGA {
+				mock_fga.CheckAny(t, suite.client.fga, true)
+			}
+
+			err := tc.request()
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+// postQuery sends the query with the id variable using the graph client
+func postQuery(client *datumclient.DatumClient, query, id string) error {
+	var out struct {
+		Organization map[string]any `json:"organization"`
+	}
+
+	return client.DatumGraphClient.(*datumclient.Client).Client.Post(context.Background(), "Organization", query, &out, map[string]any{"id": id})
+}
+
+func (suite *GraphTestSuite) TestTokenScopesValidation() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		scopes   []string
+		errorMsg string
+	}{
+		{
+			name:   "happy path, resource scopes",
+			scopes: []string{"organization:read", "group:write"},
+		},
+		{
+			name:   "happy path, wildcard scope",
+			scopes: []string{"*:read"},
+		},
+		{
+			name:     "unknown resource",
+			scopes:   []string{"tacos:read"},
+			errorMsg: auth.ErrInvalidScope.Error(),
+		},
+		{
+			name:     "unknown action",
+			scopes:   []string{"organization:eat"},
+			errorMsg: auth.ErrInvalidScope.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Create "+tc.name, func(t *testing.T) {
+			resp, err := suite.client.datum.CreatePersonalAccessToken(reqCtx, datumclient.CreatePersonalAccessTokenInput{
+				Name:            gofakeit.AppName(),
+				Scopes:          tc.scopes,
+				OrganizationIDs: []string{testOrgID},
+			})
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, tc.scopes, resp.CreatePersonalAccessToken.PersonalAccessToken.Scopes)
+		})
+	}
+}
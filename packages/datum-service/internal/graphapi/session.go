@@ -0,0 +1,150 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/datumforge/fgax"
+	"github.com/redis/go-redis/v9"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/sessions"
+)
+
+// sessionStore returns the persistent store of the sessions
+func sessionStore(c *ent.Client) (sessions.PersistentStore, error) {
+	if c.SessionConfig == nil || c.SessionConfig.RedisStore == nil {
+		return nil, ErrSessionsNotEnabled
+	}
+
+	return c.SessionConfig.RedisStore, nil
+}
+
+// userSessions returns the sessions of the user, the authenticated user is used when no user ID is provided
+func userSessions(ctx context.Context, c *ent.Client, userID *string) ([]*UserSession, error) {
+	store, err := sessionStore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := checkSessionAccess(ctx, c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := store.GetUserSessions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the session is only in the context of requests authenticated with the session cookie
+	current, _ := sessions.SessionIDFromContext(ctx)
+
+	out := make([]*UserSession, 0, len(metadata))
+
+	for _, m := range metadata {
+		s := &UserSession{
+			ID:      m.ID,
+			UserID:  m.UserID,
+			Current: m.ID == current,
+		}
+
+		if m.UserAgent != "" {
+			s.UserAgent = &m.UserAgent
+		}
+
+		if m.IPAddress != "" {
+			s.IPAddress = &m.IPAddress
+		}
+
+		if !m.CreatedAt.IsZero() {
+			s.CreatedAt = &m.CreatedAt
+		}
+
+		if !m.LastActivity.IsZero() {
+			s.LastActivity = &m.LastActivity
+		}
+
+		out = append(out, s)
+	}
+
+	return out, nil
+}
+
+// revokeSession deletes the session from the store so it can no longer be used
+func revokeSession(ctx context.Context, c *ent.Client, id string) error {
+	store, err := sessionStore(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := store.GetSession(ctx, id)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return ErrSessionNotFound
+		}
+
+		return err
+	}
+
+	if _, err := checkSessionAccess(ctx, c, &userID); err != nil {
+		// do not disclose the existence of sessions of other users
+		if errors.Is(err, ErrPermissionDenied) {
+			return ErrSessionNotFound
+		}
+
+		return err
+	}
+
+	return store.DeleteSession(ctx, id)
+}
+
+// checkSessionAccess returns the ID of the user whose sessions are accessed; users can access their own sessions and
+// admins of the organization in the context can access the sessions of its members
+func checkSessionAccess(ctx context.Context, c *ent.Client, userID *string) (string, error) {
+	authUserID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if userID == nil || *userID == "" || *userID == authUserID {
+		return authUserID, nil
+	}
+
+	orgID, err := auth.GetOrganizationIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	allow, err := c.Authz.CheckOrgWriteAccess(ctx, fgax.AccessCheck{
+		SubjectID:   authUserID,
+		SubjectType: auth.GetAuthzSubjectType(ctx),
+		ObjectID:    orgID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if !allow {
+		return "", ErrPermissionDenied
+	}
+
+	// the admin access was checked above, the membership is looked up without checking access again
+	member, err := c.OrgMembership.Query().
+		Where(
+			orgmembership.UserID(*userID),
+			orgmembership.OrganizationID(orgID),
+		).Exist(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		return "", err
+	}
+
+	if !member {
+		return "", ErrPermissionDenied
+	}
+
+	return *userID, nil
+}
@@ -0,0 +1,28 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+)
+
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (*SessionRevokePayload, error) {
+	if err := revokeSession(ctx, withTransactionalMutation(ctx), id); err != nil {
+		return nil, parseRequestError(err, action{action: ActionDelete, object: "session"}, r.logger)
+	}
+
+	return &SessionRevokePayload{DeletedID: id}, nil
+}
+
+// UserSessions is the resolver for the userSessions field.
+func (r *queryResolver) UserSessions(ctx context.Context, userID *string) ([]*UserSession, error) {
+	res, err := userSessions(ctx, withTransactionalMutation(ctx), userID)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "session"}, r.logger)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,231 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/graphapi"
+	"github.com/datumforge/datum/pkg/datumclient"
+	"github.com/datumforge/datum/pkg/sessions"
+)
+
+func (suite *GraphTestSuite) TestQueryUserSessions() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	store := suite.client.db.SessionConfig.RedisStore
+
+	member := (&UserBuilder{client: suite.client}).MustNew(reqCtx, t)
+	(&OrgMemberBuilder{client: suite.client, OrgID: testOrgID, UserID: member.ID}).MustNew(reqCtx, t)
+
+	nonMember := (&UserBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	userSession := mustStoreSession(reqCtx, t, store, testUser.ID, "datum-cli/1.0")
+	memberSession := mustStoreSession(reqCtx, t, store, member.ID, "Mozilla/5.0")
+	mustStoreSession(reqCtx, t, store, nonMember.ID, "Mozilla/5.0")
+
+	testCases := []struct {
+		name      string
+		userID    *string
+		allowed   bool
+		checkFGA  bool
+		sessionID string
+		userAgent string
+		errorMsg  string
+	}{
+		{
+			name:      "happy path, sessions of the user",
+			sessionID: userSession,
+			userAgent: "datum-cli/1.0",
+		},
+		{
+			name:      "happy path, own user id",
+			userID:    &testUser.ID,
+			sessionID: userSession,
+			userAgent: "datum-cli/1.0",
+		},
+		{
+			name:      "happy path, org admin listing sessions of a member",
+			userID:    &member.ID,
+			checkFGA:  true,
+			allowed:   true,
+			sessionID: memberSession,
+			userAgent: "Mozilla/5.0",
+		},
+		{
+			name:     "not an admin of the org",
+			userID:   &member.ID,
+			checkFGA: true,
+			allowed:  false,
+			errorMsg: graphapi.ErrPermissionDenied.Error(),
+		},
+		{
+			name:     "user not a member of the org",
+			userID:   &nonMember.ID,
+			checkFGA: true,
+			allowed:  true,
+			errorMsg: graphapi.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Get "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			if tc.checkFGA {
+				mock_fga.CheckAny(t, suite.client.fga, tc.allowed)
+			}
+
+			resp, err := suite.client.datum.GetUserSessions(reqCtx, tc.userID)
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			// creating the organization of the test user also creates a session
+			session, ok := lo.Find(resp.UserSessions, func(s *datumclient.GetUserSessions_UserSessions) bool {
+				return s.ID == tc.sessionID
+			})
+			require.True(t, ok)
+
+			owner := testUser.ID
+			if tc.userID != nil {
+				owner = *tc.userID
+			}
+
+			for _, s := range resp.UserSessions {
+				assert.Equal(t, owner, s.UserID)
+			}
+
+			assert.Equal(t, tc.userAgent, *session.UserAgent)
+			assert.Equal(t, "10.0.0.1", *session.IPAddress)
+			assert.NotNil(t, session.CreatedAt)
+			assert.NotNil(t, session.LastActivity)
+			assert.False(t, session.Current)
+		})
+	}
+
+	(&UserCleanup{client: suite.client, ID: member.ID}).MustDelete(reqCtx, t)
+	(&UserCleanup{client: suite.client, ID: nonMember.ID}).MustDelete(reqCtx, t)
+}
+
+func (suite *GraphTestSuite) TestMutationRevokeSession() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	store := suite.client.db.SessionConfig.RedisStore
+
+	member := (&UserBuilder{client: suite.client}).MustNew(reqCtx, t)
+	(&OrgMemberBuilder{client: suite.client, OrgID: testOrgID, UserID: member.ID}).MustNew(reqCtx, t)
+
+	nonMember := (&UserBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	userSession := mustStoreSession(reqCtx, t, store, testUser.ID, "datum-cli/1.0")
+	memberSession := mustStoreSession(reqCtx, t, store, member.ID, "Mozilla/5.0")
+	nonMemberSession := mustStoreSession(reqCtx, t, store, nonMember.ID, "Mozilla/5.0")
+
+	testCases := []struct {
+		name      string
+		sessionID string
+		userID    string
+		checkFGA  bool
+		errorMsg  string
+	}{
+		{
+			name:      "happy path, session of the user",
+			sessionID: userSession,
+			userID:    testUser.ID,
+		},
+		{
+			name:      "session already revoked",
+			sessionID: userSession,
+			userID:    testUser.ID,
+			errorMsg:  graphapi.ErrSessionNotFound.Error(),
+		},
+		{
+			name:      "session of another user, not found",
+			sessionID: nonMemberSession,
+			userID:    nonMember.ID,
+			checkFGA:  true,
+			errorMsg:  graphapi.ErrSessionNotFound.Error(),
+		},
+		{
+			name:      "happy path, org admin revoking the session of a member",
+			sessionID: memberSession,
+			userID:    member.ID,
+			checkFGA:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Revoke "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			if tc.checkFGA {
+				mock_fga.CheckAny(t, suite.client.fga, true)
+			}
+
+			resp, err := suite.client.datum.RevokeSession(reqCtx, tc.sessionID)
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, tc.sessionID, resp.RevokeSession.DeletedID)
+
+			// the session is no longer listed
+			userSessions, err := store.GetUserSessions(reqCtx, tc.userID)
+			require.NoError(t, err)
+			assert.False(t, lo.ContainsBy(userSessions, func(s *sessions.SessionMetadata) bool {
+				return s.ID == tc.sessionID
+			}))
+		})
+	}
+
+	// the session of the user outside of the org is not revoked
+	exists, err := store.Exists(reqCtx, nonMemberSession)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), exists)
+
+	(&UserCleanup{client: suite.client, ID: member.ID}).MustDelete(reqCtx, t)
+	(&UserCleanup{client: suite.client, ID: nonMember.ID}).MustDelete(reqCtx, t)
+}
+
+// mustStoreSession stores a session with metadata for the user and returns the session ID
+func mustStoreSession(ctx context.Context, t *testing.T, store sessions.PersistentStore, userID, userAgent string) string {
+	sessionID := sessions.GenerateSessionID()
+
+	require.NoError(t, store.StoreSessionWithExpiration(ctx, sessionID, userID, time.Hour))
+	require.NoError(t, store.StoreSessionMetadata(ctx, &sessions.SessionMetadata{
+		ID:           sessionID,
+		UserID:       userID,
+		UserAgent:    userAgent,
+		IPAddress:    "10.0.0.1",
+		CreatedAt:    time.Now(),
+		LastActivity: time.Now(),
+	}, time.Hour))
+
+	return sessionID
+}
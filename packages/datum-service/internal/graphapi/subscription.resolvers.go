@@ -0,0 +1,93 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/events/pubsub"
+	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/webhooks"
+)
+
+// EventCreated is the resolver for the eventCreated field.
+func (r *subscriptionResolver) EventCreated(ctx context.Context, types []string) (<-chan *ChangeEvent, error) {
+	if r.pubsub == nil {
+		return nil, ErrSubscriptionsNotEnabled
+	}
+
+	if err := webhooks.ValidateEventTypes(types); err != nil {
+		return nil, err
+	}
+
+	viewer, err := r.newChangeViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.subscribe(ctx, func(c *pubsub.Change) bool {
+		return webhooks.IsTracked(c.ObjectType, c.Operation) &&
+			webhooks.Subscribed(types, nil, c.EventType, c.ObjectType) &&
+			viewer.canViewChange(ctx, c)
+	}), nil
+}
+
+// EntityChanged is the resolver for the entityChanged field.
+func (r *subscriptionResolver) EntityChanged(ctx context.Context, id string) (<-chan *ChangeEvent, error) {
+	if r.pubsub == nil {
+		return nil, ErrSubscriptionsNotEnabled
+	}
+
+	// the entity must be readable by the subscriber to stream its changes
+	e, err := r.client.Entity.Get(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "entity"}, r.logger)
+	}
+
+	viewer, err := r.newChangeViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.subscribe(ctx, func(c *pubsub.Change) bool {
+		return c.ObjectType == "entity" && c.ObjectID == e.ID && viewer.canViewOrg(ctx, e.OwnerID)
+	}), nil
+}
+
+// OrgMembershipChanged is the resolver for the orgMembershipChanged field.
+func (r *subscriptionResolver) OrgMembershipChanged(ctx context.Context, organizationID *string) (<-chan *ChangeEvent, error) {
+	if r.pubsub == nil {
+		return nil, ErrSubscriptionsNotEnabled
+	}
+
+	// default to the authorized organization, e.g. for personal access tokens the organization is required
+	orgID, _ := auth.GetOrganizationIDFromContext(ctx)
+	if organizationID != nil {
+		orgID = *organizationID
+	}
+
+	if orgID == "" {
+		return nil, rout.NewMissingRequiredFieldError("organization_id")
+	}
+
+	viewer, err := r.newChangeViewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !viewer.canViewOrg(ctx, orgID) {
+		return nil, newPermissionDeniedError(ActionGet, "organization")
+	}
+
+	return r.subscribe(ctx, func(c *pubsub.Change) bool {
+		return c.ObjectType == "orgmembership" && c.OrganizationID == orgID && viewer.canViewOrg(ctx, orgID)
+	}), nil
+}
+
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,357 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/datumforge/fgax"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/apitoken"
+	"github.com/datumforge/datum/internal/ent/generated/contact"
+	"github.com/datumforge/datum/internal/ent/generated/documentdata"
+	"github.com/datumforge/datum/internal/ent/generated/entitlement"
+	"github.com/datumforge/datum/internal/ent/generated/entitlementplan"
+	"github.com/datumforge/datum/internal/ent/generated/entitlementplanfeature"
+	"github.com/datumforge/datum/internal/ent/generated/entity"
+	"github.com/datumforge/datum/internal/ent/generated/entitytype"
+	"github.com/datumforge/datum/internal/ent/generated/event"
+	"github.com/datumforge/datum/internal/ent/generated/feature"
+	"github.com/datumforge/datum/internal/ent/generated/file"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/groupsetting"
+	"github.com/datumforge/datum/internal/ent/generated/hush"
+	"github.com/datumforge/datum/internal/ent/generated/integration"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/note"
+	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
+	"github.com/datumforge/datum/internal/ent/generated/ohauthtootoken"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/subscriber"
+	"github.com/datumforge/datum/internal/ent/generated/template"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
+	"github.com/datumforge/datum/internal/ent/generated/tfasetting"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/events/pubsub"
+	"github.com/datumforge/datum/pkg/utils/ulids"
+	"github.com/datumforge/datum/pkg/webhooks"
+)
+
+// untrackedChanges are the object types whose changes are internal to the service and are not published
+var untrackedChanges = []string{"OutboxEvent"}
+
+// ownerOnlyChanges are the object types that are only readable by their owner rather than by every member of the
+// organization, deletes of these objects are not streamed as the deleted object can no longer be checked
+var ownerOnlyChanges = []string{
+	"apitoken",
+	"hush",
+	"ohauthtootoken",
+	"personalaccesstoken",
+	"tfasetting",
+	"user",
+	"usersetting",
+	"webhook",
+	"webhookdelivery",
+}
+
+// changeTables are the tables of the object types that can be queried, changes of other object types are not streamed
+var changeTables = map[string]string{
+	"apitoken":               apitoken.Table,
+	"contact":                contact.Table,
+	"documentdata":           documentdata.Table,
+	"entitlement":            entitlement.Table,
+	"entitlementplan":        entitlementplan.Table,
+	"entitlementplanfeature": entitlementplanfeature.Table,
+	"entity":                 entity.Table,
+	"entitytype":             entitytype.Table,
+	"event":                  event.Table,
+	"feature":                feature.Table,
+	"file":                   file.Table,
+	"group":                  group.Table,
+	"groupmembership":        groupmembership.Table,
+	"groupsetting":           groupsetting.Table,
+	"hush":                   hush.Table,
+	"integration":            integration.Table,
+	"invite":                 invite.Table,
+	"note":                   note.Table,
+	"oauthprovider":          oauthprovider.Table,
+	"ohauthtootoken":         ohauthtootoken.Table,
+	"organization":           organization.Table,
+	"organizationsetting":    organizationsetting.Table,
+	"orgmembership":          orgmembership.Table,
+	"personalaccesstoken":    personalaccesstoken.Table,
+	"subscriber":             subscriber.Table,
+	"template":               template.Table,
+	"templateversion":        templateversion.Table,
+	"tfasetting":             tfasetting.Table,
+	"user":                   user.Table,
+	"usersetting":            usersetting.Table,
+	"webhook":                webhook.Table,
+	"webhookdelivery":        webhookdelivery.Table,
+}
+
+// WithChanges adds a global hook that publishes the changes of all objects to the broker to be streamed to the
+// graph subscriptions; changes made in a transaction are only published once the transaction is committed
+func WithChanges(c *ent.Client, b *pubsub.Broker) {
+	c.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			// the operation is read before the mutation runs, soft deletes are turned into updates
+			op := getOp(m)
+
+			retVal, err := next.Mutate(ctx, m)
+			if err != nil {
+				return retVal, err
+			}
+
+			change, ok := newChange(ctx, m, op, retVal)
+			if !ok {
+				return retVal, nil
+			}
+
+			publish := func(ctx context.Context) {
+				if err := b.Publish(context.WithoutCancel(ctx), change); err != nil {
+					c.Logger.Errorw("unable to publish change", "event", change.EventType, "error", err)
+				}
+			}
+
+			if mt, ok := m.(interface{ Tx() (*ent.Tx, error) }); ok {
+				if tx, err := mt.Tx(); err == nil {
+					mutationCtx := ctx
+
+					tx.OnCommit(func(next ent.Committer) ent.Committer {
+						return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+							if err := next.Commit(ctx, tx); err != nil {
+								return err
+							}
+
+							// changes of bulk mutation rows that were rolled back to a savepoint were never committed
+							if isUndone(mutationCtx) {
+								return nil
+							}
+
+							publish(ctx)
+
+							return nil
+						})
+					})
+
+					return retVal, nil
+				}
+			}
+
+			publish(ctx)
+
+			return retVal, nil
+		})
+	})
+}
+
+// newChange returns the change of the mutation, false is returned for untracked object types and mutations
+// of many objects
+func newChange(ctx context.Context, m ent.Mutation, op string, v ent.Value) (*pubsub.Change, bool) {
+	if op == "" || strings.HasSuffix(m.Type(), "History") || slices.Contains(untrackedChanges, m.Type()) {
+		return nil, false
+	}
+
+	// deletes do not return the object, so the values are empty
+	out, _ := parseValue(v)
+
+	id, _ := out["id"].(string)
+	if id == "" {
+		if mi, ok := m.(interface{ ID() (string, bool) }); ok {
+			id, _ = mi.ID()
+		}
+	}
+
+	if id == "" {
+		return nil, false
+	}
+
+	obj := strings.ToLower(m.Type())
+
+	// objects of an organization other than the one of the request, e.g. memberships, have their organization set
+	orgID, _ := out["organization_id"].(string)
+
+	switch {
+	case obj == "organization":
+		orgID = id
+	case orgID == "":
+		orgID, _ = auth.GetOrganizationIDFromContext(ctx)
+	}
+
+	return &pubsub.Change{
+		ID:             ulids.New().String(),
+		EventType:      webhooks.EventType(obj, op),
+		Operation:      op,
+		ObjectType:     obj,
+		ObjectID:       id,
+		OrganizationID: orgID,
+		CreatedAt:      time.Now(),
+	}, true
+}
+
+// subscribe streams the changes matching the filter until the subscription is closed
+func (r *subscriptionResolver) subscribe(ctx context.Context, match func(*pubsub.Change) bool) <-chan *ChangeEvent {
+	changes := r.pubsub.Subscribe(ctx)
+	out := make(chan *ChangeEvent)
+
+	go func() {
+		defer close(out)
+
+		for c := range changes {
+			if !match(c) {
+				continue
+			}
+
+			select {
+			case out <- toChangeEvent(c):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// orgAccessTTL is how long the access of a subscriber to an organization is cached, so revoked access stops the
+// stream without checking the organization for every change
+const orgAccessTTL = time.Minute
+
+// changeViewer checks the access of the subscriber of a subscription to the streamed changes, changes are only
+// matched by the goroutine of the subscription so the cache is not shared
+type changeViewer struct {
+	r  *subscriptionResolver
+	au *auth.AuthenticatedUser
+	// orgs caches the access to the organizations of the changes until it expires
+	orgs map[string]orgAccess
+}
+
+// orgAccess is the cached access of the subscriber to an organization
+type orgAccess struct {
+	allowed bool
+	expires time.Time
+}
+
+// newChangeViewer returns the viewer of the changes for the authenticated subscriber
+func (r *subscriptionResolver) newChangeViewer(ctx context.Context) (*changeViewer, error) {
+	au, err := auth.GetAuthenticatedUserContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &changeViewer{
+		r:    r,
+		au:   au,
+		orgs: map[string]orgAccess{},
+	}, nil
+}
+
+// canViewChange returns true if the subscriber is allowed to view the changed object; changes are checked against
+// the organization they were made in and the changed object is read with the same checks as queries of the object,
+// changes outside of an organization are only streamed to the changed user
+func (v *changeViewer) canViewChange(ctx context.Context, c *pubsub.Change) bool {
+	if c.OrganizationID == "" {
+		return c.ObjectID == v.au.SubjectID
+	}
+
+	if !v.canViewOrg(ctx, c.OrganizationID) {
+		return false
+	}
+
+	// the relationships of a deleted object are removed with the object, so only the organization can be checked
+	if c.Operation == ActionDelete {
+		return !slices.Contains(ownerOnlyChanges, c.ObjectType)
+	}
+
+	return v.canReadObject(ctx, c)
+}
+
+// canReadObject returns true if the changed object can be read by the subscriber, the object is queried with the
+// context of the subscriber so the privacy rules and interceptors of queries of the object are applied
+func (v *changeViewer) canReadObject(ctx context.Context, c *pubsub.Change) bool {
+	table, ok := changeTables[c.ObjectType]
+	if !ok {
+		return false
+	}
+
+	if _, err := v.r.client.Noder(ctx, c.ObjectID, ent.WithFixedNodeType(table)); err != nil {
+		if !ent.IsNotFound(err) && !errors.Is(err, privacy.Deny) {
+			v.r.logger.Errorw("unable to read changed object", "object", c.ObjectType, "id", c.ObjectID, "error", err)
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// canViewOrg returns true if the organization is authorized for the request and the subscriber can view it,
+// changes of other organizations are dropped before the access is checked
+func (v *changeViewer) canViewOrg(ctx context.Context, orgID string) bool {
+	if orgID != v.au.OrganizationID && !slices.Contains(v.au.OrganizationIDs, orgID) {
+		return false
+	}
+
+	if access, ok := v.orgs[orgID]; ok && time.Now().Before(access.expires) {
+		return access.allowed
+	}
+
+	allowed := v.checkAccess(ctx, fgax.AccessCheck{
+		ObjectType: "organization",
+		ObjectID:   orgID,
+		Relation:   fgax.CanView,
+	})
+
+	v.orgs[orgID] = orgAccess{
+		allowed: allowed,
+		expires: time.Now().Add(orgAccessTTL),
+	}
+
+	return allowed
+}
+
+// checkAccess checks the access of the subscriber, errors are logged and deny access
+func (v *changeViewer) checkAccess(ctx context.Context, ac fgax.AccessCheck) bool {
+	ac.SubjectID = v.au.SubjectID
+	ac.SubjectType = auth.GetAuthzSubjectType(ctx)
+
+	allowed, err := v.r.client.Authz.CheckAccess(ctx, ac)
+	if err != nil {
+		v.r.logger.Errorw("unable to check access to change", "object", ac.ObjectType, "id", ac.ObjectID, "error", err)
+
+		return false
+	}
+
+	return allowed
+}
+
+// toChangeEvent returns the graph representation of the change
+func toChangeEvent(c *pubsub.Change) *ChangeEvent {
+	e := &ChangeEvent{
+		ID:         c.ID,
+		EventType:  c.EventType,
+		Operation:  c.Operation,
+		ObjectType: c.ObjectType,
+		ObjectID:   c.ObjectID,
+		CreatedAt:  c.CreatedAt,
+	}
+
+	if c.OrganizationID != "" {
+		e.OrganizationID = &c.OrganizationID
+	}
+
+	return e
+}
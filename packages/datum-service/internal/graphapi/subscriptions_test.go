@@ -0,0 +1,309 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/graphapi"
+	"github.com/datumforge/datum/pkg/datumclient"
+	"github.com/datumforge/datum/pkg/enums"
+	"github.com/datumforge/datum/pkg/events/pubsub"
+	"github.com/datumforge/datum/pkg/utils/ulids"
+)
+
+// receiveChange returns the next event of the subscription or fails the test when none is received in time
+func receiveChange(t *testing.T, ch <-chan *graphapi.ChangeEvent) *graphapi.ChangeEvent {
+	t.Helper()
+
+	select {
+	case e, ok := <-ch:
+		require.True(t, ok, "subscription closed")
+
+		return e
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no change received")
+	}
+
+	return nil
+}
+
+// countChecks returns the number of access checks made with the mock FGA client
+func countChecks(c *mock_fga.MockSdkClient) int {
+	return len(lo.Filter(c.Calls, func(call mock.Call, _ int) bool {
+		return call.Method == "Check"
+	}))
+}
+
+func (suite *GraphTestSuite) TestSubscriptionEventCreated() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	otherOrgID := ulids.New().String()
+
+	testCases := []struct {
+		name        string
+		types       []string
+		checked     bool
+		allowed     bool
+		changes     []*pubsub.Change
+		expected    *pubsub.Change
+		expectedErr string
+	}{
+		{
+			name:  "happy path, untracked, unsubscribed and other organization changes are not streamed",
+			types: []string{"organization.created", "user.created"},
+			changes: []*pubsub.Change{
+				{EventType: "orgmembership.created", Operation: "create", ObjectType: "orgmembership", ObjectID: ulids.New().String(), OrganizationID: testOrgID},
+				{EventType: "organization.deleted", Operation: "delete", ObjectType: "organization", ObjectID: testOrgID, OrganizationID: testOrgID},
+				{EventType: "organization.created", Operation: "create", ObjectType: "organization", ObjectID: otherOrgID, OrganizationID: otherOrgID},
+				{EventType: "user.created", Operation: "create", ObjectType: "user", ObjectID: ulids.New().String()},
+			},
+			expected: &pubsub.Change{EventType: "user.created", Operation: "create", ObjectType: "user", ObjectID: testUser.ID},
+		},
+		{
+			name:    "happy path, all events",
+			checked: true,
+			allowed: true,
+			expected: &pubsub.Change{
+				EventType: "organization.created", Operation: "create", ObjectType: "organization", ObjectID: testOrgID, OrganizationID: testOrgID,
+			},
+		},
+		{
+			name:    "changed objects that cannot be read by the subscriber are not streamed",
+			checked: true,
+			allowed: true,
+			changes: []*pubsub.Change{
+				{EventType: "personalaccesstoken.created", Operation: "create", ObjectType: "personalaccesstoken", ObjectID: ulids.New().String(), OrganizationID: testOrgID},
+				{EventType: "apitoken.deleted", Operation: "delete", ObjectType: "apitoken", ObjectID: ulids.New().String(), OrganizationID: testOrgID},
+			},
+			expected: &pubsub.Change{EventType: "user.created", Operation: "create", ObjectType: "user", ObjectID: testUser.ID},
+		},
+		{
+			name:    "no access to the organization, access is checked once for the subscription",
+			checked: true,
+			allowed: false,
+			changes: []*pubsub.Change{
+				{EventType: "organization.created", Operation: "create", ObjectType: "organization", ObjectID: testOrgID, OrganizationID: testOrgID},
+				{EventType: "organization.updated", Operation: "update", ObjectType: "organization", ObjectID: testOrgID, OrganizationID: testOrgID},
+				{EventType: "organization.created", Operation: "create", ObjectType: "organization", ObjectID: otherOrgID, OrganizationID: otherOrgID},
+			},
+			expected: &pubsub.Change{EventType: "user.created", Operation: "create", ObjectType: "user", ObjectID: testUser.ID},
+		},
+		{
+			name:        "invalid event type",
+			types:       []string{"organization.exploded"},
+			expectedErr: "invalid webhook event type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			ctx, cancel := context.WithCancel(reqCtx)
+			defer cancel()
+
+			// each case has its own broker so the changes are not matched by the subscriptions of other cases
+			broker := pubsub.NewBroker()
+			resolver := graphapi.NewResolver(suite.client.db).WithLogger(zap.NewNop().Sugar()).WithPubSub(broker)
+
+			checks := countChecks(suite.client.fga)
+
+			// only changes of the authorized organizations are checked
+			if tc.checked {
+				mock_fga.CheckAny(t, suite.client.fga, tc.allowed)
+			}
+
+			sub, err := resolver.Subscription().EventCreated(ctx, tc.types)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			// the changes are dispatched in order, so the expected change is only received if the others were dropped
+			for _, c := range append(tc.changes, tc.expected) {
+				c.ID = ulids.New().String()
+				require.NoError(t, broker.Publish(ctx, c))
+			}
+
+			e := receiveChange(t, sub)
+			assert.Equal(t, tc.expected.ID, e.ID)
+			assert.Equal(t, tc.expected.EventType, e.EventType)
+			assert.Equal(t, tc.expected.ObjectID, e.ObjectID)
+
+			// the access to the organization is cached and other organizations are never checked, reading the changed
+			// objects of an allowed organization checks the access to the objects
+			if !tc.allowed {
+				assert.Equal(t, lo.Ternary(tc.checked, 1, 0), countChecks(suite.client.fga)-checks)
+			}
+		})
+	}
+
+	// subscriptions require the broker
+	_, err = graphapi.NewResolver(suite.client.db).Subscription().EventCreated(reqCtx, nil)
+	assert.ErrorIs(t, err, graphapi.ErrSubscriptionsNotEnabled)
+}
+
+func (suite *GraphTestSuite) TestSubscriptionOrgMembershipChanged() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	broker := pubsub.NewBroker()
+	graphapi.WithChanges(suite.client.db, broker)
+
+	resolver := graphapi.NewResolver(suite.client.db).WithLogger(zap.NewNop().Sugar()).WithPubSub(broker)
+
+	user := (&UserBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	ctx, cancel := context.WithCancel(reqCtx)
+
+	defer mock_fga.ClearMocks(suite.client.fga)
+	defer cancel()
+
+	mock_fga.CheckAny(t, suite.client.fga, true)
+	mock_fga.WriteAny(t, suite.client.fga)
+
+	// an organization that is not authorized for the request cannot be subscribed to
+	otherOrgID := ulids.New().String()
+
+	_, err = resolver.Subscription().OrgMembershipChanged(ctx, &otherOrgID)
+	require.Error(t, err)
+
+	sub, err := resolver.Subscription().OrgMembershipChanged(ctx, nil)
+	require.NoError(t, err)
+
+	// changes made in a transaction are streamed once it is committed
+	tx, err := suite.client.db.Tx(allowCtx)
+	require.NoError(t, err)
+
+	om, err := tx.OrgMembership.Create().
+		SetUserID(user.ID).
+		SetOrganizationID(testOrgID).
+		SetRole(enums.RoleMember).
+		Save(allowCtx)
+	require.NoError(t, err)
+
+	assert.Empty(t, sub)
+
+	require.NoError(t, tx.Commit())
+
+	e := receiveChange(t, sub)
+	assert.Equal(t, "orgmembership.created", e.EventType)
+	assert.Equal(t, "create", e.Operation)
+	assert.Equal(t, om.ID, e.ObjectID)
+	require.NotNil(t, e.OrganizationID)
+	assert.Equal(t, testOrgID, *e.OrganizationID)
+
+	// changes of a rolled back transaction are not streamed
+	tx, err = suite.client.db.Tx(allowCtx)
+	require.NoError(t, err)
+
+	_, err = tx.OrgMembership.UpdateOneID(om.ID).SetRole(enums.RoleAdmin).Save(allowCtx)
+	require.NoError(t, err)
+
+	require.NoError(t, tx.Rollback())
+
+	// changes made outside of a transaction are streamed immediately
+	suite.client.db.OrgMembership.UpdateOneID(om.ID).SetRole(enums.RoleAdmin).ExecX(allowCtx)
+
+	e = receiveChange(t, sub)
+	assert.Equal(t, "orgmembership.updated", e.EventType)
+	assert.Equal(t, om.ID, e.ObjectID)
+}
+
+func (suite *GraphTestSuite) TestSubscriptionEntityChanged() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	broker := pubsub.NewBroker()
+	graphapi.WithChanges(suite.client.db, broker)
+
+	resolver := graphapi.NewResolver(suite.client.db).WithLogger(zap.NewNop().Sugar()).WithPubSub(broker)
+
+	entity := (&EntityBuilder{client: suite.client}).MustNew(reqCtx, t)
+	other := (&EntityBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	// the entity is queried with the field context of the subscription, as it is when served
+	ctx, cancel := context.WithCancel(graphql.WithFieldContext(reqCtx, &graphql.FieldContext{Args: map[string]any{"id": entity.ID}}))
+
+	defer mock_fga.ClearMocks(suite.client.fga)
+	defer cancel()
+
+	mock_fga.CheckAny(t, suite.client.fga, true)
+
+	_, err = resolver.Subscription().EntityChanged(ctx, ulids.New().String())
+	require.Error(t, err)
+
+	sub, err := resolver.Subscription().EntityChanged(ctx, entity.ID)
+	require.NoError(t, err)
+
+	// changes of other entities are not streamed
+	suite.client.db.Entity.UpdateOneID(other.ID).SetDisplayName("other").ExecX(allowCtx)
+	suite.client.db.Entity.UpdateOneID(entity.ID).SetDisplayName("updated").ExecX(allowCtx)
+
+	e := receiveChange(t, sub)
+	assert.Equal(t, "entity.updated", e.EventType)
+	assert.Equal(t, "update", e.Operation)
+	assert.Equal(t, entity.ID, e.ObjectID)
+}
+
+func (suite *GraphTestSuite) TestSubscriptionBulkUndone() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	broker := pubsub.NewBroker()
+	graphapi.WithChanges(suite.client.db, broker)
+
+	resolver := graphapi.NewResolver(suite.client.db).WithLogger(zap.NewNop().Sugar()).WithPubSub(broker)
+
+	entity := (&EntityBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	ctx, cancel := context.WithCancel(graphql.WithFieldContext(reqCtx, &graphql.FieldContext{Args: map[string]any{"id": entity.ID}}))
+
+	defer mock_fga.ClearMocks(suite.client.fga)
+	defer cancel()
+
+	mock_fga.CheckAny(t, suite.client.fga, true)
+
+	sub, err := resolver.Subscription().EntityChanged(ctx, entity.ID)
+	require.NoError(t, err)
+
+	// the update of the entity is rolled back with the rest of the batch, so it is never published
+	resp, err := suite.client.datum.UpdateBulkEntity(reqCtx, []string{entity.ID, ulids.New().String()},
+		datumclient.UpdateEntityInput{DisplayName: lo.ToPtr("undone")}, nil)
+	require.NoError(t, err)
+	require.Len(t, resp.UpdateBulkEntity.Errors, 1)
+
+	_, err = suite.client.datum.DeleteBulkEntity(reqCtx, []string{entity.ID}, nil)
+	require.NoError(t, err)
+
+	e := receiveChange(t, sub)
+	assert.Equal(t, "entity.deleted", e.EventType)
+	assert.Equal(t, entity.ID, e.ObjectID)
+}
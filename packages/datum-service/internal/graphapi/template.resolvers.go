@@ -89,6 +89,21 @@ func (r *mutationResolver) DeleteTemplate(ctx context.Context, id string) (*Temp
 	}, nil
 }
 
+// UpdateBulkTemplate is the resolver for the updateBulkTemplate field.
+func (r *mutationResolver) UpdateBulkTemplate(ctx context.Context, ids []string, input generated.UpdateTemplateInput, mode *BulkMode) (*TemplateBulkUpdatePayload, error) {
+	return r.bulkUpdateTemplate(ctx, ids, input, mode)
+}
+
+// DeleteBulkTemplate is the resolver for the deleteBulkTemplate field.
+func (r *mutationResolver) DeleteBulkTemplate(ctx context.Context, ids []string, mode *BulkMode) (*TemplateBulkDeletePayload, error) {
+	return r.bulkDeleteTemplate(ctx, ids, mode)
+}
+
+// UpsertBulkCSVTemplate is the resolver for the upsertBulkCSVTemplate field.
+func (r *mutationResolver) UpsertBulkCSVTemplate(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*TemplateBulkUpsertPayload, error) {
+	return r.bulkUpsertTemplate(ctx, input, keys, mode)
+}
+
 // Template is the resolver for the template field.
 func (r *queryResolver) Template(ctx context.Context, id string) (*generated.Template, error) {
 	res, err := withTransactionalMutation(ctx).Template.Get(ctx, id)
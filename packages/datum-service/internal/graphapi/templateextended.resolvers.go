@@ -0,0 +1,41 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+)
+
+// MigrateTemplateDocuments is the resolver for the migrateTemplateDocuments field.
+func (r *mutationResolver) MigrateTemplateDocuments(ctx context.Context, templateID string, version int) (*TemplateMigrationPayload, error) {
+	res, err := migrateTemplateDocuments(ctx, withTransactionalMutation(ctx), templateID, version)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "documentdata"}, r.logger)
+	}
+
+	return res, nil
+}
+
+// CloneTemplate is the resolver for the cloneTemplate field.
+func (r *mutationResolver) CloneTemplate(ctx context.Context, id string, ownerID *string, name *string) (*TemplateCreatePayload, error) {
+	res, err := cloneTemplate(ctx, withTransactionalMutation(ctx), id, ownerID, name)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionCreate, object: "template"}, r.logger)
+	}
+
+	return &TemplateCreatePayload{
+		Template: res,
+	}, nil
+}
+
+// TemplateVersionDiff is the resolver for the templateVersionDiff field.
+func (r *queryResolver) TemplateVersionDiff(ctx context.Context, templateID string, from int, to int) (*TemplateVersionDiff, error) {
+	res, err := diffTemplateVersions(ctx, withTransactionalMutation(ctx), templateID, from, to)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionGet, object: "template"}, r.logger)
+	}
+
+	return res, nil
+}
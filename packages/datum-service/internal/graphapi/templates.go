@@ -0,0 +1,255 @@
+package graphapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/documentdata"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/templateversion"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/enums"
+	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/templates"
+	"github.com/datumforge/datum/pkg/utils/ulids"
+)
+
+// renderedDocumentCategory is the category of the files created by rendering a document
+const renderedDocumentCategory = "rendered_document"
+
+// getTemplateVersion returns the version of the template
+func getTemplateVersion(ctx context.Context, c *ent.Client, templateID string, version int) (*ent.TemplateVersion, error) {
+	return c.TemplateVersion.Query().
+		Where(
+			templateversion.TemplateID(templateID),
+			templateversion.Version(version),
+		).
+		Only(ctx)
+}
+
+// diffTemplateVersions returns the changes made to the template between the two versions
+func diffTemplateVersions(ctx context.Context, c *ent.Client, templateID string, from, to int) (*TemplateVersionDiff, error) {
+	fromVersion, err := getTemplateVersion(ctx, c, templateID, from)
+	if err != nil {
+		return nil, err
+	}
+
+	toVersion, err := getTemplateVersion(ctx, c, templateID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonconfig, err := toVersionChanges(templates.Diff(fromVersion.Jsonconfig, toVersion.Jsonconfig))
+	if err != nil {
+		return nil, err
+	}
+
+	uischema, err := toVersionChanges(templates.Diff(fromVersion.Uischema, toVersion.Uischema))
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateVersionDiff{
+		TemplateID: templateID,
+		From:       from,
+		To:         to,
+		Jsonconfig: jsonconfig,
+		Uischema:   uischema,
+	}, nil
+}
+
+// toVersionChanges converts the schema changes to the graphql type, the values are returned JSON encoded
+func toVersionChanges(changes []templates.Change) ([]*TemplateVersionChange, error) {
+	out := make([]*TemplateVersionChange, len(changes))
+
+	for i, c := range changes {
+		from, err := encodeChangeValue(c.From)
+		if err != nil {
+			return nil, err
+		}
+
+		to, err := encodeChangeValue(c.To)
+		if err != nil {
+			return nil, err
+		}
+
+		out[i] = &TemplateVersionChange{
+			Path:      c.Path,
+			Operation: TemplateVersionChangeOperation(c.Operation),
+			From:      from,
+			To:        to,
+		}
+	}
+
+	return out, nil
+}
+
+// encodeChangeValue returns the JSON encoding of the value, nil if the value does not exist
+func encodeChangeValue(v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.ToPtr(string(b)), nil
+}
+
+// migrateTemplateDocuments pins the documents of the template to the version, documents are validated against
+// the schema of the version and the documents that do not conform to it are reported and left unchanged
+func migrateTemplateDocuments(ctx context.Context, c *ent.Client, templateID string, version int) (*TemplateMigrationPayload, error) {
+	v, err := getTemplateVersion(ctx, c, templateID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	docs, err := c.DocumentData.Query().
+		Where(
+			documentdata.TemplateID(templateID),
+			documentdata.Or(
+				documentdata.TemplateVersionIsNil(),
+				documentdata.TemplateVersionNEQ(version),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &TemplateMigrationPayload{
+		TemplateID: templateID,
+		Version:    version,
+		Migrated:   []string{},
+		Failed:     []*TemplateMigrationFailure{},
+	}
+
+	for _, doc := range docs {
+		if err := templates.Validate(v.Jsonconfig, doc.Data); err != nil {
+			var ve *templates.ValidationError
+			if !errors.As(err, &ve) {
+				return nil, err
+			}
+
+			out.Failed = append(out.Failed, &TemplateMigrationFailure{
+				DocumentDataID: doc.ID,
+				Errors: lo.Map(ve.Errors, func(fe templates.FieldError, _ int) *TemplateFieldError {
+					return &TemplateFieldError{Path: fe.Path, Message: fe.Message}
+				}),
+			})
+
+			continue
+		}
+
+		if err := doc.Update().SetTemplateVersion(version).Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		out.Migrated = append(out.Migrated, doc.ID)
+	}
+
+	return out, nil
+}
+
+// cloneTemplate creates a document template from the root template in the organization of the request, the
+// cloned template records the root template and version it was created from
+func cloneTemplate(ctx context.Context, c *ent.Client, id string, ownerID, name *string) (*ent.Template, error) {
+	root, err := c.Template.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if root.TemplateType != enums.RootTemplate {
+		return nil, ErrTemplateNotRoot
+	}
+
+	// set the organization in the auth context if its not done for us
+	if err := setOrganizationInAuthContext(ctx, ownerID); err != nil {
+		c.Logger.Errorw("failed to set organization in auth context", "error", err)
+
+		return nil, rout.NewMissingRequiredFieldError("owner_id")
+	}
+
+	return c.Template.Create().
+		SetName(lo.FromPtrOr(name, root.Name)).
+		SetTemplateType(enums.Document).
+		SetDescription(root.Description).
+		SetJsonconfig(root.Jsonconfig).
+		SetUischema(root.Uischema).
+		SetSourceTemplateID(root.ID).
+		SetSourceTemplateVersion(root.Version).
+		Save(ctx)
+}
+
+// renderDocumentData renders the document with the layout of its template and stores the rendered document as
+// a file of the organization that owns the document
+func renderDocumentData(ctx context.Context, c *ent.Client, id string, format templates.Format) (*ent.File, error) {
+	if c.ObjectStorage == nil {
+		return nil, ErrObjectStorageNotEnabled
+	}
+
+	doc, err := c.DocumentData.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the document is authorized, root templates and their versions are not owned by the organization of the document
+	rd, err := hooks.RenderableDocument(privacy.DecisionContext(ctx, privacy.Allow), c, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	if err := templates.Render(&buf, format, rd); err != nil {
+		return nil, err
+	}
+
+	// objects are namespaced by organization, matching the files uploaded to the organization
+	storeKey := fmt.Sprintf("%s/%s.%s", doc.OwnerID, ulids.New().String(), format.Extension())
+
+	size, err := c.ObjectStorage.Upload(ctx, storeKey, &buf, format.ContentType())
+	if err != nil {
+		return nil, err
+	}
+
+	create := c.File.Create().
+		SetFileName(fmt.Sprintf("%s.%s", doc.ID, format.Extension())).
+		SetFileExtension(format.Extension()).
+		SetFileSize(int(size)).
+		SetContentType(format.ContentType()).
+		SetStoreKey(storeKey).
+		SetCategory(renderedDocumentCategory).
+		AddOrganizationIDs(doc.OwnerID)
+
+	// api tokens are not users, so only link the file to the requester when authenticated as a user
+	if auth.GetAuthzSubjectType(ctx) == auth.UserSubjectType {
+		userID, err := auth.GetUserIDFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		create.SetUserID(userID)
+	}
+
+	f, err := create.Save(ctx)
+	if err != nil {
+		// remove the orphaned object, the transaction will be rolled back
+		if err := c.ObjectStorage.Delete(context.WithoutCancel(ctx), storeKey); err != nil {
+			c.Logger.Errorw("unable to delete orphaned object", "error", err, "store_key", storeKey)
+		}
+
+		return nil, err
+	}
+
+	return f, nil
+}
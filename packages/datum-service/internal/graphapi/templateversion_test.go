@@ -0,0 +1,269 @@
+package graphapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/datumclient"
+	"github.com/datumforge/datum/pkg/enums"
+)
+
+func (suite *GraphTestSuite) TestMutationUpdateTemplateVersions() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	template := (&TemplateBuilder{client: suite.client}).MustNew(reqCtx, t)
+	doc := (&DocumentDataBuilder{client: suite.client, TemplateID: template.ID, Data: map[string]any{"title": "nda"}}).MustNew(reqCtx, t)
+
+	assert.Equal(t, 1, template.Version)
+	assert.Equal(t, 1, doc.TemplateVersion)
+
+	defer mock_fga.ClearMocks(suite.client.fga)
+
+	mock_fga.CheckAny(t, suite.client.fga, true)
+
+	// changing the schema creates a new version
+	resp, err := suite.client.datum.UpdateTemplate(reqCtx, template.ID, datumclient.UpdateTemplateInput{
+		Jsonconfig: json.RawMessage(`{"type": "object", "required": ["name"]}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.UpdateTemplate.Template.Version)
+
+	// changes to other fields do not
+	resp, err = suite.client.datum.UpdateTemplate(reqCtx, template.ID, datumclient.UpdateTemplateInput{
+		Description: lo.ToPtr("contract template"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resp.UpdateTemplate.Template.Version)
+
+	versions, err := suite.client.datum.GetTemplateVersions(reqCtx, nil, nil, &datumclient.TemplateVersionWhereInput{
+		TemplateID: &template.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), versions.TemplateVersions.TotalCount)
+
+	numbers := []int64{}
+
+	for _, e := range versions.TemplateVersions.Edges {
+		numbers = append(numbers, e.Node.Version)
+	}
+
+	assert.ElementsMatch(t, []int64{1, 2}, numbers)
+
+	// the document stays pinned to the version it was created against, so its data is still validated against it
+	docResp, err := suite.client.datum.UpdateDocumentData(reqCtx, doc.ID, datumclient.UpdateDocumentDataInput{
+		Data: json.RawMessage(`{"title": "msa"}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, lo.ToPtr(int64(1)), docResp.UpdateDocumentData.DocumentData.TemplateVersion)
+
+	// new documents are pinned to the current version
+	_, err = suite.client.datum.CreateDocumentData(reqCtx, datumclient.CreateDocumentDataInput{
+		TemplateID: template.ID,
+		Data:       json.RawMessage(`{"title": "msa"}`),
+	})
+	require.Error(t, err)
+
+	created, err := suite.client.datum.CreateDocumentData(reqCtx, datumclient.CreateDocumentDataInput{
+		TemplateID: template.ID,
+		Data:       json.RawMessage(`{"name": "MITB"}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, lo.ToPtr(int64(2)), created.CreateDocumentData.DocumentData.TemplateVersion)
+}
+
+func (suite *GraphTestSuite) TestQueryTemplateVersionDiff() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	template := (&TemplateBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	defer mock_fga.ClearMocks(suite.client.fga)
+
+	mock_fga.CheckAny(t, suite.client.fga, true)
+
+	_, err = suite.client.datum.UpdateTemplate(reqCtx, template.ID, datumclient.UpdateTemplateInput{
+		Jsonconfig: json.RawMessage(`{"type": "object", "properties": {"name": {"type": "string", "minLength": 1}}, "required": ["name"]}`),
+	})
+	require.NoError(t, err)
+
+	resp, err := suite.client.datum.GetTemplateVersionDiff(reqCtx, template.ID, 1, 2)
+	require.NoError(t, err)
+
+	changes := resp.TemplateVersionDiff.Jsonconfig
+	require.Len(t, changes, 2)
+
+	assert.Equal(t, "/properties/name/minLength", changes[0].Path)
+	assert.Equal(t, datumclient.TemplateVersionChangeOperationAdded, changes[0].Operation)
+	assert.Nil(t, changes[0].From)
+	assert.Equal(t, lo.ToPtr("1"), changes[0].To)
+
+	assert.Equal(t, "/required", changes[1].Path)
+	assert.Equal(t, datumclient.TemplateVersionChangeOperationAdded, changes[1].Operation)
+	assert.Equal(t, lo.ToPtr(`["name"]`), changes[1].To)
+
+	assert.Empty(t, resp.TemplateVersionDiff.Uischema)
+
+	// the reverse diff removes the values
+	resp, err = suite.client.datum.GetTemplateVersionDiff(reqCtx, template.ID, 2, 1)
+	require.NoError(t, err)
+	require.Len(t, resp.TemplateVersionDiff.Jsonconfig, 2)
+	assert.Equal(t, datumclient.TemplateVersionChangeOperationRemoved, resp.TemplateVersionDiff.Jsonconfig[0].Operation)
+
+	_, err = suite.client.datum.GetTemplateVersionDiff(reqCtx, template.ID, 1, 3)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func (suite *GraphTestSuite) TestMutationMigrateTemplateDocuments() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	template := (&TemplateBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	valid := (&DocumentDataBuilder{client: suite.client, TemplateID: template.ID, Data: map[string]any{"name": "MITB", "term": 12}}).MustNew(reqCtx, t)
+	invalid := (&DocumentDataBuilder{client: suite.client, TemplateID: template.ID, Data: map[string]any{"name": "MITB", "term": 0}}).MustNew(reqCtx, t)
+	missing := (&DocumentDataBuilder{client: suite.client, TemplateID: template.ID, Data: map[string]any{"title": "nda"}}).MustNew(reqCtx, t)
+
+	defer mock_fga.ClearMocks(suite.client.fga)
+
+	mock_fga.CheckAny(t, suite.client.fga, true)
+
+	schema, err := json.Marshal(contractSchema)
+	require.NoError(t, err)
+
+	_, err = suite.client.datum.UpdateTemplate(reqCtx, template.ID, datumclient.UpdateTemplateInput{
+		Jsonconfig: schema,
+	})
+	require.NoError(t, err)
+
+	resp, err := suite.client.datum.MigrateTemplateDocuments(reqCtx, template.ID, 2)
+	require.NoError(t, err)
+
+	out := resp.MigrateTemplateDocuments
+	assert.Equal(t, int64(2), out.Version)
+	assert.Equal(t, []string{valid.ID}, out.Migrated)
+
+	failed := map[string][]string{}
+
+	for _, f := range out.Failed {
+		for _, e := range f.Errors {
+			failed[f.DocumentDataID] = append(failed[f.DocumentDataID], e.Path)
+		}
+	}
+
+	assert.Equal(t, map[string][]string{invalid.ID: {"/term"}, missing.ID: {""}}, failed)
+
+	// only the migrated documents are pinned to the version
+	doc, err := suite.client.datum.GetDocumentDataByID(reqCtx, valid.ID)
+	require.NoError(t, err)
+	assert.Equal(t, lo.ToPtr(int64(2)), doc.DocumentData.TemplateVersion)
+
+	doc, err = suite.client.datum.GetDocumentDataByID(reqCtx, invalid.ID)
+	require.NoError(t, err)
+	assert.Equal(t, lo.ToPtr(int64(1)), doc.DocumentData.TemplateVersion)
+
+	// migrated documents are not migrated again
+	resp, err = suite.client.datum.MigrateTemplateDocuments(reqCtx, template.ID, 2)
+	require.NoError(t, err)
+	assert.Empty(t, resp.MigrateTemplateDocuments.Migrated)
+	assert.Len(t, resp.MigrateTemplateDocuments.Failed, 2)
+
+	// documents can be moved back to an earlier version
+	resp, err = suite.client.datum.MigrateTemplateDocuments(reqCtx, template.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{valid.ID}, resp.MigrateTemplateDocuments.Migrated)
+
+	_, err = suite.client.datum.MigrateTemplateDocuments(reqCtx, template.ID, 3)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func (suite *GraphTestSuite) TestMutationCloneTemplate() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	root := (&TemplateBuilder{client: suite.client, TemplateType: enums.RootTemplate, Jsonconfig: contractSchema}).MustNew(reqCtx, t)
+	document := (&TemplateBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	testCases := []struct {
+		name        string
+		id          string
+		cloneName   *string
+		checked     bool
+		expectedErr string
+	}{
+		{
+			name:      "happy path",
+			id:        root.ID,
+			cloneName: lo.ToPtr("customer contract"),
+			checked:   true,
+		},
+		{
+			name:    "happy path, name of the root template",
+			id:      root.ID,
+			checked: true,
+		},
+		{
+			name:        "document templates cannot be cloned",
+			id:          document.ID,
+			checked:     true,
+			expectedErr: "only root templates can be cloned",
+		},
+		{
+			name:        "template not found",
+			id:          "notfound",
+			expectedErr: "not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Clone "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			// templates that do not exist are not checked
+			if tc.checked {
+				mock_fga.CheckAny(t, suite.client.fga, true)
+			}
+
+			resp, err := suite.client.datum.CloneTemplate(reqCtx, tc.id, nil, tc.cloneName)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				assert.ErrorContains(t, err, tc.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			clone := resp.CloneTemplate.Template
+			assert.NotEqual(t, root.ID, clone.ID)
+			assert.Equal(t, lo.FromPtrOr(tc.cloneName, root.Name), clone.Name)
+			assert.Equal(t, enums.Document, clone.TemplateType)
+			assert.Equal(t, lo.ToPtr(root.ID), clone.SourceTemplateID)
+			assert.Equal(t, lo.ToPtr(int64(root.Version)), clone.SourceTemplateVersion)
+			assert.Equal(t, int64(1), clone.Version)
+			assert.Equal(t, testOrgID, clone.Owner.ID)
+
+			var schema map[string]any
+
+			require.NoError(t, json.Unmarshal(clone.Jsonconfig, &schema))
+			assert.Equal(t, "object", schema["type"])
+			assert.Equal(t, []any{"name"}, schema["required"])
+		})
+	}
+}
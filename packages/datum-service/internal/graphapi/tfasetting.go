@@ -0,0 +1,55 @@
+package graphapi
+
+import (
+	"context"
+	"database/sql"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/pkg/utils/totp"
+)
+
+// validateTOTPCode checks the code was generated from the secret of the tfa setting
+func validateTOTPCode(ctx context.Context, c *ent.Client, settings *ent.TFASetting, code string) error {
+	if c.TOTP == nil || c.TOTP.TOTPManager == nil {
+		return ErrTOTPNotEnabled
+	}
+
+	if settings.TfaSecret == nil {
+		return ErrInvalidTOTPCode
+	}
+
+	if err := c.TOTP.TOTPManager.ValidateTOTP(ctx, &totp.User{
+		ID:        settings.OwnerID,
+		TFASecret: *settings.TfaSecret,
+	}, code); err != nil {
+		return ErrInvalidTOTPCode
+	}
+
+	return nil
+}
+
+// tfaQRCode returns the otpauth URI of the tfa setting; the URI contains the secret so it is only returned until
+// the setting has been verified
+func tfaQRCode(ctx context.Context, c *ent.Client, settings *ent.TFASetting) (*string, error) {
+	if settings.Verified || settings.TfaSecret == nil || c.TOTP == nil || c.TOTP.TOTPManager == nil {
+		return nil, nil
+	}
+
+	owner, err := c.User.Get(ctx, settings.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	qrCode, err := c.TOTP.TOTPManager.TOTPQRString(&totp.User{
+		ID:        owner.ID,
+		TFASecret: *settings.TfaSecret,
+		Email: sql.NullString{
+			String: owner.Email,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &qrCode, nil
+}
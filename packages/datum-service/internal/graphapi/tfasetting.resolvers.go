@@ -6,10 +6,12 @@ package graphapi
 
 import (
 	"context"
+	"encoding/base64"
 
 	"github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/ent/generated/tfasetting"
 	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/utils/totp"
 )
 
 // CreateTFASetting is the resolver for the createTFASetting field.
@@ -51,6 +53,76 @@ func (r *mutationResolver) UpdateTFASetting(ctx context.Context, input generated
 	return &TFASettingUpdatePayload{TfaSetting: settings}, nil
 }
 
+// VerifyTFASetting is the resolver for the verifyTFASetting field.
+func (r *mutationResolver) VerifyTFASetting(ctx context.Context, totpCode string) (*TFASettingUpdatePayload, error) {
+	if err := checkAllowedAuthType(ctx); err != nil {
+		return nil, err
+	}
+
+	// get the userID from the context
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := withTransactionalMutation(ctx).TFASetting.Query().Where(tfasetting.OwnerID(userID)).Only(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "tfasetting"}, r.logger)
+	}
+
+	if settings.Verified {
+		return nil, ErrTFAAlreadyVerified
+	}
+
+	if err := validateTOTPCode(ctx, withTransactionalMutation(ctx), settings, totpCode); err != nil {
+		return nil, err
+	}
+
+	// setting verified generates the recovery codes and enables tfa for the user
+	settings, err = settings.Update().SetVerified(true).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "tfasetting"}, r.logger)
+	}
+
+	return &TFASettingUpdatePayload{TfaSetting: settings}, nil
+}
+
+// RegenerateTFARecoveryCodes is the resolver for the regenerateTFARecoveryCodes field.
+func (r *mutationResolver) RegenerateTFARecoveryCodes(ctx context.Context) (*TFASettingUpdatePayload, error) {
+	if err := checkAllowedAuthType(ctx); err != nil {
+		return nil, err
+	}
+
+	// get the userID from the context
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client := withTransactionalMutation(ctx)
+
+	if client.TOTP == nil || client.TOTP.TOTPManager == nil {
+		return nil, ErrTOTPNotEnabled
+	}
+
+	settings, err := client.TFASetting.Query().Where(tfasetting.OwnerID(userID)).Only(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "tfasetting"}, r.logger)
+	}
+
+	if !settings.Verified {
+		return nil, ErrTFANotVerified
+	}
+
+	// replacing the codes invalidates the previous set
+	settings, err = settings.Update().SetRecoveryCodes(client.TOTP.TOTPManager.GenerateRecoveryCodes()).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "tfasetting"}, r.logger)
+	}
+
+	return &TFASettingUpdatePayload{TfaSetting: settings}, nil
+}
+
 // TfaSetting is the resolver for the tfaSettings field.
 func (r *queryResolver) TfaSetting(ctx context.Context, id *string) (*generated.TFASetting, error) {
 	if err := checkAllowedAuthType(ctx); err != nil {
@@ -80,6 +152,28 @@ func (r *queryResolver) TfaSetting(ctx context.Context, id *string) (*generated.
 	return settings, nil
 }
 
+// QRCode is the resolver for the qrCode field.
+func (r *tFASettingResolver) QRCode(ctx context.Context, obj *generated.TFASetting) (*string, error) {
+	return tfaQRCode(ctx, withTransactionalMutation(ctx), obj)
+}
+
+// QRImage is the resolver for the qrImage field.
+func (r *tFASettingResolver) QRImage(ctx context.Context, obj *generated.TFASetting) (*string, error) {
+	qrCode, err := tfaQRCode(ctx, withTransactionalMutation(ctx), obj)
+	if err != nil || qrCode == nil {
+		return nil, err
+	}
+
+	png, err := totp.QRImage(*qrCode)
+	if err != nil {
+		return nil, err
+	}
+
+	image := base64.StdEncoding.EncodeToString(png)
+
+	return &image, nil
+}
+
 // RegenBackupCodes is the resolver for the regenBackupCodes field.
 func (r *updateTFASettingInputResolver) RegenBackupCodes(ctx context.Context, obj *generated.UpdateTFASettingInput, data *bool) error {
 	return nil
@@ -3,12 +3,16 @@ package graphapi_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/datumforge/datum/internal/graphapi"
 	"github.com/datumforge/datum/pkg/datumclient"
 	"github.com/datumforge/datum/pkg/rout"
 )
@@ -155,6 +159,12 @@ func (suite *GraphTestSuite) TestMutationCreateTFASetting() {
 			// Make sure provided values match
 			assert.Equal(t, tc.input.TotpAllowed, resp.CreateTFASetting.TfaSetting.TotpAllowed)
 			assert.Empty(t, resp.CreateTFASetting.TfaSetting.RecoveryCodes)
+			assert.False(t, resp.CreateTFASetting.TfaSetting.Verified)
+
+			// the qr code is returned so the user can add the secret to an authenticator app
+			require.NotNil(t, resp.CreateTFASetting.TfaSetting.QRCode)
+			assert.Contains(t, *resp.CreateTFASetting.TfaSetting.QRCode, "otpauth://totp/")
+			assert.NotEmpty(t, resp.CreateTFASetting.TfaSetting.QRImage)
 			assert.Equal(t, tc.userID, resp.CreateTFASetting.TfaSetting.Owner.ID)
 
 			// make sure user setting was not updated
@@ -173,9 +183,11 @@ func (suite *GraphTestSuite) TestMutationUpdateTFASetting() {
 	reqCtx, err := userContext()
 	require.NoError(t, err)
 
-	(&TFASettingBuilder{client: suite.client}).MustNew(reqCtx, t, testUser.ID)
+	tfa := (&TFASettingBuilder{client: suite.client}).MustNew(reqCtx, t, testUser.ID)
 
-	recoveryCodes := []string{}
+	// verify the setting so recovery codes are generated
+	recoveryCodes := tfa.Update().SetVerified(true).SaveX(reqCtx).RecoveryCodes
+	require.NotEmpty(t, recoveryCodes)
 
 	testCases := []struct {
 		name   string
@@ -184,14 +196,6 @@ func (suite *GraphTestSuite) TestMutationUpdateTFASetting() {
 		ctx    context.Context
 		errMsg string
 	}{
-		{
-			name: "update verify",
-			input: datumclient.UpdateTFASettingInput{
-				Verified: lo.ToPtr(true),
-			},
-			client: suite.client.datum,
-			ctx:    reqCtx,
-		},
 		{
 			name: "regen codes using personal access token",
 			input: datumclient.UpdateTFASettingInput{
@@ -264,3 +268,97 @@ func (suite *GraphTestSuite) TestMutationUpdateTFASetting() {
 		})
 	}
 }
+
+func (suite *GraphTestSuite) TestMutationVerifyTFASetting() {
+	t := suite.T()
+
+	// setup user context
+	ctx, err := userContext()
+	require.NoError(t, err)
+
+	user := (&UserBuilder{client: suite.client}).MustNew(ctx, t)
+
+	reqCtx, err := userContextWithID(user.ID)
+	require.NoError(t, err)
+
+	resp, err := suite.client.datum.CreateTFASetting(reqCtx, datumclient.CreateTFASettingInput{
+		TotpAllowed: lo.ToPtr(true),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.CreateTFASetting.TfaSetting.QRCode)
+
+	key, err := otp.NewKeyFromURL(*resp.CreateTFASetting.TfaSetting.QRCode)
+	require.NoError(t, err)
+
+	validCode, err := totp.GenerateCode(key.Secret(), time.Now())
+	require.NoError(t, err)
+
+	// regenerating codes is not allowed until the setting is verified
+	_, err = suite.client.datum.RegenerateTFARecoveryCodes(reqCtx)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, graphapi.ErrTFANotVerified.Error())
+
+	testCases := []struct {
+		name   string
+		code   string
+		errMsg string
+	}{
+		{
+			name:   "invalid code",
+			code:   "000000",
+			errMsg: graphapi.ErrInvalidTOTPCode.Error(),
+		},
+		{
+			name: "happy path",
+			code: validCode,
+		},
+		{
+			name:   "already verified",
+			code:   validCode,
+			errMsg: graphapi.ErrTFAAlreadyVerified.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Verify "+tc.name, func(t *testing.T) {
+			resp, err := suite.client.datum.VerifyTFASetting(reqCtx, tc.code)
+
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			assert.True(t, resp.VerifyTFASetting.TfaSetting.Verified)
+			assert.NotEmpty(t, resp.VerifyTFASetting.TfaSetting.RecoveryCodes)
+
+			// tfa is now enabled for the user
+			userSettings, err := suite.client.datum.GetAllUserSettings(reqCtx)
+			require.NoError(t, err)
+			require.Len(t, userSettings.UserSettings.Edges, 1)
+
+			assert.True(t, *userSettings.UserSettings.Edges[0].Node.IsTfaEnabled)
+
+			// the qr code is no longer returned once verified
+			setting, err := suite.client.datum.GetTFASetting(reqCtx)
+			require.NoError(t, err)
+
+			assert.Nil(t, setting.TfaSetting.QRCode)
+			assert.Nil(t, setting.TfaSetting.QRImage)
+
+			// regenerating codes replaces the previous set
+			regen, err := suite.client.datum.RegenerateTFARecoveryCodes(reqCtx)
+			require.NoError(t, err)
+
+			assert.NotEmpty(t, regen.RegenerateTFARecoveryCodes.TfaSetting.RecoveryCodes)
+			assert.NotEqual(t, resp.VerifyTFASetting.TfaSetting.RecoveryCodes, regen.RegenerateTFARecoveryCodes.TfaSetting.RecoveryCodes)
+		})
+	}
+
+	(&UserCleanup{client: suite.client, ID: user.ID}).MustDelete(ctx, t)
+}
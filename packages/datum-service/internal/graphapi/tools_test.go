@@ -36,6 +36,9 @@ var (
 	testUser          *ent.User
 	testPersonalOrgID string
 	testOrgID         string
+
+	// allScopes grants the test tokens all actions on all resources
+	allScopes = []string{"*:read", "*:write", "*:delete"}
 )
 
 // TestGraphTestSuite runs all the tests in the GraphTestSuite
@@ -176,7 +179,7 @@ func (suite *GraphTestSuite) SetupTest() {
 	require.NoError(t, err)
 
 	// setup client with a personal access token
-	pat := (&PersonalAccessTokenBuilder{client: c, OwnerID: testUser.ID, OrganizationIDs: []string{testOrgID, testPersonalOrgID}}).MustNew(userCtx, t)
+	pat := (&PersonalAccessTokenBuilder{client: c, OwnerID: testUser.ID, OrganizationIDs: []string{testOrgID, testPersonalOrgID}, Scopes: allScopes}).MustNew(userCtx, t)
 	authHeaderPAT := datumclient.Authorization{
 		BearerToken: pat.Token,
 	}
@@ -185,7 +188,7 @@ func (suite *GraphTestSuite) SetupTest() {
 	require.NoError(t, err)
 
 	// setup client with an API token
-	apiToken := (&APITokenBuilder{client: c}).MustNew(userCtx, t)
+	apiToken := (&APITokenBuilder{client: c, Scopes: allScopes}).MustNew(userCtx, t)
 
 	authHeaderAPIToken := datumclient.Authorization{
 		BearerToken: apiToken.Token,
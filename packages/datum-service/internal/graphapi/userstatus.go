@@ -0,0 +1,102 @@
+package graphapi
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/enums"
+	"github.com/datumforge/datum/pkg/rout"
+)
+
+// lockUser locks the account of the user until the provided time, or until unlocked when no time is provided
+func lockUser(ctx context.Context, c *ent.Client, logger *zap.SugaredLogger, userID string, until *time.Time) (*ent.UserSetting, error) {
+	if until != nil && !until.After(time.Now()) {
+		return nil, rout.InvalidField("until")
+	}
+
+	return updateUserStatus(ctx, c, logger, userID, "lock", func(u *ent.UserSettingUpdateOne) {
+		u.SetLocked(true)
+
+		if until != nil {
+			u.SetLockedUntil(*until)
+		} else {
+			u.ClearLockedUntil()
+		}
+	})
+}
+
+// unlockUser unlocks the account of the user and resets their failed login attempts
+func unlockUser(ctx context.Context, c *ent.Client, logger *zap.SugaredLogger, userID string) (*ent.UserSetting, error) {
+	return updateUserStatus(ctx, c, logger, userID, "unlock", func(u *ent.UserSettingUpdateOne) {
+		u.SetLocked(false).ClearLockedUntil().SetFailedLoginAttempts(0)
+	})
+}
+
+// suspendUser suspends the account of the user
+func suspendUser(ctx context.Context, c *ent.Client, logger *zap.SugaredLogger, userID string) (*ent.UserSetting, error) {
+	return updateUserStatus(ctx, c, logger, userID, "suspend", func(u *ent.UserSettingUpdateOne) {
+		u.SetSuspendedAt(time.Now()).SetStatus(enums.UserStatusSuspended)
+	})
+}
+
+// unsuspendUser reactivates the suspended account of the user
+func unsuspendUser(ctx context.Context, c *ent.Client, logger *zap.SugaredLogger, userID string) (*ent.UserSetting, error) {
+	return updateUserStatus(ctx, c, logger, userID, "unsuspend", func(u *ent.UserSettingUpdateOne) {
+		u.ClearSuspendedAt().SetStatus(enums.UserStatusActive)
+	})
+}
+
+// updateUserStatus applies the update to the settings of the user after ensuring the authenticated user is a system
+// admin; the change is recorded in the user setting history with the admin as the updater. The sessions of users
+// that can no longer authenticate are deleted
+func updateUserStatus(ctx context.Context, c *ent.Client, logger *zap.SugaredLogger, userID, action string, apply func(*ent.UserSettingUpdateOne)) (*ent.UserSetting, error) {
+	adminID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allow, err := c.Authz.CheckSystemAdminRole(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allow {
+		return nil, ErrPermissionDenied
+	}
+
+	// the admin access was checked above, the settings of any user can be updated
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	setting, err := c.UserSetting.Query().
+		Where(usersetting.UserID(userID)).
+		Only(allowCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := c.UserSetting.UpdateOne(setting)
+	apply(update)
+
+	setting, err = update.Save(allowCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Infow("user account status updated", "action", action, "user_id", userID, "admin_id", adminID)
+
+	if setting.Locked || setting.SuspendedAt != nil {
+		if store, err := sessionStore(c); err == nil {
+			if err := store.DeleteUserSessions(ctx, userID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return setting, nil
+}
@@ -0,0 +1,50 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+	"time"
+)
+
+// LockUser is the resolver for the lockUser field.
+func (r *mutationResolver) LockUser(ctx context.Context, id string, until *time.Time) (*UserSettingUpdatePayload, error) {
+	setting, err := lockUser(ctx, withTransactionalMutation(ctx), r.logger, id, until)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "usersetting"}, r.logger)
+	}
+
+	return &UserSettingUpdatePayload{UserSetting: setting}, nil
+}
+
+// UnlockUser is the resolver for the unlockUser field.
+func (r *mutationResolver) UnlockUser(ctx context.Context, id string) (*UserSettingUpdatePayload, error) {
+	setting, err := unlockUser(ctx, withTransactionalMutation(ctx), r.logger, id)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "usersetting"}, r.logger)
+	}
+
+	return &UserSettingUpdatePayload{UserSetting: setting}, nil
+}
+
+// SuspendUser is the resolver for the suspendUser field.
+func (r *mutationResolver) SuspendUser(ctx context.Context, id string) (*UserSettingUpdatePayload, error) {
+	setting, err := suspendUser(ctx, withTransactionalMutation(ctx), r.logger, id)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "usersetting"}, r.logger)
+	}
+
+	return &UserSettingUpdatePayload{UserSetting: setting}, nil
+}
+
+// UnsuspendUser is the resolver for the unsuspendUser field.
+func (r *mutationResolver) UnsuspendUser(ctx context.Context, id string) (*UserSettingUpdatePayload, error) {
+	setting, err := unsuspendUser(ctx, withTransactionalMutation(ctx), r.logger, id)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "usersetting"}, r.logger)
+	}
+
+	return &UserSettingUpdatePayload{UserSetting: setting}, nil
+}
@@ -0,0 +1,138 @@
+package graphapi_test
+
+import (
+	"testing"
+	"time"
+
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/graphapi"
+	"github.com/datumforge/datum/pkg/enums"
+)
+
+func (suite *GraphTestSuite) TestMutationLockUser() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	user := (&UserBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	until := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		name     string
+		until    *time.Time
+		allowed  bool
+		checkFGA bool
+		errorMsg string
+	}{
+		{
+			name:     "happy path, lock until unlocked",
+			allowed:  true,
+			checkFGA: true,
+		},
+		{
+			name:     "happy path, timed lock",
+			until:    &until,
+			allowed:  true,
+			checkFGA: true,
+		},
+		{
+			name:     "lock expiring in the past",
+			until:    &past,
+			errorMsg: "invalid or unparsable field: until",
+		},
+		{
+			name:     "not a system admin",
+			allowed:  false,
+			checkFGA: true,
+			errorMsg: graphapi.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Lock "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			if tc.checkFGA {
+				mock_fga.CheckAny(t, suite.client.fga, tc.allowed)
+			}
+
+			resp, err := suite.client.datum.LockUser(reqCtx, user.ID, tc.until)
+
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			setting := resp.LockUser.UserSetting
+			assert.True(t, setting.Locked)
+			assert.Equal(t, testUser.ID, *setting.UpdatedBy)
+
+			if tc.until != nil {
+				require.NotNil(t, setting.LockedUntil)
+				assert.WithinDuration(t, *tc.until, *setting.LockedUntil, time.Second)
+			} else {
+				assert.Nil(t, setting.LockedUntil)
+			}
+		})
+	}
+
+	// unlock the user again
+	mock_fga.CheckAny(t, suite.client.fga, true)
+	defer mock_fga.ClearMocks(suite.client.fga)
+
+	resp, err := suite.client.datum.UnlockUser(reqCtx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	assert.False(t, resp.UnlockUser.UserSetting.Locked)
+	assert.Nil(t, resp.UnlockUser.UserSetting.LockedUntil)
+	assert.Zero(t, resp.UnlockUser.UserSetting.FailedLoginAttempts)
+}
+
+func (suite *GraphTestSuite) TestMutationSuspendUser() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	user := (&UserBuilder{client: suite.client}).MustNew(reqCtx, t)
+
+	// users that are not system admins can not suspend users
+	mock_fga.CheckAny(t, suite.client.fga, false)
+
+	_, err = suite.client.datum.SuspendUser(reqCtx, user.ID)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, graphapi.ErrPermissionDenied.Error())
+
+	mock_fga.ClearMocks(suite.client.fga)
+	mock_fga.CheckAny(t, suite.client.fga, true)
+
+	defer mock_fga.ClearMocks(suite.client.fga)
+
+	suspended, err := suite.client.datum.SuspendUser(reqCtx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, suspended)
+
+	assert.Equal(t, enums.UserStatusSuspended, suspended.SuspendUser.UserSetting.Status)
+	assert.NotNil(t, suspended.SuspendUser.UserSetting.SuspendedAt)
+	assert.Equal(t, testUser.ID, *suspended.SuspendUser.UserSetting.UpdatedBy)
+
+	unsuspended, err := suite.client.datum.UnsuspendUser(reqCtx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, unsuspended)
+
+	assert.Equal(t, enums.UserStatusActive, unsuspended.UnsuspendUser.UserSetting.Status)
+	assert.Nil(t, unsuspended.UnsuspendUser.UserSetting.SuspendedAt)
+}
@@ -89,6 +89,21 @@ func (r *mutationResolver) DeleteWebhook(ctx context.Context, id string) (*Webho
 	}, nil
 }
 
+// UpdateBulkWebhook is the resolver for the updateBulkWebhook field.
+func (r *mutationResolver) UpdateBulkWebhook(ctx context.Context, ids []string, input generated.UpdateWebhookInput, mode *BulkMode) (*WebhookBulkUpdatePayload, error) {
+	return r.bulkUpdateWebhook(ctx, ids, input, mode)
+}
+
+// DeleteBulkWebhook is the resolver for the deleteBulkWebhook field.
+func (r *mutationResolver) DeleteBulkWebhook(ctx context.Context, ids []string, mode *BulkMode) (*WebhookBulkDeletePayload, error) {
+	return r.bulkDeleteWebhook(ctx, ids, mode)
+}
+
+// UpsertBulkCSVWebhook is the resolver for the upsertBulkCSVWebhook field.
+func (r *mutationResolver) UpsertBulkCSVWebhook(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode) (*WebhookBulkUpsertPayload, error) {
+	return r.bulkUpsertWebhook(ctx, input, keys, mode)
+}
+
 // Webhook is the resolver for the webhook field.
 func (r *queryResolver) Webhook(ctx context.Context, id string) (*generated.Webhook, error) {
 	res, err := withTransactionalMutation(ctx).Webhook.Get(ctx, id)
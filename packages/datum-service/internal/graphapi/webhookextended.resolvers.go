@@ -0,0 +1,21 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+)
+
+// RedeliverWebhookEvent is the resolver for the redeliverWebhookEvent field.
+func (r *mutationResolver) RedeliverWebhookEvent(ctx context.Context, webhookID string, eventID string) (*WebhookRedeliveryPayload, error) {
+	deliveries, err := redeliverWebhookEvent(ctx, withTransactionalMutation(ctx), webhookID, eventID)
+	if err != nil {
+		return nil, parseRequestError(err, action{action: ActionUpdate, object: "webhook"}, r.logger)
+	}
+
+	return &WebhookRedeliveryPayload{
+		WebhookDeliveries: deliveries,
+	}, nil
+}
@@ -0,0 +1,249 @@
+package graphapi
+
+import (
+	"context"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/event"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/webhook"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/utils/marionette"
+	"github.com/datumforge/datum/pkg/webhooks"
+)
+
+// queueWebhookDeliveries queues the event to be dispatched to all enabled webhooks owned by the
+// organization that are subscribed to the event; this is a no-op when webhook delivery is not configured
+func queueWebhookDeliveries(ctx context.Context, c *ent.Client, id, orgID, eventType, objectType string, props map[string]interface{}) {
+	if c.WebhookDeliverer == nil || c.Marionette == nil || orgID == "" {
+		return
+	}
+
+	// deliveries happen in the background after the request has completed, so the context
+	// must outlive the request and is allowed to read and update the organization's webhooks
+	allowCtx := privacy.DecisionContext(context.WithoutCancel(ctx), privacy.Allow)
+
+	if err := c.Marionette.Queue(marionette.TaskFunc(func(ctx context.Context) error {
+		return dispatchWebhookEvent(ctx, c, id, orgID, eventType, objectType, props)
+	}), marionette.WithContext(allowCtx),
+		marionette.WithErrorf("could not dispatch %s event to webhooks", eventType),
+	); err != nil {
+		c.Logger.Errorw("unable to queue webhook event", "event", eventType, "error", err)
+	}
+}
+
+// dispatchWebhookEvent records the event against the organization's enabled webhooks subscribed
+// to the event and queues a delivery for each of them; webhooks of integrations (e.g. Slack) are skipped
+// because their endpoints do not accept the signed event payload. An event with an id is only dispatched once, so
+// the deliveries are not repeated when the event is published again
+func dispatchWebhookEvent(ctx context.Context, c *ent.Client, id, orgID, eventType, objectType string, props map[string]interface{}) error {
+	if id != "" {
+		dispatched, err := c.Event.Query().Where(event.ID(id)).Exist(ctx)
+		if err != nil || dispatched {
+			return err
+		}
+	}
+
+	enabled, err := c.Webhook.Query().
+		Where(
+			webhook.OwnerID(orgID),
+			webhook.Enabled(true),
+			webhook.Not(webhook.HasIntegrations()),
+		).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	hooks := []*ent.Webhook{}
+
+	for _, h := range enabled {
+		if webhooks.Subscribed(h.EventTypes, h.ObjectTypes, eventType, objectType) {
+			hooks = append(hooks, h)
+		}
+	}
+
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	create := c.Event.Create().
+		SetEventType(eventType).
+		SetMetadata(props).
+		AddWebhook(hooks...)
+
+	if id != "" {
+		create.SetID(id)
+	}
+
+	evt, err := create.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload := webhooks.NewPayload(evt.ID, eventType, orgID, props)
+
+	for _, h := range hooks {
+		if err := c.Marionette.Queue(marionette.TaskFunc(func(ctx context.Context) error {
+			return deliverWebhook(ctx, c, h, payload)
+		}), marionette.WithContext(ctx),
+			marionette.WithErrorf("could not record delivery to webhook %s", h.ID),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliverWebhook sends the payload to the webhook destination and records every attempt as a webhook delivery,
+// delivery failures are recorded on the webhook rather than returned
+func deliverWebhook(ctx context.Context, c *ent.Client, h *ent.Webhook, payload *webhooks.Payload) error {
+	res, err := c.WebhookDeliverer.Deliver(ctx, webhooks.Target{
+		URL:    h.DestinationURL,
+		Secret: h.Secret,
+	}, payload)
+
+	if _, err := createWebhookDeliveries(ctx, c, h.ID, payload.ID, res, err, false); err != nil {
+		return err
+	}
+
+	return updateWebhookStatus(ctx, c, h.ID, res, err)
+}
+
+// redeliverWebhookEvent sends a previously dispatched event to the webhook again in a single attempt and returns the
+// recorded delivery; the event is sent regardless of the webhook being enabled or subscribed to the event
+func redeliverWebhookEvent(ctx context.Context, c *ent.Client, webhookID, eventID string) ([]*ent.WebhookDelivery, error) {
+	if c.WebhookDeliverer == nil {
+		return nil, ErrWebhooksNotEnabled
+	}
+
+	orgID, err := auth.GetOrganizationIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := c.Webhook.Query().
+		Where(
+			webhook.ID(webhookID),
+			webhook.OwnerID(orgID),
+		).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// events are not scoped to an organization, so only allow events that were sent to the webhook
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	evt, err := c.Event.Query().
+		Where(
+			event.ID(eventID),
+			event.HasWebhookWith(webhook.ID(h.ID)),
+		).Only(allowCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := webhooks.NewPayload(evt.ID, evt.EventType, orgID, evt.Metadata)
+	payload.CreatedAt = evt.CreatedAt
+
+	// a single attempt is made so the mutation does not wait on retries and their backoff
+	res, deliveryErr := c.WebhookDeliverer.DeliverOnce(ctx, webhooks.Target{
+		URL:    h.DestinationURL,
+		Secret: h.Secret,
+	}, payload)
+
+	deliveries, err := createWebhookDeliveries(allowCtx, c, h.ID, evt.ID, res, deliveryErr, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := updateWebhookStatus(allowCtx, c, h.ID, res, deliveryErr); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// createWebhookDeliveries records each attempt of the delivery of the event to the webhook,
+// a single failed delivery is recorded when no attempt could be made
+func createWebhookDeliveries(ctx context.Context, c *ent.Client, webhookID, eventID string, res *webhooks.Result, deliveryErr error,
+	redelivery bool) ([]*ent.WebhookDelivery, error) {
+	newDelivery := func(number int) *ent.WebhookDeliveryCreate {
+		d := c.WebhookDelivery.Create().
+			SetWebhookID(webhookID).
+			SetEventID(eventID).
+			SetAttempt(number).
+			SetRedelivery(redelivery)
+
+		if res != nil {
+			d.SetRequestBody(string(res.Body))
+		}
+
+		return d
+	}
+
+	if res == nil || len(res.Attempts) == 0 {
+		d := newDelivery(1)
+
+		if deliveryErr != nil {
+			d.SetError(deliveryErr.Error())
+		}
+
+		delivery, err := d.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*ent.WebhookDelivery{delivery}, nil
+	}
+
+	builders := make([]*ent.WebhookDeliveryCreate, len(res.Attempts))
+
+	for i, a := range res.Attempts {
+		builders[i] = newDelivery(a.Number).
+			SetResponseStatus(a.StatusCode).
+			SetResponseBody(a.Response).
+			SetLatency(a.Duration.Milliseconds())
+
+		if a.Err != nil {
+			builders[i].SetError(a.Err.Error())
+		}
+	}
+
+	return c.WebhookDelivery.CreateBulk(builders...).Save(ctx)
+}
+
+// updateWebhookStatus updates the failures, last error and last response of the webhook,
+// disabling the webhook once the consecutive failure threshold is reached
+func updateWebhookStatus(ctx context.Context, c *ent.Client, id string, res *webhooks.Result, deliveryErr error) error {
+	update := c.Webhook.UpdateOneID(id)
+
+	if last := res.Last(); last != nil {
+		update.SetLastResponse(last.Response)
+	}
+
+	if deliveryErr == nil {
+		return update.
+			SetFailures(0).
+			ClearLastError().
+			Exec(ctx)
+	}
+
+	w, err := update.
+		AddFailures(1).
+		SetLastError(deliveryErr.Error()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if w.Enabled && c.WebhookDeliverer.ExceedsFailureThreshold(w.Failures) {
+		c.Logger.Warnw("disabling webhook after consecutive delivery failures", "webhook_id", w.ID, "failures", w.Failures)
+
+		return c.Webhook.UpdateOneID(id).
+			SetEnabled(false).
+			Exec(ctx)
+	}
+
+	return nil
+}
@@ -0,0 +1,445 @@
+package graphapi_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/webhookdelivery"
+	"github.com/datumforge/datum/internal/graphapi"
+	"github.com/datumforge/datum/pkg/datumclient"
+	"github.com/datumforge/datum/pkg/webhooks"
+)
+
+func (suite *GraphTestSuite) TestWebhookDelivery() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	testCases := []struct {
+		name             string
+		status           int
+		maxFailures      int
+		expectedFailures int
+		expectedEnabled  bool
+		expectedErr      bool
+	}{
+		{
+			name:             "delivered",
+			status:           http.StatusOK,
+			maxFailures:      3,
+			expectedFailures: 0,
+			expectedEnabled:  true,
+		},
+		{
+			name:             "failed, still enabled",
+			status:           http.StatusBadRequest,
+			maxFailures:      3,
+			expectedFailures: 1,
+			expectedEnabled:  true,
+			expectedErr:      true,
+		},
+		{
+			name:             "failed, disabled after reaching max failures",
+			status:           http.StatusBadRequest,
+			maxFailures:      1,
+			expectedFailures: 1,
+			expectedEnabled:  false,
+			expectedErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				mu        sync.Mutex
+				received  []webhooks.Payload
+				body      []byte
+				signature string
+			)
+
+			srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				body, _ = io.ReadAll(r.Body)
+
+				var p webhooks.Payload
+				_ = json.Unmarshal(body, &p)
+
+				received = append(received, p)
+				signature = r.Header.Get(webhooks.SignatureHeader)
+
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(http.StatusText(tc.status)))
+			}))
+			defer srv.Close()
+
+			suite.client.db.WebhookDeliverer = webhooks.New(webhooks.Config{
+				Enabled:     true,
+				Timeout:     time.Second,
+				MaxFailures: tc.maxFailures,
+			}, webhooks.WithHTTPClient(srv.Client()))
+
+			defer func() { suite.client.db.WebhookDeliverer = nil }()
+
+			hook := suite.client.db.Webhook.Create().
+				SetName(gofakeit.AppName()).
+				SetDestinationURL(srv.URL).
+				SetOwnerID(testOrgID).
+				SaveX(allowCtx)
+
+			defer suite.client.db.Webhook.DeleteOneID(hook.ID).ExecX(allowCtx)
+
+			// a secret is generated on create to sign deliveries
+			require.NotEmpty(t, hook.Secret)
+
+			graphapi.CreateEvent(reqCtx, suite.client.db, suite.client.db.Group.Create().Mutation(), ent.Group{
+				ID:   "01J4EXD5MM60CX4YNYN0DEE3Y1",
+				Name: "meow",
+			})
+
+			require.Eventually(t, func() bool {
+				recorded, err := suite.client.db.WebhookDelivery.Query().
+					Where(webhookdelivery.WebhookID(hook.ID)).
+					Exist(allowCtx)
+				if err != nil || !recorded {
+					return false
+				}
+
+				w, err := suite.client.db.Webhook.Get(allowCtx, hook.ID)
+				if err != nil {
+					return false
+				}
+
+				// wait until the delivery has been recorded on the webhook
+				return (w.LastError != "") == tc.expectedErr && w.Enabled == tc.expectedEnabled
+			}, 5*time.Second, 50*time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			require.Len(t, received, 1)
+			assert.Equal(t, "group.created", received[0].Type)
+			assert.Equal(t, testOrgID, received[0].OrganizationID)
+			assert.Equal(t, "meow", received[0].Data["group_name"])
+
+			// the event is recorded against the webhook
+			evt, err := suite.client.db.Event.Get(allowCtx, received[0].ID)
+			require.NoError(t, err)
+			assert.Equal(t, "group.created", evt.EventType)
+
+			assert.NoError(t, webhooks.VerifySignature(hook.Secret, signature, body, time.Minute))
+
+			// every attempt is recorded as a delivery
+			deliveries, err := suite.client.db.WebhookDelivery.Query().
+				Where(webhookdelivery.WebhookID(hook.ID)).
+				All(allowCtx)
+			require.NoError(t, err)
+			require.Len(t, deliveries, 1)
+
+			assert.Equal(t, evt.ID, deliveries[0].EventID)
+			assert.Equal(t, 1, deliveries[0].Attempt)
+			assert.Equal(t, tc.status, deliveries[0].ResponseStatus)
+			assert.Equal(t, string(body), deliveries[0].RequestBody)
+			assert.False(t, deliveries[0].Redelivery)
+
+			if tc.expectedErr {
+				assert.NotEmpty(t, deliveries[0].Error)
+			} else {
+				assert.Empty(t, deliveries[0].Error)
+			}
+
+			w, err := suite.client.db.Webhook.Get(allowCtx, hook.ID)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedFailures, w.Failures)
+			assert.Equal(t, tc.expectedEnabled, w.Enabled)
+
+			// the test client does not refuse internal addresses so the response is not kept
+			assert.Empty(t, w.LastResponse)
+			assert.Empty(t, deliveries[0].ResponseBody)
+
+			if tc.expectedErr {
+				assert.Contains(t, w.LastError, webhooks.ErrDeliveryFailed.Error())
+			} else {
+				assert.Empty(t, w.LastError)
+			}
+
+			mock_fga.ClearMocks(suite.client.fga)
+		})
+	}
+}
+
+func (suite *GraphTestSuite) TestWebhookSubscriptions() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	testCases := []struct {
+		name           string
+		destinationURL string
+		eventTypes     []string
+		objectTypes    []string
+		integration    bool
+		expectedCalls  int32
+		expectedErr    error
+	}{
+		{
+			name:          "all events",
+			expectedCalls: 1,
+		},
+		{
+			name:          "webhook of an integration",
+			integration:   true,
+			expectedCalls: 0,
+		},
+		{
+			name:          "subscribed to event type",
+			eventTypes:    []string{"group.created", "user.created"},
+			expectedCalls: 1,
+		},
+		{
+			name:          "not subscribed to event type",
+			eventTypes:    []string{"group.deleted"},
+			expectedCalls: 0,
+		},
+		{
+			name:          "subscribed to object type",
+			objectTypes:   []string{"group"},
+			expectedCalls: 1,
+		},
+		{
+			name:          "not subscribed to object type",
+			eventTypes:    []string{"group.created", "user.created"},
+			objectTypes:   []string{"user"},
+			expectedCalls: 0,
+		},
+		{
+			name:        "invalid event type",
+			eventTypes:  []string{"user_created"},
+			expectedErr: webhooks.ErrInvalidEventType,
+		},
+		{
+			name:        "invalid object type",
+			objectTypes: []string{"feature"},
+			expectedErr: webhooks.ErrInvalidObjectType,
+		},
+		{
+			name:           "destination is not https",
+			destinationURL: "http://example.com/hook",
+			expectedErr:    webhooks.ErrInvalidDestination,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls int32
+
+			srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+			}))
+			defer srv.Close()
+
+			suite.client.db.WebhookDeliverer = webhooks.New(webhooks.Config{
+				Enabled: true,
+				Timeout: time.Second,
+			}, webhooks.WithHTTPClient(srv.Client()))
+
+			defer func() { suite.client.db.WebhookDeliverer = nil }()
+
+			destinationURL := srv.URL
+			if tc.destinationURL != "" {
+				destinationURL = tc.destinationURL
+			}
+
+			hook, err := suite.client.db.Webhook.Create().
+				SetName(gofakeit.AppName()).
+				SetDestinationURL(destinationURL).
+				SetOwnerID(testOrgID).
+				SetEventTypes(tc.eventTypes).
+				SetObjectTypes(tc.objectTypes).
+				Save(allowCtx)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			defer suite.client.db.Webhook.DeleteOneID(hook.ID).ExecX(allowCtx)
+
+			if tc.integration {
+				integration := suite.client.db.Integration.Create().
+					SetName("slack").
+					SetKind("slack").
+					SetOwnerID(testOrgID).
+					AddWebhooks(hook).
+					SaveX(allowCtx)
+
+				defer suite.client.db.Integration.DeleteOneID(integration.ID).ExecX(allowCtx)
+			}
+
+			// appended event types are validated as well
+			err = suite.client.db.Webhook.UpdateOneID(hook.ID).AppendEventTypes([]string{"meow.created"}).Exec(allowCtx)
+			require.ErrorIs(t, err, webhooks.ErrInvalidEventType)
+
+			graphapi.CreateEvent(reqCtx, suite.client.db, suite.client.db.Group.Create().Mutation(), ent.Group{
+				ID:   "01J4EXD5MM60CX4YNYN0DEE3Y1",
+				Name: "meow",
+			})
+
+			if tc.expectedCalls > 0 {
+				require.Eventually(t, func() bool {
+					return atomic.LoadInt32(&calls) == tc.expectedCalls
+				}, 5*time.Second, 50*time.Millisecond)
+			} else {
+				// give the queued dispatch time to run before asserting nothing was sent
+				time.Sleep(500 * time.Millisecond)
+
+				assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+			}
+
+			mock_fga.ClearMocks(suite.client.fga)
+		})
+	}
+}
+
+func (suite *GraphTestSuite) TestMutationRedeliverWebhookEvent() {
+	t := suite.T()
+
+	reqCtx, err := userContext()
+	require.NoError(t, err)
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	var calls int32
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	hook := suite.client.db.Webhook.Create().
+		SetName(gofakeit.AppName()).
+		SetDestinationURL(srv.URL).
+		SetOwnerID(testOrgID).
+		SaveX(allowCtx)
+
+	defer suite.client.db.Webhook.DeleteOneID(hook.ID).ExecX(allowCtx)
+
+	evt := suite.client.db.Event.Create().
+		SetEventType("group.created").
+		SetMetadata(map[string]interface{}{"group_name": "meow"}).
+		AddWebhook(hook).
+		SaveX(allowCtx)
+
+	otherEvt := suite.client.db.Event.Create().
+		SetEventType("group.created").
+		SaveX(allowCtx)
+
+	testCases := []struct {
+		name        string
+		webhookID   string
+		eventID     string
+		enabled     bool
+		expectedErr string
+	}{
+		{
+			name:      "happy path",
+			webhookID: hook.ID,
+			eventID:   evt.ID,
+			enabled:   true,
+		},
+		{
+			name:        "webhook delivery not enabled",
+			webhookID:   hook.ID,
+			eventID:     evt.ID,
+			enabled:     false,
+			expectedErr: graphapi.ErrWebhooksNotEnabled.Error(),
+		},
+		{
+			name:        "event was not sent to the webhook",
+			webhookID:   hook.ID,
+			eventID:     otherEvt.ID,
+			enabled:     true,
+			expectedErr: "event not found",
+		},
+		{
+			name:        "webhook not found",
+			webhookID:   "notfound",
+			eventID:     evt.ID,
+			enabled:     true,
+			expectedErr: "webhook not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Redeliver "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.client.fga)
+
+			if tc.enabled {
+				suite.client.db.WebhookDeliverer = webhooks.New(webhooks.Config{
+					Enabled: true,
+					Timeout: time.Second,
+				}, webhooks.WithHTTPClient(srv.Client()))
+
+				defer func() { suite.client.db.WebhookDeliverer = nil }()
+			}
+
+			before := atomic.LoadInt32(&calls)
+
+			resp, err := suite.client.datum.RedeliverWebhookEvent(reqCtx, tc.webhookID, tc.eventID)
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.expectedErr)
+				assert.Nil(t, resp)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			assert.Equal(t, before+1, atomic.LoadInt32(&calls))
+
+			require.Len(t, resp.RedeliverWebhookEvent.WebhookDeliveries, 1)
+
+			delivery := resp.RedeliverWebhookEvent.WebhookDeliveries[0]
+			assert.Equal(t, hook.ID, delivery.WebhookID)
+			assert.Equal(t, evt.ID, delivery.EventID)
+			assert.Equal(t, int64(1), delivery.Attempt)
+			assert.Equal(t, int64(http.StatusOK), *delivery.ResponseStatus)
+			assert.True(t, delivery.Redelivery)
+
+			// the delivery is returned when querying the webhook deliveries
+			deliveries, err := suite.client.datum.GetWebhookDeliveries(reqCtx, nil, nil, &datumclient.WebhookDeliveryWhereInput{
+				WebhookID: &hook.ID,
+			})
+			require.NoError(t, err)
+			require.Len(t, deliveries.WebhookDeliveries.Edges, 1)
+			assert.Equal(t, delivery.ID, deliveries.WebhookDeliveries.Edges[0].Node.ID)
+		})
+	}
+}
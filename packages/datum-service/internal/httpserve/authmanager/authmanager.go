@@ -91,7 +91,12 @@ func (a *Config) GenerateUserAuthSessionWithOrg(ctx echo.Context, user *generate
 
 // GenerateOauthAuthSession creates a new auth session for the oauth user and their default organization id
 func (a *Config) GenerateOauthAuthSession(ctx context.Context, w http.ResponseWriter, user *generated.User, oauthRequest models.OauthTokenRequest) (*models.AuthData, error) {
-	auth, err := a.createTokenPair(user, "")
+	return a.GenerateOauthAuthSessionWithOrg(ctx, w, user, oauthRequest, "")
+}
+
+// GenerateOauthAuthSessionWithOrg creates a new auth session for the oauth user and the target organization id
+func (a *Config) GenerateOauthAuthSessionWithOrg(ctx context.Context, w http.ResponseWriter, user *generated.User, oauthRequest models.OauthTokenRequest, targetOrgID string) (*models.AuthData, error) {
+	auth, err := a.createTokenPair(user, targetOrgID)
 	if err != nil {
 		return nil, err
 	}
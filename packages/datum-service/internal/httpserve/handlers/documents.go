@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+	"github.com/datumforge/fgax"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/documentdata"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/hooks"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/middleware/transaction"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/templates"
+)
+
+// DocumentRenderHandler renders the data of a document with the uischema of its template and returns the
+// document as a PDF or HTML download
+func (h *Handler) DocumentRenderHandler(ctx echo.Context) error {
+	var in models.DocumentRenderRequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	// access is checked against the owning organization below, so allow the lookup of the document and its template
+	docGetCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	doc, err := transaction.FromContext(reqCtx).DocumentData.Query().
+		Where(documentdata.ID(in.ID)).
+		WithTemplate().
+		Only(docGetCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return h.NotFound(ctx, ErrNotFound)
+		}
+
+		h.Logger.Errorw("unable to get document", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	subjectID, err := auth.GetUserIDFromContext(reqCtx)
+	if err != nil {
+		h.Logger.Errorw("unable to get subject id from context", "error", err)
+
+		return h.BadRequest(ctx, err)
+	}
+
+	allow, err := h.DBClient.Authz.CheckOrgReadAccess(reqCtx, fgax.AccessCheck{
+		SubjectID:   subjectID,
+		SubjectType: auth.GetAuthzSubjectType(reqCtx),
+		ObjectID:    doc.OwnerID,
+	})
+	if err != nil {
+		h.Logger.Errorw("error checking document access", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	// do not leak the existence of documents the subject cannot access
+	if !allow {
+		return h.NotFound(ctx, ErrNotFound)
+	}
+
+	// the document is rendered with the template version it is pinned to
+	rd, err := hooks.RenderableDocument(docGetCtx, transaction.FromContext(reqCtx).Client(), doc)
+	if err != nil {
+		h.Logger.Errorw("unable to get document template", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	format := templates.Format(in.Format)
+
+	// render into a buffer so errors are returned before the response is started
+	var buf bytes.Buffer
+
+	if err := templates.Render(&buf, format, rd); err != nil {
+		h.Logger.Errorw("unable to render document", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition,
+		mime.FormatMediaType("attachment", map[string]string{"filename": fmt.Sprintf("%s.%s", doc.ID, format.Extension())}))
+
+	return ctx.Blob(http.StatusOK, format.ContentType(), buf.Bytes())
+}
+
+// BindDocumentRender returns the OpenAPI3 operation for a document render request
+func (h *Handler) BindDocumentRender() *openapi3.Operation {
+	render := openapi3.NewOperation()
+	render.Description = "Render a document with the layout of its template as a PDF or HTML download"
+	render.OperationID = "DocumentRender"
+	render.Security = &openapi3.SecurityRequirements{
+		openapi3.SecurityRequirement{
+			"bearerAuth": []string{},
+		},
+	}
+
+	render.AddParameter(openapi3.NewPathParameter("id").WithSchema(openapi3.NewStringSchema()))
+	render.AddParameter(openapi3.NewQueryParameter("format").
+		WithSchema(openapi3.NewStringSchema().WithEnum("pdf", "html").WithDefault("pdf")))
+	render.AddResponse(http.StatusOK, openapi3.NewResponse().
+		WithDescription("rendered document").
+		WithContent(openapi3.NewContentWithSchema(openapi3.NewStringSchema().WithFormat("binary"),
+			[]string{templates.FormatPDF.ContentType(), templates.FormatHTML.ContentType()})))
+	render.AddResponse(http.StatusInternalServerError, internalServerError())
+	render.AddResponse(http.StatusBadRequest, badRequest())
+	render.AddResponse(http.StatusUnauthorized, unauthorized())
+	render.AddResponse(http.StatusNotFound, notFound())
+
+	return render
+}
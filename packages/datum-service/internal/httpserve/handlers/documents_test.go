@@ -0,0 +1,164 @@
+package handlers_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/enums"
+)
+
+func (suite *HandlerTestSuite) TestDocumentRenderHandler() {
+	t := suite.T()
+
+	// add handler
+	suite.e.GET("documents/:id/render", suite.h.DocumentRenderHandler)
+
+	// bypass auth
+	ctx := context.Background()
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	mock_fga.WriteAny(t, suite.fga)
+
+	// setup test data
+	user := suite.db.User.Create().
+		SetEmail("pochacco@datum.net").
+		SetFirstName("Pochacco").
+		SetLastName("Dog").
+		SaveX(ctx)
+
+	setting, err := suite.db.UserSetting.Query().Where(usersetting.UserID(user.ID)).WithDefaultOrg().Only(ctx)
+	require.NoError(t, err)
+
+	orgID := setting.Edges.DefaultOrg.ID
+
+	reqCtx, err := auth.NewTestContextWithOrgID(user.ID, orgID)
+	require.NoError(t, err)
+
+	// templates and documents are owned by the organization of the context
+	orgCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	template := suite.db.Template.Create().
+		SetName("Mutual NDA").
+		SetTemplateType(enums.Document).
+		SetJsonconfig(map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"name": map[string]any{"type": "string", "title": "Company name"},
+			},
+		}).
+		SaveX(orgCtx)
+
+	doc := suite.db.DocumentData.Create().
+		SetTemplateID(template.ID).
+		SetData(map[string]any{"name": "MITB"}).
+		SaveX(orgCtx)
+
+	// the document is rendered with the template version it was created with, not the current template
+	suite.db.Template.UpdateOne(template).
+		SetJsonconfig(map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"name": map[string]any{"type": "string", "title": "Legal name"},
+			},
+		}).
+		ExecX(orgCtx)
+
+	mock_fga.ClearMocks(suite.fga)
+
+	testCases := []struct {
+		name              string
+		id                string
+		format            string
+		checkAllow        *bool
+		expectCode        int
+		expectContentType string
+		expectBody        string
+		expectExtension   string
+	}{
+		{
+			name:              "happy path, pdf by default",
+			id:                doc.ID,
+			checkAllow:        &[]bool{true}[0],
+			expectCode:        http.StatusOK,
+			expectContentType: "application/pdf",
+			expectBody:        "%PDF-",
+			expectExtension:   "pdf",
+		},
+		{
+			name:              "happy path, html",
+			id:                doc.ID,
+			format:            "html",
+			checkAllow:        &[]bool{true}[0],
+			expectCode:        http.StatusOK,
+			expectContentType: "text/html; charset=utf-8",
+			expectBody:        `<span class="label">Company name</span><span class="value">MITB</span>`,
+			expectExtension:   "html",
+		},
+		{
+			name:       "unsupported format",
+			id:         doc.ID,
+			format:     "docx",
+			expectCode: http.StatusBadRequest,
+		},
+		{
+			name:       "no access",
+			id:         doc.ID,
+			checkAllow: &[]bool{false}[0],
+			expectCode: http.StatusNotFound,
+		},
+		{
+			name:       "document does not exist",
+			id:         "01J5ZZQJ9XGJ3TPRX7B3RW6WVF",
+			expectCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Render "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.fga)
+
+			if tc.checkAllow != nil {
+				mock_fga.CheckAny(t, suite.fga, *tc.checkAllow)
+			}
+
+			target := "/documents/" + tc.id + "/render"
+			if tc.format != "" {
+				target += "?format=" + tc.format
+			}
+
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+
+			// Set writer for tests that write on the response
+			recorder := httptest.NewRecorder()
+
+			// Using the ServerHTTP on echo will trigger the router and middleware
+			suite.e.ServeHTTP(recorder, req.WithContext(reqCtx))
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectCode, recorder.Code)
+
+			if tc.expectCode != http.StatusOK {
+				return
+			}
+
+			body, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Contains(t, string(body), tc.expectBody)
+			assert.Equal(t, tc.expectContentType, res.Header.Get("Content-Type"))
+			assert.Equal(t, "attachment; filename="+doc.ID+"."+tc.expectExtension, res.Header.Get("Content-Disposition"))
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	echo "github.com/datumforge/echox"
+	"github.com/getkin/kin-openapi/openapi3"
+	ph "github.com/posthog/posthog-go"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/domains"
+	"github.com/datumforge/datum/pkg/middleware/transaction"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/rout"
+)
+
+// DomainVerificationHandler verifies the organization of the request owns one of its domains by looking up the
+// verification token of the organization in a DNS TXT record or the well-known file of the domain, verified domains
+// are added to the organization settings and are used to join users to the organization by their email address
+func (h *Handler) DomainVerificationHandler(ctx echo.Context) error {
+	var in models.DomainVerificationRequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	orgID, err := auth.GetOrganizationIDFromContext(reqCtx)
+	if err != nil {
+		h.Logger.Errorw("unable to get organization id from context", "error", err)
+
+		return h.BadRequest(ctx, err)
+	}
+
+	tx := transaction.FromContext(reqCtx)
+
+	org, err := tx.Organization.Query().
+		Where(organization.ID(orgID)).
+		WithSetting().
+		Only(reqCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return h.NotFound(ctx, err)
+		}
+
+		h.Logger.Errorw("unable to get organization", "error", err)
+
+		return h.InternalServerError(ctx, err)
+	}
+
+	if org.PersonalOrg {
+		return h.BadRequest(ctx, ErrPersonalOrgDomain)
+	}
+
+	setting := org.Edges.Setting
+	if setting == nil || !domains.Contains(setting.Domains, in.Domain) {
+		return h.BadRequest(ctx, ErrDomainNotFound)
+	}
+
+	// the domain is one of the organization domains, which are validated, so it can always be normalized
+	domain, _ := domains.Normalize(in.Domain)
+
+	// settings created before domain verification was added do not have a token yet, the token is stored
+	// even if the verification fails so it can be published for the next attempt
+	if setting.DomainVerificationToken == "" {
+		setting, err = tx.OrganizationSetting.UpdateOne(setting).
+			SetDomainVerificationToken(domains.NewToken()).
+			Save(reqCtx)
+		if err != nil {
+			return h.domainVerificationError(ctx, err)
+		}
+	}
+
+	if err := h.DomainVerifier.Verify(reqCtx, domains.Method(in.Method), domain, setting.DomainVerificationToken); err != nil {
+		h.Logger.Infow("unable to verify domain", "domain", domain, "method", in.Method, "error", err)
+
+		return h.BadRequest(ctx, verificationInstructions(domains.Method(in.Method), domain, setting.DomainVerificationToken, err))
+	}
+
+	if !slices.Contains(setting.VerifiedDomains, domain) {
+		setting, err = tx.OrganizationSetting.UpdateOne(setting).
+			AppendVerifiedDomains([]string{domain}).
+			Save(reqCtx)
+		if err != nil {
+			return h.domainVerificationError(ctx, err)
+		}
+	}
+
+	props := ph.NewProperties().
+		Set("organization_id", org.ID).
+		Set("organization_name", org.Name).
+		Set("domain", domain).
+		Set("method", in.Method)
+
+	h.AnalyticsClient.Event("organization_domain_verified", props)
+
+	out := &models.DomainVerificationReply{
+		Reply:           rout.Reply{Success: true},
+		Domain:          domain,
+		VerifiedDomains: setting.VerifiedDomains,
+	}
+
+	return h.Success(ctx, out)
+}
+
+// domainVerificationError returns the response for errors updating the organization settings, only
+// owners and admins of the organization are allowed to update the settings
+func (h *Handler) domainVerificationError(ctx echo.Context, err error) error {
+	if errors.Is(err, privacy.Deny) {
+		return h.Unauthorized(ctx, err)
+	}
+
+	h.Logger.Errorw("unable to update organization setting", "error", err)
+
+	return h.InternalServerError(ctx, err)
+}
+
+// verificationInstructions returns the error of a failed verification with where the token must be published
+func verificationInstructions(method domains.Method, domain, token string, err error) error {
+	if method == domains.MethodHTTP {
+		return fmt.Errorf("%w, serve %q at %s", err, domains.RecordValue(token), domains.WellKnownURL(domain))
+	}
+
+	return fmt.Errorf("%w, add a TXT record %s with the value %q", err, domains.RecordName(domain), domains.RecordValue(token))
+}
+
+// BindDomainVerificationHandler binds the domain verification handler to the OpenAPI schema
+func (h *Handler) BindDomainVerificationHandler() *openapi3.Operation {
+	verify := openapi3.NewOperation()
+	verify.Description = "Verify the organization owns one of its domains with a DNS TXT record or a file served under the well-known path of the domain"
+	verify.OperationID = "DomainVerification"
+
+	h.AddRequestBody("DomainVerificationRequest", models.ExampleDomainVerificationRequest, verify)
+	h.AddResponse("DomainVerificationReply", "success", models.ExampleDomainVerificationReply, verify, http.StatusOK)
+	verify.AddResponse(http.StatusInternalServerError, internalServerError())
+	verify.AddResponse(http.StatusBadRequest, badRequest())
+	verify.AddResponse(http.StatusUnauthorized, unauthorized())
+
+	return verify
+}
@@ -0,0 +1,155 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/pkg/domains"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/rout"
+)
+
+func (suite *HandlerTestSuite) TestDomainVerificationHandler() {
+	t := suite.T()
+
+	suite.e.POST("/domains/verify", suite.h.DomainVerificationHandler)
+
+	mock_fga.WriteAny(t, suite.fga)
+	mock_fga.CheckAny(t, suite.fga, true)
+
+	orgID, ownerCtx := suite.createSSOOrg("guardians")
+
+	setting := suite.db.OrganizationSetting.Query().
+		Where(organizationsetting.OrganizationID(orgID)).
+		OnlyX(ownerCtx)
+
+	setting = suite.db.OrganizationSetting.UpdateOne(setting).
+		SetDomains([]string{"guardians.net", "https://xandar.net", "knowhere.net"}).
+		SaveX(ownerCtx)
+
+	require.NotEmpty(t, setting.DomainVerificationToken)
+
+	token := setting.DomainVerificationToken
+
+	suite.h.DomainVerifier = domains.NewVerifier(domains.WithResolver(&domains.StaticResolver{
+		TXT: map[string][]string{
+			domains.RecordName("guardians.net"): {domains.RecordValue(token)},
+			domains.RecordName("knowhere.net"):  {domains.RecordValue("not-the-token")},
+		},
+		WellKnown: map[string][]byte{
+			domains.WellKnownURL("xandar.net"): []byte(domains.RecordValue(token)),
+		},
+	}))
+
+	testCases := []struct {
+		name           string
+		request        models.DomainVerificationRequest
+		expectedStatus int
+		expectedErr    string
+		expected       []string
+	}{
+		{
+			name: "happy path, dns",
+			request: models.DomainVerificationRequest{
+				Domain: "guardians.net",
+				Method: "dns",
+			},
+			expectedStatus: http.StatusOK,
+			expected:       []string{"guardians.net"},
+		},
+		{
+			name: "happy path, http with a domain set with a scheme",
+			request: models.DomainVerificationRequest{
+				Domain: "xandar.net",
+				Method: "HTTP",
+			},
+			expectedStatus: http.StatusOK,
+			expected:       []string{"guardians.net", "xandar.net"},
+		},
+		{
+			name: "happy path, already verified",
+			request: models.DomainVerificationRequest{
+				Domain: "Guardians.net",
+				Method: "dns",
+			},
+			expectedStatus: http.StatusOK,
+			expected:       []string{"guardians.net", "xandar.net"},
+		},
+		{
+			name: "token not published",
+			request: models.DomainVerificationRequest{
+				Domain: "knowhere.net",
+				Method: "dns",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    domains.RecordValue(token),
+		},
+		{
+			name: "not a domain of the organization",
+			request: models.DomainVerificationRequest{
+				Domain: "kree.net",
+				Method: "dns",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrDomainNotFound.Error(),
+		},
+		{
+			name: "invalid method",
+			request: models.DomainVerificationRequest{
+				Domain: "guardians.net",
+				Method: "carrier-pigeon",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "method",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(tc.request)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/domains/verify", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			recorder := httptest.NewRecorder()
+
+			suite.e.ServeHTTP(recorder, req.WithContext(ownerCtx))
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedErr != "" {
+				var out *rout.Reply
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+				assert.Contains(t, out.Error, tc.expectedErr)
+
+				return
+			}
+
+			var out *models.DomainVerificationReply
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+			assert.True(t, out.Success)
+			assert.Equal(t, tc.expected, out.VerifiedDomains)
+		})
+	}
+
+	// removing a domain removes it from the verified domains
+	setting = suite.db.OrganizationSetting.UpdateOneID(setting.ID).
+		SetDomains([]string{"guardians.net"}).
+		SaveX(ownerCtx)
+
+	assert.Equal(t, []string{"guardians.net"}, setting.VerifiedDomains)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/usersetting"
 	"github.com/datumforge/datum/internal/ent/generated/webauthn"
 	"github.com/datumforge/datum/pkg/enums"
+	authmw "github.com/datumforge/datum/pkg/middleware/auth"
 	"github.com/datumforge/datum/pkg/middleware/transaction"
 )
 
@@ -398,6 +399,16 @@ func (h *Handler) CheckAndCreateUser(ctx context.Context, name, email string, pr
 				return nil, err
 			}
 
+			// the email address of users from these providers is verified by the provider, organization single
+			// sign-on providers are not trusted to assert email addresses in the domains of other organizations
+			if provider == enums.AuthProviderGoogle || provider == enums.AuthProviderGitHub {
+				if err := h.joinDomainOrganizations(ctx, entUser); err != nil {
+					h.Logger.Errorw("unable to join organizations for domain", "error", err)
+
+					return nil, err
+				}
+			}
+
 			// return newly created user
 			return entUser, nil
 		}
@@ -405,6 +416,11 @@ func (h *Handler) CheckAndCreateUser(ctx context.Context, name, email string, pr
 		return nil, err
 	}
 
+	// existing users must still be allowed to authenticate
+	if err := authmw.CheckUserSetting(entUser.Edges.Setting); err != nil {
+		return nil, err
+	}
+
 	// update last seen of user
 	if err := h.updateUserLastSeen(ctx, entUser.ID); err != nil {
 		h.Logger.Errorw("unable to update last seen", "error", err)
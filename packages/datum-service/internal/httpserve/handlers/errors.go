@@ -81,6 +81,75 @@ var (
 
 	// ErrAlreadySwitchedIntoOrg is returned when a user attempts to switch into an org they are currently authenticated in
 	ErrAlreadySwitchedIntoOrg = errors.New("user already switched into organization")
+
+	// ErrObjectStorageDisabled is returned when a file is uploaded or downloaded without object storage configured
+	ErrObjectStorageDisabled = errors.New("file storage is not enabled")
+
+	// ErrMissingFile is returned when an upload request does not include a file
+	ErrMissingFile = errors.New("file is required")
+
+	// ErrInvalidTFAChallenge is returned when the two factor challenge token is invalid or expired
+	ErrInvalidTFAChallenge = errors.New("two factor challenge is invalid or expired, please log in again")
+
+	// ErrInvalidTFACode is returned when the provided TOTP or recovery code is not valid for the user
+	ErrInvalidTFACode = errors.New("two factor code is invalid")
+
+	// ErrTOTPNotEnabled is returned when a TOTP code is provided but TOTP is not enabled on the server
+	ErrTOTPNotEnabled = errors.New("totp is not enabled")
+
+	// ErrSSONotConfigured is returned when the organization does not have an oauth provider for single sign-on
+	ErrSSONotConfigured = errors.New("single sign-on is not configured for the organization")
+
+	// ErrSSOIdentityMissing is returned when the single sign-on provider does not return the subject of the user
+	ErrSSOIdentityMissing = errors.New("single sign-on provider did not return the identity of the user")
+
+	// ErrSSOEmailNotVerified is returned when the single sign-on provider did not verify the email address of the user
+	ErrSSOEmailNotVerified = errors.New("email address is not verified by the single sign-on provider")
+
+	// ErrSSODomainNotVerified is returned when the email address of a single sign-on user is not in a verified domain of the organization
+	ErrSSODomainNotVerified = errors.New("email address is not in a verified domain of the organization")
+
+	// ErrSSOAccountNotLinked is returned when the email address of a single sign-on user belongs to an account that is not linked to the identity
+	ErrSSOAccountNotLinked = errors.New("an account with the email address exists and is not linked to the single sign-on identity, please contact your organization administrator")
+
+	// ErrSSORequired is returned when the user must login with the single sign-on provider of their organization
+	ErrSSORequired = errors.New("organization requires login with single sign-on")
+
+	// ErrSSOLoginFailed is returned when the user cannot be authenticated with the single sign-on provider
+	ErrSSOLoginFailed = errors.New("unable to login with the single sign-on provider")
+
+	// ErrInvalidSSOState is returned when the state returned by the single sign-on provider does not match the request
+	ErrInvalidSSOState = errors.New("single sign-on state is missing or invalid")
+
+	// ErrInvalidSAMLResponse is returned when the SAML response of the identity provider cannot be validated
+	ErrInvalidSAMLResponse = errors.New("saml response is invalid")
+
+	// ErrSCIMRequiresAPIToken is returned when a SCIM request is not authenticated with an organization api token
+	ErrSCIMRequiresAPIToken = errors.New("scim provisioning requires an organization api token")
+
+	// ErrSCIMMemberNotInOrg is returned when a SCIM group member is not an active member of the organization
+	ErrSCIMMemberNotInOrg = errors.New("group members must be active members of the organization")
+
+	// ErrSCIMUserInvited is returned when a SCIM user has an existing account outside the verified domains of the organization
+	ErrSCIMUserInvited = errors.New("a user with the email address already exists, an invitation to join the organization was sent")
+
+	// ErrSCIMDomainNotVerified is returned when a new SCIM user has an email address outside the verified domains of the organization
+	ErrSCIMDomainNotVerified = errors.New("email address is not in a verified domain of the organization, an invitation to join the organization was sent")
+
+	// ErrDomainNotFound is returned when verifying a domain that is not one of the domains of the organization
+	ErrDomainNotFound = errors.New("domain is not one of the domains of the organization")
+
+	// ErrPersonalOrgDomain is returned when verifying a domain of a personal organization
+	ErrPersonalOrgDomain = errors.New("domains of a personal organization cannot be verified")
+
+	// ErrOrgNotJoinable is returned when the user is not offered to join the organization by the domain of their email address
+	ErrOrgNotJoinable = errors.New("organization cannot be joined with the domain of your email address")
+
+	// ErrJoinEmailNotVerified is returned when a user without a verified email address tries to join an organization by its domain
+	ErrJoinEmailNotVerified = errors.New("organizations can only be joined with a verified email address")
+
+	// ErrReservedEventTopic is returned when publishing an event to the topic of an event generated by the service
+	ErrReservedEventTopic = errors.New("topic is reserved for the events generated by the service")
 )
 
 var (
@@ -90,6 +159,12 @@ var (
 	UserExistsErrCode rout.ErrorCode = "USER_EXISTS"
 	// InvalidInputErrCode is returned when the input is invalid
 	InvalidInputErrCode rout.ErrorCode = "INVALID_INPUT"
+	// FileTooLargeErrCode is returned when an uploaded file exceeds the maximum size
+	FileTooLargeErrCode rout.ErrorCode = "FILE_TOO_LARGE"
+	// ContentTypeNotAllowedErrCode is returned when an uploaded file has a content type that is not allowed
+	ContentTypeNotAllowedErrCode rout.ErrorCode = "CONTENT_TYPE_NOT_ALLOWED"
+	// InvalidTFACodeErrCode is returned when the second factor provided during login is invalid
+	InvalidTFACodeErrCode rout.ErrorCode = "INVALID_TFA_CODE"
 )
 
 // IsConstraintError returns true if the error resulted from a database constraint violation.
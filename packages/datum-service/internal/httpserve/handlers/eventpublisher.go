@@ -2,15 +2,18 @@ package handlers
 
 import (
 	"net/http"
+	"slices"
 
 	echo "github.com/datumforge/echox"
 	"github.com/getkin/kin-openapi/openapi3"
 
 	"github.com/datumforge/datum/pkg/models"
 	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/webhooks"
 )
 
-// EventPublisher publishes an event to the configured topic in the message payload - today this can be anything but there is no event consumer on the other side yet
+// EventPublisher publishes an event to the configured topic in the message payload; the events generated by the
+// service are relayed from the outbox to the webhooks and analytics, so their topics cannot be published to
 func (h *Handler) EventPublisher(ctx echo.Context) error {
 	var in models.PublishRequest
 	if err := ctx.Bind(&in); err != nil {
@@ -21,6 +24,10 @@ func (h *Handler) EventPublisher(ctx echo.Context) error {
 		return h.InvalidInput(ctx, err)
 	}
 
+	if slices.Contains(webhooks.EventTypes(), in.Topic) {
+		return h.BadRequest(ctx, ErrReservedEventTopic)
+	}
+
 	if err := h.EventManager.Publish(in.Topic, []byte(in.Message)); err != nil {
 		return h.InternalServerError(ctx, err)
 	}
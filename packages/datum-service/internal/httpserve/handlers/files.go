@@ -0,0 +1,347 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	echo "github.com/datumforge/echox"
+	"github.com/datumforge/fgax"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/file"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/middleware/transaction"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/objects"
+	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/utils/ulids"
+)
+
+const (
+	// uploadFormField is the multipart form field containing the uploaded file
+	uploadFormField = "file"
+	// multipartOverhead is the additional request body size allowed on top of the max upload size for multipart boundaries and fields
+	multipartOverhead = 1 << 20
+	// sniffLength is the number of bytes used to detect the content type of an upload
+	sniffLength = 512
+	// defaultContentType is the content type sent by clients that do not know the type of the file
+	defaultContentType = "application/octet-stream"
+)
+
+// FileUploadHandler accepts a multipart file upload, writes the contents to object storage and creates the File
+// in the authenticated organization
+func (h *Handler) FileUploadHandler(ctx echo.Context) error {
+	if h.ObjectStorage == nil {
+		return h.BadRequest(ctx, ErrObjectStorageDisabled)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	subjectID, err := auth.GetUserIDFromContext(reqCtx)
+	if err != nil {
+		h.Logger.Errorw("unable to get subject id from context", "error", err)
+
+		return h.BadRequest(ctx, err)
+	}
+
+	orgID, err := auth.GetOrganizationIDFromContext(reqCtx)
+	if err != nil {
+		h.Logger.Errorw("unable to get organization id from context", "error", err)
+
+		return h.BadRequest(ctx, err)
+	}
+
+	// limit the size of the request body before the multipart form is parsed
+	if h.ObjectStorage.MaxUploadSize > 0 {
+		ctx.Request().Body = http.MaxBytesReader(ctx.Response(), ctx.Request().Body, h.ObjectStorage.MaxUploadSize+multipartOverhead)
+	}
+
+	header, err := ctx.FormFile(uploadFormField)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return h.BadRequestWithCode(ctx, objects.ErrFileTooLarge, FileTooLargeErrCode)
+		}
+
+		return h.InvalidInput(ctx, ErrMissingFile)
+	}
+
+	if err := h.ObjectStorage.ValidateSize(header.Size); err != nil {
+		return h.BadRequestWithCode(ctx, err, FileTooLargeErrCode)
+	}
+
+	f, err := header.Open()
+	if err != nil {
+		h.Logger.Errorw("unable to open uploaded file", "error", err)
+
+		return h.BadRequest(ctx, err)
+	}
+	defer f.Close()
+
+	contentType, err := detectContentType(header.Header.Get(echo.HeaderContentType), f)
+	if err != nil {
+		h.Logger.Errorw("unable to detect content type of uploaded file", "error", err)
+
+		return h.BadRequest(ctx, err)
+	}
+
+	if err := h.ObjectStorage.ValidateContentType(contentType); err != nil {
+		return h.BadRequestWithCode(ctx, err, ContentTypeNotAllowedErrCode)
+	}
+
+	fileName := filepath.Base(header.Filename)
+	extension := filepath.Ext(fileName)
+
+	// objects are namespaced by organization, the file name is not used in the key to avoid collisions
+	storeKey := fmt.Sprintf("%s/%s%s", orgID, ulids.New().String(), extension)
+
+	size, err := h.ObjectStorage.Upload(reqCtx, storeKey, f, contentType)
+	if err != nil {
+		h.Logger.Errorw("unable to upload file to object storage", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	create := transaction.FromContext(reqCtx).File.Create().
+		SetFileName(fileName).
+		SetFileExtension(strings.TrimPrefix(extension, ".")).
+		SetFileSize(int(size)).
+		SetContentType(contentType).
+		SetStoreKey(storeKey).
+		AddOrganizationIDs(orgID)
+
+	if category := ctx.FormValue("category"); category != "" {
+		create.SetCategory(category)
+	}
+
+	if annotation := ctx.FormValue("annotation"); annotation != "" {
+		create.SetAnnotation(annotation)
+	}
+
+	// api tokens are not users, so only link the file to the uploader when authenticated as a user
+	if auth.GetAuthzSubjectType(reqCtx) == auth.UserSubjectType {
+		create.SetUserID(subjectID)
+	}
+
+	entFile, err := create.Save(reqCtx)
+	if err != nil {
+		h.Logger.Errorw("unable to create file", "error", err)
+
+		// remove the orphaned object, the transaction will be rolled back
+		if err := h.ObjectStorage.Delete(context.WithoutCancel(reqCtx), storeKey); err != nil {
+			h.Logger.Errorw("unable to delete orphaned object", "error", err, "store_key", storeKey)
+		}
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	out := &models.FileUploadReply{
+		Reply:       rout.Reply{Success: true},
+		ID:          entFile.ID,
+		Name:        entFile.FileName,
+		ContentType: entFile.ContentType,
+		Size:        size,
+		Message:     "file uploaded",
+	}
+
+	return h.Created(ctx, out)
+}
+
+// FileDownloadHandler streams the contents of a file from object storage after checking the subject has access
+// to the file
+func (h *Handler) FileDownloadHandler(ctx echo.Context) error {
+	if h.ObjectStorage == nil {
+		return h.BadRequest(ctx, ErrObjectStorageDisabled)
+	}
+
+	var in models.FileDownloadRequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	// access is checked against the owning organizations below, so allow the lookup of the file and its edges
+	fileGetCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	entFile, err := transaction.FromContext(reqCtx).File.Query().
+		Where(file.ID(in.ID)).
+		WithUser().
+		WithOrganization().
+		Only(fileGetCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return h.NotFound(ctx, ErrNotFound)
+		}
+
+		h.Logger.Errorw("unable to get file", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	allow, err := h.checkFileAccess(reqCtx, entFile)
+	if err != nil {
+		h.Logger.Errorw("error checking file access", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	// do not leak the existence of files the subject cannot access
+	if !allow {
+		return h.NotFound(ctx, ErrNotFound)
+	}
+
+	obj, err := h.ObjectStorage.Download(reqCtx, entFile.StoreKey)
+	if err != nil {
+		if errors.Is(err, objects.ErrObjectNotFound) {
+			return h.NotFound(ctx, ErrNotFound)
+		}
+
+		h.Logger.Errorw("unable to download file from object storage", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+	defer obj.Close()
+
+	contentType := entFile.ContentType
+	if contentType == "" {
+		contentType = obj.ContentType
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition,
+		mime.FormatMediaType("attachment", map[string]string{"filename": entFile.FileName}))
+
+	if obj.Size > 0 {
+		ctx.Response().Header().Set(echo.HeaderContentLength, fmt.Sprintf("%d", obj.Size))
+	}
+
+	return ctx.Stream(http.StatusOK, contentType, obj)
+}
+
+// checkFileAccess returns true when the authenticated subject uploaded the file or can view one of the
+// organizations the file belongs to
+func (h *Handler) checkFileAccess(ctx context.Context, f *ent.File) (bool, error) {
+	subjectID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if f.Edges.User != nil && f.Edges.User.ID == subjectID {
+		return true, nil
+	}
+
+	for _, org := range f.Edges.Organization {
+		req := fgax.AccessCheck{
+			SubjectID:   subjectID,
+			SubjectType: auth.GetAuthzSubjectType(ctx),
+			ObjectID:    org.ID,
+		}
+
+		allow, err := h.DBClient.Authz.CheckOrgReadAccess(ctx, req)
+		if err != nil {
+			return false, err
+		}
+
+		if allow {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// detectContentType sniffs the content type from the file contents, the content type provided by the client is only
+// used when it has the same media type as the sniffed content type so a file cannot be uploaded as another type
+func detectContentType(provided string, f io.ReadSeeker) (string, error) {
+	buf := make([]byte, sniffLength)
+
+	n, err := io.ReadFull(f, buf)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	// rewind so the full contents are uploaded
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	sniffed := http.DetectContentType(buf[:n])
+
+	providedType, _, err := mime.ParseMediaType(provided)
+	if err != nil {
+		return sniffed, nil
+	}
+
+	sniffedType, _, err := mime.ParseMediaType(sniffed)
+	if err != nil || providedType != sniffedType {
+		return sniffed, nil
+	}
+
+	return provided, nil
+}
+
+// BindFileUpload returns the OpenAPI3 operation for accepting a file upload request
+func (h *Handler) BindFileUpload() *openapi3.Operation {
+	upload := openapi3.NewOperation()
+	upload.Description = "Upload a file to the authenticated organization"
+	upload.OperationID = "FileUpload"
+	upload.Security = &openapi3.SecurityRequirements{
+		openapi3.SecurityRequirement{
+			"bearerAuth": []string{},
+		},
+	}
+
+	schema := openapi3.NewObjectSchema().
+		WithProperty(uploadFormField, openapi3.NewStringSchema().WithFormat("binary")).
+		WithProperty("category", openapi3.NewStringSchema()).
+		WithProperty("annotation", openapi3.NewStringSchema())
+	schema.Required = []string{uploadFormField}
+
+	upload.RequestBody = &openapi3.RequestBodyRef{
+		Value: openapi3.NewRequestBody().
+			WithRequired(true).
+			WithContent(openapi3.NewContentWithFormDataSchema(schema)),
+	}
+
+	h.AddResponse("FileUploadReply", "success", models.ExampleFileUploadReply, upload, http.StatusCreated)
+	upload.AddResponse(http.StatusInternalServerError, internalServerError())
+	upload.AddResponse(http.StatusBadRequest, badRequest())
+	upload.AddResponse(http.StatusUnauthorized, unauthorized())
+
+	return upload
+}
+
+// BindFileDownload returns the OpenAPI3 operation for a file download request
+func (h *Handler) BindFileDownload() *openapi3.Operation {
+	download := openapi3.NewOperation()
+	download.Description = "Download the contents of a file"
+	download.OperationID = "FileDownload"
+	download.Security = &openapi3.SecurityRequirements{
+		openapi3.SecurityRequirement{
+			"bearerAuth": []string{},
+		},
+	}
+
+	download.AddParameter(openapi3.NewPathParameter("id").WithSchema(openapi3.NewStringSchema()))
+	download.AddResponse(http.StatusOK, openapi3.NewResponse().
+		WithDescription("file contents").
+		WithContent(openapi3.NewContentWithSchema(openapi3.NewStringSchema().WithFormat("binary"), []string{defaultContentType})))
+	download.AddResponse(http.StatusInternalServerError, internalServerError())
+	download.AddResponse(http.StatusBadRequest, badRequest())
+	download.AddResponse(http.StatusUnauthorized, unauthorized())
+	download.AddResponse(http.StatusNotFound, notFound())
+
+	return download
+}
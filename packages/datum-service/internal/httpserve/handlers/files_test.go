@@ -0,0 +1,305 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"testing"
+
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/objects"
+)
+
+// multipartUpload builds a multipart request body with the file contents under the file form field
+func multipartUpload(t *testing.T, fileName, contentType string, contents []byte) (*bytes.Buffer, string) {
+	t.Helper()
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	if fileName != "" {
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", `form-data; name="file"; filename="`+fileName+`"`)
+		h.Set("Content-Type", contentType)
+
+		part, err := w.CreatePart(h)
+		require.NoError(t, err)
+
+		_, err = part.Write(contents)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, w.WriteField("category", "evidence"))
+	require.NoError(t, w.Close())
+
+	return body, w.FormDataContentType()
+}
+
+func (suite *HandlerTestSuite) TestFileUploadAndDownloadHandler() {
+	t := suite.T()
+
+	// add handlers
+	suite.e.POST("files", suite.h.FileUploadHandler)
+	suite.e.GET("files/:id", suite.h.FileDownloadHandler)
+
+	store, err := objects.New(context.Background(), objects.Config{
+		Provider:            objects.ProviderDisk,
+		MaxUploadSize:       64,
+		AllowedContentTypes: []string{"text/plain", "application/pdf"},
+		Disk: objects.DiskConfig{
+			Path: t.TempDir(),
+		},
+	})
+	require.NoError(t, err)
+
+	suite.h.ObjectStorage = store
+
+	defer func() {
+		store.Close()
+		suite.h.ObjectStorage = nil
+	}()
+
+	// bypass auth
+	ctx := context.Background()
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	mock_fga.WriteAny(t, suite.fga)
+
+	// setup test data
+	uploader := suite.db.User.Create().
+		SetEmail("kitty@datum.net").
+		SetFirstName("Hello").
+		SetLastName("Kitty").
+		SaveX(ctx)
+
+	other := suite.db.User.Create().
+		SetEmail("keroppi@datum.net").
+		SetFirstName("Kero").
+		SetLastName("Keroppi").
+		SaveX(ctx)
+
+	setting, err := suite.db.UserSetting.Query().Where(usersetting.UserID(uploader.ID)).WithDefaultOrg().Only(ctx)
+	require.NoError(t, err)
+
+	uploaderCtx, err := auth.NewTestContextWithOrgID(uploader.ID, setting.Edges.DefaultOrg.ID)
+	require.NoError(t, err)
+
+	otherCtx, err := userContextWithID(other.ID)
+	require.NoError(t, err)
+
+	mock_fga.ClearMocks(suite.fga)
+
+	contents := []byte("meow meow meow")
+
+	uploadTestCases := []struct {
+		name        string
+		fileName    string
+		contentType string
+		contents    []byte
+		errCode     string
+		errMsg      string
+	}{
+		{
+			name:        "happy path",
+			fileName:    "meow.txt",
+			contentType: "text/plain",
+			contents:    contents,
+		},
+		{
+			name:        "provided content type does not match the contents",
+			fileName:    "spoofed.txt",
+			contentType: "image/png",
+			contents:    contents,
+		},
+		{
+			name:        "content type detected",
+			fileName:    "detected.txt",
+			contentType: "application/octet-stream",
+			contents:    contents,
+		},
+		{
+			name:        "file too large",
+			fileName:    "large.txt",
+			contentType: "text/plain",
+			contents:    bytes.Repeat([]byte("a"), 65),
+			errCode:     string(handlers.FileTooLargeErrCode),
+			errMsg:      objects.ErrFileTooLarge.Error(),
+		},
+		{
+			name:        "content type not allowed",
+			fileName:    "meow.png",
+			contentType: "text/plain",
+			contents:    []byte("\x89PNG\r\n\x1a\nmeow"),
+			errCode:     string(handlers.ContentTypeNotAllowedErrCode),
+			errMsg:      objects.ErrContentTypeNotAllowed.Error(),
+		},
+		{
+			name:    "missing file",
+			errCode: string(handlers.InvalidInputErrCode),
+			errMsg:  handlers.ErrMissingFile.Error(),
+		},
+	}
+
+	var uploadedID string
+
+	for _, tc := range uploadTestCases {
+		t.Run("Upload "+tc.name, func(t *testing.T) {
+			body, contentType := multipartUpload(t, tc.fileName, tc.contentType, tc.contents)
+
+			req := httptest.NewRequest(http.MethodPost, "/files", body)
+			req.Header.Set("Content-Type", contentType)
+
+			// Set writer for tests that write on the response
+			recorder := httptest.NewRecorder()
+
+			// Using the ServerHTTP on echo will trigger the router and middleware
+			suite.e.ServeHTTP(recorder, req.WithContext(uploaderCtx))
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			var out *models.FileUploadReply
+
+			// parse request body
+			if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+				t.Error("error parsing response", err)
+			}
+
+			if tc.errMsg != "" {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				assert.False(t, out.Success)
+				assert.Equal(t, tc.errMsg, out.Error)
+				assert.Equal(t, tc.errCode, string(out.ErrorCode))
+
+				return
+			}
+
+			assert.Equal(t, http.StatusCreated, recorder.Code)
+			assert.True(t, out.Success)
+			assert.NotEmpty(t, out.ID)
+			assert.Equal(t, tc.fileName, out.Name)
+			assert.Equal(t, int64(len(tc.contents)), out.Size)
+			assert.Contains(t, out.ContentType, "text/plain")
+
+			f, err := suite.db.File.Get(ctx, out.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "txt", f.FileExtension)
+			assert.Equal(t, "evidence", f.Category)
+
+			uploadedID = out.ID
+		})
+	}
+
+	require.NotEmpty(t, uploadedID)
+
+	downloadTestCases := []struct {
+		name       string
+		ctx        context.Context
+		id         string
+		checkAllow *bool
+		expectCode int
+	}{
+		{
+			name:       "uploader can download",
+			ctx:        uploaderCtx,
+			id:         uploadedID,
+			expectCode: http.StatusOK,
+		},
+		{
+			name:       "org member can download",
+			ctx:        otherCtx,
+			id:         uploadedID,
+			checkAllow: &[]bool{true}[0],
+			expectCode: http.StatusOK,
+		},
+		{
+			name:       "no access",
+			ctx:        otherCtx,
+			id:         uploadedID,
+			checkAllow: &[]bool{false}[0],
+			expectCode: http.StatusNotFound,
+		},
+		{
+			name:       "file does not exist",
+			ctx:        uploaderCtx,
+			id:         "01J5ZZQJ9XGJ3TPRX7B3RW6WVF",
+			expectCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range downloadTestCases {
+		t.Run("Download "+tc.name, func(t *testing.T) {
+			defer mock_fga.ClearMocks(suite.fga)
+
+			if tc.checkAllow != nil {
+				mock_fga.CheckAny(t, suite.fga, *tc.checkAllow)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/files/"+tc.id, nil)
+
+			// Set writer for tests that write on the response
+			recorder := httptest.NewRecorder()
+
+			// Using the ServerHTTP on echo will trigger the router and middleware
+			suite.e.ServeHTTP(recorder, req.WithContext(tc.ctx))
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectCode, recorder.Code)
+
+			if tc.expectCode != http.StatusOK {
+				return
+			}
+
+			body, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, contents, body)
+			assert.Contains(t, res.Header.Get("Content-Type"), "text/plain")
+			assert.Equal(t, `attachment; filename=detected.txt`, res.Header.Get("Content-Disposition"))
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestFileUploadHandlerStorageDisabled() {
+	t := suite.T()
+
+	// add handler
+	suite.e.POST("files", suite.h.FileUploadHandler)
+
+	reqCtx, err := auth.NewTestContextWithOrgID("01J5ZZQJ9XGJ3TPRX7B3RW6WVF", "01J5ZZQJ9XGJ3TPRX7B3RW6WVG")
+	require.NoError(t, err)
+
+	body, contentType := multipartUpload(t, "meow.txt", "text/plain", []byte("meow"))
+
+	req := httptest.NewRequest(http.MethodPost, "/files", body)
+	req.Header.Set("Content-Type", contentType)
+
+	recorder := httptest.NewRecorder()
+
+	suite.e.ServeHTTP(recorder, req.WithContext(reqCtx))
+
+	res := recorder.Result()
+	defer res.Body.Close()
+
+	var out *models.FileUploadReply
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, handlers.ErrObjectStorageDisabled.Error(), out.Error)
+}
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"net/http"
+
 	echo "github.com/datumforge/echox"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/lestrrat-go/jwx/v2/jwk"
@@ -10,7 +12,10 @@ import (
 	ent "github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/internal/httpserve/authmanager"
 	"github.com/datumforge/datum/pkg/analytics"
+	"github.com/datumforge/datum/pkg/domains"
 	"github.com/datumforge/datum/pkg/events/kafka/publisher"
+	"github.com/datumforge/datum/pkg/objects"
+	"github.com/datumforge/datum/pkg/providers/saml"
 	"github.com/datumforge/datum/pkg/sessions"
 	"github.com/datumforge/datum/pkg/tokens"
 	"github.com/datumforge/datum/pkg/utils/emails"
@@ -30,6 +35,8 @@ type Handler struct {
 	AuthManager *authmanager.Config
 	// TokenManager contains the token manager in order to validate auth requests
 	TokenManager *tokens.TokenManager
+	// TokenRevocations contains the refresh tokens revoked on logout
+	TokenRevocations tokens.RevocationList
 	// Logger provides the zap logger to do logging things from the handlers
 	Logger *zap.SugaredLogger
 	// ReadyChecks is a set of checkFuncs to determine if the application is "ready" upon startup
@@ -46,12 +53,23 @@ type Handler struct {
 	AnalyticsClient *analytics.EventManager
 	// OauthProvider contains the configuration settings for all supported Oauth2 providers
 	OauthProvider OauthProviderConfig
+	// AccountLockout contains the settings to lock accounts after too many failed login attempts
+	AccountLockout AccountLockoutConfig
 	// AuthMiddleware contains the middleware to be used for authenticated endpoints
 	AuthMiddleware []echo.MiddlewareFunc
 	// WebAuthn contains the configuration settings for the webauthn provider
 	WebAuthn *webauthn.WebAuthn
+	// SSOHTTPClient is the client used for requests to the single sign-on providers of organizations, a client that
+	// only connects to public addresses is used when it is not set
+	SSOHTTPClient *http.Client
+	// SAMLProvider contains the SAML service provider used for organization single sign-on
+	SAMLProvider *saml.Provider
+	// DomainVerifier verifies the ownership of the domains of organizations
+	DomainVerifier *domains.Verifier
 	// OTPManager contains the configuration settings for the OTP provider
 	OTPManager *totp.Manager
 	// EventManager contains the configuration settings for the event publisher
 	EventManager *publisher.KafkaPublisher
+	// ObjectStorage stores the contents of uploaded files
+	ObjectStorage *objects.Objects
 }
@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	echo "github.com/datumforge/echox"
+	"github.com/getkin/kin-openapi/openapi3"
+	ph "github.com/posthog/posthog-go"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/domains"
+	"github.com/datumforge/datum/pkg/enums"
+	"github.com/datumforge/datum/pkg/middleware/transaction"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/rout"
+)
+
+// JoinableOrganizationsHandler lists the organizations the user is offered to join because the domain of their
+// email address is a verified domain of the organization
+func (h *Handler) JoinableOrganizationsHandler(ctx echo.Context) error {
+	reqCtx := ctx.Request().Context()
+
+	u, err := h.getJoiningUser(reqCtx)
+	if err != nil {
+		return h.BadRequest(ctx, err)
+	}
+
+	orgs, err := h.domainOrganizations(reqCtx, u, enums.OrgJoinPolicyOffer, enums.OrgJoinPolicyAutoJoin)
+	if err != nil {
+		h.Logger.Errorw("unable to get organizations for domain", "error", err)
+
+		return h.InternalServerError(ctx, err)
+	}
+
+	out := &models.JoinableOrganizationsReply{
+		Reply:         rout.Reply{Success: true},
+		Organizations: []models.JoinableOrganization{},
+	}
+
+	for _, o := range orgs {
+		out.Organizations = append(out.Organizations, models.JoinableOrganization{
+			ID:          o.ID,
+			Name:        o.Name,
+			DisplayName: o.DisplayName,
+		})
+	}
+
+	return h.Success(ctx, out)
+}
+
+// JoinOrganizationHandler adds the user as a member of an organization they are offered to join because the domain
+// of their email address is a verified domain of the organization
+func (h *Handler) JoinOrganizationHandler(ctx echo.Context) error {
+	var in models.JoinOrganizationRequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	u, err := h.getJoiningUser(reqCtx)
+	if err != nil {
+		return h.BadRequest(ctx, err)
+	}
+
+	orgs, err := h.domainOrganizations(reqCtx, u, enums.OrgJoinPolicyOffer, enums.OrgJoinPolicyAutoJoin)
+	if err != nil {
+		h.Logger.Errorw("unable to get organizations for domain", "error", err)
+
+		return h.InternalServerError(ctx, err)
+	}
+
+	var org *ent.Organization
+
+	for _, o := range orgs {
+		if o.ID == in.OrganizationID {
+			org = o
+		}
+	}
+
+	if org == nil {
+		return h.BadRequest(ctx, ErrOrgNotJoinable)
+	}
+
+	if err := h.joinOrganization(reqCtx, org, u); err != nil {
+		h.Logger.Errorw("unable to join organization", "error", err)
+
+		return h.InternalServerError(ctx, err)
+	}
+
+	out := &models.JoinOrganizationReply{
+		Reply:          rout.Reply{Success: true},
+		OrganizationID: org.ID,
+		Message:        "joined organization",
+	}
+
+	return h.Success(ctx, out)
+}
+
+// getJoiningUser returns the authenticated user of the request, organizations can only be joined by users with a
+// verified email address
+func (h *Handler) getJoiningUser(ctx context.Context) (*ent.User, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		h.Logger.Errorw("unable to get user id from context", "error", err)
+
+		return nil, err
+	}
+
+	u, err := h.getUserDetailsByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !joinEmailVerified(u) {
+		return nil, ErrJoinEmailNotVerified
+	}
+
+	return u, nil
+}
+
+// joinEmailVerified returns true if the email address of the user is verified, either confirmed by the user or by
+// the provider they signed in with; users provisioned by the single sign-on provider of an organization cannot join
+// other organizations by the domain of their email address
+func joinEmailVerified(u *ent.User) bool {
+	switch u.AuthProvider {
+	case enums.AuthProviderGoogle, enums.AuthProviderGitHub:
+		return true
+	case enums.AuthProviderOIDC, enums.AuthProviderSAML:
+		return false
+	}
+
+	return u.Edges.Setting != nil && u.Edges.Setting.EmailConfirmed
+}
+
+// joinDomainOrganizations adds the user as a member of the organizations with the auto join policy and the domain
+// of the user's email address as a verified domain, it must only be called once the email address is verified
+func (h *Handler) joinDomainOrganizations(ctx context.Context, u *ent.User) error {
+	orgs, err := h.domainOrganizations(ctx, u, enums.OrgJoinPolicyAutoJoin)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range orgs {
+		if err := h.joinOrganization(ctx, o, u); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// joinOrganization adds the user as a member of the organization by the domain of their email address
+func (h *Handler) joinOrganization(ctx context.Context, org *ent.Organization, u *ent.User) error {
+	if err := h.addOrgMember(ctx, org.ID, u.ID); err != nil {
+		return err
+	}
+
+	props := ph.NewProperties().
+		Set("user_id", u.ID).
+		Set("email", u.Email).
+		Set("organization_id", org.ID).
+		Set("organization_name", org.Name)
+
+	h.AnalyticsClient.Event("organization_domain_joined", props)
+
+	return nil
+}
+
+// domainOrganizations returns the organizations with one of the join policies and the domain of the user's email
+// address as a verified domain, organizations the user is already a member of are not returned
+func (h *Handler) domainOrganizations(ctx context.Context, u *ent.User, policies ...enums.OrgJoinPolicy) ([]*ent.Organization, error) {
+	domain := domains.EmailDomain(u.Email)
+	if domain == "" {
+		return nil, nil
+	}
+
+	// the user is not a member of the organizations, so they cannot be found with their own permissions
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	return transaction.FromContext(ctx).Organization.Query().
+		Where(
+			organization.PersonalOrg(false),
+			organization.Not(organization.HasMembersWith(
+				orgmembership.UserID(u.ID),
+				orgmembership.DeletedAtIsNil(),
+			)),
+			organization.HasSettingWith(
+				organizationsetting.JoinPolicyIn(policies...),
+				func(s *sql.Selector) {
+					s.Where(sqljson.ValueContains(s.C(organizationsetting.FieldVerifiedDomains), domain))
+				},
+			),
+		).
+		Order(ent.Asc(organization.FieldName)).
+		All(allowCtx)
+}
+
+// BindJoinableOrganizationsHandler binds the joinable organizations handler to the OpenAPI schema
+func (h *Handler) BindJoinableOrganizationsHandler() *openapi3.Operation {
+	joinable := openapi3.NewOperation()
+	joinable.Description = "List the organizations the user can join with the domain of their email address"
+	joinable.OperationID = "JoinableOrganizations"
+
+	h.AddResponse("JoinableOrganizationsReply", "success", models.ExampleJoinableOrganizationsReply, joinable, http.StatusOK)
+	joinable.AddResponse(http.StatusInternalServerError, internalServerError())
+	joinable.AddResponse(http.StatusBadRequest, badRequest())
+	joinable.AddResponse(http.StatusUnauthorized, unauthorized())
+
+	return joinable
+}
+
+// BindJoinOrganizationHandler binds the join organization handler to the OpenAPI schema
+func (h *Handler) BindJoinOrganizationHandler() *openapi3.Operation {
+	join := openapi3.NewOperation()
+	join.Description = "Join an organization with the domain of the user's email address as a verified domain"
+	join.OperationID = "JoinOrganization"
+
+	h.AddRequestBody("JoinOrganizationRequest", models.ExampleJoinOrganizationRequest, join)
+	h.AddResponse("JoinOrganizationReply", "success", models.ExampleJoinOrganizationReply, join, http.StatusOK)
+	join.AddResponse(http.StatusInternalServerError, internalServerError())
+	join.AddResponse(http.StatusBadRequest, badRequest())
+	join.AddResponse(http.StatusUnauthorized, unauthorized())
+
+	return join
+}
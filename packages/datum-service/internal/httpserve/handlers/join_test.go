@@ -0,0 +1,323 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/enums"
+	"github.com/datumforge/datum/pkg/middleware/echocontext"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/rout"
+)
+
+// createDomainOrg creates an organization with the verified domain and join policy and returns the organization id
+func (suite *HandlerTestSuite) createDomainOrg(name, domain string, policy enums.OrgJoinPolicy) string {
+	orgID, ownerCtx := suite.createSSOOrg(name)
+
+	suite.db.OrganizationSetting.Update().
+		Where(organizationsetting.OrganizationID(orgID)).
+		SetDomains([]string{domain}).
+		SetVerifiedDomains([]string{domain}).
+		SetJoinPolicy(policy).
+		ExecX(ownerCtx)
+
+	return orgID
+}
+
+func (suite *HandlerTestSuite) TestJoinOrganizationHandler() {
+	t := suite.T()
+
+	suite.e.GET("/join", suite.h.JoinableOrganizationsHandler)
+	suite.e.POST("/join", suite.h.JoinOrganizationHandler)
+
+	mock_fga.WriteAny(t, suite.fga)
+	mock_fga.CheckAny(t, suite.fga, true)
+
+	offerOrgID := suite.createDomainOrg("guardians", "guardians.net", enums.OrgJoinPolicyOffer)
+	autoJoinOrgID := suite.createDomainOrg("nova", "guardians.net", enums.OrgJoinPolicyAutoJoin)
+	inviteOrgID := suite.createDomainOrg("kree", "guardians.net", enums.OrgJoinPolicyInviteOnly)
+	otherDomainOrgID := suite.createDomainOrg("ravagers", "ravagers.net", enums.OrgJoinPolicyOffer)
+
+	ctx := privacy.DecisionContext(echocontext.NewTestEchoContext().Request().Context(), privacy.Allow)
+
+	setting := suite.db.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ctx)
+
+	member := suite.db.User.Create().
+		SetEmail("starlord@Guardians.net").
+		SetFirstName("Peter").
+		SetLastName("Quill").
+		SetSetting(setting).
+		SaveX(ctx)
+
+	reqCtx, err := userContextWithID(member.ID)
+	require.NoError(t, err)
+
+	joinable := func() []string {
+		req := httptest.NewRequest(http.MethodGet, "/join", nil)
+
+		recorder := httptest.NewRecorder()
+
+		suite.e.ServeHTTP(recorder, req.WithContext(reqCtx))
+
+		res := recorder.Result()
+		defer res.Body.Close()
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		var out *models.JoinableOrganizationsReply
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+		ids := []string{}
+		for _, o := range out.Organizations {
+			ids = append(ids, o.ID)
+		}
+
+		return ids
+	}
+
+	assert.ElementsMatch(t, []string{offerOrgID, autoJoinOrgID}, joinable())
+
+	testCases := []struct {
+		name           string
+		orgID          string
+		expectedStatus int
+		expectedErr    string
+	}{
+		{
+			name:           "happy path, offered organization",
+			orgID:          offerOrgID,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "already a member",
+			orgID:          offerOrgID,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrOrgNotJoinable.Error(),
+		},
+		{
+			name:           "invite only organization",
+			orgID:          inviteOrgID,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrOrgNotJoinable.Error(),
+		},
+		{
+			name:           "organization verified another domain",
+			orgID:          otherDomainOrgID,
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrOrgNotJoinable.Error(),
+		},
+		{
+			name:           "missing organization",
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "organization_id",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(models.JoinOrganizationRequest{OrganizationID: tc.orgID})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/join", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			recorder := httptest.NewRecorder()
+
+			suite.e.ServeHTTP(recorder, req.WithContext(reqCtx))
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedErr != "" {
+				var out *rout.Reply
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+				assert.Contains(t, out.Error, tc.expectedErr)
+
+				return
+			}
+
+			var out *models.JoinOrganizationReply
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+			assert.True(t, out.Success)
+			assert.Equal(t, tc.orgID, out.OrganizationID)
+
+			memberCtx, err := auth.NewTestContextWithOrgID(member.ID, tc.orgID)
+			require.NoError(t, err)
+
+			membership, err := suite.db.OrgMembership.Query().
+				Where(
+					orgmembership.OrganizationID(tc.orgID),
+					orgmembership.UserID(member.ID),
+				).
+				Only(privacy.DecisionContext(memberCtx, privacy.Allow))
+			require.NoError(t, err)
+			assert.Equal(t, enums.RoleMember, membership.Role)
+		})
+	}
+
+	assert.Equal(t, []string{autoJoinOrgID}, joinable())
+}
+
+func (suite *HandlerTestSuite) TestJoinOrganizationHandlerEmailNotVerified() {
+	t := suite.T()
+
+	suite.e.GET("/join", suite.h.JoinableOrganizationsHandler)
+	suite.e.POST("/join", suite.h.JoinOrganizationHandler)
+
+	mock_fga.WriteAny(t, suite.fga)
+	mock_fga.CheckAny(t, suite.fga, true)
+
+	offerOrgID := suite.createDomainOrg("guardians", "guardians.net", enums.OrgJoinPolicyOffer)
+
+	ctx := privacy.DecisionContext(echocontext.NewTestEchoContext().Request().Context(), privacy.Allow)
+
+	unconfirmed := suite.db.User.Create().
+		SetEmail("mantis@guardians.net").
+		SetFirstName("Mantis").
+		SetLastName("Empath").
+		SaveX(ctx)
+
+	// users provisioned by single sign-on cannot join by domain even when their email is confirmed
+	setting := suite.db.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ctx)
+
+	ssoUser := suite.db.User.Create().
+		SetEmail("kraglin@guardians.net").
+		SetFirstName("Kraglin").
+		SetLastName("Obfonteri").
+		SetAuthProvider(enums.AuthProviderOIDC).
+		SetSetting(setting).
+		SaveX(ctx)
+
+	testCases := []struct {
+		name   string
+		userID string
+		method string
+	}{
+		{
+			name:   "list, email not confirmed",
+			userID: unconfirmed.ID,
+			method: http.MethodGet,
+		},
+		{
+			name:   "join, email not confirmed",
+			userID: unconfirmed.ID,
+			method: http.MethodPost,
+		},
+		{
+			name:   "list, single sign-on user",
+			userID: ssoUser.ID,
+			method: http.MethodGet,
+		},
+		{
+			name:   "join, single sign-on user",
+			userID: ssoUser.ID,
+			method: http.MethodPost,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reqCtx, err := userContextWithID(tc.userID)
+			require.NoError(t, err)
+
+			body, err := json.Marshal(models.JoinOrganizationRequest{OrganizationID: offerOrgID})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(tc.method, "/join", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			recorder := httptest.NewRecorder()
+
+			suite.e.ServeHTTP(recorder, req.WithContext(reqCtx))
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+			var out *rout.Reply
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+			assert.Contains(t, out.Error, handlers.ErrJoinEmailNotVerified.Error())
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestJoinDomainOrganizationsOnVerify() {
+	t := suite.T()
+
+	suite.e.GET("/verify", suite.h.VerifyEmail)
+
+	mock_fga.WriteAny(t, suite.fga)
+	mock_fga.CheckAny(t, suite.fga, true)
+
+	autoJoinOrgID := suite.createDomainOrg("nova", "xandar.net", enums.OrgJoinPolicyAutoJoin)
+	offerOrgID := suite.createDomainOrg("guardians", "xandar.net", enums.OrgJoinPolicyOffer)
+
+	ctx := privacy.DecisionContext(echocontext.NewTestEchoContext().Request().Context(), privacy.Allow)
+
+	u := suite.db.User.Create().
+		SetEmail("dey@xandar.net").
+		SetFirstName("Rhomann").
+		SetLastName("Dey").
+		SetPassword(validPassword).
+		SaveX(ctx)
+
+	user := handlers.User{
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Email:     u.Email,
+		ID:        u.ID,
+	}
+
+	require.NoError(t, user.CreateVerificationToken())
+
+	ttl, err := time.Parse(time.RFC3339Nano, user.EmailVerificationExpires.String)
+	require.NoError(t, err)
+
+	suite.db.EmailVerificationToken.Create().
+		SetOwner(u).
+		SetToken(user.EmailVerificationToken.String).
+		SetEmail(u.Email).
+		SetSecret(user.EmailVerificationSecret).
+		SetTTL(ttl).
+		SaveX(ctx)
+
+	req := httptest.NewRequest(http.MethodGet, "/verify?token="+user.EmailVerificationToken.String, nil)
+
+	recorder := httptest.NewRecorder()
+
+	suite.e.ServeHTTP(recorder, req)
+
+	res := recorder.Result()
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	orgIDs := suite.db.Organization.Query().
+		Where(organization.HasMembersWith(orgmembership.UserID(u.ID))).
+		IDsX(ctx)
+
+	assert.Contains(t, orgIDs, autoJoinOrgID)
+	assert.NotContains(t, orgIDs, offerOrgID)
+}
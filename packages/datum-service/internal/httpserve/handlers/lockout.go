@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/middleware/transaction"
+	"github.com/datumforge/datum/pkg/rout"
+)
+
+// AccountLockoutConfig contains the settings to lock the account of a user after too many failed login attempts
+type AccountLockoutConfig struct {
+	// MaxAttempts is the number of consecutive failed password attempts before the account is locked, 0 disables the lockout
+	MaxAttempts int `json:"maxAttempts" koanf:"maxAttempts" default:"5"`
+	// Duration the account is locked for, the account stays locked until unlocked by an administrator when 0
+	Duration time.Duration `json:"duration" koanf:"duration" default:"15m"`
+}
+
+// isUserStatusError returns true if the error was returned because the user is not allowed to authenticate
+func isUserStatusError(err error) bool {
+	return errors.Is(err, auth.ErrUserLocked) || errors.Is(err, auth.ErrUserSuspended) || errors.Is(err, auth.ErrNoAuthUser)
+}
+
+// invalidCredentials records the failed login attempt of the user and responds with invalid credentials, or with
+// the account being locked when the attempt reached the limit. The response is written without returning an error
+// so the transaction is committed and the recorded attempt is not rolled back
+func (h *Handler) invalidCredentials(ctx echo.Context, user *ent.User) error {
+	locked, err := h.recordFailedLogin(ctx.Request().Context(), user.Edges.Setting)
+	if err != nil {
+		h.Logger.Errorw("unable to record failed login attempt", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	if locked {
+		h.Logger.Infow("user account locked after too many failed login attempts", "user_id", user.ID)
+
+		return ctx.JSON(http.StatusBadRequest, rout.ErrorResponse(auth.ErrUserLocked))
+	}
+
+	return ctx.JSON(http.StatusBadRequest, rout.ErrorResponse(rout.ErrInvalidCredentials))
+}
+
+// recordFailedLogin increments the failed login attempts of the user and locks the account when the maximum number
+// of attempts is reached, returning true if the account was locked
+func (h *Handler) recordFailedLogin(ctx context.Context, setting *ent.UserSetting) (bool, error) {
+	if h.AccountLockout.MaxAttempts <= 0 {
+		return false, nil
+	}
+
+	// the user is not authenticated yet, allow the update of their settings
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	// the attempts are incremented in the database, so the lock is decided on the count of concurrent attempts
+	// rather than the count read before the update
+	updated, err := transaction.FromContext(ctx).UserSetting.UpdateOneID(setting.ID).
+		AddFailedLoginAttempts(1).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if updated.FailedLoginAttempts < h.AccountLockout.MaxAttempts {
+		return false, nil
+	}
+
+	update := transaction.FromContext(ctx).UserSetting.UpdateOneID(setting.ID).
+		SetLocked(true).
+		SetFailedLoginAttempts(0)
+
+	if h.AccountLockout.Duration > 0 {
+		update.SetLockedUntil(time.Now().Add(h.AccountLockout.Duration))
+	} else {
+		update.ClearLockedUntil()
+	}
+
+	return true, update.Exec(ctx)
+}
+
+// resetFailedLogins clears the failed login attempts of the user after a successful login, along with a timed lock
+// that has expired
+func (h *Handler) resetFailedLogins(ctx context.Context, setting *ent.UserSetting) error {
+	expiredLock := setting.Locked && setting.LockedUntil != nil
+
+	if setting.FailedLoginAttempts == 0 && !expiredLock {
+		return nil
+	}
+
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	update := transaction.FromContext(ctx).UserSetting.UpdateOneID(setting.ID).
+		SetFailedLoginAttempts(0)
+
+	if expiredLock {
+		update.SetLocked(false).ClearLockedUntil()
+	}
+
+	return update.Exec(ctx)
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	echo "github.com/datumforge/echox"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/datumforge/datum/pkg/auth"
 	"github.com/datumforge/datum/pkg/enums"
+	authmw "github.com/datumforge/datum/pkg/middleware/auth"
 	"github.com/datumforge/datum/pkg/models"
 	"github.com/datumforge/datum/pkg/passwd"
 	"github.com/datumforge/datum/pkg/rout"
@@ -32,20 +34,47 @@ func (h *Handler) LoginHandler(ctx echo.Context) error {
 		return h.BadRequest(ctx, auth.ErrNoAuthUser)
 	}
 
-	if user.Edges.Setting.Status != "ACTIVE" {
-		return h.BadRequest(ctx, auth.ErrNoAuthUser)
+	// locked and suspended users are rejected before the password is checked
+	if err := authmw.CheckUserSetting(user.Edges.Setting); err != nil {
+		return h.BadRequest(ctx, err)
 	}
 
 	// verify the password is correct
 	valid, err := passwd.VerifyDerivedKey(*user.Password, in.Password)
 	if err != nil || !valid {
-		return h.BadRequest(ctx, rout.ErrInvalidCredentials)
+		return h.invalidCredentials(ctx, user)
+	}
+
+	// the failed attempts of users with two factor authentication are only reset once the second factor is verified,
+	// so invalid codes cannot be retried without limit by logging in with the password again
+	if !user.Edges.Setting.IsTfaEnabled {
+		if err := h.resetFailedLogins(ctx.Request().Context(), user.Edges.Setting); err != nil {
+			h.Logger.Errorw("unable to reset failed login attempts", "error", err)
+
+			return h.InternalServerError(ctx, ErrProcessingRequest)
+		}
+	}
+
+	// members of organizations that enforce single sign-on for their domain cannot login with a password
+	if err := h.checkSSOEnforced(ctx.Request().Context(), user); err != nil {
+		if errors.Is(err, ErrSSORequired) {
+			return h.BadRequest(ctx, err)
+		}
+
+		h.Logger.Errorw("unable to check sso enforcement", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
 	}
 
 	if !user.Edges.Setting.EmailConfirmed {
 		return h.BadRequest(ctx, auth.ErrUnverifiedUser)
 	}
 
+	// users with two factor authentication enabled must complete the second factor before a session is issued
+	if user.Edges.Setting.IsTfaEnabled {
+		return h.tfaChallenge(ctx, user.ID)
+	}
+
 	// set context for remaining request based on logged in user
 	userCtx := auth.AddAuthenticatedUserContext(ctx, &auth.AuthenticatedUser{
 		SubjectID: user.ID,
@@ -89,7 +118,7 @@ func (h *Handler) LoginHandler(ctx echo.Context) error {
 // BindLoginHandler binds the login request to the OpenAPI schema
 func (h *Handler) BindLoginHandler() *openapi3.Operation {
 	login := openapi3.NewOperation()
-	login.Description = "Login is oriented towards human users who use their email and password for authentication. Login verifies the password submitted for the user is correct by looking up the user by email and using the argon2 derived key verification process to confirm the password matches. Upon authentication an access token and a refresh token with the authorized claims of the user are returned. The user can use the access token to authenticate to Datum systems. The access token has an expiration and the refresh token can be used with the refresh endpoint to get a new access token without the user having to log in again. The refresh token overlaps with the access token to provide a seamless authentication experience and the user can refresh their access token so long as the refresh token is valid"
+	login.Description = "Login is oriented towards human users who use their email and password for authentication. Login verifies the password submitted for the user is correct by looking up the user by email and using the argon2 derived key verification process to confirm the password matches. Upon authentication an access token and a refresh token with the authorized claims of the user are returned. The user can use the access token to authenticate to Datum systems. The access token has an expiration and the refresh token can be used with the refresh endpoint to get a new access token without the user having to log in again. The refresh token overlaps with the access token to provide a seamless authentication experience and the user can refresh their access token so long as the refresh token is valid. If the user has two factor authentication enabled, no tokens are returned; instead the response includes tfa_required and a short lived tfa_token which must be sent along with a TOTP or recovery code to the login/tfa endpoint to complete the login"
 	login.OperationID = "LoginHandler"
 
 	h.AddRequestBody("LoginRequest", models.ExampleLoginSuccessRequest, login)
@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
 	mock_fga "github.com/datumforge/fgax/mockery"
@@ -14,7 +15,9 @@ import (
 
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
 	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
 	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/enums"
 	"github.com/datumforge/datum/pkg/httpsling"
 	"github.com/datumforge/datum/pkg/middleware/echocontext"
 	"github.com/datumforge/datum/pkg/models"
@@ -159,3 +162,123 @@ func (suite *HandlerTestSuite) TestLoginHandler() {
 		})
 	}
 }
+
+func (suite *HandlerTestSuite) TestLoginHandlerAccountStatus() {
+	t := suite.T()
+
+	// add login handler
+	suite.e.POST("login", suite.h.LoginHandler)
+
+	// lock accounts after three failed attempts
+	suite.h.AccountLockout = handlers.AccountLockoutConfig{
+		MaxAttempts: 3,
+		Duration:    time.Minute,
+	}
+
+	defer func() {
+		suite.h.AccountLockout = handlers.AccountLockoutConfig{}
+	}()
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// set privacy allow in order to allow the creation of the users without
+	// authentication in the tests
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	// add mocks for writes
+	mock_fga.WriteAny(t, suite.fga)
+
+	validPassword := "sup3rs3cu7e!"
+
+	lockedUserSetting := suite.db.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ctx)
+
+	lockedUser := suite.db.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail("bpearl@datum.net").
+		SetPassword(validPassword).
+		SetSetting(lockedUserSetting).
+		SaveX(ctx)
+
+	suspendedUserSetting := suite.db.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SetSuspendedAt(time.Now()).
+		SetStatus(enums.UserStatusSuspended).
+		SaveX(ctx)
+
+	_ = suite.db.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail("jdoe@datum.net").
+		SetPassword(validPassword).
+		SetSetting(suspendedUserSetting).
+		SaveX(ctx)
+
+	login := func(username, password string) (int, *models.LoginReply) {
+		body, err := json.Marshal(models.LoginRequest{
+			Username: username,
+			Password: password,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(string(body)))
+		req.Header.Set(httpsling.HeaderContentType, httpsling.ContentTypeJSONUTF8)
+
+		recorder := httptest.NewRecorder()
+
+		suite.e.ServeHTTP(recorder, req)
+
+		res := recorder.Result()
+		defer res.Body.Close()
+
+		var out *models.LoginReply
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+		return recorder.Code, out
+	}
+
+	// the account is locked on the third failed attempt
+	for i := 0; i < 2; i++ {
+		code, out := login(lockedUser.Email, "thisisnottherightone")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Contains(t, out.Error, rout.ErrInvalidCredentials.Error())
+	}
+
+	setting := suite.db.UserSetting.GetX(ctx, lockedUserSetting.ID)
+	assert.Equal(t, 2, setting.FailedLoginAttempts)
+
+	code, out := login(lockedUser.Email, "thisisnottherightone")
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, out.Error, auth.ErrUserLocked.Error())
+
+	// the correct password is rejected while the account is locked
+	code, out = login(lockedUser.Email, validPassword)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, out.Error, auth.ErrUserLocked.Error())
+
+	setting = suite.db.UserSetting.GetX(ctx, lockedUserSetting.ID)
+	assert.True(t, setting.Locked)
+	require.NotNil(t, setting.LockedUntil)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), *setting.LockedUntil, 5*time.Second)
+
+	// the account is unlocked once the lock expires
+	suite.db.UserSetting.UpdateOneID(lockedUserSetting.ID).
+		SetLockedUntil(time.Now().Add(-time.Second)).
+		ExecX(ctx)
+
+	code, out = login(lockedUser.Email, validPassword)
+	assert.Equal(t, http.StatusOK, code)
+	assert.True(t, out.Success)
+
+	setting = suite.db.UserSetting.GetX(ctx, lockedUserSetting.ID)
+	assert.False(t, setting.Locked)
+	assert.Nil(t, setting.LockedUntil)
+	assert.Zero(t, setting.FailedLoginAttempts)
+
+	// suspended users are rejected
+	code, out = login("jdoe@datum.net", validPassword)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Contains(t, out.Error, auth.ErrUserSuspended.Error())
+}
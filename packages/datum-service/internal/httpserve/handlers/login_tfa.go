@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	echo "github.com/datumforge/echox"
+	"github.com/getkin/kin-openapi/openapi3"
+	ph "github.com/posthog/posthog-go"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/tfasetting"
+	"github.com/datumforge/datum/pkg/auth"
+	authmw "github.com/datumforge/datum/pkg/middleware/auth"
+	"github.com/datumforge/datum/pkg/middleware/transaction"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/utils/totp"
+)
+
+// maxTFAChallengeAttempts is the number of invalid codes accepted for a login challenge before it is invalidated
+const maxTFAChallengeAttempts = 3
+
+// tfaChallenge returns a short lived challenge token to a user that has verified their password but still needs to
+// complete the second factor at the /login/tfa route; only the latest challenge of the user can be completed
+func (h *Handler) tfaChallenge(ctx echo.Context, userID string) error {
+	token, challengeID, err := h.TokenManager.CreateTFAChallengeToken(userID)
+	if err != nil {
+		h.Logger.Errorw("unable to create tfa challenge token", "error", err)
+
+		return h.InternalServerError(ctx, err)
+	}
+
+	// the user is not authenticated yet, allow the update of their tfa settings
+	allowCtx := privacy.DecisionContext(ctx.Request().Context(), privacy.Allow)
+
+	if err := transaction.FromContext(allowCtx).TFASetting.Update().
+		Where(tfasetting.OwnerID(userID)).
+		SetChallengeID(challengeID).
+		SetChallengeAttempts(0).
+		Exec(allowCtx); err != nil {
+		h.Logger.Errorw("unable to store tfa challenge", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	out := models.LoginReply{
+		Reply:       rout.Reply{Success: true},
+		Message:     "two factor authentication required",
+		TFARequired: true,
+		TFAToken:    token,
+	}
+
+	return h.Success(ctx, out)
+}
+
+// LoginTFAHandler completes the login of a user with two factor authentication enabled; the challenge token
+// returned by the login handler must be provided along with a TOTP code or one of the user's recovery codes.
+// The challenge can only be completed once, and is invalidated after too many invalid codes
+func (h *Handler) LoginTFAHandler(ctx echo.Context) error {
+	var in models.TFALoginRequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	claims, err := h.TokenManager.VerifyTFAChallengeToken(in.TFAToken)
+	if err != nil {
+		return h.BadRequest(ctx, ErrInvalidTFAChallenge)
+	}
+
+	user, err := h.getUserDetailsByID(ctx.Request().Context(), claims.UserID)
+	if err != nil {
+		return h.BadRequest(ctx, auth.ErrNoAuthUser)
+	}
+
+	if err := authmw.CheckUserSetting(user.Edges.Setting); err != nil {
+		return h.BadRequest(ctx, err)
+	}
+
+	// set context for remaining request based on the user completing the login
+	userCtx := auth.AddAuthenticatedUserContext(ctx, &auth.AuthenticatedUser{
+		SubjectID: user.ID,
+	})
+
+	tfa, err := transaction.FromContext(userCtx).TFASetting.Query().
+		Where(tfasetting.OwnerID(user.ID)).
+		Only(userCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return h.BadRequestWithCode(ctx, ErrInvalidTFACode, InvalidTFACodeErrCode)
+		}
+
+		h.Logger.Errorw("unable to get tfa settings", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	if tfa.ChallengeID == nil || subtle.ConstantTimeCompare([]byte(*tfa.ChallengeID), []byte(claims.ID)) != 1 {
+		return h.BadRequest(ctx, ErrInvalidTFAChallenge)
+	}
+
+	if in.TOTPCode != "" {
+		if h.OTPManager == nil {
+			return h.BadRequest(ctx, ErrTOTPNotEnabled)
+		}
+
+		if tfa.TfaSecret == nil {
+			return h.invalidTFACode(ctx, user, tfa)
+		}
+
+		if err := h.OTPManager.TOTPManager.ValidateTOTP(userCtx, &totp.User{
+			ID:        user.ID,
+			TFASecret: *tfa.TfaSecret,
+		}, in.TOTPCode); err != nil {
+			return h.invalidTFACode(ctx, user, tfa)
+		}
+	} else {
+		valid, err := h.useRecoveryCode(userCtx, tfa, in.RecoveryCode)
+		if err != nil {
+			h.Logger.Errorw("unable to remove used recovery code", "error", err)
+
+			return h.InternalServerError(ctx, ErrProcessingRequest)
+		}
+
+		if !valid {
+			return h.invalidTFACode(ctx, user, tfa)
+		}
+	}
+
+	// the challenge is completed, so it cannot be used again
+	if err := transaction.FromContext(userCtx).TFASetting.UpdateOneID(tfa.ID).
+		ClearChallengeID().
+		SetChallengeAttempts(0).
+		Exec(userCtx); err != nil {
+		h.Logger.Errorw("unable to clear tfa challenge", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	// the failed attempts of users with two factor authentication are only reset once the second factor is verified
+	if err := h.resetFailedLogins(userCtx, user.Edges.Setting); err != nil {
+		h.Logger.Errorw("unable to reset failed login attempts", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	if err := h.addDefaultOrgToUserQuery(userCtx, user); err != nil {
+		return h.InternalServerError(ctx, err)
+	}
+
+	// create new claims for the user
+	auth, err := h.AuthManager.GenerateUserAuthSession(ctx, user)
+	if err != nil {
+		h.Logger.Errorw("unable create new auth session", "error", err)
+
+		return h.InternalServerError(ctx, err)
+	}
+
+	if err := h.updateUserLastSeen(userCtx, user.ID); err != nil {
+		h.Logger.Errorw("unable to update last seen", "error", err)
+
+		return h.InternalServerError(ctx, err)
+	}
+
+	props := ph.NewProperties().
+		Set("user_id", user.ID).
+		Set("email", user.Email).
+		Set("organization_id", user.Edges.Setting.Edges.DefaultOrg.ID). // user is logged into their default org
+		Set("auth_provider", user.AuthProvider).
+		Set("tfa_method", tfaMethod(in))
+
+	h.AnalyticsClient.Event("user_authenticated", props)
+
+	out := models.LoginReply{
+		Reply:    rout.Reply{Success: true},
+		Message:  "success",
+		AuthData: *auth,
+	}
+
+	return h.Success(ctx, out)
+}
+
+// invalidTFACode records the invalid code against the login challenge and the failed login attempts of the user,
+// the challenge is invalidated once it reached the maximum attempts or the account was locked. The response is
+// written without returning an error so the transaction is committed and the recorded attempt is not rolled back
+func (h *Handler) invalidTFACode(ctx echo.Context, user *ent.User, tfa *ent.TFASetting) error {
+	reqCtx := ctx.Request().Context()
+
+	locked, err := h.recordFailedLogin(reqCtx, user.Edges.Setting)
+	if err != nil {
+		h.Logger.Errorw("unable to record failed login attempt", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	// the user is not authenticated yet, allow the update of their tfa settings
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	update := transaction.FromContext(allowCtx).TFASetting.UpdateOneID(tfa.ID)
+
+	if locked || tfa.ChallengeAttempts+1 >= maxTFAChallengeAttempts {
+		update.ClearChallengeID().SetChallengeAttempts(0)
+	} else {
+		update.AddChallengeAttempts(1)
+	}
+
+	if err := update.Exec(allowCtx); err != nil {
+		h.Logger.Errorw("unable to record invalid tfa code", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	if locked {
+		h.Logger.Infow("user account locked after too many failed login attempts", "user_id", user.ID)
+
+		return ctx.JSON(http.StatusBadRequest, rout.ErrorResponse(auth.ErrUserLocked))
+	}
+
+	return ctx.JSON(http.StatusBadRequest, rout.ErrorResponseWithCode(ErrInvalidTFACode, InvalidTFACodeErrCode))
+}
+
+// useRecoveryCode checks the code against the user's recovery codes and removes it from the tfa settings
+// when it matches so the code can only be used once
+func (h *Handler) useRecoveryCode(ctx context.Context, tfa *ent.TFASetting, code string) (bool, error) {
+	code = strings.ToUpper(code)
+
+	found := false
+	remaining := make([]string, 0, len(tfa.RecoveryCodes))
+
+	// compare every code in constant time to avoid leaking which codes exist
+	for _, rc := range tfa.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(rc), []byte(code)) == 1 && !found {
+			found = true
+
+			continue
+		}
+
+		remaining = append(remaining, rc)
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	if err := transaction.FromContext(ctx).TFASetting.UpdateOneID(tfa.ID).
+		SetRecoveryCodes(remaining).
+		Exec(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// tfaMethod returns the second factor used to complete the login for analytics
+func tfaMethod(in models.TFALoginRequest) string {
+	if in.TOTPCode != "" {
+		return "totp"
+	}
+
+	return "recovery_code"
+}
+
+// BindLoginTFAHandler binds the two factor login request to the OpenAPI schema
+func (h *Handler) BindLoginTFAHandler() *openapi3.Operation {
+	login := openapi3.NewOperation()
+	login.Description = "Completes the login of a user with two factor authentication enabled. The tfa_token returned by the login endpoint must be provided along with either a TOTP code from the user's authenticator app or one of the user's recovery codes; recovery codes can only be used once. Upon success an access token and a refresh token are returned, the same as the login endpoint"
+	login.OperationID = "LoginTFAHandler"
+
+	h.AddRequestBody("TFALoginRequest", models.ExampleTFALoginRequest, login)
+	h.AddResponse("LoginReply", "success", models.ExampleLoginSuccessResponse, login, http.StatusOK)
+	login.AddResponse(http.StatusInternalServerError, internalServerError())
+	login.AddResponse(http.StatusBadRequest, badRequest())
+
+	return login
+}
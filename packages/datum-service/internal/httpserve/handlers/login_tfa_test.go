@@ -0,0 +1,310 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/pquerna/otp"
+	otptotp "github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/httpsling"
+	"github.com/datumforge/datum/pkg/middleware/echocontext"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/testutils"
+	"github.com/datumforge/datum/pkg/utils/totp"
+)
+
+func (suite *HandlerTestSuite) TestLoginTFAHandler() {
+	t := suite.T()
+
+	// add login handlers
+	suite.e.POST("login", suite.h.LoginHandler)
+	suite.e.POST("login/tfa", suite.h.LoginTFAHandler)
+
+	otpManager := totp.NewOTP(
+		totp.WithIssuer("datum"),
+		totp.WithSecret(totp.Secret{
+			Version: 0,
+			Key:     "9f0c6da662f018b58b04a093e2dbb2e1d8d54250",
+		}),
+		totp.WithRedis(testutils.NewRedisClient()),
+	)
+
+	suite.h.OTPManager = &totp.Manager{TOTPManager: otpManager}
+	defer func() { suite.h.OTPManager = nil }()
+
+	ec := echocontext.NewTestEchoContext().Request().Context()
+
+	// set privacy allow in order to allow the creation of the users without
+	// authentication in the tests
+	ctx := privacy.DecisionContext(ec, privacy.Allow)
+
+	// add mocks for writes
+	mock_fga.WriteAny(t, suite.fga)
+
+	// create user with tfa enabled
+	email := "rsanchez-tfa@datum.net"
+
+	userSetting := suite.db.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SetIsTfaEnabled(true).
+		SaveX(ctx)
+
+	user := suite.db.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(email).
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SaveX(ctx)
+
+	totpUser := &totp.User{ID: user.ID}
+	totpUser.Email.String = email
+
+	secret, err := otpManager.TOTPSecret(totpUser)
+	require.NoError(t, err)
+
+	recoveryCodes := []string{"AAAAAAAAAAAAAAAA", "BBBBBBBBBBBBBBBB"}
+
+	tfa := suite.db.TFASetting.Create().
+		SetOwnerID(user.ID).
+		SetTfaSecret(secret).
+		SetVerified(true).
+		SetTotpAllowed(true).
+		SetRecoveryCodes(recoveryCodes).
+		SaveX(ctx)
+
+	// get the plaintext secret from the otpauth uri to generate valid codes
+	totpUser.TFASecret = secret
+
+	qr, err := otpManager.TOTPQRString(totpUser)
+	require.NoError(t, err)
+
+	key, err := otp.NewKeyFromURL(qr)
+	require.NoError(t, err)
+
+	mock_fga.ClearMocks(suite.fga)
+
+	// login with the password to get the challenge token
+	login := func(t *testing.T) string {
+		body, err := json.Marshal(models.LoginRequest{
+			Username: email,
+			Password: validPassword,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(string(body)))
+		req.Header.Set(httpsling.HeaderContentType, httpsling.ContentTypeJSONUTF8)
+
+		recorder := httptest.NewRecorder()
+		suite.e.ServeHTTP(recorder, req)
+
+		res := recorder.Result()
+		defer res.Body.Close()
+
+		var out *models.LoginReply
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.True(t, out.TFARequired)
+		assert.NotEmpty(t, out.TFAToken)
+		assert.Empty(t, out.AccessToken)
+		assert.Empty(t, out.RefreshToken)
+
+		return out.TFAToken
+	}
+
+	// submit the challenge token along with a second factor
+	submit := func(t *testing.T, token, totpCode, recoveryCode string) (int, *models.LoginReply) {
+		body, err := json.Marshal(models.TFALoginRequest{
+			TFAToken:     token,
+			TOTPCode:     totpCode,
+			RecoveryCode: recoveryCode,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/login/tfa", strings.NewReader(string(body)))
+		req.Header.Set(httpsling.HeaderContentType, httpsling.ContentTypeJSONUTF8)
+
+		// Set writer for tests that write on the response
+		recorder := httptest.NewRecorder()
+
+		// Using the ServerHTTP on echo will trigger the router and middleware
+		suite.e.ServeHTTP(recorder, req)
+
+		res := recorder.Result()
+		defer res.Body.Close()
+
+		var out *models.LoginReply
+
+		// parse request body
+		if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+			t.Error("error parsing response", err)
+		}
+
+		return recorder.Code, out
+	}
+
+	validCode, err := otptotp.GenerateCode(key.Secret(), time.Now())
+	require.NoError(t, err)
+
+	// the token of the previous test case, to check a completed challenge cannot be used again
+	var previousToken string
+
+	testCases := []struct {
+		name            string
+		token           string
+		reuseToken      bool
+		invalidAttempts int
+		lockoutAttempts int
+		totpCode        string
+		recoveryCode    string
+		expectedErr     error
+		expectedCode    rout.ErrorCode
+		expectedCodes   []string
+	}{
+		{
+			name:        "missing code",
+			expectedErr: rout.NewMissingRequiredFieldError("totp_code"),
+		},
+		{
+			name:        "invalid challenge token",
+			token:       "not-a-token",
+			totpCode:    validCode,
+			expectedErr: handlers.ErrInvalidTFAChallenge,
+		},
+		{
+			name:         "invalid totp code",
+			totpCode:     "000000",
+			expectedErr:  handlers.ErrInvalidTFACode,
+			expectedCode: handlers.InvalidTFACodeErrCode,
+		},
+		{
+			name:     "happy path, totp code",
+			totpCode: validCode,
+		},
+		{
+			name:         "totp code cannot be reused",
+			totpCode:     validCode,
+			expectedErr:  handlers.ErrInvalidTFACode,
+			expectedCode: handlers.InvalidTFACodeErrCode,
+		},
+		{
+			name:         "invalid recovery code",
+			recoveryCode: "CCCCCCCCCCCCCCCC",
+			expectedErr:  handlers.ErrInvalidTFACode,
+			expectedCode: handlers.InvalidTFACodeErrCode,
+		},
+		{
+			name:          "happy path, recovery code",
+			recoveryCode:  strings.ToLower(recoveryCodes[0]),
+			expectedCodes: recoveryCodes[1:],
+		},
+		{
+			name:          "completed challenge cannot be reused",
+			reuseToken:    true,
+			recoveryCode:  recoveryCodes[1],
+			expectedErr:   handlers.ErrInvalidTFAChallenge,
+			expectedCodes: recoveryCodes[1:],
+		},
+		{
+			name:          "recovery code cannot be reused",
+			recoveryCode:  recoveryCodes[0],
+			expectedErr:   handlers.ErrInvalidTFACode,
+			expectedCode:  handlers.InvalidTFACodeErrCode,
+			expectedCodes: recoveryCodes[1:],
+		},
+		{
+			name:            "challenge still valid below the maximum invalid codes",
+			invalidAttempts: 2,
+			recoveryCode:    recoveryCodes[1],
+			expectedCodes:   []string{},
+		},
+		{
+			name:            "challenge invalidated after too many invalid codes",
+			invalidAttempts: 3,
+			totpCode:        validCode,
+			expectedErr:     handlers.ErrInvalidTFAChallenge,
+		},
+		{
+			name:            "account locked after too many failed attempts",
+			lockoutAttempts: 2,
+			invalidAttempts: 1,
+			totpCode:        "000000",
+			expectedErr:     auth.ErrUserLocked,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.lockoutAttempts > 0 {
+				suite.h.AccountLockout = handlers.AccountLockoutConfig{
+					MaxAttempts: tc.lockoutAttempts,
+					Duration:    time.Minute,
+				}
+
+				defer func() { suite.h.AccountLockout = handlers.AccountLockoutConfig{} }()
+			}
+
+			token := tc.token
+
+			switch {
+			case tc.reuseToken:
+				token = previousToken
+			case token == "":
+				token = login(t)
+			}
+
+			previousToken = token
+
+			for range tc.invalidAttempts {
+				code, _ := submit(t, token, "000000", "")
+				require.Equal(t, http.StatusBadRequest, code)
+			}
+
+			code, out := submit(t, token, tc.totpCode, tc.recoveryCode)
+
+			if tc.expectedCodes != nil {
+				setting, err := suite.db.TFASetting.Get(ctx, tfa.ID)
+				require.NoError(t, err)
+
+				assert.ElementsMatch(t, tc.expectedCodes, setting.RecoveryCodes)
+			}
+
+			if tc.expectedErr != nil {
+				assert.Equal(t, http.StatusBadRequest, code)
+				assert.Contains(t, out.Error, tc.expectedErr.Error())
+
+				if tc.expectedCode != "" {
+					assert.Equal(t, tc.expectedCode, out.ErrorCode)
+				}
+
+				return
+			}
+
+			assert.Equal(t, http.StatusOK, code)
+			assert.True(t, out.Success)
+			assert.False(t, out.TFARequired)
+			assert.NotEmpty(t, out.AccessToken)
+			assert.NotEmpty(t, out.RefreshToken)
+		})
+	}
+
+	// the account was locked by the failed attempts
+	setting, err := suite.db.UserSetting.Get(ctx, userSetting.ID)
+	require.NoError(t, err)
+	assert.True(t, setting.Locked)
+}
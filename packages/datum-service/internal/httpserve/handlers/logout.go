@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/rout"
+)
+
+// LogoutHandler destroys the session of the user and revokes their refresh token so it can no longer be used to
+// get new access tokens, optionally every session and refresh token of the user is revoked
+func (h *Handler) LogoutHandler(ctx echo.Context) error {
+	var in models.LogoutRequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	userID, err := auth.GetUserIDFromContext(reqCtx)
+	if err != nil {
+		h.Logger.Errorw("unable to get user id from context", "error", err)
+
+		return h.BadRequest(ctx, err)
+	}
+
+	// the refresh token is set in the cookies on login when it is not provided in the request
+	if in.RefreshToken == "" {
+		in.RefreshToken, _ = auth.GetRefreshToken(ctx)
+	}
+
+	if in.RefreshToken != "" {
+		if err := h.revokeRefreshToken(reqCtx, in.RefreshToken, userID); err != nil {
+			h.Logger.Errorw("unable to revoke refresh token", "error", err)
+
+			return h.BadRequest(ctx, err)
+		}
+	}
+
+	if err := h.destroySession(ctx); err != nil {
+		h.Logger.Errorw("unable to delete session", "error", err)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	if in.Everywhere {
+		if err := h.SessionConfig.RedisStore.DeleteUserSessions(reqCtx, userID); err != nil {
+			h.Logger.Errorw("unable to delete user sessions", "error", err)
+
+			return h.InternalServerError(ctx, ErrProcessingRequest)
+		}
+
+		if h.TokenRevocations != nil {
+			if err := h.TokenRevocations.RevokeSubject(reqCtx, userID, h.TokenManager.Config().RefreshDuration); err != nil {
+				h.Logger.Errorw("unable to revoke user tokens", "error", err)
+
+				return h.InternalServerError(ctx, ErrProcessingRequest)
+			}
+		}
+	}
+
+	auth.ClearAuthCookies(ctx.Response().Writer)
+
+	out := &models.LogoutReply{
+		Reply:   rout.Reply{Success: true},
+		Message: "success",
+	}
+
+	return h.Success(ctx, out)
+}
+
+// revokeRefreshToken adds the ID of the refresh token to the revocation list until it expires, the token is parsed
+// without validating its claims because refresh tokens are not valid before the access token expires
+func (h *Handler) revokeRefreshToken(ctx context.Context, refreshToken, userID string) error {
+	claims, err := h.TokenManager.Parse(refreshToken)
+	if err != nil {
+		return err
+	}
+
+	// users can only revoke their own tokens
+	if claims.Subject != userID {
+		return ErrInvalidCredentials
+	}
+
+	if h.TokenRevocations == nil || claims.ExpiresAt == nil {
+		return nil
+	}
+
+	return h.TokenRevocations.Revoke(ctx, claims.ID, claims.ExpiresAt.Time)
+}
+
+// destroySession deletes the session on the request from the persistent store and expires the session cookie
+func (h *Handler) destroySession(ctx echo.Context) error {
+	if h.SessionConfig == nil {
+		return nil
+	}
+
+	name := h.SessionConfig.CookieConfig.Name
+
+	// the session cookie is optional, e.g. when the user authenticated with the access token in the header
+	session, err := h.SessionConfig.SessionManager.Get(ctx.Request(), name)
+	if err == nil {
+		sessionID := h.SessionConfig.SessionManager.GetSessionIDFromCookie(session)
+		if sessionID != "" {
+			if err := h.SessionConfig.RedisStore.DeleteSession(ctx.Request().Context(), sessionID); err != nil {
+				return err
+			}
+		}
+	}
+
+	h.SessionConfig.SessionManager.Destroy(ctx.Response().Writer, name)
+
+	return nil
+}
+
+// BindLogoutHandler is used to bind the logout endpoint to the OpenAPI schema
+func (h *Handler) BindLogoutHandler() *openapi3.Operation {
+	logout := openapi3.NewOperation()
+	logout.Description = "Logout destroys the session of the user and revokes their refresh token. When everywhere is set, every session and refresh token of the user is revoked, logging the user out of all their devices."
+	logout.OperationID = "LogoutHandler"
+	logout.Security = &openapi3.SecurityRequirements{
+		openapi3.SecurityRequirement{
+			"bearerAuth": []string{},
+		},
+	}
+
+	h.AddRequestBody("LogoutRequest", models.ExampleLogoutRequest, logout)
+	h.AddResponse("LogoutReply", "success", models.ExampleLogoutSuccessResponse, logout, http.StatusOK)
+	logout.AddResponse(http.StatusInternalServerError, internalServerError())
+	logout.AddResponse(http.StatusBadRequest, badRequest())
+	logout.AddResponse(http.StatusUnauthorized, unauthorized())
+
+	return logout
+}
@@ -0,0 +1,161 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/pkg/httpsling"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/sessions"
+	"github.com/datumforge/datum/pkg/testutils"
+	"github.com/datumforge/datum/pkg/tokens"
+	"github.com/datumforge/datum/pkg/utils/ulids"
+)
+
+func (suite *HandlerTestSuite) TestLogoutHandler() {
+	t := suite.T()
+
+	// add handlers
+	suite.e.POST("logout", suite.h.LogoutHandler)
+	suite.e.POST("refresh", suite.h.RefreshHandler)
+
+	// Set full overlap of the refresh and access token so the refresh token is immediately valid
+	tm, err := testutils.CreateTokenManager(-60 * time.Minute) //nolint:mnd
+	require.NoError(t, err)
+
+	suite.h.TokenManager = tm
+
+	// set privacy allow in order to allow the creation of the users without
+	// authentication in the tests
+	ec := privacy.DecisionContext(context.Background(), privacy.Allow)
+
+	// add mocks for writes
+	mock_fga.WriteAny(t, suite.fga)
+
+	userID := ulids.New().String()
+
+	userSetting := suite.db.UserSetting.Create().
+		SetEmailConfirmed(true).
+		SaveX(ec)
+
+	user := suite.db.User.Create().
+		SetFirstName(gofakeit.FirstName()).
+		SetLastName(gofakeit.LastName()).
+		SetEmail(gofakeit.Email()).
+		SetPassword(validPassword).
+		SetSetting(userSetting).
+		SetID(userID).
+		SetSub(userID). // this is required to parse the refresh token
+		SaveX(ec)
+
+	reqCtx, err := userContextWithID(user.ID)
+	require.NoError(t, err)
+
+	newRefreshToken := func() string {
+		_, refresh, err := tm.CreateTokenPair(&tokens.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject: user.ID,
+			},
+			UserID: user.ID,
+		})
+		require.NoError(t, err)
+
+		return refresh
+	}
+
+	logout := func(in models.LogoutRequest) (*httptest.ResponseRecorder, *models.LogoutReply) {
+		body, err := json.Marshal(in)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/logout", strings.NewReader(string(body)))
+		req.Header.Set(httpsling.HeaderContentType, httpsling.ContentTypeJSONUTF8)
+
+		recorder := httptest.NewRecorder()
+
+		suite.e.ServeHTTP(recorder, req.WithContext(reqCtx))
+
+		var out *models.LogoutReply
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+		return recorder, out
+	}
+
+	refresh := func(token string) int {
+		body, err := json.Marshal(models.RefreshRequest{RefreshToken: token})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/refresh", strings.NewReader(string(body)))
+		req.Header.Set(httpsling.HeaderContentType, httpsling.ContentTypeJSONUTF8)
+
+		recorder := httptest.NewRecorder()
+
+		suite.e.ServeHTTP(recorder, req)
+
+		return recorder.Code
+	}
+
+	store := suite.h.SessionConfig.RedisStore
+
+	// the refresh token can no longer be used after logout
+	token := newRefreshToken()
+
+	recorder, out := logout(models.LogoutRequest{RefreshToken: token})
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, out.Success)
+
+	assert.Equal(t, http.StatusUnauthorized, refresh(token))
+
+	// the session cookie is expired
+	var expired bool
+
+	for _, c := range recorder.Result().Cookies() {
+		if c.Name == suite.h.SessionConfig.CookieConfig.Name {
+			expired = c.MaxAge < 0
+		}
+	}
+
+	assert.True(t, expired)
+
+	// other refresh tokens are still valid
+	other := newRefreshToken()
+	assert.Equal(t, http.StatusOK, refresh(other))
+
+	// refresh tokens of other users cannot be revoked
+	_, foreign, err := tm.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: ulids.New().String(),
+		},
+	})
+	require.NoError(t, err)
+
+	recorder, _ = logout(models.LogoutRequest{RefreshToken: foreign})
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	// logging out everywhere revokes all the sessions and refresh tokens of the user
+	sessionID := sessions.GenerateSessionID()
+	require.NoError(t, store.StoreSessionWithExpiration(context.Background(), sessionID, user.ID, time.Hour))
+
+	other = newRefreshToken()
+
+	recorder, out = logout(models.LogoutRequest{Everywhere: true})
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, out.Success)
+
+	exists, err := store.Exists(context.Background(), sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), exists)
+
+	assert.Equal(t, http.StatusUnauthorized, refresh(other))
+}
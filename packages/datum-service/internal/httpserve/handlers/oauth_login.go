@@ -18,6 +18,7 @@ import (
 	"github.com/datumforge/datum/pkg/providers/github"
 	"github.com/datumforge/datum/pkg/providers/google"
 	oauth "github.com/datumforge/datum/pkg/providers/oauth2"
+	"github.com/datumforge/datum/pkg/providers/saml"
 	"github.com/datumforge/datum/pkg/providers/webauthn"
 	"github.com/datumforge/datum/pkg/sessions"
 )
@@ -32,6 +33,8 @@ type OauthProviderConfig struct {
 	Google google.ProviderConfig `json:"google" koanf:"google"`
 	// Webauthn contains the configuration settings for the Webauthn Oauth Provider
 	Webauthn webauthn.ProviderConfig `json:"webauthn" koanf:"webauthn"`
+	// SAML contains the configuration settings for the SAML service provider used by organizations for single sign-on
+	SAML saml.ProviderConfig `json:"saml" koanf:"saml"`
 }
 
 const (
@@ -115,7 +118,13 @@ func (h *Handler) issueGoogleSession() http.Handler {
 		// check if users exists and create if not
 		user, err := h.CheckAndCreateUser(ctxWithToken, googleUser.Name, googleUser.Email, enums.AuthProviderGoogle, googleUser.Picture)
 		if err != nil {
+			if isUserStatusError(err) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
 			http.Error(w, err.Error(), http.StatusInternalServerError)
+
 			return
 		}
 
@@ -188,7 +197,13 @@ func (h *Handler) issueGitHubSession() http.Handler {
 		// check if users exists and create if not, updates last seen of existing user
 		user, err := h.CheckAndCreateUser(ctxWithToken, *githubUser.Name, *githubUser.Email, enums.AuthProviderGitHub, *githubUser.AvatarURL)
 		if err != nil {
+			if isUserStatusError(err) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
 			http.Error(w, err.Error(), http.StatusInternalServerError)
+
 			return
 		}
 
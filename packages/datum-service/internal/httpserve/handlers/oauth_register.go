@@ -38,6 +38,10 @@ func (h *Handler) OauthRegister(ctx echo.Context) error {
 	// check if users exists and create if not, updates last seen of existing user
 	user, err := h.CheckAndCreateUser(ctxWithToken, in.Name, in.Email, enums.AuthProvider(strings.ToUpper(in.AuthProvider)), in.Image)
 	if err != nil {
+		if isUserStatusError(err) {
+			return h.BadRequest(ctx, err)
+		}
+
 		return h.InternalServerError(ctx, err)
 	}
 
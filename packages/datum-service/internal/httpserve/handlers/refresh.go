@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	echo "github.com/datumforge/echox"
@@ -8,8 +9,10 @@ import (
 
 	ent "github.com/datumforge/datum/internal/ent/generated"
 	"github.com/datumforge/datum/pkg/auth"
+	authmw "github.com/datumforge/datum/pkg/middleware/auth"
 	"github.com/datumforge/datum/pkg/models"
 	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/tokens"
 )
 
 // RefreshHandler allows users to refresh their access token using their refresh token
@@ -32,6 +35,20 @@ func (h *Handler) RefreshHandler(ctx echo.Context) error {
 		return h.BadRequest(ctx, err)
 	}
 
+	// ensure the refresh token was not revoked on logout
+	if h.TokenRevocations != nil {
+		revoked, err := h.TokenRevocations.IsRevoked(ctx.Request().Context(), claims)
+		if err != nil {
+			h.Logger.Errorw("error checking token revocation", "error", err)
+
+			return h.InternalServerError(ctx, ErrProcessingRequest)
+		}
+
+		if revoked {
+			return h.Unauthorized(ctx, tokens.ErrTokenRevoked)
+		}
+	}
+
 	// check user in the database, sub == claims subject and ensure only one record is returned
 	user, err := h.getUserDetailsByID(ctx.Request().Context(), claims.Subject)
 	if err != nil {
@@ -42,9 +59,13 @@ func (h *Handler) RefreshHandler(ctx echo.Context) error {
 		return h.InternalServerError(ctx, ErrProcessingRequest)
 	}
 
-	// ensure the user is still active
-	if user.Edges.Setting.Status != "ACTIVE" {
-		return h.NotFound(ctx, ErrNoAuthUser)
+	// ensure the user is still active and their account is not locked or suspended
+	if err := authmw.CheckUserSetting(user.Edges.Setting); err != nil {
+		if errors.Is(err, auth.ErrNoAuthUser) {
+			return h.NotFound(ctx, ErrNoAuthUser)
+		}
+
+		return h.Unauthorized(ctx, err)
 	}
 
 	// UserID is not on the refresh token, so we need to set it now
@@ -98,6 +119,7 @@ func (h *Handler) BindRefreshHandler() *openapi3.Operation {
 	refresh.AddResponse(http.StatusInternalServerError, internalServerError())
 	refresh.AddResponse(http.StatusBadRequest, badRequest())
 	refresh.AddResponse(http.StatusNotFound, notFound())
+	refresh.AddResponse(http.StatusUnauthorized, unauthorized())
 
 	return refresh
 }
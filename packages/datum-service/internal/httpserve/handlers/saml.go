@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+
+	crewsaml "github.com/crewjam/saml"
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/pkg/enums"
+	"github.com/datumforge/datum/pkg/middleware/transaction"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/providers/saml"
+	"github.com/datumforge/datum/pkg/sessions"
+)
+
+const (
+	samlMetadataContentType = "application/samlmetadata+xml"
+)
+
+// SAMLMetadataHandler publishes the SAML service provider metadata of the organization, which is used to configure
+// the organization identity provider
+func (h *Handler) SAMLMetadataHandler(ctx echo.Context) error {
+	var in models.SSORequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	org, err := h.getSAMLOrganization(ctx.Request().Context(), in.Organization)
+	if err != nil {
+		return h.ssoError(ctx, err)
+	}
+
+	sp, err := h.SAMLProvider.ServiceProvider(org.ID, nil)
+	if err != nil {
+		h.Logger.Errorw("unable to configure saml service provider", "error", err, "organization_id", org.ID)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	metadata, err := xml.MarshalIndent(sp.Metadata(), "", "  ")
+	if err != nil {
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	return ctx.Blob(http.StatusOK, samlMetadataContentType, metadata)
+}
+
+// SAMLLoginHandler redirects the user to the SAML identity provider configured by the organization in the request
+func (h *Handler) SAMLLoginHandler(ctx echo.Context) error {
+	var in models.SSORequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.getSAMLOrganization(reqCtx, in.Organization)
+	if err != nil {
+		return h.ssoError(ctx, err)
+	}
+
+	sp, err := h.samlServiceProvider(reqCtx, org)
+	if err != nil {
+		return h.ssoError(ctx, err)
+	}
+
+	redirect, requestID, err := saml.AuthenticationRequest(sp)
+	if err != nil {
+		h.Logger.Errorw("unable to create saml authentication request", "error", err, "organization_id", org.ID)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	cookieConfig := h.samlCookieConfig()
+
+	w := ctx.Response().Writer
+	http.SetCookie(w, sessions.NewCookie(saml.RequestIDCookieName, requestID, cookieConfig))
+
+	// keep the redirect of the UI for the assertion consumer service
+	if in.RedirectURI != "" {
+		http.SetCookie(w, sessions.NewCookie("redirect_to", in.RedirectURI, cookieConfig))
+	}
+
+	return ctx.Redirect(http.StatusFound, redirect.String())
+}
+
+// SAMLACSHandler is the assertion consumer service of the organization, it validates the SAML response posted by
+// the identity provider, provisions the user into the organization on their first login and issues a session for
+// the organization
+func (h *Handler) SAMLACSHandler(ctx echo.Context) error {
+	var in models.SSORequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.getSAMLOrganization(reqCtx, in.Organization)
+	if err != nil {
+		return h.ssoError(ctx, err)
+	}
+
+	sp, err := h.samlServiceProvider(reqCtx, org)
+	if err != nil {
+		return h.ssoError(ctx, err)
+	}
+
+	// the response must be to the request started by the login handler, unless the identity provider initiated
+	// logins are allowed
+	var requestIDs []string
+	if requestID, err := ctx.Cookie(saml.RequestIDCookieName); err == nil && requestID.Value != "" {
+		requestIDs = append(requestIDs, requestID.Value)
+	}
+
+	assertion, err := sp.ParseResponse(ctx.Request(), requestIDs)
+	if err != nil {
+		var invalidErr *crewsaml.InvalidResponseError
+		if errors.As(err, &invalidErr) {
+			err = invalidErr.PrivateErr
+		}
+
+		h.Logger.Errorw("unable to validate saml response", "error", err, "organization_id", org.ID)
+
+		return h.BadRequest(ctx, ErrInvalidSAMLResponse)
+	}
+
+	samlUser, err := saml.UserFromAssertion(assertion, saml.NewAttributeMapping(org.Edges.Setting.SamlAttributeMapping))
+	if err != nil {
+		return h.BadRequest(ctx, err)
+	}
+
+	// the identity is scoped to the identity provider that issued the assertion
+	issuer := sp.IDPMetadata.EntityID
+	if assertion.Issuer.Value != "" {
+		issuer = assertion.Issuer.Value
+	}
+
+	entUser, err := h.provisionSSOUser(ctx, org, ssoIdentity{
+		issuer:  issuer,
+		subject: samlUser.Subject,
+		email:   samlUser.Email,
+		// SAML has no email verification claim, the assertion is trusted for the verified domains of the organization
+		emailVerified: true,
+		name:          samlUser.Name(),
+		provider:      enums.AuthProviderSAML,
+	})
+	if err != nil {
+		if isSSOIdentityError(err) {
+			return h.BadRequest(ctx, err)
+		}
+
+		h.Logger.Errorw("unable to provision saml user", "error", err, "organization_id", org.ID)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	authData, err := h.AuthManager.GenerateUserAuthSessionWithOrg(ctx, entUser, org.ID)
+	if err != nil {
+		h.Logger.Errorw("unable create new auth session", "error", err)
+
+		return h.InternalServerError(ctx, err)
+	}
+
+	redirectURI := h.OauthProvider.RedirectURL
+	if redirect, err := ctx.Cookie("redirect_to"); err == nil && redirect.Value != "" {
+		redirectURI = redirect.Value
+	}
+
+	// remove the cookies of the flow now that the login is complete
+	cookieConfig := h.samlCookieConfig()
+	for _, name := range []string{saml.RequestIDCookieName, "redirect_to"} {
+		sessions.RemoveCookie(ctx.Response().Writer, name, *cookieConfig)
+	}
+
+	return ctx.Redirect(http.StatusFound, fmt.Sprintf("%s?session=%s", redirectURI, authData.Session))
+}
+
+// getSAMLOrganization returns the organization by id or name with its settings, an error is returned when
+// the SAML provider is not enabled on the server
+func (h *Handler) getSAMLOrganization(ctx context.Context, org string) (*ent.Organization, error) {
+	if h.SAMLProvider == nil {
+		return nil, ErrSSONotConfigured
+	}
+
+	// the request is not authenticated, allow the lookup of the organization and its settings
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	return transaction.FromContext(ctx).Organization.Query().
+		Where(
+			organization.Or(organization.ID(org), organization.Name(org)),
+			organization.PersonalOrg(false),
+		).
+		WithSetting().
+		Only(allowCtx)
+}
+
+// samlServiceProvider returns the service provider of the organization with the metadata of the identity provider
+// configured in the organization settings
+func (h *Handler) samlServiceProvider(ctx context.Context, org *ent.Organization) (*crewsaml.ServiceProvider, error) {
+	setting := org.Edges.Setting
+	if setting == nil || (setting.SamlMetadata == "" && setting.SamlMetadataURL == "") {
+		return nil, ErrSSONotConfigured
+	}
+
+	idpMetadata, err := h.SAMLProvider.IDPMetadata(ctx, setting.SamlMetadataURL, setting.SamlMetadata)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get saml identity provider metadata: %w", err)
+	}
+
+	return h.SAMLProvider.ServiceProvider(org.ID, idpMetadata)
+}
+
+// samlCookieConfig returns the config of the cookies of the SAML flow, the identity provider posts the response
+// from its own site so the cookies must be sent on cross-site requests, which browsers only allow for secure cookies
+func (h *Handler) samlCookieConfig() *sessions.CookieConfig {
+	config := *h.SessionConfig.CookieConfig
+	if config.Secure {
+		config.SameSite = http.SameSiteNoneMode
+	}
+
+	return &config
+}
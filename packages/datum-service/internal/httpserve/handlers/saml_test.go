@@ -0,0 +1,380 @@
+package handlers_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/xml"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	crewsaml "github.com/crewjam/saml"
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/pkg/enums"
+	"github.com/datumforge/datum/pkg/middleware/echocontext"
+	"github.com/datumforge/datum/pkg/providers/saml"
+)
+
+// newTestKeyPair generates a RSA key and a self-signed certificate for the common name
+func newTestKeyPair(t *testing.T, cn string) (*rsa.PrivateKey, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return key, cert
+}
+
+// testSAMLServiceProviders returns the metadata of the service provider the test identity provider trusts
+type testSAMLServiceProviders struct {
+	metadata *crewsaml.EntityDescriptor
+}
+
+func (s testSAMLServiceProviders) GetServiceProvider(_ *http.Request, serviceProviderID string) (*crewsaml.EntityDescriptor, error) {
+	if s.metadata.EntityID != serviceProviderID {
+		return nil, os.ErrNotExist
+	}
+
+	return s.metadata, nil
+}
+
+// newTestIDP returns an identity provider with a generated key pair trusting the service provider of the organization
+func newTestIDP(t *testing.T, sp *crewsaml.ServiceProvider) *crewsaml.IdentityProvider {
+	key, cert := newTestKeyPair(t, "idp.guardians.net")
+
+	metadataURL, _ := url.Parse("https://idp.guardians.net/metadata")
+	ssoURL, _ := url.Parse("https://idp.guardians.net/sso")
+
+	return &crewsaml.IdentityProvider{
+		Key:                     key,
+		Certificate:             cert,
+		MetadataURL:             *metadataURL,
+		SSOURL:                  *ssoURL,
+		ServiceProviderProvider: testSAMLServiceProviders{metadata: sp.Metadata()},
+	}
+}
+
+// samlResponse returns the SAML response of the identity provider to the authentication request in the redirect URL
+func samlResponse(t *testing.T, idp *crewsaml.IdentityProvider, redirect string, session *crewsaml.Session) url.Values {
+	req, err := crewsaml.NewIdpAuthnRequest(idp, httptest.NewRequest(http.MethodGet, redirect, nil))
+	require.NoError(t, err)
+
+	require.NoError(t, req.Validate())
+	require.NoError(t, crewsaml.DefaultAssertionMaker{}.MakeAssertion(req, session))
+
+	form, err := req.PostBinding()
+	require.NoError(t, err)
+
+	return url.Values{
+		"SAMLResponse": {form.SAMLResponse},
+		"RelayState":   {form.RelayState},
+	}
+}
+
+func (suite *HandlerTestSuite) TestSAMLMetadataHandler() {
+	t := suite.T()
+
+	suite.e.GET("/sso/:org/saml/metadata", suite.h.SAMLMetadataHandler)
+
+	mock_fga.WriteAny(t, suite.fga)
+	mock_fga.CheckAny(t, suite.fga, true)
+
+	orgID, _ := suite.createSSOOrg("guardians")
+
+	spKey, spCert := newTestKeyPair(t, "datum.net")
+
+	suite.h.SAMLProvider = saml.NewWithKeyPair(saml.ProviderConfig{ClientEndpoint: "https://api.datum.net"}, spKey, spCert)
+	defer func() { suite.h.SAMLProvider = nil }()
+
+	testCases := []struct {
+		name           string
+		org            string
+		expectedStatus int
+	}{
+		{
+			name:           "happy path, by organization id",
+			org:            orgID,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "happy path, by organization name",
+			org:            "guardians",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "organization does not exist",
+			org:            "kree",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/sso/"+tc.org+"/saml/metadata", nil)
+
+			recorder := httptest.NewRecorder()
+
+			suite.e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var metadata crewsaml.EntityDescriptor
+			require.NoError(t, xml.NewDecoder(res.Body).Decode(&metadata))
+
+			// the entity id is always based on the organization id so it does not change with the organization name
+			assert.Equal(t, "https://api.datum.net/v1/sso/"+orgID+"/saml/metadata", metadata.EntityID)
+			require.Len(t, metadata.SPSSODescriptors, 1)
+			assert.Equal(t, "https://api.datum.net/v1/sso/"+orgID+"/saml/acs",
+				metadata.SPSSODescriptors[0].AssertionConsumerServices[0].Location)
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestSAMLLoginAndACSHandler() {
+	t := suite.T()
+
+	suite.e.GET("/sso/:org/saml/login", suite.h.SAMLLoginHandler)
+	suite.e.POST("/sso/:org/saml/acs", suite.h.SAMLACSHandler)
+
+	ctx := privacy.DecisionContext(echocontext.NewTestEchoContext().Request().Context(), privacy.Allow)
+
+	mock_fga.WriteAny(t, suite.fga)
+	mock_fga.CheckAny(t, suite.fga, true)
+
+	orgID, ownerCtx := suite.createSSOOrg("guardians")
+	noSAMLOrgID, _ := suite.createSSOOrg("ravagers")
+
+	spKey, spCert := newTestKeyPair(t, "datum.net")
+
+	suite.h.SAMLProvider = saml.NewWithKeyPair(saml.ProviderConfig{ClientEndpoint: "https://api.datum.net"}, spKey, spCert)
+	defer func() { suite.h.SAMLProvider = nil }()
+
+	sp, err := suite.h.SAMLProvider.ServiceProvider(orgID, nil)
+	require.NoError(t, err)
+
+	idp := newTestIDP(t, sp)
+	untrustedIDP := newTestIDP(t, sp)
+
+	idpMetadata, err := xml.Marshal(idp.Metadata())
+	require.NoError(t, err)
+
+	suite.db.OrganizationSetting.Update().
+		Where(organizationsetting.OrganizationID(orgID)).
+		SetSamlMetadata(string(idpMetadata)).
+		SetSamlAttributeMapping(map[string]interface{}{
+			saml.FieldEmail:     "eduPersonPrincipalName",
+			saml.FieldFirstName: "givenName",
+			saml.FieldLastName:  "sn",
+		}).
+		SetVerifiedDomains([]string{"guardians.net"}).
+		ExecX(ownerCtx)
+
+	// an existing user that is not a member of the organization is never linked
+	suite.db.User.Create().
+		SetEmail("drax@guardians.net").
+		SetFirstName("Drax").
+		SetLastName("Destroyer").
+		SaveX(ctx)
+
+	email := "starlord@guardians.net"
+
+	newSession := func(nameID, email string) *crewsaml.Session {
+		return &crewsaml.Session{
+			ID:            "session-id",
+			CreateTime:    time.Now(),
+			ExpireTime:    time.Now().Add(time.Hour),
+			NameID:        nameID,
+			UserEmail:     email,
+			UserGivenName: "Peter",
+			UserSurname:   "Quill",
+		}
+	}
+
+	session := newSession("starlord", email)
+
+	testCases := []struct {
+		name          string
+		org           string
+		idp           *crewsaml.IdentityProvider
+		session       *crewsaml.Session
+		skipRequestID bool
+		expectedLogin int
+		expectedACS   int
+		expectedErr   error
+	}{
+		{
+			name:          "happy path, user is provisioned",
+			org:           orgID,
+			idp:           idp,
+			expectedLogin: http.StatusFound,
+			expectedACS:   http.StatusFound,
+		},
+		{
+			name:          "happy path, existing member by organization name",
+			org:           "guardians",
+			idp:           idp,
+			expectedLogin: http.StatusFound,
+			expectedACS:   http.StatusFound,
+		},
+		{
+			name:          "email domain not verified",
+			org:           orgID,
+			idp:           idp,
+			session:       newSession("yondu", "yondu@ravagers.net"),
+			expectedLogin: http.StatusFound,
+			expectedACS:   http.StatusBadRequest,
+			expectedErr:   handlers.ErrSSODomainNotVerified,
+		},
+		{
+			name:          "existing user is not a member",
+			org:           orgID,
+			idp:           idp,
+			session:       newSession("drax", "drax@guardians.net"),
+			expectedLogin: http.StatusFound,
+			expectedACS:   http.StatusBadRequest,
+			expectedErr:   handlers.ErrSSOAccountNotLinked,
+		},
+		{
+			name:          "another subject with the email of a linked member",
+			org:           orgID,
+			idp:           idp,
+			session:       newSession("peter", email),
+			expectedLogin: http.StatusFound,
+			expectedACS:   http.StatusBadRequest,
+			expectedErr:   handlers.ErrSSOAccountNotLinked,
+		},
+		{
+			name:          "response signed by another identity provider",
+			org:           orgID,
+			idp:           untrustedIDP,
+			expectedLogin: http.StatusFound,
+			expectedACS:   http.StatusBadRequest,
+			expectedErr:   handlers.ErrInvalidSAMLResponse,
+		},
+		{
+			name:          "identity provider initiated login is not allowed",
+			org:           orgID,
+			idp:           idp,
+			skipRequestID: true,
+			expectedLogin: http.StatusFound,
+			expectedACS:   http.StatusBadRequest,
+			expectedErr:   handlers.ErrInvalidSAMLResponse,
+		},
+		{
+			name:          "organization without saml",
+			org:           noSAMLOrgID,
+			expectedLogin: http.StatusNotFound,
+			expectedErr:   handlers.ErrSSONotConfigured,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/sso/"+tc.org+"/saml/login?redirect_uri=http://localhost:3001/dashboard", nil)
+
+			recorder := httptest.NewRecorder()
+
+			suite.e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			require.Equal(t, tc.expectedLogin, recorder.Code)
+
+			if tc.expectedLogin != http.StatusFound {
+				assert.Contains(t, recorder.Body.String(), tc.expectedErr.Error())
+
+				return
+			}
+
+			location := res.Header.Get("Location")
+			assert.True(t, strings.HasPrefix(location, idp.SSOURL.String()))
+
+			if tc.session == nil {
+				tc.session = session
+			}
+
+			form := samlResponse(t, tc.idp, location, tc.session)
+
+			acsReq := httptest.NewRequest(http.MethodPost, "/sso/"+tc.org+"/saml/acs", strings.NewReader(form.Encode()))
+			acsReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			for _, c := range res.Cookies() {
+				if tc.skipRequestID && c.Name == saml.RequestIDCookieName {
+					continue
+				}
+
+				acsReq.AddCookie(c)
+			}
+
+			acsRecorder := httptest.NewRecorder()
+
+			suite.e.ServeHTTP(acsRecorder, acsReq.WithContext(echocontext.NewTestContext()))
+
+			acsRes := acsRecorder.Result()
+			defer acsRes.Body.Close()
+
+			require.Equal(t, tc.expectedACS, acsRecorder.Code)
+
+			if tc.expectedErr != nil {
+				assert.Contains(t, acsRecorder.Body.String(), tc.expectedErr.Error())
+
+				return
+			}
+
+			assert.True(t, strings.HasPrefix(acsRes.Header.Get("Location"), "http://localhost:3001/dashboard?session="))
+
+			u, err := suite.db.User.Query().
+				Where(user.Email(email), user.AuthProviderEQ(enums.AuthProviderSAML)).
+				Only(ctx)
+			require.NoError(t, err)
+
+			assert.Equal(t, "Peter", u.FirstName)
+			assert.Equal(t, "Quill", u.LastName)
+
+			members, err := suite.db.OrgMembership.Query().
+				Where(orgmembership.UserID(u.ID), orgmembership.OrganizationID(orgID)).
+				All(ownerCtx)
+			require.NoError(t, err)
+			require.Len(t, members, 1)
+			assert.Equal(t, enums.RoleMember, members[0].Role)
+			require.NotNil(t, members[0].SSOIssuer)
+			assert.Equal(t, idp.MetadataURL.String(), *members[0].SSOIssuer)
+			require.NotNil(t, members[0].SSOSubject)
+			assert.Equal(t, "starlord", *members[0].SSOSubject)
+		})
+	}
+}
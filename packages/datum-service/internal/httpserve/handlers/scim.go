@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/middleware/transaction"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/scim"
+)
+
+// scimBasePath is the path the SCIM resources are served under
+const scimBasePath = "/scim/v2"
+
+// SCIMServiceProviderConfigHandler returns the SCIM features supported by the server
+func (h *Handler) SCIMServiceProviderConfigHandler(ctx echo.Context) error {
+	return h.scimResponse(ctx, http.StatusOK, scim.NewServiceProviderConfig())
+}
+
+// scimOrganization returns the organization of the api token used to authenticate the SCIM request with its settings,
+// provisioning is only allowed with organization api tokens so the resources are always scoped to a single organization
+func (h *Handler) scimOrganization(ctx context.Context) (*ent.Organization, error) {
+	if !auth.IsAPITokenAuthentication(ctx) {
+		return nil, ErrSCIMRequiresAPIToken
+	}
+
+	orgID, err := auth.GetOrganizationIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// api tokens are not members of the organization, allow the lookup of the organization settings
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	return transaction.FromContext(ctx).Organization.Query().
+		Where(organization.ID(orgID)).
+		WithSetting().
+		Only(allowCtx)
+}
+
+// bindSCIMRequest binds the path and query parameters of the request, the body is not bound because SCIM
+// requests use the application/scim+json content type
+func (h *Handler) bindSCIMRequest(ctx echo.Context) (*models.SCIMRequest, error) {
+	var in models.SCIMRequest
+
+	if err := echo.BindPathParams(ctx, &in); err != nil {
+		return nil, err
+	}
+
+	if err := echo.BindQueryParams(ctx, &in); err != nil {
+		return nil, err
+	}
+
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	if in.Count == nil {
+		count := scim.DefaultCount
+		in.Count = &count
+	}
+
+	return &in, nil
+}
+
+// decodeSCIMBody decodes the SCIM resource or patch request in the body of the request
+func decodeSCIMBody(ctx echo.Context, v interface{}) error {
+	if err := json.NewDecoder(ctx.Request().Body).Decode(v); err != nil {
+		return fmt.Errorf("%w: %w", scim.ErrInvalidSyntax, err)
+	}
+
+	return nil
+}
+
+// scimLocation returns the URL of the resource returned in the meta attribute of the resource
+func scimLocation(ctx echo.Context, resource, id string) string {
+	return fmt.Sprintf("%s://%s%s/%s/%s", ctx.Scheme(), ctx.Request().Host, scimBasePath, resource, id)
+}
+
+// scimResponse writes the SCIM message with the SCIM content type
+func (h *Handler) scimResponse(ctx echo.Context, status int, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Blob(status, scim.ContentType, data)
+}
+
+// scimError writes the SCIM error response with the status code
+func (h *Handler) scimError(ctx echo.Context, status int, err error) error {
+	return h.scimResponse(ctx, status, scim.NewError(status, err))
+}
+
+// scimRequestError returns the response for errors handling a SCIM request based on the type of the error
+func (h *Handler) scimRequestError(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, ErrSCIMRequiresAPIToken):
+		return h.scimError(ctx, http.StatusForbidden, err)
+	case errors.Is(err, scim.ErrUniqueness):
+		return h.scimError(ctx, http.StatusConflict, err)
+	case ent.IsConstraintError(err):
+		return h.scimError(ctx, http.StatusConflict, scim.ErrUniqueness)
+	case ent.IsNotFound(err):
+		return h.scimError(ctx, http.StatusNotFound, nil)
+	case errors.Is(err, scim.ErrInvalidFilter), errors.Is(err, scim.ErrInvalidSyntax),
+		errors.Is(err, scim.ErrInvalidPath), errors.Is(err, scim.ErrNoTarget),
+		errors.Is(err, scim.ErrInvalidValue), errors.Is(err, scim.ErrMutability),
+		errors.Is(err, ErrSCIMMemberNotInOrg), ent.IsValidationError(err):
+		return h.scimError(ctx, http.StatusBadRequest, err)
+	}
+
+	var (
+		bindErr  *echo.BindingError
+		fieldErr *rout.FieldError
+	)
+
+	if errors.As(err, &bindErr) || errors.As(err, &fieldErr) {
+		return h.scimError(ctx, http.StatusBadRequest, fmt.Errorf("%w: %w", scim.ErrInvalidValue, err))
+	}
+
+	h.Logger.Errorw("unable to process scim request", "error", err)
+
+	return h.scimError(ctx, http.StatusInternalServerError, ErrProcessingRequest)
+}
@@ -0,0 +1,384 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/pkg/middleware/transaction"
+	"github.com/datumforge/datum/pkg/scim"
+)
+
+// scimGroupsPath is the path of the SCIM group resources
+const scimGroupsPath = "Groups"
+
+// SCIMListGroupsHandler lists the groups of the organization of the api token as SCIM groups
+func (h *Handler) SCIMListGroupsHandler(ctx echo.Context) error {
+	in, err := h.bindSCIMRequest(ctx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.scimOrganization(reqCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	entGroups, err := transaction.FromContext(reqCtx).Group.Query().
+		Where(group.OwnerID(org.ID)).
+		WithMembers(func(q *ent.GroupMembershipQuery) {
+			q.WithUser()
+		}).
+		Order(ent.Asc(group.FieldCreatedAt)).
+		All(privacy.DecisionContext(reqCtx, privacy.Allow))
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	groups := []scim.Group{}
+	for _, g := range entGroups {
+		groups = append(groups, toSCIMGroup(ctx, g))
+	}
+
+	groups, err = scim.FilterResources(groups, in.Filter)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	return h.scimResponse(ctx, http.StatusOK, scim.NewListResponse(groups, in.StartIndex, *in.Count))
+}
+
+// SCIMGetGroupHandler returns the group of the organization as a SCIM group
+func (h *Handler) SCIMGetGroupHandler(ctx echo.Context) error {
+	in, err := h.bindSCIMRequest(ctx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.scimOrganization(reqCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	g, err := h.getSCIMGroup(reqCtx, org.ID, in.ID)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	return h.scimResponse(ctx, http.StatusOK, toSCIMGroup(ctx, g))
+}
+
+// SCIMCreateGroupHandler creates the SCIM group in the organization, the members must be members of the organization
+func (h *Handler) SCIMCreateGroupHandler(ctx echo.Context) error {
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.scimOrganization(reqCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	var in scim.Group
+	if err := decodeSCIMBody(ctx, &in); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	if in.DisplayName == "" {
+		return h.scimRequestError(ctx, fmt.Errorf("%w: displayName is required", scim.ErrInvalidValue))
+	}
+
+	if err := h.checkSCIMGroupMembers(reqCtx, org.ID, in.MemberIDs()); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	input := ent.CreateGroupInput{
+		Name:    in.DisplayName,
+		OwnerID: &org.ID,
+	}
+
+	// the api token is not a member of the organization, the access is checked by the scope of the token
+	created, err := transaction.FromContext(reqCtx).Group.Create().
+		SetInput(input).
+		Save(privacy.DecisionContext(reqCtx, privacy.Allow))
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	if err := h.syncSCIMGroupMembers(reqCtx, created, in.MemberIDs()); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	if err := h.loadSCIMGroupMembers(reqCtx, created); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	return h.scimResponse(ctx, http.StatusCreated, toSCIMGroup(ctx, created))
+}
+
+// SCIMReplaceGroupHandler replaces the name and the members of the SCIM group
+func (h *Handler) SCIMReplaceGroupHandler(ctx echo.Context) error {
+	in, err := h.bindSCIMRequest(ctx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.scimOrganization(reqCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	var sg scim.Group
+	if err := decodeSCIMBody(ctx, &sg); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	g, err := h.getSCIMGroup(reqCtx, org.ID, in.ID)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	return h.updateSCIMGroup(ctx, g, &sg)
+}
+
+// SCIMPatchGroupHandler applies the patch operations to the SCIM group
+func (h *Handler) SCIMPatchGroupHandler(ctx echo.Context) error {
+	in, err := h.bindSCIMRequest(ctx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.scimOrganization(reqCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	var patch scim.PatchRequest
+	if err := decodeSCIMBody(ctx, &patch); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	g, err := h.getSCIMGroup(reqCtx, org.ID, in.ID)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	sg := toSCIMGroup(ctx, g)
+	if err := patch.Apply(&sg); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	return h.updateSCIMGroup(ctx, g, &sg)
+}
+
+// SCIMDeleteGroupHandler deletes the SCIM group from the organization
+func (h *Handler) SCIMDeleteGroupHandler(ctx echo.Context) error {
+	in, err := h.bindSCIMRequest(ctx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.scimOrganization(reqCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	g, err := h.getSCIMGroup(reqCtx, org.ID, in.ID)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	if err := transaction.FromContext(reqCtx).Group.DeleteOneID(g.ID).
+		Exec(privacy.DecisionContext(reqCtx, privacy.Allow)); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// updateSCIMGroup updates the name and the members of the group with the SCIM group and writes the updated group
+func (h *Handler) updateSCIMGroup(ctx echo.Context, g *ent.Group, sg *scim.Group) error {
+	reqCtx := ctx.Request().Context()
+
+	if sg.DisplayName == "" {
+		return h.scimRequestError(ctx, fmt.Errorf("%w: displayName is required", scim.ErrInvalidValue))
+	}
+
+	memberIDs := sg.MemberIDs()
+
+	if err := h.checkSCIMGroupMembers(reqCtx, g.OwnerID, memberIDs); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	if err := h.syncSCIMGroupMembers(reqCtx, g, memberIDs); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	if sg.DisplayName != g.Name {
+		updated, err := transaction.FromContext(reqCtx).Group.UpdateOneID(g.ID).
+			SetName(sg.DisplayName).
+			Save(privacy.DecisionContext(reqCtx, privacy.Allow))
+		if err != nil {
+			return h.scimRequestError(ctx, err)
+		}
+
+		g = updated
+	}
+
+	if err := h.loadSCIMGroupMembers(reqCtx, g); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	return h.scimResponse(ctx, http.StatusOK, toSCIMGroup(ctx, g))
+}
+
+// getSCIMGroup returns the group of the organization with its members
+func (h *Handler) getSCIMGroup(ctx context.Context, orgID, id string) (*ent.Group, error) {
+	g, err := transaction.FromContext(ctx).Group.Query().
+		Where(
+			group.ID(id),
+			group.OwnerID(orgID),
+		).
+		Only(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.loadSCIMGroupMembers(ctx, g); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// loadSCIMGroupMembers sets the current members of the group with their users on the edges of the group
+func (h *Handler) loadSCIMGroupMembers(ctx context.Context, g *ent.Group) error {
+	members, err := transaction.FromContext(ctx).GroupMembership.Query().
+		Where(groupmembership.GroupID(g.ID)).
+		WithUser().
+		Order(ent.Asc(groupmembership.FieldCreatedAt)).
+		All(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		return err
+	}
+
+	g.Edges.Members = members
+
+	return nil
+}
+
+// checkSCIMGroupMembers returns an error if any of the users is not an active member of the organization
+func (h *Handler) checkSCIMGroupMembers(ctx context.Context, orgID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	count, err := transaction.FromContext(ctx).OrgMembership.Query().
+		Where(
+			orgmembership.OrganizationID(orgID),
+			orgmembership.UserIDIn(userIDs...),
+		).
+		Count(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		return err
+	}
+
+	if count != len(userIDs) {
+		return ErrSCIMMemberNotInOrg
+	}
+
+	return nil
+}
+
+// syncSCIMGroupMembers adds and removes the memberships of the group so the users are the only members of the group,
+// removed memberships are soft deleted and the hooks of the memberships update the relationship tuples
+func (h *Handler) syncSCIMGroupMembers(ctx context.Context, g *ent.Group, userIDs []string) error {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+	tx := transaction.FromContext(ctx)
+
+	current := []string{}
+	remove := []string{}
+
+	for _, m := range g.Edges.Members {
+		current = append(current, m.UserID)
+
+		if !slices.Contains(userIDs, m.UserID) {
+			remove = append(remove, m.ID)
+		}
+	}
+
+	if len(remove) > 0 {
+		if _, err := tx.GroupMembership.Delete().
+			Where(groupmembership.IDIn(remove...)).
+			Exec(allowCtx); err != nil {
+			return err
+		}
+	}
+
+	for _, userID := range userIDs {
+		if slices.Contains(current, userID) {
+			continue
+		}
+
+		input := ent.CreateGroupMembershipInput{
+			GroupID: g.ID,
+			UserID:  userID,
+		}
+
+		if _, err := tx.GroupMembership.Create().SetInput(input).Save(allowCtx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// toSCIMGroup returns the SCIM representation of the group with its members
+func toSCIMGroup(ctx echo.Context, g *ent.Group) scim.Group {
+	created, updated := g.CreatedAt, g.UpdatedAt
+
+	members := []scim.Member{}
+
+	for _, m := range g.Edges.Members {
+		member := scim.Member{
+			Value: m.UserID,
+			Ref:   scimLocation(ctx, scimUsersPath, m.UserID),
+			Type:  scim.ResourceTypeUser,
+		}
+
+		if m.Edges.User != nil {
+			member.Display = m.Edges.User.Email
+		}
+
+		members = append(members, member)
+	}
+
+	return scim.Group{
+		Schemas:     []string{scim.GroupSchema},
+		ID:          g.ID,
+		DisplayName: g.Name,
+		Members:     members,
+		Meta: &scim.Meta{
+			ResourceType: scim.ResourceTypeGroup,
+			Created:      &created,
+			LastModified: &updated,
+			Location:     scimLocation(ctx, scimGroupsPath, g.ID),
+		},
+	}
+}
@@ -0,0 +1,489 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	echo "github.com/datumforge/echox"
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/invite"
+	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/middleware/echocontext"
+	"github.com/datumforge/datum/pkg/scim"
+	"github.com/datumforge/datum/pkg/utils/ulids"
+)
+
+// scimTestAuthMW authenticates the requests with an api token of the organization, or with a user when
+// the organization is empty
+func scimTestAuthMW(orgID string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			au := &auth.AuthenticatedUser{
+				SubjectID:          ulids.New().String(),
+				OrganizationID:     orgID,
+				OrganizationIDs:    []string{orgID},
+				AuthenticationType: auth.APITokenAuthentication,
+			}
+
+			if orgID == "" {
+				au.AuthenticationType = auth.JWTAuthentication
+			}
+
+			auth.SetAuthenticatedUserContext(c, au)
+
+			reqCtx := context.WithValue(c.Request().Context(), echocontext.EchoContextKey, c)
+			c.SetRequest(c.Request().WithContext(reqCtx))
+
+			return next(c)
+		}
+	}
+}
+
+// registerSCIMRoutes registers the SCIM handlers authenticated with an api token of the organization
+func (suite *HandlerTestSuite) registerSCIMRoutes(orgID string) {
+	mw := scimTestAuthMW(orgID)
+
+	suite.e.GET("/scim/v2/Users", suite.h.SCIMListUsersHandler, mw)
+	suite.e.GET("/scim/v2/Users/:id", suite.h.SCIMGetUserHandler, mw)
+	suite.e.POST("/scim/v2/Users", suite.h.SCIMCreateUserHandler, mw)
+	suite.e.PUT("/scim/v2/Users/:id", suite.h.SCIMReplaceUserHandler, mw)
+	suite.e.PATCH("/scim/v2/Users/:id", suite.h.SCIMPatchUserHandler, mw)
+	suite.e.DELETE("/scim/v2/Users/:id", suite.h.SCIMDeleteUserHandler, mw)
+	suite.e.GET("/scim/v2/Groups", suite.h.SCIMListGroupsHandler, mw)
+	suite.e.GET("/scim/v2/Groups/:id", suite.h.SCIMGetGroupHandler, mw)
+	suite.e.POST("/scim/v2/Groups", suite.h.SCIMCreateGroupHandler, mw)
+	suite.e.PATCH("/scim/v2/Groups/:id", suite.h.SCIMPatchGroupHandler, mw)
+	suite.e.DELETE("/scim/v2/Groups/:id", suite.h.SCIMDeleteGroupHandler, mw)
+}
+
+// scimRequest sends the SCIM request and decodes the response into out when it is not nil
+func (suite *HandlerTestSuite) scimRequest(t *testing.T, method, path string, body interface{}, out interface{}) int {
+	var reader *strings.Reader
+
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		reader = strings.NewReader(string(data))
+	} else {
+		reader = strings.NewReader("")
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set(echo.HeaderContentType, scim.ContentType)
+
+	recorder := httptest.NewRecorder()
+
+	suite.e.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusNoContent {
+		assert.Equal(t, scim.ContentType, recorder.Header().Get(echo.HeaderContentType))
+	}
+
+	if out != nil {
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(out))
+	}
+
+	return recorder.Code
+}
+
+func (suite *HandlerTestSuite) TestSCIMUsersHandler() {
+	t := suite.T()
+
+	mock_fga.WriteAny(t, suite.fga)
+	mock_fga.CheckAny(t, suite.fga, true)
+
+	orgID, ownerCtx := suite.createSSOOrg("guardians")
+
+	suite.db.OrganizationSetting.Update().
+		Where(organizationsetting.OrganizationID(orgID)).
+		SetVerifiedDomains([]string{"guardians.net"}).
+		ExecX(ownerCtx)
+
+	suite.registerSCIMRoutes(orgID)
+
+	allowCtx := privacy.DecisionContext(ownerCtx, privacy.Allow)
+
+	// existing accounts created outside of the organization
+	gamora := suite.db.User.Create().
+		SetEmail("gamora@guardians.net").
+		SetFirstName("Gamora").
+		SetLastName("Zen").
+		SaveX(allowCtx)
+
+	suite.db.User.Create().
+		SetEmail("yondu@ravagers.net").
+		SetFirstName("Yondu").
+		SetLastName("Udonta").
+		SaveX(allowCtx)
+
+	starlord := scim.User{
+		Schemas:  []string{scim.UserSchema},
+		UserName: "starlord@guardians.net",
+		Name: &scim.Name{
+			GivenName:  "Peter",
+			FamilyName: "Quill",
+		},
+		Emails: []scim.Email{
+			{Value: "starlord@guardians.net", Type: "work", Primary: true},
+		},
+		Active: scim.NewBool(true),
+	}
+
+	var created scim.User
+	status := suite.scimRequest(t, http.MethodPost, "/scim/v2/Users", starlord, &created)
+	require.Equal(t, http.StatusCreated, status)
+
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, "starlord@guardians.net", created.UserName)
+	assert.Equal(t, "Peter", created.Name.GivenName)
+	assert.True(t, created.IsActive())
+	assert.Equal(t, scim.ResourceTypeUser, created.Meta.ResourceType)
+
+	userPath := "/scim/v2/Users/" + created.ID
+
+	t.Run("user is provisioned with the organization sso provider", func(t *testing.T) {
+		u, err := suite.db.User.Query().Where(user.ID(created.ID)).Only(ownerCtx)
+		require.NoError(t, err)
+		assert.Equal(t, "OIDC", u.AuthProvider.String())
+	})
+
+	t.Run("user already provisioned", func(t *testing.T) {
+		var out scim.Error
+		status := suite.scimRequest(t, http.MethodPost, "/scim/v2/Users", starlord, &out)
+		assert.Equal(t, http.StatusConflict, status)
+		assert.Equal(t, "uniqueness", out.ScimType)
+	})
+
+	t.Run("existing user in a verified domain is added", func(t *testing.T) {
+		var out scim.User
+		status := suite.scimRequest(t, http.MethodPost, "/scim/v2/Users", scim.User{
+			Schemas:  []string{scim.UserSchema},
+			UserName: "gamora@guardians.net",
+			Name:     &scim.Name{GivenName: "Nebula", FamilyName: "Zen"},
+			Active:   scim.NewBool(true),
+		}, &out)
+		assert.Equal(t, http.StatusCreated, status)
+		assert.Equal(t, gamora.ID, out.ID)
+
+		// the organization did not create the account, so the profile is not changed
+		assert.Equal(t, "Gamora", out.Name.GivenName)
+
+		patch := map[string]interface{}{
+			"schemas": []string{scim.PatchOpSchema},
+			"Operations": []map[string]interface{}{
+				{"op": "replace", "path": "name.givenName", "value": "Nebula"},
+			},
+		}
+
+		status = suite.scimRequest(t, http.MethodPatch, "/scim/v2/Users/"+gamora.ID, patch, &out)
+		assert.Equal(t, http.StatusOK, status)
+
+		u, err := suite.db.User.Get(allowCtx, gamora.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Gamora", u.FirstName)
+	})
+
+	t.Run("existing user outside the verified domains is invited", func(t *testing.T) {
+		var out scim.Error
+		status := suite.scimRequest(t, http.MethodPost, "/scim/v2/Users", scim.User{
+			Schemas:  []string{scim.UserSchema},
+			UserName: "yondu@ravagers.net",
+			Active:   scim.NewBool(true),
+		}, &out)
+		assert.Equal(t, http.StatusConflict, status)
+		assert.Equal(t, "uniqueness", out.ScimType)
+		assert.Contains(t, out.Detail, "invitation")
+
+		exists, err := suite.db.OrgMembership.Query().
+			Where(orgmembership.OrganizationID(orgID), orgmembership.HasUserWith(user.Email("yondu@ravagers.net"))).
+			Exist(allowCtx)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		exists, err = suite.db.Invite.Query().
+			Where(invite.OwnerID(orgID), invite.Recipient("yondu@ravagers.net")).
+			Exist(allowCtx)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("new user outside the verified domains is invited", func(t *testing.T) {
+		var out scim.Error
+		status := suite.scimRequest(t, http.MethodPost, "/scim/v2/Users", scim.User{
+			Schemas:  []string{scim.UserSchema},
+			UserName: "kraglin@ravagers.net",
+			Active:   scim.NewBool(true),
+		}, &out)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "invalidValue", out.ScimType)
+		assert.Contains(t, out.Detail, "invitation")
+
+		exists, err := suite.db.User.Query().
+			Where(user.Email("kraglin@ravagers.net")).
+			Exist(allowCtx)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		exists, err = suite.db.Invite.Query().
+			Where(invite.OwnerID(orgID), invite.Recipient("kraglin@ravagers.net")).
+			Exist(allowCtx)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("user name is required", func(t *testing.T) {
+		var out scim.Error
+		status := suite.scimRequest(t, http.MethodPost, "/scim/v2/Users", scim.User{Schemas: []string{scim.UserSchema}}, &out)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "invalidValue", out.ScimType)
+	})
+
+	t.Run("list users", func(t *testing.T) {
+		var out scim.ListResponse
+		status := suite.scimRequest(t, http.MethodGet, "/scim/v2/Users", nil, &out)
+		assert.Equal(t, http.StatusOK, status)
+
+		// the owner of the organization and the existing user are members as well
+		assert.Equal(t, 3, out.TotalResults)
+	})
+
+	t.Run("list users with filter", func(t *testing.T) {
+		var out scim.ListResponse
+		status := suite.scimRequest(t, http.MethodGet, "/scim/v2/Users?filter="+url.QueryEscape(`userName eq "STARLORD@guardians.net"`), nil, &out)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, 1, out.TotalResults)
+	})
+
+	t.Run("list users with invalid filter", func(t *testing.T) {
+		var out scim.Error
+		status := suite.scimRequest(t, http.MethodGet, "/scim/v2/Users?filter="+url.QueryEscape(`userName eq`), nil, &out)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "invalidFilter", out.ScimType)
+	})
+
+	t.Run("get user", func(t *testing.T) {
+		var out scim.User
+		status := suite.scimRequest(t, http.MethodGet, userPath, nil, &out)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, created.ID, out.ID)
+	})
+
+	t.Run("get user that is not a member", func(t *testing.T) {
+		status := suite.scimRequest(t, http.MethodGet, "/scim/v2/Users/"+ulids.New().String(), nil, nil)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("patch user name", func(t *testing.T) {
+		patch := map[string]interface{}{
+			"schemas": []string{scim.PatchOpSchema},
+			"Operations": []map[string]interface{}{
+				{"op": "replace", "path": "name.givenName", "value": "Star"},
+			},
+		}
+
+		var out scim.User
+		status := suite.scimRequest(t, http.MethodPatch, userPath, patch, &out)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "Star", out.Name.GivenName)
+		assert.Equal(t, "Quill", out.Name.FamilyName)
+	})
+
+	t.Run("patch user email is not allowed", func(t *testing.T) {
+		patch := map[string]interface{}{
+			"schemas": []string{scim.PatchOpSchema},
+			"Operations": []map[string]interface{}{
+				{"op": "replace", "path": "userName", "value": "peter@guardians.net"},
+				{"op": "replace", "path": `emails[primary eq true].value`, "value": "peter@guardians.net"},
+			},
+		}
+
+		var out scim.Error
+		status := suite.scimRequest(t, http.MethodPatch, userPath, patch, &out)
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Equal(t, "mutability", out.ScimType)
+	})
+
+	t.Run("deactivate user", func(t *testing.T) {
+		patch := map[string]interface{}{
+			"schemas": []string{scim.PatchOpSchema},
+			"Operations": []map[string]interface{}{
+				{"op": "replace", "value": map[string]interface{}{"active": "False"}},
+			},
+		}
+
+		var out scim.User
+		status := suite.scimRequest(t, http.MethodPatch, userPath, patch, &out)
+		assert.Equal(t, http.StatusOK, status)
+		assert.False(t, out.IsActive())
+
+		status = suite.scimRequest(t, http.MethodGet, userPath, nil, nil)
+		assert.Equal(t, http.StatusNotFound, status)
+
+		// the membership is soft deleted and the user is kept
+		exists, err := suite.db.OrgMembership.Query().
+			Where(orgmembership.UserID(created.ID), orgmembership.OrganizationID(orgID)).
+			Exist(ownerCtx)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		_, err = suite.db.User.Get(ownerCtx, created.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("reprovision user", func(t *testing.T) {
+		var out scim.User
+		status := suite.scimRequest(t, http.MethodPost, "/scim/v2/Users", starlord, &out)
+		assert.Equal(t, http.StatusCreated, status)
+		assert.Equal(t, created.ID, out.ID)
+	})
+
+	t.Run("delete user", func(t *testing.T) {
+		status := suite.scimRequest(t, http.MethodDelete, userPath, nil, nil)
+		assert.Equal(t, http.StatusNoContent, status)
+
+		status = suite.scimRequest(t, http.MethodGet, userPath, nil, nil)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+}
+
+func (suite *HandlerTestSuite) TestSCIMRequiresAPIToken() {
+	t := suite.T()
+
+	suite.registerSCIMRoutes("")
+
+	var out scim.Error
+	status := suite.scimRequest(t, http.MethodGet, "/scim/v2/Users", nil, &out)
+	assert.Equal(t, http.StatusForbidden, status)
+	assert.Equal(t, "403", out.Status)
+	assert.Contains(t, out.Detail, "api token")
+}
+
+func (suite *HandlerTestSuite) TestSCIMGroupsHandler() {
+	t := suite.T()
+
+	mock_fga.WriteAny(t, suite.fga)
+	mock_fga.CheckAny(t, suite.fga, true)
+	mock_fga.ListAny(t, suite.fga, []string{})
+
+	orgID, ownerCtx := suite.createSSOOrg("guardians")
+
+	suite.db.OrganizationSetting.Update().
+		Where(organizationsetting.OrganizationID(orgID)).
+		SetVerifiedDomains([]string{"guardians.net"}).
+		ExecX(ownerCtx)
+
+	suite.registerSCIMRoutes(orgID)
+
+	var groot scim.User
+	status := suite.scimRequest(t, http.MethodPost, "/scim/v2/Users", scim.User{
+		Schemas:  []string{scim.UserSchema},
+		UserName: "groot@guardians.net",
+	}, &groot)
+	require.Equal(t, http.StatusCreated, status)
+
+	t.Run("members must be members of the organization", func(t *testing.T) {
+		var out scim.Error
+		status := suite.scimRequest(t, http.MethodPost, "/scim/v2/Groups", scim.Group{
+			Schemas:     []string{scim.GroupSchema},
+			DisplayName: "ravagers",
+			Members:     []scim.Member{{Value: ulids.New().String()}},
+		}, &out)
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	var created scim.Group
+	status = suite.scimRequest(t, http.MethodPost, "/scim/v2/Groups", scim.Group{
+		Schemas:     []string{scim.GroupSchema},
+		DisplayName: "guardians",
+		Members:     []scim.Member{{Value: groot.ID}},
+	}, &created)
+	require.Equal(t, http.StatusCreated, status)
+
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, "guardians", created.DisplayName)
+	require.Len(t, created.Members, 1)
+	assert.Equal(t, "groot@guardians.net", created.Members[0].Display)
+
+	// allow access to the created group
+	mock_fga.ClearMocks(suite.fga)
+	mock_fga.WriteAny(t, suite.fga)
+	mock_fga.ReadAny(t, suite.fga)
+	mock_fga.ListAny(t, suite.fga, []string{"group:" + created.ID})
+
+	groupPath := "/scim/v2/Groups/" + created.ID
+
+	t.Run("list groups with filter", func(t *testing.T) {
+		var out scim.ListResponse
+		status := suite.scimRequest(t, http.MethodGet, "/scim/v2/Groups?filter="+url.QueryEscape(`members[value eq "`+groot.ID+`"]`), nil, &out)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, 1, out.TotalResults)
+	})
+
+	t.Run("remove member", func(t *testing.T) {
+		patch := map[string]interface{}{
+			"schemas": []string{scim.PatchOpSchema},
+			"Operations": []map[string]interface{}{
+				{"op": "remove", "path": `members[value eq "` + groot.ID + `"]`},
+			},
+		}
+
+		var out scim.Group
+		status := suite.scimRequest(t, http.MethodPatch, groupPath, patch, &out)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Empty(t, out.Members)
+
+		exists, err := suite.db.GroupMembership.Query().
+			Where(groupmembership.GroupID(created.ID)).
+			Exist(ownerCtx)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("add member and rename group", func(t *testing.T) {
+		patch := map[string]interface{}{
+			"schemas": []string{scim.PatchOpSchema},
+			"Operations": []map[string]interface{}{
+				{"op": "add", "path": "members", "value": []map[string]string{{"value": groot.ID}}},
+				{"op": "replace", "path": "displayName", "value": "guardians of the galaxy"},
+			},
+		}
+
+		var out scim.Group
+		status := suite.scimRequest(t, http.MethodPatch, groupPath, patch, &out)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "guardians of the galaxy", out.DisplayName)
+		assert.Equal(t, []string{groot.ID}, out.MemberIDs())
+	})
+
+	t.Run("deprovisioned users are removed from groups", func(t *testing.T) {
+		status := suite.scimRequest(t, http.MethodDelete, "/scim/v2/Users/"+groot.ID, nil, nil)
+		require.Equal(t, http.StatusNoContent, status)
+
+		var out scim.Group
+		status = suite.scimRequest(t, http.MethodGet, groupPath, nil, &out)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Empty(t, out.Members)
+	})
+
+	t.Run("delete group", func(t *testing.T) {
+		status := suite.scimRequest(t, http.MethodDelete, groupPath, nil, nil)
+		assert.Equal(t, http.StatusNoContent, status)
+
+		status = suite.scimRequest(t, http.MethodGet, groupPath, nil, nil)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+}
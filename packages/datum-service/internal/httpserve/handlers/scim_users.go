@@ -0,0 +1,465 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	echo "github.com/datumforge/echox"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/group"
+	"github.com/datumforge/datum/internal/ent/generated/groupmembership"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/ent/generated/usersetting"
+	"github.com/datumforge/datum/internal/ent/privacy/token"
+	"github.com/datumforge/datum/pkg/enums"
+	"github.com/datumforge/datum/pkg/middleware/transaction"
+	"github.com/datumforge/datum/pkg/scim"
+)
+
+// scimUsersPath is the path of the SCIM user resources
+const scimUsersPath = "Users"
+
+// SCIMListUsersHandler lists the members of the organization of the api token as SCIM users
+func (h *Handler) SCIMListUsersHandler(ctx echo.Context) error {
+	in, err := h.bindSCIMRequest(ctx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.scimOrganization(reqCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	members, err := transaction.FromContext(reqCtx).OrgMembership.Query().
+		Where(orgmembership.OrganizationID(org.ID)).
+		WithUser().
+		Order(ent.Asc(orgmembership.FieldCreatedAt)).
+		All(privacy.DecisionContext(reqCtx, privacy.Allow))
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	users := []scim.User{}
+
+	for _, m := range members {
+		if m.Edges.User != nil {
+			users = append(users, toSCIMUser(ctx, m.Edges.User))
+		}
+	}
+
+	users, err = scim.FilterResources(users, in.Filter)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	return h.scimResponse(ctx, http.StatusOK, scim.NewListResponse(users, in.StartIndex, *in.Count))
+}
+
+// SCIMGetUserHandler returns the member of the organization as a SCIM user
+func (h *Handler) SCIMGetUserHandler(ctx echo.Context) error {
+	in, err := h.bindSCIMRequest(ctx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.scimOrganization(reqCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	member, err := h.getSCIMMember(reqCtx, org.ID, in.ID)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	return h.scimResponse(ctx, http.StatusOK, toSCIMUser(ctx, member.Edges.User))
+}
+
+// SCIMCreateUserHandler provisions the SCIM user as a member of the organization, the user account is created with
+// the single sign-on provider of the organization when there is no account with the email address yet, existing
+// accounts outside the verified domains of the organization are invited instead
+func (h *Handler) SCIMCreateUserHandler(ctx echo.Context) error {
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.scimOrganization(reqCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	var in scim.User
+	if err := decodeSCIMBody(ctx, &in); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	email := in.PrimaryEmail()
+	if email == "" {
+		return h.scimRequestError(ctx, fmt.Errorf("%w: userName is required", scim.ErrInvalidValue))
+	}
+
+	if !in.IsActive() {
+		return h.scimRequestError(ctx, fmt.Errorf("%w: users must be active when they are provisioned", scim.ErrInvalidValue))
+	}
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	exists, err := transaction.FromContext(reqCtx).OrgMembership.Query().
+		Where(
+			orgmembership.OrganizationID(org.ID),
+			orgmembership.HasUserWith(user.EmailEqualFold(email)),
+		).
+		Exist(allowCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	if exists {
+		return h.scimRequestError(ctx, fmt.Errorf("%w: %s", scim.ErrUniqueness, email))
+	}
+
+	entUser, err := h.provisionSCIMUser(reqCtx, org, &in)
+	if err != nil {
+		// the responses are not errors of the transaction, the invitation must be kept
+		if errors.Is(err, ErrSCIMUserInvited) {
+			return h.scimError(ctx, http.StatusConflict, fmt.Errorf("%w: %w", scim.ErrUniqueness, err))
+		}
+
+		if errors.Is(err, ErrSCIMDomainNotVerified) {
+			return h.scimError(ctx, http.StatusBadRequest, fmt.Errorf("%w: %w", scim.ErrInvalidValue, err))
+		}
+
+		return h.scimRequestError(ctx, err)
+	}
+
+	return h.scimResponse(ctx, http.StatusCreated, toSCIMUser(ctx, entUser))
+}
+
+// SCIMReplaceUserHandler replaces the attributes of the SCIM user, deactivated users are deprovisioned
+func (h *Handler) SCIMReplaceUserHandler(ctx echo.Context) error {
+	in, err := h.bindSCIMRequest(ctx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.scimOrganization(reqCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	var u scim.User
+	if err := decodeSCIMBody(ctx, &u); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	member, err := h.getSCIMMember(reqCtx, org.ID, in.ID)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	return h.updateSCIMUser(ctx, member, &u)
+}
+
+// SCIMPatchUserHandler applies the patch operations to the SCIM user, deactivated users are deprovisioned
+func (h *Handler) SCIMPatchUserHandler(ctx echo.Context) error {
+	in, err := h.bindSCIMRequest(ctx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.scimOrganization(reqCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	var patch scim.PatchRequest
+	if err := decodeSCIMBody(ctx, &patch); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	member, err := h.getSCIMMember(reqCtx, org.ID, in.ID)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	u := toSCIMUser(ctx, member.Edges.User)
+	if err := patch.Apply(&u); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	return h.updateSCIMUser(ctx, member, &u)
+}
+
+// SCIMDeleteUserHandler deprovisions the SCIM user from the organization
+func (h *Handler) SCIMDeleteUserHandler(ctx echo.Context) error {
+	in, err := h.bindSCIMRequest(ctx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.scimOrganization(reqCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	member, err := h.getSCIMMember(reqCtx, org.ID, in.ID)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	if err := h.deprovisionSCIMUser(reqCtx, member); err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// updateSCIMUser updates the member with the attributes of the SCIM user and writes the updated user, the profile
+// of the user is only updated when the organization created the user and is the only organization the user is a
+// member of
+func (h *Handler) updateSCIMUser(ctx echo.Context, member *ent.OrgMembership, u *scim.User) error {
+	reqCtx := ctx.Request().Context()
+	entUser := member.Edges.User
+
+	if !strings.EqualFold(u.PrimaryEmail(), entUser.Email) {
+		return h.scimRequestError(ctx, fmt.Errorf("%w: userName", scim.ErrMutability))
+	}
+
+	if !u.IsActive() {
+		if err := h.deprovisionSCIMUser(reqCtx, member); err != nil {
+			return h.scimRequestError(ctx, err)
+		}
+
+		res := toSCIMUser(ctx, entUser)
+		res.Active = scim.NewBool(false)
+
+		return h.scimResponse(ctx, http.StatusOK, res)
+	}
+
+	// the organization only manages the profile of users it created
+	if !member.UserProvisioned {
+		return h.scimResponse(ctx, http.StatusOK, toSCIMUser(ctx, entUser))
+	}
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	// the organization does not own the profile of users that are also members of other organizations
+	shared, err := transaction.FromContext(reqCtx).OrgMembership.Query().
+		Where(
+			orgmembership.UserID(entUser.ID),
+			orgmembership.OrganizationIDNEQ(member.OrganizationID),
+			orgmembership.HasOrganizationWith(organization.PersonalOrg(false)),
+		).
+		Exist(allowCtx)
+	if err != nil {
+		return h.scimRequestError(ctx, err)
+	}
+
+	if !shared {
+		update := transaction.FromContext(reqCtx).User.UpdateOneID(entUser.ID)
+
+		if u.Name != nil {
+			update.SetFirstName(u.Name.GivenName).SetLastName(u.Name.FamilyName)
+		}
+
+		if u.DisplayName != "" {
+			update.SetDisplayName(u.DisplayName)
+		}
+
+		entUser, err = update.Save(allowCtx)
+		if err != nil {
+			return h.scimRequestError(ctx, err)
+		}
+	}
+
+	return h.scimResponse(ctx, http.StatusOK, toSCIMUser(ctx, entUser))
+}
+
+// getSCIMMember returns the active membership of the user in the organization with the user
+func (h *Handler) getSCIMMember(ctx context.Context, orgID, userID string) (*ent.OrgMembership, error) {
+	return transaction.FromContext(ctx).OrgMembership.Query().
+		Where(
+			orgmembership.OrganizationID(orgID),
+			orgmembership.UserID(userID),
+		).
+		WithUser().
+		Only(privacy.DecisionContext(ctx, privacy.Allow))
+}
+
+// provisionSCIMUser adds the SCIM user to the organization, users are only created or added when the email is in a
+// verified domain of the organization, other users are sent an invitation to join the organization instead. New
+// users are created with the single sign-on provider of the organization; SAML is used when the organization has
+// SAML metadata configured
+func (h *Handler) provisionSCIMUser(ctx context.Context, org *ent.Organization, u *scim.User) (*ent.User, error) {
+	email := u.PrimaryEmail()
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	inDomain := org.Edges.Setting != nil && emailInDomains(email, org.Edges.Setting.VerifiedDomains)
+
+	existing, err := transaction.FromContext(ctx).User.Query().
+		Where(user.EmailEqualFold(email)).
+		Order(ent.Asc(user.FieldCreatedAt)).
+		First(allowCtx)
+	if err == nil {
+		if !inDomain {
+			if err := h.inviteSCIMUser(ctx, org.ID, existing.Email); err != nil {
+				return nil, err
+			}
+
+			return nil, ErrSCIMUserInvited
+		}
+
+		return existing, h.addOrgMember(ctx, org.ID, existing.ID)
+	}
+
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	// the organization does not own the domain, so it cannot create an account for the email address
+	if !inDomain {
+		if err := h.inviteSCIMUser(ctx, org.ID, email); err != nil {
+			return nil, err
+		}
+
+		return nil, ErrSCIMDomainNotVerified
+	}
+
+	provider := enums.AuthProviderOIDC
+	if s := org.Edges.Setting; s != nil && (s.SamlMetadata != "" || s.SamlMetadataURL != "") {
+		provider = enums.AuthProviderSAML
+	}
+
+	input := ent.CreateUserInput{
+		Email:        email,
+		AuthProvider: &provider,
+		DisplayName:  u.DisplayName,
+	}
+
+	if u.Name != nil {
+		input.FirstName = &u.Name.GivenName
+		input.LastName = &u.Name.FamilyName
+	}
+
+	// the request is authenticated with the api token, which cannot read the new user without an allow decision
+	ctxWithToken := token.NewContextWithOauthTooToken(ctx, email)
+
+	entUser, err := h.createUser(privacy.DecisionContext(ctxWithToken, privacy.Allow), input)
+	if err != nil {
+		return nil, err
+	}
+
+	// the organization created the account, so it manages the profile of the user
+	if err := transaction.FromContext(ctx).OrgMembership.Create().
+		SetUserID(entUser.ID).
+		SetOrganizationID(org.ID).
+		SetRole(enums.RoleMember).
+		SetUserProvisioned(true).
+		Exec(allowCtx); err != nil {
+		return nil, err
+	}
+
+	return entUser, nil
+}
+
+// inviteSCIMUser sends an invitation to join the organization to an existing user, the user must accept the
+// invitation before they are a member of the organization
+func (h *Handler) inviteSCIMUser(ctx context.Context, orgID, email string) error {
+	input := ent.CreateInviteInput{
+		Recipient: email,
+		Role:      &enums.RoleMember,
+		OwnerID:   &orgID,
+	}
+
+	return transaction.FromContext(ctx).Invite.Create().
+		SetInput(input).
+		Exec(privacy.DecisionContext(ctx, privacy.Allow))
+}
+
+// deprovisionSCIMUser removes the user from the organization and its groups, the memberships are soft deleted and
+// the user account is kept because it can be used with other organizations
+func (h *Handler) deprovisionSCIMUser(ctx context.Context, member *ent.OrgMembership) error {
+	// the api token is not a member of the organization, the access is checked by the scope of the token
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+	tx := transaction.FromContext(ctx)
+
+	if _, err := tx.GroupMembership.Delete().
+		Where(
+			groupmembership.UserID(member.UserID),
+			groupmembership.HasGroupWith(group.OwnerID(member.OrganizationID)),
+		).
+		Exec(allowCtx); err != nil {
+		return err
+	}
+
+	if err := tx.OrgMembership.DeleteOneID(member.ID).Exec(allowCtx); err != nil {
+		return err
+	}
+
+	// the user can no longer access the organization, switch their default organization back to their personal one
+	setting, err := tx.UserSetting.Query().
+		Where(usersetting.UserID(member.UserID)).
+		WithDefaultOrg().
+		Only(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	if setting.Edges.DefaultOrg != nil && setting.Edges.DefaultOrg.ID != member.OrganizationID {
+		return nil
+	}
+
+	personalOrgID, err := tx.Organization.Query().
+		Where(
+			organization.PersonalOrg(true),
+			organization.HasMembersWith(orgmembership.UserID(member.UserID)),
+		).
+		FirstID(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	return tx.UserSetting.UpdateOneID(setting.ID).SetDefaultOrgID(personalOrgID).Exec(allowCtx)
+}
+
+// toSCIMUser returns the SCIM representation of the user, only active members of the organization are returned
+func toSCIMUser(ctx echo.Context, u *ent.User) scim.User {
+	created, updated := u.CreatedAt, u.UpdatedAt
+
+	return scim.User{
+		Schemas:  []string{scim.UserSchema},
+		ID:       u.ID,
+		UserName: u.Email,
+		Name: &scim.Name{
+			GivenName:  u.FirstName,
+			FamilyName: u.LastName,
+		},
+		DisplayName: u.DisplayName,
+		Emails: []scim.Email{
+			{Value: u.Email, Type: "work", Primary: true},
+		},
+		Active: scim.NewBool(true),
+		Meta: &scim.Meta{
+			ResourceType: scim.ResourceTypeUser,
+			Created:      &created,
+			LastModified: &updated,
+			Location:     scimLocation(ctx, scimUsersPath, u.ID),
+		},
+	}
+}
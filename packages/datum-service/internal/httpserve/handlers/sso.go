@@ -0,0 +1,540 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	echo "github.com/datumforge/echox"
+	"golang.org/x/oauth2"
+
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/oauthprovider"
+	"github.com/datumforge/datum/internal/ent/generated/organization"
+	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/ent/privacy/token"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/enums"
+	"github.com/datumforge/datum/pkg/keygen"
+	authmw "github.com/datumforge/datum/pkg/middleware/auth"
+	"github.com/datumforge/datum/pkg/middleware/transaction"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/providers/oidc"
+	"github.com/datumforge/datum/pkg/sessions"
+)
+
+const (
+	ssoProvider = "oidc"
+
+	// ssoStateLength is the length of the random state and nonce values sent to the provider
+	ssoStateLength = 32
+
+	// ssoRequestTimeout is the timeout of the requests to the single sign-on provider
+	ssoRequestTimeout = 10 * time.Second
+)
+
+// defaultSSOClient is used for requests to the single sign-on providers when the handler does not set a client, the
+// endpoints of the providers are configured by the organizations so only public addresses are allowed
+var defaultSSOClient = oidc.NewPublicClient(ssoRequestTimeout)
+
+// SSOLoginHandler redirects the user to the single sign-on provider configured by the organization in the request
+func (h *Handler) SSOLoginHandler(ctx echo.Context) error {
+	var in models.SSORequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.getSSOOrganization(reqCtx, in.Organization)
+	if err != nil {
+		return h.ssoError(ctx, err)
+	}
+
+	config, _, err := h.ssoOauth2Config(h.ssoContext(reqCtx), org.Edges.Oauthprovider[0])
+	if err != nil {
+		h.Logger.Errorw("unable to configure sso provider", "error", err, "organization_id", org.ID)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	state := keygen.GenerateRandomString(ssoStateLength)
+	nonce := keygen.GenerateRandomString(ssoStateLength)
+
+	w := ctx.Response().Writer
+	http.SetCookie(w, sessions.NewCookie(oidc.StateCookieName, state, h.SessionConfig.CookieConfig))
+	http.SetCookie(w, sessions.NewCookie(oidc.NonceCookieName, nonce, h.SessionConfig.CookieConfig))
+
+	// keep the redirect of the UI for the callback
+	if in.RedirectURI != "" {
+		http.SetCookie(w, sessions.NewCookie("redirect_to", in.RedirectURI, h.SessionConfig.CookieConfig))
+	}
+
+	return ctx.Redirect(http.StatusFound, oidc.AuthCodeURL(config, state, nonce))
+}
+
+// SSOCallbackHandler exchanges the code returned by the organization single sign-on provider, provisions the user
+// into the organization on their first login and issues a session for the organization
+func (h *Handler) SSOCallbackHandler(ctx echo.Context) error {
+	var in models.SSORequest
+	if err := ctx.Bind(&in); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	if err := in.Validate(); err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	org, err := h.getSSOOrganization(reqCtx, in.Organization)
+	if err != nil {
+		return h.ssoError(ctx, err)
+	}
+
+	provider := org.Edges.Oauthprovider[0]
+
+	config, oidcProvider, err := h.ssoOauth2Config(h.ssoContext(reqCtx), provider)
+	if err != nil {
+		h.Logger.Errorw("unable to configure sso provider", "error", err, "organization_id", org.ID)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	claims, err := h.ssoClaims(ctx, config, oidcProvider, provider.InfoURL)
+	if err != nil {
+		h.Logger.Errorw("unable to get user from sso provider", "error", err, "organization_id", org.ID)
+
+		// the errors of the provider can contain its responses, so they are not returned
+		if errors.Is(err, ErrInvalidSSOState) {
+			return h.BadRequest(ctx, err)
+		}
+
+		return h.BadRequest(ctx, ErrSSOLoginFailed)
+	}
+
+	// we need the email to keep going, if its not there error the request
+	if claims.Email == "" {
+		return h.BadRequest(ctx, ErrNoEmailFound)
+	}
+
+	// the identity is scoped to the issuer of the provider, oauth2 providers without an issuer are scoped to the
+	// provider itself
+	issuer := provider.Issuer
+	if issuer == "" {
+		issuer = provider.ID
+	}
+
+	entUser, err := h.provisionSSOUser(ctx, org, ssoIdentity{
+		issuer:        issuer,
+		subject:       claims.Subject,
+		email:         claims.Email,
+		emailVerified: claims.EmailVerified,
+		name:          claims.Name,
+		image:         claims.Picture,
+		provider:      enums.AuthProviderOIDC,
+	})
+	if err != nil {
+		if isSSOIdentityError(err) {
+			return h.BadRequest(ctx, err)
+		}
+
+		h.Logger.Errorw("unable to provision sso user", "error", err, "organization_id", org.ID)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	ctxWithToken := token.NewContextWithOauthTooToken(reqCtx, claims.Email)
+
+	oauthReq := models.OauthTokenRequest{
+		Email:            claims.Email,
+		ExternalUserName: claims.Name,
+		ExternalUserID:   claims.Subject,
+		AuthProvider:     ssoProvider,
+	}
+
+	authData, err := h.AuthManager.GenerateOauthAuthSessionWithOrg(ctxWithToken, ctx.Response().Writer, entUser, oauthReq, org.ID)
+	if err != nil {
+		h.Logger.Errorw("unable create new auth session", "error", err)
+
+		return h.InternalServerError(ctx, err)
+	}
+
+	redirectURI := h.OauthProvider.RedirectURL
+	if redirect, err := ctx.Cookie("redirect_to"); err == nil && redirect.Value != "" {
+		redirectURI = redirect.Value
+	}
+
+	// remove the cookies of the flow now that the login is complete
+	for _, name := range []string{oidc.StateCookieName, oidc.NonceCookieName, "redirect_to"} {
+		sessions.RemoveCookie(ctx.Response().Writer, name, *h.SessionConfig.CookieConfig)
+	}
+
+	return ctx.Redirect(http.StatusFound, fmt.Sprintf("%s?session=%s", redirectURI, authData.Session))
+}
+
+// ssoError returns the response for errors looking up the organization single sign-on provider
+func (h *Handler) ssoError(ctx echo.Context, err error) error {
+	if ent.IsNotFound(err) || errors.Is(err, ErrSSONotConfigured) {
+		return h.NotFound(ctx, ErrSSONotConfigured)
+	}
+
+	h.Logger.Errorw("unable to get sso organization", "error", err)
+
+	return h.InternalServerError(ctx, ErrProcessingRequest)
+}
+
+// getSSOOrganization returns the organization by id or name with its settings and the oauth provider used for single sign-on,
+// the oldest provider of the organization is used when there is more than one
+func (h *Handler) getSSOOrganization(ctx context.Context, org string) (*ent.Organization, error) {
+	// the request is not authenticated, allow the lookup of the organization and its provider
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	o, err := transaction.FromContext(ctx).Organization.Query().
+		Where(
+			organization.Or(organization.ID(org), organization.Name(org)),
+			organization.PersonalOrg(false),
+		).
+		WithSetting().
+		Only(allowCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := transaction.FromContext(ctx).OauthProvider.Query().
+		Where(oauthprovider.OwnerID(o.ID)).
+		Order(ent.Asc(oauthprovider.FieldCreatedAt)).
+		First(token.NewContextWithSSOToken(allowCtx, o.ID))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrSSONotConfigured
+		}
+
+		return nil, err
+	}
+
+	o.Edges.Oauthprovider = []*ent.OauthProvider{provider}
+
+	return o, nil
+}
+
+// ssoContext returns the context with the HTTP client used for requests to the single sign-on provider
+func (h *Handler) ssoContext(ctx context.Context) context.Context {
+	client := h.SSOHTTPClient
+	if client == nil {
+		client = defaultSSOClient
+	}
+
+	return oidc.ClientContext(ctx, client)
+}
+
+// ssoOauth2Config builds the oauth2 config from the organization provider, the endpoints are discovered
+// when the provider has an OIDC issuer
+func (h *Handler) ssoOauth2Config(ctx context.Context, provider *ent.OauthProvider) (*oauth2.Config, *gooidc.Provider, error) {
+	config := &oauth2.Config{
+		ClientID:     provider.ClientID,
+		ClientSecret: provider.ClientSecret,
+		RedirectURL:  provider.RedirectURL,
+		Scopes: strings.FieldsFunc(provider.Scopes, func(r rune) bool {
+			return r == ',' || unicode.IsSpace(r)
+		}),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   provider.AuthURL,
+			TokenURL:  provider.TokenURL,
+			AuthStyle: oauth2.AuthStyle(provider.AuthStyle),
+		},
+	}
+
+	if provider.Issuer == "" {
+		return config, nil, nil
+	}
+
+	oidcProvider, err := oidc.Discover(ctx, provider.Issuer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := oidcProvider.Endpoint()
+	endpoint.AuthStyle = config.Endpoint.AuthStyle
+	config.Endpoint = endpoint
+
+	if !slices.Contains(config.Scopes, gooidc.ScopeOpenID) {
+		config.Scopes = append(config.Scopes, gooidc.ScopeOpenID)
+	}
+
+	return config, oidcProvider, nil
+}
+
+// ssoClaims exchanges the code in the callback request and returns the claims of the user, from the verified
+// ID token for OIDC providers or from the userinfo endpoint for oauth2 providers
+func (h *Handler) ssoClaims(ctx echo.Context, config *oauth2.Config, oidcProvider *gooidc.Provider, infoURL string) (*oidc.Claims, error) {
+	req := ctx.Request()
+	reqCtx := h.ssoContext(req.Context())
+
+	if oidcProvider != nil {
+		u, err := oidc.ExchangeCode(reqCtx, req, config, oidcProvider)
+		if err != nil {
+			return nil, err
+		}
+
+		return u.IDToken.Claims, nil
+	}
+
+	state, err := req.Cookie(oidc.StateCookieName)
+	if err != nil || state.Value == "" || req.URL.Query().Get("state") != state.Value {
+		return nil, ErrInvalidSSOState
+	}
+
+	tok, err := config.Exchange(reqCtx, req.URL.Query().Get("code"))
+	if err != nil {
+		return nil, err
+	}
+
+	return oidc.UserInfo(reqCtx, config, tok, infoURL)
+}
+
+// addOrgMember adds the user as a member of the organization if they are not already a member
+func (h *Handler) addOrgMember(ctx context.Context, orgID, userID string) error {
+	// the user is not yet a member, so the membership cannot be authorized by their own permissions
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	exists, err := transaction.FromContext(ctx).OrgMembership.Query().
+		Where(
+			orgmembership.OrganizationID(orgID),
+			orgmembership.UserID(userID),
+		).
+		Exist(allowCtx)
+	if err != nil || exists {
+		return err
+	}
+
+	input := ent.CreateOrgMembershipInput{
+		UserID:         userID,
+		OrganizationID: orgID,
+		Role:           &enums.RoleMember,
+	}
+
+	_, err = transaction.FromContext(ctx).OrgMembership.Create().SetInput(input).Save(allowCtx)
+
+	return err
+}
+
+// ssoIdentity is the identity of a user asserted by the single sign-on provider of an organization
+type ssoIdentity struct {
+	// issuer of the identity, the OIDC issuer or the entity id of the SAML identity provider
+	issuer string
+	// subject of the user at the issuer
+	subject       string
+	email         string
+	emailVerified bool
+	name          string
+	image         string
+	provider      enums.AuthProvider
+}
+
+// isSSOIdentityError returns true if the error was returned because the single sign-on identity cannot be used to
+// login to the organization
+func isSSOIdentityError(err error) bool {
+	return isUserStatusError(err) ||
+		errors.Is(err, ErrSSOIdentityMissing) ||
+		errors.Is(err, ErrSSOEmailNotVerified) ||
+		errors.Is(err, ErrSSODomainNotVerified) ||
+		errors.Is(err, ErrSSOAccountNotLinked)
+}
+
+// provisionSSOUser returns the member of the organization linked to the single sign-on identity. On the first login
+// of the identity it is linked to the member with the same email that does not have an identity yet, or a new user
+// is created and added to the organization; existing users that are not members of the organization are never
+// linked. The email must be verified by the provider and in one of the verified domains of the organization
+func (h *Handler) provisionSSOUser(ctx echo.Context, org *ent.Organization, id ssoIdentity) (*ent.User, error) {
+	if id.issuer == "" || id.subject == "" {
+		return nil, ErrSSOIdentityMissing
+	}
+
+	if !id.emailVerified {
+		return nil, ErrSSOEmailNotVerified
+	}
+
+	if org.Edges.Setting == nil || !emailInDomains(id.email, org.Edges.Setting.VerifiedDomains) {
+		return nil, ErrSSODomainNotVerified
+	}
+
+	reqCtx := ctx.Request().Context()
+	ctxWithToken := token.NewContextWithOauthTooToken(reqCtx, id.email)
+
+	// the user is not authenticated yet, allow the lookup of the users linked to the organization
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	linked, err := transaction.FromContext(reqCtx).User.Query().
+		Where(
+			user.HasOrgMembershipsWith(
+				orgmembership.OrganizationID(org.ID),
+				orgmembership.SSOIssuer(id.issuer),
+				orgmembership.SSOSubject(id.subject),
+			),
+		).
+		WithSetting().
+		Only(allowCtx)
+	if err == nil {
+		return h.ssoLogin(ctxWithToken, linked, id.image)
+	}
+
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	// accounts with the email of any auth provider are only linked when they are already members
+	existing, err := transaction.FromContext(reqCtx).User.Query().
+		Where(user.Email(id.email)).
+		WithSetting().
+		All(allowCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(existing) > 0 {
+		for _, u := range existing {
+			err := h.linkSSOIdentity(ctx, org.ID, u, id)
+			if err == nil {
+				return h.ssoLogin(ctxWithToken, u, id.image)
+			}
+
+			if !errors.Is(err, ErrSSOAccountNotLinked) {
+				return nil, err
+			}
+		}
+
+		return nil, ErrSSOAccountNotLinked
+	}
+
+	entUser, err := h.createUser(ctxWithToken, createUserInput(id.name, id.email, id.provider, id.image))
+	if err != nil {
+		return nil, err
+	}
+
+	// set context for the membership based on the provisioned user
+	userCtx := auth.AddAuthenticatedUserContext(ctx, &auth.AuthenticatedUser{
+		SubjectID: entUser.ID,
+	})
+
+	input := ent.CreateOrgMembershipInput{
+		UserID:         entUser.ID,
+		OrganizationID: org.ID,
+		Role:           &enums.RoleMember,
+	}
+
+	if err := transaction.FromContext(userCtx).OrgMembership.Create().
+		SetInput(input).
+		SetSSOIssuer(id.issuer).
+		SetSSOSubject(id.subject).
+		Exec(privacy.DecisionContext(userCtx, privacy.Allow)); err != nil {
+		return nil, err
+	}
+
+	return entUser, nil
+}
+
+// linkSSOIdentity links the single sign-on identity to the membership of an existing user, the user must already be
+// a member of the organization without an identity; users are added to an organization with an invite, so the
+// identity cannot be used to gain access to an account that was not granted to the organization
+func (h *Handler) linkSSOIdentity(ctx echo.Context, orgID string, u *ent.User, id ssoIdentity) error {
+	// set context for the update based on the user authorized to the organization
+	userCtx := auth.AddAuthenticatedUserContext(ctx, &auth.AuthenticatedUser{
+		SubjectID:       u.ID,
+		OrganizationID:  orgID,
+		OrganizationIDs: []string{orgID},
+	})
+
+	allowCtx := privacy.DecisionContext(userCtx, privacy.Allow)
+
+	// only a membership that is not linked to another identity of the organization is updated
+	member, err := transaction.FromContext(userCtx).OrgMembership.Query().
+		Where(
+			orgmembership.OrganizationID(orgID),
+			orgmembership.UserID(u.ID),
+			orgmembership.SSOSubjectIsNil(),
+		).
+		Only(allowCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrSSOAccountNotLinked
+		}
+
+		return err
+	}
+
+	// the role is required on updates of memberships, it is not changed
+	return transaction.FromContext(userCtx).OrgMembership.UpdateOne(member).
+		SetRole(member.Role).
+		SetSSOIssuer(id.issuer).
+		SetSSOSubject(id.subject).
+		Exec(allowCtx)
+}
+
+// ssoLogin checks the user linked to the single sign-on identity is allowed to authenticate and updates their
+// last seen and avatar
+func (h *Handler) ssoLogin(ctx context.Context, u *ent.User, image string) (*ent.User, error) {
+	if err := authmw.CheckUserSetting(u.Edges.Setting); err != nil {
+		return nil, err
+	}
+
+	if err := h.updateUserLastSeen(ctx, u.ID); err != nil {
+		return nil, err
+	}
+
+	if err := h.updateUserAvatar(ctx, u, image); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// emailInDomains returns true if the domain of the email address is one of the domains
+func emailInDomains(email string, domains []string) bool {
+	_, domain, found := strings.Cut(email, "@")
+	if !found {
+		return false
+	}
+
+	return slices.ContainsFunc(domains, func(d string) bool {
+		return strings.EqualFold(d, domain)
+	})
+}
+
+// checkSSOEnforced returns an error if the user is a member of an organization that enforces single sign-on
+// for the verified domain of the user's email address
+func (h *Handler) checkSSOEnforced(ctx context.Context, u *ent.User) error {
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	orgs, err := transaction.FromContext(ctx).Organization.Query().
+		Where(
+			organization.HasUsersWith(user.ID(u.ID)),
+			organization.HasSettingWith(organizationsetting.SSOEnforced(true)),
+		).
+		WithSetting().
+		All(allowCtx)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range orgs {
+		if emailInDomains(u.Email, o.Edges.Setting.VerifiedDomains) {
+			return ErrSSORequired
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,507 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	mock_fga "github.com/datumforge/fgax/mockery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/generated/organizationsetting"
+	"github.com/datumforge/datum/internal/ent/generated/orgmembership"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/user"
+	"github.com/datumforge/datum/internal/httpserve/handlers"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/datumclient"
+	"github.com/datumforge/datum/pkg/enums"
+	"github.com/datumforge/datum/pkg/httpsling"
+	"github.com/datumforge/datum/pkg/middleware/echocontext"
+	"github.com/datumforge/datum/pkg/models"
+	"github.com/datumforge/datum/pkg/providers/oidc"
+	"github.com/datumforge/datum/pkg/rout"
+)
+
+// newTestSSOProvider returns a test oauth2 provider with token and userinfo endpoints returning the claims
+func newTestSSOProvider(claims *oidc.Claims) *httptest.Server {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(httpsling.HeaderContentType, httpsling.ContentTypeJSONUTF8)
+		_, _ = w.Write([]byte(`{"access_token":"meow","token_type":"bearer","expires_in":3600}`))
+	})
+
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(httpsling.HeaderContentType, httpsling.ContentTypeJSONUTF8)
+		_ = json.NewEncoder(w).Encode(claims)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+// createSSOOrg creates an organization owned by a new user and returns the organization id with
+// the context of the owner authenticated to the organization
+func (suite *HandlerTestSuite) createSSOOrg(name string) (string, context.Context) {
+	t := suite.T()
+
+	ctx := privacy.DecisionContext(echocontext.NewTestEchoContext().Request().Context(), privacy.Allow)
+
+	owner := suite.db.User.Create().
+		SetEmail("owner@" + name + ".net").
+		SetFirstName("Nebula").
+		SetLastName("Owner").
+		SaveX(ctx)
+
+	reqCtx, err := userContextWithID(owner.ID)
+	require.NoError(t, err)
+
+	org, err := suite.datum.CreateOrganization(reqCtx, datumclient.CreateOrganizationInput{
+		Name: name,
+	})
+	require.NoError(t, err)
+
+	orgID := org.CreateOrganization.Organization.ID
+
+	ownerCtx, err := auth.NewTestContextWithOrgID(owner.ID, orgID)
+	require.NoError(t, err)
+
+	return orgID, privacy.DecisionContext(ownerCtx, privacy.Allow)
+}
+
+func (suite *HandlerTestSuite) TestSSOLoginHandler() {
+	t := suite.T()
+
+	suite.e.GET("/sso/:org/login", suite.h.SSOLoginHandler)
+
+	mock_fga.WriteAny(t, suite.fga)
+	mock_fga.CheckAny(t, suite.fga, true)
+
+	orgID, ownerCtx := suite.createSSOOrg("guardians")
+	noSSOOrgID, _ := suite.createSSOOrg("ravagers")
+
+	suite.db.OauthProvider.Create().
+		SetOwnerID(orgID).
+		SetName("okta").
+		SetAuthStyle(1).
+		SetClientID("guardians-client").
+		SetClientSecret("guardians-secret").
+		SetRedirectURL("http://localhost:17608/v1/sso/guardians/callback").
+		SetScopes("email profile").
+		SetAuthURL("https://sso.guardians.net/authorize").
+		SetTokenURL("https://sso.guardians.net/token").
+		SetInfoURL("https://sso.guardians.net/userinfo").
+		SaveX(ownerCtx)
+
+	testCases := []struct {
+		name           string
+		org            string
+		expectedStatus int
+		expectedErr    error
+	}{
+		{
+			name:           "happy path, by organization id",
+			org:            orgID,
+			expectedStatus: http.StatusFound,
+		},
+		{
+			name:           "happy path, by organization name",
+			org:            "guardians",
+			expectedStatus: http.StatusFound,
+		},
+		{
+			name:           "organization without a provider",
+			org:            noSSOOrgID,
+			expectedStatus: http.StatusNotFound,
+			expectedErr:    handlers.ErrSSONotConfigured,
+		},
+		{
+			name:           "organization does not exist",
+			org:            "kree",
+			expectedStatus: http.StatusNotFound,
+			expectedErr:    handlers.ErrSSONotConfigured,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/sso/"+tc.org+"/login?redirect_uri=http://localhost:3001/dashboard", nil)
+
+			recorder := httptest.NewRecorder()
+
+			suite.e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedErr != nil {
+				var out *rout.Reply
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+				assert.Contains(t, out.Error, tc.expectedErr.Error())
+
+				return
+			}
+
+			location, err := url.Parse(res.Header.Get("Location"))
+			require.NoError(t, err)
+
+			assert.Equal(t, "sso.guardians.net", location.Host)
+			assert.Equal(t, "guardians-client", location.Query().Get("client_id"))
+			assert.Equal(t, "email profile", location.Query().Get("scope"))
+
+			cookies := map[string]string{}
+			for _, c := range res.Cookies() {
+				cookies[c.Name] = c.Value
+			}
+
+			assert.Equal(t, location.Query().Get("state"), cookies[oidc.StateCookieName])
+			assert.Equal(t, location.Query().Get("nonce"), cookies[oidc.NonceCookieName])
+			assert.Equal(t, "http://localhost:3001/dashboard", cookies["redirect_to"])
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestSSOCallbackHandler() {
+	t := suite.T()
+
+	suite.e.GET("/sso/:org/callback", suite.h.SSOCallbackHandler)
+
+	ctx := privacy.DecisionContext(echocontext.NewTestEchoContext().Request().Context(), privacy.Allow)
+
+	mock_fga.WriteAny(t, suite.fga)
+	mock_fga.CheckAny(t, suite.fga, true)
+
+	claims := &oidc.Claims{}
+
+	provider := newTestSSOProvider(claims)
+	defer provider.Close()
+
+	// the test provider listens on a loopback address
+	suite.h.SSOHTTPClient = provider.Client()
+	defer func() { suite.h.SSOHTTPClient = nil }()
+
+	orgID, ownerCtx := suite.createSSOOrg("guardians")
+
+	suite.db.OrganizationSetting.Update().
+		Where(organizationsetting.OrganizationID(orgID)).
+		SetVerifiedDomains([]string{"guardians.net"}).
+		ExecX(ownerCtx)
+
+	suite.db.OauthProvider.Create().
+		SetOwnerID(orgID).
+		SetName("okta").
+		SetAuthStyle(1).
+		SetClientID("guardians-client").
+		SetClientSecret("guardians-secret").
+		SetRedirectURL("http://localhost:17608/v1/sso/guardians/callback").
+		SetScopes("email,profile").
+		SetAuthURL(provider.URL + "/authorize").
+		SetTokenURL(provider.URL + "/token").
+		SetInfoURL(provider.URL + "/userinfo").
+		SaveX(ownerCtx)
+
+	// an existing member of the organization without an identity is linked on their first login
+	member := suite.db.User.Create().
+		SetEmail("gamora@guardians.net").
+		SetFirstName("Gamora").
+		SetLastName("Zen").
+		SaveX(ctx)
+
+	suite.db.OrgMembership.Create().
+		SetUserID(member.ID).
+		SetOrganizationID(orgID).
+		SaveX(ownerCtx)
+
+	// an existing user that is not a member of the organization is never linked
+	suite.db.User.Create().
+		SetEmail("drax@guardians.net").
+		SetFirstName("Drax").
+		SetLastName("Destroyer").
+		SaveX(ctx)
+
+	testCases := []struct {
+		name           string
+		state          string
+		cookieState    string
+		claims         oidc.Claims
+		expectedStatus int
+		expectedErr    error
+	}{
+		{
+			name:        "happy path, user is provisioned",
+			state:       "groot",
+			cookieState: "groot",
+			claims: oidc.Claims{
+				Subject:       "01J6BEJ8VRX9QBMZCZ4XGM7A2B",
+				Email:         "starlord@guardians.net",
+				EmailVerified: true,
+				Name:          "Peter Quill",
+			},
+			expectedStatus: http.StatusFound,
+		},
+		{
+			name:        "happy path, existing identity",
+			state:       "rocket",
+			cookieState: "rocket",
+			claims: oidc.Claims{
+				Subject:       "01J6BEJ8VRX9QBMZCZ4XGM7A2B",
+				Email:         "starlord@guardians.net",
+				EmailVerified: true,
+				Name:          "Peter Quill",
+			},
+			expectedStatus: http.StatusFound,
+		},
+		{
+			name:        "happy path, existing member is linked",
+			state:       "rocket",
+			cookieState: "rocket",
+			claims: oidc.Claims{
+				Subject:       "01J6BEJ8VRX9QBMZCZ4XGM7A2C",
+				Email:         "gamora@guardians.net",
+				EmailVerified: true,
+				Name:          "Gamora Zen",
+			},
+			expectedStatus: http.StatusFound,
+		},
+		{
+			name:        "another identity with the email of a linked member",
+			state:       "rocket",
+			cookieState: "rocket",
+			claims: oidc.Claims{
+				Subject:       "01J6BEJ8VRX9QBMZCZ4XGM7A2D",
+				Email:         "gamora@guardians.net",
+				EmailVerified: true,
+				Name:          "Gamora Zen",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrSSOAccountNotLinked,
+		},
+		{
+			name:        "existing user is not a member",
+			state:       "rocket",
+			cookieState: "rocket",
+			claims: oidc.Claims{
+				Subject:       "01J6BEJ8VRX9QBMZCZ4XGM7A2E",
+				Email:         "drax@guardians.net",
+				EmailVerified: true,
+				Name:          "Drax Destroyer",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrSSOAccountNotLinked,
+		},
+		{
+			name:        "email not verified",
+			state:       "rocket",
+			cookieState: "rocket",
+			claims: oidc.Claims{
+				Subject: "01J6BEJ8VRX9QBMZCZ4XGM7A2F",
+				Email:   "mantis@guardians.net",
+				Name:    "Mantis",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrSSOEmailNotVerified,
+		},
+		{
+			name:        "email domain not verified",
+			state:       "rocket",
+			cookieState: "rocket",
+			claims: oidc.Claims{
+				Subject:       "01J6BEJ8VRX9QBMZCZ4XGM7A2G",
+				Email:         "yondu@ravagers.net",
+				EmailVerified: true,
+				Name:          "Yondu Udonta",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrSSODomainNotVerified,
+		},
+		{
+			name:        "missing subject",
+			state:       "rocket",
+			cookieState: "rocket",
+			claims: oidc.Claims{
+				Email:         "mantis@guardians.net",
+				EmailVerified: true,
+				Name:          "Mantis",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrSSOIdentityMissing,
+		},
+		{
+			name:           "state does not match",
+			state:          "groot",
+			cookieState:    "rocket",
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrInvalidSSOState,
+		},
+		{
+			name:           "state cookie missing",
+			state:          "groot",
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrInvalidSSOState,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			*claims = tc.claims
+
+			req := httptest.NewRequest(http.MethodGet, "/sso/guardians/callback?code=awesome-mix&state="+tc.state, nil)
+
+			if tc.cookieState != "" {
+				req.AddCookie(&http.Cookie{Name: oidc.StateCookieName, Value: tc.cookieState})
+			}
+
+			recorder := httptest.NewRecorder()
+
+			suite.e.ServeHTTP(recorder, req.WithContext(echocontext.NewTestContext()))
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedErr != nil {
+				var out *rout.Reply
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+				assert.Contains(t, out.Error, tc.expectedErr.Error())
+
+				return
+			}
+
+			assert.True(t, strings.Contains(res.Header.Get("Location"), "session="))
+
+			u, err := suite.db.User.Query().
+				Where(user.Email(tc.claims.Email)).
+				Only(ctx)
+			require.NoError(t, err)
+
+			members, err := suite.db.OrgMembership.Query().
+				Where(orgmembership.UserID(u.ID), orgmembership.OrganizationID(orgID)).
+				All(ownerCtx)
+			require.NoError(t, err)
+			require.Len(t, members, 1)
+			assert.Equal(t, enums.RoleMember, members[0].Role)
+			require.NotNil(t, members[0].SSOSubject)
+			assert.Equal(t, tc.claims.Subject, *members[0].SSOSubject)
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestLoginHandlerSSOEnforced() {
+	t := suite.T()
+
+	suite.e.POST("login", suite.h.LoginHandler)
+
+	ctx := privacy.DecisionContext(echocontext.NewTestEchoContext().Request().Context(), privacy.Allow)
+
+	mock_fga.WriteAny(t, suite.fga)
+	mock_fga.CheckAny(t, suite.fga, true)
+
+	orgID, ownerCtx := suite.createSSOOrg("guardians")
+
+	suite.db.OrganizationSetting.Update().
+		Where(organizationsetting.OrganizationID(orgID)).
+		SetDomains([]string{"guardians.net", "nebula.net"}).
+		SetVerifiedDomains([]string{"guardians.net"}).
+		SetSSOEnforced(true).
+		ExecX(ownerCtx)
+
+	validPassword := "sup3rs3cu7e!"
+
+	createUser := func(email string) string {
+		setting := suite.db.UserSetting.Create().
+			SetEmailConfirmed(true).
+			SaveX(ctx)
+
+		u := suite.db.User.Create().
+			SetFirstName("Gamora").
+			SetLastName("Zen").
+			SetEmail(email).
+			SetPassword(validPassword).
+			SetSetting(setting).
+			SaveX(ctx)
+
+		return u.ID
+	}
+
+	memberID := createUser("gamora@guardians.net")
+
+	suite.db.OrgMembership.Create().
+		SetUserID(memberID).
+		SetOrganizationID(orgID).
+		SaveX(ownerCtx)
+
+	// members with an email in a domain that is not verified are not required to use sso
+	unverifiedID := createUser("mantis@nebula.net")
+
+	suite.db.OrgMembership.Create().
+		SetUserID(unverifiedID).
+		SetOrganizationID(orgID).
+		SaveX(ownerCtx)
+
+	_ = createUser("drax@guardians.net")
+
+	testCases := []struct {
+		name           string
+		username       string
+		expectedStatus int
+		expectedErr    error
+	}{
+		{
+			name:           "member with organization domain must use sso",
+			username:       "gamora@guardians.net",
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    handlers.ErrSSORequired,
+		},
+		{
+			name:           "happy path, member with a domain that is not verified",
+			username:       "mantis@nebula.net",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "happy path, not a member of the organization",
+			username:       "drax@guardians.net",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(models.LoginRequest{
+				Username: tc.username,
+				Password: validPassword,
+			})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(string(body)))
+			req.Header.Set(httpsling.HeaderContentType, httpsling.ContentTypeJSONUTF8)
+
+			recorder := httptest.NewRecorder()
+
+			suite.e.ServeHTTP(recorder, req)
+
+			res := recorder.Result()
+			defer res.Body.Close()
+
+			var out *models.LoginReply
+			require.NoError(t, json.NewDecoder(res.Body).Decode(&out))
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedErr != nil {
+				assert.Contains(t, out.Error, tc.expectedErr.Error())
+
+				return
+			}
+
+			assert.True(t, out.Success)
+		})
+	}
+}
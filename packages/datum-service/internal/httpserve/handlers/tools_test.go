@@ -28,6 +28,7 @@ import (
 	"github.com/datumforge/datum/pkg/middleware/transaction"
 	"github.com/datumforge/datum/pkg/sessions"
 	"github.com/datumforge/datum/pkg/testutils"
+	"github.com/datumforge/datum/pkg/tokens"
 	"github.com/datumforge/datum/pkg/utils/emails"
 	"github.com/datumforge/datum/pkg/utils/marionette"
 )
@@ -182,15 +183,16 @@ func handlerSetup(t *testing.T, ent *ent.Client) *handlers.Handler {
 	as.SetSessionConfig(ent.SessionConfig)
 
 	h := &handlers.Handler{
-		IsTest:        true,
-		TokenManager:  ent.TokenManager,
-		DBClient:      ent,
-		RedisClient:   ent.SessionConfig.RedisClient,
-		Logger:        logger,
-		SessionConfig: ent.SessionConfig,
-		AuthManager:   as,
-		EmailManager:  ent.Emails,
-		TaskMan:       ent.Marionette,
+		IsTest:           true,
+		TokenManager:     ent.TokenManager,
+		TokenRevocations: tokens.NewRedisRevocationList(ent.SessionConfig.RedisClient),
+		DBClient:         ent,
+		RedisClient:      ent.SessionConfig.RedisClient,
+		Logger:           logger,
+		SessionConfig:    ent.SessionConfig,
+		AuthManager:      as,
+		EmailManager:     ent.Emails,
+		TaskMan:          ent.Marionette,
 		AnalyticsClient: &analytics.EventManager{
 			Enabled: false,
 		},
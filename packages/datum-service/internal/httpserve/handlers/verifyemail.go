@@ -102,6 +102,13 @@ func (h *Handler) VerifyEmail(ctx echo.Context) error {
 		if err := h.setEmailConfirmed(userCtx, entUser); err != nil {
 			return h.BadRequest(ctx, err)
 		}
+
+		// the email address is now verified, join the organizations which verified the domain of the email address
+		if err := h.joinDomainOrganizations(userCtx, entUser); err != nil {
+			h.Logger.Errorw("unable to join organizations for domain", "error", err)
+
+			return h.InternalServerError(ctx, err)
+		}
 	}
 
 	if err := h.addDefaultOrgToUserQuery(userCtx, entUser); err != nil {
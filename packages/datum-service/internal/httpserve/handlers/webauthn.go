@@ -11,6 +11,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/privacy/token"
 	"github.com/datumforge/datum/pkg/auth"
 	"github.com/datumforge/datum/pkg/enums"
+	authmw "github.com/datumforge/datum/pkg/middleware/auth"
 	"github.com/datumforge/datum/pkg/models"
 	provider "github.com/datumforge/datum/pkg/providers/webauthn"
 	"github.com/datumforge/datum/pkg/rout"
@@ -38,6 +39,10 @@ func (h *Handler) BeginWebauthnRegistration(ctx echo.Context) error {
 	// user is created first, no credential method is set / they are unable to login until the credential flow is finished
 	entUser, err := h.CheckAndCreateUser(ctxWithToken, r.Name, r.Email, enums.AuthProvider(webauthnProvider), "")
 	if err != nil {
+		if isUserStatusError(err) {
+			return h.BadRequest(ctx, err)
+		}
+
 		return h.InternalServerError(ctx, err)
 	}
 
@@ -252,6 +257,10 @@ func (h *Handler) FinishWebauthnLogin(ctx echo.Context) error {
 		return h.InternalServerError(ctx, err)
 	}
 
+	if err := authmw.CheckUserSetting(entUser.Edges.Setting); err != nil {
+		return h.BadRequest(ctx, err)
+	}
+
 	// create claims for verified user
 	auth, err := h.AuthManager.GenerateUserAuthSession(ctx, entUser)
 	if err != nil {
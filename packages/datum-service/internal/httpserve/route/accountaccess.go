@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/auth"
 )
 
 // registerAccountAccessHandler registers the /account/access handler
@@ -16,7 +18,7 @@ func registerAccountAccessHandler(router *Router) (err error) {
 		Name:        name,
 		Method:      method,
 		Path:        path,
-		Middlewares: authMW,
+		Middlewares: scopedAuthMW("organization", auth.ScopeActionRead),
 		Handler: func(c echo.Context) error {
 			return router.Handler.AccountAccessHandler(c)
 		},
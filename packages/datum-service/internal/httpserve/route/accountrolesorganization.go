@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/auth"
 )
 
 // registerAccountRolesOrganizationHandler registers the /account/roles/organization handler
@@ -17,7 +19,7 @@ func registerAccountRolesOrganizationHandler(router *Router) (err error) {
 		Name:        name,
 		Method:      method,
 		Path:        path,
-		Middlewares: authMW,
+		Middlewares: scopedAuthMW("organization", auth.ScopeActionRead),
 		Handler: func(c echo.Context) error {
 			return router.Handler.AccountRolesOrganizationHandler(c)
 		},
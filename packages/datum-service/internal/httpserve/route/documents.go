@@ -0,0 +1,34 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/auth"
+)
+
+// registerDocumentRenderHandler registers the handler to render a document as a PDF or HTML download
+func registerDocumentRenderHandler(router *Router) (err error) {
+	path := "/documents/:id/render"
+	method := http.MethodGet
+	name := "DocumentRender"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("documentdata", auth.ScopeActionRead),
+		Handler: func(c echo.Context) error {
+			return router.Handler.DocumentRenderHandler(c)
+		},
+	}
+
+	renderOperation := router.Handler.BindDocumentRender()
+
+	if err := router.Addv1Route(path, method, renderOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
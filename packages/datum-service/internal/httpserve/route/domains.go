@@ -0,0 +1,34 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/auth"
+)
+
+// registerDomainVerificationHandler registers the domain verification handler to verify the ownership of the domains of an organization
+func registerDomainVerificationHandler(router *Router) (err error) {
+	path := "/domains/verify"
+	method := http.MethodPost
+	name := "DomainVerification"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("organization", auth.ScopeActionWrite),
+		Handler: func(c echo.Context) error {
+			return router.Handler.DomainVerificationHandler(c)
+		},
+	}
+
+	verifyOperation := router.Handler.BindDomainVerificationHandler()
+
+	if err := router.Addv1Route(path, method, verifyOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
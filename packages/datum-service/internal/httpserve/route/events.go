@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/auth"
 )
 
 // registerEventPublisher registers the event publisher endpoint
@@ -16,7 +18,7 @@ func registerEventPublisher(router *Router) (err error) {
 		Name:        name,
 		Method:      method,
 		Path:        path,
-		Middlewares: authMW,
+		Middlewares: scopedAuthMW("event", auth.ScopeActionWrite),
 		Handler: func(c echo.Context) error {
 			return router.Handler.EventPublisher(c)
 		},
@@ -0,0 +1,59 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/auth"
+)
+
+// registerFileUploadHandler registers the file upload handler
+func registerFileUploadHandler(router *Router) (err error) {
+	path := "/files"
+	method := http.MethodPost
+	name := "FileUpload"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("file", auth.ScopeActionWrite),
+		Handler: func(c echo.Context) error {
+			return router.Handler.FileUploadHandler(c)
+		},
+	}
+
+	uploadOperation := router.Handler.BindFileUpload()
+
+	if err := router.Addv1Route(path, method, uploadOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerFileDownloadHandler registers the file download handler
+func registerFileDownloadHandler(router *Router) (err error) {
+	path := "/files/:id"
+	method := http.MethodGet
+	name := "FileDownload"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("file", auth.ScopeActionRead),
+		Handler: func(c echo.Context) error {
+			return router.Handler.FileDownloadHandler(c)
+		},
+	}
+
+	downloadOperation := router.Handler.BindFileDownload()
+
+	if err := router.Addv1Route(path, method, downloadOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/auth"
 )
 
 // registerInviteHandler registers the invite handler
@@ -16,7 +18,7 @@ func registerInviteHandler(router *Router) (err error) {
 		Name:        name,
 		Method:      method,
 		Path:        path,
-		Middlewares: authMW,
+		Middlewares: scopedAuthMW("invite", auth.ScopeActionWrite),
 		Handler: func(c echo.Context) error {
 			return router.Handler.OrganizationInviteAccept(c)
 		},
@@ -0,0 +1,59 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/auth"
+)
+
+// registerJoinableOrganizationsHandler registers the handler listing the organizations the user can join by the domain of their email address
+func registerJoinableOrganizationsHandler(router *Router) (err error) {
+	path := "/join"
+	method := http.MethodGet
+	name := "JoinableOrganizations"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("user", auth.ScopeActionRead),
+		Handler: func(c echo.Context) error {
+			return router.Handler.JoinableOrganizationsHandler(c)
+		},
+	}
+
+	joinableOperation := router.Handler.BindJoinableOrganizationsHandler()
+
+	if err := router.Addv1Route(path, method, joinableOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerJoinOrganizationHandler registers the handler to join an organization by the domain of the user's email address
+func registerJoinOrganizationHandler(router *Router) (err error) {
+	path := "/join"
+	method := http.MethodPost
+	name := "JoinOrganization"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("user", auth.ScopeActionWrite),
+		Handler: func(c echo.Context) error {
+			return router.Handler.JoinOrganizationHandler(c)
+		},
+	}
+
+	joinOperation := router.Handler.BindJoinOrganizationHandler()
+
+	if err := router.Addv1Route(path, method, joinOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
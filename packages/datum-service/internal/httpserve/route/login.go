@@ -31,3 +31,29 @@ func registerLoginHandler(router *Router) (err error) {
 
 	return nil
 }
+
+// LoginTFA completes the login of users with two factor authentication enabled - see the handlers/login_tfa.go
+// for more information
+func registerLoginTFAHandler(router *Router) (err error) {
+	path := "/login/tfa"
+	method := http.MethodPost
+	name := "LoginTFA"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: restrictedEndpointsMW,
+		Handler: func(c echo.Context) error {
+			return router.Handler.LoginTFAHandler(c)
+		},
+	}
+
+	loginTFAOperation := router.Handler.BindLoginTFAHandler()
+
+	if err := router.Addv1Route(path, method, loginTFAOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/auth"
+)
+
+// registerLogoutHandler registers the logout handler and route
+func registerLogoutHandler(router *Router) (err error) {
+	path := "/logout"
+	method := http.MethodPost
+	name := "Logout"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("session", auth.ScopeActionDelete),
+		Handler: func(c echo.Context) error {
+			return router.Handler.LogoutHandler(c)
+		},
+	}
+
+	logoutOperation := router.Handler.BindLogoutHandler()
+
+	if err := router.Addv1Route(path, method, logoutOperation, route); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	echo "github.com/datumforge/echox"
 
+	"github.com/datumforge/datum/pkg/auth"
 	"github.com/datumforge/datum/pkg/httpsling"
 )
 
@@ -42,7 +43,7 @@ func registerUserInfoHandler(router *Router) (err error) {
 		Name:        name,
 		Method:      method,
 		Path:        path,
-		Middlewares: authMW,
+		Middlewares: scopedAuthMW("user", auth.ScopeActionRead),
 		Handler: func(c echo.Context) error {
 			c.Response().Header().Set(httpsling.HeaderContentType, httpsling.ContentTypeJSONUTF8)
 
@@ -1,6 +1,7 @@
 package route
 
 import (
+	"slices"
 	"time"
 
 	echo "github.com/datumforge/echox"
@@ -8,6 +9,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 
 	"github.com/datumforge/datum/internal/httpserve/handlers"
+	authmw "github.com/datumforge/datum/pkg/middleware/auth"
 	"github.com/datumforge/datum/pkg/middleware/ratelimit"
 	"github.com/datumforge/datum/pkg/middleware/transaction"
 )
@@ -20,6 +22,12 @@ var (
 	restrictedEndpointsMW = []echo.MiddlewareFunc{}
 )
 
+// scopedAuthMW returns the middleware for authenticated endpoints that also requires api tokens and personal
+// access tokens to be granted the action on the resource
+func scopedAuthMW(resource, action string) []echo.MiddlewareFunc {
+	return append(slices.Clone(authMW), authmw.RequireScope(resource, action))
+}
+
 // Router is a struct that holds the echo router, the OpenAPI schema, and the handler - it's a way to group these components together
 type Router struct {
 	Echo    *echo.Echo
@@ -122,12 +130,18 @@ func RegisterRoutes(router *Router) error {
 		registerRegisterHandler,
 		registerVerifySubscribeHandler,
 		registerRefreshHandler,
+		registerLogoutHandler,
 		registerJwksWellKnownHandler,
 		registerInviteHandler,
 		registerGithubLoginHandler,
 		registerGithubCallbackHandler,
 		registerGoogleLoginHandler,
 		registerGoogleCallbackHandler,
+		registerSSOLoginHandler,
+		registerSSOCallbackHandler,
+		registerSAMLMetadataHandler,
+		registerSAMLLoginHandler,
+		registerSAMLACSHandler,
 		registerWebauthnRegistrationHandler,
 		registerWebauthnVerificationsHandler,
 		registerWebauthnAuthenticationHandler,
@@ -143,9 +157,29 @@ func RegisterRoutes(router *Router) error {
 		registerFaviconHandler,
 		registerOpenAPIHandler,
 		registerLoginHandler,
+		registerLoginTFAHandler,
 		registerAccountAccessHandler,
 		registerAccountRolesHandler,
 		registerAccountRolesOrganizationHandler,
+		registerFileUploadHandler,
+		registerFileDownloadHandler,
+		registerDocumentRenderHandler,
+		registerSCIMServiceProviderConfigHandler,
+		registerSCIMListUsersHandler,
+		registerSCIMGetUserHandler,
+		registerSCIMCreateUserHandler,
+		registerSCIMReplaceUserHandler,
+		registerSCIMPatchUserHandler,
+		registerSCIMDeleteUserHandler,
+		registerSCIMListGroupsHandler,
+		registerSCIMGetGroupHandler,
+		registerSCIMCreateGroupHandler,
+		registerSCIMReplaceGroupHandler,
+		registerSCIMPatchGroupHandler,
+		registerSCIMDeleteGroupHandler,
+		registerDomainVerificationHandler,
+		registerJoinableOrganizationsHandler,
+		registerJoinOrganizationHandler,
 	}
 
 	for _, route := range routeHandlers {
@@ -0,0 +1,308 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/auth"
+)
+
+// registerSCIMServiceProviderConfigHandler registers the SCIM service provider configuration handler
+func registerSCIMServiceProviderConfigHandler(router *Router) (err error) {
+	path := "/scim/v2/ServiceProviderConfig"
+	method := http.MethodGet
+	name := "SCIMServiceProviderConfig"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionRead),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMServiceProviderConfigHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSCIMListUsersHandler registers the SCIM list users handler
+func registerSCIMListUsersHandler(router *Router) (err error) {
+	path := "/scim/v2/Users"
+	method := http.MethodGet
+	name := "SCIMListUsers"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionRead),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMListUsersHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSCIMGetUserHandler registers the SCIM get user handler
+func registerSCIMGetUserHandler(router *Router) (err error) {
+	path := "/scim/v2/Users/:id"
+	method := http.MethodGet
+	name := "SCIMGetUser"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionRead),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMGetUserHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSCIMCreateUserHandler registers the SCIM create user handler
+func registerSCIMCreateUserHandler(router *Router) (err error) {
+	path := "/scim/v2/Users"
+	method := http.MethodPost
+	name := "SCIMCreateUser"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionWrite),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMCreateUserHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSCIMReplaceUserHandler registers the SCIM replace user handler
+func registerSCIMReplaceUserHandler(router *Router) (err error) {
+	path := "/scim/v2/Users/:id"
+	method := http.MethodPut
+	name := "SCIMReplaceUser"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionWrite),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMReplaceUserHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSCIMPatchUserHandler registers the SCIM patch user handler
+func registerSCIMPatchUserHandler(router *Router) (err error) {
+	path := "/scim/v2/Users/:id"
+	method := http.MethodPatch
+	name := "SCIMPatchUser"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionWrite),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMPatchUserHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSCIMDeleteUserHandler registers the SCIM delete user handler
+func registerSCIMDeleteUserHandler(router *Router) (err error) {
+	path := "/scim/v2/Users/:id"
+	method := http.MethodDelete
+	name := "SCIMDeleteUser"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionDelete),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMDeleteUserHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSCIMListGroupsHandler registers the SCIM list groups handler
+func registerSCIMListGroupsHandler(router *Router) (err error) {
+	path := "/scim/v2/Groups"
+	method := http.MethodGet
+	name := "SCIMListGroups"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionRead),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMListGroupsHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSCIMGetGroupHandler registers the SCIM get group handler
+func registerSCIMGetGroupHandler(router *Router) (err error) {
+	path := "/scim/v2/Groups/:id"
+	method := http.MethodGet
+	name := "SCIMGetGroup"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionRead),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMGetGroupHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSCIMCreateGroupHandler registers the SCIM create group handler
+func registerSCIMCreateGroupHandler(router *Router) (err error) {
+	path := "/scim/v2/Groups"
+	method := http.MethodPost
+	name := "SCIMCreateGroup"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionWrite),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMCreateGroupHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSCIMReplaceGroupHandler registers the SCIM replace group handler
+func registerSCIMReplaceGroupHandler(router *Router) (err error) {
+	path := "/scim/v2/Groups/:id"
+	method := http.MethodPut
+	name := "SCIMReplaceGroup"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionWrite),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMReplaceGroupHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSCIMPatchGroupHandler registers the SCIM patch group handler
+func registerSCIMPatchGroupHandler(router *Router) (err error) {
+	path := "/scim/v2/Groups/:id"
+	method := http.MethodPatch
+	name := "SCIMPatchGroup"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionWrite),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMPatchGroupHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSCIMDeleteGroupHandler registers the SCIM delete group handler
+func registerSCIMDeleteGroupHandler(router *Router) (err error) {
+	path := "/scim/v2/Groups/:id"
+	method := http.MethodDelete
+	name := "SCIMDeleteGroup"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: scopedAuthMW("scim", auth.ScopeActionDelete),
+		Handler: func(c echo.Context) error {
+			return router.Handler.SCIMDeleteGroupHandler(c)
+		},
+	}
+
+	if err := router.AddUnversionedRoute(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
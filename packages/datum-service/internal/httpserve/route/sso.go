@@ -0,0 +1,122 @@
+package route
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+)
+
+// registerSSOLoginHandler registers the organization single sign-on login handler
+func registerSSOLoginHandler(router *Router) (err error) {
+	path := "/sso/:org/login"
+	method := http.MethodGet
+	name := "SSOLogin"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: mw,
+		Handler: func(c echo.Context) error {
+			return router.Handler.SSOLoginHandler(c)
+		},
+	}
+
+	if err := router.Addv1Route(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSSOCallbackHandler registers the organization single sign-on callback handler
+func registerSSOCallbackHandler(router *Router) (err error) {
+	path := "/sso/:org/callback"
+	method := http.MethodGet
+	name := "SSOCallback"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: mw,
+		Handler: func(c echo.Context) error {
+			return router.Handler.SSOCallbackHandler(c)
+		},
+	}
+
+	if err := router.Addv1Route(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSAMLMetadataHandler registers the organization SAML service provider metadata handler
+func registerSAMLMetadataHandler(router *Router) (err error) {
+	path := "/sso/:org/saml/metadata"
+	method := http.MethodGet
+	name := "SAMLMetadata"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: mw,
+		Handler: func(c echo.Context) error {
+			return router.Handler.SAMLMetadataHandler(c)
+		},
+	}
+
+	if err := router.Addv1Route(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSAMLLoginHandler registers the organization SAML login handler
+func registerSAMLLoginHandler(router *Router) (err error) {
+	path := "/sso/:org/saml/login"
+	method := http.MethodGet
+	name := "SAMLLogin"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: mw,
+		Handler: func(c echo.Context) error {
+			return router.Handler.SAMLLoginHandler(c)
+		},
+	}
+
+	if err := router.Addv1Route(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerSAMLACSHandler registers the organization SAML assertion consumer service handler
+func registerSAMLACSHandler(router *Router) (err error) {
+	path := "/sso/:org/saml/acs"
+	method := http.MethodPost
+	name := "SAMLACS"
+
+	route := echo.Route{
+		Name:        name,
+		Method:      method,
+		Path:        path,
+		Middlewares: mw,
+		Handler: func(c echo.Context) error {
+			return router.Handler.SAMLACSHandler(c)
+		},
+	}
+
+	if err := router.Addv1Route(path, method, nil, route); err != nil {
+		return err
+	}
+
+	return nil
+}
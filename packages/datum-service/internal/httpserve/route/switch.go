@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/auth"
 )
 
 // registerSwitchRoute registers the switch route to switch the user's logged in organization context
@@ -16,7 +18,7 @@ func registerSwitchRoute(router *Router) (err error) {
 		Name:        name,
 		Method:      method,
 		Path:        path,
-		Middlewares: authMW,
+		Middlewares: scopedAuthMW("organization", auth.ScopeActionRead),
 		Handler: func(c echo.Context) error {
 			return router.Handler.SwitchHandler(c)
 		},
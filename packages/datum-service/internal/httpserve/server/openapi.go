@@ -151,6 +151,7 @@ func NewOpenAPISpec() (*openapi3.T, error) {
 var openAPISchemas = map[string]any{
 	"LoginRequest":               &models.LoginRequest{},
 	"LoginResponse":              &models.LoginReply{},
+	"TFALoginRequest":            &models.TFALoginRequest{},
 	"ForgotPasswordRequest":      &models.ForgotPasswordRequest{},
 	"ForgotPasswordResponse":     &models.ForgotPasswordReply{},
 	"ResetPasswordRequest":       &models.ResetPasswordRequest{},
@@ -171,6 +172,7 @@ var openAPISchemas = map[string]any{
 	"VerifySubscriptionResponse": &models.VerifySubscribeReply{},
 	"InviteRequest":              &models.InviteRequest{},
 	"InviteResponse":             &models.InviteReply{},
+	"FileUploadReply":            &models.FileUploadReply{},
 	"ErrorResponse":              &rout.StatusError{},
 }
 
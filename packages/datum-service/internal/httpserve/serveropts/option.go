@@ -1,6 +1,7 @@
 package serveropts
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -23,7 +24,9 @@ import (
 	"github.com/datumforge/datum/internal/httpserve/server"
 	"github.com/datumforge/datum/pkg/analytics"
 	"github.com/datumforge/datum/pkg/cache"
+	"github.com/datumforge/datum/pkg/domains"
 	"github.com/datumforge/datum/pkg/events/kafka/publisher"
+	"github.com/datumforge/datum/pkg/events/pubsub"
 	"github.com/datumforge/datum/pkg/httpsling"
 	authmw "github.com/datumforge/datum/pkg/middleware/auth"
 	"github.com/datumforge/datum/pkg/middleware/cachecontrol"
@@ -33,6 +36,8 @@ import (
 	"github.com/datumforge/datum/pkg/middleware/ratelimit"
 	"github.com/datumforge/datum/pkg/middleware/redirect"
 	"github.com/datumforge/datum/pkg/middleware/secure"
+	"github.com/datumforge/datum/pkg/objects"
+	"github.com/datumforge/datum/pkg/providers/saml"
 	"github.com/datumforge/datum/pkg/providers/webauthn"
 	"github.com/datumforge/datum/pkg/sessions"
 	"github.com/datumforge/datum/pkg/tokens"
@@ -170,6 +175,9 @@ func WithAuth() ServerOption {
 		// add oauth providers
 		s.Config.Handler.OauthProvider = s.Config.Settings.Auth.Providers
 
+		// add account lockout settings
+		s.Config.Handler.AccountLockout = s.Config.Settings.Auth.AccountLockout
+
 		// add auth middleware
 		conf := authmw.NewAuthOptions(
 			authmw.WithAudience(s.Config.Settings.Auth.Token.Audience),
@@ -177,10 +185,18 @@ func WithAuth() ServerOption {
 			authmw.WithJWKSEndpoint(s.Config.Settings.Auth.Token.JWKSEndpoint),
 			authmw.WithDBClient(s.Config.Handler.DBClient),
 			authmw.WithCookieConfig(s.Config.SessionConfig.CookieConfig),
+			authmw.WithRevocationList(s.Config.Handler.TokenRevocations),
 		)
 
 		s.Config.Handler.WebAuthn = webauthn.NewWithConfig(s.Config.Settings.Auth.Providers.Webauthn)
 
+		samlProvider, err := saml.NewWithConfig(s.Config.Settings.Auth.Providers.SAML)
+		if err != nil {
+			s.Config.Logger.Panicw("unable to configure saml provider", "error", err.Error())
+		}
+
+		s.Config.Handler.SAMLProvider = samlProvider
+
 		s.Config.GraphMiddleware = append(s.Config.GraphMiddleware, authmw.Authenticate(&conf))
 		s.Config.Handler.AuthMiddleware = append(s.Config.Handler.AuthMiddleware, authmw.Authenticate(&conf))
 	})
@@ -210,12 +226,14 @@ func WithReadyChecks(c *entx.EntClientConfig, f *fgax.Client, r *redis.Client) S
 }
 
 // WithGraphRoute adds the graph handler to the server
-func WithGraphRoute(srv *server.Server, c *generated.Client) ServerOption {
+func WithGraphRoute(srv *server.Server, c *generated.Client, b *pubsub.Broker) ServerOption {
 	return newApplyFunc(func(s *ServerOptions) {
 		// Setup Graph API Handlers
 		r := graphapi.NewResolver(c).
 			WithLogger(s.Config.Logger.Named("resolvers")).
-			WithExtensions(s.Config.Settings.Server.EnableGraphExtensions)
+			WithExtensions(s.Config.Settings.Server.EnableGraphExtensions).
+			WithQueryLimits(s.Config.Settings.Server.GraphQueryLimits).
+			WithPubSub(b)
 
 		// add pool to the resolver to manage the number of goroutines
 		r.WithPool(
@@ -266,8 +284,8 @@ func WithEventPublisher() ServerOption {
 	})
 }
 
-// WithEmailManager sets up the default SendGrid email manager to be used to send emails to users
-// on registration, password reset, etc
+// WithEmailManager sets up the default email manager, sending with the configured transport, to be used to send
+// emails to users on registration, password reset, etc
 func WithEmailManager() ServerOption {
 	return newApplyFunc(func(s *ServerOptions) {
 		em, err := emails.New(s.Config.Settings.Email)
@@ -348,6 +366,9 @@ func WithSessionManager(rc *redis.Client) ServerOption {
 		s.Config.Handler.SessionConfig = &sessionConfig
 		s.Config.SessionConfig = &sessionConfig
 		s.Config.Handler.AuthManager.SetSessionConfig(&sessionConfig)
+
+		// refresh tokens revoked on logout are stored alongside the sessions
+		s.Config.Handler.TokenRevocations = tokens.NewRedisRevocationList(rc)
 	})
 }
 
@@ -415,6 +436,27 @@ func WithOTP() ServerOption {
 	})
 }
 
+// WithObjectStorage sets up the object storage backend used for file uploads
+func WithObjectStorage() ServerOption {
+	return newApplyFunc(func(s *ServerOptions) {
+		if s.Config.Settings.ObjectStorage.Enabled {
+			store, err := objects.New(context.Background(), s.Config.Settings.ObjectStorage)
+			if err != nil {
+				panic(err)
+			}
+
+			s.Config.Handler.ObjectStorage = store
+		}
+	})
+}
+
+// WithDomainVerifier sets up the verifier used to verify the ownership of the domains of organizations
+func WithDomainVerifier() ServerOption {
+	return newApplyFunc(func(s *ServerOptions) {
+		s.Config.Handler.DomainVerifier = domains.NewVerifier()
+	})
+}
+
 // WithRateLimiter sets up the rate limiter for the server
 func WithRateLimiter() ServerOption {
 	return newApplyFunc(func(s *ServerOptions) {
@@ -0,0 +1,2 @@
+// Package outbox relays the events written to the transactional outbox to an event publisher
+package outbox
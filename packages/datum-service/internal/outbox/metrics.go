@@ -0,0 +1,29 @@
+package outbox
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// publishedEvents counts the events published by the relay
+	publishedEvents = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "outbox_events_published_total",
+		Help: "Number of outbox events published",
+	})
+	// failedEvents counts the attempts to publish an event that failed
+	failedEvents = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "outbox_events_failed_total",
+		Help: "Number of failed attempts to publish an outbox event",
+	})
+	// pendingEvents is the number of events waiting to be published
+	pendingEvents = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "outbox_events_pending",
+		Help: "Number of outbox events waiting to be published",
+	})
+	// lagSeconds is the age of the oldest event waiting to be published
+	lagSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "outbox_lag_seconds",
+		Help: "Age in seconds of the oldest outbox event waiting to be published",
+	})
+)
@@ -0,0 +1,429 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/datumforge/datum/internal/ent/entconfig"
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/outboxevent"
+	"github.com/datumforge/datum/internal/ent/generated/predicate"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/pkg/events"
+	"github.com/datumforge/datum/pkg/utils/ulids"
+	"github.com/datumforge/datum/pkg/webhooks"
+)
+
+const (
+	defaultInterval     = time.Second
+	defaultBatchSize    = 100
+	defaultMaxBackoff   = 5 * time.Minute
+	defaultClaimTimeout = time.Minute
+)
+
+// ErrRelayAlreadyStarted is returned when the relay is started more than once
+var ErrRelayAlreadyStarted = errors.New("outbox relay is already started")
+
+// Relay drains the outbox to the event publisher; events are published at least once, an event is only marked as
+// published after the publisher accepted it, and events about the same aggregate are published in the order
+// they were written. Every drain claims its batch of events so relays running on multiple replicas do not publish
+// the same events, and only the events of the relayed topics are published
+type Relay struct {
+	client    *ent.Client
+	publisher events.EventPublisher
+	config    entconfig.Outbox
+	logger    *zap.SugaredLogger
+	topics    []string
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// RelayOption configures the relay
+type RelayOption func(*Relay)
+
+// WithTopics sets the topics relayed to the publisher, by default only the events generated by the service are relayed
+func WithTopics(topics ...string) RelayOption {
+	return func(r *Relay) {
+		r.topics = topics
+	}
+}
+
+// NewRelay returns a relay publishing the outbox events of the client to the publisher
+func NewRelay(client *ent.Client, publisher events.EventPublisher, cfg entconfig.Outbox, logger *zap.SugaredLogger, opts ...RelayOption) *Relay {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultInterval
+	}
+
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultMaxBackoff
+	}
+
+	if cfg.ClaimTimeout <= 0 {
+		cfg.ClaimTimeout = defaultClaimTimeout
+	}
+
+	if logger == nil {
+		logger = zap.NewNop().Sugar()
+	}
+
+	r := &Relay{
+		client:    client,
+		publisher: publisher,
+		config:    cfg,
+		logger:    logger,
+		topics:    webhooks.EventTypes(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Start starts the publisher and polls the outbox in the background until the context is canceled or the relay is closed
+func (r *Relay) Start(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cancel != nil {
+		return ErrRelayAlreadyStarted
+	}
+
+	if err := r.publisher.StartPublisher(ctx); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	go r.run(ctx)
+
+	return nil
+}
+
+// run drains the outbox on every interval
+func (r *Relay) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.Drain(ctx); err != nil && ctx.Err() == nil {
+			r.logger.Errorw("unable to drain outbox", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Close stops polling, waits for the current drain to finish (or the context to be done) and closes the publisher
+func (r *Relay) Close(ctx context.Context) error {
+	r.mu.Lock()
+	cancel, done := r.cancel, r.done
+	r.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		r.logger.Warnw("timed out waiting for outbox relay to stop")
+	}
+
+	return r.publisher.Close(ctx)
+}
+
+// Drain claims and publishes a batch of pending events and returns the number of events published
+func (r *Relay) Drain(ctx context.Context) (int, error) {
+	// the relay is not acting on behalf of a user
+	ctx = privacy.DecisionContext(ctx, privacy.Allow)
+
+	now := time.Now()
+
+	claimID, err := r.claim(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	// release the events of the batch that were not published, so they can be claimed by the next drain
+	defer func() {
+		if err := r.release(context.WithoutCancel(ctx), claimID); err != nil {
+			r.logger.Errorw("unable to release claimed outbox events", "error", err)
+		}
+	}()
+
+	pending, err := r.client.OutboxEvent.Query().
+		Where(outboxevent.ClaimID(claimID)).
+		Order(ent.Asc(outboxevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	blocked, err := r.blockedAggregates(ctx, pending, claimID)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+
+	for _, evt := range pending {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// an earlier event about the aggregate has not been published yet
+		if id, ok := blocked[evt.AggregateID]; ok && id < evt.ID {
+			continue
+		}
+
+		if err := r.publish(ctx, evt); err != nil {
+			r.logger.Errorw("unable to publish outbox event", "error", err, "id", evt.ID, "topic", evt.Topic)
+
+			// hold back the remaining events about the aggregate to keep them in order
+			blocked[evt.AggregateID] = evt.ID
+
+			continue
+		}
+
+		published++
+	}
+
+	if err := r.cleanup(ctx, now); err != nil {
+		return published, err
+	}
+
+	return published, r.recordLag(ctx, now)
+}
+
+// claim marks a batch of pending events as claimed by the drain and returns the id of the claim; the update only
+// matches events that are still unclaimed, so an event is never claimed by two relays at the same time
+func (r *Relay) claim(ctx context.Context, now time.Time) (string, error) {
+	ids, err := r.client.OutboxEvent.Query().
+		Where(
+			outboxevent.PublishedAtIsNil(),
+			outboxevent.AvailableAtLTE(now),
+			outboxevent.TopicIn(r.topics...),
+			unclaimed(now),
+		).
+		Order(ent.Asc(outboxevent.FieldID)).
+		Limit(r.config.BatchSize).
+		IDs(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	claimID := ulids.New().String()
+
+	if len(ids) == 0 {
+		return claimID, nil
+	}
+
+	if _, err := r.client.OutboxEvent.Update().
+		Where(
+			outboxevent.IDIn(ids...),
+			outboxevent.PublishedAtIsNil(),
+			unclaimed(now),
+		).
+		SetClaimID(claimID).
+		SetClaimedUntil(now.Add(r.config.ClaimTimeout)).
+		Save(ctx); err != nil {
+		return "", err
+	}
+
+	return claimID, nil
+}
+
+// release clears the claim of the events of the drain that were not published
+func (r *Relay) release(ctx context.Context, claimID string) error {
+	return r.client.OutboxEvent.Update().
+		Where(
+			outboxevent.ClaimID(claimID),
+			outboxevent.PublishedAtIsNil(),
+		).
+		ClearClaimID().
+		ClearClaimedUntil().
+		Exec(ctx)
+}
+
+// unclaimed matches the events without a claim or with an expired claim
+func unclaimed(now time.Time) predicate.OutboxEvent {
+	return outboxevent.Or(
+		outboxevent.ClaimedUntilIsNil(),
+		outboxevent.ClaimedUntilLT(now),
+	)
+}
+
+// blockedAggregates returns the aggregates of the claimed events that have an earlier pending event outside of the
+// claim, either waiting on a retry or claimed by another relay, mapped to the id of that earlier event
+func (r *Relay) blockedAggregates(ctx context.Context, pending []*ent.OutboxEvent, claimID string) (map[string]string, error) {
+	blocked := map[string]string{}
+
+	if len(pending) == 0 {
+		return blocked, nil
+	}
+
+	aggregateIDs := make([]string, 0, len(pending))
+	for _, evt := range pending {
+		aggregateIDs = append(aggregateIDs, evt.AggregateID)
+	}
+
+	waiting, err := r.client.OutboxEvent.Query().
+		Where(
+			outboxevent.PublishedAtIsNil(),
+			outboxevent.TopicIn(r.topics...),
+			outboxevent.AggregateIDIn(aggregateIDs...),
+			outboxevent.Or(
+				outboxevent.ClaimIDIsNil(),
+				outboxevent.ClaimIDNEQ(claimID),
+			),
+		).
+		Order(ent.Asc(outboxevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, evt := range waiting {
+		if _, ok := blocked[evt.AggregateID]; !ok {
+			blocked[evt.AggregateID] = evt.ID
+		}
+	}
+
+	return blocked, nil
+}
+
+// publish sends the event to the publisher and records the outcome on the event
+func (r *Relay) publish(ctx context.Context, evt *ent.OutboxEvent) error {
+	msg := &events.Message{
+		ID:             evt.ID,
+		Topic:          evt.Topic,
+		AggregateType:  evt.AggregateType,
+		AggregateID:    evt.AggregateID,
+		OrganizationID: evt.OrganizationID,
+		Payload:        evt.Payload,
+		CreatedAt:      evt.CreatedAt,
+	}
+
+	attempts := evt.Attempts + 1
+
+	publishedTo, pubErr := r.publishPending(ctx, evt, msg)
+	if pubErr != nil {
+		failedEvents.Inc()
+
+		if err := r.client.OutboxEvent.UpdateOneID(evt.ID).
+			SetAttempts(attempts).
+			SetPublishedTo(publishedTo).
+			SetLastError(pubErr.Error()).
+			SetAvailableAt(time.Now().Add(r.backoff(attempts))).
+			Exec(ctx); err != nil {
+			return errors.Join(pubErr, err)
+		}
+
+		return pubErr
+	}
+
+	publishedEvents.Inc()
+
+	// if this update fails the event is published again on the next drain
+	return r.client.OutboxEvent.UpdateOneID(evt.ID).
+		SetAttempts(attempts).
+		SetPublishedTo(publishedTo).
+		SetPublishedAt(time.Now()).
+		ClearLastError().
+		ClearClaimID().
+		ClearClaimedUntil().
+		Exec(ctx)
+}
+
+// publishPending sends the event to the publishers that have not accepted it on an earlier attempt and returns the
+// names of the publishers that have accepted it
+func (r *Relay) publishPending(ctx context.Context, evt *ent.OutboxEvent, msg *events.Message) ([]string, error) {
+	if p, ok := r.publisher.(events.PendingPublisher); ok {
+		return p.PublishPending(ctx, evt.Topic, msg, evt.PublishedTo)
+	}
+
+	return evt.PublishedTo, r.publisher.Publish(ctx, evt.Topic, msg)
+}
+
+// backoff returns the time to wait before the next attempt, doubling the interval on every attempt
+func (r *Relay) backoff(attempts int) time.Duration {
+	backoff := r.config.Interval
+
+	for i := 1; i < attempts && backoff < r.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, r.config.MaxBackoff)
+}
+
+// cleanup deletes the published events older than the retention period
+func (r *Relay) cleanup(ctx context.Context, now time.Time) error {
+	if r.config.Retention <= 0 {
+		return nil
+	}
+
+	_, err := r.client.OutboxEvent.Delete().
+		Where(outboxevent.PublishedAtLT(now.Add(-r.config.Retention))).
+		Exec(ctx)
+
+	return err
+}
+
+// recordLag updates the pending and lag metrics
+func (r *Relay) recordLag(ctx context.Context, now time.Time) error {
+	pending, err := r.client.OutboxEvent.Query().
+		Where(
+			outboxevent.PublishedAtIsNil(),
+			outboxevent.TopicIn(r.topics...),
+		).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	pendingEvents.Set(float64(pending))
+
+	if pending == 0 {
+		lagSeconds.Set(0)
+
+		return nil
+	}
+
+	oldest, err := r.client.OutboxEvent.Query().
+		Where(
+			outboxevent.PublishedAtIsNil(),
+			outboxevent.TopicIn(r.topics...),
+		).
+		Order(ent.Asc(outboxevent.FieldID)).
+		First(ctx)
+	if err != nil {
+		return err
+	}
+
+	lagSeconds.Set(now.Sub(oldest.CreatedAt).Seconds())
+
+	return nil
+}
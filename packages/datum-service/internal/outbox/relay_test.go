@@ -0,0 +1,349 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/internal/ent/entconfig"
+	ent "github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/internal/ent/generated/outboxevent"
+	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	_ "github.com/datumforge/datum/internal/ent/generated/runtime"
+	"github.com/datumforge/datum/internal/entdb"
+	"github.com/datumforge/datum/internal/outbox"
+	"github.com/datumforge/datum/pkg/events"
+	"github.com/datumforge/datum/pkg/events/memory"
+	"github.com/datumforge/datum/pkg/events/soiree"
+	"github.com/datumforge/datum/pkg/testutils"
+)
+
+// flakyPublisher records the published messages and fails the first attempt of the configured events
+type flakyPublisher struct {
+	mu        sync.Mutex
+	failOnce  map[string]bool
+	published []string
+}
+
+func (p *flakyPublisher) StartPublisher(context.Context) error { return nil }
+
+func (p *flakyPublisher) Close(context.Context) error { return nil }
+
+func (p *flakyPublisher) Publish(_ context.Context, _ string, payload interface{}) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	msg := payload.(*events.Message)
+	name := msg.Payload["name"].(string)
+
+	p.published = append(p.published, name)
+
+	if p.failOnce[name] {
+		delete(p.failOnce, name)
+
+		return errors.New("broker unavailable")
+	}
+
+	return nil
+}
+
+func newTestClient(t *testing.T) *ent.Client {
+	tf := entdb.NewTestFixture()
+	t.Cleanup(func() { testutils.TeardownFixture(tf) })
+
+	db, err := entdb.NewTestClient(context.Background(), tf, []ent.Option{})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func createEvent(ctx context.Context, t *testing.T, db *ent.Client, aggregateID, name string) *ent.OutboxEvent {
+	return db.OutboxEvent.Create().
+		SetTopic("group.created").
+		SetAggregateType("group").
+		SetAggregateID(aggregateID).
+		SetOrganizationID("org").
+		SetPayload(map[string]interface{}{"name": name}).
+		SaveX(ctx)
+}
+
+func TestRelayDrain(t *testing.T) {
+	db := newTestClient(t)
+	ctx := privacy.DecisionContext(context.Background(), privacy.Allow)
+
+	pool := soiree.NewEventPool()
+
+	var (
+		mu       sync.Mutex
+		received []events.Message
+	)
+
+	_, err := pool.On("group.created", func(e soiree.Event) error {
+		var msg events.Message
+		if err := json.Unmarshal(e.Payload().([]byte), &msg); err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		received = append(received, msg)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	broker, err := memory.NewBroker(pool, "group.created")
+	require.NoError(t, err)
+	require.NoError(t, broker.Subscribe(ctx))
+
+	relay := outbox.NewRelay(db, broker, entconfig.Outbox{Retention: time.Hour}, nil)
+	require.NoError(t, relay.Start(ctx))
+
+	t.Cleanup(func() { relay.Close(context.Background()) })
+
+	evt := createEvent(ctx, t, db, "group-1", "meow")
+
+	// the relay polls in the background
+	require.Eventually(t, func() bool {
+		published, err := db.OutboxEvent.Get(ctx, evt.ID)
+
+		return err == nil && published.PublishedAt != nil
+	}, 5*time.Second, 50*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	require.Len(t, received, 1)
+	assert.Equal(t, evt.ID, received[0].ID)
+	assert.Equal(t, "group-1", received[0].AggregateID)
+	assert.Equal(t, "org", received[0].OrganizationID)
+	assert.Equal(t, "meow", received[0].Payload["name"])
+
+	// published events are not published again
+	n, err := relay.Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Len(t, received, 1)
+
+	assert.ErrorIs(t, relay.Start(ctx), outbox.ErrRelayAlreadyStarted)
+}
+
+func TestRelayOrdering(t *testing.T) {
+	db := newTestClient(t)
+	ctx := privacy.DecisionContext(context.Background(), privacy.Allow)
+
+	publisher := &flakyPublisher{failOnce: map[string]bool{"a1": true}}
+
+	relay := outbox.NewRelay(db, publisher, entconfig.Outbox{Interval: time.Minute}, nil)
+
+	a1 := createEvent(ctx, t, db, "a", "a1")
+	createEvent(ctx, t, db, "a", "a2")
+	createEvent(ctx, t, db, "b", "b1")
+
+	// a1 fails, a2 is held back to keep the events about a in order
+	n, err := relay.Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"a1", "b1"}, publisher.published)
+
+	failed, err := db.OutboxEvent.Get(ctx, a1.ID)
+	require.NoError(t, err)
+	assert.Nil(t, failed.PublishedAt)
+	assert.Equal(t, 1, failed.Attempts)
+	assert.Equal(t, "broker unavailable", failed.LastError)
+	assert.True(t, failed.AvailableAt.After(time.Now()))
+
+	// a1 is backing off, so a2 is still held back
+	n, err = relay.Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	// once a1 is available again, both events are published in order
+	db.OutboxEvent.UpdateOneID(a1.ID).SetAvailableAt(time.Now()).ExecX(ctx)
+
+	n, err = relay.Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"a1", "b1", "a1", "a2"}, publisher.published)
+
+	published, err := db.OutboxEvent.Get(ctx, a1.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, published.PublishedAt)
+	assert.Equal(t, 2, published.Attempts)
+	assert.Empty(t, published.LastError)
+}
+
+func TestRelayClaims(t *testing.T) {
+	db := newTestClient(t)
+	ctx := privacy.DecisionContext(context.Background(), privacy.Allow)
+
+	a1 := createEvent(ctx, t, db, "a", "a1")
+	createEvent(ctx, t, db, "a", "a2")
+	createEvent(ctx, t, db, "b", "b1")
+
+	// a1 is claimed by the drain of another relay
+	db.OutboxEvent.UpdateOneID(a1.ID).
+		SetClaimID("other-relay").
+		SetClaimedUntil(time.Now().Add(time.Minute)).
+		ExecX(ctx)
+
+	publisher := &flakyPublisher{}
+	relay := outbox.NewRelay(db, publisher, entconfig.Outbox{Interval: time.Minute}, nil)
+
+	// a1 is not published again, and a2 is held back until a1 is published
+	n, err := relay.Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"b1"}, publisher.published)
+
+	// the unpublished events of the batch are released
+	held, err := db.OutboxEvent.Query().Where(outboxevent.PublishedAtIsNil()).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, held, 2)
+
+	for _, evt := range held {
+		if evt.ID == a1.ID {
+			assert.Equal(t, "other-relay", *evt.ClaimID)
+
+			continue
+		}
+
+		assert.Nil(t, evt.ClaimID)
+		assert.Nil(t, evt.ClaimedUntil)
+	}
+
+	// once the claim of the other relay expired, the events are published in order
+	db.OutboxEvent.UpdateOneID(a1.ID).SetClaimedUntil(time.Now().Add(-time.Second)).ExecX(ctx)
+
+	n, err = relay.Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"b1", "a1", "a2"}, publisher.published)
+
+	published, err := db.OutboxEvent.Get(ctx, a1.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, published.PublishedAt)
+	assert.Nil(t, published.ClaimID)
+}
+
+func TestRelayConcurrentDrains(t *testing.T) {
+	db := newTestClient(t)
+	ctx := privacy.DecisionContext(context.Background(), privacy.Allow)
+
+	for i := range 20 {
+		createEvent(ctx, t, db, fmt.Sprintf("group-%d", i), fmt.Sprintf("g%d", i))
+	}
+
+	publisher := &flakyPublisher{}
+
+	relays := []*outbox.Relay{
+		outbox.NewRelay(db, publisher, entconfig.Outbox{Interval: time.Minute, BatchSize: 5}, nil),
+		outbox.NewRelay(db, publisher, entconfig.Outbox{Interval: time.Minute, BatchSize: 5}, nil),
+		outbox.NewRelay(db, publisher, entconfig.Outbox{Interval: time.Minute, BatchSize: 5}, nil),
+	}
+
+	var wg sync.WaitGroup
+
+	for _, relay := range relays {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range 10 {
+				_, err := relay.Drain(ctx)
+				assert.NoError(t, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// every event is published exactly once
+	assert.Len(t, publisher.published, 20)
+	assert.ElementsMatch(t, lo.Uniq(publisher.published), publisher.published)
+}
+
+func TestRelayTopics(t *testing.T) {
+	db := newTestClient(t)
+	ctx := privacy.DecisionContext(context.Background(), privacy.Allow)
+
+	createEvent(ctx, t, db, "group-1", "service")
+
+	custom := db.OutboxEvent.Create().
+		SetTopic("custom.topic").
+		SetAggregateType("topic").
+		SetAggregateID("custom.topic").
+		SetPayload(map[string]interface{}{"name": "custom"}).
+		SaveX(ctx)
+
+	publisher := &flakyPublisher{}
+
+	// only the events generated by the service are relayed by default
+	n, err := outbox.NewRelay(db, publisher, entconfig.Outbox{}, nil).Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"service"}, publisher.published)
+
+	unpublished, err := db.OutboxEvent.Get(ctx, custom.ID)
+	require.NoError(t, err)
+	assert.Nil(t, unpublished.PublishedAt)
+
+	// the relayed topics can be configured
+	n, err = outbox.NewRelay(db, publisher, entconfig.Outbox{}, nil, outbox.WithTopics("custom.topic")).Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"service", "custom"}, publisher.published)
+}
+
+func TestRelayMultiPublisher(t *testing.T) {
+	db := newTestClient(t)
+	ctx := privacy.DecisionContext(context.Background(), privacy.Allow)
+
+	inProcess := &flakyPublisher{}
+	kafka := &flakyPublisher{failOnce: map[string]bool{"meow": true}}
+
+	publishers := events.MultiPublisher{
+		{Name: "graphapi", EventPublisher: inProcess},
+		{Name: "kafka", EventPublisher: kafka},
+	}
+
+	relay := outbox.NewRelay(db, publishers, entconfig.Outbox{Interval: time.Minute}, nil)
+
+	evt := createEvent(ctx, t, db, "group-1", "meow")
+
+	// kafka fails, the publisher that accepted the event is recorded
+	n, err := relay.Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	failed, err := db.OutboxEvent.Get(ctx, evt.ID)
+	require.NoError(t, err)
+	assert.Nil(t, failed.PublishedAt)
+	assert.Equal(t, []string{"graphapi"}, failed.PublishedTo)
+
+	// the retry is only published to kafka
+	db.OutboxEvent.UpdateOneID(evt.ID).SetAvailableAt(time.Now()).ExecX(ctx)
+
+	n, err = relay.Drain(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"meow"}, inProcess.published)
+	assert.Equal(t, []string{"meow", "meow"}, kafka.published)
+
+	published, err := db.OutboxEvent.Get(ctx, evt.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, published.PublishedAt)
+	assert.Equal(t, []string{"graphapi", "kafka"}, published.PublishedTo)
+}
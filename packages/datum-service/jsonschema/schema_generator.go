@@ -36,6 +36,7 @@ var includedPackages = []string{
 	"./pkg/cache",
 	"./internal/ent",
 	"./internal/entdb",
+	"./internal/graphapi",
 	"./internal/httpserve/handlers",
 	"./pkg/otelx",
 	"./pkg/sessions",
@@ -45,6 +46,9 @@ var includedPackages = []string{
 	"./pkg/analytics",
 	"./pkg/middleware",
 	"./pkg/events/kafka/kafkaconfig",
+	"./pkg/events/pubsub",
+	"./pkg/webhooks",
+	"./pkg/objects",
 }
 
 // schemaConfig represents the configuration for the schema generator
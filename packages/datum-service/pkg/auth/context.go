@@ -51,6 +51,9 @@ type AuthenticatedUser struct {
 	OrganizationIDs []string
 	// AuthenticationType is the type of authentication used to authenticate the user (JWT, PAT, API Token)
 	AuthenticationType AuthenticationType
+	// Scopes are the scopes of the api token or personal access token used to authenticate, they are not set for
+	// JWT authentication
+	Scopes []string
 }
 
 // GetContextName returns the name of the context key
@@ -91,6 +94,16 @@ func AddAuthenticatedUserContext(c echo.Context, user *AuthenticatedUser) contex
 	return ctx
 }
 
+// GetAuthenticatedUserFromEchoContext retrieves the authenticated user from the echo context
+func GetAuthenticatedUserFromEchoContext(c echo.Context) (*AuthenticatedUser, error) {
+	au, ok := c.Get(ContextAuthenticatedUser.name).(*AuthenticatedUser)
+	if !ok {
+		return nil, ErrNoAuthUser
+	}
+
+	return au, nil
+}
+
 // GetAuthTypeFromEchoContext retrieves the authentication type from the echo context
 func GetAuthTypeFromEchoContext(c echo.Context) AuthenticationType {
 	if v := c.Get(ContextAuthenticatedUser.name); v != nil {
@@ -17,6 +17,18 @@ var (
 	// ErrUnverifiedUser is returned when the user is not verified
 	ErrUnverifiedUser = errors.New("user is not verified")
 
+	// ErrUserLocked is returned when the account of the user is locked
+	ErrUserLocked = errors.New("user account is locked")
+
+	// ErrUserSuspended is returned when the account of the user is suspended
+	ErrUserSuspended = errors.New("user account is suspended")
+
+	// ErrInvalidScope is returned when a token scope is not in the form of resource:action or is unknown
+	ErrInvalidScope = errors.New("invalid scope")
+
+	// ErrInsufficientScope is returned when the scopes of the token do not allow the requested operation
+	ErrInsufficientScope = errors.New("token scopes do not allow the requested operation")
+
 	// ErrParseBearer is returned when the bearer token could not be parsed from the authorization header
 	ErrParseBearer = errors.New("could not parse bearer token from authorization header")
 
@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sliceutil "github.com/datumforge/datum/pkg/utils/slice"
+)
+
+const (
+	// ScopeActionRead allows reading the resource
+	ScopeActionRead = "read"
+	// ScopeActionWrite allows creating and updating the resource
+	ScopeActionWrite = "write"
+	// ScopeActionDelete allows deleting the resource
+	ScopeActionDelete = "delete"
+
+	// ScopeWildcard matches all resources
+	ScopeWildcard = "*"
+
+	// scopeSeparator separates the resource and the action of a scope
+	scopeSeparator = ":"
+)
+
+// ScopeActions are the actions a scope can grant on a resource
+var ScopeActions = []string{ScopeActionRead, ScopeActionWrite, ScopeActionDelete}
+
+// ScopeResources are the resources a scope can be granted on, the names match the lowercase name of the
+// graph type, except `scim` which grants access to the SCIM provisioning endpoints; resources that are not
+// listed can only be accessed with a wildcard scope
+var ScopeResources = []string{
+	"apitoken",
+	"auditlog",
+	"contact",
+	"documentdata",
+	"entitlement",
+	"entitlementplan",
+	"entitlementplanfeature",
+	"entity",
+	"entitytype",
+	"event",
+	"feature",
+	"file",
+	"group",
+	"groupmembership",
+	"groupsetting",
+	"hush",
+	"integration",
+	"invite",
+	"note",
+	"oauthprovider",
+	"ohauthtootoken",
+	"organization",
+	"organizationsetting",
+	"orgmembership",
+	"personalaccesstoken",
+	"scim",
+	"search",
+	"session",
+	"subscriber",
+	"template",
+	"tfasetting",
+	"user",
+	"usersetting",
+	"webhook",
+	"webhookdelivery",
+}
+
+// Scope is a permission granted to an api token or personal access token in the form of `resource:action`
+type Scope struct {
+	// Resource the scope is granted on, or `*` for all resources
+	Resource string
+	// Action the scope allows on the resource
+	Action string
+}
+
+// String returns the scope in the form of `resource:action`
+func (s Scope) String() string {
+	return s.Resource + scopeSeparator + s.Action
+}
+
+// ParseScope parses the `resource:action` scope, a scope with only an action (e.g. `read`) is granted on all
+// resources; an error is returned for unknown resources and actions
+func ParseScope(scope string) (Scope, error) {
+	resource, action, found := strings.Cut(strings.ToLower(strings.TrimSpace(scope)), scopeSeparator)
+	if !found {
+		resource, action = ScopeWildcard, resource
+	}
+
+	if !sliceutil.Contains(ScopeActions, action) {
+		return Scope{}, fmt.Errorf("%w: %s", ErrInvalidScope, scope)
+	}
+
+	if resource != ScopeWildcard && !sliceutil.Contains(ScopeResources, resource) {
+		return Scope{}, fmt.Errorf("%w: %s", ErrInvalidScope, scope)
+	}
+
+	return Scope{Resource: resource, Action: action}, nil
+}
+
+// ValidateScopes returns an error if any of the scopes is unknown
+func ValidateScopes(scopes []string) error {
+	for _, s := range scopes {
+		if _, err := ParseScope(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ScopesAllow returns true if any of the scopes grants the action on the resource; invalid scopes are ignored
+func ScopesAllow(scopes []string, resource, action string) bool {
+	for _, s := range scopes {
+		scope, err := ParseScope(s)
+		if err != nil {
+			continue
+		}
+
+		if scope.Action == action && (scope.Resource == ScopeWildcard || scope.Resource == resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckScope returns an error if the authenticated user in the context is not allowed to perform the action on
+// the resource; users authenticated with a JWT are not restricted by scopes, api tokens and personal access tokens
+// are restricted to the scopes they were created with
+func CheckScope(ctx context.Context, resource, action string) error {
+	au, err := GetAuthenticatedUserContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return au.CheckScope(resource, action)
+}
+
+// CheckScope returns an error if the authenticated user is not allowed to perform the action on the resource
+func (a *AuthenticatedUser) CheckScope(resource, action string) error {
+	if a.AuthenticationType != PATAuthentication && a.AuthenticationType != APITokenAuthentication {
+		return nil
+	}
+
+	if !ScopesAllow(a.Scopes, resource, action) {
+		return fmt.Errorf("%w: %s", ErrInsufficientScope, Scope{Resource: resource, Action: action})
+	}
+
+	return nil
+}
+
+// CheckGrantScopes returns an error if the authenticated user in the context is not allowed to grant the scopes to
+// a token; api tokens and personal access tokens can only grant the scopes they hold so they cannot create or update
+// a token with more access than they have
+func CheckGrantScopes(ctx context.Context, scopes []string) error {
+	au, err := GetAuthenticatedUserContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return au.CheckGrantScopes(scopes)
+}
+
+// CheckGrantScopes returns an error if the authenticated user is not allowed to grant the scopes to a token
+func (a *AuthenticatedUser) CheckGrantScopes(scopes []string) error {
+	for _, s := range scopes {
+		scope, err := ParseScope(s)
+		if err != nil {
+			return err
+		}
+
+		if err := a.CheckScope(scope.Resource, scope.Action); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
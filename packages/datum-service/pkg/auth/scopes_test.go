@@ -0,0 +1,207 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/auth"
+)
+
+func TestParseScope(t *testing.T) {
+	testCases := []struct {
+		name     string
+		scope    string
+		expected auth.Scope
+		err      error
+	}{
+		{
+			name:     "resource scope",
+			scope:    "organization:read",
+			expected: auth.Scope{Resource: "organization", Action: auth.ScopeActionRead},
+		},
+		{
+			name:     "wildcard scope",
+			scope:    "*:delete",
+			expected: auth.Scope{Resource: auth.ScopeWildcard, Action: auth.ScopeActionDelete},
+		},
+		{
+			name:     "action only scope is granted on all resources",
+			scope:    "write",
+			expected: auth.Scope{Resource: auth.ScopeWildcard, Action: auth.ScopeActionWrite},
+		},
+		{
+			name:     "scope is case insensitive",
+			scope:    "Group:Write",
+			expected: auth.Scope{Resource: "group", Action: auth.ScopeActionWrite},
+		},
+		{
+			name:  "unknown resource",
+			scope: "tacos:read",
+			err:   auth.ErrInvalidScope,
+		},
+		{
+			name:  "unknown action",
+			scope: "organization:eat",
+			err:   auth.ErrInvalidScope,
+		},
+		{
+			name:  "empty scope",
+			scope: "",
+			err:   auth.ErrInvalidScope,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := auth.ParseScope(tc.scope)
+			if tc.err != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestCheckScope(t *testing.T) {
+	testCases := []struct {
+		name     string
+		user     *auth.AuthenticatedUser
+		resource string
+		action   string
+		err      error
+	}{
+		{
+			name:     "jwt users are not restricted",
+			user:     &auth.AuthenticatedUser{AuthenticationType: auth.JWTAuthentication},
+			resource: "organization",
+			action:   auth.ScopeActionDelete,
+		},
+		{
+			name: "token with resource scope",
+			user: &auth.AuthenticatedUser{
+				AuthenticationType: auth.PATAuthentication,
+				Scopes:             []string{"organization:read"},
+			},
+			resource: "organization",
+			action:   auth.ScopeActionRead,
+		},
+		{
+			name: "token with wildcard scope",
+			user: &auth.AuthenticatedUser{
+				AuthenticationType: auth.APITokenAuthentication,
+				Scopes:             []string{"read"},
+			},
+			resource: "group",
+			action:   auth.ScopeActionRead,
+		},
+		{
+			name: "token without the action",
+			user: &auth.AuthenticatedUser{
+				AuthenticationType: auth.PATAuthentication,
+				Scopes:             []string{"organization:read"},
+			},
+			resource: "organization",
+			action:   auth.ScopeActionWrite,
+			err:      auth.ErrInsufficientScope,
+		},
+		{
+			name: "token without the resource",
+			user: &auth.AuthenticatedUser{
+				AuthenticationType: auth.APITokenAuthentication,
+				Scopes:             []string{"organization:read"},
+			},
+			resource: "group",
+			action:   auth.ScopeActionRead,
+			err:      auth.ErrInsufficientScope,
+		},
+		{
+			name:     "token without scopes",
+			user:     &auth.AuthenticatedUser{AuthenticationType: auth.APITokenAuthentication},
+			resource: "group",
+			action:   auth.ScopeActionRead,
+			err:      auth.ErrInsufficientScope,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.user.CheckScope(tc.resource, tc.action)
+			if tc.err != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckGrantScopes(t *testing.T) {
+	testCases := []struct {
+		name   string
+		user   *auth.AuthenticatedUser
+		scopes []string
+		err    error
+	}{
+		{
+			name:   "jwt user grants any scope",
+			user:   &auth.AuthenticatedUser{AuthenticationType: auth.JWTAuthentication},
+			scopes: []string{"*:write", "*:delete"},
+		},
+		{
+			name: "token grants scopes it holds",
+			user: &auth.AuthenticatedUser{
+				AuthenticationType: auth.APITokenAuthentication,
+				Scopes:             []string{"*:read", "apitoken:write"},
+			},
+			scopes: []string{"group:read", "apitoken:write"},
+		},
+		{
+			name: "token grants a wildcard scope it does not hold",
+			user: &auth.AuthenticatedUser{
+				AuthenticationType: auth.APITokenAuthentication,
+				Scopes:             []string{"apitoken:write"},
+			},
+			scopes: []string{"*:write"},
+			err:    auth.ErrInsufficientScope,
+		},
+		{
+			name: "token grants an action it does not hold",
+			user: &auth.AuthenticatedUser{
+				AuthenticationType: auth.PATAuthentication,
+				Scopes:             []string{"personalaccesstoken:write"},
+			},
+			scopes: []string{"personalaccesstoken:delete"},
+			err:    auth.ErrInsufficientScope,
+		},
+		{
+			name:   "invalid scope",
+			user:   &auth.AuthenticatedUser{AuthenticationType: auth.JWTAuthentication},
+			scopes: []string{"meow:write"},
+			err:    auth.ErrInvalidScope,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.user.CheckGrantScopes(tc.scopes)
+			if tc.err != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
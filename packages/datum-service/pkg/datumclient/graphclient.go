@@ -20,20 +20,26 @@ type DatumGraphClient interface {
 	GetAllAPITokens(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllAPITokens, error)
 	GetAPITokenByID(ctx context.Context, apiTokenID string, interceptors ...clientv2.RequestInterceptor) (*GetAPITokenByID, error)
 	DeleteAPIToken(ctx context.Context, deleteAPITokenID string, interceptors ...clientv2.RequestInterceptor) (*DeleteAPIToken, error)
+	AuditLogs(ctx context.Context, first *int64, after *string, last *int64, before *string, where *AuditLogWhereInput, interceptors ...clientv2.RequestInterceptor) (*AuditLogs, error)
 	CreateBulkCSVContact(ctx context.Context, input graphql.Upload, interceptors ...clientv2.RequestInterceptor) (*CreateBulkCSVContact, error)
 	CreateBulkContact(ctx context.Context, input []*CreateContactInput, interceptors ...clientv2.RequestInterceptor) (*CreateBulkContact, error)
 	CreateContact(ctx context.Context, input CreateContactInput, interceptors ...clientv2.RequestInterceptor) (*CreateContact, error)
+	DeleteBulkContact(ctx context.Context, ids []string, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*DeleteBulkContact, error)
 	DeleteContact(ctx context.Context, deleteContactID string, interceptors ...clientv2.RequestInterceptor) (*DeleteContact, error)
 	GetAllContacts(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllContacts, error)
 	GetContactByID(ctx context.Context, contactID string, interceptors ...clientv2.RequestInterceptor) (*GetContactByID, error)
 	GetContacts(ctx context.Context, where *ContactWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetContacts, error)
+	ImportBulkCSVContact(ctx context.Context, input graphql.Upload, mapping []*CSVColumnMapping, dryRun *bool, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*ImportBulkCSVContact, error)
+	UpdateBulkContact(ctx context.Context, ids []string, input UpdateContactInput, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*UpdateBulkContact, error)
 	UpdateContact(ctx context.Context, updateContactID string, input UpdateContactInput, interceptors ...clientv2.RequestInterceptor) (*UpdateContact, error)
+	UpsertBulkCSVContact(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*UpsertBulkCSVContact, error)
 	GetAllContactHistories(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllContactHistories, error)
 	GetContactHistories(ctx context.Context, where *ContactHistoryWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetContactHistories, error)
 	CreateDocumentData(ctx context.Context, input CreateDocumentDataInput, interceptors ...clientv2.RequestInterceptor) (*CreateDocumentData, error)
 	DeleteDocumentData(ctx context.Context, deleteDocumentDataID string, interceptors ...clientv2.RequestInterceptor) (*DeleteDocumentData, error)
 	GetDocumentDataByID(ctx context.Context, documentDataID string, interceptors ...clientv2.RequestInterceptor) (*GetDocumentDataByID, error)
 	UpdateDocumentData(ctx context.Context, updateDocumentDataID string, input UpdateDocumentDataInput, interceptors ...clientv2.RequestInterceptor) (*UpdateDocumentData, error)
+	RenderDocumentData(ctx context.Context, renderDocumentDataID string, format *DocumentRenderFormat, interceptors ...clientv2.RequestInterceptor) (*RenderDocumentData, error)
 	GetAllDocumentDataHistories(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllDocumentDataHistories, error)
 	GetDocumentDataHistories(ctx context.Context, where *DocumentDataHistoryWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetDocumentDataHistories, error)
 	CreateBulkCSVEntitlement(ctx context.Context, input graphql.Upload, interceptors ...clientv2.RequestInterceptor) (*CreateBulkCSVEntitlement, error)
@@ -69,11 +75,15 @@ type DatumGraphClient interface {
 	CreateBulkCSVEntity(ctx context.Context, input graphql.Upload, interceptors ...clientv2.RequestInterceptor) (*CreateBulkCSVEntity, error)
 	CreateBulkEntity(ctx context.Context, input []*CreateEntityInput, interceptors ...clientv2.RequestInterceptor) (*CreateBulkEntity, error)
 	CreateEntity(ctx context.Context, input CreateEntityInput, interceptors ...clientv2.RequestInterceptor) (*CreateEntity, error)
+	DeleteBulkEntity(ctx context.Context, ids []string, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*DeleteBulkEntity, error)
 	DeleteEntity(ctx context.Context, deleteEntityID string, interceptors ...clientv2.RequestInterceptor) (*DeleteEntity, error)
 	GetAllEntities(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllEntities, error)
 	GetEntities(ctx context.Context, where *EntityWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetEntities, error)
 	GetEntityByID(ctx context.Context, entityID string, interceptors ...clientv2.RequestInterceptor) (*GetEntityByID, error)
+	ImportBulkCSVEntity(ctx context.Context, input graphql.Upload, mapping []*CSVColumnMapping, dryRun *bool, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*ImportBulkCSVEntity, error)
+	UpdateBulkEntity(ctx context.Context, ids []string, input UpdateEntityInput, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*UpdateBulkEntity, error)
 	UpdateEntity(ctx context.Context, updateEntityID string, input UpdateEntityInput, interceptors ...clientv2.RequestInterceptor) (*UpdateEntity, error)
+	UpsertBulkCSVEntity(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*UpsertBulkCSVEntity, error)
 	GetAllEntityHistories(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllEntityHistories, error)
 	GetEntityHistories(ctx context.Context, where *EntityHistoryWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetEntityHistories, error)
 	CreateBulkCSVEntityType(ctx context.Context, input graphql.Upload, interceptors ...clientv2.RequestInterceptor) (*CreateBulkCSVEntityType, error)
@@ -207,6 +217,8 @@ type DatumGraphClient interface {
 	GetPersonalAccessTokenByID(ctx context.Context, personalAccessTokenID string, interceptors ...clientv2.RequestInterceptor) (*GetPersonalAccessTokenByID, error)
 	UpdatePersonalAccessToken(ctx context.Context, updatePersonalAccessTokenID string, input UpdatePersonalAccessTokenInput, interceptors ...clientv2.RequestInterceptor) (*UpdatePersonalAccessToken, error)
 	Search(ctx context.Context, query string, interceptors ...clientv2.RequestInterceptor) (*Search, error)
+	GetUserSessions(ctx context.Context, userID *string, interceptors ...clientv2.RequestInterceptor) (*GetUserSessions, error)
+	RevokeSession(ctx context.Context, revokeSessionID string, interceptors ...clientv2.RequestInterceptor) (*RevokeSession, error)
 	CreateBulkCSVSubscriber(ctx context.Context, input graphql.Upload, interceptors ...clientv2.RequestInterceptor) (*CreateBulkCSVSubscriber, error)
 	CreateBulkSubscriber(ctx context.Context, input []*CreateSubscriberInput, interceptors ...clientv2.RequestInterceptor) (*CreateBulkSubscriber, error)
 	CreateSubscriber(ctx context.Context, input CreateSubscriberInput, interceptors ...clientv2.RequestInterceptor) (*CreateSubscriber, error)
@@ -223,10 +235,16 @@ type DatumGraphClient interface {
 	UpdateTemplate(ctx context.Context, updateTemplateID string, input UpdateTemplateInput, interceptors ...clientv2.RequestInterceptor) (*UpdateTemplate, error)
 	GetAllTemplateHistories(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllTemplateHistories, error)
 	GetTemplateHistories(ctx context.Context, where *TemplateHistoryWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetTemplateHistories, error)
+	GetTemplateVersions(ctx context.Context, first *int64, after *string, where *TemplateVersionWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetTemplateVersions, error)
+	GetTemplateVersionDiff(ctx context.Context, templateID string, from int64, to int64, interceptors ...clientv2.RequestInterceptor) (*GetTemplateVersionDiff, error)
+	MigrateTemplateDocuments(ctx context.Context, templateID string, version int64, interceptors ...clientv2.RequestInterceptor) (*MigrateTemplateDocuments, error)
+	CloneTemplate(ctx context.Context, id string, ownerID *string, name *string, interceptors ...clientv2.RequestInterceptor) (*CloneTemplate, error)
 	CreateTFASetting(ctx context.Context, input CreateTFASettingInput, interceptors ...clientv2.RequestInterceptor) (*CreateTFASetting, error)
 	GetAllTFASettings(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllTFASettings, error)
 	GetTFASetting(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetTFASetting, error)
 	UpdateTFASetting(ctx context.Context, input UpdateTFASettingInput, interceptors ...clientv2.RequestInterceptor) (*UpdateTFASetting, error)
+	VerifyTFASetting(ctx context.Context, totpCode string, interceptors ...clientv2.RequestInterceptor) (*VerifyTFASetting, error)
+	RegenerateTFARecoveryCodes(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*RegenerateTFARecoveryCodes, error)
 	CreateUser(ctx context.Context, input CreateUserInput, interceptors ...clientv2.RequestInterceptor) (*CreateUser, error)
 	DeleteUser(ctx context.Context, deleteUserID string, interceptors ...clientv2.RequestInterceptor) (*DeleteUser, error)
 	GetAllUsers(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllUsers, error)
@@ -241,6 +259,10 @@ type DatumGraphClient interface {
 	UpdateUserSetting(ctx context.Context, updateUserSettingID string, input UpdateUserSettingInput, interceptors ...clientv2.RequestInterceptor) (*UpdateUserSetting, error)
 	GetAllUserSettingHistories(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllUserSettingHistories, error)
 	GetUserSettingHistories(ctx context.Context, where *UserSettingHistoryWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetUserSettingHistories, error)
+	LockUser(ctx context.Context, lockUserID string, until *time.Time, interceptors ...clientv2.RequestInterceptor) (*LockUser, error)
+	UnlockUser(ctx context.Context, unlockUserID string, interceptors ...clientv2.RequestInterceptor) (*UnlockUser, error)
+	SuspendUser(ctx context.Context, suspendUserID string, interceptors ...clientv2.RequestInterceptor) (*SuspendUser, error)
+	UnsuspendUser(ctx context.Context, unsuspendUserID string, interceptors ...clientv2.RequestInterceptor) (*UnsuspendUser, error)
 	GetWebhookByID(ctx context.Context, webhookID string, interceptors ...clientv2.RequestInterceptor) (*GetWebhookByID, error)
 	GetAllWebhooks(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllWebhooks, error)
 	CreateWebhook(ctx context.Context, input CreateWebhookInput, interceptors ...clientv2.RequestInterceptor) (*CreateWebhook, error)
@@ -248,6 +270,8 @@ type DatumGraphClient interface {
 	CreateBulkCSVWebhook(ctx context.Context, input graphql.Upload, interceptors ...clientv2.RequestInterceptor) (*CreateBulkCSVWebhook, error)
 	UpdateWebhook(ctx context.Context, updateWebhookID string, input UpdateWebhookInput, interceptors ...clientv2.RequestInterceptor) (*UpdateWebhook, error)
 	DeleteWebhook(ctx context.Context, deleteWebhookID string, interceptors ...clientv2.RequestInterceptor) (*DeleteWebhook, error)
+	GetWebhookDeliveries(ctx context.Context, first *int64, after *string, where *WebhookDeliveryWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetWebhookDeliveries, error)
+	RedeliverWebhookEvent(ctx context.Context, webhookID string, eventID string, interceptors ...clientv2.RequestInterceptor) (*RedeliverWebhookEvent, error)
 	GetAllWebhookHistories(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllWebhookHistories, error)
 	GetWebhookHistories(ctx context.Context, where *WebhookHistoryWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetWebhookHistories, error)
 }
@@ -711,6 +735,152 @@ func (t *DeleteAPIToken_DeleteAPIToken) GetDeletedID() string {
 	return t.DeletedID
 }
 
+type AuditLogs_AuditLogs_PageInfo struct {
+	StartCursor     *string "json:\"startCursor,omitempty\" graphql:\"startCursor\""
+	EndCursor       *string "json:\"endCursor,omitempty\" graphql:\"endCursor\""
+	HasPreviousPage bool    "json:\"hasPreviousPage\" graphql:\"hasPreviousPage\""
+	HasNextPage     bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+}
+
+func (t *AuditLogs_AuditLogs_PageInfo) GetStartCursor() *string {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_PageInfo{}
+	}
+	return t.StartCursor
+}
+func (t *AuditLogs_AuditLogs_PageInfo) GetEndCursor() *string {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_PageInfo{}
+	}
+	return t.EndCursor
+}
+func (t *AuditLogs_AuditLogs_PageInfo) GetHasPreviousPage() bool {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_PageInfo{}
+	}
+	return t.HasPreviousPage
+}
+func (t *AuditLogs_AuditLogs_PageInfo) GetHasNextPage() bool {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_PageInfo{}
+	}
+	return t.HasNextPage
+}
+
+type AuditLogs_AuditLogs_Edges_Node_Changes struct {
+	Field string          "json:\"field\" graphql:\"field\""
+	Old   json.RawMessage "json:\"old,omitempty\" graphql:\"old\""
+	New   json.RawMessage "json:\"new,omitempty\" graphql:\"new\""
+}
+
+func (t *AuditLogs_AuditLogs_Edges_Node_Changes) GetField() string {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_Edges_Node_Changes{}
+	}
+	return t.Field
+}
+func (t *AuditLogs_AuditLogs_Edges_Node_Changes) GetOld() *json.RawMessage {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_Edges_Node_Changes{}
+	}
+	return &t.Old
+}
+func (t *AuditLogs_AuditLogs_Edges_Node_Changes) GetNew() *json.RawMessage {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_Edges_Node_Changes{}
+	}
+	return &t.New
+}
+
+type AuditLogs_AuditLogs_Edges_Node struct {
+	Table     *string                                   "json:\"table,omitempty\" graphql:\"table\""
+	Time      *time.Time                                "json:\"time,omitempty\" graphql:\"time\""
+	ID        string                                    "json:\"id\" graphql:\"id\""
+	Operation *string                                   "json:\"operation,omitempty\" graphql:\"operation\""
+	Changes   []*AuditLogs_AuditLogs_Edges_Node_Changes "json:\"changes,omitempty\" graphql:\"changes\""
+	UpdatedBy *string                                   "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *AuditLogs_AuditLogs_Edges_Node) GetTable() *string {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_Edges_Node{}
+	}
+	return t.Table
+}
+func (t *AuditLogs_AuditLogs_Edges_Node) GetTime() *time.Time {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_Edges_Node{}
+	}
+	return t.Time
+}
+func (t *AuditLogs_AuditLogs_Edges_Node) GetID() string {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_Edges_Node{}
+	}
+	return t.ID
+}
+func (t *AuditLogs_AuditLogs_Edges_Node) GetOperation() *string {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_Edges_Node{}
+	}
+	return t.Operation
+}
+func (t *AuditLogs_AuditLogs_Edges_Node) GetChanges() []*AuditLogs_AuditLogs_Edges_Node_Changes {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_Edges_Node{}
+	}
+	return t.Changes
+}
+func (t *AuditLogs_AuditLogs_Edges_Node) GetUpdatedBy() *string {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_Edges_Node{}
+	}
+	return t.UpdatedBy
+}
+
+type AuditLogs_AuditLogs_Edges struct {
+	Cursor string                          "json:\"cursor\" graphql:\"cursor\""
+	Node   *AuditLogs_AuditLogs_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *AuditLogs_AuditLogs_Edges) GetCursor() string {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_Edges{}
+	}
+	return t.Cursor
+}
+func (t *AuditLogs_AuditLogs_Edges) GetNode() *AuditLogs_AuditLogs_Edges_Node {
+	if t == nil {
+		t = &AuditLogs_AuditLogs_Edges{}
+	}
+	return t.Node
+}
+
+type AuditLogs_AuditLogs struct {
+	TotalCount int64                        "json:\"totalCount\" graphql:\"totalCount\""
+	PageInfo   AuditLogs_AuditLogs_PageInfo "json:\"pageInfo\" graphql:\"pageInfo\""
+	Edges      []*AuditLogs_AuditLogs_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *AuditLogs_AuditLogs) GetTotalCount() int64 {
+	if t == nil {
+		t = &AuditLogs_AuditLogs{}
+	}
+	return t.TotalCount
+}
+func (t *AuditLogs_AuditLogs) GetPageInfo() *AuditLogs_AuditLogs_PageInfo {
+	if t == nil {
+		t = &AuditLogs_AuditLogs{}
+	}
+	return &t.PageInfo
+}
+func (t *AuditLogs_AuditLogs) GetEdges() []*AuditLogs_AuditLogs_Edges {
+	if t == nil {
+		t = &AuditLogs_AuditLogs{}
+	}
+	return t.Edges
+}
+
 type CreateBulkCSVContact_CreateBulkCSVContact_Contacts struct {
 	Address     *string          "json:\"address,omitempty\" graphql:\"address\""
 	Company     *string          "json:\"company,omitempty\" graphql:\"company\""
@@ -1050,6 +1220,56 @@ func (t *CreateContact_CreateContact) GetContact() *CreateContact_CreateContact_
 	return &t.Contact
 }
 
+type DeleteBulkContact_DeleteBulkContact_Errors struct {
+	Row     int64   "json:\"row\" graphql:\"row\""
+	Line    *int64  "json:\"line,omitempty\" graphql:\"line\""
+	ID      *string "json:\"id,omitempty\" graphql:\"id\""
+	Message string  "json:\"message\" graphql:\"message\""
+}
+
+func (t *DeleteBulkContact_DeleteBulkContact_Errors) GetRow() int64 {
+	if t == nil {
+		t = &DeleteBulkContact_DeleteBulkContact_Errors{}
+	}
+	return t.Row
+}
+func (t *DeleteBulkContact_DeleteBulkContact_Errors) GetLine() *int64 {
+	if t == nil {
+		t = &DeleteBulkContact_DeleteBulkContact_Errors{}
+	}
+	return t.Line
+}
+func (t *DeleteBulkContact_DeleteBulkContact_Errors) GetID() *string {
+	if t == nil {
+		t = &DeleteBulkContact_DeleteBulkContact_Errors{}
+	}
+	return t.ID
+}
+func (t *DeleteBulkContact_DeleteBulkContact_Errors) GetMessage() string {
+	if t == nil {
+		t = &DeleteBulkContact_DeleteBulkContact_Errors{}
+	}
+	return t.Message
+}
+
+type DeleteBulkContact_DeleteBulkContact struct {
+	DeletedIDs []string                                      "json:\"deletedIDs\" graphql:\"deletedIDs\""
+	Errors     []*DeleteBulkContact_DeleteBulkContact_Errors "json:\"errors,omitempty\" graphql:\"errors\""
+}
+
+func (t *DeleteBulkContact_DeleteBulkContact) GetDeletedIDs() []string {
+	if t == nil {
+		t = &DeleteBulkContact_DeleteBulkContact{}
+	}
+	return t.DeletedIDs
+}
+func (t *DeleteBulkContact_DeleteBulkContact) GetErrors() []*DeleteBulkContact_DeleteBulkContact_Errors {
+	if t == nil {
+		t = &DeleteBulkContact_DeleteBulkContact{}
+	}
+	return t.Errors
+}
+
 type DeleteContact_DeleteContact struct {
 	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
 }
@@ -1411,6 +1631,317 @@ func (t *GetContacts_Contacts) GetEdges() []*GetContacts_Contacts_Edges {
 	return t.Edges
 }
 
+type ImportBulkCSVContact_ImportBulkCSVContact_Contacts struct {
+	Address     *string          "json:\"address,omitempty\" graphql:\"address\""
+	Company     *string          "json:\"company,omitempty\" graphql:\"company\""
+	CreatedAt   *time.Time       "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy   *string          "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Email       *string          "json:\"email,omitempty\" graphql:\"email\""
+	FullName    string           "json:\"fullName\" graphql:\"fullName\""
+	ID          string           "json:\"id\" graphql:\"id\""
+	OwnerID     *string          "json:\"ownerID,omitempty\" graphql:\"ownerID\""
+	PhoneNumber *string          "json:\"phoneNumber,omitempty\" graphql:\"phoneNumber\""
+	Status      enums.UserStatus "json:\"status\" graphql:\"status\""
+	Tags        []string         "json:\"tags,omitempty\" graphql:\"tags\""
+	Title       *string          "json:\"title,omitempty\" graphql:\"title\""
+	UpdatedAt   *time.Time       "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy   *string          "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetAddress() *string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.Address
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetCompany() *string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.Company
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.CreatedAt
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetCreatedBy() *string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.CreatedBy
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetEmail() *string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.Email
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetFullName() string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.FullName
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetID() string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.ID
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetOwnerID() *string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.OwnerID
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetPhoneNumber() *string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.PhoneNumber
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetStatus() *enums.UserStatus {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return &t.Status
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetTags() []string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.Tags
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetTitle() *string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.Title
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.UpdatedAt
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Contacts) GetUpdatedBy() *string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Contacts{}
+	}
+	return t.UpdatedBy
+}
+
+type ImportBulkCSVContact_ImportBulkCSVContact_Errors struct {
+	Row     int64   "json:\"row\" graphql:\"row\""
+	Line    *int64  "json:\"line,omitempty\" graphql:\"line\""
+	ID      *string "json:\"id,omitempty\" graphql:\"id\""
+	Message string  "json:\"message\" graphql:\"message\""
+}
+
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Errors) GetRow() int64 {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Errors{}
+	}
+	return t.Row
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Errors) GetLine() *int64 {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Errors{}
+	}
+	return t.Line
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Errors) GetID() *string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Errors{}
+	}
+	return t.ID
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact_Errors) GetMessage() string {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact_Errors{}
+	}
+	return t.Message
+}
+
+type ImportBulkCSVContact_ImportBulkCSVContact struct {
+	Contacts []*ImportBulkCSVContact_ImportBulkCSVContact_Contacts "json:\"contacts,omitempty\" graphql:\"contacts\""
+	Errors   []*ImportBulkCSVContact_ImportBulkCSVContact_Errors   "json:\"errors,omitempty\" graphql:\"errors\""
+	DryRun   bool                                                  "json:\"dryRun\" graphql:\"dryRun\""
+}
+
+func (t *ImportBulkCSVContact_ImportBulkCSVContact) GetContacts() []*ImportBulkCSVContact_ImportBulkCSVContact_Contacts {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact{}
+	}
+	return t.Contacts
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact) GetErrors() []*ImportBulkCSVContact_ImportBulkCSVContact_Errors {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact{}
+	}
+	return t.Errors
+}
+func (t *ImportBulkCSVContact_ImportBulkCSVContact) GetDryRun() bool {
+	if t == nil {
+		t = &ImportBulkCSVContact_ImportBulkCSVContact{}
+	}
+	return t.DryRun
+}
+
+type UpdateBulkContact_UpdateBulkContact_Contacts struct {
+	Address     *string          "json:\"address,omitempty\" graphql:\"address\""
+	Company     *string          "json:\"company,omitempty\" graphql:\"company\""
+	CreatedAt   *time.Time       "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy   *string          "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Email       *string          "json:\"email,omitempty\" graphql:\"email\""
+	FullName    string           "json:\"fullName\" graphql:\"fullName\""
+	ID          string           "json:\"id\" graphql:\"id\""
+	OwnerID     *string          "json:\"ownerID,omitempty\" graphql:\"ownerID\""
+	PhoneNumber *string          "json:\"phoneNumber,omitempty\" graphql:\"phoneNumber\""
+	Status      enums.UserStatus "json:\"status\" graphql:\"status\""
+	Tags        []string         "json:\"tags,omitempty\" graphql:\"tags\""
+	Title       *string          "json:\"title,omitempty\" graphql:\"title\""
+	UpdatedAt   *time.Time       "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy   *string          "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetAddress() *string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.Address
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetCompany() *string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.Company
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.CreatedAt
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetCreatedBy() *string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.CreatedBy
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetEmail() *string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.Email
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetFullName() string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.FullName
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetID() string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.ID
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetOwnerID() *string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.OwnerID
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetPhoneNumber() *string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.PhoneNumber
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetStatus() *enums.UserStatus {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return &t.Status
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetTags() []string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.Tags
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetTitle() *string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.Title
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.UpdatedAt
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Contacts) GetUpdatedBy() *string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Contacts{}
+	}
+	return t.UpdatedBy
+}
+
+type UpdateBulkContact_UpdateBulkContact_Errors struct {
+	Row     int64   "json:\"row\" graphql:\"row\""
+	Line    *int64  "json:\"line,omitempty\" graphql:\"line\""
+	ID      *string "json:\"id,omitempty\" graphql:\"id\""
+	Message string  "json:\"message\" graphql:\"message\""
+}
+
+func (t *UpdateBulkContact_UpdateBulkContact_Errors) GetRow() int64 {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Errors{}
+	}
+	return t.Row
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Errors) GetLine() *int64 {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Errors{}
+	}
+	return t.Line
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Errors) GetID() *string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Errors{}
+	}
+	return t.ID
+}
+func (t *UpdateBulkContact_UpdateBulkContact_Errors) GetMessage() string {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact_Errors{}
+	}
+	return t.Message
+}
+
+type UpdateBulkContact_UpdateBulkContact struct {
+	Contacts []*UpdateBulkContact_UpdateBulkContact_Contacts "json:\"contacts,omitempty\" graphql:\"contacts\""
+	Errors   []*UpdateBulkContact_UpdateBulkContact_Errors   "json:\"errors,omitempty\" graphql:\"errors\""
+}
+
+func (t *UpdateBulkContact_UpdateBulkContact) GetContacts() []*UpdateBulkContact_UpdateBulkContact_Contacts {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact{}
+	}
+	return t.Contacts
+}
+func (t *UpdateBulkContact_UpdateBulkContact) GetErrors() []*UpdateBulkContact_UpdateBulkContact_Errors {
+	if t == nil {
+		t = &UpdateBulkContact_UpdateBulkContact{}
+	}
+	return t.Errors
+}
+
 type UpdateContact_UpdateContact_Contact struct {
 	Address     *string          "json:\"address,omitempty\" graphql:\"address\""
 	Company     *string          "json:\"company,omitempty\" graphql:\"company\""
@@ -1524,6 +2055,158 @@ func (t *UpdateContact_UpdateContact) GetContact() *UpdateContact_UpdateContact_
 	return &t.Contact
 }
 
+type UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts struct {
+	Address     *string          "json:\"address,omitempty\" graphql:\"address\""
+	Company     *string          "json:\"company,omitempty\" graphql:\"company\""
+	CreatedAt   *time.Time       "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy   *string          "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Email       *string          "json:\"email,omitempty\" graphql:\"email\""
+	FullName    string           "json:\"fullName\" graphql:\"fullName\""
+	ID          string           "json:\"id\" graphql:\"id\""
+	OwnerID     *string          "json:\"ownerID,omitempty\" graphql:\"ownerID\""
+	PhoneNumber *string          "json:\"phoneNumber,omitempty\" graphql:\"phoneNumber\""
+	Status      enums.UserStatus "json:\"status\" graphql:\"status\""
+	Tags        []string         "json:\"tags,omitempty\" graphql:\"tags\""
+	Title       *string          "json:\"title,omitempty\" graphql:\"title\""
+	UpdatedAt   *time.Time       "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy   *string          "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetAddress() *string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.Address
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetCompany() *string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.Company
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.CreatedAt
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetCreatedBy() *string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.CreatedBy
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetEmail() *string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.Email
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetFullName() string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.FullName
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetID() string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.ID
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetOwnerID() *string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.OwnerID
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetPhoneNumber() *string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.PhoneNumber
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetStatus() *enums.UserStatus {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return &t.Status
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetTags() []string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.Tags
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetTitle() *string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.Title
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.UpdatedAt
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts) GetUpdatedBy() *string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts{}
+	}
+	return t.UpdatedBy
+}
+
+type UpsertBulkCSVContact_UpsertBulkCSVContact_Errors struct {
+	Row     int64   "json:\"row\" graphql:\"row\""
+	Line    *int64  "json:\"line,omitempty\" graphql:\"line\""
+	ID      *string "json:\"id,omitempty\" graphql:\"id\""
+	Message string  "json:\"message\" graphql:\"message\""
+}
+
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Errors) GetRow() int64 {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Errors{}
+	}
+	return t.Row
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Errors) GetLine() *int64 {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Errors{}
+	}
+	return t.Line
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Errors) GetID() *string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Errors{}
+	}
+	return t.ID
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact_Errors) GetMessage() string {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact_Errors{}
+	}
+	return t.Message
+}
+
+type UpsertBulkCSVContact_UpsertBulkCSVContact struct {
+	Contacts []*UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts "json:\"contacts,omitempty\" graphql:\"contacts\""
+	Errors   []*UpsertBulkCSVContact_UpsertBulkCSVContact_Errors   "json:\"errors,omitempty\" graphql:\"errors\""
+}
+
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact) GetContacts() []*UpsertBulkCSVContact_UpsertBulkCSVContact_Contacts {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact{}
+	}
+	return t.Contacts
+}
+func (t *UpsertBulkCSVContact_UpsertBulkCSVContact) GetErrors() []*UpsertBulkCSVContact_UpsertBulkCSVContact_Errors {
+	if t == nil {
+		t = &UpsertBulkCSVContact_UpsertBulkCSVContact{}
+	}
+	return t.Errors
+}
+
 type GetAllContactHistories_ContactHistories_Edges_Node struct {
 	Address     *string           "json:\"address,omitempty\" graphql:\"address\""
 	Company     *string           "json:\"company,omitempty\" graphql:\"company\""
@@ -1815,13 +2498,14 @@ func (t *GetContactHistories_ContactHistories) GetEdges() []*GetContactHistories
 }
 
 type CreateDocumentData_CreateDocumentData_DocumentData struct {
-	ID         string          "json:\"id\" graphql:\"id\""
-	TemplateID string          "json:\"templateID\" graphql:\"templateID\""
-	Data       json.RawMessage "json:\"data\" graphql:\"data\""
-	CreatedAt  *time.Time      "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	CreatedBy  *string         "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedAt  *time.Time      "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	UpdatedBy  *string         "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	ID              string          "json:\"id\" graphql:\"id\""
+	TemplateID      string          "json:\"templateID\" graphql:\"templateID\""
+	TemplateVersion *int64          "json:\"templateVersion,omitempty\" graphql:\"templateVersion\""
+	Data            json.RawMessage "json:\"data\" graphql:\"data\""
+	CreatedAt       *time.Time      "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy       *string         "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedAt       *time.Time      "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy       *string         "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
 }
 
 func (t *CreateDocumentData_CreateDocumentData_DocumentData) GetID() string {
@@ -1836,6 +2520,12 @@ func (t *CreateDocumentData_CreateDocumentData_DocumentData) GetTemplateID() str
 	}
 	return t.TemplateID
 }
+func (t *CreateDocumentData_CreateDocumentData_DocumentData) GetTemplateVersion() *int64 {
+	if t == nil {
+		t = &CreateDocumentData_CreateDocumentData_DocumentData{}
+	}
+	return t.TemplateVersion
+}
 func (t *CreateDocumentData_CreateDocumentData_DocumentData) GetData() *json.RawMessage {
 	if t == nil {
 		t = &CreateDocumentData_CreateDocumentData_DocumentData{}
@@ -1890,13 +2580,14 @@ func (t *DeleteDocumentData_DeleteDocumentData) GetDeletedID() string {
 }
 
 type GetDocumentDataByID_DocumentData struct {
-	ID         string          "json:\"id\" graphql:\"id\""
-	TemplateID string          "json:\"templateID\" graphql:\"templateID\""
-	Data       json.RawMessage "json:\"data\" graphql:\"data\""
-	CreatedAt  *time.Time      "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	CreatedBy  *string         "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedAt  *time.Time      "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	UpdatedBy  *string         "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	ID              string          "json:\"id\" graphql:\"id\""
+	TemplateID      string          "json:\"templateID\" graphql:\"templateID\""
+	TemplateVersion *int64          "json:\"templateVersion,omitempty\" graphql:\"templateVersion\""
+	Data            json.RawMessage "json:\"data\" graphql:\"data\""
+	CreatedAt       *time.Time      "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy       *string         "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedAt       *time.Time      "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy       *string         "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
 }
 
 func (t *GetDocumentDataByID_DocumentData) GetID() string {
@@ -1911,6 +2602,12 @@ func (t *GetDocumentDataByID_DocumentData) GetTemplateID() string {
 	}
 	return t.TemplateID
 }
+func (t *GetDocumentDataByID_DocumentData) GetTemplateVersion() *int64 {
+	if t == nil {
+		t = &GetDocumentDataByID_DocumentData{}
+	}
+	return t.TemplateVersion
+}
 func (t *GetDocumentDataByID_DocumentData) GetData() *json.RawMessage {
 	if t == nil {
 		t = &GetDocumentDataByID_DocumentData{}
@@ -1943,13 +2640,14 @@ func (t *GetDocumentDataByID_DocumentData) GetUpdatedBy() *string {
 }
 
 type UpdateDocumentData_UpdateDocumentData_DocumentData struct {
-	ID         string          "json:\"id\" graphql:\"id\""
-	TemplateID string          "json:\"templateID\" graphql:\"templateID\""
-	Data       json.RawMessage "json:\"data\" graphql:\"data\""
-	CreatedAt  *time.Time      "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	CreatedBy  *string         "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedAt  *time.Time      "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	UpdatedBy  *string         "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	ID              string          "json:\"id\" graphql:\"id\""
+	TemplateID      string          "json:\"templateID\" graphql:\"templateID\""
+	TemplateVersion *int64          "json:\"templateVersion,omitempty\" graphql:\"templateVersion\""
+	Data            json.RawMessage "json:\"data\" graphql:\"data\""
+	CreatedAt       *time.Time      "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy       *string         "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedAt       *time.Time      "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy       *string         "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
 }
 
 func (t *UpdateDocumentData_UpdateDocumentData_DocumentData) GetID() string {
@@ -1964,6 +2662,12 @@ func (t *UpdateDocumentData_UpdateDocumentData_DocumentData) GetTemplateID() str
 	}
 	return t.TemplateID
 }
+func (t *UpdateDocumentData_UpdateDocumentData_DocumentData) GetTemplateVersion() *int64 {
+	if t == nil {
+		t = &UpdateDocumentData_UpdateDocumentData_DocumentData{}
+	}
+	return t.TemplateVersion
+}
 func (t *UpdateDocumentData_UpdateDocumentData_DocumentData) GetData() *json.RawMessage {
 	if t == nil {
 		t = &UpdateDocumentData_UpdateDocumentData_DocumentData{}
@@ -2006,6 +2710,106 @@ func (t *UpdateDocumentData_UpdateDocumentData) GetDocumentData() *UpdateDocumen
 	return &t.DocumentData
 }
 
+type RenderDocumentData_RenderDocumentData_File_User struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *RenderDocumentData_RenderDocumentData_File_User) GetID() string {
+	if t == nil {
+		t = &RenderDocumentData_RenderDocumentData_File_User{}
+	}
+	return t.ID
+}
+
+type RenderDocumentData_RenderDocumentData_File_Organization struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *RenderDocumentData_RenderDocumentData_File_Organization) GetID() string {
+	if t == nil {
+		t = &RenderDocumentData_RenderDocumentData_File_Organization{}
+	}
+	return t.ID
+}
+
+type RenderDocumentData_RenderDocumentData_File struct {
+	Category      *string                                                    "json:\"category,omitempty\" graphql:\"category\""
+	ContentType   string                                                     "json:\"contentType\" graphql:\"contentType\""
+	FileExtension string                                                     "json:\"fileExtension\" graphql:\"fileExtension\""
+	FileName      string                                                     "json:\"fileName\" graphql:\"fileName\""
+	FileSize      *int64                                                     "json:\"fileSize,omitempty\" graphql:\"fileSize\""
+	ID            string                                                     "json:\"id\" graphql:\"id\""
+	StoreKey      string                                                     "json:\"storeKey\" graphql:\"storeKey\""
+	User          *RenderDocumentData_RenderDocumentData_File_User           "json:\"user,omitempty\" graphql:\"user\""
+	Organization  []*RenderDocumentData_RenderDocumentData_File_Organization "json:\"organization,omitempty\" graphql:\"organization\""
+}
+
+func (t *RenderDocumentData_RenderDocumentData_File) GetCategory() *string {
+	if t == nil {
+		t = &RenderDocumentData_RenderDocumentData_File{}
+	}
+	return t.Category
+}
+func (t *RenderDocumentData_RenderDocumentData_File) GetContentType() string {
+	if t == nil {
+		t = &RenderDocumentData_RenderDocumentData_File{}
+	}
+	return t.ContentType
+}
+func (t *RenderDocumentData_RenderDocumentData_File) GetFileExtension() string {
+	if t == nil {
+		t = &RenderDocumentData_RenderDocumentData_File{}
+	}
+	return t.FileExtension
+}
+func (t *RenderDocumentData_RenderDocumentData_File) GetFileName() string {
+	if t == nil {
+		t = &RenderDocumentData_RenderDocumentData_File{}
+	}
+	return t.FileName
+}
+func (t *RenderDocumentData_RenderDocumentData_File) GetFileSize() *int64 {
+	if t == nil {
+		t = &RenderDocumentData_RenderDocumentData_File{}
+	}
+	return t.FileSize
+}
+func (t *RenderDocumentData_RenderDocumentData_File) GetID() string {
+	if t == nil {
+		t = &RenderDocumentData_RenderDocumentData_File{}
+	}
+	return t.ID
+}
+func (t *RenderDocumentData_RenderDocumentData_File) GetStoreKey() string {
+	if t == nil {
+		t = &RenderDocumentData_RenderDocumentData_File{}
+	}
+	return t.StoreKey
+}
+func (t *RenderDocumentData_RenderDocumentData_File) GetUser() *RenderDocumentData_RenderDocumentData_File_User {
+	if t == nil {
+		t = &RenderDocumentData_RenderDocumentData_File{}
+	}
+	return t.User
+}
+func (t *RenderDocumentData_RenderDocumentData_File) GetOrganization() []*RenderDocumentData_RenderDocumentData_File_Organization {
+	if t == nil {
+		t = &RenderDocumentData_RenderDocumentData_File{}
+	}
+	return t.Organization
+}
+
+type RenderDocumentData_RenderDocumentData struct {
+	File RenderDocumentData_RenderDocumentData_File "json:\"file\" graphql:\"file\""
+}
+
+func (t *RenderDocumentData_RenderDocumentData) GetFile() *RenderDocumentData_RenderDocumentData_File {
+	if t == nil {
+		t = &RenderDocumentData_RenderDocumentData{}
+	}
+	return &t.File
+}
+
 type GetAllDocumentDataHistories_DocumentDataHistories_Edges_Node struct {
 	CreatedAt   *time.Time        "json:\"createdAt,omitempty\" graphql:\"createdAt\""
 	CreatedBy   *string           "json:\"createdBy,omitempty\" graphql:\"createdBy\""
@@ -5550,6 +6354,56 @@ func (t *CreateEntity_CreateEntity) GetEntity() *CreateEntity_CreateEntity_Entit
 	return &t.Entity
 }
 
+type DeleteBulkEntity_DeleteBulkEntity_Errors struct {
+	Row     int64   "json:\"row\" graphql:\"row\""
+	Line    *int64  "json:\"line,omitempty\" graphql:\"line\""
+	ID      *string "json:\"id,omitempty\" graphql:\"id\""
+	Message string  "json:\"message\" graphql:\"message\""
+}
+
+func (t *DeleteBulkEntity_DeleteBulkEntity_Errors) GetRow() int64 {
+	if t == nil {
+		t = &DeleteBulkEntity_DeleteBulkEntity_Errors{}
+	}
+	return t.Row
+}
+func (t *DeleteBulkEntity_DeleteBulkEntity_Errors) GetLine() *int64 {
+	if t == nil {
+		t = &DeleteBulkEntity_DeleteBulkEntity_Errors{}
+	}
+	return t.Line
+}
+func (t *DeleteBulkEntity_DeleteBulkEntity_Errors) GetID() *string {
+	if t == nil {
+		t = &DeleteBulkEntity_DeleteBulkEntity_Errors{}
+	}
+	return t.ID
+}
+func (t *DeleteBulkEntity_DeleteBulkEntity_Errors) GetMessage() string {
+	if t == nil {
+		t = &DeleteBulkEntity_DeleteBulkEntity_Errors{}
+	}
+	return t.Message
+}
+
+type DeleteBulkEntity_DeleteBulkEntity struct {
+	DeletedIDs []string                                    "json:\"deletedIDs\" graphql:\"deletedIDs\""
+	Errors     []*DeleteBulkEntity_DeleteBulkEntity_Errors "json:\"errors,omitempty\" graphql:\"errors\""
+}
+
+func (t *DeleteBulkEntity_DeleteBulkEntity) GetDeletedIDs() []string {
+	if t == nil {
+		t = &DeleteBulkEntity_DeleteBulkEntity{}
+	}
+	return t.DeletedIDs
+}
+func (t *DeleteBulkEntity_DeleteBulkEntity) GetErrors() []*DeleteBulkEntity_DeleteBulkEntity_Errors {
+	if t == nil {
+		t = &DeleteBulkEntity_DeleteBulkEntity{}
+	}
+	return t.Errors
+}
+
 type DeleteEntity_DeleteEntity struct {
 	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
 }
@@ -6079,6 +6933,389 @@ func (t *GetEntityByID_Entity) GetUpdatedBy() *string {
 	return t.UpdatedBy
 }
 
+type ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_Notes struct {
+	Text      string     "json:\"text\" graphql:\"text\""
+	UpdatedAt *time.Time "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy *string    "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_Notes) GetText() string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_Notes{}
+	}
+	return t.Text
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_Notes) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_Notes{}
+	}
+	return t.UpdatedAt
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_Notes) GetUpdatedBy() *string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_Notes{}
+	}
+	return t.UpdatedBy
+}
+
+type ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_EntityType struct {
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_EntityType) GetName() string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_EntityType{}
+	}
+	return t.Name
+}
+
+type ImportBulkCSVEntity_ImportBulkCSVEntity_Entities struct {
+	CreatedAt   *time.Time                                                   "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy   *string                                                      "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Description *string                                                      "json:\"description,omitempty\" graphql:\"description\""
+	DisplayName *string                                                      "json:\"displayName,omitempty\" graphql:\"displayName\""
+	Status      *string                                                      "json:\"status,omitempty\" graphql:\"status\""
+	Domains     []string                                                     "json:\"domains,omitempty\" graphql:\"domains\""
+	Notes       []*ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_Notes    "json:\"notes,omitempty\" graphql:\"notes\""
+	EntityType  *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_EntityType "json:\"entityType,omitempty\" graphql:\"entityType\""
+	ID          string                                                       "json:\"id\" graphql:\"id\""
+	Name        *string                                                      "json:\"name,omitempty\" graphql:\"name\""
+	OwnerID     *string                                                      "json:\"ownerID,omitempty\" graphql:\"ownerID\""
+	Tags        []string                                                     "json:\"tags,omitempty\" graphql:\"tags\""
+	UpdatedAt   *time.Time                                                   "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy   *string                                                      "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.CreatedAt
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetCreatedBy() *string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.CreatedBy
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetDescription() *string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.Description
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetDisplayName() *string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.DisplayName
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetStatus() *string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.Status
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetDomains() []string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.Domains
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetNotes() []*ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_Notes {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.Notes
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetEntityType() *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities_EntityType {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.EntityType
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetID() string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.ID
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetName() *string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.Name
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetOwnerID() *string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.OwnerID
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetTags() []string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.Tags
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.UpdatedAt
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Entities) GetUpdatedBy() *string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Entities{}
+	}
+	return t.UpdatedBy
+}
+
+type ImportBulkCSVEntity_ImportBulkCSVEntity_Errors struct {
+	Row     int64   "json:\"row\" graphql:\"row\""
+	Line    *int64  "json:\"line,omitempty\" graphql:\"line\""
+	ID      *string "json:\"id,omitempty\" graphql:\"id\""
+	Message string  "json:\"message\" graphql:\"message\""
+}
+
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Errors) GetRow() int64 {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Errors{}
+	}
+	return t.Row
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Errors) GetLine() *int64 {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Errors{}
+	}
+	return t.Line
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Errors) GetID() *string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Errors{}
+	}
+	return t.ID
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity_Errors) GetMessage() string {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity_Errors{}
+	}
+	return t.Message
+}
+
+type ImportBulkCSVEntity_ImportBulkCSVEntity struct {
+	Entities []*ImportBulkCSVEntity_ImportBulkCSVEntity_Entities "json:\"entities,omitempty\" graphql:\"entities\""
+	Errors   []*ImportBulkCSVEntity_ImportBulkCSVEntity_Errors   "json:\"errors,omitempty\" graphql:\"errors\""
+	DryRun   bool                                                "json:\"dryRun\" graphql:\"dryRun\""
+}
+
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity) GetEntities() []*ImportBulkCSVEntity_ImportBulkCSVEntity_Entities {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity{}
+	}
+	return t.Entities
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity) GetErrors() []*ImportBulkCSVEntity_ImportBulkCSVEntity_Errors {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity{}
+	}
+	return t.Errors
+}
+func (t *ImportBulkCSVEntity_ImportBulkCSVEntity) GetDryRun() bool {
+	if t == nil {
+		t = &ImportBulkCSVEntity_ImportBulkCSVEntity{}
+	}
+	return t.DryRun
+}
+
+type UpdateBulkEntity_UpdateBulkEntity_Entities_Notes struct {
+	Text      string     "json:\"text\" graphql:\"text\""
+	UpdatedAt *time.Time "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy *string    "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities_Notes) GetText() string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities_Notes{}
+	}
+	return t.Text
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities_Notes) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities_Notes{}
+	}
+	return t.UpdatedAt
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities_Notes) GetUpdatedBy() *string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities_Notes{}
+	}
+	return t.UpdatedBy
+}
+
+type UpdateBulkEntity_UpdateBulkEntity_Entities_EntityType struct {
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities_EntityType) GetName() string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities_EntityType{}
+	}
+	return t.Name
+}
+
+type UpdateBulkEntity_UpdateBulkEntity_Entities struct {
+	CreatedAt   *time.Time                                             "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy   *string                                                "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Description *string                                                "json:\"description,omitempty\" graphql:\"description\""
+	DisplayName *string                                                "json:\"displayName,omitempty\" graphql:\"displayName\""
+	Status      *string                                                "json:\"status,omitempty\" graphql:\"status\""
+	Domains     []string                                               "json:\"domains,omitempty\" graphql:\"domains\""
+	Notes       []*UpdateBulkEntity_UpdateBulkEntity_Entities_Notes    "json:\"notes,omitempty\" graphql:\"notes\""
+	EntityType  *UpdateBulkEntity_UpdateBulkEntity_Entities_EntityType "json:\"entityType,omitempty\" graphql:\"entityType\""
+	ID          string                                                 "json:\"id\" graphql:\"id\""
+	Name        *string                                                "json:\"name,omitempty\" graphql:\"name\""
+	OwnerID     *string                                                "json:\"ownerID,omitempty\" graphql:\"ownerID\""
+	Tags        []string                                               "json:\"tags,omitempty\" graphql:\"tags\""
+	UpdatedAt   *time.Time                                             "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy   *string                                                "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.CreatedAt
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetCreatedBy() *string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.CreatedBy
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetDescription() *string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.Description
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetDisplayName() *string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.DisplayName
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetStatus() *string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.Status
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetDomains() []string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.Domains
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetNotes() []*UpdateBulkEntity_UpdateBulkEntity_Entities_Notes {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.Notes
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetEntityType() *UpdateBulkEntity_UpdateBulkEntity_Entities_EntityType {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.EntityType
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetID() string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.ID
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetName() *string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.Name
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetOwnerID() *string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.OwnerID
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetTags() []string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.Tags
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.UpdatedAt
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Entities) GetUpdatedBy() *string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Entities{}
+	}
+	return t.UpdatedBy
+}
+
+type UpdateBulkEntity_UpdateBulkEntity_Errors struct {
+	Row     int64   "json:\"row\" graphql:\"row\""
+	Line    *int64  "json:\"line,omitempty\" graphql:\"line\""
+	ID      *string "json:\"id,omitempty\" graphql:\"id\""
+	Message string  "json:\"message\" graphql:\"message\""
+}
+
+func (t *UpdateBulkEntity_UpdateBulkEntity_Errors) GetRow() int64 {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Errors{}
+	}
+	return t.Row
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Errors) GetLine() *int64 {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Errors{}
+	}
+	return t.Line
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Errors) GetID() *string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Errors{}
+	}
+	return t.ID
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity_Errors) GetMessage() string {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity_Errors{}
+	}
+	return t.Message
+}
+
+type UpdateBulkEntity_UpdateBulkEntity struct {
+	Entities []*UpdateBulkEntity_UpdateBulkEntity_Entities "json:\"entities,omitempty\" graphql:\"entities\""
+	Errors   []*UpdateBulkEntity_UpdateBulkEntity_Errors   "json:\"errors,omitempty\" graphql:\"errors\""
+}
+
+func (t *UpdateBulkEntity_UpdateBulkEntity) GetEntities() []*UpdateBulkEntity_UpdateBulkEntity_Entities {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity{}
+	}
+	return t.Entities
+}
+func (t *UpdateBulkEntity_UpdateBulkEntity) GetErrors() []*UpdateBulkEntity_UpdateBulkEntity_Errors {
+	if t == nil {
+		t = &UpdateBulkEntity_UpdateBulkEntity{}
+	}
+	return t.Errors
+}
+
 type UpdateEntity_UpdateEntity_Entity_Notes struct {
 	Text      string     "json:\"text\" graphql:\"text\""
 	UpdatedAt *time.Time "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
@@ -6228,6 +7465,194 @@ func (t *UpdateEntity_UpdateEntity) GetEntity() *UpdateEntity_UpdateEntity_Entit
 	return &t.Entity
 }
 
+type UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_Notes struct {
+	Text      string     "json:\"text\" graphql:\"text\""
+	UpdatedAt *time.Time "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy *string    "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_Notes) GetText() string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_Notes{}
+	}
+	return t.Text
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_Notes) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_Notes{}
+	}
+	return t.UpdatedAt
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_Notes) GetUpdatedBy() *string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_Notes{}
+	}
+	return t.UpdatedBy
+}
+
+type UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_EntityType struct {
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_EntityType) GetName() string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_EntityType{}
+	}
+	return t.Name
+}
+
+type UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities struct {
+	CreatedAt   *time.Time                                                   "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy   *string                                                      "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Description *string                                                      "json:\"description,omitempty\" graphql:\"description\""
+	DisplayName *string                                                      "json:\"displayName,omitempty\" graphql:\"displayName\""
+	Status      *string                                                      "json:\"status,omitempty\" graphql:\"status\""
+	Domains     []string                                                     "json:\"domains,omitempty\" graphql:\"domains\""
+	Notes       []*UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_Notes    "json:\"notes,omitempty\" graphql:\"notes\""
+	EntityType  *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_EntityType "json:\"entityType,omitempty\" graphql:\"entityType\""
+	ID          string                                                       "json:\"id\" graphql:\"id\""
+	Name        *string                                                      "json:\"name,omitempty\" graphql:\"name\""
+	OwnerID     *string                                                      "json:\"ownerID,omitempty\" graphql:\"ownerID\""
+	Tags        []string                                                     "json:\"tags,omitempty\" graphql:\"tags\""
+	UpdatedAt   *time.Time                                                   "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy   *string                                                      "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.CreatedAt
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetCreatedBy() *string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.CreatedBy
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetDescription() *string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.Description
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetDisplayName() *string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.DisplayName
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetStatus() *string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.Status
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetDomains() []string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.Domains
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetNotes() []*UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_Notes {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.Notes
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetEntityType() *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities_EntityType {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.EntityType
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetID() string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.ID
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetName() *string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.Name
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetOwnerID() *string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.OwnerID
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetTags() []string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.Tags
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.UpdatedAt
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities) GetUpdatedBy() *string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities{}
+	}
+	return t.UpdatedBy
+}
+
+type UpsertBulkCSVEntity_UpsertBulkCSVEntity_Errors struct {
+	Row     int64   "json:\"row\" graphql:\"row\""
+	Line    *int64  "json:\"line,omitempty\" graphql:\"line\""
+	ID      *string "json:\"id,omitempty\" graphql:\"id\""
+	Message string  "json:\"message\" graphql:\"message\""
+}
+
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Errors) GetRow() int64 {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Errors{}
+	}
+	return t.Row
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Errors) GetLine() *int64 {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Errors{}
+	}
+	return t.Line
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Errors) GetID() *string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Errors{}
+	}
+	return t.ID
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity_Errors) GetMessage() string {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity_Errors{}
+	}
+	return t.Message
+}
+
+type UpsertBulkCSVEntity_UpsertBulkCSVEntity struct {
+	Entities []*UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities "json:\"entities,omitempty\" graphql:\"entities\""
+	Errors   []*UpsertBulkCSVEntity_UpsertBulkCSVEntity_Errors   "json:\"errors,omitempty\" graphql:\"errors\""
+}
+
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity) GetEntities() []*UpsertBulkCSVEntity_UpsertBulkCSVEntity_Entities {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity{}
+	}
+	return t.Entities
+}
+func (t *UpsertBulkCSVEntity_UpsertBulkCSVEntity) GetErrors() []*UpsertBulkCSVEntity_UpsertBulkCSVEntity_Errors {
+	if t == nil {
+		t = &UpsertBulkCSVEntity_UpsertBulkCSVEntity{}
+	}
+	return t.Errors
+}
+
 type GetAllEntityHistories_EntityHistories_Edges_Node struct {
 	CreatedAt    *time.Time        "json:\"createdAt,omitempty\" graphql:\"createdAt\""
 	CreatedBy    *string           "json:\"createdBy,omitempty\" graphql:\"createdBy\""
@@ -17597,6 +19022,7 @@ type GetAllOauthProviderHistories_OauthProviderHistories_Edges_Node struct {
 	HistoryTime  time.Time         "json:\"historyTime\" graphql:\"historyTime\""
 	ID           string            "json:\"id\" graphql:\"id\""
 	InfoURL      string            "json:\"infoURL\" graphql:\"infoURL\""
+	Issuer       *string           "json:\"issuer,omitempty\" graphql:\"issuer\""
 	Name         string            "json:\"name\" graphql:\"name\""
 	Operation    enthistory.OpType "json:\"operation\" graphql:\"operation\""
 	OwnerID      *string           "json:\"ownerID,omitempty\" graphql:\"ownerID\""
@@ -17663,6 +19089,12 @@ func (t *GetAllOauthProviderHistories_OauthProviderHistories_Edges_Node) GetInfo
 	}
 	return t.InfoURL
 }
+func (t *GetAllOauthProviderHistories_OauthProviderHistories_Edges_Node) GetIssuer() *string {
+	if t == nil {
+		t = &GetAllOauthProviderHistories_OauthProviderHistories_Edges_Node{}
+	}
+	return t.Issuer
+}
 func (t *GetAllOauthProviderHistories_OauthProviderHistories_Edges_Node) GetName() string {
 	if t == nil {
 		t = &GetAllOauthProviderHistories_OauthProviderHistories_Edges_Node{}
@@ -17756,6 +19188,7 @@ type GetOauthProviderHistories_OauthProviderHistories_Edges_Node struct {
 	HistoryTime  time.Time         "json:\"historyTime\" graphql:\"historyTime\""
 	ID           string            "json:\"id\" graphql:\"id\""
 	InfoURL      string            "json:\"infoURL\" graphql:\"infoURL\""
+	Issuer       *string           "json:\"issuer,omitempty\" graphql:\"issuer\""
 	Name         string            "json:\"name\" graphql:\"name\""
 	Operation    enthistory.OpType "json:\"operation\" graphql:\"operation\""
 	OwnerID      *string           "json:\"ownerID,omitempty\" graphql:\"ownerID\""
@@ -17822,6 +19255,12 @@ func (t *GetOauthProviderHistories_OauthProviderHistories_Edges_Node) GetInfoURL
 	}
 	return t.InfoURL
 }
+func (t *GetOauthProviderHistories_OauthProviderHistories_Edges_Node) GetIssuer() *string {
+	if t == nil {
+		t = &GetOauthProviderHistories_OauthProviderHistories_Edges_Node{}
+	}
+	return t.Issuer
+}
 func (t *GetOauthProviderHistories_OauthProviderHistories_Edges_Node) GetName() string {
 	if t == nil {
 		t = &GetOauthProviderHistories_OauthProviderHistories_Edges_Node{}
@@ -18752,19 +20191,23 @@ func (t *CreateBulkOrganization_CreateBulkOrganization) GetOrganizations() []*Cr
 }
 
 type CreateOrganization_CreateOrganization_Organization_Setting struct {
-	ID             string        "json:\"id\" graphql:\"id\""
-	CreatedAt      *time.Time    "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	UpdatedAt      *time.Time    "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	CreatedBy      *string       "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedBy      *string       "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Domains        []string      "json:\"domains,omitempty\" graphql:\"domains\""
-	BillingContact *string       "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string       "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string       "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	BillingAddress *string       "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	TaxIdentifier  *string       "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	GeoLocation    *enums.Region "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
-	Tags           []string      "json:\"tags,omitempty\" graphql:\"tags\""
+	ID                   string                 "json:\"id\" graphql:\"id\""
+	CreatedAt            *time.Time             "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	UpdatedAt            *time.Time             "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	CreatedBy            *string                "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy            *string                "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Domains              []string               "json:\"domains,omitempty\" graphql:\"domains\""
+	BillingContact       *string                "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail         *string                "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone         *string                "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	BillingAddress       *string                "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	TaxIdentifier        *string                "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	GeoLocation          *enums.Region          "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
+	SamlAttributeMapping map[string]interface{} "json:\"samlAttributeMapping,omitempty\" graphql:\"samlAttributeMapping\""
+	SamlMetadata         *string                "json:\"samlMetadata,omitempty\" graphql:\"samlMetadata\""
+	SamlMetadataURL      *string                "json:\"samlMetadataURL,omitempty\" graphql:\"samlMetadataURL\""
+	SsoEnforced          bool                   "json:\"ssoEnforced\" graphql:\"ssoEnforced\""
+	Tags                 []string               "json:\"tags,omitempty\" graphql:\"tags\""
 }
 
 func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetID() string {
@@ -18839,6 +20282,30 @@ func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetGeoLocat
 	}
 	return t.GeoLocation
 }
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetSamlAttributeMapping() map[string]interface{} {
+	if t == nil {
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+	}
+	return t.SamlAttributeMapping
+}
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetSamlMetadata() *string {
+	if t == nil {
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+	}
+	return t.SamlMetadata
+}
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetSamlMetadataURL() *string {
+	if t == nil {
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+	}
+	return t.SamlMetadataURL
+}
+func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetSsoEnforced() bool {
+	if t == nil {
+		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
+	}
+	return t.SsoEnforced
+}
 func (t *CreateOrganization_CreateOrganization_Organization_Setting) GetTags() []string {
 	if t == nil {
 		t = &CreateOrganization_CreateOrganization_Organization_Setting{}
@@ -19144,19 +20611,23 @@ func (t *GetAllOrganizations_Organizations_Edges_Node_Members) GetUser() *GetAll
 }
 
 type GetAllOrganizations_Organizations_Edges_Node_Setting struct {
-	ID             string        "json:\"id\" graphql:\"id\""
-	CreatedAt      *time.Time    "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	UpdatedAt      *time.Time    "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	CreatedBy      *string       "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedBy      *string       "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Domains        []string      "json:\"domains,omitempty\" graphql:\"domains\""
-	BillingContact *string       "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string       "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string       "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	BillingAddress *string       "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	TaxIdentifier  *string       "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	GeoLocation    *enums.Region "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
-	Tags           []string      "json:\"tags,omitempty\" graphql:\"tags\""
+	ID                   string                 "json:\"id\" graphql:\"id\""
+	CreatedAt            *time.Time             "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	UpdatedAt            *time.Time             "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	CreatedBy            *string                "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy            *string                "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Domains              []string               "json:\"domains,omitempty\" graphql:\"domains\""
+	BillingContact       *string                "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail         *string                "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone         *string                "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	BillingAddress       *string                "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	TaxIdentifier        *string                "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	GeoLocation          *enums.Region          "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
+	SamlAttributeMapping map[string]interface{} "json:\"samlAttributeMapping,omitempty\" graphql:\"samlAttributeMapping\""
+	SamlMetadata         *string                "json:\"samlMetadata,omitempty\" graphql:\"samlMetadata\""
+	SamlMetadataURL      *string                "json:\"samlMetadataURL,omitempty\" graphql:\"samlMetadataURL\""
+	SsoEnforced          bool                   "json:\"ssoEnforced\" graphql:\"ssoEnforced\""
+	Tags                 []string               "json:\"tags,omitempty\" graphql:\"tags\""
 }
 
 func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetID() string {
@@ -19231,6 +20702,30 @@ func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetGeoLocation()
 	}
 	return t.GeoLocation
 }
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetSamlAttributeMapping() map[string]interface{} {
+	if t == nil {
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+	}
+	return t.SamlAttributeMapping
+}
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetSamlMetadata() *string {
+	if t == nil {
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+	}
+	return t.SamlMetadata
+}
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetSamlMetadataURL() *string {
+	if t == nil {
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+	}
+	return t.SamlMetadataURL
+}
+func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetSsoEnforced() bool {
+	if t == nil {
+		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
+	}
+	return t.SsoEnforced
+}
 func (t *GetAllOrganizations_Organizations_Edges_Node_Setting) GetTags() []string {
 	if t == nil {
 		t = &GetAllOrganizations_Organizations_Edges_Node_Setting{}
@@ -19471,19 +20966,23 @@ func (t *GetOrganizationByID_Organization_Members) GetUser() *GetOrganizationByI
 }
 
 type GetOrganizationByID_Organization_Setting struct {
-	ID             string        "json:\"id\" graphql:\"id\""
-	CreatedAt      *time.Time    "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	UpdatedAt      *time.Time    "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	CreatedBy      *string       "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedBy      *string       "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Domains        []string      "json:\"domains,omitempty\" graphql:\"domains\""
-	BillingContact *string       "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string       "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string       "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	BillingAddress *string       "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	TaxIdentifier  *string       "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	GeoLocation    *enums.Region "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
-	Tags           []string      "json:\"tags,omitempty\" graphql:\"tags\""
+	ID                   string                 "json:\"id\" graphql:\"id\""
+	CreatedAt            *time.Time             "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	UpdatedAt            *time.Time             "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	CreatedBy            *string                "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy            *string                "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Domains              []string               "json:\"domains,omitempty\" graphql:\"domains\""
+	BillingContact       *string                "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail         *string                "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone         *string                "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	BillingAddress       *string                "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	TaxIdentifier        *string                "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	GeoLocation          *enums.Region          "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
+	SamlAttributeMapping map[string]interface{} "json:\"samlAttributeMapping,omitempty\" graphql:\"samlAttributeMapping\""
+	SamlMetadata         *string                "json:\"samlMetadata,omitempty\" graphql:\"samlMetadata\""
+	SamlMetadataURL      *string                "json:\"samlMetadataURL,omitempty\" graphql:\"samlMetadataURL\""
+	SsoEnforced          bool                   "json:\"ssoEnforced\" graphql:\"ssoEnforced\""
+	Tags                 []string               "json:\"tags,omitempty\" graphql:\"tags\""
 }
 
 func (t *GetOrganizationByID_Organization_Setting) GetID() string {
@@ -19558,6 +21057,30 @@ func (t *GetOrganizationByID_Organization_Setting) GetGeoLocation() *enums.Regio
 	}
 	return t.GeoLocation
 }
+func (t *GetOrganizationByID_Organization_Setting) GetSamlAttributeMapping() map[string]interface{} {
+	if t == nil {
+		t = &GetOrganizationByID_Organization_Setting{}
+	}
+	return t.SamlAttributeMapping
+}
+func (t *GetOrganizationByID_Organization_Setting) GetSamlMetadata() *string {
+	if t == nil {
+		t = &GetOrganizationByID_Organization_Setting{}
+	}
+	return t.SamlMetadata
+}
+func (t *GetOrganizationByID_Organization_Setting) GetSamlMetadataURL() *string {
+	if t == nil {
+		t = &GetOrganizationByID_Organization_Setting{}
+	}
+	return t.SamlMetadataURL
+}
+func (t *GetOrganizationByID_Organization_Setting) GetSsoEnforced() bool {
+	if t == nil {
+		t = &GetOrganizationByID_Organization_Setting{}
+	}
+	return t.SsoEnforced
+}
 func (t *GetOrganizationByID_Organization_Setting) GetTags() []string {
 	if t == nil {
 		t = &GetOrganizationByID_Organization_Setting{}
@@ -19790,19 +21313,23 @@ func (t *GetOrganizations_Organizations_Edges_Node_Members) GetUser() *GetOrgani
 }
 
 type GetOrganizations_Organizations_Edges_Node_Setting struct {
-	ID             string        "json:\"id\" graphql:\"id\""
-	CreatedAt      *time.Time    "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	UpdatedAt      *time.Time    "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	CreatedBy      *string       "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedBy      *string       "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Domains        []string      "json:\"domains,omitempty\" graphql:\"domains\""
-	BillingContact *string       "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string       "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string       "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	BillingAddress *string       "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	TaxIdentifier  *string       "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	GeoLocation    *enums.Region "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
-	Tags           []string      "json:\"tags,omitempty\" graphql:\"tags\""
+	ID                   string                 "json:\"id\" graphql:\"id\""
+	CreatedAt            *time.Time             "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	UpdatedAt            *time.Time             "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	CreatedBy            *string                "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy            *string                "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Domains              []string               "json:\"domains,omitempty\" graphql:\"domains\""
+	BillingContact       *string                "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail         *string                "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone         *string                "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	BillingAddress       *string                "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	TaxIdentifier        *string                "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	GeoLocation          *enums.Region          "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
+	SamlAttributeMapping map[string]interface{} "json:\"samlAttributeMapping,omitempty\" graphql:\"samlAttributeMapping\""
+	SamlMetadata         *string                "json:\"samlMetadata,omitempty\" graphql:\"samlMetadata\""
+	SamlMetadataURL      *string                "json:\"samlMetadataURL,omitempty\" graphql:\"samlMetadataURL\""
+	SsoEnforced          bool                   "json:\"ssoEnforced\" graphql:\"ssoEnforced\""
+	Tags                 []string               "json:\"tags,omitempty\" graphql:\"tags\""
 }
 
 func (t *GetOrganizations_Organizations_Edges_Node_Setting) GetID() string {
@@ -19877,6 +21404,30 @@ func (t *GetOrganizations_Organizations_Edges_Node_Setting) GetGeoLocation() *en
 	}
 	return t.GeoLocation
 }
+func (t *GetOrganizations_Organizations_Edges_Node_Setting) GetSamlAttributeMapping() map[string]interface{} {
+	if t == nil {
+		t = &GetOrganizations_Organizations_Edges_Node_Setting{}
+	}
+	return t.SamlAttributeMapping
+}
+func (t *GetOrganizations_Organizations_Edges_Node_Setting) GetSamlMetadata() *string {
+	if t == nil {
+		t = &GetOrganizations_Organizations_Edges_Node_Setting{}
+	}
+	return t.SamlMetadata
+}
+func (t *GetOrganizations_Organizations_Edges_Node_Setting) GetSamlMetadataURL() *string {
+	if t == nil {
+		t = &GetOrganizations_Organizations_Edges_Node_Setting{}
+	}
+	return t.SamlMetadataURL
+}
+func (t *GetOrganizations_Organizations_Edges_Node_Setting) GetSsoEnforced() bool {
+	if t == nil {
+		t = &GetOrganizations_Organizations_Edges_Node_Setting{}
+	}
+	return t.SsoEnforced
+}
 func (t *GetOrganizations_Organizations_Edges_Node_Setting) GetTags() []string {
 	if t == nil {
 		t = &GetOrganizations_Organizations_Edges_Node_Setting{}
@@ -20020,19 +21571,23 @@ func (t *UpdateOrganization_UpdateOrganization_Organization_Members) GetUserID()
 }
 
 type UpdateOrganization_UpdateOrganization_Organization_Setting struct {
-	ID             string        "json:\"id\" graphql:\"id\""
-	CreatedAt      *time.Time    "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	UpdatedAt      *time.Time    "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	CreatedBy      *string       "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	UpdatedBy      *string       "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Domains        []string      "json:\"domains,omitempty\" graphql:\"domains\""
-	BillingContact *string       "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string       "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string       "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	BillingAddress *string       "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	TaxIdentifier  *string       "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	GeoLocation    *enums.Region "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
-	Tags           []string      "json:\"tags,omitempty\" graphql:\"tags\""
+	ID                   string                 "json:\"id\" graphql:\"id\""
+	CreatedAt            *time.Time             "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	UpdatedAt            *time.Time             "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	CreatedBy            *string                "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	UpdatedBy            *string                "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Domains              []string               "json:\"domains,omitempty\" graphql:\"domains\""
+	BillingContact       *string                "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail         *string                "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone         *string                "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	BillingAddress       *string                "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	TaxIdentifier        *string                "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	GeoLocation          *enums.Region          "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
+	SamlAttributeMapping map[string]interface{} "json:\"samlAttributeMapping,omitempty\" graphql:\"samlAttributeMapping\""
+	SamlMetadata         *string                "json:\"samlMetadata,omitempty\" graphql:\"samlMetadata\""
+	SamlMetadataURL      *string                "json:\"samlMetadataURL,omitempty\" graphql:\"samlMetadataURL\""
+	SsoEnforced          bool                   "json:\"ssoEnforced\" graphql:\"ssoEnforced\""
+	Tags                 []string               "json:\"tags,omitempty\" graphql:\"tags\""
 }
 
 func (t *UpdateOrganization_UpdateOrganization_Organization_Setting) GetID() string {
@@ -20107,6 +21662,30 @@ func (t *UpdateOrganization_UpdateOrganization_Organization_Setting) GetGeoLocat
 	}
 	return t.GeoLocation
 }
+func (t *UpdateOrganization_UpdateOrganization_Organization_Setting) GetSamlAttributeMapping() map[string]interface{} {
+	if t == nil {
+		t = &UpdateOrganization_UpdateOrganization_Organization_Setting{}
+	}
+	return t.SamlAttributeMapping
+}
+func (t *UpdateOrganization_UpdateOrganization_Organization_Setting) GetSamlMetadata() *string {
+	if t == nil {
+		t = &UpdateOrganization_UpdateOrganization_Organization_Setting{}
+	}
+	return t.SamlMetadata
+}
+func (t *UpdateOrganization_UpdateOrganization_Organization_Setting) GetSamlMetadataURL() *string {
+	if t == nil {
+		t = &UpdateOrganization_UpdateOrganization_Organization_Setting{}
+	}
+	return t.SamlMetadataURL
+}
+func (t *UpdateOrganization_UpdateOrganization_Organization_Setting) GetSsoEnforced() bool {
+	if t == nil {
+		t = &UpdateOrganization_UpdateOrganization_Organization_Setting{}
+	}
+	return t.SsoEnforced
+}
 func (t *UpdateOrganization_UpdateOrganization_Organization_Setting) GetTags() []string {
 	if t == nil {
 		t = &UpdateOrganization_UpdateOrganization_Organization_Setting{}
@@ -20466,20 +22045,24 @@ func (t *GetAllOrganizationSettings_OrganizationSettings_Edges_Node_Organization
 }
 
 type GetAllOrganizationSettings_OrganizationSettings_Edges_Node struct {
-	BillingAddress *string                                                                  "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	BillingContact *string                                                                  "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string                                                                  "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string                                                                  "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	CreatedAt      *time.Time                                                               "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	CreatedBy      *string                                                                  "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	Domains        []string                                                                 "json:\"domains,omitempty\" graphql:\"domains\""
-	GeoLocation    *enums.Region                                                            "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
-	ID             string                                                                   "json:\"id\" graphql:\"id\""
-	Tags           []string                                                                 "json:\"tags,omitempty\" graphql:\"tags\""
-	TaxIdentifier  *string                                                                  "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	UpdatedAt      *time.Time                                                               "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	UpdatedBy      *string                                                                  "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Organization   *GetAllOrganizationSettings_OrganizationSettings_Edges_Node_Organization "json:\"organization,omitempty\" graphql:\"organization\""
+	BillingAddress       *string                                                                  "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	BillingContact       *string                                                                  "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail         *string                                                                  "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone         *string                                                                  "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	CreatedAt            *time.Time                                                               "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy            *string                                                                  "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Domains              []string                                                                 "json:\"domains,omitempty\" graphql:\"domains\""
+	GeoLocation          *enums.Region                                                            "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
+	ID                   string                                                                   "json:\"id\" graphql:\"id\""
+	SamlAttributeMapping map[string]interface{}                                                   "json:\"samlAttributeMapping,omitempty\" graphql:\"samlAttributeMapping\""
+	SamlMetadata         *string                                                                  "json:\"samlMetadata,omitempty\" graphql:\"samlMetadata\""
+	SamlMetadataURL      *string                                                                  "json:\"samlMetadataURL,omitempty\" graphql:\"samlMetadataURL\""
+	SsoEnforced          bool                                                                     "json:\"ssoEnforced\" graphql:\"ssoEnforced\""
+	Tags                 []string                                                                 "json:\"tags,omitempty\" graphql:\"tags\""
+	TaxIdentifier        *string                                                                  "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	UpdatedAt            *time.Time                                                               "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy            *string                                                                  "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Organization         *GetAllOrganizationSettings_OrganizationSettings_Edges_Node_Organization "json:\"organization,omitempty\" graphql:\"organization\""
 }
 
 func (t *GetAllOrganizationSettings_OrganizationSettings_Edges_Node) GetBillingAddress() *string {
@@ -20536,6 +22119,30 @@ func (t *GetAllOrganizationSettings_OrganizationSettings_Edges_Node) GetID() str
 	}
 	return t.ID
 }
+func (t *GetAllOrganizationSettings_OrganizationSettings_Edges_Node) GetSamlAttributeMapping() map[string]interface{} {
+	if t == nil {
+		t = &GetAllOrganizationSettings_OrganizationSettings_Edges_Node{}
+	}
+	return t.SamlAttributeMapping
+}
+func (t *GetAllOrganizationSettings_OrganizationSettings_Edges_Node) GetSamlMetadata() *string {
+	if t == nil {
+		t = &GetAllOrganizationSettings_OrganizationSettings_Edges_Node{}
+	}
+	return t.SamlMetadata
+}
+func (t *GetAllOrganizationSettings_OrganizationSettings_Edges_Node) GetSamlMetadataURL() *string {
+	if t == nil {
+		t = &GetAllOrganizationSettings_OrganizationSettings_Edges_Node{}
+	}
+	return t.SamlMetadataURL
+}
+func (t *GetAllOrganizationSettings_OrganizationSettings_Edges_Node) GetSsoEnforced() bool {
+	if t == nil {
+		t = &GetAllOrganizationSettings_OrganizationSettings_Edges_Node{}
+	}
+	return t.SsoEnforced
+}
 func (t *GetAllOrganizationSettings_OrganizationSettings_Edges_Node) GetTags() []string {
 	if t == nil {
 		t = &GetAllOrganizationSettings_OrganizationSettings_Edges_Node{}
@@ -20608,20 +22215,24 @@ func (t *GetOrganizationSettingByID_OrganizationSetting_Organization) GetName()
 }
 
 type GetOrganizationSettingByID_OrganizationSetting struct {
-	BillingAddress *string                                                      "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	BillingContact *string                                                      "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string                                                      "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string                                                      "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	CreatedAt      *time.Time                                                   "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	CreatedBy      *string                                                      "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	Domains        []string                                                     "json:\"domains,omitempty\" graphql:\"domains\""
-	GeoLocation    *enums.Region                                                "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
-	ID             string                                                       "json:\"id\" graphql:\"id\""
-	Tags           []string                                                     "json:\"tags,omitempty\" graphql:\"tags\""
-	TaxIdentifier  *string                                                      "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	UpdatedAt      *time.Time                                                   "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	UpdatedBy      *string                                                      "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Organization   *GetOrganizationSettingByID_OrganizationSetting_Organization "json:\"organization,omitempty\" graphql:\"organization\""
+	BillingAddress       *string                                                      "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	BillingContact       *string                                                      "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail         *string                                                      "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone         *string                                                      "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	CreatedAt            *time.Time                                                   "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy            *string                                                      "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Domains              []string                                                     "json:\"domains,omitempty\" graphql:\"domains\""
+	GeoLocation          *enums.Region                                                "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
+	ID                   string                                                       "json:\"id\" graphql:\"id\""
+	SamlAttributeMapping map[string]interface{}                                       "json:\"samlAttributeMapping,omitempty\" graphql:\"samlAttributeMapping\""
+	SamlMetadata         *string                                                      "json:\"samlMetadata,omitempty\" graphql:\"samlMetadata\""
+	SamlMetadataURL      *string                                                      "json:\"samlMetadataURL,omitempty\" graphql:\"samlMetadataURL\""
+	SsoEnforced          bool                                                         "json:\"ssoEnforced\" graphql:\"ssoEnforced\""
+	Tags                 []string                                                     "json:\"tags,omitempty\" graphql:\"tags\""
+	TaxIdentifier        *string                                                      "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	UpdatedAt            *time.Time                                                   "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy            *string                                                      "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Organization         *GetOrganizationSettingByID_OrganizationSetting_Organization "json:\"organization,omitempty\" graphql:\"organization\""
 }
 
 func (t *GetOrganizationSettingByID_OrganizationSetting) GetBillingAddress() *string {
@@ -20678,6 +22289,30 @@ func (t *GetOrganizationSettingByID_OrganizationSetting) GetID() string {
 	}
 	return t.ID
 }
+func (t *GetOrganizationSettingByID_OrganizationSetting) GetSamlAttributeMapping() map[string]interface{} {
+	if t == nil {
+		t = &GetOrganizationSettingByID_OrganizationSetting{}
+	}
+	return t.SamlAttributeMapping
+}
+func (t *GetOrganizationSettingByID_OrganizationSetting) GetSamlMetadata() *string {
+	if t == nil {
+		t = &GetOrganizationSettingByID_OrganizationSetting{}
+	}
+	return t.SamlMetadata
+}
+func (t *GetOrganizationSettingByID_OrganizationSetting) GetSamlMetadataURL() *string {
+	if t == nil {
+		t = &GetOrganizationSettingByID_OrganizationSetting{}
+	}
+	return t.SamlMetadataURL
+}
+func (t *GetOrganizationSettingByID_OrganizationSetting) GetSsoEnforced() bool {
+	if t == nil {
+		t = &GetOrganizationSettingByID_OrganizationSetting{}
+	}
+	return t.SsoEnforced
+}
 func (t *GetOrganizationSettingByID_OrganizationSetting) GetTags() []string {
 	if t == nil {
 		t = &GetOrganizationSettingByID_OrganizationSetting{}
@@ -20728,20 +22363,24 @@ func (t *GetOrganizationSettings_OrganizationSettings_Edges_Node_Organization) G
 }
 
 type GetOrganizationSettings_OrganizationSettings_Edges_Node struct {
-	BillingAddress *string                                                               "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	BillingContact *string                                                               "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string                                                               "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string                                                               "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	CreatedAt      *time.Time                                                            "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	CreatedBy      *string                                                               "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	Domains        []string                                                              "json:\"domains,omitempty\" graphql:\"domains\""
-	GeoLocation    *enums.Region                                                         "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
-	ID             string                                                                "json:\"id\" graphql:\"id\""
-	Tags           []string                                                              "json:\"tags,omitempty\" graphql:\"tags\""
-	TaxIdentifier  *string                                                               "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	UpdatedAt      *time.Time                                                            "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	UpdatedBy      *string                                                               "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Organization   *GetOrganizationSettings_OrganizationSettings_Edges_Node_Organization "json:\"organization,omitempty\" graphql:\"organization\""
+	BillingAddress       *string                                                               "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	BillingContact       *string                                                               "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail         *string                                                               "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone         *string                                                               "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	CreatedAt            *time.Time                                                            "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy            *string                                                               "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Domains              []string                                                              "json:\"domains,omitempty\" graphql:\"domains\""
+	GeoLocation          *enums.Region                                                         "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
+	ID                   string                                                                "json:\"id\" graphql:\"id\""
+	SamlAttributeMapping map[string]interface{}                                                "json:\"samlAttributeMapping,omitempty\" graphql:\"samlAttributeMapping\""
+	SamlMetadata         *string                                                               "json:\"samlMetadata,omitempty\" graphql:\"samlMetadata\""
+	SamlMetadataURL      *string                                                               "json:\"samlMetadataURL,omitempty\" graphql:\"samlMetadataURL\""
+	SsoEnforced          bool                                                                  "json:\"ssoEnforced\" graphql:\"ssoEnforced\""
+	Tags                 []string                                                              "json:\"tags,omitempty\" graphql:\"tags\""
+	TaxIdentifier        *string                                                               "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	UpdatedAt            *time.Time                                                            "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy            *string                                                               "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Organization         *GetOrganizationSettings_OrganizationSettings_Edges_Node_Organization "json:\"organization,omitempty\" graphql:\"organization\""
 }
 
 func (t *GetOrganizationSettings_OrganizationSettings_Edges_Node) GetBillingAddress() *string {
@@ -20798,6 +22437,30 @@ func (t *GetOrganizationSettings_OrganizationSettings_Edges_Node) GetID() string
 	}
 	return t.ID
 }
+func (t *GetOrganizationSettings_OrganizationSettings_Edges_Node) GetSamlAttributeMapping() map[string]interface{} {
+	if t == nil {
+		t = &GetOrganizationSettings_OrganizationSettings_Edges_Node{}
+	}
+	return t.SamlAttributeMapping
+}
+func (t *GetOrganizationSettings_OrganizationSettings_Edges_Node) GetSamlMetadata() *string {
+	if t == nil {
+		t = &GetOrganizationSettings_OrganizationSettings_Edges_Node{}
+	}
+	return t.SamlMetadata
+}
+func (t *GetOrganizationSettings_OrganizationSettings_Edges_Node) GetSamlMetadataURL() *string {
+	if t == nil {
+		t = &GetOrganizationSettings_OrganizationSettings_Edges_Node{}
+	}
+	return t.SamlMetadataURL
+}
+func (t *GetOrganizationSettings_OrganizationSettings_Edges_Node) GetSsoEnforced() bool {
+	if t == nil {
+		t = &GetOrganizationSettings_OrganizationSettings_Edges_Node{}
+	}
+	return t.SsoEnforced
+}
 func (t *GetOrganizationSettings_OrganizationSettings_Edges_Node) GetTags() []string {
 	if t == nil {
 		t = &GetOrganizationSettings_OrganizationSettings_Edges_Node{}
@@ -20870,20 +22533,24 @@ func (t *UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting
 }
 
 type UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting struct {
-	BillingAddress *string                                                                               "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	BillingContact *string                                                                               "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string                                                                               "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string                                                                               "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	CreatedAt      *time.Time                                                                            "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	CreatedBy      *string                                                                               "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	Domains        []string                                                                              "json:\"domains,omitempty\" graphql:\"domains\""
-	GeoLocation    *enums.Region                                                                         "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
-	ID             string                                                                                "json:\"id\" graphql:\"id\""
-	Tags           []string                                                                              "json:\"tags,omitempty\" graphql:\"tags\""
-	TaxIdentifier  *string                                                                               "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	UpdatedAt      *time.Time                                                                            "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	UpdatedBy      *string                                                                               "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
-	Organization   *UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting_Organization "json:\"organization,omitempty\" graphql:\"organization\""
+	BillingAddress       *string                                                                               "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	BillingContact       *string                                                                               "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail         *string                                                                               "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone         *string                                                                               "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	CreatedAt            *time.Time                                                                            "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy            *string                                                                               "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Domains              []string                                                                              "json:\"domains,omitempty\" graphql:\"domains\""
+	GeoLocation          *enums.Region                                                                         "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
+	ID                   string                                                                                "json:\"id\" graphql:\"id\""
+	SamlAttributeMapping map[string]interface{}                                                                "json:\"samlAttributeMapping,omitempty\" graphql:\"samlAttributeMapping\""
+	SamlMetadata         *string                                                                               "json:\"samlMetadata,omitempty\" graphql:\"samlMetadata\""
+	SamlMetadataURL      *string                                                                               "json:\"samlMetadataURL,omitempty\" graphql:\"samlMetadataURL\""
+	SsoEnforced          bool                                                                                  "json:\"ssoEnforced\" graphql:\"ssoEnforced\""
+	Tags                 []string                                                                              "json:\"tags,omitempty\" graphql:\"tags\""
+	TaxIdentifier        *string                                                                               "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	UpdatedAt            *time.Time                                                                            "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy            *string                                                                               "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	Organization         *UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting_Organization "json:\"organization,omitempty\" graphql:\"organization\""
 }
 
 func (t *UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting) GetBillingAddress() *string {
@@ -20940,6 +22607,30 @@ func (t *UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting
 	}
 	return t.ID
 }
+func (t *UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting) GetSamlAttributeMapping() map[string]interface{} {
+	if t == nil {
+		t = &UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting{}
+	}
+	return t.SamlAttributeMapping
+}
+func (t *UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting) GetSamlMetadata() *string {
+	if t == nil {
+		t = &UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting{}
+	}
+	return t.SamlMetadata
+}
+func (t *UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting) GetSamlMetadataURL() *string {
+	if t == nil {
+		t = &UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting{}
+	}
+	return t.SamlMetadataURL
+}
+func (t *UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting) GetSsoEnforced() bool {
+	if t == nil {
+		t = &UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting{}
+	}
+	return t.SsoEnforced
+}
 func (t *UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting) GetTags() []string {
 	if t == nil {
 		t = &UpdateOrganizationSetting_UpdateOrganizationSetting_OrganizationSetting{}
@@ -20983,23 +22674,27 @@ func (t *UpdateOrganizationSetting_UpdateOrganizationSetting) GetOrganizationSet
 }
 
 type GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node struct {
-	BillingAddress *string           "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	BillingContact *string           "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string           "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string           "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	CreatedAt      *time.Time        "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	CreatedBy      *string           "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	Domains        []string          "json:\"domains,omitempty\" graphql:\"domains\""
-	GeoLocation    *enums.Region     "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
-	HistoryTime    time.Time         "json:\"historyTime\" graphql:\"historyTime\""
-	ID             string            "json:\"id\" graphql:\"id\""
-	Operation      enthistory.OpType "json:\"operation\" graphql:\"operation\""
-	OrganizationID *string           "json:\"organizationID,omitempty\" graphql:\"organizationID\""
-	Ref            *string           "json:\"ref,omitempty\" graphql:\"ref\""
-	Tags           []string          "json:\"tags,omitempty\" graphql:\"tags\""
-	TaxIdentifier  *string           "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	UpdatedAt      *time.Time        "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	UpdatedBy      *string           "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	BillingAddress       *string                "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	BillingContact       *string                "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail         *string                "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone         *string                "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	CreatedAt            *time.Time             "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy            *string                "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Domains              []string               "json:\"domains,omitempty\" graphql:\"domains\""
+	GeoLocation          *enums.Region          "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
+	HistoryTime          time.Time              "json:\"historyTime\" graphql:\"historyTime\""
+	ID                   string                 "json:\"id\" graphql:\"id\""
+	Operation            enthistory.OpType      "json:\"operation\" graphql:\"operation\""
+	OrganizationID       *string                "json:\"organizationID,omitempty\" graphql:\"organizationID\""
+	Ref                  *string                "json:\"ref,omitempty\" graphql:\"ref\""
+	SamlAttributeMapping map[string]interface{} "json:\"samlAttributeMapping,omitempty\" graphql:\"samlAttributeMapping\""
+	SamlMetadata         *string                "json:\"samlMetadata,omitempty\" graphql:\"samlMetadata\""
+	SamlMetadataURL      *string                "json:\"samlMetadataURL,omitempty\" graphql:\"samlMetadataURL\""
+	SsoEnforced          bool                   "json:\"ssoEnforced\" graphql:\"ssoEnforced\""
+	Tags                 []string               "json:\"tags,omitempty\" graphql:\"tags\""
+	TaxIdentifier        *string                "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	UpdatedAt            *time.Time             "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy            *string                "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
 }
 
 func (t *GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node) GetBillingAddress() *string {
@@ -21080,6 +22775,30 @@ func (t *GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_N
 	}
 	return t.Ref
 }
+func (t *GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node) GetSamlAttributeMapping() map[string]interface{} {
+	if t == nil {
+		t = &GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node{}
+	}
+	return t.SamlAttributeMapping
+}
+func (t *GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node) GetSamlMetadata() *string {
+	if t == nil {
+		t = &GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node{}
+	}
+	return t.SamlMetadata
+}
+func (t *GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node) GetSamlMetadataURL() *string {
+	if t == nil {
+		t = &GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node{}
+	}
+	return t.SamlMetadataURL
+}
+func (t *GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node) GetSsoEnforced() bool {
+	if t == nil {
+		t = &GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node{}
+	}
+	return t.SsoEnforced
+}
 func (t *GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node) GetTags() []string {
 	if t == nil {
 		t = &GetAllOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node{}
@@ -21128,23 +22847,27 @@ func (t *GetAllOrganizationSettingHistories_OrganizationSettingHistories) GetEdg
 }
 
 type GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node struct {
-	BillingAddress *string           "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
-	BillingContact *string           "json:\"billingContact,omitempty\" graphql:\"billingContact\""
-	BillingEmail   *string           "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
-	BillingPhone   *string           "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
-	CreatedAt      *time.Time        "json:\"createdAt,omitempty\" graphql:\"createdAt\""
-	CreatedBy      *string           "json:\"createdBy,omitempty\" graphql:\"createdBy\""
-	Domains        []string          "json:\"domains,omitempty\" graphql:\"domains\""
-	GeoLocation    *enums.Region     "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
-	HistoryTime    time.Time         "json:\"historyTime\" graphql:\"historyTime\""
-	ID             string            "json:\"id\" graphql:\"id\""
-	Operation      enthistory.OpType "json:\"operation\" graphql:\"operation\""
-	OrganizationID *string           "json:\"organizationID,omitempty\" graphql:\"organizationID\""
-	Ref            *string           "json:\"ref,omitempty\" graphql:\"ref\""
-	Tags           []string          "json:\"tags,omitempty\" graphql:\"tags\""
-	TaxIdentifier  *string           "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
-	UpdatedAt      *time.Time        "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
-	UpdatedBy      *string           "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+	BillingAddress       *string                "json:\"billingAddress,omitempty\" graphql:\"billingAddress\""
+	BillingContact       *string                "json:\"billingContact,omitempty\" graphql:\"billingContact\""
+	BillingEmail         *string                "json:\"billingEmail,omitempty\" graphql:\"billingEmail\""
+	BillingPhone         *string                "json:\"billingPhone,omitempty\" graphql:\"billingPhone\""
+	CreatedAt            *time.Time             "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy            *string                "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	Domains              []string               "json:\"domains,omitempty\" graphql:\"domains\""
+	GeoLocation          *enums.Region          "json:\"geoLocation,omitempty\" graphql:\"geoLocation\""
+	HistoryTime          time.Time              "json:\"historyTime\" graphql:\"historyTime\""
+	ID                   string                 "json:\"id\" graphql:\"id\""
+	Operation            enthistory.OpType      "json:\"operation\" graphql:\"operation\""
+	OrganizationID       *string                "json:\"organizationID,omitempty\" graphql:\"organizationID\""
+	Ref                  *string                "json:\"ref,omitempty\" graphql:\"ref\""
+	SamlAttributeMapping map[string]interface{} "json:\"samlAttributeMapping,omitempty\" graphql:\"samlAttributeMapping\""
+	SamlMetadata         *string                "json:\"samlMetadata,omitempty\" graphql:\"samlMetadata\""
+	SamlMetadataURL      *string                "json:\"samlMetadataURL,omitempty\" graphql:\"samlMetadataURL\""
+	SsoEnforced          bool                   "json:\"ssoEnforced\" graphql:\"ssoEnforced\""
+	Tags                 []string               "json:\"tags,omitempty\" graphql:\"tags\""
+	TaxIdentifier        *string                "json:\"taxIdentifier,omitempty\" graphql:\"taxIdentifier\""
+	UpdatedAt            *time.Time             "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy            *string                "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
 }
 
 func (t *GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node) GetBillingAddress() *string {
@@ -21225,6 +22948,30 @@ func (t *GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node
 	}
 	return t.Ref
 }
+func (t *GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node) GetSamlAttributeMapping() map[string]interface{} {
+	if t == nil {
+		t = &GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node{}
+	}
+	return t.SamlAttributeMapping
+}
+func (t *GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node) GetSamlMetadata() *string {
+	if t == nil {
+		t = &GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node{}
+	}
+	return t.SamlMetadata
+}
+func (t *GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node) GetSamlMetadataURL() *string {
+	if t == nil {
+		t = &GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node{}
+	}
+	return t.SamlMetadataURL
+}
+func (t *GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node) GetSsoEnforced() bool {
+	if t == nil {
+		t = &GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node{}
+	}
+	return t.SsoEnforced
+}
 func (t *GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node) GetTags() []string {
 	if t == nil {
 		t = &GetOrganizationSettingHistories_OrganizationSettingHistories_Edges_Node{}
@@ -22641,6 +24388,70 @@ func (t *Search_Search) GetNodes() []*Search_Search_Nodes {
 	return t.Nodes
 }
 
+type GetUserSessions_UserSessions struct {
+	ID           string     "json:\"id\" graphql:\"id\""
+	UserID       string     "json:\"userID\" graphql:\"userID\""
+	UserAgent    *string    "json:\"userAgent,omitempty\" graphql:\"userAgent\""
+	IPAddress    *string    "json:\"ipAddress,omitempty\" graphql:\"ipAddress\""
+	CreatedAt    *time.Time "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	LastActivity *time.Time "json:\"lastActivity,omitempty\" graphql:\"lastActivity\""
+	Current      bool       "json:\"current\" graphql:\"current\""
+}
+
+func (t *GetUserSessions_UserSessions) GetID() string {
+	if t == nil {
+		t = &GetUserSessions_UserSessions{}
+	}
+	return t.ID
+}
+func (t *GetUserSessions_UserSessions) GetUserID() string {
+	if t == nil {
+		t = &GetUserSessions_UserSessions{}
+	}
+	return t.UserID
+}
+func (t *GetUserSessions_UserSessions) GetUserAgent() *string {
+	if t == nil {
+		t = &GetUserSessions_UserSessions{}
+	}
+	return t.UserAgent
+}
+func (t *GetUserSessions_UserSessions) GetIPAddress() *string {
+	if t == nil {
+		t = &GetUserSessions_UserSessions{}
+	}
+	return t.IPAddress
+}
+func (t *GetUserSessions_UserSessions) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetUserSessions_UserSessions{}
+	}
+	return t.CreatedAt
+}
+func (t *GetUserSessions_UserSessions) GetLastActivity() *time.Time {
+	if t == nil {
+		t = &GetUserSessions_UserSessions{}
+	}
+	return t.LastActivity
+}
+func (t *GetUserSessions_UserSessions) GetCurrent() bool {
+	if t == nil {
+		t = &GetUserSessions_UserSessions{}
+	}
+	return t.Current
+}
+
+type RevokeSession_RevokeSession struct {
+	DeletedID string "json:\"deletedID\" graphql:\"deletedID\""
+}
+
+func (t *RevokeSession_RevokeSession) GetDeletedID() string {
+	if t == nil {
+		t = &RevokeSession_RevokeSession{}
+	}
+	return t.DeletedID
+}
+
 type CreateBulkCSVSubscriber_CreateBulkCSVSubscriber_Subscribers struct {
 	Active        bool   "json:\"active\" graphql:\"active\""
 	Email         string "json:\"email\" graphql:\"email\""
@@ -23072,6 +24883,7 @@ type CreateBulkCSVTemplate_CreateBulkCSVTemplate_Templates struct {
 	Jsonconfig  json.RawMessage                                              "json:\"jsonconfig\" graphql:\"jsonconfig\""
 	Name        string                                                       "json:\"name\" graphql:\"name\""
 	Uischema    json.RawMessage                                              "json:\"uischema,omitempty\" graphql:\"uischema\""
+	Version     int64                                                        "json:\"version\" graphql:\"version\""
 	Owner       *CreateBulkCSVTemplate_CreateBulkCSVTemplate_Templates_Owner "json:\"owner,omitempty\" graphql:\"owner\""
 }
 
@@ -23105,6 +24917,12 @@ func (t *CreateBulkCSVTemplate_CreateBulkCSVTemplate_Templates) GetUischema() *j
 	}
 	return &t.Uischema
 }
+func (t *CreateBulkCSVTemplate_CreateBulkCSVTemplate_Templates) GetVersion() int64 {
+	if t == nil {
+		t = &CreateBulkCSVTemplate_CreateBulkCSVTemplate_Templates{}
+	}
+	return t.Version
+}
 func (t *CreateBulkCSVTemplate_CreateBulkCSVTemplate_Templates) GetOwner() *CreateBulkCSVTemplate_CreateBulkCSVTemplate_Templates_Owner {
 	if t == nil {
 		t = &CreateBulkCSVTemplate_CreateBulkCSVTemplate_Templates{}
@@ -23140,6 +24958,7 @@ type CreateBulkTemplate_CreateBulkTemplate_Templates struct {
 	Jsonconfig  json.RawMessage                                        "json:\"jsonconfig\" graphql:\"jsonconfig\""
 	Name        string                                                 "json:\"name\" graphql:\"name\""
 	Uischema    json.RawMessage                                        "json:\"uischema,omitempty\" graphql:\"uischema\""
+	Version     int64                                                  "json:\"version\" graphql:\"version\""
 	Owner       *CreateBulkTemplate_CreateBulkTemplate_Templates_Owner "json:\"owner,omitempty\" graphql:\"owner\""
 }
 
@@ -23173,6 +24992,12 @@ func (t *CreateBulkTemplate_CreateBulkTemplate_Templates) GetUischema() *json.Ra
 	}
 	return &t.Uischema
 }
+func (t *CreateBulkTemplate_CreateBulkTemplate_Templates) GetVersion() int64 {
+	if t == nil {
+		t = &CreateBulkTemplate_CreateBulkTemplate_Templates{}
+	}
+	return t.Version
+}
 func (t *CreateBulkTemplate_CreateBulkTemplate_Templates) GetOwner() *CreateBulkTemplate_CreateBulkTemplate_Templates_Owner {
 	if t == nil {
 		t = &CreateBulkTemplate_CreateBulkTemplate_Templates{}
@@ -23208,6 +25033,7 @@ type CreateTemplate_CreateTemplate_Template struct {
 	Jsonconfig  json.RawMessage                               "json:\"jsonconfig\" graphql:\"jsonconfig\""
 	Name        string                                        "json:\"name\" graphql:\"name\""
 	Uischema    json.RawMessage                               "json:\"uischema,omitempty\" graphql:\"uischema\""
+	Version     int64                                         "json:\"version\" graphql:\"version\""
 	Owner       *CreateTemplate_CreateTemplate_Template_Owner "json:\"owner,omitempty\" graphql:\"owner\""
 }
 
@@ -23241,6 +25067,12 @@ func (t *CreateTemplate_CreateTemplate_Template) GetUischema() *json.RawMessage
 	}
 	return &t.Uischema
 }
+func (t *CreateTemplate_CreateTemplate_Template) GetVersion() int64 {
+	if t == nil {
+		t = &CreateTemplate_CreateTemplate_Template{}
+	}
+	return t.Version
+}
 func (t *CreateTemplate_CreateTemplate_Template) GetOwner() *CreateTemplate_CreateTemplate_Template_Owner {
 	if t == nil {
 		t = &CreateTemplate_CreateTemplate_Template{}
@@ -23278,6 +25110,7 @@ type GetAllTemplates_Templates_Edges_Node struct {
 	Jsonconfig  json.RawMessage                             "json:\"jsonconfig\" graphql:\"jsonconfig\""
 	Name        string                                      "json:\"name\" graphql:\"name\""
 	Uischema    json.RawMessage                             "json:\"uischema,omitempty\" graphql:\"uischema\""
+	Version     int64                                       "json:\"version\" graphql:\"version\""
 	UpdatedAt   *time.Time                                  "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
 	UpdatedBy   *string                                     "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
 	Owner       *GetAllTemplates_Templates_Edges_Node_Owner "json:\"owner,omitempty\" graphql:\"owner\""
@@ -23325,6 +25158,12 @@ func (t *GetAllTemplates_Templates_Edges_Node) GetUischema() *json.RawMessage {
 	}
 	return &t.Uischema
 }
+func (t *GetAllTemplates_Templates_Edges_Node) GetVersion() int64 {
+	if t == nil {
+		t = &GetAllTemplates_Templates_Edges_Node{}
+	}
+	return t.Version
+}
 func (t *GetAllTemplates_Templates_Edges_Node) GetUpdatedAt() *time.Time {
 	if t == nil {
 		t = &GetAllTemplates_Templates_Edges_Node{}
@@ -23385,6 +25224,7 @@ type GetTemplateByID_Template struct {
 	Jsonconfig  json.RawMessage                 "json:\"jsonconfig\" graphql:\"jsonconfig\""
 	Name        string                          "json:\"name\" graphql:\"name\""
 	Uischema    json.RawMessage                 "json:\"uischema,omitempty\" graphql:\"uischema\""
+	Version     int64                           "json:\"version\" graphql:\"version\""
 	UpdatedAt   *time.Time                      "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
 	UpdatedBy   *string                         "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
 	Owner       *GetTemplateByID_Template_Owner "json:\"owner,omitempty\" graphql:\"owner\""
@@ -23432,6 +25272,12 @@ func (t *GetTemplateByID_Template) GetUischema() *json.RawMessage {
 	}
 	return &t.Uischema
 }
+func (t *GetTemplateByID_Template) GetVersion() int64 {
+	if t == nil {
+		t = &GetTemplateByID_Template{}
+	}
+	return t.Version
+}
 func (t *GetTemplateByID_Template) GetUpdatedAt() *time.Time {
 	if t == nil {
 		t = &GetTemplateByID_Template{}
@@ -23470,6 +25316,7 @@ type UpdateTemplate_UpdateTemplate_Template struct {
 	Jsonconfig  json.RawMessage                               "json:\"jsonconfig\" graphql:\"jsonconfig\""
 	Name        string                                        "json:\"name\" graphql:\"name\""
 	Uischema    json.RawMessage                               "json:\"uischema,omitempty\" graphql:\"uischema\""
+	Version     int64                                         "json:\"version\" graphql:\"version\""
 	UpdatedAt   *time.Time                                    "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
 	UpdatedBy   *string                                       "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
 	Owner       *UpdateTemplate_UpdateTemplate_Template_Owner "json:\"owner,omitempty\" graphql:\"owner\""
@@ -23517,6 +25364,12 @@ func (t *UpdateTemplate_UpdateTemplate_Template) GetUischema() *json.RawMessage
 	}
 	return &t.Uischema
 }
+func (t *UpdateTemplate_UpdateTemplate_Template) GetVersion() int64 {
+	if t == nil {
+		t = &UpdateTemplate_UpdateTemplate_Template{}
+	}
+	return t.Version
+}
 func (t *UpdateTemplate_UpdateTemplate_Template) GetUpdatedAt() *time.Time {
 	if t == nil {
 		t = &UpdateTemplate_UpdateTemplate_Template{}
@@ -23809,6 +25662,380 @@ func (t *GetTemplateHistories_TemplateHistories) GetEdges() []*GetTemplateHistor
 	return t.Edges
 }
 
+type GetTemplateVersions_TemplateVersions_PageInfo struct {
+	HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+	EndCursor   *string "json:\"endCursor,omitempty\" graphql:\"endCursor\""
+}
+
+func (t *GetTemplateVersions_TemplateVersions_PageInfo) GetHasNextPage() bool {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions_PageInfo{}
+	}
+	return t.HasNextPage
+}
+func (t *GetTemplateVersions_TemplateVersions_PageInfo) GetEndCursor() *string {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions_PageInfo{}
+	}
+	return t.EndCursor
+}
+
+type GetTemplateVersions_TemplateVersions_Edges_Node struct {
+	CreatedAt  *time.Time      "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	CreatedBy  *string         "json:\"createdBy,omitempty\" graphql:\"createdBy\""
+	ID         string          "json:\"id\" graphql:\"id\""
+	Jsonconfig json.RawMessage "json:\"jsonconfig\" graphql:\"jsonconfig\""
+	TemplateID string          "json:\"templateID\" graphql:\"templateID\""
+	Uischema   json.RawMessage "json:\"uischema,omitempty\" graphql:\"uischema\""
+	Version    int64           "json:\"version\" graphql:\"version\""
+}
+
+func (t *GetTemplateVersions_TemplateVersions_Edges_Node) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions_Edges_Node{}
+	}
+	return t.CreatedAt
+}
+func (t *GetTemplateVersions_TemplateVersions_Edges_Node) GetCreatedBy() *string {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions_Edges_Node{}
+	}
+	return t.CreatedBy
+}
+func (t *GetTemplateVersions_TemplateVersions_Edges_Node) GetID() string {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions_Edges_Node{}
+	}
+	return t.ID
+}
+func (t *GetTemplateVersions_TemplateVersions_Edges_Node) GetJsonconfig() *json.RawMessage {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions_Edges_Node{}
+	}
+	return &t.Jsonconfig
+}
+func (t *GetTemplateVersions_TemplateVersions_Edges_Node) GetTemplateID() string {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions_Edges_Node{}
+	}
+	return t.TemplateID
+}
+func (t *GetTemplateVersions_TemplateVersions_Edges_Node) GetUischema() *json.RawMessage {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions_Edges_Node{}
+	}
+	return &t.Uischema
+}
+func (t *GetTemplateVersions_TemplateVersions_Edges_Node) GetVersion() int64 {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions_Edges_Node{}
+	}
+	return t.Version
+}
+
+type GetTemplateVersions_TemplateVersions_Edges struct {
+	Node *GetTemplateVersions_TemplateVersions_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *GetTemplateVersions_TemplateVersions_Edges) GetNode() *GetTemplateVersions_TemplateVersions_Edges_Node {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions_Edges{}
+	}
+	return t.Node
+}
+
+type GetTemplateVersions_TemplateVersions struct {
+	TotalCount int64                                         "json:\"totalCount\" graphql:\"totalCount\""
+	PageInfo   GetTemplateVersions_TemplateVersions_PageInfo "json:\"pageInfo\" graphql:\"pageInfo\""
+	Edges      []*GetTemplateVersions_TemplateVersions_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *GetTemplateVersions_TemplateVersions) GetTotalCount() int64 {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions{}
+	}
+	return t.TotalCount
+}
+func (t *GetTemplateVersions_TemplateVersions) GetPageInfo() *GetTemplateVersions_TemplateVersions_PageInfo {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions{}
+	}
+	return &t.PageInfo
+}
+func (t *GetTemplateVersions_TemplateVersions) GetEdges() []*GetTemplateVersions_TemplateVersions_Edges {
+	if t == nil {
+		t = &GetTemplateVersions_TemplateVersions{}
+	}
+	return t.Edges
+}
+
+type GetTemplateVersionDiff_TemplateVersionDiff_Jsonconfig struct {
+	Path      string                         "json:\"path\" graphql:\"path\""
+	Operation TemplateVersionChangeOperation "json:\"operation\" graphql:\"operation\""
+	From      *string                        "json:\"from,omitempty\" graphql:\"from\""
+	To        *string                        "json:\"to,omitempty\" graphql:\"to\""
+}
+
+func (t *GetTemplateVersionDiff_TemplateVersionDiff_Jsonconfig) GetPath() string {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff_Jsonconfig{}
+	}
+	return t.Path
+}
+func (t *GetTemplateVersionDiff_TemplateVersionDiff_Jsonconfig) GetOperation() *TemplateVersionChangeOperation {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff_Jsonconfig{}
+	}
+	return &t.Operation
+}
+func (t *GetTemplateVersionDiff_TemplateVersionDiff_Jsonconfig) GetFrom() *string {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff_Jsonconfig{}
+	}
+	return t.From
+}
+func (t *GetTemplateVersionDiff_TemplateVersionDiff_Jsonconfig) GetTo() *string {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff_Jsonconfig{}
+	}
+	return t.To
+}
+
+type GetTemplateVersionDiff_TemplateVersionDiff_Uischema struct {
+	Path      string                         "json:\"path\" graphql:\"path\""
+	Operation TemplateVersionChangeOperation "json:\"operation\" graphql:\"operation\""
+	From      *string                        "json:\"from,omitempty\" graphql:\"from\""
+	To        *string                        "json:\"to,omitempty\" graphql:\"to\""
+}
+
+func (t *GetTemplateVersionDiff_TemplateVersionDiff_Uischema) GetPath() string {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff_Uischema{}
+	}
+	return t.Path
+}
+func (t *GetTemplateVersionDiff_TemplateVersionDiff_Uischema) GetOperation() *TemplateVersionChangeOperation {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff_Uischema{}
+	}
+	return &t.Operation
+}
+func (t *GetTemplateVersionDiff_TemplateVersionDiff_Uischema) GetFrom() *string {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff_Uischema{}
+	}
+	return t.From
+}
+func (t *GetTemplateVersionDiff_TemplateVersionDiff_Uischema) GetTo() *string {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff_Uischema{}
+	}
+	return t.To
+}
+
+type GetTemplateVersionDiff_TemplateVersionDiff struct {
+	TemplateID string                                                   "json:\"templateID\" graphql:\"templateID\""
+	From       int64                                                    "json:\"from\" graphql:\"from\""
+	To         int64                                                    "json:\"to\" graphql:\"to\""
+	Jsonconfig []*GetTemplateVersionDiff_TemplateVersionDiff_Jsonconfig "json:\"jsonconfig\" graphql:\"jsonconfig\""
+	Uischema   []*GetTemplateVersionDiff_TemplateVersionDiff_Uischema   "json:\"uischema\" graphql:\"uischema\""
+}
+
+func (t *GetTemplateVersionDiff_TemplateVersionDiff) GetTemplateID() string {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff{}
+	}
+	return t.TemplateID
+}
+func (t *GetTemplateVersionDiff_TemplateVersionDiff) GetFrom() int64 {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff{}
+	}
+	return t.From
+}
+func (t *GetTemplateVersionDiff_TemplateVersionDiff) GetTo() int64 {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff{}
+	}
+	return t.To
+}
+func (t *GetTemplateVersionDiff_TemplateVersionDiff) GetJsonconfig() []*GetTemplateVersionDiff_TemplateVersionDiff_Jsonconfig {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff{}
+	}
+	return t.Jsonconfig
+}
+func (t *GetTemplateVersionDiff_TemplateVersionDiff) GetUischema() []*GetTemplateVersionDiff_TemplateVersionDiff_Uischema {
+	if t == nil {
+		t = &GetTemplateVersionDiff_TemplateVersionDiff{}
+	}
+	return t.Uischema
+}
+
+type MigrateTemplateDocuments_MigrateTemplateDocuments_Failed_Errors struct {
+	Path    string "json:\"path\" graphql:\"path\""
+	Message string "json:\"message\" graphql:\"message\""
+}
+
+func (t *MigrateTemplateDocuments_MigrateTemplateDocuments_Failed_Errors) GetPath() string {
+	if t == nil {
+		t = &MigrateTemplateDocuments_MigrateTemplateDocuments_Failed_Errors{}
+	}
+	return t.Path
+}
+func (t *MigrateTemplateDocuments_MigrateTemplateDocuments_Failed_Errors) GetMessage() string {
+	if t == nil {
+		t = &MigrateTemplateDocuments_MigrateTemplateDocuments_Failed_Errors{}
+	}
+	return t.Message
+}
+
+type MigrateTemplateDocuments_MigrateTemplateDocuments_Failed struct {
+	DocumentDataID string                                                             "json:\"documentDataID\" graphql:\"documentDataID\""
+	Errors         []*MigrateTemplateDocuments_MigrateTemplateDocuments_Failed_Errors "json:\"errors\" graphql:\"errors\""
+}
+
+func (t *MigrateTemplateDocuments_MigrateTemplateDocuments_Failed) GetDocumentDataID() string {
+	if t == nil {
+		t = &MigrateTemplateDocuments_MigrateTemplateDocuments_Failed{}
+	}
+	return t.DocumentDataID
+}
+func (t *MigrateTemplateDocuments_MigrateTemplateDocuments_Failed) GetErrors() []*MigrateTemplateDocuments_MigrateTemplateDocuments_Failed_Errors {
+	if t == nil {
+		t = &MigrateTemplateDocuments_MigrateTemplateDocuments_Failed{}
+	}
+	return t.Errors
+}
+
+type MigrateTemplateDocuments_MigrateTemplateDocuments struct {
+	TemplateID string                                                      "json:\"templateID\" graphql:\"templateID\""
+	Version    int64                                                       "json:\"version\" graphql:\"version\""
+	Migrated   []string                                                    "json:\"migrated\" graphql:\"migrated\""
+	Failed     []*MigrateTemplateDocuments_MigrateTemplateDocuments_Failed "json:\"failed\" graphql:\"failed\""
+}
+
+func (t *MigrateTemplateDocuments_MigrateTemplateDocuments) GetTemplateID() string {
+	if t == nil {
+		t = &MigrateTemplateDocuments_MigrateTemplateDocuments{}
+	}
+	return t.TemplateID
+}
+func (t *MigrateTemplateDocuments_MigrateTemplateDocuments) GetVersion() int64 {
+	if t == nil {
+		t = &MigrateTemplateDocuments_MigrateTemplateDocuments{}
+	}
+	return t.Version
+}
+func (t *MigrateTemplateDocuments_MigrateTemplateDocuments) GetMigrated() []string {
+	if t == nil {
+		t = &MigrateTemplateDocuments_MigrateTemplateDocuments{}
+	}
+	return t.Migrated
+}
+func (t *MigrateTemplateDocuments_MigrateTemplateDocuments) GetFailed() []*MigrateTemplateDocuments_MigrateTemplateDocuments_Failed {
+	if t == nil {
+		t = &MigrateTemplateDocuments_MigrateTemplateDocuments{}
+	}
+	return t.Failed
+}
+
+type CloneTemplate_CloneTemplate_Template_Owner struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *CloneTemplate_CloneTemplate_Template_Owner) GetID() string {
+	if t == nil {
+		t = &CloneTemplate_CloneTemplate_Template_Owner{}
+	}
+	return t.ID
+}
+
+type CloneTemplate_CloneTemplate_Template struct {
+	Description           *string                                     "json:\"description,omitempty\" graphql:\"description\""
+	ID                    string                                      "json:\"id\" graphql:\"id\""
+	Jsonconfig            json.RawMessage                             "json:\"jsonconfig\" graphql:\"jsonconfig\""
+	Name                  string                                      "json:\"name\" graphql:\"name\""
+	SourceTemplateID      *string                                     "json:\"sourceTemplateID,omitempty\" graphql:\"sourceTemplateID\""
+	SourceTemplateVersion *int64                                      "json:\"sourceTemplateVersion,omitempty\" graphql:\"sourceTemplateVersion\""
+	TemplateType          enums.DocumentType                          "json:\"templateType\" graphql:\"templateType\""
+	Uischema              json.RawMessage                             "json:\"uischema,omitempty\" graphql:\"uischema\""
+	Version               int64                                       "json:\"version\" graphql:\"version\""
+	Owner                 *CloneTemplate_CloneTemplate_Template_Owner "json:\"owner,omitempty\" graphql:\"owner\""
+}
+
+func (t *CloneTemplate_CloneTemplate_Template) GetDescription() *string {
+	if t == nil {
+		t = &CloneTemplate_CloneTemplate_Template{}
+	}
+	return t.Description
+}
+func (t *CloneTemplate_CloneTemplate_Template) GetID() string {
+	if t == nil {
+		t = &CloneTemplate_CloneTemplate_Template{}
+	}
+	return t.ID
+}
+func (t *CloneTemplate_CloneTemplate_Template) GetJsonconfig() *json.RawMessage {
+	if t == nil {
+		t = &CloneTemplate_CloneTemplate_Template{}
+	}
+	return &t.Jsonconfig
+}
+func (t *CloneTemplate_CloneTemplate_Template) GetName() string {
+	if t == nil {
+		t = &CloneTemplate_CloneTemplate_Template{}
+	}
+	return t.Name
+}
+func (t *CloneTemplate_CloneTemplate_Template) GetSourceTemplateID() *string {
+	if t == nil {
+		t = &CloneTemplate_CloneTemplate_Template{}
+	}
+	return t.SourceTemplateID
+}
+func (t *CloneTemplate_CloneTemplate_Template) GetSourceTemplateVersion() *int64 {
+	if t == nil {
+		t = &CloneTemplate_CloneTemplate_Template{}
+	}
+	return t.SourceTemplateVersion
+}
+func (t *CloneTemplate_CloneTemplate_Template) GetTemplateType() *enums.DocumentType {
+	if t == nil {
+		t = &CloneTemplate_CloneTemplate_Template{}
+	}
+	return &t.TemplateType
+}
+func (t *CloneTemplate_CloneTemplate_Template) GetUischema() *json.RawMessage {
+	if t == nil {
+		t = &CloneTemplate_CloneTemplate_Template{}
+	}
+	return &t.Uischema
+}
+func (t *CloneTemplate_CloneTemplate_Template) GetVersion() int64 {
+	if t == nil {
+		t = &CloneTemplate_CloneTemplate_Template{}
+	}
+	return t.Version
+}
+func (t *CloneTemplate_CloneTemplate_Template) GetOwner() *CloneTemplate_CloneTemplate_Template_Owner {
+	if t == nil {
+		t = &CloneTemplate_CloneTemplate_Template{}
+	}
+	return t.Owner
+}
+
+type CloneTemplate_CloneTemplate struct {
+	Template CloneTemplate_CloneTemplate_Template "json:\"template\" graphql:\"template\""
+}
+
+func (t *CloneTemplate_CloneTemplate) GetTemplate() *CloneTemplate_CloneTemplate_Template {
+	if t == nil {
+		t = &CloneTemplate_CloneTemplate{}
+	}
+	return &t.Template
+}
+
 type CreateTFASetting_CreateTFASetting_TfaSetting_Owner struct {
 	ID string "json:\"id\" graphql:\"id\""
 }
@@ -23824,6 +26051,8 @@ type CreateTFASetting_CreateTFASetting_TfaSetting struct {
 	RecoveryCodes []string                                            "json:\"recoveryCodes,omitempty\" graphql:\"recoveryCodes\""
 	TotpAllowed   *bool                                               "json:\"totpAllowed,omitempty\" graphql:\"totpAllowed\""
 	Verified      bool                                                "json:\"verified\" graphql:\"verified\""
+	QRCode        *string                                             "json:\"qrCode,omitempty\" graphql:\"qrCode\""
+	QRImage       *string                                             "json:\"qrImage,omitempty\" graphql:\"qrImage\""
 	Owner         *CreateTFASetting_CreateTFASetting_TfaSetting_Owner "json:\"owner,omitempty\" graphql:\"owner\""
 }
 
@@ -23845,6 +26074,18 @@ func (t *CreateTFASetting_CreateTFASetting_TfaSetting) GetVerified() bool {
 	}
 	return t.Verified
 }
+func (t *CreateTFASetting_CreateTFASetting_TfaSetting) GetQRCode() *string {
+	if t == nil {
+		t = &CreateTFASetting_CreateTFASetting_TfaSetting{}
+	}
+	return t.QRCode
+}
+func (t *CreateTFASetting_CreateTFASetting_TfaSetting) GetQRImage() *string {
+	if t == nil {
+		t = &CreateTFASetting_CreateTFASetting_TfaSetting{}
+	}
+	return t.QRImage
+}
 func (t *CreateTFASetting_CreateTFASetting_TfaSetting) GetOwner() *CreateTFASetting_CreateTFASetting_TfaSetting_Owner {
 	if t == nil {
 		t = &CreateTFASetting_CreateTFASetting_TfaSetting{}
@@ -23943,6 +26184,8 @@ type GetTFASetting_TfaSetting struct {
 	RecoveryCodes []string                        "json:\"recoveryCodes,omitempty\" graphql:\"recoveryCodes\""
 	TotpAllowed   *bool                           "json:\"totpAllowed,omitempty\" graphql:\"totpAllowed\""
 	Verified      bool                            "json:\"verified\" graphql:\"verified\""
+	QRCode        *string                         "json:\"qrCode,omitempty\" graphql:\"qrCode\""
+	QRImage       *string                         "json:\"qrImage,omitempty\" graphql:\"qrImage\""
 	Owner         *GetTFASetting_TfaSetting_Owner "json:\"owner,omitempty\" graphql:\"owner\""
 }
 
@@ -23964,6 +26207,18 @@ func (t *GetTFASetting_TfaSetting) GetVerified() bool {
 	}
 	return t.Verified
 }
+func (t *GetTFASetting_TfaSetting) GetQRCode() *string {
+	if t == nil {
+		t = &GetTFASetting_TfaSetting{}
+	}
+	return t.QRCode
+}
+func (t *GetTFASetting_TfaSetting) GetQRImage() *string {
+	if t == nil {
+		t = &GetTFASetting_TfaSetting{}
+	}
+	return t.QRImage
+}
 func (t *GetTFASetting_TfaSetting) GetOwner() *GetTFASetting_TfaSetting_Owner {
 	if t == nil {
 		t = &GetTFASetting_TfaSetting{}
@@ -24007,6 +26262,78 @@ func (t *UpdateTFASetting_UpdateTFASetting) GetTfaSetting() *UpdateTFASetting_Up
 	return &t.TfaSetting
 }
 
+type VerifyTFASetting_VerifyTFASetting_TfaSetting struct {
+	RecoveryCodes []string "json:\"recoveryCodes,omitempty\" graphql:\"recoveryCodes\""
+	TotpAllowed   *bool    "json:\"totpAllowed,omitempty\" graphql:\"totpAllowed\""
+	Verified      bool     "json:\"verified\" graphql:\"verified\""
+}
+
+func (t *VerifyTFASetting_VerifyTFASetting_TfaSetting) GetRecoveryCodes() []string {
+	if t == nil {
+		t = &VerifyTFASetting_VerifyTFASetting_TfaSetting{}
+	}
+	return t.RecoveryCodes
+}
+func (t *VerifyTFASetting_VerifyTFASetting_TfaSetting) GetTotpAllowed() *bool {
+	if t == nil {
+		t = &VerifyTFASetting_VerifyTFASetting_TfaSetting{}
+	}
+	return t.TotpAllowed
+}
+func (t *VerifyTFASetting_VerifyTFASetting_TfaSetting) GetVerified() bool {
+	if t == nil {
+		t = &VerifyTFASetting_VerifyTFASetting_TfaSetting{}
+	}
+	return t.Verified
+}
+
+type VerifyTFASetting_VerifyTFASetting struct {
+	TfaSetting VerifyTFASetting_VerifyTFASetting_TfaSetting "json:\"tfaSetting\" graphql:\"tfaSetting\""
+}
+
+func (t *VerifyTFASetting_VerifyTFASetting) GetTfaSetting() *VerifyTFASetting_VerifyTFASetting_TfaSetting {
+	if t == nil {
+		t = &VerifyTFASetting_VerifyTFASetting{}
+	}
+	return &t.TfaSetting
+}
+
+type RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes_TfaSetting struct {
+	RecoveryCodes []string "json:\"recoveryCodes,omitempty\" graphql:\"recoveryCodes\""
+	TotpAllowed   *bool    "json:\"totpAllowed,omitempty\" graphql:\"totpAllowed\""
+	Verified      bool     "json:\"verified\" graphql:\"verified\""
+}
+
+func (t *RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes_TfaSetting) GetRecoveryCodes() []string {
+	if t == nil {
+		t = &RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes_TfaSetting{}
+	}
+	return t.RecoveryCodes
+}
+func (t *RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes_TfaSetting) GetTotpAllowed() *bool {
+	if t == nil {
+		t = &RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes_TfaSetting{}
+	}
+	return t.TotpAllowed
+}
+func (t *RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes_TfaSetting) GetVerified() bool {
+	if t == nil {
+		t = &RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes_TfaSetting{}
+	}
+	return t.Verified
+}
+
+type RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes struct {
+	TfaSetting RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes_TfaSetting "json:\"tfaSetting\" graphql:\"tfaSetting\""
+}
+
+func (t *RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes) GetTfaSetting() *RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes_TfaSetting {
+	if t == nil {
+		t = &RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes{}
+	}
+	return &t.TfaSetting
+}
+
 type CreateUser_CreateUser_User_OrgMemberships struct {
 	ID             string "json:\"id\" graphql:\"id\""
 	OrganizationID string "json:\"organizationID\" graphql:\"organizationID\""
@@ -26480,6 +28807,290 @@ func (t *GetUserSettingHistories_UserSettingHistories) GetEdges() []*GetUserSett
 	return t.Edges
 }
 
+type LockUser_LockUser_UserSetting struct {
+	ID                  string           "json:\"id\" graphql:\"id\""
+	Status              enums.UserStatus "json:\"status\" graphql:\"status\""
+	Locked              bool             "json:\"locked\" graphql:\"locked\""
+	LockedUntil         *time.Time       "json:\"lockedUntil,omitempty\" graphql:\"lockedUntil\""
+	FailedLoginAttempts int64            "json:\"failedLoginAttempts\" graphql:\"failedLoginAttempts\""
+	SuspendedAt         *time.Time       "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
+	UpdatedAt           *time.Time       "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy           *string          "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *LockUser_LockUser_UserSetting) GetID() string {
+	if t == nil {
+		t = &LockUser_LockUser_UserSetting{}
+	}
+	return t.ID
+}
+func (t *LockUser_LockUser_UserSetting) GetStatus() *enums.UserStatus {
+	if t == nil {
+		t = &LockUser_LockUser_UserSetting{}
+	}
+	return &t.Status
+}
+func (t *LockUser_LockUser_UserSetting) GetLocked() bool {
+	if t == nil {
+		t = &LockUser_LockUser_UserSetting{}
+	}
+	return t.Locked
+}
+func (t *LockUser_LockUser_UserSetting) GetLockedUntil() *time.Time {
+	if t == nil {
+		t = &LockUser_LockUser_UserSetting{}
+	}
+	return t.LockedUntil
+}
+func (t *LockUser_LockUser_UserSetting) GetFailedLoginAttempts() int64 {
+	if t == nil {
+		t = &LockUser_LockUser_UserSetting{}
+	}
+	return t.FailedLoginAttempts
+}
+func (t *LockUser_LockUser_UserSetting) GetSuspendedAt() *time.Time {
+	if t == nil {
+		t = &LockUser_LockUser_UserSetting{}
+	}
+	return t.SuspendedAt
+}
+func (t *LockUser_LockUser_UserSetting) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &LockUser_LockUser_UserSetting{}
+	}
+	return t.UpdatedAt
+}
+func (t *LockUser_LockUser_UserSetting) GetUpdatedBy() *string {
+	if t == nil {
+		t = &LockUser_LockUser_UserSetting{}
+	}
+	return t.UpdatedBy
+}
+
+type LockUser_LockUser struct {
+	UserSetting LockUser_LockUser_UserSetting "json:\"userSetting\" graphql:\"userSetting\""
+}
+
+func (t *LockUser_LockUser) GetUserSetting() *LockUser_LockUser_UserSetting {
+	if t == nil {
+		t = &LockUser_LockUser{}
+	}
+	return &t.UserSetting
+}
+
+type UnlockUser_UnlockUser_UserSetting struct {
+	ID                  string           "json:\"id\" graphql:\"id\""
+	Status              enums.UserStatus "json:\"status\" graphql:\"status\""
+	Locked              bool             "json:\"locked\" graphql:\"locked\""
+	LockedUntil         *time.Time       "json:\"lockedUntil,omitempty\" graphql:\"lockedUntil\""
+	FailedLoginAttempts int64            "json:\"failedLoginAttempts\" graphql:\"failedLoginAttempts\""
+	SuspendedAt         *time.Time       "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
+	UpdatedAt           *time.Time       "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy           *string          "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *UnlockUser_UnlockUser_UserSetting) GetID() string {
+	if t == nil {
+		t = &UnlockUser_UnlockUser_UserSetting{}
+	}
+	return t.ID
+}
+func (t *UnlockUser_UnlockUser_UserSetting) GetStatus() *enums.UserStatus {
+	if t == nil {
+		t = &UnlockUser_UnlockUser_UserSetting{}
+	}
+	return &t.Status
+}
+func (t *UnlockUser_UnlockUser_UserSetting) GetLocked() bool {
+	if t == nil {
+		t = &UnlockUser_UnlockUser_UserSetting{}
+	}
+	return t.Locked
+}
+func (t *UnlockUser_UnlockUser_UserSetting) GetLockedUntil() *time.Time {
+	if t == nil {
+		t = &UnlockUser_UnlockUser_UserSetting{}
+	}
+	return t.LockedUntil
+}
+func (t *UnlockUser_UnlockUser_UserSetting) GetFailedLoginAttempts() int64 {
+	if t == nil {
+		t = &UnlockUser_UnlockUser_UserSetting{}
+	}
+	return t.FailedLoginAttempts
+}
+func (t *UnlockUser_UnlockUser_UserSetting) GetSuspendedAt() *time.Time {
+	if t == nil {
+		t = &UnlockUser_UnlockUser_UserSetting{}
+	}
+	return t.SuspendedAt
+}
+func (t *UnlockUser_UnlockUser_UserSetting) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &UnlockUser_UnlockUser_UserSetting{}
+	}
+	return t.UpdatedAt
+}
+func (t *UnlockUser_UnlockUser_UserSetting) GetUpdatedBy() *string {
+	if t == nil {
+		t = &UnlockUser_UnlockUser_UserSetting{}
+	}
+	return t.UpdatedBy
+}
+
+type UnlockUser_UnlockUser struct {
+	UserSetting UnlockUser_UnlockUser_UserSetting "json:\"userSetting\" graphql:\"userSetting\""
+}
+
+func (t *UnlockUser_UnlockUser) GetUserSetting() *UnlockUser_UnlockUser_UserSetting {
+	if t == nil {
+		t = &UnlockUser_UnlockUser{}
+	}
+	return &t.UserSetting
+}
+
+type SuspendUser_SuspendUser_UserSetting struct {
+	ID                  string           "json:\"id\" graphql:\"id\""
+	Status              enums.UserStatus "json:\"status\" graphql:\"status\""
+	Locked              bool             "json:\"locked\" graphql:\"locked\""
+	LockedUntil         *time.Time       "json:\"lockedUntil,omitempty\" graphql:\"lockedUntil\""
+	FailedLoginAttempts int64            "json:\"failedLoginAttempts\" graphql:\"failedLoginAttempts\""
+	SuspendedAt         *time.Time       "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
+	UpdatedAt           *time.Time       "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy           *string          "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *SuspendUser_SuspendUser_UserSetting) GetID() string {
+	if t == nil {
+		t = &SuspendUser_SuspendUser_UserSetting{}
+	}
+	return t.ID
+}
+func (t *SuspendUser_SuspendUser_UserSetting) GetStatus() *enums.UserStatus {
+	if t == nil {
+		t = &SuspendUser_SuspendUser_UserSetting{}
+	}
+	return &t.Status
+}
+func (t *SuspendUser_SuspendUser_UserSetting) GetLocked() bool {
+	if t == nil {
+		t = &SuspendUser_SuspendUser_UserSetting{}
+	}
+	return t.Locked
+}
+func (t *SuspendUser_SuspendUser_UserSetting) GetLockedUntil() *time.Time {
+	if t == nil {
+		t = &SuspendUser_SuspendUser_UserSetting{}
+	}
+	return t.LockedUntil
+}
+func (t *SuspendUser_SuspendUser_UserSetting) GetFailedLoginAttempts() int64 {
+	if t == nil {
+		t = &SuspendUser_SuspendUser_UserSetting{}
+	}
+	return t.FailedLoginAttempts
+}
+func (t *SuspendUser_SuspendUser_UserSetting) GetSuspendedAt() *time.Time {
+	if t == nil {
+		t = &SuspendUser_SuspendUser_UserSetting{}
+	}
+	return t.SuspendedAt
+}
+func (t *SuspendUser_SuspendUser_UserSetting) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &SuspendUser_SuspendUser_UserSetting{}
+	}
+	return t.UpdatedAt
+}
+func (t *SuspendUser_SuspendUser_UserSetting) GetUpdatedBy() *string {
+	if t == nil {
+		t = &SuspendUser_SuspendUser_UserSetting{}
+	}
+	return t.UpdatedBy
+}
+
+type SuspendUser_SuspendUser struct {
+	UserSetting SuspendUser_SuspendUser_UserSetting "json:\"userSetting\" graphql:\"userSetting\""
+}
+
+func (t *SuspendUser_SuspendUser) GetUserSetting() *SuspendUser_SuspendUser_UserSetting {
+	if t == nil {
+		t = &SuspendUser_SuspendUser{}
+	}
+	return &t.UserSetting
+}
+
+type UnsuspendUser_UnsuspendUser_UserSetting struct {
+	ID                  string           "json:\"id\" graphql:\"id\""
+	Status              enums.UserStatus "json:\"status\" graphql:\"status\""
+	Locked              bool             "json:\"locked\" graphql:\"locked\""
+	LockedUntil         *time.Time       "json:\"lockedUntil,omitempty\" graphql:\"lockedUntil\""
+	FailedLoginAttempts int64            "json:\"failedLoginAttempts\" graphql:\"failedLoginAttempts\""
+	SuspendedAt         *time.Time       "json:\"suspendedAt,omitempty\" graphql:\"suspendedAt\""
+	UpdatedAt           *time.Time       "json:\"updatedAt,omitempty\" graphql:\"updatedAt\""
+	UpdatedBy           *string          "json:\"updatedBy,omitempty\" graphql:\"updatedBy\""
+}
+
+func (t *UnsuspendUser_UnsuspendUser_UserSetting) GetID() string {
+	if t == nil {
+		t = &UnsuspendUser_UnsuspendUser_UserSetting{}
+	}
+	return t.ID
+}
+func (t *UnsuspendUser_UnsuspendUser_UserSetting) GetStatus() *enums.UserStatus {
+	if t == nil {
+		t = &UnsuspendUser_UnsuspendUser_UserSetting{}
+	}
+	return &t.Status
+}
+func (t *UnsuspendUser_UnsuspendUser_UserSetting) GetLocked() bool {
+	if t == nil {
+		t = &UnsuspendUser_UnsuspendUser_UserSetting{}
+	}
+	return t.Locked
+}
+func (t *UnsuspendUser_UnsuspendUser_UserSetting) GetLockedUntil() *time.Time {
+	if t == nil {
+		t = &UnsuspendUser_UnsuspendUser_UserSetting{}
+	}
+	return t.LockedUntil
+}
+func (t *UnsuspendUser_UnsuspendUser_UserSetting) GetFailedLoginAttempts() int64 {
+	if t == nil {
+		t = &UnsuspendUser_UnsuspendUser_UserSetting{}
+	}
+	return t.FailedLoginAttempts
+}
+func (t *UnsuspendUser_UnsuspendUser_UserSetting) GetSuspendedAt() *time.Time {
+	if t == nil {
+		t = &UnsuspendUser_UnsuspendUser_UserSetting{}
+	}
+	return t.SuspendedAt
+}
+func (t *UnsuspendUser_UnsuspendUser_UserSetting) GetUpdatedAt() *time.Time {
+	if t == nil {
+		t = &UnsuspendUser_UnsuspendUser_UserSetting{}
+	}
+	return t.UpdatedAt
+}
+func (t *UnsuspendUser_UnsuspendUser_UserSetting) GetUpdatedBy() *string {
+	if t == nil {
+		t = &UnsuspendUser_UnsuspendUser_UserSetting{}
+	}
+	return t.UpdatedBy
+}
+
+type UnsuspendUser_UnsuspendUser struct {
+	UserSetting UnsuspendUser_UnsuspendUser_UserSetting "json:\"userSetting\" graphql:\"userSetting\""
+}
+
+func (t *UnsuspendUser_UnsuspendUser) GetUserSetting() *UnsuspendUser_UnsuspendUser_UserSetting {
+	if t == nil {
+		t = &UnsuspendUser_UnsuspendUser{}
+	}
+	return &t.UserSetting
+}
+
 type GetWebhookByID_Webhook_Events struct {
 	ID string "json:\"id\" graphql:\"id\""
 }
@@ -26497,6 +29108,8 @@ type GetWebhookByID_Webhook struct {
 	Description    *string                          "json:\"description,omitempty\" graphql:\"description\""
 	DestinationURL string                           "json:\"destinationURL\" graphql:\"destinationURL\""
 	Enabled        bool                             "json:\"enabled\" graphql:\"enabled\""
+	EventTypes     []string                         "json:\"eventTypes,omitempty\" graphql:\"eventTypes\""
+	ObjectTypes    []string                         "json:\"objectTypes,omitempty\" graphql:\"objectTypes\""
 	Failures       *int64                           "json:\"failures,omitempty\" graphql:\"failures\""
 	ID             string                           "json:\"id\" graphql:\"id\""
 	LastError      *string                          "json:\"lastError,omitempty\" graphql:\"lastError\""
@@ -26538,6 +29151,18 @@ func (t *GetWebhookByID_Webhook) GetEnabled() bool {
 	}
 	return t.Enabled
 }
+func (t *GetWebhookByID_Webhook) GetEventTypes() []string {
+	if t == nil {
+		t = &GetWebhookByID_Webhook{}
+	}
+	return t.EventTypes
+}
+func (t *GetWebhookByID_Webhook) GetObjectTypes() []string {
+	if t == nil {
+		t = &GetWebhookByID_Webhook{}
+	}
+	return t.ObjectTypes
+}
 func (t *GetWebhookByID_Webhook) GetFailures() *int64 {
 	if t == nil {
 		t = &GetWebhookByID_Webhook{}
@@ -26610,6 +29235,8 @@ type GetAllWebhooks_Webhooks_Edges_Node struct {
 	Description    *string                                      "json:\"description,omitempty\" graphql:\"description\""
 	DestinationURL string                                       "json:\"destinationURL\" graphql:\"destinationURL\""
 	Enabled        bool                                         "json:\"enabled\" graphql:\"enabled\""
+	EventTypes     []string                                     "json:\"eventTypes,omitempty\" graphql:\"eventTypes\""
+	ObjectTypes    []string                                     "json:\"objectTypes,omitempty\" graphql:\"objectTypes\""
 	Failures       *int64                                       "json:\"failures,omitempty\" graphql:\"failures\""
 	ID             string                                       "json:\"id\" graphql:\"id\""
 	LastError      *string                                      "json:\"lastError,omitempty\" graphql:\"lastError\""
@@ -26651,6 +29278,18 @@ func (t *GetAllWebhooks_Webhooks_Edges_Node) GetEnabled() bool {
 	}
 	return t.Enabled
 }
+func (t *GetAllWebhooks_Webhooks_Edges_Node) GetEventTypes() []string {
+	if t == nil {
+		t = &GetAllWebhooks_Webhooks_Edges_Node{}
+	}
+	return t.EventTypes
+}
+func (t *GetAllWebhooks_Webhooks_Edges_Node) GetObjectTypes() []string {
+	if t == nil {
+		t = &GetAllWebhooks_Webhooks_Edges_Node{}
+	}
+	return t.ObjectTypes
+}
 func (t *GetAllWebhooks_Webhooks_Edges_Node) GetFailures() *int64 {
 	if t == nil {
 		t = &GetAllWebhooks_Webhooks_Edges_Node{}
@@ -26743,6 +29382,8 @@ type CreateWebhook_CreateWebhook_Webhook struct {
 	Description    *string                                       "json:\"description,omitempty\" graphql:\"description\""
 	DestinationURL string                                        "json:\"destinationURL\" graphql:\"destinationURL\""
 	Enabled        bool                                          "json:\"enabled\" graphql:\"enabled\""
+	EventTypes     []string                                      "json:\"eventTypes,omitempty\" graphql:\"eventTypes\""
+	ObjectTypes    []string                                      "json:\"objectTypes,omitempty\" graphql:\"objectTypes\""
 	Failures       *int64                                        "json:\"failures,omitempty\" graphql:\"failures\""
 	ID             string                                        "json:\"id\" graphql:\"id\""
 	LastError      *string                                       "json:\"lastError,omitempty\" graphql:\"lastError\""
@@ -26770,6 +29411,18 @@ func (t *CreateWebhook_CreateWebhook_Webhook) GetEnabled() bool {
 	}
 	return t.Enabled
 }
+func (t *CreateWebhook_CreateWebhook_Webhook) GetEventTypes() []string {
+	if t == nil {
+		t = &CreateWebhook_CreateWebhook_Webhook{}
+	}
+	return t.EventTypes
+}
+func (t *CreateWebhook_CreateWebhook_Webhook) GetObjectTypes() []string {
+	if t == nil {
+		t = &CreateWebhook_CreateWebhook_Webhook{}
+	}
+	return t.ObjectTypes
+}
 func (t *CreateWebhook_CreateWebhook_Webhook) GetFailures() *int64 {
 	if t == nil {
 		t = &CreateWebhook_CreateWebhook_Webhook{}
@@ -26825,15 +29478,17 @@ func (t *CreateWebhook_CreateWebhook) GetWebhook() *CreateWebhook_CreateWebhook_
 }
 
 type CreateBulkWebhook_CreateBulkWebhook_Webhooks struct {
-	Description    *string "json:\"description,omitempty\" graphql:\"description\""
-	DestinationURL string  "json:\"destinationURL\" graphql:\"destinationURL\""
-	Enabled        bool    "json:\"enabled\" graphql:\"enabled\""
-	Failures       *int64  "json:\"failures,omitempty\" graphql:\"failures\""
-	ID             string  "json:\"id\" graphql:\"id\""
-	LastError      *string "json:\"lastError,omitempty\" graphql:\"lastError\""
-	LastResponse   *string "json:\"lastResponse,omitempty\" graphql:\"lastResponse\""
-	Name           string  "json:\"name\" graphql:\"name\""
-	OwnerID        *string "json:\"ownerID,omitempty\" graphql:\"ownerID\""
+	Description    *string  "json:\"description,omitempty\" graphql:\"description\""
+	DestinationURL string   "json:\"destinationURL\" graphql:\"destinationURL\""
+	Enabled        bool     "json:\"enabled\" graphql:\"enabled\""
+	EventTypes     []string "json:\"eventTypes,omitempty\" graphql:\"eventTypes\""
+	ObjectTypes    []string "json:\"objectTypes,omitempty\" graphql:\"objectTypes\""
+	Failures       *int64   "json:\"failures,omitempty\" graphql:\"failures\""
+	ID             string   "json:\"id\" graphql:\"id\""
+	LastError      *string  "json:\"lastError,omitempty\" graphql:\"lastError\""
+	LastResponse   *string  "json:\"lastResponse,omitempty\" graphql:\"lastResponse\""
+	Name           string   "json:\"name\" graphql:\"name\""
+	OwnerID        *string  "json:\"ownerID,omitempty\" graphql:\"ownerID\""
 }
 
 func (t *CreateBulkWebhook_CreateBulkWebhook_Webhooks) GetDescription() *string {
@@ -26854,6 +29509,18 @@ func (t *CreateBulkWebhook_CreateBulkWebhook_Webhooks) GetEnabled() bool {
 	}
 	return t.Enabled
 }
+func (t *CreateBulkWebhook_CreateBulkWebhook_Webhooks) GetEventTypes() []string {
+	if t == nil {
+		t = &CreateBulkWebhook_CreateBulkWebhook_Webhooks{}
+	}
+	return t.EventTypes
+}
+func (t *CreateBulkWebhook_CreateBulkWebhook_Webhooks) GetObjectTypes() []string {
+	if t == nil {
+		t = &CreateBulkWebhook_CreateBulkWebhook_Webhooks{}
+	}
+	return t.ObjectTypes
+}
 func (t *CreateBulkWebhook_CreateBulkWebhook_Webhooks) GetFailures() *int64 {
 	if t == nil {
 		t = &CreateBulkWebhook_CreateBulkWebhook_Webhooks{}
@@ -26903,15 +29570,17 @@ func (t *CreateBulkWebhook_CreateBulkWebhook) GetWebhooks() []*CreateBulkWebhook
 }
 
 type CreateBulkCSVWebhook_CreateBulkCSVWebhook_Webhooks struct {
-	Description    *string "json:\"description,omitempty\" graphql:\"description\""
-	DestinationURL string  "json:\"destinationURL\" graphql:\"destinationURL\""
-	Enabled        bool    "json:\"enabled\" graphql:\"enabled\""
-	Failures       *int64  "json:\"failures,omitempty\" graphql:\"failures\""
-	ID             string  "json:\"id\" graphql:\"id\""
-	LastError      *string "json:\"lastError,omitempty\" graphql:\"lastError\""
-	LastResponse   *string "json:\"lastResponse,omitempty\" graphql:\"lastResponse\""
-	Name           string  "json:\"name\" graphql:\"name\""
-	OwnerID        *string "json:\"ownerID,omitempty\" graphql:\"ownerID\""
+	Description    *string  "json:\"description,omitempty\" graphql:\"description\""
+	DestinationURL string   "json:\"destinationURL\" graphql:\"destinationURL\""
+	Enabled        bool     "json:\"enabled\" graphql:\"enabled\""
+	EventTypes     []string "json:\"eventTypes,omitempty\" graphql:\"eventTypes\""
+	ObjectTypes    []string "json:\"objectTypes,omitempty\" graphql:\"objectTypes\""
+	Failures       *int64   "json:\"failures,omitempty\" graphql:\"failures\""
+	ID             string   "json:\"id\" graphql:\"id\""
+	LastError      *string  "json:\"lastError,omitempty\" graphql:\"lastError\""
+	LastResponse   *string  "json:\"lastResponse,omitempty\" graphql:\"lastResponse\""
+	Name           string   "json:\"name\" graphql:\"name\""
+	OwnerID        *string  "json:\"ownerID,omitempty\" graphql:\"ownerID\""
 }
 
 func (t *CreateBulkCSVWebhook_CreateBulkCSVWebhook_Webhooks) GetDescription() *string {
@@ -26932,6 +29601,18 @@ func (t *CreateBulkCSVWebhook_CreateBulkCSVWebhook_Webhooks) GetEnabled() bool {
 	}
 	return t.Enabled
 }
+func (t *CreateBulkCSVWebhook_CreateBulkCSVWebhook_Webhooks) GetEventTypes() []string {
+	if t == nil {
+		t = &CreateBulkCSVWebhook_CreateBulkCSVWebhook_Webhooks{}
+	}
+	return t.EventTypes
+}
+func (t *CreateBulkCSVWebhook_CreateBulkCSVWebhook_Webhooks) GetObjectTypes() []string {
+	if t == nil {
+		t = &CreateBulkCSVWebhook_CreateBulkCSVWebhook_Webhooks{}
+	}
+	return t.ObjectTypes
+}
 func (t *CreateBulkCSVWebhook_CreateBulkCSVWebhook_Webhooks) GetFailures() *int64 {
 	if t == nil {
 		t = &CreateBulkCSVWebhook_CreateBulkCSVWebhook_Webhooks{}
@@ -26997,6 +29678,8 @@ type UpdateWebhook_UpdateWebhook_Webhook struct {
 	Description    *string                                       "json:\"description,omitempty\" graphql:\"description\""
 	DestinationURL string                                        "json:\"destinationURL\" graphql:\"destinationURL\""
 	Enabled        bool                                          "json:\"enabled\" graphql:\"enabled\""
+	EventTypes     []string                                      "json:\"eventTypes,omitempty\" graphql:\"eventTypes\""
+	ObjectTypes    []string                                      "json:\"objectTypes,omitempty\" graphql:\"objectTypes\""
 	Failures       *int64                                        "json:\"failures,omitempty\" graphql:\"failures\""
 	ID             string                                        "json:\"id\" graphql:\"id\""
 	LastError      *string                                       "json:\"lastError,omitempty\" graphql:\"lastError\""
@@ -27038,6 +29721,18 @@ func (t *UpdateWebhook_UpdateWebhook_Webhook) GetEnabled() bool {
 	}
 	return t.Enabled
 }
+func (t *UpdateWebhook_UpdateWebhook_Webhook) GetEventTypes() []string {
+	if t == nil {
+		t = &UpdateWebhook_UpdateWebhook_Webhook{}
+	}
+	return t.EventTypes
+}
+func (t *UpdateWebhook_UpdateWebhook_Webhook) GetObjectTypes() []string {
+	if t == nil {
+		t = &UpdateWebhook_UpdateWebhook_Webhook{}
+	}
+	return t.ObjectTypes
+}
 func (t *UpdateWebhook_UpdateWebhook_Webhook) GetFailures() *int64 {
 	if t == nil {
 		t = &UpdateWebhook_UpdateWebhook_Webhook{}
@@ -27115,6 +29810,233 @@ func (t *DeleteWebhook_DeleteWebhook) GetDeletedID() string {
 	return t.DeletedID
 }
 
+type GetWebhookDeliveries_WebhookDeliveries_PageInfo struct {
+	HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+	EndCursor   *string "json:\"endCursor,omitempty\" graphql:\"endCursor\""
+}
+
+func (t *GetWebhookDeliveries_WebhookDeliveries_PageInfo) GetHasNextPage() bool {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_PageInfo{}
+	}
+	return t.HasNextPage
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries_PageInfo) GetEndCursor() *string {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_PageInfo{}
+	}
+	return t.EndCursor
+}
+
+type GetWebhookDeliveries_WebhookDeliveries_Edges_Node struct {
+	Attempt        int64      "json:\"attempt\" graphql:\"attempt\""
+	CreatedAt      *time.Time "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	Error          *string    "json:\"error,omitempty\" graphql:\"error\""
+	EventID        string     "json:\"eventID\" graphql:\"eventID\""
+	ID             string     "json:\"id\" graphql:\"id\""
+	Latency        *int64     "json:\"latency,omitempty\" graphql:\"latency\""
+	Redelivery     bool       "json:\"redelivery\" graphql:\"redelivery\""
+	RequestBody    *string    "json:\"requestBody,omitempty\" graphql:\"requestBody\""
+	ResponseBody   *string    "json:\"responseBody,omitempty\" graphql:\"responseBody\""
+	ResponseStatus *int64     "json:\"responseStatus,omitempty\" graphql:\"responseStatus\""
+	WebhookID      string     "json:\"webhookID\" graphql:\"webhookID\""
+}
+
+func (t *GetWebhookDeliveries_WebhookDeliveries_Edges_Node) GetAttempt() int64 {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_Edges_Node{}
+	}
+	return t.Attempt
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries_Edges_Node) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_Edges_Node{}
+	}
+	return t.CreatedAt
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries_Edges_Node) GetError() *string {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_Edges_Node{}
+	}
+	return t.Error
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries_Edges_Node) GetEventID() string {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_Edges_Node{}
+	}
+	return t.EventID
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries_Edges_Node) GetID() string {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_Edges_Node{}
+	}
+	return t.ID
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries_Edges_Node) GetLatency() *int64 {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_Edges_Node{}
+	}
+	return t.Latency
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries_Edges_Node) GetRedelivery() bool {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_Edges_Node{}
+	}
+	return t.Redelivery
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries_Edges_Node) GetRequestBody() *string {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_Edges_Node{}
+	}
+	return t.RequestBody
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries_Edges_Node) GetResponseBody() *string {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_Edges_Node{}
+	}
+	return t.ResponseBody
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries_Edges_Node) GetResponseStatus() *int64 {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_Edges_Node{}
+	}
+	return t.ResponseStatus
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries_Edges_Node) GetWebhookID() string {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_Edges_Node{}
+	}
+	return t.WebhookID
+}
+
+type GetWebhookDeliveries_WebhookDeliveries_Edges struct {
+	Node *GetWebhookDeliveries_WebhookDeliveries_Edges_Node "json:\"node,omitempty\" graphql:\"node\""
+}
+
+func (t *GetWebhookDeliveries_WebhookDeliveries_Edges) GetNode() *GetWebhookDeliveries_WebhookDeliveries_Edges_Node {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries_Edges{}
+	}
+	return t.Node
+}
+
+type GetWebhookDeliveries_WebhookDeliveries struct {
+	TotalCount int64                                           "json:\"totalCount\" graphql:\"totalCount\""
+	PageInfo   GetWebhookDeliveries_WebhookDeliveries_PageInfo "json:\"pageInfo\" graphql:\"pageInfo\""
+	Edges      []*GetWebhookDeliveries_WebhookDeliveries_Edges "json:\"edges,omitempty\" graphql:\"edges\""
+}
+
+func (t *GetWebhookDeliveries_WebhookDeliveries) GetTotalCount() int64 {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries{}
+	}
+	return t.TotalCount
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries) GetPageInfo() *GetWebhookDeliveries_WebhookDeliveries_PageInfo {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries{}
+	}
+	return &t.PageInfo
+}
+func (t *GetWebhookDeliveries_WebhookDeliveries) GetEdges() []*GetWebhookDeliveries_WebhookDeliveries_Edges {
+	if t == nil {
+		t = &GetWebhookDeliveries_WebhookDeliveries{}
+	}
+	return t.Edges
+}
+
+type RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries struct {
+	Attempt        int64      "json:\"attempt\" graphql:\"attempt\""
+	CreatedAt      *time.Time "json:\"createdAt,omitempty\" graphql:\"createdAt\""
+	Error          *string    "json:\"error,omitempty\" graphql:\"error\""
+	EventID        string     "json:\"eventID\" graphql:\"eventID\""
+	ID             string     "json:\"id\" graphql:\"id\""
+	Latency        *int64     "json:\"latency,omitempty\" graphql:\"latency\""
+	Redelivery     bool       "json:\"redelivery\" graphql:\"redelivery\""
+	RequestBody    *string    "json:\"requestBody,omitempty\" graphql:\"requestBody\""
+	ResponseBody   *string    "json:\"responseBody,omitempty\" graphql:\"responseBody\""
+	ResponseStatus *int64     "json:\"responseStatus,omitempty\" graphql:\"responseStatus\""
+	WebhookID      string     "json:\"webhookID\" graphql:\"webhookID\""
+}
+
+func (t *RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries) GetAttempt() int64 {
+	if t == nil {
+		t = &RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries{}
+	}
+	return t.Attempt
+}
+func (t *RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries) GetCreatedAt() *time.Time {
+	if t == nil {
+		t = &RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries{}
+	}
+	return t.CreatedAt
+}
+func (t *RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries) GetError() *string {
+	if t == nil {
+		t = &RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries{}
+	}
+	return t.Error
+}
+func (t *RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries) GetEventID() string {
+	if t == nil {
+		t = &RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries{}
+	}
+	return t.EventID
+}
+func (t *RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries) GetID() string {
+	if t == nil {
+		t = &RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries{}
+	}
+	return t.ID
+}
+func (t *RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries) GetLatency() *int64 {
+	if t == nil {
+		t = &RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries{}
+	}
+	return t.Latency
+}
+func (t *RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries) GetRedelivery() bool {
+	if t == nil {
+		t = &RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries{}
+	}
+	return t.Redelivery
+}
+func (t *RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries) GetRequestBody() *string {
+	if t == nil {
+		t = &RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries{}
+	}
+	return t.RequestBody
+}
+func (t *RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries) GetResponseBody() *string {
+	if t == nil {
+		t = &RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries{}
+	}
+	return t.ResponseBody
+}
+func (t *RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries) GetResponseStatus() *int64 {
+	if t == nil {
+		t = &RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries{}
+	}
+	return t.ResponseStatus
+}
+func (t *RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries) GetWebhookID() string {
+	if t == nil {
+		t = &RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries{}
+	}
+	return t.WebhookID
+}
+
+type RedeliverWebhookEvent_RedeliverWebhookEvent struct {
+	WebhookDeliveries []*RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries "json:\"webhookDeliveries\" graphql:\"webhookDeliveries\""
+}
+
+func (t *RedeliverWebhookEvent_RedeliverWebhookEvent) GetWebhookDeliveries() []*RedeliverWebhookEvent_RedeliverWebhookEvent_WebhookDeliveries {
+	if t == nil {
+		t = &RedeliverWebhookEvent_RedeliverWebhookEvent{}
+	}
+	return t.WebhookDeliveries
+}
+
 type GetAllWebhookHistories_WebhookHistories_Edges_Node struct {
 	CreatedAt      *time.Time        "json:\"createdAt,omitempty\" graphql:\"createdAt\""
 	CreatedBy      *string           "json:\"createdBy,omitempty\" graphql:\"createdBy\""
@@ -27460,6 +30382,17 @@ func (t *DeleteAPIToken) GetDeleteAPIToken() *DeleteAPIToken_DeleteAPIToken {
 	return &t.DeleteAPIToken
 }
 
+type AuditLogs struct {
+	AuditLogs AuditLogs_AuditLogs "json:\"auditLogs\" graphql:\"auditLogs\""
+}
+
+func (t *AuditLogs) GetAuditLogs() *AuditLogs_AuditLogs {
+	if t == nil {
+		t = &AuditLogs{}
+	}
+	return &t.AuditLogs
+}
+
 type CreateBulkCSVContact struct {
 	CreateBulkCSVContact CreateBulkCSVContact_CreateBulkCSVContact "json:\"createBulkCSVContact\" graphql:\"createBulkCSVContact\""
 }
@@ -27493,6 +30426,17 @@ func (t *CreateContact) GetCreateContact() *CreateContact_CreateContact {
 	return &t.CreateContact
 }
 
+type DeleteBulkContact struct {
+	DeleteBulkContact DeleteBulkContact_DeleteBulkContact "json:\"deleteBulkContact\" graphql:\"deleteBulkContact\""
+}
+
+func (t *DeleteBulkContact) GetDeleteBulkContact() *DeleteBulkContact_DeleteBulkContact {
+	if t == nil {
+		t = &DeleteBulkContact{}
+	}
+	return &t.DeleteBulkContact
+}
+
 type DeleteContact struct {
 	DeleteContact DeleteContact_DeleteContact "json:\"deleteContact\" graphql:\"deleteContact\""
 }
@@ -27537,6 +30481,28 @@ func (t *GetContacts) GetContacts() *GetContacts_Contacts {
 	return &t.Contacts
 }
 
+type ImportBulkCSVContact struct {
+	ImportBulkCSVContact ImportBulkCSVContact_ImportBulkCSVContact "json:\"importBulkCSVContact\" graphql:\"importBulkCSVContact\""
+}
+
+func (t *ImportBulkCSVContact) GetImportBulkCSVContact() *ImportBulkCSVContact_ImportBulkCSVContact {
+	if t == nil {
+		t = &ImportBulkCSVContact{}
+	}
+	return &t.ImportBulkCSVContact
+}
+
+type UpdateBulkContact struct {
+	UpdateBulkContact UpdateBulkContact_UpdateBulkContact "json:\"updateBulkContact\" graphql:\"updateBulkContact\""
+}
+
+func (t *UpdateBulkContact) GetUpdateBulkContact() *UpdateBulkContact_UpdateBulkContact {
+	if t == nil {
+		t = &UpdateBulkContact{}
+	}
+	return &t.UpdateBulkContact
+}
+
 type UpdateContact struct {
 	UpdateContact UpdateContact_UpdateContact "json:\"updateContact\" graphql:\"updateContact\""
 }
@@ -27548,6 +30514,17 @@ func (t *UpdateContact) GetUpdateContact() *UpdateContact_UpdateContact {
 	return &t.UpdateContact
 }
 
+type UpsertBulkCSVContact struct {
+	UpsertBulkCSVContact UpsertBulkCSVContact_UpsertBulkCSVContact "json:\"upsertBulkCSVContact\" graphql:\"upsertBulkCSVContact\""
+}
+
+func (t *UpsertBulkCSVContact) GetUpsertBulkCSVContact() *UpsertBulkCSVContact_UpsertBulkCSVContact {
+	if t == nil {
+		t = &UpsertBulkCSVContact{}
+	}
+	return &t.UpsertBulkCSVContact
+}
+
 type GetAllContactHistories struct {
 	ContactHistories GetAllContactHistories_ContactHistories "json:\"contactHistories\" graphql:\"contactHistories\""
 }
@@ -27614,6 +30591,17 @@ func (t *UpdateDocumentData) GetUpdateDocumentData() *UpdateDocumentData_UpdateD
 	return &t.UpdateDocumentData
 }
 
+type RenderDocumentData struct {
+	RenderDocumentData RenderDocumentData_RenderDocumentData "json:\"renderDocumentData\" graphql:\"renderDocumentData\""
+}
+
+func (t *RenderDocumentData) GetRenderDocumentData() *RenderDocumentData_RenderDocumentData {
+	if t == nil {
+		t = &RenderDocumentData{}
+	}
+	return &t.RenderDocumentData
+}
+
 type GetAllDocumentDataHistories struct {
 	DocumentDataHistories GetAllDocumentDataHistories_DocumentDataHistories "json:\"documentDataHistories\" graphql:\"documentDataHistories\""
 }
@@ -27999,6 +30987,17 @@ func (t *CreateEntity) GetCreateEntity() *CreateEntity_CreateEntity {
 	return &t.CreateEntity
 }
 
+type DeleteBulkEntity struct {
+	DeleteBulkEntity DeleteBulkEntity_DeleteBulkEntity "json:\"deleteBulkEntity\" graphql:\"deleteBulkEntity\""
+}
+
+func (t *DeleteBulkEntity) GetDeleteBulkEntity() *DeleteBulkEntity_DeleteBulkEntity {
+	if t == nil {
+		t = &DeleteBulkEntity{}
+	}
+	return &t.DeleteBulkEntity
+}
+
 type DeleteEntity struct {
 	DeleteEntity DeleteEntity_DeleteEntity "json:\"deleteEntity\" graphql:\"deleteEntity\""
 }
@@ -28043,6 +31042,28 @@ func (t *GetEntityByID) GetEntity() *GetEntityByID_Entity {
 	return &t.Entity
 }
 
+type ImportBulkCSVEntity struct {
+	ImportBulkCSVEntity ImportBulkCSVEntity_ImportBulkCSVEntity "json:\"importBulkCSVEntity\" graphql:\"importBulkCSVEntity\""
+}
+
+func (t *ImportBulkCSVEntity) GetImportBulkCSVEntity() *ImportBulkCSVEntity_ImportBulkCSVEntity {
+	if t == nil {
+		t = &ImportBulkCSVEntity{}
+	}
+	return &t.ImportBulkCSVEntity
+}
+
+type UpdateBulkEntity struct {
+	UpdateBulkEntity UpdateBulkEntity_UpdateBulkEntity "json:\"updateBulkEntity\" graphql:\"updateBulkEntity\""
+}
+
+func (t *UpdateBulkEntity) GetUpdateBulkEntity() *UpdateBulkEntity_UpdateBulkEntity {
+	if t == nil {
+		t = &UpdateBulkEntity{}
+	}
+	return &t.UpdateBulkEntity
+}
+
 type UpdateEntity struct {
 	UpdateEntity UpdateEntity_UpdateEntity "json:\"updateEntity\" graphql:\"updateEntity\""
 }
@@ -28054,6 +31075,17 @@ func (t *UpdateEntity) GetUpdateEntity() *UpdateEntity_UpdateEntity {
 	return &t.UpdateEntity
 }
 
+type UpsertBulkCSVEntity struct {
+	UpsertBulkCSVEntity UpsertBulkCSVEntity_UpsertBulkCSVEntity "json:\"upsertBulkCSVEntity\" graphql:\"upsertBulkCSVEntity\""
+}
+
+func (t *UpsertBulkCSVEntity) GetUpsertBulkCSVEntity() *UpsertBulkCSVEntity_UpsertBulkCSVEntity {
+	if t == nil {
+		t = &UpsertBulkCSVEntity{}
+	}
+	return &t.UpsertBulkCSVEntity
+}
+
 type GetAllEntityHistories struct {
 	EntityHistories GetAllEntityHistories_EntityHistories "json:\"entityHistories\" graphql:\"entityHistories\""
 }
@@ -29517,6 +32549,28 @@ func (t *Search) GetSearch() *Search_Search {
 	return t.Search
 }
 
+type GetUserSessions struct {
+	UserSessions []*GetUserSessions_UserSessions "json:\"userSessions\" graphql:\"userSessions\""
+}
+
+func (t *GetUserSessions) GetUserSessions() []*GetUserSessions_UserSessions {
+	if t == nil {
+		t = &GetUserSessions{}
+	}
+	return t.UserSessions
+}
+
+type RevokeSession struct {
+	RevokeSession RevokeSession_RevokeSession "json:\"revokeSession\" graphql:\"revokeSession\""
+}
+
+func (t *RevokeSession) GetRevokeSession() *RevokeSession_RevokeSession {
+	if t == nil {
+		t = &RevokeSession{}
+	}
+	return &t.RevokeSession
+}
+
 type CreateBulkCSVSubscriber struct {
 	CreateBulkCSVSubscriber CreateBulkCSVSubscriber_CreateBulkCSVSubscriber "json:\"createBulkCSVSubscriber\" graphql:\"createBulkCSVSubscriber\""
 }
@@ -29693,6 +32747,50 @@ func (t *GetTemplateHistories) GetTemplateHistories() *GetTemplateHistories_Temp
 	return &t.TemplateHistories
 }
 
+type GetTemplateVersions struct {
+	TemplateVersions GetTemplateVersions_TemplateVersions "json:\"templateVersions\" graphql:\"templateVersions\""
+}
+
+func (t *GetTemplateVersions) GetTemplateVersions() *GetTemplateVersions_TemplateVersions {
+	if t == nil {
+		t = &GetTemplateVersions{}
+	}
+	return &t.TemplateVersions
+}
+
+type GetTemplateVersionDiff struct {
+	TemplateVersionDiff GetTemplateVersionDiff_TemplateVersionDiff "json:\"templateVersionDiff\" graphql:\"templateVersionDiff\""
+}
+
+func (t *GetTemplateVersionDiff) GetTemplateVersionDiff() *GetTemplateVersionDiff_TemplateVersionDiff {
+	if t == nil {
+		t = &GetTemplateVersionDiff{}
+	}
+	return &t.TemplateVersionDiff
+}
+
+type MigrateTemplateDocuments struct {
+	MigrateTemplateDocuments MigrateTemplateDocuments_MigrateTemplateDocuments "json:\"migrateTemplateDocuments\" graphql:\"migrateTemplateDocuments\""
+}
+
+func (t *MigrateTemplateDocuments) GetMigrateTemplateDocuments() *MigrateTemplateDocuments_MigrateTemplateDocuments {
+	if t == nil {
+		t = &MigrateTemplateDocuments{}
+	}
+	return &t.MigrateTemplateDocuments
+}
+
+type CloneTemplate struct {
+	CloneTemplate CloneTemplate_CloneTemplate "json:\"cloneTemplate\" graphql:\"cloneTemplate\""
+}
+
+func (t *CloneTemplate) GetCloneTemplate() *CloneTemplate_CloneTemplate {
+	if t == nil {
+		t = &CloneTemplate{}
+	}
+	return &t.CloneTemplate
+}
+
 type CreateTFASetting struct {
 	CreateTFASetting CreateTFASetting_CreateTFASetting "json:\"createTFASetting\" graphql:\"createTFASetting\""
 }
@@ -29737,6 +32835,28 @@ func (t *UpdateTFASetting) GetUpdateTFASetting() *UpdateTFASetting_UpdateTFASett
 	return &t.UpdateTFASetting
 }
 
+type VerifyTFASetting struct {
+	VerifyTFASetting VerifyTFASetting_VerifyTFASetting "json:\"verifyTFASetting\" graphql:\"verifyTFASetting\""
+}
+
+func (t *VerifyTFASetting) GetVerifyTFASetting() *VerifyTFASetting_VerifyTFASetting {
+	if t == nil {
+		t = &VerifyTFASetting{}
+	}
+	return &t.VerifyTFASetting
+}
+
+type RegenerateTFARecoveryCodes struct {
+	RegenerateTFARecoveryCodes RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes "json:\"regenerateTFARecoveryCodes\" graphql:\"regenerateTFARecoveryCodes\""
+}
+
+func (t *RegenerateTFARecoveryCodes) GetRegenerateTFARecoveryCodes() *RegenerateTFARecoveryCodes_RegenerateTFARecoveryCodes {
+	if t == nil {
+		t = &RegenerateTFARecoveryCodes{}
+	}
+	return &t.RegenerateTFARecoveryCodes
+}
+
 type CreateUser struct {
 	CreateUser CreateUser_CreateUser "json:\"createUser\" graphql:\"createUser\""
 }
@@ -29891,6 +33011,50 @@ func (t *GetUserSettingHistories) GetUserSettingHistories() *GetUserSettingHisto
 	return &t.UserSettingHistories
 }
 
+type LockUser struct {
+	LockUser LockUser_LockUser "json:\"lockUser\" graphql:\"lockUser\""
+}
+
+func (t *LockUser) GetLockUser() *LockUser_LockUser {
+	if t == nil {
+		t = &LockUser{}
+	}
+	return &t.LockUser
+}
+
+type UnlockUser struct {
+	UnlockUser UnlockUser_UnlockUser "json:\"unlockUser\" graphql:\"unlockUser\""
+}
+
+func (t *UnlockUser) GetUnlockUser() *UnlockUser_UnlockUser {
+	if t == nil {
+		t = &UnlockUser{}
+	}
+	return &t.UnlockUser
+}
+
+type SuspendUser struct {
+	SuspendUser SuspendUser_SuspendUser "json:\"suspendUser\" graphql:\"suspendUser\""
+}
+
+func (t *SuspendUser) GetSuspendUser() *SuspendUser_SuspendUser {
+	if t == nil {
+		t = &SuspendUser{}
+	}
+	return &t.SuspendUser
+}
+
+type UnsuspendUser struct {
+	UnsuspendUser UnsuspendUser_UnsuspendUser "json:\"unsuspendUser\" graphql:\"unsuspendUser\""
+}
+
+func (t *UnsuspendUser) GetUnsuspendUser() *UnsuspendUser_UnsuspendUser {
+	if t == nil {
+		t = &UnsuspendUser{}
+	}
+	return &t.UnsuspendUser
+}
+
 type GetWebhookByID struct {
 	Webhook GetWebhookByID_Webhook "json:\"webhook\" graphql:\"webhook\""
 }
@@ -29968,6 +33132,28 @@ func (t *DeleteWebhook) GetDeleteWebhook() *DeleteWebhook_DeleteWebhook {
 	return &t.DeleteWebhook
 }
 
+type GetWebhookDeliveries struct {
+	WebhookDeliveries GetWebhookDeliveries_WebhookDeliveries "json:\"webhookDeliveries\" graphql:\"webhookDeliveries\""
+}
+
+func (t *GetWebhookDeliveries) GetWebhookDeliveries() *GetWebhookDeliveries_WebhookDeliveries {
+	if t == nil {
+		t = &GetWebhookDeliveries{}
+	}
+	return &t.WebhookDeliveries
+}
+
+type RedeliverWebhookEvent struct {
+	RedeliverWebhookEvent RedeliverWebhookEvent_RedeliverWebhookEvent "json:\"redeliverWebhookEvent\" graphql:\"redeliverWebhookEvent\""
+}
+
+func (t *RedeliverWebhookEvent) GetRedeliverWebhookEvent() *RedeliverWebhookEvent_RedeliverWebhookEvent {
+	if t == nil {
+		t = &RedeliverWebhookEvent{}
+	}
+	return &t.RedeliverWebhookEvent
+}
+
 type GetAllWebhookHistories struct {
 	WebhookHistories GetAllWebhookHistories_WebhookHistories "json:\"webhookHistories\" graphql:\"webhookHistories\""
 }
@@ -30169,6 +33355,55 @@ func (c *Client) DeleteAPIToken(ctx context.Context, deleteAPITokenID string, in
 	return &res, nil
 }
 
+const AuditLogsDocument = `query AuditLogs ($first: Int, $after: Cursor, $last: Int, $before: Cursor, $where: AuditLogWhereInput) {
+	auditLogs(first: $first, after: $after, last: $last, before: $before, where: $where) {
+		totalCount
+		pageInfo {
+			startCursor
+			endCursor
+			hasPreviousPage
+			hasNextPage
+		}
+		edges {
+			cursor
+			node {
+				table
+				time
+				id
+				operation
+				changes {
+					field
+					old
+					new
+				}
+				updatedBy
+			}
+		}
+	}
+}
+`
+
+func (c *Client) AuditLogs(ctx context.Context, first *int64, after *string, last *int64, before *string, where *AuditLogWhereInput, interceptors ...clientv2.RequestInterceptor) (*AuditLogs, error) {
+	vars := map[string]any{
+		"first":  first,
+		"after":  after,
+		"last":   last,
+		"before": before,
+		"where":  where,
+	}
+
+	var res AuditLogs
+	if err := c.Client.Post(ctx, "AuditLogs", AuditLogsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const CreateBulkCSVContactDocument = `mutation CreateBulkCSVContact ($input: Upload!) {
 	createBulkCSVContact(input: $input) {
 		contacts {
@@ -30286,6 +33521,37 @@ func (c *Client) CreateContact(ctx context.Context, input CreateContactInput, in
 	return &res, nil
 }
 
+const DeleteBulkContactDocument = `mutation DeleteBulkContact ($ids: [ID!]!, $mode: BulkMode) {
+	deleteBulkContact(ids: $ids, mode: $mode) {
+		deletedIDs
+		errors {
+			row
+			line
+			id
+			message
+		}
+	}
+}
+`
+
+func (c *Client) DeleteBulkContact(ctx context.Context, ids []string, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*DeleteBulkContact, error) {
+	vars := map[string]any{
+		"ids":  ids,
+		"mode": mode,
+	}
+
+	var res DeleteBulkContact
+	if err := c.Client.Post(ctx, "DeleteBulkContact", DeleteBulkContactDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const DeleteContactDocument = `mutation DeleteContact ($deleteContactId: ID!) {
 	deleteContact(id: $deleteContactId) {
 		deletedID
@@ -30427,6 +33693,102 @@ func (c *Client) GetContacts(ctx context.Context, where *ContactWhereInput, inte
 	return &res, nil
 }
 
+const ImportBulkCSVContactDocument = `mutation ImportBulkCSVContact ($input: Upload!, $mapping: [CSVColumnMapping!], $dryRun: Boolean, $mode: BulkMode) {
+	importBulkCSVContact(input: $input, mapping: $mapping, dryRun: $dryRun, mode: $mode) {
+		contacts {
+			address
+			company
+			createdAt
+			createdBy
+			email
+			fullName
+			id
+			ownerID
+			phoneNumber
+			status
+			tags
+			title
+			updatedAt
+			updatedBy
+		}
+		errors {
+			row
+			line
+			id
+			message
+		}
+		dryRun
+	}
+}
+`
+
+func (c *Client) ImportBulkCSVContact(ctx context.Context, input graphql.Upload, mapping []*CSVColumnMapping, dryRun *bool, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*ImportBulkCSVContact, error) {
+	vars := map[string]any{
+		"input":   input,
+		"mapping": mapping,
+		"dryRun":  dryRun,
+		"mode":    mode,
+	}
+
+	var res ImportBulkCSVContact
+	if err := c.Client.Post(ctx, "ImportBulkCSVContact", ImportBulkCSVContactDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateBulkContactDocument = `mutation UpdateBulkContact ($ids: [ID!]!, $input: UpdateContactInput!, $mode: BulkMode) {
+	updateBulkContact(ids: $ids, input: $input, mode: $mode) {
+		contacts {
+			address
+			company
+			createdAt
+			createdBy
+			email
+			fullName
+			id
+			ownerID
+			phoneNumber
+			status
+			tags
+			title
+			updatedAt
+			updatedBy
+		}
+		errors {
+			row
+			line
+			id
+			message
+		}
+	}
+}
+`
+
+func (c *Client) UpdateBulkContact(ctx context.Context, ids []string, input UpdateContactInput, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*UpdateBulkContact, error) {
+	vars := map[string]any{
+		"ids":   ids,
+		"input": input,
+		"mode":  mode,
+	}
+
+	var res UpdateBulkContact
+	if err := c.Client.Post(ctx, "UpdateBulkContact", UpdateBulkContactDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const UpdateContactDocument = `mutation UpdateContact ($updateContactId: ID!, $input: UpdateContactInput!) {
 	updateContact(id: $updateContactId, input: $input) {
 		contact {
@@ -30467,6 +33829,53 @@ func (c *Client) UpdateContact(ctx context.Context, updateContactID string, inpu
 	return &res, nil
 }
 
+const UpsertBulkCSVContactDocument = `mutation UpsertBulkCSVContact ($input: Upload!, $keys: [String!], $mode: BulkMode) {
+	upsertBulkCSVContact(input: $input, keys: $keys, mode: $mode) {
+		contacts {
+			address
+			company
+			createdAt
+			createdBy
+			email
+			fullName
+			id
+			ownerID
+			phoneNumber
+			status
+			tags
+			title
+			updatedAt
+			updatedBy
+		}
+		errors {
+			row
+			line
+			id
+			message
+		}
+	}
+}
+`
+
+func (c *Client) UpsertBulkCSVContact(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*UpsertBulkCSVContact, error) {
+	vars := map[string]any{
+		"input": input,
+		"keys":  keys,
+		"mode":  mode,
+	}
+
+	var res UpsertBulkCSVContact
+	if err := c.Client.Post(ctx, "UpsertBulkCSVContact", UpsertBulkCSVContactDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetAllContactHistoriesDocument = `query GetAllContactHistories {
 	contactHistories {
 		edges {
@@ -30558,6 +33967,7 @@ const CreateDocumentDataDocument = `mutation CreateDocumentData ($input: CreateD
 		documentData {
 			id
 			templateID
+			templateVersion
 			data
 			createdAt
 			createdBy
@@ -30613,6 +34023,7 @@ const GetDocumentDataByIDDocument = `query GetDocumentDataByID ($documentDataId:
 	documentData(id: $documentDataId) {
 		id
 		templateID
+		templateVersion
 		data
 		createdAt
 		createdBy
@@ -30644,6 +34055,7 @@ const UpdateDocumentDataDocument = `mutation UpdateDocumentData ($updateDocument
 		documentData {
 			id
 			templateID
+			templateVersion
 			data
 			createdAt
 			createdBy
@@ -30672,6 +34084,45 @@ func (c *Client) UpdateDocumentData(ctx context.Context, updateDocumentDataID st
 	return &res, nil
 }
 
+const RenderDocumentDataDocument = `mutation RenderDocumentData ($renderDocumentDataId: ID!, $format: DocumentRenderFormat) {
+	renderDocumentData(id: $renderDocumentDataId, format: $format) {
+		file {
+			category
+			contentType
+			fileExtension
+			fileName
+			fileSize
+			id
+			storeKey
+			user {
+				id
+			}
+			organization {
+				id
+			}
+		}
+	}
+}
+`
+
+func (c *Client) RenderDocumentData(ctx context.Context, renderDocumentDataID string, format *DocumentRenderFormat, interceptors ...clientv2.RequestInterceptor) (*RenderDocumentData, error) {
+	vars := map[string]any{
+		"renderDocumentDataId": renderDocumentDataID,
+		"format":               format,
+	}
+
+	var res RenderDocumentData
+	if err := c.Client.Post(ctx, "RenderDocumentData", RenderDocumentDataDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetAllDocumentDataHistoriesDocument = `query GetAllDocumentDataHistories {
 	documentDataHistories {
 		edges {
@@ -31990,6 +35441,37 @@ func (c *Client) CreateEntity(ctx context.Context, input CreateEntityInput, inte
 	return &res, nil
 }
 
+const DeleteBulkEntityDocument = `mutation DeleteBulkEntity ($ids: [ID!]!, $mode: BulkMode) {
+	deleteBulkEntity(ids: $ids, mode: $mode) {
+		deletedIDs
+		errors {
+			row
+			line
+			id
+			message
+		}
+	}
+}
+`
+
+func (c *Client) DeleteBulkEntity(ctx context.Context, ids []string, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*DeleteBulkEntity, error) {
+	vars := map[string]any{
+		"ids":  ids,
+		"mode": mode,
+	}
+
+	var res DeleteBulkEntity
+	if err := c.Client.Post(ctx, "DeleteBulkEntity", DeleteBulkEntityDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const DeleteEntityDocument = `mutation DeleteEntity ($deleteEntityId: ID!) {
 	deleteEntity(id: $deleteEntityId) {
 		deletedID
@@ -32158,6 +35640,114 @@ func (c *Client) GetEntityByID(ctx context.Context, entityID string, interceptor
 	return &res, nil
 }
 
+const ImportBulkCSVEntityDocument = `mutation ImportBulkCSVEntity ($input: Upload!, $mapping: [CSVColumnMapping!], $dryRun: Boolean, $mode: BulkMode) {
+	importBulkCSVEntity(input: $input, mapping: $mapping, dryRun: $dryRun, mode: $mode) {
+		entities {
+			createdAt
+			createdBy
+			description
+			displayName
+			status
+			domains
+			notes {
+				text
+				updatedAt
+				updatedBy
+			}
+			entityType {
+				name
+			}
+			id
+			name
+			ownerID
+			tags
+			updatedAt
+			updatedBy
+		}
+		errors {
+			row
+			line
+			id
+			message
+		}
+		dryRun
+	}
+}
+`
+
+func (c *Client) ImportBulkCSVEntity(ctx context.Context, input graphql.Upload, mapping []*CSVColumnMapping, dryRun *bool, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*ImportBulkCSVEntity, error) {
+	vars := map[string]any{
+		"input":   input,
+		"mapping": mapping,
+		"dryRun":  dryRun,
+		"mode":    mode,
+	}
+
+	var res ImportBulkCSVEntity
+	if err := c.Client.Post(ctx, "ImportBulkCSVEntity", ImportBulkCSVEntityDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateBulkEntityDocument = `mutation UpdateBulkEntity ($ids: [ID!]!, $input: UpdateEntityInput!, $mode: BulkMode) {
+	updateBulkEntity(ids: $ids, input: $input, mode: $mode) {
+		entities {
+			createdAt
+			createdBy
+			description
+			displayName
+			status
+			domains
+			notes {
+				text
+				updatedAt
+				updatedBy
+			}
+			entityType {
+				name
+			}
+			id
+			name
+			ownerID
+			tags
+			updatedAt
+			updatedBy
+		}
+		errors {
+			row
+			line
+			id
+			message
+		}
+	}
+}
+`
+
+func (c *Client) UpdateBulkEntity(ctx context.Context, ids []string, input UpdateEntityInput, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*UpdateBulkEntity, error) {
+	vars := map[string]any{
+		"ids":   ids,
+		"input": input,
+		"mode":  mode,
+	}
+
+	var res UpdateBulkEntity
+	if err := c.Client.Post(ctx, "UpdateBulkEntity", UpdateBulkEntityDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const UpdateEntityDocument = `mutation UpdateEntity ($updateEntityId: ID!, $input: UpdateEntityInput!) {
 	updateEntity(id: $updateEntityId, input: $input) {
 		entity {
@@ -32204,6 +35794,59 @@ func (c *Client) UpdateEntity(ctx context.Context, updateEntityID string, input
 	return &res, nil
 }
 
+const UpsertBulkCSVEntityDocument = `mutation UpsertBulkCSVEntity ($input: Upload!, $keys: [String!], $mode: BulkMode) {
+	upsertBulkCSVEntity(input: $input, keys: $keys, mode: $mode) {
+		entities {
+			createdAt
+			createdBy
+			description
+			displayName
+			status
+			domains
+			notes {
+				text
+				updatedAt
+				updatedBy
+			}
+			entityType {
+				name
+			}
+			id
+			name
+			ownerID
+			tags
+			updatedAt
+			updatedBy
+		}
+		errors {
+			row
+			line
+			id
+			message
+		}
+	}
+}
+`
+
+func (c *Client) UpsertBulkCSVEntity(ctx context.Context, input graphql.Upload, keys []string, mode *BulkMode, interceptors ...clientv2.RequestInterceptor) (*UpsertBulkCSVEntity, error) {
+	vars := map[string]any{
+		"input": input,
+		"keys":  keys,
+		"mode":  mode,
+	}
+
+	var res UpsertBulkCSVEntity
+	if err := c.Client.Post(ctx, "UpsertBulkCSVEntity", UpsertBulkCSVEntityDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetAllEntityHistoriesDocument = `query GetAllEntityHistories {
 	entityHistories {
 		edges {
@@ -36030,6 +39673,7 @@ const GetAllOauthProviderHistoriesDocument = `query GetAllOauthProviderHistories
 				historyTime
 				id
 				infoURL
+				issuer
 				name
 				operation
 				ownerID
@@ -36074,6 +39718,7 @@ const GetOauthProviderHistoriesDocument = `query GetOauthProviderHistories ($whe
 				historyTime
 				id
 				infoURL
+				issuer
 				name
 				operation
 				ownerID
@@ -36440,6 +40085,10 @@ const CreateOrganizationDocument = `mutation CreateOrganization ($input: CreateO
 				billingAddress
 				taxIdentifier
 				geoLocation
+				samlAttributeMapping
+				samlMetadata
+				samlMetadataURL
+				ssoEnforced
 				tags
 			}
 			parent {
@@ -36548,6 +40197,10 @@ const GetAllOrganizationsDocument = `query GetAllOrganizations {
 					billingAddress
 					taxIdentifier
 					geoLocation
+					samlAttributeMapping
+					samlMetadata
+					samlMetadataURL
+					ssoEnforced
 					tags
 				}
 				createdAt
@@ -36617,6 +40270,10 @@ const GetOrganizationByIDDocument = `query GetOrganizationByID ($organizationId:
 			billingAddress
 			taxIdentifier
 			geoLocation
+			samlAttributeMapping
+			samlMetadata
+			samlMetadataURL
+			ssoEnforced
 			tags
 		}
 		createdAt
@@ -36690,6 +40347,10 @@ const GetOrganizationsDocument = `query GetOrganizations ($where: OrganizationWh
 					billingAddress
 					taxIdentifier
 					geoLocation
+					samlAttributeMapping
+					samlMetadata
+					samlMetadataURL
+					ssoEnforced
 					tags
 				}
 				createdAt
@@ -36744,6 +40405,10 @@ const UpdateOrganizationDocument = `mutation UpdateOrganization ($updateOrganiza
 				billingAddress
 				taxIdentifier
 				geoLocation
+				samlAttributeMapping
+				samlMetadata
+				samlMetadataURL
+				ssoEnforced
 				tags
 			}
 		}
@@ -36864,6 +40529,10 @@ const GetAllOrganizationSettingsDocument = `query GetAllOrganizationSettings {
 				domains
 				geoLocation
 				id
+				samlAttributeMapping
+				samlMetadata
+				samlMetadataURL
+				ssoEnforced
 				tags
 				taxIdentifier
 				updatedAt
@@ -36904,6 +40573,10 @@ const GetOrganizationSettingByIDDocument = `query GetOrganizationSettingByID ($o
 		domains
 		geoLocation
 		id
+		samlAttributeMapping
+		samlMetadata
+		samlMetadataURL
+		ssoEnforced
 		tags
 		taxIdentifier
 		updatedAt
@@ -36946,6 +40619,10 @@ const GetOrganizationSettingsDocument = `query GetOrganizationSettings ($where:
 				domains
 				geoLocation
 				id
+				samlAttributeMapping
+				samlMetadata
+				samlMetadataURL
+				ssoEnforced
 				tags
 				taxIdentifier
 				updatedAt
@@ -36989,6 +40666,10 @@ const UpdateOrganizationSettingDocument = `mutation UpdateOrganizationSetting ($
 			domains
 			geoLocation
 			id
+			samlAttributeMapping
+			samlMetadata
+			samlMetadataURL
+			ssoEnforced
 			tags
 			taxIdentifier
 			updatedAt
@@ -37037,6 +40718,10 @@ const GetAllOrganizationSettingHistoriesDocument = `query GetAllOrganizationSett
 				operation
 				organizationID
 				ref
+				samlAttributeMapping
+				samlMetadata
+				samlMetadataURL
+				ssoEnforced
 				tags
 				taxIdentifier
 				updatedAt
@@ -37079,6 +40764,10 @@ const GetOrganizationSettingHistoriesDocument = `query GetOrganizationSettingHis
 				operation
 				organizationID
 				ref
+				samlAttributeMapping
+				samlMetadata
+				samlMetadataURL
+				ssoEnforced
 				tags
 				taxIdentifier
 				updatedAt
@@ -37673,6 +41362,60 @@ func (c *Client) Search(ctx context.Context, query string, interceptors ...clien
 	return &res, nil
 }
 
+const GetUserSessionsDocument = `query GetUserSessions ($userID: ID) {
+	userSessions(userID: $userID) {
+		id
+		userID
+		userAgent
+		ipAddress
+		createdAt
+		lastActivity
+		current
+	}
+}
+`
+
+func (c *Client) GetUserSessions(ctx context.Context, userID *string, interceptors ...clientv2.RequestInterceptor) (*GetUserSessions, error) {
+	vars := map[string]any{
+		"userID": userID,
+	}
+
+	var res GetUserSessions
+	if err := c.Client.Post(ctx, "GetUserSessions", GetUserSessionsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const RevokeSessionDocument = `mutation RevokeSession ($revokeSessionId: ID!) {
+	revokeSession(id: $revokeSessionId) {
+		deletedID
+	}
+}
+`
+
+func (c *Client) RevokeSession(ctx context.Context, revokeSessionID string, interceptors ...clientv2.RequestInterceptor) (*RevokeSession, error) {
+	vars := map[string]any{
+		"revokeSessionId": revokeSessionID,
+	}
+
+	var res RevokeSession
+	if err := c.Client.Post(ctx, "RevokeSession", RevokeSessionDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const CreateBulkCSVSubscriberDocument = `mutation CreateBulkCSVSubscriber ($input: Upload!) {
 	createBulkCSVSubscriber(input: $input) {
 		subscribers {
@@ -37923,6 +41666,7 @@ const CreateBulkCSVTemplateDocument = `mutation CreateBulkCSVTemplate ($input: U
 			jsonconfig
 			name
 			uischema
+			version
 			owner {
 				id
 			}
@@ -37956,6 +41700,7 @@ const CreateBulkTemplateDocument = `mutation CreateBulkTemplate ($input: [Create
 			jsonconfig
 			name
 			uischema
+			version
 			owner {
 				id
 			}
@@ -37989,6 +41734,7 @@ const CreateTemplateDocument = `mutation CreateTemplate ($input: CreateTemplateI
 			jsonconfig
 			name
 			uischema
+			version
 			owner {
 				id
 			}
@@ -38025,6 +41771,7 @@ const GetAllTemplatesDocument = `query GetAllTemplates {
 				jsonconfig
 				name
 				uischema
+				version
 				updatedAt
 				updatedBy
 				owner {
@@ -38060,6 +41807,7 @@ const GetTemplateByIDDocument = `query GetTemplateByID ($getTemplateId: ID!) {
 		jsonconfig
 		name
 		uischema
+		version
 		updatedAt
 		updatedBy
 		owner {
@@ -38096,6 +41844,7 @@ const UpdateTemplateDocument = `mutation UpdateTemplate ($updateTemplateId: ID!,
 			jsonconfig
 			name
 			uischema
+			version
 			updatedAt
 			updatedBy
 			owner {
@@ -38149,11 +41898,53 @@ const GetAllTemplateHistoriesDocument = `query GetAllTemplateHistories {
 }
 `
 
-func (c *Client) GetAllTemplateHistories(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllTemplateHistories, error) {
-	vars := map[string]any{}
+func (c *Client) GetAllTemplateHistories(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetAllTemplateHistories, error) {
+	vars := map[string]any{}
+
+	var res GetAllTemplateHistories
+	if err := c.Client.Post(ctx, "GetAllTemplateHistories", GetAllTemplateHistoriesDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetTemplateHistoriesDocument = `query GetTemplateHistories ($where: TemplateHistoryWhereInput) {
+	templateHistories(where: $where) {
+		edges {
+			node {
+				createdAt
+				createdBy
+				description
+				historyTime
+				id
+				jsonconfig
+				name
+				operation
+				ownerID
+				ref
+				tags
+				templateType
+				uischema
+				updatedAt
+				updatedBy
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetTemplateHistories(ctx context.Context, where *TemplateHistoryWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetTemplateHistories, error) {
+	vars := map[string]any{
+		"where": where,
+	}
 
-	var res GetAllTemplateHistories
-	if err := c.Client.Post(ctx, "GetAllTemplateHistories", GetAllTemplateHistoriesDocument, &res, vars, interceptors...); err != nil {
+	var res GetTemplateHistories
+	if err := c.Client.Post(ctx, "GetTemplateHistories", GetTemplateHistoriesDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -38164,38 +41955,150 @@ func (c *Client) GetAllTemplateHistories(ctx context.Context, interceptors ...cl
 	return &res, nil
 }
 
-const GetTemplateHistoriesDocument = `query GetTemplateHistories ($where: TemplateHistoryWhereInput) {
-	templateHistories(where: $where) {
+const GetTemplateVersionsDocument = `query GetTemplateVersions ($first: Int, $after: Cursor, $where: TemplateVersionWhereInput) {
+	templateVersions(first: $first, after: $after, where: $where) {
+		totalCount
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
 		edges {
 			node {
 				createdAt
 				createdBy
-				description
-				historyTime
 				id
 				jsonconfig
-				name
-				operation
-				ownerID
-				ref
-				tags
-				templateType
+				templateID
 				uischema
-				updatedAt
-				updatedBy
+				version
 			}
 		}
 	}
 }
 `
 
-func (c *Client) GetTemplateHistories(ctx context.Context, where *TemplateHistoryWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetTemplateHistories, error) {
+func (c *Client) GetTemplateVersions(ctx context.Context, first *int64, after *string, where *TemplateVersionWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetTemplateVersions, error) {
 	vars := map[string]any{
+		"first": first,
+		"after": after,
 		"where": where,
 	}
 
-	var res GetTemplateHistories
-	if err := c.Client.Post(ctx, "GetTemplateHistories", GetTemplateHistoriesDocument, &res, vars, interceptors...); err != nil {
+	var res GetTemplateVersions
+	if err := c.Client.Post(ctx, "GetTemplateVersions", GetTemplateVersionsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetTemplateVersionDiffDocument = `query GetTemplateVersionDiff ($templateID: ID!, $from: Int!, $to: Int!) {
+	templateVersionDiff(templateID: $templateID, from: $from, to: $to) {
+		templateID
+		from
+		to
+		jsonconfig {
+			path
+			operation
+			from
+			to
+		}
+		uischema {
+			path
+			operation
+			from
+			to
+		}
+	}
+}
+`
+
+func (c *Client) GetTemplateVersionDiff(ctx context.Context, templateID string, from int64, to int64, interceptors ...clientv2.RequestInterceptor) (*GetTemplateVersionDiff, error) {
+	vars := map[string]any{
+		"templateID": templateID,
+		"from":       from,
+		"to":         to,
+	}
+
+	var res GetTemplateVersionDiff
+	if err := c.Client.Post(ctx, "GetTemplateVersionDiff", GetTemplateVersionDiffDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const MigrateTemplateDocumentsDocument = `mutation MigrateTemplateDocuments ($templateID: ID!, $version: Int!) {
+	migrateTemplateDocuments(templateID: $templateID, version: $version) {
+		templateID
+		version
+		migrated
+		failed {
+			documentDataID
+			errors {
+				path
+				message
+			}
+		}
+	}
+}
+`
+
+func (c *Client) MigrateTemplateDocuments(ctx context.Context, templateID string, version int64, interceptors ...clientv2.RequestInterceptor) (*MigrateTemplateDocuments, error) {
+	vars := map[string]any{
+		"templateID": templateID,
+		"version":    version,
+	}
+
+	var res MigrateTemplateDocuments
+	if err := c.Client.Post(ctx, "MigrateTemplateDocuments", MigrateTemplateDocumentsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CloneTemplateDocument = `mutation CloneTemplate ($id: ID!, $ownerID: ID, $name: String) {
+	cloneTemplate(id: $id, ownerID: $ownerID, name: $name) {
+		template {
+			description
+			id
+			jsonconfig
+			name
+			sourceTemplateID
+			sourceTemplateVersion
+			templateType
+			uischema
+			version
+			owner {
+				id
+			}
+		}
+	}
+}
+`
+
+func (c *Client) CloneTemplate(ctx context.Context, id string, ownerID *string, name *string, interceptors ...clientv2.RequestInterceptor) (*CloneTemplate, error) {
+	vars := map[string]any{
+		"id":      id,
+		"ownerID": ownerID,
+		"name":    name,
+	}
+
+	var res CloneTemplate
+	if err := c.Client.Post(ctx, "CloneTemplate", CloneTemplateDocument, &res, vars, interceptors...); err != nil {
 		if c.Client.ParseDataWhenErrors {
 			return &res, err
 		}
@@ -38212,6 +42115,8 @@ const CreateTFASettingDocument = `mutation CreateTFASetting ($input: CreateTFASe
 			recoveryCodes
 			totpAllowed
 			verified
+			qrCode
+			qrImage
 			owner {
 				id
 			}
@@ -38273,6 +42178,8 @@ const GetTFASettingDocument = `query GetTFASetting {
 		recoveryCodes
 		totpAllowed
 		verified
+		qrCode
+		qrImage
 		owner {
 			id
 		}
@@ -38323,6 +42230,60 @@ func (c *Client) UpdateTFASetting(ctx context.Context, input UpdateTFASettingInp
 	return &res, nil
 }
 
+const VerifyTFASettingDocument = `mutation VerifyTFASetting ($totpCode: String!) {
+	verifyTFASetting(totpCode: $totpCode) {
+		tfaSetting {
+			recoveryCodes
+			totpAllowed
+			verified
+		}
+	}
+}
+`
+
+func (c *Client) VerifyTFASetting(ctx context.Context, totpCode string, interceptors ...clientv2.RequestInterceptor) (*VerifyTFASetting, error) {
+	vars := map[string]any{
+		"totpCode": totpCode,
+	}
+
+	var res VerifyTFASetting
+	if err := c.Client.Post(ctx, "VerifyTFASetting", VerifyTFASettingDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const RegenerateTFARecoveryCodesDocument = `mutation RegenerateTFARecoveryCodes {
+	regenerateTFARecoveryCodes {
+		tfaSetting {
+			recoveryCodes
+			totpAllowed
+			verified
+		}
+	}
+}
+`
+
+func (c *Client) RegenerateTFARecoveryCodes(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*RegenerateTFARecoveryCodes, error) {
+	vars := map[string]any{}
+
+	var res RegenerateTFARecoveryCodes
+	if err := c.Client.Post(ctx, "RegenerateTFARecoveryCodes", RegenerateTFARecoveryCodesDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const CreateUserDocument = `mutation CreateUser ($input: CreateUserInput!) {
 	createUser(input: $input) {
 		user {
@@ -39001,6 +42962,139 @@ func (c *Client) GetUserSettingHistories(ctx context.Context, where *UserSetting
 	return &res, nil
 }
 
+const LockUserDocument = `mutation LockUser ($lockUserId: ID!, $until: Time) {
+	lockUser(id: $lockUserId, until: $until) {
+		userSetting {
+			id
+			status
+			locked
+			lockedUntil
+			failedLoginAttempts
+			suspendedAt
+			updatedAt
+			updatedBy
+		}
+	}
+}
+`
+
+func (c *Client) LockUser(ctx context.Context, lockUserID string, until *time.Time, interceptors ...clientv2.RequestInterceptor) (*LockUser, error) {
+	vars := map[string]any{
+		"lockUserId": lockUserID,
+		"until":      until,
+	}
+
+	var res LockUser
+	if err := c.Client.Post(ctx, "LockUser", LockUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UnlockUserDocument = `mutation UnlockUser ($unlockUserId: ID!) {
+	unlockUser(id: $unlockUserId) {
+		userSetting {
+			id
+			status
+			locked
+			lockedUntil
+			failedLoginAttempts
+			suspendedAt
+			updatedAt
+			updatedBy
+		}
+	}
+}
+`
+
+func (c *Client) UnlockUser(ctx context.Context, unlockUserID string, interceptors ...clientv2.RequestInterceptor) (*UnlockUser, error) {
+	vars := map[string]any{
+		"unlockUserId": unlockUserID,
+	}
+
+	var res UnlockUser
+	if err := c.Client.Post(ctx, "UnlockUser", UnlockUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const SuspendUserDocument = `mutation SuspendUser ($suspendUserId: ID!) {
+	suspendUser(id: $suspendUserId) {
+		userSetting {
+			id
+			status
+			locked
+			lockedUntil
+			failedLoginAttempts
+			suspendedAt
+			updatedAt
+			updatedBy
+		}
+	}
+}
+`
+
+func (c *Client) SuspendUser(ctx context.Context, suspendUserID string, interceptors ...clientv2.RequestInterceptor) (*SuspendUser, error) {
+	vars := map[string]any{
+		"suspendUserId": suspendUserID,
+	}
+
+	var res SuspendUser
+	if err := c.Client.Post(ctx, "SuspendUser", SuspendUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UnsuspendUserDocument = `mutation UnsuspendUser ($unsuspendUserId: ID!) {
+	unsuspendUser(id: $unsuspendUserId) {
+		userSetting {
+			id
+			status
+			locked
+			lockedUntil
+			failedLoginAttempts
+			suspendedAt
+			updatedAt
+			updatedBy
+		}
+	}
+}
+`
+
+func (c *Client) UnsuspendUser(ctx context.Context, unsuspendUserID string, interceptors ...clientv2.RequestInterceptor) (*UnsuspendUser, error) {
+	vars := map[string]any{
+		"unsuspendUserId": unsuspendUserID,
+	}
+
+	var res UnsuspendUser
+	if err := c.Client.Post(ctx, "UnsuspendUser", UnsuspendUserDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetWebhookByIDDocument = `query GetWebhookByID ($webhookId: ID!) {
 	webhook(id: $webhookId) {
 		createdAt
@@ -39008,6 +43102,8 @@ const GetWebhookByIDDocument = `query GetWebhookByID ($webhookId: ID!) {
 		description
 		destinationURL
 		enabled
+		eventTypes
+		objectTypes
 		failures
 		id
 		lastError
@@ -39049,6 +43145,8 @@ const GetAllWebhooksDocument = `query GetAllWebhooks {
 				description
 				destinationURL
 				enabled
+				eventTypes
+				objectTypes
 				failures
 				id
 				lastError
@@ -39087,6 +43185,8 @@ const CreateWebhookDocument = `mutation CreateWebhook ($input: CreateWebhookInpu
 			description
 			destinationURL
 			enabled
+			eventTypes
+			objectTypes
 			failures
 			id
 			lastError
@@ -39124,6 +43224,8 @@ const CreateBulkWebhookDocument = `mutation CreateBulkWebhook ($input: [CreateWe
 			description
 			destinationURL
 			enabled
+			eventTypes
+			objectTypes
 			failures
 			id
 			lastError
@@ -39158,6 +43260,8 @@ const CreateBulkCSVWebhookDocument = `mutation CreateBulkCSVWebhook ($input: Upl
 			description
 			destinationURL
 			enabled
+			eventTypes
+			objectTypes
 			failures
 			id
 			lastError
@@ -39194,6 +43298,8 @@ const UpdateWebhookDocument = `mutation UpdateWebhook ($updateWebhookId: ID!, $i
 			description
 			destinationURL
 			enabled
+			eventTypes
+			objectTypes
 			failures
 			id
 			lastError
@@ -39252,6 +43358,88 @@ func (c *Client) DeleteWebhook(ctx context.Context, deleteWebhookID string, inte
 	return &res, nil
 }
 
+const GetWebhookDeliveriesDocument = `query GetWebhookDeliveries ($first: Int, $after: Cursor, $where: WebhookDeliveryWhereInput) {
+	webhookDeliveries(first: $first, after: $after, where: $where) {
+		totalCount
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		edges {
+			node {
+				attempt
+				createdAt
+				error
+				eventID
+				id
+				latency
+				redelivery
+				requestBody
+				responseBody
+				responseStatus
+				webhookID
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetWebhookDeliveries(ctx context.Context, first *int64, after *string, where *WebhookDeliveryWhereInput, interceptors ...clientv2.RequestInterceptor) (*GetWebhookDeliveries, error) {
+	vars := map[string]any{
+		"first": first,
+		"after": after,
+		"where": where,
+	}
+
+	var res GetWebhookDeliveries
+	if err := c.Client.Post(ctx, "GetWebhookDeliveries", GetWebhookDeliveriesDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const RedeliverWebhookEventDocument = `mutation RedeliverWebhookEvent ($webhookID: ID!, $eventID: ID!) {
+	redeliverWebhookEvent(webhookID: $webhookID, eventID: $eventID) {
+		webhookDeliveries {
+			attempt
+			createdAt
+			error
+			eventID
+			id
+			latency
+			redelivery
+			requestBody
+			responseBody
+			responseStatus
+			webhookID
+		}
+	}
+}
+`
+
+func (c *Client) RedeliverWebhookEvent(ctx context.Context, webhookID string, eventID string, interceptors ...clientv2.RequestInterceptor) (*RedeliverWebhookEvent, error) {
+	vars := map[string]any{
+		"webhookID": webhookID,
+		"eventID":   eventID,
+	}
+
+	var res RedeliverWebhookEvent
+	if err := c.Client.Post(ctx, "RedeliverWebhookEvent", RedeliverWebhookEventDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetAllWebhookHistoriesDocument = `query GetAllWebhookHistories {
 	webhookHistories {
 		edges {
@@ -39344,20 +43532,26 @@ var DocumentOperationNames = map[string]string{
 	GetAllAPITokensDocument:                       "GetAllAPITokens",
 	GetAPITokenByIDDocument:                       "GetAPITokenByID",
 	DeleteAPITokenDocument:                        "DeleteAPIToken",
+	AuditLogsDocument:                             "AuditLogs",
 	CreateBulkCSVContactDocument:                  "CreateBulkCSVContact",
 	CreateBulkContactDocument:                     "CreateBulkContact",
 	CreateContactDocument:                         "CreateContact",
+	DeleteBulkContactDocument:                     "DeleteBulkContact",
 	DeleteContactDocument:                         "DeleteContact",
 	GetAllContactsDocument:                        "GetAllContacts",
 	GetContactByIDDocument:                        "GetContactByID",
 	GetContactsDocument:                           "GetContacts",
+	ImportBulkCSVContactDocument:                  "ImportBulkCSVContact",
+	UpdateBulkContactDocument:                     "UpdateBulkContact",
 	UpdateContactDocument:                         "UpdateContact",
+	UpsertBulkCSVContactDocument:                  "UpsertBulkCSVContact",
 	GetAllContactHistoriesDocument:                "GetAllContactHistories",
 	GetContactHistoriesDocument:                   "GetContactHistories",
 	CreateDocumentDataDocument:                    "CreateDocumentData",
 	DeleteDocumentDataDocument:                    "DeleteDocumentData",
 	GetDocumentDataByIDDocument:                   "GetDocumentDataByID",
 	UpdateDocumentDataDocument:                    "UpdateDocumentData",
+	RenderDocumentDataDocument:                    "RenderDocumentData",
 	GetAllDocumentDataHistoriesDocument:           "GetAllDocumentDataHistories",
 	GetDocumentDataHistoriesDocument:              "GetDocumentDataHistories",
 	CreateBulkCSVEntitlementDocument:              "CreateBulkCSVEntitlement",
@@ -39393,11 +43587,15 @@ var DocumentOperationNames = map[string]string{
 	CreateBulkCSVEntityDocument:                   "CreateBulkCSVEntity",
 	CreateBulkEntityDocument:                      "CreateBulkEntity",
 	CreateEntityDocument:                          "CreateEntity",
+	DeleteBulkEntityDocument:                      "DeleteBulkEntity",
 	DeleteEntityDocument:                          "DeleteEntity",
 	GetAllEntitiesDocument:                        "GetAllEntities",
 	GetEntitiesDocument:                           "GetEntities",
 	GetEntityByIDDocument:                         "GetEntityByID",
+	ImportBulkCSVEntityDocument:                   "ImportBulkCSVEntity",
+	UpdateBulkEntityDocument:                      "UpdateBulkEntity",
 	UpdateEntityDocument:                          "UpdateEntity",
+	UpsertBulkCSVEntityDocument:                   "UpsertBulkCSVEntity",
 	GetAllEntityHistoriesDocument:                 "GetAllEntityHistories",
 	GetEntityHistoriesDocument:                    "GetEntityHistories",
 	CreateBulkCSVEntityTypeDocument:               "CreateBulkCSVEntityType",
@@ -39531,6 +43729,8 @@ var DocumentOperationNames = map[string]string{
 	GetPersonalAccessTokenByIDDocument:            "GetPersonalAccessTokenByID",
 	UpdatePersonalAccessTokenDocument:             "UpdatePersonalAccessToken",
 	SearchDocument:                                "Search",
+	GetUserSessionsDocument:                       "GetUserSessions",
+	RevokeSessionDocument:                         "RevokeSession",
 	CreateBulkCSVSubscriberDocument:               "CreateBulkCSVSubscriber",
 	CreateBulkSubscriberDocument:                  "CreateBulkSubscriber",
 	CreateSubscriberDocument:                      "CreateSubscriber",
@@ -39547,10 +43747,16 @@ var DocumentOperationNames = map[string]string{
 	UpdateTemplateDocument:                        "UpdateTemplate",
 	GetAllTemplateHistoriesDocument:               "GetAllTemplateHistories",
 	GetTemplateHistoriesDocument:                  "GetTemplateHistories",
+	GetTemplateVersionsDocument:                   "GetTemplateVersions",
+	GetTemplateVersionDiffDocument:                "GetTemplateVersionDiff",
+	MigrateTemplateDocumentsDocument:              "MigrateTemplateDocuments",
+	CloneTemplateDocument:                         "CloneTemplate",
 	CreateTFASettingDocument:                      "CreateTFASetting",
 	GetAllTFASettingsDocument:                     "GetAllTFASettings",
 	GetTFASettingDocument:                         "GetTFASetting",
 	UpdateTFASettingDocument:                      "UpdateTFASetting",
+	VerifyTFASettingDocument:                      "VerifyTFASetting",
+	RegenerateTFARecoveryCodesDocument:            "RegenerateTFARecoveryCodes",
 	CreateUserDocument:                            "CreateUser",
 	DeleteUserDocument:                            "DeleteUser",
 	GetAllUsersDocument:                           "GetAllUsers",
@@ -39565,6 +43771,10 @@ var DocumentOperationNames = map[string]string{
 	UpdateUserSettingDocument:                     "UpdateUserSetting",
 	GetAllUserSettingHistoriesDocument:            "GetAllUserSettingHistories",
 	GetUserSettingHistoriesDocument:               "GetUserSettingHistories",
+	LockUserDocument:                              "LockUser",
+	UnlockUserDocument:                            "UnlockUser",
+	SuspendUserDocument:                           "SuspendUser",
+	UnsuspendUserDocument:                         "UnsuspendUser",
 	GetWebhookByIDDocument:                        "GetWebhookByID",
 	GetAllWebhooksDocument:                        "GetAllWebhooks",
 	CreateWebhookDocument:                         "CreateWebhook",
@@ -39572,6 +43782,8 @@ var DocumentOperationNames = map[string]string{
 	CreateBulkCSVWebhookDocument:                  "CreateBulkCSVWebhook",
 	UpdateWebhookDocument:                         "UpdateWebhook",
 	DeleteWebhookDocument:                         "DeleteWebhook",
+	GetWebhookDeliveriesDocument:                  "GetWebhookDeliveries",
+	RedeliverWebhookEventDocument:                 "RedeliverWebhookEvent",
 	GetAllWebhookHistoriesDocument:                "GetAllWebhookHistories",
 	GetWebhookHistoriesDocument:                   "GetWebhookHistories",
 }
@@ -241,16 +241,26 @@ type APITokenWhereInput struct {
 }
 
 type AuditLog struct {
-	Table     *string    `json:"table,omitempty"`
-	Time      *time.Time `json:"time,omitempty"`
-	ID        string     `json:"id"`
-	Operation *string    `json:"operation,omitempty"`
-	Changes   []string   `json:"changes,omitempty"`
-	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	Table     *string           `json:"table,omitempty"`
+	Time      *time.Time        `json:"time,omitempty"`
+	ID        string            `json:"id"`
+	Operation *string           `json:"operation,omitempty"`
+	Changes   []*AuditLogChange `json:"changes,omitempty"`
+	UpdatedBy *string           `json:"updatedBy,omitempty"`
 }
 
 func (AuditLog) IsNode() {}
 
+// A field of the object changed by the operation recorded in the audit log
+type AuditLogChange struct {
+	// The name of the changed field
+	Field string `json:"field"`
+	// The value of the field before the operation, null when the field was not set
+	Old json.RawMessage `json:"old,omitempty"`
+	// The value of the field after the operation, null when the field is no longer set
+	New json.RawMessage `json:"new,omitempty"`
+}
+
 // A connection to a list of items.
 type AuditLogConnection struct {
 	// A list of edges.
@@ -278,6 +288,44 @@ type AuditLogWhereInput struct {
 	After     *time.Time `json:"after,omitempty"`
 }
 
+// BulkError is the error of a single row of a bulk mutation
+type BulkError struct {
+	// Position of the row in the input, starting at 1
+	Row int64 `json:"row"`
+	// Line of the row in the csv file, the header is line 1
+	Line *int64 `json:"line,omitempty"`
+	// ID of the object of the row, if known
+	ID *string `json:"id,omitempty"`
+	// Error message
+	Message string `json:"message"`
+}
+
+// CSVColumnMapping maps a column of a csv file to a field of the input it is imported into
+type CSVColumnMapping struct {
+	// Name of the column in the header of the csv file
+	Column string `json:"column"`
+	// Name of the input field, e.g. fullName
+	Field string `json:"field"`
+}
+
+// A committed change of an object, the changed object can be queried by its ID
+type ChangeEvent struct {
+	// ID of the change
+	ID string `json:"id"`
+	// Type of the event of the change, e.g. organization.created
+	EventType string `json:"eventType"`
+	// Operation of the change, create, update or delete
+	Operation string `json:"operation"`
+	// Type of the changed object
+	ObjectType string `json:"objectType"`
+	// ID of the changed object
+	ObjectID string `json:"objectID"`
+	// ID of the organization the change was made in
+	OrganizationID *string `json:"organizationID,omitempty"`
+	// Time the change was committed
+	CreatedAt time.Time `json:"createdAt"`
+}
+
 type Contact struct {
 	ID        string     `json:"id"`
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
@@ -316,6 +364,40 @@ type ContactBulkCreatePayload struct {
 	Contacts []*Contact `json:"contacts,omitempty"`
 }
 
+// Return response for deleteBulkContact mutation
+type ContactBulkDeletePayload struct {
+	// Deleted contact IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for importBulkCSVContact mutation
+type ContactBulkImportPayload struct {
+	// Imported contacts, in a dry run the contacts that are valid but were not saved
+	Contacts []*Contact `json:"contacts,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+	// Whether the import was a dry run
+	DryRun bool `json:"dryRun"`
+}
+
+// Return response for updateBulkContact mutation
+type ContactBulkUpdatePayload struct {
+	// Updated contacts
+	Contacts []*Contact `json:"contacts,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVContact mutation
+type ContactBulkUpsertPayload struct {
+	// Created and updated contacts
+	Contacts []*Contact `json:"contacts,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type ContactConnection struct {
 	// A list of edges.
@@ -1205,7 +1287,9 @@ type CreateOauthProviderInput struct {
 	// the auth style, 0: auto detect 1: third party log in 2: log in with username and password
 	AuthStyle string `json:"authStyle"`
 	// the URL to request user information by token
-	InfoURL string  `json:"infoURL"`
+	InfoURL string `json:"infoURL"`
+	// the OIDC issuer URL, when set the provider endpoints are discovered and ID tokens are verified
+	Issuer  *string `json:"issuer,omitempty"`
 	OwnerID *string `json:"ownerID,omitempty"`
 }
 
@@ -1301,8 +1385,18 @@ type CreateOrganizationSettingInput struct {
 	// Usually government-issued tax ID or business ID such as ABN in Australia
 	TaxIdentifier *string `json:"taxIdentifier,omitempty"`
 	// geographical location of the organization
-	GeoLocation    *enums.Region `json:"geoLocation,omitempty"`
-	OrganizationID *string       `json:"organizationID,omitempty"`
+	GeoLocation *enums.Region `json:"geoLocation,omitempty"`
+	// members with an email address in one of the organization domains must login with the organization SSO provider
+	SsoEnforced *bool `json:"ssoEnforced,omitempty"`
+	// URL of the SAML metadata of the organization identity provider
+	SamlMetadataURL *string `json:"samlMetadataURL,omitempty"`
+	// SAML metadata XML of the organization identity provider, used instead of the metadata URL when set
+	SamlMetadata *string `json:"samlMetadata,omitempty"`
+	// mapping of the user fields (email, first_name, last_name, display_name) to the SAML assertion attribute names
+	SamlAttributeMapping map[string]interface{} `json:"samlAttributeMapping,omitempty"`
+	// the policy for users with an email address in a verified domain, whether they are added as members, offered to join, or must be invited
+	JoinPolicy     *enums.OrgJoinPolicy `json:"joinPolicy,omitempty"`
+	OrganizationID *string              `json:"organizationID,omitempty"`
 }
 
 // CreatePersonalAccessTokenInput is used for create PersonalAccessToken object.
@@ -1360,9 +1454,12 @@ type CreateTemplateInput struct {
 	// the jsonschema object of the template
 	Jsonconfig json.RawMessage `json:"jsonconfig"`
 	// the uischema for the template to render in the UI
-	Uischema    json.RawMessage `json:"uischema,omitempty"`
-	OwnerID     *string         `json:"ownerID,omitempty"`
-	DocumentIDs []string        `json:"documentIDs,omitempty"`
+	Uischema         json.RawMessage `json:"uischema,omitempty"`
+	OwnerID          *string         `json:"ownerID,omitempty"`
+	DocumentIDs      []string        `json:"documentIDs,omitempty"`
+	VersionIDs       []string        `json:"versionIDs,omitempty"`
+	SourceTemplateID *string         `json:"sourceTemplateID,omitempty"`
+	CloneIDs         []string        `json:"cloneIDs,omitempty"`
 }
 
 // CreateUserInput is used for create User object.
@@ -1439,6 +1536,10 @@ type CreateWebhookInput struct {
 	DestinationURL string `json:"destinationURL"`
 	// indicates if the webhook is active and enabled
 	Enabled *bool `json:"enabled,omitempty"`
+	// the event types the webhook is subscribed to, all events are sent when empty
+	EventTypes []string `json:"eventTypes,omitempty"`
+	// the object types the webhook receives events for, all object types are sent when empty
+	ObjectTypes []string `json:"objectTypes,omitempty"`
 	// the number of failures
 	Failures *int64 `json:"failures,omitempty"`
 	// the last error message
@@ -1464,6 +1565,8 @@ type DocumentData struct {
 	OwnerID *string `json:"ownerID,omitempty"`
 	// the template id of the document
 	TemplateID string `json:"templateID"`
+	// the version of the template the document is validated against
+	TemplateVersion *int64 `json:"templateVersion,omitempty"`
 	// the json data of the document
 	Data     json.RawMessage `json:"data"`
 	Owner    *Organization   `json:"owner,omitempty"`
@@ -1479,6 +1582,30 @@ type DocumentDataBulkCreatePayload struct {
 	DocumentData []*DocumentData `json:"documentData,omitempty"`
 }
 
+// Return response for deleteBulkDocumentData mutation
+type DocumentDataBulkDeletePayload struct {
+	// Deleted documentdata IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkDocumentData mutation
+type DocumentDataBulkUpdatePayload struct {
+	// Updated documentData
+	DocumentData []*DocumentData `json:"documentData,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVDocumentData mutation
+type DocumentDataBulkUpsertPayload struct {
+	// Created and updated documentData
+	DocumentData []*DocumentData `json:"documentData,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type DocumentDataConnection struct {
 	// A list of edges.
@@ -1526,6 +1653,8 @@ type DocumentDataHistory struct {
 	OwnerID *string `json:"ownerID,omitempty"`
 	// the template id of the document
 	TemplateID string `json:"templateID"`
+	// the version of the template the document is validated against
+	TemplateVersion *int64 `json:"templateVersion,omitempty"`
 	// the json data of the document
 	Data json.RawMessage `json:"data"`
 }
@@ -1708,6 +1837,17 @@ type DocumentDataHistoryWhereInput struct {
 	TemplateIDHasSuffix    *string  `json:"templateIDHasSuffix,omitempty"`
 	TemplateIDEqualFold    *string  `json:"templateIDEqualFold,omitempty"`
 	TemplateIDContainsFold *string  `json:"templateIDContainsFold,omitempty"`
+	// template_version field predicates
+	TemplateVersion       *int64  `json:"templateVersion,omitempty"`
+	TemplateVersionNeq    *int64  `json:"templateVersionNEQ,omitempty"`
+	TemplateVersionIn     []int64 `json:"templateVersionIn,omitempty"`
+	TemplateVersionNotIn  []int64 `json:"templateVersionNotIn,omitempty"`
+	TemplateVersionGt     *int64  `json:"templateVersionGT,omitempty"`
+	TemplateVersionGte    *int64  `json:"templateVersionGTE,omitempty"`
+	TemplateVersionLt     *int64  `json:"templateVersionLT,omitempty"`
+	TemplateVersionLte    *int64  `json:"templateVersionLTE,omitempty"`
+	TemplateVersionIsNil  *bool   `json:"templateVersionIsNil,omitempty"`
+	TemplateVersionNotNil *bool   `json:"templateVersionNotNil,omitempty"`
 }
 
 // Return response for updateDocumentData mutation
@@ -1844,6 +1984,17 @@ type DocumentDataWhereInput struct {
 	TemplateIDHasSuffix    *string  `json:"templateIDHasSuffix,omitempty"`
 	TemplateIDEqualFold    *string  `json:"templateIDEqualFold,omitempty"`
 	TemplateIDContainsFold *string  `json:"templateIDContainsFold,omitempty"`
+	// template_version field predicates
+	TemplateVersion       *int64  `json:"templateVersion,omitempty"`
+	TemplateVersionNeq    *int64  `json:"templateVersionNEQ,omitempty"`
+	TemplateVersionIn     []int64 `json:"templateVersionIn,omitempty"`
+	TemplateVersionNotIn  []int64 `json:"templateVersionNotIn,omitempty"`
+	TemplateVersionGt     *int64  `json:"templateVersionGT,omitempty"`
+	TemplateVersionGte    *int64  `json:"templateVersionGTE,omitempty"`
+	TemplateVersionLt     *int64  `json:"templateVersionLT,omitempty"`
+	TemplateVersionLte    *int64  `json:"templateVersionLTE,omitempty"`
+	TemplateVersionIsNil  *bool   `json:"templateVersionIsNil,omitempty"`
+	TemplateVersionNotNil *bool   `json:"templateVersionNotNil,omitempty"`
 	// owner edge predicates
 	HasOwner     *bool                     `json:"hasOwner,omitempty"`
 	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
@@ -1895,6 +2046,30 @@ type EntitlementBulkCreatePayload struct {
 	Entitlements []*Entitlement `json:"entitlements,omitempty"`
 }
 
+// Return response for deleteBulkEntitlement mutation
+type EntitlementBulkDeletePayload struct {
+	// Deleted entitlement IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkEntitlement mutation
+type EntitlementBulkUpdatePayload struct {
+	// Updated entitlements
+	Entitlements []*Entitlement `json:"entitlements,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVEntitlement mutation
+type EntitlementBulkUpsertPayload struct {
+	// Created and updated entitlements
+	Entitlements []*Entitlement `json:"entitlements,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type EntitlementConnection struct {
 	// A list of edges.
@@ -2236,6 +2411,30 @@ type EntitlementPlanBulkCreatePayload struct {
 	EntitlementPlans []*EntitlementPlan `json:"entitlementPlans,omitempty"`
 }
 
+// Return response for deleteBulkEntitlementPlan mutation
+type EntitlementPlanBulkDeletePayload struct {
+	// Deleted entitlementplan IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkEntitlementPlan mutation
+type EntitlementPlanBulkUpdatePayload struct {
+	// Updated entitlementPlans
+	EntitlementPlans []*EntitlementPlan `json:"entitlementPlans,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVEntitlementPlan mutation
+type EntitlementPlanBulkUpsertPayload struct {
+	// Created and updated entitlementPlans
+	EntitlementPlans []*EntitlementPlan `json:"entitlementPlans,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type EntitlementPlanConnection struct {
 	// A list of edges.
@@ -2296,6 +2495,30 @@ type EntitlementPlanFeatureBulkCreatePayload struct {
 	EntitlementPlanFeatures []*EntitlementPlanFeature `json:"entitlementPlanFeatures,omitempty"`
 }
 
+// Return response for deleteBulkEntitlementPlanFeature mutation
+type EntitlementPlanFeatureBulkDeletePayload struct {
+	// Deleted entitlementplanfeature IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkEntitlementPlanFeature mutation
+type EntitlementPlanFeatureBulkUpdatePayload struct {
+	// Updated entitlementPlanFeatures
+	EntitlementPlanFeatures []*EntitlementPlanFeature `json:"entitlementPlanFeatures,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVEntitlementPlanFeature mutation
+type EntitlementPlanFeatureBulkUpsertPayload struct {
+	// Created and updated entitlementPlanFeatures
+	EntitlementPlanFeatures []*EntitlementPlanFeature `json:"entitlementPlanFeatures,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type EntitlementPlanFeatureConnection struct {
 	// A list of edges.
@@ -3348,6 +3571,40 @@ type EntityBulkCreatePayload struct {
 	Entities []*Entity `json:"entities,omitempty"`
 }
 
+// Return response for deleteBulkEntity mutation
+type EntityBulkDeletePayload struct {
+	// Deleted entity IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for importBulkCSVEntity mutation
+type EntityBulkImportPayload struct {
+	// Imported entities, in a dry run the entities that are valid but were not saved
+	Entities []*Entity `json:"entities,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+	// Whether the import was a dry run
+	DryRun bool `json:"dryRun"`
+}
+
+// Return response for updateBulkEntity mutation
+type EntityBulkUpdatePayload struct {
+	// Updated entities
+	Entities []*Entity `json:"entities,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVEntity mutation
+type EntityBulkUpsertPayload struct {
+	// Created and updated entities
+	Entities []*Entity `json:"entities,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type EntityConnection struct {
 	// A list of edges.
@@ -3679,6 +3936,30 @@ type EntityTypeBulkCreatePayload struct {
 	EntityTypes []*EntityType `json:"entityTypes,omitempty"`
 }
 
+// Return response for deleteBulkEntityType mutation
+type EntityTypeBulkDeletePayload struct {
+	// Deleted entitytype IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkEntityType mutation
+type EntityTypeBulkUpdatePayload struct {
+	// Updated entityTypes
+	EntityTypes []*EntityType `json:"entityTypes,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVEntityType mutation
+type EntityTypeBulkUpsertPayload struct {
+	// Created and updated entityTypes
+	EntityTypes []*EntityType `json:"entityTypes,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type EntityTypeConnection struct {
 	// A list of edges.
@@ -4298,6 +4579,7 @@ type Event struct {
 	Entitlement            []*Entitlement            `json:"entitlement,omitempty"`
 	Webhook                []*Webhook                `json:"webhook,omitempty"`
 	Subscriber             []*Subscriber             `json:"subscriber,omitempty"`
+	WebhookDeliveries      []*WebhookDelivery        `json:"webhookDeliveries,omitempty"`
 }
 
 func (Event) IsNode() {}
@@ -4695,6 +4977,9 @@ type EventWhereInput struct {
 	// subscriber edge predicates
 	HasSubscriber     *bool                   `json:"hasSubscriber,omitempty"`
 	HasSubscriberWith []*SubscriberWhereInput `json:"hasSubscriberWith,omitempty"`
+	// webhook_deliveries edge predicates
+	HasWebhookDeliveries     *bool                        `json:"hasWebhookDeliveries,omitempty"`
+	HasWebhookDeliveriesWith []*WebhookDeliveryWhereInput `json:"hasWebhookDeliveriesWith,omitempty"`
 }
 
 type Feature struct {
@@ -4733,6 +5018,30 @@ type FeatureBulkCreatePayload struct {
 	Features []*Feature `json:"features,omitempty"`
 }
 
+// Return response for deleteBulkFeature mutation
+type FeatureBulkDeletePayload struct {
+	// Deleted feature IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkFeature mutation
+type FeatureBulkUpdatePayload struct {
+	// Updated features
+	Features []*Feature `json:"features,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVFeature mutation
+type FeatureBulkUpsertPayload struct {
+	// Created and updated features
+	Features []*Feature `json:"features,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type FeatureConnection struct {
 	// A list of edges.
@@ -5219,6 +5528,30 @@ type FileBulkCreatePayload struct {
 	Files []*File `json:"files,omitempty"`
 }
 
+// Return response for deleteBulkFile mutation
+type FileBulkDeletePayload struct {
+	// Deleted file IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkFile mutation
+type FileBulkUpdatePayload struct {
+	// Updated files
+	Files []*File `json:"files,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVFile mutation
+type FileBulkUpsertPayload struct {
+	// Created and updated files
+	Files []*File `json:"files,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type FileConnection struct {
 	// A list of edges.
@@ -6451,6 +6784,30 @@ type GroupSettingBulkCreatePayload struct {
 	GroupSettings []*GroupSetting `json:"groupSettings,omitempty"`
 }
 
+// Return response for deleteBulkGroupSetting mutation
+type GroupSettingBulkDeletePayload struct {
+	// Deleted groupsetting IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkGroupSetting mutation
+type GroupSettingBulkUpdatePayload struct {
+	// Updated groupSettings
+	GroupSettings []*GroupSetting `json:"groupSettings,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVGroupSetting mutation
+type GroupSettingBulkUpsertPayload struct {
+	// Created and updated groupSettings
+	GroupSettings []*GroupSetting `json:"groupSettings,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type GroupSettingConnection struct {
 	// A list of edges.
@@ -7038,6 +7395,30 @@ type HushBulkCreatePayload struct {
 	Hushes []*Hush `json:"hushes,omitempty"`
 }
 
+// Return response for deleteBulkHush mutation
+type HushBulkDeletePayload struct {
+	// Deleted hush IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkHush mutation
+type HushBulkUpdatePayload struct {
+	// Updated hushs
+	Hushes []*Hush `json:"hushes,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVHush mutation
+type HushBulkUpsertPayload struct {
+	// Created and updated hushs
+	Hushes []*Hush `json:"hushes,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type HushConnection struct {
 	// A list of edges.
@@ -7496,6 +7877,30 @@ type IntegrationBulkCreatePayload struct {
 	Integrations []*Integration `json:"integrations,omitempty"`
 }
 
+// Return response for deleteBulkIntegration mutation
+type IntegrationBulkDeletePayload struct {
+	// Deleted integration IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkIntegration mutation
+type IntegrationBulkUpdatePayload struct {
+	// Updated integrations
+	Integrations []*Integration `json:"integrations,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVIntegration mutation
+type IntegrationBulkUpsertPayload struct {
+	// Created and updated integrations
+	Integrations []*Integration `json:"integrations,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type IntegrationConnection struct {
 	// A list of edges.
@@ -8583,8 +8988,10 @@ type OauthProvider struct {
 	// the auth style, 0: auto detect 1: third party log in 2: log in with username and password
 	AuthStyle string `json:"authStyle"`
 	// the URL to request user information by token
-	InfoURL string        `json:"infoURL"`
-	Owner   *Organization `json:"owner,omitempty"`
+	InfoURL string `json:"infoURL"`
+	// the OIDC issuer URL, when set the provider endpoints are discovered and ID tokens are verified
+	Issuer *string       `json:"issuer,omitempty"`
+	Owner  *Organization `json:"owner,omitempty"`
 }
 
 func (OauthProvider) IsNode() {}
@@ -8595,6 +9002,30 @@ type OauthProviderBulkCreatePayload struct {
 	OauthProviders []*OauthProvider `json:"oauthProviders,omitempty"`
 }
 
+// Return response for deleteBulkOauthProvider mutation
+type OauthProviderBulkDeletePayload struct {
+	// Deleted oauthprovider IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkOauthProvider mutation
+type OauthProviderBulkUpdatePayload struct {
+	// Updated oauthProviders
+	OauthProviders []*OauthProvider `json:"oauthProviders,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVOauthProvider mutation
+type OauthProviderBulkUpsertPayload struct {
+	// Created and updated oauthProviders
+	OauthProviders []*OauthProvider `json:"oauthProviders,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type OauthProviderConnection struct {
 	// A list of edges.
@@ -8658,6 +9089,8 @@ type OauthProviderHistory struct {
 	AuthStyle string `json:"authStyle"`
 	// the URL to request user information by token
 	InfoURL string `json:"infoURL"`
+	// the OIDC issuer URL, when set the provider endpoints are discovered and ID tokens are verified
+	Issuer *string `json:"issuer,omitempty"`
 }
 
 func (OauthProviderHistory) IsNode() {}
@@ -8945,6 +9378,22 @@ type OauthProviderHistoryWhereInput struct {
 	InfoURLHasSuffix    *string  `json:"infoURLHasSuffix,omitempty"`
 	InfoURLEqualFold    *string  `json:"infoURLEqualFold,omitempty"`
 	InfoURLContainsFold *string  `json:"infoURLContainsFold,omitempty"`
+	// issuer field predicates
+	Issuer             *string  `json:"issuer,omitempty"`
+	IssuerNeq          *string  `json:"issuerNEQ,omitempty"`
+	IssuerIn           []string `json:"issuerIn,omitempty"`
+	IssuerNotIn        []string `json:"issuerNotIn,omitempty"`
+	IssuerGt           *string  `json:"issuerGT,omitempty"`
+	IssuerGte          *string  `json:"issuerGTE,omitempty"`
+	IssuerLt           *string  `json:"issuerLT,omitempty"`
+	IssuerLte          *string  `json:"issuerLTE,omitempty"`
+	IssuerContains     *string  `json:"issuerContains,omitempty"`
+	IssuerHasPrefix    *string  `json:"issuerHasPrefix,omitempty"`
+	IssuerHasSuffix    *string  `json:"issuerHasSuffix,omitempty"`
+	IssuerIsNil        *bool    `json:"issuerIsNil,omitempty"`
+	IssuerNotNil       *bool    `json:"issuerNotNil,omitempty"`
+	IssuerEqualFold    *string  `json:"issuerEqualFold,omitempty"`
+	IssuerContainsFold *string  `json:"issuerContainsFold,omitempty"`
 }
 
 // Return response for updateOauthProvider mutation
@@ -9188,6 +9637,22 @@ type OauthProviderWhereInput struct {
 	InfoURLHasSuffix    *string  `json:"infoURLHasSuffix,omitempty"`
 	InfoURLEqualFold    *string  `json:"infoURLEqualFold,omitempty"`
 	InfoURLContainsFold *string  `json:"infoURLContainsFold,omitempty"`
+	// issuer field predicates
+	Issuer             *string  `json:"issuer,omitempty"`
+	IssuerNeq          *string  `json:"issuerNEQ,omitempty"`
+	IssuerIn           []string `json:"issuerIn,omitempty"`
+	IssuerNotIn        []string `json:"issuerNotIn,omitempty"`
+	IssuerGt           *string  `json:"issuerGT,omitempty"`
+	IssuerGte          *string  `json:"issuerGTE,omitempty"`
+	IssuerLt           *string  `json:"issuerLT,omitempty"`
+	IssuerLte          *string  `json:"issuerLTE,omitempty"`
+	IssuerContains     *string  `json:"issuerContains,omitempty"`
+	IssuerHasPrefix    *string  `json:"issuerHasPrefix,omitempty"`
+	IssuerHasSuffix    *string  `json:"issuerHasSuffix,omitempty"`
+	IssuerIsNil        *bool    `json:"issuerIsNil,omitempty"`
+	IssuerNotNil       *bool    `json:"issuerNotNil,omitempty"`
+	IssuerEqualFold    *string  `json:"issuerEqualFold,omitempty"`
+	IssuerContainsFold *string  `json:"issuerContainsFold,omitempty"`
 	// owner edge predicates
 	HasOwner     *bool                     `json:"hasOwner,omitempty"`
 	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
@@ -9221,6 +9686,30 @@ type OhAuthTooTokenBulkCreatePayload struct {
 	OhAuthTooTokens []*OhAuthTooToken `json:"ohAuthTooTokens,omitempty"`
 }
 
+// Return response for deleteBulkOhAuthTooToken mutation
+type OhAuthTooTokenBulkDeletePayload struct {
+	// Deleted ohauthtootoken IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkOhAuthTooToken mutation
+type OhAuthTooTokenBulkUpdatePayload struct {
+	// Updated ohAuthTooTokens
+	OhAuthTooTokens []*OhAuthTooToken `json:"ohAuthTooTokens,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVOhAuthTooToken mutation
+type OhAuthTooTokenBulkUpsertPayload struct {
+	// Created and updated ohAuthTooTokens
+	OhAuthTooTokens []*OhAuthTooToken `json:"ohAuthTooTokens,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type OhAuthTooTokenConnection struct {
 	// A list of edges.
@@ -10122,8 +10611,22 @@ type OrganizationSetting struct {
 	// geographical location of the organization
 	GeoLocation *enums.Region `json:"geoLocation,omitempty"`
 	// the ID of the organization the settings belong to
-	OrganizationID *string       `json:"organizationID,omitempty"`
-	Organization   *Organization `json:"organization,omitempty"`
+	OrganizationID *string `json:"organizationID,omitempty"`
+	// members with an email address in one of the organization domains must login with the organization SSO provider
+	SsoEnforced bool `json:"ssoEnforced"`
+	// URL of the SAML metadata of the organization identity provider
+	SamlMetadataURL *string `json:"samlMetadataURL,omitempty"`
+	// SAML metadata XML of the organization identity provider, used instead of the metadata URL when set
+	SamlMetadata *string `json:"samlMetadata,omitempty"`
+	// mapping of the user fields (email, first_name, last_name, display_name) to the SAML assertion attribute names
+	SamlAttributeMapping map[string]interface{} `json:"samlAttributeMapping,omitempty"`
+	// token published in a DNS TXT record or the well-known file of a domain to verify the organization owns the domain
+	DomainVerificationToken *string `json:"domainVerificationToken,omitempty"`
+	// domains of the organization which have been verified to be owned by the organization
+	VerifiedDomains []string `json:"verifiedDomains,omitempty"`
+	// the policy for users with an email address in a verified domain, whether they are added as members, offered to join, or must be invited
+	JoinPolicy   enums.OrgJoinPolicy `json:"joinPolicy"`
+	Organization *Organization       `json:"organization,omitempty"`
 }
 
 func (OrganizationSetting) IsNode() {}
@@ -10134,6 +10637,30 @@ type OrganizationSettingBulkCreatePayload struct {
 	OrganizationSettings []*OrganizationSetting `json:"organizationSettings,omitempty"`
 }
 
+// Return response for deleteBulkOrganizationSetting mutation
+type OrganizationSettingBulkDeletePayload struct {
+	// Deleted organizationsetting IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkOrganizationSetting mutation
+type OrganizationSettingBulkUpdatePayload struct {
+	// Updated organizationSettings
+	OrganizationSettings []*OrganizationSetting `json:"organizationSettings,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVOrganizationSetting mutation
+type OrganizationSettingBulkUpsertPayload struct {
+	// Created and updated organizationSettings
+	OrganizationSettings []*OrganizationSetting `json:"organizationSettings,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type OrganizationSettingConnection struct {
 	// A list of edges.
@@ -10193,6 +10720,20 @@ type OrganizationSettingHistory struct {
 	GeoLocation *enums.Region `json:"geoLocation,omitempty"`
 	// the ID of the organization the settings belong to
 	OrganizationID *string `json:"organizationID,omitempty"`
+	// members with an email address in one of the organization domains must login with the organization SSO provider
+	SsoEnforced bool `json:"ssoEnforced"`
+	// URL of the SAML metadata of the organization identity provider
+	SamlMetadataURL *string `json:"samlMetadataURL,omitempty"`
+	// SAML metadata XML of the organization identity provider, used instead of the metadata URL when set
+	SamlMetadata *string `json:"samlMetadata,omitempty"`
+	// mapping of the user fields (email, first_name, last_name, display_name) to the SAML assertion attribute names
+	SamlAttributeMapping map[string]interface{} `json:"samlAttributeMapping,omitempty"`
+	// token published in a DNS TXT record or the well-known file of a domain to verify the organization owns the domain
+	DomainVerificationToken *string `json:"domainVerificationToken,omitempty"`
+	// domains of the organization which have been verified to be owned by the organization
+	VerifiedDomains []string `json:"verifiedDomains,omitempty"`
+	// the policy for users with an email address in a verified domain, whether they are added as members, offered to join, or must be invited
+	JoinPolicy enums.OrgJoinPolicy `json:"joinPolicy"`
 }
 
 func (OrganizationSettingHistory) IsNode() {}
@@ -10446,6 +10987,62 @@ type OrganizationSettingHistoryWhereInput struct {
 	OrganizationIDNotNil       *bool    `json:"organizationIDNotNil,omitempty"`
 	OrganizationIDEqualFold    *string  `json:"organizationIDEqualFold,omitempty"`
 	OrganizationIDContainsFold *string  `json:"organizationIDContainsFold,omitempty"`
+	// sso_enforced field predicates
+	SsoEnforced    *bool `json:"ssoEnforced,omitempty"`
+	SsoEnforcedNeq *bool `json:"ssoEnforcedNEQ,omitempty"`
+	// saml_metadata_url field predicates
+	SamlMetadataURL             *string  `json:"samlMetadataURL,omitempty"`
+	SamlMetadataURLNeq          *string  `json:"samlMetadataURLNEQ,omitempty"`
+	SamlMetadataURLIn           []string `json:"samlMetadataURLIn,omitempty"`
+	SamlMetadataURLNotIn        []string `json:"samlMetadataURLNotIn,omitempty"`
+	SamlMetadataURLGt           *string  `json:"samlMetadataURLGT,omitempty"`
+	SamlMetadataURLGte          *string  `json:"samlMetadataURLGTE,omitempty"`
+	SamlMetadataURLLt           *string  `json:"samlMetadataURLLT,omitempty"`
+	SamlMetadataURLLte          *string  `json:"samlMetadataURLLTE,omitempty"`
+	SamlMetadataURLContains     *string  `json:"samlMetadataURLContains,omitempty"`
+	SamlMetadataURLHasPrefix    *string  `json:"samlMetadataURLHasPrefix,omitempty"`
+	SamlMetadataURLHasSuffix    *string  `json:"samlMetadataURLHasSuffix,omitempty"`
+	SamlMetadataURLIsNil        *bool    `json:"samlMetadataURLIsNil,omitempty"`
+	SamlMetadataURLNotNil       *bool    `json:"samlMetadataURLNotNil,omitempty"`
+	SamlMetadataURLEqualFold    *string  `json:"samlMetadataURLEqualFold,omitempty"`
+	SamlMetadataURLContainsFold *string  `json:"samlMetadataURLContainsFold,omitempty"`
+	// saml_metadata field predicates
+	SamlMetadata             *string  `json:"samlMetadata,omitempty"`
+	SamlMetadataNeq          *string  `json:"samlMetadataNEQ,omitempty"`
+	SamlMetadataIn           []string `json:"samlMetadataIn,omitempty"`
+	SamlMetadataNotIn        []string `json:"samlMetadataNotIn,omitempty"`
+	SamlMetadataGt           *string  `json:"samlMetadataGT,omitempty"`
+	SamlMetadataGte          *string  `json:"samlMetadataGTE,omitempty"`
+	SamlMetadataLt           *string  `json:"samlMetadataLT,omitempty"`
+	SamlMetadataLte          *string  `json:"samlMetadataLTE,omitempty"`
+	SamlMetadataContains     *string  `json:"samlMetadataContains,omitempty"`
+	SamlMetadataHasPrefix    *string  `json:"samlMetadataHasPrefix,omitempty"`
+	SamlMetadataHasSuffix    *string  `json:"samlMetadataHasSuffix,omitempty"`
+	SamlMetadataIsNil        *bool    `json:"samlMetadataIsNil,omitempty"`
+	SamlMetadataNotNil       *bool    `json:"samlMetadataNotNil,omitempty"`
+	SamlMetadataEqualFold    *string  `json:"samlMetadataEqualFold,omitempty"`
+	SamlMetadataContainsFold *string  `json:"samlMetadataContainsFold,omitempty"`
+	// domain_verification_token field predicates
+	DomainVerificationToken             *string  `json:"domainVerificationToken,omitempty"`
+	DomainVerificationTokenNeq          *string  `json:"domainVerificationTokenNEQ,omitempty"`
+	DomainVerificationTokenIn           []string `json:"domainVerificationTokenIn,omitempty"`
+	DomainVerificationTokenNotIn        []string `json:"domainVerificationTokenNotIn,omitempty"`
+	DomainVerificationTokenGt           *string  `json:"domainVerificationTokenGT,omitempty"`
+	DomainVerificationTokenGte          *string  `json:"domainVerificationTokenGTE,omitempty"`
+	DomainVerificationTokenLt           *string  `json:"domainVerificationTokenLT,omitempty"`
+	DomainVerificationTokenLte          *string  `json:"domainVerificationTokenLTE,omitempty"`
+	DomainVerificationTokenContains     *string  `json:"domainVerificationTokenContains,omitempty"`
+	DomainVerificationTokenHasPrefix    *string  `json:"domainVerificationTokenHasPrefix,omitempty"`
+	DomainVerificationTokenHasSuffix    *string  `json:"domainVerificationTokenHasSuffix,omitempty"`
+	DomainVerificationTokenIsNil        *bool    `json:"domainVerificationTokenIsNil,omitempty"`
+	DomainVerificationTokenNotNil       *bool    `json:"domainVerificationTokenNotNil,omitempty"`
+	DomainVerificationTokenEqualFold    *string  `json:"domainVerificationTokenEqualFold,omitempty"`
+	DomainVerificationTokenContainsFold *string  `json:"domainVerificationTokenContainsFold,omitempty"`
+	// join_policy field predicates
+	JoinPolicy      *enums.OrgJoinPolicy  `json:"joinPolicy,omitempty"`
+	JoinPolicyNeq   *enums.OrgJoinPolicy  `json:"joinPolicyNEQ,omitempty"`
+	JoinPolicyIn    []enums.OrgJoinPolicy `json:"joinPolicyIn,omitempty"`
+	JoinPolicyNotIn []enums.OrgJoinPolicy `json:"joinPolicyNotIn,omitempty"`
 }
 
 // Return response for updateOrganizationSetting mutation
@@ -10655,6 +11252,62 @@ type OrganizationSettingWhereInput struct {
 	OrganizationIDNotNil       *bool    `json:"organizationIDNotNil,omitempty"`
 	OrganizationIDEqualFold    *string  `json:"organizationIDEqualFold,omitempty"`
 	OrganizationIDContainsFold *string  `json:"organizationIDContainsFold,omitempty"`
+	// sso_enforced field predicates
+	SsoEnforced    *bool `json:"ssoEnforced,omitempty"`
+	SsoEnforcedNeq *bool `json:"ssoEnforcedNEQ,omitempty"`
+	// saml_metadata_url field predicates
+	SamlMetadataURL             *string  `json:"samlMetadataURL,omitempty"`
+	SamlMetadataURLNeq          *string  `json:"samlMetadataURLNEQ,omitempty"`
+	SamlMetadataURLIn           []string `json:"samlMetadataURLIn,omitempty"`
+	SamlMetadataURLNotIn        []string `json:"samlMetadataURLNotIn,omitempty"`
+	SamlMetadataURLGt           *string  `json:"samlMetadataURLGT,omitempty"`
+	SamlMetadataURLGte          *string  `json:"samlMetadataURLGTE,omitempty"`
+	SamlMetadataURLLt           *string  `json:"samlMetadataURLLT,omitempty"`
+	SamlMetadataURLLte          *string  `json:"samlMetadataURLLTE,omitempty"`
+	SamlMetadataURLContains     *string  `json:"samlMetadataURLContains,omitempty"`
+	SamlMetadataURLHasPrefix    *string  `json:"samlMetadataURLHasPrefix,omitempty"`
+	SamlMetadataURLHasSuffix    *string  `json:"samlMetadataURLHasSuffix,omitempty"`
+	SamlMetadataURLIsNil        *bool    `json:"samlMetadataURLIsNil,omitempty"`
+	SamlMetadataURLNotNil       *bool    `json:"samlMetadataURLNotNil,omitempty"`
+	SamlMetadataURLEqualFold    *string  `json:"samlMetadataURLEqualFold,omitempty"`
+	SamlMetadataURLContainsFold *string  `json:"samlMetadataURLContainsFold,omitempty"`
+	// saml_metadata field predicates
+	SamlMetadata             *string  `json:"samlMetadata,omitempty"`
+	SamlMetadataNeq          *string  `json:"samlMetadataNEQ,omitempty"`
+	SamlMetadataIn           []string `json:"samlMetadataIn,omitempty"`
+	SamlMetadataNotIn        []string `json:"samlMetadataNotIn,omitempty"`
+	SamlMetadataGt           *string  `json:"samlMetadataGT,omitempty"`
+	SamlMetadataGte          *string  `json:"samlMetadataGTE,omitempty"`
+	SamlMetadataLt           *string  `json:"samlMetadataLT,omitempty"`
+	SamlMetadataLte          *string  `json:"samlMetadataLTE,omitempty"`
+	SamlMetadataContains     *string  `json:"samlMetadataContains,omitempty"`
+	SamlMetadataHasPrefix    *string  `json:"samlMetadataHasPrefix,omitempty"`
+	SamlMetadataHasSuffix    *string  `json:"samlMetadataHasSuffix,omitempty"`
+	SamlMetadataIsNil        *bool    `json:"samlMetadataIsNil,omitempty"`
+	SamlMetadataNotNil       *bool    `json:"samlMetadataNotNil,omitempty"`
+	SamlMetadataEqualFold    *string  `json:"samlMetadataEqualFold,omitempty"`
+	SamlMetadataContainsFold *string  `json:"samlMetadataContainsFold,omitempty"`
+	// domain_verification_token field predicates
+	DomainVerificationToken             *string  `json:"domainVerificationToken,omitempty"`
+	DomainVerificationTokenNeq          *string  `json:"domainVerificationTokenNEQ,omitempty"`
+	DomainVerificationTokenIn           []string `json:"domainVerificationTokenIn,omitempty"`
+	DomainVerificationTokenNotIn        []string `json:"domainVerificationTokenNotIn,omitempty"`
+	DomainVerificationTokenGt           *string  `json:"domainVerificationTokenGT,omitempty"`
+	DomainVerificationTokenGte          *string  `json:"domainVerificationTokenGTE,omitempty"`
+	DomainVerificationTokenLt           *string  `json:"domainVerificationTokenLT,omitempty"`
+	DomainVerificationTokenLte          *string  `json:"domainVerificationTokenLTE,omitempty"`
+	DomainVerificationTokenContains     *string  `json:"domainVerificationTokenContains,omitempty"`
+	DomainVerificationTokenHasPrefix    *string  `json:"domainVerificationTokenHasPrefix,omitempty"`
+	DomainVerificationTokenHasSuffix    *string  `json:"domainVerificationTokenHasSuffix,omitempty"`
+	DomainVerificationTokenIsNil        *bool    `json:"domainVerificationTokenIsNil,omitempty"`
+	DomainVerificationTokenNotNil       *bool    `json:"domainVerificationTokenNotNil,omitempty"`
+	DomainVerificationTokenEqualFold    *string  `json:"domainVerificationTokenEqualFold,omitempty"`
+	DomainVerificationTokenContainsFold *string  `json:"domainVerificationTokenContainsFold,omitempty"`
+	// join_policy field predicates
+	JoinPolicy      *enums.OrgJoinPolicy  `json:"joinPolicy,omitempty"`
+	JoinPolicyNeq   *enums.OrgJoinPolicy  `json:"joinPolicyNEQ,omitempty"`
+	JoinPolicyIn    []enums.OrgJoinPolicy `json:"joinPolicyIn,omitempty"`
+	JoinPolicyNotIn []enums.OrgJoinPolicy `json:"joinPolicyNotIn,omitempty"`
 	// organization edge predicates
 	HasOrganization     *bool                     `json:"hasOrganization,omitempty"`
 	HasOrganizationWith []*OrganizationWhereInput `json:"hasOrganizationWith,omitempty"`
@@ -10944,6 +11597,30 @@ type PersonalAccessTokenBulkCreatePayload struct {
 	PersonalAccessTokens []*PersonalAccessToken `json:"personalAccessTokens,omitempty"`
 }
 
+// Return response for deleteBulkPersonalAccessToken mutation
+type PersonalAccessTokenBulkDeletePayload struct {
+	// Deleted personalaccesstoken IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkPersonalAccessToken mutation
+type PersonalAccessTokenBulkUpdatePayload struct {
+	// Updated personalAccessTokens
+	PersonalAccessTokens []*PersonalAccessToken `json:"personalAccessTokens,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVPersonalAccessToken mutation
+type PersonalAccessTokenBulkUpsertPayload struct {
+	// Created and updated personalAccessTokens
+	PersonalAccessTokens []*PersonalAccessToken `json:"personalAccessTokens,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type PersonalAccessTokenConnection struct {
 	// A list of edges.
@@ -11128,6 +11805,12 @@ type PersonalAccessTokenWhereInput struct {
 type Query struct {
 }
 
+// Return response for revokeSession mutation
+type SessionRevokePayload struct {
+	// Revoked session ID
+	DeletedID string `json:"deletedID"`
+}
+
 type Subscriber struct {
 	ID        string     `json:"id"`
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
@@ -11365,6 +12048,9 @@ type SubscriberWhereInput struct {
 	HasEventsWith []*EventWhereInput `json:"hasEventsWith,omitempty"`
 }
 
+type Subscription struct {
+}
+
 type TFASetting struct {
 	ID        string     `json:"id"`
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
@@ -11384,6 +12070,10 @@ type TFASetting struct {
 	// specifies a user may complete authentication by verifying a TOTP code delivered through an authenticator app
 	TotpAllowed *bool `json:"totpAllowed,omitempty"`
 	Owner       *User `json:"owner,omitempty"`
+	// otpauth URI used to add the TOTP secret to an authenticator app, only returned until the tfaSetting is verified
+	QRCode *string `json:"qrCode,omitempty"`
+	// base64 encoded PNG image of the qrCode, only returned until the tfaSetting is verified
+	QRImage *string `json:"qrImage,omitempty"`
 }
 
 func (TFASetting) IsNode() {}
@@ -11566,9 +12256,18 @@ type Template struct {
 	// the jsonschema object of the template
 	Jsonconfig json.RawMessage `json:"jsonconfig"`
 	// the uischema for the template to render in the UI
-	Uischema  json.RawMessage `json:"uischema,omitempty"`
-	Owner     *Organization   `json:"owner,omitempty"`
-	Documents []*DocumentData `json:"documents,omitempty"`
+	Uischema json.RawMessage `json:"uischema,omitempty"`
+	// the current version of the template, incremented each time the jsonconfig or uischema is changed
+	Version int64 `json:"version"`
+	// the root template the template was cloned from
+	SourceTemplateID *string `json:"sourceTemplateID,omitempty"`
+	// the version of the root template the template was cloned from
+	SourceTemplateVersion *int64             `json:"sourceTemplateVersion,omitempty"`
+	Owner                 *Organization      `json:"owner,omitempty"`
+	Documents             []*DocumentData    `json:"documents,omitempty"`
+	Versions              []*TemplateVersion `json:"versions,omitempty"`
+	SourceTemplate        *Template          `json:"sourceTemplate,omitempty"`
+	Clones                []*Template        `json:"clones,omitempty"`
 }
 
 func (Template) IsNode() {}
@@ -11579,6 +12278,30 @@ type TemplateBulkCreatePayload struct {
 	Templates []*Template `json:"templates,omitempty"`
 }
 
+// Return response for deleteBulkTemplate mutation
+type TemplateBulkDeletePayload struct {
+	// Deleted template IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkTemplate mutation
+type TemplateBulkUpdatePayload struct {
+	// Updated templates
+	Templates []*Template `json:"templates,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVTemplate mutation
+type TemplateBulkUpsertPayload struct {
+	// Created and updated templates
+	Templates []*Template `json:"templates,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type TemplateConnection struct {
 	// A list of edges.
@@ -11609,6 +12332,14 @@ type TemplateEdge struct {
 	Cursor string `json:"cursor"`
 }
 
+// A field that does not conform to the schema of a template
+type TemplateFieldError struct {
+	// JSON pointer to the field
+	Path string `json:"path"`
+	// reason the field is not valid
+	Message string `json:"message"`
+}
+
 type TemplateHistory struct {
 	ID          string            `json:"id"`
 	HistoryTime time.Time         `json:"historyTime"`
@@ -11634,6 +12365,12 @@ type TemplateHistory struct {
 	Jsonconfig json.RawMessage `json:"jsonconfig"`
 	// the uischema for the template to render in the UI
 	Uischema json.RawMessage `json:"uischema,omitempty"`
+	// the current version of the template, incremented each time the jsonconfig or uischema is changed
+	Version int64 `json:"version"`
+	// the root template the template was cloned from
+	SourceTemplateID *string `json:"sourceTemplateID,omitempty"`
+	// the version of the root template the template was cloned from
+	SourceTemplateVersion *int64 `json:"sourceTemplateVersion,omitempty"`
 }
 
 func (TemplateHistory) IsNode() {}
@@ -11843,6 +12580,62 @@ type TemplateHistoryWhereInput struct {
 	DescriptionNotNil       *bool    `json:"descriptionNotNil,omitempty"`
 	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
 	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+	// version field predicates
+	Version      *int64  `json:"version,omitempty"`
+	VersionNeq   *int64  `json:"versionNEQ,omitempty"`
+	VersionIn    []int64 `json:"versionIn,omitempty"`
+	VersionNotIn []int64 `json:"versionNotIn,omitempty"`
+	VersionGt    *int64  `json:"versionGT,omitempty"`
+	VersionGte   *int64  `json:"versionGTE,omitempty"`
+	VersionLt    *int64  `json:"versionLT,omitempty"`
+	VersionLte   *int64  `json:"versionLTE,omitempty"`
+	// source_template_id field predicates
+	SourceTemplateID             *string  `json:"sourceTemplateID,omitempty"`
+	SourceTemplateIDNeq          *string  `json:"sourceTemplateIDNEQ,omitempty"`
+	SourceTemplateIDIn           []string `json:"sourceTemplateIDIn,omitempty"`
+	SourceTemplateIDNotIn        []string `json:"sourceTemplateIDNotIn,omitempty"`
+	SourceTemplateIDGt           *string  `json:"sourceTemplateIDGT,omitempty"`
+	SourceTemplateIDGte          *string  `json:"sourceTemplateIDGTE,omitempty"`
+	SourceTemplateIDLt           *string  `json:"sourceTemplateIDLT,omitempty"`
+	SourceTemplateIDLte          *string  `json:"sourceTemplateIDLTE,omitempty"`
+	SourceTemplateIDContains     *string  `json:"sourceTemplateIDContains,omitempty"`
+	SourceTemplateIDHasPrefix    *string  `json:"sourceTemplateIDHasPrefix,omitempty"`
+	SourceTemplateIDHasSuffix    *string  `json:"sourceTemplateIDHasSuffix,omitempty"`
+	SourceTemplateIDIsNil        *bool    `json:"sourceTemplateIDIsNil,omitempty"`
+	SourceTemplateIDNotNil       *bool    `json:"sourceTemplateIDNotNil,omitempty"`
+	SourceTemplateIDEqualFold    *string  `json:"sourceTemplateIDEqualFold,omitempty"`
+	SourceTemplateIDContainsFold *string  `json:"sourceTemplateIDContainsFold,omitempty"`
+	// source_template_version field predicates
+	SourceTemplateVersion       *int64  `json:"sourceTemplateVersion,omitempty"`
+	SourceTemplateVersionNeq    *int64  `json:"sourceTemplateVersionNEQ,omitempty"`
+	SourceTemplateVersionIn     []int64 `json:"sourceTemplateVersionIn,omitempty"`
+	SourceTemplateVersionNotIn  []int64 `json:"sourceTemplateVersionNotIn,omitempty"`
+	SourceTemplateVersionGt     *int64  `json:"sourceTemplateVersionGT,omitempty"`
+	SourceTemplateVersionGte    *int64  `json:"sourceTemplateVersionGTE,omitempty"`
+	SourceTemplateVersionLt     *int64  `json:"sourceTemplateVersionLT,omitempty"`
+	SourceTemplateVersionLte    *int64  `json:"sourceTemplateVersionLTE,omitempty"`
+	SourceTemplateVersionIsNil  *bool   `json:"sourceTemplateVersionIsNil,omitempty"`
+	SourceTemplateVersionNotNil *bool   `json:"sourceTemplateVersionNotNil,omitempty"`
+}
+
+// A document that could not be migrated to the template version
+type TemplateMigrationFailure struct {
+	// ID of the document
+	DocumentDataID string `json:"documentDataID"`
+	// fields of the document that do not conform to the schema of the version
+	Errors []*TemplateFieldError `json:"errors"`
+}
+
+// Return response for migrateTemplateDocuments mutation
+type TemplateMigrationPayload struct {
+	// ID of the template
+	TemplateID string `json:"templateID"`
+	// version the documents were migrated to
+	Version int64 `json:"version"`
+	// IDs of the migrated documents
+	Migrated []string `json:"migrated"`
+	// documents that do not conform to the version and were not migrated
+	Failed []*TemplateMigrationFailure `json:"failed"`
 }
 
 // Ordering options for Template connections
@@ -11859,12 +12652,88 @@ type TemplateUpdatePayload struct {
 	Template *Template `json:"template"`
 }
 
-// TemplateWhereInput is used for filtering Template objects.
+type TemplateVersion struct {
+	ID        string     `json:"id"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	DeletedBy *string    `json:"deletedBy,omitempty"`
+	// The organization id that owns the object
+	OwnerID *string `json:"ownerID,omitempty"`
+	// the template the version belongs to
+	TemplateID string `json:"templateID"`
+	// the version number of the template, starting at 1
+	Version int64 `json:"version"`
+	// the jsonschema object of the template at the version
+	Jsonconfig json.RawMessage `json:"jsonconfig"`
+	// the uischema of the template at the version
+	Uischema json.RawMessage `json:"uischema,omitempty"`
+	Owner    *Organization   `json:"owner,omitempty"`
+	Template *Template       `json:"template"`
+}
+
+func (TemplateVersion) IsNode() {}
+
+// A change to a value of the template between two versions
+type TemplateVersionChange struct {
+	// JSON pointer to the value that changed
+	Path string `json:"path"`
+	// the kind of change
+	Operation TemplateVersionChangeOperation `json:"operation"`
+	// the JSON encoded value in the version compared from, empty when the value was added
+	From *string `json:"from,omitempty"`
+	// the JSON encoded value in the version compared to, empty when the value was removed
+	To *string `json:"to,omitempty"`
+}
+
+// A connection to a list of items.
+type TemplateVersionConnection struct {
+	// A list of edges.
+	Edges []*TemplateVersionEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for templateVersionDiff query
+type TemplateVersionDiff struct {
+	// ID of the template
+	TemplateID string `json:"templateID"`
+	// version compared from
+	From int64 `json:"from"`
+	// version compared to
+	To int64 `json:"to"`
+	// changes to the jsonconfig of the template
+	Jsonconfig []*TemplateVersionChange `json:"jsonconfig"`
+	// changes to the uischema of the template
+	Uischema []*TemplateVersionChange `json:"uischema"`
+}
+
+// An edge in a connection.
+type TemplateVersionEdge struct {
+	// The item at the end of the edge.
+	Node *TemplateVersion `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for TemplateVersion connections
+type TemplateVersionOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order TemplateVersions.
+	Field TemplateVersionOrderField `json:"field"`
+}
+
+// TemplateVersionWhereInput is used for filtering TemplateVersion objects.
 // Input was generated by ent.
-type TemplateWhereInput struct {
-	Not *TemplateWhereInput   `json:"not,omitempty"`
-	And []*TemplateWhereInput `json:"and,omitempty"`
-	Or  []*TemplateWhereInput `json:"or,omitempty"`
+type TemplateVersionWhereInput struct {
+	Not *TemplateVersionWhereInput   `json:"not,omitempty"`
+	And []*TemplateVersionWhereInput `json:"and,omitempty"`
+	Or  []*TemplateVersionWhereInput `json:"or,omitempty"`
 	// id field predicates
 	ID             *string  `json:"id,omitempty"`
 	IDNeq          *string  `json:"idNEQ,omitempty"`
@@ -11973,47 +12842,237 @@ type TemplateWhereInput struct {
 	OwnerIDNotNil       *bool    `json:"ownerIDNotNil,omitempty"`
 	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
 	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
-	// name field predicates
-	Name             *string  `json:"name,omitempty"`
-	NameNeq          *string  `json:"nameNEQ,omitempty"`
-	NameIn           []string `json:"nameIn,omitempty"`
-	NameNotIn        []string `json:"nameNotIn,omitempty"`
-	NameGt           *string  `json:"nameGT,omitempty"`
-	NameGte          *string  `json:"nameGTE,omitempty"`
-	NameLt           *string  `json:"nameLT,omitempty"`
-	NameLte          *string  `json:"nameLTE,omitempty"`
-	NameContains     *string  `json:"nameContains,omitempty"`
-	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
-	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
-	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
-	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
-	// template_type field predicates
-	TemplateType      *enums.DocumentType  `json:"templateType,omitempty"`
-	TemplateTypeNeq   *enums.DocumentType  `json:"templateTypeNEQ,omitempty"`
-	TemplateTypeIn    []enums.DocumentType `json:"templateTypeIn,omitempty"`
-	TemplateTypeNotIn []enums.DocumentType `json:"templateTypeNotIn,omitempty"`
-	// description field predicates
-	Description             *string  `json:"description,omitempty"`
-	DescriptionNeq          *string  `json:"descriptionNEQ,omitempty"`
-	DescriptionIn           []string `json:"descriptionIn,omitempty"`
-	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
-	DescriptionGt           *string  `json:"descriptionGT,omitempty"`
-	DescriptionGte          *string  `json:"descriptionGTE,omitempty"`
-	DescriptionLt           *string  `json:"descriptionLT,omitempty"`
-	DescriptionLte          *string  `json:"descriptionLTE,omitempty"`
-	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
-	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
-	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
-	DescriptionIsNil        *bool    `json:"descriptionIsNil,omitempty"`
-	DescriptionNotNil       *bool    `json:"descriptionNotNil,omitempty"`
-	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
-	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
-	// owner edge predicates
+	// template_id field predicates
+	TemplateID             *string  `json:"templateID,omitempty"`
+	TemplateIDNeq          *string  `json:"templateIDNEQ,omitempty"`
+	TemplateIDIn           []string `json:"templateIDIn,omitempty"`
+	TemplateIDNotIn        []string `json:"templateIDNotIn,omitempty"`
+	TemplateIDGt           *string  `json:"templateIDGT,omitempty"`
+	TemplateIDGte          *string  `json:"templateIDGTE,omitempty"`
+	TemplateIDLt           *string  `json:"templateIDLT,omitempty"`
+	TemplateIDLte          *string  `json:"templateIDLTE,omitempty"`
+	TemplateIDContains     *string  `json:"templateIDContains,omitempty"`
+	TemplateIDHasPrefix    *string  `json:"templateIDHasPrefix,omitempty"`
+	TemplateIDHasSuffix    *string  `json:"templateIDHasSuffix,omitempty"`
+	TemplateIDEqualFold    *string  `json:"templateIDEqualFold,omitempty"`
+	TemplateIDContainsFold *string  `json:"templateIDContainsFold,omitempty"`
+	// version field predicates
+	Version      *int64  `json:"version,omitempty"`
+	VersionNeq   *int64  `json:"versionNEQ,omitempty"`
+	VersionIn    []int64 `json:"versionIn,omitempty"`
+	VersionNotIn []int64 `json:"versionNotIn,omitempty"`
+	VersionGt    *int64  `json:"versionGT,omitempty"`
+	VersionGte   *int64  `json:"versionGTE,omitempty"`
+	VersionLt    *int64  `json:"versionLT,omitempty"`
+	VersionLte   *int64  `json:"versionLTE,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+	// template edge predicates
+	HasTemplate     *bool                 `json:"hasTemplate,omitempty"`
+	HasTemplateWith []*TemplateWhereInput `json:"hasTemplateWith,omitempty"`
+}
+
+// TemplateWhereInput is used for filtering Template objects.
+// Input was generated by ent.
+type TemplateWhereInput struct {
+	Not *TemplateWhereInput   `json:"not,omitempty"`
+	And []*TemplateWhereInput `json:"and,omitempty"`
+	Or  []*TemplateWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt       *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq    *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt     *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte    *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt     *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte    *time.Time   `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  *bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil *bool        `json:"createdAtNotNil,omitempty"`
+	// updated_at field predicates
+	UpdatedAt       *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq    *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt     *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte    *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt     *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte    *time.Time   `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  *bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil *bool        `json:"updatedAtNotNil,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// deleted_at field predicates
+	DeletedAt       *time.Time   `json:"deletedAt,omitempty"`
+	DeletedAtNeq    *time.Time   `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []*time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []*time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGt     *time.Time   `json:"deletedAtGT,omitempty"`
+	DeletedAtGte    *time.Time   `json:"deletedAtGTE,omitempty"`
+	DeletedAtLt     *time.Time   `json:"deletedAtLT,omitempty"`
+	DeletedAtLte    *time.Time   `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  *bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil *bool        `json:"deletedAtNotNil,omitempty"`
+	// deleted_by field predicates
+	DeletedBy             *string  `json:"deletedBy,omitempty"`
+	DeletedByNeq          *string  `json:"deletedByNEQ,omitempty"`
+	DeletedByIn           []string `json:"deletedByIn,omitempty"`
+	DeletedByNotIn        []string `json:"deletedByNotIn,omitempty"`
+	DeletedByGt           *string  `json:"deletedByGT,omitempty"`
+	DeletedByGte          *string  `json:"deletedByGTE,omitempty"`
+	DeletedByLt           *string  `json:"deletedByLT,omitempty"`
+	DeletedByLte          *string  `json:"deletedByLTE,omitempty"`
+	DeletedByContains     *string  `json:"deletedByContains,omitempty"`
+	DeletedByHasPrefix    *string  `json:"deletedByHasPrefix,omitempty"`
+	DeletedByHasSuffix    *string  `json:"deletedByHasSuffix,omitempty"`
+	DeletedByIsNil        *bool    `json:"deletedByIsNil,omitempty"`
+	DeletedByNotNil       *bool    `json:"deletedByNotNil,omitempty"`
+	DeletedByEqualFold    *string  `json:"deletedByEqualFold,omitempty"`
+	DeletedByContainsFold *string  `json:"deletedByContainsFold,omitempty"`
+	// owner_id field predicates
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNeq          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGt           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGte          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLt           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLte          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDIsNil        *bool    `json:"ownerIDIsNil,omitempty"`
+	OwnerIDNotNil       *bool    `json:"ownerIDNotNil,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
+	// name field predicates
+	Name             *string  `json:"name,omitempty"`
+	NameNeq          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGt           *string  `json:"nameGT,omitempty"`
+	NameGte          *string  `json:"nameGTE,omitempty"`
+	NameLt           *string  `json:"nameLT,omitempty"`
+	NameLte          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// template_type field predicates
+	TemplateType      *enums.DocumentType  `json:"templateType,omitempty"`
+	TemplateTypeNeq   *enums.DocumentType  `json:"templateTypeNEQ,omitempty"`
+	TemplateTypeIn    []enums.DocumentType `json:"templateTypeIn,omitempty"`
+	TemplateTypeNotIn []enums.DocumentType `json:"templateTypeNotIn,omitempty"`
+	// description field predicates
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNeq          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGt           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGte          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLt           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLte          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        *bool    `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       *bool    `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+	// version field predicates
+	Version      *int64  `json:"version,omitempty"`
+	VersionNeq   *int64  `json:"versionNEQ,omitempty"`
+	VersionIn    []int64 `json:"versionIn,omitempty"`
+	VersionNotIn []int64 `json:"versionNotIn,omitempty"`
+	VersionGt    *int64  `json:"versionGT,omitempty"`
+	VersionGte   *int64  `json:"versionGTE,omitempty"`
+	VersionLt    *int64  `json:"versionLT,omitempty"`
+	VersionLte   *int64  `json:"versionLTE,omitempty"`
+	// source_template_id field predicates
+	SourceTemplateID             *string  `json:"sourceTemplateID,omitempty"`
+	SourceTemplateIDNeq          *string  `json:"sourceTemplateIDNEQ,omitempty"`
+	SourceTemplateIDIn           []string `json:"sourceTemplateIDIn,omitempty"`
+	SourceTemplateIDNotIn        []string `json:"sourceTemplateIDNotIn,omitempty"`
+	SourceTemplateIDGt           *string  `json:"sourceTemplateIDGT,omitempty"`
+	SourceTemplateIDGte          *string  `json:"sourceTemplateIDGTE,omitempty"`
+	SourceTemplateIDLt           *string  `json:"sourceTemplateIDLT,omitempty"`
+	SourceTemplateIDLte          *string  `json:"sourceTemplateIDLTE,omitempty"`
+	SourceTemplateIDContains     *string  `json:"sourceTemplateIDContains,omitempty"`
+	SourceTemplateIDHasPrefix    *string  `json:"sourceTemplateIDHasPrefix,omitempty"`
+	SourceTemplateIDHasSuffix    *string  `json:"sourceTemplateIDHasSuffix,omitempty"`
+	SourceTemplateIDIsNil        *bool    `json:"sourceTemplateIDIsNil,omitempty"`
+	SourceTemplateIDNotNil       *bool    `json:"sourceTemplateIDNotNil,omitempty"`
+	SourceTemplateIDEqualFold    *string  `json:"sourceTemplateIDEqualFold,omitempty"`
+	SourceTemplateIDContainsFold *string  `json:"sourceTemplateIDContainsFold,omitempty"`
+	// source_template_version field predicates
+	SourceTemplateVersion       *int64  `json:"sourceTemplateVersion,omitempty"`
+	SourceTemplateVersionNeq    *int64  `json:"sourceTemplateVersionNEQ,omitempty"`
+	SourceTemplateVersionIn     []int64 `json:"sourceTemplateVersionIn,omitempty"`
+	SourceTemplateVersionNotIn  []int64 `json:"sourceTemplateVersionNotIn,omitempty"`
+	SourceTemplateVersionGt     *int64  `json:"sourceTemplateVersionGT,omitempty"`
+	SourceTemplateVersionGte    *int64  `json:"sourceTemplateVersionGTE,omitempty"`
+	SourceTemplateVersionLt     *int64  `json:"sourceTemplateVersionLT,omitempty"`
+	SourceTemplateVersionLte    *int64  `json:"sourceTemplateVersionLTE,omitempty"`
+	SourceTemplateVersionIsNil  *bool   `json:"sourceTemplateVersionIsNil,omitempty"`
+	SourceTemplateVersionNotNil *bool   `json:"sourceTemplateVersionNotNil,omitempty"`
+	// owner edge predicates
 	HasOwner     *bool                     `json:"hasOwner,omitempty"`
 	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
 	// documents edge predicates
 	HasDocuments     *bool                     `json:"hasDocuments,omitempty"`
 	HasDocumentsWith []*DocumentDataWhereInput `json:"hasDocumentsWith,omitempty"`
+	// versions edge predicates
+	HasVersions     *bool                        `json:"hasVersions,omitempty"`
+	HasVersionsWith []*TemplateVersionWhereInput `json:"hasVersionsWith,omitempty"`
+	// source_template edge predicates
+	HasSourceTemplate     *bool                 `json:"hasSourceTemplate,omitempty"`
+	HasSourceTemplateWith []*TemplateWhereInput `json:"hasSourceTemplateWith,omitempty"`
+	// clones edge predicates
+	HasClones     *bool                 `json:"hasClones,omitempty"`
+	HasClonesWith []*TemplateWhereInput `json:"hasClonesWith,omitempty"`
 }
 
 // UpdateAPITokenInput is used for update APIToken object.
@@ -12507,9 +13566,12 @@ type UpdateOauthProviderInput struct {
 	// the auth style, 0: auto detect 1: third party log in 2: log in with username and password
 	AuthStyle *string `json:"authStyle,omitempty"`
 	// the URL to request user information by token
-	InfoURL    *string `json:"infoURL,omitempty"`
-	OwnerID    *string `json:"ownerID,omitempty"`
-	ClearOwner *bool   `json:"clearOwner,omitempty"`
+	InfoURL *string `json:"infoURL,omitempty"`
+	// the OIDC issuer URL, when set the provider endpoints are discovered and ID tokens are verified
+	Issuer      *string `json:"issuer,omitempty"`
+	ClearIssuer *bool   `json:"clearIssuer,omitempty"`
+	OwnerID     *string `json:"ownerID,omitempty"`
+	ClearOwner  *bool   `json:"clearOwner,omitempty"`
 }
 
 // UpdateOhAuthTooTokenInput is used for update OhAuthTooToken object.
@@ -12670,10 +13732,23 @@ type UpdateOrganizationSettingInput struct {
 	TaxIdentifier      *string `json:"taxIdentifier,omitempty"`
 	ClearTaxIdentifier *bool   `json:"clearTaxIdentifier,omitempty"`
 	// geographical location of the organization
-	GeoLocation       *enums.Region `json:"geoLocation,omitempty"`
-	ClearGeoLocation  *bool         `json:"clearGeoLocation,omitempty"`
-	OrganizationID    *string       `json:"organizationID,omitempty"`
-	ClearOrganization *bool         `json:"clearOrganization,omitempty"`
+	GeoLocation      *enums.Region `json:"geoLocation,omitempty"`
+	ClearGeoLocation *bool         `json:"clearGeoLocation,omitempty"`
+	// members with an email address in one of the organization domains must login with the organization SSO provider
+	SsoEnforced *bool `json:"ssoEnforced,omitempty"`
+	// URL of the SAML metadata of the organization identity provider
+	SamlMetadataURL      *string `json:"samlMetadataURL,omitempty"`
+	ClearSamlMetadataURL *bool   `json:"clearSamlMetadataURL,omitempty"`
+	// SAML metadata XML of the organization identity provider, used instead of the metadata URL when set
+	SamlMetadata      *string `json:"samlMetadata,omitempty"`
+	ClearSamlMetadata *bool   `json:"clearSamlMetadata,omitempty"`
+	// mapping of the user fields (email, first_name, last_name, display_name) to the SAML assertion attribute names
+	SamlAttributeMapping      map[string]interface{} `json:"samlAttributeMapping,omitempty"`
+	ClearSamlAttributeMapping *bool                  `json:"clearSamlAttributeMapping,omitempty"`
+	// the policy for users with an email address in a verified domain, whether they are added as members, offered to join, or must be invited
+	JoinPolicy        *enums.OrgJoinPolicy `json:"joinPolicy,omitempty"`
+	OrganizationID    *string              `json:"organizationID,omitempty"`
+	ClearOrganization *bool                `json:"clearOrganization,omitempty"`
 }
 
 // UpdatePersonalAccessTokenInput is used for update PersonalAccessToken object.
@@ -12727,8 +13802,6 @@ type UpdateTFASettingInput struct {
 	Tags       []string `json:"tags,omitempty"`
 	AppendTags []string `json:"appendTags,omitempty"`
 	ClearTags  *bool    `json:"clearTags,omitempty"`
-	// specifies if the TFA device has been verified
-	Verified *bool `json:"verified,omitempty"`
 	// specifies a user may complete authentication by verifying a TOTP code delivered through an authenticator app
 	TotpAllowed      *bool `json:"totpAllowed,omitempty"`
 	ClearTotpAllowed *bool `json:"clearTotpAllowed,omitempty"`
@@ -12760,6 +13833,12 @@ type UpdateTemplateInput struct {
 	AddDocumentIDs    []string        `json:"addDocumentIDs,omitempty"`
 	RemoveDocumentIDs []string        `json:"removeDocumentIDs,omitempty"`
 	ClearDocuments    *bool           `json:"clearDocuments,omitempty"`
+	AddVersionIDs     []string        `json:"addVersionIDs,omitempty"`
+	RemoveVersionIDs  []string        `json:"removeVersionIDs,omitempty"`
+	ClearVersions     *bool           `json:"clearVersions,omitempty"`
+	AddCloneIDs       []string        `json:"addCloneIDs,omitempty"`
+	RemoveCloneIDs    []string        `json:"removeCloneIDs,omitempty"`
+	ClearClones       *bool           `json:"clearClones,omitempty"`
 }
 
 // UpdateUserInput is used for update User object.
@@ -12876,6 +13955,14 @@ type UpdateWebhookInput struct {
 	DestinationURL *string `json:"destinationURL,omitempty"`
 	// indicates if the webhook is active and enabled
 	Enabled *bool `json:"enabled,omitempty"`
+	// the event types the webhook is subscribed to, all events are sent when empty
+	EventTypes       []string `json:"eventTypes,omitempty"`
+	AppendEventTypes []string `json:"appendEventTypes,omitempty"`
+	ClearEventTypes  *bool    `json:"clearEventTypes,omitempty"`
+	// the object types the webhook receives events for, all object types are sent when empty
+	ObjectTypes       []string `json:"objectTypes,omitempty"`
+	AppendObjectTypes []string `json:"appendObjectTypes,omitempty"`
+	ClearObjectTypes  *bool    `json:"clearObjectTypes,omitempty"`
 	// the number of failures
 	Failures      *int64 `json:"failures,omitempty"`
 	ClearFailures *bool  `json:"clearFailures,omitempty"`
@@ -13321,6 +14408,24 @@ type UserSearchResult struct {
 
 func (UserSearchResult) IsGlobalSearchResult() {}
 
+// A session of a user, describing the device the user logged in from
+type UserSession struct {
+	// ID of the session
+	ID string `json:"id"`
+	// ID of the user the session belongs to
+	UserID string `json:"userID"`
+	// User agent of the device that created the session
+	UserAgent *string `json:"userAgent,omitempty"`
+	// IP address of the device that created the session
+	IPAddress *string `json:"ipAddress,omitempty"`
+	// Time the session was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// Last time the session was used
+	LastActivity *time.Time `json:"lastActivity,omitempty"`
+	// Whether the session is the one used for the request
+	Current bool `json:"current"`
+}
+
 type UserSetting struct {
 	ID        string     `json:"id"`
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
@@ -13334,6 +14439,10 @@ type UserSetting struct {
 	UserID    *string    `json:"userID,omitempty"`
 	// user account is locked if unconfirmed or explicitly locked
 	Locked bool `json:"locked"`
+	// the time the lock of the user account expires, a locked account without an expiration must be unlocked by an admin
+	LockedUntil *time.Time `json:"lockedUntil,omitempty"`
+	// the number of consecutive failed login attempts, the account is locked when the limit is reached
+	FailedLoginAttempts int64 `json:"failedLoginAttempts"`
 	// The time notifications regarding the user were silenced
 	SilencedAt *time.Time `json:"silencedAt,omitempty"`
 	// The time the user was suspended
@@ -13399,6 +14508,10 @@ type UserSettingHistory struct {
 	UserID    *string    `json:"userID,omitempty"`
 	// user account is locked if unconfirmed or explicitly locked
 	Locked bool `json:"locked"`
+	// the time the lock of the user account expires, a locked account without an expiration must be unlocked by an admin
+	LockedUntil *time.Time `json:"lockedUntil,omitempty"`
+	// the number of consecutive failed login attempts, the account is locked when the limit is reached
+	FailedLoginAttempts int64 `json:"failedLoginAttempts"`
 	// The time notifications regarding the user were silenced
 	SilencedAt *time.Time `json:"silencedAt,omitempty"`
 	// The time the user was suspended
@@ -13580,6 +14693,26 @@ type UserSettingHistoryWhereInput struct {
 	// locked field predicates
 	Locked    *bool `json:"locked,omitempty"`
 	LockedNeq *bool `json:"lockedNEQ,omitempty"`
+	// locked_until field predicates
+	LockedUntil       *time.Time   `json:"lockedUntil,omitempty"`
+	LockedUntilNeq    *time.Time   `json:"lockedUntilNEQ,omitempty"`
+	LockedUntilIn     []*time.Time `json:"lockedUntilIn,omitempty"`
+	LockedUntilNotIn  []*time.Time `json:"lockedUntilNotIn,omitempty"`
+	LockedUntilGt     *time.Time   `json:"lockedUntilGT,omitempty"`
+	LockedUntilGte    *time.Time   `json:"lockedUntilGTE,omitempty"`
+	LockedUntilLt     *time.Time   `json:"lockedUntilLT,omitempty"`
+	LockedUntilLte    *time.Time   `json:"lockedUntilLTE,omitempty"`
+	LockedUntilIsNil  *bool        `json:"lockedUntilIsNil,omitempty"`
+	LockedUntilNotNil *bool        `json:"lockedUntilNotNil,omitempty"`
+	// failed_login_attempts field predicates
+	FailedLoginAttempts      *int64  `json:"failedLoginAttempts,omitempty"`
+	FailedLoginAttemptsNeq   *int64  `json:"failedLoginAttemptsNEQ,omitempty"`
+	FailedLoginAttemptsIn    []int64 `json:"failedLoginAttemptsIn,omitempty"`
+	FailedLoginAttemptsNotIn []int64 `json:"failedLoginAttemptsNotIn,omitempty"`
+	FailedLoginAttemptsGt    *int64  `json:"failedLoginAttemptsGT,omitempty"`
+	FailedLoginAttemptsGte   *int64  `json:"failedLoginAttemptsGTE,omitempty"`
+	FailedLoginAttemptsLt    *int64  `json:"failedLoginAttemptsLT,omitempty"`
+	FailedLoginAttemptsLte   *int64  `json:"failedLoginAttemptsLTE,omitempty"`
 	// silenced_at field predicates
 	SilencedAt       *time.Time   `json:"silencedAt,omitempty"`
 	SilencedAtNeq    *time.Time   `json:"silencedAtNEQ,omitempty"`
@@ -13745,6 +14878,26 @@ type UserSettingWhereInput struct {
 	// locked field predicates
 	Locked    *bool `json:"locked,omitempty"`
 	LockedNeq *bool `json:"lockedNEQ,omitempty"`
+	// locked_until field predicates
+	LockedUntil       *time.Time   `json:"lockedUntil,omitempty"`
+	LockedUntilNeq    *time.Time   `json:"lockedUntilNEQ,omitempty"`
+	LockedUntilIn     []*time.Time `json:"lockedUntilIn,omitempty"`
+	LockedUntilNotIn  []*time.Time `json:"lockedUntilNotIn,omitempty"`
+	LockedUntilGt     *time.Time   `json:"lockedUntilGT,omitempty"`
+	LockedUntilGte    *time.Time   `json:"lockedUntilGTE,omitempty"`
+	LockedUntilLt     *time.Time   `json:"lockedUntilLT,omitempty"`
+	LockedUntilLte    *time.Time   `json:"lockedUntilLTE,omitempty"`
+	LockedUntilIsNil  *bool        `json:"lockedUntilIsNil,omitempty"`
+	LockedUntilNotNil *bool        `json:"lockedUntilNotNil,omitempty"`
+	// failed_login_attempts field predicates
+	FailedLoginAttempts      *int64  `json:"failedLoginAttempts,omitempty"`
+	FailedLoginAttemptsNeq   *int64  `json:"failedLoginAttemptsNEQ,omitempty"`
+	FailedLoginAttemptsIn    []int64 `json:"failedLoginAttemptsIn,omitempty"`
+	FailedLoginAttemptsNotIn []int64 `json:"failedLoginAttemptsNotIn,omitempty"`
+	FailedLoginAttemptsGt    *int64  `json:"failedLoginAttemptsGT,omitempty"`
+	FailedLoginAttemptsGte   *int64  `json:"failedLoginAttemptsGTE,omitempty"`
+	FailedLoginAttemptsLt    *int64  `json:"failedLoginAttemptsLT,omitempty"`
+	FailedLoginAttemptsLte   *int64  `json:"failedLoginAttemptsLTE,omitempty"`
 	// silenced_at field predicates
 	SilencedAt       *time.Time   `json:"silencedAt,omitempty"`
 	SilencedAtNeq    *time.Time   `json:"silencedAtNEQ,omitempty"`
@@ -14088,15 +15241,20 @@ type Webhook struct {
 	DestinationURL string `json:"destinationURL"`
 	// indicates if the webhook is active and enabled
 	Enabled bool `json:"enabled"`
+	// the event types the webhook is subscribed to, all events are sent when empty
+	EventTypes []string `json:"eventTypes,omitempty"`
+	// the object types the webhook receives events for, all object types are sent when empty
+	ObjectTypes []string `json:"objectTypes,omitempty"`
 	// the number of failures
 	Failures *int64 `json:"failures,omitempty"`
 	// the last error message
 	LastError *string `json:"lastError,omitempty"`
 	// the last response
-	LastResponse *string        `json:"lastResponse,omitempty"`
-	Owner        *Organization  `json:"owner,omitempty"`
-	Events       []*Event       `json:"events,omitempty"`
-	Integrations []*Integration `json:"integrations,omitempty"`
+	LastResponse *string                    `json:"lastResponse,omitempty"`
+	Owner        *Organization              `json:"owner,omitempty"`
+	Events       []*Event                   `json:"events,omitempty"`
+	Deliveries   *WebhookDeliveryConnection `json:"deliveries"`
+	Integrations []*Integration             `json:"integrations,omitempty"`
 }
 
 func (Webhook) IsNode() {}
@@ -14107,6 +15265,30 @@ type WebhookBulkCreatePayload struct {
 	Webhooks []*Webhook `json:"webhooks,omitempty"`
 }
 
+// Return response for deleteBulkWebhook mutation
+type WebhookBulkDeletePayload struct {
+	// Deleted webhook IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for updateBulkWebhook mutation
+type WebhookBulkUpdatePayload struct {
+	// Updated webhooks
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
+// Return response for upsertBulkCSVWebhook mutation
+type WebhookBulkUpsertPayload struct {
+	// Created and updated webhooks
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
+	// Errors of the rows that failed
+	Errors []*BulkError `json:"errors,omitempty"`
+}
+
 // A connection to a list of items.
 type WebhookConnection struct {
 	// A list of edges.
@@ -14129,6 +15311,225 @@ type WebhookDeletePayload struct {
 	DeletedID string `json:"deletedID"`
 }
 
+type WebhookDelivery struct {
+	ID        string     `json:"id"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	// The organization id that owns the object
+	OwnerID *string `json:"ownerID,omitempty"`
+	// the webhook the event was delivered to
+	WebhookID string `json:"webhookID"`
+	// the event that was delivered
+	EventID string `json:"eventID"`
+	// the attempt number of the delivery, starting at 1
+	Attempt int64 `json:"attempt"`
+	// indicates if the delivery was a manual redelivery of the event
+	Redelivery bool `json:"redelivery"`
+	// the signed request body sent to the webhook destination
+	RequestBody *string `json:"requestBody,omitempty"`
+	// the http status code returned by the destination, 0 if no response was received
+	ResponseStatus *int64 `json:"responseStatus,omitempty"`
+	// the response body returned by the destination
+	ResponseBody *string `json:"responseBody,omitempty"`
+	// the time in milliseconds the delivery attempt took
+	Latency *int64 `json:"latency,omitempty"`
+	// the error of the delivery attempt, empty if the delivery succeeded
+	Error   *string       `json:"error,omitempty"`
+	Owner   *Organization `json:"owner,omitempty"`
+	Webhook *Webhook      `json:"webhook"`
+	Event   *Event        `json:"event"`
+}
+
+func (WebhookDelivery) IsNode() {}
+
+// A connection to a list of items.
+type WebhookDeliveryConnection struct {
+	// A list of edges.
+	Edges []*WebhookDeliveryEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// An edge in a connection.
+type WebhookDeliveryEdge struct {
+	// The item at the end of the edge.
+	Node *WebhookDelivery `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for WebhookDelivery connections
+type WebhookDeliveryOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order WebhookDeliveries.
+	Field WebhookDeliveryOrderField `json:"field"`
+}
+
+// WebhookDeliveryWhereInput is used for filtering WebhookDelivery objects.
+// Input was generated by ent.
+type WebhookDeliveryWhereInput struct {
+	Not *WebhookDeliveryWhereInput   `json:"not,omitempty"`
+	And []*WebhookDeliveryWhereInput `json:"and,omitempty"`
+	Or  []*WebhookDeliveryWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt       *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq    *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt     *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte    *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt     *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte    *time.Time   `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  *bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil *bool        `json:"createdAtNotNil,omitempty"`
+	// updated_at field predicates
+	UpdatedAt       *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq    *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt     *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte    *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt     *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte    *time.Time   `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  *bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil *bool        `json:"updatedAtNotNil,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// owner_id field predicates
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIDNeq          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGt           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIDGte          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLt           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIDLte          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDIsNil        *bool    `json:"ownerIDIsNil,omitempty"`
+	OwnerIDNotNil       *bool    `json:"ownerIDNotNil,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
+	// webhook_id field predicates
+	WebhookID             *string  `json:"webhookID,omitempty"`
+	WebhookIDNeq          *string  `json:"webhookIDNEQ,omitempty"`
+	WebhookIDIn           []string `json:"webhookIDIn,omitempty"`
+	WebhookIDNotIn        []string `json:"webhookIDNotIn,omitempty"`
+	WebhookIDGt           *string  `json:"webhookIDGT,omitempty"`
+	WebhookIDGte          *string  `json:"webhookIDGTE,omitempty"`
+	WebhookIDLt           *string  `json:"webhookIDLT,omitempty"`
+	WebhookIDLte          *string  `json:"webhookIDLTE,omitempty"`
+	WebhookIDContains     *string  `json:"webhookIDContains,omitempty"`
+	WebhookIDHasPrefix    *string  `json:"webhookIDHasPrefix,omitempty"`
+	WebhookIDHasSuffix    *string  `json:"webhookIDHasSuffix,omitempty"`
+	WebhookIDEqualFold    *string  `json:"webhookIDEqualFold,omitempty"`
+	WebhookIDContainsFold *string  `json:"webhookIDContainsFold,omitempty"`
+	// event_id field predicates
+	EventID             *string  `json:"eventID,omitempty"`
+	EventIDNeq          *string  `json:"eventIDNEQ,omitempty"`
+	EventIDIn           []string `json:"eventIDIn,omitempty"`
+	EventIDNotIn        []string `json:"eventIDNotIn,omitempty"`
+	EventIDGt           *string  `json:"eventIDGT,omitempty"`
+	EventIDGte          *string  `json:"eventIDGTE,omitempty"`
+	EventIDLt           *string  `json:"eventIDLT,omitempty"`
+	EventIDLte          *string  `json:"eventIDLTE,omitempty"`
+	EventIDContains     *string  `json:"eventIDContains,omitempty"`
+	EventIDHasPrefix    *string  `json:"eventIDHasPrefix,omitempty"`
+	EventIDHasSuffix    *string  `json:"eventIDHasSuffix,omitempty"`
+	EventIDEqualFold    *string  `json:"eventIDEqualFold,omitempty"`
+	EventIDContainsFold *string  `json:"eventIDContainsFold,omitempty"`
+	// attempt field predicates
+	Attempt      *int64  `json:"attempt,omitempty"`
+	AttemptNeq   *int64  `json:"attemptNEQ,omitempty"`
+	AttemptIn    []int64 `json:"attemptIn,omitempty"`
+	AttemptNotIn []int64 `json:"attemptNotIn,omitempty"`
+	AttemptGt    *int64  `json:"attemptGT,omitempty"`
+	AttemptGte   *int64  `json:"attemptGTE,omitempty"`
+	AttemptLt    *int64  `json:"attemptLT,omitempty"`
+	AttemptLte   *int64  `json:"attemptLTE,omitempty"`
+	// redelivery field predicates
+	Redelivery    *bool `json:"redelivery,omitempty"`
+	RedeliveryNeq *bool `json:"redeliveryNEQ,omitempty"`
+	// response_status field predicates
+	ResponseStatus       *int64  `json:"responseStatus,omitempty"`
+	ResponseStatusNeq    *int64  `json:"responseStatusNEQ,omitempty"`
+	ResponseStatusIn     []int64 `json:"responseStatusIn,omitempty"`
+	ResponseStatusNotIn  []int64 `json:"responseStatusNotIn,omitempty"`
+	ResponseStatusGt     *int64  `json:"responseStatusGT,omitempty"`
+	ResponseStatusGte    *int64  `json:"responseStatusGTE,omitempty"`
+	ResponseStatusLt     *int64  `json:"responseStatusLT,omitempty"`
+	ResponseStatusLte    *int64  `json:"responseStatusLTE,omitempty"`
+	ResponseStatusIsNil  *bool   `json:"responseStatusIsNil,omitempty"`
+	ResponseStatusNotNil *bool   `json:"responseStatusNotNil,omitempty"`
+	// latency field predicates
+	Latency       *int64  `json:"latency,omitempty"`
+	LatencyNeq    *int64  `json:"latencyNEQ,omitempty"`
+	LatencyIn     []int64 `json:"latencyIn,omitempty"`
+	LatencyNotIn  []int64 `json:"latencyNotIn,omitempty"`
+	LatencyGt     *int64  `json:"latencyGT,omitempty"`
+	LatencyGte    *int64  `json:"latencyGTE,omitempty"`
+	LatencyLt     *int64  `json:"latencyLT,omitempty"`
+	LatencyLte    *int64  `json:"latencyLTE,omitempty"`
+	LatencyIsNil  *bool   `json:"latencyIsNil,omitempty"`
+	LatencyNotNil *bool   `json:"latencyNotNil,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+	// webhook edge predicates
+	HasWebhook     *bool                `json:"hasWebhook,omitempty"`
+	HasWebhookWith []*WebhookWhereInput `json:"hasWebhookWith,omitempty"`
+	// event edge predicates
+	HasEvent     *bool              `json:"hasEvent,omitempty"`
+	HasEventWith []*EventWhereInput `json:"hasEventWith,omitempty"`
+}
+
 // An edge in a connection.
 type WebhookEdge struct {
 	// The item at the end of the edge.
@@ -14160,6 +15561,10 @@ type WebhookHistory struct {
 	DestinationURL string `json:"destinationURL"`
 	// indicates if the webhook is active and enabled
 	Enabled bool `json:"enabled"`
+	// the event types the webhook is subscribed to, all events are sent when empty
+	EventTypes []string `json:"eventTypes,omitempty"`
+	// the object types the webhook receives events for, all object types are sent when empty
+	ObjectTypes []string `json:"objectTypes,omitempty"`
 	// the number of failures
 	Failures *int64 `json:"failures,omitempty"`
 	// the last error message
@@ -14424,6 +15829,12 @@ type WebhookOrder struct {
 	Field WebhookOrderField `json:"field"`
 }
 
+// Return response for redeliverWebhookEvent mutation
+type WebhookRedeliveryPayload struct {
+	// Delivery attempts made for the event
+	WebhookDeliveries []*WebhookDelivery `json:"webhookDeliveries"`
+}
+
 // Return response for updateWebhook mutation
 type WebhookUpdatePayload struct {
 	// Updated webhook
@@ -14624,11 +16035,100 @@ type WebhookWhereInput struct {
 	// events edge predicates
 	HasEvents     *bool              `json:"hasEvents,omitempty"`
 	HasEventsWith []*EventWhereInput `json:"hasEventsWith,omitempty"`
+	// deliveries edge predicates
+	HasDeliveries     *bool                        `json:"hasDeliveries,omitempty"`
+	HasDeliveriesWith []*WebhookDeliveryWhereInput `json:"hasDeliveriesWith,omitempty"`
 	// integrations edge predicates
 	HasIntegrations     *bool                    `json:"hasIntegrations,omitempty"`
 	HasIntegrationsWith []*IntegrationWhereInput `json:"hasIntegrationsWith,omitempty"`
 }
 
+// BulkMode determines how a bulk mutation handles rows that fail
+type BulkMode string
+
+const (
+	// Undo all rows when any row fails, this is the default
+	BulkModeAllOrNothing BulkMode = "ALL_OR_NOTHING"
+	// Keep the rows that succeeded and report the rows that failed
+	BulkModeBestEffort BulkMode = "BEST_EFFORT"
+)
+
+var AllBulkMode = []BulkMode{
+	BulkModeAllOrNothing,
+	BulkModeBestEffort,
+}
+
+func (e BulkMode) IsValid() bool {
+	switch e {
+	case BulkModeAllOrNothing, BulkModeBestEffort:
+		return true
+	}
+	return false
+}
+
+func (e BulkMode) String() string {
+	return string(e)
+}
+
+func (e *BulkMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BulkMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BulkMode", str)
+	}
+	return nil
+}
+
+func (e BulkMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// The output format of a rendered document
+type DocumentRenderFormat string
+
+const (
+	DocumentRenderFormatPDF  DocumentRenderFormat = "PDF"
+	DocumentRenderFormatHTML DocumentRenderFormat = "HTML"
+)
+
+var AllDocumentRenderFormat = []DocumentRenderFormat{
+	DocumentRenderFormatPDF,
+	DocumentRenderFormatHTML,
+}
+
+func (e DocumentRenderFormat) IsValid() bool {
+	switch e {
+	case DocumentRenderFormatPDF, DocumentRenderFormatHTML:
+		return true
+	}
+	return false
+}
+
+func (e DocumentRenderFormat) String() string {
+	return string(e)
+}
+
+func (e *DocumentRenderFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DocumentRenderFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DocumentRenderFormat", str)
+	}
+	return nil
+}
+
+func (e DocumentRenderFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Properties by which EntityHistory connections can be ordered.
 type EntityHistoryOrderField string
 
@@ -15253,6 +16753,90 @@ func (e TemplateOrderField) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// The operation of a change between two template versions
+type TemplateVersionChangeOperation string
+
+const (
+	TemplateVersionChangeOperationAdded   TemplateVersionChangeOperation = "ADDED"
+	TemplateVersionChangeOperationRemoved TemplateVersionChangeOperation = "REMOVED"
+	TemplateVersionChangeOperationChanged TemplateVersionChangeOperation = "CHANGED"
+)
+
+var AllTemplateVersionChangeOperation = []TemplateVersionChangeOperation{
+	TemplateVersionChangeOperationAdded,
+	TemplateVersionChangeOperationRemoved,
+	TemplateVersionChangeOperationChanged,
+}
+
+func (e TemplateVersionChangeOperation) IsValid() bool {
+	switch e {
+	case TemplateVersionChangeOperationAdded, TemplateVersionChangeOperationRemoved, TemplateVersionChangeOperationChanged:
+		return true
+	}
+	return false
+}
+
+func (e TemplateVersionChangeOperation) String() string {
+	return string(e)
+}
+
+func (e *TemplateVersionChangeOperation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TemplateVersionChangeOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TemplateVersionChangeOperation", str)
+	}
+	return nil
+}
+
+func (e TemplateVersionChangeOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Properties by which TemplateVersion connections can be ordered.
+type TemplateVersionOrderField string
+
+const (
+	TemplateVersionOrderFieldVersion TemplateVersionOrderField = "version"
+)
+
+var AllTemplateVersionOrderField = []TemplateVersionOrderField{
+	TemplateVersionOrderFieldVersion,
+}
+
+func (e TemplateVersionOrderField) IsValid() bool {
+	switch e {
+	case TemplateVersionOrderFieldVersion:
+		return true
+	}
+	return false
+}
+
+func (e TemplateVersionOrderField) String() string {
+	return string(e)
+}
+
+func (e *TemplateVersionOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TemplateVersionOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TemplateVersionOrderField", str)
+	}
+	return nil
+}
+
+func (e TemplateVersionOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Properties by which UserHistory connections can be ordered.
 type UserHistoryOrderField string
 
@@ -15341,6 +16925,46 @@ func (e UserOrderField) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Properties by which WebhookDelivery connections can be ordered.
+type WebhookDeliveryOrderField string
+
+const (
+	WebhookDeliveryOrderFieldAttempt WebhookDeliveryOrderField = "attempt"
+)
+
+var AllWebhookDeliveryOrderField = []WebhookDeliveryOrderField{
+	WebhookDeliveryOrderFieldAttempt,
+}
+
+func (e WebhookDeliveryOrderField) IsValid() bool {
+	switch e {
+	case WebhookDeliveryOrderFieldAttempt:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryOrderField) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryOrderField", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Properties by which WebhookHistory connections can be ordered.
 type WebhookHistoryOrderField string
 
@@ -13,8 +13,12 @@ type DatumRestClient interface {
 	Register(context.Context, *models.RegisterRequest) (*models.RegisterReply, error)
 	// Login to the Datum API
 	Login(context.Context, *models.LoginRequest) (*models.LoginReply, error)
+	// LoginTFA completes a login with a second factor when two factor authentication is enabled
+	LoginTFA(context.Context, *models.TFALoginRequest) (*models.LoginReply, error)
 	// Refresh a user's access token
 	Refresh(context.Context, *models.RefreshRequest) (*models.RefreshReply, error)
+	// Logout of the Datum API, revoking the session and refresh token
+	Logout(context.Context, *models.LogoutRequest) (*models.LogoutReply, error)
 	// Switch the current organization context
 	Switch(context.Context, *models.SwitchOrganizationRequest) (*models.SwitchOrganizationReply, error)
 	// VerifyEmail verifies the email address of a user
@@ -98,6 +102,27 @@ func (s *APIv1) Login(ctx context.Context, in *models.LoginRequest) (out *models
 	return out, nil
 }
 
+// LoginTFA completes a login with a second factor when two factor authentication is enabled
+func (s *APIv1) LoginTFA(ctx context.Context, in *models.TFALoginRequest) (out *models.LoginReply, err error) {
+	req := s.HTTPSlingClient.NewRequestBuilder(http.MethodPost, "/v1/login/tfa")
+	req.Body(in)
+
+	resp, err := req.Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := resp.ScanJSON(&out); err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, newAuthenticationError(resp.StatusCode(), out.Error)
+	}
+
+	return out, nil
+}
+
 // Refresh a user's access token
 func (s *APIv1) Refresh(ctx context.Context, in *models.RefreshRequest) (out *models.RefreshReply, err error) {
 	req := s.HTTPSlingClient.NewRequestBuilder(http.MethodPost, "/v1/refresh")
@@ -119,6 +144,27 @@ func (s *APIv1) Refresh(ctx context.Context, in *models.RefreshRequest) (out *mo
 	return out, nil
 }
 
+// Logout of the Datum API, revoking the session and refresh token
+func (s *APIv1) Logout(ctx context.Context, in *models.LogoutRequest) (out *models.LogoutReply, err error) {
+	req := s.HTTPSlingClient.NewRequestBuilder(http.MethodPost, "/v1/logout")
+	req.Body(in)
+
+	resp, err := req.Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := resp.ScanJSON(&out); err != nil {
+		return nil, err
+	}
+
+	if !resp.IsSuccess() {
+		return nil, newAuthenticationError(resp.StatusCode(), out.Error)
+	}
+
+	return out, nil
+}
+
 // Switch the current organization context
 func (s *APIv1) Switch(ctx context.Context, in *models.SwitchOrganizationRequest) (out *models.SwitchOrganizationReply, err error) {
 	req := s.HTTPSlingClient.NewRequestBuilder(http.MethodPost, "/v1/switch")
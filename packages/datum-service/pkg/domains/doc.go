@@ -0,0 +1,3 @@
+// Package domains verifies the ownership of the domains of an organization with a DNS TXT record or a file
+// served under the well-known path of the domain
+package domains
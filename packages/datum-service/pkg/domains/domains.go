@@ -0,0 +1,168 @@
+package domains
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/datumforge/datum/pkg/keygen"
+)
+
+const (
+	// RecordPrefix is the label prepended to the domain for the name of the TXT record
+	RecordPrefix = "_datum-verification"
+	// RecordValuePrefix is prepended to the verification token in the TXT record and the well-known file
+	RecordValuePrefix = "datum-verification="
+	// WellKnownPath is the path of the verification file served by the domain
+	WellKnownPath = "/.well-known/datum-verification.txt"
+
+	tokenLength    = 32
+	defaultTimeout = 10 * time.Second
+)
+
+// Method is the method used to verify the ownership of a domain
+type Method string
+
+const (
+	// MethodDNS verifies the domain with a TXT record on the record name of the domain
+	MethodDNS Method = "dns"
+	// MethodHTTP verifies the domain with a file served under the well-known path of the domain
+	MethodHTTP Method = "http"
+)
+
+// Verifier verifies the ownership of domains by looking up the verification token with its resolver
+type Verifier struct {
+	resolver Resolver
+}
+
+// Option configures the Verifier
+type Option func(v *Verifier)
+
+// WithResolver sets the resolver used to look up the verification tokens
+func WithResolver(r Resolver) Option {
+	return func(v *Verifier) {
+		v.resolver = r
+	}
+}
+
+// NewVerifier returns a Verifier using the DNS resolver and HTTP client of the host unless another resolver is set
+func NewVerifier(opts ...Option) *Verifier {
+	v := &Verifier{
+		resolver: NewResolver(defaultTimeout),
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Verify returns nil when the verification token is published for the domain using the method,
+// ErrVerificationFailed is returned when the token cannot be found
+func (v *Verifier) Verify(ctx context.Context, method Method, domain, token string) error {
+	host, err := Normalize(domain)
+	if err != nil {
+		return err
+	}
+
+	expected := RecordValue(token)
+
+	switch method {
+	case MethodDNS:
+		records, err := v.resolver.LookupTXT(ctx, RecordName(host))
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrVerificationFailed, err)
+		}
+
+		if slices.ContainsFunc(records, func(r string) bool {
+			return strings.TrimSpace(r) == expected
+		}) {
+			return nil
+		}
+	case MethodHTTP:
+		body, err := v.resolver.FetchWellKnown(ctx, WellKnownURL(host))
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrVerificationFailed, err)
+		}
+
+		if strings.TrimSpace(string(body)) == expected {
+			return nil
+		}
+	default:
+		return ErrInvalidMethod
+	}
+
+	return ErrVerificationFailed
+}
+
+// Normalize returns the lower case host name of the domain, the domains of an organization
+// can be set with a scheme, port or path which are not part of the verified domain
+func Normalize(domain string) (string, error) {
+	domain = strings.TrimSpace(domain)
+	if !strings.Contains(domain, "://") {
+		domain = "https://" + domain
+	}
+
+	u, err := url.Parse(domain)
+	if err != nil {
+		return "", ErrInvalidDomain
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+
+	// only public domain names can be verified, not ip addresses or single label hosts such as localhost
+	if !strings.Contains(host, ".") || net.ParseIP(host) != nil {
+		return "", ErrInvalidDomain
+	}
+
+	return host, nil
+}
+
+// Contains returns true if the domain is one of the domains after both are normalized
+func Contains(domains []string, domain string) bool {
+	host, err := Normalize(domain)
+	if err != nil {
+		return false
+	}
+
+	return slices.ContainsFunc(domains, func(d string) bool {
+		h, err := Normalize(d)
+
+		return err == nil && h == host
+	})
+}
+
+// EmailDomain returns the lower case domain of the email address, or an empty string if there is none
+func EmailDomain(email string) string {
+	i := strings.LastIndex(email, "@")
+	if i < 0 {
+		return ""
+	}
+
+	return strings.ToLower(email[i+1:])
+}
+
+// NewToken returns a new random verification token
+func NewToken() string {
+	return keygen.AlphaNumeric(tokenLength)
+}
+
+// RecordName returns the name of the TXT record holding the verification token of the domain
+func RecordName(domain string) string {
+	return RecordPrefix + "." + domain
+}
+
+// RecordValue returns the value of the TXT record and the contents of the well-known file for the token
+func RecordValue(token string) string {
+	return RecordValuePrefix + token
+}
+
+// WellKnownURL returns the URL of the verification file of the domain
+func WellKnownURL(domain string) string {
+	return "https://" + domain + WellKnownPath
+}
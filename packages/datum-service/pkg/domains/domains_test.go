@@ -0,0 +1,168 @@
+package domains_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/domains"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		domain   string
+		expected string
+		err      error
+	}{
+		{
+			domain:   "meow.com",
+			expected: "meow.com",
+		},
+		{
+			domain:   "https://Meow.com:8080/path",
+			expected: "meow.com",
+		},
+		{
+			domain:   "mail.meow.com.",
+			expected: "mail.meow.com",
+		},
+		{
+			domain: "localhost",
+			err:    domains.ErrInvalidDomain,
+		},
+		{
+			domain: "127.0.0.1",
+			err:    domains.ErrInvalidDomain,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.domain, func(t *testing.T) {
+			host, err := domains.Normalize(tc.domain)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, host)
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	list := []string{"https://meow.com", "kitty.net"}
+
+	assert.True(t, domains.Contains(list, "MEOW.com"))
+	assert.True(t, domains.Contains(list, "kitty.net"))
+	assert.False(t, domains.Contains(list, "woof.com"))
+	assert.False(t, domains.Contains(list, "localhost"))
+}
+
+func TestEmailDomain(t *testing.T) {
+	assert.Equal(t, "meow.com", domains.EmailDomain("kitty@Meow.com"))
+	assert.Equal(t, "", domains.EmailDomain("kitty"))
+}
+
+func TestVerify(t *testing.T) {
+	token := domains.NewToken()
+
+	resolver := &domains.StaticResolver{
+		TXT: map[string][]string{
+			domains.RecordName("meow.com"):  {"v=spf1 -all", domains.RecordValue(token)},
+			domains.RecordName("kitty.net"): {domains.RecordValue("not-the-token")},
+		},
+		WellKnown: map[string][]byte{
+			domains.WellKnownURL("meow.com"):  []byte(domains.RecordValue(token) + "\n"),
+			domains.WellKnownURL("kitty.net"): []byte("not-the-token"),
+		},
+	}
+
+	v := domains.NewVerifier(domains.WithResolver(resolver))
+
+	testCases := []struct {
+		name   string
+		method domains.Method
+		domain string
+		err    error
+	}{
+		{
+			name:   "dns, happy path",
+			method: domains.MethodDNS,
+			domain: "https://meow.com",
+		},
+		{
+			name:   "http, happy path",
+			method: domains.MethodHTTP,
+			domain: "meow.com",
+		},
+		{
+			name:   "dns, wrong token",
+			method: domains.MethodDNS,
+			domain: "kitty.net",
+			err:    domains.ErrVerificationFailed,
+		},
+		{
+			name:   "http, wrong token",
+			method: domains.MethodHTTP,
+			domain: "kitty.net",
+			err:    domains.ErrVerificationFailed,
+		},
+		{
+			name:   "dns, no record",
+			method: domains.MethodDNS,
+			domain: "woof.com",
+			err:    domains.ErrVerificationFailed,
+		},
+		{
+			name:   "http, no file",
+			method: domains.MethodHTTP,
+			domain: "woof.com",
+			err:    domains.ErrVerificationFailed,
+		},
+		{
+			name:   "invalid method",
+			method: "carrier-pigeon",
+			domain: "meow.com",
+			err:    domains.ErrInvalidMethod,
+		},
+		{
+			name:   "invalid domain",
+			method: domains.MethodDNS,
+			domain: "localhost",
+			err:    domains.ErrInvalidDomain,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := v.Verify(context.Background(), tc.method, tc.domain, token)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestFetchWellKnownPrivateHost(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("token"))
+	}))
+	defer srv.Close()
+
+	resolver := domains.NewResolver(time.Second)
+
+	body, err := resolver.FetchWellKnown(context.Background(), srv.URL+domains.WellKnownPath)
+	require.ErrorIs(t, err, domains.ErrHostNotAllowed)
+	assert.Nil(t, body)
+}
@@ -0,0 +1,22 @@
+package domains
+
+import (
+	"errors"
+)
+
+var (
+	// ErrInvalidDomain is returned when the domain cannot be parsed into a host name
+	ErrInvalidDomain = errors.New("domains: invalid domain")
+
+	// ErrInvalidMethod is returned when the verification method is not supported
+	ErrInvalidMethod = errors.New("domains: invalid verification method")
+
+	// ErrVerificationFailed is returned when the verification token is not found for the domain
+	ErrVerificationFailed = errors.New("domains: verification token not found")
+
+	// ErrHostNotAllowed is returned when the verification file is hosted on a non-public address
+	ErrHostNotAllowed = errors.New("domains: verification host is not a public address")
+
+	// ErrRedirectNotAllowed is returned when fetching the verification file redirects to a non-https URL
+	ErrRedirectNotAllowed = errors.New("domains: verification redirect is not allowed")
+)
@@ -0,0 +1,80 @@
+package domains
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/datumforge/datum/pkg/httpsling"
+)
+
+const (
+	// maxWellKnownSize is the maximum number of bytes read from the well-known file
+	maxWellKnownSize = 1024
+	// maxRedirects is the maximum number of redirects followed when fetching the well-known file
+	maxRedirects = 3
+)
+
+// Resolver looks up the verification tokens published for a domain, it can be replaced to stub the lookups in tests
+type Resolver interface {
+	// LookupTXT returns the TXT records of the name
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	// FetchWellKnown returns the contents of the verification file at the URL
+	FetchWellKnown(ctx context.Context, url string) ([]byte, error)
+}
+
+// netResolver looks up the verification tokens with the DNS resolver and an HTTP client
+type netResolver struct {
+	resolver *net.Resolver
+	client   *http.Client
+}
+
+// NewResolver returns a Resolver using the DNS resolver of the host and an HTTP client with the timeout, the client
+// only connects to public addresses because the domains are provided by users
+func NewResolver(timeout time.Duration) Resolver {
+	return &netResolver{
+		resolver: net.DefaultResolver,
+		client: &http.Client{
+			Timeout:       timeout,
+			Transport:     httpsling.NewPublicOnlyTransport(timeout, ErrHostNotAllowed),
+			CheckRedirect: checkRedirect,
+		},
+	}
+}
+
+// checkRedirect only follows a limited number of redirects to https URLs
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects || req.URL.Scheme != "https" {
+		return ErrRedirectNotAllowed
+	}
+
+	return nil
+}
+
+// LookupTXT returns the TXT records of the name
+func (r *netResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	return r.resolver.LookupTXT(ctx, name)
+}
+
+// FetchWellKnown returns the contents of the verification file at the URL, only a successful response is accepted
+func (r *netResolver) FetchWellKnown(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d fetching %s", resp.StatusCode, url) //nolint:err113
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxWellKnownSize))
+}
@@ -0,0 +1,34 @@
+package domains
+
+import (
+	"context"
+	"fmt"
+)
+
+// StaticResolver is a Resolver returning fixed records, used to stub the lookups in tests
+type StaticResolver struct {
+	// TXT contains the TXT records by record name
+	TXT map[string][]string
+	// WellKnown contains the contents of the verification files by URL
+	WellKnown map[string][]byte
+}
+
+// LookupTXT returns the TXT records of the name
+func (r *StaticResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	records, ok := r.TXT[name]
+	if !ok {
+		return nil, fmt.Errorf("no such host %s", name) //nolint:err113
+	}
+
+	return records, nil
+}
+
+// FetchWellKnown returns the contents of the verification file at the URL
+func (r *StaticResolver) FetchWellKnown(_ context.Context, url string) ([]byte, error) {
+	body, ok := r.WellKnown[url]
+	if !ok {
+		return nil, fmt.Errorf("unexpected status code 404 fetching %s", url) //nolint:err113
+	}
+
+	return body, nil
+}
@@ -17,14 +17,18 @@ var (
 	AuthProviderGitHub AuthProvider = "GITHUB"
 	// Webauthn passkey provider for authentication
 	AuthProviderWebauthn AuthProvider = "WEBAUTHN"
+	// OIDC provider configured by an organization for single sign-on
+	AuthProviderOIDC AuthProvider = "OIDC"
+	// SAML identity provider configured by an organization for single sign-on
+	AuthProviderSAML AuthProvider = "SAML"
 	// AuthProviderInvalid is the default value for the AuthProvider enum
 	AuthProviderInvalid AuthProvider = "INVALID"
 )
 
 // Values returns a slice of strings that represents all the possible values of the AuthProvider enum.
-// Possible default values are "CREDENTIALS", "GOOGLE", "GITHUB", and "WEBAUTHN"
+// Possible default values are "CREDENTIALS", "GOOGLE", "GITHUB", "WEBAUTHN", "OIDC", and "SAML"
 func (AuthProvider) Values() (kinds []string) {
-	for _, s := range []AuthProvider{AuthProviderCredentials, AuthProviderGoogle, AuthProviderGitHub, AuthProviderWebauthn} {
+	for _, s := range []AuthProvider{AuthProviderCredentials, AuthProviderGoogle, AuthProviderGitHub, AuthProviderWebauthn, AuthProviderOIDC, AuthProviderSAML} {
 		kinds = append(kinds, string(s))
 	}
 
@@ -47,6 +51,10 @@ func ToAuthProvider(r string) *AuthProvider {
 		return &AuthProviderGitHub
 	case AuthProviderWebauthn.String():
 		return &AuthProviderWebauthn
+	case AuthProviderOIDC.String():
+		return &AuthProviderOIDC
+	case AuthProviderSAML.String():
+		return &AuthProviderSAML
 	default:
 		return &AuthProviderInvalid
 	}
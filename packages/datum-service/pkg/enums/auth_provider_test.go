@@ -30,6 +30,14 @@ func TestToAuthProvider(t *testing.T) {
 			input:    "webauthn",
 			expected: enums.AuthProviderWebauthn,
 		},
+		{
+			input:    "oidc",
+			expected: enums.AuthProviderOIDC,
+		},
+		{
+			input:    "saml",
+			expected: enums.AuthProviderSAML,
+		},
 		{
 			input:    "UNKNOWN",
 			expected: enums.AuthProviderInvalid,
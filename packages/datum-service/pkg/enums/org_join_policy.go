@@ -0,0 +1,66 @@
+package enums
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+type OrgJoinPolicy string
+
+var (
+	// OrgJoinPolicyInviteOnly is when users can only join the organization by invite
+	OrgJoinPolicyInviteOnly OrgJoinPolicy = "INVITE_ONLY"
+	// OrgJoinPolicyOffer is when users with an email address in a verified domain of the organization are offered to join
+	OrgJoinPolicyOffer OrgJoinPolicy = "OFFER"
+	// OrgJoinPolicyAutoJoin is when users with an email address in a verified domain of the organization are added as members
+	OrgJoinPolicyAutoJoin OrgJoinPolicy = "AUTO_JOIN"
+	// OrgJoinPolicyInvalid is the default value for the OrgJoinPolicy enum
+	OrgJoinPolicyInvalid OrgJoinPolicy = "INVALID"
+)
+
+// Values returns a slice of strings that represents all the possible values of the OrgJoinPolicy enum.
+// Possible default values are "INVITE_ONLY", "OFFER", and "AUTO_JOIN".
+func (OrgJoinPolicy) Values() (kinds []string) {
+	for _, s := range []OrgJoinPolicy{OrgJoinPolicyInviteOnly, OrgJoinPolicyOffer, OrgJoinPolicyAutoJoin} {
+		kinds = append(kinds, string(s))
+	}
+
+	return
+}
+
+// String returns the OrgJoinPolicy as a string
+func (r OrgJoinPolicy) String() string {
+	return string(r)
+}
+
+// ToOrgJoinPolicy returns the organization join policy enum based on string input
+func ToOrgJoinPolicy(r string) *OrgJoinPolicy {
+	switch r := strings.ToUpper(r); r {
+	case OrgJoinPolicyInviteOnly.String():
+		return &OrgJoinPolicyInviteOnly
+	case OrgJoinPolicyOffer.String():
+		return &OrgJoinPolicyOffer
+	case OrgJoinPolicyAutoJoin.String():
+		return &OrgJoinPolicyAutoJoin
+	default:
+		return &OrgJoinPolicyInvalid
+	}
+}
+
+// MarshalGQL implement the Marshaler interface for gqlgen
+func (r OrgJoinPolicy) MarshalGQL(w io.Writer) {
+	_, _ = w.Write([]byte(`"` + r.String() + `"`))
+}
+
+// UnmarshalGQL implement the Unmarshaler interface for gqlgen
+func (r *OrgJoinPolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("wrong type for OrgJoinPolicy, got: %T", v) //nolint:err113
+	}
+
+	*r = OrgJoinPolicy(str)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package enums_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/datumforge/datum/pkg/enums"
+)
+
+func TestToOrgJoinPolicy(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected enums.OrgJoinPolicy
+	}{
+		{
+			input:    "invite_only",
+			expected: enums.OrgJoinPolicyInviteOnly,
+		},
+		{
+			input:    "offer",
+			expected: enums.OrgJoinPolicyOffer,
+		},
+		{
+			input:    "AUTO_JOIN",
+			expected: enums.OrgJoinPolicyAutoJoin,
+		},
+		{
+			input:    "UNKNOWN",
+			expected: enums.OrgJoinPolicyInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Convert %s to Org Join Policy", tc.input), func(t *testing.T) {
+			result := enums.ToOrgJoinPolicy(tc.input)
+			assert.Equal(t, tc.expected, *result)
+		})
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 )
 
-// This file currently contains unused interfaces and types that will be called / integrated in the future
+// The interfaces are implemented by the kafka consumer in pkg/events/kafka/consumer and the in-memory broker in pkg/events/memory
 
 // EventConsumer is the interface for consuming events
 type EventConsumer interface {
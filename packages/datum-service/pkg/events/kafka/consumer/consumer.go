@@ -0,0 +1,228 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/datum/pkg/events"
+	"github.com/datumforge/datum/pkg/events/kafka/config"
+	"github.com/datumforge/datum/pkg/events/soiree"
+)
+
+// retryBackoff is the time to wait before rejoining the consumer group after a consume error
+const retryBackoff = 2 * time.Second
+
+// KafkaConsumer consumes messages from kafka topics as part of a consumer group and dispatches each message into
+// a soiree event pool, so listeners registered on the pool handle events the same way regardless of whether they
+// were emitted in-process or published to kafka
+type KafkaConsumer struct {
+	// Brokers is a list of Kafka brokers
+	Brokers []string
+	// Config is the configuration for the consumer group
+	Config config.ConsumerConfig
+
+	pool         *soiree.EventPool
+	saramaConfig *sarama.Config
+	logger       *zap.SugaredLogger
+	newGroup     func(brokers []string, groupID string, cfg *sarama.Config) (sarama.ConsumerGroup, error)
+
+	mu     sync.Mutex
+	group  sarama.ConsumerGroup
+	cancel context.CancelFunc
+	done   chan struct{}
+	closed bool
+}
+
+// ensure the kafka consumer satisfies the event consumer interface
+var _ events.EventConsumer = (*KafkaConsumer)(nil)
+
+// Option is a function that configures the KafkaConsumer
+type Option func(*KafkaConsumer)
+
+// WithSaramaConfig overrides the default sarama configuration used by the consumer group
+func WithSaramaConfig(cfg *sarama.Config) Option {
+	return func(c *KafkaConsumer) {
+		c.saramaConfig = cfg
+	}
+}
+
+// WithLogger sets the logger used to report consume and listener errors
+func WithLogger(logger *zap.SugaredLogger) Option {
+	return func(c *KafkaConsumer) {
+		c.logger = logger
+	}
+}
+
+// NewKafkaConsumer creates a new KafkaConsumer that dispatches messages from the configured topics into the pool
+func NewKafkaConsumer(brokers []string, cfg config.ConsumerConfig, pool *soiree.EventPool, opts ...Option) (*KafkaConsumer, error) {
+	switch {
+	case len(brokers) == 0:
+		return nil, ErrMissingBrokers
+	case cfg.GroupID == "":
+		return nil, ErrMissingGroupID
+	case len(cfg.Topics) == 0:
+		return nil, ErrMissingTopics
+	case pool == nil:
+		return nil, ErrMissingEventPool
+	}
+
+	c := &KafkaConsumer{
+		Brokers:  brokers,
+		Config:   cfg,
+		pool:     pool,
+		logger:   zap.NewNop().Sugar(),
+		newGroup: sarama.NewConsumerGroup,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.saramaConfig == nil {
+		c.saramaConfig = sarama.NewConfig()
+	}
+
+	// offsets are committed for the group, the initial offset only applies when the group has no committed offset
+	c.saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+	if cfg.OffsetFromNewest {
+		c.saramaConfig.Consumer.Offsets.Initial = sarama.OffsetNewest
+	}
+
+	return c, nil
+}
+
+// Subscribe joins the consumer group and starts consuming the configured topics in the background; consumption
+// stops when the context is canceled or the consumer is closed
+func (c *KafkaConsumer) Subscribe(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrConsumerClosed
+	}
+
+	if c.group != nil {
+		return ErrAlreadySubscribed
+	}
+
+	group, err := c.newGroup(c.Brokers, c.Config.GroupID, c.saramaConfig)
+	if err != nil {
+		return err
+	}
+
+	consumeCtx, cancel := context.WithCancel(ctx)
+
+	c.group = group
+	c.cancel = cancel
+	c.done = make(chan struct{})
+
+	go c.consume(consumeCtx, group, &handler{pool: c.pool, logger: c.logger})
+
+	return nil
+}
+
+// consume runs the consumer group session in a loop, a new session is started after every server-side rebalance
+func (c *KafkaConsumer) consume(ctx context.Context, group sarama.ConsumerGroup, h sarama.ConsumerGroupHandler) {
+	defer close(c.done)
+
+	for {
+		if err := group.Consume(ctx, c.Config.Topics, h); err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return
+			}
+
+			c.logger.Errorw("error consuming from kafka", "error", err, "group", c.Config.GroupID)
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(retryBackoff):
+			}
+		}
+
+		// check if context was canceled, signaling that the consumer should stop
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// Close stops consumption, waits for in-flight messages to finish dispatching (or the context to be done) and leaves
+// the consumer group, committing the offsets of all handled messages
+func (c *KafkaConsumer) Close(ctx context.Context) error {
+	c.mu.Lock()
+
+	if c.closed {
+		c.mu.Unlock()
+
+		return nil
+	}
+
+	c.closed = true
+	group, cancel, done := c.group, c.cancel, c.done
+
+	c.mu.Unlock()
+
+	if group == nil {
+		return nil
+	}
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		c.logger.Warnw("timed out waiting for kafka consumer to stop", "group", c.Config.GroupID)
+	}
+
+	return group.Close()
+}
+
+// handler implements the sarama consumer group handler, dispatching each claimed message into the event pool
+type handler struct {
+	pool   *soiree.EventPool
+	logger *zap.SugaredLogger
+}
+
+// Setup is run at the beginning of a new session, before ConsumeClaim
+func (h *handler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// Cleanup is run at the end of a session, once all ConsumeClaim goroutines have exited
+func (h *handler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// ConsumeClaim dispatches the messages of the claim to the listeners of the topic in the event pool; the message
+// is marked as consumed once the listeners have run, listener errors are logged and do not block the partition
+func (h *handler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	// The `ConsumeClaim` itself is called within a goroutine, messages of a partition are handled in order
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+
+			for _, err := range h.pool.EmitSync(message.Topic, message.Value) {
+				// the message was not handled, leave it unmarked so it is redelivered to the group
+				if errors.Is(err, soiree.ErrEmitterClosed) {
+					return err
+				}
+
+				h.logger.Errorw("error handling kafka message", "error", err, "topic", message.Topic,
+					"partition", message.Partition, "offset", message.Offset)
+			}
+
+			session.MarkMessage(message, "")
+		// Should return when `session.Context()` is done, otherwise the rebalance will time out
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/datumforge/datum/pkg/events/kafka/config"
+	"github.com/datumforge/datum/pkg/events/soiree"
+)
+
+// fakeGroup is a consumer group that hands the messages sent on its channel to the handler in a single claim
+type fakeGroup struct {
+	sarama.ConsumerGroup
+
+	messages chan *sarama.ConsumerMessage
+	session  *fakeSession
+	closed   chan struct{}
+}
+
+func newFakeGroup() *fakeGroup {
+	return &fakeGroup{
+		messages: make(chan *sarama.ConsumerMessage),
+		closed:   make(chan struct{}),
+	}
+}
+
+func (g *fakeGroup) Consume(ctx context.Context, _ []string, h sarama.ConsumerGroupHandler) error {
+	select {
+	case <-g.closed:
+		return sarama.ErrClosedConsumerGroup
+	default:
+	}
+
+	g.session.ctx = ctx
+
+	if err := h.Setup(g.session); err != nil {
+		return err
+	}
+
+	err := h.ConsumeClaim(g.session, &fakeClaim{messages: g.messages})
+
+	return errors.Join(err, h.Cleanup(g.session))
+}
+
+func (g *fakeGroup) Close() error {
+	close(g.closed)
+
+	return nil
+}
+
+// fakeSession records the marked messages
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+
+	ctx    context.Context
+	mu     sync.Mutex
+	marked []int64
+}
+
+func (s *fakeSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.marked = append(s.marked, msg.Offset)
+}
+
+func (s *fakeSession) markedOffsets() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]int64{}, s.marked...)
+}
+
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+func TestNewKafkaConsumer(t *testing.T) {
+	pool := soiree.NewEventPool()
+	cfg := config.ConsumerConfig{GroupID: "group", Topics: []string{"topic"}}
+
+	testCases := []struct {
+		name        string
+		brokers     []string
+		cfg         config.ConsumerConfig
+		pool        *soiree.EventPool
+		expectedErr error
+	}{
+		{
+			name:    "happy path",
+			brokers: []string{"localhost:10000"},
+			cfg:     cfg,
+			pool:    pool,
+		},
+		{
+			name:        "missing brokers",
+			cfg:         cfg,
+			pool:        pool,
+			expectedErr: ErrMissingBrokers,
+		},
+		{
+			name:        "missing group",
+			brokers:     []string{"localhost:10000"},
+			cfg:         config.ConsumerConfig{Topics: []string{"topic"}},
+			pool:        pool,
+			expectedErr: ErrMissingGroupID,
+		},
+		{
+			name:        "missing topics",
+			brokers:     []string{"localhost:10000"},
+			cfg:         config.ConsumerConfig{GroupID: "group"},
+			pool:        pool,
+			expectedErr: ErrMissingTopics,
+		},
+		{
+			name:        "missing pool",
+			brokers:     []string{"localhost:10000"},
+			cfg:         cfg,
+			expectedErr: ErrMissingEventPool,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := NewKafkaConsumer(tc.brokers, tc.cfg, tc.pool)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, sarama.OffsetOldest, c.saramaConfig.Consumer.Offsets.Initial)
+		})
+	}
+}
+
+func TestKafkaConsumerDispatch(t *testing.T) {
+	ctx := context.Background()
+	pool := soiree.NewEventPool()
+
+	received := make(chan string, 3)
+
+	_, err := pool.On("user.created", func(e soiree.Event) error {
+		received <- string(e.Payload().([]byte))
+
+		if string(e.Payload().([]byte)) == "fail" {
+			return errors.New("listener failed")
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	c, err := NewKafkaConsumer([]string{"localhost:10000"},
+		config.ConsumerConfig{GroupID: "group", Topics: []string{"user.created"}}, pool)
+	require.NoError(t, err)
+
+	group := newFakeGroup()
+	group.session = &fakeSession{}
+
+	c.newGroup = func([]string, string, *sarama.Config) (sarama.ConsumerGroup, error) {
+		return group, nil
+	}
+
+	require.NoError(t, c.Subscribe(ctx))
+	assert.ErrorIs(t, c.Subscribe(ctx), ErrAlreadySubscribed)
+
+	for i, value := range []string{"first", "fail", "third"} {
+		group.messages <- &sarama.ConsumerMessage{Topic: "user.created", Value: []byte(value), Offset: int64(i)}
+	}
+
+	for _, expected := range []string{"first", "fail", "third"} {
+		select {
+		case got := <-received:
+			assert.Equal(t, expected, got)
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for message")
+		}
+	}
+
+	closeCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	require.NoError(t, c.Close(closeCtx))
+
+	// listener errors do not block the partition, every message is marked
+	assert.Equal(t, []int64{0, 1, 2}, group.session.markedOffsets())
+
+	// close is idempotent and the consumer cannot be reused
+	require.NoError(t, c.Close(closeCtx))
+	assert.ErrorIs(t, c.Subscribe(ctx), ErrConsumerClosed)
+}
+
+func TestKafkaConsumerClosedPool(t *testing.T) {
+	pool := soiree.NewEventPool()
+	require.NoError(t, pool.Close())
+
+	h := &handler{pool: pool, logger: zap.NewNop().Sugar()}
+
+	messages := make(chan *sarama.ConsumerMessage, 1)
+	messages <- &sarama.ConsumerMessage{Topic: "topic", Value: []byte("payload")}
+
+	session := &fakeSession{ctx: context.Background()}
+
+	// messages are not marked when the pool can no longer handle them
+	err := h.ConsumeClaim(session, &fakeClaim{messages: messages})
+	assert.ErrorIs(t, err, soiree.ErrEmitterClosed)
+	assert.Empty(t, session.markedOffsets())
+}
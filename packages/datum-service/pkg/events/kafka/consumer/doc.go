@@ -0,0 +1,2 @@
+// Package consumer provides a kafka event consumer that dispatches the consumed messages into a soiree event pool
+package consumer
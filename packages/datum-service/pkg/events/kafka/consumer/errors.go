@@ -0,0 +1,18 @@
+package consumer
+
+import "errors"
+
+var (
+	// ErrMissingBrokers is returned when the consumer is created without any kafka brokers
+	ErrMissingBrokers = errors.New("at least one kafka broker is required")
+	// ErrMissingGroupID is returned when the consumer is created without a consumer group
+	ErrMissingGroupID = errors.New("a consumer group id is required")
+	// ErrMissingTopics is returned when the consumer is created without any topics to consume
+	ErrMissingTopics = errors.New("at least one topic is required")
+	// ErrMissingEventPool is returned when the consumer is created without an event pool to dispatch to
+	ErrMissingEventPool = errors.New("an event pool is required")
+	// ErrAlreadySubscribed is returned when subscribe is called on a consumer that is already consuming
+	ErrAlreadySubscribed = errors.New("consumer is already subscribed")
+	// ErrConsumerClosed is returned when subscribe is called on a consumer that has been closed
+	ErrConsumerClosed = errors.New("consumer is closed")
+)
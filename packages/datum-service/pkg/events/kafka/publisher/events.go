@@ -0,0 +1,110 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/IBM/sarama"
+
+	"github.com/datumforge/datum/pkg/events"
+	"github.com/datumforge/datum/pkg/events/kafka/kafkaconfig"
+)
+
+// ErrPublisherNotStarted is returned when publishing before the publisher has been started
+var ErrPublisherNotStarted = errors.New("kafka publisher has not been started")
+
+// KafkaEventPublisher publishes events to kafka using a long lived producer
+type KafkaEventPublisher struct {
+	// Config is the configuration for the Kafka event source
+	Config kafkaconfig.Config
+
+	mu       sync.Mutex
+	producer sarama.SyncProducer
+}
+
+// ensure the kafka event publisher satisfies the event publisher interface
+var _ events.EventPublisher = (*KafkaEventPublisher)(nil)
+
+// NewKafkaEventPublisher creates a new KafkaEventPublisher
+func NewKafkaEventPublisher(cfg kafkaconfig.Config) *KafkaEventPublisher {
+	return &KafkaEventPublisher{
+		Config: cfg,
+	}
+}
+
+// StartPublisher connects the producer to the kafka brokers
+func (kp *KafkaEventPublisher) StartPublisher(_ context.Context) error {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+
+	if kp.producer != nil {
+		return nil
+	}
+
+	config := sarama.NewConfig()
+	config.ClientID = kp.Config.AppName
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Retry.Max = 5
+	config.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(kp.Config.Addresses, config)
+	if err != nil {
+		return err
+	}
+
+	kp.producer = producer
+
+	return nil
+}
+
+// Publish sends the payload to the topic; payloads that are not raw bytes are encoded as json and payloads
+// implementing events.Keyer are keyed so they land on the same partition, in order
+func (kp *KafkaEventPublisher) Publish(_ context.Context, topic string, payload interface{}) error {
+	kp.mu.Lock()
+	producer := kp.producer
+	kp.mu.Unlock()
+
+	if producer == nil {
+		return ErrPublisherNotStarted
+	}
+
+	value, ok := payload.([]byte)
+	if !ok {
+		var err error
+
+		value, err = json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.ByteEncoder(value),
+	}
+
+	if k, ok := payload.(events.Keyer); ok {
+		msg.Key = sarama.StringEncoder(k.Key())
+	}
+
+	_, _, err := producer.SendMessage(msg)
+
+	return err
+}
+
+// Close closes the producer, flushing any buffered messages
+func (kp *KafkaEventPublisher) Close(_ context.Context) error {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+
+	if kp.producer == nil {
+		return nil
+	}
+
+	err := kp.producer.Close()
+	kp.producer = nil
+
+	return err
+}
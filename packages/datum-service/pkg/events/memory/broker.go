@@ -0,0 +1,179 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/datumforge/datum/pkg/events"
+	"github.com/datumforge/datum/pkg/events/soiree"
+)
+
+// Broker is an in-memory stand-in for kafka; published payloads are encoded to bytes as they would be on the wire and
+// appended to a per topic log, and the subscribed topics are dispatched into the event pool in order. The broker
+// tracks the offset of every topic like a consumer group, so messages published before Subscribe are delivered
+// once the broker subscribes
+type Broker struct {
+	pool   *soiree.EventPool
+	topics []string
+
+	mu         sync.Mutex
+	log        map[string][][]byte
+	offsets    map[string]int
+	started    bool
+	subscribed bool
+	closed     bool
+}
+
+// ensure the broker satisfies both event interfaces
+var (
+	_ events.EventPublisher = (*Broker)(nil)
+	_ events.EventConsumer  = (*Broker)(nil)
+)
+
+// NewBroker creates a new in-memory broker that dispatches the given topics into the event pool
+func NewBroker(pool *soiree.EventPool, topics ...string) (*Broker, error) {
+	if pool == nil {
+		return nil, ErrMissingEventPool
+	}
+
+	return &Broker{
+		pool:    pool,
+		topics:  topics,
+		log:     map[string][][]byte{},
+		offsets: map[string]int{},
+	}, nil
+}
+
+// StartPublisher starts the publisher side of the broker
+func (b *Broker) StartPublisher(_ context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return ErrBrokerClosed
+	}
+
+	b.started = true
+
+	return nil
+}
+
+// Publish encodes the payload and appends it to the topic; when the broker is subscribed to the topic the message is
+// dispatched to the listeners before returning and any listener errors are returned
+func (b *Broker) Publish(_ context.Context, topic string, payload interface{}) error {
+	value, err := encode(payload)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+
+	switch {
+	case b.closed:
+		b.mu.Unlock()
+
+		return ErrBrokerClosed
+	case !b.started:
+		b.mu.Unlock()
+
+		return ErrPublisherNotStarted
+	}
+
+	b.log[topic] = append(b.log[topic], value)
+	subscribed := b.subscribed
+
+	b.mu.Unlock()
+
+	if !subscribed || !slices.Contains(b.topics, topic) {
+		return nil
+	}
+
+	return b.dispatch(topic)
+}
+
+// Subscribe starts dispatching the subscribed topics into the event pool, starting with any messages that were
+// published before the broker subscribed
+func (b *Broker) Subscribe(_ context.Context) error {
+	b.mu.Lock()
+
+	switch {
+	case b.closed:
+		b.mu.Unlock()
+
+		return ErrBrokerClosed
+	case b.subscribed:
+		b.mu.Unlock()
+
+		return ErrAlreadySubscribed
+	}
+
+	b.subscribed = true
+
+	b.mu.Unlock()
+
+	var errs []error
+
+	for _, topic := range b.topics {
+		if err := b.dispatch(topic); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Close closes the broker for both publishing and consuming; the event pool is owned by the caller and is not closed
+func (b *Broker) Close(_ context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+
+	return nil
+}
+
+// Messages returns a copy of the encoded messages published to the topic
+func (b *Broker) Messages(topic string) [][]byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return slices.Clone(b.log[topic])
+}
+
+// dispatch emits every message on the topic past the committed offset into the event pool; the offset is committed
+// before the listeners run so a listener that publishes to the same topic does not redeliver the message
+func (b *Broker) dispatch(topic string) error {
+	var errs []error
+
+	for {
+		b.mu.Lock()
+
+		if b.closed || b.offsets[topic] >= len(b.log[topic]) {
+			b.mu.Unlock()
+
+			return errors.Join(errs...)
+		}
+
+		value := b.log[topic][b.offsets[topic]]
+		b.offsets[topic]++
+
+		b.mu.Unlock()
+
+		errs = append(errs, b.pool.EmitSync(topic, value)...)
+	}
+}
+
+// encode returns the payload as bytes, raw bytes are published as is and all other payloads are encoded as json
+func encode(payload interface{}) ([]byte, error) {
+	switch p := payload.(type) {
+	case []byte:
+		return p, nil
+	case string:
+		return []byte(p), nil
+	default:
+		return json.Marshal(p)
+	}
+}
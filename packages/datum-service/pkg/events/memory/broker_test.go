@@ -0,0 +1,122 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/events/soiree"
+)
+
+func TestNewBroker(t *testing.T) {
+	_, err := NewBroker(nil, "topic")
+	assert.ErrorIs(t, err, ErrMissingEventPool)
+
+	b, err := NewBroker(soiree.NewEventPool(), "topic")
+	require.NoError(t, err)
+	assert.NotNil(t, b)
+}
+
+func TestBrokerPublishSubscribe(t *testing.T) {
+	ctx := context.Background()
+	pool := soiree.NewEventPool()
+
+	var (
+		mu       sync.Mutex
+		received []string
+	)
+
+	_, err := pool.On("user.created", func(e soiree.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		received = append(received, string(e.Payload().([]byte)))
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	b, err := NewBroker(pool, "user.created")
+	require.NoError(t, err)
+
+	// publishing before the publisher is started fails
+	assert.ErrorIs(t, b.Publish(ctx, "user.created", "first"), ErrPublisherNotStarted)
+
+	require.NoError(t, b.StartPublisher(ctx))
+
+	// messages published before subscribing are delivered on subscribe
+	require.NoError(t, b.Publish(ctx, "user.created", map[string]string{"id": "1"}))
+	assert.Empty(t, received)
+
+	require.NoError(t, b.Subscribe(ctx))
+	assert.Equal(t, []string{`{"id":"1"}`}, received)
+
+	assert.ErrorIs(t, b.Subscribe(ctx), ErrAlreadySubscribed)
+
+	// messages are delivered once, in order
+	require.NoError(t, b.Publish(ctx, "user.created", []byte("raw")))
+	require.NoError(t, b.Publish(ctx, "user.created", "string"))
+	assert.Equal(t, []string{`{"id":"1"}`, "raw", "string"}, received)
+
+	// topics that are not subscribed are kept but not dispatched
+	require.NoError(t, b.Publish(ctx, "org.created", "ignored"))
+	assert.Len(t, received, 3)
+	assert.Len(t, b.Messages("org.created"), 1)
+
+	require.NoError(t, b.Close(ctx))
+	assert.ErrorIs(t, b.Publish(ctx, "user.created", "closed"), ErrBrokerClosed)
+	assert.ErrorIs(t, b.Subscribe(ctx), ErrBrokerClosed)
+	assert.Len(t, received, 3)
+}
+
+func TestBrokerListenerErrors(t *testing.T) {
+	ctx := context.Background()
+	pool := soiree.NewEventPool()
+
+	errListener := errors.New("listener failed")
+
+	_, err := pool.On("topic", func(e soiree.Event) error {
+		return errListener
+	})
+	require.NoError(t, err)
+
+	b, err := NewBroker(pool, "topic")
+	require.NoError(t, err)
+
+	require.NoError(t, b.StartPublisher(ctx))
+	require.NoError(t, b.Subscribe(ctx))
+
+	assert.ErrorIs(t, b.Publish(ctx, "topic", "payload"), errListener)
+}
+
+func TestBrokerPublishFromListener(t *testing.T) {
+	ctx := context.Background()
+	pool := soiree.NewEventPool()
+
+	b, err := NewBroker(pool, "topic")
+	require.NoError(t, err)
+
+	count := 0
+
+	// a listener publishing to the topic it consumes must not deadlock or redeliver
+	_, err = pool.On("topic", func(e soiree.Event) error {
+		count++
+
+		if string(e.Payload().([]byte)) == "first" {
+			return b.Publish(ctx, "topic", "second")
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, b.StartPublisher(ctx))
+	require.NoError(t, b.Subscribe(ctx))
+	require.NoError(t, b.Publish(ctx, "topic", "first"))
+
+	assert.Equal(t, 2, count)
+}
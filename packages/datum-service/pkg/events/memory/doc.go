@@ -0,0 +1,3 @@
+// Package memory provides an in-memory event broker implementing both the event publisher and consumer interfaces,
+// used in tests and when no external broker is configured
+package memory
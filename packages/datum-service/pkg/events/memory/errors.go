@@ -0,0 +1,14 @@
+package memory
+
+import "errors"
+
+var (
+	// ErrMissingEventPool is returned when the broker is created without an event pool to dispatch to
+	ErrMissingEventPool = errors.New("an event pool is required")
+	// ErrPublisherNotStarted is returned when publishing to a broker that has not been started
+	ErrPublisherNotStarted = errors.New("publisher has not been started")
+	// ErrAlreadySubscribed is returned when subscribe is called on a broker that is already consuming
+	ErrAlreadySubscribed = errors.New("broker is already subscribed")
+	// ErrBrokerClosed is returned when publishing to or subscribing on a closed broker
+	ErrBrokerClosed = errors.New("broker is closed")
+)
@@ -0,0 +1,114 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// Message is the envelope of an event relayed from the transactional outbox to an event publisher
+type Message struct {
+	// ID is the id of the outbox event, consumers can use it to deduplicate redelivered messages
+	ID string `json:"id"`
+	// Topic is the topic the message is published to
+	Topic string `json:"topic"`
+	// AggregateType is the type of the object the event is about
+	AggregateType string `json:"aggregate_type"`
+	// AggregateID is the id of the object the event is about
+	AggregateID string `json:"aggregate_id"`
+	// OrganizationID is the organization the event was produced in
+	OrganizationID string `json:"organization_id,omitempty"`
+	// Payload is the data of the event
+	Payload map[string]interface{} `json:"payload"`
+	// CreatedAt is the time the event was written to the outbox
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Keyer is implemented by payloads that must be published in order with other payloads sharing the same key
+type Keyer interface {
+	Key() string
+}
+
+// Key returns the aggregate id so messages about the same object are partitioned together
+func (m *Message) Key() string {
+	return m.AggregateID
+}
+
+// PendingPublisher is implemented by publishers that track which of their publishers accepted an event, so a retried
+// event is only published to the publishers that have not accepted it yet
+type PendingPublisher interface {
+	EventPublisher
+	// PublishPending publishes the payload to the publishers that are not in published and returns the names of all the
+	// publishers that have accepted the payload
+	PublishPending(ctx context.Context, topic string, payload interface{}, published []string) ([]string, error)
+}
+
+// NamedPublisher is an event publisher with a name, the name identifies the publisher in the publishers that
+// accepted an event
+type NamedPublisher struct {
+	// Name of the publisher, e.g. kafka
+	Name string
+	EventPublisher
+}
+
+// MultiPublisher publishes every event to all of the publishers
+type MultiPublisher []NamedPublisher
+
+// ensure the multi publisher tracks the publishers that accepted an event
+var _ PendingPublisher = MultiPublisher(nil)
+
+// StartPublisher starts all of the publishers
+func (p MultiPublisher) StartPublisher(ctx context.Context) error {
+	for _, pub := range p {
+		if err := pub.StartPublisher(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Publish publishes the payload to all of the publishers, the errors of all failed publishers are returned
+func (p MultiPublisher) Publish(ctx context.Context, topic string, payload interface{}) error {
+	_, err := p.PublishPending(ctx, topic, payload, nil)
+
+	return err
+}
+
+// PublishPending publishes the payload to the publishers that are not in published, the names of the publishers
+// that have accepted the payload are returned with the errors of all failed publishers
+func (p MultiPublisher) PublishPending(ctx context.Context, topic string, payload interface{}, published []string) ([]string, error) {
+	accepted := slices.Clone(published)
+
+	var errs []error
+
+	for _, pub := range p {
+		if slices.Contains(published, pub.Name) {
+			continue
+		}
+
+		if err := pub.Publish(ctx, topic, payload); err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		accepted = append(accepted, pub.Name)
+	}
+
+	return accepted, errors.Join(errs...)
+}
+
+// Close closes all of the publishers
+func (p MultiPublisher) Close(ctx context.Context) error {
+	var errs []error
+
+	for _, pub := range p {
+		if err := pub.Close(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,218 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultChannel    = "datum:changes"
+	defaultBufferSize = 100
+)
+
+// Change is a committed change of an object
+type Change struct {
+	// ID is the id of the change
+	ID string `json:"id"`
+	// EventType is the type of the event of the change, e.g. `organization.created`
+	EventType string `json:"event_type"`
+	// Operation is the operation of the change, create, update or delete
+	Operation string `json:"operation"`
+	// ObjectType is the type of the changed object
+	ObjectType string `json:"object_type"`
+	// ObjectID is the id of the changed object
+	ObjectID string `json:"object_id"`
+	// OrganizationID is the organization the change was made in
+	OrganizationID string `json:"organization_id,omitempty"`
+	// CreatedAt is the time the change was published
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Broker fans out the published changes to the subscribers; without redis the changes are dispatched to the
+// subscribers of the replica, with redis the changes are published to the channel and every replica dispatches
+// the changes it receives from the channel to its subscribers
+type Broker struct {
+	redis      *redis.Client
+	channel    string
+	bufferSize int
+	logger     *zap.SugaredLogger
+
+	mu          sync.RWMutex
+	subscribers map[chan *Change]struct{}
+	ps          *redis.PubSub
+	closed      bool
+}
+
+// Option is a function that configures the broker
+type Option func(*Broker)
+
+// WithConfig sets the channel and buffer size of the broker from the configuration
+func WithConfig(c Config) Option {
+	return func(b *Broker) {
+		if c.Channel != "" {
+			b.channel = c.Channel
+		}
+
+		if c.BufferSize > 0 {
+			b.bufferSize = c.BufferSize
+		}
+	}
+}
+
+// WithRedis fans out the changes to all replicas with the redis client
+func WithRedis(rc *redis.Client) Option {
+	return func(b *Broker) {
+		b.redis = rc
+	}
+}
+
+// WithLogger sets the logger of the broker
+func WithLogger(l *zap.SugaredLogger) Option {
+	return func(b *Broker) {
+		b.logger = l
+	}
+}
+
+// NewBroker returns a new broker configured with the options
+func NewBroker(opts ...Option) *Broker {
+	b := &Broker{
+		channel:     defaultChannel,
+		bufferSize:  defaultBufferSize,
+		logger:      zap.NewNop().Sugar(),
+		subscribers: map[chan *Change]struct{}{},
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// Start subscribes to the redis channel and dispatches the received changes until the broker is closed, it is a
+// no-op without redis
+func (b *Broker) Start(ctx context.Context) error {
+	if b.redis == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case b.closed:
+		return ErrBrokerClosed
+	case b.ps != nil:
+		return ErrAlreadyStarted
+	}
+
+	ps := b.redis.Subscribe(ctx, b.channel)
+
+	// wait for the subscription to be confirmed so changes published after start are not missed
+	if _, err := ps.Receive(ctx); err != nil {
+		ps.Close()
+
+		return err
+	}
+
+	b.ps = ps
+
+	go b.receive(ps.Channel())
+
+	return nil
+}
+
+// Publish publishes the change to the subscribers of all replicas
+func (b *Broker) Publish(ctx context.Context, c *Change) error {
+	b.mu.RLock()
+	closed := b.closed
+	b.mu.RUnlock()
+
+	if closed {
+		return ErrBrokerClosed
+	}
+
+	if b.redis == nil {
+		b.dispatch(c)
+
+		return nil
+	}
+
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	return b.redis.Publish(ctx, b.channel, payload).Err()
+}
+
+// Subscribe returns a channel receiving the published changes until the context is done
+func (b *Broker) Subscribe(ctx context.Context) <-chan *Change {
+	ch := make(chan *Change, b.bufferSize)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		b.mu.Unlock()
+
+		close(ch)
+	}()
+
+	return ch
+}
+
+// Close stops receiving from redis and publishing changes, the channels of the subscribers are closed when
+// their context is done
+func (b *Broker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+
+	if b.ps == nil {
+		return nil
+	}
+
+	return b.ps.Close()
+}
+
+// receive dispatches the changes received from the redis channel until the subscription is closed
+func (b *Broker) receive(msgs <-chan *redis.Message) {
+	for msg := range msgs {
+		var c Change
+
+		if err := json.Unmarshal([]byte(msg.Payload), &c); err != nil {
+			b.logger.Errorw("unable to decode change", "channel", msg.Channel, "error", err)
+
+			continue
+		}
+
+		b.dispatch(&c)
+	}
+}
+
+// dispatch sends the change to every subscriber without blocking, the change is dropped for subscribers with
+// a full buffer so a slow subscriber does not hold up the others
+func (b *Broker) dispatch(c *Change) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- c:
+		default:
+			b.logger.Warnw("subscriber is not keeping up, dropping change", "event", c.EventType, "object_id", c.ObjectID)
+		}
+	}
+}
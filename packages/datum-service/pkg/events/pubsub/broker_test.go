@@ -0,0 +1,123 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/events/pubsub"
+)
+
+// receive returns the next change of the subscription or fails the test when none is received in time
+func receive(t *testing.T, ch <-chan *pubsub.Change) *pubsub.Change {
+	t.Helper()
+
+	select {
+	case c, ok := <-ch:
+		require.True(t, ok, "subscription closed")
+
+		return c
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no change received")
+	}
+
+	return nil
+}
+
+func TestBrokerInProcess(t *testing.T) {
+	ctx := context.Background()
+
+	b := pubsub.NewBroker()
+	require.NoError(t, b.Start(ctx))
+
+	subCtx, cancel := context.WithCancel(ctx)
+
+	first := b.Subscribe(subCtx)
+	second := b.Subscribe(ctx)
+
+	change := &pubsub.Change{
+		ID:         "01",
+		EventType:  "organization.created",
+		Operation:  "create",
+		ObjectType: "organization",
+		ObjectID:   "MITB",
+	}
+
+	require.NoError(t, b.Publish(ctx, change))
+
+	// every subscriber receives the change
+	assert.Equal(t, change, receive(t, first))
+	assert.Equal(t, change, receive(t, second))
+
+	// the subscription is closed when its context is done
+	cancel()
+
+	_, ok := <-first
+	assert.False(t, ok)
+
+	// changes are dropped for subscribers that do not keep up
+	slow := pubsub.NewBroker(pubsub.WithConfig(pubsub.Config{BufferSize: 1}))
+	sub := slow.Subscribe(ctx)
+
+	require.NoError(t, slow.Publish(ctx, &pubsub.Change{ID: "01"}))
+	require.NoError(t, slow.Publish(ctx, &pubsub.Change{ID: "02"}))
+
+	assert.Equal(t, "01", receive(t, sub).ID)
+	assert.Empty(t, sub)
+
+	require.NoError(t, b.Close())
+	assert.ErrorIs(t, b.Publish(ctx, change), pubsub.ErrBrokerClosed)
+}
+
+func TestBrokerRedis(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	newClient := func() *redis.Client {
+		return redis.NewClient(&redis.Options{
+			Addr:             mr.Addr(),
+			DisableIndentity: true, // # spellcheck:off
+		})
+	}
+
+	ctx := context.Background()
+
+	// two replicas sharing the same redis
+	replica1 := pubsub.NewBroker(pubsub.WithRedis(newClient()), pubsub.WithConfig(pubsub.Config{Channel: "test:changes"}))
+	replica2 := pubsub.NewBroker(pubsub.WithRedis(newClient()), pubsub.WithConfig(pubsub.Config{Channel: "test:changes"}))
+
+	require.NoError(t, replica1.Start(ctx))
+	require.NoError(t, replica2.Start(ctx))
+
+	assert.ErrorIs(t, replica1.Start(ctx), pubsub.ErrAlreadyStarted)
+
+	sub1 := replica1.Subscribe(ctx)
+	sub2 := replica2.Subscribe(ctx)
+
+	change := &pubsub.Change{
+		ID:             "01",
+		EventType:      "orgmembership.updated",
+		Operation:      "update",
+		ObjectType:     "orgmembership",
+		ObjectID:       "MITB",
+		OrganizationID: "DATUM",
+		CreatedAt:      time.Now().UTC().Truncate(time.Second),
+	}
+
+	// a change published on one replica is received by the subscribers of all replicas, once
+	require.NoError(t, replica1.Publish(ctx, change))
+
+	assert.Equal(t, change, receive(t, sub1))
+	assert.Equal(t, change, receive(t, sub2))
+	assert.Empty(t, sub1)
+
+	require.NoError(t, replica1.Close())
+	require.NoError(t, replica2.Close())
+
+	assert.ErrorIs(t, replica1.Publish(ctx, change), pubsub.ErrBrokerClosed)
+	assert.ErrorIs(t, replica1.Start(ctx), pubsub.ErrBrokerClosed)
+}
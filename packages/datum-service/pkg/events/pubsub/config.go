@@ -0,0 +1,12 @@
+package pubsub
+
+// Config contains the settings of the broker streaming changes to the graph subscriptions
+type Config struct {
+	// Enabled turns on the graph subscriptions
+	Enabled bool `json:"enabled" koanf:"enabled" default:"true"`
+	// Channel is the redis channel the changes are fanned out on to the other replicas when redis is enabled
+	Channel string `json:"channel" koanf:"channel" default:"datum:changes"`
+	// BufferSize is the number of changes buffered for each subscription, changes are dropped for subscriptions
+	// that do not keep up
+	BufferSize int `json:"bufferSize" koanf:"bufferSize" default:"100"`
+}
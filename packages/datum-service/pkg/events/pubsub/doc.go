@@ -0,0 +1,3 @@
+// Package pubsub fans out the committed changes of objects to the graph subscriptions, in-process for a single
+// replica or across all replicas of the service with redis
+package pubsub
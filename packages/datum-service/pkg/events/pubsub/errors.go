@@ -0,0 +1,10 @@
+package pubsub
+
+import "errors"
+
+var (
+	// ErrBrokerClosed is returned when publishing to a closed broker
+	ErrBrokerClosed = errors.New("broker is closed")
+	// ErrAlreadyStarted is returned when start is called on a broker that is already receiving from redis
+	ErrAlreadyStarted = errors.New("broker is already started")
+)
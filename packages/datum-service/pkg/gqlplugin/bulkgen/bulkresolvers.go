@@ -9,6 +9,7 @@ import (
 	"github.com/99designs/gqlgen/codegen/templates"
 	"github.com/99designs/gqlgen/plugin"
 	"github.com/gertd/go-pluralize"
+	"github.com/stoewer/go-strcase"
 )
 
 //go:embed bulk.gotpl
@@ -39,8 +40,26 @@ type Object struct {
 	Name string
 	// PluralName of the object
 	PluralName string
+	// Create is true if the object has a bulk create mutation
+	Create bool
+	// Update is true if the object has a bulk update mutation
+	Update bool
+	// Delete is true if the object has a bulk delete mutation
+	Delete bool
+	// Upsert is true if the object has a csv upsert mutation
+	Upsert bool
+	// Import is true if the object has a csv import mutation
+	Import bool
+	// HasOwner is true if the update input of the object has an owner field
+	HasOwner bool
+	// AppendFields are the fields of the update input that are appended to instead of replaced
+	AppendFields []string
 }
 
+// bulkPrefixes are the prefixes of the bulk mutations, csv create mutations are not included because they reuse
+// the bulk create functions
+var bulkPrefixes = []string{"createBulk", "updateBulk", "deleteBulk", "upsertBulkCSV", "importBulkCSV"}
+
 // GenerateCode generates the bulk resolver code
 func (m *Plugin) GenerateCode(data *codegen.Data) error {
 	if !data.Config.Resolver.IsDefined() {
@@ -57,18 +76,36 @@ func (m *Plugin) generateSingleFile(data codegen.Data) error {
 		Objects: []Object{},
 	}
 
+	// index of the object in the list of objects, an object has a mutation for each bulk operation
+	index := map[string]int{}
+
 	for _, f := range data.Schema.Mutation.Fields {
-		lowerName := strings.ToLower(f.Name)
+		prefix, ok := bulkPrefix(f.Name)
+		if !ok {
+			continue
+		}
 
-		// if the field is a bulk create mutation, add it to the list of objects
-		// we skip csv bulk mutations because they will reuse the same functions
-		if strings.Contains(lowerName, "bulk") && !strings.Contains(lowerName, "csv") {
-			objectName := strings.Replace(f.Name, "createBulk", "", 1)
+		objectName := strings.TrimPrefix(f.Name, prefix)
+
+		i, ok := index[objectName]
+		if !ok {
+			i = len(inputData.Objects)
+			index[objectName] = i
+
+			inputData.Objects = append(inputData.Objects, newObject(data, objectName))
+		}
 
-			inputData.Objects = append(inputData.Objects, Object{
-				Name:       objectName,
-				PluralName: pluralize.NewClient().Plural(objectName),
-			})
+		switch prefix {
+		case "createBulk":
+			inputData.Objects[i].Create = true
+		case "updateBulk":
+			inputData.Objects[i].Update = true
+		case "deleteBulk":
+			inputData.Objects[i].Delete = true
+		case "upsertBulkCSV":
+			inputData.Objects[i].Upsert = true
+		case "importBulkCSV":
+			inputData.Objects[i].Import = true
 		}
 	}
 
@@ -85,3 +122,42 @@ func (m *Plugin) generateSingleFile(data codegen.Data) error {
 		Template: bulkTemplate,
 	})
 }
+
+// bulkPrefix returns the bulk operation prefix of the mutation name
+func bulkPrefix(name string) (string, bool) {
+	// skip the csv create mutations, createBulkCSV also has the createBulk prefix
+	if strings.HasPrefix(name, "createBulkCSV") {
+		return "", false
+	}
+
+	for _, p := range bulkPrefixes {
+		if strings.HasPrefix(name, p) {
+			return p, true
+		}
+	}
+
+	return "", false
+}
+
+// newObject returns the object with the fields of its update input used by the bulk update resolvers
+func newObject(data codegen.Data, name string) Object {
+	obj := Object{
+		Name:       name,
+		PluralName: pluralize.NewClient().Plural(name),
+	}
+
+	input := data.Schema.Types["Update"+name+"Input"]
+	if input == nil {
+		return obj
+	}
+
+	obj.HasOwner = input.Fields.ForName("ownerID") != nil
+
+	for _, f := range input.Fields {
+		if strings.Contains(f.Name, "append") {
+			obj.AppendFields = append(obj.AppendFields, strcase.UpperCamelCase(f.Name))
+		}
+	}
+
+	return obj
+}
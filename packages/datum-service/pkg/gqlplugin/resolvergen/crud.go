@@ -79,6 +79,34 @@ func renderBulkUpload(field *codegen.Field) string {
 	})
 }
 
+// renderBulkUpsert renders the bulk csv upsert template
+func renderBulkUpsert(field *codegen.Field) string {
+	return renderTemplate("upsert.gotpl", &crudResolver{
+		Field: field,
+	})
+}
+
+// renderBulkImport renders the bulk csv import template
+func renderBulkImport(field *codegen.Field) string {
+	return renderTemplate("import.gotpl", &crudResolver{
+		Field: field,
+	})
+}
+
+// renderBulkUpdate renders the bulk update template
+func renderBulkUpdate(field *codegen.Field) string {
+	return renderTemplate("bulkupdate.gotpl", &crudResolver{
+		Field: field,
+	})
+}
+
+// renderBulkDelete renders the bulk delete template
+func renderBulkDelete(field *codegen.Field) string {
+	return renderTemplate("bulkdelete.gotpl", &crudResolver{
+		Field: field,
+	})
+}
+
 // renderBulk renders the bulk template
 func renderBulk(field *codegen.Field) string {
 	return renderTemplate("bulk.gotpl", &crudResolver{
@@ -101,7 +129,7 @@ func renderList(field *codegen.Field) string {
 }
 
 // crudTypes is a list of CRUD operations that are included in the resolver name
-var stripStrings = []string{"Create", "Update", "Delete", "Bulk", "CSV", "Connection", "Payload"}
+var stripStrings = []string{"Create", "Update", "Delete", "Upsert", "Import", "Bulk", "CSV", "Connection", "Payload"}
 
 // getEntityName returns the entity name by stripping the CRUD operation from the resolver name
 func getEntityName(name string) string {
@@ -74,6 +74,16 @@ func TestGetEntityName(t *testing.T) {
 			input:    "BulkCSVOrder",
 			expected: "Order",
 		},
+		{
+			name:     "strip Upsert + CSV + Bulk",
+			input:    "ContactBulkUpsertPayload",
+			expected: "Contact",
+		},
+		{
+			name:     "strip Import + CSV + Bulk",
+			input:    "EntityBulkImportPayload",
+			expected: "Entity",
+		},
 		{
 			name:     "strip Connection",
 			input:    "UserConnection",
@@ -70,8 +70,16 @@ func isQuery(f *codegen.Field) bool {
 // mutationImplementer returns the implementation for the mutation
 func mutationImplementer(f *codegen.Field) string {
 	switch crudType(f) {
+	case "UpsertCSV":
+		return renderBulkUpsert(f)
+	case "ImportCSV":
+		return renderBulkImport(f)
 	case "BulkCSV":
 		return renderBulkUpload(f)
+	case "BulkUpdate":
+		return renderBulkUpdate(f)
+	case "BulkDelete":
+		return renderBulkDelete(f)
 	case "Bulk":
 		return renderBulk(f)
 	case "Create":
@@ -97,8 +105,16 @@ func queryImplementer(f *codegen.Field) string {
 // crudType returns the type of CRUD operation
 func crudType(f *codegen.Field) string {
 	switch {
+	case strings.Contains(f.GoFieldName, "Upsert") && strings.Contains(f.GoFieldName, "CSV"):
+		return "UpsertCSV"
+	case strings.Contains(f.GoFieldName, "Import") && strings.Contains(f.GoFieldName, "CSV"):
+		return "ImportCSV"
 	case strings.Contains(f.GoFieldName, "CSV"):
 		return "BulkCSV"
+	case strings.Contains(f.GoFieldName, "UpdateBulk"):
+		return "BulkUpdate"
+	case strings.Contains(f.GoFieldName, "DeleteBulk"):
+		return "BulkDelete"
 	case strings.Contains(f.GoFieldName, "Bulk"):
 		return "Bulk"
 	case strings.Contains(f.GoFieldName, "Create"):
@@ -0,0 +1,63 @@
+package resolvergen
+
+import (
+	"testing"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudType(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "CreateContact",
+			expected: "Create",
+		},
+		{
+			name:     "CreateBulkContact",
+			expected: "Bulk",
+		},
+		{
+			name:     "CreateBulkCSVContact",
+			expected: "BulkCSV",
+		},
+		{
+			name:     "UpdateBulkContact",
+			expected: "BulkUpdate",
+		},
+		{
+			name:     "DeleteBulkContact",
+			expected: "BulkDelete",
+		},
+		{
+			name:     "UpsertBulkCSVContact",
+			expected: "UpsertCSV",
+		},
+		{
+			name:     "ImportBulkCSVContact",
+			expected: "ImportCSV",
+		},
+		{
+			name:     "UpdateContact",
+			expected: "Update",
+		},
+		{
+			name:     "DeleteContact",
+			expected: "Delete",
+		},
+		{
+			name:     "RenderDocumentData",
+			expected: "unknown",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := crudType(&codegen.Field{GoFieldName: tc.name})
+			assert.Equal(t, tc.expected, res)
+		})
+	}
+}
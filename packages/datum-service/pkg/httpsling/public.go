@@ -0,0 +1,56 @@
+package httpsling
+
+import (
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// sharedAddressSpace is the carrier-grade NAT range, which is not covered by net.IP.IsPrivate
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)} //nolint:mnd
+
+// PublicIP returns true if the address is a global unicast address that is not in a private range
+func PublicIP(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+
+	return ip.IsGlobalUnicast() &&
+		!ip.IsPrivate() &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+// PublicOnlyControl returns a dialer control function that refuses connections to loopback, private, link-local and
+// other non-public addresses with the refused error. The address is checked after it is resolved so redirects and
+// DNS rebinding cannot reach internal hosts
+func PublicOnlyControl(refused error) func(network, address string, c syscall.RawConn) error {
+	return func(_, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+
+		if !PublicIP(net.ParseIP(host)) {
+			return refused
+		}
+
+		return nil
+	}
+}
+
+// NewPublicOnlyTransport returns a transport that only connects to public addresses, used for requests to URLs that
+// are configured by users. Connections to other addresses fail with the refused error
+func NewPublicOnlyTransport(timeout time.Duration, refused error) *http.Transport {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: PublicOnlyControl(refused),
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would be dialed instead of the destination, bypassing the address check
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return transport
+}
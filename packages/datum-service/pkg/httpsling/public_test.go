@@ -0,0 +1,64 @@
+package httpsling
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPublicIP tests the addresses that are considered public
+func TestPublicIP(t *testing.T) {
+	testCases := []struct {
+		ip       string
+		expected bool
+	}{
+		{ip: "93.184.216.34", expected: true},
+		{ip: "2606:2800:220:1:248:1893:25c8:1946", expected: true},
+		{ip: "127.0.0.1"},
+		{ip: "::1"},
+		{ip: "10.0.0.1"},
+		{ip: "172.16.0.1"},
+		{ip: "192.168.1.1"},
+		{ip: "169.254.169.254"},
+		{ip: "fe80::1"},
+		{ip: "fd00::1"},
+		{ip: "100.64.0.1"},
+		{ip: "0.0.0.0"},
+		{ip: "not an ip"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.ip, func(t *testing.T) {
+			assert.Equal(t, tc.expected, PublicIP(net.ParseIP(tc.ip)))
+		})
+	}
+}
+
+// TestNewPublicOnlyTransport tests connections to internal addresses are refused
+func TestNewPublicOnlyTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	errRefused := errors.New("refused")
+
+	client := &http.Client{Transport: NewPublicOnlyTransport(time.Second, errRefused)}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	require.ErrorIs(t, err, errRefused)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/datumforge/datum/internal/ent/generated/apitoken"
 	"github.com/datumforge/datum/internal/ent/generated/personalaccesstoken"
 	"github.com/datumforge/datum/internal/ent/generated/privacy"
+	"github.com/datumforge/datum/internal/ent/generated/usersetting"
 	"github.com/datumforge/datum/pkg/auth"
 	api "github.com/datumforge/datum/pkg/models"
 	"github.com/datumforge/datum/pkg/rout"
@@ -141,10 +142,23 @@ func Reauthenticate(conf *AuthOptions, validator tokens.Validator) func(c echo.C
 		}
 
 		// Check to ensure the refresh token is still valid.
-		if _, err = validator.Verify(refreshToken); err != nil {
+		claims, err := validator.Verify(refreshToken)
+		if err != nil {
 			return "", err
 		}
 
+		// Check to ensure the refresh token was not revoked on logout.
+		if conf.revocations != nil {
+			revoked, err := conf.revocations.IsRevoked(c.Request().Context(), claims)
+			if err != nil {
+				return "", err
+			}
+
+			if revoked {
+				return "", tokens.ErrTokenRevoked
+			}
+		}
+
 		// Reauthenticate using the refresh token.
 		req := &api.RefreshRequest{RefreshToken: refreshToken}
 
@@ -171,6 +185,11 @@ func createAuthenticatedUserFromClaims(ctx context.Context, dbClient *generated.
 	// all the query to get the organization, need to bypass the authz filter to get the org
 	ctx = privacy.DecisionContext(ctx, privacy.Allow)
 
+	// access tokens issued before the user was locked or suspended can no longer be used
+	if err := checkUserStatus(ctx, dbClient, user.ID); err != nil {
+		return nil, err
+	}
+
 	org, err := dbClient.Organization.Get(ctx, claims.OrgID)
 	if err != nil {
 		return nil, err
@@ -211,13 +230,20 @@ func checkToken(ctx context.Context, conf *AuthOptions, token string) (*auth.Aut
 // isValidPersonalAccessToken checks if the provided token is a valid personal access token and returns the authenticated user
 func isValidPersonalAccessToken(ctx context.Context, dbClient *generated.Client, token string) (*auth.AuthenticatedUser, string, error) {
 	pat, err := dbClient.PersonalAccessToken.Query().Where(personalaccesstoken.Token(token)).
-		WithOwner().
+		WithOwner(func(q *generated.UserQuery) {
+			q.WithSetting()
+		}).
 		WithOrganizations().
 		Only(ctx)
 	if err != nil {
 		return nil, "", err
 	}
 
+	// the token can not be used when its owner is not allowed to authenticate
+	if err := CheckUserSetting(pat.Edges.Owner.Edges.Setting); err != nil {
+		return nil, "", err
+	}
+
 	// check if the token has expired
 	if pat.ExpiresAt != nil && pat.ExpiresAt.Before(time.Now()) {
 		return nil, "", rout.ErrExpiredCredentials
@@ -242,6 +268,7 @@ func isValidPersonalAccessToken(ctx context.Context, dbClient *generated.Client,
 		SubjectName:        getSubjectName(pat.Edges.Owner),
 		OrganizationIDs:    orgIDs,
 		AuthenticationType: auth.PATAuthentication,
+		Scopes:             pat.Scopes,
 	}, pat.ID, nil
 }
 
@@ -252,6 +279,14 @@ func isValidAPIToken(ctx context.Context, dbClient *generated.Client, token stri
 		return nil, "", err
 	}
 
+	// api tokens are owned by the organization, but tokens created by a user that is no longer allowed to
+	// authenticate can not be used
+	if t.CreatedBy != "" {
+		if err := checkUserStatus(ctx, dbClient, t.CreatedBy); err != nil && !generated.IsNotFound(err) {
+			return nil, "", err
+		}
+	}
+
 	// check if the token has expired
 	if t.ExpiresAt != nil && t.ExpiresAt.Before(time.Now()) {
 		return nil, "", rout.ErrExpiredCredentials
@@ -263,9 +298,23 @@ func isValidAPIToken(ctx context.Context, dbClient *generated.Client, token stri
 		OrganizationID:     t.OwnerID,
 		OrganizationIDs:    []string{t.OwnerID},
 		AuthenticationType: auth.APITokenAuthentication,
+		Scopes:             t.Scopes,
 	}, t.ID, nil
 }
 
+// checkUserStatus gets the settings of the user and returns an error if the user is not allowed to authenticate,
+// the context must allow the query to bypass the privacy rules
+func checkUserStatus(ctx context.Context, dbClient *generated.Client, userID string) error {
+	setting, err := dbClient.UserSetting.Query().
+		Where(usersetting.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return CheckUserSetting(setting)
+}
+
 // getSubjectName returns the subject name for the user
 func getSubjectName(user *generated.User) string {
 	subjectName := user.FirstName + " " + user.LastName
@@ -36,6 +36,8 @@ type AuthOptions struct {
 	validator tokens.Validator
 	// reauth constructed by the auth options (can be directly supplied by the user).
 	reauth Reauthenticator
+	// revocations is checked to reject refresh tokens revoked on logout
+	revocations tokens.RevocationList
 
 	// Skipper defines a function to skip middleware
 	Skipper middleware.Skipper
@@ -204,6 +206,14 @@ func WithReauthenticator(reauth Reauthenticator) AuthOption {
 	}
 }
 
+// WithRevocationList allows the user to specify the list of revoked refresh tokens that are rejected
+// when reauthenticating
+func WithRevocationList(revocations tokens.RevocationList) AuthOption {
+	return func(opts *AuthOptions) {
+		opts.revocations = revocations
+	}
+}
+
 // WithSkipperFunc allows the user to specify a skipper function for the middleware
 func WithSkipperFunc(skipper middleware.Skipper) AuthOption {
 	return func(opts *AuthOptions) {
@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"net/http"
+
+	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/rout"
+)
+
+// RequireScope is a middleware function that rejects requests authenticated with an api token or personal access
+// token that was not granted the action on the resource; it must be added after the Authenticate middleware which
+// is responsible for rejecting unauthenticated requests
+func RequireScope(resource, action string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			au, err := auth.GetAuthenticatedUserFromEchoContext(c)
+			if err != nil {
+				return next(c)
+			}
+
+			if err := au.CheckScope(resource, action); err != nil {
+				return c.JSON(http.StatusForbidden, rout.ErrorResponse(err))
+			}
+
+			return next(c)
+		}
+	}
+}
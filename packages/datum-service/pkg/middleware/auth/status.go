@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/datumforge/datum/internal/ent/generated"
+	"github.com/datumforge/datum/pkg/auth"
+	"github.com/datumforge/datum/pkg/enums"
+)
+
+// CheckUserSetting returns an error if the user with the settings is not allowed to authenticate; suspended users,
+// users whose account is locked and users that are not active are rejected. Silenced users are still allowed to
+// authenticate, silencing only stops notifications from being sent to the user
+func CheckUserSetting(setting *generated.UserSetting) error {
+	if setting == nil {
+		return auth.ErrNoAuthUser
+	}
+
+	if setting.SuspendedAt != nil || setting.Status == enums.UserStatusSuspended {
+		return auth.ErrUserSuspended
+	}
+
+	if IsLocked(setting) {
+		return auth.ErrUserLocked
+	}
+
+	if setting.Status != enums.UserStatusActive {
+		return auth.ErrNoAuthUser
+	}
+
+	return nil
+}
+
+// IsLocked returns true if the account of the user is locked; accounts locked until a time in the past are
+// automatically unlocked
+func IsLocked(setting *generated.UserSetting) bool {
+	if !setting.Locked {
+		return false
+	}
+
+	return setting.LockedUntil == nil || setting.LockedUntil.After(time.Now())
+}
@@ -5,8 +5,10 @@ import (
 
 	"github.com/go-webauthn/webauthn/protocol"
 
+	"github.com/datumforge/datum/pkg/domains"
 	"github.com/datumforge/datum/pkg/passwd"
 	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/templates"
 	"github.com/datumforge/datum/pkg/utils/ulids"
 )
 
@@ -37,6 +39,10 @@ type LoginReply struct {
 	rout.Reply
 	AuthData
 	Message string `json:"message"`
+	// TFARequired is set when the user must complete a second factor at the /login/tfa route before a session is issued
+	TFARequired bool `json:"tfa_required,omitempty"`
+	// TFAToken is the short lived challenge token that must be sent to the /login/tfa route
+	TFAToken string `json:"tfa_token,omitempty"`
 }
 
 // Validate ensures the required fields are set on the LoginRequest request
@@ -74,6 +80,68 @@ var ExampleLoginSuccessResponse = LoginReply{
 	},
 }
 
+// =========
+// LOGIN TFA
+// =========
+
+// TFALoginRequest holds the payload for the /login/tfa route, either a TOTP code or a recovery code must be provided
+type TFALoginRequest struct {
+	TFAToken     string `json:"tfa_token"`
+	TOTPCode     string `json:"totp_code,omitempty"`
+	RecoveryCode string `json:"recovery_code,omitempty"`
+}
+
+// Validate ensures the required fields are set on the TFALoginRequest request
+func (r *TFALoginRequest) Validate() error {
+	r.TFAToken = strings.TrimSpace(r.TFAToken)
+	r.TOTPCode = strings.TrimSpace(r.TOTPCode)
+	r.RecoveryCode = strings.TrimSpace(r.RecoveryCode)
+
+	switch {
+	case r.TFAToken == "":
+		return rout.NewMissingRequiredFieldError("tfa_token")
+	case r.TOTPCode == "" && r.RecoveryCode == "":
+		return rout.NewMissingRequiredFieldError("totp_code")
+	}
+
+	return nil
+}
+
+// ExampleTFALoginRequest is an example of a two factor login request for OpenAPI documentation
+var ExampleTFALoginRequest = TFALoginRequest{
+	TFAToken: "tfa_token",
+	TOTPCode: "123456",
+}
+
+// =========
+// LOGOUT
+// =========
+
+// LogoutRequest holds the fields that can be included on a request to the `/logout` endpoint, the refresh token
+// is read from the cookies when it is not provided
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Everywhere   bool   `json:"everywhere,omitempty"`
+}
+
+// LogoutReply holds the fields that are sent on a response to the `/logout` endpoint
+type LogoutReply struct {
+	rout.Reply
+	Message string `json:"message,omitempty"`
+}
+
+// ExampleLogoutRequest is an example of a successful logout request for OpenAPI documentation
+var ExampleLogoutRequest = LogoutRequest{
+	RefreshToken: "token",
+	Everywhere:   false,
+}
+
+// ExampleLogoutSuccessResponse is an example of a successful logout response for OpenAPI documentation
+var ExampleLogoutSuccessResponse = LogoutReply{
+	Reply:   rout.Reply{Success: true},
+	Message: "success",
+}
+
 // =========
 // REFRESH
 // =========
@@ -576,6 +644,158 @@ type OauthTokenRequest struct {
 	Image            string `json:"image,omitempty"`
 }
 
+// SSORequest holds the fields that should be included on a request to the `/sso/:org/login`, `/sso/:org/callback`
+// and `/sso/:org/saml/*` endpoints
+type SSORequest struct {
+	Organization string `param:"org"`
+	RedirectURI  string `query:"redirect_uri"`
+}
+
+// Validate ensures the required fields are set on the SSORequest request
+func (r *SSORequest) Validate() error {
+	if r.Organization == "" {
+		return rout.NewMissingRequiredFieldError("org")
+	}
+
+	return nil
+}
+
+// =========
+// SCIM
+// =========
+
+// SCIMRequest holds the path and query parameters of a request to the `/scim/v2/Users` and `/scim/v2/Groups`
+// endpoints, the body of the request is a SCIM resource or patch request
+type SCIMRequest struct {
+	ID         string `param:"id"`
+	Filter     string `query:"filter"`
+	StartIndex int    `query:"startIndex"`
+	Count      *int   `query:"count"`
+}
+
+// Validate ensures the parameters of the SCIMRequest are valid
+func (r *SCIMRequest) Validate() error {
+	if r.StartIndex < 0 {
+		return rout.InvalidField("startIndex")
+	}
+
+	if r.Count != nil && *r.Count < 0 {
+		return rout.InvalidField("count")
+	}
+
+	return nil
+}
+
+// =========
+// DOMAINS/VERIFY
+// =========
+
+// DomainVerificationRequest holds the fields that should be included on a request to the `/domains/verify` endpoint
+type DomainVerificationRequest struct {
+	Domain string `json:"domain"`
+	Method string `json:"method"`
+}
+
+// DomainVerificationReply holds the fields that are sent on a response to the `/domains/verify` endpoint
+type DomainVerificationReply struct {
+	rout.Reply
+	Domain          string   `json:"domain"`
+	VerifiedDomains []string `json:"verified_domains"`
+}
+
+// Validate ensures the required fields are set on the DomainVerificationRequest
+func (r *DomainVerificationRequest) Validate() error {
+	r.Domain = strings.TrimSpace(r.Domain)
+	r.Method = strings.ToLower(strings.TrimSpace(r.Method))
+
+	switch {
+	case r.Domain == "":
+		return rout.NewMissingRequiredFieldError("domain")
+	case r.Method == "":
+		return rout.NewMissingRequiredFieldError("method")
+	case r.Method != string(domains.MethodDNS) && r.Method != string(domains.MethodHTTP):
+		return rout.InvalidField("method")
+	}
+
+	return nil
+}
+
+// ExampleDomainVerificationRequest is an example of a successful `/domains/verify` request for OpenAPI documentation
+var ExampleDomainVerificationRequest = DomainVerificationRequest{
+	Domain: "datum.net",
+	Method: string(domains.MethodDNS),
+}
+
+// ExampleDomainVerificationReply is an example of a successful `/domains/verify` response for OpenAPI documentation
+var ExampleDomainVerificationReply = DomainVerificationReply{
+	Reply:           rout.Reply{Success: true},
+	Domain:          "datum.net",
+	VerifiedDomains: []string{"datum.net"},
+}
+
+// =========
+// JOIN
+// =========
+
+// JoinOrganizationRequest holds the fields that should be included on a request to the `/join` endpoint
+type JoinOrganizationRequest struct {
+	OrganizationID string `json:"organization_id"`
+}
+
+// JoinOrganizationReply holds the fields that are sent on a response to the `/join` endpoint
+type JoinOrganizationReply struct {
+	rout.Reply
+	OrganizationID string `json:"organization_id"`
+	Message        string `json:"message,omitempty"`
+}
+
+// JoinableOrganization is an organization the user is offered to join because the domain of their email address
+// is a verified domain of the organization
+type JoinableOrganization struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+// JoinableOrganizationsReply holds the fields that are sent on a response to the `/join` list endpoint
+type JoinableOrganizationsReply struct {
+	rout.Reply
+	Organizations []JoinableOrganization `json:"organizations"`
+}
+
+// Validate ensures the required fields are set on the JoinOrganizationRequest
+func (r *JoinOrganizationRequest) Validate() error {
+	if r.OrganizationID == "" {
+		return rout.NewMissingRequiredFieldError("organization_id")
+	}
+
+	return nil
+}
+
+// ExampleJoinOrganizationRequest is an example of a successful `/join` request for OpenAPI documentation
+var ExampleJoinOrganizationRequest = JoinOrganizationRequest{
+	OrganizationID: "01J4HMNDSZCCQBTY93BF9CBF5D",
+}
+
+// ExampleJoinOrganizationReply is an example of a successful `/join` response for OpenAPI documentation
+var ExampleJoinOrganizationReply = JoinOrganizationReply{
+	Reply:          rout.Reply{Success: true},
+	OrganizationID: "01J4HMNDSZCCQBTY93BF9CBF5D",
+	Message:        "joined organization",
+}
+
+// ExampleJoinableOrganizationsReply is an example of a successful `/join` list response for OpenAPI documentation
+var ExampleJoinableOrganizationsReply = JoinableOrganizationsReply{
+	Reply: rout.Reply{Success: true},
+	Organizations: []JoinableOrganization{
+		{
+			ID:          "01J4HMNDSZCCQBTY93BF9CBF5D",
+			Name:        "datum",
+			DisplayName: "Datum",
+		},
+	},
+}
+
 // =========
 // ACCOUNT/ACCESS
 // =========
@@ -713,3 +933,83 @@ var ExampleAccountRolesOrganizationReply = AccountRolesOrganizationReply{
 	Roles:          []string{"can_view", "can_edit", "audit_log_viewer"},
 	OrganizationID: "01J4HMNDSZCCQBTY93BF9CBF5D",
 }
+
+// =========
+// FILES
+// =========
+
+// FileUploadReply holds the fields that are sent on a response to the `/files` upload endpoint
+type FileUploadReply struct {
+	rout.Reply
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	Message     string `json:"message"`
+}
+
+// FileDownloadRequest holds the fields that should be included on a request to the `/files/:id` endpoint
+type FileDownloadRequest struct {
+	ID string `param:"id"`
+}
+
+// Validate ensures the required fields are set on the FileDownloadRequest request
+func (r *FileDownloadRequest) Validate() error {
+	if r.ID == "" {
+		return rout.NewMissingRequiredFieldError("id")
+	}
+
+	return nil
+}
+
+// ExampleFileUploadReply is an example of a successful file upload response for OpenAPI documentation
+var ExampleFileUploadReply = FileUploadReply{
+	Reply:       rout.Reply{Success: true},
+	ID:          "01J5ZZQJ9XGJ3TPRX7B3RW6WVF",
+	Name:        "meow.pdf",
+	ContentType: "application/pdf",
+	Size:        1024,
+	Message:     "file uploaded",
+}
+
+// ExampleFileDownloadRequest is an example of a file download request for OpenAPI documentation
+var ExampleFileDownloadRequest = FileDownloadRequest{
+	ID: "01J5ZZQJ9XGJ3TPRX7B3RW6WVF",
+}
+
+// =========
+// DOCUMENTS
+// =========
+
+// DocumentRenderRequest holds the fields that should be included on a request to the `/documents/:id/render` endpoint
+type DocumentRenderRequest struct {
+	ID     string `param:"id"`
+	Format string `query:"format"`
+}
+
+// Validate ensures the required fields are set on the DocumentRenderRequest request, documents are rendered
+// as PDF when no format is requested
+func (r *DocumentRenderRequest) Validate() error {
+	if r.ID == "" {
+		return rout.NewMissingRequiredFieldError("id")
+	}
+
+	if r.Format == "" {
+		r.Format = string(templates.FormatPDF)
+	}
+
+	f, err := templates.ParseFormat(r.Format)
+	if err != nil {
+		return rout.InvalidField("format")
+	}
+
+	r.Format = string(f)
+
+	return nil
+}
+
+// ExampleDocumentRenderRequest is an example of a document render request for OpenAPI documentation
+var ExampleDocumentRenderRequest = DocumentRenderRequest{
+	ID:     "01J5ZZQJ9XGJ3TPRX7B3RW6WVF",
+	Format: "pdf",
+}
@@ -0,0 +1,46 @@
+package objects
+
+const (
+	// ProviderDisk stores objects on the local filesystem
+	ProviderDisk = "disk"
+	// ProviderS3 stores objects in an S3 (or S3-compatible) bucket
+	ProviderS3 = "s3"
+)
+
+// Config contains the configuration for the object storage backend
+type Config struct {
+	// Enabled turns on the file upload and download endpoints
+	Enabled bool `json:"enabled" koanf:"enabled" default:"false"`
+	// Provider is the storage backend to use, either disk or s3
+	Provider string `json:"provider" koanf:"provider" default:"disk"`
+	// MaxUploadSize is the maximum size of an uploaded file in bytes
+	MaxUploadSize int64 `json:"maxUploadSize" koanf:"maxUploadSize" default:"33554432"`
+	// AllowedContentTypes is the list of content types allowed to be uploaded, empty allows all types
+	AllowedContentTypes []string `json:"allowedContentTypes" koanf:"allowedContentTypes"`
+	// Disk contains the configuration for the local disk provider
+	Disk DiskConfig `json:"disk" koanf:"disk"`
+	// S3 contains the configuration for the s3 provider
+	S3 S3Config `json:"s3" koanf:"s3"`
+}
+
+// DiskConfig contains the configuration for storing objects on the local filesystem
+type DiskConfig struct {
+	// Path is the directory objects are written to, it is created if it does not exist
+	Path string `json:"path" koanf:"path" default:"./uploads"`
+}
+
+// S3Config contains the configuration for storing objects in an S3 compatible bucket
+type S3Config struct {
+	// Bucket is the name of the bucket objects are written to
+	Bucket string `json:"bucket" koanf:"bucket"`
+	// Region is the region of the bucket
+	Region string `json:"region" koanf:"region" default:"us-east-1"`
+	// Endpoint overrides the default AWS endpoint, used for S3 compatible services such as minio
+	Endpoint string `json:"endpoint" koanf:"endpoint"`
+	// AccessKeyID is the access key used to authenticate, when empty the default AWS credential chain is used
+	AccessKeyID string `json:"accessKeyID" koanf:"accessKeyID"`
+	// SecretAccessKey is the secret key used to authenticate
+	SecretAccessKey string `json:"secretAccessKey" koanf:"secretAccessKey"`
+	// UsePathStyle forces path style bucket addressing, required by most S3 compatible services
+	UsePathStyle bool `json:"usePathStyle" koanf:"usePathStyle" default:"false"`
+}
@@ -0,0 +1,18 @@
+package objects
+
+import (
+	"gocloud.dev/blob/fileblob"
+)
+
+// NewDiskStorage returns a Storage that writes objects to a directory on the local filesystem
+func NewDiskStorage(config DiskConfig) (Storage, error) {
+	bucket, err := fileblob.OpenBucket(config.Path, &fileblob.Options{
+		CreateDir: true,
+		NoTempDir: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &bucketStorage{bucket: bucket}, nil
+}
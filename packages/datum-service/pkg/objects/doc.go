@@ -0,0 +1,2 @@
+// Package objects provides a pluggable blob storage backend used to store the contents of uploaded files
+package objects
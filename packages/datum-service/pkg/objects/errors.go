@@ -0,0 +1,18 @@
+package objects
+
+import (
+	"errors"
+)
+
+var (
+	// ErrUnsupportedProvider is returned when the configured storage provider is unknown
+	ErrUnsupportedProvider = errors.New("unsupported object storage provider")
+	// ErrMissingBucket is returned when the s3 provider is configured without a bucket
+	ErrMissingBucket = errors.New("object storage bucket is required")
+	// ErrObjectNotFound is returned when the requested object does not exist in storage
+	ErrObjectNotFound = errors.New("object not found in storage")
+	// ErrFileTooLarge is returned when an upload exceeds the maximum allowed size
+	ErrFileTooLarge = errors.New("file exceeds the maximum allowed upload size")
+	// ErrContentTypeNotAllowed is returned when an upload has a content type that is not allowed
+	ErrContentTypeNotAllowed = errors.New("file content type is not allowed")
+)
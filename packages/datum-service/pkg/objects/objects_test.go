@@ -0,0 +1,118 @@
+package objects_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/objects"
+)
+
+func TestDiskStorage(t *testing.T) {
+	ctx := context.Background()
+
+	o, err := objects.New(ctx, objects.Config{
+		Provider: objects.ProviderDisk,
+		Disk: objects.DiskConfig{
+			Path: t.TempDir(),
+		},
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { o.Close() })
+
+	key := "01J5ZZQJ9XGJ3TPRX7B3RW6WVF/meow.txt"
+	content := "hello, kitty"
+
+	n, err := o.Upload(ctx, key, strings.NewReader(content), "text/plain")
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), n)
+
+	obj, err := o.Download(ctx, key)
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(obj)
+	require.NoError(t, err)
+	require.NoError(t, obj.Close())
+
+	assert.Equal(t, content, string(body))
+	assert.Equal(t, "text/plain", obj.ContentType)
+	assert.Equal(t, int64(len(content)), obj.Size)
+
+	require.NoError(t, o.Delete(ctx, key))
+
+	_, err = o.Download(ctx, key)
+	assert.ErrorIs(t, err, objects.ErrObjectNotFound)
+
+	err = o.Delete(ctx, key)
+	assert.ErrorIs(t, err, objects.ErrObjectNotFound)
+}
+
+func TestNewUnsupportedProvider(t *testing.T) {
+	_, err := objects.New(context.Background(), objects.Config{Provider: "floppy"})
+	assert.ErrorIs(t, err, objects.ErrUnsupportedProvider)
+}
+
+func TestNewS3MissingBucket(t *testing.T) {
+	_, err := objects.New(context.Background(), objects.Config{Provider: objects.ProviderS3})
+	assert.ErrorIs(t, err, objects.ErrMissingBucket)
+}
+
+func TestValidateContentType(t *testing.T) {
+	testCases := []struct {
+		name        string
+		allowed     []string
+		contentType string
+		wantErr     bool
+	}{
+		{
+			name:        "no restrictions",
+			contentType: "application/x-anything",
+		},
+		{
+			name:        "allowed",
+			allowed:     []string{"image/png", "application/pdf"},
+			contentType: "application/pdf",
+		},
+		{
+			name:        "allowed with parameters",
+			allowed:     []string{"text/plain"},
+			contentType: "Text/Plain; charset=utf-8",
+		},
+		{
+			name:        "not allowed",
+			allowed:     []string{"image/png"},
+			contentType: "application/pdf",
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &objects.Objects{AllowedContentTypes: tc.allowed}
+
+			err := o.ValidateContentType(tc.contentType)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, objects.ErrContentTypeNotAllowed)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateSize(t *testing.T) {
+	o := &objects.Objects{MaxUploadSize: 10}
+
+	assert.NoError(t, o.ValidateSize(10))
+	assert.ErrorIs(t, o.ValidateSize(11), objects.ErrFileTooLarge)
+
+	unlimited := &objects.Objects{}
+	assert.NoError(t, unlimited.ValidateSize(1<<40))
+}
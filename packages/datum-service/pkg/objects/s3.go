@@ -0,0 +1,49 @@
+package objects
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"gocloud.dev/blob/s3blob"
+)
+
+// NewS3Storage returns a Storage that writes objects to an S3 or S3 compatible bucket
+func NewS3Storage(ctx context.Context, config S3Config) (Storage, error) {
+	if config.Bucket == "" {
+		return nil, ErrMissingBucket
+	}
+
+	opts := []func(*awsconfig.LoadOptions) error{
+		awsconfig.WithRegion(config.Region),
+	}
+
+	// use static credentials when provided, otherwise fall back to the default credential chain
+	if config.AccessKeyID != "" {
+		opts = append(opts, awsconfig.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(config.AccessKeyID, config.SecretAccessKey, ""),
+		))
+	}
+
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if config.Endpoint != "" {
+			o.BaseEndpoint = aws.String(config.Endpoint)
+		}
+
+		o.UsePathStyle = config.UsePathStyle
+	})
+
+	bucket, err := s3blob.OpenBucketV2(ctx, client, config.Bucket, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bucketStorage{bucket: bucket}, nil
+}
@@ -0,0 +1,161 @@
+package objects
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strings"
+
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+// Storage is the interface implemented by object storage backends
+type Storage interface {
+	// Upload writes the contents of the reader to the given key and returns the number of bytes written
+	Upload(ctx context.Context, key string, r io.Reader, contentType string) (int64, error)
+	// Download returns a reader for the object stored at the given key, the caller must close it
+	Download(ctx context.Context, key string) (*Object, error)
+	// Delete removes the object stored at the given key
+	Delete(ctx context.Context, key string) error
+	// Close releases any resources held by the storage backend
+	Close() error
+}
+
+// Object is a stored object returned from a download
+type Object struct {
+	io.ReadCloser
+	// ContentType is the content type the object was stored with
+	ContentType string
+	// Size is the size of the object in bytes
+	Size int64
+}
+
+// Objects wraps a storage backend with the upload restrictions from the configuration
+type Objects struct {
+	Storage
+
+	// MaxUploadSize is the maximum size of an uploaded file in bytes
+	MaxUploadSize int64
+	// AllowedContentTypes is the list of content types allowed to be uploaded
+	AllowedContentTypes []string
+}
+
+// New returns the storage backend for the configured provider
+func New(ctx context.Context, config Config) (*Objects, error) {
+	var (
+		s   Storage
+		err error
+	)
+
+	switch config.Provider {
+	case ProviderDisk, "":
+		s, err = NewDiskStorage(config.Disk)
+	case ProviderS3:
+		s, err = NewS3Storage(ctx, config.S3)
+	default:
+		return nil, ErrUnsupportedProvider
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Objects{
+		Storage:             s,
+		MaxUploadSize:       config.MaxUploadSize,
+		AllowedContentTypes: config.AllowedContentTypes,
+	}, nil
+}
+
+// ValidateContentType returns an error if the content type is not in the allowed list, an empty list allows all
+func (o *Objects) ValidateContentType(contentType string) error {
+	if len(o.AllowedContentTypes) == 0 {
+		return nil
+	}
+
+	// ignore parameters such as charset when comparing
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.TrimSpace(strings.ToLower(mediaType))
+
+	if slices.Contains(o.AllowedContentTypes, mediaType) {
+		return nil
+	}
+
+	return ErrContentTypeNotAllowed
+}
+
+// ValidateSize returns an error if the size exceeds the maximum upload size, a zero maximum allows any size
+func (o *Objects) ValidateSize(size int64) error {
+	if o.MaxUploadSize > 0 && size > o.MaxUploadSize {
+		return ErrFileTooLarge
+	}
+
+	return nil
+}
+
+// bucketStorage implements Storage on top of a gocloud blob bucket
+type bucketStorage struct {
+	bucket *blob.Bucket
+}
+
+// Upload writes the contents of the reader to the given key
+func (b *bucketStorage) Upload(ctx context.Context, key string, r io.Reader, contentType string) (int64, error) {
+	w, err := b.bucket.NewWriter(ctx, key, &blob.WriterOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(w, r)
+	if err != nil {
+		// close the writer without committing the partial object
+		_ = w.Close()
+		_ = b.bucket.Delete(ctx, key)
+
+		return 0, err
+	}
+
+	if err := w.Close(); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// Download returns a reader for the object stored at the given key
+func (b *bucketStorage) Download(ctx context.Context, key string) (*Object, error) {
+	r, err := b.bucket.NewReader(ctx, key, nil)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return nil, ErrObjectNotFound
+		}
+
+		return nil, err
+	}
+
+	return &Object{
+		ReadCloser:  r,
+		ContentType: r.ContentType(),
+		Size:        r.Size(),
+	}, nil
+}
+
+// Delete removes the object stored at the given key
+func (b *bucketStorage) Delete(ctx context.Context, key string) error {
+	if err := b.bucket.Delete(ctx, key); err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return ErrObjectNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Close closes the underlying bucket
+func (b *bucketStorage) Close() error {
+	return b.bucket.Close()
+}
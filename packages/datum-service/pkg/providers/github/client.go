@@ -111,12 +111,14 @@ func (u *UsersMock) ListEmails(ctx context.Context, opts *github.ListOptions) ([
 
 	return []*github.UserEmail{
 		{
-			Email:   github.String("antman@datum.net"),
-			Primary: github.Bool(true),
+			Email:    github.String("antman@datum.net"),
+			Primary:  github.Bool(true),
+			Verified: github.Bool(true),
 		},
 		{
-			Email:   github.String("ant-man@avengers.com"),
-			Primary: github.Bool(false),
+			Email:    github.String("ant-man@avengers.com"),
+			Primary:  github.Bool(false),
+			Verified: github.Bool(true),
 		},
 	}, &github.Response{Response: resp}, nil
 }
@@ -22,8 +22,8 @@ var (
 	// ErrUnableToGetGithubUser when the user cannot be retrieved from GitHub
 	ErrUnableToGetGithubUser = errors.New("unable to get github user")
 
-	// ErrPrimaryEmailNotFound when the user's primary email cannot be retrieved from GitHub
-	ErrPrimaryEmailNotFound = errors.New("unable to get primary email address")
+	// ErrPrimaryEmailNotFound when the user's primary verified email cannot be retrieved from GitHub
+	ErrPrimaryEmailNotFound = errors.New("unable to get verified primary email address")
 
 	// ErrContextMissingErrorValue is returned when the context does not have an error value
 	ErrContextMissingErrorValue = fmt.Errorf("context missing error value")
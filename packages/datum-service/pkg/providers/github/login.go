@@ -79,15 +79,14 @@ func githubHandler(config *oauth2.Config, clientConfig *ClientConfig, success, f
 			return
 		}
 
-		// Make a request to `user/emails` if the email was not returned (due to privacy)
-		if user.Email == nil {
-			user.Email, err = getUserEmails(ctx, githubClient)
-			if err != nil {
-				ctx = WithError(ctx, err)
-				failure.ServeHTTP(w, req.WithContext(ctx))
-
-				return
-			}
+		// the email of the profile is not guaranteed to be verified, and is not returned when it is private,
+		// so always use the primary verified email from `user/emails`
+		user.Email, err = getUserEmails(ctx, githubClient)
+		if err != nil {
+			ctx = WithError(ctx, err)
+			failure.ServeHTTP(w, req.WithContext(ctx))
+
+			return
 		}
 
 		ctx = WithUser(ctx, user)
@@ -153,16 +152,16 @@ func enterpriseGithubClientFromAuthURL(authURL string, config *ClientConfig) (*C
 	return config, nil
 }
 
-// getUserEmails from `user/emails` and return the user's primary email address
+// getUserEmails from `user/emails` and return the user's primary email address, the address must be verified
 func getUserEmails(ctx context.Context, githubClient GitHubClient) (*string, error) {
 	emails, _, err := githubClient.Users.ListEmails(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get the primary email
+	// Get the primary email, unverified addresses are not owned by the user
 	for _, em := range emails {
-		if em.GetPrimary() {
+		if em.GetPrimary() && em.GetVerified() {
 			return em.Email, nil
 		}
 	}
@@ -23,7 +23,7 @@ const (
 
 func TestGithubHandler(t *testing.T) {
 	jsonData := `{"id": 917408, "name": "Sarah Funkytown"}`
-	emailJSONData := `[{"primary": true, "email": "sfunk@meow.net"}, {"primary": false, "email": "sfunk@woof.net"}]`
+	emailJSONData := `[{"primary": true, "verified": true, "email": "sfunk@meow.net"}, {"primary": false, "verified": true, "email": "sfunk@woof.net"}]`
 
 	expectedUser := &github.User{
 		ID:    github.Int64(917408),
@@ -117,9 +117,44 @@ func TestErrorGettingUser(t *testing.T) {
 	assert.Equal(t, FailureHandlerCalled, w.Body.String())
 }
 
+func TestUnverifiedPrimaryEmail(t *testing.T) {
+	jsonData := `{"id": 917408, "name": "Sarah Funkytown", "email": "sfunk@meow.net"}`
+	emailJSONData := `[{"primary": true, "verified": false, "email": "sfunk@meow.net"}, {"primary": false, "verified": true, "email": "sfunk@woof.net"}]`
+
+	proxyClient, server := newGithubTestServer("", jsonData, emailJSONData)
+	defer server.Close()
+
+	// oauth2 Client will use the proxy client's base Transport
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, proxyClient)
+	anyToken := &oauth2.Token{AccessToken: anytoken}
+	ctx = oauth2Login.WithToken(ctx, anyToken)
+
+	config := &oauth2.Config{}
+	success := testutils.AssertSuccessNotCalled(t)
+	failure := func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		err := ErrorFromContext(ctx)
+
+		if assert.NotNil(t, err) {
+			assert.Equal(t, ErrPrimaryEmailNotFound, err)
+		}
+
+		fmt.Fprint(w, FailureHandlerCalled)
+	}
+
+	// GithubHandler does not trust the profile email or an unverified primary email, assert that:
+	// - failure handler is called
+	// - error primary email not found added to the failure handler ctx
+	githubHandler := githubHandler(config, &ClientConfig{IsEnterprise: false, IsMock: false}, success, http.HandlerFunc(failure))
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/", nil) // nolint: noctx
+	githubHandler.ServeHTTP(w, req.WithContext(ctx))
+	assert.Equal(t, FailureHandlerCalled, w.Body.String())
+}
+
 func TestGithubEnterprise(t *testing.T) {
 	jsonData := `{"id": 917408, "name": "Sarah Funkytown"}`
-	emailJSONData := `[{"primary": true, "email": "sfunk@meow.net"}, {"primary": false, "email": "sfunk@woof.net"}]`
+	emailJSONData := `[{"primary": true, "verified": true, "email": "sfunk@meow.net"}, {"primary": false, "verified": true, "email": "sfunk@woof.net"}]`
 	expectedUser := &github.User{
 		ID:    github.Int64(917408),
 		Name:  github.String("Sarah Funkytown"),
@@ -22,6 +22,9 @@ var (
 	// ErrCannotValidateGoogleUser when the Google user is invalid
 	ErrCannotValidateGoogleUser = errors.New("could not validate google user")
 
+	// ErrGoogleEmailNotVerified when the email address of the Google user is not verified
+	ErrGoogleEmailNotVerified = errors.New("google email address is not verified")
+
 	// ErrContextMissingErrorValue is returned when the context does not have an error value
 	ErrContextMissingErrorValue = fmt.Errorf("context missing error value")
 )
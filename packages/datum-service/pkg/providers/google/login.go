@@ -91,6 +91,11 @@ func validateResponse(user *google.Userinfo, err error) error {
 		return ErrCannotValidateGoogleUser
 	}
 
+	// the email address is used to find the user and their organizations, so it must be verified
+	if user.VerifiedEmail == nil || !*user.VerifiedEmail {
+		return ErrGoogleEmailNotVerified
+	}
+
 	return nil
 }
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
 	google "google.golang.org/api/oauth2/v2"
 
 	oauth2Login "github.com/datumforge/datum/pkg/providers/oauth2"
@@ -20,7 +21,7 @@ const (
 )
 
 func TestGoogleHandler(t *testing.T) {
-	jsonData := `{"id": "900913", "name": "Rusty Shackleford"}`
+	jsonData := `{"id": "900913", "name": "Rusty Shackleford", "verified_email": true}`
 	expectedUser := &google.Userinfo{Id: "900913", Name: "Rusty Shackleford"}
 	proxyClient, server := newGoogleTestServer(jsonData)
 
@@ -110,7 +111,9 @@ func TestErrorGettingUser(t *testing.T) {
 }
 
 func TestValidateResponse(t *testing.T) {
-	assert.Equal(t, nil, validateResponse(&google.Userinfo{Id: "123"}, nil))
+	assert.Equal(t, nil, validateResponse(&google.Userinfo{Id: "123", VerifiedEmail: googleapi.Bool(true)}, nil))
+	assert.Equal(t, ErrGoogleEmailNotVerified, validateResponse(&google.Userinfo{Id: "123"}, nil))
+	assert.Equal(t, ErrGoogleEmailNotVerified, validateResponse(&google.Userinfo{Id: "123", VerifiedEmail: googleapi.Bool(false)}, nil))
 	assert.Equal(t, ErrUnableToGetGoogleUser, validateResponse(nil, ErrServerError))
 	assert.Equal(t, ErrCannotValidateGoogleUser, validateResponse(nil, nil))
 	assert.Equal(t, ErrCannotValidateGoogleUser, validateResponse(&google.Userinfo{Name: "Ben"}, nil))
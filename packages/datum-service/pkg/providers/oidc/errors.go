@@ -0,0 +1,13 @@
+package oidc
+
+import (
+	"errors"
+)
+
+var (
+	// ErrUserInfo is returned when the userinfo endpoint of the provider does not return the user claims
+	ErrUserInfo = errors.New("oidc: unable to get user info from provider")
+
+	// ErrHostNotAllowed is returned when an endpoint of the provider resolves to an internal address
+	ErrHostNotAllowed = errors.New("oidc: provider host is not allowed")
+)
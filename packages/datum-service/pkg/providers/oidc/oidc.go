@@ -2,12 +2,24 @@ package oidc
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"golang.org/x/oauth2"
 
 	echo "github.com/datumforge/echox"
+
+	"github.com/datumforge/datum/pkg/httpsling"
+)
+
+const (
+	// StateCookieName is the name of the cookie holding the state sent to the provider
+	StateCookieName = "state"
+	// NonceCookieName is the name of the cookie holding the nonce expected in the ID token
+	NonceCookieName = "nonce"
 )
 
 type User struct {
@@ -29,14 +41,42 @@ type IDToken struct {
 }
 
 type Claims struct {
+	Subject       string `json:"sub"`
 	Email         string `json:"email"`
 	EmailVerified bool   `json:"email_verified"`
 	Name          string `json:"name"`
 	Picture       string `json:"picture"`
 }
 
+// NewPublicClient returns an HTTP client for providers configured by users, it only connects to public addresses
+func NewPublicClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: httpsling.NewPublicOnlyTransport(timeout, ErrHostNotAllowed),
+	}
+}
+
+// ClientContext returns a context with the HTTP client used for discovery, the token exchange and the userinfo
+// requests made with the context
+func ClientContext(ctx context.Context, client *http.Client) context.Context {
+	return oidc.ClientContext(ctx, client)
+}
+
+// Discover returns the provider for the issuer using the OIDC discovery document
+func Discover(ctx context.Context, issuer string) (*oidc.Provider, error) {
+	return oidc.NewProvider(ctx, issuer)
+}
+
+// AuthCodeURL returns the URL to the provider's consent page with the state and the nonce
+// that must be returned in the ID token
+func AuthCodeURL(config *oauth2.Config, state, nonce string) string {
+	return config.AuthCodeURL(state, oidc.Nonce(nonce))
+}
+
+// ExchangeCode exchanges the authorization code in the request for a token and verifies the returned ID token
+// against the state and nonce cookies set when the flow was started
 func ExchangeCode(ctx context.Context, r *http.Request, config *oauth2.Config, provider *oidc.Provider) (*User, error) {
-	state, err := r.Cookie("state")
+	state, err := r.Cookie(StateCookieName)
 	if err != nil {
 		return nil, echo.NewHTTPError(http.StatusBadRequest, "State cookie is not set in request")
 	}
@@ -67,7 +107,7 @@ func ExchangeCode(ctx context.Context, r *http.Request, config *oauth2.Config, p
 		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Unable to verify ID Token: "+err.Error())
 	}
 
-	nonce, err := r.Cookie("nonce")
+	nonce, err := r.Cookie(NonceCookieName)
 	if err != nil {
 		return nil, echo.NewHTTPError(http.StatusBadRequest, "Nonce is not provided")
 	}
@@ -92,3 +132,29 @@ func ExchangeCode(ctx context.Context, r *http.Request, config *oauth2.Config, p
 
 	return &user, nil
 }
+
+// UserInfo requests the claims of the user from the userinfo endpoint of a provider that does not support
+// OIDC discovery, the response is expected to contain the standard OIDC claims
+func UserInfo(ctx context.Context, config *oauth2.Config, token *oauth2.Token, infoURL string) (*Claims, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, infoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := config.Client(ctx, token).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s", ErrUserInfo, resp.Status)
+	}
+
+	var claims Claims
+	if err := json.NewDecoder(resp.Body).Decode(&claims); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
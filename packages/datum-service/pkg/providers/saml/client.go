@@ -0,0 +1,28 @@
+package saml
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/datumforge/datum/pkg/httpsling"
+)
+
+// newMetadataClient returns the client used to fetch the metadata of identity providers, the metadata URL is
+// configured by organizations so connections to internal addresses are refused unless explicitly allowed
+func newMetadataClient(config ProviderConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: config.MetadataTimeout}
+
+	if !config.AllowPrivateMetadataHosts {
+		dialer.Control = httpsling.PublicOnlyControl(ErrMetadataHostNotAllowed)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// a proxy would be dialed instead of the identity provider, bypassing the address check
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   config.MetadataTimeout,
+		Transport: transport,
+	}
+}
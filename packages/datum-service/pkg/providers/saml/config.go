@@ -0,0 +1,107 @@
+package saml
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+const (
+	ProviderName = "SAML"
+
+	// metadataCacheSize is the number of identity provider metadata URLs cached
+	metadataCacheSize = 256
+)
+
+// ProviderConfig represents the configuration settings for the SAML service provider
+type ProviderConfig struct {
+	// Enabled is the provider enabled
+	Enabled bool `json:"enabled" koanf:"enabled" default:"true"`
+	// ClientEndpoint is the endpoint of the datum server the identity providers send users back to
+	ClientEndpoint string `json:"clientEndpoint" koanf:"clientEndpoint" default:"http://localhost:17608"`
+	// CertFile is the path to the certificate of the service provider published in its metadata
+	CertFile string `json:"certFile" koanf:"certFile"`
+	// KeyFile is the path to the private key used to sign authentication requests and decrypt assertions
+	KeyFile string `json:"keyFile" koanf:"keyFile"`
+	// AllowIDPInitiated allows logins started from the identity provider without an authentication request
+	AllowIDPInitiated bool `json:"allowIdpInitiated" koanf:"allowIdpInitiated" default:"false"`
+	// MetadataTimeout is the timeout when fetching the metadata of an identity provider
+	MetadataTimeout time.Duration `json:"metadataTimeout" koanf:"metadataTimeout" default:"10s"`
+	// MetadataCacheTTL is how long the metadata fetched from the URL of an identity provider is cached, zero disables the cache
+	MetadataCacheTTL time.Duration `json:"metadataCacheTTL" koanf:"metadataCacheTTL" default:"1h"`
+	// AllowPrivateMetadataHosts allows fetching metadata from loopback, private and link-local addresses, only for development
+	AllowPrivateMetadataHosts bool `json:"allowPrivateMetadataHosts" koanf:"allowPrivateMetadataHosts" default:"false"`
+}
+
+// Provider is the SAML service provider shared by all organizations, each organization gets its own
+// entity ID and endpoints
+type Provider struct {
+	config      ProviderConfig
+	key         *rsa.PrivateKey
+	certificate *x509.Certificate
+	httpClient  *http.Client
+	metadata    *expirable.LRU[string, *saml.EntityDescriptor]
+}
+
+// NewWithConfig returns a configured SAML Provider, the key pair is optional and only required
+// to sign authentication requests and receive encrypted assertions
+func NewWithConfig(config ProviderConfig) (*Provider, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+
+	p := newProvider(config)
+
+	if config.CertFile == "" && config.KeyFile == "" {
+		return p, nil
+	}
+
+	keyPair, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := keyPair.PrivateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidKeyPair
+	}
+
+	cert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+
+	p.key = key
+	p.certificate = cert
+
+	return p, nil
+}
+
+// NewWithKeyPair returns a SAML Provider using the given key pair
+func NewWithKeyPair(config ProviderConfig, key *rsa.PrivateKey, cert *x509.Certificate) *Provider {
+	p := newProvider(config)
+
+	p.key = key
+	p.certificate = cert
+
+	return p
+}
+
+// newProvider returns a Provider with the client used to fetch identity provider metadata and its cache
+func newProvider(config ProviderConfig) *Provider {
+	p := &Provider{
+		config:     config,
+		httpClient: newMetadataClient(config),
+	}
+
+	if config.MetadataCacheTTL > 0 {
+		p.metadata = expirable.NewLRU[string, *saml.EntityDescriptor](metadataCacheSize, nil, config.MetadataCacheTTL)
+	}
+
+	return p
+}
@@ -0,0 +1,2 @@
+// Package saml is a provider package offering SAML 2.0 service provider login functionality
+package saml
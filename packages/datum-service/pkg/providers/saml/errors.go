@@ -0,0 +1,25 @@
+package saml
+
+import (
+	"errors"
+)
+
+var (
+	// ErrMetadataRequired is returned when neither the metadata URL nor the metadata XML of the identity provider is set
+	ErrMetadataRequired = errors.New("saml: identity provider metadata is required")
+
+	// ErrMetadataURLNotAllowed is returned when the metadata URL of the identity provider is not an http(s) URL
+	ErrMetadataURLNotAllowed = errors.New("saml: identity provider metadata url must use http or https")
+
+	// ErrMetadataHostNotAllowed is returned when the metadata URL of the identity provider resolves to an internal address
+	ErrMetadataHostNotAllowed = errors.New("saml: identity provider metadata host is not allowed")
+
+	// ErrNoIDPSSODescriptor is returned when the metadata does not describe an identity provider
+	ErrNoIDPSSODescriptor = errors.New("saml: metadata does not contain an identity provider descriptor")
+
+	// ErrMissingEmail is returned when the email of the user cannot be found in the assertion
+	ErrMissingEmail = errors.New("saml: email not found in assertion")
+
+	// ErrInvalidKeyPair is returned when the service provider key pair cannot be loaded
+	ErrInvalidKeyPair = errors.New("saml: invalid service provider key pair")
+)
@@ -0,0 +1,228 @@
+package saml
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	dsig "github.com/russellhaering/goxmldsig"
+)
+
+const (
+	// RequestIDCookieName is the name of the cookie holding the id of the authentication request sent
+	// to the identity provider, the assertion must be in response to this request
+	RequestIDCookieName = "saml_request_id"
+
+	metadataPath = "/v1/sso/%s/saml/metadata"
+	acsPath      = "/v1/sso/%s/saml/acs"
+)
+
+// User fields that can be mapped from the assertion attributes
+const (
+	FieldEmail       = "email"
+	FieldFirstName   = "first_name"
+	FieldLastName    = "last_name"
+	FieldDisplayName = "display_name"
+)
+
+// DefaultAttributeMapping is the name of the attribute used for each user field when the organization does not
+// configure its own mapping
+var DefaultAttributeMapping = AttributeMapping{
+	FieldEmail:       "email",
+	FieldFirstName:   "firstName",
+	FieldLastName:    "lastName",
+	FieldDisplayName: "displayName",
+}
+
+// AttributeMapping maps the user fields to the name, or the friendly name, of the attribute in the assertion
+type AttributeMapping map[string]string
+
+// NewAttributeMapping returns the default attribute mapping overridden by the mapping configured by the organization,
+// unknown fields and non-string attribute names are ignored
+func NewAttributeMapping(mapping map[string]interface{}) AttributeMapping {
+	m := AttributeMapping{}
+
+	for field, attr := range DefaultAttributeMapping {
+		m[field] = attr
+
+		if v, ok := mapping[field].(string); ok && v != "" {
+			m[field] = v
+		}
+	}
+
+	return m
+}
+
+// User is the user asserted by the identity provider
+type User struct {
+	// Subject is the name id of the user at the identity provider
+	Subject     string
+	Email       string
+	FirstName   string
+	LastName    string
+	DisplayName string
+}
+
+// Name returns the full name of the user, falling back to the display name
+func (u *User) Name() string {
+	if name := strings.TrimSpace(u.FirstName + " " + u.LastName); name != "" {
+		return name
+	}
+
+	return u.DisplayName
+}
+
+// UserFromAssertion returns the user from a validated assertion using the attribute mapping, the name id is used for
+// the email when it is not in the attributes and the name id is an email address
+func UserFromAssertion(assertion *saml.Assertion, mapping AttributeMapping) (*User, error) {
+	u := &User{}
+
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		u.Subject = assertion.Subject.NameID.Value
+	}
+
+	u.Email = attributeValue(assertion, mapping[FieldEmail])
+	u.FirstName = attributeValue(assertion, mapping[FieldFirstName])
+	u.LastName = attributeValue(assertion, mapping[FieldLastName])
+	u.DisplayName = attributeValue(assertion, mapping[FieldDisplayName])
+
+	if u.Email == "" && strings.Contains(u.Subject, "@") {
+		u.Email = u.Subject
+	}
+
+	if u.Email == "" {
+		return nil, ErrMissingEmail
+	}
+
+	return u, nil
+}
+
+// attributeValue returns the first value of the attribute matching the name or the friendly name
+func attributeValue(assertion *saml.Assertion, name string) string {
+	if name == "" {
+		return ""
+	}
+
+	for _, statement := range assertion.AttributeStatements {
+		for _, attr := range statement.Attributes {
+			if !strings.EqualFold(attr.Name, name) && !strings.EqualFold(attr.FriendlyName, name) {
+				continue
+			}
+
+			for _, v := range attr.Values {
+				if v.Value != "" {
+					return strings.TrimSpace(v.Value)
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// ParseMetadata parses the metadata XML of an identity provider
+func ParseMetadata(data []byte) (*saml.EntityDescriptor, error) {
+	metadata, err := samlsp.ParseMetadata(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(metadata.IDPSSODescriptors) == 0 {
+		return nil, ErrNoIDPSSODescriptor
+	}
+
+	return metadata, nil
+}
+
+// IDPMetadata returns the metadata of the identity provider, the metadata XML is used when set
+// otherwise the metadata is fetched from the URL and cached
+func (p *Provider) IDPMetadata(ctx context.Context, metadataURL, metadataXML string) (*saml.EntityDescriptor, error) {
+	if metadataXML != "" {
+		return ParseMetadata([]byte(metadataXML))
+	}
+
+	if metadataURL == "" {
+		return nil, ErrMetadataRequired
+	}
+
+	if p.metadata != nil {
+		if metadata, ok := p.metadata.Get(metadataURL); ok {
+			return metadata, nil
+		}
+	}
+
+	u, err := url.Parse(metadataURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, ErrMetadataURLNotAllowed
+	}
+
+	metadata, err := samlsp.FetchMetadata(ctx, p.httpClient, *u)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(metadata.IDPSSODescriptors) == 0 {
+		return nil, ErrNoIDPSSODescriptor
+	}
+
+	if p.metadata != nil {
+		p.metadata.Add(metadataURL, metadata)
+	}
+
+	return metadata, nil
+}
+
+// ServiceProvider returns the service provider of the organization, the identity provider metadata
+// is only required to start logins and validate assertions, not to publish the service provider metadata
+func (p *Provider) ServiceProvider(orgID string, idpMetadata *saml.EntityDescriptor) (*saml.ServiceProvider, error) {
+	metadataURL, err := url.Parse(p.config.ClientEndpoint + fmt.Sprintf(metadataPath, orgID))
+	if err != nil {
+		return nil, err
+	}
+
+	acsURL, err := url.Parse(p.config.ClientEndpoint + fmt.Sprintf(acsPath, orgID))
+	if err != nil {
+		return nil, err
+	}
+
+	sp := &saml.ServiceProvider{
+		EntityID:          metadataURL.String(),
+		Key:               p.key,
+		Certificate:       p.certificate,
+		HTTPClient:        p.httpClient,
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		IDPMetadata:       idpMetadata,
+		AllowIDPInitiated: p.config.AllowIDPInitiated,
+	}
+
+	// requests can only be signed with the key of the service provider
+	if p.key != nil {
+		sp.SignatureMethod = dsig.RSASHA256SignatureMethod
+	}
+
+	return sp, nil
+}
+
+// AuthenticationRequest returns the redirect URL to the identity provider and the id of the request which
+// must be kept to validate the response of the identity provider
+func AuthenticationRequest(sp *saml.ServiceProvider) (*url.URL, string, error) {
+	req, err := sp.MakeAuthenticationRequest(sp.GetSSOBindingLocation(saml.HTTPRedirectBinding), saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return nil, "", err
+	}
+
+	redirect, err := req.Redirect("", sp)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return redirect, req.ID, nil
+}
@@ -0,0 +1,262 @@
+package saml_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	crewsaml "github.com/crewjam/saml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/providers/saml"
+)
+
+func newAssertion(nameID string, attrs map[string]string) *crewsaml.Assertion {
+	statement := crewsaml.AttributeStatement{}
+
+	for name, value := range attrs {
+		statement.Attributes = append(statement.Attributes, crewsaml.Attribute{
+			Name:         "urn:oid:" + name,
+			FriendlyName: name,
+			Values:       []crewsaml.AttributeValue{{Value: value}},
+		})
+	}
+
+	return &crewsaml.Assertion{
+		Subject: &crewsaml.Subject{
+			NameID: &crewsaml.NameID{Value: nameID},
+		},
+		AttributeStatements: []crewsaml.AttributeStatement{statement},
+	}
+}
+
+func TestNewAttributeMapping(t *testing.T) {
+	mapping := saml.NewAttributeMapping(map[string]interface{}{
+		saml.FieldEmail:    "mail",
+		saml.FieldLastName: 42,
+		"favorite_song":    "hooked on a feeling",
+	})
+
+	assert.Equal(t, saml.AttributeMapping{
+		saml.FieldEmail:       "mail",
+		saml.FieldFirstName:   "firstName",
+		saml.FieldLastName:    "lastName",
+		saml.FieldDisplayName: "displayName",
+	}, mapping)
+}
+
+func TestUserFromAssertion(t *testing.T) {
+	testCases := []struct {
+		name         string
+		assertion    *crewsaml.Assertion
+		mapping      saml.AttributeMapping
+		expected     *saml.User
+		expectedName string
+		errorMsg     string
+	}{
+		{
+			name: "happy path, default mapping",
+			assertion: newAssertion("starlord", map[string]string{
+				"email":     "starlord@guardians.net",
+				"firstName": "Peter",
+				"lastName":  "Quill",
+			}),
+			mapping: saml.DefaultAttributeMapping,
+			expected: &saml.User{
+				Subject:   "starlord",
+				Email:     "starlord@guardians.net",
+				FirstName: "Peter",
+				LastName:  "Quill",
+			},
+			expectedName: "Peter Quill",
+		},
+		{
+			name: "happy path, custom mapping by attribute name",
+			assertion: newAssertion("starlord", map[string]string{
+				"mail":        "starlord@guardians.net",
+				"displayName": "Star-Lord",
+			}),
+			mapping: saml.NewAttributeMapping(map[string]interface{}{
+				saml.FieldEmail: "urn:oid:mail",
+			}),
+			expected: &saml.User{
+				Subject:     "starlord",
+				Email:       "starlord@guardians.net",
+				DisplayName: "Star-Lord",
+			},
+			expectedName: "Star-Lord",
+		},
+		{
+			name:      "happy path, email from name id",
+			assertion: newAssertion("groot@guardians.net", nil),
+			mapping:   saml.DefaultAttributeMapping,
+			expected: &saml.User{
+				Subject: "groot@guardians.net",
+				Email:   "groot@guardians.net",
+			},
+		},
+		{
+			name:      "missing email",
+			assertion: newAssertion("groot", map[string]string{"firstName": "Groot"}),
+			mapping:   saml.DefaultAttributeMapping,
+			errorMsg:  saml.ErrMissingEmail.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			u, err := saml.UserFromAssertion(tc.assertion, tc.mapping)
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, u)
+			assert.Equal(t, tc.expectedName, u.Name())
+		})
+	}
+}
+
+func TestParseMetadata(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata string
+		errorMsg string
+	}{
+		{
+			name: "happy path",
+			metadata: `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.guardians.net/metadata">
+  <IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.guardians.net/sso"/>
+  </IDPSSODescriptor>
+</EntityDescriptor>`,
+		},
+		{
+			name: "service provider metadata",
+			metadata: `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.guardians.net/metadata">
+  <SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol"/>
+</EntityDescriptor>`,
+			errorMsg: saml.ErrNoIDPSSODescriptor.Error(),
+		},
+		{
+			name:     "not xml",
+			metadata: "I am Groot",
+			errorMsg: "EOF",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, err := saml.ParseMetadata([]byte(tc.metadata))
+			if tc.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "https://idp.guardians.net/metadata", metadata.EntityID)
+		})
+	}
+}
+
+func TestIDPMetadata(t *testing.T) {
+	metadata := `<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.guardians.net/metadata">
+  <IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.guardians.net/sso"/>
+  </IDPSSODescriptor>
+</EntityDescriptor>`
+
+	var fetches atomic.Int32
+
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+
+		_, _ = w.Write([]byte(metadata))
+	}))
+	defer idp.Close()
+
+	testCases := []struct {
+		name            string
+		config          saml.ProviderConfig
+		metadataURL     string
+		expectedFetches int32
+		errorMsg        string
+	}{
+		{
+			name: "happy path, metadata is cached",
+			config: saml.ProviderConfig{
+				MetadataTimeout:           time.Second,
+				MetadataCacheTTL:          time.Minute,
+				AllowPrivateMetadataHosts: true,
+			},
+			metadataURL:     idp.URL,
+			expectedFetches: 1,
+		},
+		{
+			name: "happy path, cache disabled",
+			config: saml.ProviderConfig{
+				MetadataTimeout:           time.Second,
+				AllowPrivateMetadataHosts: true,
+			},
+			metadataURL:     idp.URL,
+			expectedFetches: 2,
+		},
+		{
+			name: "loopback host is not allowed",
+			config: saml.ProviderConfig{
+				MetadataTimeout: time.Second,
+			},
+			metadataURL: idp.URL,
+			errorMsg:    saml.ErrMetadataHostNotAllowed.Error(),
+		},
+		{
+			name: "link local host is not allowed",
+			config: saml.ProviderConfig{
+				MetadataTimeout: time.Second,
+			},
+			metadataURL: "http://169.254.169.254/latest/meta-data",
+			errorMsg:    saml.ErrMetadataHostNotAllowed.Error(),
+		},
+		{
+			name: "scheme is not allowed",
+			config: saml.ProviderConfig{
+				MetadataTimeout:           time.Second,
+				AllowPrivateMetadataHosts: true,
+			},
+			metadataURL: "file:///etc/passwd",
+			errorMsg:    saml.ErrMetadataURLNotAllowed.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetches.Store(0)
+
+			p := saml.NewWithKeyPair(tc.config, nil, nil)
+
+			for range 2 {
+				idpMetadata, err := p.IDPMetadata(context.Background(), tc.metadataURL, "")
+				if tc.errorMsg != "" {
+					require.Error(t, err)
+					assert.ErrorContains(t, err, tc.errorMsg)
+
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, "https://idp.guardians.net/metadata", idpMetadata.EntityID)
+			}
+
+			assert.Equal(t, tc.expectedFetches, fetches.Load())
+		})
+	}
+}
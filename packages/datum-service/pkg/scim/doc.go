@@ -0,0 +1,3 @@
+// Package scim contains the resources, filters and patch operations of the SCIM 2.0 protocol (RFC 7643 and RFC 7644)
+// used to provision users and groups from an identity provider
+package scim
@@ -0,0 +1,75 @@
+package scim
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+var (
+	// ErrInvalidFilter is returned when the filter of a list request cannot be parsed
+	ErrInvalidFilter = errors.New("scim: invalid filter")
+
+	// ErrInvalidSyntax is returned when the request body is not a valid SCIM message
+	ErrInvalidSyntax = errors.New("scim: invalid syntax")
+
+	// ErrInvalidPath is returned when the path of a patch operation cannot be parsed
+	ErrInvalidPath = errors.New("scim: invalid path")
+
+	// ErrNoTarget is returned when the path of a patch operation does not match any value
+	ErrNoTarget = errors.New("scim: path does not match any value")
+
+	// ErrInvalidValue is returned when a value is missing or not compatible with the attribute
+	ErrInvalidValue = errors.New("scim: invalid value")
+
+	// ErrMutability is returned when a request attempts to modify a read-only or immutable attribute
+	ErrMutability = errors.New("scim: attribute cannot be modified")
+
+	// ErrUniqueness is returned when a resource already exists
+	ErrUniqueness = errors.New("scim: resource already exists")
+)
+
+// errorTypes maps the errors to the scimType of the error response
+var errorTypes = map[error]string{
+	ErrInvalidFilter: "invalidFilter",
+	ErrInvalidSyntax: "invalidSyntax",
+	ErrInvalidPath:   "invalidPath",
+	ErrNoTarget:      "noTarget",
+	ErrInvalidValue:  "invalidValue",
+	ErrMutability:    "mutability",
+	ErrUniqueness:    "uniqueness",
+}
+
+// Error is the error response of the SCIM protocol
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+	Status   string   `json:"status"`
+}
+
+// NewError returns the error response with the status code, the scimType is set when the error wraps one of the
+// errors of the package
+func NewError(status int, err error) *Error {
+	e := &Error{
+		Schemas: []string{ErrorSchema},
+		Status:  strconv.Itoa(status),
+		Detail:  http.StatusText(status),
+	}
+
+	if err == nil {
+		return e
+	}
+
+	e.Detail = err.Error()
+
+	for target, scimType := range errorTypes {
+		if errors.Is(err, target) {
+			e.ScimType = scimType
+
+			break
+		}
+	}
+
+	return e
+}
@@ -0,0 +1,593 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Filter is a parsed filter expression (RFC 7644 section 3.4.2.2) which is matched against resources
+type Filter struct {
+	expr expression
+}
+
+// ParseFilter parses the filter expression, all attribute operators, the logical operators and,
+// or and not, grouping and value paths (e.g. `emails[type eq "work"]`) are supported
+func ParseFilter(filter string) (*Filter, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.done() {
+		return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidFilter, p.peek().value)
+	}
+
+	return &Filter{expr: expr}, nil
+}
+
+// Matches returns true if the resource matches the filter, string comparisons ignore case
+func (f *Filter) Matches(resource interface{}) (bool, error) {
+	m, err := toMap(resource)
+	if err != nil {
+		return false, err
+	}
+
+	return f.expr.match(m), nil
+}
+
+// FilterResources returns the resources matching the filter, all resources are returned when the filter is empty
+func FilterResources[T any](resources []T, filter string) ([]T, error) {
+	if strings.TrimSpace(filter) == "" {
+		return resources, nil
+	}
+
+	f, err := ParseFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []T{}
+
+	for _, r := range resources {
+		ok, err := f.Matches(r)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			matches = append(matches, r)
+		}
+	}
+
+	return matches, nil
+}
+
+// expression is a node of a parsed filter
+type expression interface {
+	match(resource map[string]interface{}) bool
+}
+
+// logicalExpression is the and, or of two expressions
+type logicalExpression struct {
+	op          string
+	left, right expression
+}
+
+func (e logicalExpression) match(resource map[string]interface{}) bool {
+	if e.op == "and" {
+		return e.left.match(resource) && e.right.match(resource)
+	}
+
+	return e.left.match(resource) || e.right.match(resource)
+}
+
+// notExpression negates the expression
+type notExpression struct {
+	expr expression
+}
+
+func (e notExpression) match(resource map[string]interface{}) bool {
+	return !e.expr.match(resource)
+}
+
+// valuePathExpression matches the elements of a multi-valued complex attribute against the expression
+type valuePathExpression struct {
+	attr string
+	expr expression
+}
+
+func (e valuePathExpression) match(resource map[string]interface{}) bool {
+	for _, v := range asSlice(getAttr(resource, e.attr)) {
+		if m, ok := v.(map[string]interface{}); ok && e.expr.match(m) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// attributeExpression compares the values of the attribute to the value with the operator
+type attributeExpression struct {
+	path  attrPath
+	op    string
+	value interface{}
+}
+
+func (e attributeExpression) match(resource map[string]interface{}) bool {
+	values := e.path.values(resource)
+
+	switch e.op {
+	case "pr":
+		// complex attributes are present without a value sub-attribute
+		if e.path.subAttr == "" {
+			values = asSlice(getAttr(resource, e.path.attr))
+		}
+
+		for _, v := range values {
+			if v != nil && v != "" {
+				return true
+			}
+		}
+
+		return false
+	case "ne":
+		return !(attributeExpression{path: e.path, op: "eq", value: e.value}).match(resource)
+	}
+
+	if e.value == nil && e.op == "eq" {
+		return len(values) == 0
+	}
+
+	for _, v := range values {
+		if compare(v, e.op, e.value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compare returns true if the attribute value compares to the filter value with the operator
+func compare(v interface{}, op string, value interface{}) bool {
+	switch value := value.(type) {
+	case string:
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+
+		s, value = strings.ToLower(s), strings.ToLower(value)
+
+		switch op {
+		case "eq":
+			return s == value
+		case "co":
+			return strings.Contains(s, value)
+		case "sw":
+			return strings.HasPrefix(s, value)
+		case "ew":
+			return strings.HasSuffix(s, value)
+		case "gt":
+			return s > value
+		case "ge":
+			return s >= value
+		case "lt":
+			return s < value
+		case "le":
+			return s <= value
+		}
+	case float64:
+		n, ok := v.(float64)
+		if !ok {
+			return false
+		}
+
+		switch op {
+		case "eq":
+			return n == value
+		case "gt":
+			return n > value
+		case "ge":
+			return n >= value
+		case "lt":
+			return n < value
+		case "le":
+			return n <= value
+		}
+	case bool:
+		b, ok := v.(bool)
+
+		return ok && op == "eq" && b == value
+	}
+
+	return false
+}
+
+// attrPath is the path of an attribute, with an optional sub-attribute, e.g. `name.givenName`
+type attrPath struct {
+	attr    string
+	subAttr string
+}
+
+// parseAttrPath parses the attribute path, removing the schema prefix of core attributes
+func parseAttrPath(path string) (attrPath, error) {
+	for _, schema := range []string{UserSchema, GroupSchema} {
+		if len(path) > len(schema) && strings.EqualFold(path[:len(schema)+1], schema+":") {
+			path = path[len(schema)+1:]
+		}
+	}
+
+	attr, subAttr, _ := strings.Cut(path, ".")
+	if !isAttrName(attr) || (subAttr != "" && !isAttrName(subAttr)) {
+		return attrPath{}, fmt.Errorf("%w: %q", ErrInvalidPath, path)
+	}
+
+	return attrPath{attr: attr, subAttr: subAttr}, nil
+}
+
+// values returns the values of the attribute in the resource, multi-valued attributes are flattened and the
+// value sub-attribute is used for complex attributes without a sub-attribute in the path
+func (p attrPath) values(resource map[string]interface{}) []interface{} {
+	values := []interface{}{}
+
+	for _, v := range asSlice(getAttr(resource, p.attr)) {
+		m, ok := v.(map[string]interface{})
+
+		switch {
+		case !ok:
+			values = append(values, v)
+		case p.subAttr != "":
+			values = append(values, asSlice(getAttr(m, p.subAttr))...)
+		default:
+			values = append(values, asSlice(getAttr(m, "value"))...)
+		}
+	}
+
+	return values
+}
+
+// isAttrName returns true if the name is a valid attribute name
+func isAttrName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for i, r := range name {
+		if unicode.IsLetter(r) || r == '$' || (i > 0 && (unicode.IsDigit(r) || r == '_' || r == '-')) {
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// getAttr returns the value of the attribute, ignoring the case of the name
+func getAttr(resource map[string]interface{}, name string) interface{} {
+	if v, ok := resource[name]; ok {
+		return v
+	}
+
+	for k, v := range resource {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// asSlice returns the values of a multi-valued attribute, or the value of a singular attribute in a slice
+func asSlice(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return v
+	default:
+		return []interface{}{v}
+	}
+}
+
+// toMap returns the JSON representation of the resource
+func toMap(resource interface{}) (map[string]interface{}, error) {
+	if m, ok := resource.(map[string]interface{}); ok {
+		return m, nil
+	}
+
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// token types of filters
+const (
+	tokenWord = iota
+	tokenString
+	tokenOpenParen
+	tokenCloseParen
+	tokenOpenBracket
+	tokenCloseBracket
+)
+
+// token is a lexical token of a filter
+type token struct {
+	kind  int
+	value string
+}
+
+// tokenize splits the filter into tokens
+func tokenize(filter string) ([]token, error) {
+	tokens := []token{}
+
+	for i := 0; i < len(filter); {
+		c := filter[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenOpenParen, value: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenCloseParen, value: ")"})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{kind: tokenOpenBracket, value: "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{kind: tokenCloseBracket, value: "]"})
+			i++
+		case c == '"':
+			end := i + 1
+			for ; end < len(filter) && filter[end] != '"'; end++ {
+				if filter[end] == '\\' {
+					end++
+				}
+			}
+
+			if end >= len(filter) {
+				return nil, fmt.Errorf("%w: unterminated string", ErrInvalidFilter)
+			}
+
+			var s string
+			if err := json.Unmarshal([]byte(filter[i:end+1]), &s); err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidFilter, err)
+			}
+
+			tokens = append(tokens, token{kind: tokenString, value: s})
+			i = end + 1
+		default:
+			end := len(filter)
+			if n := strings.IndexAny(filter[i:], " \t\n\r()[]\""); n >= 0 {
+				end = i + n
+			}
+
+			tokens = append(tokens, token{kind: tokenWord, value: filter[i:end]})
+			i = end
+		}
+	}
+
+	return tokens, nil
+}
+
+// parser is a recursive descent parser of the filter tokens
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{kind: -1}
+	}
+
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.pos++
+
+	return t
+}
+
+// isKeyword returns true if the next token is the keyword
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+
+	return t.kind == tokenWord && strings.EqualFold(t.value, keyword)
+}
+
+// expect returns an error if the next token is not of the kind
+func (p *parser) expect(kind int, value string) error {
+	if t := p.next(); t.kind != kind {
+		return fmt.Errorf("%w: expected %q", ErrInvalidFilter, value)
+	}
+
+	return nil
+}
+
+// parseOr parses expressions joined with or, which has the lowest precedence
+func (p *parser) parseOr() (expression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("or") {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = logicalExpression{op: "or", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseAnd parses expressions joined with and
+func (p *parser) parseAnd() (expression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.isKeyword("and") {
+		p.next()
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = logicalExpression{op: "and", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseUnary parses a negated, grouped, value path or attribute expression
+func (p *parser) parseUnary() (expression, error) {
+	if p.isKeyword("not") {
+		p.next()
+
+		expr, err := p.parseGroup()
+		if err != nil {
+			return nil, err
+		}
+
+		return notExpression{expr: expr}, nil
+	}
+
+	if p.peek().kind == tokenOpenParen {
+		return p.parseGroup()
+	}
+
+	t := p.next()
+	if t.kind != tokenWord {
+		return nil, fmt.Errorf("%w: expected attribute", ErrInvalidFilter)
+	}
+
+	if p.peek().kind == tokenOpenBracket {
+		p.next()
+
+		path, err := parseAttrPath(t.value)
+		if err != nil || path.subAttr != "" {
+			return nil, fmt.Errorf("%w: invalid attribute %q", ErrInvalidFilter, t.value)
+		}
+
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(tokenCloseBracket, "]"); err != nil {
+			return nil, err
+		}
+
+		return valuePathExpression{attr: path.attr, expr: expr}, nil
+	}
+
+	return p.parseAttribute(t.value)
+}
+
+// parseGroup parses an expression in parentheses
+func (p *parser) parseGroup() (expression, error) {
+	if err := p.expect(tokenOpenParen, "("); err != nil {
+		return nil, err
+	}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.expect(tokenCloseParen, ")"); err != nil {
+		return nil, err
+	}
+
+	return expr, nil
+}
+
+// parseAttribute parses the operator and the value of an attribute expression
+func (p *parser) parseAttribute(attr string) (expression, error) {
+	path, err := parseAttrPath(attr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid attribute %q", ErrInvalidFilter, attr)
+	}
+
+	t := p.next()
+	if t.kind != tokenWord {
+		return nil, fmt.Errorf("%w: expected operator after %q", ErrInvalidFilter, attr)
+	}
+
+	op := strings.ToLower(t.value)
+
+	switch op {
+	case "pr":
+		return attributeExpression{path: path, op: op}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("%w: unknown operator %q", ErrInvalidFilter, t.value)
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	return attributeExpression{path: path, op: op, value: value}, nil
+}
+
+// parseValue parses the comparison value, a string, number, boolean or null
+func (p *parser) parseValue() (interface{}, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenString:
+		return t.value, nil
+	case tokenWord:
+		var v interface{}
+		if err := json.Unmarshal([]byte(strings.ToLower(t.value)), &v); err != nil {
+			return nil, fmt.Errorf("%w: invalid value %q", ErrInvalidFilter, t.value)
+		}
+
+		if _, ok := v.(map[string]interface{}); ok {
+			return nil, fmt.Errorf("%w: invalid value %q", ErrInvalidFilter, t.value)
+		}
+
+		if _, ok := v.([]interface{}); ok {
+			return nil, fmt.Errorf("%w: invalid value %q", ErrInvalidFilter, t.value)
+		}
+
+		return v, nil
+	default:
+		return nil, fmt.Errorf("%w: expected value", ErrInvalidFilter)
+	}
+}
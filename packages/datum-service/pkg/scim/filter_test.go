@@ -0,0 +1,164 @@
+package scim_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/scim"
+)
+
+func TestFilterMatches(t *testing.T) {
+	user := scim.User{
+		Schemas:     []string{scim.UserSchema},
+		ID:          "01J6GDH6QDW7FG2QZZQ3W0X2HE",
+		UserName:    "starlord@guardians.net",
+		DisplayName: "Star-Lord",
+		Name: &scim.Name{
+			GivenName:  "Peter",
+			FamilyName: "Quill",
+		},
+		Emails: []scim.Email{
+			{Value: "starlord@guardians.net", Type: "work", Primary: true},
+			{Value: "peter@terra.net", Type: "home"},
+		},
+		Active: scim.NewBool(true),
+	}
+
+	testCases := []struct {
+		filter   string
+		expected bool
+	}{
+		{filter: `userName eq "starlord@guardians.net"`, expected: true},
+		{filter: `USERNAME eq "STARLORD@guardians.net"`, expected: true},
+		{filter: `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "starlord@guardians.net"`, expected: true},
+		{filter: `userName eq "groot@guardians.net"`, expected: false},
+		{filter: `userName ne "groot@guardians.net"`, expected: true},
+		{filter: `userName sw "star"`, expected: true},
+		{filter: `userName ew "guardians.net"`, expected: true},
+		{filter: `displayName co "-"`, expected: true},
+		{filter: `name.givenName eq "Peter"`, expected: true},
+		{filter: `name.familyName eq "Peter"`, expected: false},
+		{filter: `emails.value eq "peter@terra.net"`, expected: true},
+		{filter: `emails eq "peter@terra.net"`, expected: true},
+		{filter: `emails[type eq "work" and value co "guardians"]`, expected: true},
+		{filter: `emails[type eq "work" and value co "terra"]`, expected: false},
+		{filter: `active eq true`, expected: true},
+		{filter: `active eq false`, expected: false},
+		{filter: `externalId pr`, expected: false},
+		{filter: `name pr`, expected: true},
+		{filter: `externalId eq null`, expected: true},
+		{filter: `userName eq "groot@guardians.net" or displayName eq "Star-Lord"`, expected: true},
+		{filter: `userName eq "groot@guardians.net" and displayName eq "Star-Lord"`, expected: false},
+		{filter: `not (userName eq "groot@guardians.net")`, expected: true},
+		{filter: `(userName eq "groot@guardians.net" or displayName eq "Star-Lord") and active eq true`, expected: true},
+		{filter: `displayName eq "Star-Lord" or userName eq "groot" and active eq false`, expected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.filter, func(t *testing.T) {
+			f, err := scim.ParseFilter(tc.filter)
+			require.NoError(t, err)
+
+			matches, err := f.Matches(user)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, matches)
+		})
+	}
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	testCases := []string{
+		`userName`,
+		`userName eq`,
+		`userName is "starlord"`,
+		`userName eq "starlord`,
+		`userName eq starlord`,
+		`(userName eq "starlord"`,
+		`userName eq "starlord")`,
+		`emails[type eq "work"`,
+		`not userName eq "starlord"`,
+		`userName eq "starlord" and`,
+	}
+
+	for _, filter := range testCases {
+		t.Run(filter, func(t *testing.T) {
+			_, err := scim.ParseFilter(filter)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, scim.ErrInvalidFilter)
+		})
+	}
+}
+
+func TestFilterResources(t *testing.T) {
+	groups := []scim.Group{
+		{DisplayName: "guardians"},
+		{DisplayName: "ravagers"},
+		{DisplayName: "nova corps"},
+	}
+
+	matches, err := scim.FilterResources(groups, `displayName eq "ravagers"`)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "ravagers", matches[0].DisplayName)
+
+	matches, err = scim.FilterResources(groups, "")
+	require.NoError(t, err)
+	assert.Len(t, matches, 3)
+
+	_, err = scim.FilterResources(groups, "displayName eq")
+	assert.ErrorIs(t, err, scim.ErrInvalidFilter)
+}
+
+func TestNewListResponse(t *testing.T) {
+	resources := []string{"groot", "rocket", "gamora", "drax", "mantis"}
+
+	testCases := []struct {
+		name       string
+		startIndex int
+		count      int
+		expected   []interface{}
+	}{
+		{
+			name:       "first page",
+			startIndex: 1,
+			count:      2,
+			expected:   []interface{}{"groot", "rocket"},
+		},
+		{
+			name:       "last page",
+			startIndex: 5,
+			count:      2,
+			expected:   []interface{}{"mantis"},
+		},
+		{
+			name:       "start index out of range",
+			startIndex: 10,
+			count:      2,
+			expected:   []interface{}{},
+		},
+		{
+			name:       "start index below 1",
+			startIndex: 0,
+			count:      1,
+			expected:   []interface{}{"groot"},
+		},
+		{
+			name:       "count of 0",
+			startIndex: 1,
+			count:      0,
+			expected:   []interface{}{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := scim.NewListResponse(resources, tc.startIndex, tc.count)
+
+			assert.Equal(t, 5, res.TotalResults)
+			assert.Equal(t, len(tc.expected), res.ItemsPerPage)
+			assert.Equal(t, tc.expected, res.Resources)
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// patch operations
+const (
+	opAdd     = "add"
+	opRemove  = "remove"
+	opReplace = "replace"
+)
+
+// multiValuedAttributes are the multi-valued attributes of the resources, values added to these attributes
+// are appended even when the attribute is not set yet
+var multiValuedAttributes = []string{"emails", "members"}
+
+// readOnlyAttributes are the attributes that cannot be modified by patch operations
+var readOnlyAttributes = []string{"id", "meta", "schemas"}
+
+// Apply applies the operations of the patch request (RFC 7644 section 3.5.2) to the resource, which must be
+// a pointer to a resource of this package; the resource is not modified when an operation fails
+func (r *PatchRequest) Apply(resource interface{}) error {
+	rv := reflect.ValueOf(resource)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("%w: resource must be a pointer", ErrInvalidValue)
+	}
+
+	m, err := toMap(resource)
+	if err != nil {
+		return err
+	}
+
+	for _, op := range r.Operations {
+		if err := applyOperation(m, op); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	// decode into a new resource so removed attributes are not kept
+	patched := reflect.New(rv.Elem().Type())
+	if err := json.Unmarshal(data, patched.Interface()); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+	}
+
+	rv.Elem().Set(patched.Elem())
+
+	return nil
+}
+
+// patchPath is the path of a patch operation, e.g. `members[value eq "id"]` or `name.givenName`
+type patchPath struct {
+	attrPath
+	filter *Filter
+}
+
+// parsePatchPath parses the path of a patch operation
+func parsePatchPath(path string) (patchPath, error) {
+	open := strings.Index(path, "[")
+	if open < 0 {
+		p, err := parseAttrPath(path)
+
+		return patchPath{attrPath: p}, err
+	}
+
+	closing := strings.LastIndex(path, "]")
+	if closing < open {
+		return patchPath{}, fmt.Errorf("%w: %q", ErrInvalidPath, path)
+	}
+
+	attr := path[:open]
+
+	rest := path[closing+1:]
+	if rest != "" {
+		if !strings.HasPrefix(rest, ".") {
+			return patchPath{}, fmt.Errorf("%w: %q", ErrInvalidPath, path)
+		}
+
+		attr += rest
+	}
+
+	p, err := parseAttrPath(attr)
+	if err != nil {
+		return patchPath{}, err
+	}
+
+	filter, err := ParseFilter(path[open+1 : closing])
+	if err != nil {
+		return patchPath{}, fmt.Errorf("%w: %w", ErrInvalidPath, err)
+	}
+
+	return patchPath{attrPath: p, filter: filter}, nil
+}
+
+// applyOperation applies the patch operation to the JSON representation of the resource
+func applyOperation(resource map[string]interface{}, op PatchOperation) error {
+	kind := strings.ToLower(op.Op)
+	if kind != opAdd && kind != opRemove && kind != opReplace {
+		return fmt.Errorf("%w: unknown operation %q", ErrInvalidSyntax, op.Op)
+	}
+
+	var value interface{}
+	if len(op.Value) > 0 {
+		if err := json.Unmarshal(op.Value, &value); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+		}
+	}
+
+	if op.Path != "" {
+		path, err := parsePatchPath(op.Path)
+		if err != nil {
+			return err
+		}
+
+		return applyPath(resource, kind, path, value)
+	}
+
+	if kind == opRemove {
+		return fmt.Errorf("%w: remove operations require a path", ErrNoTarget)
+	}
+
+	// without a path the value holds the attributes to add or replace, the names of the attributes
+	// can be paths as well
+	values, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%w: operations without a path require an object value", ErrInvalidValue)
+	}
+
+	for name, v := range values {
+		if strings.EqualFold(name, "schemas") {
+			continue
+		}
+
+		path, err := parsePatchPath(name)
+		if err != nil {
+			return err
+		}
+
+		if err := applyPath(resource, kind, path, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyPath applies the operation to the attribute at the path
+func applyPath(resource map[string]interface{}, kind string, path patchPath, value interface{}) error {
+	if contains(readOnlyAttributes, path.attr) {
+		return fmt.Errorf("%w: %s", ErrMutability, path.attr)
+	}
+
+	if kind != opRemove && value == nil {
+		return fmt.Errorf("%w: %s requires a value", ErrInvalidValue, kind)
+	}
+
+	key := attrKey(resource, path.attr)
+
+	if path.filter != nil {
+		return applyFiltered(resource, key, kind, path, value)
+	}
+
+	if path.subAttr != "" {
+		for _, v := range asSlice(resource[key]) {
+			if m, ok := v.(map[string]interface{}); ok {
+				setAttr(m, kind, path.subAttr, value)
+			}
+		}
+
+		if resource[key] == nil && kind != opRemove {
+			resource[key] = map[string]interface{}{path.subAttr: value}
+		}
+
+		return nil
+	}
+
+	existing := resource[key]
+
+	switch kind {
+	case opRemove:
+		return removeValues(resource, key, value)
+	case opAdd:
+		if _, ok := existing.([]interface{}); ok || (existing == nil && contains(multiValuedAttributes, key)) {
+			resource[key] = append(asSlice(existing), asSlice(value)...)
+
+			return nil
+		}
+	}
+
+	// complex attributes are merged, the sub-attributes that are not in the value are kept
+	if m, ok := existing.(map[string]interface{}); ok {
+		if v, ok := value.(map[string]interface{}); ok {
+			for name, sub := range v {
+				m[attrKey(m, name)] = sub
+			}
+
+			return nil
+		}
+	}
+
+	if contains(multiValuedAttributes, key) {
+		value = asSlice(value)
+	}
+
+	resource[key] = value
+
+	return nil
+}
+
+// applyFiltered applies the operation to the values of the multi-valued attribute matching the filter of the path
+func applyFiltered(resource map[string]interface{}, key, kind string, path patchPath, value interface{}) error {
+	values, ok := resource[key].([]interface{})
+	if !ok && kind != opRemove {
+		return fmt.Errorf("%w: %s", ErrNoTarget, key)
+	}
+
+	kept := []interface{}{}
+	matched := false
+
+	for _, v := range values {
+		m, ok := v.(map[string]interface{})
+		if !ok || !path.filter.expr.match(m) {
+			kept = append(kept, v)
+
+			continue
+		}
+
+		matched = true
+
+		switch {
+		case path.subAttr != "":
+			setAttr(m, kind, path.subAttr, value)
+		case kind == opRemove:
+			continue
+		default:
+			if v, ok := value.(map[string]interface{}); ok {
+				for name, sub := range v {
+					m[attrKey(m, name)] = sub
+				}
+			}
+		}
+
+		kept = append(kept, m)
+	}
+
+	// removing values that do not exist is not an error, so identity providers can retry removals
+	if !matched && kind != opRemove {
+		return fmt.Errorf("%w: %s", ErrNoTarget, key)
+	}
+
+	resource[key] = kept
+
+	return nil
+}
+
+// removeValues removes the attribute, or only the values of a multi-valued attribute in the value of the operation
+// which some identity providers send instead of a filter
+func removeValues(resource map[string]interface{}, key string, value interface{}) error {
+	values, ok := resource[key].([]interface{})
+	if !ok || value == nil {
+		delete(resource, key)
+
+		return nil
+	}
+
+	remove := []string{}
+
+	for _, v := range asSlice(value) {
+		if m, ok := v.(map[string]interface{}); ok {
+			if s, ok := getAttr(m, "value").(string); ok {
+				remove = append(remove, s)
+			}
+		}
+	}
+
+	kept := []interface{}{}
+
+	for _, v := range values {
+		if m, ok := v.(map[string]interface{}); ok {
+			if s, ok := getAttr(m, "value").(string); ok && contains(remove, s) {
+				continue
+			}
+		}
+
+		kept = append(kept, v)
+	}
+
+	resource[key] = kept
+
+	return nil
+}
+
+// setAttr sets or removes the sub-attribute of a complex value
+func setAttr(m map[string]interface{}, kind, name string, value interface{}) {
+	key := attrKey(m, name)
+
+	if kind == opRemove {
+		delete(m, key)
+
+		return
+	}
+
+	m[key] = value
+}
+
+// attrKey returns the key of the attribute in the resource ignoring case, or the name when the attribute is not set
+func attrKey(resource map[string]interface{}, name string) string {
+	if _, ok := resource[name]; ok {
+		return name
+	}
+
+	for k := range resource {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+
+	return name
+}
@@ -0,0 +1,277 @@
+package scim_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/scim"
+)
+
+func newPatch(t *testing.T, ops ...map[string]interface{}) *scim.PatchRequest {
+	data, err := json.Marshal(map[string]interface{}{
+		"schemas":    []string{scim.PatchOpSchema},
+		"Operations": ops,
+	})
+	require.NoError(t, err)
+
+	var req scim.PatchRequest
+	require.NoError(t, json.Unmarshal(data, &req))
+
+	return &req
+}
+
+func newGroup() *scim.Group {
+	return &scim.Group{
+		Schemas:     []string{scim.GroupSchema},
+		ID:          "01J6GDH6QDW7FG2QZZQ3W0X2HE",
+		DisplayName: "guardians",
+		Members: []scim.Member{
+			{Value: "starlord"},
+			{Value: "groot"},
+		},
+	}
+}
+
+func TestPatchGroup(t *testing.T) {
+	testCases := []struct {
+		name            string
+		ops             []map[string]interface{}
+		expectedName    string
+		expectedMembers []string
+		errorIs         error
+	}{
+		{
+			name: "add members",
+			ops: []map[string]interface{}{
+				{"op": "add", "path": "members", "value": []map[string]string{{"value": "rocket"}, {"value": "gamora"}}},
+			},
+			expectedName:    "guardians",
+			expectedMembers: []string{"starlord", "groot", "rocket", "gamora"},
+		},
+		{
+			name: "add member without path",
+			ops: []map[string]interface{}{
+				{"op": "Add", "value": map[string]interface{}{"members": []map[string]string{{"value": "drax"}}}},
+			},
+			expectedName:    "guardians",
+			expectedMembers: []string{"starlord", "groot", "drax"},
+		},
+		{
+			name: "remove member with filter",
+			ops: []map[string]interface{}{
+				{"op": "remove", "path": `members[value eq "groot"]`},
+			},
+			expectedName:    "guardians",
+			expectedMembers: []string{"starlord"},
+		},
+		{
+			name: "remove member that is not in the group",
+			ops: []map[string]interface{}{
+				{"op": "remove", "path": `members[value eq "ronan"]`},
+			},
+			expectedName:    "guardians",
+			expectedMembers: []string{"starlord", "groot"},
+		},
+		{
+			name: "remove members with value",
+			ops: []map[string]interface{}{
+				{"op": "Remove", "path": "members", "value": []map[string]string{{"value": "starlord"}}},
+			},
+			expectedName:    "guardians",
+			expectedMembers: []string{"groot"},
+		},
+		{
+			name: "remove all members",
+			ops: []map[string]interface{}{
+				{"op": "remove", "path": "members"},
+			},
+			expectedName:    "guardians",
+			expectedMembers: []string{},
+		},
+		{
+			name: "replace members and name",
+			ops: []map[string]interface{}{
+				{"op": "replace", "path": "members", "value": []map[string]string{{"value": "yondu"}}},
+				{"op": "replace", "path": "displayName", "value": "ravagers"},
+			},
+			expectedName:    "ravagers",
+			expectedMembers: []string{"yondu"},
+		},
+		{
+			name: "replace name without path",
+			ops: []map[string]interface{}{
+				{"op": "replace", "value": map[string]interface{}{"id": "01J6GDH6QDW7FG2QZZQ3W0X2HE", "displayName": "ravagers"}},
+			},
+			errorIs: scim.ErrMutability,
+		},
+		{
+			name: "replace member that is not in the group",
+			ops: []map[string]interface{}{
+				{"op": "replace", "path": `members[value eq "ronan"]`, "value": map[string]string{"value": "nebula"}},
+			},
+			errorIs: scim.ErrNoTarget,
+		},
+		{
+			name: "unknown operation",
+			ops: []map[string]interface{}{
+				{"op": "merge", "path": "displayName", "value": "ravagers"},
+			},
+			errorIs: scim.ErrInvalidSyntax,
+		},
+		{
+			name: "remove without path",
+			ops: []map[string]interface{}{
+				{"op": "remove"},
+			},
+			errorIs: scim.ErrNoTarget,
+		},
+		{
+			name: "invalid path",
+			ops: []map[string]interface{}{
+				{"op": "remove", "path": `members[value eq]`},
+			},
+			errorIs: scim.ErrInvalidPath,
+		},
+		{
+			name: "replace without value",
+			ops: []map[string]interface{}{
+				{"op": "replace", "path": "displayName"},
+			},
+			errorIs: scim.ErrInvalidValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := newGroup()
+
+			err := newPatch(t, tc.ops...).Apply(g)
+			if tc.errorIs != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tc.errorIs)
+
+				// the group is not modified
+				assert.Equal(t, newGroup(), g)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedName, g.DisplayName)
+			assert.Equal(t, tc.expectedMembers, g.MemberIDs())
+			assert.Equal(t, "01J6GDH6QDW7FG2QZZQ3W0X2HE", g.ID)
+		})
+	}
+}
+
+func TestPatchUser(t *testing.T) {
+	newUser := func() *scim.User {
+		return &scim.User{
+			Schemas:  []string{scim.UserSchema},
+			UserName: "starlord@guardians.net",
+			Name: &scim.Name{
+				GivenName:  "Peter",
+				FamilyName: "Quill",
+			},
+			Emails: []scim.Email{
+				{Value: "starlord@guardians.net", Type: "work", Primary: true},
+			},
+			Active: scim.NewBool(true),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		ops      []map[string]interface{}
+		expected func(u *scim.User)
+	}{
+		{
+			name: "deactivate",
+			ops: []map[string]interface{}{
+				{"op": "replace", "path": "active", "value": false},
+			},
+			expected: func(u *scim.User) {
+				u.Active = scim.NewBool(false)
+			},
+		},
+		{
+			name: "deactivate with a string value",
+			ops: []map[string]interface{}{
+				{"op": "Replace", "value": map[string]interface{}{"active": "False"}},
+			},
+			expected: func(u *scim.User) {
+				u.Active = scim.NewBool(false)
+			},
+		},
+		{
+			name: "replace sub-attribute",
+			ops: []map[string]interface{}{
+				{"op": "replace", "path": "name.givenName", "value": "Star"},
+			},
+			expected: func(u *scim.User) {
+				u.Name.GivenName = "Star"
+			},
+		},
+		{
+			name: "replace sub-attribute without path",
+			ops: []map[string]interface{}{
+				{"op": "replace", "value": map[string]interface{}{"name.familyName": "Lord", "displayName": "Star-Lord"}},
+			},
+			expected: func(u *scim.User) {
+				u.Name.FamilyName = "Lord"
+				u.DisplayName = "Star-Lord"
+			},
+		},
+		{
+			name: "merge complex attribute",
+			ops: []map[string]interface{}{
+				{"op": "replace", "path": "name", "value": map[string]interface{}{"formatted": "Peter Quill"}},
+			},
+			expected: func(u *scim.User) {
+				u.Name.Formatted = "Peter Quill"
+			},
+		},
+		{
+			name: "replace email with filter",
+			ops: []map[string]interface{}{
+				{"op": "replace", "path": `emails[type eq "work"].value`, "value": "peter@guardians.net"},
+			},
+			expected: func(u *scim.User) {
+				u.Emails[0].Value = "peter@guardians.net"
+			},
+		},
+		{
+			name: "remove name",
+			ops: []map[string]interface{}{
+				{"op": "remove", "path": "name"},
+			},
+			expected: func(u *scim.User) {
+				u.Name = nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			u := newUser()
+
+			require.NoError(t, newPatch(t, tc.ops...).Apply(u))
+
+			expected := newUser()
+			tc.expected(expected)
+
+			assert.Equal(t, expected, u)
+		})
+	}
+}
+
+func TestPatchInvalidValue(t *testing.T) {
+	u := &scim.User{UserName: "starlord@guardians.net"}
+
+	err := newPatch(t, map[string]interface{}{"op": "replace", "path": "active", "value": "maybe"}).Apply(u)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, scim.ErrInvalidValue)
+}
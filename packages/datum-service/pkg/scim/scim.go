@@ -0,0 +1,268 @@
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ContentType is the media type of SCIM messages
+	ContentType = "application/scim+json"
+
+	// UserSchema is the schema of the user resource
+	UserSchema = "urn:ietf:params:scim:schemas:core:2.0:User"
+	// GroupSchema is the schema of the group resource
+	GroupSchema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	// ServiceProviderConfigSchema is the schema of the service provider configuration
+	ServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	// ListResponseSchema is the schema of the response of list requests
+	ListResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	// PatchOpSchema is the schema of patch requests
+	PatchOpSchema = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	// ErrorSchema is the schema of error responses
+	ErrorSchema = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	// ResourceTypeUser is the resource type of users
+	ResourceTypeUser = "User"
+	// ResourceTypeGroup is the resource type of groups
+	ResourceTypeGroup = "Group"
+
+	// DefaultCount is the number of resources returned by list requests that do not set a count
+	DefaultCount = 100
+	// MaxCount is the maximum number of resources returned by a list request
+	MaxCount = 1000
+)
+
+// Bool is a boolean that also accepts the string values "true" and "false" sent by some identity providers
+type Bool bool
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+
+	v, err := strconv.ParseBool(strings.ToLower(s))
+	if err != nil {
+		return ErrInvalidValue
+	}
+
+	*b = Bool(v)
+
+	return nil
+}
+
+// NewBool returns a pointer to the boolean
+func NewBool(b bool) *Bool {
+	v := Bool(b)
+
+	return &v
+}
+
+// Meta is the metadata of a resource
+type Meta struct {
+	ResourceType string     `json:"resourceType,omitempty"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+// Name is the name of a user
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+// Email is an email address of a user
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary Bool   `json:"primary,omitempty"`
+}
+
+// User is the SCIM user resource
+type User struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	Name        *Name    `json:"name,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Emails      []Email  `json:"emails,omitempty"`
+	Active      *Bool    `json:"active,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// PrimaryEmail returns the primary email address of the user, falling back to the first email address
+// and then the user name
+func (u *User) PrimaryEmail() string {
+	for _, e := range u.Emails {
+		if e.Primary && e.Value != "" {
+			return e.Value
+		}
+	}
+
+	for _, e := range u.Emails {
+		if e.Value != "" {
+			return e.Value
+		}
+	}
+
+	return u.UserName
+}
+
+// IsActive returns false only when the user is explicitly deactivated
+func (u *User) IsActive() bool {
+	return u.Active == nil || bool(*u.Active)
+}
+
+// Member is a member of a group
+type Member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+// Group is the SCIM group resource
+type Group struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// MemberIDs returns the unique ids of the members of the group
+func (g *Group) MemberIDs() []string {
+	ids := []string{}
+
+	for _, m := range g.Members {
+		if m.Value == "" || contains(ids, m.Value) {
+			continue
+		}
+
+		ids = append(ids, m.Value)
+	}
+
+	return ids
+}
+
+// ListResponse is the response of list requests
+type ListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+// NewListResponse returns the page of the resources starting at the 1-based start index
+func NewListResponse[T any](resources []T, startIndex, count int) *ListResponse {
+	if startIndex < 1 {
+		startIndex = 1
+	}
+
+	if count < 0 {
+		count = 0
+	}
+
+	if count > MaxCount {
+		count = MaxCount
+	}
+
+	page := []interface{}{}
+
+	for i := startIndex - 1; i < len(resources) && len(page) < count; i++ {
+		page = append(page, resources[i])
+	}
+
+	return &ListResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}
+}
+
+// PatchRequest is the request to modify a resource with a list of operations
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation is an add, remove or replace operation on the attribute at the path
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Supported is whether an optional feature of the protocol is supported
+type Supported struct {
+	Supported bool `json:"supported"`
+}
+
+// BulkSupported is the bulk configuration of the service provider
+type BulkSupported struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+// FilterSupported is the filter configuration of the service provider
+type FilterSupported struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+// AuthenticationScheme is an authentication scheme supported by the service provider
+type AuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary,omitempty"`
+}
+
+// ServiceProviderConfig describes the features of the protocol supported by the service provider
+type ServiceProviderConfig struct {
+	Schemas               []string               `json:"schemas"`
+	Patch                 Supported              `json:"patch"`
+	Bulk                  BulkSupported          `json:"bulk"`
+	Filter                FilterSupported        `json:"filter"`
+	ChangePassword        Supported              `json:"changePassword"`
+	Sort                  Supported              `json:"sort"`
+	ETag                  Supported              `json:"etag"`
+	AuthenticationSchemes []AuthenticationScheme `json:"authenticationSchemes"`
+}
+
+// NewServiceProviderConfig returns the configuration of the features implemented by this package
+func NewServiceProviderConfig() *ServiceProviderConfig {
+	return &ServiceProviderConfig{
+		Schemas: []string{ServiceProviderConfigSchema},
+		Patch:   Supported{Supported: true},
+		Filter:  FilterSupported{Supported: true, MaxResults: MaxCount},
+		AuthenticationSchemes: []AuthenticationScheme{
+			{
+				Type:        "oauthbearertoken",
+				Name:        "OAuth Bearer Token",
+				Description: "Authentication with an organization API token",
+				Primary:     true,
+			},
+		},
+	}
+}
+
+// contains returns true if the slice contains the string, ignoring case
+func contains(s []string, v string) bool {
+	for _, i := range s {
+		if strings.EqualFold(i, v) {
+			return true
+		}
+	}
+
+	return false
+}
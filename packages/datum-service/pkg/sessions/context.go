@@ -82,6 +82,19 @@ func SessionToken(ctx context.Context) (string, error) {
 	return sd.store.EncodeCookie(sd)
 }
 
+// SessionIDFromContext returns the ID of the session in the ctx
+func SessionIDFromContext(ctx context.Context) (string, error) {
+	sd := getSessionDataFromContext(ctx)
+	if sd == nil {
+		return "", ErrInvalidSession
+	}
+
+	sd.mu.Lock()
+	defer sd.mu.Unlock()
+
+	return sd.GetKey(), nil
+}
+
 // addSessionDataToContext adds the session details to the context
 func (s *Session[P]) addSessionDataToContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, SessionContextKey, s)
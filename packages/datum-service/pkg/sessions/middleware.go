@@ -2,6 +2,7 @@ package sessions
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/datumforge/echox/middleware"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
+
+	"github.com/datumforge/datum/pkg/middleware/echocontext"
 )
 
 // SessionConfig is used to configure session management
@@ -110,14 +113,48 @@ func (sc *SessionConfig) SaveAndStoreSession(ctx context.Context, w http.Respons
 		return c, err
 	}
 
-	ttl := time.Duration(sc.CookieConfig.MaxAge * int(time.Second))
+	ttl := sc.sessionTTL()
 	if err := sc.RedisStore.StoreSessionWithExpiration(c, sessionID, userID, ttl); err != nil {
 		return c, err
 	}
 
+	// the metadata is only needed to list the sessions of a user
+	if userID == "" {
+		return c, nil
+	}
+
+	if err := sc.RedisStore.StoreSessionMetadata(c, newSessionMetadata(ctx, sessionID, userID), ttl); err != nil {
+		return c, err
+	}
+
 	return c, nil
 }
 
+// sessionTTL returns how long sessions are kept in the persistent store, this matches the max age of the cookie
+func (sc *SessionConfig) sessionTTL() time.Duration {
+	return time.Duration(sc.CookieConfig.MaxAge * int(time.Second))
+}
+
+// newSessionMetadata returns the metadata of a new session, the device of the user is taken from the request when
+// the echo context is available
+func newSessionMetadata(ctx context.Context, sessionID, userID string) *SessionMetadata {
+	now := time.Now()
+
+	metadata := &SessionMetadata{
+		ID:           sessionID,
+		UserID:       userID,
+		CreatedAt:    now,
+		LastActivity: now,
+	}
+
+	if ec, err := echocontext.EchoContextFromContext(ctx); err == nil {
+		metadata.UserAgent = ec.Request().UserAgent()
+		metadata.IPAddress = ec.RealIP()
+	}
+
+	return metadata
+}
+
 // LoadAndSave is a middleware function that loads and saves session data using a
 // provided session manager. It takes a `SessionManager` as input and returns a middleware function
 // that can be used with an Echo framework application
@@ -181,12 +218,7 @@ func LoadAndSaveWithConfig(config SessionConfig) echo.MiddlewareFunc {
 			c.SetRequest(c.Request().WithContext(ctx))
 
 			c.Response().Before(func() {
-				// refresh and save session cookie
-				if err := config.CreateAndStoreSession(c, sessionID); err != nil {
-					config.Logger.Errorw("unable to create and store new session", "error", err)
-
-					panic(err)
-				}
+				config.refreshSession(c, session, sessionID)
 
 				addHeaderIfMissing(c.Response(), "Cache-Control", `no-cache="Set-Cookie"`)
 				addHeaderIfMissing(c.Response(), "Vary", "Cookie")
@@ -197,6 +229,28 @@ func LoadAndSaveWithConfig(config SessionConfig) echo.MiddlewareFunc {
 	}
 }
 
+// refreshSession records the activity on the session and refreshes the session cookie before the response is
+// written. The session may have been revoked during the request (e.g. by revoking the current session or locking the
+// user), in which case the cookie is expired instead. Errors are logged as the response can no longer be changed
+func (sc *SessionConfig) refreshSession(c echo.Context, session *Session[map[string]any], sessionID string) {
+	err := sc.RedisStore.TouchSession(c.Request().Context(), sessionID, sc.sessionTTL())
+
+	switch {
+	case errors.Is(err, redis.Nil):
+		RemoveCookie(c.Response().Writer, sc.CookieConfig.Name, *sc.CookieConfig)
+
+		return
+	case err != nil:
+		sc.Logger.Errorw("unable to refresh session", "error", err)
+
+		return
+	}
+
+	if err := session.Save(c.Response().Writer); err != nil {
+		sc.Logger.Errorw("unable to save session", "error", err)
+	}
+}
+
 // addHeaderIfMissing function is used to add a header to the HTTP response if it is not already
 // present. It takes in the response writer (`http.ResponseWriter`), the header key, and the header
 // value as parameters
@@ -0,0 +1,89 @@
+package sessions_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	echo "github.com/datumforge/echox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/sessions"
+)
+
+func TestLoadAndSaveRevokedSession(t *testing.T) {
+	testCases := []struct {
+		name          string
+		revoke        bool
+		expectExpired bool
+	}{
+		{
+			name: "happy path, session cookie is refreshed",
+		},
+		{
+			name:          "session revoked during the request, session cookie is expired",
+			revoke:        true,
+			expectExpired: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cookieConfig := *sessions.DebugCookieConfig
+			cookieConfig.Name = sessions.DevCookieName
+
+			sm := sessions.NewCookieStore[map[string]any](&cookieConfig,
+				[]byte("my-signing-secret"), []byte("encryptionsecret"))
+
+			config := sessions.NewSessionConfig(sm, sessions.WithPersistence(newRedisClient()))
+			config.CookieConfig = &cookieConfig
+
+			// create the session of the user
+			rec := httptest.NewRecorder()
+
+			ctx, err := config.SaveAndStoreSession(context.Background(), rec, map[string]any{sessions.UserIDKey: "MITB"}, "MITB")
+			require.NoError(t, err)
+
+			sessionID, err := sessions.SessionIDFromContext(ctx)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			for _, c := range rec.Result().Cookies() {
+				req.AddCookie(c)
+			}
+
+			rec = httptest.NewRecorder()
+			ec := echo.New().NewContext(req, rec)
+
+			handler := sessions.LoadAndSaveWithConfig(config)(func(c echo.Context) error {
+				if tc.revoke {
+					require.NoError(t, config.RedisStore.DeleteSession(c.Request().Context(), sessionID))
+				}
+
+				return c.NoContent(http.StatusOK)
+			})
+
+			require.NotPanics(t, func() {
+				require.NoError(t, handler(ec))
+			})
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			cookies := rec.Result().Cookies()
+			require.Len(t, cookies, 1)
+			assert.Equal(t, sessions.DevCookieName, cookies[0].Name)
+
+			if tc.expectExpired {
+				assert.Empty(t, cookies[0].Value)
+				assert.Negative(t, cookies[0].MaxAge)
+
+				return
+			}
+
+			assert.NotEmpty(t, cookies[0].Value)
+			assert.Positive(t, cookies[0].MaxAge)
+		})
+	}
+}
@@ -2,6 +2,9 @@ package sessions
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"slices"
 	"sync"
 	"time"
 
@@ -15,6 +18,34 @@ type PersistentStore interface {
 	StoreSession(ctx context.Context, key, value string) error
 	StoreSessionWithExpiration(ctx context.Context, key, value string, ttl time.Duration) error
 	DeleteSession(ctx context.Context, key string) error
+	DeleteUserSessions(ctx context.Context, userID string) error
+	StoreSessionMetadata(ctx context.Context, metadata *SessionMetadata, ttl time.Duration) error
+	TouchSession(ctx context.Context, key string, ttl time.Duration) error
+	GetUserSessions(ctx context.Context, userID string) ([]*SessionMetadata, error)
+}
+
+const (
+	// userSessionsKeyPrefix is the prefix of the keys of the sets holding the session IDs of each user
+	userSessionsKeyPrefix = "user_sessions:"
+	// sessionMetadataKeyPrefix is the prefix of the keys holding the metadata of each session
+	sessionMetadataKeyPrefix = "session_metadata:"
+)
+
+// SessionMetadata describes the device a session was created on and when it was last used, it is stored next to
+// the session so users can list where they are logged in
+type SessionMetadata struct {
+	// ID is the session ID
+	ID string `json:"id"`
+	// UserID is the ID of the user the session belongs to
+	UserID string `json:"userID"`
+	// UserAgent is the user agent of the request that created the session
+	UserAgent string `json:"userAgent,omitempty"`
+	// IPAddress is the IP address of the request that created the session
+	IPAddress string `json:"ipAddress,omitempty"`
+	// CreatedAt is the time the session was created
+	CreatedAt time.Time `json:"createdAt"`
+	// LastActivity is the last time the session was used
+	LastActivity time.Time `json:"lastActivity"`
 }
 
 var _ PersistentStore = &persistentStore{}
@@ -54,7 +85,7 @@ func (s *persistentStore) StoreSession(ctx context.Context, key, value string) e
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	return s.client.Set(ctx, key, value, defaultMaxAgeSeconds).Err()
+	return s.storeSession(ctx, key, value, defaultMaxAgeSeconds)
 }
 
 // StoreSessionWithExpiration is used to store a session in the store with a key and value and a time to live
@@ -62,13 +93,203 @@ func (s *persistentStore) StoreSessionWithExpiration(ctx context.Context, key, v
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	return s.client.Set(ctx, key, value, ttl).Err()
+	return s.storeSession(ctx, key, value, ttl)
 }
 
 // DeleteSession is used to delete a session from the store
-func (s *persistentStore) DeleteSession(ctx context.Context, userID string) error {
+func (s *persistentStore) DeleteSession(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	userID, err := s.client.Get(ctx, key).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.Del(ctx, key, sessionMetadataKey(key))
+
+	if userID != "" {
+		pipe.SRem(ctx, userSessionsKey(userID), key)
+	}
+
+	_, err = pipe.Exec(ctx)
+
+	return err
+}
+
+// DeleteUserSessions is used to delete all the sessions of the user from the store
+func (s *persistentStore) DeleteUserSessions(ctx context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessionIDs, err := s.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := []string{userSessionsKey(userID)}
+	for _, id := range sessionIDs {
+		keys = append(keys, id, sessionMetadataKey(id))
+	}
+
+	return s.client.Del(ctx, keys...).Err()
+}
+
+// StoreSessionMetadata is used to store the metadata of a session, the ttl should match the ttl of the session
+func (s *persistentStore) StoreSessionMetadata(ctx context.Context, metadata *SessionMetadata, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	return s.client.Set(ctx, sessionMetadataKey(metadata.ID), data, ttl).Err()
+}
+
+// TouchSession is used to record activity on a session, the last activity of the session is updated and the
+// session is kept for another ttl
+func (s *persistentStore) TouchSession(ctx context.Context, key string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	userID, err := s.client.Get(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+
+	metadata, err := s.getSessionMetadata(ctx, key)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.Expire(ctx, key, ttl)
+
+	if userID != "" {
+		pipe.Expire(ctx, userSessionsKey(userID), ttl)
+	}
+
+	// sessions created before metadata was recorded only have their ttl extended
+	if metadata != nil {
+		metadata.LastActivity = time.Now()
+
+		data, err := json.Marshal(metadata)
+		if err != nil {
+			return err
+		}
+
+		pipe.Set(ctx, sessionMetadataKey(key), data, ttl)
+	}
+
+	_, err = pipe.Exec(ctx)
+
+	return err
+}
+
+// GetUserSessions returns the metadata of the active sessions of the user, most recently used first; sessions that
+// expired are removed from the sessions of the user
+func (s *persistentStore) GetUserSessions(ctx context.Context, userID string) ([]*SessionMetadata, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	return s.client.Del(ctx, userID).Err()
+	sessionIDs, err := s.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	out := []*SessionMetadata{}
+
+	if len(sessionIDs) == 0 {
+		return out, nil
+	}
+
+	// the session and its metadata are fetched together to know whether the session is still active
+	keys := []string{}
+	for _, id := range sessionIDs {
+		keys = append(keys, id, sessionMetadataKey(id))
+	}
+
+	values, err := s.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	expired := []any{}
+
+	for i, id := range sessionIDs {
+		session, data := values[2*i], values[2*i+1]
+
+		if session == nil {
+			expired = append(expired, id)
+
+			continue
+		}
+
+		metadata := &SessionMetadata{ID: id, UserID: userID}
+
+		if data != nil {
+			if err := json.Unmarshal([]byte(data.(string)), metadata); err != nil {
+				return nil, err
+			}
+		}
+
+		out = append(out, metadata)
+	}
+
+	if len(expired) > 0 {
+		if err := s.client.SRem(ctx, userSessionsKey(userID), expired...).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	slices.SortFunc(out, func(a, b *SessionMetadata) int {
+		return b.LastActivity.Compare(a.LastActivity)
+	})
+
+	return out, nil
+}
+
+// storeSession stores the session and adds it to the sessions of the user, the value of the session is the user ID
+func (s *persistentStore) storeSession(ctx context.Context, key, userID string, ttl time.Duration) error {
+	pipe := s.client.TxPipeline()
+	pipe.Set(ctx, key, userID, ttl)
+
+	// sessions created before the user is known (e.g. webauthn login) are not added to the sessions of a user
+	if userID != "" {
+		pipe.SAdd(ctx, userSessionsKey(userID), key)
+		// the sessions of a user share the same ttl, so the set expires with the latest session
+		pipe.Expire(ctx, userSessionsKey(userID), ttl)
+	}
+
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// getSessionMetadata returns the metadata of the session
+func (s *persistentStore) getSessionMetadata(ctx context.Context, key string) (*SessionMetadata, error) {
+	data, err := s.client.Get(ctx, sessionMetadataKey(key)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &SessionMetadata{}
+	if err := json.Unmarshal(data, metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// userSessionsKey returns the key of the set holding the session IDs of the user
+func userSessionsKey(userID string) string {
+	return userSessionsKeyPrefix + userID
+}
+
+// sessionMetadataKey returns the key holding the metadata of the session
+func sessionMetadataKey(sessionID string) string {
+	return sessionMetadataKeyPrefix + sessionID
 }
@@ -171,6 +171,123 @@ func TestDeleteSession(t *testing.T) {
 	}
 }
 
+func TestDeleteUserSessions(t *testing.T) {
+	rc := newRedisClient()
+	ps := sessions.NewStore(rc)
+
+	ctx := context.Background()
+
+	userSessions := []string{sessions.GenerateSessionID(), sessions.GenerateSessionID()}
+	for _, s := range userSessions {
+		require.NoError(t, ps.StoreSessionWithExpiration(ctx, s, "MITB", time.Hour))
+	}
+
+	otherSession := sessions.GenerateSessionID()
+	require.NoError(t, ps.StoreSessionWithExpiration(ctx, otherSession, "SITB", time.Hour))
+
+	// deleting a single session removes it from the sessions of the user
+	require.NoError(t, ps.DeleteSession(ctx, userSessions[0]))
+	assert.Equal(t, []string{userSessions[1]}, mustMembers(t, "user_sessions:MITB"))
+
+	require.NoError(t, ps.DeleteUserSessions(ctx, "MITB"))
+
+	exists, err := ps.Exists(ctx, userSessions[1])
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), exists)
+
+	// sessions of other users are not deleted
+	exists, err = ps.Exists(ctx, otherSession)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), exists)
+
+	// deleting the sessions of a user without sessions should not error
+	require.NoError(t, ps.DeleteUserSessions(ctx, "MITB"))
+}
+
+func TestGetUserSessions(t *testing.T) {
+	rc := newRedisClient()
+	ps := sessions.NewStore(rc)
+
+	ctx := context.Background()
+
+	created := time.Now().Add(-time.Hour)
+
+	laptop := sessions.GenerateSessionID()
+	require.NoError(t, ps.StoreSessionWithExpiration(ctx, laptop, "MITB", time.Hour))
+	require.NoError(t, ps.StoreSessionMetadata(ctx, &sessions.SessionMetadata{
+		ID:           laptop,
+		UserID:       "MITB",
+		UserAgent:    "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_5)",
+		IPAddress:    "10.0.0.1",
+		CreatedAt:    created,
+		LastActivity: created,
+	}, time.Hour))
+
+	phone := sessions.GenerateSessionID()
+	require.NoError(t, ps.StoreSessionWithExpiration(ctx, phone, "MITB", 2*time.Hour))
+	require.NoError(t, ps.StoreSessionMetadata(ctx, &sessions.SessionMetadata{
+		ID:           phone,
+		UserID:       "MITB",
+		UserAgent:    "Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X)",
+		IPAddress:    "10.0.0.2",
+		CreatedAt:    created,
+		LastActivity: created.Add(time.Minute),
+	}, 2*time.Hour))
+
+	// sessions without metadata are still listed
+	legacy := sessions.GenerateSessionID()
+	require.NoError(t, ps.StoreSessionWithExpiration(ctx, legacy, "MITB", 2*time.Hour))
+
+	// the most recently used sessions are listed first
+	userSessions, err := ps.GetUserSessions(ctx, "MITB")
+	require.NoError(t, err)
+	require.Len(t, userSessions, 3)
+	assert.Equal(t, phone, userSessions[0].ID)
+	assert.Equal(t, "10.0.0.2", userSessions[0].IPAddress)
+	assert.Equal(t, laptop, userSessions[1].ID)
+	assert.Contains(t, userSessions[1].UserAgent, "Macintosh")
+	assert.Equal(t, legacy, userSessions[2].ID)
+	assert.Equal(t, "MITB", userSessions[2].UserID)
+
+	// touching a session records the activity and keeps the session
+	require.NoError(t, ps.TouchSession(ctx, laptop, 2*time.Hour))
+
+	userSessions, err = ps.GetUserSessions(ctx, "MITB")
+	require.NoError(t, err)
+	require.Len(t, userSessions, 3)
+	assert.Equal(t, laptop, userSessions[0].ID)
+	assert.WithinDuration(t, time.Now(), userSessions[0].LastActivity, time.Minute)
+	assert.WithinDuration(t, created, userSessions[0].CreatedAt, time.Second)
+
+	// expired sessions are removed from the sessions of the user
+	mr.FastForward(2 * time.Hour)
+
+	userSessions, err = ps.GetUserSessions(ctx, "MITB")
+	require.NoError(t, err)
+	assert.Empty(t, userSessions)
+	assert.False(t, mr.Exists("user_sessions:MITB"))
+
+	// expired sessions can not be touched
+	require.ErrorIs(t, ps.TouchSession(ctx, laptop, time.Hour), redis.Nil)
+
+	// revoking a session removes its metadata
+	require.NoError(t, ps.StoreSessionWithExpiration(ctx, laptop, "MITB", time.Hour))
+	require.NoError(t, ps.StoreSessionMetadata(ctx, &sessions.SessionMetadata{ID: laptop, UserID: "MITB"}, time.Hour))
+	require.NoError(t, ps.DeleteSession(ctx, laptop))
+	assert.False(t, mr.Exists("session_metadata:"+laptop))
+
+	userSessions, err = ps.GetUserSessions(ctx, "MITB")
+	require.NoError(t, err)
+	assert.Empty(t, userSessions)
+}
+
+func mustMembers(t *testing.T, key string) []string {
+	members, err := mr.Members(key)
+	require.NoError(t, err)
+
+	return members
+}
+
 func newRedisClient() *redis.Client {
 	var err error
 
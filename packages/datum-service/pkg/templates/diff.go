@@ -0,0 +1,116 @@
+package templates
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ChangeOperation is the kind of change made to a value of a schema between two versions
+type ChangeOperation string
+
+const (
+	// ChangeAdded is a value that only exists in the newer schema
+	ChangeAdded ChangeOperation = "ADDED"
+	// ChangeRemoved is a value that only exists in the older schema
+	ChangeRemoved ChangeOperation = "REMOVED"
+	// ChangeModified is a value that exists in both schemas with different contents
+	ChangeModified ChangeOperation = "CHANGED"
+)
+
+// Change is a difference between two schemas at the JSON pointer of the value
+type Change struct {
+	Path      string          `json:"path"`
+	Operation ChangeOperation `json:"operation"`
+	From      any             `json:"from,omitempty"`
+	To        any             `json:"to,omitempty"`
+}
+
+// Diff returns the changes required to turn the from schema into the to schema, sorted by path. Objects are
+// compared key by key, any other values (including arrays) are reported as a single change
+func Diff(from, to map[string]any) []Change {
+	changes := []Change{}
+
+	diffObjects("", from, to, &changes)
+
+	slices.SortStableFunc(changes, func(a, b Change) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return changes
+}
+
+// diffObjects appends the changes between the objects at the path
+func diffObjects(path string, from, to map[string]any, changes *[]Change) {
+	for k, v := range from {
+		p := path + "/" + escapePointer(k)
+
+		nv, ok := to[k]
+		if !ok {
+			*changes = append(*changes, Change{Path: p, Operation: ChangeRemoved, From: v})
+
+			continue
+		}
+
+		diffValues(p, v, nv, changes)
+	}
+
+	for k, v := range to {
+		if _, ok := from[k]; !ok {
+			*changes = append(*changes, Change{Path: path + "/" + escapePointer(k), Operation: ChangeAdded, To: v})
+		}
+	}
+}
+
+// diffValues appends the changes between the values at the path, descending into objects
+func diffValues(path string, from, to any, changes *[]Change) {
+	fromObj, fromIsObj := from.(map[string]any)
+	toObj, toIsObj := to.(map[string]any)
+
+	if fromIsObj && toIsObj {
+		diffObjects(path, fromObj, toObj, changes)
+
+		return
+	}
+
+	if !equal(from, to) {
+		*changes = append(*changes, Change{Path: path, Operation: ChangeModified, From: from, To: to})
+	}
+}
+
+// equal compares the values, numbers are compared by value as schemas are decoded into different types
+func equal(a, b any) bool {
+	if af, ok := toFloat(a); ok {
+		if bf, ok := toFloat(b); ok {
+			return af == bf
+		}
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// toFloat returns the numeric value of the json number
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case interface{ String() string }:
+		f, err := strconv.ParseFloat(n.String(), 64)
+
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+// escapePointer escapes the key to be used as a JSON pointer reference token (RFC 6901)
+func escapePointer(k string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(k)
+}
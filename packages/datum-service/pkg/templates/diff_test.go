@@ -0,0 +1,78 @@
+package templates_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/datumforge/datum/pkg/templates"
+)
+
+func TestDiff(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     map[string]any
+		to       map[string]any
+		expected []templates.Change
+	}{
+		{
+			name:     "no changes",
+			from:     ndaSchema,
+			to:       ndaSchema,
+			expected: []templates.Change{},
+		},
+		{
+			name:     "numbers of different types are equal",
+			from:     map[string]any{"minimum": 1},
+			to:       map[string]any{"minimum": float64(1)},
+			expected: []templates.Change{},
+		},
+		{
+			name: "added, removed and changed values",
+			from: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name":  map[string]any{"type": "string"},
+					"years": map[string]any{"type": "integer", "minimum": 1},
+				},
+				"required": []any{"name"},
+			},
+			to: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name":  map[string]any{"type": "string", "minLength": 1},
+					"email": map[string]any{"type": "string"},
+				},
+				"required": []any{"name", "email"},
+			},
+			expected: []templates.Change{
+				{Path: "/properties/email", Operation: templates.ChangeAdded, To: map[string]any{"type": "string"}},
+				{Path: "/properties/name/minLength", Operation: templates.ChangeAdded, To: 1},
+				{Path: "/properties/years", Operation: templates.ChangeRemoved, From: map[string]any{"type": "integer", "minimum": 1}},
+				{Path: "/required", Operation: templates.ChangeModified, From: []any{"name"}, To: []any{"name", "email"}},
+			},
+		},
+		{
+			name: "keys are escaped",
+			from: map[string]any{},
+			to:   map[string]any{"patternProperties": map[string]any{"^a/b~c$": true}},
+			expected: []templates.Change{
+				{Path: "/patternProperties", Operation: templates.ChangeAdded, To: map[string]any{"^a/b~c$": true}},
+			},
+		},
+		{
+			name: "nested keys are escaped",
+			from: map[string]any{"patternProperties": map[string]any{}},
+			to:   map[string]any{"patternProperties": map[string]any{"^a/b~c$": true}},
+			expected: []templates.Change{
+				{Path: "/patternProperties/^a~1b~0c$", Operation: templates.ChangeAdded, To: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, templates.Diff(tc.from, tc.to))
+		})
+	}
+}
@@ -0,0 +1,3 @@
+// Package templates validates the JSON Schema of templates and the data of the documents created from them,
+// and renders the documents as HTML or PDF with the layout of their template
+package templates
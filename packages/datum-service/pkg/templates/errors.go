@@ -0,0 +1,59 @@
+package templates
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrExternalReference is returned when a template schema references a schema outside of the template
+	ErrExternalReference = errors.New("external schema references are not supported")
+	// ErrUnsupportedFormat is returned when a document is rendered in a format that is not supported
+	ErrUnsupportedFormat = errors.New("unsupported render format")
+)
+
+// FieldError is a single violation of a schema at the location of the field in the validated document
+type FieldError struct {
+	// Path is the JSON pointer to the field, e.g. `/address/zip`, the root of the document is an empty path
+	Path string `json:"path"`
+	// Message describes the violation
+	Message string `json:"message"`
+}
+
+// ValidationError is returned when the data of a document does not conform to the schema of its template
+type ValidationError struct {
+	Errors []FieldError
+}
+
+// Error returns the ValidationError in string format
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("document data does not conform to the template schema: %s", summary(e.Errors))
+}
+
+// SchemaError is returned when the schema of a template is not a valid JSON Schema document
+type SchemaError struct {
+	Errors []FieldError
+}
+
+// Error returns the SchemaError in string format
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("template jsonconfig is not a valid json schema: %s", summary(e.Errors))
+}
+
+// summary returns the first violation and the number of remaining violations
+func summary(errs []FieldError) string {
+	if len(errs) == 0 {
+		return "unknown error"
+	}
+
+	s := errs[0].Message
+	if errs[0].Path != "" {
+		s = fmt.Sprintf("%s: %s", errs[0].Path, s)
+	}
+
+	if len(errs) > 1 {
+		s = fmt.Sprintf("%s (and %d more)", s, len(errs)-1)
+	}
+
+	return s
+}
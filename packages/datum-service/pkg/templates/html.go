@@ -0,0 +1,55 @@
+package templates
+
+import (
+	"html/template"
+	"io"
+)
+
+// htmlTemplate is the standalone page documents are rendered into, the styles are inlined so the page can be
+// saved and opened without access to the server
+var htmlTemplate = template.Must(template.New("document").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; font-size: 14px; color: #1a1a1a; max-width: 800px; margin: 40px auto; }
+h1 { font-size: 24px; margin-bottom: 4px; }
+.description { color: #555; margin-top: 0; }
+.heading { font-size: 16px; font-weight: bold; margin: 20px 0 8px; }
+.field { display: flex; padding: 4px 0; border-bottom: 1px solid #eee; }
+.label { flex: 0 0 35%; font-weight: bold; }
+.value { flex: 1; white-space: pre-wrap; }
+.text { margin: 8px 0; }
+</style>
+</head>
+<body>
+<h1>{{ .Title }}</h1>
+{{- with .Description }}
+<p class="description">{{ . }}</p>
+{{- end }}
+{{- range .Blocks }}
+{{- if eq .Kind "heading" }}
+<div class="heading" style="margin-left: {{ .Level }}em">{{ .Label }}</div>
+{{- else if eq .Kind "text" }}
+<p class="text" style="margin-left: {{ .Level }}em">{{ .Value }}</p>
+{{- else }}
+<div class="field" style="margin-left: {{ .Level }}em"><span class="label">{{ .Label }}</span><span class="value">{{ .Value }}</span></div>
+{{- end }}
+{{- end }}
+</body>
+</html>
+`))
+
+// renderHTML writes the blocks of the document as an HTML page, all values are escaped by the template
+func renderHTML(w io.Writer, doc Document, blocks []block) error {
+	return htmlTemplate.Execute(w, struct {
+		Title       string
+		Description string
+		Blocks      []block
+	}{
+		Title:       doc.Title,
+		Description: doc.Description,
+		Blocks:      blocks,
+	})
+}
@@ -0,0 +1,104 @@
+package templates
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const (
+	// pdfFont is one of the core PDF fonts, core fonts are available in every reader and are not embedded
+	pdfFont = "Helvetica"
+	// pdfIndent is the indentation of each nested level in mm
+	pdfIndent = 6
+	// pdfLabelWidth is the portion of the line used by the label of a field
+	pdfLabelWidth = 0.35
+	// pdfLineHeight is the height of a line of text in mm
+	pdfLineHeight = 5.5
+)
+
+// renderPDF writes the blocks of the document as an A4 PDF
+func renderPDF(w io.Writer, doc Document, blocks []block) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+
+	// the core fonts use the cp1252 code page, runes that cannot be encoded are dropped
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.SetTitle(doc.Title, true)
+	pdf.SetCreator("datum", true)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-15)                                                                                 //nolint:mnd
+		pdf.SetFont(pdfFont, "", 8)                                                                   //nolint:mnd
+		pdf.SetTextColor(128, 128, 128)                                                               //nolint:mnd
+		pdf.CellFormat(0, 10, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "C", false, 0, "") //nolint:mnd
+	})
+
+	pdf.AddPage()
+
+	pageWidth, pageHeight := pdf.GetPageSize()
+	left, _, right, bottom := pdf.GetMargins()
+	width := pageWidth - left - right
+
+	pdf.SetFont(pdfFont, "B", 18)                      //nolint:mnd
+	pdf.MultiCell(0, 9, tr(doc.Title), "", "L", false) //nolint:mnd
+
+	if doc.Description != "" {
+		pdf.SetFont(pdfFont, "", 10) //nolint:mnd
+		pdf.SetTextColor(85, 85, 85) //nolint:mnd
+		pdf.MultiCell(0, pdfLineHeight, tr(doc.Description), "", "L", false)
+		pdf.SetTextColor(0, 0, 0)
+	}
+
+	pdf.Ln(4) //nolint:mnd
+
+	for _, b := range blocks {
+		indent := float64(b.Level * pdfIndent)
+		lineWidth := width - indent
+
+		switch b.Kind {
+		case blockHeading:
+			pdf.Ln(2) //nolint:mnd
+			pdf.SetX(left + indent)
+			pdf.SetFont(pdfFont, "B", 13)                            //nolint:mnd
+			pdf.MultiCell(lineWidth, 7, tr(b.Label), "", "L", false) //nolint:mnd
+		case blockText:
+			pdf.SetX(left + indent)
+			pdf.SetFont(pdfFont, "", 10) //nolint:mnd
+			pdf.MultiCell(lineWidth, pdfLineHeight, tr(b.Value), "", "L", false)
+		case blockField:
+			labelWidth := lineWidth * pdfLabelWidth
+
+			pdf.SetFont(pdfFont, "B", 10) //nolint:mnd
+			labelHeight := float64(len(pdf.SplitText(tr(b.Label), labelWidth))) * pdfLineHeight
+
+			pdf.SetFont(pdfFont, "", 10) //nolint:mnd
+			valueHeight := float64(len(pdf.SplitText(tr(b.Value), lineWidth-labelWidth))) * pdfLineHeight
+
+			// keep the label and the start of the value on the same page
+			if pdf.GetY()+min(max(labelHeight, valueHeight), pdfLineHeight*4) > pageHeight-bottom { //nolint:mnd
+				pdf.AddPage()
+			}
+
+			y, page := pdf.GetY(), pdf.PageNo()
+
+			pdf.SetXY(left+indent, y)
+			pdf.SetFont(pdfFont, "B", 10) //nolint:mnd
+			pdf.MultiCell(labelWidth, pdfLineHeight, tr(b.Label), "", "L", false)
+
+			pdf.SetXY(left+indent+labelWidth, y)
+			pdf.SetFont(pdfFont, "", 10) //nolint:mnd
+			pdf.MultiCell(lineWidth-labelWidth, pdfLineHeight, tr(b.Value), "", "L", false)
+
+			// the label and value wrap independently, the next field starts below the longest of the two
+			if pdf.PageNo() == page {
+				pdf.SetY(max(y+labelHeight, pdf.GetY()))
+			}
+
+			pdf.Ln(1)
+		}
+	}
+
+	return pdf.Output(w)
+}
@@ -0,0 +1,309 @@
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/samber/lo"
+)
+
+// Format is the output format of a rendered document
+type Format string
+
+const (
+	// FormatHTML renders the document as a standalone HTML page
+	FormatHTML Format = "HTML"
+	// FormatPDF renders the document as a PDF
+	FormatPDF Format = "PDF"
+)
+
+// ParseFormat returns the format matching the case insensitive name
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToUpper(s)); f {
+	case FormatHTML, FormatPDF:
+		return f, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrUnsupportedFormat, s)
+}
+
+// ContentType returns the media type of the format
+func (f Format) ContentType() string {
+	if f == FormatHTML {
+		return "text/html; charset=utf-8"
+	}
+
+	return "application/pdf"
+}
+
+// Extension returns the file extension of the format, without the leading dot
+func (f Format) Extension() string {
+	return strings.ToLower(string(f))
+}
+
+// Document is the data of a document with the template it was created from
+type Document struct {
+	// Title is shown at the top of the rendered document, usually the name of the template
+	Title string
+	// Description is shown below the title
+	Description string
+	// Schema is the JSON Schema of the template, titles of the properties are used as labels
+	Schema map[string]any
+	// UISchema is the JSON Forms layout of the template, when empty the properties of the schema are rendered in order
+	UISchema map[string]any
+	// Data is the data of the document
+	Data map[string]any
+}
+
+// Render writes the document in the format to the writer
+func Render(w io.Writer, format Format, doc Document) error {
+	blocks := layout(doc)
+
+	switch format {
+	case FormatHTML:
+		return renderHTML(w, doc, blocks)
+	case FormatPDF:
+		return renderPDF(w, doc, blocks)
+	}
+
+	return fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+}
+
+// blockKind is the kind of element in the layout of a rendered document
+type blockKind string
+
+const (
+	blockHeading blockKind = "heading"
+	blockField   blockKind = "field"
+	blockText    blockKind = "text"
+)
+
+// block is a single element of the rendered document, nested groups are flattened into blocks with a deeper level
+type block struct {
+	Kind  blockKind
+	Level int
+	Label string
+	Value string
+}
+
+// layout returns the blocks of the document following the uischema of the template
+func layout(doc Document) []block {
+	blocks := []block{}
+
+	if _, ok := doc.UISchema["type"]; ok {
+		walkElement(doc, doc.UISchema, 0, &blocks)
+
+		return blocks
+	}
+
+	// without a layout the document is rendered property by property
+	for _, k := range propertyKeys(doc.Schema, doc.Data) {
+		appendValue(label(nil, propertySchema(doc.Schema, k), k), doc.Data[k], propertySchema(doc.Schema, k), 0, &blocks)
+	}
+
+	return blocks
+}
+
+// walkElement appends the blocks of a JSON Forms uischema element
+func walkElement(doc Document, el map[string]any, level int, blocks *[]block) {
+	elType, _ := el["type"].(string)
+
+	switch elType {
+	case "Control":
+		path := scopePath(el["scope"])
+		if len(path) == 0 {
+			return
+		}
+
+		schema := doc.Schema
+		for _, p := range path {
+			schema = propertySchema(schema, p)
+		}
+
+		appendValue(label(el["label"], schema, path[len(path)-1]), valueAt(doc.Data, path), schema, level, blocks)
+
+		return
+	case "Label":
+		if text, ok := el["text"].(string); ok && text != "" {
+			*blocks = append(*blocks, block{Kind: blockText, Level: level, Value: text})
+		}
+
+		return
+	case "Group", "Category":
+		if l, ok := el["label"].(string); ok && l != "" {
+			*blocks = append(*blocks, block{Kind: blockHeading, Level: level, Label: l})
+			level++
+		}
+	}
+
+	// layouts and categorizations only group their elements
+	elements, _ := el["elements"].([]any)
+	for _, e := range elements {
+		if child, ok := e.(map[string]any); ok {
+			walkElement(doc, child, level, blocks)
+		}
+	}
+}
+
+// appendValue appends the blocks of a value, objects and arrays of objects are rendered as a group of fields
+func appendValue(l string, v any, schema map[string]any, level int, blocks *[]block) {
+	switch val := v.(type) {
+	case map[string]any:
+		if l != "" {
+			*blocks = append(*blocks, block{Kind: blockHeading, Level: level, Label: l})
+		}
+
+		for _, k := range propertyKeys(schema, val) {
+			ps := propertySchema(schema, k)
+			appendValue(label(nil, ps, k), val[k], ps, level+1, blocks)
+		}
+
+		return
+	case []any:
+		if slices.ContainsFunc(val, func(item any) bool { _, ok := item.(map[string]any); return ok }) {
+			items, _ := schema["items"].(map[string]any)
+
+			for i, item := range val {
+				appendValue(strings.TrimSpace(fmt.Sprintf("%s %d", l, i+1)), item, items, level, blocks)
+			}
+
+			return
+		}
+	}
+
+	*blocks = append(*blocks, block{Kind: blockField, Level: level, Label: l, Value: formatValue(v)})
+}
+
+// formatValue returns the human readable value of a scalar, arrays of scalars are joined by commas
+func formatValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case bool:
+		if val {
+			return "Yes"
+		}
+
+		return "No"
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case json.Number:
+		return val.String()
+	case []any:
+		return strings.Join(lo.Map(val, func(item any, _ int) string { return formatValue(item) }), ", ")
+	}
+
+	return fmt.Sprint(v)
+}
+
+// label returns the label of a field, the label of the uischema element takes precedence over the title
+// of the property, a label of false hides the label
+func label(uiLabel any, schema map[string]any, key string) string {
+	switch l := uiLabel.(type) {
+	case string:
+		return l
+	case bool:
+		if !l {
+			return ""
+		}
+	}
+
+	if title, ok := schema["title"].(string); ok && title != "" {
+		return title
+	}
+
+	return humanize(key)
+}
+
+// humanize returns the property name as a sentence, e.g. `firstName` and `first_name` are returned as `First name`
+func humanize(key string) string {
+	var b strings.Builder
+
+	for i, r := range key {
+		switch {
+		case r == '_' || r == '-':
+			b.WriteRune(' ')
+		case i == 0:
+			b.WriteRune(unicode.ToUpper(r))
+		case unicode.IsUpper(r):
+			b.WriteRune(' ')
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// scopePath returns the property names of a JSON Forms scope, e.g. `#/properties/party/properties/email`
+// is returned as [party email]
+func scopePath(scope any) []string {
+	s, _ := scope.(string)
+
+	tokens := strings.Split(strings.TrimPrefix(s, "#/"), "/")
+	path := []string{}
+
+	for i := 0; i+1 < len(tokens); i += 2 {
+		if tokens[i] != "properties" {
+			return nil
+		}
+
+		path = append(path, unescapePointer(tokens[i+1]))
+	}
+
+	return path
+}
+
+// valueAt returns the value of the data at the property path, nil when it does not exist
+func valueAt(data map[string]any, path []string) any {
+	var v any = data
+
+	for _, p := range path {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		v = obj[p]
+	}
+
+	return v
+}
+
+// propertySchema returns the schema of the property of an object schema, nil when it is not defined
+func propertySchema(schema map[string]any, key string) map[string]any {
+	props, _ := schema["properties"].(map[string]any)
+	ps, _ := props[key].(map[string]any)
+
+	return ps
+}
+
+// propertyKeys returns the properties to render, the properties defined by the schema are listed first
+// followed by any additional properties of the data, both sorted by name
+func propertyKeys(schema, data map[string]any) []string {
+	props, _ := schema["properties"].(map[string]any)
+
+	keys := lo.Keys(props)
+	slices.Sort(keys)
+
+	extra := lo.Filter(lo.Keys(data), func(k string, _ int) bool {
+		_, ok := props[k]
+		return !ok
+	})
+	slices.Sort(extra)
+
+	return append(keys, extra...)
+}
+
+// unescapePointer unescapes a JSON pointer reference token (RFC 6901)
+func unescapePointer(k string) string {
+	return strings.NewReplacer("~1", "/", "~0", "~").Replace(k)
+}
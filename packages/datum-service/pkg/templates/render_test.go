@@ -0,0 +1,149 @@
+package templates_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/templates"
+)
+
+var contractDocument = templates.Document{
+	Title:       "Mutual NDA",
+	Description: "non-disclosure agreement",
+	Schema: map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"name":      map[string]any{"type": "string", "title": "Company name"},
+			"years":     map[string]any{"type": "integer"},
+			"signed":    map[string]any{"type": "boolean"},
+			"firstName": map[string]any{"type": "string"},
+			"address": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"zip": map[string]any{"type": "string"},
+				},
+			},
+		},
+	},
+	Data: map[string]any{
+		"name":      "<script>MITB</script>",
+		"years":     float64(2),
+		"signed":    true,
+		"firstName": "sarah",
+		"address":   map[string]any{"zip": "94107"},
+		"tags":      []any{"legal", "sales"},
+	},
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := templates.ParseFormat("pdf")
+	require.NoError(t, err)
+	assert.Equal(t, templates.FormatPDF, f)
+	assert.Equal(t, "application/pdf", f.ContentType())
+	assert.Equal(t, "pdf", f.Extension())
+
+	f, err = templates.ParseFormat("HTML")
+	require.NoError(t, err)
+	assert.Equal(t, templates.FormatHTML, f)
+	assert.Equal(t, "html", f.Extension())
+
+	_, err = templates.ParseFormat("docx")
+	assert.ErrorIs(t, err, templates.ErrUnsupportedFormat)
+}
+
+func TestRenderHTML(t *testing.T) {
+	testCases := []struct {
+		name       string
+		uischema   map[string]any
+		expected   []string
+		unexpected []string
+	}{
+		{
+			name: "default layout",
+			expected: []string{
+				"<title>Mutual NDA</title>",
+				`<p class="description">non-disclosure agreement</p>`,
+				`<div class="heading" style="margin-left: 0em">Address</div>`,
+				`<span class="label">Zip</span><span class="value">94107</span>`,
+				`<span class="label">Company name</span><span class="value">&lt;script&gt;MITB&lt;/script&gt;</span>`,
+				`<span class="label">First name</span><span class="value">sarah</span>`,
+				`<span class="label">Signed</span><span class="value">Yes</span>`,
+				`<span class="label">Years</span><span class="value">2</span>`,
+				`<span class="label">Tags</span><span class="value">legal, sales</span>`,
+			},
+			unexpected: []string{"<script>"},
+		},
+		{
+			name: "uischema layout",
+			uischema: map[string]any{
+				"type": "VerticalLayout",
+				"elements": []any{
+					map[string]any{"type": "Label", "text": "Parties"},
+					map[string]any{"type": "Control", "scope": "#/properties/name", "label": "Company"},
+					map[string]any{
+						"type":  "Group",
+						"label": "Location",
+						"elements": []any{
+							map[string]any{"type": "Control", "scope": "#/properties/address/properties/zip"},
+						},
+					},
+				},
+			},
+			expected: []string{
+				`<p class="text" style="margin-left: 0em">Parties</p>`,
+				`<span class="label">Company</span>`,
+				`<div class="heading" style="margin-left: 0em">Location</div>`,
+				`<div class="field" style="margin-left: 1em"><span class="label">Zip</span><span class="value">94107</span></div>`,
+			},
+			unexpected: []string{"Years", "First name"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc := contractDocument
+			doc.UISchema = tc.uischema
+
+			var buf bytes.Buffer
+
+			require.NoError(t, templates.Render(&buf, templates.FormatHTML, doc))
+
+			out := buf.String()
+
+			for _, e := range tc.expected {
+				assert.Contains(t, out, e)
+			}
+
+			for _, u := range tc.unexpected {
+				assert.NotContains(t, out, u)
+			}
+		})
+	}
+}
+
+func TestRenderHTMLOrder(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, templates.Render(&buf, templates.FormatHTML, contractDocument))
+
+	out := buf.String()
+
+	// properties of the schema are rendered before the additional properties of the data
+	assert.Less(t, strings.Index(out, "Years"), strings.Index(out, "Tags"))
+}
+
+func TestRenderPDF(t *testing.T) {
+	var buf bytes.Buffer
+
+	doc := contractDocument
+	doc.Data = map[string]any{"name": strings.Repeat("long value ", 2000)}
+
+	require.NoError(t, templates.Render(&buf, templates.FormatPDF, doc))
+
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+	assert.Contains(t, buf.String(), "/Title")
+}
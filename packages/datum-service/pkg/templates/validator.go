@@ -0,0 +1,167 @@
+package templates
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const (
+	// schemaURL is the location the template schemas are compiled at, relative references resolve against it
+	// and are rejected as external references
+	schemaURL = "https://datum.net/schemas/template.json"
+
+	cacheSize = 256
+)
+
+// compiled caches the compiled schemas by the hash of their content, so an updated template schema is compiled
+// again while unchanged schemas shared by many documents are compiled once
+var compiled, _ = lru.New[string, *jsonschema.Schema](cacheSize)
+
+// Compile returns the compiled JSON Schema, a *SchemaError is returned when the schema is invalid
+func Compile(schema map[string]any) (*jsonschema.Schema, error) {
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(b)
+	key := hex.EncodeToString(sum[:])
+
+	if s, ok := compiled.Get(key); ok {
+		return s, nil
+	}
+
+	c := jsonschema.NewCompiler()
+	c.AssertFormat = true
+
+	// never load schemas from the network or filesystem of the server
+	c.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("%w: %s", ErrExternalReference, url)
+	}
+
+	if err := c.AddResource(schemaURL, bytes.NewReader(b)); err != nil {
+		return nil, newSchemaError(err)
+	}
+
+	s, err := c.Compile(schemaURL)
+	if err != nil {
+		return nil, newSchemaError(err)
+	}
+
+	compiled.Add(key, s)
+
+	return s, nil
+}
+
+// ValidateSchema returns a *SchemaError if the schema is not a valid JSON Schema document, an empty schema is valid
+func ValidateSchema(schema map[string]any) error {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	_, err := Compile(schema)
+
+	return err
+}
+
+// Validate returns a *ValidationError listing every field of the data that does not conform to the schema, data
+// is not constrained by an empty schema
+func Validate(schema, data map[string]any) error {
+	if len(schema) == 0 {
+		return nil
+	}
+
+	s, err := Compile(schema)
+	if err != nil {
+		return err
+	}
+
+	v, err := normalize(data)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Validate(v); err != nil {
+		var ve *jsonschema.ValidationError
+		if errors.As(err, &ve) {
+			return &ValidationError{Errors: fieldErrors(ve)}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// normalize round trips the data through JSON so it only holds the types the validator expects, e.g. integers
+// set by resolvers become json numbers
+func normalize(data map[string]any) (any, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// newSchemaError returns the violations of the meta-schema by the template schema, or the compilation error
+func newSchemaError(err error) *SchemaError {
+	var ve *jsonschema.ValidationError
+	if errors.As(err, &ve) {
+		return &SchemaError{Errors: fieldErrors(ve)}
+	}
+
+	msg := err.Error()
+
+	var se *jsonschema.SchemaError
+	if errors.As(err, &se) && se.Err != nil {
+		msg = se.Err.Error()
+	}
+
+	return &SchemaError{Errors: []FieldError{{Message: strings.TrimPrefix(msg, "jsonschema: ")}}}
+}
+
+// fieldErrors flattens the validation error to the violations of the individual fields, sorted by path
+func fieldErrors(ve *jsonschema.ValidationError) []FieldError {
+	var out []FieldError
+
+	var flatten func(*jsonschema.ValidationError)
+
+	flatten = func(ve *jsonschema.ValidationError) {
+		if len(ve.Causes) == 0 {
+			out = append(out, FieldError{Path: ve.InstanceLocation, Message: ve.Message})
+
+			return
+		}
+
+		for _, c := range ve.Causes {
+			flatten(c)
+		}
+	}
+
+	flatten(ve)
+
+	slices.SortStableFunc(out, func(a, b FieldError) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return slices.Compact(out)
+}
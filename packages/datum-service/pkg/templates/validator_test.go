@@ -0,0 +1,144 @@
+package templates_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/templates"
+)
+
+var ndaSchema = map[string]any{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"type":    "object",
+	"properties": map[string]any{
+		"name":  map[string]any{"type": "string", "minLength": 1},
+		"email": map[string]any{"type": "string", "format": "email"},
+		"years": map[string]any{"type": "integer", "minimum": 1},
+		"address": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"zip": map[string]any{"type": "string", "pattern": "^[0-9]{5}$"},
+			},
+			"required": []any{"zip"},
+		},
+	},
+	"required": []any{"name"},
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schema   map[string]any
+		data     map[string]any
+		expected []templates.FieldError
+	}{
+		{
+			name:   "happy path",
+			schema: ndaSchema,
+			data: map[string]any{
+				"name":    "MITB",
+				"email":   "mitb@datum.net",
+				"years":   2,
+				"address": map[string]any{"zip": "94107"},
+			},
+		},
+		{
+			name:   "empty schema does not constrain the data",
+			schema: map[string]any{},
+			data:   map[string]any{"anything": true},
+		},
+		{
+			name:   "missing required field",
+			schema: ndaSchema,
+			data:   map[string]any{"email": "mitb@datum.net"},
+			expected: []templates.FieldError{
+				{Path: "", Message: "missing properties: 'name'"},
+			},
+		},
+		{
+			name:   "every invalid field is reported with its path",
+			schema: ndaSchema,
+			data: map[string]any{
+				"name":    "MITB",
+				"email":   "not-an-email",
+				"years":   1.5,
+				"address": map[string]any{"zip": "941"},
+			},
+			expected: []templates.FieldError{
+				{Path: "/address/zip", Message: "does not match pattern '^[0-9]{5}$'"},
+				{Path: "/email", Message: "'not-an-email' is not valid 'email'"},
+				{Path: "/years", Message: "expected integer, but got number"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := templates.Validate(tc.schema, tc.data)
+
+			if tc.expected == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			var ve *templates.ValidationError
+
+			require.ErrorAs(t, err, &ve)
+			assert.Equal(t, tc.expected, ve.Errors)
+		})
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	testCases := []struct {
+		name        string
+		schema      map[string]any
+		expectedErr string
+	}{
+		{
+			name:   "happy path",
+			schema: ndaSchema,
+		},
+		{
+			name:   "empty schema",
+			schema: nil,
+		},
+		{
+			name:        "invalid type",
+			schema:      map[string]any{"type": "strings"},
+			expectedErr: "/type",
+		},
+		{
+			name: "invalid keyword value",
+			schema: map[string]any{
+				"properties": map[string]any{"name": map[string]any{"minLength": -1}},
+			},
+			expectedErr: "/properties/name/minLength",
+		},
+		{
+			name:        "external references are not loaded",
+			schema:      map[string]any{"$ref": "file:///etc/passwd"},
+			expectedErr: "external schema references are not supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := templates.ValidateSchema(tc.schema)
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			var se *templates.SchemaError
+
+			require.ErrorAs(t, err, &se)
+			assert.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
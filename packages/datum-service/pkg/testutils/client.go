@@ -123,6 +123,9 @@ func testGraphServer(t *testing.T, c *generated.Client) *handler.Server {
 
 	graphapi.WithTransactions(srv, c)
 
+	// restrict tokens to their scopes
+	graphapi.WithScopes(srv)
+
 	// if you do not want sleeps (the writer prefers naps anyways), skip cache
 	graphapi.WithSkipCache(srv)
 
@@ -16,6 +16,8 @@ type Config struct {
 	AccessDuration time.Duration `json:"accessDuration" koanf:"accessDuration" default:"1h"`
 	// RefreshDuration represents the duration of the refresh token is valid for
 	RefreshDuration time.Duration `json:"refreshDuration" koanf:"refreshDuration" default:"2h"`
+	// TFAChallengeDuration represents the duration a two factor challenge token issued after password login is valid for
+	TFAChallengeDuration time.Duration `json:"tfaChallengeDuration" koanf:"tfaChallengeDuration" default:"5m"`
 	// RefreshOverlap represents the overlap time for a refresh and access token
 	RefreshOverlap time.Duration `json:"refreshOverlap" koanf:"refreshOverlap" default:"-15m" `
 	// JWKSEndpoint represents the endpoint for the JSON Web Key Set
@@ -21,6 +21,15 @@ var (
 
 	// ErrUnknownSigningKey returns when the signing key fetched does not match the loaded managed keys
 	ErrUnknownSigningKey = errors.New("unknown signing key")
+
+	// ErrTFAChallengeMissingUserID returns when a two factor challenge token is created or verified without a user id
+	ErrTFAChallengeMissingUserID = errors.New("two factor challenge token is missing user id")
+
+	// ErrTokenRevoked returns when a refresh token was revoked before it expired, e.g. on logout
+	ErrTokenRevoked = errors.New("token has been revoked")
+
+	// ErrRevocationMissingSubject returns when the tokens of a subject are revoked without a subject
+	ErrRevocationMissingSubject = errors.New("unable to revoke tokens, subject is required")
 )
 
 var (
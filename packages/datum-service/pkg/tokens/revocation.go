@@ -0,0 +1,91 @@
+package tokens
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// revokedTokenKeyPrefix is the prefix of the keys of revoked token IDs
+	revokedTokenKeyPrefix = "revoked_token:"
+	// revokedSubjectKeyPrefix is the prefix of the keys holding the time all tokens of a subject were revoked
+	revokedSubjectKeyPrefix = "revoked_subject:"
+)
+
+// RevocationList keeps track of refresh tokens that were revoked before they expired, e.g. when a user logs out
+type RevocationList interface {
+	// Revoke the token with the ID until it expires
+	Revoke(ctx context.Context, id string, expires time.Time) error
+	// RevokeSubject revokes all the tokens issued to the subject until now, ttl should be at least the refresh
+	// duration so every token issued before is expired when the revocation is removed
+	RevokeSubject(ctx context.Context, subject string, ttl time.Duration) error
+	// IsRevoked returns true if the token with the claims was revoked
+	IsRevoked(ctx context.Context, claims *Claims) (bool, error)
+}
+
+var _ RevocationList = &redisRevocationList{}
+
+// redisRevocationList stores the revoked tokens in redis
+type redisRevocationList struct {
+	client *redis.Client
+}
+
+// NewRedisRevocationList returns a RevocationList that stores the revoked tokens in redis
+func NewRedisRevocationList(client *redis.Client) RevocationList {
+	return &redisRevocationList{
+		client: client,
+	}
+}
+
+// Revoke the token with the ID until it expires
+func (r *redisRevocationList) Revoke(ctx context.Context, id string, expires time.Time) error {
+	if id == "" {
+		return ErrTokenInvalidID
+	}
+
+	ttl := time.Until(expires)
+	if ttl <= 0 {
+		// the token is already expired
+		return nil
+	}
+
+	return r.client.Set(ctx, revokedTokenKeyPrefix+id, 1, ttl).Err()
+}
+
+// RevokeSubject revokes all the tokens issued to the subject until now
+func (r *redisRevocationList) RevokeSubject(ctx context.Context, subject string, ttl time.Duration) error {
+	if subject == "" {
+		return ErrRevocationMissingSubject
+	}
+
+	return r.client.Set(ctx, revokedSubjectKeyPrefix+subject, time.Now().Unix(), ttl).Err()
+}
+
+// IsRevoked returns true if the token ID was revoked or the token was issued before all the tokens of its subject
+// were revoked
+func (r *redisRevocationList) IsRevoked(ctx context.Context, claims *Claims) (bool, error) {
+	values, err := r.client.MGet(ctx, revokedTokenKeyPrefix+claims.ID, revokedSubjectKeyPrefix+claims.Subject).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return false, err
+	}
+
+	if values[0] != nil {
+		return true, nil
+	}
+
+	if values[1] == nil || claims.IssuedAt == nil {
+		return false, nil
+	}
+
+	revokedAt, err := strconv.ParseInt(values[1].(string), 10, 64)
+	if err != nil {
+		return false, err
+	}
+
+	// issued at has a precision of seconds, tokens issued in the same second as the revocation are revoked
+	return claims.IssuedAt.Unix() <= revokedAt, nil
+}
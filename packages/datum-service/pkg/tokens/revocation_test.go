@@ -0,0 +1,83 @@
+package tokens_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/tokens"
+)
+
+func TestRedisRevocationList(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	rl := tokens.NewRedisRevocationList(redis.NewClient(&redis.Options{
+		Addr:             mr.Addr(),
+		DisableIndentity: true, // # spellcheck:off
+	}))
+
+	ctx := context.Background()
+
+	claims := func(id, subject string, issuedAt time.Time) *tokens.Claims {
+		return &tokens.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID:       id,
+				Subject:  subject,
+				IssuedAt: jwt.NewNumericDate(issuedAt),
+			},
+		}
+	}
+
+	now := time.Now()
+
+	// tokens are not revoked by default
+	revoked, err := rl.IsRevoked(ctx, claims("01", "MITB", now))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	// revoking a token only revokes the token with the id until it expires
+	require.NoError(t, rl.Revoke(ctx, "01", now.Add(time.Hour)))
+
+	revoked, err = rl.IsRevoked(ctx, claims("01", "MITB", now))
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	revoked, err = rl.IsRevoked(ctx, claims("02", "MITB", now))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	mr.FastForward(time.Hour)
+
+	revoked, err = rl.IsRevoked(ctx, claims("01", "MITB", now))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	// expired tokens do not need to be revoked
+	require.NoError(t, rl.Revoke(ctx, "03", now.Add(-time.Minute)))
+	assert.False(t, mr.Exists("revoked_token:03"))
+
+	require.ErrorIs(t, rl.Revoke(ctx, "", now.Add(time.Hour)), tokens.ErrTokenInvalidID)
+
+	// revoking a subject revokes all the tokens issued before
+	require.NoError(t, rl.RevokeSubject(ctx, "MITB", time.Hour))
+
+	revoked, err = rl.IsRevoked(ctx, claims("04", "MITB", now.Add(-time.Minute)))
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	revoked, err = rl.IsRevoked(ctx, claims("05", "MITB", now.Add(time.Minute)))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	revoked, err = rl.IsRevoked(ctx, claims("06", "SITB", now.Add(-time.Minute)))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	require.ErrorIs(t, rl.RevokeSubject(ctx, "", time.Hour), tokens.ErrRevocationMissingSubject)
+}
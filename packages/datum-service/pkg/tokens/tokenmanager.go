@@ -16,7 +16,12 @@ import (
 	"github.com/oklog/ulid/v2"
 )
 
-const DefaultRefreshAudience = "https://auth.datum.net/v1/refresh"
+const (
+	DefaultRefreshAudience = "https://auth.datum.net/v1/refresh"
+	DefaultTFAAudience     = "https://auth.datum.net/v1/login/tfa"
+	// DefaultTFAChallengeDuration is used when the token manager is not configured with a challenge duration
+	DefaultTFAChallengeDuration = 5 * time.Minute
+)
 
 // the signing method should match the value returned by the JWKS
 var (
@@ -37,6 +42,7 @@ var (
 type TokenManager struct {
 	validator
 	refreshAudience string
+	tfaAudience     string
 	conf            Config
 	currentKeyID    ulid.ULID
 	currentKey      *rsa.PrivateKey
@@ -255,6 +261,68 @@ func (tm *TokenManager) CreateRefreshToken(accessToken *jwt.Token) (refreshToken
 	return tm.CreateToken(claims), nil
 }
 
+// CreateTFAChallengeToken returns a signed, short lived token for a user that has verified their password but
+// still has to complete a second factor along with the ID of the token; the token is only valid for the tfa
+// audience so it cannot be used as an access token
+func (tm *TokenManager) CreateTFAChallengeToken(userID string) (string, string, error) {
+	if userID == "" {
+		return "", "", ErrTFAChallengeMissingUserID
+	}
+
+	kid, err := tm.genKeyID()
+	if err != nil {
+		return "", "", err
+	}
+
+	duration := tm.conf.TFAChallengeDuration
+	if duration <= 0 {
+		duration = DefaultTFAChallengeDuration
+	}
+
+	now := time.Now()
+	issueTime := jwt.NewNumericDate(now)
+
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        strings.ToLower(kid.String()),
+			Subject:   userID,
+			Audience:  jwt.ClaimStrings{tm.TFAAudience()},
+			Issuer:    tm.issuer,
+			IssuedAt:  issueTime,
+			NotBefore: issueTime,
+			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+		},
+		UserID: userID,
+	}
+
+	tks, err := tm.Sign(tm.CreateToken(claims))
+	if err != nil {
+		return "", "", err
+	}
+
+	return tks, claims.ID, nil
+}
+
+// VerifyTFAChallengeToken verifies a token created by CreateTFAChallengeToken and returns its claims
+func (tm *TokenManager) VerifyTFAChallengeToken(tks string) (*Claims, error) {
+	v := &validator{
+		audience: tm.TFAAudience(),
+		issuer:   tm.issuer,
+		keyFunc:  tm.keyFunc,
+	}
+
+	claims, err := v.Verify(tks)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.UserID == "" {
+		return nil, ErrTFAChallengeMissingUserID
+	}
+
+	return claims, nil
+}
+
 // Keys returns the JWKS with public keys for use externally
 func (tm *TokenManager) Keys() (keys jwk.Set, err error) {
 	keys = jwk.NewSet()
@@ -303,6 +371,20 @@ func (tm *TokenManager) RefreshAudience() string {
 	return tm.refreshAudience
 }
 
+// TFAAudience returns the audience of two factor challenge tokens, which is the URL the challenge token should be
+// sent to in order to complete the login
+func (tm *TokenManager) TFAAudience() string {
+	if tm.tfaAudience == "" {
+		if aud, err := url.Parse(tm.issuer); err == nil && aud.Host != "" {
+			tm.tfaAudience = aud.ResolveReference(&url.URL{Path: "/v1/login/tfa"}).String()
+		} else {
+			tm.tfaAudience = DefaultTFAAudience
+		}
+	}
+
+	return tm.tfaAudience
+}
+
 // Config returns the token manager config
 func (tm *TokenManager) Config() Config {
 	return tm.conf
@@ -180,6 +180,43 @@ func (s *TokenTestSuite) TestValidTokens() {
 	require.NoError(err)
 }
 
+func (s *TokenTestSuite) TestTFAChallengeToken() {
+	require := s.Require()
+	tm, err := tokens.New(s.conf)
+	require.NoError(err, "could not initialize token manager")
+
+	_, _, err = tm.CreateTFAChallengeToken("")
+	require.ErrorIs(err, tokens.ErrTFAChallengeMissingUserID)
+
+	tks, id, err := tm.CreateTFAChallengeToken("01H6PGFB4T34D4WWEXQMAGJNMK")
+	require.NoError(err, "could not create challenge token")
+	require.NotEmpty(tks)
+	require.NotEmpty(id)
+
+	claims, err := tm.VerifyTFAChallengeToken(tks)
+	require.NoError(err, "could not verify challenge token")
+	require.Equal(id, claims.ID)
+	require.Equal("01H6PGFB4T34D4WWEXQMAGJNMK", claims.UserID)
+	require.Equal(jwt.ClaimStrings{"http://localhost:3001/v1/login/tfa"}, claims.Audience)
+	require.Equal(tokens.DefaultTFAChallengeDuration, claims.ExpiresAt.Sub(claims.IssuedAt.Time))
+
+	// the challenge token must not be accepted as an access token
+	_, err = tm.Verify(tks)
+	require.ErrorIs(err, tokens.ErrTokenInvalidAudience)
+
+	// and an access token must not be accepted as a challenge token
+	atks, _, err := tm.CreateTokenPair(&tokens.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject: "01H6PGFB4T34D4WWEXQMAGJNMK",
+		},
+		UserID: "01H6PGFB4T34D4WWEXQMAGJNMK",
+	})
+	require.NoError(err)
+
+	_, err = tm.VerifyTFAChallengeToken(atks)
+	require.ErrorIs(err, tokens.ErrTokenInvalidAudience)
+}
+
 func (s *TokenTestSuite) TestInvalidTokens() {
 	// Create the token manager
 	require := s.Require()
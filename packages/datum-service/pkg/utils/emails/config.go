@@ -3,11 +3,12 @@ package emails
 import (
 	"net/mail"
 	"net/url"
+	"time"
 
 	"github.com/datumforge/datum/pkg/utils/sendgrid"
 )
 
-// Config for sending emails via SendGrid and managing marketing contacts
+// Config for sending emails via SendGrid, SMTP or to local files and managing marketing contacts
 type Config struct {
 	// SendGridAPIKey is the SendGrid API key to authenticate with the service
 	SendGridAPIKey string `json:"sendGridApiKey" koanf:"sendGridApiKey"`
@@ -21,12 +22,44 @@ type Config struct {
 	DatumListID string `json:"datumListId" koanf:"datumListId"`
 	// AdminEmail is an internal group email configured within datum for email testing and visibility
 	AdminEmail string `json:"adminEmail" koanf:"adminEmail" default:"admins@datum.net"`
+	// Transport is the backend used to send emails when not in testing mode: sendgrid, smtp or file
+	Transport string `json:"transport" koanf:"transport" jsonschema:"enum=sendgrid,enum=smtp,enum=file" default:"sendgrid"`
+	// SMTP is the configuration for sending emails to an SMTP server
+	SMTP SMTPConfig `json:"smtp" koanf:"smtp"`
+	// File is the configuration for writing emails to a local directory
+	File FileConfig `json:"file" koanf:"file"`
 	// ConsoleURLConfig is the configuration for the URLs used in emails
 	ConsoleURLConfig ConsoleURLConfig `json:"consoleUrl" koanf:"consoleUrl"`
 	// MarketingURLConfig is the configuration for the URLs used in marketing emails
 	MarketingURLConfig MarketingURLConfig `json:"marketingUrl" koanf:"marketingUrl"`
 }
 
+// SMTPConfig is the configuration of the smtp transport
+type SMTPConfig struct {
+	// Host is the hostname of the SMTP server
+	Host string `json:"host" koanf:"host"`
+	// Port is the port of the SMTP server
+	Port int `json:"port" koanf:"port" default:"587"`
+	// Username is used to authenticate with the SMTP server, authentication is skipped when it is empty
+	Username string `json:"username" koanf:"username"`
+	// Password is used to authenticate with the SMTP server
+	Password string `json:"password" koanf:"password"`
+	// StartTLS upgrades the connection with STARTTLS and fails when the server does not support it
+	StartTLS bool `json:"startTls" koanf:"startTls" default:"true"`
+	// InsecureSkipVerify skips the verification of the certificate of the SMTP server
+	InsecureSkipVerify bool `json:"insecureSkipVerify" koanf:"insecureSkipVerify" default:"false"`
+	// Timeout is the maximum time to connect to the SMTP server
+	Timeout time.Duration `json:"timeout" koanf:"timeout" default:"10s"`
+}
+
+// FileConfig is the configuration of the file transport
+type FileConfig struct {
+	// Directory is where the emails are written
+	Directory string `json:"directory" koanf:"directory"`
+	// Maildir delivers the emails to a maildir in the directory instead of writing .eml files
+	Maildir bool `json:"maildir" koanf:"maildir" default:"false"`
+}
+
 // ConsoleURLConfig for the datum registration
 type ConsoleURLConfig struct {
 	// ConsoleBase is the base URL used for URL links in emails
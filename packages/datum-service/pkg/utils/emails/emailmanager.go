@@ -1,29 +1,21 @@
 package emails
 
 import (
-	"github.com/sendgrid/rest"
-	sg "github.com/sendgrid/sendgrid-go"
+	"strings"
+
 	sgmail "github.com/sendgrid/sendgrid-go/helpers/mail"
 
-	"github.com/datumforge/datum/pkg/rout"
-	"github.com/datumforge/datum/pkg/utils/emails/mock"
 	"github.com/datumforge/datum/pkg/utils/sendgrid"
 )
 
-// EmailManager allows a server to send rich emails using the SendGrid service
+// EmailManager allows a server to send rich emails using the configured transport
 type EmailManager struct {
-	conf   Config
-	client SendGridClient
+	conf      Config
+	transport Transport
 	ConsoleURLConfig
 	MarketingURLConfig
 }
 
-// SendGridClient is an interface that can be implemented by live email clients to send
-// real emails or by mock clients for testing
-type SendGridClient interface {
-	Send(email *sgmail.SGMailV3) (*rest.Response, error)
-}
-
 // New email manager with the specified configuration
 func New(conf Config) (m *EmailManager, err error) {
 	// conf.Validate checks presence of admin, from email, and testing flags
@@ -33,34 +25,16 @@ func New(conf Config) (m *EmailManager, err error) {
 		return nil, err
 	}
 
-	if conf.Testing {
-		// there's an additional Storage field in the SendGridClient within mock
-		m.client = &mock.SendGridClient{
-			Storage: conf.Archive,
-		}
-	} else {
-		if conf.SendGridAPIKey == "" {
-			return nil, ErrFailedToCreateEmailClient
-		}
-
-		m.client = sg.NewSendClient(conf.SendGridAPIKey)
+	if m.transport, err = newTransport(conf); err != nil {
+		return nil, err
 	}
 
 	return m, nil
 }
 
-func (m *EmailManager) Send(message *sgmail.SGMailV3) (err error) {
-	var rep *rest.Response
-
-	if rep, err = m.client.Send(message); err != nil {
-		return err
-	}
-
-	if rep.StatusCode < 200 || rep.StatusCode >= 300 {
-		return rout.HTTPErrorResponse(rep.Body)
-	}
-
-	return nil
+// Send the email with the configured transport
+func (m *EmailManager) Send(message *sgmail.SGMailV3) error {
+	return m.transport.Send(message)
 }
 
 // MustFromContact function is a helper function that returns the
@@ -103,9 +77,16 @@ func (m *EmailManager) MustAdminContact() sendgrid.Contact {
 	return contact
 }
 
-// Validate the from and admin emails are present if the SendGrid API is enabled
+// sendingEnabled returns true if emails are sent with the SendGrid API or another transport is configured
+func (m *EmailManager) sendingEnabled() bool {
+	transport := strings.ToLower(m.conf.Transport)
+
+	return m.Enabled() || transport == TransportSMTP || transport == TransportFile
+}
+
+// Validate the from and admin emails are present if sending emails is enabled
 func (m *EmailManager) Validate() (err error) {
-	if m.Enabled() {
+	if m.sendingEnabled() {
 		if m.conf.AdminEmail == "" || m.conf.FromEmail == "" {
 			return ErrBothAdminAndFromRequired
 		}
@@ -35,6 +35,9 @@ var (
 
 	// ErrBothAdminAndFromRequired
 	ErrBothAdminAndFromRequired = errors.New("invalid configuration: admin and from emails are required if sendgrid is enabled")
+
+	// ErrSMTPStartTLSNotSupported is returned when STARTTLS is required but not supported by the SMTP server
+	ErrSMTPStartTLSNotSupported = errors.New("smtp server does not support STARTTLS")
 )
 
 // InvalidEmailConfigError is returned when an invalid url configuration was provided
@@ -55,6 +58,24 @@ func newInvalidEmailConfigError(field string) *InvalidEmailConfigError {
 	}
 }
 
+// UnknownTransportError is returned when the configured email transport is not supported
+type UnknownTransportError struct {
+	// Transport that was configured
+	Transport string
+}
+
+// Error returns the UnknownTransportError in string format
+func (e *UnknownTransportError) Error() string {
+	return fmt.Sprintf("invalid email configuration: unknown transport %q", e.Transport)
+}
+
+// newUnknownTransportError returns an error for an unsupported email transport
+func newUnknownTransportError(transport string) *UnknownTransportError {
+	return &UnknownTransportError{
+		Transport: transport,
+	}
+}
+
 // MissingRequiredFieldError is returned when a required field was not provided in a request
 type MissingRequiredFieldError struct {
 	// RequiredField that is missing
@@ -0,0 +1,67 @@
+package emails
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	sgmail "github.com/sendgrid/sendgrid-go/helpers/mail"
+
+	"github.com/datumforge/datum/pkg/utils/ulids"
+)
+
+const (
+	// fileDirPermissions are the permissions of the directories created by the file transport
+	fileDirPermissions = 0700
+	// filePermissions are the permissions of the emails written by the file transport
+	filePermissions = 0600
+)
+
+// FileTransport writes emails to a local directory instead of sending them, either as .eml files or delivered to
+// a maildir that can be read by mail clients
+type FileTransport struct {
+	conf FileConfig
+}
+
+// NewFileTransport returns a transport writing emails to the configured directory
+func NewFileTransport(conf FileConfig) (*FileTransport, error) {
+	if conf.Directory == "" {
+		return nil, newInvalidEmailConfigError("file directory")
+	}
+
+	if conf.Maildir {
+		for _, dir := range []string{"tmp", "new", "cur"} {
+			if err := os.MkdirAll(filepath.Join(conf.Directory, dir), fileDirPermissions); err != nil {
+				return nil, err
+			}
+		}
+	} else if err := os.MkdirAll(conf.Directory, fileDirPermissions); err != nil {
+		return nil, err
+	}
+
+	return &FileTransport{conf: conf}, nil
+}
+
+// Send writes the email to the directory
+func (t *FileTransport) Send(msg *sgmail.SGMailV3) error {
+	data, err := BuildMIME(msg)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%d.%s", time.Now().Unix(), ulids.New())
+
+	if !t.conf.Maildir {
+		return os.WriteFile(filepath.Join(t.conf.Directory, name+".eml"), data, filePermissions)
+	}
+
+	// maildir delivery writes to tmp and moves the complete message to new so readers never see partial messages
+	tmp := filepath.Join(t.conf.Directory, "tmp", name)
+
+	if err := os.WriteFile(tmp, data, filePermissions); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filepath.Join(t.conf.Directory, "new", name))
+}
@@ -0,0 +1,231 @@
+package emails
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	sgmail "github.com/sendgrid/sendgrid-go/helpers/mail"
+
+	"github.com/datumforge/datum/pkg/utils/ulids"
+)
+
+// base64LineLength is the maximum length of the lines of base64 encoded attachments
+const base64LineLength = 76
+
+// BuildMIME converts the email to an RFC 5322 message with a text and html alternative and the attachments, it
+// is used by the transports that do not send emails with the SendGrid API
+func BuildMIME(msg *sgmail.SGMailV3) ([]byte, error) {
+	if msg.From == nil || msg.From.Address == "" {
+		return nil, ErrMissingSender
+	}
+
+	var to, cc []string
+
+	for _, p := range msg.Personalizations {
+		for _, r := range p.To {
+			to = append(to, formatAddress(r))
+		}
+
+		for _, r := range p.CC {
+			cc = append(cc, formatAddress(r))
+		}
+	}
+
+	if len(Recipients(msg)) == 0 {
+		return nil, ErrMissingRecipient
+	}
+
+	buf := new(bytes.Buffer)
+
+	writeHeader(buf, "From", formatAddress(msg.From))
+	writeHeader(buf, "To", strings.Join(to, ", "))
+
+	if len(cc) > 0 {
+		writeHeader(buf, "Cc", strings.Join(cc, ", "))
+	}
+
+	if msg.ReplyTo != nil && msg.ReplyTo.Address != "" {
+		writeHeader(buf, "Reply-To", formatAddress(msg.ReplyTo))
+	}
+
+	writeHeader(buf, "Subject", mime.QEncoding.Encode("utf-8", messageSubject(msg)))
+	writeHeader(buf, "Date", time.Now().Format(time.RFC1123Z))
+	writeHeader(buf, "Message-ID", fmt.Sprintf("<%s@%s>", ulids.New(), addressDomain(msg.From.Address)))
+	writeHeader(buf, "MIME-Version", "1.0")
+
+	body := multipart.NewWriter(buf)
+
+	if len(msg.Attachments) == 0 {
+		writeHeader(buf, "Content-Type", mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": body.Boundary()}))
+		buf.WriteString("\r\n")
+
+		if err := writeContent(body, msg.Content); err != nil {
+			return nil, err
+		}
+
+		if err := body.Close(); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	writeHeader(buf, "Content-Type", mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": body.Boundary()}))
+	buf.WriteString("\r\n")
+
+	// the text and html alternative is nested in the mixed message with the attachments
+	boundary := multipart.NewWriter(io.Discard).Boundary()
+
+	part, err := body.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": boundary})},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	alternative := multipart.NewWriter(part)
+	if err := alternative.SetBoundary(boundary); err != nil {
+		return nil, err
+	}
+
+	if err := writeContent(alternative, msg.Content); err != nil {
+		return nil, err
+	}
+
+	if err := alternative.Close(); err != nil {
+		return nil, err
+	}
+
+	for _, a := range msg.Attachments {
+		if err := writeAttachment(body, a); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Recipients returns the addresses the email is delivered to, including the blind copies
+func Recipients(msg *sgmail.SGMailV3) []string {
+	var recipients []string
+
+	for _, p := range msg.Personalizations {
+		for _, list := range [][]*sgmail.Email{p.To, p.CC, p.BCC} {
+			for _, r := range list {
+				if r != nil && r.Address != "" {
+					recipients = append(recipients, r.Address)
+				}
+			}
+		}
+	}
+
+	return recipients
+}
+
+// writeContent writes the text and html content of the email as quoted-printable parts
+func writeContent(w *multipart.Writer, contents []*sgmail.Content) error {
+	for _, c := range contents {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", mime.FormatMediaType(c.Type, map[string]string{"charset": "utf-8"}))
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+
+		part, err := w.CreatePart(header)
+		if err != nil {
+			return err
+		}
+
+		qp := quotedprintable.NewWriter(part)
+
+		if _, err := qp.Write([]byte(c.Value)); err != nil {
+			return err
+		}
+
+		if err := qp.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeAttachment writes the attachment, the content of SendGrid attachments is already base64 encoded
+func writeAttachment(w *multipart.Writer, a *sgmail.Attachment) error {
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", mime.FormatMediaType(a.Type, map[string]string{"name": a.Filename}))
+	header.Set("Content-Transfer-Encoding", "base64")
+
+	disposition := a.Disposition
+	if disposition == "" {
+		disposition = "attachment"
+	}
+
+	header.Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": a.Filename}))
+
+	if a.ContentID != "" {
+		header.Set("Content-ID", fmt.Sprintf("<%s>", a.ContentID))
+	}
+
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	content := a.Content
+	for len(content) > base64LineLength {
+		if _, err := io.WriteString(part, content[:base64LineLength]+"\r\n"); err != nil {
+			return err
+		}
+
+		content = content[base64LineLength:]
+	}
+
+	_, err = io.WriteString(part, content+"\r\n")
+
+	return err
+}
+
+// writeHeader writes a single header line of the message
+func writeHeader(buf *bytes.Buffer, key, value string) {
+	fmt.Fprintf(buf, "%s: %s\r\n", key, value)
+}
+
+// formatAddress formats the email address for a message header
+func formatAddress(e *sgmail.Email) string {
+	return (&mail.Address{Name: e.Name, Address: e.Address}).String()
+}
+
+// messageSubject returns the subject of the email, falling back to the subject of the first personalization
+func messageSubject(msg *sgmail.SGMailV3) string {
+	if msg.Subject != "" {
+		return msg.Subject
+	}
+
+	for _, p := range msg.Personalizations {
+		if p.Subject != "" {
+			return p.Subject
+		}
+	}
+
+	return ""
+}
+
+// addressDomain returns the domain of the email address, used for the message id
+func addressDomain(address string) string {
+	if i := strings.LastIndex(address, "@"); i >= 0 {
+		return address[i+1:]
+	}
+
+	return "localhost"
+}
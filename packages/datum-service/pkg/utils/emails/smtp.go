@@ -0,0 +1,96 @@
+package emails
+
+import (
+	"crypto/tls"
+	"net"
+	"net/smtp"
+	"strconv"
+
+	sgmail "github.com/sendgrid/sendgrid-go/helpers/mail"
+)
+
+// SMTPTransport sends emails to an SMTP server
+type SMTPTransport struct {
+	conf SMTPConfig
+}
+
+// NewSMTPTransport returns a transport sending emails to the SMTP server
+func NewSMTPTransport(conf SMTPConfig) (*SMTPTransport, error) {
+	if conf.Host == "" {
+		return nil, newInvalidEmailConfigError("smtp host")
+	}
+
+	if conf.Port == 0 {
+		conf.Port = 587
+	}
+
+	return &SMTPTransport{conf: conf}, nil
+}
+
+// Send the email to the SMTP server, the authentication is only attempted over an encrypted connection
+// (or to localhost)
+func (t *SMTPTransport) Send(msg *sgmail.SGMailV3) error {
+	data, err := BuildMIME(msg)
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(t.conf.Host, strconv.Itoa(t.conf.Port)), t.conf.Timeout)
+	if err != nil {
+		return err
+	}
+
+	c, err := smtp.NewClient(conn, t.conf.Host)
+	if err != nil {
+		conn.Close()
+
+		return err
+	}
+
+	defer c.Close()
+
+	if t.conf.StartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return ErrSMTPStartTLSNotSupported
+		}
+
+		if err := c.StartTLS(&tls.Config{
+			ServerName:         t.conf.Host,
+			InsecureSkipVerify: t.conf.InsecureSkipVerify, //nolint:gosec
+			MinVersion:         tls.VersionTLS12,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if t.conf.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", t.conf.Username, t.conf.Password, t.conf.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(msg.From.Address); err != nil {
+		return err
+	}
+
+	for _, r := range Recipients(msg) {
+		if err := c.Rcpt(r); err != nil {
+			return err
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
@@ -0,0 +1,91 @@
+package emails
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/sendgrid/rest"
+	sg "github.com/sendgrid/sendgrid-go"
+	sgmail "github.com/sendgrid/sendgrid-go/helpers/mail"
+
+	"github.com/datumforge/datum/pkg/rout"
+	"github.com/datumforge/datum/pkg/utils/emails/mock"
+)
+
+const (
+	// TransportSendGrid sends emails with the SendGrid API
+	TransportSendGrid = "sendgrid"
+	// TransportSMTP sends emails to an SMTP server
+	TransportSMTP = "smtp"
+	// TransportFile writes emails to a local directory as .eml files or to a maildir
+	TransportFile = "file"
+)
+
+// Transport delivers the emails built by the email manager; the messages are built for SendGrid and are converted
+// to MIME by the transports that do not use the SendGrid API
+type Transport interface {
+	Send(msg *sgmail.SGMailV3) error
+}
+
+// SendGridClient is an interface that can be implemented by live email clients to send
+// real emails or by mock clients for testing
+type SendGridClient interface {
+	Send(email *sgmail.SGMailV3) (*rest.Response, error)
+}
+
+// SendGridTransport sends emails with a SendGrid client
+type SendGridTransport struct {
+	client SendGridClient
+}
+
+// NewSendGridTransport returns a transport sending emails with the SendGrid API
+func NewSendGridTransport(apiKey string) (*SendGridTransport, error) {
+	if apiKey == "" {
+		return nil, ErrFailedToCreateEmailClient
+	}
+
+	return &SendGridTransport{client: sg.NewSendClient(apiKey)}, nil
+}
+
+// NewArchiveTransport returns a transport recording emails in the mock instead of sending them, emails are written in
+// MIME format to the archive directory when it is set
+func NewArchiveTransport(archive string) *SendGridTransport {
+	return &SendGridTransport{
+		client: &mock.SendGridClient{
+			Storage: archive,
+		},
+	}
+}
+
+// Send the email with the SendGrid client
+func (t *SendGridTransport) Send(msg *sgmail.SGMailV3) error {
+	rep, err := t.client.Send(msg)
+	if err != nil {
+		return err
+	}
+
+	if rep.StatusCode < http.StatusOK || rep.StatusCode >= http.StatusMultipleChoices {
+		return rout.HTTPErrorResponse(rep.Body)
+	}
+
+	return nil
+}
+
+// newTransport returns the transport configured for the email manager
+func newTransport(conf Config) (Transport, error) {
+	// testing mode never sends live emails
+	if conf.Testing {
+		return NewArchiveTransport(conf.Archive), nil
+	}
+
+	switch strings.ToLower(conf.Transport) {
+	case "", TransportSendGrid:
+		return NewSendGridTransport(conf.SendGridAPIKey)
+	case TransportSMTP:
+		return NewSMTPTransport(conf.SMTP)
+	case TransportFile:
+		return NewFileTransport(conf.File)
+	default:
+		return nil, newUnknownTransportError(conf.Transport)
+	}
+}
@@ -0,0 +1,266 @@
+package emails_test
+
+import (
+	"bufio"
+	"net"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	sgmail "github.com/sendgrid/sendgrid-go/helpers/mail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/utils/emails"
+)
+
+func testMessage() *sgmail.SGMailV3 {
+	msg := sgmail.NewV3MailInit(
+		sgmail.NewEmail("Datum", "no-reply@datum.net"),
+		"Welcome to Datum",
+		sgmail.NewEmail("Art Vandelay", "art@vandelayindustries.com"),
+		sgmail.NewContent("text/plain", "hello art"),
+		sgmail.NewContent("text/html", "<p>hello art</p>"),
+	)
+
+	msg.Personalizations[0].AddBCCs(sgmail.NewEmail("", "admins@datum.net"))
+
+	return msg
+}
+
+func TestBuildMIME(t *testing.T) {
+	msg := testMessage()
+
+	data, err := emails.BuildMIME(msg)
+	require.NoError(t, err)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(string(data)))
+	require.NoError(t, err)
+
+	assert.Equal(t, `"Datum" <no-reply@datum.net>`, parsed.Header.Get("From"))
+	assert.Equal(t, `"Art Vandelay" <art@vandelayindustries.com>`, parsed.Header.Get("To"))
+	assert.Empty(t, parsed.Header.Get("Bcc"))
+	assert.Contains(t, parsed.Header.Get("Subject"), "Welcome")
+	assert.Contains(t, parsed.Header.Get("Content-Type"), "multipart/alternative")
+	assert.Contains(t, string(data), "<p>hello art</p>")
+
+	assert.Equal(t, []string{"art@vandelayindustries.com", "admins@datum.net"}, emails.Recipients(msg))
+
+	// attachments are sent in a mixed message
+	attachment := sgmail.NewAttachment()
+	attachment.SetContent("aGVsbG8gYXJ0")
+	attachment.SetType("text/plain")
+	attachment.SetFilename("hello.txt")
+	msg.AddAttachment(attachment)
+
+	data, err = emails.BuildMIME(msg)
+	require.NoError(t, err)
+
+	parsed, err = mail.ReadMessage(strings.NewReader(string(data)))
+	require.NoError(t, err)
+	assert.Contains(t, parsed.Header.Get("Content-Type"), "multipart/mixed")
+	assert.Contains(t, string(data), "filename=hello.txt")
+
+	// the sender and recipients are required
+	_, err = emails.BuildMIME(sgmail.NewV3Mail())
+	require.ErrorIs(t, err, emails.ErrMissingSender)
+
+	_, err = emails.BuildMIME(sgmail.NewV3Mail().SetFrom(sgmail.NewEmail("", "no-reply@datum.net")))
+	require.ErrorIs(t, err, emails.ErrMissingRecipient)
+}
+
+func TestFileTransport(t *testing.T) {
+	t.Run("eml", func(t *testing.T) {
+		dir := t.TempDir()
+
+		transport, err := emails.NewFileTransport(emails.FileConfig{Directory: dir})
+		require.NoError(t, err)
+		require.NoError(t, transport.Send(testMessage()))
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+
+		data, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "hello art")
+	})
+
+	t.Run("maildir", func(t *testing.T) {
+		dir := t.TempDir()
+
+		transport, err := emails.NewFileTransport(emails.FileConfig{Directory: dir, Maildir: true})
+		require.NoError(t, err)
+		require.NoError(t, transport.Send(testMessage()))
+
+		delivered, err := os.ReadDir(filepath.Join(dir, "new"))
+		require.NoError(t, err)
+		assert.Len(t, delivered, 1)
+
+		pending, err := os.ReadDir(filepath.Join(dir, "tmp"))
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("missing directory", func(t *testing.T) {
+		_, err := emails.NewFileTransport(emails.FileConfig{})
+		require.Error(t, err)
+	})
+}
+
+func TestSMTPTransport(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	defer ln.Close()
+
+	received := make(chan smtpSession, 1)
+
+	go serveSMTP(ln, received)
+
+	host, port, err := net.SplitHostPort(ln.Addr().String())
+	require.NoError(t, err)
+
+	p, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	transport, err := emails.NewSMTPTransport(emails.SMTPConfig{
+		Host:    host,
+		Port:    p,
+		Timeout: time.Second,
+	})
+	require.NoError(t, err)
+	require.NoError(t, transport.Send(testMessage()))
+
+	select {
+	case session := <-received:
+		assert.Equal(t, "no-reply@datum.net", session.from)
+		assert.Equal(t, []string{"art@vandelayindustries.com", "admins@datum.net"}, session.rcpt)
+		assert.Contains(t, session.data, "Subject:")
+		assert.Contains(t, session.data, "hello art")
+	case <-time.After(5 * time.Second):
+		t.Fatal("smtp server did not receive the email")
+	}
+
+	// STARTTLS is required unless disabled
+	go serveSMTP(ln, received)
+
+	transport, err = emails.NewSMTPTransport(emails.SMTPConfig{
+		Host:     host,
+		Port:     p,
+		StartTLS: true,
+		Timeout:  time.Second,
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, transport.Send(testMessage()), emails.ErrSMTPStartTLSNotSupported)
+
+	_, err = emails.NewSMTPTransport(emails.SMTPConfig{})
+	require.Error(t, err)
+}
+
+func TestNewEmailManagerTransport(t *testing.T) {
+	_, err := emails.New(emails.Config{
+		Transport:  "pigeon",
+		FromEmail:  "no-reply@datum.net",
+		AdminEmail: "admins@datum.net",
+	})
+	require.ErrorContains(t, err, "unknown transport")
+
+	_, err = emails.New(emails.Config{
+		Transport: emails.TransportFile,
+		File:      emails.FileConfig{Directory: t.TempDir()},
+	})
+	require.ErrorIs(t, err, emails.ErrBothAdminAndFromRequired)
+
+	m, err := emails.New(emails.Config{
+		Transport:  emails.TransportFile,
+		FromEmail:  "no-reply@datum.net",
+		AdminEmail: "admins@datum.net",
+		File:       emails.FileConfig{Directory: t.TempDir()},
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.Send(testMessage()))
+}
+
+// smtpSession is the envelope and data received by the fake smtp server
+type smtpSession struct {
+	from string
+	rcpt []string
+	data string
+}
+
+// serveSMTP accepts a single connection and answers the commands of the smtp client
+func serveSMTP(ln net.Listener, received chan<- smtpSession) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+
+	reply := func(line string) {
+		w.WriteString(line + "\r\n") //nolint:errcheck
+		w.Flush()                    //nolint:errcheck
+	}
+
+	session := smtpSession{}
+
+	reply("220 localhost ESMTP")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		cmd := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"):
+			// STARTTLS is not advertised
+			reply("250 localhost")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			session.from = strings.Trim(strings.TrimPrefix(cmd, "MAIL FROM:"), "<>")
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			session.rcpt = append(session.rcpt, strings.Trim(strings.TrimPrefix(cmd, "RCPT TO:"), "<>"))
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+
+			var data strings.Builder
+
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+
+				if line == ".\r\n" {
+					break
+				}
+
+				data.WriteString(line)
+			}
+
+			session.data = data.String()
+
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 bye")
+
+			received <- session
+
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
@@ -71,6 +71,9 @@ var (
 	// ErrFailedToGetSecretForQR is an error representing a failure to get secret for qr
 	ErrFailedToGetSecretForQR = errors.New("failed to get secret for qr")
 
+	// ErrFailedToGenerateQRImage is an error representing a failure to encode a qr code as an image
+	ErrFailedToGenerateQRImage = errors.New("failed to generate qr image")
+
 	// ErrFailedToGenerateSecret is an error representing a failure to generate secret
 	ErrFailedToGenerateSecret = errors.New("failed to generate secret")
 
@@ -0,0 +1,18 @@
+package totp
+
+import (
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// qrImageSize is the width and height in pixels of generated qr code images
+const qrImageSize = 256
+
+// QRImage encodes the otpauth URI returned by TOTPQRString as a PNG image that can be scanned by an authenticator app
+func QRImage(otpauth string) ([]byte, error) {
+	png, err := qrcode.Encode(otpauth, qrcode.Medium, qrImageSize)
+	if err != nil {
+		return nil, ErrFailedToGenerateQRImage
+	}
+
+	return png, nil
+}
@@ -165,6 +165,11 @@ func (o *OTP) ValidateTOTP(ctx context.Context, user *User, code string) error {
 		return ErrIncorrectCodeProvided
 	}
 
+	// reuse of codes can only be prevented when a redis client is configured
+	if o.db == nil {
+		return nil
+	}
+
 	key := fmt.Sprintf("%s_%s", user.ID, code)
 
 	// Validated code has previously been used in the past thirty seconds
@@ -104,3 +104,14 @@ func TestGenerateRecoveryCodes(t *testing.T) {
 		assert.Len(t, code, 8, "incorrect recovery code length")
 	}
 }
+
+func TestQRImage(t *testing.T) {
+	png, err := QRImage("otpauth://totp/authenticator.local:mitb@datum.net?secret=JBSWY3DPEHPK3PXP&issuer=authenticator.local")
+	require.NoError(t, err)
+
+	// all png files start with the same signature
+	assert.True(t, strings.HasPrefix(string(png), "\x89PNG\r\n\x1a\n"), "qr image is not a png")
+
+	_, err = QRImage(strings.Repeat("a", 8000))
+	assert.ErrorIs(t, err, ErrFailedToGenerateQRImage)
+}
@@ -0,0 +1,21 @@
+package webhooks
+
+import "time"
+
+// Config contains the configuration for outbound webhook delivery
+type Config struct {
+	// Enabled turns on delivery of events to configured webhooks
+	Enabled bool `json:"enabled" koanf:"enabled" default:"false"`
+	// Timeout is the maximum duration of a single delivery attempt
+	Timeout time.Duration `json:"timeout" koanf:"timeout" default:"10s"`
+	// MaxRetries is the number of times a failed delivery is retried before giving up
+	MaxRetries int `json:"maxRetries" koanf:"maxRetries" default:"3"`
+	// RetryInterval is the initial backoff between delivery attempts, it doubles on each retry
+	RetryInterval time.Duration `json:"retryInterval" koanf:"retryInterval" default:"1s"`
+	// MaxRetryInterval is the maximum backoff between delivery attempts
+	MaxRetryInterval time.Duration `json:"maxRetryInterval" koanf:"maxRetryInterval" default:"30s"`
+	// MaxFailures is the number of consecutive failed deliveries before a webhook is disabled, 0 never disables
+	MaxFailures int `json:"maxFailures" koanf:"maxFailures" default:"10"`
+	// AllowPrivateHosts allows deliveries to loopback, private and link-local addresses over http, only for development
+	AllowPrivateHosts bool `json:"allowPrivateHosts" koanf:"allowPrivateHosts" default:"false"`
+}
@@ -0,0 +1,297 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/datumforge/datum/pkg/httpsling"
+)
+
+const (
+	// secretLength is the number of random bytes used for generated webhook secrets
+	secretLength = 32
+	// maxResponseLength is the maximum number of bytes of the response body kept on an attempt
+	maxResponseLength = 1024
+	// backoffMultiplier is the multiplier applied to the retry interval after each attempt
+	backoffMultiplier = 2
+	// userAgent is sent on all webhook deliveries
+	userAgent = "Datum-Webhooks/1.0"
+)
+
+// Deliverer sends signed payloads to webhook destinations, retrying failed attempts with exponential backoff
+type Deliverer struct {
+	config  Config
+	client  *httpsling.Client
+	backoff httpsling.BackoffStrategy
+	// guarded is true when the client only connects to public addresses, response bodies of internal hosts
+	// must not be kept as they are returned to the organization that configured the webhook
+	guarded bool
+}
+
+// Option configures the Deliverer
+type Option func(*Deliverer)
+
+// WithHTTPClient sets the underlying http client used for deliveries, the client does not refuse internal addresses
+// so the response bodies of the deliveries are not kept
+func WithHTTPClient(c *http.Client) Option {
+	return func(d *Deliverer) {
+		d.client.HTTPClient = c
+		d.guarded = false
+	}
+}
+
+// WithBackoffStrategy sets the backoff strategy used between delivery attempts
+func WithBackoffStrategy(strategy httpsling.BackoffStrategy) Option {
+	return func(d *Deliverer) {
+		d.backoff = strategy
+	}
+}
+
+// New returns a Deliverer configured with the provided config and options, destination urls are configured by
+// organizations so connections to internal addresses are refused unless explicitly allowed
+func New(config Config, opts ...Option) *Deliverer {
+	clientConfig := &httpsling.Config{
+		Timeout: config.Timeout,
+	}
+
+	if !config.AllowPrivateHosts {
+		clientConfig.Transport = httpsling.NewPublicOnlyTransport(config.Timeout, ErrDestinationNotAllowed)
+	}
+
+	d := &Deliverer{
+		config:  config,
+		client:  httpsling.Create(clientConfig),
+		backoff: httpsling.ExponentialBackoffStrategy(config.RetryInterval, backoffMultiplier, config.MaxRetryInterval),
+		guarded: !config.AllowPrivateHosts,
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// Config returns the configuration of the Deliverer
+func (d *Deliverer) Config() Config {
+	return d.config
+}
+
+// ExceedsFailureThreshold returns true when the number of consecutive failures should disable the webhook
+func (d *Deliverer) ExceedsFailureThreshold(failures int) bool {
+	return d.config.MaxFailures > 0 && failures >= d.config.MaxFailures
+}
+
+// Target is the destination of a webhook delivery
+type Target struct {
+	// URL is the destination url the payload is posted to
+	URL string
+	// Secret is used to sign the payload, if empty the payload is not signed
+	Secret []byte
+}
+
+// ValidateDestinationURL returns an error if the destination url of a webhook is not an absolute https url
+func ValidateDestinationURL(destination string) error {
+	u, err := url.Parse(destination)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return ErrInvalidDestination
+	}
+
+	return nil
+}
+
+// Attempt is the outcome of a single delivery attempt
+type Attempt struct {
+	// Number is the attempt number, starting at 1
+	Number int
+	// StatusCode is the http status code returned by the destination, 0 if no response was received
+	StatusCode int
+	// Response is the (truncated) response body returned by the destination, only kept when the destination
+	// passed the internal address check
+	Response string
+	// Duration is how long the attempt took
+	Duration time.Duration
+	// Err is the error of the attempt, nil if the delivery succeeded
+	Err error
+}
+
+// Result contains all attempts made for a delivery
+type Result struct {
+	// Body is the signed request body sent to the destination
+	Body []byte
+	// Attempts made for the delivery in order
+	Attempts []*Attempt
+}
+
+// Last returns the last attempt of the delivery
+func (r *Result) Last() *Attempt {
+	if r == nil || len(r.Attempts) == 0 {
+		return nil
+	}
+
+	return r.Attempts[len(r.Attempts)-1]
+}
+
+// Success returns true if the last attempt of the delivery succeeded
+func (r *Result) Success() bool {
+	last := r.Last()
+
+	return last != nil && last.Err == nil
+}
+
+// Deliver posts the signed payload to the target, retrying on network errors, 429 and 5xx responses
+// up to the configured number of retries. An error is returned if the final attempt failed
+func (d *Deliverer) Deliver(ctx context.Context, target Target, payload *Payload) (*Result, error) {
+	return d.deliver(ctx, target, payload, d.config.MaxRetries)
+}
+
+// DeliverOnce posts the signed payload to the target in a single attempt without retrying,
+// an error is returned if the attempt failed
+func (d *Deliverer) DeliverOnce(ctx context.Context, target Target, payload *Payload) (*Result, error) {
+	return d.deliver(ctx, target, payload, 0)
+}
+
+// deliver posts the signed payload to the target, retrying failed attempts up to maxRetries times
+func (d *Deliverer) deliver(ctx context.Context, target Target, payload *Payload, maxRetries int) (*Result, error) {
+	if target.URL == "" {
+		return nil, ErrMissingDestination
+	}
+
+	// webhooks created before https was required are not delivered over plain http
+	if !d.config.AllowPrivateHosts {
+		if err := ValidateDestinationURL(target.URL); err != nil {
+			return nil, err
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{Body: body}
+
+	for i := 0; i <= maxRetries; i++ {
+		attempt := d.attempt(ctx, target, payload, body, i+1)
+		res.Attempts = append(res.Attempts, attempt)
+
+		if attempt.Err == nil || !retryable(attempt) || i == maxRetries {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return res, fmt.Errorf("%w: %w", ErrDeliveryFailed, ctx.Err())
+		case <-time.After(d.backoff(i)):
+		}
+	}
+
+	if last := res.Last(); last.Err != nil {
+		return res, fmt.Errorf("%w: %w", ErrDeliveryFailed, last.Err)
+	}
+
+	return res, nil
+}
+
+// attempt makes a single delivery attempt to the target
+func (d *Deliverer) attempt(ctx context.Context, target Target, payload *Payload, body []byte, number int) *Attempt {
+	start := time.Now()
+
+	req := d.client.Post(target.URL).
+		ContentType(httpsling.ContentTypeJSONUTF8).
+		UserAgent(userAgent).
+		Header(EventHeader, payload.Type).
+		Header(DeliveryHeader, payload.ID).
+		Header(AttemptHeader, strconv.Itoa(number)).
+		Body(json.RawMessage(body))
+
+	// the destination is not trusted, so only the part of the response that is kept is read
+	req.AddMiddleware(limitResponseBody(maxResponseLength))
+
+	if len(target.Secret) > 0 {
+		req.Header(SignatureHeader, Sign(target.Secret, start, body))
+	}
+
+	attempt := &Attempt{
+		Number: number,
+	}
+
+	resp, err := req.Send(ctx)
+
+	attempt.Duration = time.Since(start)
+
+	if err != nil {
+		attempt.Err = err
+
+		return attempt
+	}
+
+	defer resp.Close()
+
+	attempt.StatusCode = resp.StatusCode()
+
+	if d.guarded {
+		attempt.Response = resp.String()
+	}
+
+	if !resp.IsSuccess() {
+		attempt.Err = fmt.Errorf("%w: %d", ErrUnexpectedStatus, attempt.StatusCode)
+	}
+
+	return attempt
+}
+
+// retryable returns true if the failed attempt should be retried
+func retryable(a *Attempt) bool {
+	switch {
+	case errors.Is(a.Err, ErrDestinationNotAllowed):
+		return false
+	case a.StatusCode == 0:
+		// no response was received (e.g. connection refused or timeout)
+		return true
+	case a.StatusCode == http.StatusTooManyRequests:
+		return true
+	case a.StatusCode >= http.StatusInternalServerError:
+		return true
+	default:
+		return false
+	}
+}
+
+// limitResponseBody returns a middleware that only reads the first n bytes of the response body
+func limitResponseBody(n int64) httpsling.Middleware {
+	return func(next httpsling.MiddlewareHandlerFunc) httpsling.MiddlewareHandlerFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			if err != nil || resp == nil {
+				return resp, err
+			}
+
+			resp.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.LimitReader(resp.Body, n), resp.Body}
+
+			return resp, nil
+		}
+	}
+}
+
+// NewSecret returns a new randomly generated secret used for signing webhook payloads
+func NewSecret() ([]byte, error) {
+	secret := make([]byte, secretLength)
+
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
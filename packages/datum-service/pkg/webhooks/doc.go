@@ -0,0 +1,2 @@
+// Package webhooks handles signing and delivery of outbound webhook payloads to organization configured destinations
+package webhooks
@@ -0,0 +1,28 @@
+package webhooks
+
+import (
+	"errors"
+)
+
+var (
+	// ErrMissingDestination is returned when a webhook has no destination url configured
+	ErrMissingDestination = errors.New("webhook destination url is required")
+	// ErrInvalidDestination is returned when the destination url of a webhook is not an https url
+	ErrInvalidDestination = errors.New("webhook destination url must be an https url")
+	// ErrDestinationNotAllowed is returned when the destination url of a webhook resolves to an internal address
+	ErrDestinationNotAllowed = errors.New("webhook destination host is not allowed")
+	// ErrDeliveryFailed is returned when all delivery attempts to a webhook failed
+	ErrDeliveryFailed = errors.New("webhook delivery failed")
+	// ErrUnexpectedStatus is returned when the destination responds with a non-2xx status code
+	ErrUnexpectedStatus = errors.New("unexpected response status from webhook destination")
+	// ErrInvalidSignatureHeader is returned when the signature header cannot be parsed
+	ErrInvalidSignatureHeader = errors.New("invalid webhook signature header")
+	// ErrSignatureMismatch is returned when the signature does not match the payload
+	ErrSignatureMismatch = errors.New("webhook signature does not match payload")
+	// ErrSignatureExpired is returned when the signature timestamp is outside of the allowed tolerance
+	ErrSignatureExpired = errors.New("webhook signature timestamp is outside of the tolerance")
+	// ErrInvalidEventType is returned when a webhook subscribes to an event type that is not emitted
+	ErrInvalidEventType = errors.New("invalid webhook event type")
+	// ErrInvalidObjectType is returned when a webhook filters on an object type that does not emit events
+	ErrInvalidObjectType = errors.New("invalid webhook object type")
+)
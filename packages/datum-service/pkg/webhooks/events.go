@@ -0,0 +1,80 @@
+package webhooks
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// trackedObjectTypes are the object types that emit events
+var trackedObjectTypes = []string{"user", "organization", "group", "subscriber"}
+
+// trackedActions are the mutation actions that emit events for the tracked object types
+var trackedActions = []string{"create", "delete"}
+
+// EventType returns the name of the event emitted for the action on the object type, e.g. `user.created`
+func EventType(objectType, action string) string {
+	return fmt.Sprintf("%s.%sd", strings.ToLower(objectType), action)
+}
+
+// IsTracked returns true if an event is emitted for the action on the object type
+func IsTracked(objectType, action string) bool {
+	return slices.Contains(trackedObjectTypes, strings.ToLower(objectType)) &&
+		slices.Contains(trackedActions, action)
+}
+
+// ObjectTypes returns the object types that emit events
+func ObjectTypes() []string {
+	return slices.Clone(trackedObjectTypes)
+}
+
+// EventTypes returns the names of all events emitted by the service
+func EventTypes() []string {
+	types := []string{}
+
+	for _, obj := range trackedObjectTypes {
+		for _, action := range trackedActions {
+			types = append(types, EventType(obj, action))
+		}
+	}
+
+	return types
+}
+
+// ValidateEventTypes returns an error if any of the event types is not emitted by the service
+func ValidateEventTypes(eventTypes []string) error {
+	valid := EventTypes()
+
+	for _, t := range eventTypes {
+		if !slices.Contains(valid, t) {
+			return fmt.Errorf("%w: %s, must be one of [%s]", ErrInvalidEventType, t, strings.Join(valid, ", "))
+		}
+	}
+
+	return nil
+}
+
+// ValidateObjectTypes returns an error if any of the object types does not emit events
+func ValidateObjectTypes(objectTypes []string) error {
+	for _, t := range objectTypes {
+		if !slices.Contains(trackedObjectTypes, t) {
+			return fmt.Errorf("%w: %s, must be one of [%s]", ErrInvalidObjectType, t, strings.Join(trackedObjectTypes, ", "))
+		}
+	}
+
+	return nil
+}
+
+// Subscribed returns true if a webhook subscribed to the event types and object types should receive the event,
+// an empty list of event types or object types matches all events
+func Subscribed(eventTypes, objectTypes []string, eventType, objectType string) bool {
+	if len(eventTypes) > 0 && !slices.Contains(eventTypes, eventType) {
+		return false
+	}
+
+	if len(objectTypes) > 0 && !slices.Contains(objectTypes, strings.ToLower(objectType)) {
+		return false
+	}
+
+	return true
+}
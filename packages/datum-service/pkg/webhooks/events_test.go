@@ -0,0 +1,86 @@
+package webhooks_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/webhooks"
+)
+
+func TestEventTypes(t *testing.T) {
+	assert.Equal(t, "user.created", webhooks.EventType("User", "create"))
+	assert.Equal(t, "organization.deleted", webhooks.EventType("organization", "delete"))
+
+	types := webhooks.EventTypes()
+	assert.Contains(t, types, "user.created")
+	assert.Contains(t, types, "organization.created")
+	assert.Contains(t, types, "group.deleted")
+	assert.Contains(t, types, "subscriber.created")
+	assert.NotContains(t, types, "user.updated")
+
+	assert.True(t, webhooks.IsTracked("Organization", "create"))
+	assert.False(t, webhooks.IsTracked("Organization", "update"))
+	assert.False(t, webhooks.IsTracked("Feature", "create"))
+}
+
+func TestValidateEventTypes(t *testing.T) {
+	require.NoError(t, webhooks.ValidateEventTypes(nil))
+	require.NoError(t, webhooks.ValidateEventTypes([]string{"user.created", "organization.created"}))
+	require.ErrorIs(t, webhooks.ValidateEventTypes([]string{"user.created", "user_created"}), webhooks.ErrInvalidEventType)
+	require.ErrorIs(t, webhooks.ValidateEventTypes([]string{"user.updated"}), webhooks.ErrInvalidEventType)
+}
+
+func TestValidateObjectTypes(t *testing.T) {
+	require.NoError(t, webhooks.ValidateObjectTypes(nil))
+	require.NoError(t, webhooks.ValidateObjectTypes([]string{"user", "group"}))
+	require.ErrorIs(t, webhooks.ValidateObjectTypes([]string{"User"}), webhooks.ErrInvalidObjectType)
+	require.ErrorIs(t, webhooks.ValidateObjectTypes([]string{"feature"}), webhooks.ErrInvalidObjectType)
+}
+
+func TestSubscribed(t *testing.T) {
+	testCases := []struct {
+		name        string
+		eventTypes  []string
+		objectTypes []string
+		expected    bool
+	}{
+		{
+			name:     "no filters",
+			expected: true,
+		},
+		{
+			name:       "matching event type",
+			eventTypes: []string{"organization.created", "user.created"},
+			expected:   true,
+		},
+		{
+			name:       "event type not subscribed",
+			eventTypes: []string{"user.deleted"},
+			expected:   false,
+		},
+		{
+			name:        "matching object type",
+			objectTypes: []string{"user"},
+			expected:    true,
+		},
+		{
+			name:        "object type not subscribed",
+			objectTypes: []string{"group"},
+			expected:    false,
+		},
+		{
+			name:        "both filters must match",
+			eventTypes:  []string{"user.created"},
+			objectTypes: []string{"group"},
+			expected:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, webhooks.Subscribed(tc.eventTypes, tc.objectTypes, "user.created", "User"))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package webhooks
+
+import (
+	"time"
+)
+
+// Payload is the JSON body sent to a webhook destination for an event
+type Payload struct {
+	// ID is the unique identifier of the event
+	ID string `json:"id"`
+	// Type is the event type, e.g. user.created
+	Type string `json:"type"`
+	// OrganizationID is the organization that owns the webhook
+	OrganizationID string `json:"organization_id,omitempty"`
+	// CreatedAt is the time the event occurred
+	CreatedAt time.Time `json:"created_at"`
+	// Data contains the properties of the event
+	Data map[string]interface{} `json:"data"`
+}
+
+// NewPayload returns a payload for the event with the provided data
+func NewPayload(id, eventType, orgID string, data map[string]interface{}) *Payload {
+	return &Payload{
+		ID:             id,
+		Type:           eventType,
+		OrganizationID: orgID,
+		CreatedAt:      time.Now().UTC(),
+		Data:           data,
+	}
+}
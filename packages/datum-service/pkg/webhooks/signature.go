@@ -0,0 +1,80 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader is the header containing the timestamp and HMAC signature of the payload
+	SignatureHeader = "X-Datum-Signature"
+	// EventHeader is the header containing the event type of the payload
+	EventHeader = "X-Datum-Event"
+	// DeliveryHeader is the header containing the event id of the payload
+	DeliveryHeader = "X-Datum-Delivery"
+	// AttemptHeader is the header containing the delivery attempt number
+	AttemptHeader = "X-Datum-Attempt"
+
+	signatureVersion = "v1"
+)
+
+// Sign returns the signature header value for the body using the webhook secret,
+// the format is `t=<unix timestamp>,v1=<hex encoded hmac-sha256 of "<timestamp>.<body>">`
+func Sign(secret []byte, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+
+	return fmt.Sprintf("t=%s,%s=%s", ts, signatureVersion, computeSignature(secret, ts, body))
+}
+
+// VerifySignature checks the signature header against the body and secret, a zero tolerance skips the timestamp check
+func VerifySignature(secret []byte, header string, body []byte, tolerance time.Duration) error {
+	var ts, sig string
+
+	for _, part := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return ErrInvalidSignatureHeader
+		}
+
+		switch k {
+		case "t":
+			ts = v
+		case signatureVersion:
+			sig = v
+		}
+	}
+
+	if ts == "" || sig == "" {
+		return ErrInvalidSignatureHeader
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return ErrInvalidSignatureHeader
+	}
+
+	if tolerance > 0 && time.Since(time.Unix(unix, 0)) > tolerance {
+		return ErrSignatureExpired
+	}
+
+	if !hmac.Equal([]byte(sig), []byte(computeSignature(secret, ts, body))) {
+		return ErrSignatureMismatch
+	}
+
+	return nil
+}
+
+// computeSignature returns the hex encoded hmac of the timestamp and body
+func computeSignature(secret []byte, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
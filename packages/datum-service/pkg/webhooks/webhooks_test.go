@@ -0,0 +1,263 @@
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datumforge/datum/pkg/webhooks"
+)
+
+// testConfig returns a config with short backoff intervals for testing, private hosts are allowed
+// to deliver to the local test servers
+func testConfig() webhooks.Config {
+	return webhooks.Config{
+		Enabled:           true,
+		Timeout:           time.Second,
+		MaxRetries:        2,
+		RetryInterval:     time.Millisecond,
+		MaxRetryInterval:  5 * time.Millisecond,
+		MaxFailures:       3,
+		AllowPrivateHosts: true,
+	}
+}
+
+// receiver returns a test server that responds with the status codes in order and
+// records the requests it received
+func receiver(t *testing.T, secret []byte, statuses ...int) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var calls int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		// every attempt must carry a valid signature for the exact body
+		if secret != nil {
+			assert.NoError(t, webhooks.VerifySignature(secret, r.Header.Get(webhooks.SignatureHeader), body, time.Minute))
+		}
+
+		var p webhooks.Payload
+		assert.NoError(t, json.Unmarshal(body, &p))
+		assert.Equal(t, p.Type, r.Header.Get(webhooks.EventHeader))
+		assert.Equal(t, p.ID, r.Header.Get(webhooks.DeliveryHeader))
+
+		status := statuses[len(statuses)-1]
+		if int(n) <= len(statuses) {
+			status = statuses[n-1]
+		}
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(http.StatusText(status)))
+	}))
+
+	t.Cleanup(srv.Close)
+
+	return srv, &calls
+}
+
+func TestDeliver(t *testing.T) {
+	secret, err := webhooks.NewSecret()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name             string
+		statuses         []int
+		expectedAttempts int
+		expectedStatus   int
+		expectedErr      error
+	}{
+		{
+			name:             "happy path",
+			statuses:         []int{http.StatusOK},
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "retried after server error",
+			statuses:         []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusAccepted},
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusAccepted,
+		},
+		{
+			name:             "retried after rate limit",
+			statuses:         []int{http.StatusTooManyRequests, http.StatusOK},
+			expectedAttempts: 2,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "client error is not retried",
+			statuses:         []int{http.StatusBadRequest},
+			expectedAttempts: 1,
+			expectedStatus:   http.StatusBadRequest,
+			expectedErr:      webhooks.ErrDeliveryFailed,
+		},
+		{
+			name:             "retries exhausted",
+			statuses:         []int{http.StatusServiceUnavailable},
+			expectedAttempts: 3,
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedErr:      webhooks.ErrDeliveryFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, calls := receiver(t, secret, tc.statuses...)
+
+			d := webhooks.New(testConfig())
+
+			payload := webhooks.NewPayload("01J4EXD5MM60CX4YNYN0DEE3Y1", "user.created", "01J4EXD5MM60CX4YNYN0DEE3Y2",
+				map[string]interface{}{"user_id": "01J4EXD5MM60CX4YNYN0DEE3Y3"})
+
+			res, err := d.Deliver(context.Background(), webhooks.Target{URL: srv.URL, Secret: secret}, payload)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				assert.False(t, res.Success())
+			} else {
+				require.NoError(t, err)
+				assert.True(t, res.Success())
+			}
+
+			assert.Len(t, res.Attempts, tc.expectedAttempts)
+			assert.Equal(t, int32(tc.expectedAttempts), atomic.LoadInt32(calls))
+			assert.Equal(t, tc.expectedStatus, res.Last().StatusCode)
+			assert.Equal(t, tc.expectedAttempts, res.Last().Number)
+
+			// response bodies are only kept when internal addresses are refused
+			assert.Empty(t, res.Last().Response)
+		})
+	}
+}
+
+func TestDeliverOnce(t *testing.T) {
+	srv, calls := receiver(t, nil, http.StatusServiceUnavailable)
+
+	d := webhooks.New(testConfig())
+
+	res, err := d.DeliverOnce(context.Background(), webhooks.Target{URL: srv.URL}, webhooks.NewPayload("1", "user.created", "", nil))
+	require.ErrorIs(t, err, webhooks.ErrDeliveryFailed)
+	assert.Len(t, res.Attempts, 1)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+	assert.Equal(t, http.StatusServiceUnavailable, res.Last().StatusCode)
+}
+
+func TestDeliverPrivateHost(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("delivery must not reach the private host")
+	}))
+	defer srv.Close()
+
+	config := testConfig()
+	config.AllowPrivateHosts = false
+
+	d := webhooks.New(config)
+
+	res, err := d.Deliver(context.Background(), webhooks.Target{URL: srv.URL}, webhooks.NewPayload("1", "user.created", "", nil))
+	require.ErrorIs(t, err, webhooks.ErrDeliveryFailed)
+	require.ErrorIs(t, err, webhooks.ErrDestinationNotAllowed)
+
+	// refused destinations are not retried
+	assert.Len(t, res.Attempts, 1)
+	assert.Equal(t, 0, res.Last().StatusCode)
+
+	// plain http destinations are refused before connecting
+	_, err = d.Deliver(context.Background(), webhooks.Target{URL: "http://example.com/hook"}, webhooks.NewPayload("1", "user.created", "", nil))
+	require.ErrorIs(t, err, webhooks.ErrInvalidDestination)
+}
+
+func TestValidateDestinationURL(t *testing.T) {
+	testCases := []struct {
+		url         string
+		expectedErr bool
+	}{
+		{url: "https://example.com/hook"},
+		{url: "http://example.com/hook", expectedErr: true},
+		{url: "file:///etc/passwd", expectedErr: true},
+		{url: "https://", expectedErr: true},
+		{url: "", expectedErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			err := webhooks.ValidateDestinationURL(tc.url)
+			if tc.expectedErr {
+				require.ErrorIs(t, err, webhooks.ErrInvalidDestination)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeliverUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := srv.URL
+	srv.Close()
+
+	d := webhooks.New(testConfig())
+
+	res, err := d.Deliver(context.Background(), webhooks.Target{URL: url}, webhooks.NewPayload("1", "user.created", "", nil))
+	require.ErrorIs(t, err, webhooks.ErrDeliveryFailed)
+	assert.Len(t, res.Attempts, 3)
+	assert.Equal(t, 0, res.Last().StatusCode)
+}
+
+func TestDeliverMissingDestination(t *testing.T) {
+	d := webhooks.New(testConfig())
+
+	_, err := d.Deliver(context.Background(), webhooks.Target{}, webhooks.NewPayload("1", "user.created", "", nil))
+	require.ErrorIs(t, err, webhooks.ErrMissingDestination)
+}
+
+func TestExceedsFailureThreshold(t *testing.T) {
+	d := webhooks.New(testConfig())
+
+	assert.False(t, d.ExceedsFailureThreshold(2))
+	assert.True(t, d.ExceedsFailureThreshold(3))
+	assert.True(t, d.ExceedsFailureThreshold(4))
+
+	// zero max failures never disables the webhook
+	d = webhooks.New(webhooks.Config{})
+	assert.False(t, d.ExceedsFailureThreshold(100))
+}
+
+func TestVerifySignature(t *testing.T) {
+	secret := []byte("supersecret")
+	body := []byte(`{"id":"1","type":"user.created"}`)
+
+	header := webhooks.Sign(secret, time.Now(), body)
+
+	require.NoError(t, webhooks.VerifySignature(secret, header, body, time.Minute))
+
+	// tampered body
+	require.ErrorIs(t, webhooks.VerifySignature(secret, header, []byte(`{"id":"2"}`), time.Minute), webhooks.ErrSignatureMismatch)
+
+	// wrong secret
+	require.ErrorIs(t, webhooks.VerifySignature([]byte("wrong"), header, body, time.Minute), webhooks.ErrSignatureMismatch)
+
+	// expired timestamp
+	old := webhooks.Sign(secret, time.Now().Add(-time.Hour), body)
+	require.ErrorIs(t, webhooks.VerifySignature(secret, old, body, time.Minute), webhooks.ErrSignatureExpired)
+
+	// tolerance of zero skips the timestamp check
+	require.NoError(t, webhooks.VerifySignature(secret, old, body, 0))
+
+	// malformed headers
+	require.ErrorIs(t, webhooks.VerifySignature(secret, "", body, 0), webhooks.ErrInvalidSignatureHeader)
+	require.ErrorIs(t, webhooks.VerifySignature(secret, "t=abc,v1=123", body, 0), webhooks.ErrInvalidSignatureHeader)
+	require.ErrorIs(t, webhooks.VerifySignature(secret, "garbage", body, 0), webhooks.ErrInvalidSignatureHeader)
+}